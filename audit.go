@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"go.uber.org/zap"
+)
+
+// runAuditPrune prunes the audits once by the retention policy
+func runAuditPrune(
+	application *app.Application,
+	logger *zap.Logger,
+	dryRun bool,
+) error {
+	prune, err := application.AuditPrune(context.Background(), dryRun)
+	if err != nil {
+		if prune != nil {
+			logger.Error(
+				"audit prune failed",
+				zap.Int("id", prune.ID),
+				zap.Int("pruned_rows", prune.PrunedRows),
+				zap.Int("archived_rows", prune.ArchivedRows),
+				zap.Error(err),
+			)
+		} else {
+			logger.Error("audit prune failed", zap.Error(err))
+		}
+		return err
+	}
+	logger.Info(
+		"audits pruned",
+		zap.Int("id", prune.ID),
+		zap.Bool("dry_run", prune.DryRun),
+		zap.Int("pruned_rows", prune.PrunedRows),
+		zap.Int("archived_rows", prune.ArchivedRows),
+		zap.Duration("duration", prune.FinishedAt.Time.Sub(prune.CreatedAt)),
+	)
+	return nil
+}
+
+// scheduleAuditPrune prunes the audits every
+// config.Config.AuditRetention.IntervalInHours
+func scheduleAuditPrune(application *app.Application, logger *zap.Logger) {
+	ticker := time.NewTicker(
+		time.Hour * time.Duration(config.Config.AuditRetention.IntervalInHours),
+	)
+	defer ticker.Stop()
+	for range ticker.C {
+		// errors are logged and the next prune is tried on schedule
+		_ = runAuditPrune(
+			application,
+			logger,
+			config.Config.AuditRetention.DryRun,
+		)
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"go.uber.org/zap"
+)
+
+// runCatalogExport exports the catalog once
+func runCatalogExport(application *app.Application, logger *zap.Logger) error {
+	export, err := application.CatalogExport(context.Background())
+	if err != nil {
+		if export != nil {
+			logger.Error(
+				"catalog export failed",
+				zap.Int("id", export.ID),
+				zap.Error(err),
+			)
+		} else {
+			logger.Error("catalog export failed", zap.Error(err))
+		}
+		return err
+	}
+	logger.Info("catalog exported", zap.Int("id", export.ID))
+	return nil
+}
+
+// scheduleCatalogExport exports the catalog every
+// config.Config.Export.IntervalInHours
+func scheduleCatalogExport(application *app.Application, logger *zap.Logger) {
+	ticker := time.NewTicker(
+		time.Hour * time.Duration(config.Config.Export.IntervalInHours),
+	)
+	defer ticker.Stop()
+	for range ticker.C {
+		// errors are logged and the next export is tried on schedule
+		_ = runCatalogExport(application, logger)
+	}
+}
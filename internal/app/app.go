@@ -3,16 +3,24 @@ package app
 import (
 	"context"
 	"io"
+	"sync"
 
 	"github.com/aria3ppp/watchlist-server/internal/auth"
+	"github.com/aria3ppp/watchlist-server/internal/collection"
+	"github.com/aria3ppp/watchlist-server/internal/contribution"
 	"github.com/aria3ppp/watchlist-server/internal/dto"
 	"github.com/aria3ppp/watchlist-server/internal/hasher"
+	"github.com/aria3ppp/watchlist-server/internal/list"
+	"github.com/aria3ppp/watchlist-server/internal/metadata"
 	"github.com/aria3ppp/watchlist-server/internal/models"
 	"github.com/aria3ppp/watchlist-server/internal/query"
 	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/review"
 	"github.com/aria3ppp/watchlist-server/internal/search"
+	"github.com/aria3ppp/watchlist-server/internal/stats"
 	"github.com/aria3ppp/watchlist-server/internal/storage"
 	"github.com/aria3ppp/watchlist-server/internal/watchlist"
+	"github.com/volatiletech/null/v8"
 )
 
 // remove leading comment symbols to enable mocking
@@ -63,10 +71,16 @@ type Service interface {
 	) (uri string, err error)
 
 	// Movie
-	MovieGet(ctx context.Context, id int) (*models.Film, error)
+	MovieGet(
+		ctx context.Context,
+		id int,
+		localeOptions query.LocaleOptions,
+	) (*models.Film, error)
 	MoviesGetAll(
 		ctx context.Context,
 		queryOptions query.Options,
+		releaseOptions query.ReleaseOptions,
+		localeOptions query.LocaleOptions,
 	) (movies []*models.Film, total int, err error)
 	MovieCreate(
 		ctx context.Context,
@@ -102,11 +116,37 @@ type Service interface {
 		options *storage.PutOptions,
 	) (uri string, err error)
 
+	MovieExternalIDsGet(
+		ctx context.Context,
+		id int,
+	) (extIDs []*models.ExternalID, err error)
+	MovieExternalIDPut(
+		ctx context.Context,
+		id int,
+		contributorID int,
+		req *dto.ExternalIDPutRequest,
+	) error
+	MovieExternalIDAuditsGetAll(
+		ctx context.Context,
+		id int,
+		queryOptions query.SortOrderOptions,
+	) (audits []*models.ExternalIdsAudit, total int, err error)
+	MovieGetByExternalID(
+		ctx context.Context,
+		provider string,
+		externalID string,
+	) (movie *models.Film, err error)
+
 	// Series
-	SeriesGet(ctx context.Context, id int) (*models.Series, error)
+	SeriesGet(
+		ctx context.Context,
+		id int,
+		localeOptions query.LocaleOptions,
+	) (*models.Series, error)
 	SeriesesGetAll(
 		ctx context.Context,
 		queryOptions query.Options,
+		localeOptions query.LocaleOptions,
 	) (series []*models.Series, total int, err error)
 	SeriesCreate(
 		ctx context.Context,
@@ -142,21 +182,45 @@ type Service interface {
 		options *storage.PutOptions,
 	) (uri string, err error)
 
+	SeriesExternalIDsGet(
+		ctx context.Context,
+		id int,
+	) (extIDs []*models.ExternalID, err error)
+	SeriesExternalIDPut(
+		ctx context.Context,
+		id int,
+		contributorID int,
+		req *dto.ExternalIDPutRequest,
+	) error
+	SeriesExternalIDAuditsGetAll(
+		ctx context.Context,
+		id int,
+		queryOptions query.SortOrderOptions,
+	) (audits []*models.ExternalIdsAudit, total int, err error)
+	SeriesGetByExternalID(
+		ctx context.Context,
+		provider string,
+		externalID string,
+	) (series *models.Series, err error)
+
 	// Episode
 	EpisodeGet(
 		ctx context.Context,
 		seriesID, seasonNumber, episodeNumber int,
+		localeOptions query.LocaleOptions,
 	) (*models.Film, error)
 	EpisodesGetAllBySeries(
 		ctx context.Context,
 		seriesID int,
 		queryOptions query.SortOrderOptions,
+		localeOptions query.LocaleOptions,
 	) (episodes []*models.Film, total int, err error)
 	EpisodesGetAllBySeason(
 		ctx context.Context,
 		seriesID int,
 		seasonNumber int,
 		queryOptions query.SortOrderOptions,
+		localeOptions query.LocaleOptions,
 	) (episodes []*models.Film, total int, err error)
 	EpisodePut(
 		ctx context.Context,
@@ -189,23 +253,361 @@ type Service interface {
 		contributorID int,
 		req *dto.InvalidationRequest,
 	) error
+	EpisodesRenumber(
+		ctx context.Context,
+		seriesID int,
+		contributorID int,
+		req *dto.EpisodesRenumberRequest,
+	) error
 	EpisodeAuditsGetAll(
 		ctx context.Context,
 		seriesID, seasonNumber, episodeNumber int,
 		queryOptions query.SortOrderOptions,
 	) (audits []*models.FilmsAudit, total int, err error)
 
+	// Import
+	ImportCreate(
+		ctx context.Context,
+		userID int,
+		format string,
+		dryRun bool,
+		file io.Reader,
+	) (jobID int, err error)
+	ImportJobGet(
+		ctx context.Context,
+		id int,
+		userID int,
+	) (*models.ImportJob, error)
+	ImportErrorsGetAll(
+		ctx context.Context,
+		id int,
+		userID int,
+		queryOptions query.SortOrderOptions,
+	) (importErrors []*models.ImportError, total int, err error)
+	ImportJobsWait(ctx context.Context) error
+	ImportJobsFailUnfinished(ctx context.Context) (failed int, err error)
+
+	// Metadata
+	MovieMetadataGet(ctx context.Context, id int) (*metadata.Movie, error)
+	MovieMetadataApply(ctx context.Context, id int, contributorID int) error
+	SeriesMetadataGet(ctx context.Context, id int) (*metadata.Series, error)
+	SeriesMetadataApply(ctx context.Context, id int, contributorID int) error
+	SeasonMetadataGet(
+		ctx context.Context,
+		seriesID int,
+		seasonNumber int,
+	) (*metadata.Season, error)
+	SeasonMetadataApply(
+		ctx context.Context,
+		seriesID int,
+		seasonNumber int,
+		contributorID int,
+	) error
+
+	// Translation
+	MovieTranslationsGet(
+		ctx context.Context,
+		id int,
+	) (translations []*models.Translation, err error)
+	MovieTranslationPut(
+		ctx context.Context,
+		id int,
+		contributorID int,
+		req *dto.TranslationPutRequest,
+	) error
+	MovieTranslationAuditsGetAll(
+		ctx context.Context,
+		id int,
+		queryOptions query.SortOrderOptions,
+	) (audits []*models.TranslationsAudit, total int, err error)
+	SeriesTranslationsGet(
+		ctx context.Context,
+		id int,
+	) (translations []*models.Translation, err error)
+	SeriesTranslationPut(
+		ctx context.Context,
+		id int,
+		contributorID int,
+		req *dto.TranslationPutRequest,
+	) error
+	SeriesTranslationAuditsGetAll(
+		ctx context.Context,
+		id int,
+		queryOptions query.SortOrderOptions,
+	) (audits []*models.TranslationsAudit, total int, err error)
+	EpisodeTranslationsGet(
+		ctx context.Context,
+		seriesID, seasonNumber, episodeNumber int,
+	) (translations []*models.Translation, err error)
+	EpisodeTranslationPut(
+		ctx context.Context,
+		seriesID, seasonNumber, episodeNumber int,
+		contributorID int,
+		req *dto.TranslationPutRequest,
+	) error
+	EpisodeTranslationAuditsGetAll(
+		ctx context.Context,
+		seriesID, seasonNumber, episodeNumber int,
+		queryOptions query.SortOrderOptions,
+	) (audits []*models.TranslationsAudit, total int, err error)
+
+	// Release
+	MovieReleasesGet(
+		ctx context.Context,
+		id int,
+	) (releases []*models.Release, err error)
+	MovieReleasePut(
+		ctx context.Context,
+		id int,
+		contributorID int,
+		req *dto.ReleasePutRequest,
+	) error
+	MovieReleaseAuditsGetAll(
+		ctx context.Context,
+		id int,
+		queryOptions query.SortOrderOptions,
+	) (audits []*models.ReleasesAudit, total int, err error)
+
+	// Content Rating
+	MovieContentRatingsGet(
+		ctx context.Context,
+		id int,
+	) (contentRatings []*models.ContentRating, err error)
+	MovieContentRatingPut(
+		ctx context.Context,
+		id int,
+		contributorID int,
+		req *dto.ContentRatingPutRequest,
+	) error
+	MovieContentRatingAuditsGetAll(
+		ctx context.Context,
+		id int,
+		queryOptions query.SortOrderOptions,
+	) (audits []*models.ContentRatingsAudit, total int, err error)
+
+	// Media
+	MovieMediaGet(
+		ctx context.Context,
+		id int,
+	) (media []*models.MediaItem, err error)
+	MovieMediaUpload(
+		ctx context.Context,
+		id int,
+		contributorID int,
+		kind string,
+		primary bool,
+		file io.Reader,
+		options *storage.PutOptions,
+	) (media *models.MediaItem, err error)
+	MovieMediaTrailerAdd(
+		ctx context.Context,
+		id int,
+		contributorID int,
+		req *dto.MediaTrailerAddRequest,
+	) (media *models.MediaItem, err error)
+	MovieMediaReorder(
+		ctx context.Context,
+		id int,
+		contributorID int,
+		req *dto.MediaReorderRequest,
+	) error
+	MovieMediaRemove(
+		ctx context.Context,
+		id int,
+		mediaID int,
+		contributorID int,
+	) error
+	MovieMediaAuditsGetAll(
+		ctx context.Context,
+		id int,
+		queryOptions query.SortOrderOptions,
+	) (audits []*models.MediaItemsAudit, total int, err error)
+	SeriesMediaGet(
+		ctx context.Context,
+		id int,
+	) (media []*models.MediaItem, err error)
+	SeriesMediaUpload(
+		ctx context.Context,
+		id int,
+		contributorID int,
+		kind string,
+		primary bool,
+		file io.Reader,
+		options *storage.PutOptions,
+	) (media *models.MediaItem, err error)
+	SeriesMediaTrailerAdd(
+		ctx context.Context,
+		id int,
+		contributorID int,
+		req *dto.MediaTrailerAddRequest,
+	) (media *models.MediaItem, err error)
+	SeriesMediaReorder(
+		ctx context.Context,
+		id int,
+		contributorID int,
+		req *dto.MediaReorderRequest,
+	) error
+	SeriesMediaRemove(
+		ctx context.Context,
+		id int,
+		mediaID int,
+		contributorID int,
+	) error
+	SeriesMediaAuditsGetAll(
+		ctx context.Context,
+		id int,
+		queryOptions query.SortOrderOptions,
+	) (audits []*models.MediaItemsAudit, total int, err error)
+
+	// Collection
+	CollectionGet(ctx context.Context, id int) (*models.Collection, error)
+	CollectionsGetAll(
+		ctx context.Context,
+		queryOptions query.Options,
+	) (collections []*models.Collection, total int, err error)
+	CollectionCreate(
+		ctx context.Context,
+		contributorID int,
+		req *dto.CollectionCreateRequest,
+	) (collectionID int, err error)
+	CollectionUpdate(
+		ctx context.Context,
+		collectionID int,
+		contributorID int,
+		req *dto.CollectionUpdateRequest,
+	) error
+	CollectionInvalidate(
+		ctx context.Context,
+		collectionID int,
+		contributorID int,
+		req *dto.InvalidationRequest,
+	) error
+	CollectionAuditsGetAll(
+		ctx context.Context,
+		id int,
+		queryOptions query.SortOrderOptions,
+	) (audits []*models.CollectionsAudit, total int, err error)
+	CollectionItemsGet(
+		ctx context.Context,
+		id int,
+		localeOptions query.LocaleOptions,
+	) (items []*collection.Item, err error)
+	CollectionItemsPut(
+		ctx context.Context,
+		id int,
+		contributorID int,
+		req *dto.CollectionItemsPutRequest,
+	) error
+	CollectionItemAuditsGetAll(
+		ctx context.Context,
+		id int,
+		queryOptions query.SortOrderOptions,
+	) (audits []*models.CollectionItemsAudit, total int, err error)
+
+	// Export
+	CatalogExport(ctx context.Context) (*models.CatalogExport, error)
+	CatalogExportFileGet(
+		ctx context.Context,
+		filename string,
+	) (file io.ReadCloser, err error)
+
+	// Contribution
+	UserContributionsGet(
+		ctx context.Context,
+		userID int,
+		queryOptions query.SortOrderOptions,
+	) (
+		stats *contribution.Stats,
+		contributions []*contribution.Contribution,
+		total int,
+		err error,
+	)
+	ContributorsLeaderboard(
+		ctx context.Context,
+		offset int,
+		limit int,
+	) (stats []*contribution.Stats, total int, err error)
+	UserReputation(ctx context.Context, userID int) (int, error)
+
+	// Audit retention
+	AuditPrune(ctx context.Context, dryRun bool) (*models.AuditPrune, error)
+	AuditPruneGetLatest(ctx context.Context) (*models.AuditPrune, error)
+
+	// Moderation
+	UserSetModerator(ctx context.Context, userID int, moderator bool) error
+	MovieHide(ctx context.Context, id int, moderatorID int) error
+	MovieRestore(ctx context.Context, id int, moderatorID int) error
+	MovieDelete(ctx context.Context, id int, moderatorID int) error
+	SeriesHide(ctx context.Context, id int, moderatorID int) error
+	SeriesRestore(ctx context.Context, id int, moderatorID int) error
+	SeriesDelete(ctx context.Context, id int, moderatorID int) error
+	EpisodeHide(
+		ctx context.Context,
+		seriesID, seasonNumber, episodeNumber int,
+		moderatorID int,
+	) error
+	EpisodeRestore(
+		ctx context.Context,
+		seriesID, seasonNumber, episodeNumber int,
+		moderatorID int,
+	) error
+	EpisodeDelete(
+		ctx context.Context,
+		seriesID, seasonNumber, episodeNumber int,
+		moderatorID int,
+	) error
+
 	// Watchlist
 	WatchlistGet(
 		ctx context.Context,
 		userID int,
 		queryOptions query.WatchlistOptions,
+		localeOptions query.LocaleOptions,
 	) (watchlist []*watchlist.Item, total int, err error)
 	WatchlistAdd(
 		ctx context.Context,
 		userID int,
 		filmID int,
 	) (watchID int, err error)
+	WatchlistAddCollection(
+		ctx context.Context,
+		userID int,
+		collectionID int,
+	) (watchIDs []int, err error)
+	WatchlistAddSeries(
+		ctx context.Context,
+		userID int,
+		seriesID int,
+		follow bool,
+	) (watchIDs []int, err error)
+	WatchlistAddSeason(
+		ctx context.Context,
+		userID int,
+		seriesID int,
+		seasonNumber int,
+	) (watchIDs []int, err error)
+	SeriesUnfollow(
+		ctx context.Context,
+		userID int,
+		seriesID int,
+	) error
+	WatchEventCreate(
+		ctx context.Context,
+		userID int,
+		watchID int,
+		req *dto.WatchEventCreateRequest,
+	) (eventID int, err error)
+	WatchEventUndo(
+		ctx context.Context,
+		userID int,
+		watchID int,
+	) error
+	WatchEventsGetAll(
+		ctx context.Context,
+		userID int,
+		watchID int,
+		queryOptions query.SortOrderOptions,
+	) (events []*models.WatchEvent, total int, err error)
 	WatchlistDelete(
 		ctx context.Context,
 		userID int,
@@ -216,14 +618,252 @@ type Service interface {
 		userID int,
 		watchID int,
 	) error
+	WatchlistItemUpdate(
+		ctx context.Context,
+		userID int,
+		watchID int,
+		req *dto.WatchlistItemUpdateRequest,
+	) error
+	WatchlistMove(
+		ctx context.Context,
+		userID int,
+		watchID int,
+		afterID null.Int,
+	) error
+	UpNextGet(
+		ctx context.Context,
+		userID int,
+		offset int,
+		limit int,
+		localeOptions query.LocaleOptions,
+	) (upNext []*watchlist.UpNext, total int, err error)
+
+	// Playback Progress
+	PlaybackProgressGet(
+		ctx context.Context,
+		userID int,
+		filmID int,
+	) (*models.PlaybackProgress, error)
+	PlaybackProgressPut(
+		ctx context.Context,
+		userID int,
+		filmID int,
+		req *dto.PlaybackProgressPutRequest,
+	) (progress *models.PlaybackProgress, watched bool, err error)
+	ContinueWatchingGet(
+		ctx context.Context,
+		userID int,
+		offset int,
+		limit int,
+		localeOptions query.LocaleOptions,
+	) (progress []*watchlist.Progress, total int, err error)
+
+	// Score
+	MovieScorePut(
+		ctx context.Context,
+		userID int,
+		movieID int,
+		req *dto.ScorePutRequest,
+	) error
+	MovieScoreDelete(
+		ctx context.Context,
+		userID int,
+		movieID int,
+	) error
+	EpisodeScorePut(
+		ctx context.Context,
+		userID int,
+		seriesID, seasonNumber, episodeNumber int,
+		req *dto.ScorePutRequest,
+	) error
+	EpisodeScoreDelete(
+		ctx context.Context,
+		userID int,
+		seriesID, seasonNumber, episodeNumber int,
+	) error
+	SeriesScorePut(
+		ctx context.Context,
+		userID int,
+		seriesID int,
+		req *dto.ScorePutRequest,
+	) error
+	SeriesScoreDelete(
+		ctx context.Context,
+		userID int,
+		seriesID int,
+	) error
+	MovieReviewPut(
+		ctx context.Context,
+		userID int,
+		movieID int,
+		req *dto.ReviewPutRequest,
+	) (*models.Review, error)
+	MovieReviewDelete(ctx context.Context, userID int, movieID int) error
+	MovieReviewsGetAll(
+		ctx context.Context,
+		userID int,
+		movieID int,
+		queryOptions query.ReviewOptions,
+	) ([]*review.Review, int, error)
+	SeriesReviewPut(
+		ctx context.Context,
+		userID int,
+		seriesID int,
+		req *dto.ReviewPutRequest,
+	) (*models.Review, error)
+	SeriesReviewDelete(ctx context.Context, userID int, seriesID int) error
+	SeriesReviewsGetAll(
+		ctx context.Context,
+		userID int,
+		seriesID int,
+		queryOptions query.ReviewOptions,
+	) ([]*review.Review, int, error)
+	ReviewVote(ctx context.Context, userID int, reviewID int) error
+	ReviewUnvote(ctx context.Context, userID int, reviewID int) error
+	ReviewAuditsGetAll(
+		ctx context.Context,
+		userID int,
+		reviewID int,
+		queryOptions query.SortOrderOptions,
+	) ([]*models.ReviewsAudit, int, error)
+	ReviewSetStatus(
+		ctx context.Context,
+		reviewID int,
+		moderatorID int,
+		req *dto.ReviewStatusRequest,
+	) error
+
+	// List
+	ListGet(ctx context.Context, userID int, listID int) (*models.List, error)
+	ListsGetAllByUser(
+		ctx context.Context,
+		viewerID int,
+		userID int,
+		queryOptions query.SortOrderOptions,
+	) ([]*models.List, int, error)
+	ListCreate(
+		ctx context.Context,
+		userID int,
+		req *dto.ListCreateRequest,
+	) (int, error)
+	ListUpdate(
+		ctx context.Context,
+		userID int,
+		listID int,
+		req *dto.ListUpdateRequest,
+	) error
+	ListDelete(ctx context.Context, userID int, listID int) error
+	ListItemsGet(
+		ctx context.Context,
+		userID int,
+		listID int,
+		localeOptions query.LocaleOptions,
+	) ([]*list.Item, error)
+	ListItemAdd(
+		ctx context.Context,
+		userID int,
+		listID int,
+		req *dto.ListItemAddRequest,
+	) (int, error)
+	ListItemRemove(
+		ctx context.Context,
+		userID int,
+		listID int,
+		itemID int,
+	) error
+	ListReorder(
+		ctx context.Context,
+		userID int,
+		listID int,
+		req *dto.ListReorderRequest,
+	) error
+	WatchlistAddList(
+		ctx context.Context,
+		userID int,
+		listID int,
+	) (watchIDs []int, err error)
+	ListMembersGet(
+		ctx context.Context,
+		userID int,
+		listID int,
+	) ([]*list.Member, error)
+	ListMemberUpdate(
+		ctx context.Context,
+		userID int,
+		listID int,
+		memberID int,
+		req *dto.ListMemberUpdateRequest,
+	) error
+	ListMemberRemove(
+		ctx context.Context,
+		userID int,
+		listID int,
+		memberID int,
+	) error
+	ListInvitesGet(
+		ctx context.Context,
+		userID int,
+		listID int,
+	) ([]*models.ListInvite, error)
+	ListInvitesGetByUser(
+		ctx context.Context,
+		userID int,
+	) ([]*models.ListInvite, error)
+	ListInviteCreate(
+		ctx context.Context,
+		userID int,
+		listID int,
+		req *dto.ListInviteCreateRequest,
+	) (int, error)
+	ListInviteLinkCreate(
+		ctx context.Context,
+		userID int,
+		listID int,
+		req *dto.ListInviteLinkCreateRequest,
+	) (*list.LinkInvite, error)
+	ListInviteAccept(
+		ctx context.Context,
+		userID int,
+		inviteID int,
+		req *dto.ListInviteAcceptRequest,
+	) (listID int, err error)
+	ListInviteDelete(ctx context.Context, userID int, inviteID int) error
+	ListActivitiesGet(
+		ctx context.Context,
+		userID int,
+		listID int,
+		queryOptions query.SortOrderOptions,
+	) ([]*models.ListActivity, int, error)
+
+	// Stats
+	StatsGet(
+		ctx context.Context,
+		userID int,
+		statsOptions query.StatsOptions,
+		localeOptions query.LocaleOptions,
+	) (*stats.Stats, error)
+	YearReviewGet(
+		ctx context.Context,
+		userID int,
+		year int,
+		timezone string,
+		localeOptions query.LocaleOptions,
+	) (*stats.YearReview, error)
 }
 
 type Application struct {
-	repo    repo.ServiceTx
-	auth    auth.Interface
-	search  search.Service
-	hasher  hasher.Interface
-	storage storage.Service
+	repo     repo.ServiceTx
+	auth     auth.Interface
+	search   search.Service
+	hasher   hasher.Interface
+	storage  storage.Service
+	metadata metadata.Provider
+
+	// import jobs run in the background until they finish or importCancel is
+	// called on shutdown
+	importJobs   sync.WaitGroup
+	importCtx    context.Context
+	importCancel context.CancelFunc
 }
 
 var _ Service = (*Application)(nil)
@@ -234,12 +874,17 @@ func NewApplication(
 	searchService search.Service,
 	hasher hasher.Interface,
 	storage storage.Service,
+	metadataProvider metadata.Provider,
 ) *Application {
+	importCtx, importCancel := context.WithCancel(context.Background())
 	return &Application{
-		repo:    repo,
-		auth:    auth,
-		search:  searchService,
-		hasher:  hasher,
-		storage: storage,
+		repo:         repo,
+		auth:         auth,
+		search:       searchService,
+		hasher:       hasher,
+		storage:      storage,
+		metadata:     metadataProvider,
+		importCtx:    importCtx,
+		importCancel: importCancel,
 	}
 }
@@ -0,0 +1,216 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/storage"
+	"github.com/volatiletech/null/v8"
+)
+
+const (
+	AuditPruneStatusRunning   = "running"
+	AuditPruneStatusSucceeded = "succeeded"
+	AuditPruneStatusFailed    = "failed"
+)
+
+// WithActor returns a copy of ctx carrying the acting user recorded in the
+// audits of the records updated or deleted in transactions
+func WithActor(ctx context.Context, userID int) context.Context {
+	return repo.WithActor(ctx, userID)
+}
+
+// actorTx runs fn in a transaction so the audits of its writes record the
+// acting user of ctx: the acting user is only set in transactions
+func (app *Application) actorTx(
+	ctx context.Context,
+	fn func(ctx context.Context, tx repo.Service) error,
+) error {
+	return app.repo.Tx(ctx, nil, fn)
+}
+
+// auditPruneTables are the audit tables pruned by the retention policy
+var auditPruneTables = []string{
+	models.TableNames.FilmsAudit,
+	models.TableNames.SeriesesAudit,
+	models.TableNames.ExternalIdsAudit,
+	models.TableNames.TranslationsAudit,
+	models.TableNames.ReleasesAudit,
+	models.TableNames.ContentRatingsAudit,
+	models.TableNames.CollectionsAudit,
+	models.TableNames.CollectionItemsAudit,
+	models.TableNames.MediaItemsAudit,
+	models.TableNames.UsersAudit,
+	models.TableNames.WatchfilmsAudit,
+}
+
+// AuditPrune deletes the audits not retained by the configured retention
+// policy one batch at a time. Every batch is archived into the storage as
+// ndjson before its deletion is committed if archive is configured. A dry run
+// only counts the audits would be pruned.
+func (app *Application) AuditPrune(
+	ctx context.Context,
+	dryRun bool,
+) (prune *models.AuditPrune, err error) {
+	prune = &models.AuditPrune{
+		Status: AuditPruneStatusRunning,
+		DryRun: dryRun,
+	}
+	err = app.repo.AuditPruneCreate(ctx, prune)
+	if err != nil {
+		return nil, err
+	}
+
+	// finish the prune
+	defer func() {
+		prune.Status = AuditPruneStatusSucceeded
+		if err != nil {
+			prune.Status = AuditPruneStatusFailed
+		}
+		prune.FinishedAt = null.TimeFrom(time.Now())
+		updateErr := app.repo.AuditPruneUpdate(ctx, prune.ID, map[string]any{
+			models.AuditPruneColumns.Status:       prune.Status,
+			models.AuditPruneColumns.PrunedRows:   prune.PrunedRows,
+			models.AuditPruneColumns.ArchivedRows: prune.ArchivedRows,
+			models.AuditPruneColumns.FinishedAt:   prune.FinishedAt,
+		})
+		if err == nil {
+			err = updateErr
+		}
+	}()
+
+	retentionOptions := query.AuditRetentionOptions{
+		KeepLast: config.Config.AuditRetention.KeepLast,
+	}
+	if config.Config.AuditRetention.MaxAgeInDays > 0 {
+		retentionOptions.Before = null.TimeFrom(prune.CreatedAt.AddDate(
+			0,
+			0,
+			-config.Config.AuditRetention.MaxAgeInDays,
+		))
+	}
+
+	for _, table := range auditPruneTables {
+		if dryRun {
+			count, err := app.repo.AuditsPruneCount(
+				ctx,
+				table,
+				retentionOptions,
+			)
+			if err != nil {
+				return prune, err
+			}
+			prune.PrunedRows += count
+			continue
+		}
+		if err := app.auditPruneTable(
+			ctx,
+			prune,
+			table,
+			retentionOptions,
+		); err != nil {
+			return prune, err
+		}
+	}
+
+	return prune, nil
+}
+
+// auditPruneTable prunes the audit table until a batch is not full
+func (app *Application) auditPruneTable(
+	ctx context.Context,
+	prune *models.AuditPrune,
+	table string,
+	retentionOptions query.AuditRetentionOptions,
+) error {
+	batchSize := config.Config.AuditRetention.BatchSize
+	for batch := 1; ; batch++ {
+		var audits []string
+		err := app.repo.Tx(
+			ctx,
+			nil,
+			func(ctx context.Context, tx repo.Service) error {
+				var err error
+				audits, err = tx.AuditsPrune(
+					ctx,
+					table,
+					retentionOptions,
+					batchSize,
+				)
+				if err != nil ||
+					len(audits) == 0 ||
+					!config.Config.AuditRetention.Archive {
+					return err
+				}
+				// an archive failure rolls back the deletion
+				return app.auditPruneArchive(
+					ctx,
+					prune.ID,
+					table,
+					batch,
+					audits,
+				)
+			},
+		)
+		if err != nil {
+			return err
+		}
+		prune.PrunedRows += len(audits)
+		if config.Config.AuditRetention.Archive {
+			prune.ArchivedRows += len(audits)
+		}
+		if len(audits) < batchSize {
+			return nil
+		}
+	}
+}
+
+// auditPruneArchive puts a batch of pruned audits into the storage as ndjson
+func (app *Application) auditPruneArchive(
+	ctx context.Context,
+	pruneID int,
+	table string,
+	batch int,
+	audits []string,
+) error {
+	data := strings.Join(audits, "\n") + "\n"
+	_, err := app.storage.PutFile(
+		ctx,
+		strings.NewReader(data),
+		&storage.PutOptions{
+			Bucket:      config.Config.MinIO.Bucket.Export.Name,
+			Category:    config.Config.MinIO.Category.AuditArchive,
+			CategoryID:  pruneID,
+			Filename:    AuditPruneArchiveFilename(table, batch),
+			ContentType: "application/x-ndjson",
+			Size:        int64(len(data)),
+		},
+	)
+	return err
+}
+
+// AuditPruneArchiveFilename is the name of the archive of a batch of pruned
+// audits of the audit table
+func AuditPruneArchiveFilename(table string, batch int) string {
+	return fmt.Sprintf("%s-%d.ndjson", table, batch)
+}
+
+// AuditPruneGetLatest reads the latest audit prune
+func (app *Application) AuditPruneGetLatest(
+	ctx context.Context,
+) (*models.AuditPrune, error) {
+	prune, err := app.repo.AuditPruneGetLatest(ctx)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return prune, nil
+}
@@ -0,0 +1,235 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/repo/mock_repo"
+	"github.com/aria3ppp/watchlist-server/internal/storage"
+	"github.com/aria3ppp/watchlist-server/internal/storage/mock_storage"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestAuditPrune(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		pruneID          = 1
+		batchSize        = config.Config.AuditRetention.BatchSize
+		retentionOptions = query.AuditRetentionOptions{
+			KeepLast: config.Config.AuditRetention.KeepLast,
+		}
+
+		expPutFileError = errors.New("PutFile error")
+	)
+
+	// a full batch then a last batch of films audits
+	fullBatch := make([]string, batchSize)
+	for i := range fullBatch {
+		fullBatch[i] = `{"id": 1}`
+	}
+	lastBatch := []string{`{"id": 2}`}
+
+	type TestCase struct {
+		name            string
+		dryRun          bool
+		putFileErr      error
+		expStatus       string
+		expPrunedRows   int
+		expArchivedRows int
+		expErr          error
+	}
+
+	testCases := []TestCase{
+		{
+			name:          "dry run",
+			dryRun:        true,
+			expStatus:     app.AuditPruneStatusSucceeded,
+			expPrunedRows: 7,
+		},
+		{
+			name:          "PutFile error",
+			putFileErr:    expPutFileError,
+			expStatus:     app.AuditPruneStatusFailed,
+			expPrunedRows: 0,
+			expErr:        expPutFileError,
+		},
+		{
+			name:            "ok",
+			expStatus:       app.AuditPruneStatusSucceeded,
+			expPrunedRows:   batchSize + 1,
+			expArchivedRows: batchSize + 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+			mockStorage := mock_storage.NewMockService(controller)
+
+			mockRepo.EXPECT().
+				AuditPruneCreate(ctx, &models.AuditPrune{
+					Status: app.AuditPruneStatusRunning,
+					DryRun: tc.dryRun,
+				}).
+				Do(func(_ context.Context, prune *models.AuditPrune) {
+					prune.ID = pruneID
+				}).
+				Return(nil)
+
+			if tc.dryRun {
+				mockRepo.EXPECT().
+					AuditsPruneCount(
+						ctx,
+						models.TableNames.FilmsAudit,
+						retentionOptions,
+					).
+					Return(7, nil)
+				mockRepo.EXPECT().
+					AuditsPruneCount(ctx, gomock.Any(), retentionOptions).
+					Return(0, nil).
+					AnyTimes()
+			} else {
+				mockRepo.EXPECT().
+					Tx(ctx, nil, gomock.Any()).
+					DoAndReturn(
+						func(ctx context.Context, _ any, fn func(_ context.Context, _ repo.Service) error) error {
+							return fn(ctx, mockRepo)
+						},
+					).
+					AnyTimes()
+
+				putFile := func(
+					_ context.Context,
+					file io.Reader,
+					options *storage.PutOptions,
+				) (string, error) {
+					require.Equal(
+						config.Config.MinIO.Bucket.Export.Name,
+						options.Bucket,
+					)
+					require.Equal(
+						config.Config.MinIO.Category.AuditArchive,
+						options.Category,
+					)
+					require.Equal(pruneID, options.CategoryID)
+					data, err := io.ReadAll(file)
+					require.NoError(err)
+					require.Equal(options.Size, int64(len(data)))
+					return "", tc.putFileErr
+				}
+
+				if tc.putFileErr != nil {
+					mockRepo.EXPECT().
+						AuditsPrune(
+							ctx,
+							models.TableNames.FilmsAudit,
+							retentionOptions,
+							batchSize,
+						).
+						Return(fullBatch, nil)
+					mockStorage.EXPECT().
+						PutFile(ctx, gomock.Any(), gomock.Any()).
+						DoAndReturn(putFile)
+				} else {
+					// films audits are pruned until a batch is not full
+					gomock.InOrder(
+						mockRepo.EXPECT().
+							AuditsPrune(
+								ctx,
+								models.TableNames.FilmsAudit,
+								retentionOptions,
+								batchSize,
+							).
+							Return(fullBatch, nil),
+						mockRepo.EXPECT().
+							AuditsPrune(
+								ctx,
+								models.TableNames.FilmsAudit,
+								retentionOptions,
+								batchSize,
+							).
+							Return(lastBatch, nil),
+					)
+					mockRepo.EXPECT().
+						AuditsPrune(ctx, gomock.Any(), retentionOptions, batchSize).
+						Return(nil, nil).
+						AnyTimes()
+
+					// every batch is archived
+					gomock.InOrder(
+						mockStorage.EXPECT().
+							PutFile(ctx, gomock.Any(), gomock.Any()).
+							Do(func(_ context.Context, _ io.Reader, options *storage.PutOptions) {
+								require.Equal(
+									app.AuditPruneArchiveFilename(
+										models.TableNames.FilmsAudit,
+										1,
+									),
+									options.Filename,
+								)
+							}).
+							DoAndReturn(putFile),
+						mockStorage.EXPECT().
+							PutFile(ctx, gomock.Any(), gomock.Any()).
+							Do(func(_ context.Context, _ io.Reader, options *storage.PutOptions) {
+								require.Equal(
+									app.AuditPruneArchiveFilename(
+										models.TableNames.FilmsAudit,
+										2,
+									),
+									options.Filename,
+								)
+							}).
+							DoAndReturn(putFile),
+					)
+				}
+			}
+
+			mockRepo.EXPECT().
+				AuditPruneUpdate(ctx, pruneID, gomock.Any()).
+				Do(func(_ context.Context, _ int, cols map[string]any) {
+					require.Equal(
+						tc.expStatus,
+						cols[models.AuditPruneColumns.Status],
+					)
+					require.Equal(
+						tc.expPrunedRows,
+						cols[models.AuditPruneColumns.PrunedRows],
+					)
+					require.Equal(
+						tc.expArchivedRows,
+						cols[models.AuditPruneColumns.ArchivedRows],
+					)
+					require.True(
+						cols[models.AuditPruneColumns.FinishedAt].(null.Time).Valid,
+					)
+				}).
+				Return(nil)
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, mockStorage, nil)
+
+			prune, err := app.AuditPrune(ctx, tc.dryRun)
+			require.Equal(tc.expErr, err)
+			require.Equal(pruneID, prune.ID)
+			require.Equal(tc.expStatus, prune.Status)
+			require.Equal(tc.expPrunedRows, prune.PrunedRows)
+		})
+	}
+}
@@ -0,0 +1,365 @@
+package app
+
+import (
+	"context"
+
+	"github.com/aria3ppp/watchlist-server/internal/collection"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+)
+
+func (app *Application) CollectionGet(
+	ctx context.Context,
+	id int,
+) (*models.Collection, error) {
+	c, err := app.repo.CollectionGet(ctx, id)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+func (app *Application) CollectionsGetAll(
+	ctx context.Context,
+	queryOptions query.Options,
+) (collections []*models.Collection, total int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			var err error
+			collections, err = tx.CollectionsGetAll(ctx, queryOptions)
+			if err != nil {
+				return err
+			}
+			total, err = tx.CollectionsCount(ctx, queryOptions.Invalidation)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return collections, total, nil
+}
+
+func (app *Application) CollectionCreate(
+	ctx context.Context,
+	contributorID int,
+	req *dto.CollectionCreateRequest,
+) (collectionID int, err error) {
+	insertCollection := &models.Collection{
+		Title:        req.Title,
+		Descriptions: req.Descriptions,
+	}
+
+	err = app.repo.CollectionCreate(ctx, contributorID, insertCollection)
+	if err != nil {
+		return 0, err
+	}
+
+	return insertCollection.ID, nil
+}
+
+func (app *Application) CollectionUpdate(
+	ctx context.Context,
+	collectionID int,
+	contributorID int,
+	req *dto.CollectionUpdateRequest,
+) error {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return err
+	}
+
+	columns := collectionUpdateRequestToValidMap(req)
+
+	err := app.repo.CollectionUpdate(ctx, collectionID, contributorID, columns)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func collectionUpdateRequestToValidMap(
+	req *dto.CollectionUpdateRequest,
+) map[string]any {
+	m := make(map[string]any)
+	if req.Title.Valid {
+		m[models.CollectionColumns.Title] = req.Title.String
+	}
+	if req.Descriptions.Valid {
+		m[models.CollectionColumns.Descriptions] = req.Descriptions.String
+	}
+	return m
+}
+
+func (app *Application) CollectionInvalidate(
+	ctx context.Context,
+	collectionID int,
+	contributorID int,
+	req *dto.InvalidationRequest,
+) error {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return err
+	}
+
+	err := app.repo.CollectionUpdate(
+		ctx,
+		collectionID,
+		contributorID,
+		map[string]any{
+			models.CollectionColumns.Invalidation: req.Invalidation,
+		},
+	)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (app *Application) CollectionAuditsGetAll(
+	ctx context.Context,
+	id int,
+	queryOptions query.SortOrderOptions,
+) (audits []*models.CollectionsAudit, total int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the collection exists
+			_, err := tx.CollectionGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// fetch audits
+			audits, err = tx.CollectionAuditsGetAll(ctx, id, queryOptions)
+			if err != nil {
+				return err
+			}
+			// count total audits
+			total, err = tx.CollectionAuditsCount(ctx, id)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return audits, total, nil
+}
+
+func (app *Application) CollectionItemsGet(
+	ctx context.Context,
+	id int,
+	localeOptions query.LocaleOptions,
+) (items []*collection.Item, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the collection exists
+			_, err := tx.CollectionGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			items, err = tx.CollectionItemsGetAll(ctx, id)
+			if err != nil {
+				return err
+			}
+			return localizeCollectionItems(ctx, tx, localeOptions, items)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func localizeCollectionItems(
+	ctx context.Context,
+	r repo.Service,
+	localeOptions query.LocaleOptions,
+	items []*collection.Item,
+) error {
+	var (
+		films    []*models.Film
+		serieses []*models.Series
+	)
+	for _, item := range items {
+		if item.Film != nil {
+			films = append(films, item.Film)
+		}
+		if item.Series != nil {
+			serieses = append(serieses, item.Series)
+		}
+	}
+	if err := localizeFilms(ctx, r, localeOptions, films...); err != nil {
+		return err
+	}
+	return localizeSerieses(ctx, r, localeOptions, serieses...)
+}
+
+// CollectionItemsPut replaces the items of the collection by the movies and
+// serieses of the request in order
+func (app *Application) CollectionItemsPut(
+	ctx context.Context,
+	id int,
+	contributorID int,
+	req *dto.CollectionItemsPutRequest,
+) error {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return err
+	}
+
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the collection exists
+			_, err := tx.CollectionGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// check the items exist: a film item must be a movie
+			items := make([]*models.CollectionItem, len(req.Items))
+			for i, item := range req.Items {
+				if item.FilmID.Valid {
+					_, err = tx.MovieGet(ctx, item.FilmID.Int)
+				} else {
+					_, err = tx.SeriesGet(ctx, item.SeriesID.Int)
+				}
+				if err != nil {
+					if err == repo.ErrNoRecord {
+						return ErrNotFound
+					}
+					return err
+				}
+				items[i] = &models.CollectionItem{
+					FilmID:   item.FilmID,
+					SeriesID: item.SeriesID,
+				}
+			}
+			return tx.CollectionItemsPut(ctx, id, contributorID, items)
+		},
+	)
+}
+
+func (app *Application) CollectionItemAuditsGetAll(
+	ctx context.Context,
+	id int,
+	queryOptions query.SortOrderOptions,
+) (audits []*models.CollectionItemsAudit, total int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the collection exists
+			_, err := tx.CollectionGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// fetch audits
+			audits, err = tx.CollectionItemAuditsGetAll(ctx, id, queryOptions)
+			if err != nil {
+				return err
+			}
+			// count total audits
+			total, err = tx.CollectionItemAuditsCount(ctx, id)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return audits, total, nil
+}
+
+// WatchlistAddCollection adds the items of the collection to the watchlist in
+// order: a series adds its episodes in order and films already in the
+// watchlist are skipped
+func (app *Application) WatchlistAddCollection(
+	ctx context.Context,
+	userID int,
+	collectionID int,
+) (watchIDs []int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := tx.CollectionGet(ctx, collectionID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			items, err := tx.CollectionItemsGetAll(ctx, collectionID)
+			if err != nil {
+				return err
+			}
+			var filmIDs []int
+			for _, item := range items {
+				if item.FilmID.Valid {
+					filmIDs = append(filmIDs, item.FilmID.Int)
+					continue
+				}
+				episodes, err := seriesEpisodesInOrder(ctx, tx, item.SeriesID.Int)
+				if err != nil {
+					return err
+				}
+				for _, e := range episodes {
+					filmIDs = append(filmIDs, e.ID)
+				}
+			}
+			watchIDs, err = tx.WatchlistAddAll(ctx, userID, filmIDs)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return watchIDs, nil
+}
+
+// seriesEpisodesInOrder returns all the episodes of the series by season and
+// episode number
+func seriesEpisodesInOrder(
+	ctx context.Context,
+	r repo.Service,
+	seriesID int,
+) ([]*models.Film, error) {
+	total, err := r.EpisodesCountBySeries(ctx, seriesID)
+	if err != nil || total == 0 {
+		return nil, err
+	}
+	return r.EpisodesGetAllBySeries(
+		ctx,
+		seriesID,
+		query.SortOrderOptions{Offset: 0, Limit: total, SortOrder: "asc"},
+	)
+}
@@ -0,0 +1,232 @@
+package app_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/collection"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/repo/mock_repo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestCollectionItemsPut(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx           = context.Background()
+		collectionID  = 1
+		contributorID = 1
+		movieID       = 10
+		seriesID      = 20
+	)
+
+	type TestCase struct {
+		name          string
+		collection    *models.Collection
+		movie         *models.Film
+		series        *models.Series
+		expRepoCalled bool
+		expErr        error
+	}
+
+	testCases := []TestCase{
+		{
+			name:   "collection not found",
+			expErr: app.ErrNotFound,
+		},
+		{
+			name:       "movie not found",
+			collection: &models.Collection{ID: collectionID},
+			expErr:     app.ErrNotFound,
+		},
+		{
+			name:       "series not found",
+			collection: &models.Collection{ID: collectionID},
+			movie:      &models.Film{ID: movieID},
+			expErr:     app.ErrNotFound,
+		},
+		{
+			name:          "ok",
+			collection:    &models.Collection{ID: collectionID},
+			movie:         &models.Film{ID: movieID},
+			series:        &models.Series{ID: seriesID},
+			expRepoCalled: true,
+		},
+	}
+
+	req := &dto.CollectionItemsPutRequest{
+		Items: []*dto.CollectionItem{
+			{FilmID: null.IntFrom(movieID)},
+			{SeriesID: null.IntFrom(seriesID)},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+			if tc.collection == nil {
+				mockRepo.EXPECT().
+					CollectionGet(ctx, collectionID).
+					Return(nil, repo.ErrNoRecord)
+			} else {
+				mockRepo.EXPECT().
+					CollectionGet(ctx, collectionID).
+					Return(tc.collection, nil)
+				if tc.movie == nil {
+					mockRepo.EXPECT().
+						MovieGet(ctx, movieID).
+						Return(nil, repo.ErrNoRecord)
+				} else {
+					mockRepo.EXPECT().MovieGet(ctx, movieID).Return(tc.movie, nil)
+					if tc.series == nil {
+						mockRepo.EXPECT().
+							SeriesGet(ctx, seriesID).
+							Return(nil, repo.ErrNoRecord)
+					} else {
+						mockRepo.EXPECT().
+							SeriesGet(ctx, seriesID).
+							Return(tc.series, nil)
+					}
+				}
+			}
+			if tc.expRepoCalled {
+				mockRepo.EXPECT().
+					CollectionItemsPut(
+						ctx,
+						collectionID,
+						contributorID,
+						[]*models.CollectionItem{
+							{FilmID: null.IntFrom(movieID)},
+							{SeriesID: null.IntFrom(seriesID)},
+						},
+					).
+					Return(nil)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.CollectionItemsPut(ctx, collectionID, contributorID, req)
+			require.Equal(tc.expErr, err)
+		})
+	}
+}
+
+func TestWatchlistAddCollection(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx          = context.Background()
+		userID       = 1
+		collectionID = 1
+		seriesID     = 20
+	)
+
+	type TestCase struct {
+		name        string
+		collection  *models.Collection
+		items       []*collection.Item
+		episodes    []*models.Film
+		expFilmIDs  []int
+		expWatchIDs []int
+		expErr      error
+	}
+
+	testCases := []TestCase{
+		{
+			name:   "collection not found",
+			expErr: app.ErrNotFound,
+		},
+		{
+			name:       "movies and series episodes in order",
+			collection: &models.Collection{ID: collectionID},
+			items: []*collection.Item{
+				{CollectionItem: models.CollectionItem{
+					Position: 1,
+					FilmID:   null.IntFrom(10),
+				}},
+				{CollectionItem: models.CollectionItem{
+					Position: 2,
+					SeriesID: null.IntFrom(seriesID),
+				}},
+				{CollectionItem: models.CollectionItem{
+					Position: 3,
+					FilmID:   null.IntFrom(11),
+				}},
+			},
+			episodes:    []*models.Film{{ID: 21}, {ID: 22}},
+			expFilmIDs:  []int{10, 21, 22, 11},
+			expWatchIDs: []int{1, 2, 3, 4},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+			if tc.collection == nil {
+				mockRepo.EXPECT().
+					CollectionGet(ctx, collectionID).
+					Return(nil, repo.ErrNoRecord)
+			} else {
+				mockRepo.EXPECT().
+					CollectionGet(ctx, collectionID).
+					Return(tc.collection, nil)
+				mockRepo.EXPECT().
+					CollectionItemsGetAll(ctx, collectionID).
+					Return(tc.items, nil)
+				mockRepo.EXPECT().
+					EpisodesCountBySeries(ctx, seriesID).
+					Return(len(tc.episodes), nil)
+				mockRepo.EXPECT().
+					EpisodesGetAllBySeries(
+						ctx,
+						seriesID,
+						query.SortOrderOptions{
+							Offset:    0,
+							Limit:     len(tc.episodes),
+							SortOrder: "asc",
+						},
+					).
+					Return(tc.episodes, nil)
+				mockRepo.EXPECT().
+					WatchlistAddAll(ctx, userID, tc.expFilmIDs).
+					Return(tc.expWatchIDs, nil)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			watchIDs, err := app.WatchlistAddCollection(ctx, userID, collectionID)
+			require.Equal(tc.expErr, err)
+			require.Equal(tc.expWatchIDs, watchIDs)
+		})
+	}
+}
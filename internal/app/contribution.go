@@ -0,0 +1,150 @@
+package app
+
+import (
+	"context"
+
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/contribution"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+)
+
+// UserContributionsGet reads the contribution statistics of the user along
+// with a page of their contributions
+func (app *Application) UserContributionsGet(
+	ctx context.Context,
+	userID int,
+	queryOptions query.SortOrderOptions,
+) (
+	stats *contribution.Stats,
+	contributions []*contribution.Contribution,
+	total int,
+	err error,
+) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the user exists
+			_, err := tx.UserGet(ctx, userID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// fetch stats
+			stats, err = tx.ContributorStatsGet(
+				ctx,
+				userID,
+				reputationOptions(),
+			)
+			if err != nil {
+				return err
+			}
+			// fetch contributions
+			contributions, err = tx.ContributionsGetAll(
+				ctx,
+				userID,
+				queryOptions,
+			)
+			if err != nil {
+				return err
+			}
+			// count total contributions
+			total, err = tx.ContributionsCount(ctx, userID)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return stats, contributions, total, nil
+}
+
+// ContributorsLeaderboard reads the contribution statistics of the
+// contributors from the highest reputation score
+func (app *Application) ContributorsLeaderboard(
+	ctx context.Context,
+	offset int,
+	limit int,
+) (stats []*contribution.Stats, total int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			stats, err = tx.ContributorStatsGetAll(
+				ctx,
+				reputationOptions(),
+				offset,
+				limit,
+			)
+			if err != nil {
+				return err
+			}
+			total, err = tx.ContributorsCount(ctx)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return stats, total, nil
+}
+
+// UserReputation computes the reputation score of the user
+func (app *Application) UserReputation(
+	ctx context.Context,
+	userID int,
+) (int, error) {
+	return userReputation(ctx, app.repo, userID)
+}
+
+func userReputation(
+	ctx context.Context,
+	r repo.Service,
+	userID int,
+) (int, error) {
+	stats, err := r.ContributorStatsGet(ctx, userID, reputationOptions())
+	if err != nil {
+		return 0, err
+	}
+	return stats.Score, nil
+}
+
+// checkEditReputation fails with ErrLowReputation if the reputation score of
+// the user is below the configured minimum to edit
+func (app *Application) checkEditReputation(
+	ctx context.Context,
+	userID int,
+) error {
+	return editReputationCheck(ctx, app.repo, userID)
+}
+
+// editReputationCheck is checkEditReputation within a transaction
+func editReputationCheck(
+	ctx context.Context,
+	r repo.Service,
+	userID int,
+) error {
+	if config.Config.Reputation.MinEditScore <= 0 {
+		return nil
+	}
+	score, err := userReputation(ctx, r, userID)
+	if err != nil {
+		return err
+	}
+	if score < config.Config.Reputation.MinEditScore {
+		return ErrLowReputation
+	}
+	return nil
+}
+
+func reputationOptions() query.ReputationOptions {
+	return query.ReputationOptions{
+		CreationPoints:      config.Config.Reputation.CreationPoints,
+		EditPoints:          config.Config.Reputation.EditPoints,
+		RevertedEditPenalty: config.Config.Reputation.RevertedEditPenalty,
+		InvalidationPenalty: config.Config.Reputation.InvalidationPenalty,
+	}
+}
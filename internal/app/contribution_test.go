@@ -0,0 +1,362 @@
+package app_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/contribution"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/repo/mock_repo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+var reputationOptions = query.ReputationOptions{
+	CreationPoints:      config.Config.Reputation.CreationPoints,
+	EditPoints:          config.Config.Reputation.EditPoints,
+	RevertedEditPenalty: config.Config.Reputation.RevertedEditPenalty,
+	InvalidationPenalty: config.Config.Reputation.InvalidationPenalty,
+}
+
+func TestUserContributionsGet(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		userID       = 1
+		queryOptions = query.SortOrderOptions{
+			Offset:    0,
+			Limit:     10,
+			SortOrder: "desc",
+		}
+		expStats = &contribution.Stats{
+			UserID:    userID,
+			Creations: 1,
+			Edits:     1,
+			Score:     12,
+		}
+		expContributions = []*contribution.Contribution{
+			{
+				Kind:          contribution.KindMovie,
+				ID:            2,
+				Title:         "title",
+				Action:        contribution.ActionEdit,
+				ContributedAt: time.Now(),
+			},
+			{
+				Kind:          contribution.KindMovie,
+				ID:            1,
+				Title:         "title",
+				Action:        contribution.ActionCreate,
+				ContributedAt: time.Now(),
+			},
+		}
+		expError = errors.New("error")
+	)
+
+	type TestCase struct {
+		name       string
+		userGetErr error
+		statsErr   error
+		expErr     error
+	}
+
+	testCases := []TestCase{
+		{
+			name:       "user not found",
+			userGetErr: repo.ErrNoRecord,
+			expErr:     app.ErrNotFound,
+		},
+		{
+			name:     "stats error",
+			statsErr: expError,
+			expErr:   expError,
+		},
+		{
+			name: "ok",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(
+					func(ctx context.Context, _ *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+						return fn(ctx, mockRepo)
+					},
+				)
+
+			mockRepo.EXPECT().
+				UserGet(ctx, userID).
+				Return(&models.User{ID: userID}, tc.userGetErr)
+
+			if tc.userGetErr == nil {
+				mockRepo.EXPECT().
+					ContributorStatsGet(ctx, userID, reputationOptions).
+					Return(expStats, tc.statsErr)
+			}
+
+			if tc.expErr == nil {
+				mockRepo.EXPECT().
+					ContributionsGetAll(ctx, userID, queryOptions).
+					Return(expContributions, nil)
+				mockRepo.EXPECT().
+					ContributionsCount(ctx, userID).
+					Return(len(expContributions), nil)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			stats, contributions, total, err := app.UserContributionsGet(
+				ctx,
+				userID,
+				queryOptions,
+			)
+			require.Equal(tc.expErr, err)
+			if tc.expErr != nil {
+				require.Nil(stats)
+				require.Nil(contributions)
+				require.Zero(total)
+				return
+			}
+			require.Equal(expStats, stats)
+			require.Equal(expContributions, contributions)
+			require.Equal(len(expContributions), total)
+		})
+	}
+}
+
+func TestContributorsLeaderboard(t *testing.T) {
+	t.Parallel()
+
+	require := require.New(t)
+
+	var (
+		ctx = context.Background()
+
+		offset   = 0
+		limit    = 10
+		expStats = []*contribution.Stats{
+			{UserID: 2, Creations: 3, Score: 30},
+			{UserID: 1, Edits: 1, Score: 2},
+		}
+	)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockServiceTx(controller)
+
+	mockRepo.EXPECT().
+		Tx(ctx, nil, gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, _ *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+				return fn(ctx, mockRepo)
+			},
+		)
+	mockRepo.EXPECT().
+		ContributorStatsGetAll(ctx, reputationOptions, offset, limit).
+		Return(expStats, nil)
+	mockRepo.EXPECT().
+		ContributorsCount(ctx).
+		Return(len(expStats), nil)
+
+	app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	stats, total, err := app.ContributorsLeaderboard(ctx, offset, limit)
+	require.NoError(err)
+	require.Equal(expStats, stats)
+	require.Equal(len(expStats), total)
+}
+
+// TestEditReputationGate is not parallel as it changes the configured minimum
+// score to edit
+func TestEditReputationGate(t *testing.T) {
+	minEditScore := config.Config.Reputation.MinEditScore
+	config.Config.Reputation.MinEditScore = 10
+	t.Cleanup(func() {
+		config.Config.Reputation.MinEditScore = minEditScore
+	})
+
+	var (
+		ctx = context.Background()
+
+		id            = 1
+		contributorID = 1
+		req           = &dto.MovieUpdateRequest{
+			Title: null.StringFrom("movie"),
+		}
+	)
+
+	type TestCase struct {
+		name   string
+		score  int
+		expErr error
+	}
+
+	testCases := []TestCase{
+		{
+			name:   "low reputation",
+			score:  9,
+			expErr: app.ErrLowReputation,
+		},
+		{
+			name:  "ok",
+			score: 10,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				ContributorStatsGet(ctx, contributorID, reputationOptions).
+				Return(
+					&contribution.Stats{UserID: contributorID, Score: tc.score},
+					nil,
+				)
+
+			if tc.expErr == nil {
+				mockRepo.EXPECT().
+					MovieUpdate(
+						ctx,
+						id,
+						contributorID,
+						movieUpdateRequestToValidMap(req),
+					).
+					Return(nil)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.MovieUpdate(ctx, id, contributorID, req)
+			require.Equal(tc.expErr, err)
+		})
+	}
+
+	t.Run("season metadata apply", func(t *testing.T) {
+		require := require.New(t)
+
+		controller := gomock.NewController(t)
+		mockRepo := mock_repo.NewMockServiceTx(controller)
+
+		mockRepo.EXPECT().
+			ContributorStatsGet(ctx, contributorID, reputationOptions).
+			Return(&contribution.Stats{UserID: contributorID, Score: 9}, nil)
+
+		application := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+		err := application.SeasonMetadataApply(ctx, id, 1, contributorID)
+		require.Equal(app.ErrLowReputation, err)
+	})
+
+	// every write changing an existing record is gated before touching it
+	writes := map[string]func(*app.Application) error{
+		"episode put": func(a *app.Application) error {
+			return a.EpisodePut(
+				ctx, id, 1, 1, contributorID, &dto.EpisodePutRequest{},
+			)
+		},
+		"episodes put all by season": func(a *app.Application) error {
+			return a.EpisodesPutAllBySeason(
+				ctx, id, 1, contributorID, &dto.EpisodesPutAllBySeasonRequest{},
+			)
+		},
+		"episodes renumber": func(a *app.Application) error {
+			return a.EpisodesRenumber(
+				ctx, id, contributorID, &dto.EpisodesRenumberRequest{},
+			)
+		},
+		"movie invalidate": func(a *app.Application) error {
+			return a.MovieInvalidate(
+				ctx, id, contributorID, &dto.InvalidationRequest{},
+			)
+		},
+		"series invalidate": func(a *app.Application) error {
+			return a.SeriesInvalidate(
+				ctx, id, contributorID, &dto.InvalidationRequest{},
+			)
+		},
+		"episode invalidate": func(a *app.Application) error {
+			return a.EpisodeInvalidate(
+				ctx, id, 1, 1, contributorID, &dto.InvalidationRequest{},
+			)
+		},
+		"episodes invalidate all by season": func(a *app.Application) error {
+			return a.EpisodesInvalidateAllBySeason(
+				ctx, id, 1, contributorID, &dto.InvalidationRequest{},
+			)
+		},
+		"movie translation put": func(a *app.Application) error {
+			return a.MovieTranslationPut(
+				ctx, id, contributorID, &dto.TranslationPutRequest{},
+			)
+		},
+		"movie external id put": func(a *app.Application) error {
+			return a.MovieExternalIDPut(
+				ctx, id, contributorID, &dto.ExternalIDPutRequest{},
+			)
+		},
+		"movie release put": func(a *app.Application) error {
+			return a.MovieReleasePut(
+				ctx, id, contributorID, &dto.ReleasePutRequest{},
+			)
+		},
+		"movie media reorder": func(a *app.Application) error {
+			return a.MovieMediaReorder(
+				ctx, id, contributorID, &dto.MediaReorderRequest{},
+			)
+		},
+		"series media remove": func(a *app.Application) error {
+			return a.SeriesMediaRemove(ctx, id, 1, contributorID)
+		},
+		"collection invalidate": func(a *app.Application) error {
+			return a.CollectionInvalidate(
+				ctx, id, contributorID, &dto.InvalidationRequest{},
+			)
+		},
+		"collection items put": func(a *app.Application) error {
+			return a.CollectionItemsPut(
+				ctx, id, contributorID, &dto.CollectionItemsPutRequest{},
+			)
+		},
+	}
+
+	for name, write := range writes {
+		write := write
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				ContributorStatsGet(ctx, contributorID, reputationOptions).
+				Return(&contribution.Stats{UserID: contributorID, Score: 9}, nil)
+
+			application := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			require.Equal(app.ErrLowReputation, write(application))
+		})
+	}
+}
@@ -7,11 +7,13 @@ import (
 	"github.com/aria3ppp/watchlist-server/internal/models"
 	"github.com/aria3ppp/watchlist-server/internal/query"
 	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/volatiletech/null/v8"
 )
 
 func (app *Application) EpisodeGet(
 	ctx context.Context,
 	seriesID, seasonNumber, episodeNumber int,
+	localeOptions query.LocaleOptions,
 ) (*models.Film, error) {
 	episode, err := app.repo.EpisodeGet(
 		ctx,
@@ -25,6 +27,9 @@ func (app *Application) EpisodeGet(
 		}
 		return nil, err
 	}
+	if err := localizeFilms(ctx, app.repo, localeOptions, episode); err != nil {
+		return nil, err
+	}
 	return episode, nil
 }
 
@@ -32,6 +37,7 @@ func (app *Application) EpisodesGetAllBySeries(
 	ctx context.Context,
 	seriesID int,
 	queryOptions query.SortOrderOptions,
+	localeOptions query.LocaleOptions,
 ) (episodes []*models.Film, total int, err error) {
 	err = app.repo.Tx(
 		ctx,
@@ -53,7 +59,10 @@ func (app *Application) EpisodesGetAllBySeries(
 				return err
 			}
 			total, err = tx.EpisodesCountBySeries(ctx, seriesID)
-			return err
+			if err != nil {
+				return err
+			}
+			return localizeFilms(ctx, tx, localeOptions, episodes...)
 		},
 	)
 	if err != nil {
@@ -67,6 +76,7 @@ func (app *Application) EpisodesGetAllBySeason(
 	seriesID int,
 	seasonNumber int,
 	queryOptions query.SortOrderOptions,
+	localeOptions query.LocaleOptions,
 ) (episodes []*models.Film, total int, err error) {
 	err = app.repo.Tx(
 		ctx,
@@ -89,7 +99,10 @@ func (app *Application) EpisodesGetAllBySeason(
 				return err
 			}
 			total, err = tx.EpisodesCountBySeason(ctx, seriesID, seasonNumber)
-			return err
+			if err != nil {
+				return err
+			}
+			return localizeFilms(ctx, tx, localeOptions, episodes...)
 		},
 	)
 	if err != nil {
@@ -106,6 +119,11 @@ func (app *Application) EpisodePut(
 	contributorID int,
 	req *dto.EpisodePutRequest,
 ) error {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return err
+	}
+
 	err := app.repo.Tx(
 		ctx,
 		nil,
@@ -119,25 +137,53 @@ func (app *Application) EpisodePut(
 				return err
 			}
 			// then put episode
-			err = app.repo.EpisodePut(
+			return episodePut(
 				ctx,
+				tx,
 				seriesID,
 				seasonNumber,
 				episodeNumber,
 				contributorID,
-				&models.Film{
-					Title:        req.Title,
-					Descriptions: req.Descriptions,
-					DateReleased: req.DateReleased,
-					Duration:     req.Duration,
-				},
+				req,
 			)
-			return err
 		},
 	)
 	return err
 }
 
+// episodePut puts a single episode of an existing series: every episode write
+// by a put request goes through it to check the numbering of the episode
+func episodePut(
+	ctx context.Context,
+	tx repo.Service,
+	seriesID, seasonNumber, episodeNumber int,
+	contributorID int,
+	req *dto.EpisodePutRequest,
+) error {
+	// check absolute number is not used by another episode
+	err := checkAbsoluteNumberUnused(
+		ctx,
+		tx,
+		seriesID,
+		req.AbsoluteNumber,
+		func(e *models.Film) bool {
+			return e.SeasonNumber.Int == seasonNumber &&
+				e.EpisodeNumber.Int == episodeNumber
+		},
+	)
+	if err != nil {
+		return err
+	}
+	return tx.EpisodePut(
+		ctx,
+		seriesID,
+		seasonNumber,
+		episodeNumber,
+		contributorID,
+		episodePutRequestToModel(req),
+	)
+}
+
 func (app *Application) EpisodesPutAllBySeason(
 	ctx context.Context,
 	seriesID int,
@@ -145,6 +191,11 @@ func (app *Application) EpisodesPutAllBySeason(
 	contributorID int,
 	req *dto.EpisodesPutAllBySeasonRequest,
 ) error {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return err
+	}
+
 	err := app.repo.Tx(
 		ctx,
 		nil,
@@ -159,18 +210,26 @@ func (app *Application) EpisodesPutAllBySeason(
 			// replace episodes
 			for i, e := range req.Episodes {
 				episodeNumber := i + 1
-				err := tx.EpisodePut(
+				err := checkAbsoluteNumberUnused(
+					ctx,
+					tx,
+					seriesID,
+					e.AbsoluteNumber,
+					func(episode *models.Film) bool {
+						return episode.SeasonNumber.Int == seasonNumber &&
+							episode.EpisodeNumber.Int <= len(req.Episodes)
+					},
+				)
+				if err != nil {
+					return err
+				}
+				err = tx.EpisodePut(
 					ctx,
 					seriesID,
 					seasonNumber,
 					episodeNumber,
 					contributorID,
-					&models.Film{
-						Title:        e.Title,
-						Descriptions: e.Descriptions,
-						DateReleased: e.DateReleased,
-						Duration:     e.Duration,
-					},
+					episodePutRequestToModel(e),
 				)
 				if err != nil {
 					return err
@@ -183,6 +242,46 @@ func (app *Application) EpisodesPutAllBySeason(
 	return err
 }
 
+func episodePutRequestToModel(req *dto.EpisodePutRequest) *models.Film {
+	return &models.Film{
+		Title:          req.Title,
+		Descriptions:   req.Descriptions,
+		DateReleased:   req.DateReleased,
+		Duration:       req.Duration,
+		AbsoluteNumber: req.AbsoluteNumber,
+		PartNumber:     req.PartNumber,
+	}
+}
+
+// checkAbsoluteNumberUnused returns ErrUsedEpisodeNumber if the absolute
+// number is used by an episode of the series which is not replaced.
+func checkAbsoluteNumberUnused(
+	ctx context.Context,
+	tx repo.Service,
+	seriesID int,
+	absoluteNumber null.Int,
+	replaced func(*models.Film) bool,
+) error {
+	if !absoluteNumber.Valid {
+		return nil
+	}
+	episode, err := tx.EpisodeGetByAbsoluteNumber(
+		ctx,
+		seriesID,
+		absoluteNumber.Int,
+	)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil
+		}
+		return err
+	}
+	if !replaced(episode) {
+		return ErrUsedEpisodeNumber
+	}
+	return nil
+}
+
 //------------------------------------------------------------------------------
 
 func (app *Application) EpisodeUpdate(
@@ -191,15 +290,65 @@ func (app *Application) EpisodeUpdate(
 	contributorID int,
 	req *dto.EpisodeUpdateRequest,
 ) error {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return err
+	}
+
 	columns := episodeUpdateRequestToValidMap(req)
 
-	err := app.repo.EpisodeUpdate(
+	if req.AbsoluteNumber.Valid {
+		// check absolute number is not used by another episode
+		return app.repo.Tx(
+			ctx,
+			nil,
+			func(ctx context.Context, tx repo.Service) error {
+				episode, err := tx.EpisodeGet(
+					ctx,
+					seriesID,
+					seasonNumber,
+					episodeNumber,
+				)
+				if err != nil {
+					if err == repo.ErrNoRecord {
+						return ErrNotFound
+					}
+					return err
+				}
+				err = checkAbsoluteNumberUnused(
+					ctx,
+					tx,
+					seriesID,
+					req.AbsoluteNumber,
+					func(e *models.Film) bool { return e.ID == episode.ID },
+				)
+				if err != nil {
+					return err
+				}
+				return tx.EpisodeUpdate(
+					ctx,
+					seriesID,
+					seasonNumber,
+					episodeNumber,
+					contributorID,
+					columns,
+				)
+			},
+		)
+	}
+
+	err := app.actorTx(
 		ctx,
-		seriesID,
-		seasonNumber,
-		episodeNumber,
-		contributorID,
-		columns,
+		func(ctx context.Context, tx repo.Service) error {
+			return tx.EpisodeUpdate(
+				ctx,
+				seriesID,
+				seasonNumber,
+				episodeNumber,
+				contributorID,
+				columns,
+			)
+		},
 	)
 	if err != nil {
 		if err == repo.ErrNoRecord {
@@ -227,6 +376,12 @@ func episodeUpdateRequestToValidMap(
 	if req.Duration.Valid {
 		m[models.FilmColumns.Duration] = req.Duration.Int
 	}
+	if req.AbsoluteNumber.Valid {
+		m[models.FilmColumns.AbsoluteNumber] = req.AbsoluteNumber.Int
+	}
+	if req.PartNumber.Valid {
+		m[models.FilmColumns.PartNumber] = req.PartNumber.Int
+	}
 	return m
 }
 
@@ -236,14 +391,24 @@ func (app *Application) EpisodeInvalidate(
 	contributorID int,
 	req *dto.InvalidationRequest,
 ) error {
-	err := app.repo.EpisodeUpdate(
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return err
+	}
+
+	err := app.actorTx(
 		ctx,
-		seriesID,
-		seasonNumber,
-		episodeNumber,
-		contributorID,
-		map[string]any{
-			models.FilmColumns.Invalidation: req.Invalidation,
+		func(ctx context.Context, tx repo.Service) error {
+			return tx.EpisodeUpdate(
+				ctx,
+				seriesID,
+				seasonNumber,
+				episodeNumber,
+				contributorID,
+				map[string]any{
+					models.FilmColumns.Invalidation: req.Invalidation,
+				},
+			)
 		},
 	)
 	if err != nil {
@@ -261,12 +426,22 @@ func (app *Application) EpisodesInvalidateAllBySeason(
 	contributorID int,
 	req *dto.InvalidationRequest,
 ) error {
-	err := app.repo.EpisodesInvalidateAllBySeason(
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return err
+	}
+
+	err := app.actorTx(
 		ctx,
-		seriesID,
-		seasonNumber,
-		contributorID,
-		req.Invalidation,
+		func(ctx context.Context, tx repo.Service) error {
+			return tx.EpisodesInvalidateAllBySeason(
+				ctx,
+				seriesID,
+				seasonNumber,
+				contributorID,
+				req.Invalidation,
+			)
+		},
 	)
 	if err != nil {
 		if err == repo.ErrNoRecord {
@@ -279,6 +454,94 @@ func (app *Application) EpisodesInvalidateAllBySeason(
 
 //------------------------------------------------------------------------------
 
+// EpisodesRenumber moves episodes of a series between seasons and episode
+// numbers atomically. episodes could swap their numbers but must not move to
+// the numbers of an episode which is not moved.
+func (app *Application) EpisodesRenumber(
+	ctx context.Context,
+	seriesID int,
+	contributorID int,
+	req *dto.EpisodesRenumberRequest,
+) error {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return err
+	}
+
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// check series id exists
+			if _, err := tx.SeriesGet(ctx, seriesID); err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// fetch moving episodes
+			episodes := make([]*models.Film, len(req.Moves))
+			moved := make(map[int]*dto.EpisodeMove, len(req.Moves))
+			for i, m := range req.Moves {
+				episode, err := tx.EpisodeGet(
+					ctx,
+					seriesID,
+					m.SeasonNumber,
+					m.EpisodeNumber,
+				)
+				if err != nil {
+					if err == repo.ErrNoRecord {
+						return ErrNotFound
+					}
+					return err
+				}
+				episodes[i] = episode
+				moved[episode.ID] = m
+			}
+			// check the target numbers are free or left by a moving episode
+			for _, m := range req.Moves {
+				episode, err := tx.EpisodeGet(
+					ctx,
+					seriesID,
+					m.ToSeasonNumber,
+					m.ToEpisodeNumber,
+				)
+				if err != nil && err != repo.ErrNoRecord {
+					return err
+				}
+				if err == nil && moved[episode.ID] == nil {
+					return ErrUsedEpisodeNumber
+				}
+				err = checkAbsoluteNumberUnused(
+					ctx,
+					tx,
+					seriesID,
+					m.ToAbsoluteNumber,
+					func(e *models.Film) bool {
+						em := moved[e.ID]
+						return em != nil &&
+							(em == m || em.ToAbsoluteNumber.Valid)
+					},
+				)
+				if err != nil {
+					return err
+				}
+			}
+			// renumber
+			for i, m := range req.Moves {
+				episodes[i].SeasonNumber = null.IntFrom(m.ToSeasonNumber)
+				episodes[i].EpisodeNumber = null.IntFrom(m.ToEpisodeNumber)
+				if m.ToAbsoluteNumber.Valid {
+					episodes[i].AbsoluteNumber = m.ToAbsoluteNumber
+				}
+			}
+			return tx.EpisodesRenumber(ctx, contributorID, episodes)
+		},
+	)
+}
+
+//------------------------------------------------------------------------------
+
 func (app *Application) EpisodeAuditsGetAll(
 	ctx context.Context,
 	seriesID, seasonNumber, episodeNumber int,
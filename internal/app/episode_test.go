@@ -109,13 +109,14 @@ func TestEpisodeGet(t *testing.T) {
 				).
 				Return(tc.get.exp.episode, tc.get.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			episode, err := app.EpisodeGet(
 				ctx,
 				seriesID,
 				seasonNumber,
 				episodeNumber,
+				query.LocaleOptions{},
 			)
 			require.Equal(tc.exp.err, err)
 			require.Equal(tc.exp.episode, episode)
@@ -349,12 +350,13 @@ func TestEpisodesGetAllBySeries(t *testing.T) {
 				}
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			episodes, total, err := app.EpisodesGetAllBySeries(
 				ctx,
 				seriesID,
 				queryOptions,
+				query.LocaleOptions{},
 			)
 			require.Equal(tc.exp.err, err)
 			require.Equal(tc.exp.episodes, episodes)
@@ -590,13 +592,14 @@ func TestEpisodesGetAllBySeason(t *testing.T) {
 				}
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			episodes, total, err := app.EpisodesGetAllBySeason(
 				ctx,
 				seriesID,
 				seasonNumber,
 				queryOptions,
+				query.LocaleOptions{},
 			)
 			require.Equal(tc.exp.err, err)
 			require.Equal(tc.exp.episodes, episodes)
@@ -774,7 +777,7 @@ func TestEpisodePut(t *testing.T) {
 					After(seriesGetCall)
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			err := app.EpisodePut(
 				ctx,
@@ -972,7 +975,7 @@ func TestEpisodesPutAllBySeason(t *testing.T) {
 				}
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			err := app.EpisodesPutAllBySeason(
 				ctx,
@@ -1074,11 +1077,17 @@ func TestEpisodeUpdate(t *testing.T) {
 			controller := gomock.NewController(t)
 			mockRepo := mock_repo.NewMockServiceTx(controller)
 
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+
 			mockRepo.EXPECT().
 				EpisodeUpdate(ctx, seriesID, seasonNumber, episodeNumber, contributorID, episodeUpdateRequestToValidMap(req)).
 				Return(tc.update.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			err := app.EpisodeUpdate(
 				ctx,
@@ -1172,13 +1181,19 @@ func TestEpisodeInvalidate(t *testing.T) {
 			controller := gomock.NewController(t)
 			mockRepo := mock_repo.NewMockServiceTx(controller)
 
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+
 			mockRepo.EXPECT().
 				EpisodeUpdate(ctx, seriesID, seasonNumber, episodeNumber, contributorID, map[string]any{
 					models.FilmColumns.Invalidation: req.Invalidation,
 				}).
 				Return(tc.episodeInvalidate.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			err := app.EpisodeInvalidate(
 				ctx,
@@ -1271,11 +1286,17 @@ func TestEpisodesInvalidateAllBySeason(t *testing.T) {
 			controller := gomock.NewController(t)
 			mockRepo := mock_repo.NewMockServiceTx(controller)
 
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+
 			mockRepo.EXPECT().
 				EpisodesInvalidateAllBySeason(ctx, seriesID, seasonNumber, contributorID, req.Invalidation).
 				Return(tc.episodesInvalidateAllBySeason.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			err := app.EpisodesInvalidateAllBySeason(
 				ctx,
@@ -1522,7 +1543,7 @@ func TestEpisodeAuditsGetAll(t *testing.T) {
 				}
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			audits, total, err := app.EpisodeAuditsGetAll(
 				ctx,
@@ -1537,3 +1558,166 @@ func TestEpisodeAuditsGetAll(t *testing.T) {
 		})
 	}
 }
+
+func TestEpisodesRenumber(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx           = context.Background()
+		seriesID      = 1
+		contributorID = 1
+		series        = &models.Series{ID: seriesID, Title: "series"}
+	)
+
+	episode := func(id, season, number int) *models.Film {
+		return &models.Film{
+			ID:            id,
+			Title:         "episode",
+			SeriesID:      null.IntFrom(seriesID),
+			SeasonNumber:  null.IntFrom(season),
+			EpisodeNumber: null.IntFrom(number),
+		}
+	}
+
+	type Get struct {
+		season, number int
+		episode        *models.Film
+	}
+	type TestCase struct {
+		name        string
+		req         *dto.EpisodesRenumberRequest
+		gets        []Get
+		absolute    map[int]*models.Film
+		expEpisodes []*models.Film
+		expErr      error
+	}
+
+	testCases := []TestCase{
+		{
+			name: "episode not found",
+			req: &dto.EpisodesRenumberRequest{
+				Moves: []*dto.EpisodeMove{
+					{SeasonNumber: 1, EpisodeNumber: 1, ToSeasonNumber: 0, ToEpisodeNumber: 1},
+				},
+			},
+			gets:   []Get{{1, 1, nil}},
+			expErr: app.ErrNotFound,
+		},
+		{
+			name: "episode number used",
+			req: &dto.EpisodesRenumberRequest{
+				Moves: []*dto.EpisodeMove{
+					{SeasonNumber: 1, EpisodeNumber: 1, ToSeasonNumber: 1, ToEpisodeNumber: 2},
+				},
+			},
+			gets: []Get{
+				{1, 1, episode(1, 1, 1)},
+				{1, 2, episode(2, 1, 2)},
+			},
+			expErr: app.ErrUsedEpisodeNumber,
+		},
+		{
+			name: "absolute number used",
+			req: &dto.EpisodesRenumberRequest{
+				Moves: []*dto.EpisodeMove{
+					{
+						SeasonNumber:     1,
+						EpisodeNumber:    1,
+						ToSeasonNumber:   0,
+						ToEpisodeNumber:  1,
+						ToAbsoluteNumber: null.IntFrom(2),
+					},
+				},
+			},
+			gets: []Get{
+				{1, 1, episode(1, 1, 1)},
+				{0, 1, nil},
+			},
+			absolute: map[int]*models.Film{2: episode(2, 1, 2)},
+			expErr:   app.ErrUsedEpisodeNumber,
+		},
+		{
+			name: "swap episodes and move to specials",
+			req: &dto.EpisodesRenumberRequest{
+				Moves: []*dto.EpisodeMove{
+					{SeasonNumber: 1, EpisodeNumber: 1, ToSeasonNumber: 1, ToEpisodeNumber: 2},
+					{SeasonNumber: 1, EpisodeNumber: 2, ToSeasonNumber: 1, ToEpisodeNumber: 1},
+					{
+						SeasonNumber:     1,
+						EpisodeNumber:    3,
+						ToSeasonNumber:   0,
+						ToEpisodeNumber:  1,
+						ToAbsoluteNumber: null.IntFrom(100),
+					},
+				},
+			},
+			gets: []Get{
+				{1, 1, episode(1, 1, 1)},
+				{1, 2, episode(2, 1, 2)},
+				{1, 3, episode(3, 1, 3)},
+				{0, 1, nil},
+			},
+			absolute: map[int]*models.Film{100: nil},
+			expEpisodes: []*models.Film{
+				episode(1, 1, 2),
+				episode(2, 1, 1),
+				func() *models.Film {
+					e := episode(3, 0, 1)
+					e.AbsoluteNumber = null.IntFrom(100)
+					return e
+				}(),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+			mockRepo.EXPECT().SeriesGet(ctx, seriesID).Return(series, nil)
+			for _, g := range tc.gets {
+				g := g
+				mockRepo.EXPECT().
+					EpisodeGet(ctx, seriesID, g.season, g.number).
+					DoAndReturn(func(context.Context, int, int, int) (*models.Film, error) {
+						if g.episode == nil {
+							return nil, repo.ErrNoRecord
+						}
+						// return a copy as the app renumbers fetched episodes
+						e := *g.episode
+						return &e, nil
+					}).
+					AnyTimes()
+			}
+			for number, e := range tc.absolute {
+				var err error
+				if e == nil {
+					err = repo.ErrNoRecord
+				}
+				mockRepo.EXPECT().
+					EpisodeGetByAbsoluteNumber(ctx, seriesID, number).
+					Return(e, err)
+			}
+			if tc.expEpisodes != nil {
+				mockRepo.EXPECT().
+					EpisodesRenumber(ctx, contributorID, tc.expEpisodes).
+					Return(nil)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.EpisodesRenumber(ctx, seriesID, contributorID, tc.req)
+			require.Equal(tc.expErr, err)
+		})
+	}
+}
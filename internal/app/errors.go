@@ -9,4 +9,22 @@ var (
 	ErrUsedEmail         = errors.New("email used")
 	ErrIncorrectPassword = errors.New("incorrect password")
 	ErrSamePassword      = errors.New("same password")
+	ErrUsedExternalID    = errors.New("external id used")
+	ErrInvalidImportFile = errors.New("invalid import file")
+	ErrInvalidImportRows = errors.New("invalid import rows")
+	ErrImportJobPanicked = errors.New("import job panicked")
+	ErrNoExternalID      = errors.New("no external id")
+	ErrMetadataNotFound  = errors.New("metadata not found")
+	ErrMetadataProvider  = errors.New("metadata provider failed")
+	ErrUsedEpisodeNumber = errors.New("episode number used")
+	ErrInvalidMediaOrder = errors.New("invalid media order")
+	ErrLowReputation     = errors.New("low reputation")
+	ErrNotModerator      = errors.New("not moderator")
+	ErrPurgeCleanup      = errors.New("purge cleanup failed")
+	ErrOwnReview         = errors.New("own review")
+	ErrListItemExists    = errors.New("list item exists")
+	ErrInvalidListOrder  = errors.New("invalid list order")
+	ErrListPermission    = errors.New("list permission denied")
+	ErrListMemberExists  = errors.New("list member exists")
+	ErrListInviteExists  = errors.New("list invite exists")
 )
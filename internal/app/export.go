@@ -0,0 +1,353 @@
+package app
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"io"
+	"os"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/catalog"
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/storage"
+	"github.com/volatiletech/null/v8"
+)
+
+const (
+	CatalogExportStatusRunning   = "running"
+	CatalogExportStatusSucceeded = "succeeded"
+	CatalogExportStatusFailed    = "failed"
+)
+
+// CatalogExport dumps the catalog tables into gzip compressed files of every
+// configured format and puts them along a manifest into the export bucket.
+// All tables are read from the same snapshot one batch at a time.
+func (app *Application) CatalogExport(
+	ctx context.Context,
+) (export *models.CatalogExport, err error) {
+	export = &models.CatalogExport{Status: CatalogExportStatusRunning}
+	err = app.repo.CatalogExportCreate(ctx, export)
+	if err != nil {
+		return nil, err
+	}
+
+	// finish the export
+	defer func() {
+		export.Status = CatalogExportStatusSucceeded
+		if err != nil {
+			export.Status = CatalogExportStatusFailed
+		}
+		export.FinishedAt = null.TimeFrom(time.Now())
+		updateErr := app.repo.CatalogExportUpdate(ctx, export.ID, map[string]any{
+			models.CatalogExportColumns.Status:     export.Status,
+			models.CatalogExportColumns.FinishedAt: export.FinishedAt,
+		})
+		if err == nil {
+			err = updateErr
+		}
+	}()
+
+	manifest := &catalog.Manifest{
+		ExportID:  export.ID,
+		CreatedAt: export.CreatedAt,
+	}
+	err = app.repo.Tx(
+		ctx,
+		&sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true},
+		func(ctx context.Context, tx repo.Service) error {
+			for _, table := range catalogExportTables() {
+				files, err := app.catalogExportDump(ctx, tx, export.ID, table)
+				if err != nil {
+					return err
+				}
+				manifest.Files = append(manifest.Files, files...)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return export, err
+	}
+
+	// put the manifest last: an export without a manifest is incomplete
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return export, err
+	}
+	_, err = app.storage.PutFile(
+		ctx,
+		bytes.NewReader(data),
+		&storage.PutOptions{
+			Bucket:      config.Config.MinIO.Bucket.Export.Name,
+			Category:    config.Config.MinIO.Category.Export,
+			CategoryID:  export.ID,
+			Filename:    catalog.ManifestFilename,
+			ContentType: "application/json",
+			Size:        int64(len(data)),
+		},
+	)
+	return export, err
+}
+
+// CatalogExportFileGet opens a file of the latest succeeded export.
+func (app *Application) CatalogExportFileGet(
+	ctx context.Context,
+	filename string,
+) (file io.ReadCloser, err error) {
+	export, err := app.repo.CatalogExportGetLatest(
+		ctx,
+		CatalogExportStatusSucceeded,
+	)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	file, err = app.storage.GetFile(ctx, &storage.GetOptions{
+		Bucket:     config.Config.MinIO.Bucket.Export.Name,
+		Category:   config.Config.MinIO.Category.Export,
+		CategoryID: export.ID,
+		Filename:   filename,
+	})
+	if err != nil {
+		if err == storage.ErrNoFile {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return file, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type catalogExportTable struct {
+	name  string
+	model any
+	// read calls write with every batch of records read by cursor
+	read func(
+		ctx context.Context,
+		tx repo.Service,
+		write func(records []any) error,
+	) error
+}
+
+func catalogExportTables() []*catalogExportTable {
+	tables := []*catalogExportTable{
+		{
+			name:  models.TableNames.Films,
+			model: models.Film{},
+			read: func(ctx context.Context, tx repo.Service, write func([]any) error) error {
+				cursor := 0
+				for {
+					films, err := tx.FilmsGetAllByCursor(
+						ctx,
+						cursor,
+						config.Config.Export.BatchSize,
+					)
+					if err != nil || len(films) == 0 {
+						return err
+					}
+					if err := write(toAnys(films)); err != nil {
+						return err
+					}
+					cursor = films[len(films)-1].ID
+				}
+			},
+		},
+		{
+			name:  models.TableNames.Serieses,
+			model: models.Series{},
+			read: func(ctx context.Context, tx repo.Service, write func([]any) error) error {
+				cursor := 0
+				for {
+					serieses, err := tx.SeriesesGetAllByCursor(
+						ctx,
+						cursor,
+						config.Config.Export.BatchSize,
+					)
+					if err != nil || len(serieses) == 0 {
+						return err
+					}
+					if err := write(toAnys(serieses)); err != nil {
+						return err
+					}
+					cursor = serieses[len(serieses)-1].ID
+				}
+			},
+		},
+	}
+	if !config.Config.Export.IncludeAudits {
+		return tables
+	}
+	return append(
+		tables,
+		&catalogExportTable{
+			name:  models.TableNames.FilmsAudit,
+			model: models.FilmsAudit{},
+			read: func(ctx context.Context, tx repo.Service, write func([]any) error) error {
+				var cursor query.AuditCursor
+				for {
+					audits, err := tx.FilmAuditsGetAllByCursor(
+						ctx,
+						cursor,
+						config.Config.Export.BatchSize,
+					)
+					if err != nil || len(audits) == 0 {
+						return err
+					}
+					if err := write(toAnys(audits)); err != nil {
+						return err
+					}
+					last := audits[len(audits)-1]
+					cursor = query.AuditCursor{
+						ID:            last.ID,
+						ContributedAt: last.ContributedAt,
+						ContributedBy: last.ContributedBy,
+					}
+				}
+			},
+		},
+		&catalogExportTable{
+			name:  models.TableNames.SeriesesAudit,
+			model: models.SeriesesAudit{},
+			read: func(ctx context.Context, tx repo.Service, write func([]any) error) error {
+				var cursor query.AuditCursor
+				for {
+					audits, err := tx.SeriesAuditsGetAllByCursor(
+						ctx,
+						cursor,
+						config.Config.Export.BatchSize,
+					)
+					if err != nil || len(audits) == 0 {
+						return err
+					}
+					if err := write(toAnys(audits)); err != nil {
+						return err
+					}
+					last := audits[len(audits)-1]
+					cursor = query.AuditCursor{
+						ID:            last.ID,
+						ContributedAt: last.ContributedAt,
+						ContributedBy: last.ContributedBy,
+					}
+				}
+			},
+		},
+	)
+}
+
+func toAnys[T any](records []T) []any {
+	anys := make([]any, len(records))
+	for i, r := range records {
+		anys[i] = r
+	}
+	return anys
+}
+
+// catalogExportFile is a gzip compressed dump being written to a temp file
+type catalogExportFile struct {
+	manifest *catalog.ManifestFile
+	file     *os.File
+	gzip     *gzip.Writer
+	encoder  catalog.Encoder
+	hash     hash.Hash
+}
+
+// catalogExportDump reads the table once encoding every batch into a temp file
+// per format and then puts the files into storage
+func (app *Application) catalogExportDump(
+	ctx context.Context,
+	tx repo.Service,
+	exportID int,
+	table *catalogExportTable,
+) (manifestFiles []*catalog.ManifestFile, err error) {
+	files := make([]*catalogExportFile, 0, len(config.Config.Export.Formats))
+	defer func() {
+		for _, f := range files {
+			f.file.Close()
+			os.Remove(f.file.Name())
+		}
+	}()
+
+	for _, format := range config.Config.Export.Formats {
+		file, err := os.CreateTemp("", "export-*")
+		if err != nil {
+			return nil, err
+		}
+		f := &catalogExportFile{
+			manifest: &catalog.ManifestFile{
+				Name:   catalog.Filename(table.name, format),
+				Table:  table.name,
+				Format: format,
+			},
+			file: file,
+			hash: sha256.New(),
+		}
+		files = append(files, f)
+		f.gzip = gzip.NewWriter(io.MultiWriter(f.file, f.hash))
+		f.encoder, err = catalog.NewEncoder(f.gzip, format, table.model)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// write records
+	err = table.read(ctx, tx, func(records []any) error {
+		for _, f := range files {
+			for _, record := range records {
+				if err := f.encoder.Encode(record); err != nil {
+					return err
+				}
+			}
+			f.manifest.Rows += len(records)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range files {
+		// flush encoder and compressor
+		if err := f.encoder.Flush(); err != nil {
+			return nil, err
+		}
+		if err := f.gzip.Close(); err != nil {
+			return nil, err
+		}
+		f.manifest.SHA256 = hex.EncodeToString(f.hash.Sum(nil))
+		// the file offset is at the end of file
+		f.manifest.Size, err = f.file.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := f.file.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+		// put file
+		_, err = app.storage.PutFile(ctx, f.file, &storage.PutOptions{
+			Bucket:      config.Config.MinIO.Bucket.Export.Name,
+			Category:    config.Config.MinIO.Category.Export,
+			CategoryID:  exportID,
+			Filename:    f.manifest.Name,
+			ContentType: "application/gzip",
+			Size:        f.manifest.Size,
+		})
+		if err != nil {
+			return nil, err
+		}
+		manifestFiles = append(manifestFiles, f.manifest)
+	}
+
+	return manifestFiles, nil
+}
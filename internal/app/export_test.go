@@ -0,0 +1,402 @@
+package app_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/catalog"
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/repo/mock_repo"
+	"github.com/aria3ppp/watchlist-server/internal/storage"
+	"github.com/aria3ppp/watchlist-server/internal/storage/mock_storage"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestCatalogExport(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		exportID  = 1
+		createdAt = testutils.Date(2023, 1, 12)
+
+		films = []*models.Film{
+			{
+				ID:            1,
+				Title:         "movie",
+				DateReleased:  testutils.Date(2000, 1, 1),
+				ContributedBy: 1,
+				ContributedAt: testutils.Date(2023, 1, 1),
+			},
+			{
+				ID:            2,
+				Title:         "episode",
+				DateReleased:  testutils.Date(2001, 1, 1),
+				SeriesID:      null.IntFrom(1),
+				SeasonNumber:  null.IntFrom(1),
+				EpisodeNumber: null.IntFrom(1),
+				ContributedBy: 1,
+				ContributedAt: testutils.Date(2023, 1, 2),
+			},
+		}
+
+		expTxError      = errors.New("Tx error")
+		expPutFileError = errors.New("PutFile error")
+	)
+
+	type TestCase struct {
+		name       string
+		txErr      error
+		putFileErr error
+		expStatus  string
+		expErr     error
+	}
+
+	testCases := []TestCase{
+		{
+			name:      "Tx error",
+			txErr:     expTxError,
+			expStatus: app.CatalogExportStatusFailed,
+			expErr:    expTxError,
+		},
+		{
+			name:       "PutFile error",
+			putFileErr: expPutFileError,
+			expStatus:  app.CatalogExportStatusFailed,
+			expErr:     expPutFileError,
+		},
+		{
+			name:      "ok",
+			expStatus: app.CatalogExportStatusSucceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+			mockStorage := mock_storage.NewMockService(controller)
+
+			mockRepo.EXPECT().
+				CatalogExportCreate(ctx, &models.CatalogExport{
+					Status: app.CatalogExportStatusRunning,
+				}).
+				Do(func(_ context.Context, export *models.CatalogExport) {
+					export.ID = exportID
+					export.CreatedAt = createdAt
+				}).
+				Return(nil)
+
+			// files put by name
+			putFiles := map[string][]byte{}
+			if tc.txErr == nil {
+				mockRepo.EXPECT().
+					Tx(
+						ctx,
+						&sql.TxOptions{
+							Isolation: sql.LevelRepeatableRead,
+							ReadOnly:  true,
+						},
+						gomock.Any(),
+					).
+					DoAndReturn(
+						func(ctx context.Context, _ *sql.TxOptions, fn func(_ context.Context, _ repo.Service) error) error {
+							return fn(ctx, mockRepo)
+						},
+					)
+
+				// films are read until an empty batch
+				gomock.InOrder(
+					mockRepo.EXPECT().
+						FilmsGetAllByCursor(ctx, 0, config.Config.Export.BatchSize).
+						Return(films, nil),
+					mockRepo.EXPECT().
+						FilmsGetAllByCursor(ctx, films[1].ID, config.Config.Export.BatchSize).
+						Return(nil, nil),
+				)
+
+				putFile := func(
+					_ context.Context,
+					file io.Reader,
+					options *storage.PutOptions,
+				) (string, error) {
+					require.Equal(
+						config.Config.MinIO.Bucket.Export.Name,
+						options.Bucket,
+					)
+					require.Equal(
+						config.Config.MinIO.Category.Export,
+						options.Category,
+					)
+					require.Equal(exportID, options.CategoryID)
+					data, err := io.ReadAll(file)
+					require.NoError(err)
+					require.Equal(options.Size, int64(len(data)))
+					putFiles[options.Filename] = data
+					return "", tc.putFileErr
+				}
+
+				if tc.putFileErr != nil {
+					mockStorage.EXPECT().
+						PutFile(ctx, gomock.Any(), gomock.Any()).
+						DoAndReturn(putFile)
+				} else {
+					mockRepo.EXPECT().
+						SeriesesGetAllByCursor(ctx, 0, config.Config.Export.BatchSize).
+						Return(nil, nil)
+
+					mockStorage.EXPECT().
+						PutFile(ctx, gomock.Any(), gomock.Any()).
+						DoAndReturn(putFile).
+						Times(2*len(config.Config.Export.Formats) + 1)
+				}
+			} else {
+				mockRepo.EXPECT().
+					Tx(ctx, gomock.Any(), gomock.Any()).
+					Return(tc.txErr)
+			}
+
+			mockRepo.EXPECT().
+				CatalogExportUpdate(ctx, exportID, gomock.Any()).
+				Do(func(_ context.Context, _ int, cols map[string]any) {
+					require.Equal(
+						tc.expStatus,
+						cols[models.CatalogExportColumns.Status],
+					)
+					require.True(
+						cols[models.CatalogExportColumns.FinishedAt].(null.Time).Valid,
+					)
+				}).
+				Return(nil)
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, mockStorage, nil)
+
+			export, err := app.CatalogExport(ctx)
+			require.Equal(tc.expErr, err)
+			require.Equal(exportID, export.ID)
+			require.Equal(tc.expStatus, export.Status)
+
+			if tc.expErr != nil {
+				return
+			}
+
+			// check manifest
+			var manifest catalog.Manifest
+			require.NoError(
+				json.Unmarshal(putFiles[catalog.ManifestFilename], &manifest),
+			)
+			require.Equal(exportID, manifest.ExportID)
+			require.Equal(createdAt, manifest.CreatedAt)
+			require.Equal(
+				2*len(config.Config.Export.Formats),
+				len(manifest.Files),
+			)
+
+			for _, f := range manifest.Files {
+				data, exists := putFiles[f.Name]
+				require.True(exists)
+				require.Equal(catalog.Filename(f.Table, f.Format), f.Name)
+				require.Equal(int64(len(data)), f.Size)
+				sum := sha256.Sum256(data)
+				require.Equal(hex.EncodeToString(sum[:]), f.SHA256)
+
+				// check dump content
+				gz, err := gzip.NewReader(bytes.NewReader(data))
+				require.NoError(err)
+				content, err := io.ReadAll(gz)
+				require.NoError(err)
+
+				var expContent bytes.Buffer
+				switch f.Table {
+				case models.TableNames.Films:
+					require.Equal(len(films), f.Rows)
+					encoder, err := catalog.NewEncoder(
+						&expContent,
+						f.Format,
+						models.Film{},
+					)
+					require.NoError(err)
+					for _, film := range films {
+						require.NoError(encoder.Encode(film))
+					}
+					require.NoError(encoder.Flush())
+				case models.TableNames.Serieses:
+					require.Equal(0, f.Rows)
+					encoder, err := catalog.NewEncoder(
+						&expContent,
+						f.Format,
+						models.Series{},
+					)
+					require.NoError(err)
+					require.NoError(encoder.Flush())
+				default:
+					t.Fatalf("unexpected table %s", f.Table)
+				}
+				require.Equal(expContent.String(), string(content))
+			}
+		})
+	}
+}
+
+func TestCatalogExportFileGet(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		filename = catalog.Filename(models.TableNames.Films, catalog.FormatCSV)
+		export   = &models.CatalogExport{
+			ID:     1,
+			Status: app.CatalogExportStatusSucceeded,
+		}
+		file = io.NopCloser(strings.NewReader("file"))
+
+		expCatalogExportGetLatestError = errors.New("CatalogExportGetLatest error")
+		expGetFileError                = errors.New("GetFile error")
+	)
+
+	type CatalogExportGetLatestExp struct {
+		export *models.CatalogExport
+		err    error
+	}
+	type GetFileExp struct {
+		file io.ReadCloser
+		err  error
+	}
+	type GetFile struct {
+		call bool
+		exp  GetFileExp
+	}
+	type Exp struct {
+		file io.ReadCloser
+		err  error
+	}
+	type TestCase struct {
+		name                   string
+		catalogExportGetLatest CatalogExportGetLatestExp
+		getFile                GetFile
+		exp                    Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name: "no export",
+			catalogExportGetLatest: CatalogExportGetLatestExp{
+				err: repo.ErrNoRecord,
+			},
+			exp: Exp{
+				err: app.ErrNotFound,
+			},
+		},
+		{
+			name: "CatalogExportGetLatest error",
+			catalogExportGetLatest: CatalogExportGetLatestExp{
+				err: expCatalogExportGetLatestError,
+			},
+			exp: Exp{
+				err: expCatalogExportGetLatestError,
+			},
+		},
+		{
+			name: "no file",
+			catalogExportGetLatest: CatalogExportGetLatestExp{
+				export: export,
+			},
+			getFile: GetFile{
+				call: true,
+				exp: GetFileExp{
+					err: storage.ErrNoFile,
+				},
+			},
+			exp: Exp{
+				err: app.ErrNotFound,
+			},
+		},
+		{
+			name: "GetFile error",
+			catalogExportGetLatest: CatalogExportGetLatestExp{
+				export: export,
+			},
+			getFile: GetFile{
+				call: true,
+				exp: GetFileExp{
+					err: expGetFileError,
+				},
+			},
+			exp: Exp{
+				err: expGetFileError,
+			},
+		},
+		{
+			name: "ok",
+			catalogExportGetLatest: CatalogExportGetLatestExp{
+				export: export,
+			},
+			getFile: GetFile{
+				call: true,
+				exp: GetFileExp{
+					file: file,
+				},
+			},
+			exp: Exp{
+				file: file,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+			mockStorage := mock_storage.NewMockService(controller)
+
+			mockRepo.EXPECT().
+				CatalogExportGetLatest(ctx, app.CatalogExportStatusSucceeded).
+				Return(
+					tc.catalogExportGetLatest.export,
+					tc.catalogExportGetLatest.err,
+				)
+
+			if tc.getFile.call {
+				mockStorage.EXPECT().
+					GetFile(ctx, &storage.GetOptions{
+						Bucket:     config.Config.MinIO.Bucket.Export.Name,
+						Category:   config.Config.MinIO.Category.Export,
+						CategoryID: export.ID,
+						Filename:   filename,
+					}).
+					Return(tc.getFile.exp.file, tc.getFile.exp.err)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, mockStorage, nil)
+
+			file, err := app.CatalogExportFileGet(ctx, filename)
+			require.Equal(tc.exp.err, err)
+			require.Equal(tc.exp.file, file)
+		})
+	}
+}
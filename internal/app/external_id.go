@@ -0,0 +1,298 @@
+package app
+
+import (
+	"context"
+
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+)
+
+func (app *Application) MovieExternalIDsGet(
+	ctx context.Context,
+	id int,
+) (extIDs []*models.ExternalID, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the movie exists
+			_, err := tx.MovieGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// fetch external ids
+			extIDs, err = tx.ExternalIDsGetAllByFilm(ctx, id)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return extIDs, nil
+}
+
+func (app *Application) MovieExternalIDPut(
+	ctx context.Context,
+	id int,
+	contributorID int,
+	req *dto.ExternalIDPutRequest,
+) error {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return err
+	}
+
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the movie exists
+			_, err := tx.MovieGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// check external id have not been used by another film
+			extID, err := tx.FilmExternalIDGet(ctx, req.Provider, req.ExternalID)
+			if err == nil && extID.FilmID.Int != id {
+				return ErrUsedExternalID
+			}
+			if err != nil && err != repo.ErrNoRecord {
+				return err
+			}
+			// put the external id
+			return tx.ExternalIDPutByFilm(
+				ctx,
+				id,
+				contributorID,
+				&models.ExternalID{
+					Provider:   req.Provider,
+					ExternalID: req.ExternalID,
+				},
+			)
+		},
+	)
+}
+
+func (app *Application) MovieExternalIDAuditsGetAll(
+	ctx context.Context,
+	id int,
+	queryOptions query.SortOrderOptions,
+) (audits []*models.ExternalIdsAudit, total int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the movie exists
+			_, err := tx.MovieGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// fetch audits
+			audits, err = tx.ExternalIDAuditsGetAllByFilm(ctx, id, queryOptions)
+			if err != nil {
+				return err
+			}
+			// count total audits
+			total, err = tx.ExternalIDAuditsCountByFilm(ctx, id)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return audits, total, nil
+}
+
+func (app *Application) MovieGetByExternalID(
+	ctx context.Context,
+	provider string,
+	externalID string,
+) (movie *models.Film, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// lookup the external id
+			extID, err := tx.FilmExternalIDGet(ctx, provider, externalID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// the external id must identify a film
+			if !extID.FilmID.Valid {
+				return ErrNotFound
+			}
+			// fetch the movie: episodes are not movies
+			movie, err = tx.MovieGet(ctx, extID.FilmID.Int)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return movie, nil
+}
+
+// -----------------------------------------------------------------------------
+
+func (app *Application) SeriesExternalIDsGet(
+	ctx context.Context,
+	id int,
+) (extIDs []*models.ExternalID, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the series exists
+			_, err := tx.SeriesGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// fetch external ids
+			extIDs, err = tx.ExternalIDsGetAllBySeries(ctx, id)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return extIDs, nil
+}
+
+func (app *Application) SeriesExternalIDPut(
+	ctx context.Context,
+	id int,
+	contributorID int,
+	req *dto.ExternalIDPutRequest,
+) error {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return err
+	}
+
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the series exists
+			_, err := tx.SeriesGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// check external id have not been used by another series
+			extID, err := tx.SeriesExternalIDGet(ctx, req.Provider, req.ExternalID)
+			if err == nil && extID.SeriesID.Int != id {
+				return ErrUsedExternalID
+			}
+			if err != nil && err != repo.ErrNoRecord {
+				return err
+			}
+			// put the external id
+			return tx.ExternalIDPutBySeries(
+				ctx,
+				id,
+				contributorID,
+				&models.ExternalID{
+					Provider:   req.Provider,
+					ExternalID: req.ExternalID,
+				},
+			)
+		},
+	)
+}
+
+func (app *Application) SeriesExternalIDAuditsGetAll(
+	ctx context.Context,
+	id int,
+	queryOptions query.SortOrderOptions,
+) (audits []*models.ExternalIdsAudit, total int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the series exists
+			_, err := tx.SeriesGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// fetch audits
+			audits, err = tx.ExternalIDAuditsGetAllBySeries(ctx, id, queryOptions)
+			if err != nil {
+				return err
+			}
+			// count total audits
+			total, err = tx.ExternalIDAuditsCountBySeries(ctx, id)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return audits, total, nil
+}
+
+func (app *Application) SeriesGetByExternalID(
+	ctx context.Context,
+	provider string,
+	externalID string,
+) (series *models.Series, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// lookup the external id
+			extID, err := tx.SeriesExternalIDGet(ctx, provider, externalID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// the external id must identify a series
+			if !extID.SeriesID.Valid {
+				return ErrNotFound
+			}
+			// fetch the series
+			series, err = tx.SeriesGet(ctx, extID.SeriesID.Int)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return series, nil
+}
@@ -0,0 +1,1394 @@
+package app_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/repo/mock_repo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestMovieExternalIDsGet(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		id = 1
+
+		expMovie                        = &models.Film{ID: id, Title: "movie"}
+		expMovieGetError                = errors.New("MovieGet error")
+		expExternalIDsGetAllByFilmError = errors.New(
+			"ExternalIDsGetAllByFilm error",
+		)
+		expExtIDs = []*models.ExternalID{
+			{ID: 1, FilmID: null.IntFrom(id), Provider: "imdb"},
+		}
+	)
+
+	type TxExp struct {
+		err error
+	}
+	type Tx struct {
+		exp TxExp
+	}
+	type MovieGetExp struct {
+		movie *models.Film
+		err   error
+	}
+	type MovieGet struct {
+		exp MovieGetExp
+	}
+	type GetAllExp struct {
+		extIDs []*models.ExternalID
+		err    error
+	}
+	type GetAll struct {
+		exp GetAllExp
+	}
+	type Exp struct {
+		extIDs []*models.ExternalID
+		err    error
+	}
+	type TestCase struct {
+		name     string
+		tx       Tx
+		movieGet MovieGet
+		getAll   GetAll
+		exp      Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name: "not found",
+			tx: Tx{
+				exp: TxExp{
+					err: app.ErrNotFound,
+				},
+			},
+			movieGet: MovieGet{
+				exp: MovieGetExp{
+					movie: nil,
+					err:   repo.ErrNoRecord,
+				},
+			},
+			exp: Exp{
+				extIDs: nil,
+				err:    app.ErrNotFound,
+			},
+		},
+
+		{
+			name: "MovieGet error",
+			tx: Tx{
+				exp: TxExp{
+					err: expMovieGetError,
+				},
+			},
+			movieGet: MovieGet{
+				exp: MovieGetExp{
+					movie: nil,
+					err:   expMovieGetError,
+				},
+			},
+			exp: Exp{
+				extIDs: nil,
+				err:    expMovieGetError,
+			},
+		},
+
+		{
+			name: "ExternalIDsGetAllByFilm error",
+			tx: Tx{
+				exp: TxExp{
+					err: expExternalIDsGetAllByFilmError,
+				},
+			},
+			movieGet: MovieGet{
+				exp: MovieGetExp{
+					movie: expMovie,
+					err:   nil,
+				},
+			},
+			getAll: GetAll{
+				exp: GetAllExp{
+					extIDs: nil,
+					err:    expExternalIDsGetAllByFilmError,
+				},
+			},
+			exp: Exp{
+				extIDs: nil,
+				err:    expExternalIDsGetAllByFilmError,
+			},
+		},
+
+		{
+			name: "ok",
+			tx: Tx{
+				exp: TxExp{
+					err: nil,
+				},
+			},
+			movieGet: MovieGet{
+				exp: MovieGetExp{
+					movie: expMovie,
+					err:   nil,
+				},
+			},
+			getAll: GetAll{
+				exp: GetAllExp{
+					extIDs: expExtIDs,
+					err:    nil,
+				},
+			},
+			exp: Exp{
+				extIDs: expExtIDs,
+				err:    nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			txCall := mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				Do(func(ctx context.Context, opts *sql.TxOptions, fn func(_ context.Context, _ repo.Service) error) {
+					fn(ctx, mockRepo)
+				}).
+				Return(tc.tx.exp.err)
+
+			movieGetCall := mockRepo.EXPECT().
+				MovieGet(ctx, id).
+				Return(tc.movieGet.exp.movie, tc.movieGet.exp.err).
+				After(txCall)
+
+			if tc.movieGet.exp.err == nil {
+				mockRepo.EXPECT().
+					ExternalIDsGetAllByFilm(ctx, id).
+					Return(tc.getAll.exp.extIDs, tc.getAll.exp.err).
+					After(movieGetCall)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			extIDs, err := app.MovieExternalIDsGet(ctx, id)
+			require.Equal(tc.exp.err, err)
+			require.Equal(tc.exp.extIDs, extIDs)
+		})
+	}
+}
+
+func TestMovieExternalIDPut(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		id            = 1
+		contributorID = 1
+		req           = &dto.ExternalIDPutRequest{
+			Provider:   dto.ExternalIDProviderIMDb,
+			ExternalID: "tt0111161",
+		}
+		putExtID = &models.ExternalID{
+			Provider:   req.Provider,
+			ExternalID: req.ExternalID,
+		}
+
+		expMovie                    = &models.Film{ID: id, Title: "movie"}
+		expMovieGetError            = errors.New("MovieGet error")
+		expExternalIDGetError       = errors.New("ExternalIDGet error")
+		expExternalIDPutByFilmError = errors.New("ExternalIDPutByFilm error")
+	)
+
+	type TxExp struct {
+		err error
+	}
+	type Tx struct {
+		exp TxExp
+	}
+	type MovieGetExp struct {
+		movie *models.Film
+		err   error
+	}
+	type MovieGet struct {
+		exp MovieGetExp
+	}
+	type ExternalIDGetExp struct {
+		extID *models.ExternalID
+		err   error
+	}
+	type ExternalIDGet struct {
+		exp ExternalIDGetExp
+	}
+	type PutExp struct {
+		err error
+	}
+	type Put struct {
+		call bool
+		exp  PutExp
+	}
+	type Exp struct {
+		err error
+	}
+	type TestCase struct {
+		name          string
+		tx            Tx
+		movieGet      MovieGet
+		externalIDGet ExternalIDGet
+		put           Put
+		exp           Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name: "not found",
+			tx: Tx{
+				exp: TxExp{
+					err: app.ErrNotFound,
+				},
+			},
+			movieGet: MovieGet{
+				exp: MovieGetExp{
+					movie: nil,
+					err:   repo.ErrNoRecord,
+				},
+			},
+			exp: Exp{
+				err: app.ErrNotFound,
+			},
+		},
+
+		{
+			name: "MovieGet error",
+			tx: Tx{
+				exp: TxExp{
+					err: expMovieGetError,
+				},
+			},
+			movieGet: MovieGet{
+				exp: MovieGetExp{
+					movie: nil,
+					err:   expMovieGetError,
+				},
+			},
+			exp: Exp{
+				err: expMovieGetError,
+			},
+		},
+
+		{
+			name: "ExternalIDGet error",
+			tx: Tx{
+				exp: TxExp{
+					err: expExternalIDGetError,
+				},
+			},
+			movieGet: MovieGet{
+				exp: MovieGetExp{
+					movie: expMovie,
+					err:   nil,
+				},
+			},
+			externalIDGet: ExternalIDGet{
+				exp: ExternalIDGetExp{
+					extID: nil,
+					err:   expExternalIDGetError,
+				},
+			},
+			exp: Exp{
+				err: expExternalIDGetError,
+			},
+		},
+
+		{
+			name: "external id used by another film",
+			tx: Tx{
+				exp: TxExp{
+					err: app.ErrUsedExternalID,
+				},
+			},
+			movieGet: MovieGet{
+				exp: MovieGetExp{
+					movie: expMovie,
+					err:   nil,
+				},
+			},
+			externalIDGet: ExternalIDGet{
+				exp: ExternalIDGetExp{
+					extID: &models.ExternalID{FilmID: null.IntFrom(id + 1)},
+					err:   nil,
+				},
+			},
+			exp: Exp{
+				err: app.ErrUsedExternalID,
+			},
+		},
+
+		{
+			name: "external id used by a series",
+			tx: Tx{
+				exp: TxExp{
+					err: app.ErrUsedExternalID,
+				},
+			},
+			movieGet: MovieGet{
+				exp: MovieGetExp{
+					movie: expMovie,
+					err:   nil,
+				},
+			},
+			externalIDGet: ExternalIDGet{
+				exp: ExternalIDGetExp{
+					extID: &models.ExternalID{SeriesID: null.IntFrom(id)},
+					err:   nil,
+				},
+			},
+			exp: Exp{
+				err: app.ErrUsedExternalID,
+			},
+		},
+
+		{
+			name: "ExternalIDPutByFilm error",
+			tx: Tx{
+				exp: TxExp{
+					err: expExternalIDPutByFilmError,
+				},
+			},
+			movieGet: MovieGet{
+				exp: MovieGetExp{
+					movie: expMovie,
+					err:   nil,
+				},
+			},
+			externalIDGet: ExternalIDGet{
+				exp: ExternalIDGetExp{
+					extID: nil,
+					err:   repo.ErrNoRecord,
+				},
+			},
+			put: Put{
+				call: true,
+				exp: PutExp{
+					err: expExternalIDPutByFilmError,
+				},
+			},
+			exp: Exp{
+				err: expExternalIDPutByFilmError,
+			},
+		},
+
+		{
+			name: "ok: same film",
+			tx: Tx{
+				exp: TxExp{
+					err: nil,
+				},
+			},
+			movieGet: MovieGet{
+				exp: MovieGetExp{
+					movie: expMovie,
+					err:   nil,
+				},
+			},
+			externalIDGet: ExternalIDGet{
+				exp: ExternalIDGetExp{
+					extID: &models.ExternalID{FilmID: null.IntFrom(id)},
+					err:   nil,
+				},
+			},
+			put: Put{
+				call: true,
+				exp: PutExp{
+					err: nil,
+				},
+			},
+			exp: Exp{
+				err: nil,
+			},
+		},
+
+		{
+			name: "ok",
+			tx: Tx{
+				exp: TxExp{
+					err: nil,
+				},
+			},
+			movieGet: MovieGet{
+				exp: MovieGetExp{
+					movie: expMovie,
+					err:   nil,
+				},
+			},
+			externalIDGet: ExternalIDGet{
+				exp: ExternalIDGetExp{
+					extID: nil,
+					err:   repo.ErrNoRecord,
+				},
+			},
+			put: Put{
+				call: true,
+				exp: PutExp{
+					err: nil,
+				},
+			},
+			exp: Exp{
+				err: nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			txCall := mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				Do(func(ctx context.Context, opts *sql.TxOptions, fn func(_ context.Context, _ repo.Service) error) {
+					fn(ctx, mockRepo)
+				}).
+				Return(tc.tx.exp.err)
+
+			movieGetCall := mockRepo.EXPECT().
+				MovieGet(ctx, id).
+				Return(tc.movieGet.exp.movie, tc.movieGet.exp.err).
+				After(txCall)
+
+			if tc.movieGet.exp.err == nil {
+				externalIDGetCall := mockRepo.EXPECT().
+					FilmExternalIDGet(ctx, req.Provider, req.ExternalID).
+					Return(tc.externalIDGet.exp.extID, tc.externalIDGet.exp.err).
+					After(movieGetCall)
+
+				if tc.put.call {
+					mockRepo.EXPECT().
+						ExternalIDPutByFilm(ctx, id, contributorID, putExtID).
+						Return(tc.put.exp.err).
+						After(externalIDGetCall)
+				}
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.MovieExternalIDPut(ctx, id, contributorID, req)
+			require.Equal(tc.exp.err, err)
+		})
+	}
+}
+
+func TestMovieExternalIDAuditsGetAll(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		id           = 1
+		queryOptions = query.SortOrderOptions{
+			Offset:    0,
+			Limit:     math.MaxInt,
+			SortOrder: "desc",
+		}
+
+		expMovie                           = &models.Film{ID: id, Title: "movie"}
+		expMovieGetError                   = errors.New("MovieGet error")
+		expExternalIDAuditsGetAllByFilmErr = errors.New(
+			"ExternalIDAuditsGetAllByFilm error",
+		)
+		expExternalIDAuditsCountByFilmErr = errors.New(
+			"ExternalIDAuditsCountByFilm error",
+		)
+		expAudits = []*models.ExternalIdsAudit{{ExternalID: "tt0111161"}}
+		expTotal  = 100
+	)
+
+	type TxExp struct {
+		err error
+	}
+	type Tx struct {
+		exp TxExp
+	}
+	type MovieGetExp struct {
+		movie *models.Film
+		err   error
+	}
+	type MovieGet struct {
+		exp MovieGetExp
+	}
+	type AuditsGetAllExp struct {
+		audits []*models.ExternalIdsAudit
+		err    error
+	}
+	type AuditsGetAll struct {
+		exp AuditsGetAllExp
+	}
+	type AuditsCountExp struct {
+		total int
+		err   error
+	}
+	type AuditsCount struct {
+		exp AuditsCountExp
+	}
+	type Exp struct {
+		audits []*models.ExternalIdsAudit
+		total  int
+		err    error
+	}
+	type TestCase struct {
+		name         string
+		tx           Tx
+		movieGet     MovieGet
+		auditsGetAll AuditsGetAll
+		auditsCount  AuditsCount
+		exp          Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name: "not found",
+			tx: Tx{
+				exp: TxExp{
+					err: app.ErrNotFound,
+				},
+			},
+			movieGet: MovieGet{
+				exp: MovieGetExp{
+					movie: nil,
+					err:   repo.ErrNoRecord,
+				},
+			},
+			exp: Exp{
+				audits: nil,
+				total:  0,
+				err:    app.ErrNotFound,
+			},
+		},
+
+		{
+			name: "MovieGet error",
+			tx: Tx{
+				exp: TxExp{
+					err: expMovieGetError,
+				},
+			},
+			movieGet: MovieGet{
+				exp: MovieGetExp{
+					movie: nil,
+					err:   expMovieGetError,
+				},
+			},
+			exp: Exp{
+				audits: nil,
+				total:  0,
+				err:    expMovieGetError,
+			},
+		},
+
+		{
+			name: "ExternalIDAuditsGetAllByFilm error",
+			tx: Tx{
+				exp: TxExp{
+					err: expExternalIDAuditsGetAllByFilmErr,
+				},
+			},
+			movieGet: MovieGet{
+				exp: MovieGetExp{
+					movie: expMovie,
+					err:   nil,
+				},
+			},
+			auditsGetAll: AuditsGetAll{
+				exp: AuditsGetAllExp{
+					audits: nil,
+					err:    expExternalIDAuditsGetAllByFilmErr,
+				},
+			},
+			exp: Exp{
+				audits: nil,
+				total:  0,
+				err:    expExternalIDAuditsGetAllByFilmErr,
+			},
+		},
+
+		{
+			name: "ExternalIDAuditsCountByFilm error",
+			tx: Tx{
+				exp: TxExp{
+					err: expExternalIDAuditsCountByFilmErr,
+				},
+			},
+			movieGet: MovieGet{
+				exp: MovieGetExp{
+					movie: expMovie,
+					err:   nil,
+				},
+			},
+			auditsGetAll: AuditsGetAll{
+				exp: AuditsGetAllExp{
+					audits: expAudits,
+					err:    nil,
+				},
+			},
+			auditsCount: AuditsCount{
+				exp: AuditsCountExp{
+					total: 0,
+					err:   expExternalIDAuditsCountByFilmErr,
+				},
+			},
+			exp: Exp{
+				audits: nil,
+				total:  0,
+				err:    expExternalIDAuditsCountByFilmErr,
+			},
+		},
+
+		{
+			name: "ok",
+			tx: Tx{
+				exp: TxExp{
+					err: nil,
+				},
+			},
+			movieGet: MovieGet{
+				exp: MovieGetExp{
+					movie: expMovie,
+					err:   nil,
+				},
+			},
+			auditsGetAll: AuditsGetAll{
+				exp: AuditsGetAllExp{
+					audits: expAudits,
+					err:    nil,
+				},
+			},
+			auditsCount: AuditsCount{
+				exp: AuditsCountExp{
+					total: expTotal,
+					err:   nil,
+				},
+			},
+			exp: Exp{
+				audits: expAudits,
+				total:  expTotal,
+				err:    nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			txCall := mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				Do(func(ctx context.Context, opts *sql.TxOptions, fn func(_ context.Context, _ repo.Service) error) {
+					fn(ctx, mockRepo)
+				}).
+				Return(tc.tx.exp.err)
+
+			movieGetCall := mockRepo.EXPECT().
+				MovieGet(ctx, id).
+				Return(tc.movieGet.exp.movie, tc.movieGet.exp.err).
+				After(txCall)
+
+			if tc.movieGet.exp.err == nil {
+				auditsGetAllCall := mockRepo.EXPECT().
+					ExternalIDAuditsGetAllByFilm(ctx, id, queryOptions).
+					Return(tc.auditsGetAll.exp.audits, tc.auditsGetAll.exp.err).
+					After(movieGetCall)
+
+				if tc.auditsGetAll.exp.err == nil {
+					mockRepo.EXPECT().
+						ExternalIDAuditsCountByFilm(ctx, id).
+						Return(tc.auditsCount.exp.total, tc.auditsCount.exp.err).
+						After(auditsGetAllCall)
+				}
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			audits, total, err := app.MovieExternalIDAuditsGetAll(
+				ctx,
+				id,
+				queryOptions,
+			)
+			require.Equal(tc.exp.err, err)
+			require.Equal(tc.exp.audits, audits)
+			require.Equal(tc.exp.total, total)
+		})
+	}
+}
+
+func TestMovieGetByExternalID(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		provider   = dto.ExternalIDProviderIMDb
+		externalID = "tt0111161"
+		filmID     = 1
+
+		expMovie              = &models.Film{ID: filmID, Title: "movie"}
+		expExternalIDGetError = errors.New("ExternalIDGet error")
+		expMovieGetError      = errors.New("MovieGet error")
+	)
+
+	type TxExp struct {
+		err error
+	}
+	type Tx struct {
+		exp TxExp
+	}
+	type ExternalIDGetExp struct {
+		extID *models.ExternalID
+		err   error
+	}
+	type ExternalIDGet struct {
+		exp ExternalIDGetExp
+	}
+	type MovieGetExp struct {
+		movie *models.Film
+		err   error
+	}
+	type MovieGet struct {
+		call bool
+		exp  MovieGetExp
+	}
+	type Exp struct {
+		movie *models.Film
+		err   error
+	}
+	type TestCase struct {
+		name          string
+		tx            Tx
+		externalIDGet ExternalIDGet
+		movieGet      MovieGet
+		exp           Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name: "external id not found",
+			tx: Tx{
+				exp: TxExp{
+					err: app.ErrNotFound,
+				},
+			},
+			externalIDGet: ExternalIDGet{
+				exp: ExternalIDGetExp{
+					extID: nil,
+					err:   repo.ErrNoRecord,
+				},
+			},
+			exp: Exp{
+				movie: nil,
+				err:   app.ErrNotFound,
+			},
+		},
+
+		{
+			name: "ExternalIDGet error",
+			tx: Tx{
+				exp: TxExp{
+					err: expExternalIDGetError,
+				},
+			},
+			externalIDGet: ExternalIDGet{
+				exp: ExternalIDGetExp{
+					extID: nil,
+					err:   expExternalIDGetError,
+				},
+			},
+			exp: Exp{
+				movie: nil,
+				err:   expExternalIDGetError,
+			},
+		},
+
+		{
+			name: "external id of a series",
+			tx: Tx{
+				exp: TxExp{
+					err: app.ErrNotFound,
+				},
+			},
+			externalIDGet: ExternalIDGet{
+				exp: ExternalIDGetExp{
+					extID: &models.ExternalID{SeriesID: null.IntFrom(1)},
+					err:   nil,
+				},
+			},
+			exp: Exp{
+				movie: nil,
+				err:   app.ErrNotFound,
+			},
+		},
+
+		{
+			name: "external id of an episode",
+			tx: Tx{
+				exp: TxExp{
+					err: app.ErrNotFound,
+				},
+			},
+			externalIDGet: ExternalIDGet{
+				exp: ExternalIDGetExp{
+					extID: &models.ExternalID{FilmID: null.IntFrom(filmID)},
+					err:   nil,
+				},
+			},
+			movieGet: MovieGet{
+				call: true,
+				exp: MovieGetExp{
+					movie: nil,
+					err:   repo.ErrNoRecord,
+				},
+			},
+			exp: Exp{
+				movie: nil,
+				err:   app.ErrNotFound,
+			},
+		},
+
+		{
+			name: "MovieGet error",
+			tx: Tx{
+				exp: TxExp{
+					err: expMovieGetError,
+				},
+			},
+			externalIDGet: ExternalIDGet{
+				exp: ExternalIDGetExp{
+					extID: &models.ExternalID{FilmID: null.IntFrom(filmID)},
+					err:   nil,
+				},
+			},
+			movieGet: MovieGet{
+				call: true,
+				exp: MovieGetExp{
+					movie: nil,
+					err:   expMovieGetError,
+				},
+			},
+			exp: Exp{
+				movie: nil,
+				err:   expMovieGetError,
+			},
+		},
+
+		{
+			name: "ok",
+			tx: Tx{
+				exp: TxExp{
+					err: nil,
+				},
+			},
+			externalIDGet: ExternalIDGet{
+				exp: ExternalIDGetExp{
+					extID: &models.ExternalID{FilmID: null.IntFrom(filmID)},
+					err:   nil,
+				},
+			},
+			movieGet: MovieGet{
+				call: true,
+				exp: MovieGetExp{
+					movie: expMovie,
+					err:   nil,
+				},
+			},
+			exp: Exp{
+				movie: expMovie,
+				err:   nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			txCall := mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				Do(func(ctx context.Context, opts *sql.TxOptions, fn func(_ context.Context, _ repo.Service) error) {
+					fn(ctx, mockRepo)
+				}).
+				Return(tc.tx.exp.err)
+
+			externalIDGetCall := mockRepo.EXPECT().
+				FilmExternalIDGet(ctx, provider, externalID).
+				Return(tc.externalIDGet.exp.extID, tc.externalIDGet.exp.err).
+				After(txCall)
+
+			if tc.movieGet.call {
+				mockRepo.EXPECT().
+					MovieGet(ctx, filmID).
+					Return(tc.movieGet.exp.movie, tc.movieGet.exp.err).
+					After(externalIDGetCall)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			movie, err := app.MovieGetByExternalID(ctx, provider, externalID)
+			require.Equal(tc.exp.err, err)
+			require.Equal(tc.exp.movie, movie)
+		})
+	}
+}
+
+func TestSeriesExternalIDPut(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		id            = 1
+		contributorID = 1
+		req           = &dto.ExternalIDPutRequest{
+			Provider:   dto.ExternalIDProviderWikidata,
+			ExternalID: "Q1079",
+		}
+		putExtID = &models.ExternalID{
+			Provider:   req.Provider,
+			ExternalID: req.ExternalID,
+		}
+
+		expSeries                     = &models.Series{ID: id, Title: "series"}
+		expSeriesGetError             = errors.New("SeriesGet error")
+		expExternalIDPutBySeriesError = errors.New(
+			"ExternalIDPutBySeries error",
+		)
+	)
+
+	type TxExp struct {
+		err error
+	}
+	type Tx struct {
+		exp TxExp
+	}
+	type SeriesGetExp struct {
+		series *models.Series
+		err    error
+	}
+	type SeriesGet struct {
+		exp SeriesGetExp
+	}
+	type ExternalIDGetExp struct {
+		extID *models.ExternalID
+		err   error
+	}
+	type ExternalIDGet struct {
+		exp ExternalIDGetExp
+	}
+	type PutExp struct {
+		err error
+	}
+	type Put struct {
+		call bool
+		exp  PutExp
+	}
+	type Exp struct {
+		err error
+	}
+	type TestCase struct {
+		name          string
+		tx            Tx
+		seriesGet     SeriesGet
+		externalIDGet ExternalIDGet
+		put           Put
+		exp           Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name: "not found",
+			tx: Tx{
+				exp: TxExp{
+					err: app.ErrNotFound,
+				},
+			},
+			seriesGet: SeriesGet{
+				exp: SeriesGetExp{
+					series: nil,
+					err:    repo.ErrNoRecord,
+				},
+			},
+			exp: Exp{
+				err: app.ErrNotFound,
+			},
+		},
+
+		{
+			name: "SeriesGet error",
+			tx: Tx{
+				exp: TxExp{
+					err: expSeriesGetError,
+				},
+			},
+			seriesGet: SeriesGet{
+				exp: SeriesGetExp{
+					series: nil,
+					err:    expSeriesGetError,
+				},
+			},
+			exp: Exp{
+				err: expSeriesGetError,
+			},
+		},
+
+		{
+			name: "external id used by a film",
+			tx: Tx{
+				exp: TxExp{
+					err: app.ErrUsedExternalID,
+				},
+			},
+			seriesGet: SeriesGet{
+				exp: SeriesGetExp{
+					series: expSeries,
+					err:    nil,
+				},
+			},
+			externalIDGet: ExternalIDGet{
+				exp: ExternalIDGetExp{
+					extID: &models.ExternalID{FilmID: null.IntFrom(id)},
+					err:   nil,
+				},
+			},
+			exp: Exp{
+				err: app.ErrUsedExternalID,
+			},
+		},
+
+		{
+			name: "ExternalIDPutBySeries error",
+			tx: Tx{
+				exp: TxExp{
+					err: expExternalIDPutBySeriesError,
+				},
+			},
+			seriesGet: SeriesGet{
+				exp: SeriesGetExp{
+					series: expSeries,
+					err:    nil,
+				},
+			},
+			externalIDGet: ExternalIDGet{
+				exp: ExternalIDGetExp{
+					extID: nil,
+					err:   repo.ErrNoRecord,
+				},
+			},
+			put: Put{
+				call: true,
+				exp: PutExp{
+					err: expExternalIDPutBySeriesError,
+				},
+			},
+			exp: Exp{
+				err: expExternalIDPutBySeriesError,
+			},
+		},
+
+		{
+			name: "ok",
+			tx: Tx{
+				exp: TxExp{
+					err: nil,
+				},
+			},
+			seriesGet: SeriesGet{
+				exp: SeriesGetExp{
+					series: expSeries,
+					err:    nil,
+				},
+			},
+			externalIDGet: ExternalIDGet{
+				exp: ExternalIDGetExp{
+					extID: &models.ExternalID{SeriesID: null.IntFrom(id)},
+					err:   nil,
+				},
+			},
+			put: Put{
+				call: true,
+				exp: PutExp{
+					err: nil,
+				},
+			},
+			exp: Exp{
+				err: nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			txCall := mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				Do(func(ctx context.Context, opts *sql.TxOptions, fn func(_ context.Context, _ repo.Service) error) {
+					fn(ctx, mockRepo)
+				}).
+				Return(tc.tx.exp.err)
+
+			seriesGetCall := mockRepo.EXPECT().
+				SeriesGet(ctx, id).
+				Return(tc.seriesGet.exp.series, tc.seriesGet.exp.err).
+				After(txCall)
+
+			if tc.seriesGet.exp.err == nil {
+				externalIDGetCall := mockRepo.EXPECT().
+					SeriesExternalIDGet(ctx, req.Provider, req.ExternalID).
+					Return(tc.externalIDGet.exp.extID, tc.externalIDGet.exp.err).
+					After(seriesGetCall)
+
+				if tc.put.call {
+					mockRepo.EXPECT().
+						ExternalIDPutBySeries(ctx, id, contributorID, putExtID).
+						Return(tc.put.exp.err).
+						After(externalIDGetCall)
+				}
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.SeriesExternalIDPut(ctx, id, contributorID, req)
+			require.Equal(tc.exp.err, err)
+		})
+	}
+}
+
+func TestSeriesGetByExternalID(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		provider   = dto.ExternalIDProviderTMDb
+		externalID = "1396"
+		seriesID   = 1
+
+		expSeries             = &models.Series{ID: seriesID, Title: "series"}
+		expExternalIDGetError = errors.New("ExternalIDGet error")
+		expSeriesGetError     = errors.New("SeriesGet error")
+	)
+
+	type TxExp struct {
+		err error
+	}
+	type Tx struct {
+		exp TxExp
+	}
+	type ExternalIDGetExp struct {
+		extID *models.ExternalID
+		err   error
+	}
+	type ExternalIDGet struct {
+		exp ExternalIDGetExp
+	}
+	type SeriesGetExp struct {
+		series *models.Series
+		err    error
+	}
+	type SeriesGet struct {
+		call bool
+		exp  SeriesGetExp
+	}
+	type Exp struct {
+		series *models.Series
+		err    error
+	}
+	type TestCase struct {
+		name          string
+		tx            Tx
+		externalIDGet ExternalIDGet
+		seriesGet     SeriesGet
+		exp           Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name: "external id not found",
+			tx: Tx{
+				exp: TxExp{
+					err: app.ErrNotFound,
+				},
+			},
+			externalIDGet: ExternalIDGet{
+				exp: ExternalIDGetExp{
+					extID: nil,
+					err:   repo.ErrNoRecord,
+				},
+			},
+			exp: Exp{
+				series: nil,
+				err:    app.ErrNotFound,
+			},
+		},
+
+		{
+			name: "ExternalIDGet error",
+			tx: Tx{
+				exp: TxExp{
+					err: expExternalIDGetError,
+				},
+			},
+			externalIDGet: ExternalIDGet{
+				exp: ExternalIDGetExp{
+					extID: nil,
+					err:   expExternalIDGetError,
+				},
+			},
+			exp: Exp{
+				series: nil,
+				err:    expExternalIDGetError,
+			},
+		},
+
+		{
+			name: "external id of a film",
+			tx: Tx{
+				exp: TxExp{
+					err: app.ErrNotFound,
+				},
+			},
+			externalIDGet: ExternalIDGet{
+				exp: ExternalIDGetExp{
+					extID: &models.ExternalID{FilmID: null.IntFrom(1)},
+					err:   nil,
+				},
+			},
+			exp: Exp{
+				series: nil,
+				err:    app.ErrNotFound,
+			},
+		},
+
+		{
+			name: "SeriesGet error",
+			tx: Tx{
+				exp: TxExp{
+					err: expSeriesGetError,
+				},
+			},
+			externalIDGet: ExternalIDGet{
+				exp: ExternalIDGetExp{
+					extID: &models.ExternalID{SeriesID: null.IntFrom(seriesID)},
+					err:   nil,
+				},
+			},
+			seriesGet: SeriesGet{
+				call: true,
+				exp: SeriesGetExp{
+					series: nil,
+					err:    expSeriesGetError,
+				},
+			},
+			exp: Exp{
+				series: nil,
+				err:    expSeriesGetError,
+			},
+		},
+
+		{
+			name: "ok",
+			tx: Tx{
+				exp: TxExp{
+					err: nil,
+				},
+			},
+			externalIDGet: ExternalIDGet{
+				exp: ExternalIDGetExp{
+					extID: &models.ExternalID{SeriesID: null.IntFrom(seriesID)},
+					err:   nil,
+				},
+			},
+			seriesGet: SeriesGet{
+				call: true,
+				exp: SeriesGetExp{
+					series: expSeries,
+					err:    nil,
+				},
+			},
+			exp: Exp{
+				series: expSeries,
+				err:    nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			txCall := mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				Do(func(ctx context.Context, opts *sql.TxOptions, fn func(_ context.Context, _ repo.Service) error) {
+					fn(ctx, mockRepo)
+				}).
+				Return(tc.tx.exp.err)
+
+			externalIDGetCall := mockRepo.EXPECT().
+				SeriesExternalIDGet(ctx, provider, externalID).
+				Return(tc.externalIDGet.exp.extID, tc.externalIDGet.exp.err).
+				After(txCall)
+
+			if tc.seriesGet.call {
+				mockRepo.EXPECT().
+					SeriesGet(ctx, seriesID).
+					Return(tc.seriesGet.exp.series, tc.seriesGet.exp.err).
+					After(externalIDGetCall)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			series, err := app.SeriesGetByExternalID(ctx, provider, externalID)
+			require.Equal(tc.exp.err, err)
+			require.Equal(tc.exp.series, series)
+		})
+	}
+}
@@ -0,0 +1,424 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/catalog"
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/volatiletech/null/v8"
+)
+
+const (
+	ImportJobStatusPending   = "pending"
+	ImportJobStatusRunning   = "running"
+	ImportJobStatusSucceeded = "succeeded"
+	ImportJobStatusFailed    = "failed"
+)
+
+func (app *Application) ImportCreate(
+	ctx context.Context,
+	userID int,
+	format string,
+	dryRun bool,
+	file io.Reader,
+) (jobID int, err error) {
+	// decode the whole file before accepting the job
+	rows, err := catalog.Decode(file, format)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidImportFile, err)
+	}
+	if len(rows) == 0 {
+		return 0, fmt.Errorf("%w: no rows", ErrInvalidImportFile)
+	}
+	if len(rows) > config.Config.Import.MaxRows {
+		return 0, fmt.Errorf(
+			"%w: rows exceed the limit of %d",
+			ErrInvalidImportFile,
+			config.Config.Import.MaxRows,
+		)
+	}
+
+	job := &models.ImportJob{
+		UserID:    userID,
+		Format:    format,
+		DryRun:    dryRun,
+		Status:    ImportJobStatusPending,
+		TotalRows: len(rows),
+	}
+	err = app.repo.ImportJobCreate(ctx, job)
+	if err != nil {
+		return 0, err
+	}
+
+	// run the job detached from the request context: the job reports its own
+	// failure by its status and is only canceled by shutting down the server
+	app.importJobs.Add(1)
+	go func() {
+		defer app.importJobs.Done()
+		importJobRun(app.importCtx, app.repo, job, rows)
+	}()
+
+	return job.ID, nil
+}
+
+func (app *Application) ImportJobGet(
+	ctx context.Context,
+	id int,
+	userID int,
+) (*models.ImportJob, error) {
+	job, err := app.repo.ImportJobGet(ctx, id)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	// users only see their own jobs
+	if job.UserID != userID {
+		return nil, ErrNotFound
+	}
+	return job, nil
+}
+
+func (app *Application) ImportErrorsGetAll(
+	ctx context.Context,
+	id int,
+	userID int,
+	queryOptions query.SortOrderOptions,
+) (importErrors []*models.ImportError, total int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the job exists
+			job, err := tx.ImportJobGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			if job.UserID != userID {
+				return ErrNotFound
+			}
+			// fetch errors
+			importErrors, err = tx.ImportErrorsGetAll(ctx, id, queryOptions)
+			if err != nil {
+				return err
+			}
+			// count total errors
+			total, err = tx.ImportErrorsCount(ctx, id)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return importErrors, total, nil
+}
+
+// ImportJobsWait waits for the running import jobs to finish. if ctx is done
+// first the jobs are canceled and waited for again: a canceled job rolls back
+// its current batch and is marked failed.
+func (app *Application) ImportJobsWait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		app.importJobs.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		app.importCancel()
+		<-done
+		return ctx.Err()
+	}
+}
+
+// ImportJobsFailUnfinished marks the jobs left pending or running by a previous
+// run of the server as failed: they were interrupted and will never finish.
+func (app *Application) ImportJobsFailUnfinished(
+	ctx context.Context,
+) (failed int, err error) {
+	return app.repo.ImportJobsUpdateAllByStatus(
+		ctx,
+		[]string{ImportJobStatusPending, ImportJobStatusRunning},
+		map[string]any{
+			models.ImportJobColumns.Status:     ImportJobStatusFailed,
+			models.ImportJobColumns.FinishedAt: null.TimeFrom(time.Now()),
+		},
+	)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// importJobRun validates all the rows and then, if the job is not a dry-run
+// and all the rows are valid, applies them in batched transactions. the import
+// is not atomic: if a batch fails the batches before it stay committed and the
+// processed rows of the job count the rows applied. a panic while running
+// fails the job instead of crashing the server as the job runs detached.
+func importJobRun(
+	ctx context.Context,
+	r repo.ServiceTx,
+	job *models.ImportJob,
+	rows []*catalog.Row,
+) (err error) {
+	// finish the job: not with ctx as a canceled job must still be finished
+	defer func() {
+		status := ImportJobStatusSucceeded
+		if err != nil {
+			status = ImportJobStatusFailed
+		}
+		finishErr := r.ImportJobUpdate(context.Background(), job.ID, map[string]any{
+			models.ImportJobColumns.Status:     status,
+			models.ImportJobColumns.FinishedAt: null.TimeFrom(time.Now()),
+		})
+		if err == nil {
+			err = finishErr
+		}
+	}()
+	// runs before finishing the job so the job is marked failed
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("%w: %v", ErrImportJobPanicked, p)
+		}
+	}()
+
+	err = r.ImportJobUpdate(ctx, job.ID, map[string]any{
+		models.ImportJobColumns.Status: ImportJobStatusRunning,
+	})
+	if err != nil {
+		return err
+	}
+
+	// validate rows
+	importErrors, err := importRowsValidate(ctx, r, rows)
+	if err != nil {
+		return err
+	}
+	for _, ie := range importErrors {
+		ie.JobID = job.ID
+		if err := r.ImportErrorCreate(ctx, ie); err != nil {
+			return err
+		}
+	}
+	cols := map[string]any{
+		models.ImportJobColumns.FailedRows: len(importErrors),
+	}
+	if job.DryRun {
+		cols[models.ImportJobColumns.ProcessedRows] = len(rows)
+	}
+	err = r.ImportJobUpdate(ctx, job.ID, cols)
+	if err != nil {
+		return err
+	}
+
+	// a dry-run only reports errors
+	if job.DryRun {
+		return nil
+	}
+	// do not apply anything if any row is invalid
+	if len(importErrors) > 0 {
+		return ErrInvalidImportRows
+	}
+
+	// apply rows in batches
+	// created series ids keyed by their row number
+	seriesIDs := make(map[int]int)
+	batchSize := config.Config.Import.BatchSize
+	for start := 0; start < len(rows); start += batchSize {
+		end := start + batchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+
+		var failedRow *catalog.Row
+		err = r.Tx(
+			ctx,
+			nil,
+			func(ctx context.Context, tx repo.Service) error {
+				for _, row := range rows[start:end] {
+					err := importRowApply(ctx, tx, job.UserID, row, seriesIDs)
+					if err != nil {
+						failedRow = row
+						return err
+					}
+				}
+				return nil
+			},
+		)
+		if err != nil {
+			// report the failed row: the whole batch is rolled back but the
+			// batches before it are kept
+			if failedRow != nil {
+				createErr := r.ImportErrorCreate(ctx, &models.ImportError{
+					JobID:     job.ID,
+					RowNumber: failedRow.Number,
+					Message:   err.Error(),
+				})
+				if createErr != nil {
+					return createErr
+				}
+				updateErr := r.ImportJobUpdate(ctx, job.ID, map[string]any{
+					models.ImportJobColumns.FailedRows: 1,
+				})
+				if updateErr != nil {
+					return updateErr
+				}
+			}
+			return err
+		}
+
+		// report progress
+		err = r.ImportJobUpdate(ctx, job.ID, map[string]any{
+			models.ImportJobColumns.ProcessedRows: end,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importRowsValidate returns an import error for each invalid row.
+func importRowsValidate(
+	ctx context.Context,
+	r repo.Service,
+	rows []*catalog.Row,
+) ([]*models.ImportError, error) {
+	var importErrors []*models.ImportError
+	rowError := func(row *catalog.Row, err error) {
+		importErrors = append(importErrors, &models.ImportError{
+			RowNumber: row.Number,
+			Message:   err.Error(),
+		})
+	}
+
+	// valid series rows and existence of referenced series
+	seriesRows := make(map[int]bool)
+	seriesExists := make(map[int]bool)
+
+	for _, row := range rows {
+		if row.Err != nil {
+			rowError(row, row.Err)
+			continue
+		}
+		if err := row.Data.Validate(); err != nil {
+			if internalErr, ok := err.(validation.InternalError); ok {
+				return nil, internalErr
+			}
+			rowError(row, err)
+			continue
+		}
+
+		switch row.Data.Kind {
+		case dto.ImportRowKindSeries:
+			seriesRows[row.Number] = true
+
+		case dto.ImportRowKindEpisode:
+			// series row must precede the episode
+			if row.Data.SeriesRow.Valid &&
+				!seriesRows[row.Data.SeriesRow.Int] {
+				rowError(row, validation.Errors{
+					"series_row": errors.New(
+						"must refer to a preceding valid series row",
+					),
+				})
+				continue
+			}
+			// series id must exist
+			if row.Data.SeriesID.Valid {
+				seriesID := row.Data.SeriesID.Int
+				exists, checked := seriesExists[seriesID]
+				if !checked {
+					_, err := r.SeriesGet(ctx, seriesID)
+					if err != nil && err != repo.ErrNoRecord {
+						return nil, err
+					}
+					exists = err == nil
+					seriesExists[seriesID] = exists
+				}
+				if !exists {
+					rowError(row, validation.Errors{
+						"series_id": errors.New("series not found"),
+					})
+					continue
+				}
+			}
+		}
+	}
+
+	return importErrors, nil
+}
+
+// importRowApply creates or puts the row attributed to the contributor. the
+// episodes are put through the same checks as the episodes put by the api
+func importRowApply(
+	ctx context.Context,
+	tx repo.Service,
+	contributorID int,
+	row *catalog.Row,
+	seriesIDs map[int]int,
+) error {
+	data := row.Data
+	switch data.Kind {
+	case dto.ImportRowKindMovie:
+		return tx.MovieCreate(ctx, contributorID, &models.Film{
+			Title:        data.Title,
+			Descriptions: data.Descriptions,
+			DateReleased: data.DateReleased,
+			Duration:     data.Duration,
+		})
+
+	case dto.ImportRowKindSeries:
+		series := &models.Series{
+			Title:        data.Title,
+			Descriptions: data.Descriptions,
+			DateStarted:  data.DateStarted,
+			DateEnded:    data.DateEnded,
+		}
+		if err := tx.SeriesCreate(ctx, contributorID, series); err != nil {
+			return err
+		}
+		seriesIDs[row.Number] = series.ID
+		return nil
+	}
+
+	// put episode
+	seriesID := data.SeriesID.Int
+	if data.SeriesRow.Valid {
+		seriesID = seriesIDs[data.SeriesRow.Int]
+	} else {
+		// putting episodes of an existing series edits it as the api does
+		if err := editReputationCheck(ctx, tx, contributorID); err != nil {
+			return err
+		}
+	}
+	return episodePut(
+		ctx,
+		tx,
+		seriesID,
+		data.SeasonNumber,
+		data.EpisodeNumber,
+		contributorID,
+		&dto.EpisodePutRequest{
+			Title:          data.Title,
+			Descriptions:   data.Descriptions,
+			DateReleased:   data.DateReleased,
+			Duration:       data.Duration,
+			AbsoluteNumber: data.AbsoluteNumber,
+			PartNumber:     data.PartNumber,
+		},
+	)
+}
@@ -0,0 +1,1157 @@
+package app_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	_ "unsafe"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/catalog"
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/contribution"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/repo/mock_repo"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestImportCreate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		userID = 1
+
+		expImportJobCreateError = errors.New("ImportJobCreate error")
+	)
+
+	type ImportJobCreateExp struct {
+		err error
+	}
+	type ImportJobCreate struct {
+		call bool
+		exp  ImportJobCreateExp
+	}
+	type Exp struct {
+		err error
+	}
+	type TestCase struct {
+		name            string
+		format          string
+		file            string
+		importJobCreate ImportJobCreate
+		exp             Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name:   "unsupported format",
+			format: "xml",
+			file:   "<movie/>",
+			exp: Exp{
+				err: app.ErrInvalidImportFile,
+			},
+		},
+
+		{
+			name:   "malformed file",
+			format: catalog.FormatCSV,
+			file:   "kind,unknown\n",
+			exp: Exp{
+				err: app.ErrInvalidImportFile,
+			},
+		},
+
+		{
+			name:   "no rows",
+			format: catalog.FormatNDJSON,
+			file:   "\n\n",
+			exp: Exp{
+				err: app.ErrInvalidImportFile,
+			},
+		},
+
+		{
+			name:   "too many rows",
+			format: catalog.FormatCSV,
+			file: "kind\n" + strings.Repeat(
+				"movie\n",
+				config.Config.Import.MaxRows+1,
+			),
+			exp: Exp{
+				err: app.ErrInvalidImportFile,
+			},
+		},
+
+		{
+			name:   "ImportJobCreate error",
+			format: catalog.FormatCSV,
+			file:   "kind,title\nmovie,movie\n",
+			importJobCreate: ImportJobCreate{
+				call: true,
+				exp: ImportJobCreateExp{
+					err: expImportJobCreateError,
+				},
+			},
+			exp: Exp{
+				err: expImportJobCreateError,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			if tc.importJobCreate.call {
+				mockRepo.EXPECT().
+					ImportJobCreate(ctx, &models.ImportJob{
+						UserID:    userID,
+						Format:    tc.format,
+						DryRun:    true,
+						Status:    app.ImportJobStatusPending,
+						TotalRows: 1,
+					}).
+					Return(tc.importJobCreate.exp.err)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			jobID, err := app.ImportCreate(
+				ctx,
+				userID,
+				tc.format,
+				true,
+				strings.NewReader(tc.file),
+			)
+			require.ErrorIs(err, tc.exp.err)
+			require.Equal(0, jobID)
+		})
+	}
+}
+
+func TestImportJobsWait(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	var (
+		ctx = context.Background()
+
+		userID = 1
+		jobID  = 2
+	)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockServiceTx(controller)
+
+	mockRepo.EXPECT().
+		ImportJobCreate(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, job *models.ImportJob) error {
+			job.ID = jobID
+			return nil
+		})
+	// the job runs until it is canceled
+	mockRepo.EXPECT().
+		ImportJobUpdate(gomock.Any(), jobID, map[string]any{
+			models.ImportJobColumns.Status: app.ImportJobStatusRunning,
+		}).
+		DoAndReturn(
+			func(ctx context.Context, id int, cols map[string]any) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+		)
+	// the canceled job is still finished
+	mockRepo.EXPECT().
+		ImportJobUpdate(context.Background(), jobID, gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, id int, cols map[string]any) error {
+				require.Equal(
+					app.ImportJobStatusFailed,
+					cols[models.ImportJobColumns.Status],
+				)
+				return nil
+			},
+		)
+
+	application := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	createdJobID, err := application.ImportCreate(
+		ctx,
+		userID,
+		catalog.FormatCSV,
+		false,
+		strings.NewReader("kind,title\nmovie,movie\n"),
+	)
+	require.NoError(err)
+	require.Equal(jobID, createdJobID)
+
+	// the job does not finish in time: it is canceled
+	waitCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	err = application.ImportJobsWait(waitCtx)
+	require.ErrorIs(err, context.Canceled)
+
+	// there's no job left
+	err = application.ImportJobsWait(ctx)
+	require.NoError(err)
+}
+
+func TestImportJobsFailUnfinished(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	var (
+		ctx = context.Background()
+
+		expError = errors.New("ImportJobsUpdateAllByStatus error")
+	)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockServiceTx(controller)
+
+	statuses := []string{app.ImportJobStatusPending, app.ImportJobStatusRunning}
+	gomock.InOrder(
+		mockRepo.EXPECT().
+			ImportJobsUpdateAllByStatus(ctx, statuses, gomock.Any()).
+			DoAndReturn(
+				func(
+					ctx context.Context,
+					statuses []string,
+					cols map[string]any,
+				) (int, error) {
+					require.Equal(
+						app.ImportJobStatusFailed,
+						cols[models.ImportJobColumns.Status],
+					)
+					finishedAt := cols[models.ImportJobColumns.FinishedAt]
+					require.True(finishedAt.(null.Time).Valid)
+					return 2, nil
+				},
+			),
+		mockRepo.EXPECT().
+			ImportJobsUpdateAllByStatus(ctx, statuses, gomock.Any()).
+			Return(0, expError),
+	)
+
+	application := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	failed, err := application.ImportJobsFailUnfinished(ctx)
+	require.NoError(err)
+	require.Equal(2, failed)
+
+	failed, err = application.ImportJobsFailUnfinished(ctx)
+	require.Equal(expError, err)
+	require.Equal(0, failed)
+}
+
+func TestImportJobGet(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		id     = 1
+		userID = 1
+
+		expImportJobGetError = errors.New("ImportJobGet error")
+	)
+
+	type ImportJobGetExp struct {
+		job *models.ImportJob
+		err error
+	}
+	type ImportJobGet struct {
+		exp ImportJobGetExp
+	}
+	type Exp struct {
+		job *models.ImportJob
+		err error
+	}
+	type TestCase struct {
+		name         string
+		importJobGet ImportJobGet
+		exp          Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name: "not found",
+			importJobGet: ImportJobGet{
+				exp: ImportJobGetExp{
+					job: nil,
+					err: repo.ErrNoRecord,
+				},
+			},
+			exp: Exp{
+				job: nil,
+				err: app.ErrNotFound,
+			},
+		},
+
+		{
+			name: "ImportJobGet error",
+			importJobGet: ImportJobGet{
+				exp: ImportJobGetExp{
+					job: nil,
+					err: expImportJobGetError,
+				},
+			},
+			exp: Exp{
+				job: nil,
+				err: expImportJobGetError,
+			},
+		},
+
+		{
+			name: "job of another user",
+			importJobGet: ImportJobGet{
+				exp: ImportJobGetExp{
+					job: &models.ImportJob{ID: id, UserID: userID + 1},
+					err: nil,
+				},
+			},
+			exp: Exp{
+				job: nil,
+				err: app.ErrNotFound,
+			},
+		},
+
+		{
+			name: "ok",
+			importJobGet: ImportJobGet{
+				exp: ImportJobGetExp{
+					job: &models.ImportJob{ID: id, UserID: userID},
+					err: nil,
+				},
+			},
+			exp: Exp{
+				job: &models.ImportJob{ID: id, UserID: userID},
+				err: nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				ImportJobGet(ctx, id).
+				Return(tc.importJobGet.exp.job, tc.importJobGet.exp.err)
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			job, err := app.ImportJobGet(ctx, id, userID)
+			require.Equal(tc.exp.err, err)
+			require.Equal(tc.exp.job, job)
+		})
+	}
+}
+
+func TestImportErrorsGetAll(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		id           = 1
+		userID       = 1
+		queryOptions = query.SortOrderOptions{
+			Offset:    0,
+			Limit:     math.MaxInt,
+			SortOrder: "asc",
+		}
+
+		expJob                     = &models.ImportJob{ID: id, UserID: userID}
+		expImportErrorsGetAllError = errors.New("ImportErrorsGetAll error")
+		expImportErrorsCountError  = errors.New("ImportErrorsCount error")
+		expImportErrors            = []*models.ImportError{
+			{ID: 1, JobID: id, RowNumber: 1, Message: "title: cannot be blank."},
+		}
+	)
+
+	type TxExp struct {
+		err error
+	}
+	type Tx struct {
+		exp TxExp
+	}
+	type ImportJobGetExp struct {
+		job *models.ImportJob
+		err error
+	}
+	type ImportJobGet struct {
+		exp ImportJobGetExp
+	}
+	type GetAllExp struct {
+		importErrors []*models.ImportError
+		err          error
+	}
+	type GetAll struct {
+		call bool
+		exp  GetAllExp
+	}
+	type CountExp struct {
+		total int
+		err   error
+	}
+	type Count struct {
+		call bool
+		exp  CountExp
+	}
+	type Exp struct {
+		importErrors []*models.ImportError
+		total        int
+		err          error
+	}
+	type TestCase struct {
+		name         string
+		tx           Tx
+		importJobGet ImportJobGet
+		getAll       GetAll
+		count        Count
+		exp          Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name: "not found",
+			tx: Tx{
+				exp: TxExp{
+					err: app.ErrNotFound,
+				},
+			},
+			importJobGet: ImportJobGet{
+				exp: ImportJobGetExp{
+					job: nil,
+					err: repo.ErrNoRecord,
+				},
+			},
+			exp: Exp{
+				err: app.ErrNotFound,
+			},
+		},
+
+		{
+			name: "job of another user",
+			tx: Tx{
+				exp: TxExp{
+					err: app.ErrNotFound,
+				},
+			},
+			importJobGet: ImportJobGet{
+				exp: ImportJobGetExp{
+					job: &models.ImportJob{ID: id, UserID: userID + 1},
+					err: nil,
+				},
+			},
+			exp: Exp{
+				err: app.ErrNotFound,
+			},
+		},
+
+		{
+			name: "ImportErrorsGetAll error",
+			tx: Tx{
+				exp: TxExp{
+					err: expImportErrorsGetAllError,
+				},
+			},
+			importJobGet: ImportJobGet{
+				exp: ImportJobGetExp{
+					job: expJob,
+					err: nil,
+				},
+			},
+			getAll: GetAll{
+				call: true,
+				exp: GetAllExp{
+					importErrors: nil,
+					err:          expImportErrorsGetAllError,
+				},
+			},
+			exp: Exp{
+				err: expImportErrorsGetAllError,
+			},
+		},
+
+		{
+			name: "ImportErrorsCount error",
+			tx: Tx{
+				exp: TxExp{
+					err: expImportErrorsCountError,
+				},
+			},
+			importJobGet: ImportJobGet{
+				exp: ImportJobGetExp{
+					job: expJob,
+					err: nil,
+				},
+			},
+			getAll: GetAll{
+				call: true,
+				exp: GetAllExp{
+					importErrors: expImportErrors,
+					err:          nil,
+				},
+			},
+			count: Count{
+				call: true,
+				exp: CountExp{
+					total: 0,
+					err:   expImportErrorsCountError,
+				},
+			},
+			exp: Exp{
+				err: expImportErrorsCountError,
+			},
+		},
+
+		{
+			name: "ok",
+			tx: Tx{
+				exp: TxExp{
+					err: nil,
+				},
+			},
+			importJobGet: ImportJobGet{
+				exp: ImportJobGetExp{
+					job: expJob,
+					err: nil,
+				},
+			},
+			getAll: GetAll{
+				call: true,
+				exp: GetAllExp{
+					importErrors: expImportErrors,
+					err:          nil,
+				},
+			},
+			count: Count{
+				call: true,
+				exp: CountExp{
+					total: len(expImportErrors),
+					err:   nil,
+				},
+			},
+			exp: Exp{
+				importErrors: expImportErrors,
+				total:        len(expImportErrors),
+				err:          nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			txCall := mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				Do(func(ctx context.Context, opts *sql.TxOptions, fn func(_ context.Context, _ repo.Service) error) {
+					fn(ctx, mockRepo)
+				}).
+				Return(tc.tx.exp.err)
+
+			jobGetCall := mockRepo.EXPECT().
+				ImportJobGet(ctx, id).
+				Return(tc.importJobGet.exp.job, tc.importJobGet.exp.err).
+				After(txCall)
+
+			if tc.getAll.call {
+				getAllCall := mockRepo.EXPECT().
+					ImportErrorsGetAll(ctx, id, queryOptions).
+					Return(tc.getAll.exp.importErrors, tc.getAll.exp.err).
+					After(jobGetCall)
+
+				if tc.count.call {
+					mockRepo.EXPECT().
+						ImportErrorsCount(ctx, id).
+						Return(tc.count.exp.total, tc.count.exp.err).
+						After(getAllCall)
+				}
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			importErrors, total, err := app.ImportErrorsGetAll(
+				ctx,
+				id,
+				userID,
+				queryOptions,
+			)
+			require.Equal(tc.exp.err, err)
+			require.Equal(tc.exp.importErrors, importErrors)
+			require.Equal(tc.exp.total, total)
+		})
+	}
+}
+
+func TestImportJobRun(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		userID   = 1
+		jobID    = 1
+		seriesID = 7
+
+		expSeriesGetError   = errors.New("SeriesGet error")
+		expMovieCreateError = errors.New("MovieCreate error")
+
+		validRows = func() []*catalog.Row {
+			return []*catalog.Row{
+				{
+					Number: 1,
+					Data: &dto.ImportRow{
+						Kind:        dto.ImportRowKindSeries,
+						Title:       "series",
+						DateStarted: testutils.Date(2000, 1, 1),
+					},
+				},
+				{
+					Number: 2,
+					Data: &dto.ImportRow{
+						Kind:          dto.ImportRowKindEpisode,
+						Title:         "episode",
+						DateReleased:  testutils.Date(2000, 1, 1),
+						SeriesRow:     null.IntFrom(1),
+						SeasonNumber:  1,
+						EpisodeNumber: 1,
+					},
+				},
+				{
+					Number: 3,
+					Data: &dto.ImportRow{
+						Kind:         dto.ImportRowKindMovie,
+						Title:        "movie",
+						DateReleased: testutils.Date(2000, 1, 1),
+					},
+				},
+			}
+		}
+		invalidRows = func() []*catalog.Row {
+			return []*catalog.Row{
+				{
+					Number: 1,
+					Data:   &dto.ImportRow{},
+					Err:    errors.New("record on line 2: wrong number of fields"),
+				},
+				{
+					Number: 2,
+					Data: &dto.ImportRow{
+						Kind:         dto.ImportRowKindMovie,
+						DateReleased: testutils.Date(2000, 1, 1),
+					},
+				},
+				{
+					Number: 3,
+					Data: &dto.ImportRow{
+						Kind:          dto.ImportRowKindEpisode,
+						Title:         "episode",
+						DateReleased:  testutils.Date(2000, 1, 1),
+						SeriesRow:     null.IntFrom(2),
+						SeasonNumber:  1,
+						EpisodeNumber: 1,
+					},
+				},
+				{
+					Number: 4,
+					Data: &dto.ImportRow{
+						Kind:          dto.ImportRowKindEpisode,
+						Title:         "episode",
+						DateReleased:  testutils.Date(2000, 1, 1),
+						SeriesID:      null.IntFrom(seriesID),
+						SeasonNumber:  1,
+						EpisodeNumber: 1,
+					},
+				},
+			}
+		}
+		invalidRowsErrors = []*models.ImportError{
+			{
+				JobID:     jobID,
+				RowNumber: 1,
+				Message:   "record on line 2: wrong number of fields",
+			},
+			{
+				JobID:     jobID,
+				RowNumber: 2,
+				Message:   "title: cannot be blank.",
+			},
+			{
+				JobID:     jobID,
+				RowNumber: 3,
+				Message:   "series_row: must refer to a preceding valid series row.",
+			},
+			{
+				JobID:     jobID,
+				RowNumber: 4,
+				Message:   "series_id: series not found.",
+			},
+		}
+	)
+
+	expectRunning := func(mockRepo *mock_repo.MockServiceTx) *gomock.Call {
+		return mockRepo.EXPECT().
+			ImportJobUpdate(ctx, jobID, map[string]any{
+				models.ImportJobColumns.Status: app.ImportJobStatusRunning,
+			}).
+			Return(nil)
+	}
+	expectFinished := func(
+		t *testing.T,
+		mockRepo *mock_repo.MockServiceTx,
+		status string,
+	) *gomock.Call {
+		return mockRepo.EXPECT().
+			ImportJobUpdate(ctx, jobID, gomock.Any()).
+			Do(func(_ context.Context, _ int, cols map[string]any) {
+				require.Equal(t, status, cols[models.ImportJobColumns.Status])
+				require.True(
+					t,
+					cols[models.ImportJobColumns.FinishedAt].(null.Time).Valid,
+				)
+			}).
+			Return(nil)
+	}
+
+	t.Run("dry-run reports errors", func(t *testing.T) {
+		t.Parallel()
+		require := require.New(t)
+
+		controller := gomock.NewController(t)
+		mockRepo := mock_repo.NewMockServiceTx(controller)
+
+		calls := []*gomock.Call{
+			expectRunning(mockRepo),
+			mockRepo.EXPECT().
+				SeriesGet(ctx, seriesID).
+				Return(nil, repo.ErrNoRecord),
+		}
+		for _, ie := range invalidRowsErrors {
+			calls = append(
+				calls,
+				mockRepo.EXPECT().ImportErrorCreate(ctx, ie).Return(nil),
+			)
+		}
+		calls = append(
+			calls,
+			mockRepo.EXPECT().
+				ImportJobUpdate(ctx, jobID, map[string]any{
+					models.ImportJobColumns.FailedRows:    len(invalidRowsErrors),
+					models.ImportJobColumns.ProcessedRows: len(invalidRowsErrors),
+				}).
+				Return(nil),
+			expectFinished(t, mockRepo, app.ImportJobStatusSucceeded),
+		)
+		gomock.InOrder(calls...)
+
+		err := importJobRun(
+			ctx,
+			mockRepo,
+			&models.ImportJob{ID: jobID, UserID: userID, DryRun: true},
+			invalidRows(),
+		)
+		require.NoError(err)
+	})
+
+	t.Run("invalid rows are not applied", func(t *testing.T) {
+		t.Parallel()
+		require := require.New(t)
+
+		controller := gomock.NewController(t)
+		mockRepo := mock_repo.NewMockServiceTx(controller)
+
+		calls := []*gomock.Call{
+			expectRunning(mockRepo),
+			mockRepo.EXPECT().
+				SeriesGet(ctx, seriesID).
+				Return(nil, repo.ErrNoRecord),
+		}
+		for _, ie := range invalidRowsErrors {
+			calls = append(
+				calls,
+				mockRepo.EXPECT().ImportErrorCreate(ctx, ie).Return(nil),
+			)
+		}
+		calls = append(
+			calls,
+			mockRepo.EXPECT().
+				ImportJobUpdate(ctx, jobID, map[string]any{
+					models.ImportJobColumns.FailedRows: len(invalidRowsErrors),
+				}).
+				Return(nil),
+			expectFinished(t, mockRepo, app.ImportJobStatusFailed),
+		)
+		gomock.InOrder(calls...)
+
+		err := importJobRun(
+			ctx,
+			mockRepo,
+			&models.ImportJob{ID: jobID, UserID: userID},
+			invalidRows(),
+		)
+		require.Equal(app.ErrInvalidImportRows, err)
+	})
+
+	t.Run("SeriesGet error", func(t *testing.T) {
+		t.Parallel()
+		require := require.New(t)
+
+		controller := gomock.NewController(t)
+		mockRepo := mock_repo.NewMockServiceTx(controller)
+
+		gomock.InOrder(
+			expectRunning(mockRepo),
+			mockRepo.EXPECT().
+				SeriesGet(ctx, seriesID).
+				Return(nil, expSeriesGetError),
+			expectFinished(t, mockRepo, app.ImportJobStatusFailed),
+		)
+
+		err := importJobRun(
+			ctx,
+			mockRepo,
+			&models.ImportJob{ID: jobID, UserID: userID},
+			invalidRows(),
+		)
+		require.Equal(expSeriesGetError, err)
+	})
+
+	t.Run("apply rows", func(t *testing.T) {
+		t.Parallel()
+		require := require.New(t)
+
+		controller := gomock.NewController(t)
+		mockRepo := mock_repo.NewMockServiceTx(controller)
+
+		rows := validRows()
+
+		gomock.InOrder(
+			expectRunning(mockRepo),
+			mockRepo.EXPECT().
+				ImportJobUpdate(ctx, jobID, map[string]any{
+					models.ImportJobColumns.FailedRows: 0,
+				}).
+				Return(nil),
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(_ context.Context, _ repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				}),
+			mockRepo.EXPECT().
+				SeriesCreate(ctx, userID, &models.Series{
+					Title:       rows[0].Data.Title,
+					DateStarted: rows[0].Data.DateStarted,
+				}).
+				Do(func(_ context.Context, _ int, series *models.Series) {
+					series.ID = seriesID
+				}).
+				Return(nil),
+			mockRepo.EXPECT().
+				EpisodePut(ctx, seriesID, 1, 1, userID, &models.Film{
+					Title:        rows[1].Data.Title,
+					DateReleased: rows[1].Data.DateReleased,
+				}).
+				Return(nil),
+			mockRepo.EXPECT().
+				MovieCreate(ctx, userID, &models.Film{
+					Title:        rows[2].Data.Title,
+					DateReleased: rows[2].Data.DateReleased,
+				}).
+				Return(nil),
+			mockRepo.EXPECT().
+				ImportJobUpdate(ctx, jobID, map[string]any{
+					models.ImportJobColumns.ProcessedRows: len(rows),
+				}).
+				Return(nil),
+			expectFinished(t, mockRepo, app.ImportJobStatusSucceeded),
+		)
+
+		err := importJobRun(
+			ctx,
+			mockRepo,
+			&models.ImportJob{ID: jobID, UserID: userID},
+			rows,
+		)
+		require.NoError(err)
+	})
+
+	t.Run("apply error", func(t *testing.T) {
+		t.Parallel()
+		require := require.New(t)
+
+		controller := gomock.NewController(t)
+		mockRepo := mock_repo.NewMockServiceTx(controller)
+
+		rows := validRows()
+
+		gomock.InOrder(
+			expectRunning(mockRepo),
+			mockRepo.EXPECT().
+				ImportJobUpdate(ctx, jobID, map[string]any{
+					models.ImportJobColumns.FailedRows: 0,
+				}).
+				Return(nil),
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(_ context.Context, _ repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				}),
+			mockRepo.EXPECT().
+				SeriesCreate(ctx, userID, gomock.Any()).
+				Return(nil),
+			mockRepo.EXPECT().
+				EpisodePut(ctx, 0, 1, 1, userID, gomock.Any()).
+				Return(nil),
+			mockRepo.EXPECT().
+				MovieCreate(ctx, userID, gomock.Any()).
+				Return(expMovieCreateError),
+			mockRepo.EXPECT().
+				ImportErrorCreate(ctx, &models.ImportError{
+					JobID:     jobID,
+					RowNumber: rows[2].Number,
+					Message:   expMovieCreateError.Error(),
+				}).
+				Return(nil),
+			mockRepo.EXPECT().
+				ImportJobUpdate(ctx, jobID, map[string]any{
+					models.ImportJobColumns.FailedRows: 1,
+				}).
+				Return(nil),
+			expectFinished(t, mockRepo, app.ImportJobStatusFailed),
+		)
+
+		err := importJobRun(
+			ctx,
+			mockRepo,
+			&models.ImportJob{ID: jobID, UserID: userID},
+			rows,
+		)
+		require.Equal(expMovieCreateError, err)
+	})
+
+	t.Run("apply used absolute number", func(t *testing.T) {
+		t.Parallel()
+		require := require.New(t)
+
+		controller := gomock.NewController(t)
+		mockRepo := mock_repo.NewMockServiceTx(controller)
+
+		rows := validRows()
+		rows[1].Data.AbsoluteNumber = null.IntFrom(5)
+
+		gomock.InOrder(
+			expectRunning(mockRepo),
+			mockRepo.EXPECT().
+				ImportJobUpdate(ctx, jobID, map[string]any{
+					models.ImportJobColumns.FailedRows: 0,
+				}).
+				Return(nil),
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(_ context.Context, _ repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				}),
+			mockRepo.EXPECT().
+				SeriesCreate(ctx, userID, gomock.Any()).
+				Do(func(_ context.Context, _ int, series *models.Series) {
+					series.ID = seriesID
+				}).
+				Return(nil),
+			// the absolute number is used by another episode
+			mockRepo.EXPECT().
+				EpisodeGetByAbsoluteNumber(ctx, seriesID, 5).
+				Return(&models.Film{
+					SeasonNumber:  null.IntFrom(2),
+					EpisodeNumber: null.IntFrom(1),
+				}, nil),
+			mockRepo.EXPECT().
+				ImportErrorCreate(ctx, &models.ImportError{
+					JobID:     jobID,
+					RowNumber: rows[1].Number,
+					Message:   app.ErrUsedEpisodeNumber.Error(),
+				}).
+				Return(nil),
+			mockRepo.EXPECT().
+				ImportJobUpdate(ctx, jobID, map[string]any{
+					models.ImportJobColumns.FailedRows: 1,
+				}).
+				Return(nil),
+			expectFinished(t, mockRepo, app.ImportJobStatusFailed),
+		)
+
+		err := importJobRun(
+			ctx,
+			mockRepo,
+			&models.ImportJob{ID: jobID, UserID: userID},
+			rows,
+		)
+		require.Equal(app.ErrUsedEpisodeNumber, err)
+	})
+
+	t.Run("apply panic", func(t *testing.T) {
+		t.Parallel()
+		require := require.New(t)
+
+		controller := gomock.NewController(t)
+		mockRepo := mock_repo.NewMockServiceTx(controller)
+
+		rows := validRows()
+
+		gomock.InOrder(
+			expectRunning(mockRepo),
+			mockRepo.EXPECT().
+				ImportJobUpdate(ctx, jobID, map[string]any{
+					models.ImportJobColumns.FailedRows: 0,
+				}).
+				Return(nil),
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(_ context.Context, _ repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				}),
+			mockRepo.EXPECT().
+				SeriesCreate(ctx, userID, gomock.Any()).
+				Do(func(context.Context, int, *models.Series) {
+					panic("SeriesCreate panic")
+				}),
+			expectFinished(t, mockRepo, app.ImportJobStatusFailed),
+		)
+
+		err := importJobRun(
+			ctx,
+			mockRepo,
+			&models.ImportJob{ID: jobID, UserID: userID},
+			rows,
+		)
+		require.ErrorIs(err, app.ErrImportJobPanicked)
+	})
+}
+
+// TestImportJobRunEditReputationGate is not parallel as it changes the
+// configured minimum score to edit
+func TestImportJobRunEditReputationGate(t *testing.T) {
+	minEditScore := config.Config.Reputation.MinEditScore
+	config.Config.Reputation.MinEditScore = 10
+	t.Cleanup(func() {
+		config.Config.Reputation.MinEditScore = minEditScore
+	})
+
+	require := require.New(t)
+
+	var (
+		ctx = context.Background()
+
+		userID   = 1
+		jobID    = 1
+		seriesID = 7
+
+		// putting episodes of an existing series edits it
+		rows = []*catalog.Row{
+			{
+				Number: 1,
+				Data: &dto.ImportRow{
+					Kind:          dto.ImportRowKindEpisode,
+					Title:         "episode",
+					DateReleased:  testutils.Date(2000, 1, 1),
+					SeriesID:      null.IntFrom(seriesID),
+					SeasonNumber:  1,
+					EpisodeNumber: 1,
+				},
+			},
+		}
+	)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockServiceTx(controller)
+
+	gomock.InOrder(
+		mockRepo.EXPECT().
+			ImportJobUpdate(ctx, jobID, map[string]any{
+				models.ImportJobColumns.Status: app.ImportJobStatusRunning,
+			}).
+			Return(nil),
+		mockRepo.EXPECT().
+			SeriesGet(ctx, seriesID).
+			Return(&models.Series{ID: seriesID}, nil),
+		mockRepo.EXPECT().
+			ImportJobUpdate(ctx, jobID, map[string]any{
+				models.ImportJobColumns.FailedRows: 0,
+			}).
+			Return(nil),
+		mockRepo.EXPECT().
+			Tx(ctx, nil, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(_ context.Context, _ repo.Service) error) error {
+				return fn(ctx, mockRepo)
+			}),
+		mockRepo.EXPECT().
+			ContributorStatsGet(ctx, userID, reputationOptions).
+			Return(&contribution.Stats{UserID: userID, Score: 9}, nil),
+		mockRepo.EXPECT().
+			ImportErrorCreate(ctx, &models.ImportError{
+				JobID:     jobID,
+				RowNumber: rows[0].Number,
+				Message:   app.ErrLowReputation.Error(),
+			}).
+			Return(nil),
+		mockRepo.EXPECT().
+			ImportJobUpdate(ctx, jobID, map[string]any{
+				models.ImportJobColumns.FailedRows: 1,
+			}).
+			Return(nil),
+		mockRepo.EXPECT().
+			ImportJobUpdate(ctx, jobID, gomock.Any()).
+			Do(func(_ context.Context, _ int, cols map[string]any) {
+				require.Equal(
+					app.ImportJobStatusFailed,
+					cols[models.ImportJobColumns.Status],
+				)
+			}).
+			Return(nil),
+	)
+
+	err := importJobRun(
+		ctx,
+		mockRepo,
+		&models.ImportJob{ID: jobID, UserID: userID},
+		rows,
+	)
+	require.Equal(app.ErrLowReputation, err)
+}
+
+//go:linkname importJobRun github.com/aria3ppp/watchlist-server/internal/app.importJobRun
+func importJobRun(
+	ctx context.Context,
+	r repo.ServiceTx,
+	job *models.ImportJob,
+	rows []*catalog.Row,
+) error
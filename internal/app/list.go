@@ -0,0 +1,889 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/hasher"
+	"github.com/aria3ppp/watchlist-server/internal/list"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
+)
+
+// ListGet returns the list if visible to the user
+func (app *Application) ListGet(
+	ctx context.Context,
+	userID int,
+	listID int,
+) (*models.List, error) {
+	return listGetVisible(ctx, app.repo, userID, listID)
+}
+
+// ListsGetAllByUser returns the lists of the user of userID: the private lists
+// are returned to their owner only
+func (app *Application) ListsGetAllByUser(
+	ctx context.Context,
+	viewerID int,
+	userID int,
+	queryOptions query.SortOrderOptions,
+) (lists []*models.List, total int, err error) {
+	publicOnly := viewerID != userID
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			var err error
+			lists, err = tx.ListsGetAllByUser(
+				ctx,
+				userID,
+				publicOnly,
+				queryOptions,
+			)
+			if err != nil {
+				return err
+			}
+			total, err = tx.ListsCountByUser(ctx, userID, publicOnly)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return lists, total, nil
+}
+
+// ListCreate creates the list owned by the user
+func (app *Application) ListCreate(
+	ctx context.Context,
+	userID int,
+	req *dto.ListCreateRequest,
+) (listID int, err error) {
+	visibility := req.Visibility
+	if visibility == "" {
+		visibility = list.VisibilityPrivate
+	}
+	insertList := &models.List{
+		Title:        req.Title,
+		Descriptions: req.Descriptions,
+		Visibility:   visibility,
+	}
+
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			err := tx.ListCreate(ctx, userID, insertList)
+			if err != nil {
+				return err
+			}
+			return tx.ListActivityCreate(ctx, &models.ListActivity{
+				ListID: insertList.ID,
+				UserID: null.IntFrom(userID),
+				Action: list.ActivityCreate,
+			})
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return insertList.ID, nil
+}
+
+// ListUpdate updates the list if the user is its owner
+func (app *Application) ListUpdate(
+	ctx context.Context,
+	userID int,
+	listID int,
+	req *dto.ListUpdateRequest,
+) error {
+	columns := listUpdateRequestToValidMap(req)
+
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := listGetAsMember(ctx, tx, userID, listID, list.RoleOwner)
+			if err != nil {
+				return err
+			}
+			err = tx.ListUpdate(ctx, userID, listID, columns)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			return tx.ListActivityCreate(ctx, &models.ListActivity{
+				ListID: listID,
+				UserID: null.IntFrom(userID),
+				Action: list.ActivityUpdate,
+			})
+		},
+	)
+}
+
+func listUpdateRequestToValidMap(req *dto.ListUpdateRequest) map[string]any {
+	m := make(map[string]any)
+	if req.Title.Valid {
+		m[models.ListColumns.Title] = req.Title.String
+	}
+	if req.Descriptions.Valid {
+		m[models.ListColumns.Descriptions] = req.Descriptions.String
+	}
+	if req.Visibility.Valid {
+		m[models.ListColumns.Visibility] = req.Visibility.String
+	}
+	return m
+}
+
+// ListDelete deletes the list if the user is its owner
+func (app *Application) ListDelete(
+	ctx context.Context,
+	userID int,
+	listID int,
+) error {
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := listGetAsMember(ctx, tx, userID, listID, list.RoleOwner)
+			if err != nil {
+				return err
+			}
+			err = tx.ListDelete(ctx, userID, listID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			return nil
+		},
+	)
+}
+
+// ListItemsGet returns the items of the list by position if the list is
+// visible to the user
+func (app *Application) ListItemsGet(
+	ctx context.Context,
+	userID int,
+	listID int,
+	localeOptions query.LocaleOptions,
+) (items []*list.Item, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the list is visible to the user
+			_, err := listGetVisible(ctx, tx, userID, listID)
+			if err != nil {
+				return err
+			}
+			items, err = tx.ListItemsGetAll(ctx, listID)
+			if err != nil {
+				return err
+			}
+			return localizeListItems(ctx, tx, localeOptions, items)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func localizeListItems(
+	ctx context.Context,
+	r repo.Service,
+	localeOptions query.LocaleOptions,
+	items []*list.Item,
+) error {
+	var (
+		films    []*models.Film
+		serieses []*models.Series
+	)
+	for _, item := range items {
+		if item.Film != nil {
+			films = append(films, item.Film)
+		}
+		if item.Series != nil {
+			serieses = append(serieses, item.Series)
+		}
+	}
+	if err := localizeFilms(ctx, r, localeOptions, films...); err != nil {
+		return err
+	}
+	return localizeSerieses(ctx, r, localeOptions, serieses...)
+}
+
+// ListItemAdd appends the film (movie or episode) or series of the request to
+// the list if the user is its owner or an editor
+func (app *Application) ListItemAdd(
+	ctx context.Context,
+	userID int,
+	listID int,
+	req *dto.ListItemAddRequest,
+) (itemID int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the user can edit the list
+			_, err := listGetAsMember(
+				ctx,
+				tx,
+				userID,
+				listID,
+				list.RoleOwner,
+				list.RoleEditor,
+			)
+			if err != nil {
+				return err
+			}
+			// check the item exists
+			if req.FilmID.Valid {
+				_, err = tx.FilmGet(ctx, req.FilmID.Int)
+			} else {
+				_, err = tx.SeriesGet(ctx, req.SeriesID.Int)
+			}
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// check the item is not a member of the list yet
+			items, err := tx.ListItemsGetAll(ctx, listID)
+			if err != nil {
+				return err
+			}
+			for _, item := range items {
+				if (req.FilmID.Valid && item.FilmID == req.FilmID) ||
+					(req.SeriesID.Valid && item.SeriesID == req.SeriesID) {
+					return ErrListItemExists
+				}
+			}
+			item := &models.ListItem{
+				FilmID:   req.FilmID,
+				SeriesID: req.SeriesID,
+			}
+			err = tx.ListItemAdd(ctx, userID, listID, item)
+			if err != nil {
+				return err
+			}
+			itemID = item.ID
+			return tx.ListActivityCreate(ctx, &models.ListActivity{
+				ListID:   listID,
+				UserID:   null.IntFrom(userID),
+				Action:   list.ActivityItemAdd,
+				FilmID:   req.FilmID,
+				SeriesID: req.SeriesID,
+			})
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return itemID, nil
+}
+
+// ListItemRemove removes the item from the list if the user is its owner or
+// an editor: the items past it move up a position
+func (app *Application) ListItemRemove(
+	ctx context.Context,
+	userID int,
+	listID int,
+	itemID int,
+) error {
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the user can edit the list
+			_, err := listGetAsMember(
+				ctx,
+				tx,
+				userID,
+				listID,
+				list.RoleOwner,
+				list.RoleEditor,
+			)
+			if err != nil {
+				return err
+			}
+			// find the item to log what was removed
+			items, err := tx.ListItemsGetAll(ctx, listID)
+			if err != nil {
+				return err
+			}
+			var removed *list.Item
+			for _, item := range items {
+				if item.ID == itemID {
+					removed = item
+					break
+				}
+			}
+			if removed == nil {
+				return ErrNotFound
+			}
+			err = tx.ListItemDelete(ctx, userID, listID, itemID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			return tx.ListActivityCreate(ctx, &models.ListActivity{
+				ListID:   listID,
+				UserID:   null.IntFrom(userID),
+				Action:   list.ActivityItemRemove,
+				FilmID:   removed.FilmID,
+				SeriesID: removed.SeriesID,
+			})
+		},
+	)
+}
+
+// ListReorder positions the items of the list in the order of the request if
+// the user is its owner or an editor: the request must order all the items.
+// only the moved items are updated
+func (app *Application) ListReorder(
+	ctx context.Context,
+	userID int,
+	listID int,
+	req *dto.ListReorderRequest,
+) error {
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the user can edit the list
+			_, err := listGetAsMember(
+				ctx,
+				tx,
+				userID,
+				listID,
+				list.RoleOwner,
+				list.RoleEditor,
+			)
+			if err != nil {
+				return err
+			}
+			current, err := tx.ListItemsGetAll(ctx, listID)
+			if err != nil {
+				return err
+			}
+			if len(current) != len(req.ItemIDs) {
+				return ErrInvalidListOrder
+			}
+			byID := make(map[int]*list.Item, len(current))
+			for _, item := range current {
+				byID[item.ID] = item
+			}
+			for _, id := range req.ItemIDs {
+				if _, exists := byID[id]; !exists {
+					return ErrInvalidListOrder
+				}
+			}
+			moved := false
+			for i, id := range req.ItemIDs {
+				if byID[id].Position == i+1 {
+					continue
+				}
+				err = tx.ListItemSetPosition(ctx, userID, listID, id, i+1)
+				if err != nil {
+					return err
+				}
+				moved = true
+			}
+			if !moved {
+				return nil
+			}
+			return tx.ListActivityCreate(ctx, &models.ListActivity{
+				ListID: listID,
+				UserID: null.IntFrom(userID),
+				Action: list.ActivityReorder,
+			})
+		},
+	)
+}
+
+// WatchlistAddList adds the items of the list to the watchlist in order: a
+// series adds its episodes in order and films already in the watchlist are
+// skipped
+func (app *Application) WatchlistAddList(
+	ctx context.Context,
+	userID int,
+	listID int,
+) (watchIDs []int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := listGetVisible(ctx, tx, userID, listID)
+			if err != nil {
+				return err
+			}
+			items, err := tx.ListItemsGetAll(ctx, listID)
+			if err != nil {
+				return err
+			}
+			var filmIDs []int
+			for _, item := range items {
+				if item.FilmID.Valid {
+					filmIDs = append(filmIDs, item.FilmID.Int)
+					continue
+				}
+				episodes, err := seriesEpisodesInOrder(ctx, tx, item.SeriesID.Int)
+				if err != nil {
+					return err
+				}
+				for _, e := range episodes {
+					filmIDs = append(filmIDs, e.ID)
+				}
+			}
+			watchIDs, err = tx.WatchlistAddAll(ctx, userID, filmIDs)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return watchIDs, nil
+}
+
+// ListMembersGet returns the members of the list if visible to the user
+func (app *Application) ListMembersGet(
+	ctx context.Context,
+	userID int,
+	listID int,
+) (members []*list.Member, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := listGetVisible(ctx, tx, userID, listID)
+			if err != nil {
+				return err
+			}
+			members, err = tx.ListMembersGetAll(ctx, listID)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// ListMemberUpdate sets the role of the member if the user is the list owner
+func (app *Application) ListMemberUpdate(
+	ctx context.Context,
+	userID int,
+	listID int,
+	memberID int,
+	req *dto.ListMemberUpdateRequest,
+) error {
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := listGetAsMember(ctx, tx, userID, listID, list.RoleOwner)
+			if err != nil {
+				return err
+			}
+			err = tx.ListMemberSetRole(ctx, userID, listID, memberID, req.Role)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			return tx.ListActivityCreate(ctx, &models.ListActivity{
+				ListID:   listID,
+				UserID:   null.IntFrom(userID),
+				Action:   list.ActivityMemberUpdate,
+				MemberID: null.IntFrom(memberID),
+			})
+		},
+	)
+}
+
+// ListMemberRemove removes the member from the list: the owner removes the
+// members and the members leave the list by removing themselves. the owner
+// cannot leave their list
+func (app *Application) ListMemberRemove(
+	ctx context.Context,
+	userID int,
+	listID int,
+	memberID int,
+) error {
+	roles := []string{list.RoleOwner}
+	if memberID == userID {
+		roles = []string{list.RoleEditor, list.RoleViewer}
+	}
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := listGetAsMember(ctx, tx, userID, listID, roles...)
+			if err != nil {
+				return err
+			}
+			err = tx.ListMemberDelete(ctx, userID, listID, memberID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			return tx.ListActivityCreate(ctx, &models.ListActivity{
+				ListID:   listID,
+				UserID:   null.IntFrom(userID),
+				Action:   list.ActivityMemberRemove,
+				MemberID: null.IntFrom(memberID),
+			})
+		},
+	)
+}
+
+// ListInvitesGet returns the pending invites of the list if the user is its
+// owner
+func (app *Application) ListInvitesGet(
+	ctx context.Context,
+	userID int,
+	listID int,
+) (invites []*models.ListInvite, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := listGetAsMember(ctx, tx, userID, listID, list.RoleOwner)
+			if err != nil {
+				return err
+			}
+			invites, err = tx.ListInvitesGetAllByList(ctx, listID)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return invites, nil
+}
+
+// ListInvitesGetByUser returns the pending invites of the user
+func (app *Application) ListInvitesGetByUser(
+	ctx context.Context,
+	userID int,
+) ([]*models.ListInvite, error) {
+	return app.repo.ListInvitesGetAllByUser(ctx, userID)
+}
+
+// ListInviteCreate invites the user of the request email to the list if the
+// user is its owner
+func (app *Application) ListInviteCreate(
+	ctx context.Context,
+	userID int,
+	listID int,
+	req *dto.ListInviteCreateRequest,
+) (inviteID int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := listGetAsMember(ctx, tx, userID, listID, list.RoleOwner)
+			if err != nil {
+				return err
+			}
+			invitee, err := tx.UserGetByEmail(ctx, req.Email)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// check the invitee is neither a member nor invited yet
+			_, err = tx.ListMemberGet(ctx, listID, invitee.ID)
+			if err == nil {
+				return ErrListMemberExists
+			}
+			if err != repo.ErrNoRecord {
+				return err
+			}
+			invites, err := tx.ListInvitesGetAllByList(ctx, listID)
+			if err != nil {
+				return err
+			}
+			for _, invite := range invites {
+				if invite.UserID == null.IntFrom(invitee.ID) {
+					return ErrListInviteExists
+				}
+			}
+			invite := &models.ListInvite{
+				ListID:    listID,
+				Role:      req.Role,
+				UserID:    null.IntFrom(invitee.ID),
+				ExpiresAt: listInviteExpiresAt(),
+			}
+			err = tx.ListInviteCreate(ctx, userID, invite)
+			if err != nil {
+				return err
+			}
+			inviteID = invite.ID
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return inviteID, nil
+}
+
+// ListInviteLinkCreate creates a link invite to the list if the user is its
+// owner: the token of the invite is returned once
+func (app *Application) ListInviteLinkCreate(
+	ctx context.Context,
+	userID int,
+	listID int,
+	req *dto.ListInviteLinkCreateRequest,
+) (linkInvite *list.LinkInvite, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := listGetAsMember(ctx, tx, userID, listID, list.RoleOwner)
+			if err != nil {
+				return err
+			}
+			token, err := uuid.NewRandom()
+			if err != nil {
+				return err
+			}
+			// hash and then save the token
+			tokenHash, err := app.hasher.GenerateHash([]byte(token.String()))
+			if err != nil {
+				return err
+			}
+			invite := &models.ListInvite{
+				ListID:    listID,
+				Role:      req.Role,
+				TokenHash: null.StringFrom(string(tokenHash)),
+				ExpiresAt: listInviteExpiresAt(),
+			}
+			err = tx.ListInviteCreate(ctx, userID, invite)
+			if err != nil {
+				return err
+			}
+			linkInvite = &list.LinkInvite{
+				ListInvite: *invite,
+				Token:      token.String(),
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return linkInvite, nil
+}
+
+// ListInviteAccept joins the user to the list of the invite: the email invites
+// are accepted by their invitee and used up while the link invites are
+// accepted by their token until they expire
+func (app *Application) ListInviteAccept(
+	ctx context.Context,
+	userID int,
+	inviteID int,
+	req *dto.ListInviteAcceptRequest,
+) (listID int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			invite, err := tx.ListInviteGet(ctx, inviteID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			if !invite.ExpiresAt.After(time.Now()) {
+				return ErrNotFound
+			}
+			if invite.UserID.Valid {
+				if invite.UserID.Int != userID {
+					return ErrNotFound
+				}
+			} else {
+				if !req.Token.Valid {
+					return ErrNotFound
+				}
+				err = app.hasher.CompareHash(
+					[]byte(invite.TokenHash.String),
+					[]byte(req.Token.String),
+				)
+				if err != nil {
+					if err == hasher.ErrMismatchedHash {
+						return ErrNotFound
+					}
+					return err
+				}
+			}
+			_, err = tx.ListMemberGet(ctx, invite.ListID, userID)
+			if err == nil {
+				return ErrListMemberExists
+			}
+			if err != repo.ErrNoRecord {
+				return err
+			}
+			err = tx.ListMemberAdd(ctx, &models.ListMember{
+				ListID: invite.ListID,
+				UserID: userID,
+				Role:   invite.Role,
+			})
+			if err != nil {
+				return err
+			}
+			if invite.UserID.Valid {
+				err = tx.ListInviteDelete(ctx, userID, inviteID)
+				if err != nil {
+					return err
+				}
+			}
+			listID = invite.ListID
+			return tx.ListActivityCreate(ctx, &models.ListActivity{
+				ListID:   invite.ListID,
+				UserID:   null.IntFrom(userID),
+				Action:   list.ActivityMemberJoin,
+				MemberID: null.IntFrom(userID),
+			})
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return listID, nil
+}
+
+// ListInviteDelete declines the invite of the user or revokes the invite of the
+// list of the user
+func (app *Application) ListInviteDelete(
+	ctx context.Context,
+	userID int,
+	inviteID int,
+) error {
+	err := app.repo.ListInviteDelete(ctx, userID, inviteID)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func listInviteExpiresAt() time.Time {
+	return time.Now().Add(
+		time.Hour * time.Duration(config.Config.List.InviteExpireInHours),
+	)
+}
+
+// ListActivitiesGet returns the activity log of the list if the user is a
+// member of it
+func (app *Application) ListActivitiesGet(
+	ctx context.Context,
+	userID int,
+	listID int,
+	queryOptions query.SortOrderOptions,
+) (activities []*models.ListActivity, total int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := listGetAsMember(ctx, tx, userID, listID)
+			if err != nil {
+				return err
+			}
+			activities, err = tx.ListActivitiesGetAll(ctx, listID, queryOptions)
+			if err != nil {
+				return err
+			}
+			total, err = tx.ListActivitiesCount(ctx, listID)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return activities, total, nil
+}
+
+// listGetVisible returns the list if visible to the user: private lists are
+// visible to their members only
+func listGetVisible(
+	ctx context.Context,
+	r repo.Service,
+	userID int,
+	listID int,
+) (*models.List, error) {
+	l, err := r.ListGet(ctx, listID)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if l.Visibility == list.VisibilityPublic {
+		return l, nil
+	}
+	_, err = r.ListMemberGet(ctx, listID, userID)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return l, nil
+}
+
+// listGetAsMember returns the list if the user is a member of it in one of the
+// roles: no roles accepts all the roles. the lists of the other users are not
+// found and the members in another role are forbidden
+func listGetAsMember(
+	ctx context.Context,
+	r repo.Service,
+	userID int,
+	listID int,
+	roles ...string,
+) (*models.List, error) {
+	l, err := r.ListGet(ctx, listID)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	member, err := r.ListMemberGet(ctx, listID, userID)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if len(roles) == 0 {
+		return l, nil
+	}
+	for _, role := range roles {
+		if member.Role == role {
+			return l, nil
+		}
+	}
+	return nil, ErrListPermission
+}
@@ -0,0 +1,460 @@
+package app_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/hasher"
+	"github.com/aria3ppp/watchlist-server/internal/hasher/mock_hasher"
+	"github.com/aria3ppp/watchlist-server/internal/list"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/repo/mock_repo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestListGet(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = context.Background()
+		ownerID = 1
+		otherID = 2
+		listID  = 3
+	)
+
+	type TestCase struct {
+		name       string
+		userID     int
+		visibility string
+		memberErr  error
+		expErr     error
+	}
+
+	testCases := []TestCase{
+		{
+			name:       "private list of owner",
+			userID:     ownerID,
+			visibility: list.VisibilityPrivate,
+		},
+		{
+			name:       "private list of member",
+			userID:     otherID,
+			visibility: list.VisibilityPrivate,
+		},
+		{
+			name:       "private list of other user",
+			userID:     otherID,
+			visibility: list.VisibilityPrivate,
+			memberErr:  repo.ErrNoRecord,
+			expErr:     app.ErrNotFound,
+		},
+		{
+			name:       "public list of other user",
+			userID:     otherID,
+			visibility: list.VisibilityPublic,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			l := &models.List{
+				ID:         listID,
+				UserID:     ownerID,
+				Visibility: tc.visibility,
+			}
+			mockRepo.EXPECT().ListGet(ctx, listID).Return(l, nil)
+			if tc.visibility == list.VisibilityPrivate {
+				mockRepo.EXPECT().
+					ListMemberGet(ctx, listID, tc.userID).
+					Return(&models.ListMember{}, tc.memberErr)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			got, err := app.ListGet(ctx, tc.userID, listID)
+			require.Equal(tc.expErr, err)
+			if tc.expErr == nil {
+				require.Equal(l, got)
+			} else {
+				require.Nil(got)
+			}
+		})
+	}
+}
+
+func TestListItemAdd(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		userID = 1
+		listID = 2
+		filmID = 3
+		itemID = 4
+	)
+
+	type TestCase struct {
+		name      string
+		role      string
+		memberErr error
+		filmErr   error
+		items     []*list.Item
+		expItemID int
+		expErr    error
+	}
+
+	testCases := []TestCase{
+		{
+			name:      "list of other user",
+			memberErr: repo.ErrNoRecord,
+			expErr:    app.ErrNotFound,
+		},
+		{
+			name:   "viewer",
+			role:   list.RoleViewer,
+			expErr: app.ErrListPermission,
+		},
+		{
+			name:    "film not found",
+			role:    list.RoleOwner,
+			filmErr: repo.ErrNoRecord,
+			expErr:  app.ErrNotFound,
+		},
+		{
+			name: "film already in list",
+			role: list.RoleOwner,
+			items: []*list.Item{
+				{ListItem: models.ListItem{FilmID: null.IntFrom(filmID)}},
+			},
+			expErr: app.ErrListItemExists,
+		},
+		{
+			name: "ok",
+			role: list.RoleEditor,
+			items: []*list.Item{
+				{ListItem: models.ListItem{SeriesID: null.IntFrom(filmID)}},
+			},
+			expItemID: itemID,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+			mockRepo.EXPECT().
+				ListGet(ctx, listID).
+				Return(&models.List{
+					ID:         listID,
+					Visibility: list.VisibilityPrivate,
+				}, nil)
+			mockRepo.EXPECT().
+				ListMemberGet(ctx, listID, userID).
+				Return(&models.ListMember{Role: tc.role}, tc.memberErr)
+			canEdit := tc.role == list.RoleOwner || tc.role == list.RoleEditor
+			if canEdit {
+				mockRepo.EXPECT().
+					FilmGet(ctx, filmID).
+					Return(&models.Film{ID: filmID}, tc.filmErr)
+			}
+			if canEdit && tc.filmErr == nil {
+				mockRepo.EXPECT().
+					ListItemsGetAll(ctx, listID).
+					Return(tc.items, nil)
+			}
+			if tc.expErr == nil {
+				mockRepo.EXPECT().
+					ListItemAdd(
+						ctx,
+						userID,
+						listID,
+						&models.ListItem{FilmID: null.IntFrom(filmID)},
+					).
+					DoAndReturn(func(ctx context.Context, userID int, listID int, item *models.ListItem) error {
+						item.ID = itemID
+						return nil
+					})
+				mockRepo.EXPECT().
+					ListActivityCreate(ctx, &models.ListActivity{
+						ListID: listID,
+						UserID: null.IntFrom(userID),
+						Action: list.ActivityItemAdd,
+						FilmID: null.IntFrom(filmID),
+					}).
+					Return(nil)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			gotItemID, err := app.ListItemAdd(
+				ctx,
+				userID,
+				listID,
+				&dto.ListItemAddRequest{FilmID: null.IntFrom(filmID)},
+			)
+			require.Equal(tc.expErr, err)
+			require.Equal(tc.expItemID, gotItemID)
+		})
+	}
+}
+
+func TestListReorder(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		userID = 1
+		listID = 2
+		items  = []*list.Item{
+			{ListItem: models.ListItem{ID: 10, Position: 1}},
+			{ListItem: models.ListItem{ID: 11, Position: 2}},
+			{ListItem: models.ListItem{ID: 12, Position: 3}},
+		}
+	)
+
+	type TestCase struct {
+		name     string
+		itemIDs  []int
+		expMoves map[int]int
+		expErr   error
+	}
+
+	testCases := []TestCase{
+		{
+			name:    "missing item",
+			itemIDs: []int{12, 10},
+			expErr:  app.ErrInvalidListOrder,
+		},
+		{
+			name:    "unknown item",
+			itemIDs: []int{12, 10, 13},
+			expErr:  app.ErrInvalidListOrder,
+		},
+		{
+			name:     "ok",
+			itemIDs:  []int{12, 11, 10},
+			expMoves: map[int]int{12: 1, 10: 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+			mockRepo.EXPECT().
+				ListGet(ctx, listID).
+				Return(&models.List{ID: listID, UserID: userID}, nil)
+			mockRepo.EXPECT().
+				ListMemberGet(ctx, listID, userID).
+				Return(&models.ListMember{Role: list.RoleOwner}, nil)
+			mockRepo.EXPECT().
+				ListItemsGetAll(ctx, listID).
+				Return(items, nil)
+			for itemID, position := range tc.expMoves {
+				mockRepo.EXPECT().
+					ListItemSetPosition(ctx, userID, listID, itemID, position).
+					Return(nil)
+			}
+			if len(tc.expMoves) > 0 {
+				mockRepo.EXPECT().
+					ListActivityCreate(ctx, &models.ListActivity{
+						ListID: listID,
+						UserID: null.IntFrom(userID),
+						Action: list.ActivityReorder,
+					}).
+					Return(nil)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.ListReorder(
+				ctx,
+				userID,
+				listID,
+				&dto.ListReorderRequest{ItemIDs: tc.itemIDs},
+			)
+			require.Equal(tc.expErr, err)
+		})
+	}
+}
+
+func TestListInviteAccept(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx       = context.Background()
+		userID    = 1
+		listID    = 2
+		inviteID  = 3
+		token     = "token"
+		tokenHash = "token hash"
+	)
+
+	type TestCase struct {
+		name      string
+		invite    *models.ListInvite
+		token     null.String
+		hashErr   error
+		memberErr error
+		expListID int
+		expErr    error
+	}
+
+	emailInvite := func(inviteeID int, expiresAt time.Time) *models.ListInvite {
+		return &models.ListInvite{
+			ID:        inviteID,
+			ListID:    listID,
+			Role:      list.RoleEditor,
+			UserID:    null.IntFrom(inviteeID),
+			ExpiresAt: expiresAt,
+		}
+	}
+	linkInvite := &models.ListInvite{
+		ID:        inviteID,
+		ListID:    listID,
+		Role:      list.RoleViewer,
+		TokenHash: null.StringFrom(tokenHash),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	testCases := []TestCase{
+		{
+			name:   "expired invite",
+			invite: emailInvite(userID, time.Now().Add(-time.Hour)),
+			expErr: app.ErrNotFound,
+		},
+		{
+			name:   "invite of other user",
+			invite: emailInvite(userID+1, time.Now().Add(time.Hour)),
+			expErr: app.ErrNotFound,
+		},
+		{
+			name:   "link invite without token",
+			invite: linkInvite,
+			expErr: app.ErrNotFound,
+		},
+		{
+			name:    "link invite with wrong token",
+			invite:  linkInvite,
+			token:   null.StringFrom(token),
+			hashErr: hasher.ErrMismatchedHash,
+			expErr:  app.ErrNotFound,
+		},
+		{
+			name:   "already a member",
+			invite: emailInvite(userID, time.Now().Add(time.Hour)),
+			expErr: app.ErrListMemberExists,
+		},
+		{
+			name:      "email invite",
+			invite:    emailInvite(userID, time.Now().Add(time.Hour)),
+			memberErr: repo.ErrNoRecord,
+			expListID: listID,
+		},
+		{
+			name:      "link invite",
+			invite:    linkInvite,
+			token:     null.StringFrom(token),
+			memberErr: repo.ErrNoRecord,
+			expListID: listID,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+			mockHasher := mock_hasher.NewMockInterface(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+			mockRepo.EXPECT().
+				ListInviteGet(ctx, inviteID).
+				Return(tc.invite, nil)
+			if tc.token.Valid {
+				mockHasher.EXPECT().
+					CompareHash([]byte(tokenHash), []byte(tc.token.String)).
+					Return(tc.hashErr)
+			}
+			if tc.expErr == nil || tc.expErr == app.ErrListMemberExists {
+				mockRepo.EXPECT().
+					ListMemberGet(ctx, listID, userID).
+					Return(&models.ListMember{}, tc.memberErr)
+			}
+			if tc.expErr == nil {
+				mockRepo.EXPECT().
+					ListMemberAdd(ctx, &models.ListMember{
+						ListID: listID,
+						UserID: userID,
+						Role:   tc.invite.Role,
+					}).
+					Return(nil)
+				if tc.invite.UserID.Valid {
+					mockRepo.EXPECT().
+						ListInviteDelete(ctx, userID, inviteID).
+						Return(nil)
+				}
+				mockRepo.EXPECT().
+					ListActivityCreate(ctx, &models.ListActivity{
+						ListID:   listID,
+						UserID:   null.IntFrom(userID),
+						Action:   list.ActivityMemberJoin,
+						MemberID: null.IntFrom(userID),
+					}).
+					Return(nil)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, mockHasher, nil, nil)
+
+			gotListID, err := app.ListInviteAccept(
+				ctx,
+				userID,
+				inviteID,
+				&dto.ListInviteAcceptRequest{Token: tc.token},
+			)
+			require.Equal(tc.expErr, err)
+			require.Equal(tc.expListID, gotListID)
+		})
+	}
+}
@@ -0,0 +1,480 @@
+package app
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/storage"
+	"github.com/volatiletech/null/v8"
+)
+
+// mediaOwner is the movie or series owning media. artists own no media as
+// there are no artists to own them
+type mediaOwner struct {
+	// check returns ErrNotFound if the owner does not exist
+	check func(ctx context.Context, tx repo.Service) error
+	list  func(ctx context.Context, tx repo.Service) ([]*models.MediaItem, error)
+	// own sets the owner of a new media
+	own func(media *models.MediaItem)
+}
+
+func movieMediaOwner(id int) *mediaOwner {
+	return &mediaOwner{
+		check: func(ctx context.Context, tx repo.Service) error {
+			_, err := tx.MovieGet(ctx, id)
+			if err == repo.ErrNoRecord {
+				return ErrNotFound
+			}
+			return err
+		},
+		list: func(
+			ctx context.Context,
+			tx repo.Service,
+		) ([]*models.MediaItem, error) {
+			return tx.MediaItemsGetAllByFilm(ctx, id)
+		},
+		own: func(media *models.MediaItem) {
+			media.FilmID = null.IntFrom(id)
+		},
+	}
+}
+
+func seriesMediaOwner(id int) *mediaOwner {
+	return &mediaOwner{
+		check: func(ctx context.Context, tx repo.Service) error {
+			_, err := tx.SeriesGet(ctx, id)
+			if err == repo.ErrNoRecord {
+				return ErrNotFound
+			}
+			return err
+		},
+		list: func(
+			ctx context.Context,
+			tx repo.Service,
+		) ([]*models.MediaItem, error) {
+			return tx.MediaItemsGetAllBySeries(ctx, id)
+		},
+		own: func(media *models.MediaItem) {
+			media.SeriesID = null.IntFrom(id)
+		},
+	}
+}
+
+func (app *Application) MovieMediaGet(
+	ctx context.Context,
+	id int,
+) (media []*models.MediaItem, err error) {
+	return app.mediaGet(ctx, movieMediaOwner(id))
+}
+
+func (app *Application) SeriesMediaGet(
+	ctx context.Context,
+	id int,
+) (media []*models.MediaItem, err error) {
+	return app.mediaGet(ctx, seriesMediaOwner(id))
+}
+
+func (app *Application) mediaGet(
+	ctx context.Context,
+	owner *mediaOwner,
+) (media []*models.MediaItem, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			if err := owner.check(ctx, tx); err != nil {
+				return err
+			}
+			media, err = owner.list(ctx, tx)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return media, nil
+}
+
+func (app *Application) MovieMediaUpload(
+	ctx context.Context,
+	id int,
+	contributorID int,
+	kind string,
+	primary bool,
+	file io.Reader,
+	options *storage.PutOptions,
+) (media *models.MediaItem, err error) {
+	return app.mediaUpload(
+		ctx,
+		movieMediaOwner(id),
+		contributorID,
+		kind,
+		primary,
+		file,
+		options,
+	)
+}
+
+func (app *Application) SeriesMediaUpload(
+	ctx context.Context,
+	id int,
+	contributorID int,
+	kind string,
+	primary bool,
+	file io.Reader,
+	options *storage.PutOptions,
+) (media *models.MediaItem, err error) {
+	return app.mediaUpload(
+		ctx,
+		seriesMediaOwner(id),
+		contributorID,
+		kind,
+		primary,
+		file,
+		options,
+	)
+}
+
+func (app *Application) mediaUpload(
+	ctx context.Context,
+	owner *mediaOwner,
+	contributorID int,
+	kind string,
+	primary bool,
+	file io.Reader,
+	options *storage.PutOptions,
+) (media *models.MediaItem, err error) {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return nil, err
+	}
+
+	var uploaded bool
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the owner exists
+			if err := owner.check(ctx, tx); err != nil {
+				return err
+			}
+			// put file
+			uri, err := app.storage.PutFile(ctx, file, options)
+			if err != nil {
+				return err
+			}
+			uploaded = true
+			media = &models.MediaItem{Kind: kind, URI: uri, IsPrimary: primary}
+			return mediaAdd(ctx, tx, owner, contributorID, media)
+		},
+	)
+	if err != nil {
+		if uploaded {
+			// delete the file no media item refers to. a failure to delete it
+			// is dropped in favor of the error adding the media item
+			_ = app.storage.DeleteFiles(ctx, &storage.DeleteOptions{
+				Bucket:     options.Bucket,
+				Category:   options.Category,
+				CategoryID: options.CategoryID,
+				Filename:   options.Filename,
+			})
+		}
+		return nil, err
+	}
+	return media, nil
+}
+
+func (app *Application) MovieMediaTrailerAdd(
+	ctx context.Context,
+	id int,
+	contributorID int,
+	req *dto.MediaTrailerAddRequest,
+) (media *models.MediaItem, err error) {
+	return app.mediaTrailerAdd(ctx, movieMediaOwner(id), contributorID, req)
+}
+
+func (app *Application) SeriesMediaTrailerAdd(
+	ctx context.Context,
+	id int,
+	contributorID int,
+	req *dto.MediaTrailerAddRequest,
+) (media *models.MediaItem, err error) {
+	return app.mediaTrailerAdd(ctx, seriesMediaOwner(id), contributorID, req)
+}
+
+func (app *Application) mediaTrailerAdd(
+	ctx context.Context,
+	owner *mediaOwner,
+	contributorID int,
+	req *dto.MediaTrailerAddRequest,
+) (media *models.MediaItem, err error) {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return nil, err
+	}
+
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the owner exists
+			if err := owner.check(ctx, tx); err != nil {
+				return err
+			}
+			media = &models.MediaItem{
+				Kind:      dto.MediaKindTrailer,
+				URI:       req.URL,
+				IsPrimary: req.Primary,
+			}
+			return mediaAdd(ctx, tx, owner, contributorID, media)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return media, nil
+}
+
+// mediaAdd adds the media after the media of the owner: a primary media
+// replaces the primary media of its kind
+func mediaAdd(
+	ctx context.Context,
+	tx repo.Service,
+	owner *mediaOwner,
+	contributorID int,
+	media *models.MediaItem,
+) error {
+	current, err := owner.list(ctx, tx)
+	if err != nil {
+		return err
+	}
+	media.Position = 1
+	for _, m := range current {
+		if m.Position >= media.Position {
+			media.Position = m.Position + 1
+		}
+		if media.IsPrimary && m.IsPrimary && m.Kind == media.Kind {
+			err = tx.MediaItemUpdate(ctx, m.ID, contributorID, map[string]any{
+				models.MediaItemColumns.IsPrimary: false,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	owner.own(media)
+	return tx.MediaItemCreate(ctx, contributorID, media)
+}
+
+func (app *Application) MovieMediaReorder(
+	ctx context.Context,
+	id int,
+	contributorID int,
+	req *dto.MediaReorderRequest,
+) error {
+	return app.mediaReorder(ctx, movieMediaOwner(id), contributorID, req)
+}
+
+func (app *Application) SeriesMediaReorder(
+	ctx context.Context,
+	id int,
+	contributorID int,
+	req *dto.MediaReorderRequest,
+) error {
+	return app.mediaReorder(ctx, seriesMediaOwner(id), contributorID, req)
+}
+
+// mediaReorder positions the media of the owner in the order of the request:
+// the request must order all the media and have at most one primary media of
+// each kind. only the changed media are updated
+func (app *Application) mediaReorder(
+	ctx context.Context,
+	owner *mediaOwner,
+	contributorID int,
+	req *dto.MediaReorderRequest,
+) error {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return err
+	}
+
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the owner exists
+			if err := owner.check(ctx, tx); err != nil {
+				return err
+			}
+			current, err := owner.list(ctx, tx)
+			if err != nil {
+				return err
+			}
+			if len(current) != len(req.Media) {
+				return ErrInvalidMediaOrder
+			}
+			byID := make(map[int]*models.MediaItem, len(current))
+			for _, m := range current {
+				byID[m.ID] = m
+			}
+			primaryKinds := make(map[string]bool)
+			for _, o := range req.Media {
+				m, exists := byID[o.ID]
+				if !exists || (o.Primary && primaryKinds[m.Kind]) {
+					return ErrInvalidMediaOrder
+				}
+				if o.Primary {
+					primaryKinds[m.Kind] = true
+				}
+			}
+			// update media unset from primary before media set to primary
+			for _, primary := range []bool{false, true} {
+				for i, o := range req.Media {
+					m := byID[o.ID]
+					if o.Primary != primary ||
+						(m.Position == i+1 && m.IsPrimary == o.Primary) {
+						continue
+					}
+					err = tx.MediaItemUpdate(
+						ctx,
+						m.ID,
+						contributorID,
+						map[string]any{
+							models.MediaItemColumns.Position:  i + 1,
+							models.MediaItemColumns.IsPrimary: o.Primary,
+						},
+					)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		},
+	)
+}
+
+func (app *Application) MovieMediaRemove(
+	ctx context.Context,
+	id int,
+	mediaID int,
+	contributorID int,
+) error {
+	return app.mediaRemove(ctx, movieMediaOwner(id), mediaID, contributorID)
+}
+
+func (app *Application) SeriesMediaRemove(
+	ctx context.Context,
+	id int,
+	mediaID int,
+	contributorID int,
+) error {
+	return app.mediaRemove(ctx, seriesMediaOwner(id), mediaID, contributorID)
+}
+
+// mediaRemove marks the media of the owner as removed: the stored file is
+// kept to be referenced by the audit history
+func (app *Application) mediaRemove(
+	ctx context.Context,
+	owner *mediaOwner,
+	mediaID int,
+	contributorID int,
+) error {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return err
+	}
+
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the owner exists
+			if err := owner.check(ctx, tx); err != nil {
+				return err
+			}
+			current, err := owner.list(ctx, tx)
+			if err != nil {
+				return err
+			}
+			for _, m := range current {
+				if m.ID != mediaID {
+					continue
+				}
+				return tx.MediaItemUpdate(
+					ctx,
+					mediaID,
+					contributorID,
+					map[string]any{
+						models.MediaItemColumns.RemovedAt: null.TimeFrom(time.Now()),
+						models.MediaItemColumns.IsPrimary: false,
+					},
+				)
+			}
+			return ErrNotFound
+		},
+	)
+}
+
+func (app *Application) MovieMediaAuditsGetAll(
+	ctx context.Context,
+	id int,
+	queryOptions query.SortOrderOptions,
+) (audits []*models.MediaItemsAudit, total int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the movie exists
+			if err := movieMediaOwner(id).check(ctx, tx); err != nil {
+				return err
+			}
+			// fetch audits
+			audits, err = tx.MediaItemAuditsGetAllByFilm(ctx, id, queryOptions)
+			if err != nil {
+				return err
+			}
+			// count total audits
+			total, err = tx.MediaItemAuditsCountByFilm(ctx, id)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return audits, total, nil
+}
+
+func (app *Application) SeriesMediaAuditsGetAll(
+	ctx context.Context,
+	id int,
+	queryOptions query.SortOrderOptions,
+) (audits []*models.MediaItemsAudit, total int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the series exists
+			if err := seriesMediaOwner(id).check(ctx, tx); err != nil {
+				return err
+			}
+			// fetch audits
+			audits, err = tx.MediaItemAuditsGetAllBySeries(ctx, id, queryOptions)
+			if err != nil {
+				return err
+			}
+			// count total audits
+			total, err = tx.MediaItemAuditsCountBySeries(ctx, id)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return audits, total, nil
+}
@@ -0,0 +1,271 @@
+package app_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/repo/mock_repo"
+	"github.com/aria3ppp/watchlist-server/internal/storage"
+	"github.com/aria3ppp/watchlist-server/internal/storage/mock_storage"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestMovieMediaReorder(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx           = context.Background()
+		movieID       = 1
+		contributorID = 1
+		movie         = &models.Film{ID: movieID, Title: "movie"}
+	)
+
+	type Update struct {
+		id       int
+		position int
+		primary  bool
+	}
+	type TestCase struct {
+		name       string
+		current    []*models.MediaItem
+		req        *dto.MediaReorderRequest
+		expUpdates []Update
+		expErr     error
+	}
+
+	media := func(id, position int, kind string, primary bool) *models.MediaItem {
+		return &models.MediaItem{
+			ID:        id,
+			FilmID:    null.IntFrom(movieID),
+			Kind:      kind,
+			Position:  position,
+			IsPrimary: primary,
+		}
+	}
+
+	testCases := []TestCase{
+		{
+			name: "media missing from order",
+			current: []*models.MediaItem{
+				media(1, 1, dto.MediaKindPoster, true),
+				media(2, 2, dto.MediaKindPoster, false),
+			},
+			req: &dto.MediaReorderRequest{
+				Media: []*dto.MediaOrder{{ID: 1}},
+			},
+			expErr: app.ErrInvalidMediaOrder,
+		},
+		{
+			name: "media of another record",
+			current: []*models.MediaItem{
+				media(1, 1, dto.MediaKindPoster, true),
+			},
+			req: &dto.MediaReorderRequest{
+				Media: []*dto.MediaOrder{{ID: 3}},
+			},
+			expErr: app.ErrInvalidMediaOrder,
+		},
+		{
+			name: "two primary posters",
+			current: []*models.MediaItem{
+				media(1, 1, dto.MediaKindPoster, true),
+				media(2, 2, dto.MediaKindPoster, false),
+			},
+			req: &dto.MediaReorderRequest{
+				Media: []*dto.MediaOrder{
+					{ID: 1, Primary: true},
+					{ID: 2, Primary: true},
+				},
+			},
+			expErr: app.ErrInvalidMediaOrder,
+		},
+		{
+			name: "swap posters and their primary flag",
+			current: []*models.MediaItem{
+				media(1, 1, dto.MediaKindPoster, true),
+				media(2, 2, dto.MediaKindPoster, false),
+				media(3, 3, dto.MediaKindTrailer, true),
+			},
+			req: &dto.MediaReorderRequest{
+				Media: []*dto.MediaOrder{
+					{ID: 2, Primary: true},
+					{ID: 1},
+					{ID: 3, Primary: true},
+				},
+			},
+			// unset primary first and the unchanged trailer is not updated
+			expUpdates: []Update{
+				{id: 1, position: 2, primary: false},
+				{id: 2, position: 1, primary: true},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+			mockRepo.EXPECT().MovieGet(ctx, movieID).Return(movie, nil)
+			mockRepo.EXPECT().
+				MediaItemsGetAllByFilm(ctx, movieID).
+				Return(tc.current, nil)
+			var calls []*gomock.Call
+			for _, u := range tc.expUpdates {
+				calls = append(
+					calls,
+					mockRepo.EXPECT().
+						MediaItemUpdate(ctx, u.id, contributorID, map[string]any{
+							models.MediaItemColumns.Position:  u.position,
+							models.MediaItemColumns.IsPrimary: u.primary,
+						}).
+						Return(nil),
+				)
+			}
+			gomock.InOrder(calls...)
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.MovieMediaReorder(ctx, movieID, contributorID, tc.req)
+			require.Equal(tc.expErr, err)
+		})
+	}
+}
+
+func TestSeriesMediaTrailerAdd(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		ctx           = context.Background()
+		seriesID      = 1
+		contributorID = 1
+		series        = &models.Series{ID: seriesID, Title: "series"}
+		req           = &dto.MediaTrailerAddRequest{
+			URL:     "https://www.youtube.com/watch?v=trailer",
+			Primary: true,
+		}
+	)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockServiceTx(controller)
+
+	mockRepo.EXPECT().
+		Tx(ctx, nil, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+			return fn(ctx, mockRepo)
+		})
+	mockRepo.EXPECT().SeriesGet(ctx, seriesID).Return(series, nil)
+	mockRepo.EXPECT().
+		MediaItemsGetAllBySeries(ctx, seriesID).
+		Return([]*models.MediaItem{
+			{ID: 1, Kind: dto.MediaKindPoster, Position: 1, IsPrimary: true},
+			{ID: 2, Kind: dto.MediaKindTrailer, Position: 3, IsPrimary: true},
+		}, nil)
+	// the new primary trailer replaces the primary trailer
+	gomock.InOrder(
+		mockRepo.EXPECT().
+			MediaItemUpdate(ctx, 2, contributorID, map[string]any{
+				models.MediaItemColumns.IsPrimary: false,
+			}).
+			Return(nil),
+		mockRepo.EXPECT().
+			MediaItemCreate(ctx, contributorID, &models.MediaItem{
+				SeriesID:  null.IntFrom(seriesID),
+				Kind:      dto.MediaKindTrailer,
+				URI:       req.URL,
+				Position:  4,
+				IsPrimary: true,
+			}).
+			Return(nil),
+	)
+
+	app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	media, err := app.SeriesMediaTrailerAdd(ctx, seriesID, contributorID, req)
+	require.NoError(err)
+	require.Equal(4, media.Position)
+}
+
+func TestMovieMediaUpload(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		ctx           = context.Background()
+		movieID       = 1
+		contributorID = 1
+		movie         = &models.Film{ID: movieID, Title: "movie"}
+		file          = strings.NewReader("still")
+		options       = &storage.PutOptions{
+			Bucket:      "bucket",
+			Category:    "movie",
+			CategoryID:  movieID,
+			Filename:    "still-1",
+			ContentType: "image/png",
+			Size:        5,
+		}
+		uri          = "/bucket/movie/1/still-1?versionId=1"
+		expCreateErr = errors.New("media item create error")
+	)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockServiceTx(controller)
+	mockStorage := mock_storage.NewMockService(controller)
+
+	mockRepo.EXPECT().
+		Tx(ctx, nil, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+			return fn(ctx, mockRepo)
+		})
+	mockRepo.EXPECT().MovieGet(ctx, movieID).Return(movie, nil)
+	mockStorage.EXPECT().PutFile(ctx, file, options).Return(uri, nil)
+	mockRepo.EXPECT().MediaItemsGetAllByFilm(ctx, movieID).Return(nil, nil)
+	mockRepo.EXPECT().
+		MediaItemCreate(ctx, contributorID, &models.MediaItem{
+			FilmID:   null.IntFrom(movieID),
+			Kind:     dto.MediaKindStill,
+			URI:      uri,
+			Position: 1,
+		}).
+		Return(expCreateErr)
+	// the uploaded file is deleted as no media item refers to it
+	mockStorage.EXPECT().
+		DeleteFiles(ctx, &storage.DeleteOptions{
+			Bucket:     options.Bucket,
+			Category:   options.Category,
+			CategoryID: options.CategoryID,
+			Filename:   options.Filename,
+		}).
+		Return(nil)
+
+	app := app.NewApplication(mockRepo, nil, nil, nil, mockStorage, nil)
+
+	media, err := app.MovieMediaUpload(
+		ctx,
+		movieID,
+		contributorID,
+		dto.MediaKindStill,
+		false,
+		file,
+		options,
+	)
+	require.Equal(expCreateErr, err)
+	require.Nil(media)
+}
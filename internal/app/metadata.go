@@ -0,0 +1,386 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/metadata"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/storage"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/volatiletech/null/v8"
+)
+
+func (app *Application) MovieMetadataGet(
+	ctx context.Context,
+	id int,
+) (*metadata.Movie, error) {
+	providerID, err := app.movieProviderID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	movie, err := app.metadata.Movie(ctx, providerID)
+	if err != nil {
+		return nil, metadataError(err)
+	}
+	return movie, nil
+}
+
+// MovieMetadataApply updates the movie and its poster by the provider data.
+// Provider fields failing validation are not applied.
+func (app *Application) MovieMetadataApply(
+	ctx context.Context,
+	id int,
+	contributorID int,
+) error {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return err
+	}
+
+	movie, err := app.MovieMetadataGet(ctx, id)
+	if err != nil {
+		return err
+	}
+	// fetch poster before applying any change
+	poster, contentType, err := app.metadataPoster(ctx, movie.Poster)
+	if err != nil {
+		return err
+	}
+
+	req := &dto.MovieUpdateRequest{
+		Title:        null.NewString(movie.Title, movie.Title != ""),
+		Descriptions: movie.Descriptions,
+		DateReleased: movie.DateReleased,
+		Duration:     movie.Duration,
+	}
+	if err := dropInvalidFields(req); err != nil {
+		return err
+	}
+	if len(movieUpdateRequestToValidMap(req)) > 0 {
+		err = app.MovieUpdate(ctx, id, contributorID, req)
+		if err != nil {
+			return err
+		}
+	}
+
+	if poster == nil {
+		return nil
+	}
+	_, err = app.MoviePutPoster(
+		ctx,
+		id,
+		contributorID,
+		bytes.NewReader(poster),
+		&storage.PutOptions{
+			Bucket:      config.Config.MinIO.Bucket.Image.Name,
+			Category:    config.Config.MinIO.Category.Movie,
+			CategoryID:  id,
+			Filename:    config.Config.MinIO.Filename.Movie,
+			ContentType: contentType,
+			Size:        int64(len(poster)),
+		},
+	)
+	return err
+}
+
+func (app *Application) SeriesMetadataGet(
+	ctx context.Context,
+	id int,
+) (*metadata.Series, error) {
+	providerID, err := app.seriesProviderID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	series, err := app.metadata.Series(ctx, providerID)
+	if err != nil {
+		return nil, metadataError(err)
+	}
+	return series, nil
+}
+
+// SeriesMetadataApply updates the series and its poster by the provider data.
+// Provider fields failing validation are not applied.
+func (app *Application) SeriesMetadataApply(
+	ctx context.Context,
+	id int,
+	contributorID int,
+) error {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return err
+	}
+
+	series, err := app.SeriesMetadataGet(ctx, id)
+	if err != nil {
+		return err
+	}
+	// fetch poster before applying any change
+	poster, contentType, err := app.metadataPoster(ctx, series.Poster)
+	if err != nil {
+		return err
+	}
+
+	req := &dto.SeriesUpdateRequest{
+		Title:        null.NewString(series.Title, series.Title != ""),
+		Descriptions: series.Descriptions,
+		DateStarted:  series.DateStarted,
+		DateEnded:    series.DateEnded,
+	}
+	if err := dropInvalidFields(req); err != nil {
+		return err
+	}
+	if len(seriesUpdateRequestToValidMap(req)) > 0 {
+		err = app.SeriesUpdate(ctx, id, contributorID, req)
+		if err != nil {
+			return err
+		}
+	}
+
+	if poster == nil {
+		return nil
+	}
+	_, err = app.SeriesPutPoster(
+		ctx,
+		id,
+		contributorID,
+		bytes.NewReader(poster),
+		&storage.PutOptions{
+			Bucket:      config.Config.MinIO.Bucket.Image.Name,
+			Category:    config.Config.MinIO.Category.Series,
+			CategoryID:  id,
+			Filename:    config.Config.MinIO.Filename.Series,
+			ContentType: contentType,
+			Size:        int64(len(poster)),
+		},
+	)
+	return err
+}
+
+func (app *Application) SeasonMetadataGet(
+	ctx context.Context,
+	seriesID int,
+	seasonNumber int,
+) (*metadata.Season, error) {
+	providerID, err := app.seriesProviderID(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	season, err := app.metadata.Season(ctx, providerID, seasonNumber)
+	if err != nil {
+		return nil, metadataError(err)
+	}
+	return season, nil
+}
+
+// SeasonMetadataApply puts all the season episodes by the provider data.
+// Episodes missing a valid title or release date are skipped and other
+// provider fields failing validation are not applied. Episodes are put the
+// same way as the episode put endpoint as the existing ones are replaced.
+func (app *Application) SeasonMetadataApply(
+	ctx context.Context,
+	seriesID int,
+	seasonNumber int,
+	contributorID int,
+) error {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return err
+	}
+
+	season, err := app.SeasonMetadataGet(ctx, seriesID, seasonNumber)
+	if err != nil {
+		return err
+	}
+
+	episodes := make(map[int]*dto.EpisodePutRequest, len(season.Episodes))
+	for _, e := range season.Episodes {
+		if e.EpisodeNumber < 1 ||
+			e.EpisodeNumber > config.Config.Validation.Film.EpisodeNumber.MaxValue {
+			continue
+		}
+		req := &dto.EpisodePutRequest{
+			Title:        e.Title,
+			Descriptions: e.Descriptions,
+			DateReleased: e.DateReleased.Time,
+			Duration:     e.Duration,
+		}
+		if err := dropInvalidFields(req); err != nil {
+			return err
+		}
+		// dropped required fields are still invalid
+		if req.Validate() != nil {
+			continue
+		}
+		episodes[e.EpisodeNumber] = req
+	}
+
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// check series id exists
+			if _, err := tx.SeriesGet(ctx, seriesID); err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// put episodes in order
+			for _, e := range season.Episodes {
+				req, exists := episodes[e.EpisodeNumber]
+				if !exists {
+					continue
+				}
+				err := episodePut(
+					ctx,
+					tx,
+					seriesID,
+					seasonNumber,
+					e.EpisodeNumber,
+					contributorID,
+					req,
+				)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// movieProviderID looks up the movie external id of the metadata provider
+func (app *Application) movieProviderID(
+	ctx context.Context,
+	id int,
+) (providerID string, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the movie exists
+			_, err := tx.MovieGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			extIDs, err := tx.ExternalIDsGetAllByFilm(ctx, id)
+			if err != nil {
+				return err
+			}
+			providerID, err = app.providerID(extIDs)
+			return err
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	return providerID, nil
+}
+
+// seriesProviderID looks up the series external id of the metadata provider
+func (app *Application) seriesProviderID(
+	ctx context.Context,
+	id int,
+) (providerID string, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the series exists
+			_, err := tx.SeriesGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			extIDs, err := tx.ExternalIDsGetAllBySeries(ctx, id)
+			if err != nil {
+				return err
+			}
+			providerID, err = app.providerID(extIDs)
+			return err
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	return providerID, nil
+}
+
+func (app *Application) providerID(
+	extIDs []*models.ExternalID,
+) (string, error) {
+	for _, extID := range extIDs {
+		if extID.Provider == app.metadata.Name() {
+			return extID.ExternalID, nil
+		}
+	}
+	return "", ErrNoExternalID
+}
+
+// metadataPoster downloads the poster if there's any: too large posters and
+// unsupported image types are skipped
+func (app *Application) metadataPoster(
+	ctx context.Context,
+	url null.String,
+) (poster []byte, contentType string, err error) {
+	if !url.Valid {
+		return nil, "", nil
+	}
+	poster, err = app.metadata.Poster(ctx, url.String)
+	if err != nil {
+		if err == metadata.ErrPosterTooLarge || err == metadata.ErrNotFound {
+			return nil, "", nil
+		}
+		return nil, "", metadataError(err)
+	}
+	contentType = http.DetectContentType(poster)
+	for _, st := range config.Config.MinIO.Bucket.Image.SupportedTypes {
+		if contentType == st {
+			return poster, contentType, nil
+		}
+	}
+	return nil, "", nil
+}
+
+func metadataError(err error) error {
+	if err == metadata.ErrNotFound {
+		return ErrMetadataNotFound
+	}
+	return fmt.Errorf("%w: %s", ErrMetadataProvider, err)
+}
+
+// dropInvalidFields sets the fields of the request failing validation to
+// their zero value: req must be a pointer to a struct of json tagged fields
+func dropInvalidFields(req validation.Validatable) error {
+	err := req.Validate()
+	if err == nil {
+		return nil
+	}
+	errs, isValidationErrors := err.(validation.Errors)
+	if !isValidationErrors {
+		return err
+	}
+	v := reflect.ValueOf(req).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		name := strings.Split(v.Type().Field(i).Tag.Get("json"), ",")[0]
+		if _, invalid := errs[name]; invalid {
+			v.Field(i).Set(reflect.Zero(v.Field(i).Type()))
+		}
+	}
+	return nil
+}
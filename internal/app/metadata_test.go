@@ -0,0 +1,660 @@
+package app_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/metadata"
+	"github.com/aria3ppp/watchlist-server/internal/metadata/mock_metadata"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/repo/mock_repo"
+	"github.com/aria3ppp/watchlist-server/internal/storage"
+	"github.com/aria3ppp/watchlist-server/internal/storage/mock_storage"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+// jpeg magic number detected as image/jpeg
+var metadataPoster = []byte("\xff\xd8\xff\xe0 jpeg image")
+
+func TestMovieMetadataGet(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		movieID    = 1
+		providerID = "603"
+		movie      = &metadata.Movie{Title: "The Matrix"}
+
+		expMovieGetError      = errors.New("MovieGet error")
+		expProviderMovieError = errors.New("provider Movie error")
+	)
+
+	type MovieGetExp struct {
+		err error
+	}
+	type ExternalIDsGetAllByFilmExp struct {
+		extIDs []*models.ExternalID
+	}
+	type ExternalIDsGetAllByFilm struct {
+		call bool
+		exp  ExternalIDsGetAllByFilmExp
+	}
+	type ProviderMovieExp struct {
+		movie *metadata.Movie
+		err   error
+	}
+	type ProviderMovie struct {
+		call bool
+		exp  ProviderMovieExp
+	}
+	type Exp struct {
+		movie *metadata.Movie
+		err   error
+	}
+	type TestCase struct {
+		name                    string
+		movieGet                MovieGetExp
+		externalIDsGetAllByFilm ExternalIDsGetAllByFilm
+		providerMovie           ProviderMovie
+		exp                     Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name: "movie not found",
+			movieGet: MovieGetExp{
+				err: repo.ErrNoRecord,
+			},
+			exp: Exp{
+				err: app.ErrNotFound,
+			},
+		},
+		{
+			name: "MovieGet error",
+			movieGet: MovieGetExp{
+				err: expMovieGetError,
+			},
+			exp: Exp{
+				err: expMovieGetError,
+			},
+		},
+		{
+			name: "no provider external id",
+			externalIDsGetAllByFilm: ExternalIDsGetAllByFilm{
+				call: true,
+				exp: ExternalIDsGetAllByFilmExp{
+					extIDs: []*models.ExternalID{
+						{
+							Provider:   dto.ExternalIDProviderIMDb,
+							ExternalID: "tt0133093",
+						},
+					},
+				},
+			},
+			exp: Exp{
+				err: app.ErrNoExternalID,
+			},
+		},
+		{
+			name: "metadata not found",
+			externalIDsGetAllByFilm: ExternalIDsGetAllByFilm{
+				call: true,
+				exp: ExternalIDsGetAllByFilmExp{
+					extIDs: []*models.ExternalID{
+						{
+							Provider:   dto.ExternalIDProviderTMDb,
+							ExternalID: providerID,
+						},
+					},
+				},
+			},
+			providerMovie: ProviderMovie{
+				call: true,
+				exp: ProviderMovieExp{
+					err: metadata.ErrNotFound,
+				},
+			},
+			exp: Exp{
+				err: app.ErrMetadataNotFound,
+			},
+		},
+		{
+			name: "provider error",
+			externalIDsGetAllByFilm: ExternalIDsGetAllByFilm{
+				call: true,
+				exp: ExternalIDsGetAllByFilmExp{
+					extIDs: []*models.ExternalID{
+						{
+							Provider:   dto.ExternalIDProviderTMDb,
+							ExternalID: providerID,
+						},
+					},
+				},
+			},
+			providerMovie: ProviderMovie{
+				call: true,
+				exp: ProviderMovieExp{
+					err: expProviderMovieError,
+				},
+			},
+			exp: Exp{
+				err: app.ErrMetadataProvider,
+			},
+		},
+		{
+			name: "ok",
+			externalIDsGetAllByFilm: ExternalIDsGetAllByFilm{
+				call: true,
+				exp: ExternalIDsGetAllByFilmExp{
+					extIDs: []*models.ExternalID{
+						{
+							Provider:   dto.ExternalIDProviderIMDb,
+							ExternalID: "tt0133093",
+						},
+						{
+							Provider:   dto.ExternalIDProviderTMDb,
+							ExternalID: providerID,
+						},
+					},
+				},
+			},
+			providerMovie: ProviderMovie{
+				call: true,
+				exp: ProviderMovieExp{
+					movie: movie,
+				},
+			},
+			exp: Exp{
+				movie: movie,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+			mockProvider := mock_metadata.NewMockProvider(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(_ context.Context, _ repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+			mockRepo.EXPECT().
+				MovieGet(ctx, movieID).
+				Return(nil, tc.movieGet.err)
+			if tc.externalIDsGetAllByFilm.call {
+				mockRepo.EXPECT().
+					ExternalIDsGetAllByFilm(ctx, movieID).
+					Return(tc.externalIDsGetAllByFilm.exp.extIDs, nil)
+				mockProvider.EXPECT().
+					Name().
+					Return(dto.ExternalIDProviderTMDb).
+					AnyTimes()
+			}
+			if tc.providerMovie.call {
+				mockProvider.EXPECT().
+					Movie(ctx, providerID).
+					Return(tc.providerMovie.exp.movie, tc.providerMovie.exp.err)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, mockProvider)
+
+			movie, err := app.MovieMetadataGet(ctx, movieID)
+			require.True(errors.Is(err, tc.exp.err))
+			require.Equal(tc.exp.movie, movie)
+		})
+	}
+}
+
+func TestMovieMetadataApply(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		movieID       = 1
+		contributorID = 2
+		providerID    = "603"
+		posterURL     = "https://image.tmdb.org/t/p/w500/matrix.jpg"
+		posterURI     = "/img/movie/1/poster?versionId=1"
+
+		expProviderPosterError = errors.New("provider Poster error")
+	)
+
+	type TestCase struct {
+		name           string
+		movie          *metadata.Movie
+		poster         []byte
+		posterErr      error
+		expColumns     map[string]any
+		expPutPoster   bool
+		expErr         error
+		expErrWrapping bool
+	}
+
+	testCases := []TestCase{
+		{
+			name: "poster error",
+			movie: &metadata.Movie{
+				Title:  "The Matrix",
+				Poster: null.StringFrom(posterURL),
+			},
+			posterErr:      expProviderPosterError,
+			expErr:         app.ErrMetadataProvider,
+			expErrWrapping: true,
+		},
+		{
+			name: "invalid fields are dropped",
+			movie: &metadata.Movie{
+				Title:        "The Matrix",
+				Descriptions: null.StringFrom("a"),
+				DateReleased: null.TimeFrom(testutils.Date(1999, 3, 30)),
+				// too short
+				Duration: null.IntFrom(1),
+			},
+			expColumns: map[string]any{
+				models.FilmColumns.Title:        "The Matrix",
+				models.FilmColumns.DateReleased: testutils.Date(1999, 3, 30),
+			},
+		},
+		{
+			name: "unsupported poster is skipped",
+			movie: &metadata.Movie{
+				Title:  "The Matrix",
+				Poster: null.StringFrom(posterURL),
+			},
+			poster: []byte("plain text"),
+			expColumns: map[string]any{
+				models.FilmColumns.Title: "The Matrix",
+			},
+		},
+		{
+			name: "too large poster is skipped",
+			movie: &metadata.Movie{
+				Title:  "The Matrix",
+				Poster: null.StringFrom(posterURL),
+			},
+			posterErr: metadata.ErrPosterTooLarge,
+			expColumns: map[string]any{
+				models.FilmColumns.Title: "The Matrix",
+			},
+		},
+		{
+			name: "no valid fields",
+			movie: &metadata.Movie{
+				Poster: null.StringFrom(posterURL),
+			},
+			poster:       metadataPoster,
+			expPutPoster: true,
+		},
+		{
+			name: "ok",
+			movie: &metadata.Movie{
+				Title:        "The Matrix",
+				Descriptions: null.StringFrom("A computer hacker"),
+				DateReleased: null.TimeFrom(testutils.Date(1999, 3, 30)),
+				Duration:     null.IntFrom(8160),
+				Poster:       null.StringFrom(posterURL),
+			},
+			poster: metadataPoster,
+			expColumns: map[string]any{
+				models.FilmColumns.Title:        "The Matrix",
+				models.FilmColumns.Descriptions: "A computer hacker",
+				models.FilmColumns.DateReleased: testutils.Date(1999, 3, 30),
+				models.FilmColumns.Duration:     8160,
+			},
+			expPutPoster: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+			mockStorage := mock_storage.NewMockService(controller)
+			mockProvider := mock_metadata.NewMockProvider(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(_ context.Context, _ repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+			mockRepo.EXPECT().
+				MovieGet(ctx, movieID).
+				Return(&models.Film{ID: movieID}, nil)
+			mockRepo.EXPECT().
+				ExternalIDsGetAllByFilm(ctx, movieID).
+				Return([]*models.ExternalID{
+					{
+						Provider:   dto.ExternalIDProviderTMDb,
+						ExternalID: providerID,
+					},
+				}, nil)
+			mockProvider.EXPECT().
+				Name().
+				Return(dto.ExternalIDProviderTMDb)
+			mockProvider.EXPECT().
+				Movie(ctx, providerID).
+				Return(tc.movie, nil)
+			if tc.movie.Poster.Valid {
+				mockProvider.EXPECT().
+					Poster(ctx, posterURL).
+					Return(tc.poster, tc.posterErr)
+			}
+			if tc.expColumns != nil {
+				mockRepo.EXPECT().
+					MovieUpdate(ctx, movieID, contributorID, tc.expColumns).
+					Return(nil)
+			}
+			if tc.expPutPoster {
+				putFileCall := mockStorage.EXPECT().
+					PutFile(ctx, gomock.Any(), &storage.PutOptions{
+						Bucket:      config.Config.MinIO.Bucket.Image.Name,
+						Category:    config.Config.MinIO.Category.Movie,
+						CategoryID:  movieID,
+						Filename:    config.Config.MinIO.Filename.Movie,
+						ContentType: "image/jpeg",
+						Size:        int64(len(metadataPoster)),
+					}).
+					Return(posterURI, nil)
+				mockRepo.EXPECT().
+					MovieUpdate(ctx, movieID, contributorID, map[string]any{
+						models.FilmColumns.Poster: posterURI,
+					}).
+					Return(nil).
+					After(putFileCall)
+			}
+
+			app := app.NewApplication(
+				mockRepo,
+				nil,
+				nil,
+				nil,
+				mockStorage,
+				mockProvider,
+			)
+
+			err := app.MovieMetadataApply(ctx, movieID, contributorID)
+			if tc.expErrWrapping {
+				require.True(errors.Is(err, tc.expErr))
+			} else {
+				require.Equal(tc.expErr, err)
+			}
+		})
+	}
+}
+
+func TestSeriesMetadataApply(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	var (
+		ctx = context.Background()
+
+		seriesID      = 1
+		contributorID = 2
+		providerID    = "1396"
+		posterURL     = "https://image.tmdb.org/t/p/w500/bb.jpg"
+		posterURI     = "/img/series/1/poster?versionId=1"
+	)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockServiceTx(controller)
+	mockStorage := mock_storage.NewMockService(controller)
+	mockProvider := mock_metadata.NewMockProvider(controller)
+
+	mockRepo.EXPECT().
+		Tx(ctx, nil, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(_ context.Context, _ repo.Service) error) error {
+			return fn(ctx, mockRepo)
+		})
+	mockRepo.EXPECT().
+		SeriesGet(ctx, seriesID).
+		Return(&models.Series{ID: seriesID}, nil)
+	mockRepo.EXPECT().
+		ExternalIDsGetAllBySeries(ctx, seriesID).
+		Return([]*models.ExternalID{
+			{
+				Provider:   dto.ExternalIDProviderTMDb,
+				ExternalID: providerID,
+			},
+		}, nil)
+	mockProvider.EXPECT().
+		Name().
+		Return(dto.ExternalIDProviderTMDb)
+	mockProvider.EXPECT().
+		Series(ctx, providerID).
+		Return(&metadata.Series{
+			Title:        "Breaking Bad",
+			Descriptions: null.StringFrom("A chemistry teacher"),
+			DateStarted:  null.TimeFrom(testutils.Date(2008, 1, 20)),
+			DateEnded:    null.TimeFrom(testutils.Date(2013, 9, 29)),
+			Poster:       null.StringFrom(posterURL),
+		}, nil)
+	mockProvider.EXPECT().
+		Poster(ctx, posterURL).
+		Return(metadataPoster, nil)
+	seriesUpdateCall := mockRepo.EXPECT().
+		SeriesUpdate(ctx, seriesID, contributorID, map[string]any{
+			models.SeriesColumns.Title:        "Breaking Bad",
+			models.SeriesColumns.Descriptions: "A chemistry teacher",
+			models.SeriesColumns.DateStarted:  testutils.Date(2008, 1, 20),
+			models.SeriesColumns.DateEnded:    testutils.Date(2013, 9, 29),
+		}).
+		Return(nil)
+	putFileCall := mockStorage.EXPECT().
+		PutFile(ctx, gomock.Any(), &storage.PutOptions{
+			Bucket:      config.Config.MinIO.Bucket.Image.Name,
+			Category:    config.Config.MinIO.Category.Series,
+			CategoryID:  seriesID,
+			Filename:    config.Config.MinIO.Filename.Series,
+			ContentType: "image/jpeg",
+			Size:        int64(len(metadataPoster)),
+		}).
+		Return(posterURI, nil).
+		After(seriesUpdateCall)
+	mockRepo.EXPECT().
+		SeriesUpdate(ctx, seriesID, contributorID, map[string]any{
+			models.SeriesColumns.Poster: posterURI,
+		}).
+		Return(nil).
+		After(putFileCall)
+
+	app := app.NewApplication(
+		mockRepo,
+		nil,
+		nil,
+		nil,
+		mockStorage,
+		mockProvider,
+	)
+
+	err := app.SeriesMetadataApply(ctx, seriesID, contributorID)
+	require.NoError(err)
+}
+
+func TestSeasonMetadataApply(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		seriesID      = 1
+		seasonNumber  = 2
+		contributorID = 3
+		providerID    = "1396"
+
+		season = &metadata.Season{
+			SeasonNumber: seasonNumber,
+			Episodes: []*metadata.Episode{
+				{
+					EpisodeNumber: 1,
+					Title:         "Seven Thirty-Seven",
+					Descriptions:  null.StringFrom("Walt and Jesse"),
+					DateReleased:  null.TimeFrom(testutils.Date(2009, 3, 8)),
+					Duration:      null.IntFrom(2820),
+				},
+				{
+					// missing release date
+					EpisodeNumber: 2,
+					Title:         "Grilled",
+				},
+				{
+					// invalid episode number
+					EpisodeNumber: 0,
+					Title:         "Special",
+					DateReleased:  null.TimeFrom(testutils.Date(2009, 3, 1)),
+				},
+				{
+					// too long description is dropped
+					EpisodeNumber: 3,
+					Title:         "Bit by a Dead Bee",
+					Descriptions: null.StringFrom(
+						strings.Repeat(
+							"a",
+							config.Config.Validation.Film.Descriptions.MaxLength+1,
+						),
+					),
+					DateReleased: null.TimeFrom(testutils.Date(2009, 3, 22)),
+				},
+			},
+		}
+
+		expSeasonError     = errors.New("provider Season error")
+		expEpisodePutError = errors.New("EpisodePut error")
+	)
+
+	type TestCase struct {
+		name          string
+		seasonErr     error
+		episodePutErr error
+		expErr        error
+	}
+
+	testCases := []TestCase{
+		{
+			name:      "metadata not found",
+			seasonErr: metadata.ErrNotFound,
+			expErr:    app.ErrMetadataNotFound,
+		},
+		{
+			name:      "provider error",
+			seasonErr: expSeasonError,
+			expErr:    app.ErrMetadataProvider,
+		},
+		{
+			name:          "EpisodePut error",
+			episodePutErr: expEpisodePutError,
+			expErr:        expEpisodePutError,
+		},
+		{
+			name: "ok",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+			mockProvider := mock_metadata.NewMockProvider(controller)
+
+			txCalls := 1
+			if tc.seasonErr == nil {
+				txCalls = 2
+			}
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(_ context.Context, _ repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				}).
+				Times(txCalls)
+			mockRepo.EXPECT().
+				SeriesGet(ctx, seriesID).
+				Return(&models.Series{ID: seriesID}, nil).
+				Times(txCalls)
+			mockRepo.EXPECT().
+				ExternalIDsGetAllBySeries(ctx, seriesID).
+				Return([]*models.ExternalID{
+					{
+						Provider:   dto.ExternalIDProviderTMDb,
+						ExternalID: providerID,
+					},
+				}, nil)
+			mockProvider.EXPECT().
+				Name().
+				Return(dto.ExternalIDProviderTMDb)
+			mockProvider.EXPECT().
+				Season(ctx, providerID, seasonNumber).
+				Return(season, tc.seasonErr)
+
+			if tc.seasonErr == nil {
+				firstPut := mockRepo.EXPECT().
+					EpisodePut(
+						ctx,
+						seriesID,
+						seasonNumber,
+						1,
+						contributorID,
+						&models.Film{
+							Title:        "Seven Thirty-Seven",
+							Descriptions: null.StringFrom("Walt and Jesse"),
+							DateReleased: testutils.Date(2009, 3, 8),
+							Duration:     null.IntFrom(2820),
+						},
+					).
+					Return(tc.episodePutErr)
+				if tc.episodePutErr == nil {
+					mockRepo.EXPECT().
+						EpisodePut(
+							ctx,
+							seriesID,
+							seasonNumber,
+							3,
+							contributorID,
+							&models.Film{
+								Title:        "Bit by a Dead Bee",
+								DateReleased: testutils.Date(2009, 3, 22),
+							},
+						).
+						Return(nil).
+						After(firstPut)
+				}
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, mockProvider)
+
+			err := app.SeasonMetadataApply(
+				ctx,
+				seriesID,
+				seasonNumber,
+				contributorID,
+			)
+			require.True(errors.Is(err, tc.expErr))
+		})
+	}
+}
@@ -0,0 +1,291 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/storage"
+	"github.com/volatiletech/null/v8"
+)
+
+// UserSetModerator grants or revokes the moderator role of the user
+func (app *Application) UserSetModerator(
+	ctx context.Context,
+	userID int,
+	moderator bool,
+) error {
+	err := app.repo.UserUpdate(ctx, userID, map[string]any{
+		models.UserColumns.Moderator: moderator,
+	})
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// checkModerator returns ErrNotModerator if the user is not a moderator
+func checkModerator(ctx context.Context, r repo.Service, userID int) error {
+	user, err := r.UserGet(ctx, userID)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return ErrNotModerator
+		}
+		return err
+	}
+	if !user.Moderator {
+		return ErrNotModerator
+	}
+	return nil
+}
+
+// moderate runs fn in a transaction once the moderator is checked, mapping
+// missing records to ErrNotFound. the moderator is the actor of the audits of
+// the moderated records while their contributors are kept
+func (app *Application) moderate(
+	ctx context.Context,
+	moderatorID int,
+	fn func(ctx context.Context, tx repo.Service) error,
+) error {
+	err := app.actorTx(
+		WithActor(ctx, moderatorID),
+		func(ctx context.Context, tx repo.Service) error {
+			if err := checkModerator(ctx, tx, moderatorID); err != nil {
+				return err
+			}
+			return fn(ctx, tx)
+		},
+	)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// deletedAt returns the deletion time to hide if hide is set or else the null
+// deletion time to restore
+func deletedAt(hide bool) null.Time {
+	if hide {
+		return null.TimeFrom(time.Now())
+	}
+	return null.Time{}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// MovieHide hides the movie from the listings, searches and watchlists until
+// restored
+func (app *Application) MovieHide(
+	ctx context.Context,
+	id int,
+	moderatorID int,
+) error {
+	return app.moderate(
+		ctx,
+		moderatorID,
+		func(ctx context.Context, tx repo.Service) error {
+			return tx.MovieSetDeletedAt(ctx, id, deletedAt(true))
+		},
+	)
+}
+
+func (app *Application) MovieRestore(
+	ctx context.Context,
+	id int,
+	moderatorID int,
+) error {
+	return app.moderate(
+		ctx,
+		moderatorID,
+		func(ctx context.Context, tx repo.Service) error {
+			return tx.MovieSetDeletedAt(ctx, id, deletedAt(false))
+		},
+	)
+}
+
+// MovieDelete deletes the movie and then removes its files and search
+// document. the removal failures return an error wrapping ErrPurgeCleanup as
+// the deletion stays committed
+func (app *Application) MovieDelete(
+	ctx context.Context,
+	id int,
+	moderatorID int,
+) error {
+	err := app.moderate(
+		ctx,
+		moderatorID,
+		func(ctx context.Context, tx repo.Service) error {
+			return tx.MovieDelete(ctx, id)
+		},
+	)
+	if err != nil {
+		return err
+	}
+	return app.purgeCleanup(
+		ctx,
+		config.Config.MinIO.Category.Movie,
+		id,
+		app.search.DeleteMovie,
+	)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// SeriesHide hides the series along with its episodes from the listings,
+// searches and watchlists until restored
+func (app *Application) SeriesHide(
+	ctx context.Context,
+	id int,
+	moderatorID int,
+) error {
+	return app.moderate(
+		ctx,
+		moderatorID,
+		func(ctx context.Context, tx repo.Service) error {
+			return tx.SeriesSetDeletedAt(ctx, id, deletedAt(true))
+		},
+	)
+}
+
+// SeriesRestore restores the series along with the episodes hidden by it
+func (app *Application) SeriesRestore(
+	ctx context.Context,
+	id int,
+	moderatorID int,
+) error {
+	return app.moderate(
+		ctx,
+		moderatorID,
+		func(ctx context.Context, tx repo.Service) error {
+			return tx.SeriesSetDeletedAt(ctx, id, deletedAt(false))
+		},
+	)
+}
+
+// SeriesDelete deletes the series along with its episodes and then removes
+// its files and search document. the removal failures return an error
+// wrapping ErrPurgeCleanup as the deletion stays committed
+func (app *Application) SeriesDelete(
+	ctx context.Context,
+	id int,
+	moderatorID int,
+) error {
+	err := app.moderate(
+		ctx,
+		moderatorID,
+		func(ctx context.Context, tx repo.Service) error {
+			return tx.SeriesDelete(ctx, id)
+		},
+	)
+	if err != nil {
+		return err
+	}
+	return app.purgeCleanup(
+		ctx,
+		config.Config.MinIO.Category.Series,
+		id,
+		app.search.DeleteSeries,
+	)
+}
+
+// purgeCleanup removes the files of the category id and its search document
+// once its deletion is committed. both are removed even if one fails as
+// nothing rolls back
+func (app *Application) purgeCleanup(
+	ctx context.Context,
+	category string,
+	id int,
+	deleteDocument func(ctx context.Context, id int) error,
+) error {
+	var failures []string
+	err := app.storage.DeleteFiles(ctx, &storage.DeleteOptions{
+		Bucket:     config.Config.MinIO.Bucket.Image.Name,
+		Category:   category,
+		CategoryID: id,
+	})
+	if err != nil {
+		failures = append(failures, "files: "+err.Error())
+	}
+	if err := deleteDocument(ctx, id); err != nil {
+		failures = append(failures, "search document: "+err.Error())
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf(
+			"%w: %s",
+			ErrPurgeCleanup,
+			strings.Join(failures, "; "),
+		)
+	}
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// EpisodeHide hides the episode of the visible series
+func (app *Application) EpisodeHide(
+	ctx context.Context,
+	seriesID, seasonNumber, episodeNumber int,
+	moderatorID int,
+) error {
+	return app.moderate(
+		ctx,
+		moderatorID,
+		func(ctx context.Context, tx repo.Service) error {
+			if _, err := tx.SeriesGet(ctx, seriesID); err != nil {
+				return err
+			}
+			return tx.EpisodeSetDeletedAt(
+				ctx,
+				seriesID, seasonNumber, episodeNumber,
+				deletedAt(true),
+			)
+		},
+	)
+}
+
+// EpisodeRestore restores the episode of the visible series
+func (app *Application) EpisodeRestore(
+	ctx context.Context,
+	seriesID, seasonNumber, episodeNumber int,
+	moderatorID int,
+) error {
+	return app.moderate(
+		ctx,
+		moderatorID,
+		func(ctx context.Context, tx repo.Service) error {
+			if _, err := tx.SeriesGet(ctx, seriesID); err != nil {
+				return err
+			}
+			return tx.EpisodeSetDeletedAt(
+				ctx,
+				seriesID, seasonNumber, episodeNumber,
+				deletedAt(false),
+			)
+		},
+	)
+}
+
+// EpisodeDelete deletes the episode whether its series is hidden or not
+func (app *Application) EpisodeDelete(
+	ctx context.Context,
+	seriesID, seasonNumber, episodeNumber int,
+	moderatorID int,
+) error {
+	return app.moderate(
+		ctx,
+		moderatorID,
+		func(ctx context.Context, tx repo.Service) error {
+			return tx.EpisodeDelete(ctx, seriesID, seasonNumber, episodeNumber)
+		},
+	)
+}
@@ -0,0 +1,412 @@
+package app_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/repo/mock_repo"
+	"github.com/aria3ppp/watchlist-server/internal/search/mock_search"
+	"github.com/aria3ppp/watchlist-server/internal/storage"
+	"github.com/aria3ppp/watchlist-server/internal/storage/mock_storage"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestUserSetModerator(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		userID = 1
+	)
+
+	type TestCase struct {
+		name      string
+		moderator bool
+		updateErr error
+		expErr    error
+	}
+
+	testCases := []TestCase{
+		{
+			name:      "user not found",
+			moderator: true,
+			updateErr: repo.ErrNoRecord,
+			expErr:    app.ErrNotFound,
+		},
+		{
+			name:      "grant",
+			moderator: true,
+		},
+		{
+			name:      "revoke",
+			moderator: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				UserUpdate(ctx, userID, map[string]any{
+					models.UserColumns.Moderator: tc.moderator,
+				}).
+				Return(tc.updateErr)
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.UserSetModerator(ctx, userID, tc.moderator)
+			require.Equal(tc.expErr, err)
+		})
+	}
+}
+
+func TestMovieHide(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx         = context.Background()
+		movieID     = 1
+		moderatorID = 2
+		actorCtx    = app.WithActor(ctx, moderatorID)
+	)
+
+	type TestCase struct {
+		name       string
+		userGetErr error
+		moderator  bool
+		hideErr    error
+		expErr     error
+	}
+
+	testCases := []TestCase{
+		{
+			name:       "moderator not found",
+			userGetErr: repo.ErrNoRecord,
+			expErr:     app.ErrNotModerator,
+		},
+		{
+			name:   "not moderator",
+			expErr: app.ErrNotModerator,
+		},
+		{
+			name:      "movie not found",
+			moderator: true,
+			hideErr:   repo.ErrNoRecord,
+			expErr:    app.ErrNotFound,
+		},
+		{
+			name:      "ok",
+			moderator: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(actorCtx, nil, gomock.Any()).
+				DoAndReturn(
+					func(ctx context.Context, _ *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+						return fn(ctx, mockRepo)
+					},
+				)
+
+			mockRepo.EXPECT().
+				UserGet(actorCtx, moderatorID).
+				Return(
+					&models.User{ID: moderatorID, Moderator: tc.moderator},
+					tc.userGetErr,
+				)
+
+			if tc.moderator {
+				mockRepo.EXPECT().
+					MovieSetDeletedAt(actorCtx, movieID, gomock.Any()).
+					DoAndReturn(
+						func(_ context.Context, _ int, deletedAt null.Time) error {
+							require.True(deletedAt.Valid)
+							return tc.hideErr
+						},
+					)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.MovieHide(ctx, movieID, moderatorID)
+			require.Equal(tc.expErr, err)
+		})
+	}
+}
+
+func TestSeriesRestore(t *testing.T) {
+	t.Parallel()
+
+	require := require.New(t)
+
+	var (
+		ctx         = context.Background()
+		seriesID    = 1
+		moderatorID = 2
+		actorCtx    = app.WithActor(ctx, moderatorID)
+	)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockServiceTx(controller)
+
+	mockRepo.EXPECT().
+		Tx(actorCtx, nil, gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, _ *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+				return fn(ctx, mockRepo)
+			},
+		)
+	mockRepo.EXPECT().
+		UserGet(actorCtx, moderatorID).
+		Return(&models.User{ID: moderatorID, Moderator: true}, nil)
+	mockRepo.EXPECT().
+		SeriesSetDeletedAt(actorCtx, seriesID, null.Time{}).
+		Return(nil)
+
+	app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	err := app.SeriesRestore(ctx, seriesID, moderatorID)
+	require.NoError(err)
+}
+
+func TestEpisodeHide(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx                                   = context.Background()
+		seriesID, seasonNumber, episodeNumber = 1, 1, 1
+		moderatorID                           = 2
+		actorCtx                              = app.WithActor(ctx, moderatorID)
+	)
+
+	type TestCase struct {
+		name         string
+		seriesGetErr error
+		hideErr      error
+		expErr       error
+	}
+
+	testCases := []TestCase{
+		{
+			name:         "series not found",
+			seriesGetErr: repo.ErrNoRecord,
+			expErr:       app.ErrNotFound,
+		},
+		{
+			name:    "episode not found",
+			hideErr: repo.ErrNoRecord,
+			expErr:  app.ErrNotFound,
+		},
+		{
+			name: "ok",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(actorCtx, nil, gomock.Any()).
+				DoAndReturn(
+					func(ctx context.Context, _ *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+						return fn(ctx, mockRepo)
+					},
+				)
+			mockRepo.EXPECT().
+				UserGet(actorCtx, moderatorID).
+				Return(&models.User{ID: moderatorID, Moderator: true}, nil)
+			mockRepo.EXPECT().
+				SeriesGet(actorCtx, seriesID).
+				Return(&models.Series{ID: seriesID}, tc.seriesGetErr)
+			if tc.seriesGetErr == nil {
+				mockRepo.EXPECT().
+					EpisodeSetDeletedAt(
+						actorCtx,
+						seriesID, seasonNumber, episodeNumber,
+						gomock.Any(),
+					).
+					Return(tc.hideErr)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.EpisodeHide(
+				ctx,
+				seriesID, seasonNumber, episodeNumber,
+				moderatorID,
+			)
+			require.Equal(tc.expErr, err)
+		})
+	}
+}
+
+func TestMovieDelete(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx         = context.Background()
+		movieID     = 1
+		moderatorID = 2
+		actorCtx    = app.WithActor(ctx, moderatorID)
+		expError    = errors.New("error")
+	)
+
+	type TestCase struct {
+		name       string
+		deleteErr  error
+		storageErr error
+		searchErr  error
+		expErr     error
+	}
+
+	testCases := []TestCase{
+		{
+			name:      "movie not found",
+			deleteErr: repo.ErrNoRecord,
+			expErr:    app.ErrNotFound,
+		},
+		{
+			name:       "storage error",
+			storageErr: expError,
+			expErr:     app.ErrPurgeCleanup,
+		},
+		{
+			name:      "search error",
+			searchErr: expError,
+			expErr:    app.ErrPurgeCleanup,
+		},
+		{
+			name: "ok",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+			mockStorage := mock_storage.NewMockService(controller)
+			mockSearch := mock_search.NewMockService(controller)
+
+			mockRepo.EXPECT().
+				Tx(actorCtx, nil, gomock.Any()).
+				DoAndReturn(
+					func(ctx context.Context, _ *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+						return fn(ctx, mockRepo)
+					},
+				)
+			mockRepo.EXPECT().
+				UserGet(actorCtx, moderatorID).
+				Return(&models.User{ID: moderatorID, Moderator: true}, nil)
+			mockRepo.EXPECT().
+				MovieDelete(actorCtx, movieID).
+				Return(tc.deleteErr)
+
+			// the files and the document are removed once the deletion is
+			// committed
+			if tc.deleteErr == nil {
+				mockStorage.EXPECT().
+					DeleteFiles(ctx, &storage.DeleteOptions{
+						Bucket:     config.Config.MinIO.Bucket.Image.Name,
+						Category:   config.Config.MinIO.Category.Movie,
+						CategoryID: movieID,
+					}).
+					Return(tc.storageErr)
+				mockSearch.EXPECT().
+					DeleteMovie(ctx, movieID).
+					Return(tc.searchErr)
+			}
+
+			app := app.NewApplication(
+				mockRepo,
+				nil,
+				mockSearch,
+				nil,
+				mockStorage,
+				nil,
+			)
+
+			err := app.MovieDelete(ctx, movieID, moderatorID)
+			require.ErrorIs(err, tc.expErr)
+		})
+	}
+}
+
+func TestSeriesDelete(t *testing.T) {
+	t.Parallel()
+
+	require := require.New(t)
+
+	var (
+		ctx         = context.Background()
+		seriesID    = 1
+		moderatorID = 2
+		actorCtx    = app.WithActor(ctx, moderatorID)
+	)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockServiceTx(controller)
+	mockStorage := mock_storage.NewMockService(controller)
+	mockSearch := mock_search.NewMockService(controller)
+
+	mockRepo.EXPECT().
+		Tx(actorCtx, nil, gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, _ *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+				return fn(ctx, mockRepo)
+			},
+		)
+	mockRepo.EXPECT().
+		UserGet(actorCtx, moderatorID).
+		Return(&models.User{ID: moderatorID, Moderator: true}, nil)
+	mockRepo.EXPECT().
+		SeriesDelete(actorCtx, seriesID).
+		Return(nil)
+	mockStorage.EXPECT().
+		DeleteFiles(ctx, &storage.DeleteOptions{
+			Bucket:     config.Config.MinIO.Bucket.Image.Name,
+			Category:   config.Config.MinIO.Category.Series,
+			CategoryID: seriesID,
+		}).
+		Return(nil)
+	mockSearch.EXPECT().
+		DeleteSeries(ctx, seriesID).
+		Return(nil)
+
+	app := app.NewApplication(mockRepo, nil, mockSearch, nil, mockStorage, nil)
+
+	err := app.SeriesDelete(ctx, seriesID, moderatorID)
+	require.NoError(err)
+}
@@ -14,6 +14,7 @@ import (
 func (app *Application) MovieGet(
 	ctx context.Context,
 	id int,
+	localeOptions query.LocaleOptions,
 ) (*models.Film, error) {
 	movie, err := app.repo.MovieGet(ctx, id)
 	if err != nil {
@@ -22,24 +23,36 @@ func (app *Application) MovieGet(
 		}
 		return nil, err
 	}
+	if err := localizeFilms(ctx, app.repo, localeOptions, movie); err != nil {
+		return nil, err
+	}
 	return movie, nil
 }
 
 func (app *Application) MoviesGetAll(
 	ctx context.Context,
 	queryOptions query.Options,
+	releaseOptions query.ReleaseOptions,
+	localeOptions query.LocaleOptions,
 ) (movies []*models.Film, total int, err error) {
 	err = app.repo.Tx(
 		ctx,
 		nil,
 		func(ctx context.Context, tx repo.Service) error {
 			var err error
-			movies, err = tx.MoviesGetAll(ctx, queryOptions)
+			movies, err = tx.MoviesGetAll(ctx, queryOptions, releaseOptions)
 			if err != nil {
 				return err
 			}
-			total, err = tx.MoviesCount(ctx)
-			return err
+			total, err = tx.MoviesCount(
+				ctx,
+				queryOptions.Invalidation,
+				releaseOptions,
+			)
+			if err != nil {
+				return err
+			}
+			return localizeFilms(ctx, tx, localeOptions, movies...)
 		},
 	)
 	if err != nil {
@@ -74,6 +87,11 @@ func (app *Application) MovieUpdate(
 	contributorID int,
 	req *dto.MovieUpdateRequest,
 ) error {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return err
+	}
+
 	columns := movieUpdateRequestToValidMap(req)
 
 	err := app.repo.MovieUpdate(ctx, id, contributorID, columns)
@@ -112,6 +130,11 @@ func (app *Application) MovieInvalidate(
 	contributorID int,
 	req *dto.InvalidationRequest,
 ) error {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return err
+	}
+
 	err := app.repo.MovieUpdate(
 		ctx,
 		id,
@@ -176,6 +199,11 @@ func (app *Application) MoviePutPoster(
 	poster io.Reader,
 	options *storage.PutOptions,
 ) (uri string, err error) {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return "", err
+	}
+
 	// put file
 	uri, err = app.storage.PutFile(ctx, poster, options)
 	if err != nil {
@@ -107,9 +107,9 @@ func TestMovieGet(t *testing.T) {
 				MovieGet(ctx, id).
 				Return(tc.get.exp.movie, tc.get.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
-			movie, err := app.MovieGet(ctx, id)
+			movie, err := app.MovieGet(ctx, id, query.LocaleOptions{})
 			require.Equal(tc.exp.err, err)
 			require.Equal(tc.exp.movie, movie)
 		})
@@ -128,6 +128,11 @@ func TestMoviesGetAll(t *testing.T) {
 			SortField: models.FilmColumns.ID,
 			SortOrder: "asc",
 		}
+		releaseOptions = query.ReleaseOptions{
+			Region:   "DE",
+			Released: true,
+			MaxAge:   null.IntFrom(12),
+		}
 
 		expMovies            = []*models.Film{{Title: "movie"}}
 		expTotal             = 1000
@@ -259,20 +264,25 @@ func TestMoviesGetAll(t *testing.T) {
 				Return(tc.tx.exp.err)
 
 			getAllCall := mockRepo.EXPECT().
-				MoviesGetAll(ctx, queryOptions).
+				MoviesGetAll(ctx, queryOptions, releaseOptions).
 				Return(tc.getAll.exp.movies, tc.getAll.exp.err).
 				After(txCall)
 
 			if tc.getAll.exp.err == nil {
 				mockRepo.EXPECT().
-					MoviesCount(ctx).
+					MoviesCount(ctx, queryOptions.Invalidation, releaseOptions).
 					Return(tc.count.exp.total, tc.count.exp.err).
 					After(getAllCall)
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
-			movies, total, err := app.MoviesGetAll(ctx, queryOptions)
+			movies, total, err := app.MoviesGetAll(
+				ctx,
+				queryOptions,
+				releaseOptions,
+				query.LocaleOptions{},
+			)
 			require.Equal(tc.exp.err, err)
 			require.Equal(tc.exp.movies, movies)
 			require.Equal(tc.exp.total, total)
@@ -359,7 +369,7 @@ func TestMovieCreate(t *testing.T) {
 				}).
 				Return(tc.create.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			id, err := app.MovieCreate(ctx, contributorID, req)
 			require.Equal(tc.exp.err, err)
@@ -458,7 +468,7 @@ func TestMovieUpdate(t *testing.T) {
 				MovieUpdate(ctx, id, contributorID, movieUpdateRequestToValidMap(req)).
 				Return(tc.update.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			err := app.MovieUpdate(ctx, id, contributorID, req)
 			require.Equal(tc.exp.err, err)
@@ -549,7 +559,7 @@ func TestMovieInvalidate(t *testing.T) {
 				}).
 				Return(tc.movieInvalidate.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			err := app.MovieInvalidate(ctx, id, contributorID, req)
 			require.Equal(tc.exp.err, err)
@@ -788,7 +798,7 @@ func TestMovieAuditsGetAll(t *testing.T) {
 				}
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			audits, total, err := app.MovieAuditsGetAll(ctx, id, queryOptions)
 			require.Equal(tc.exp.err, err)
@@ -879,7 +889,7 @@ func TestMoviesSearch(t *testing.T) {
 				SearchMovies(ctx, queryOptions).
 				Return(tc.search.exp.movies, tc.exp.total, tc.search.exp.err)
 
-			app := app.NewApplication(nil, nil, mockSearch, nil, nil)
+			app := app.NewApplication(nil, nil, mockSearch, nil, nil, nil)
 
 			movies, total, err := app.MoviesSearch(ctx, queryOptions)
 			require.Equal(tc.exp.err, err)
@@ -1020,7 +1030,7 @@ func TestMoviePutPoster(t *testing.T) {
 					After(putFileCall)
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, mockStorage)
+			app := app.NewApplication(mockRepo, nil, nil, nil, mockStorage, nil)
 
 			uri, err := app.MoviePutPoster(
 				ctx,
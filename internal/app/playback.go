@@ -0,0 +1,154 @@
+package app
+
+import (
+	"context"
+
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/watchlist"
+	"github.com/volatiletech/null/v8"
+)
+
+func (app *Application) PlaybackProgressGet(
+	ctx context.Context,
+	userID int,
+	filmID int,
+) (*models.PlaybackProgress, error) {
+	progress, err := app.repo.PlaybackProgressGet(ctx, userID, filmID)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return progress, nil
+}
+
+// PlaybackProgressPut puts the playback progress of the film unless a later
+// update of it is already put and returns the put progress: the later one if
+// any. once the position crosses the watched threshold of the film duration
+// the latest added watchlist item of the film is marked watched
+func (app *Application) PlaybackProgressPut(
+	ctx context.Context,
+	userID int,
+	filmID int,
+	req *dto.PlaybackProgressPutRequest,
+) (progress *models.PlaybackProgress, watched bool, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			film, err := tx.FilmGet(ctx, filmID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			prev, err := tx.PlaybackProgressGet(ctx, userID, filmID)
+			if err != nil && err != repo.ErrNoRecord {
+				return err
+			}
+			progress = &models.PlaybackProgress{
+				UserID:    userID,
+				FilmID:    filmID,
+				Position:  req.Position,
+				Device:    req.Device,
+				UpdatedAt: req.UpdatedAt.Time,
+			}
+			applied, err := tx.PlaybackProgressPut(ctx, progress)
+			if err != nil {
+				return err
+			}
+			if !applied {
+				// the later put progress wins
+				progress, err = tx.PlaybackProgressGet(ctx, userID, filmID)
+				return err
+			}
+			if !playbackWatched(progress.Position, film.Duration) ||
+				(prev != nil && playbackWatched(prev.Position, film.Duration)) {
+				return nil
+			}
+			watchID, err := tx.WatchlistGetIDByFilm(ctx, userID, filmID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return nil
+				}
+				return err
+			}
+			err = tx.WatchEventCreate(
+				ctx,
+				userID,
+				watchID,
+				&models.WatchEvent{
+					TimeWatched: progress.UpdatedAt,
+					Device:      progress.Device,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			watched = true
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, false, err
+	}
+	return progress, watched, nil
+}
+
+// ContinueWatchingGet returns the playback progress of the user not crossed
+// the watched threshold of the film duration: the latest updated first
+func (app *Application) ContinueWatchingGet(
+	ctx context.Context,
+	userID int,
+	offset int,
+	limit int,
+	localeOptions query.LocaleOptions,
+) (progress []*watchlist.Progress, total int, err error) {
+	threshold := config.Config.Playback.WatchedThresholdPercent
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			var err error
+			progress, err = tx.ContinueWatchingGet(
+				ctx,
+				userID,
+				offset,
+				limit,
+				threshold,
+			)
+			if err != nil {
+				return err
+			}
+			total, err = tx.ContinueWatchingCount(ctx, userID, threshold)
+			if err != nil {
+				return err
+			}
+			films := make([]*models.Film, len(progress))
+			for i, p := range progress {
+				films[i] = &p.Film
+			}
+			return localizeFilms(ctx, tx, localeOptions, films...)
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return progress, total, nil
+}
+
+// playbackWatched reports whether the position crosses the watched threshold
+// of the duration, both in seconds: a non-positive threshold never does
+func playbackWatched(position int, duration null.Int) bool {
+	threshold := config.Config.Playback.WatchedThresholdPercent
+	if threshold <= 0 || !duration.Valid || duration.Int <= 0 {
+		return false
+	}
+	return position*100 >= duration.Int*threshold
+}
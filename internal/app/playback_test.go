@@ -0,0 +1,292 @@
+package app_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/repo/mock_repo"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/aria3ppp/watchlist-server/internal/watchlist"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestPlaybackProgressGet(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = context.Background()
+		userID   = 1
+		filmID   = 2
+		progress = &models.PlaybackProgress{
+			UserID:   userID,
+			FilmID:   filmID,
+			Position: 120,
+		}
+	)
+
+	type TestCase struct {
+		name        string
+		getProgress *models.PlaybackProgress
+		getErr      error
+		expProgress *models.PlaybackProgress
+		expErr      error
+	}
+
+	testCases := []TestCase{
+		{
+			name:   "not found",
+			getErr: repo.ErrNoRecord,
+			expErr: app.ErrNotFound,
+		},
+		{
+			name:        "ok",
+			getProgress: progress,
+			expProgress: progress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				PlaybackProgressGet(ctx, userID, filmID).
+				Return(tc.getProgress, tc.getErr)
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			gotProgress, err := app.PlaybackProgressGet(ctx, userID, filmID)
+			require.Equal(tc.expErr, err)
+			require.Equal(tc.expProgress, gotProgress)
+		})
+	}
+}
+
+func TestPlaybackProgressPut(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx       = context.Background()
+		userID    = 1
+		filmID    = 2
+		watchID   = 3
+		updatedAt = testutils.Date(2020, 1, 1)
+		// an hour: crossed at 90 percent by default
+		film = &models.Film{ID: filmID, Duration: null.IntFrom(60 * 60)}
+	)
+
+	progressAt := func(position int) *models.PlaybackProgress {
+		return &models.PlaybackProgress{
+			UserID:    userID,
+			FilmID:    filmID,
+			Position:  position,
+			Device:    null.StringFrom("tv"),
+			UpdatedAt: updatedAt,
+		}
+	}
+
+	type TestCase struct {
+		name        string
+		position    int
+		filmErr     error
+		prev        *models.PlaybackProgress
+		applied     bool
+		latest      *models.PlaybackProgress
+		lookupItem  bool
+		hasItem     bool
+		expProgress *models.PlaybackProgress
+		expWatched  bool
+		expErr      error
+	}
+
+	testCases := []TestCase{
+		{
+			name:     "film not found",
+			position: 600,
+			filmErr:  repo.ErrNoRecord,
+			expErr:   app.ErrNotFound,
+		},
+		{
+			name:        "later progress wins",
+			position:    600,
+			prev:        progressAt(1200),
+			applied:     false,
+			latest:      progressAt(1200),
+			expProgress: progressAt(1200),
+		},
+		{
+			name:        "below threshold",
+			position:    600,
+			applied:     true,
+			expProgress: progressAt(600),
+		},
+		{
+			name:        "crosses threshold",
+			position:    3300,
+			prev:        progressAt(600),
+			applied:     true,
+			lookupItem:  true,
+			hasItem:     true,
+			expProgress: progressAt(3300),
+			expWatched:  true,
+		},
+		{
+			name:        "crosses threshold not in watchlist",
+			position:    3300,
+			applied:     true,
+			lookupItem:  true,
+			expProgress: progressAt(3300),
+		},
+		{
+			name:        "crossed threshold already",
+			position:    3500,
+			prev:        progressAt(3300),
+			applied:     true,
+			expProgress: progressAt(3500),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+
+			if tc.filmErr != nil {
+				mockRepo.EXPECT().
+					FilmGet(ctx, filmID).
+					Return(nil, tc.filmErr)
+			} else {
+				mockRepo.EXPECT().
+					FilmGet(ctx, filmID).
+					Return(film, nil)
+
+				getErr := error(nil)
+				if tc.prev == nil {
+					getErr = repo.ErrNoRecord
+				}
+				getPrev := mockRepo.EXPECT().
+					PlaybackProgressGet(ctx, userID, filmID).
+					Return(tc.prev, getErr)
+
+				put := mockRepo.EXPECT().
+					PlaybackProgressPut(ctx, progressAt(tc.position)).
+					Return(tc.applied, nil).
+					After(getPrev)
+
+				if !tc.applied {
+					mockRepo.EXPECT().
+						PlaybackProgressGet(ctx, userID, filmID).
+						Return(tc.latest, nil).
+						After(put)
+				}
+
+				if tc.lookupItem {
+					getItemErr := error(nil)
+					if !tc.hasItem {
+						getItemErr = repo.ErrNoRecord
+					}
+					mockRepo.EXPECT().
+						WatchlistGetIDByFilm(ctx, userID, filmID).
+						Return(watchID, getItemErr)
+				}
+
+				if tc.expWatched {
+					mockRepo.EXPECT().
+						WatchEventCreate(ctx, userID, watchID, &models.WatchEvent{
+							TimeWatched: updatedAt,
+							Device:      null.StringFrom("tv"),
+						}).
+						Return(nil)
+				}
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			gotProgress, gotWatched, err := app.PlaybackProgressPut(
+				ctx,
+				userID,
+				filmID,
+				&dto.PlaybackProgressPutRequest{
+					Position:  tc.position,
+					Device:    null.StringFrom("tv"),
+					UpdatedAt: null.TimeFrom(updatedAt),
+				},
+			)
+			require.Equal(tc.expErr, err)
+			require.Equal(tc.expProgress, gotProgress)
+			require.Equal(tc.expWatched, gotWatched)
+		})
+	}
+}
+
+func TestContinueWatchingGet(t *testing.T) {
+	t.Parallel()
+
+	require := require.New(t)
+
+	var (
+		ctx      = context.Background()
+		userID   = 1
+		progress = []*watchlist.Progress{
+			{
+				PlaybackProgress: models.PlaybackProgress{
+					UserID:   userID,
+					FilmID:   2,
+					Position: 120,
+				},
+				Film: models.Film{ID: 2},
+			},
+		}
+	)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockServiceTx(controller)
+
+	mockRepo.EXPECT().
+		Tx(ctx, nil, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+			return fn(ctx, mockRepo)
+		})
+	mockRepo.EXPECT().
+		ContinueWatchingGet(ctx, userID, 0, 10, 90).
+		Return(progress, nil)
+	mockRepo.EXPECT().
+		ContinueWatchingCount(ctx, userID, 90).
+		Return(len(progress), nil)
+
+	app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	gotProgress, total, err := app.ContinueWatchingGet(
+		ctx,
+		userID,
+		0,
+		10,
+		query.LocaleOptions{},
+	)
+	require.NoError(err)
+	require.Equal(progress, gotProgress)
+	require.Equal(len(progress), total)
+}
@@ -0,0 +1,226 @@
+package app
+
+import (
+	"context"
+
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/rating"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+)
+
+func (app *Application) MovieReleasesGet(
+	ctx context.Context,
+	id int,
+) (releases []*models.Release, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the movie exists
+			_, err := tx.MovieGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// fetch releases
+			releases, err = tx.ReleasesGetAllByFilm(ctx, id)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return releases, nil
+}
+
+func (app *Application) MovieReleasePut(
+	ctx context.Context,
+	id int,
+	contributorID int,
+	req *dto.ReleasePutRequest,
+) error {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return err
+	}
+
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the movie exists
+			_, err := tx.MovieGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// put the release
+			return tx.ReleasePut(
+				ctx,
+				id,
+				contributorID,
+				releasePutRequestToModel(req),
+			)
+		},
+	)
+}
+
+func (app *Application) MovieReleaseAuditsGetAll(
+	ctx context.Context,
+	id int,
+	queryOptions query.SortOrderOptions,
+) (audits []*models.ReleasesAudit, total int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the movie exists
+			_, err := tx.MovieGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// fetch audits
+			audits, err = tx.ReleaseAuditsGetAllByFilm(ctx, id, queryOptions)
+			if err != nil {
+				return err
+			}
+			// count total audits
+			total, err = tx.ReleaseAuditsCountByFilm(ctx, id)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return audits, total, nil
+}
+
+//------------------------------------------------------------------------------
+
+func (app *Application) MovieContentRatingsGet(
+	ctx context.Context,
+	id int,
+) (contentRatings []*models.ContentRating, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the movie exists
+			_, err := tx.MovieGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// fetch content ratings
+			contentRatings, err = tx.ContentRatingsGetAllByFilm(ctx, id)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return contentRatings, nil
+}
+
+func (app *Application) MovieContentRatingPut(
+	ctx context.Context,
+	id int,
+	contributorID int,
+	req *dto.ContentRatingPutRequest,
+) error {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return err
+	}
+
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the movie exists
+			_, err := tx.MovieGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// put the content rating
+			return tx.ContentRatingPut(
+				ctx,
+				id,
+				contributorID,
+				contentRatingPutRequestToModel(req),
+			)
+		},
+	)
+}
+
+func (app *Application) MovieContentRatingAuditsGetAll(
+	ctx context.Context,
+	id int,
+	queryOptions query.SortOrderOptions,
+) (audits []*models.ContentRatingsAudit, total int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the movie exists
+			_, err := tx.MovieGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// fetch audits
+			audits, err = tx.ContentRatingAuditsGetAllByFilm(ctx, id, queryOptions)
+			if err != nil {
+				return err
+			}
+			// count total audits
+			total, err = tx.ContentRatingAuditsCountByFilm(ctx, id)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return audits, total, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func releasePutRequestToModel(req *dto.ReleasePutRequest) *models.Release {
+	return &models.Release{
+		Region:       req.Region,
+		ReleaseType:  req.ReleaseType,
+		DateReleased: req.DateReleased,
+	}
+}
+
+// contentRatingPutRequestToModel resolves the rating system and the minimum
+// age of the validated rating of the region
+func contentRatingPutRequestToModel(
+	req *dto.ContentRatingPutRequest,
+) *models.ContentRating {
+	system, _ := rating.Lookup(req.Region)
+	r, _ := system.Rating(req.Rating)
+	return &models.ContentRating{
+		Region:       req.Region,
+		RatingSystem: system.Name,
+		Rating:       r.Rating,
+		MinAge:       r.MinAge,
+	}
+}
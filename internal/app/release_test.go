@@ -0,0 +1,175 @@
+package app_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/repo/mock_repo"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMovieReleasePut(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		id            = 1
+		contributorID = 1
+		req           = &dto.ReleasePutRequest{
+			Region:       "DE",
+			ReleaseType:  dto.ReleaseTypeStreaming,
+			DateReleased: testutils.Date(2000, 1, 1),
+		}
+		expRelease = &models.Release{
+			Region:       "DE",
+			ReleaseType:  dto.ReleaseTypeStreaming,
+			DateReleased: testutils.Date(2000, 1, 1),
+		}
+
+		expMovieGetError   = errors.New("MovieGet error")
+		expReleasePutError = errors.New("ReleasePut error")
+	)
+
+	type TestCase struct {
+		name        string
+		movieGetErr error
+		putCall     bool
+		putErr      error
+		expErr      error
+	}
+
+	testCases := []TestCase{
+		{
+			name:        "not found",
+			movieGetErr: repo.ErrNoRecord,
+			expErr:      app.ErrNotFound,
+		},
+		{
+			name:        "MovieGet error",
+			movieGetErr: expMovieGetError,
+			expErr:      expMovieGetError,
+		},
+		{
+			name:    "ReleasePut error",
+			putCall: true,
+			putErr:  expReleasePutError,
+			expErr:  expReleasePutError,
+		},
+		{
+			name:    "ok",
+			putCall: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(_ context.Context, _ repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+
+			mockRepo.EXPECT().
+				MovieGet(ctx, id).
+				Return(&models.Film{ID: id}, tc.movieGetErr)
+
+			if tc.putCall {
+				mockRepo.EXPECT().
+					ReleasePut(ctx, id, contributorID, expRelease).
+					Return(tc.putErr)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.MovieReleasePut(ctx, id, contributorID, req)
+			require.Equal(tc.expErr, err)
+		})
+	}
+}
+
+func TestMovieContentRatingPut(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		id            = 1
+		contributorID = 1
+	)
+
+	type TestCase struct {
+		name             string
+		req              *dto.ContentRatingPutRequest
+		expContentRating *models.ContentRating
+	}
+
+	// the rating system and the minimum age are resolved by region
+	testCases := []TestCase{
+		{
+			name: "US",
+			req:  &dto.ContentRatingPutRequest{Region: "US", Rating: "PG-13"},
+			expContentRating: &models.ContentRating{
+				Region:       "US",
+				RatingSystem: "MPA",
+				Rating:       "PG-13",
+				MinAge:       13,
+			},
+		},
+		{
+			name: "DE",
+			req:  &dto.ContentRatingPutRequest{Region: "DE", Rating: "16"},
+			expContentRating: &models.ContentRating{
+				Region:       "DE",
+				RatingSystem: "FSK",
+				Rating:       "16",
+				MinAge:       16,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(_ context.Context, _ repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+
+			mockRepo.EXPECT().
+				MovieGet(ctx, id).
+				Return(&models.Film{ID: id}, nil)
+
+			mockRepo.EXPECT().
+				ContentRatingPut(ctx, id, contributorID, tc.expContentRating).
+				Return(nil)
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.MovieContentRatingPut(ctx, id, contributorID, tc.req)
+			require.NoError(err)
+		})
+	}
+}
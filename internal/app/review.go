@@ -0,0 +1,294 @@
+package app
+
+import (
+	"context"
+
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/review"
+)
+
+// MovieReviewPut writes the review of the user on the movie or edits it: the
+// previous version is kept in the review history
+func (app *Application) MovieReviewPut(
+	ctx context.Context,
+	userID int,
+	movieID int,
+	req *dto.ReviewPutRequest,
+) (r *models.Review, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := tx.MovieGet(ctx, movieID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			r = &models.Review{Body: req.Body, Spoiler: req.Spoiler}
+			return tx.FilmReviewPut(ctx, userID, movieID, r)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (app *Application) MovieReviewDelete(
+	ctx context.Context,
+	userID int,
+	movieID int,
+) error {
+	err := app.repo.FilmReviewDelete(ctx, userID, movieID)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// MovieReviewsGetAll returns the published reviews of the movie along with the
+// hidden review of the user if any
+func (app *Application) MovieReviewsGetAll(
+	ctx context.Context,
+	userID int,
+	movieID int,
+	queryOptions query.ReviewOptions,
+) (reviews []*review.Review, total int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the movie exists
+			_, err := tx.MovieGet(ctx, movieID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// fetch reviews
+			reviews, err = tx.ReviewsGetAllByFilm(
+				ctx,
+				movieID,
+				userID,
+				queryOptions,
+			)
+			if err != nil {
+				return err
+			}
+			// count total reviews
+			total, err = tx.ReviewsCountByFilm(ctx, movieID, userID)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return reviews, total, nil
+}
+
+// SeriesReviewPut writes the review of the user on the series or edits it: the
+// previous version is kept in the review history
+func (app *Application) SeriesReviewPut(
+	ctx context.Context,
+	userID int,
+	seriesID int,
+	req *dto.ReviewPutRequest,
+) (r *models.Review, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := tx.SeriesGet(ctx, seriesID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			r = &models.Review{Body: req.Body, Spoiler: req.Spoiler}
+			return tx.SeriesReviewPut(ctx, userID, seriesID, r)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (app *Application) SeriesReviewDelete(
+	ctx context.Context,
+	userID int,
+	seriesID int,
+) error {
+	err := app.repo.SeriesReviewDelete(ctx, userID, seriesID)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// SeriesReviewsGetAll returns the published reviews of the series along with
+// the hidden review of the user if any
+func (app *Application) SeriesReviewsGetAll(
+	ctx context.Context,
+	userID int,
+	seriesID int,
+	queryOptions query.ReviewOptions,
+) (reviews []*review.Review, total int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the series exists
+			_, err := tx.SeriesGet(ctx, seriesID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// fetch reviews
+			reviews, err = tx.ReviewsGetAllBySeries(
+				ctx,
+				seriesID,
+				userID,
+				queryOptions,
+			)
+			if err != nil {
+				return err
+			}
+			// count total reviews
+			total, err = tx.ReviewsCountBySeries(ctx, seriesID, userID)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return reviews, total, nil
+}
+
+// ReviewVote votes the review helpful: the authors cannot vote their own
+// reviews
+func (app *Application) ReviewVote(
+	ctx context.Context,
+	userID int,
+	reviewID int,
+) error {
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			r, err := reviewGetVisible(ctx, tx, userID, reviewID)
+			if err != nil {
+				return err
+			}
+			if r.UserID == userID {
+				return ErrOwnReview
+			}
+			return tx.ReviewVotePut(ctx, reviewID, userID)
+		},
+	)
+}
+
+func (app *Application) ReviewUnvote(
+	ctx context.Context,
+	userID int,
+	reviewID int,
+) error {
+	err := app.repo.ReviewVoteDelete(ctx, reviewID, userID)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// ReviewAuditsGetAll returns the edit history of the review
+func (app *Application) ReviewAuditsGetAll(
+	ctx context.Context,
+	userID int,
+	reviewID int,
+	queryOptions query.SortOrderOptions,
+) (audits []*models.ReviewsAudit, total int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the review is visible to the user
+			_, err := reviewGetVisible(ctx, tx, userID, reviewID)
+			if err != nil {
+				return err
+			}
+			// fetch audits
+			audits, err = tx.ReviewAuditsGetAll(ctx, reviewID, queryOptions)
+			if err != nil {
+				return err
+			}
+			// count total audits
+			total, err = tx.ReviewAuditsCount(ctx, reviewID)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return audits, total, nil
+}
+
+// ReviewSetStatus publishes or hides the review
+func (app *Application) ReviewSetStatus(
+	ctx context.Context,
+	reviewID int,
+	moderatorID int,
+	req *dto.ReviewStatusRequest,
+) error {
+	return app.moderate(
+		ctx,
+		moderatorID,
+		func(ctx context.Context, tx repo.Service) error {
+			return tx.ReviewSetStatus(ctx, reviewID, req.Status)
+		},
+	)
+}
+
+// reviewGetVisible returns the review if visible to the user: hidden reviews
+// are visible to their authors and the moderators only
+func reviewGetVisible(
+	ctx context.Context,
+	tx repo.Service,
+	userID int,
+	reviewID int,
+) (*models.Review, error) {
+	r, err := tx.ReviewGet(ctx, reviewID)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if r.Status == review.StatusHidden && r.UserID != userID {
+		err = checkModerator(ctx, tx, userID)
+		if err != nil {
+			if err == ErrNotModerator {
+				return nil, ErrNotFound
+			}
+			return nil, err
+		}
+	}
+	return r, nil
+}
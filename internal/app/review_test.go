@@ -0,0 +1,341 @@
+package app_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/repo/mock_repo"
+	"github.com/aria3ppp/watchlist-server/internal/review"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMovieReviewPut(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx         = context.Background()
+		userID      = 1
+		movieID     = 2
+		req         = &dto.ReviewPutRequest{Body: "body", Spoiler: true}
+		expPutError = errors.New("FilmReviewPut error")
+	)
+
+	type TestCase struct {
+		name   string
+		getErr error
+		putErr error
+		expErr error
+	}
+
+	testCases := []TestCase{
+		{
+			name:   "movie not found",
+			getErr: repo.ErrNoRecord,
+			expErr: app.ErrNotFound,
+		},
+		{
+			name:   "FilmReviewPut error",
+			putErr: expPutError,
+			expErr: expPutError,
+		},
+		{
+			name: "ok",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+			mockRepo.EXPECT().
+				MovieGet(ctx, movieID).
+				Return(&models.Film{ID: movieID}, tc.getErr)
+			if tc.getErr == nil {
+				mockRepo.EXPECT().
+					FilmReviewPut(
+						ctx,
+						userID,
+						movieID,
+						&models.Review{Body: req.Body, Spoiler: req.Spoiler},
+					).
+					Return(tc.putErr)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			r, err := app.MovieReviewPut(ctx, userID, movieID, req)
+			require.Equal(tc.expErr, err)
+			if tc.expErr == nil {
+				require.Equal(req.Body, r.Body)
+				require.True(r.Spoiler)
+			} else {
+				require.Nil(r)
+			}
+		})
+	}
+}
+
+func TestMovieReviewsGetAll(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx          = context.Background()
+		userID       = 1
+		movieID      = 2
+		queryOptions = query.ReviewOptions{
+			Offset:    0,
+			Limit:     10,
+			SortField: query.ReviewSortHelpful,
+			SortOrder: "desc",
+		}
+		reviews = []*review.Review{
+			{Review: models.Review{ID: 1}, HelpfulVotes: 2},
+			{Review: models.Review{ID: 2}, HelpfulVotes: 0},
+		}
+	)
+
+	type TestCase struct {
+		name       string
+		getErr     error
+		expReviews []*review.Review
+		expTotal   int
+		expErr     error
+	}
+
+	testCases := []TestCase{
+		{
+			name:   "movie not found",
+			getErr: repo.ErrNoRecord,
+			expErr: app.ErrNotFound,
+		},
+		{
+			name:       "ok",
+			expReviews: reviews,
+			expTotal:   len(reviews),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+			mockRepo.EXPECT().
+				MovieGet(ctx, movieID).
+				Return(&models.Film{ID: movieID}, tc.getErr)
+			if tc.getErr == nil {
+				mockRepo.EXPECT().
+					ReviewsGetAllByFilm(ctx, movieID, userID, queryOptions).
+					Return(reviews, nil)
+				mockRepo.EXPECT().
+					ReviewsCountByFilm(ctx, movieID, userID).
+					Return(len(reviews), nil)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			gotReviews, total, err := app.MovieReviewsGetAll(
+				ctx,
+				userID,
+				movieID,
+				queryOptions,
+			)
+			require.Equal(tc.expErr, err)
+			require.Equal(tc.expReviews, gotReviews)
+			require.Equal(tc.expTotal, total)
+		})
+	}
+}
+
+func TestReviewVote(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = context.Background()
+		userID   = 1
+		authorID = 2
+		reviewID = 3
+	)
+
+	type TestCase struct {
+		name      string
+		review    *models.Review
+		getErr    error
+		moderator bool
+		expErr    error
+	}
+
+	testCases := []TestCase{
+		{
+			name:   "review not found",
+			getErr: repo.ErrNoRecord,
+			expErr: app.ErrNotFound,
+		},
+		{
+			name: "hidden review",
+			review: &models.Review{
+				ID:     reviewID,
+				UserID: authorID,
+				Status: review.StatusHidden,
+			},
+			expErr: app.ErrNotFound,
+		},
+		{
+			name: "own review",
+			review: &models.Review{
+				ID:     reviewID,
+				UserID: userID,
+				Status: review.StatusPublished,
+			},
+			expErr: app.ErrOwnReview,
+		},
+		{
+			name: "hidden review voted by moderator",
+			review: &models.Review{
+				ID:     reviewID,
+				UserID: authorID,
+				Status: review.StatusHidden,
+			},
+			moderator: true,
+		},
+		{
+			name: "ok",
+			review: &models.Review{
+				ID:     reviewID,
+				UserID: authorID,
+				Status: review.StatusPublished,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+			mockRepo.EXPECT().
+				ReviewGet(ctx, reviewID).
+				Return(tc.review, tc.getErr)
+			if tc.review != nil && tc.review.Status == review.StatusHidden {
+				mockRepo.EXPECT().
+					UserGet(ctx, userID).
+					Return(&models.User{ID: userID, Moderator: tc.moderator}, nil)
+			}
+			if tc.expErr == nil {
+				mockRepo.EXPECT().
+					ReviewVotePut(ctx, reviewID, userID).
+					Return(nil)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.ReviewVote(ctx, userID, reviewID)
+			require.Equal(tc.expErr, err)
+		})
+	}
+}
+
+func TestReviewSetStatus(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx         = context.Background()
+		moderatorID = 1
+		actorCtx    = app.WithActor(ctx, moderatorID)
+		reviewID    = 2
+		req         = &dto.ReviewStatusRequest{Status: review.StatusHidden}
+	)
+
+	type TestCase struct {
+		name      string
+		moderator bool
+		setErr    error
+		expErr    error
+	}
+
+	testCases := []TestCase{
+		{
+			name:   "not moderator",
+			expErr: app.ErrNotModerator,
+		},
+		{
+			name:      "review not found",
+			moderator: true,
+			setErr:    repo.ErrNoRecord,
+			expErr:    app.ErrNotFound,
+		},
+		{
+			name:      "ok",
+			moderator: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(actorCtx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+			mockRepo.EXPECT().
+				UserGet(actorCtx, moderatorID).
+				Return(
+					&models.User{ID: moderatorID, Moderator: tc.moderator},
+					nil,
+				)
+			if tc.moderator {
+				mockRepo.EXPECT().
+					ReviewSetStatus(actorCtx, reviewID, review.StatusHidden).
+					Return(tc.setErr)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.ReviewSetStatus(ctx, reviewID, moderatorID, req)
+			require.Equal(tc.expErr, err)
+		})
+	}
+}
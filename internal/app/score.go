@@ -0,0 +1,164 @@
+package app
+
+import (
+	"context"
+
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+)
+
+// MovieScorePut scores the movie or edits the score of the user on the movie
+func (app *Application) MovieScorePut(
+	ctx context.Context,
+	userID int,
+	movieID int,
+	req *dto.ScorePutRequest,
+) error {
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := tx.MovieGet(ctx, movieID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			return tx.FilmScorePut(ctx, userID, movieID, req.Score.Int)
+		},
+	)
+}
+
+func (app *Application) MovieScoreDelete(
+	ctx context.Context,
+	userID int,
+	movieID int,
+) error {
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := tx.MovieGet(ctx, movieID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			err = tx.FilmScoreDelete(ctx, userID, movieID)
+			if err == repo.ErrNoRecord {
+				return ErrNotFound
+			}
+			return err
+		},
+	)
+}
+
+// EpisodeScorePut scores the episode or edits the score of the user on the
+// episode
+func (app *Application) EpisodeScorePut(
+	ctx context.Context,
+	userID int,
+	seriesID, seasonNumber, episodeNumber int,
+	req *dto.ScorePutRequest,
+) error {
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			episode, err := tx.EpisodeGet(
+				ctx,
+				seriesID,
+				seasonNumber,
+				episodeNumber,
+			)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			return tx.FilmScorePut(ctx, userID, episode.ID, req.Score.Int)
+		},
+	)
+}
+
+func (app *Application) EpisodeScoreDelete(
+	ctx context.Context,
+	userID int,
+	seriesID, seasonNumber, episodeNumber int,
+) error {
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			episode, err := tx.EpisodeGet(
+				ctx,
+				seriesID,
+				seasonNumber,
+				episodeNumber,
+			)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			err = tx.FilmScoreDelete(ctx, userID, episode.ID)
+			if err == repo.ErrNoRecord {
+				return ErrNotFound
+			}
+			return err
+		},
+	)
+}
+
+// SeriesScorePut scores the series or edits the score of the user on the
+// series
+func (app *Application) SeriesScorePut(
+	ctx context.Context,
+	userID int,
+	seriesID int,
+	req *dto.ScorePutRequest,
+) error {
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := tx.SeriesGet(ctx, seriesID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			return tx.SeriesScorePut(ctx, userID, seriesID, req.Score.Int)
+		},
+	)
+}
+
+func (app *Application) SeriesScoreDelete(
+	ctx context.Context,
+	userID int,
+	seriesID int,
+) error {
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := tx.SeriesGet(ctx, seriesID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			err = tx.SeriesScoreDelete(ctx, userID, seriesID)
+			if err == repo.ErrNoRecord {
+				return ErrNotFound
+			}
+			return err
+		},
+	)
+}
@@ -0,0 +1,237 @@
+package app_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/repo/mock_repo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestMovieScorePut(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx         = context.Background()
+		userID      = 1
+		movieID     = 2
+		req         = &dto.ScorePutRequest{Score: null.IntFrom(87)}
+		expPutError = errors.New("FilmScorePut error")
+	)
+
+	type TestCase struct {
+		name   string
+		getErr error
+		putErr error
+		expErr error
+	}
+
+	testCases := []TestCase{
+		{
+			name:   "movie not found",
+			getErr: repo.ErrNoRecord,
+			expErr: app.ErrNotFound,
+		},
+		{
+			name:   "FilmScorePut error",
+			putErr: expPutError,
+			expErr: expPutError,
+		},
+		{
+			name: "ok",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+			mockRepo.EXPECT().
+				MovieGet(ctx, movieID).
+				Return(&models.Film{ID: movieID}, tc.getErr)
+			if tc.getErr == nil {
+				mockRepo.EXPECT().
+					FilmScorePut(ctx, userID, movieID, 87).
+					Return(tc.putErr)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.MovieScorePut(ctx, userID, movieID, req)
+			require.Equal(tc.expErr, err)
+		})
+	}
+}
+
+func TestMovieScoreDelete(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = context.Background()
+		userID  = 1
+		movieID = 2
+	)
+
+	type TestCase struct {
+		name      string
+		getErr    error
+		deleteErr error
+		expErr    error
+	}
+
+	testCases := []TestCase{
+		{
+			name:   "movie not found",
+			getErr: repo.ErrNoRecord,
+			expErr: app.ErrNotFound,
+		},
+		{
+			name:      "score not found",
+			deleteErr: repo.ErrNoRecord,
+			expErr:    app.ErrNotFound,
+		},
+		{
+			name: "ok",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+			mockRepo.EXPECT().
+				MovieGet(ctx, movieID).
+				Return(&models.Film{ID: movieID}, tc.getErr)
+			if tc.getErr == nil {
+				mockRepo.EXPECT().
+					FilmScoreDelete(ctx, userID, movieID).
+					Return(tc.deleteErr)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.MovieScoreDelete(ctx, userID, movieID)
+			require.Equal(tc.expErr, err)
+		})
+	}
+}
+
+func TestEpisodeScorePut(t *testing.T) {
+	t.Parallel()
+
+	require := require.New(t)
+
+	var (
+		ctx       = context.Background()
+		userID    = 1
+		seriesID  = 2
+		episodeID = 3
+		req       = &dto.ScorePutRequest{Score: null.IntFrom(0)}
+	)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockServiceTx(controller)
+
+	mockRepo.EXPECT().
+		Tx(ctx, nil, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+			return fn(ctx, mockRepo)
+		})
+	mockRepo.EXPECT().
+		EpisodeGet(ctx, seriesID, 1, 4).
+		Return(&models.Film{ID: episodeID}, nil)
+	mockRepo.EXPECT().
+		FilmScorePut(ctx, userID, episodeID, 0).
+		Return(nil)
+
+	app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	err := app.EpisodeScorePut(ctx, userID, seriesID, 1, 4, req)
+	require.NoError(err)
+}
+
+func TestSeriesScorePut(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = context.Background()
+		userID   = 1
+		seriesID = 2
+		req      = &dto.ScorePutRequest{Score: null.IntFrom(100)}
+	)
+
+	type TestCase struct {
+		name   string
+		getErr error
+		expErr error
+	}
+
+	testCases := []TestCase{
+		{
+			name:   "series not found",
+			getErr: repo.ErrNoRecord,
+			expErr: app.ErrNotFound,
+		},
+		{
+			name: "ok",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+			mockRepo.EXPECT().
+				SeriesGet(ctx, seriesID).
+				Return(&models.Series{ID: seriesID}, tc.getErr)
+			if tc.getErr == nil {
+				mockRepo.EXPECT().
+					SeriesScorePut(ctx, userID, seriesID, 100).
+					Return(nil)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.SeriesScorePut(ctx, userID, seriesID, req)
+			require.Equal(tc.expErr, err)
+		})
+	}
+}
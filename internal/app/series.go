@@ -14,6 +14,7 @@ import (
 func (app *Application) SeriesGet(
 	ctx context.Context,
 	id int,
+	localeOptions query.LocaleOptions,
 ) (*models.Series, error) {
 	series, err := app.repo.SeriesGet(ctx, id)
 	if err != nil {
@@ -22,12 +23,16 @@ func (app *Application) SeriesGet(
 		}
 		return nil, err
 	}
+	if err := localizeSerieses(ctx, app.repo, localeOptions, series); err != nil {
+		return nil, err
+	}
 	return series, nil
 }
 
 func (app *Application) SeriesesGetAll(
 	ctx context.Context,
 	queryOptions query.Options,
+	localeOptions query.LocaleOptions,
 ) (series []*models.Series, total int, err error) {
 	err = app.repo.Tx(
 		ctx,
@@ -38,8 +43,11 @@ func (app *Application) SeriesesGetAll(
 			if err != nil {
 				return err
 			}
-			total, err = tx.SeriesesCount(ctx)
-			return err
+			total, err = tx.SeriesesCount(ctx, queryOptions.Invalidation)
+			if err != nil {
+				return err
+			}
+			return localizeSerieses(ctx, tx, localeOptions, series...)
 		},
 	)
 	if err != nil {
@@ -74,6 +82,11 @@ func (app *Application) SeriesUpdate(
 	contributorID int,
 	req *dto.SeriesUpdateRequest,
 ) error {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return err
+	}
+
 	columns := seriesUpdateRequestToValidMap(req)
 
 	err := app.repo.SeriesUpdate(ctx, seriesID, contributorID, columns)
@@ -112,6 +125,11 @@ func (app *Application) SeriesInvalidate(
 	contributorID int,
 	req *dto.InvalidationRequest,
 ) error {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return err
+	}
+
 	err := app.repo.SeriesUpdate(
 		ctx,
 		seriesID,
@@ -176,6 +194,11 @@ func (app *Application) SeriesPutPoster(
 	poster io.Reader,
 	options *storage.PutOptions,
 ) (uri string, err error) {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return "", err
+	}
+
 	// put file
 	uri, err = app.storage.PutFile(ctx, poster, options)
 	if err != nil {
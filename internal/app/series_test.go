@@ -107,9 +107,9 @@ func TestSeriesGet(t *testing.T) {
 				SeriesGet(ctx, id).
 				Return(tc.get.exp.series, tc.get.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
-			series, err := app.SeriesGet(ctx, id)
+			series, err := app.SeriesGet(ctx, id, query.LocaleOptions{})
 			require.Equal(tc.exp.err, err)
 			require.Equal(tc.exp.series, series)
 		})
@@ -265,14 +265,18 @@ func TestSeriesesGetAll(t *testing.T) {
 
 			if tc.getAll.exp.err == nil {
 				mockRepo.EXPECT().
-					SeriesesCount(ctx).
+					SeriesesCount(ctx, queryOptions.Invalidation).
 					Return(tc.count.exp.total, tc.count.exp.err).
 					After(getAllCall)
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
-			serieses, total, err := app.SeriesesGetAll(ctx, queryOptions)
+			serieses, total, err := app.SeriesesGetAll(
+				ctx,
+				queryOptions,
+				query.LocaleOptions{},
+			)
 			require.Equal(tc.exp.err, err)
 			require.Equal(tc.exp.serieses, serieses)
 			require.Equal(tc.exp.total, total)
@@ -362,7 +366,7 @@ func TestSeriesCreate(t *testing.T) {
 				}).
 				Return(tc.create.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			id, err := app.SeriesCreate(ctx, contributorID, req)
 			require.Equal(tc.exp.err, err)
@@ -463,7 +467,7 @@ func TestSeriesUpdate(t *testing.T) {
 				SeriesUpdate(ctx, seriesID, contributorID, seriesUpdateRequestToValidMap(req)).
 				Return(tc.update.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			err := app.SeriesUpdate(
 				ctx,
@@ -559,7 +563,7 @@ func TestSeriesInvalidate(t *testing.T) {
 				}).
 				Return(tc.seriesInvalidate.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			err := app.SeriesInvalidate(ctx, seriesID, contributorID, req)
 			require.Equal(tc.exp.err, err)
@@ -799,7 +803,7 @@ func TestSeriesAuditsGetAll(t *testing.T) {
 				}
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			audits, total, err := app.SeriesAuditsGetAll(
 				ctx,
@@ -894,7 +898,7 @@ func TestSeriesesSearch(t *testing.T) {
 				SearchSerieses(ctx, queryOptions).
 				Return(tc.search.exp.serieses, tc.exp.total, tc.search.exp.err)
 
-			app := app.NewApplication(nil, nil, mockSearch, nil, nil)
+			app := app.NewApplication(nil, nil, mockSearch, nil, nil, nil)
 
 			series, total, err := app.SeriesesSearch(ctx, queryOptions)
 			require.Equal(tc.exp.err, err)
@@ -1035,7 +1039,7 @@ func TestSeriesPutPoster(t *testing.T) {
 					After(putFileCall)
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, mockStorage)
+			app := app.NewApplication(mockRepo, nil, nil, nil, mockStorage, nil)
 
 			uri, err := app.SeriesPutPoster(
 				ctx,
@@ -0,0 +1,223 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/stats"
+	"github.com/volatiletech/null/v8"
+)
+
+// StatsGet returns the watch statistics of the user between the stats options
+// dates: the totals, the streaks, the busiest weekdays and hours and the top
+// series by time watched
+func (app *Application) StatsGet(
+	ctx context.Context,
+	userID int,
+	statsOptions query.StatsOptions,
+	localeOptions query.LocaleOptions,
+) (s *stats.Stats, err error) {
+	// read every part from the same snapshot
+	err = app.repo.Tx(
+		ctx,
+		&sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true},
+		func(ctx context.Context, tx repo.Service) error {
+			var err error
+			s, err = statsGet(ctx, tx, userID, statsOptions)
+			if err != nil {
+				return err
+			}
+			return localizeStats(ctx, tx, localeOptions, s)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// YearReviewGet returns the year in review report of the user in the time
+// zone: the stored report is returned until it expires and then it's
+// regenerated and stored again
+func (app *Application) YearReviewGet(
+	ctx context.Context,
+	userID int,
+	year int,
+	timezone string,
+	localeOptions query.LocaleOptions,
+) (review *stats.YearReview, err error) {
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, err
+	}
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			stored, err := tx.YearReviewGet(ctx, userID, year, timezone)
+			if err != nil && err != repo.ErrNoRecord {
+				return err
+			}
+			if err == nil && yearReviewFresh(stored.GeneratedAt) {
+				review = new(stats.YearReview)
+				err = json.Unmarshal(stored.Report, review)
+				if err != nil {
+					return err
+				}
+				review.GeneratedAt = stored.GeneratedAt
+			} else {
+				review, err = yearReviewGenerate(
+					ctx,
+					tx,
+					userID,
+					year,
+					location,
+				)
+				if err != nil {
+					return err
+				}
+				// store the report not localized as it's shared by locales
+				report, err := json.Marshal(review)
+				if err != nil {
+					return err
+				}
+				err = tx.YearReviewPut(ctx, &models.YearReview{
+					UserID:      userID,
+					Year:        year,
+					Timezone:    timezone,
+					Report:      report,
+					GeneratedAt: review.GeneratedAt,
+				})
+				if err != nil {
+					return err
+				}
+			}
+			err = localizeStats(ctx, tx, localeOptions, &review.Stats)
+			if err != nil {
+				return err
+			}
+			films := make([]*models.Film, len(review.TopFilms))
+			for i, top := range review.TopFilms {
+				films[i] = &top.Film
+			}
+			return localizeFilms(ctx, tx, localeOptions, films...)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+func yearReviewGenerate(
+	ctx context.Context,
+	tx repo.Service,
+	userID int,
+	year int,
+	location *time.Location,
+) (*stats.YearReview, error) {
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, location)
+	statsOptions := query.StatsOptions{
+		From:     null.TimeFrom(start),
+		To:       null.TimeFrom(start.AddDate(1, 0, 0).Add(-time.Microsecond)),
+		Timezone: location.String(),
+	}
+	s, err := statsGet(ctx, tx, userID, statsOptions)
+	if err != nil {
+		return nil, err
+	}
+	months, err := tx.StatsBucketsGet(
+		ctx,
+		userID,
+		statsOptions,
+		query.StatsBucketMonth,
+	)
+	if err != nil {
+		return nil, err
+	}
+	topFilms, err := tx.StatsTopFilmsGet(
+		ctx,
+		userID,
+		statsOptions,
+		config.Config.Stats.TopCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &stats.YearReview{
+		Year:     year,
+		Timezone: location.String(),
+		Stats:    *s,
+		Months:   months,
+		TopFilms: topFilms,
+		// postgres keeps microseconds
+		GeneratedAt: time.Now().Truncate(time.Microsecond),
+	}, nil
+}
+
+func statsGet(
+	ctx context.Context,
+	tx repo.Service,
+	userID int,
+	statsOptions query.StatsOptions,
+) (*stats.Stats, error) {
+	s, err := tx.StatsGet(ctx, userID, statsOptions)
+	if err != nil {
+		return nil, err
+	}
+	s.Weekdays, err = tx.StatsBucketsGet(
+		ctx,
+		userID,
+		statsOptions,
+		query.StatsBucketWeekday,
+	)
+	if err != nil {
+		return nil, err
+	}
+	s.Hours, err = tx.StatsBucketsGet(
+		ctx,
+		userID,
+		statsOptions,
+		query.StatsBucketHour,
+	)
+	if err != nil {
+		return nil, err
+	}
+	s.TopSeries, err = tx.StatsTopSeriesGet(
+		ctx,
+		userID,
+		statsOptions,
+		config.Config.Stats.TopCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func localizeStats(
+	ctx context.Context,
+	tx repo.Service,
+	localeOptions query.LocaleOptions,
+	s *stats.Stats,
+) error {
+	serieses := make([]*models.Series, len(s.TopSeries))
+	for i, top := range s.TopSeries {
+		serieses[i] = &top.Series
+	}
+	return localizeSerieses(ctx, tx, localeOptions, serieses...)
+}
+
+// yearReviewFresh reports whether a year review generated at is not expired:
+// a non-positive expiry never keeps them
+func yearReviewFresh(generatedAt time.Time) bool {
+	expireIn := time.Hour *
+		time.Duration(config.Config.Stats.YearReviewExpireInHours)
+	return expireIn > 0 && time.Since(generatedAt) < expireIn
+}
@@ -0,0 +1,232 @@
+package app_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/repo/mock_repo"
+	"github.com/aria3ppp/watchlist-server/internal/stats"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestStatsGet(t *testing.T) {
+	t.Parallel()
+
+	require := require.New(t)
+
+	var (
+		ctx          = context.Background()
+		userID       = 1
+		statsOptions = query.StatsOptions{
+			From:     null.TimeFrom(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+			Timezone: "Europe/Berlin",
+		}
+		totals = &stats.Stats{
+			MinutesWatched: 90,
+			HoursWatched:   1.5,
+			WatchesCount:   2,
+			MoviesCount:    1,
+			EpisodesCount:  1,
+			CurrentStreak:  1,
+			LongestStreak:  2,
+		}
+		weekdays  = []*stats.Bucket{{Value: 1, WatchesCount: 2, MinutesWatched: 90}}
+		hours     = []*stats.Bucket{{Value: 21, WatchesCount: 2, MinutesWatched: 90}}
+		topSeries = []*stats.SeriesTime{
+			{Series: models.Series{ID: 3}, MinutesWatched: 45, EpisodesCount: 1},
+		}
+	)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockServiceTx(controller)
+
+	mockRepo.EXPECT().
+		Tx(
+			ctx,
+			&sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true},
+			gomock.Any(),
+		).
+		DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+			return fn(ctx, mockRepo)
+		})
+	mockRepo.EXPECT().
+		StatsGet(ctx, userID, statsOptions).
+		Return(totals, nil)
+	mockRepo.EXPECT().
+		StatsBucketsGet(ctx, userID, statsOptions, query.StatsBucketWeekday).
+		Return(weekdays, nil)
+	mockRepo.EXPECT().
+		StatsBucketsGet(ctx, userID, statsOptions, query.StatsBucketHour).
+		Return(hours, nil)
+	mockRepo.EXPECT().
+		StatsTopSeriesGet(ctx, userID, statsOptions, config.Config.Stats.TopCount).
+		Return(topSeries, nil)
+
+	app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	gotStats, err := app.StatsGet(
+		ctx,
+		userID,
+		statsOptions,
+		query.LocaleOptions{},
+	)
+	require.NoError(err)
+
+	expStats := *totals
+	expStats.Weekdays = weekdays
+	expStats.Hours = hours
+	expStats.TopSeries = topSeries
+	require.Equal(&expStats, gotStats)
+}
+
+func TestYearReviewGet(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = context.Background()
+		userID   = 1
+		year     = 2022
+		timezone = "Asia/Tokyo"
+
+		storedReview = &stats.YearReview{
+			Year:     year,
+			Timezone: timezone,
+			Stats:    stats.Stats{WatchesCount: 7},
+			Months:   []*stats.Bucket{{Value: 3, WatchesCount: 7}},
+		}
+	)
+	location, err := time.LoadLocation(timezone)
+	require.NoError(t, err)
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, location)
+	statsOptions := query.StatsOptions{
+		From:     null.TimeFrom(start),
+		To:       null.TimeFrom(start.AddDate(1, 0, 0).Add(-time.Microsecond)),
+		Timezone: timezone,
+	}
+	report, err := json.Marshal(storedReview)
+	require.NoError(t, err)
+
+	type TestCase struct {
+		name          string
+		storedErr     error
+		generatedAgo  time.Duration
+		expGenerated  bool
+		expWatchCount int
+	}
+
+	testCases := []TestCase{
+		{
+			name:          "stored review is fresh",
+			generatedAgo:  time.Minute,
+			expWatchCount: 7,
+		},
+		{
+			name: "stored review is expired",
+			generatedAgo: time.Hour*
+				time.Duration(config.Config.Stats.YearReviewExpireInHours) +
+				time.Minute,
+			expGenerated:  true,
+			expWatchCount: 9,
+		},
+		{
+			name:          "no stored review",
+			storedErr:     repo.ErrNoRecord,
+			expGenerated:  true,
+			expWatchCount: 9,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			generatedAt := time.Now().Add(-tc.generatedAgo)
+			var stored *models.YearReview
+			if tc.storedErr == nil {
+				stored = &models.YearReview{
+					UserID:      userID,
+					Year:        year,
+					Timezone:    timezone,
+					Report:      report,
+					GeneratedAt: generatedAt,
+				}
+			}
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+			mockRepo.EXPECT().
+				YearReviewGet(ctx, userID, year, timezone).
+				Return(stored, tc.storedErr)
+			if tc.expGenerated {
+				mockRepo.EXPECT().
+					StatsGet(ctx, userID, statsOptions).
+					Return(&stats.Stats{WatchesCount: 9}, nil)
+				mockRepo.EXPECT().
+					StatsBucketsGet(ctx, userID, statsOptions, query.StatsBucketWeekday).
+					Return(nil, nil)
+				mockRepo.EXPECT().
+					StatsBucketsGet(ctx, userID, statsOptions, query.StatsBucketHour).
+					Return(nil, nil)
+				mockRepo.EXPECT().
+					StatsTopSeriesGet(ctx, userID, statsOptions, config.Config.Stats.TopCount).
+					Return(nil, nil)
+				mockRepo.EXPECT().
+					StatsBucketsGet(ctx, userID, statsOptions, query.StatsBucketMonth).
+					Return(nil, nil)
+				mockRepo.EXPECT().
+					StatsTopFilmsGet(ctx, userID, statsOptions, config.Config.Stats.TopCount).
+					Return(nil, nil)
+				mockRepo.EXPECT().
+					YearReviewPut(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, review *models.YearReview) error {
+						require.Equal(userID, review.UserID)
+						require.Equal(year, review.Year)
+						require.Equal(timezone, review.Timezone)
+						var reviewReport stats.YearReview
+						require.NoError(json.Unmarshal(review.Report, &reviewReport))
+						require.Equal(9, reviewReport.WatchesCount)
+						return nil
+					})
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			gotReview, err := app.YearReviewGet(
+				ctx,
+				userID,
+				year,
+				timezone,
+				query.LocaleOptions{},
+			)
+			require.NoError(err)
+			require.Equal(year, gotReview.Year)
+			require.Equal(timezone, gotReview.Timezone)
+			require.Equal(tc.expWatchCount, gotReview.WatchesCount)
+			if !tc.expGenerated {
+				require.Equal(storedReview.Months, gotReview.Months)
+				require.True(generatedAt.Equal(gotReview.GeneratedAt))
+			} else {
+				require.WithinDuration(time.Now(), gotReview.GeneratedAt, time.Minute)
+			}
+		})
+	}
+}
@@ -0,0 +1,470 @@
+package app
+
+import (
+	"context"
+
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/locale"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+)
+
+func (app *Application) MovieTranslationsGet(
+	ctx context.Context,
+	id int,
+) (translations []*models.Translation, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the movie exists
+			_, err := tx.MovieGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// fetch translations
+			translations, err = tx.TranslationsGetAllByFilm(ctx, id)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return translations, nil
+}
+
+func (app *Application) MovieTranslationPut(
+	ctx context.Context,
+	id int,
+	contributorID int,
+	req *dto.TranslationPutRequest,
+) error {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return err
+	}
+
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the movie exists
+			_, err := tx.MovieGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// put the translation
+			return tx.TranslationPutByFilm(
+				ctx,
+				id,
+				contributorID,
+				translationPutRequestToModel(req),
+			)
+		},
+	)
+}
+
+func (app *Application) MovieTranslationAuditsGetAll(
+	ctx context.Context,
+	id int,
+	queryOptions query.SortOrderOptions,
+) (audits []*models.TranslationsAudit, total int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the movie exists
+			_, err := tx.MovieGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// fetch audits
+			audits, err = tx.TranslationAuditsGetAllByFilm(ctx, id, queryOptions)
+			if err != nil {
+				return err
+			}
+			// count total audits
+			total, err = tx.TranslationAuditsCountByFilm(ctx, id)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return audits, total, nil
+}
+
+//------------------------------------------------------------------------------
+
+func (app *Application) SeriesTranslationsGet(
+	ctx context.Context,
+	id int,
+) (translations []*models.Translation, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the series exists
+			_, err := tx.SeriesGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// fetch translations
+			translations, err = tx.TranslationsGetAllBySeries(ctx, id)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return translations, nil
+}
+
+func (app *Application) SeriesTranslationPut(
+	ctx context.Context,
+	id int,
+	contributorID int,
+	req *dto.TranslationPutRequest,
+) error {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return err
+	}
+
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the series exists
+			_, err := tx.SeriesGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// put the translation
+			return tx.TranslationPutBySeries(
+				ctx,
+				id,
+				contributorID,
+				translationPutRequestToModel(req),
+			)
+		},
+	)
+}
+
+func (app *Application) SeriesTranslationAuditsGetAll(
+	ctx context.Context,
+	id int,
+	queryOptions query.SortOrderOptions,
+) (audits []*models.TranslationsAudit, total int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first check the series exists
+			_, err := tx.SeriesGet(ctx, id)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// fetch audits
+			audits, err = tx.TranslationAuditsGetAllBySeries(
+				ctx,
+				id,
+				queryOptions,
+			)
+			if err != nil {
+				return err
+			}
+			// count total audits
+			total, err = tx.TranslationAuditsCountBySeries(ctx, id)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return audits, total, nil
+}
+
+//------------------------------------------------------------------------------
+
+func (app *Application) EpisodeTranslationsGet(
+	ctx context.Context,
+	seriesID, seasonNumber, episodeNumber int,
+) (translations []*models.Translation, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first fetch the episode
+			episode, err := tx.EpisodeGet(
+				ctx,
+				seriesID,
+				seasonNumber,
+				episodeNumber,
+			)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// fetch translations
+			translations, err = tx.TranslationsGetAllByFilm(ctx, episode.ID)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return translations, nil
+}
+
+func (app *Application) EpisodeTranslationPut(
+	ctx context.Context,
+	seriesID, seasonNumber, episodeNumber int,
+	contributorID int,
+	req *dto.TranslationPutRequest,
+) error {
+	// check the contributor is reputable enough to edit
+	if err := app.checkEditReputation(ctx, contributorID); err != nil {
+		return err
+	}
+
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first fetch the episode
+			episode, err := tx.EpisodeGet(
+				ctx,
+				seriesID,
+				seasonNumber,
+				episodeNumber,
+			)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// put the translation
+			return tx.TranslationPutByFilm(
+				ctx,
+				episode.ID,
+				contributorID,
+				translationPutRequestToModel(req),
+			)
+		},
+	)
+}
+
+func (app *Application) EpisodeTranslationAuditsGetAll(
+	ctx context.Context,
+	seriesID, seasonNumber, episodeNumber int,
+	queryOptions query.SortOrderOptions,
+) (audits []*models.TranslationsAudit, total int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			// first fetch the episode
+			episode, err := tx.EpisodeGet(
+				ctx,
+				seriesID,
+				seasonNumber,
+				episodeNumber,
+			)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			// fetch audits
+			audits, err = tx.TranslationAuditsGetAllByFilm(
+				ctx,
+				episode.ID,
+				queryOptions,
+			)
+			if err != nil {
+				return err
+			}
+			// count total audits
+			total, err = tx.TranslationAuditsCountByFilm(ctx, episode.ID)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return audits, total, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func translationPutRequestToModel(
+	req *dto.TranslationPutRequest,
+) *models.Translation {
+	return &models.Translation{
+		Language:     locale.Canonicalize(req.Language),
+		Title:        req.Title,
+		Descriptions: req.Descriptions,
+	}
+}
+
+// localeLanguages returns the preferred languages of the locale options:
+// the locale of the user is looked up if there's no preferred languages
+func localeLanguages(
+	ctx context.Context,
+	r repo.Service,
+	localeOptions query.LocaleOptions,
+) ([]string, error) {
+	if len(localeOptions.Languages) > 0 || localeOptions.UserID == 0 {
+		return localeOptions.Languages, nil
+	}
+	user, err := r.UserGet(ctx, localeOptions.UserID)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !user.Locale.Valid {
+		return nil, nil
+	}
+	return []string{user.Locale.String}, nil
+}
+
+// languageSubtags returns the distinct language subtags of the tags
+func languageSubtags(tags []string) []string {
+	subtags := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		subtag := locale.Language(tag)
+		if _, exists := seen[subtag]; exists {
+			continue
+		}
+		seen[subtag] = struct{}{}
+		subtags = append(subtags, subtag)
+	}
+	return subtags
+}
+
+// localizeFilms replaces the title and descriptions of the films by their
+// translation best matching the locale options: films having no matching
+// translation keep their original title and descriptions
+func localizeFilms(
+	ctx context.Context,
+	r repo.Service,
+	localeOptions query.LocaleOptions,
+	films ...*models.Film,
+) error {
+	if len(films) == 0 {
+		return nil
+	}
+	languages, err := localeLanguages(ctx, r, localeOptions)
+	if err != nil || len(languages) == 0 {
+		return err
+	}
+	filmIDs := make([]int, len(films))
+	for i, f := range films {
+		filmIDs[i] = f.ID
+	}
+	translations, err := r.TranslationsGetAllByFilms(
+		ctx,
+		filmIDs,
+		languageSubtags(languages),
+	)
+	if err != nil {
+		return err
+	}
+	byFilm := make(map[int][]*models.Translation)
+	for _, t := range translations {
+		byFilm[t.FilmID.Int] = append(byFilm[t.FilmID.Int], t)
+	}
+	for _, f := range films {
+		if t := matchTranslation(languages, byFilm[f.ID]); t != nil {
+			f.Title = t.Title
+			if t.Descriptions.Valid {
+				f.Descriptions = t.Descriptions
+			}
+		}
+	}
+	return nil
+}
+
+// localizeSerieses replaces the title and descriptions of the serieses by
+// their translation best matching the locale options: serieses having no
+// matching translation keep their original title and descriptions
+func localizeSerieses(
+	ctx context.Context,
+	r repo.Service,
+	localeOptions query.LocaleOptions,
+	serieses ...*models.Series,
+) error {
+	if len(serieses) == 0 {
+		return nil
+	}
+	languages, err := localeLanguages(ctx, r, localeOptions)
+	if err != nil || len(languages) == 0 {
+		return err
+	}
+	seriesIDs := make([]int, len(serieses))
+	for i, s := range serieses {
+		seriesIDs[i] = s.ID
+	}
+	translations, err := r.TranslationsGetAllBySerieses(
+		ctx,
+		seriesIDs,
+		languageSubtags(languages),
+	)
+	if err != nil {
+		return err
+	}
+	bySeries := make(map[int][]*models.Translation)
+	for _, t := range translations {
+		bySeries[t.SeriesID.Int] = append(bySeries[t.SeriesID.Int], t)
+	}
+	for _, s := range serieses {
+		if t := matchTranslation(languages, bySeries[s.ID]); t != nil {
+			s.Title = t.Title
+			if t.Descriptions.Valid {
+				s.Descriptions = t.Descriptions
+			}
+		}
+	}
+	return nil
+}
+
+func matchTranslation(
+	languages []string,
+	translations []*models.Translation,
+) *models.Translation {
+	available := make([]string, len(translations))
+	for i, t := range translations {
+		available[i] = t.Language
+	}
+	if i := locale.Match(languages, available); i >= 0 {
+		return translations[i]
+	}
+	return nil
+}
@@ -0,0 +1,768 @@
+package app_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/repo/mock_repo"
+	"github.com/aria3ppp/watchlist-server/internal/watchlist"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestMovieTranslationsGet(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		id = 1
+
+		expMovie                         = &models.Film{ID: id, Title: "movie"}
+		expMovieGetError                 = errors.New("MovieGet error")
+		expTranslationsGetAllByFilmError = errors.New(
+			"TranslationsGetAllByFilm error",
+		)
+		expTranslations = []*models.Translation{
+			{ID: 1, FilmID: null.IntFrom(id), Language: "de", Title: "Film"},
+		}
+	)
+
+	type MovieGetExp struct {
+		movie *models.Film
+		err   error
+	}
+	type GetAllExp struct {
+		translations []*models.Translation
+		err          error
+	}
+	type GetAll struct {
+		call bool
+		exp  GetAllExp
+	}
+	type Exp struct {
+		translations []*models.Translation
+		err          error
+	}
+	type TestCase struct {
+		name     string
+		movieGet MovieGetExp
+		getAll   GetAll
+		exp      Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name: "not found",
+			movieGet: MovieGetExp{
+				err: repo.ErrNoRecord,
+			},
+			exp: Exp{
+				err: app.ErrNotFound,
+			},
+		},
+		{
+			name: "MovieGet error",
+			movieGet: MovieGetExp{
+				err: expMovieGetError,
+			},
+			exp: Exp{
+				err: expMovieGetError,
+			},
+		},
+		{
+			name: "TranslationsGetAllByFilm error",
+			movieGet: MovieGetExp{
+				movie: expMovie,
+			},
+			getAll: GetAll{
+				call: true,
+				exp: GetAllExp{
+					err: expTranslationsGetAllByFilmError,
+				},
+			},
+			exp: Exp{
+				err: expTranslationsGetAllByFilmError,
+			},
+		},
+		{
+			name: "ok",
+			movieGet: MovieGetExp{
+				movie: expMovie,
+			},
+			getAll: GetAll{
+				call: true,
+				exp: GetAllExp{
+					translations: expTranslations,
+				},
+			},
+			exp: Exp{
+				translations: expTranslations,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(_ context.Context, _ repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+
+			mockRepo.EXPECT().
+				MovieGet(ctx, id).
+				Return(tc.movieGet.movie, tc.movieGet.err)
+
+			if tc.getAll.call {
+				mockRepo.EXPECT().
+					TranslationsGetAllByFilm(ctx, id).
+					Return(tc.getAll.exp.translations, tc.getAll.exp.err)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			translations, err := app.MovieTranslationsGet(ctx, id)
+			require.Equal(tc.exp.err, err)
+			require.Equal(tc.exp.translations, translations)
+		})
+	}
+}
+
+func TestMovieTranslationPut(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		id            = 1
+		contributorID = 1
+		req           = &dto.TranslationPutRequest{
+			Language:     "pt-br",
+			Title:        "filme",
+			Descriptions: null.StringFrom("descrições"),
+		}
+		// language is canonicalized
+		expTranslation = &models.Translation{
+			Language:     "pt-BR",
+			Title:        "filme",
+			Descriptions: null.StringFrom("descrições"),
+		}
+
+		expMovieGetError             = errors.New("MovieGet error")
+		expTranslationPutByFilmError = errors.New("TranslationPutByFilm error")
+	)
+
+	type TestCase struct {
+		name        string
+		movieGetErr error
+		putCall     bool
+		putErr      error
+		expErr      error
+	}
+
+	testCases := []TestCase{
+		{
+			name:        "not found",
+			movieGetErr: repo.ErrNoRecord,
+			expErr:      app.ErrNotFound,
+		},
+		{
+			name:        "MovieGet error",
+			movieGetErr: expMovieGetError,
+			expErr:      expMovieGetError,
+		},
+		{
+			name:    "TranslationPutByFilm error",
+			putCall: true,
+			putErr:  expTranslationPutByFilmError,
+			expErr:  expTranslationPutByFilmError,
+		},
+		{
+			name:    "ok",
+			putCall: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(_ context.Context, _ repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+
+			mockRepo.EXPECT().
+				MovieGet(ctx, id).
+				Return(&models.Film{ID: id}, tc.movieGetErr)
+
+			if tc.putCall {
+				mockRepo.EXPECT().
+					TranslationPutByFilm(ctx, id, contributorID, expTranslation).
+					Return(tc.putErr)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.MovieTranslationPut(ctx, id, contributorID, req)
+			require.Equal(tc.expErr, err)
+		})
+	}
+}
+
+func TestSeriesTranslationAuditsGetAll(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		id           = 1
+		queryOptions = query.SortOrderOptions{
+			Offset:    0,
+			Limit:     math.MaxInt,
+			SortOrder: "desc",
+		}
+		expAudits = []*models.TranslationsAudit{
+			{ID: 1, SeriesID: null.IntFrom(id), Language: "fr", Title: "série"},
+		}
+		expTotal = 1
+
+		expSeriesGetError = errors.New("SeriesGet error")
+		expGetAllError    = errors.New("TranslationAuditsGetAllBySeries error")
+		expCountError     = errors.New("TranslationAuditsCountBySeries error")
+	)
+
+	type TestCase struct {
+		name         string
+		seriesGetErr error
+		getAllCall   bool
+		getAllErr    error
+		countCall    bool
+		countErr     error
+		expAudits    []*models.TranslationsAudit
+		expTotal     int
+		expErr       error
+	}
+
+	testCases := []TestCase{
+		{
+			name:         "not found",
+			seriesGetErr: repo.ErrNoRecord,
+			expErr:       app.ErrNotFound,
+		},
+		{
+			name:         "SeriesGet error",
+			seriesGetErr: expSeriesGetError,
+			expErr:       expSeriesGetError,
+		},
+		{
+			name:       "TranslationAuditsGetAllBySeries error",
+			getAllCall: true,
+			getAllErr:  expGetAllError,
+			expErr:     expGetAllError,
+		},
+		{
+			name:       "TranslationAuditsCountBySeries error",
+			getAllCall: true,
+			countCall:  true,
+			countErr:   expCountError,
+			expErr:     expCountError,
+		},
+		{
+			name:       "ok",
+			getAllCall: true,
+			countCall:  true,
+			expAudits:  expAudits,
+			expTotal:   expTotal,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(_ context.Context, _ repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+
+			mockRepo.EXPECT().
+				SeriesGet(ctx, id).
+				Return(&models.Series{ID: id}, tc.seriesGetErr)
+
+			if tc.getAllCall {
+				mockRepo.EXPECT().
+					TranslationAuditsGetAllBySeries(ctx, id, queryOptions).
+					Return(expAudits, tc.getAllErr)
+			}
+			if tc.countCall {
+				mockRepo.EXPECT().
+					TranslationAuditsCountBySeries(ctx, id).
+					Return(expTotal, tc.countErr)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			audits, total, err := app.SeriesTranslationAuditsGetAll(
+				ctx,
+				id,
+				queryOptions,
+			)
+			require.Equal(tc.expErr, err)
+			require.Equal(tc.expAudits, audits)
+			require.Equal(tc.expTotal, total)
+		})
+	}
+}
+
+func TestEpisodeTranslationPut(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		seriesID      = 1
+		seasonNumber  = 1
+		episodeNumber = 1
+		episodeID     = 10
+		contributorID = 1
+		req           = &dto.TranslationPutRequest{
+			Language: "de",
+			Title:    "Pilotfolge",
+		}
+
+		expEpisodeGetError = errors.New("EpisodeGet error")
+	)
+
+	type TestCase struct {
+		name          string
+		episodeGetErr error
+		putCall       bool
+		expErr        error
+	}
+
+	testCases := []TestCase{
+		{
+			name:          "not found",
+			episodeGetErr: repo.ErrNoRecord,
+			expErr:        app.ErrNotFound,
+		},
+		{
+			name:          "EpisodeGet error",
+			episodeGetErr: expEpisodeGetError,
+			expErr:        expEpisodeGetError,
+		},
+		{
+			name:    "ok",
+			putCall: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(_ context.Context, _ repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+
+			mockRepo.EXPECT().
+				EpisodeGet(ctx, seriesID, seasonNumber, episodeNumber).
+				Return(&models.Film{ID: episodeID}, tc.episodeGetErr)
+
+			if tc.putCall {
+				// the translation is put by the episode film id
+				mockRepo.EXPECT().
+					TranslationPutByFilm(
+						ctx,
+						episodeID,
+						contributorID,
+						&models.Translation{Language: "de", Title: "Pilotfolge"},
+					).
+					Return(nil)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.EpisodeTranslationPut(
+				ctx,
+				seriesID,
+				seasonNumber,
+				episodeNumber,
+				contributorID,
+				req,
+			)
+			require.Equal(tc.expErr, err)
+		})
+	}
+}
+
+func TestMovieGetLocalized(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		id     = 1
+		userID = 1
+
+		translations = []*models.Translation{
+			{
+				FilmID:       null.IntFrom(id),
+				Language:     "pt-BR",
+				Title:        "filme brasileiro",
+				Descriptions: null.StringFrom("descrições"),
+			},
+			{
+				FilmID:   null.IntFrom(id),
+				Language: "pt-PT",
+				Title:    "filme português",
+			},
+		}
+
+		expUserGetError     = errors.New("UserGet error")
+		expTranslationError = errors.New("TranslationsGetAllByFilms error")
+	)
+
+	type UserGet struct {
+		call bool
+		user *models.User
+		err  error
+	}
+	type TranslationsGet struct {
+		call      bool
+		languages []string
+		err       error
+	}
+	type Exp struct {
+		title        string
+		descriptions null.String
+		err          error
+	}
+	type TestCase struct {
+		name            string
+		localeOptions   query.LocaleOptions
+		userGet         UserGet
+		translationsGet TranslationsGet
+		exp             Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name: "no locale",
+			exp: Exp{
+				title:        "movie",
+				descriptions: null.StringFrom("descriptions"),
+			},
+		},
+		{
+			name: "exact language",
+			localeOptions: query.LocaleOptions{
+				Languages: []string{"pt-PT", "pt-BR"},
+				UserID:    userID,
+			},
+			translationsGet: TranslationsGet{
+				call:      true,
+				languages: []string{"pt"},
+			},
+			exp: Exp{
+				title: "filme português",
+				// missing translated descriptions keep the original
+				descriptions: null.StringFrom("descriptions"),
+			},
+		},
+		{
+			name: "language subtag",
+			localeOptions: query.LocaleOptions{
+				Languages: []string{"de", "pt"},
+			},
+			translationsGet: TranslationsGet{
+				call:      true,
+				languages: []string{"de", "pt"},
+			},
+			exp: Exp{
+				title:        "filme brasileiro",
+				descriptions: null.StringFrom("descrições"),
+			},
+		},
+		{
+			name: "no match",
+			localeOptions: query.LocaleOptions{
+				Languages: []string{"fa"},
+			},
+			translationsGet: TranslationsGet{
+				call:      true,
+				languages: []string{"fa"},
+			},
+			exp: Exp{
+				title:        "movie",
+				descriptions: null.StringFrom("descriptions"),
+			},
+		},
+		{
+			name: "user locale",
+			localeOptions: query.LocaleOptions{
+				UserID: userID,
+			},
+			userGet: UserGet{
+				call: true,
+				user: &models.User{ID: userID, Locale: null.StringFrom("pt-PT")},
+			},
+			translationsGet: TranslationsGet{
+				call:      true,
+				languages: []string{"pt"},
+			},
+			exp: Exp{
+				title:        "filme português",
+				descriptions: null.StringFrom("descriptions"),
+			},
+		},
+		{
+			name: "user without locale",
+			localeOptions: query.LocaleOptions{
+				UserID: userID,
+			},
+			userGet: UserGet{
+				call: true,
+				user: &models.User{ID: userID},
+			},
+			exp: Exp{
+				title:        "movie",
+				descriptions: null.StringFrom("descriptions"),
+			},
+		},
+		{
+			name: "UserGet error",
+			localeOptions: query.LocaleOptions{
+				UserID: userID,
+			},
+			userGet: UserGet{
+				call: true,
+				err:  expUserGetError,
+			},
+			exp: Exp{
+				err: expUserGetError,
+			},
+		},
+		{
+			name: "TranslationsGetAllByFilms error",
+			localeOptions: query.LocaleOptions{
+				Languages: []string{"pt-BR"},
+			},
+			translationsGet: TranslationsGet{
+				call:      true,
+				languages: []string{"pt"},
+				err:       expTranslationError,
+			},
+			exp: Exp{
+				err: expTranslationError,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				MovieGet(ctx, id).
+				Return(
+					&models.Film{
+						ID:           id,
+						Title:        "movie",
+						Descriptions: null.StringFrom("descriptions"),
+					},
+					nil,
+				)
+
+			if tc.userGet.call {
+				mockRepo.EXPECT().
+					UserGet(ctx, userID).
+					Return(tc.userGet.user, tc.userGet.err)
+			}
+			if tc.translationsGet.call {
+				mockRepo.EXPECT().
+					TranslationsGetAllByFilms(
+						ctx,
+						[]int{id},
+						tc.translationsGet.languages,
+					).
+					Return(translations, tc.translationsGet.err)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			movie, err := app.MovieGet(ctx, id, tc.localeOptions)
+			require.Equal(tc.exp.err, err)
+			if tc.exp.err != nil {
+				require.Nil(movie)
+				return
+			}
+			require.Equal(tc.exp.title, movie.Title)
+			require.Equal(tc.exp.descriptions, movie.Descriptions)
+		})
+	}
+}
+
+func TestSeriesesGetAllLocalized(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	var (
+		ctx = context.Background()
+
+		queryOptions = query.Options{
+			Offset:    0,
+			Limit:     math.MaxInt,
+			SortField: models.SeriesColumns.ID,
+			SortOrder: "asc",
+		}
+		localeOptions = query.LocaleOptions{Languages: []string{"fr"}}
+	)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockServiceTx(controller)
+
+	mockRepo.EXPECT().
+		Tx(ctx, nil, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(_ context.Context, _ repo.Service) error) error {
+			return fn(ctx, mockRepo)
+		})
+	mockRepo.EXPECT().
+		SeriesesGetAll(ctx, queryOptions).
+		Return(
+			[]*models.Series{
+				{ID: 1, Title: "series 1"},
+				{ID: 2, Title: "series 2"},
+			},
+			nil,
+		)
+	mockRepo.EXPECT().SeriesesCount(ctx, queryOptions.Invalidation).Return(2, nil)
+	mockRepo.EXPECT().
+		TranslationsGetAllBySerieses(ctx, []int{1, 2}, []string{"fr"}).
+		Return(
+			[]*models.Translation{
+				{SeriesID: null.IntFrom(2), Language: "fr", Title: "série 2"},
+			},
+			nil,
+		)
+
+	app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	serieses, total, err := app.SeriesesGetAll(ctx, queryOptions, localeOptions)
+	require.NoError(err)
+	require.Equal(2, total)
+	require.Equal("series 1", serieses[0].Title)
+	require.Equal("série 2", serieses[1].Title)
+}
+
+func TestWatchlistGetLocalized(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	var (
+		ctx = context.Background()
+
+		userID       = 1
+		queryOptions = query.WatchlistOptions{
+			Offset:    0,
+			Limit:     math.MaxInt,
+			SortOrder: "asc",
+		}
+		// languages default to the user locale
+		localeOptions = query.LocaleOptions{UserID: userID}
+	)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockServiceTx(controller)
+
+	mockRepo.EXPECT().
+		Tx(ctx, nil, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(_ context.Context, _ repo.Service) error) error {
+			return fn(ctx, mockRepo)
+		})
+	mockRepo.EXPECT().
+		WatchlistGet(ctx, userID, queryOptions).
+		Return(
+			[]*watchlist.Item{
+				{Film: models.Film{ID: 1, Title: "movie"}},
+				{Film: models.Film{ID: 2, Title: "episode"}},
+			},
+			nil,
+		)
+	mockRepo.EXPECT().
+		WatchlistCount(
+			ctx,
+			userID,
+			queryOptions.WhereTimeWatched,
+			queryOptions.Release,
+			queryOptions.Watched,
+			queryOptions.Item,
+		).
+		Return(2, nil)
+	mockRepo.EXPECT().
+		UserGet(ctx, userID).
+		Return(&models.User{ID: userID, Locale: null.StringFrom("de")}, nil)
+	mockRepo.EXPECT().
+		TranslationsGetAllByFilms(ctx, []int{1, 2}, []string{"de"}).
+		Return(
+			[]*models.Translation{
+				{FilmID: null.IntFrom(1), Language: "de-AT", Title: "Film"},
+			},
+			nil,
+		)
+
+	app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	items, total, err := app.WatchlistGet(
+		ctx,
+		userID,
+		queryOptions,
+		localeOptions,
+	)
+	require.NoError(err)
+	require.Equal(2, total)
+	require.Equal("Film", items[0].Film.Title)
+	require.Equal("episode", items[1].Film.Title)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/aria3ppp/watchlist-server/internal/auth"
 	"github.com/aria3ppp/watchlist-server/internal/dto"
 	"github.com/aria3ppp/watchlist-server/internal/hasher"
+	"github.com/aria3ppp/watchlist-server/internal/locale"
 	"github.com/aria3ppp/watchlist-server/internal/models"
 	"github.com/aria3ppp/watchlist-server/internal/repo"
 	"github.com/aria3ppp/watchlist-server/internal/storage"
@@ -257,6 +258,9 @@ func userUpdateRequestToValidMap(
 	if req.Birthdate.Valid {
 		m[models.UserColumns.Birthdate] = req.Birthdate.Time
 	}
+	if req.Locale.Valid {
+		m[models.UserColumns.Locale] = locale.Canonicalize(req.Locale.String)
+	}
 	return m
 }
 
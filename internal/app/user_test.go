@@ -108,7 +108,7 @@ func TestUserGet(t *testing.T) {
 				UserGet(ctx, id).
 				Return(tc.get.exp.series, tc.get.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			user, err := app.UserGet(ctx, id)
 			require.Equal(tc.exp.err, err)
@@ -344,7 +344,7 @@ func TestUserCreate(t *testing.T) {
 				}
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, mockHasher, nil)
+			app := app.NewApplication(mockRepo, nil, nil, mockHasher, nil, nil)
 
 			userID, err := app.UserCreate(ctx, req)
 			require.Equal(tc.exp.err, err)
@@ -829,6 +829,7 @@ func TestUserLogin(t *testing.T) {
 				nil,
 				mockHasher,
 				nil,
+				nil,
 			)
 
 			resp, err := app.UserLogin(ctx, req)
@@ -999,6 +1000,7 @@ func TestUserLogout(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 			)
 
 			err := app.UserLogout(ctx, userID, refreshToken)
@@ -1151,6 +1153,7 @@ func TestUserRefreshToken(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 			)
 
 			resp, err := app.UserRefreshToken(ctx, userID, refreshToken)
@@ -1244,7 +1247,7 @@ func TestUserUpdate(t *testing.T) {
 				UserUpdate(ctx, userID, columns).
 				Return(tc.userUpdate.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			err := app.UserUpdate(ctx, userID, req)
 			require.Equal(tc.exp.err, err)
@@ -1335,7 +1338,7 @@ func TestUserEmailUpdate(t *testing.T) {
 				UserUpdate(ctx, userID, columns).
 				Return(tc.userUpdate.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			err := app.UserEmailUpdate(ctx, userID, req)
 			require.Equal(tc.exp.err, err)
@@ -1660,7 +1663,7 @@ func TestUserPasswordUpdate(t *testing.T) {
 				}
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, mockHasher, nil)
+			app := app.NewApplication(mockRepo, nil, nil, mockHasher, nil, nil)
 
 			err := app.UserPasswordUpdate(ctx, userID, tc.req)
 			require.Equal(tc.exp.err, err)
@@ -1897,7 +1900,7 @@ func TestUserDelete(t *testing.T) {
 				}
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, mockHasher, nil)
+			app := app.NewApplication(mockRepo, nil, nil, mockHasher, nil, nil)
 
 			err := app.UserDelete(ctx, userID, req)
 			require.Equal(tc.exp.err, err)
@@ -2035,7 +2038,7 @@ func TestUserPutAvatar(t *testing.T) {
 					After(putFileCall)
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, mockStorage)
+			app := app.NewApplication(mockRepo, nil, nil, nil, mockStorage, nil)
 
 			uri, err := app.UserPutAvatar(ctx, userID, avatar, options)
 			require.Equal(tc.exp.err, err)
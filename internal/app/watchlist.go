@@ -3,15 +3,19 @@ package app
 import (
 	"context"
 
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/models"
 	"github.com/aria3ppp/watchlist-server/internal/query"
 	"github.com/aria3ppp/watchlist-server/internal/repo"
 	"github.com/aria3ppp/watchlist-server/internal/watchlist"
+	"github.com/volatiletech/null/v8"
 )
 
 func (app *Application) WatchlistGet(
 	ctx context.Context,
 	userID int,
 	queryOptions query.WatchlistOptions,
+	localeOptions query.LocaleOptions,
 ) (watchlist []*watchlist.Item, total int, err error) {
 	// Run in a transaction context
 	err = app.repo.Tx(
@@ -31,8 +35,18 @@ func (app *Application) WatchlistGet(
 				ctx,
 				userID,
 				queryOptions.WhereTimeWatched,
+				queryOptions.Release,
+				queryOptions.Watched,
+				queryOptions.Item,
 			)
-			return err
+			if err != nil {
+				return err
+			}
+			films := make([]*models.Film, len(watchlist))
+			for i, item := range watchlist {
+				films[i] = &item.Film
+			}
+			return localizeFilms(ctx, tx, localeOptions, films...)
 		},
 	)
 	// This check is mandatory as if the transaction failed,
@@ -40,6 +54,10 @@ func (app *Application) WatchlistGet(
 	if err != nil {
 		return nil, 0, err
 	}
+	// hidden films are kept in the watchlist as tombstones
+	for _, item := range watchlist {
+		item.Bury()
+	}
 	return watchlist, total, nil
 }
 
@@ -74,7 +92,12 @@ func (app *Application) WatchlistDelete(
 	userID int,
 	watchID int,
 ) error {
-	err := app.repo.WatchlistDelete(ctx, userID, watchID)
+	err := app.actorTx(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			return tx.WatchlistDelete(ctx, userID, watchID)
+		},
+	)
 	if err != nil {
 		if err == repo.ErrNoRecord {
 			return ErrNotFound
@@ -89,7 +112,318 @@ func (app *Application) WatchlistSetWatched(
 	userID int,
 	watchID int,
 ) error {
-	err := app.repo.WatchlistSetWatched(ctx, userID, watchID)
+	err := app.actorTx(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			return tx.WatchlistSetWatched(ctx, userID, watchID)
+		},
+	)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// WatchlistItemUpdate sets the private details of the watchlist item
+func (app *Application) WatchlistItemUpdate(
+	ctx context.Context,
+	userID int,
+	watchID int,
+	req *dto.WatchlistItemUpdateRequest,
+) error {
+	columns := watchlistItemUpdateRequestToValidMap(req)
+
+	return app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			if len(columns) > 0 {
+				err := tx.WatchlistItemUpdate(ctx, userID, watchID, columns)
+				if err != nil {
+					if err == repo.ErrNoRecord {
+						return ErrNotFound
+					}
+					return err
+				}
+			}
+			// nil tags are left unchanged
+			if req.Tags == nil {
+				return nil
+			}
+			err := tx.WatchlistTagsSet(
+				ctx,
+				userID,
+				watchID,
+				uniqueTags(req.Tags),
+			)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			return nil
+		},
+	)
+}
+
+func watchlistItemUpdateRequestToValidMap(
+	req *dto.WatchlistItemUpdateRequest,
+) map[string]any {
+	m := make(map[string]any)
+	if req.Priority.Valid {
+		m[models.WatchfilmColumns.Priority] = req.Priority.Int
+	}
+	if req.Note.Valid {
+		// an empty note clears the note
+		m[models.WatchfilmColumns.Note] = null.NewString(
+			req.Note.String,
+			req.Note.String != "",
+		)
+	}
+	return m
+}
+
+// uniqueTags returns the tags without the duplicates in order
+func uniqueTags(tags []string) []string {
+	unique := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		unique = append(unique, tag)
+	}
+	return unique
+}
+
+// WatchlistMove moves the watchlist item right after the item of afterID or to
+// the top of the watchlist if afterID is null
+func (app *Application) WatchlistMove(
+	ctx context.Context,
+	userID int,
+	watchID int,
+	afterID null.Int,
+) error {
+	if afterID.Valid && afterID.Int == watchID {
+		return nil
+	}
+	err := app.actorTx(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			return tx.WatchlistMove(ctx, userID, watchID, afterID)
+		},
+	)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// UpNextGet returns the next episode to watch of every series the user has
+// activity on with the progress of the user: the recently active first
+func (app *Application) UpNextGet(
+	ctx context.Context,
+	userID int,
+	offset int,
+	limit int,
+	localeOptions query.LocaleOptions,
+) (upNext []*watchlist.UpNext, total int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			var err error
+			upNext, err = tx.UpNextGet(ctx, userID, offset, limit)
+			if err != nil {
+				return err
+			}
+			total, err = tx.UpNextCount(ctx, userID)
+			if err != nil {
+				return err
+			}
+			serieses := make([]*models.Series, len(upNext))
+			episodes := make([]*models.Film, len(upNext))
+			for i, next := range upNext {
+				serieses[i] = &next.Series
+				episodes[i] = &next.Episode
+			}
+			err = localizeSerieses(ctx, tx, localeOptions, serieses...)
+			if err != nil {
+				return err
+			}
+			return localizeFilms(ctx, tx, localeOptions, episodes...)
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return upNext, total, nil
+}
+
+// WatchlistAddSeries adds all the episodes of the series to the watchlist in
+// episode order: episodes already in the watchlist are skipped. if follow is
+// set the new episodes of the series are appended to the watchlist too
+func (app *Application) WatchlistAddSeries(
+	ctx context.Context,
+	userID int,
+	seriesID int,
+	follow bool,
+) (watchIDs []int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := tx.SeriesGet(ctx, seriesID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			episodes, err := seriesEpisodesInOrder(ctx, tx, seriesID)
+			if err != nil {
+				return err
+			}
+			filmIDs := make([]int, len(episodes))
+			for i, e := range episodes {
+				filmIDs[i] = e.ID
+			}
+			watchIDs, err = tx.WatchlistAddAll(ctx, userID, filmIDs)
+			if err != nil {
+				return err
+			}
+			if follow {
+				return tx.SeriesFollow(ctx, userID, seriesID)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return watchIDs, nil
+}
+
+// WatchlistAddSeason adds all the episodes of the season to the watchlist in
+// episode order: episodes already in the watchlist are skipped
+func (app *Application) WatchlistAddSeason(
+	ctx context.Context,
+	userID int,
+	seriesID int,
+	seasonNumber int,
+) (watchIDs []int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			_, err := tx.SeriesGet(ctx, seriesID)
+			if err != nil {
+				if err == repo.ErrNoRecord {
+					return ErrNotFound
+				}
+				return err
+			}
+			total, err := tx.EpisodesCountBySeason(ctx, seriesID, seasonNumber)
+			if err != nil {
+				return err
+			}
+			if total == 0 {
+				return ErrNotFound
+			}
+			episodes, err := tx.EpisodesGetAllBySeason(
+				ctx,
+				seriesID,
+				seasonNumber,
+				query.SortOrderOptions{Offset: 0, Limit: total, SortOrder: "asc"},
+			)
+			if err != nil {
+				return err
+			}
+			filmIDs := make([]int, len(episodes))
+			for i, e := range episodes {
+				filmIDs[i] = e.ID
+			}
+			watchIDs, err = tx.WatchlistAddAll(ctx, userID, filmIDs)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return watchIDs, nil
+}
+
+// SeriesUnfollow stops appending the new episodes of the series to the
+// watchlist
+func (app *Application) SeriesUnfollow(
+	ctx context.Context,
+	userID int,
+	seriesID int,
+) error {
+	err := app.actorTx(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			return tx.SeriesUnfollow(ctx, userID, seriesID)
+		},
+	)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// WatchEventCreate logs a watch of the watchlist item: the time watched of the
+// item is its latest watch
+func (app *Application) WatchEventCreate(
+	ctx context.Context,
+	userID int,
+	watchID int,
+	req *dto.WatchEventCreateRequest,
+) (eventID int, err error) {
+	event := &models.WatchEvent{
+		TimeWatched: req.TimeWatched.Time,
+		Rating:      req.Rating,
+		Device:      req.Device,
+	}
+	err = app.actorTx(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			return tx.WatchEventCreate(ctx, userID, watchID, event)
+		},
+	)
+	if err != nil {
+		if err == repo.ErrNoRecord {
+			return 0, ErrNotFound
+		}
+		return 0, err
+	}
+	return event.ID, nil
+}
+
+// WatchEventUndo undoes the latest watch of the watchlist item: the time
+// watched of the item falls back to its previous watch if any
+func (app *Application) WatchEventUndo(
+	ctx context.Context,
+	userID int,
+	watchID int,
+) error {
+	err := app.actorTx(
+		ctx,
+		func(ctx context.Context, tx repo.Service) error {
+			return tx.WatchEventUndo(ctx, userID, watchID)
+		},
+	)
 	if err != nil {
 		if err == repo.ErrNoRecord {
 			return ErrNotFound
@@ -98,3 +432,33 @@ func (app *Application) WatchlistSetWatched(
 	}
 	return nil
 }
+
+func (app *Application) WatchEventsGetAll(
+	ctx context.Context,
+	userID int,
+	watchID int,
+	queryOptions query.SortOrderOptions,
+) (events []*models.WatchEvent, total int, err error) {
+	err = app.repo.Tx(
+		ctx,
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			var err error
+			events, err = tx.WatchEventsGetAll(
+				ctx,
+				userID,
+				watchID,
+				queryOptions,
+			)
+			if err != nil {
+				return err
+			}
+			total, err = tx.WatchEventsCount(ctx, userID, watchID)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	return events, total, nil
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
 	"github.com/aria3ppp/watchlist-server/internal/models"
 	"github.com/aria3ppp/watchlist-server/internal/query"
 	"github.com/aria3ppp/watchlist-server/internal/repo"
@@ -15,6 +16,7 @@ import (
 	"github.com/aria3ppp/watchlist-server/internal/watchlist"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
 )
 
 func TestWatchlistGet(t *testing.T) {
@@ -29,6 +31,11 @@ func TestWatchlistGet(t *testing.T) {
 			Limit:            math.MaxInt,
 			SortOrder:        "asc",
 			WhereTimeWatched: repo.RawSqlWhereTimeWatchedEmptyClause,
+			Release: query.ReleaseOptions{
+				Region:      "US",
+				Released:    true,
+				ReleaseType: "streaming",
+			},
 		}
 
 		expWatchlist = []*watchlist.Item{
@@ -172,17 +179,25 @@ func TestWatchlistGet(t *testing.T) {
 
 			if tc.getAll.exp.err == nil {
 				mockRepo.EXPECT().
-					WatchlistCount(ctx, userID, queryOptions.WhereTimeWatched).
+					WatchlistCount(
+						ctx,
+						userID,
+						queryOptions.WhereTimeWatched,
+						queryOptions.Release,
+						queryOptions.Watched,
+						queryOptions.Item,
+					).
 					Return(tc.count.exp.total, tc.count.exp.err).
 					After(getAllCall)
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			watchlist, total, err := app.WatchlistGet(
 				ctx,
 				userID,
 				queryOptions,
+				query.LocaleOptions{},
 			)
 			require.Equal(tc.exp.err, err)
 			require.Equal(tc.exp.watchlist, watchlist)
@@ -350,7 +365,7 @@ func TestWatchlistAdd(t *testing.T) {
 					After(filmExistsCall)
 			}
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			watchID, err := app.WatchlistAdd(ctx, userID, filmID)
 			require.Equal(tc.exp.err, err)
@@ -433,11 +448,17 @@ func TestWatchlistDelete(t *testing.T) {
 			controller := gomock.NewController(t)
 			mockRepo := mock_repo.NewMockServiceTx(controller)
 
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+
 			mockRepo.EXPECT().
 				WatchlistDelete(ctx, userID, watchID).
 				Return(tc.delete.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			err := app.WatchlistDelete(ctx, userID, watchID)
 			require.Equal(tc.exp.err, err)
@@ -445,6 +466,38 @@ func TestWatchlistDelete(t *testing.T) {
 	}
 }
 
+func TestWatchlistDeleteActor(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		userID      = 1
+		moderatorID = 2
+		watchID     = 3
+		ctx         = app.WithActor(context.Background(), moderatorID)
+	)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockServiceTx(controller)
+
+	// the delete runs in a transaction recording the acting user in its audit
+	mockRepo.EXPECT().
+		Tx(ctx, nil, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+			actorID, ok := repo.ActorFromContext(ctx)
+			require.True(ok)
+			require.Equal(moderatorID, actorID)
+			return fn(ctx, mockRepo)
+		})
+	mockRepo.EXPECT().
+		WatchlistDelete(ctx, userID, watchID).
+		Return(nil)
+
+	app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	err := app.WatchlistDelete(ctx, userID, watchID)
+	require.NoError(err)
+}
+
 func TestWatchlistSetWatched(t *testing.T) {
 	t.Parallel()
 
@@ -519,14 +572,530 @@ func TestWatchlistSetWatched(t *testing.T) {
 			controller := gomock.NewController(t)
 			mockRepo := mock_repo.NewMockServiceTx(controller)
 
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+
 			mockRepo.EXPECT().
 				WatchlistSetWatched(ctx, userID, watchID).
 				Return(tc.setWatched.exp.err)
 
-			app := app.NewApplication(mockRepo, nil, nil, nil, nil)
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
 
 			err := app.WatchlistSetWatched(ctx, userID, watchID)
 			require.Equal(tc.exp.err, err)
 		})
 	}
 }
+
+func TestWatchlistMove(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		userID  = 1
+		watchID = 1
+		afterID = null.IntFrom(2)
+
+		expWatchlistMoveError = errors.New("WatchlistMove error")
+	)
+
+	type MoveExp struct {
+		err error
+	}
+	type Move struct {
+		exp MoveExp
+	}
+	type Exp struct {
+		err error
+	}
+	type TestCase struct {
+		name string
+		move Move
+		exp  Exp
+	}
+
+	testCases := []TestCase{
+		{
+			name: "not found",
+			move: Move{
+				exp: MoveExp{
+					err: repo.ErrNoRecord,
+				},
+			},
+			exp: Exp{
+				err: app.ErrNotFound,
+			},
+		},
+
+		{
+			name: "WatchlistMove error",
+			move: Move{
+				exp: MoveExp{
+					err: expWatchlistMoveError,
+				},
+			},
+			exp: Exp{
+				err: expWatchlistMoveError,
+			},
+		},
+
+		{
+			name: "ok",
+			move: Move{
+				exp: MoveExp{
+					err: nil,
+				},
+			},
+			exp: Exp{
+				err: nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+
+			mockRepo.EXPECT().
+				WatchlistMove(ctx, userID, watchID, afterID).
+				Return(tc.move.exp.err)
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.WatchlistMove(ctx, userID, watchID, afterID)
+			require.Equal(tc.exp.err, err)
+		})
+	}
+
+	// moving an item after itself changes nothing
+	t.Run("after itself", func(t *testing.T) {
+		t.Parallel()
+		require := require.New(t)
+
+		controller := gomock.NewController(t)
+		mockRepo := mock_repo.NewMockServiceTx(controller)
+
+		app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+		err := app.WatchlistMove(ctx, userID, watchID, null.IntFrom(watchID))
+		require.NoError(err)
+	})
+}
+
+func TestUpNextGet(t *testing.T) {
+	t.Parallel()
+
+	require := require.New(t)
+
+	var (
+		ctx    = context.Background()
+		userID = 1
+		upNext = []*watchlist.UpNext{
+			{
+				Series:        models.Series{ID: 1},
+				Episode:       models.Film{ID: 3},
+				EpisodesCount: 10,
+				WatchedCount:  2,
+				WatchID:       null.IntFrom(5),
+			},
+		}
+	)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockServiceTx(controller)
+
+	mockRepo.EXPECT().
+		Tx(ctx, nil, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+			return fn(ctx, mockRepo)
+		})
+	mockRepo.EXPECT().
+		UpNextGet(ctx, userID, 0, 10).
+		Return(upNext, nil)
+	mockRepo.EXPECT().
+		UpNextCount(ctx, userID).
+		Return(len(upNext), nil)
+
+	app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	gotUpNext, total, err := app.UpNextGet(
+		ctx,
+		userID,
+		0,
+		10,
+		query.LocaleOptions{},
+	)
+	require.NoError(err)
+	require.Equal(upNext, gotUpNext)
+	require.Equal(len(upNext), total)
+}
+
+func TestWatchlistAddSeries(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = context.Background()
+		userID   = 1
+		seriesID = 2
+	)
+
+	type TestCase struct {
+		name         string
+		seriesGetErr error
+		follow       bool
+		episodes     []*models.Film
+		expFilmIDs   []int
+		expWatchIDs  []int
+		expErr       error
+	}
+
+	testCases := []TestCase{
+		{
+			name:         "series not found",
+			seriesGetErr: repo.ErrNoRecord,
+			expErr:       app.ErrNotFound,
+		},
+		{
+			name:        "episodes in order",
+			episodes:    []*models.Film{{ID: 21}, {ID: 22}, {ID: 31}},
+			expFilmIDs:  []int{21, 22, 31},
+			expWatchIDs: []int{1, 2},
+		},
+		{
+			name:        "episodes in order and follow",
+			follow:      true,
+			episodes:    []*models.Film{{ID: 21}},
+			expFilmIDs:  []int{21},
+			expWatchIDs: []int{1},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+			mockRepo.EXPECT().
+				SeriesGet(ctx, seriesID).
+				Return(&models.Series{ID: seriesID}, tc.seriesGetErr)
+			if tc.seriesGetErr == nil {
+				mockRepo.EXPECT().
+					EpisodesCountBySeries(ctx, seriesID).
+					Return(len(tc.episodes), nil)
+				mockRepo.EXPECT().
+					EpisodesGetAllBySeries(
+						ctx,
+						seriesID,
+						query.SortOrderOptions{
+							Offset:    0,
+							Limit:     len(tc.episodes),
+							SortOrder: "asc",
+						},
+					).
+					Return(tc.episodes, nil)
+				mockRepo.EXPECT().
+					WatchlistAddAll(ctx, userID, tc.expFilmIDs).
+					Return(tc.expWatchIDs, nil)
+			}
+			if tc.follow {
+				mockRepo.EXPECT().
+					SeriesFollow(ctx, userID, seriesID).
+					Return(nil)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			watchIDs, err := app.WatchlistAddSeries(
+				ctx,
+				userID,
+				seriesID,
+				tc.follow,
+			)
+			require.Equal(tc.expErr, err)
+			require.Equal(tc.expWatchIDs, watchIDs)
+		})
+	}
+}
+
+func TestWatchlistAddSeason(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx          = context.Background()
+		userID       = 1
+		seriesID     = 2
+		seasonNumber = 3
+	)
+
+	type TestCase struct {
+		name         string
+		seriesGetErr error
+		episodes     []*models.Film
+		expWatchIDs  []int
+		expErr       error
+	}
+
+	testCases := []TestCase{
+		{
+			name:         "series not found",
+			seriesGetErr: repo.ErrNoRecord,
+			expErr:       app.ErrNotFound,
+		},
+		{
+			name:   "season not found",
+			expErr: app.ErrNotFound,
+		},
+		{
+			name:        "episodes in order",
+			episodes:    []*models.Film{{ID: 31}, {ID: 32}},
+			expWatchIDs: []int{1, 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+			mockRepo.EXPECT().
+				SeriesGet(ctx, seriesID).
+				Return(&models.Series{ID: seriesID}, tc.seriesGetErr)
+			if tc.seriesGetErr == nil {
+				mockRepo.EXPECT().
+					EpisodesCountBySeason(ctx, seriesID, seasonNumber).
+					Return(len(tc.episodes), nil)
+			}
+			if len(tc.episodes) > 0 {
+				mockRepo.EXPECT().
+					EpisodesGetAllBySeason(
+						ctx,
+						seriesID,
+						seasonNumber,
+						query.SortOrderOptions{
+							Offset:    0,
+							Limit:     len(tc.episodes),
+							SortOrder: "asc",
+						},
+					).
+					Return(tc.episodes, nil)
+				mockRepo.EXPECT().
+					WatchlistAddAll(ctx, userID, []int{31, 32}).
+					Return(tc.expWatchIDs, nil)
+			}
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			watchIDs, err := app.WatchlistAddSeason(
+				ctx,
+				userID,
+				seriesID,
+				seasonNumber,
+			)
+			require.Equal(tc.expErr, err)
+			require.Equal(tc.expWatchIDs, watchIDs)
+		})
+	}
+}
+
+func TestWatchEventCreate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = context.Background()
+		userID  = 1
+		watchID = 2
+		eventID = 3
+		req     = &dto.WatchEventCreateRequest{
+			Rating: null.IntFrom(8),
+			Device: null.StringFrom("tv"),
+		}
+	)
+
+	type TestCase struct {
+		name       string
+		createErr  error
+		expEventID int
+		expErr     error
+	}
+
+	testCases := []TestCase{
+		{
+			name:      "watchlist record not found",
+			createErr: repo.ErrNoRecord,
+			expErr:    app.ErrNotFound,
+		},
+		{
+			name:       "ok",
+			expEventID: eventID,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+
+			mockRepo.EXPECT().
+				WatchEventCreate(ctx, userID, watchID, &models.WatchEvent{
+					Rating: req.Rating,
+					Device: req.Device,
+				}).
+				DoAndReturn(
+					func(_ context.Context, _ int, _ int, event *models.WatchEvent) error {
+						event.ID = eventID
+						return tc.createErr
+					},
+				)
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			gotEventID, err := app.WatchEventCreate(ctx, userID, watchID, req)
+			require.Equal(tc.expErr, err)
+			require.Equal(tc.expEventID, gotEventID)
+		})
+	}
+}
+
+func TestWatchEventUndo(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = context.Background()
+		userID  = 1
+		watchID = 2
+	)
+
+	type TestCase struct {
+		name    string
+		undoErr error
+		expErr  error
+	}
+
+	testCases := []TestCase{
+		{
+			name:    "no watch to undo",
+			undoErr: repo.ErrNoRecord,
+			expErr:  app.ErrNotFound,
+		},
+		{
+			name: "ok",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+
+			controller := gomock.NewController(t)
+			mockRepo := mock_repo.NewMockServiceTx(controller)
+
+			mockRepo.EXPECT().
+				Tx(ctx, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+					return fn(ctx, mockRepo)
+				})
+
+			mockRepo.EXPECT().
+				WatchEventUndo(ctx, userID, watchID).
+				Return(tc.undoErr)
+
+			app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+			err := app.WatchEventUndo(ctx, userID, watchID)
+			require.Equal(tc.expErr, err)
+		})
+	}
+}
+
+func TestWatchEventsGetAll(t *testing.T) {
+	t.Parallel()
+
+	require := require.New(t)
+
+	var (
+		ctx          = context.Background()
+		userID       = 1
+		watchID      = 2
+		queryOptions = query.SortOrderOptions{
+			Offset:    0,
+			Limit:     math.MaxInt,
+			SortOrder: "desc",
+		}
+		events = []*models.WatchEvent{
+			{ID: 2, WatchID: watchID},
+			{ID: 1, WatchID: watchID},
+		}
+	)
+
+	controller := gomock.NewController(t)
+	mockRepo := mock_repo.NewMockServiceTx(controller)
+
+	mockRepo.EXPECT().
+		Tx(ctx, nil, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, opts *sql.TxOptions, fn func(context.Context, repo.Service) error) error {
+			return fn(ctx, mockRepo)
+		})
+	mockRepo.EXPECT().
+		WatchEventsGetAll(ctx, userID, watchID, queryOptions).
+		Return(events, nil)
+	mockRepo.EXPECT().
+		WatchEventsCount(ctx, userID, watchID).
+		Return(len(events), nil)
+
+	app := app.NewApplication(mockRepo, nil, nil, nil, nil, nil)
+
+	gotEvents, total, err := app.WatchEventsGetAll(
+		ctx,
+		userID,
+		watchID,
+		queryOptions,
+	)
+	require.NoError(err)
+	require.Equal(events, gotEvents)
+	require.Equal(len(events), total)
+}
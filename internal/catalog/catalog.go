@@ -0,0 +1,10 @@
+package catalog
+
+import "errors"
+
+const (
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported format")
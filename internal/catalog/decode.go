@@ -0,0 +1,221 @@
+package catalog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/volatiletech/null/v8"
+)
+
+// Row is a decoded row of an import file.
+// Number is the 1-based row number and Err is set if the row failed decoding.
+type Row struct {
+	Number int
+	Data   *dto.ImportRow
+	Err    error
+}
+
+// Decode decodes all the rows of an import file.
+// A malformed row is returned with its decoding error set while an error is
+// returned only if the file could not be decoded at all.
+func Decode(r io.Reader, format string) ([]*Row, error) {
+	switch format {
+	case FormatCSV:
+		return decodeCSV(r)
+	case FormatNDJSON:
+		return decodeNDJSON(r)
+	}
+	return nil, ErrUnsupportedFormat
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// csv cell decoders keyed by header column name
+var csvColumns = map[string]func(row *dto.ImportRow, cell string) error{
+	"kind": func(row *dto.ImportRow, cell string) error {
+		row.Kind = cell
+		return nil
+	},
+	"title": func(row *dto.ImportRow, cell string) error {
+		row.Title = cell
+		return nil
+	},
+	"descriptions": func(row *dto.ImportRow, cell string) error {
+		row.Descriptions = null.NewString(cell, cell != "")
+		return nil
+	},
+	"date_released": func(row *dto.ImportRow, cell string) (err error) {
+		row.DateReleased, err = parseDate(cell)
+		return err
+	},
+	"duration": func(row *dto.ImportRow, cell string) (err error) {
+		row.Duration, err = parseNullInt(cell)
+		return err
+	},
+	"date_started": func(row *dto.ImportRow, cell string) (err error) {
+		row.DateStarted, err = parseDate(cell)
+		return err
+	},
+	"date_ended": func(row *dto.ImportRow, cell string) error {
+		date, err := parseDate(cell)
+		row.DateEnded = null.NewTime(date, !date.IsZero())
+		return err
+	},
+	"series_id": func(row *dto.ImportRow, cell string) (err error) {
+		row.SeriesID, err = parseNullInt(cell)
+		return err
+	},
+	"series_row": func(row *dto.ImportRow, cell string) (err error) {
+		row.SeriesRow, err = parseNullInt(cell)
+		return err
+	},
+	"season_number": func(row *dto.ImportRow, cell string) (err error) {
+		row.SeasonNumber, err = parseInt(cell)
+		return err
+	},
+	"episode_number": func(row *dto.ImportRow, cell string) (err error) {
+		row.EpisodeNumber, err = parseInt(cell)
+		return err
+	},
+	"absolute_number": func(row *dto.ImportRow, cell string) (err error) {
+		row.AbsoluteNumber, err = parseNullInt(cell)
+		return err
+	},
+	"part_number": func(row *dto.ImportRow, cell string) (err error) {
+		row.PartNumber, err = parseNullInt(cell)
+		return err
+	},
+}
+
+var (
+	errInvalidDate    = validation.NewError("validation_is_date", "must be a valid date")
+	errInvalidInteger = validation.NewError("validation_is_int", "must be an integer number")
+)
+
+func decodeCSV(r io.Reader) ([]*Row, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	// read header
+	header, err := reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, errors.New("missing csv header")
+		}
+		return nil, err
+	}
+	hasKind := false
+	for _, column := range header {
+		if _, exists := csvColumns[column]; !exists {
+			return nil, fmt.Errorf("unknown csv column %q", column)
+		}
+		if column == "kind" {
+			hasKind = true
+		}
+	}
+	if !hasKind {
+		return nil, errors.New("missing csv column \"kind\"")
+	}
+
+	// read rows
+	var rows []*Row
+	for number := 1; ; number++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		row := &Row{Number: number, Data: &dto.ImportRow{}}
+		rows = append(rows, row)
+		if err != nil {
+			// a record with wrong number of fields is only a malformed row
+			if errors.Is(err, csv.ErrFieldCount) {
+				row.Err = csv.ErrFieldCount
+				continue
+			}
+			return nil, err
+		}
+		// decode cells
+		cellErrs := validation.Errors{}
+		for i, cell := range record {
+			if err := csvColumns[header[i]](row.Data, cell); err != nil {
+				cellErrs[header[i]] = err
+			}
+		}
+		row.Err = cellErrs.Filter()
+	}
+
+	return rows, nil
+}
+
+func parseDate(cell string) (time.Time, error) {
+	if cell == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range []string{"2006-01-02", time.RFC3339} {
+		if date, err := time.Parse(layout, cell); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, errInvalidDate
+}
+
+func parseInt(cell string) (int, error) {
+	if cell == "" {
+		return 0, nil
+	}
+	number, err := strconv.Atoi(cell)
+	if err != nil {
+		return 0, errInvalidInteger
+	}
+	return number, nil
+}
+
+func parseNullInt(cell string) (null.Int, error) {
+	if cell == "" {
+		return null.Int{}, nil
+	}
+	number, err := parseInt(cell)
+	if err != nil {
+		return null.Int{}, err
+	}
+	return null.IntFrom(number), nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func decodeNDJSON(r io.Reader) ([]*Row, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, bufio.MaxScanTokenSize*16)
+
+	var rows []*Row
+	for number := 1; scanner.Scan(); {
+		line := bytes.TrimSpace(scanner.Bytes())
+		// skip blank lines
+		if len(line) == 0 {
+			continue
+		}
+		row := &Row{Number: number, Data: &dto.ImportRow{}}
+		rows = append(rows, row)
+		number++
+
+		decoder := json.NewDecoder(bytes.NewReader(line))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(row.Data); err != nil {
+			row.Err = err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,211 @@
+package catalog_test
+
+import (
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/catalog"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestDecode(t *testing.T) {
+	require := require.New(t)
+
+	// unsupported format
+	_, err := catalog.Decode(strings.NewReader(""), "xml")
+	require.Equal(catalog.ErrUnsupportedFormat, err)
+}
+
+func TestDecodeCSV(t *testing.T) {
+	testCases := []struct {
+		name      string
+		file      string
+		expRows   []*catalog.Row
+		expHasErr bool
+	}{
+		{
+			name:      "tc1",
+			file:      "",
+			expHasErr: true,
+		},
+		{
+			name:      "tc2",
+			file:      "kind,title,unknown\n",
+			expHasErr: true,
+		},
+		{
+			name:      "tc3",
+			file:      "title,date_released\n",
+			expHasErr: true,
+		},
+		{
+			name:    "tc4",
+			file:    "kind,title\n",
+			expRows: nil,
+		},
+		{
+			name: "tc5",
+			file: "kind,title,descriptions,date_released,duration,date_started,date_ended,series_id,series_row,season_number,episode_number\n" +
+				"movie,Movie,,2000-01-01,5400,,,,,,\n" +
+				"series,Series,\"a, description\",,,2000-01-01,2001-01-01T00:00:00Z,,,,\n" +
+				"episode,Episode,,2000-01-01,,,,,2,1,1\n" +
+				"episode,Episode,,2000-01-01,,,,12,,1,2\n",
+			expRows: []*catalog.Row{
+				{
+					Number: 1,
+					Data: &dto.ImportRow{
+						Kind:         dto.ImportRowKindMovie,
+						Title:        "Movie",
+						DateReleased: testutils.Date(2000, time.January, 1),
+						Duration:     null.IntFrom(5400),
+					},
+				},
+				{
+					Number: 2,
+					Data: &dto.ImportRow{
+						Kind:         dto.ImportRowKindSeries,
+						Title:        "Series",
+						Descriptions: null.StringFrom("a, description"),
+						DateStarted:  testutils.Date(2000, time.January, 1),
+						DateEnded: null.TimeFrom(
+							testutils.Date(2001, time.January, 1),
+						),
+					},
+				},
+				{
+					Number: 3,
+					Data: &dto.ImportRow{
+						Kind:          dto.ImportRowKindEpisode,
+						Title:         "Episode",
+						DateReleased:  testutils.Date(2000, time.January, 1),
+						SeriesRow:     null.IntFrom(2),
+						SeasonNumber:  1,
+						EpisodeNumber: 1,
+					},
+				},
+				{
+					Number: 4,
+					Data: &dto.ImportRow{
+						Kind:          dto.ImportRowKindEpisode,
+						Title:         "Episode",
+						DateReleased:  testutils.Date(2000, time.January, 1),
+						SeriesID:      null.IntFrom(12),
+						SeasonNumber:  1,
+						EpisodeNumber: 2,
+					},
+				},
+			},
+		},
+		{
+			name: "tc6",
+			file: "kind,title,date_released,duration\n" +
+				"movie,Movie,01/01/2000,long\n" +
+				"movie,Movie\n" +
+				"movie,Movie,2000-01-01,\n",
+			expRows: []*catalog.Row{
+				{
+					Number: 1,
+					Data: &dto.ImportRow{
+						Kind:  dto.ImportRowKindMovie,
+						Title: "Movie",
+					},
+					Err: validation.Errors{
+						"date_released": validation.NewError(
+							"validation_is_date",
+							"must be a valid date",
+						),
+						"duration": validation.NewError(
+							"validation_is_int",
+							"must be an integer number",
+						),
+					},
+				},
+				{
+					Number: 2,
+					Data:   &dto.ImportRow{},
+					Err:    csv.ErrFieldCount,
+				},
+				{
+					Number: 3,
+					Data: &dto.ImportRow{
+						Kind:         dto.ImportRowKindMovie,
+						Title:        "Movie",
+						DateReleased: testutils.Date(2000, time.January, 1),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+
+			rows, err := catalog.Decode(
+				strings.NewReader(tc.file),
+				catalog.FormatCSV,
+			)
+			if tc.expHasErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tc.expRows, rows)
+		})
+	}
+}
+
+func TestDecodeNDJSON(t *testing.T) {
+	require := require.New(t)
+
+	file := `{"kind": "movie", "title": "Movie", "date_released": "2000-01-01T00:00:00Z", "duration": 5400}
+
+{"kind": "series", "title": "Series", "unknown": true}
+{"kind": "series", "title": 
+{"kind": "episode", "title": "Episode", "date_released": "2000-01-01T00:00:00Z", "series_id": 12, "season_number": 1, "episode_number": 1}
+`
+
+	rows, err := catalog.Decode(strings.NewReader(file), catalog.FormatNDJSON)
+	require.NoError(err)
+	require.Equal(4, len(rows))
+
+	require.Equal(1, rows[0].Number)
+	require.NoError(rows[0].Err)
+	require.Equal(
+		&dto.ImportRow{
+			Kind:         dto.ImportRowKindMovie,
+			Title:        "Movie",
+			DateReleased: testutils.Date(2000, time.January, 1),
+			Duration:     null.IntFrom(5400),
+		},
+		rows[0].Data,
+	)
+
+	// unknown fields are not allowed
+	require.Equal(2, rows[1].Number)
+	require.Error(rows[1].Err)
+
+	// malformed json
+	require.Equal(3, rows[2].Number)
+	require.Error(rows[2].Err)
+
+	require.Equal(4, rows[3].Number)
+	require.NoError(rows[3].Err)
+	require.Equal(
+		&dto.ImportRow{
+			Kind:          dto.ImportRowKindEpisode,
+			Title:         "Episode",
+			DateReleased:  testutils.Date(2000, time.January, 1),
+			SeriesID:      null.IntFrom(12),
+			SeasonNumber:  1,
+			EpisodeNumber: 1,
+		},
+		rows[3].Data,
+	)
+}
@@ -0,0 +1,147 @@
+package catalog
+
+import (
+	"database/sql/driver"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// ManifestFilename is the name of the manifest file of an export.
+const ManifestFilename = "manifest.json"
+
+// Manifest describes the files of an export.
+type Manifest struct {
+	ExportID  int             `json:"export_id"`
+	CreatedAt time.Time       `json:"created_at"`
+	Files     []*ManifestFile `json:"files"`
+}
+
+// ManifestFile describes a gzip compressed dump of a table.
+// Size and SHA256 are of the compressed file.
+type ManifestFile struct {
+	Name   string `json:"name"`
+	Table  string `json:"table"`
+	Format string `json:"format"`
+	Rows   int    `json:"rows"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// Filename returns the name of the gzip compressed dump of a table.
+func Filename(table string, format string) string {
+	return table + "." + format + ".gz"
+}
+
+// Encoder encodes the records of a table one at a time.
+type Encoder interface {
+	Encode(record any) error
+	// Flush writes any buffered data to the underlying writer
+	Flush() error
+}
+
+// NewEncoder returns an encoder writing records of the same type as model.
+// A csv encoder writes a header of model's json field names up front.
+func NewEncoder(w io.Writer, format string, model any) (Encoder, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVEncoder(w, model)
+	case FormatNDJSON:
+		return &ndjsonEncoder{encoder: json.NewEncoder(w)}, nil
+	}
+	return nil, ErrUnsupportedFormat
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type ndjsonEncoder struct {
+	encoder *json.Encoder
+}
+
+func (e *ndjsonEncoder) Encode(record any) error {
+	return e.encoder.Encode(record)
+}
+
+func (e *ndjsonEncoder) Flush() error {
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type csvEncoder struct {
+	writer *csv.Writer
+	// struct field indexes of the columns
+	fields []int
+	row    []string
+}
+
+func newCSVEncoder(w io.Writer, model any) (*csvEncoder, error) {
+	t := reflect.TypeOf(model)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("catalog: csv model must be a struct: got %s", t)
+	}
+	var (
+		header []string
+		fields []int
+	)
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" || !t.Field(i).IsExported() {
+			continue
+		}
+		header = append(header, name)
+		fields = append(fields, i)
+	}
+	e := &csvEncoder{
+		writer: csv.NewWriter(w),
+		fields: fields,
+		row:    make([]string, len(fields)),
+	}
+	if err := e.writer.Write(header); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (e *csvEncoder) Encode(record any) error {
+	v := reflect.Indirect(reflect.ValueOf(record))
+	for i, field := range e.fields {
+		cell, err := csvCell(v.Field(field).Interface())
+		if err != nil {
+			return err
+		}
+		e.row[i] = cell
+	}
+	return e.writer.Write(e.row)
+}
+
+func (e *csvEncoder) Flush() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+// csvCell formats a value so it could be decoded back by Decode: null values
+// are empty cells and times are RFC3339
+func csvCell(value any) (string, error) {
+	if valuer, ok := value.(driver.Valuer); ok {
+		v, err := valuer.Value()
+		if err != nil {
+			return "", err
+		}
+		if v == nil {
+			return "", nil
+		}
+		value = v
+	}
+	if t, ok := value.(time.Time); ok {
+		return t.Format(time.RFC3339), nil
+	}
+	return fmt.Sprint(value), nil
+}
@@ -0,0 +1,115 @@
+package catalog_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/catalog"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+var encodeRecords = []*models.Film{
+	{
+		ID:            1,
+		Title:         "movie",
+		Descriptions:  null.StringFrom("a, \"quoted\" description"),
+		DateReleased:  testutils.Date(2000, 1, 1),
+		Duration:      null.IntFrom(7200),
+		ContributedBy: 1,
+		ContributedAt: testutils.Date(2022, 12, 31),
+	},
+	{
+		ID:            2,
+		Title:         "episode",
+		DateReleased:  testutils.Date(2001, 2, 3),
+		SeriesID:      null.IntFrom(1),
+		SeasonNumber:  null.IntFrom(1),
+		EpisodeNumber: null.IntFrom(2),
+		ContributedBy: 2,
+		ContributedAt: testutils.Date(2023, 1, 1),
+		Invalidation:  null.StringFrom("invalid"),
+	},
+}
+
+func TestNewEncoder(t *testing.T) {
+	require := require.New(t)
+
+	// unsupported format
+	_, err := catalog.NewEncoder(&bytes.Buffer{}, "xml", models.Film{})
+	require.Equal(catalog.ErrUnsupportedFormat, err)
+
+	// csv model must be a struct
+	_, err = catalog.NewEncoder(&bytes.Buffer{}, catalog.FormatCSV, 1)
+	require.Error(err)
+}
+
+func TestEncodeCSV(t *testing.T) {
+	require := require.New(t)
+
+	var buf bytes.Buffer
+	encoder, err := catalog.NewEncoder(&buf, catalog.FormatCSV, models.Film{})
+	require.NoError(err)
+
+	for _, record := range encodeRecords {
+		require.NoError(encoder.Encode(record))
+	}
+	require.NoError(encoder.Flush())
+
+	expFile := strings.Join(
+		[]string{
+			"id,title,descriptions,date_released,duration,series_id,season_number,episode_number,poster,contributed_by,contributed_at,invalidation,absolute_number,part_number,deleted_at",
+			`1,movie,"a, ""quoted"" description",2000-01-01T00:00:00Z,7200,,,,,1,2022-12-31T00:00:00Z,,,,`,
+			"2,episode,,2001-02-03T00:00:00Z,,1,1,2,,2,2023-01-01T00:00:00Z,invalid,,,",
+		},
+		"\n",
+	) + "\n"
+	require.Equal(expFile, buf.String())
+}
+
+func TestEncodeCSV_empty(t *testing.T) {
+	require := require.New(t)
+
+	var buf bytes.Buffer
+	encoder, err := catalog.NewEncoder(&buf, catalog.FormatCSV, &models.Series{})
+	require.NoError(err)
+	require.NoError(encoder.Flush())
+
+	require.Equal(
+		"id,title,descriptions,date_started,date_ended,poster,contributed_by,contributed_at,invalidation,deleted_at\n",
+		buf.String(),
+	)
+}
+
+func TestEncodeNDJSON(t *testing.T) {
+	require := require.New(t)
+
+	var buf bytes.Buffer
+	encoder, err := catalog.NewEncoder(&buf, catalog.FormatNDJSON, models.Film{})
+	require.NoError(err)
+
+	for _, record := range encodeRecords {
+		require.NoError(encoder.Encode(record))
+	}
+	require.NoError(encoder.Flush())
+
+	expFile := strings.Join(
+		[]string{
+			`{"id":1,"title":"movie","descriptions":"a, \"quoted\" description","date_released":"2000-01-01T00:00:00Z","duration":7200,"series_id":null,"season_number":null,"episode_number":null,"poster":null,"contributed_by":1,"contributed_at":"2022-12-31T00:00:00Z","invalidation":null,"absolute_number":null,"part_number":null,"deleted_at":null}`,
+			`{"id":2,"title":"episode","descriptions":null,"date_released":"2001-02-03T00:00:00Z","duration":null,"series_id":1,"season_number":1,"episode_number":2,"poster":null,"contributed_by":2,"contributed_at":"2023-01-01T00:00:00Z","invalidation":"invalid","absolute_number":null,"part_number":null,"deleted_at":null}`,
+		},
+		"\n",
+	) + "\n"
+	require.Equal(expFile, buf.String())
+}
+
+func TestFilename(t *testing.T) {
+	require.Equal(
+		t,
+		"films.ndjson.gz",
+		catalog.Filename(models.TableNames.Films, catalog.FormatNDJSON),
+	)
+}
@@ -0,0 +1,10 @@
+package collection
+
+import "github.com/aria3ppp/watchlist-server/internal/models"
+
+// Item is a collection item along with the movie or series it refers to
+type Item struct {
+	models.CollectionItem
+	Film   *models.Film   `json:"film,omitempty"`
+	Series *models.Series `json:"series,omitempty"`
+}
@@ -44,19 +44,77 @@ type config struct {
 				Name           string   `yaml:"name" env-required:"true"`
 				SupportedTypes []string `yaml:"supported_types" env-required:"true"`
 			} `yaml:"image" env-required:"true"`
+			Export struct {
+				Name string `yaml:"name" env-required:"true"`
+			} `yaml:"export" env-required:"true"`
 		} `yaml:"bucket" env-required:"true"`
 		Category struct {
-			User   string `yaml:"user" env-required:"true"`
-			Series string `yaml:"series" env-required:"true"`
-			Movie  string `yaml:"movie" env-required:"true"`
+			User         string `yaml:"user" env-required:"true"`
+			Series       string `yaml:"series" env-required:"true"`
+			Movie        string `yaml:"movie" env-required:"true"`
+			Export       string `yaml:"export" env-required:"true"`
+			AuditArchive string `yaml:"audit_archive" env-required:"true"`
 		} `yaml:"category" env-required:"true"`
 		Filename struct {
 			User   string `yaml:"user" env-required:"true"`
 			Series string `yaml:"series" env-required:"true"`
 			Movie  string `yaml:"movie" env-required:"true"`
+			Media  string `yaml:"media" env-required:"true"`
 		} `yaml:"filename" env-required:"true"`
 	} `yaml:"minio" env-required:"true"`
 
+	Import struct {
+		BatchSize int `yaml:"batch_size" env-required:"true"`
+		MaxRows   int `yaml:"max_rows" env-required:"true"`
+	} `yaml:"import" env-required:"true"`
+
+	Metadata struct {
+		TimeoutInSeconds  int `yaml:"timeout_in_seconds" env-required:"true"`
+		PosterMaxSizeInKB int `yaml:"poster_max_size_in_kb" env-required:"true"`
+		TMDb              struct {
+			BaseUrl      string `yaml:"base_url" env-required:"true"`
+			ImageBaseUrl string `yaml:"image_base_url" env-required:"true"`
+			ApiKey       string `yaml:"api_key" env:"TMDB_API_KEY"`
+		} `yaml:"tmdb" env-required:"true"`
+	} `yaml:"metadata" env-required:"true"`
+
+	Export struct {
+		IntervalInHours int      `yaml:"interval_in_hours"`
+		BatchSize       int      `yaml:"batch_size" env-required:"true"`
+		Formats         []string `yaml:"formats" env-required:"true"`
+		IncludeAudits   bool     `yaml:"include_audits"`
+	} `yaml:"export" env-required:"true"`
+
+	AuditRetention struct {
+		IntervalInHours int  `yaml:"interval_in_hours"`
+		KeepLast        int  `yaml:"keep_last"`
+		MaxAgeInDays    int  `yaml:"max_age_in_days"`
+		BatchSize       int  `yaml:"batch_size" env-required:"true"`
+		Archive         bool `yaml:"archive"`
+		DryRun          bool `yaml:"dry_run" env:"AUDIT_RETENTION_DRY_RUN"`
+	} `yaml:"audit_retention" env-required:"true"`
+
+	Reputation struct {
+		CreationPoints      int `yaml:"creation_points"`
+		EditPoints          int `yaml:"edit_points"`
+		RevertedEditPenalty int `yaml:"reverted_edit_penalty"`
+		InvalidationPenalty int `yaml:"invalidation_penalty"`
+		MinEditScore        int `yaml:"min_edit_score"`
+	} `yaml:"reputation" env-required:"true"`
+
+	Playback struct {
+		WatchedThresholdPercent int `yaml:"watched_threshold_percent"`
+	} `yaml:"playback" env-required:"true"`
+
+	List struct {
+		InviteExpireInHours int `yaml:"invite_expire_in_hours" env-required:"true"`
+	} `yaml:"list" env-required:"true"`
+
+	Stats struct {
+		TopCount                int `yaml:"top_count" env-required:"true"`
+		YearReviewExpireInHours int `yaml:"year_review_expire_in_hours"`
+	} `yaml:"stats" env-required:"true"`
+
 	Validation struct {
 		Pagination struct {
 			Page struct {
@@ -80,6 +138,9 @@ type config struct {
 				MinLength int `yaml:"min_length" env-required:"true"`
 				MaxLength int `yaml:"max_length" env-required:"true"`
 			} `yaml:"invalidation" env-required:"true"`
+			MaxAge struct {
+				MaxValue int `yaml:"max_value" env-required:"true"`
+			} `yaml:"max_age" env-required:"true"`
 			Array struct {
 				MaxLength int `yaml:"max_length" env-required:"true"`
 			} `yaml:"array" env-required:"true"`
@@ -148,6 +209,12 @@ type config struct {
 			SeasonNumber struct {
 				MaxValue int `yaml:"max_value" env-required:"true"`
 			} `yaml:"season_number" env-required:"true"`
+			AbsoluteNumber struct {
+				MaxValue int `yaml:"max_value" env-required:"true"`
+			} `yaml:"absolute_number" env-required:"true"`
+			PartNumber struct {
+				MaxValue int `yaml:"max_value" env-required:"true"`
+			} `yaml:"part_number" env-required:"true"`
 		} `yaml:"film" env-required:"true"`
 
 		Series struct {
@@ -174,5 +241,87 @@ type config struct {
 				} `yaml:"min_value" env-required:"true"`
 			} `yaml:"date_ended" env-required:"true"`
 		} `yaml:"series" env-required:"true"`
+
+		Collection struct {
+			Title struct {
+				MinLength int `yaml:"min_length" env-required:"true"`
+				MaxLength int `yaml:"max_length" env-required:"true"`
+			} `yaml:"title" env-required:"true"`
+			Descriptions struct {
+				MinLength int `yaml:"min_length" env-required:"true"`
+				MaxLength int `yaml:"max_length" env-required:"true"`
+			} `yaml:"descriptions" env-required:"true"`
+		} `yaml:"collection" env-required:"true"`
+
+		List struct {
+			Title struct {
+				MinLength int `yaml:"min_length" env-required:"true"`
+				MaxLength int `yaml:"max_length" env-required:"true"`
+			} `yaml:"title" env-required:"true"`
+			Descriptions struct {
+				MinLength int `yaml:"min_length" env-required:"true"`
+				MaxLength int `yaml:"max_length" env-required:"true"`
+			} `yaml:"descriptions" env-required:"true"`
+		} `yaml:"list" env-required:"true"`
+
+		Media struct {
+			TrailerURL struct {
+				MaxLength int `yaml:"max_length" env-required:"true"`
+			} `yaml:"trailer_url" env-required:"true"`
+		} `yaml:"media" env-required:"true"`
+
+		Translation struct {
+			Title struct {
+				MinLength int `yaml:"min_length" env-required:"true"`
+				MaxLength int `yaml:"max_length" env-required:"true"`
+			} `yaml:"title" env-required:"true"`
+			Descriptions struct {
+				MinLength int `yaml:"min_length" env-required:"true"`
+				MaxLength int `yaml:"max_length" env-required:"true"`
+			} `yaml:"descriptions" env-required:"true"`
+		} `yaml:"translation" env-required:"true"`
+
+		WatchEvent struct {
+			Rating struct {
+				MinValue int `yaml:"min_value" env-required:"true"`
+				MaxValue int `yaml:"max_value" env-required:"true"`
+			} `yaml:"rating" env-required:"true"`
+			Device struct {
+				MaxLength int `yaml:"max_length" env-required:"true"`
+			} `yaml:"device" env-required:"true"`
+		} `yaml:"watch_event" env-required:"true"`
+
+		PlaybackProgress struct {
+			Device struct {
+				MaxLength int `yaml:"max_length" env-required:"true"`
+			} `yaml:"device" env-required:"true"`
+		} `yaml:"playback_progress" env-required:"true"`
+
+		Score struct {
+			MinValue int `yaml:"min_value"`
+			MaxValue int `yaml:"max_value" env-required:"true"`
+		} `yaml:"score" env-required:"true"`
+
+		Review struct {
+			Body struct {
+				MinLength int `yaml:"min_length" env-required:"true"`
+				MaxLength int `yaml:"max_length" env-required:"true"`
+			} `yaml:"body" env-required:"true"`
+		} `yaml:"review" env-required:"true"`
+
+		WatchlistItem struct {
+			Priority struct {
+				MaxValue int `yaml:"max_value" env-required:"true"`
+			} `yaml:"priority" env-required:"true"`
+			Note struct {
+				MaxLength int `yaml:"max_length" env-required:"true"`
+			} `yaml:"note" env-required:"true"`
+			Tag struct {
+				MaxLength int `yaml:"max_length" env-required:"true"`
+			} `yaml:"tag" env-required:"true"`
+			Tags struct {
+				MaxCount int `yaml:"max_count" env-required:"true"`
+			} `yaml:"tags" env-required:"true"`
+		} `yaml:"watchlist_item" env-required:"true"`
 	} `yaml:"validation" env-required:"true"`
 }
@@ -0,0 +1,43 @@
+// Package contribution holds the contribution statistics of the users.
+package contribution
+
+import "time"
+
+// the kinds of the contributed records
+const (
+	KindMovie   = "movie"
+	KindEpisode = "episode"
+	KindSeries  = "series"
+)
+
+// the actions of the contributions: a create is the first version of a record,
+// an invalidate is the version invalidating it and an edit is any other
+// version
+const (
+	ActionCreate     = "create"
+	ActionEdit       = "edit"
+	ActionInvalidate = "invalidate"
+)
+
+// Contribution is a version of a movie, episode or series contributed by a
+// user: a reverted edit is one the next version has restored the previous
+// version of the record
+type Contribution struct {
+	Kind          string    `boil:"kind"           json:"kind"`
+	ID            int       `boil:"id"             json:"id"`
+	Title         string    `boil:"title"          json:"title"`
+	Action        string    `boil:"action"         json:"action"`
+	Reverted      bool      `boil:"reverted"       json:"reverted"`
+	ContributedAt time.Time `boil:"contributed_at" json:"contributed_at"`
+}
+
+// Stats are the contribution statistics of a user along with the reputation
+// score computed from them
+type Stats struct {
+	UserID                int `boil:"user_id"                json:"user_id"`
+	Creations             int `boil:"creations"              json:"creations"`
+	Edits                 int `boil:"edits"                  json:"edits"`
+	RevertedEdits         int `boil:"reverted_edits"         json:"reverted_edits"`
+	InvalidationsReceived int `boil:"invalidations_received" json:"invalidations_received"`
+	Score                 int `boil:"score"                  json:"score"`
+}
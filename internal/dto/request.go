@@ -1,9 +1,14 @@
 package dto
 
 import (
+	"regexp"
 	"time"
 
 	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/list"
+	"github.com/aria3ppp/watchlist-server/internal/locale"
+	"github.com/aria3ppp/watchlist-server/internal/rating"
+	"github.com/aria3ppp/watchlist-server/internal/review"
 	"github.com/aria3ppp/watchlist-server/internal/validator"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
@@ -152,6 +157,7 @@ type UserUpdateRequest struct {
 	LastName  null.String `json:"last_name"`
 	Bio       null.String `json:"bio"`
 	Birthdate null.Time   `json:"birthdate"`
+	Locale    null.String `json:"locale"`
 }
 
 var _ validation.Validatable = UserUpdateRequest{}
@@ -217,6 +223,14 @@ func (r UserUpdateRequest) Validate() error {
 				),
 			),
 		),
+		validation.Field(
+			&r.Locale,
+			validation.When(
+				r.Locale.Valid,
+				validation.Required,
+				validation.Match(locale.Pattern),
+			),
+		),
 	)
 }
 
@@ -623,20 +637,102 @@ func (r MovieUpdateRequest) Validate() error { return FilmUpdateRequest(r).Valid
 // -----------------------------------------------------------------------------
 // EpisodePutRequest
 // -----------------------------------------------------------------------------
-type EpisodePutRequest FilmCreateRequest
+type EpisodePutRequest struct {
+	Title          string      `json:"title"`
+	Descriptions   null.String `json:"descriptions"`
+	DateReleased   time.Time   `json:"date_released"`
+	Duration       null.Int    `json:"duration"`
+	AbsoluteNumber null.Int    `json:"absolute_number"`
+	PartNumber     null.Int    `json:"part_number"`
+}
 
 var _ validation.Validatable = EpisodePutRequest{}
 
-func (r EpisodePutRequest) Validate() error { return FilmCreateRequest(r).Validate() }
+func (r EpisodePutRequest) Validate() error {
+	return mergeValidationErrors(
+		FilmCreateRequest{
+			Title:        r.Title,
+			Descriptions: r.Descriptions,
+			DateReleased: r.DateReleased,
+			Duration:     r.Duration,
+		}.Validate(),
+		validateEpisodeNumbering(r.AbsoluteNumber, r.PartNumber),
+	)
+}
 
 // -----------------------------------------------------------------------------
 // EpisodeUpdateRequest
 // -----------------------------------------------------------------------------
-type EpisodeUpdateRequest FilmUpdateRequest
+type EpisodeUpdateRequest struct {
+	Title          null.String `json:"title"`
+	Descriptions   null.String `json:"descriptions"`
+	DateReleased   null.Time   `json:"date_released"`
+	Duration       null.Int    `json:"duration"`
+	AbsoluteNumber null.Int    `json:"absolute_number"`
+	PartNumber     null.Int    `json:"part_number"`
+}
 
 var _ validation.Validatable = EpisodeUpdateRequest{}
 
-func (r EpisodeUpdateRequest) Validate() error { return FilmUpdateRequest(r).Validate() }
+func (r EpisodeUpdateRequest) Validate() error {
+	return mergeValidationErrors(
+		FilmUpdateRequest{
+			Title:        r.Title,
+			Descriptions: r.Descriptions,
+			DateReleased: r.DateReleased,
+			Duration:     r.Duration,
+		}.Validate(),
+		validateEpisodeNumbering(r.AbsoluteNumber, r.PartNumber),
+	)
+}
+
+// validateEpisodeNumbering validates the optional absolute number of an
+// episode across its series and the part number of a multi-part episode.
+func validateEpisodeNumbering(absoluteNumber, partNumber null.Int) error {
+	return validation.Errors{
+		"absolute_number": validation.Validate(
+			absoluteNumber,
+			validation.When(
+				absoluteNumber.Valid,
+				validation.Required,
+				validation.Min(1),
+				validation.Max(
+					config.Config.Validation.Film.AbsoluteNumber.MaxValue,
+				),
+			),
+		),
+		"part_number": validation.Validate(
+			partNumber,
+			validation.When(
+				partNumber.Valid,
+				validation.Required,
+				validation.Min(1),
+				validation.Max(
+					config.Config.Validation.Film.PartNumber.MaxValue,
+				),
+			),
+		),
+	}.Filter()
+}
+
+// mergeValidationErrors merges field errors of validations into one
+// validation.Errors. an internal error is returned as is.
+func mergeValidationErrors(errs ...error) error {
+	merged := validation.Errors{}
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		fieldErrs, ok := err.(validation.Errors)
+		if !ok {
+			return err
+		}
+		for field, fieldErr := range fieldErrs {
+			merged[field] = fieldErr
+		}
+	}
+	return merged.Filter()
+}
 
 // -----------------------------------------------------------------------------
 // EpisodesPutAllBySeasonRequest
@@ -657,10 +753,137 @@ func (r EpisodesPutAllBySeasonRequest) Validate() error {
 				1,
 				config.Config.Validation.Request.Array.MaxLength,
 			),
+			validation.By(uniqueAbsoluteNumbers),
+		),
+	)
+}
+
+var ErrDuplicateAbsoluteNumber = validation.NewError(
+	"validation_absolute_number_duplicate",
+	"must not use an absolute number more than once",
+)
+
+func uniqueAbsoluteNumbers(value any) error {
+	episodes, _ := value.([]*EpisodePutRequest)
+	used := make(map[int]bool, len(episodes))
+	for _, e := range episodes {
+		if e == nil || !e.AbsoluteNumber.Valid {
+			continue
+		}
+		if used[e.AbsoluteNumber.Int] {
+			return ErrDuplicateAbsoluteNumber
+		}
+		used[e.AbsoluteNumber.Int] = true
+	}
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// EpisodesRenumberRequest
+// -----------------------------------------------------------------------------
+var ErrDuplicateEpisodeMove = validation.NewError(
+	"validation_episode_move_duplicate",
+	"must not move from or to an episode more than once",
+)
+
+// EpisodeMove moves the episode numbered SeasonNumber and EpisodeNumber to
+// ToSeasonNumber and ToEpisodeNumber. season 0 holds specials.
+// the absolute number is kept unless ToAbsoluteNumber is set.
+type EpisodeMove struct {
+	SeasonNumber     int      `json:"season_number"`
+	EpisodeNumber    int      `json:"episode_number"`
+	ToSeasonNumber   int      `json:"to_season_number"`
+	ToEpisodeNumber  int      `json:"to_episode_number"`
+	ToAbsoluteNumber null.Int `json:"to_absolute_number"`
+}
+
+var _ validation.Validatable = EpisodeMove{}
+
+func (m EpisodeMove) Validate() error {
+	return validation.ValidateStruct(
+		&m,
+		validation.Field(
+			&m.SeasonNumber,
+			validation.Min(0),
+			validation.Max(config.Config.Validation.Film.SeasonNumber.MaxValue),
+		),
+		validation.Field(
+			&m.EpisodeNumber,
+			validation.Required,
+			validation.Min(1),
+			validation.Max(config.Config.Validation.Film.EpisodeNumber.MaxValue),
+		),
+		validation.Field(
+			&m.ToSeasonNumber,
+			validation.Min(0),
+			validation.Max(config.Config.Validation.Film.SeasonNumber.MaxValue),
+		),
+		validation.Field(
+			&m.ToEpisodeNumber,
+			validation.Required,
+			validation.Min(1),
+			validation.Max(config.Config.Validation.Film.EpisodeNumber.MaxValue),
+		),
+		validation.Field(
+			&m.ToAbsoluteNumber,
+			validation.When(
+				m.ToAbsoluteNumber.Valid,
+				validation.Required,
+				validation.Min(1),
+				validation.Max(
+					config.Config.Validation.Film.AbsoluteNumber.MaxValue,
+				),
+			),
+		),
+	)
+}
+
+type EpisodesRenumberRequest struct {
+	Moves []*EpisodeMove `json:"moves"`
+}
+
+var _ validation.Validatable = EpisodesRenumberRequest{}
+
+func (r EpisodesRenumberRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Moves,
+			validation.Required,
+			validation.Length(
+				1,
+				config.Config.Validation.Request.Array.MaxLength,
+			),
+			validation.By(uniqueEpisodeMoves),
 		),
 	)
 }
 
+func uniqueEpisodeMoves(value any) error {
+	moves, _ := value.([]*EpisodeMove)
+	type episode struct{ season, number int }
+	from := make(map[episode]bool, len(moves))
+	to := make(map[episode]bool, len(moves))
+	toAbsolute := make(map[int]bool, len(moves))
+	for _, m := range moves {
+		if m == nil {
+			continue
+		}
+		f := episode{m.SeasonNumber, m.EpisodeNumber}
+		t := episode{m.ToSeasonNumber, m.ToEpisodeNumber}
+		if from[f] || to[t] ||
+			(m.ToAbsoluteNumber.Valid && toAbsolute[m.ToAbsoluteNumber.Int]) {
+			return ErrDuplicateEpisodeMove
+		}
+		from[f] = true
+		to[t] = true
+		if m.ToAbsoluteNumber.Valid {
+			toAbsolute[m.ToAbsoluteNumber.Int] = true
+		}
+	}
+	return nil
+}
+
 // -----------------------------------------------------------------------------
 // InvalidationRequest
 // -----------------------------------------------------------------------------
@@ -683,3 +906,1044 @@ func (r InvalidationRequest) Validate() error {
 		),
 	)
 }
+
+// -----------------------------------------------------------------------------
+// ExternalIDPutRequest
+// -----------------------------------------------------------------------------
+const (
+	ExternalIDProviderIMDb     = "imdb"
+	ExternalIDProviderTMDb     = "tmdb"
+	ExternalIDProviderWikidata = "wikidata"
+)
+
+// external id format of each supported provider
+var externalIDFormats = map[string]*regexp.Regexp{
+	ExternalIDProviderIMDb:     regexp.MustCompile(`^tt[0-9]{7,8}$`),
+	ExternalIDProviderTMDb:     regexp.MustCompile(`^[1-9][0-9]{0,9}$`),
+	ExternalIDProviderWikidata: regexp.MustCompile(`^Q[1-9][0-9]{0,9}$`),
+}
+
+type ExternalIDPutRequest struct {
+	Provider   string `json:"provider"`
+	ExternalID string `json:"external_id"`
+}
+
+var _ validation.Validatable = ExternalIDPutRequest{}
+
+func (r ExternalIDPutRequest) Validate() error {
+	format, isKnownProvider := externalIDFormats[r.Provider]
+
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Provider,
+			validation.Required,
+			validation.In(
+				ExternalIDProviderIMDb,
+				ExternalIDProviderTMDb,
+				ExternalIDProviderWikidata,
+			),
+		),
+		validation.Field(
+			&r.ExternalID,
+			validation.Required,
+			validation.When(isKnownProvider, validation.Match(format)),
+		),
+	)
+}
+
+// -----------------------------------------------------------------------------
+// TranslationPutRequest
+// -----------------------------------------------------------------------------
+type TranslationPutRequest struct {
+	Language     string      `json:"language"`
+	Title        string      `json:"title"`
+	Descriptions null.String `json:"descriptions"`
+}
+
+var _ validation.Validatable = TranslationPutRequest{}
+
+func (r TranslationPutRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Language,
+			validation.Required,
+			validation.Match(locale.Pattern),
+		),
+		validation.Field(
+			&r.Title,
+			validation.Required,
+			validation.Length(
+				config.Config.Validation.Translation.Title.MinLength,
+				config.Config.Validation.Translation.Title.MaxLength,
+			),
+		),
+		validation.Field(
+			&r.Descriptions,
+			validation.When(
+				r.Descriptions.Valid,
+				validation.Required,
+				validation.Length(
+					config.Config.Validation.Translation.Descriptions.MinLength,
+					config.Config.Validation.Translation.Descriptions.MaxLength,
+				),
+			),
+		),
+	)
+}
+
+// -----------------------------------------------------------------------------
+// ReleasePutRequest
+// -----------------------------------------------------------------------------
+const (
+	ReleaseTypeTheatrical = "theatrical"
+	ReleaseTypeStreaming  = "streaming"
+	ReleaseTypePhysical   = "physical"
+)
+
+// RegionPattern matches ISO 3166-1 alpha-2 region codes
+var RegionPattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+type ReleasePutRequest struct {
+	Region       string    `json:"region"`
+	ReleaseType  string    `json:"release_type"`
+	DateReleased time.Time `json:"date_released"`
+}
+
+var _ validation.Validatable = ReleasePutRequest{}
+
+func (r ReleasePutRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Region,
+			validation.Required,
+			validation.Match(RegionPattern),
+		),
+		validation.Field(
+			&r.ReleaseType,
+			validation.Required,
+			validation.In(
+				ReleaseTypeTheatrical,
+				ReleaseTypeStreaming,
+				ReleaseTypePhysical,
+			),
+		),
+		validation.Field(
+			&r.DateReleased,
+			validation.Required,
+			validation.Min(
+				time.Date(
+					config.Config.Validation.Film.DateReleased.MinValue.Year,
+					time.Month(
+						config.Config.Validation.Film.DateReleased.MinValue.Month,
+					),
+					config.Config.Validation.Film.DateReleased.MinValue.Day,
+					0, 0, 0, 0, time.UTC,
+				),
+			),
+		),
+	)
+}
+
+// -----------------------------------------------------------------------------
+// ContentRatingPutRequest
+// -----------------------------------------------------------------------------
+type ContentRatingPutRequest struct {
+	Region string `json:"region"`
+	Rating string `json:"rating"`
+}
+
+var _ validation.Validatable = ContentRatingPutRequest{}
+
+func (r ContentRatingPutRequest) Validate() error {
+	regions := rating.Regions()
+	regionValues := make([]any, len(regions))
+	for i, region := range regions {
+		regionValues[i] = region
+	}
+	system, isKnownRegion := rating.Lookup(r.Region)
+	ratings := make([]any, len(system.Ratings))
+	for i, name := range system.RatingNames() {
+		ratings[i] = name
+	}
+
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Region,
+			validation.Required,
+			validation.In(regionValues...),
+		),
+		validation.Field(
+			&r.Rating,
+			validation.Required,
+			validation.When(isKnownRegion, validation.In(ratings...)),
+		),
+	)
+}
+
+// -----------------------------------------------------------------------------
+// CollectionCreateRequest
+// -----------------------------------------------------------------------------
+type CollectionCreateRequest struct {
+	Title        string      `json:"title"`
+	Descriptions null.String `json:"descriptions"`
+}
+
+var _ validation.Validatable = CollectionCreateRequest{}
+
+func (r CollectionCreateRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Title,
+			validation.Required,
+			validation.Length(
+				config.Config.Validation.Collection.Title.MinLength,
+				config.Config.Validation.Collection.Title.MaxLength,
+			),
+		),
+		validation.Field(
+			&r.Descriptions,
+			validation.When(
+				r.Descriptions.Valid,
+				validation.Required,
+				validation.Length(
+					config.Config.Validation.Collection.Descriptions.MinLength,
+					config.Config.Validation.Collection.Descriptions.MaxLength,
+				),
+			),
+		),
+	)
+}
+
+// -----------------------------------------------------------------------------
+// CollectionUpdateRequest
+// -----------------------------------------------------------------------------
+type CollectionUpdateRequest struct {
+	Title        null.String `json:"title"`
+	Descriptions null.String `json:"descriptions"`
+}
+
+var _ validation.Validatable = CollectionUpdateRequest{}
+
+func (r CollectionUpdateRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Title,
+			validation.When(
+				r.Title.Valid,
+				validation.Required,
+				validation.Length(
+					config.Config.Validation.Collection.Title.MinLength,
+					config.Config.Validation.Collection.Title.MaxLength,
+				),
+			),
+		),
+		validation.Field(
+			&r.Descriptions,
+			validation.When(
+				r.Descriptions.Valid,
+				validation.Required,
+				validation.Length(
+					config.Config.Validation.Collection.Descriptions.MinLength,
+					config.Config.Validation.Collection.Descriptions.MaxLength,
+				),
+			),
+		),
+	)
+}
+
+// -----------------------------------------------------------------------------
+// CollectionItemsPutRequest
+// -----------------------------------------------------------------------------
+var ErrDuplicateCollectionItem = validation.NewError(
+	"validation_collection_item_duplicate",
+	"must not contain a movie or series more than once",
+)
+
+// CollectionItem refers either to a movie by FilmID or to a series by
+// SeriesID.
+type CollectionItem struct {
+	FilmID   null.Int `json:"film_id"`
+	SeriesID null.Int `json:"series_id"`
+}
+
+var _ validation.Validatable = CollectionItem{}
+
+func (i CollectionItem) Validate() error {
+	return validation.ValidateStruct(
+		&i,
+		validation.Field(
+			&i.FilmID,
+			validation.When(
+				!i.SeriesID.Valid,
+				validation.Required,
+				validation.Min(1),
+			).Else(validation.Empty),
+		),
+		validation.Field(
+			&i.SeriesID,
+			validation.When(
+				i.SeriesID.Valid,
+				validation.Required,
+				validation.Min(1),
+			),
+		),
+	)
+}
+
+// CollectionItemsPutRequest replaces the membership of a collection; items
+// are positioned in the order given.
+type CollectionItemsPutRequest struct {
+	Items []*CollectionItem `json:"items"`
+}
+
+var _ validation.Validatable = CollectionItemsPutRequest{}
+
+func (r CollectionItemsPutRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Items,
+			validation.Length(
+				0,
+				config.Config.Validation.Request.Array.MaxLength,
+			),
+			validation.By(uniqueCollectionItems),
+		),
+	)
+}
+
+func uniqueCollectionItems(value any) error {
+	items, _ := value.([]*CollectionItem)
+	films := make(map[int]bool, len(items))
+	serieses := make(map[int]bool, len(items))
+	for _, i := range items {
+		if i == nil {
+			continue
+		}
+		if i.FilmID.Valid {
+			if films[i.FilmID.Int] {
+				return ErrDuplicateCollectionItem
+			}
+			films[i.FilmID.Int] = true
+		}
+		if i.SeriesID.Valid {
+			if serieses[i.SeriesID.Int] {
+				return ErrDuplicateCollectionItem
+			}
+			serieses[i.SeriesID.Int] = true
+		}
+	}
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// MediaTrailerAddRequest
+// -----------------------------------------------------------------------------
+const (
+	MediaKindPoster   = "poster"
+	MediaKindBackdrop = "backdrop"
+	MediaKindStill    = "still"
+	MediaKindTrailer  = "trailer"
+)
+
+// MediaImageKinds are the kinds of uploaded media
+var MediaImageKinds = []any{MediaKindPoster, MediaKindBackdrop, MediaKindStill}
+
+type MediaTrailerAddRequest struct {
+	URL     string `json:"url"`
+	Primary bool   `json:"primary"`
+}
+
+var _ validation.Validatable = MediaTrailerAddRequest{}
+
+func (r MediaTrailerAddRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.URL,
+			validation.Required,
+			validation.Length(
+				1,
+				config.Config.Validation.Media.TrailerURL.MaxLength,
+			),
+			is.URL,
+		),
+	)
+}
+
+// -----------------------------------------------------------------------------
+// MediaReorderRequest
+// -----------------------------------------------------------------------------
+var ErrDuplicateMediaOrder = validation.NewError(
+	"validation_media_order_duplicate",
+	"must not order a media more than once",
+)
+
+// MediaOrder positions the media of ID by its index in the order: Primary
+// makes it the primary media of its kind
+type MediaOrder struct {
+	ID      int  `json:"id"`
+	Primary bool `json:"primary"`
+}
+
+var _ validation.Validatable = MediaOrder{}
+
+func (o MediaOrder) Validate() error {
+	return validation.ValidateStruct(
+		&o,
+		validation.Field(&o.ID, validation.Required, validation.Min(1)),
+	)
+}
+
+// MediaReorderRequest orders all the media of a record
+type MediaReorderRequest struct {
+	Media []*MediaOrder `json:"media"`
+}
+
+var _ validation.Validatable = MediaReorderRequest{}
+
+func (r MediaReorderRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Media,
+			validation.Required,
+			validation.Length(
+				1,
+				config.Config.Validation.Request.Array.MaxLength,
+			),
+			validation.By(uniqueMediaOrders),
+		),
+	)
+}
+
+func uniqueMediaOrders(value any) error {
+	orders, _ := value.([]*MediaOrder)
+	used := make(map[int]bool, len(orders))
+	for _, o := range orders {
+		if o == nil {
+			continue
+		}
+		if used[o.ID] {
+			return ErrDuplicateMediaOrder
+		}
+		used[o.ID] = true
+	}
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// WatchEventCreateRequest
+// -----------------------------------------------------------------------------
+// WatchEventCreateRequest logs a watch: a null TimeWatched logs it now
+type WatchEventCreateRequest struct {
+	TimeWatched null.Time   `json:"time_watched"`
+	Rating      null.Int    `json:"rating"`
+	Device      null.String `json:"device"`
+}
+
+var _ validation.Validatable = WatchEventCreateRequest{}
+
+func (r WatchEventCreateRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.TimeWatched,
+			validation.When(
+				r.TimeWatched.Valid,
+				validation.Required,
+				validation.Max(time.Now()),
+			),
+		),
+		validation.Field(
+			&r.Rating,
+			validation.When(
+				r.Rating.Valid,
+				validation.Min(
+					config.Config.Validation.WatchEvent.Rating.MinValue,
+				),
+				validation.Max(
+					config.Config.Validation.WatchEvent.Rating.MaxValue,
+				),
+			),
+		),
+		validation.Field(
+			&r.Device,
+			validation.When(
+				r.Device.Valid,
+				validation.Required,
+				validation.Length(
+					1,
+					config.Config.Validation.WatchEvent.Device.MaxLength,
+				),
+			),
+		),
+	)
+}
+
+// -----------------------------------------------------------------------------
+// PlaybackProgressPutRequest
+// -----------------------------------------------------------------------------
+// PlaybackProgressPutRequest puts the position in seconds: a null UpdatedAt
+// puts it now
+type PlaybackProgressPutRequest struct {
+	Position  int         `json:"position"`
+	Device    null.String `json:"device"`
+	UpdatedAt null.Time   `json:"updated_at"`
+}
+
+var _ validation.Validatable = PlaybackProgressPutRequest{}
+
+func (r PlaybackProgressPutRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(&r.Position, validation.Min(0)),
+		validation.Field(
+			&r.Device,
+			validation.When(
+				r.Device.Valid,
+				validation.Required,
+				validation.Length(
+					1,
+					config.Config.Validation.PlaybackProgress.Device.MaxLength,
+				),
+			),
+		),
+		validation.Field(
+			&r.UpdatedAt,
+			validation.When(
+				r.UpdatedAt.Valid,
+				validation.Required,
+				validation.Max(time.Now()),
+			),
+		),
+	)
+}
+
+// -----------------------------------------------------------------------------
+// ScorePutRequest
+// -----------------------------------------------------------------------------
+type ScorePutRequest struct {
+	Score null.Int `json:"score"`
+}
+
+var _ validation.Validatable = ScorePutRequest{}
+
+func (r ScorePutRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Score,
+			validation.NotNil,
+			validation.Min(config.Config.Validation.Score.MinValue),
+			validation.Max(config.Config.Validation.Score.MaxValue),
+		),
+	)
+}
+
+// -----------------------------------------------------------------------------
+// ReviewPutRequest
+// -----------------------------------------------------------------------------
+type ReviewPutRequest struct {
+	// Body is markdown
+	Body    string `json:"body"`
+	Spoiler bool   `json:"spoiler"`
+}
+
+var _ validation.Validatable = ReviewPutRequest{}
+
+func (r ReviewPutRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Body,
+			validation.Required,
+			validation.Length(
+				config.Config.Validation.Review.Body.MinLength,
+				config.Config.Validation.Review.Body.MaxLength,
+			),
+		),
+	)
+}
+
+// -----------------------------------------------------------------------------
+// ReviewStatusRequest
+// -----------------------------------------------------------------------------
+type ReviewStatusRequest struct {
+	Status string `json:"status"`
+}
+
+var _ validation.Validatable = ReviewStatusRequest{}
+
+func (r ReviewStatusRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Status,
+			validation.Required,
+			validation.In(review.StatusPublished, review.StatusHidden),
+		),
+	)
+}
+
+// -----------------------------------------------------------------------------
+// ListCreateRequest
+// -----------------------------------------------------------------------------
+// ListCreateRequest creates a list: an empty Visibility creates a private list
+type ListCreateRequest struct {
+	Title        string      `json:"title"`
+	Descriptions null.String `json:"descriptions"`
+	Visibility   string      `json:"visibility"`
+}
+
+var _ validation.Validatable = ListCreateRequest{}
+
+func (r ListCreateRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Title,
+			validation.Required,
+			validation.Length(
+				config.Config.Validation.List.Title.MinLength,
+				config.Config.Validation.List.Title.MaxLength,
+			),
+		),
+		validation.Field(
+			&r.Descriptions,
+			validation.When(
+				r.Descriptions.Valid,
+				validation.Required,
+				validation.Length(
+					config.Config.Validation.List.Descriptions.MinLength,
+					config.Config.Validation.List.Descriptions.MaxLength,
+				),
+			),
+		),
+		validation.Field(
+			&r.Visibility,
+			validation.In(list.VisibilityPrivate, list.VisibilityPublic),
+		),
+	)
+}
+
+// -----------------------------------------------------------------------------
+// ListUpdateRequest
+// -----------------------------------------------------------------------------
+type ListUpdateRequest struct {
+	Title        null.String `json:"title"`
+	Descriptions null.String `json:"descriptions"`
+	Visibility   null.String `json:"visibility"`
+}
+
+var _ validation.Validatable = ListUpdateRequest{}
+
+func (r ListUpdateRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Title,
+			validation.When(
+				r.Title.Valid,
+				validation.Required,
+				validation.Length(
+					config.Config.Validation.List.Title.MinLength,
+					config.Config.Validation.List.Title.MaxLength,
+				),
+			),
+		),
+		validation.Field(
+			&r.Descriptions,
+			validation.When(
+				r.Descriptions.Valid,
+				validation.Required,
+				validation.Length(
+					config.Config.Validation.List.Descriptions.MinLength,
+					config.Config.Validation.List.Descriptions.MaxLength,
+				),
+			),
+		),
+		validation.Field(
+			&r.Visibility,
+			validation.When(
+				r.Visibility.Valid,
+				validation.Required,
+				validation.In(list.VisibilityPrivate, list.VisibilityPublic),
+			),
+		),
+	)
+}
+
+// -----------------------------------------------------------------------------
+// ListItemAddRequest
+// -----------------------------------------------------------------------------
+// ListItemAddRequest refers either to a film (movie or episode) by FilmID or
+// to a series by SeriesID
+type ListItemAddRequest struct {
+	FilmID   null.Int `json:"film_id"`
+	SeriesID null.Int `json:"series_id"`
+}
+
+var _ validation.Validatable = ListItemAddRequest{}
+
+func (r ListItemAddRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.FilmID,
+			validation.When(
+				!r.SeriesID.Valid,
+				validation.Required,
+				validation.Min(1),
+			).Else(validation.Empty),
+		),
+		validation.Field(
+			&r.SeriesID,
+			validation.When(
+				r.SeriesID.Valid,
+				validation.Required,
+				validation.Min(1),
+			),
+		),
+	)
+}
+
+// -----------------------------------------------------------------------------
+// ListReorderRequest
+// -----------------------------------------------------------------------------
+var ErrDuplicateListOrder = validation.NewError(
+	"validation_list_order_duplicate",
+	"must not order an item more than once",
+)
+
+// ListReorderRequest orders all the items of a list by their ids
+type ListReorderRequest struct {
+	ItemIDs []int `json:"item_ids"`
+}
+
+var _ validation.Validatable = ListReorderRequest{}
+
+func (r ListReorderRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.ItemIDs,
+			validation.Required,
+			validation.Length(
+				1,
+				config.Config.Validation.Request.Array.MaxLength,
+			),
+			validation.Each(validation.Min(1)),
+			validation.By(uniqueListOrder),
+		),
+	)
+}
+
+func uniqueListOrder(value any) error {
+	itemIDs, _ := value.([]int)
+	used := make(map[int]bool, len(itemIDs))
+	for _, id := range itemIDs {
+		if used[id] {
+			return ErrDuplicateListOrder
+		}
+		used[id] = true
+	}
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// ListMemberUpdateRequest
+// -----------------------------------------------------------------------------
+// ListMemberUpdateRequest sets the role of a list member: the list has one
+// owner so members are editors or viewers
+type ListMemberUpdateRequest struct {
+	Role string `json:"role"`
+}
+
+var _ validation.Validatable = ListMemberUpdateRequest{}
+
+func (r ListMemberUpdateRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Role,
+			validation.Required,
+			validation.In(list.RoleEditor, list.RoleViewer),
+		),
+	)
+}
+
+// -----------------------------------------------------------------------------
+// ListInviteCreateRequest
+// -----------------------------------------------------------------------------
+// ListInviteCreateRequest invites the user of Email to join a list as Role
+type ListInviteCreateRequest struct {
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+var _ validation.Validatable = ListInviteCreateRequest{}
+
+func (r ListInviteCreateRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Email,
+			emailValidationRules...,
+		),
+		validation.Field(
+			&r.Role,
+			validation.Required,
+			validation.In(list.RoleEditor, list.RoleViewer),
+		),
+	)
+}
+
+// -----------------------------------------------------------------------------
+// ListInviteLinkCreateRequest
+// -----------------------------------------------------------------------------
+// ListInviteLinkCreateRequest creates a link invite anyone holding its token
+// can accept to join a list as Role
+type ListInviteLinkCreateRequest struct {
+	Role string `json:"role"`
+}
+
+var _ validation.Validatable = ListInviteLinkCreateRequest{}
+
+func (r ListInviteLinkCreateRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Role,
+			validation.Required,
+			validation.In(list.RoleEditor, list.RoleViewer),
+		),
+	)
+}
+
+// -----------------------------------------------------------------------------
+// ListInviteAcceptRequest
+// -----------------------------------------------------------------------------
+// ListInviteAcceptRequest accepts an invite: Token is required by the link
+// invites only
+type ListInviteAcceptRequest struct {
+	Token null.String `json:"token"`
+}
+
+var _ validation.Validatable = ListInviteAcceptRequest{}
+
+func (r ListInviteAcceptRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Token,
+			validation.When(r.Token.Valid, validation.Required),
+		),
+	)
+}
+
+// -----------------------------------------------------------------------------
+// WatchlistItemUpdateRequest
+// -----------------------------------------------------------------------------
+// WatchlistItemUpdateRequest sets the private details of a watchlist item: an
+// empty Note clears the note and Tags replace the tags unless nil
+type WatchlistItemUpdateRequest struct {
+	Priority null.Int    `json:"priority"`
+	Note     null.String `json:"note"`
+	Tags     []string    `json:"tags"`
+}
+
+var _ validation.Validatable = WatchlistItemUpdateRequest{}
+
+func (r WatchlistItemUpdateRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Priority,
+			validation.When(
+				r.Priority.Valid,
+				validation.Min(0),
+				validation.Max(
+					config.Config.Validation.WatchlistItem.Priority.MaxValue,
+				),
+			),
+		),
+		validation.Field(
+			&r.Note,
+			validation.When(
+				r.Note.Valid,
+				validation.Length(
+					0,
+					config.Config.Validation.WatchlistItem.Note.MaxLength,
+				),
+			),
+		),
+		validation.Field(
+			&r.Tags,
+			validation.Length(
+				0,
+				config.Config.Validation.WatchlistItem.Tags.MaxCount,
+			),
+			validation.Each(
+				validation.Required,
+				validation.Length(
+					1,
+					config.Config.Validation.WatchlistItem.Tag.MaxLength,
+				),
+			),
+		),
+	)
+}
+
+// -----------------------------------------------------------------------------
+// WatchlistMoveRequest
+// -----------------------------------------------------------------------------
+// WatchlistMoveRequest moves a watchlist item right after the item of AfterID
+// or to the top of the watchlist if AfterID is null
+type WatchlistMoveRequest struct {
+	AfterID null.Int `json:"after_id"`
+}
+
+var _ validation.Validatable = WatchlistMoveRequest{}
+
+func (r WatchlistMoveRequest) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.AfterID,
+			validation.When(r.AfterID.Valid, validation.Min(1)),
+		),
+	)
+}
+
+// -----------------------------------------------------------------------------
+// ImportRow
+// -----------------------------------------------------------------------------
+const (
+	ImportRowKindMovie   = "movie"
+	ImportRowKindSeries  = "series"
+	ImportRowKindEpisode = "episode"
+)
+
+// ImportRow is a row of a catalog import file.
+// An episode row refers either to an existing series by SeriesID or to a
+// preceding series row of the same file by its 1-based row number SeriesRow.
+type ImportRow struct {
+	Kind           string      `json:"kind"`
+	Title          string      `json:"title"`
+	Descriptions   null.String `json:"descriptions"`
+	DateReleased   time.Time   `json:"date_released"`
+	Duration       null.Int    `json:"duration"`
+	DateStarted    time.Time   `json:"date_started"`
+	DateEnded      null.Time   `json:"date_ended"`
+	SeriesID       null.Int    `json:"series_id"`
+	SeriesRow      null.Int    `json:"series_row"`
+	SeasonNumber   int         `json:"season_number"`
+	EpisodeNumber  int         `json:"episode_number"`
+	AbsoluteNumber null.Int    `json:"absolute_number"`
+	PartNumber     null.Int    `json:"part_number"`
+}
+
+var _ validation.Validatable = ImportRow{}
+
+func (r ImportRow) Validate() error {
+	err := validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Kind,
+			validation.Required,
+			validation.In(
+				ImportRowKindMovie,
+				ImportRowKindSeries,
+				ImportRowKindEpisode,
+			),
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	switch r.Kind {
+	case ImportRowKindMovie:
+		return MovieCreateRequest{
+			Title:        r.Title,
+			Descriptions: r.Descriptions,
+			DateReleased: r.DateReleased,
+			Duration:     r.Duration,
+		}.Validate()
+
+	case ImportRowKindSeries:
+		return SeriesCreateRequest{
+			Title:        r.Title,
+			Descriptions: r.Descriptions,
+			DateStarted:  r.DateStarted,
+			DateEnded:    r.DateEnded,
+		}.Validate()
+	}
+
+	// validate episode
+	errs := validation.Errors{}
+	err = EpisodePutRequest{
+		Title:          r.Title,
+		Descriptions:   r.Descriptions,
+		DateReleased:   r.DateReleased,
+		Duration:       r.Duration,
+		AbsoluteNumber: r.AbsoluteNumber,
+		PartNumber:     r.PartNumber,
+	}.Validate()
+	if err != nil {
+		fieldErrs, ok := err.(validation.Errors)
+		if !ok {
+			return err
+		}
+		errs = fieldErrs
+	}
+	err = validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.SeriesID,
+			validation.When(
+				!r.SeriesRow.Valid,
+				validation.Required,
+				validation.Min(1),
+			).Else(validation.Empty),
+		),
+		validation.Field(
+			&r.SeriesRow,
+			validation.When(
+				r.SeriesRow.Valid,
+				validation.Required,
+				validation.Min(1),
+			),
+		),
+		validation.Field(
+			&r.SeasonNumber,
+			validation.Min(0),
+			validation.Max(config.Config.Validation.Film.SeasonNumber.MaxValue),
+		),
+		validation.Field(
+			&r.EpisodeNumber,
+			validation.Required,
+			validation.Min(1),
+			validation.Max(
+				config.Config.Validation.Film.EpisodeNumber.MaxValue,
+			),
+		),
+	)
+	if err != nil {
+		fieldErrs, ok := err.(validation.Errors)
+		if !ok {
+			return err
+		}
+		for field, fieldErr := range fieldErrs {
+			errs[field] = fieldErr
+		}
+	}
+	return errs.Filter()
+}
@@ -1,11 +1,14 @@
 package dto_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/aria3ppp/watchlist-server/internal/config"
 	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/list"
+	"github.com/aria3ppp/watchlist-server/internal/review"
 	"github.com/aria3ppp/watchlist-server/internal/testutils"
 	"github.com/aria3ppp/watchlist-server/internal/validator"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
@@ -401,6 +404,22 @@ func TestUserUpdateRequest_Validate(t *testing.T) {
 				),
 			},
 		},
+		{
+			name: "tc4",
+			req: dto.UserUpdateRequest{
+				Locale: null.StringFrom("pt-BR"),
+			},
+			expError: nil,
+		},
+		{
+			name: "tc5",
+			req: dto.UserUpdateRequest{
+				Locale: null.StringFrom("pt_BR"),
+			},
+			expError: validation.Errors{
+				"locale": validation.ErrMatchInvalid,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1340,6 +1359,192 @@ func TestEpisodesPutAllBySeasonRequest_Validate(t *testing.T) {
 	}
 }
 
+func TestEpisodesPutAllBySeasonRequest_ValidateNumbering(t *testing.T) {
+	testCases := []struct {
+		name     string
+		req      dto.EpisodesPutAllBySeasonRequest
+		expError error
+	}{
+		{
+			name: "valid numbers",
+			req: dto.EpisodesPutAllBySeasonRequest{
+				Episodes: []*dto.EpisodePutRequest{
+					{
+						Title:          "part one",
+						DateReleased:   testutils.Date(2000, 1, 1),
+						AbsoluteNumber: null.IntFrom(11),
+						PartNumber:     null.IntFrom(1),
+					},
+					{
+						Title:          "part two",
+						DateReleased:   testutils.Date(2000, 1, 1),
+						AbsoluteNumber: null.IntFrom(12),
+						PartNumber:     null.IntFrom(2),
+					},
+				},
+			},
+			expError: nil,
+		},
+		{
+			name: "out of range numbers",
+			req: dto.EpisodesPutAllBySeasonRequest{
+				Episodes: []*dto.EpisodePutRequest{
+					{
+						Title:          "episode",
+						DateReleased:   testutils.Date(2000, 1, 1),
+						AbsoluteNumber: null.IntFrom(0),
+						PartNumber: null.IntFrom(
+							config.Config.Validation.Film.PartNumber.MaxValue + 1,
+						),
+					},
+				},
+			},
+			expError: validation.Errors{
+				"episodes": validation.Errors{
+					"0": validation.Errors{
+						"absolute_number": validation.ErrRequired,
+						"part_number": validation.ErrMaxLessEqualThanRequired.SetParams(
+							map[string]any{
+								"threshold": config.Config.Validation.Film.PartNumber.MaxValue,
+							},
+						),
+					},
+				},
+			},
+		},
+		{
+			name: "duplicate absolute numbers",
+			req: dto.EpisodesPutAllBySeasonRequest{
+				Episodes: []*dto.EpisodePutRequest{
+					{
+						Title:          "episode",
+						DateReleased:   testutils.Date(2000, 1, 1),
+						AbsoluteNumber: null.IntFrom(1),
+					},
+					{
+						Title:          "episode",
+						DateReleased:   testutils.Date(2000, 1, 1),
+						AbsoluteNumber: null.IntFrom(1),
+					},
+				},
+			},
+			expError: validation.Errors{
+				"episodes": dto.ErrDuplicateAbsoluteNumber,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.req.Validate())
+		})
+	}
+}
+
+func TestEpisodesRenumberRequest_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		req      dto.EpisodesRenumberRequest
+		expError error
+	}{
+		{
+			name: "no moves",
+			req:  dto.EpisodesRenumberRequest{},
+			expError: validation.Errors{
+				"moves": validation.ErrRequired,
+			},
+		},
+		{
+			name: "swap and move to specials",
+			req: dto.EpisodesRenumberRequest{
+				Moves: []*dto.EpisodeMove{
+					{
+						SeasonNumber:    1,
+						EpisodeNumber:   1,
+						ToSeasonNumber:  1,
+						ToEpisodeNumber: 2,
+					},
+					{
+						SeasonNumber:    1,
+						EpisodeNumber:   2,
+						ToSeasonNumber:  1,
+						ToEpisodeNumber: 1,
+					},
+					{
+						SeasonNumber:     1,
+						EpisodeNumber:    3,
+						ToSeasonNumber:   0,
+						ToEpisodeNumber:  1,
+						ToAbsoluteNumber: null.IntFrom(3),
+					},
+				},
+			},
+			expError: nil,
+		},
+		{
+			name: "invalid move",
+			req: dto.EpisodesRenumberRequest{
+				Moves: []*dto.EpisodeMove{
+					{
+						SeasonNumber:     -1,
+						ToSeasonNumber:   config.Config.Validation.Film.SeasonNumber.MaxValue + 1,
+						ToEpisodeNumber:  1,
+						ToAbsoluteNumber: null.IntFrom(-1),
+					},
+				},
+			},
+			expError: validation.Errors{
+				"moves": validation.Errors{
+					"0": validation.Errors{
+						"season_number": validation.ErrMinGreaterEqualThanRequired.SetParams(
+							map[string]any{"threshold": 0},
+						),
+						"episode_number": validation.ErrRequired,
+						"to_season_number": validation.ErrMaxLessEqualThanRequired.SetParams(
+							map[string]any{
+								"threshold": config.Config.Validation.Film.SeasonNumber.MaxValue,
+							},
+						),
+						"to_absolute_number": validation.ErrMinGreaterEqualThanRequired.SetParams(
+							map[string]any{"threshold": 1},
+						),
+					},
+				},
+			},
+		},
+		{
+			name: "duplicate target",
+			req: dto.EpisodesRenumberRequest{
+				Moves: []*dto.EpisodeMove{
+					{
+						SeasonNumber:    1,
+						EpisodeNumber:   1,
+						ToSeasonNumber:  2,
+						ToEpisodeNumber: 1,
+					},
+					{
+						SeasonNumber:    1,
+						EpisodeNumber:   2,
+						ToSeasonNumber:  2,
+						ToEpisodeNumber: 1,
+					},
+				},
+			},
+			expError: validation.Errors{
+				"moves": dto.ErrDuplicateEpisodeMove,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.req.Validate())
+		})
+	}
+}
+
 func TestInvalidationRequest_Validate(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -1399,3 +1604,1022 @@ func TestInvalidationRequest_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestTranslationPutRequest_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		req      dto.TranslationPutRequest
+		expError error
+	}{
+		{
+			name: "tc1",
+			req:  dto.TranslationPutRequest{},
+			expError: validation.Errors{
+				"language": validation.ErrRequired,
+				"title":    validation.ErrRequired,
+			},
+		},
+		{
+			name: "tc2",
+			req: dto.TranslationPutRequest{
+				Language:     "fa",
+				Title:        "title",
+				Descriptions: null.StringFrom("descriptions"),
+			},
+			expError: nil,
+		},
+		{
+			name: "tc3",
+			req: dto.TranslationPutRequest{
+				Language: "zh-Hant-TW",
+				Title:    "title",
+			},
+			expError: nil,
+		},
+		{
+			name: "tc4",
+			req: dto.TranslationPutRequest{
+				Language: "english",
+				Title: testutils.GenerateStringLongerThanMaxLength(
+					config.Config.Validation.Translation.Title.MaxLength,
+				),
+				Descriptions: null.StringFrom(""),
+			},
+			expError: validation.Errors{
+				"language": validation.ErrMatchInvalid,
+				"title": validation.ErrLengthOutOfRange.SetParams(
+					map[string]any{
+						"min": config.Config.Validation.Translation.Title.MinLength,
+						"max": config.Config.Validation.Translation.Title.MaxLength,
+					},
+				),
+				"descriptions": validation.ErrRequired,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.req.Validate())
+		})
+	}
+}
+
+func TestExternalIDPutRequest_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		req      dto.ExternalIDPutRequest
+		expError error
+	}{
+		{
+			name: "tc1",
+			req:  dto.ExternalIDPutRequest{},
+			expError: validation.Errors{
+				"provider":    validation.ErrRequired,
+				"external_id": validation.ErrRequired,
+			},
+		},
+		{
+			name: "tc2",
+			req: dto.ExternalIDPutRequest{
+				Provider:   dto.ExternalIDProviderIMDb,
+				ExternalID: "tt0111161",
+			},
+			expError: nil,
+		},
+		{
+			name: "tc3",
+			req: dto.ExternalIDPutRequest{
+				Provider:   dto.ExternalIDProviderTMDb,
+				ExternalID: "278",
+			},
+			expError: nil,
+		},
+		{
+			name: "tc4",
+			req: dto.ExternalIDPutRequest{
+				Provider:   dto.ExternalIDProviderWikidata,
+				ExternalID: "Q172241",
+			},
+			expError: nil,
+		},
+		{
+			name: "tc5",
+			req: dto.ExternalIDPutRequest{
+				Provider:   "netflix",
+				ExternalID: "70005379",
+			},
+			expError: validation.Errors{
+				"provider": validation.ErrInInvalid,
+			},
+		},
+		{
+			name: "tc6",
+			req: dto.ExternalIDPutRequest{
+				Provider:   dto.ExternalIDProviderIMDb,
+				ExternalID: "nm0000151",
+			},
+			expError: validation.Errors{
+				"external_id": validation.ErrMatchInvalid,
+			},
+		},
+		{
+			name: "tc7",
+			req: dto.ExternalIDPutRequest{
+				Provider:   dto.ExternalIDProviderTMDb,
+				ExternalID: "tt0111161",
+			},
+			expError: validation.Errors{
+				"external_id": validation.ErrMatchInvalid,
+			},
+		},
+		{
+			name: "tc8",
+			req: dto.ExternalIDPutRequest{
+				Provider:   dto.ExternalIDProviderWikidata,
+				ExternalID: "Q0",
+			},
+			expError: validation.Errors{
+				"external_id": validation.ErrMatchInvalid,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.req.Validate())
+		})
+	}
+}
+
+func TestCollectionItemsPutRequest_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		req      dto.CollectionItemsPutRequest
+		expError error
+	}{
+		{
+			name:     "no items",
+			req:      dto.CollectionItemsPutRequest{},
+			expError: nil,
+		},
+		{
+			name: "movies and series",
+			req: dto.CollectionItemsPutRequest{
+				Items: []*dto.CollectionItem{
+					{FilmID: null.IntFrom(1)},
+					{SeriesID: null.IntFrom(1)},
+					{FilmID: null.IntFrom(2)},
+				},
+			},
+			expError: nil,
+		},
+		{
+			name: "invalid items",
+			req: dto.CollectionItemsPutRequest{
+				Items: []*dto.CollectionItem{
+					{},
+					{FilmID: null.IntFrom(1), SeriesID: null.IntFrom(1)},
+					{SeriesID: null.IntFrom(-1)},
+				},
+			},
+			expError: validation.Errors{
+				"items": validation.Errors{
+					"0": validation.Errors{
+						"film_id": validation.ErrRequired,
+					},
+					"1": validation.Errors{
+						"film_id": validation.ErrEmpty,
+					},
+					"2": validation.Errors{
+						"series_id": validation.ErrMinGreaterEqualThanRequired.SetParams(
+							map[string]any{"threshold": 1},
+						),
+					},
+				},
+			},
+		},
+		{
+			name: "duplicate item",
+			req: dto.CollectionItemsPutRequest{
+				Items: []*dto.CollectionItem{
+					{SeriesID: null.IntFrom(1)},
+					{FilmID: null.IntFrom(1)},
+					{SeriesID: null.IntFrom(1)},
+				},
+			},
+			expError: validation.Errors{
+				"items": dto.ErrDuplicateCollectionItem,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.req.Validate())
+		})
+	}
+}
+
+func TestMediaTrailerAddRequest_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		req      dto.MediaTrailerAddRequest
+		expError error
+	}{
+		{
+			name: "no url",
+			req:  dto.MediaTrailerAddRequest{},
+			expError: validation.Errors{
+				"url": validation.ErrRequired,
+			},
+		},
+		{
+			name: "invalid url",
+			req:  dto.MediaTrailerAddRequest{URL: "not a url"},
+			expError: validation.Errors{
+				"url": is.ErrURL,
+			},
+		},
+		{
+			name: "ok",
+			req: dto.MediaTrailerAddRequest{
+				URL:     "https://www.youtube.com/watch?v=trailer",
+				Primary: true,
+			},
+			expError: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.req.Validate())
+		})
+	}
+}
+
+func TestMediaReorderRequest_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		req      dto.MediaReorderRequest
+		expError error
+	}{
+		{
+			name: "no media",
+			req:  dto.MediaReorderRequest{},
+			expError: validation.Errors{
+				"media": validation.ErrRequired,
+			},
+		},
+		{
+			name: "invalid id",
+			req: dto.MediaReorderRequest{
+				Media: []*dto.MediaOrder{{ID: 1}, {ID: -1}},
+			},
+			expError: validation.Errors{
+				"media": validation.Errors{
+					"1": validation.Errors{
+						"id": validation.ErrMinGreaterEqualThanRequired.SetParams(
+							map[string]any{"threshold": 1},
+						),
+					},
+				},
+			},
+		},
+		{
+			name: "duplicate id",
+			req: dto.MediaReorderRequest{
+				Media: []*dto.MediaOrder{{ID: 1}, {ID: 2}, {ID: 1}},
+			},
+			expError: validation.Errors{
+				"media": dto.ErrDuplicateMediaOrder,
+			},
+		},
+		{
+			name: "ok",
+			req: dto.MediaReorderRequest{
+				Media: []*dto.MediaOrder{{ID: 2, Primary: true}, {ID: 1}},
+			},
+			expError: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.req.Validate())
+		})
+	}
+}
+
+func TestImportRow_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		req      dto.ImportRow
+		expError error
+	}{
+		{
+			name: "tc1",
+			req:  dto.ImportRow{},
+			expError: validation.Errors{
+				"kind": validation.ErrRequired,
+			},
+		},
+		{
+			name: "tc2",
+			req: dto.ImportRow{
+				Kind:  "documentary",
+				Title: "title",
+			},
+			expError: validation.Errors{
+				"kind": validation.ErrInInvalid,
+			},
+		},
+		{
+			name: "tc3",
+			req: dto.ImportRow{
+				Kind:         dto.ImportRowKindMovie,
+				Title:        "movie",
+				DateReleased: testutils.Date(2000, time.November, 11),
+			},
+			expError: nil,
+		},
+		{
+			name: "tc4",
+			req: dto.ImportRow{
+				Kind:        dto.ImportRowKindMovie,
+				DateStarted: testutils.Date(2000, time.November, 11),
+			},
+			expError: validation.Errors{
+				"title":         validation.ErrRequired,
+				"date_released": validation.ErrRequired,
+			},
+		},
+		{
+			name: "tc5",
+			req: dto.ImportRow{
+				Kind:        dto.ImportRowKindSeries,
+				Title:       "series",
+				DateStarted: testutils.Date(2000, time.November, 11),
+			},
+			expError: nil,
+		},
+		{
+			name: "tc6",
+			req: dto.ImportRow{
+				Kind:         dto.ImportRowKindSeries,
+				Title:        "series",
+				DateReleased: testutils.Date(2000, time.November, 11),
+			},
+			expError: validation.Errors{
+				"date_started": validation.ErrRequired,
+			},
+		},
+		{
+			name: "tc7",
+			req: dto.ImportRow{
+				Kind:          dto.ImportRowKindEpisode,
+				Title:         "episode",
+				DateReleased:  testutils.Date(2000, time.November, 11),
+				SeriesID:      null.IntFrom(1),
+				SeasonNumber:  1,
+				EpisodeNumber: 1,
+			},
+			expError: nil,
+		},
+		{
+			name: "tc8",
+			req: dto.ImportRow{
+				Kind:          dto.ImportRowKindEpisode,
+				Title:         "episode",
+				DateReleased:  testutils.Date(2000, time.November, 11),
+				SeriesRow:     null.IntFrom(1),
+				SeasonNumber:  1,
+				EpisodeNumber: 1,
+			},
+			expError: nil,
+		},
+		{
+			name: "tc9",
+			req: dto.ImportRow{
+				Kind: dto.ImportRowKindEpisode,
+			},
+			expError: validation.Errors{
+				"title":          validation.ErrRequired,
+				"date_released":  validation.ErrRequired,
+				"series_id":      validation.ErrRequired,
+				"episode_number": validation.ErrRequired,
+			},
+		},
+		{
+			name: "tc10",
+			req: dto.ImportRow{
+				Kind:         dto.ImportRowKindEpisode,
+				Title:        "episode",
+				DateReleased: testutils.Date(2000, time.November, 11),
+				SeriesID:     null.IntFrom(1),
+				SeriesRow:    null.IntFrom(1),
+				SeasonNumber: config.Config.Validation.Film.SeasonNumber.MaxValue + 1,
+				EpisodeNumber: config.Config.Validation.Film.EpisodeNumber.MaxValue +
+					1,
+			},
+			expError: validation.Errors{
+				"series_id": validation.ErrEmpty,
+				"season_number": validation.ErrMaxLessEqualThanRequired.SetParams(
+					map[string]any{
+						"threshold": config.Config.Validation.Film.SeasonNumber.MaxValue,
+					},
+				),
+				"episode_number": validation.ErrMaxLessEqualThanRequired.SetParams(
+					map[string]any{
+						"threshold": config.Config.Validation.Film.EpisodeNumber.MaxValue,
+					},
+				),
+			},
+		},
+		{
+			name: "tc11",
+			req: dto.ImportRow{
+				Kind:          dto.ImportRowKindEpisode,
+				Title:         "episode",
+				DateReleased:  testutils.Date(2000, time.November, 11),
+				SeriesRow:     null.IntFrom(0),
+				SeasonNumber:  1,
+				EpisodeNumber: 1,
+			},
+			expError: validation.Errors{
+				"series_row": validation.ErrRequired,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.req.Validate())
+		})
+	}
+}
+
+func TestReleasePutRequest_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		req      dto.ReleasePutRequest
+		expError error
+	}{
+		{
+			name: "tc1",
+			req:  dto.ReleasePutRequest{},
+			expError: validation.Errors{
+				"region":        validation.ErrRequired,
+				"release_type":  validation.ErrRequired,
+				"date_released": validation.ErrRequired,
+			},
+		},
+		{
+			name: "tc2",
+			req: dto.ReleasePutRequest{
+				Region:       "DE",
+				ReleaseType:  dto.ReleaseTypeTheatrical,
+				DateReleased: testutils.Date(2000, 1, 1),
+			},
+			expError: nil,
+		},
+		{
+			name: "tc3",
+			req: dto.ReleasePutRequest{
+				Region:      "de",
+				ReleaseType: "tv",
+				DateReleased: testutils.Date(
+					config.Config.Validation.Film.DateReleased.MinValue.Year-1,
+					1,
+					1,
+				),
+			},
+			expError: validation.Errors{
+				"region":       validation.ErrMatchInvalid,
+				"release_type": validation.ErrInInvalid,
+				"date_released": validation.ErrMinGreaterEqualThanRequired.SetParams(
+					map[string]any{
+						"threshold": time.Date(
+							config.Config.Validation.Film.DateReleased.MinValue.Year,
+							time.Month(
+								config.Config.Validation.Film.DateReleased.MinValue.Month,
+							),
+							config.Config.Validation.Film.DateReleased.MinValue.Day,
+							0, 0, 0, 0, time.UTC,
+						),
+					},
+				),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.req.Validate())
+		})
+	}
+}
+
+func TestContentRatingPutRequest_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		req      dto.ContentRatingPutRequest
+		expError error
+	}{
+		{
+			name: "tc1",
+			req:  dto.ContentRatingPutRequest{},
+			expError: validation.Errors{
+				"region": validation.ErrRequired,
+				"rating": validation.ErrRequired,
+			},
+		},
+		{
+			name:     "tc2",
+			req:      dto.ContentRatingPutRequest{Region: "US", Rating: "PG-13"},
+			expError: nil,
+		},
+		{
+			name: "tc3",
+			req:  dto.ContentRatingPutRequest{Region: "DE", Rating: "PG-13"},
+			expError: validation.Errors{
+				"rating": validation.ErrInInvalid,
+			},
+		},
+		{
+			name: "tc4",
+			req:  dto.ContentRatingPutRequest{Region: "XX", Rating: "PG-13"},
+			expError: validation.Errors{
+				"region": validation.ErrInInvalid,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.req.Validate())
+		})
+	}
+}
+
+func TestWatchEventCreateRequest_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		req      dto.WatchEventCreateRequest
+		expError error
+	}{
+		{
+			name: "invalid rating",
+			req: dto.WatchEventCreateRequest{
+				Rating: null.IntFrom(
+					config.Config.Validation.WatchEvent.Rating.MaxValue + 1,
+				),
+			},
+			expError: validation.Errors{
+				"rating": validation.ErrMaxLessEqualThanRequired.SetParams(
+					map[string]any{
+						"threshold": config.Config.Validation.WatchEvent.Rating.MaxValue,
+					},
+				),
+			},
+		},
+		{
+			name: "empty device",
+			req: dto.WatchEventCreateRequest{
+				Device: null.StringFrom(""),
+			},
+			expError: validation.Errors{
+				"device": validation.ErrRequired,
+			},
+		},
+		{
+			name:     "watched now",
+			req:      dto.WatchEventCreateRequest{},
+			expError: nil,
+		},
+		{
+			name: "ok",
+			req: dto.WatchEventCreateRequest{
+				TimeWatched: null.TimeFrom(testutils.Date(2020, 1, 1)),
+				Rating:      null.IntFrom(7),
+				Device:      null.StringFrom("tv"),
+			},
+			expError: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.req.Validate())
+		})
+	}
+}
+
+func TestPlaybackProgressPutRequest_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		req      dto.PlaybackProgressPutRequest
+		expError error
+	}{
+		{
+			name: "negative position",
+			req: dto.PlaybackProgressPutRequest{
+				Position: -1,
+			},
+			expError: validation.Errors{
+				"position": validation.ErrMinGreaterEqualThanRequired.SetParams(
+					map[string]any{"threshold": 0},
+				),
+			},
+		},
+		{
+			name: "empty device",
+			req: dto.PlaybackProgressPutRequest{
+				Position: 10,
+				Device:   null.StringFrom(""),
+			},
+			expError: validation.Errors{
+				"device": validation.ErrRequired,
+			},
+		},
+		{
+			name:     "updated now",
+			req:      dto.PlaybackProgressPutRequest{Position: 10},
+			expError: nil,
+		},
+		{
+			name: "ok",
+			req: dto.PlaybackProgressPutRequest{
+				Position:  2520,
+				Device:    null.StringFrom("tv"),
+				UpdatedAt: null.TimeFrom(testutils.Date(2020, 1, 1)),
+			},
+			expError: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.req.Validate())
+		})
+	}
+}
+
+func TestScorePutRequest_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		req      dto.ScorePutRequest
+		expError error
+	}{
+		{
+			name: "no score",
+			req:  dto.ScorePutRequest{},
+			expError: validation.Errors{
+				"score": validation.ErrNotNilRequired,
+			},
+		},
+		{
+			name: "score too high",
+			req: dto.ScorePutRequest{
+				Score: null.IntFrom(
+					config.Config.Validation.Score.MaxValue + 1,
+				),
+			},
+			expError: validation.Errors{
+				"score": validation.ErrMaxLessEqualThanRequired.SetParams(
+					map[string]any{
+						"threshold": config.Config.Validation.Score.MaxValue,
+					},
+				),
+			},
+		},
+		{
+			name:     "zero score",
+			req:      dto.ScorePutRequest{Score: null.IntFrom(0)},
+			expError: nil,
+		},
+		{
+			name:     "ok",
+			req:      dto.ScorePutRequest{Score: null.IntFrom(87)},
+			expError: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.req.Validate())
+		})
+	}
+}
+
+func TestReviewPutRequest_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		req      dto.ReviewPutRequest
+		expError error
+	}{
+		{
+			name: "no body",
+			req:  dto.ReviewPutRequest{Spoiler: true},
+			expError: validation.Errors{
+				"body": validation.ErrRequired,
+			},
+		},
+		{
+			name: "body too long",
+			req: dto.ReviewPutRequest{
+				Body: strings.Repeat(
+					"a",
+					config.Config.Validation.Review.Body.MaxLength+1,
+				),
+			},
+			expError: validation.Errors{
+				"body": validation.ErrLengthOutOfRange.SetParams(
+					map[string]any{
+						"min": config.Config.Validation.Review.Body.MinLength,
+						"max": config.Config.Validation.Review.Body.MaxLength,
+					},
+				),
+			},
+		},
+		{
+			name: "ok",
+			req: dto.ReviewPutRequest{
+				Body:    "**great** movie",
+				Spoiler: true,
+			},
+			expError: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.req.Validate())
+		})
+	}
+}
+
+func TestReviewStatusRequest_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		req      dto.ReviewStatusRequest
+		expError error
+	}{
+		{
+			name: "no status",
+			req:  dto.ReviewStatusRequest{},
+			expError: validation.Errors{
+				"status": validation.ErrRequired,
+			},
+		},
+		{
+			name: "invalid status",
+			req:  dto.ReviewStatusRequest{Status: "pending"},
+			expError: validation.Errors{
+				"status": validation.ErrInInvalid,
+			},
+		},
+		{
+			name:     "ok",
+			req:      dto.ReviewStatusRequest{Status: review.StatusHidden},
+			expError: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.req.Validate())
+		})
+	}
+}
+
+func TestListCreateRequest_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		req      dto.ListCreateRequest
+		expError error
+	}{
+		{
+			name: "no title",
+			req:  dto.ListCreateRequest{},
+			expError: validation.Errors{
+				"title": validation.ErrRequired,
+			},
+		},
+		{
+			name: "invalid visibility",
+			req: dto.ListCreateRequest{
+				Title:      "Halloween marathon",
+				Visibility: "friends",
+			},
+			expError: validation.Errors{
+				"visibility": validation.ErrInInvalid,
+			},
+		},
+		{
+			name: "ok",
+			req: dto.ListCreateRequest{
+				Title:        "Best of 2023",
+				Descriptions: null.StringFrom("descriptions"),
+				Visibility:   list.VisibilityPublic,
+			},
+			expError: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.req.Validate())
+		})
+	}
+}
+
+func TestListItemAddRequest_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		req      dto.ListItemAddRequest
+		expError error
+	}{
+		{
+			name: "no item",
+			req:  dto.ListItemAddRequest{},
+			expError: validation.Errors{
+				"film_id": validation.ErrRequired,
+			},
+		},
+		{
+			name: "film and series",
+			req: dto.ListItemAddRequest{
+				FilmID:   null.IntFrom(1),
+				SeriesID: null.IntFrom(1),
+			},
+			expError: validation.Errors{
+				"film_id": validation.ErrEmpty,
+			},
+		},
+		{
+			name:     "ok",
+			req:      dto.ListItemAddRequest{SeriesID: null.IntFrom(1)},
+			expError: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.req.Validate())
+		})
+	}
+}
+
+func TestListReorderRequest_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		req      dto.ListReorderRequest
+		expError error
+	}{
+		{
+			name: "no items",
+			req:  dto.ListReorderRequest{},
+			expError: validation.Errors{
+				"item_ids": validation.ErrRequired,
+			},
+		},
+		{
+			name: "duplicate id",
+			req:  dto.ListReorderRequest{ItemIDs: []int{1, 2, 1}},
+			expError: validation.Errors{
+				"item_ids": dto.ErrDuplicateListOrder,
+			},
+		},
+		{
+			name:     "ok",
+			req:      dto.ListReorderRequest{ItemIDs: []int{2, 1}},
+			expError: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.req.Validate())
+		})
+	}
+}
+
+func TestListInviteCreateRequest_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		req      dto.ListInviteCreateRequest
+		expError error
+	}{
+		{
+			name: "invalid email",
+			req: dto.ListInviteCreateRequest{
+				Email: "not an email",
+				Role:  list.RoleEditor,
+			},
+			expError: validation.Errors{
+				"email": is.ErrEmail,
+			},
+		},
+		{
+			name: "owner role",
+			req: dto.ListInviteCreateRequest{
+				Email: "user@prog.net",
+				Role:  list.RoleOwner,
+			},
+			expError: validation.Errors{
+				"role": validation.ErrInInvalid,
+			},
+		},
+		{
+			name: "ok",
+			req: dto.ListInviteCreateRequest{
+				Email: "user@prog.net",
+				Role:  list.RoleViewer,
+			},
+			expError: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.req.Validate())
+		})
+	}
+}
+
+func TestWatchlistItemUpdateRequest_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		req      dto.WatchlistItemUpdateRequest
+		expError error
+	}{
+		{
+			name: "priority too high",
+			req: dto.WatchlistItemUpdateRequest{
+				Priority: null.IntFrom(
+					config.Config.Validation.WatchlistItem.Priority.MaxValue + 1,
+				),
+			},
+			expError: validation.Errors{
+				"priority": validation.ErrMaxLessEqualThanRequired.SetParams(
+					map[string]any{
+						"threshold": config.Config.Validation.WatchlistItem.Priority.MaxValue,
+					},
+				),
+			},
+		},
+		{
+			name: "empty tag",
+			req: dto.WatchlistItemUpdateRequest{
+				Tags: []string{"tag", ""},
+			},
+			expError: validation.Errors{
+				"tags": validation.Errors{
+					"1": validation.ErrRequired,
+				},
+			},
+		},
+		{
+			name: "empty note and tags",
+			req: dto.WatchlistItemUpdateRequest{
+				Note: null.StringFrom(""),
+				Tags: []string{},
+			},
+			expError: nil,
+		},
+		{
+			name: "ok",
+			req: dto.WatchlistItemUpdateRequest{
+				Priority: null.IntFrom(0),
+				Note:     null.StringFrom("note"),
+				Tags:     []string{"tag1", "tag2"},
+			},
+			expError: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.req.Validate())
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package list
+
+import "github.com/aria3ppp/watchlist-server/internal/models"
+
+// the visibilities of the lists: private lists are visible to their members
+// only
+const (
+	VisibilityPrivate = "private"
+	VisibilityPublic  = "public"
+)
+
+// the roles of the list members: the owner manages the list and its members,
+// the editors manage the items and the viewers see the list
+const (
+	RoleOwner  = "owner"
+	RoleEditor = "editor"
+	RoleViewer = "viewer"
+)
+
+// the actions of the list activity log
+const (
+	ActivityCreate       = "create"
+	ActivityUpdate       = "update"
+	ActivityItemAdd      = "item_add"
+	ActivityItemRemove   = "item_remove"
+	ActivityReorder      = "reorder"
+	ActivityMemberJoin   = "member_join"
+	ActivityMemberUpdate = "member_update"
+	ActivityMemberRemove = "member_remove"
+)
+
+// Item is a list item along with the film or series it refers to
+type Item struct {
+	models.ListItem
+	Film   *models.Film   `json:"film,omitempty"`
+	Series *models.Series `json:"series,omitempty"`
+}
+
+// Member is a list member along with their user
+type Member struct {
+	models.ListMember
+	User *models.User `json:"user,omitempty"`
+}
+
+// LinkInvite is a created link invite along with its token: the token is
+// returned once as only its hash is stored
+type LinkInvite struct {
+	models.ListInvite
+	Token string `json:"token"`
+}
@@ -0,0 +1,101 @@
+package locale
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Pattern of the supported BCP-47 language tags: a language subtag optionally
+// followed by a script and a region subtag e.g. en, pt-BR or zh-Hant-TW
+var Pattern = regexp.MustCompile(
+	`^[a-zA-Z]{2,3}(-[a-zA-Z]{4})?(-([a-zA-Z]{2}|[0-9]{3}))?$`,
+)
+
+// Canonicalize returns the tag by the BCP-47 case conventions e.g. pt-br is
+// canonicalized to pt-BR: tag must match the Pattern
+func Canonicalize(tag string) string {
+	subtags := strings.Split(tag, "-")
+	subtags[0] = strings.ToLower(subtags[0])
+	for i := 1; i < len(subtags); i++ {
+		if len(subtags[i]) == 4 {
+			// script subtag
+			subtags[i] = strings.ToUpper(subtags[i][:1]) +
+				strings.ToLower(subtags[i][1:])
+		} else {
+			// region subtag
+			subtags[i] = strings.ToUpper(subtags[i])
+		}
+	}
+	return strings.Join(subtags, "-")
+}
+
+// Language returns the language subtag of the tag e.g. pt of pt-BR
+func Language(tag string) string {
+	return strings.ToLower(strings.SplitN(tag, "-", 2)[0])
+}
+
+// ParseAcceptLanguage returns the canonical tags of an Accept-Language header
+// ordered by their quality values. Wildcards, zero quality and unsupported
+// tags are left out.
+func ParseAcceptLanguage(header string) []string {
+	type weightedTag struct {
+		tag     string
+		quality float64
+	}
+	var weightedTags []weightedTag
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		tag := strings.TrimSpace(fields[0])
+		if !Pattern.MatchString(tag) {
+			continue
+		}
+		quality := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if !strings.HasPrefix(param, "q=") {
+				continue
+			}
+			q, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64)
+			if err != nil || q < 0 || q > 1 {
+				q = 0
+			}
+			quality = q
+		}
+		if quality == 0 {
+			continue
+		}
+		weightedTags = append(
+			weightedTags,
+			weightedTag{tag: Canonicalize(tag), quality: quality},
+		)
+	}
+	sort.SliceStable(weightedTags, func(i, j int) bool {
+		return weightedTags[i].quality > weightedTags[j].quality
+	})
+	tags := make([]string, 0, len(weightedTags))
+	for _, wt := range weightedTags {
+		tags = append(tags, wt.tag)
+	}
+	return tags
+}
+
+// Match returns the index of the available tag best matching the preferred
+// tags or -1 if there's no match. Preferences are tried in order, first by an
+// exact match and then by a match of the language subtag.
+func Match(preferred []string, available []string) int {
+	for _, p := range preferred {
+		for i, a := range available {
+			if strings.EqualFold(p, a) {
+				return i
+			}
+		}
+		for i, a := range available {
+			if Language(p) == Language(a) {
+				return i
+			}
+		}
+	}
+	return -1
+}
@@ -0,0 +1,78 @@
+package locale_test
+
+import (
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/locale"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPattern(t *testing.T) {
+	require := require.New(t)
+
+	for _, tag := range []string{"en", "fil", "pt-BR", "zh-Hant", "zh-Hant-TW", "es-419"} {
+		require.True(locale.Pattern.MatchString(tag), tag)
+	}
+	for _, tag := range []string{"", "e", "english", "en_US", "en-", "*", "en-US-x"} {
+		require.False(locale.Pattern.MatchString(tag), tag)
+	}
+}
+
+func TestCanonicalize(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal("en", locale.Canonicalize("EN"))
+	require.Equal("pt-BR", locale.Canonicalize("pt-br"))
+	require.Equal("zh-Hant-TW", locale.Canonicalize("ZH-hANT-tw"))
+	require.Equal("es-419", locale.Canonicalize("es-419"))
+}
+
+func TestLanguage(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal("en", locale.Language("en"))
+	require.Equal("pt", locale.Language("PT-BR"))
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	require := require.New(t)
+
+	require.Empty(locale.ParseAcceptLanguage(""))
+	require.Empty(locale.ParseAcceptLanguage("*"))
+	require.Equal(
+		[]string{"fr-CH", "fr", "de", "en"},
+		locale.ParseAcceptLanguage(
+			"en;q=0.7, fr-ch, *;q=0.5, fr;q=0.9, de;q=0.8, it;q=0, x_invalid",
+		),
+	)
+	// equal qualities keep their order
+	require.Equal(
+		[]string{"fa", "en"},
+		locale.ParseAcceptLanguage("fa;q=0.5,en;q=0.5"),
+	)
+	// invalid quality is ignored
+	require.Equal(
+		[]string{"en"},
+		locale.ParseAcceptLanguage("en, fa;q=2"),
+	)
+}
+
+func TestMatch(t *testing.T) {
+	require := require.New(t)
+
+	available := []string{"en", "pt-BR", "pt-PT", "fa"}
+
+	require.Equal(-1, locale.Match(nil, available))
+	require.Equal(-1, locale.Match([]string{"de"}, available))
+	require.Equal(-1, locale.Match([]string{"en"}, nil))
+	require.Equal(0, locale.Match([]string{"en"}, available))
+	// exact match
+	require.Equal(2, locale.Match([]string{"pt-PT"}, available))
+	// language match
+	require.Equal(0, locale.Match([]string{"en-GB"}, available))
+	require.Equal(1, locale.Match([]string{"pt"}, available))
+	// preferences in order
+	require.Equal(3, locale.Match([]string{"de", "fa", "en"}, available))
+	// a preferred language match wins over a less preferred exact match
+	require.Equal(1, locale.Match([]string{"pt-AO", "en"}, available))
+}
@@ -0,0 +1,59 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+
+	"github.com/volatiletech/null/v8"
+)
+
+//go:generate mockgen -destination mock_metadata/mock_provider.go . Provider
+
+// Provider fetches catalog metadata by the provider's own identifiers, which
+// are stored as external ids of films and serieses.
+type Provider interface {
+	// Name is the external id provider name of the provider identifiers
+	Name() string
+	Movie(ctx context.Context, id string) (*Movie, error)
+	Series(ctx context.Context, id string) (*Series, error)
+	Season(ctx context.Context, seriesID string, seasonNumber int) (*Season, error)
+	// Poster downloads a poster by its url
+	Poster(ctx context.Context, url string) ([]byte, error)
+}
+
+var (
+	ErrNotFound           = errors.New("metadata: not found")
+	ErrPosterTooLarge     = errors.New("metadata: poster too large")
+	ErrUnexpectedResponse = errors.New("metadata: unexpected response")
+)
+
+// Movie metadata: durations are in seconds and Poster is a url.
+type Movie struct {
+	Title        string      `json:"title"`
+	Descriptions null.String `json:"descriptions"`
+	DateReleased null.Time   `json:"date_released"`
+	Duration     null.Int    `json:"duration"`
+	Poster       null.String `json:"poster"`
+}
+
+// Series metadata: DateEnded is only set if the series has ended.
+type Series struct {
+	Title        string      `json:"title"`
+	Descriptions null.String `json:"descriptions"`
+	DateStarted  null.Time   `json:"date_started"`
+	DateEnded    null.Time   `json:"date_ended"`
+	Poster       null.String `json:"poster"`
+}
+
+type Season struct {
+	SeasonNumber int        `json:"season_number"`
+	Episodes     []*Episode `json:"episodes"`
+}
+
+type Episode struct {
+	EpisodeNumber int         `json:"episode_number"`
+	Title         string      `json:"title"`
+	Descriptions  null.String `json:"descriptions"`
+	DateReleased  null.Time   `json:"date_released"`
+	Duration      null.Int    `json:"duration"`
+}
@@ -0,0 +1,100 @@
+package metadatatestutils
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+// FakeTMDb is a local http server responding to TMDb api paths by the
+// registered responses. Unregistered paths respond 404.
+type FakeTMDb struct {
+	*httptest.Server
+	APIKey string
+
+	mu        sync.Mutex
+	responses map[string]any
+	images    map[string][]byte
+	requests  []string
+}
+
+func NewFakeTMDb(apiKey string) *FakeTMDb {
+	f := &FakeTMDb{
+		APIKey:    apiKey,
+		responses: map[string]any{},
+		images:    map[string][]byte{},
+	}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	return f
+}
+
+// BaseURL of the api paths
+func (f *FakeTMDb) BaseURL() string {
+	return f.URL + "/3"
+}
+
+// ImageBaseURL of the poster paths
+func (f *FakeTMDb) ImageBaseURL() string {
+	return f.URL + "/t/p/w500"
+}
+
+// SetResponse registers the json response of an api path e.g. /movie/603
+func (f *FakeTMDb) SetResponse(path string, response any) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.responses[path] = response
+}
+
+// SetImage registers an image of a poster path e.g. /poster.jpg
+func (f *FakeTMDb) SetImage(path string, image []byte) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.images[path] = image
+}
+
+// Requests returns the requested paths
+func (f *FakeTMDb) Requests() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.requests...)
+}
+
+// Reset removes all responses, images and requests
+func (f *FakeTMDb) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.responses = map[string]any{}
+	f.images = map[string][]byte{}
+	f.requests = nil
+}
+
+func (f *FakeTMDb) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, r.URL.Path)
+
+	if strings.HasPrefix(r.URL.Path, "/t/p/w500/") {
+		image, exists := f.images[strings.TrimPrefix(r.URL.Path, "/t/p/w500")]
+		if !exists {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", http.DetectContentType(image))
+		w.Write(image)
+		return
+	}
+
+	if r.URL.Query().Get("api_key") != f.APIKey {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	response, exists := f.responses[strings.TrimPrefix(r.URL.Path, "/3")]
+	if !exists {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
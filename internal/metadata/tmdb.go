@@ -0,0 +1,222 @@
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/volatiletech/null/v8"
+)
+
+// TMDb is a client of The Movie Database v3 api.
+type TMDb struct {
+	client       *http.Client
+	baseURL      string
+	imageBaseURL string
+	apiKey       string
+}
+
+var _ Provider = &TMDb{}
+
+// NewTMDb returns a TMDb client: imageBaseURL includes the poster size path
+// e.g. https://image.tmdb.org/t/p/w500
+func NewTMDb(
+	client *http.Client,
+	baseURL string,
+	imageBaseURL string,
+	apiKey string,
+) *TMDb {
+	return &TMDb{
+		client:       client,
+		baseURL:      baseURL,
+		imageBaseURL: imageBaseURL,
+		apiKey:       apiKey,
+	}
+}
+
+func (t *TMDb) Name() string {
+	return dto.ExternalIDProviderTMDb
+}
+
+type tmdbMovie struct {
+	Title       string `json:"title"`
+	Overview    string `json:"overview"`
+	ReleaseDate string `json:"release_date"`
+	// runtime in minutes
+	Runtime    int    `json:"runtime"`
+	PosterPath string `json:"poster_path"`
+}
+
+func (t *TMDb) Movie(ctx context.Context, id string) (*Movie, error) {
+	var m tmdbMovie
+	if err := t.get(ctx, "/movie/"+url.PathEscape(id), &m); err != nil {
+		return nil, err
+	}
+	return &Movie{
+		Title:        m.Title,
+		Descriptions: tmdbString(m.Overview),
+		DateReleased: tmdbDate(m.ReleaseDate),
+		Duration:     tmdbRuntime(m.Runtime),
+		Poster:       t.posterURL(m.PosterPath),
+	}, nil
+}
+
+type tmdbSeries struct {
+	Name         string `json:"name"`
+	Overview     string `json:"overview"`
+	FirstAirDate string `json:"first_air_date"`
+	LastAirDate  string `json:"last_air_date"`
+	Status       string `json:"status"`
+	PosterPath   string `json:"poster_path"`
+}
+
+func (t *TMDb) Series(ctx context.Context, id string) (*Series, error) {
+	var s tmdbSeries
+	if err := t.get(ctx, "/tv/"+url.PathEscape(id), &s); err != nil {
+		return nil, err
+	}
+	series := &Series{
+		Title:        s.Name,
+		Descriptions: tmdbString(s.Overview),
+		DateStarted:  tmdbDate(s.FirstAirDate),
+		Poster:       t.posterURL(s.PosterPath),
+	}
+	// the last air date of a running series is just its latest episode
+	if s.Status == "Ended" || s.Status == "Canceled" {
+		series.DateEnded = tmdbDate(s.LastAirDate)
+	}
+	return series, nil
+}
+
+type tmdbSeason struct {
+	SeasonNumber int `json:"season_number"`
+	Episodes     []struct {
+		EpisodeNumber int    `json:"episode_number"`
+		Name          string `json:"name"`
+		Overview      string `json:"overview"`
+		AirDate       string `json:"air_date"`
+		Runtime       int    `json:"runtime"`
+	} `json:"episodes"`
+}
+
+func (t *TMDb) Season(
+	ctx context.Context,
+	seriesID string,
+	seasonNumber int,
+) (*Season, error) {
+	var s tmdbSeason
+	path := "/tv/" + url.PathEscape(seriesID) +
+		"/season/" + strconv.Itoa(seasonNumber)
+	if err := t.get(ctx, path, &s); err != nil {
+		return nil, err
+	}
+	season := &Season{
+		SeasonNumber: s.SeasonNumber,
+		Episodes:     make([]*Episode, len(s.Episodes)),
+	}
+	for i, e := range s.Episodes {
+		season.Episodes[i] = &Episode{
+			EpisodeNumber: e.EpisodeNumber,
+			Title:         e.Name,
+			Descriptions:  tmdbString(e.Overview),
+			DateReleased:  tmdbDate(e.AirDate),
+			Duration:      tmdbRuntime(e.Runtime),
+		}
+	}
+	return season, nil
+}
+
+func (t *TMDb) Poster(ctx context.Context, posterURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, posterURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if err := tmdbCheckStatus(resp); err != nil {
+		return nil, err
+	}
+	// read one byte past the limit to detect too large posters
+	maxSize := int64(config.Config.Metadata.PosterMaxSizeInKB) * 1024
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, ErrPosterTooLarge
+	}
+	return data, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// get decodes the json response of an api path into v
+func (t *TMDb) get(ctx context.Context, path string, v any) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		t.baseURL+path+"?"+url.Values{"api_key": {t.apiKey}}.Encode(),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if err := tmdbCheckStatus(resp); err != nil {
+		return err
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("%w: %s", ErrUnexpectedResponse, err)
+	}
+	return nil
+}
+
+func tmdbCheckStatus(resp *http.Response) error {
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return ErrNotFound
+	}
+	return fmt.Errorf("%w: status %d", ErrUnexpectedResponse, resp.StatusCode)
+}
+
+func (t *TMDb) posterURL(path string) null.String {
+	if path == "" {
+		return null.String{}
+	}
+	return null.StringFrom(t.imageBaseURL + path)
+}
+
+// tmdb responds empty strings and zero numbers for unknown values
+
+func tmdbString(s string) null.String {
+	return null.NewString(s, s != "")
+}
+
+func tmdbDate(s string) null.Time {
+	date, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return null.Time{}
+	}
+	return null.TimeFrom(date)
+}
+
+func tmdbRuntime(minutes int) null.Int {
+	return null.NewInt(minutes*60, minutes > 0)
+}
@@ -0,0 +1,231 @@
+package metadata_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/metadata"
+	"github.com/aria3ppp/watchlist-server/internal/metadata/metadatatestutils"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+const apiKey = "api-key"
+
+func newTMDb(t *testing.T) (*metadata.TMDb, *metadatatestutils.FakeTMDb) {
+	fake := metadatatestutils.NewFakeTMDb(apiKey)
+	t.Cleanup(fake.Close)
+	tmdb := metadata.NewTMDb(
+		http.DefaultClient,
+		fake.BaseURL(),
+		fake.ImageBaseURL(),
+		apiKey,
+	)
+	return tmdb, fake
+}
+
+func TestTMDb_Name(t *testing.T) {
+	tmdb, _ := newTMDb(t)
+	require.Equal(t, dto.ExternalIDProviderTMDb, tmdb.Name())
+}
+
+func TestTMDb_Movie(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	tmdb, fake := newTMDb(t)
+
+	// not found
+	movie, err := tmdb.Movie(ctx, "603")
+	require.Equal(metadata.ErrNotFound, err)
+	require.Nil(movie)
+
+	fake.SetResponse("/movie/603", map[string]any{
+		"title":        "The Matrix",
+		"overview":     "A computer hacker learns about the true nature of reality.",
+		"release_date": "1999-03-30",
+		"runtime":      136,
+		"poster_path":  "/matrix.jpg",
+	})
+	fake.SetResponse("/movie/604", map[string]any{
+		"title":        "Unknown",
+		"overview":     "",
+		"release_date": "",
+		"runtime":      0,
+		"poster_path":  nil,
+	})
+
+	// found
+	movie, err = tmdb.Movie(ctx, "603")
+	require.NoError(err)
+	require.Equal(
+		&metadata.Movie{
+			Title: "The Matrix",
+			Descriptions: null.StringFrom(
+				"A computer hacker learns about the true nature of reality.",
+			),
+			DateReleased: null.TimeFrom(testutils.Date(1999, 3, 30)),
+			Duration:     null.IntFrom(136 * 60),
+			Poster:       null.StringFrom(fake.ImageBaseURL() + "/matrix.jpg"),
+		},
+		movie,
+	)
+
+	// unknown values are null
+	movie, err = tmdb.Movie(ctx, "604")
+	require.NoError(err)
+	require.Equal(&metadata.Movie{Title: "Unknown"}, movie)
+
+	// wrong api key
+	unauthorized := metadata.NewTMDb(
+		http.DefaultClient,
+		fake.BaseURL(),
+		fake.ImageBaseURL(),
+		"wrong",
+	)
+	_, err = unauthorized.Movie(ctx, "603")
+	require.True(errors.Is(err, metadata.ErrUnexpectedResponse))
+}
+
+func TestTMDb_Series(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	tmdb, fake := newTMDb(t)
+
+	fake.SetResponse("/tv/1396", map[string]any{
+		"name":           "Breaking Bad",
+		"overview":       "A chemistry teacher turns to crime.",
+		"first_air_date": "2008-01-20",
+		"last_air_date":  "2013-09-29",
+		"status":         "Ended",
+		"poster_path":    "/bb.jpg",
+	})
+	fake.SetResponse("/tv/1397", map[string]any{
+		"name":           "Running",
+		"first_air_date": "2020-01-01",
+		"last_air_date":  "2022-01-01",
+		"status":         "Returning Series",
+	})
+
+	// ended series
+	series, err := tmdb.Series(ctx, "1396")
+	require.NoError(err)
+	require.Equal(
+		&metadata.Series{
+			Title:        "Breaking Bad",
+			Descriptions: null.StringFrom("A chemistry teacher turns to crime."),
+			DateStarted:  null.TimeFrom(testutils.Date(2008, 1, 20)),
+			DateEnded:    null.TimeFrom(testutils.Date(2013, 9, 29)),
+			Poster:       null.StringFrom(fake.ImageBaseURL() + "/bb.jpg"),
+		},
+		series,
+	)
+
+	// running series has not ended
+	series, err = tmdb.Series(ctx, "1397")
+	require.NoError(err)
+	require.Equal(
+		&metadata.Series{
+			Title:       "Running",
+			DateStarted: null.TimeFrom(testutils.Date(2020, 1, 1)),
+		},
+		series,
+	)
+
+	// not found
+	_, err = tmdb.Series(ctx, "1")
+	require.Equal(metadata.ErrNotFound, err)
+}
+
+func TestTMDb_Season(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	tmdb, fake := newTMDb(t)
+
+	// not found
+	_, err := tmdb.Season(ctx, "1396", 1)
+	require.Equal(metadata.ErrNotFound, err)
+
+	fake.SetResponse("/tv/1396/season/1", map[string]any{
+		"season_number": 1,
+		"episodes": []map[string]any{
+			{
+				"episode_number": 1,
+				"name":           "Pilot",
+				"overview":       "Walter White is diagnosed with cancer.",
+				"air_date":       "2008-01-20",
+				"runtime":        58,
+			},
+			{
+				"episode_number": 2,
+				"name":           "Cat's in the Bag...",
+				"air_date":       "2008-01-27",
+			},
+		},
+	})
+
+	season, err := tmdb.Season(ctx, "1396", 1)
+	require.NoError(err)
+	require.Equal(
+		&metadata.Season{
+			SeasonNumber: 1,
+			Episodes: []*metadata.Episode{
+				{
+					EpisodeNumber: 1,
+					Title:         "Pilot",
+					Descriptions: null.StringFrom(
+						"Walter White is diagnosed with cancer.",
+					),
+					DateReleased: null.TimeFrom(testutils.Date(2008, 1, 20)),
+					Duration:     null.IntFrom(58 * 60),
+				},
+				{
+					EpisodeNumber: 2,
+					Title:         "Cat's in the Bag...",
+					DateReleased:  null.TimeFrom(testutils.Date(2008, 1, 27)),
+				},
+			},
+		},
+		season,
+	)
+
+	// malformed response
+	fake.SetResponse("/tv/1396/season/2", []int{1, 2})
+	_, err = tmdb.Season(ctx, "1396", 2)
+	require.True(errors.Is(err, metadata.ErrUnexpectedResponse))
+}
+
+func TestTMDb_Poster(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	tmdb, fake := newTMDb(t)
+
+	// not found
+	_, err := tmdb.Poster(ctx, fake.ImageBaseURL()+"/poster.jpg")
+	require.Equal(metadata.ErrNotFound, err)
+
+	// found
+	image := []byte("\xff\xd8\xff\xe0 jpeg image")
+	fake.SetImage("/poster.jpg", image)
+
+	data, err := tmdb.Poster(ctx, fake.ImageBaseURL()+"/poster.jpg")
+	require.NoError(err)
+	require.Equal(image, data)
+
+	// too large
+	fake.SetImage(
+		"/large.jpg",
+		bytes.Repeat([]byte{0}, config.Config.Metadata.PosterMaxSizeInKB*1024+1),
+	)
+	_, err = tmdb.Poster(ctx, fake.ImageBaseURL()+"/large.jpg")
+	require.Equal(metadata.ErrPosterTooLarge, err)
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testAuditPrunes(t *testing.T) {
+	t.Parallel()
+
+	query := AuditPrunes()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testAuditPrunesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditPrune{}
+	if err = randomize.Struct(seed, o, auditPruneDBTypes, true, auditPruneColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditPrune struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AuditPrunes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAuditPrunesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditPrune{}
+	if err = randomize.Struct(seed, o, auditPruneDBTypes, true, auditPruneColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditPrune struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := AuditPrunes().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AuditPrunes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAuditPrunesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditPrune{}
+	if err = randomize.Struct(seed, o, auditPruneDBTypes, true, auditPruneColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditPrune struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AuditPruneSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := AuditPrunes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testAuditPrunesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditPrune{}
+	if err = randomize.Struct(seed, o, auditPruneDBTypes, true, auditPruneColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditPrune struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := AuditPruneExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if AuditPrune exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected AuditPruneExists to return true, but got false.")
+	}
+}
+
+func testAuditPrunesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditPrune{}
+	if err = randomize.Struct(seed, o, auditPruneDBTypes, true, auditPruneColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditPrune struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	auditPruneFound, err := FindAuditPrune(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if auditPruneFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testAuditPrunesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditPrune{}
+	if err = randomize.Struct(seed, o, auditPruneDBTypes, true, auditPruneColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditPrune struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = AuditPrunes().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAuditPrunesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditPrune{}
+	if err = randomize.Struct(seed, o, auditPruneDBTypes, true, auditPruneColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditPrune struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := AuditPrunes().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testAuditPrunesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	auditPruneOne := &AuditPrune{}
+	auditPruneTwo := &AuditPrune{}
+	if err = randomize.Struct(seed, auditPruneOne, auditPruneDBTypes, false, auditPruneColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditPrune struct: %s", err)
+	}
+	if err = randomize.Struct(seed, auditPruneTwo, auditPruneDBTypes, false, auditPruneColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditPrune struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = auditPruneOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = auditPruneTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AuditPrunes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testAuditPrunesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	auditPruneOne := &AuditPrune{}
+	auditPruneTwo := &AuditPrune{}
+	if err = randomize.Struct(seed, auditPruneOne, auditPruneDBTypes, false, auditPruneColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditPrune struct: %s", err)
+	}
+	if err = randomize.Struct(seed, auditPruneTwo, auditPruneDBTypes, false, auditPruneColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditPrune struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = auditPruneOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = auditPruneTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AuditPrunes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func auditPruneBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *AuditPrune) error {
+	*o = AuditPrune{}
+	return nil
+}
+
+func auditPruneAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *AuditPrune) error {
+	*o = AuditPrune{}
+	return nil
+}
+
+func auditPruneAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *AuditPrune) error {
+	*o = AuditPrune{}
+	return nil
+}
+
+func auditPruneBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *AuditPrune) error {
+	*o = AuditPrune{}
+	return nil
+}
+
+func auditPruneAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *AuditPrune) error {
+	*o = AuditPrune{}
+	return nil
+}
+
+func auditPruneBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *AuditPrune) error {
+	*o = AuditPrune{}
+	return nil
+}
+
+func auditPruneAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *AuditPrune) error {
+	*o = AuditPrune{}
+	return nil
+}
+
+func auditPruneBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *AuditPrune) error {
+	*o = AuditPrune{}
+	return nil
+}
+
+func auditPruneAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *AuditPrune) error {
+	*o = AuditPrune{}
+	return nil
+}
+
+func testAuditPrunesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &AuditPrune{}
+	o := &AuditPrune{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, auditPruneDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize AuditPrune object: %s", err)
+	}
+
+	AddAuditPruneHook(boil.BeforeInsertHook, auditPruneBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	auditPruneBeforeInsertHooks = []AuditPruneHook{}
+
+	AddAuditPruneHook(boil.AfterInsertHook, auditPruneAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	auditPruneAfterInsertHooks = []AuditPruneHook{}
+
+	AddAuditPruneHook(boil.AfterSelectHook, auditPruneAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	auditPruneAfterSelectHooks = []AuditPruneHook{}
+
+	AddAuditPruneHook(boil.BeforeUpdateHook, auditPruneBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	auditPruneBeforeUpdateHooks = []AuditPruneHook{}
+
+	AddAuditPruneHook(boil.AfterUpdateHook, auditPruneAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	auditPruneAfterUpdateHooks = []AuditPruneHook{}
+
+	AddAuditPruneHook(boil.BeforeDeleteHook, auditPruneBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	auditPruneBeforeDeleteHooks = []AuditPruneHook{}
+
+	AddAuditPruneHook(boil.AfterDeleteHook, auditPruneAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	auditPruneAfterDeleteHooks = []AuditPruneHook{}
+
+	AddAuditPruneHook(boil.BeforeUpsertHook, auditPruneBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	auditPruneBeforeUpsertHooks = []AuditPruneHook{}
+
+	AddAuditPruneHook(boil.AfterUpsertHook, auditPruneAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	auditPruneAfterUpsertHooks = []AuditPruneHook{}
+}
+
+func testAuditPrunesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditPrune{}
+	if err = randomize.Struct(seed, o, auditPruneDBTypes, true, auditPruneColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditPrune struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AuditPrunes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAuditPrunesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditPrune{}
+	if err = randomize.Struct(seed, o, auditPruneDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AuditPrune struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(auditPruneColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AuditPrunes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testAuditPrunesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditPrune{}
+	if err = randomize.Struct(seed, o, auditPruneDBTypes, true, auditPruneColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditPrune struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAuditPrunesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditPrune{}
+	if err = randomize.Struct(seed, o, auditPruneDBTypes, true, auditPruneColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditPrune struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := AuditPruneSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAuditPrunesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditPrune{}
+	if err = randomize.Struct(seed, o, auditPruneDBTypes, true, auditPruneColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditPrune struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := AuditPrunes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	auditPruneDBTypes = map[string]string{`ID`: `integer`, `Status`: `character varying`, `DryRun`: `boolean`, `PrunedRows`: `integer`, `ArchivedRows`: `integer`, `CreatedAt`: `timestamp with time zone`, `FinishedAt`: `timestamp with time zone`}
+	_                 = bytes.MinRead
+)
+
+func testAuditPrunesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(auditPrunePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(auditPruneAllColumns) == len(auditPrunePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditPrune{}
+	if err = randomize.Struct(seed, o, auditPruneDBTypes, true, auditPruneColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditPrune struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AuditPrunes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, auditPruneDBTypes, true, auditPrunePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AuditPrune struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testAuditPrunesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(auditPruneAllColumns) == len(auditPrunePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &AuditPrune{}
+	if err = randomize.Struct(seed, o, auditPruneDBTypes, true, auditPruneColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize AuditPrune struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := AuditPrunes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, auditPruneDBTypes, true, auditPrunePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AuditPrune struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(auditPruneAllColumns, auditPrunePrimaryKeyColumns) {
+		fields = auditPruneAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			auditPruneAllColumns,
+			auditPrunePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := AuditPruneSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testAuditPrunesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(auditPruneAllColumns) == len(auditPrunePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := AuditPrune{}
+	if err = randomize.Struct(seed, &o, auditPruneDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize AuditPrune struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AuditPrune: %s", err)
+	}
+
+	count, err := AuditPrunes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, auditPruneDBTypes, false, auditPrunePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize AuditPrune struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert AuditPrune: %s", err)
+	}
+
+	count, err = AuditPrunes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -12,141 +12,703 @@ import "testing"
 // It does NOT run each operation group in parallel.
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
+	t.Run("AuditPrunes", testAuditPrunes)
+	t.Run("CatalogExports", testCatalogExports)
+	t.Run("CollectionItems", testCollectionItems)
+	t.Run("CollectionItemsAudits", testCollectionItemsAudits)
+	t.Run("Collections", testCollections)
+	t.Run("CollectionsAudits", testCollectionsAudits)
+	t.Run("ContentRatings", testContentRatings)
+	t.Run("ContentRatingsAudits", testContentRatingsAudits)
+	t.Run("Contributions", testContributions)
+	t.Run("ContributorStats", testContributorStats)
+	t.Run("ExternalIds", testExternalIds)
+	t.Run("ExternalIdsAudits", testExternalIdsAudits)
+	t.Run("FilmScoreAggregates", testFilmScoreAggregates)
 	t.Run("Films", testFilms)
 	t.Run("FilmsAudits", testFilmsAudits)
+	t.Run("ImportErrors", testImportErrors)
+	t.Run("ImportJobs", testImportJobs)
+	t.Run("ListActivities", testListActivities)
+	t.Run("ListInvites", testListInvites)
+	t.Run("ListItems", testListItems)
+	t.Run("ListMembers", testListMembers)
+	t.Run("Lists", testLists)
+	t.Run("MediaItems", testMediaItems)
+	t.Run("MediaItemsAudits", testMediaItemsAudits)
+	t.Run("PlaybackProgresses", testPlaybackProgresses)
+	t.Run("Releases", testReleases)
+	t.Run("ReleasesAudits", testReleasesAudits)
+	t.Run("ReviewVotes", testReviewVotes)
+	t.Run("Reviews", testReviews)
+	t.Run("ReviewsAudits", testReviewsAudits)
+	t.Run("Scores", testScores)
+	t.Run("SeriesAggregates", testSeriesAggregates)
+	t.Run("SeriesFollows", testSeriesFollows)
+	t.Run("SeriesScoreAggregates", testSeriesScoreAggregates)
 	t.Run("Serieses", testSerieses)
 	t.Run("SeriesesAudits", testSeriesesAudits)
 	t.Run("Tokens", testTokens)
+	t.Run("Translations", testTranslations)
+	t.Run("TranslationsAudits", testTranslationsAudits)
 	t.Run("Users", testUsers)
+	t.Run("UsersAudits", testUsersAudits)
+	t.Run("WatchEvents", testWatchEvents)
+	t.Run("WatchfilmTags", testWatchfilmTags)
 	t.Run("Watchfilms", testWatchfilms)
+	t.Run("WatchfilmsAudits", testWatchfilmsAudits)
+	t.Run("YearReviews", testYearReviews)
 }
 
 func TestDelete(t *testing.T) {
+	t.Run("AuditPrunes", testAuditPrunesDelete)
+	t.Run("CatalogExports", testCatalogExportsDelete)
+	t.Run("CollectionItems", testCollectionItemsDelete)
+	t.Run("CollectionItemsAudits", testCollectionItemsAuditsDelete)
+	t.Run("Collections", testCollectionsDelete)
+	t.Run("CollectionsAudits", testCollectionsAuditsDelete)
+	t.Run("ContentRatings", testContentRatingsDelete)
+	t.Run("ContentRatingsAudits", testContentRatingsAuditsDelete)
+	t.Run("Contributions", testContributionsDelete)
+	t.Run("ContributorStats", testContributorStatsDelete)
+	t.Run("ExternalIds", testExternalIdsDelete)
+	t.Run("ExternalIdsAudits", testExternalIdsAuditsDelete)
+	t.Run("FilmScoreAggregates", testFilmScoreAggregatesDelete)
 	t.Run("Films", testFilmsDelete)
 	t.Run("FilmsAudits", testFilmsAuditsDelete)
+	t.Run("ImportErrors", testImportErrorsDelete)
+	t.Run("ImportJobs", testImportJobsDelete)
+	t.Run("ListActivities", testListActivitiesDelete)
+	t.Run("ListInvites", testListInvitesDelete)
+	t.Run("ListItems", testListItemsDelete)
+	t.Run("ListMembers", testListMembersDelete)
+	t.Run("Lists", testListsDelete)
+	t.Run("MediaItems", testMediaItemsDelete)
+	t.Run("MediaItemsAudits", testMediaItemsAuditsDelete)
+	t.Run("PlaybackProgresses", testPlaybackProgressesDelete)
+	t.Run("Releases", testReleasesDelete)
+	t.Run("ReleasesAudits", testReleasesAuditsDelete)
+	t.Run("ReviewVotes", testReviewVotesDelete)
+	t.Run("Reviews", testReviewsDelete)
+	t.Run("ReviewsAudits", testReviewsAuditsDelete)
+	t.Run("Scores", testScoresDelete)
+	t.Run("SeriesAggregates", testSeriesAggregatesDelete)
+	t.Run("SeriesFollows", testSeriesFollowsDelete)
+	t.Run("SeriesScoreAggregates", testSeriesScoreAggregatesDelete)
 	t.Run("Serieses", testSeriesesDelete)
 	t.Run("SeriesesAudits", testSeriesesAuditsDelete)
 	t.Run("Tokens", testTokensDelete)
+	t.Run("Translations", testTranslationsDelete)
+	t.Run("TranslationsAudits", testTranslationsAuditsDelete)
 	t.Run("Users", testUsersDelete)
+	t.Run("UsersAudits", testUsersAuditsDelete)
+	t.Run("WatchEvents", testWatchEventsDelete)
+	t.Run("WatchfilmTags", testWatchfilmTagsDelete)
 	t.Run("Watchfilms", testWatchfilmsDelete)
+	t.Run("WatchfilmsAudits", testWatchfilmsAuditsDelete)
+	t.Run("YearReviews", testYearReviewsDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
+	t.Run("AuditPrunes", testAuditPrunesQueryDeleteAll)
+	t.Run("CatalogExports", testCatalogExportsQueryDeleteAll)
+	t.Run("CollectionItems", testCollectionItemsQueryDeleteAll)
+	t.Run("CollectionItemsAudits", testCollectionItemsAuditsQueryDeleteAll)
+	t.Run("Collections", testCollectionsQueryDeleteAll)
+	t.Run("CollectionsAudits", testCollectionsAuditsQueryDeleteAll)
+	t.Run("ContentRatings", testContentRatingsQueryDeleteAll)
+	t.Run("ContentRatingsAudits", testContentRatingsAuditsQueryDeleteAll)
+	t.Run("Contributions", testContributionsQueryDeleteAll)
+	t.Run("ContributorStats", testContributorStatsQueryDeleteAll)
+	t.Run("ExternalIds", testExternalIdsQueryDeleteAll)
+	t.Run("ExternalIdsAudits", testExternalIdsAuditsQueryDeleteAll)
+	t.Run("FilmScoreAggregates", testFilmScoreAggregatesQueryDeleteAll)
 	t.Run("Films", testFilmsQueryDeleteAll)
 	t.Run("FilmsAudits", testFilmsAuditsQueryDeleteAll)
+	t.Run("ImportErrors", testImportErrorsQueryDeleteAll)
+	t.Run("ImportJobs", testImportJobsQueryDeleteAll)
+	t.Run("ListActivities", testListActivitiesQueryDeleteAll)
+	t.Run("ListInvites", testListInvitesQueryDeleteAll)
+	t.Run("ListItems", testListItemsQueryDeleteAll)
+	t.Run("ListMembers", testListMembersQueryDeleteAll)
+	t.Run("Lists", testListsQueryDeleteAll)
+	t.Run("MediaItems", testMediaItemsQueryDeleteAll)
+	t.Run("MediaItemsAudits", testMediaItemsAuditsQueryDeleteAll)
+	t.Run("PlaybackProgresses", testPlaybackProgressesQueryDeleteAll)
+	t.Run("Releases", testReleasesQueryDeleteAll)
+	t.Run("ReleasesAudits", testReleasesAuditsQueryDeleteAll)
+	t.Run("ReviewVotes", testReviewVotesQueryDeleteAll)
+	t.Run("Reviews", testReviewsQueryDeleteAll)
+	t.Run("ReviewsAudits", testReviewsAuditsQueryDeleteAll)
+	t.Run("Scores", testScoresQueryDeleteAll)
+	t.Run("SeriesAggregates", testSeriesAggregatesQueryDeleteAll)
+	t.Run("SeriesFollows", testSeriesFollowsQueryDeleteAll)
+	t.Run("SeriesScoreAggregates", testSeriesScoreAggregatesQueryDeleteAll)
 	t.Run("Serieses", testSeriesesQueryDeleteAll)
 	t.Run("SeriesesAudits", testSeriesesAuditsQueryDeleteAll)
 	t.Run("Tokens", testTokensQueryDeleteAll)
+	t.Run("Translations", testTranslationsQueryDeleteAll)
+	t.Run("TranslationsAudits", testTranslationsAuditsQueryDeleteAll)
 	t.Run("Users", testUsersQueryDeleteAll)
+	t.Run("UsersAudits", testUsersAuditsQueryDeleteAll)
+	t.Run("WatchEvents", testWatchEventsQueryDeleteAll)
+	t.Run("WatchfilmTags", testWatchfilmTagsQueryDeleteAll)
 	t.Run("Watchfilms", testWatchfilmsQueryDeleteAll)
+	t.Run("WatchfilmsAudits", testWatchfilmsAuditsQueryDeleteAll)
+	t.Run("YearReviews", testYearReviewsQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
+	t.Run("AuditPrunes", testAuditPrunesSliceDeleteAll)
+	t.Run("CatalogExports", testCatalogExportsSliceDeleteAll)
+	t.Run("CollectionItems", testCollectionItemsSliceDeleteAll)
+	t.Run("CollectionItemsAudits", testCollectionItemsAuditsSliceDeleteAll)
+	t.Run("Collections", testCollectionsSliceDeleteAll)
+	t.Run("CollectionsAudits", testCollectionsAuditsSliceDeleteAll)
+	t.Run("ContentRatings", testContentRatingsSliceDeleteAll)
+	t.Run("ContentRatingsAudits", testContentRatingsAuditsSliceDeleteAll)
+	t.Run("Contributions", testContributionsSliceDeleteAll)
+	t.Run("ContributorStats", testContributorStatsSliceDeleteAll)
+	t.Run("ExternalIds", testExternalIdsSliceDeleteAll)
+	t.Run("ExternalIdsAudits", testExternalIdsAuditsSliceDeleteAll)
+	t.Run("FilmScoreAggregates", testFilmScoreAggregatesSliceDeleteAll)
 	t.Run("Films", testFilmsSliceDeleteAll)
 	t.Run("FilmsAudits", testFilmsAuditsSliceDeleteAll)
+	t.Run("ImportErrors", testImportErrorsSliceDeleteAll)
+	t.Run("ImportJobs", testImportJobsSliceDeleteAll)
+	t.Run("ListActivities", testListActivitiesSliceDeleteAll)
+	t.Run("ListInvites", testListInvitesSliceDeleteAll)
+	t.Run("ListItems", testListItemsSliceDeleteAll)
+	t.Run("ListMembers", testListMembersSliceDeleteAll)
+	t.Run("Lists", testListsSliceDeleteAll)
+	t.Run("MediaItems", testMediaItemsSliceDeleteAll)
+	t.Run("MediaItemsAudits", testMediaItemsAuditsSliceDeleteAll)
+	t.Run("PlaybackProgresses", testPlaybackProgressesSliceDeleteAll)
+	t.Run("Releases", testReleasesSliceDeleteAll)
+	t.Run("ReleasesAudits", testReleasesAuditsSliceDeleteAll)
+	t.Run("ReviewVotes", testReviewVotesSliceDeleteAll)
+	t.Run("Reviews", testReviewsSliceDeleteAll)
+	t.Run("ReviewsAudits", testReviewsAuditsSliceDeleteAll)
+	t.Run("Scores", testScoresSliceDeleteAll)
+	t.Run("SeriesAggregates", testSeriesAggregatesSliceDeleteAll)
+	t.Run("SeriesFollows", testSeriesFollowsSliceDeleteAll)
+	t.Run("SeriesScoreAggregates", testSeriesScoreAggregatesSliceDeleteAll)
 	t.Run("Serieses", testSeriesesSliceDeleteAll)
 	t.Run("SeriesesAudits", testSeriesesAuditsSliceDeleteAll)
 	t.Run("Tokens", testTokensSliceDeleteAll)
+	t.Run("Translations", testTranslationsSliceDeleteAll)
+	t.Run("TranslationsAudits", testTranslationsAuditsSliceDeleteAll)
 	t.Run("Users", testUsersSliceDeleteAll)
+	t.Run("UsersAudits", testUsersAuditsSliceDeleteAll)
+	t.Run("WatchEvents", testWatchEventsSliceDeleteAll)
+	t.Run("WatchfilmTags", testWatchfilmTagsSliceDeleteAll)
 	t.Run("Watchfilms", testWatchfilmsSliceDeleteAll)
+	t.Run("WatchfilmsAudits", testWatchfilmsAuditsSliceDeleteAll)
+	t.Run("YearReviews", testYearReviewsSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
+	t.Run("AuditPrunes", testAuditPrunesExists)
+	t.Run("CatalogExports", testCatalogExportsExists)
+	t.Run("CollectionItems", testCollectionItemsExists)
+	t.Run("CollectionItemsAudits", testCollectionItemsAuditsExists)
+	t.Run("Collections", testCollectionsExists)
+	t.Run("CollectionsAudits", testCollectionsAuditsExists)
+	t.Run("ContentRatings", testContentRatingsExists)
+	t.Run("ContentRatingsAudits", testContentRatingsAuditsExists)
+	t.Run("Contributions", testContributionsExists)
+	t.Run("ContributorStats", testContributorStatsExists)
+	t.Run("ExternalIds", testExternalIdsExists)
+	t.Run("ExternalIdsAudits", testExternalIdsAuditsExists)
+	t.Run("FilmScoreAggregates", testFilmScoreAggregatesExists)
 	t.Run("Films", testFilmsExists)
 	t.Run("FilmsAudits", testFilmsAuditsExists)
+	t.Run("ImportErrors", testImportErrorsExists)
+	t.Run("ImportJobs", testImportJobsExists)
+	t.Run("ListActivities", testListActivitiesExists)
+	t.Run("ListInvites", testListInvitesExists)
+	t.Run("ListItems", testListItemsExists)
+	t.Run("ListMembers", testListMembersExists)
+	t.Run("Lists", testListsExists)
+	t.Run("MediaItems", testMediaItemsExists)
+	t.Run("MediaItemsAudits", testMediaItemsAuditsExists)
+	t.Run("PlaybackProgresses", testPlaybackProgressesExists)
+	t.Run("Releases", testReleasesExists)
+	t.Run("ReleasesAudits", testReleasesAuditsExists)
+	t.Run("ReviewVotes", testReviewVotesExists)
+	t.Run("Reviews", testReviewsExists)
+	t.Run("ReviewsAudits", testReviewsAuditsExists)
+	t.Run("Scores", testScoresExists)
+	t.Run("SeriesAggregates", testSeriesAggregatesExists)
+	t.Run("SeriesFollows", testSeriesFollowsExists)
+	t.Run("SeriesScoreAggregates", testSeriesScoreAggregatesExists)
 	t.Run("Serieses", testSeriesesExists)
 	t.Run("SeriesesAudits", testSeriesesAuditsExists)
 	t.Run("Tokens", testTokensExists)
+	t.Run("Translations", testTranslationsExists)
+	t.Run("TranslationsAudits", testTranslationsAuditsExists)
 	t.Run("Users", testUsersExists)
+	t.Run("UsersAudits", testUsersAuditsExists)
+	t.Run("WatchEvents", testWatchEventsExists)
+	t.Run("WatchfilmTags", testWatchfilmTagsExists)
 	t.Run("Watchfilms", testWatchfilmsExists)
+	t.Run("WatchfilmsAudits", testWatchfilmsAuditsExists)
+	t.Run("YearReviews", testYearReviewsExists)
 }
 
 func TestFind(t *testing.T) {
+	t.Run("AuditPrunes", testAuditPrunesFind)
+	t.Run("CatalogExports", testCatalogExportsFind)
+	t.Run("CollectionItems", testCollectionItemsFind)
+	t.Run("CollectionItemsAudits", testCollectionItemsAuditsFind)
+	t.Run("Collections", testCollectionsFind)
+	t.Run("CollectionsAudits", testCollectionsAuditsFind)
+	t.Run("ContentRatings", testContentRatingsFind)
+	t.Run("ContentRatingsAudits", testContentRatingsAuditsFind)
+	t.Run("Contributions", testContributionsFind)
+	t.Run("ContributorStats", testContributorStatsFind)
+	t.Run("ExternalIds", testExternalIdsFind)
+	t.Run("ExternalIdsAudits", testExternalIdsAuditsFind)
+	t.Run("FilmScoreAggregates", testFilmScoreAggregatesFind)
 	t.Run("Films", testFilmsFind)
 	t.Run("FilmsAudits", testFilmsAuditsFind)
+	t.Run("ImportErrors", testImportErrorsFind)
+	t.Run("ImportJobs", testImportJobsFind)
+	t.Run("ListActivities", testListActivitiesFind)
+	t.Run("ListInvites", testListInvitesFind)
+	t.Run("ListItems", testListItemsFind)
+	t.Run("ListMembers", testListMembersFind)
+	t.Run("Lists", testListsFind)
+	t.Run("MediaItems", testMediaItemsFind)
+	t.Run("MediaItemsAudits", testMediaItemsAuditsFind)
+	t.Run("PlaybackProgresses", testPlaybackProgressesFind)
+	t.Run("Releases", testReleasesFind)
+	t.Run("ReleasesAudits", testReleasesAuditsFind)
+	t.Run("ReviewVotes", testReviewVotesFind)
+	t.Run("Reviews", testReviewsFind)
+	t.Run("ReviewsAudits", testReviewsAuditsFind)
+	t.Run("Scores", testScoresFind)
+	t.Run("SeriesAggregates", testSeriesAggregatesFind)
+	t.Run("SeriesFollows", testSeriesFollowsFind)
+	t.Run("SeriesScoreAggregates", testSeriesScoreAggregatesFind)
 	t.Run("Serieses", testSeriesesFind)
 	t.Run("SeriesesAudits", testSeriesesAuditsFind)
 	t.Run("Tokens", testTokensFind)
+	t.Run("Translations", testTranslationsFind)
+	t.Run("TranslationsAudits", testTranslationsAuditsFind)
 	t.Run("Users", testUsersFind)
+	t.Run("UsersAudits", testUsersAuditsFind)
+	t.Run("WatchEvents", testWatchEventsFind)
+	t.Run("WatchfilmTags", testWatchfilmTagsFind)
 	t.Run("Watchfilms", testWatchfilmsFind)
+	t.Run("WatchfilmsAudits", testWatchfilmsAuditsFind)
+	t.Run("YearReviews", testYearReviewsFind)
 }
 
 func TestBind(t *testing.T) {
+	t.Run("AuditPrunes", testAuditPrunesBind)
+	t.Run("CatalogExports", testCatalogExportsBind)
+	t.Run("CollectionItems", testCollectionItemsBind)
+	t.Run("CollectionItemsAudits", testCollectionItemsAuditsBind)
+	t.Run("Collections", testCollectionsBind)
+	t.Run("CollectionsAudits", testCollectionsAuditsBind)
+	t.Run("ContentRatings", testContentRatingsBind)
+	t.Run("ContentRatingsAudits", testContentRatingsAuditsBind)
+	t.Run("Contributions", testContributionsBind)
+	t.Run("ContributorStats", testContributorStatsBind)
+	t.Run("ExternalIds", testExternalIdsBind)
+	t.Run("ExternalIdsAudits", testExternalIdsAuditsBind)
+	t.Run("FilmScoreAggregates", testFilmScoreAggregatesBind)
 	t.Run("Films", testFilmsBind)
 	t.Run("FilmsAudits", testFilmsAuditsBind)
+	t.Run("ImportErrors", testImportErrorsBind)
+	t.Run("ImportJobs", testImportJobsBind)
+	t.Run("ListActivities", testListActivitiesBind)
+	t.Run("ListInvites", testListInvitesBind)
+	t.Run("ListItems", testListItemsBind)
+	t.Run("ListMembers", testListMembersBind)
+	t.Run("Lists", testListsBind)
+	t.Run("MediaItems", testMediaItemsBind)
+	t.Run("MediaItemsAudits", testMediaItemsAuditsBind)
+	t.Run("PlaybackProgresses", testPlaybackProgressesBind)
+	t.Run("Releases", testReleasesBind)
+	t.Run("ReleasesAudits", testReleasesAuditsBind)
+	t.Run("ReviewVotes", testReviewVotesBind)
+	t.Run("Reviews", testReviewsBind)
+	t.Run("ReviewsAudits", testReviewsAuditsBind)
+	t.Run("Scores", testScoresBind)
+	t.Run("SeriesAggregates", testSeriesAggregatesBind)
+	t.Run("SeriesFollows", testSeriesFollowsBind)
+	t.Run("SeriesScoreAggregates", testSeriesScoreAggregatesBind)
 	t.Run("Serieses", testSeriesesBind)
 	t.Run("SeriesesAudits", testSeriesesAuditsBind)
 	t.Run("Tokens", testTokensBind)
+	t.Run("Translations", testTranslationsBind)
+	t.Run("TranslationsAudits", testTranslationsAuditsBind)
 	t.Run("Users", testUsersBind)
+	t.Run("UsersAudits", testUsersAuditsBind)
+	t.Run("WatchEvents", testWatchEventsBind)
+	t.Run("WatchfilmTags", testWatchfilmTagsBind)
 	t.Run("Watchfilms", testWatchfilmsBind)
+	t.Run("WatchfilmsAudits", testWatchfilmsAuditsBind)
+	t.Run("YearReviews", testYearReviewsBind)
 }
 
 func TestOne(t *testing.T) {
+	t.Run("AuditPrunes", testAuditPrunesOne)
+	t.Run("CatalogExports", testCatalogExportsOne)
+	t.Run("CollectionItems", testCollectionItemsOne)
+	t.Run("CollectionItemsAudits", testCollectionItemsAuditsOne)
+	t.Run("Collections", testCollectionsOne)
+	t.Run("CollectionsAudits", testCollectionsAuditsOne)
+	t.Run("ContentRatings", testContentRatingsOne)
+	t.Run("ContentRatingsAudits", testContentRatingsAuditsOne)
+	t.Run("Contributions", testContributionsOne)
+	t.Run("ContributorStats", testContributorStatsOne)
+	t.Run("ExternalIds", testExternalIdsOne)
+	t.Run("ExternalIdsAudits", testExternalIdsAuditsOne)
+	t.Run("FilmScoreAggregates", testFilmScoreAggregatesOne)
 	t.Run("Films", testFilmsOne)
 	t.Run("FilmsAudits", testFilmsAuditsOne)
+	t.Run("ImportErrors", testImportErrorsOne)
+	t.Run("ImportJobs", testImportJobsOne)
+	t.Run("ListActivities", testListActivitiesOne)
+	t.Run("ListInvites", testListInvitesOne)
+	t.Run("ListItems", testListItemsOne)
+	t.Run("ListMembers", testListMembersOne)
+	t.Run("Lists", testListsOne)
+	t.Run("MediaItems", testMediaItemsOne)
+	t.Run("MediaItemsAudits", testMediaItemsAuditsOne)
+	t.Run("PlaybackProgresses", testPlaybackProgressesOne)
+	t.Run("Releases", testReleasesOne)
+	t.Run("ReleasesAudits", testReleasesAuditsOne)
+	t.Run("ReviewVotes", testReviewVotesOne)
+	t.Run("Reviews", testReviewsOne)
+	t.Run("ReviewsAudits", testReviewsAuditsOne)
+	t.Run("Scores", testScoresOne)
+	t.Run("SeriesAggregates", testSeriesAggregatesOne)
+	t.Run("SeriesFollows", testSeriesFollowsOne)
+	t.Run("SeriesScoreAggregates", testSeriesScoreAggregatesOne)
 	t.Run("Serieses", testSeriesesOne)
 	t.Run("SeriesesAudits", testSeriesesAuditsOne)
 	t.Run("Tokens", testTokensOne)
+	t.Run("Translations", testTranslationsOne)
+	t.Run("TranslationsAudits", testTranslationsAuditsOne)
 	t.Run("Users", testUsersOne)
+	t.Run("UsersAudits", testUsersAuditsOne)
+	t.Run("WatchEvents", testWatchEventsOne)
+	t.Run("WatchfilmTags", testWatchfilmTagsOne)
 	t.Run("Watchfilms", testWatchfilmsOne)
+	t.Run("WatchfilmsAudits", testWatchfilmsAuditsOne)
+	t.Run("YearReviews", testYearReviewsOne)
 }
 
 func TestAll(t *testing.T) {
+	t.Run("AuditPrunes", testAuditPrunesAll)
+	t.Run("CatalogExports", testCatalogExportsAll)
+	t.Run("CollectionItems", testCollectionItemsAll)
+	t.Run("CollectionItemsAudits", testCollectionItemsAuditsAll)
+	t.Run("Collections", testCollectionsAll)
+	t.Run("CollectionsAudits", testCollectionsAuditsAll)
+	t.Run("ContentRatings", testContentRatingsAll)
+	t.Run("ContentRatingsAudits", testContentRatingsAuditsAll)
+	t.Run("Contributions", testContributionsAll)
+	t.Run("ContributorStats", testContributorStatsAll)
+	t.Run("ExternalIds", testExternalIdsAll)
+	t.Run("ExternalIdsAudits", testExternalIdsAuditsAll)
+	t.Run("FilmScoreAggregates", testFilmScoreAggregatesAll)
 	t.Run("Films", testFilmsAll)
 	t.Run("FilmsAudits", testFilmsAuditsAll)
+	t.Run("ImportErrors", testImportErrorsAll)
+	t.Run("ImportJobs", testImportJobsAll)
+	t.Run("ListActivities", testListActivitiesAll)
+	t.Run("ListInvites", testListInvitesAll)
+	t.Run("ListItems", testListItemsAll)
+	t.Run("ListMembers", testListMembersAll)
+	t.Run("Lists", testListsAll)
+	t.Run("MediaItems", testMediaItemsAll)
+	t.Run("MediaItemsAudits", testMediaItemsAuditsAll)
+	t.Run("PlaybackProgresses", testPlaybackProgressesAll)
+	t.Run("Releases", testReleasesAll)
+	t.Run("ReleasesAudits", testReleasesAuditsAll)
+	t.Run("ReviewVotes", testReviewVotesAll)
+	t.Run("Reviews", testReviewsAll)
+	t.Run("ReviewsAudits", testReviewsAuditsAll)
+	t.Run("Scores", testScoresAll)
+	t.Run("SeriesAggregates", testSeriesAggregatesAll)
+	t.Run("SeriesFollows", testSeriesFollowsAll)
+	t.Run("SeriesScoreAggregates", testSeriesScoreAggregatesAll)
 	t.Run("Serieses", testSeriesesAll)
 	t.Run("SeriesesAudits", testSeriesesAuditsAll)
 	t.Run("Tokens", testTokensAll)
+	t.Run("Translations", testTranslationsAll)
+	t.Run("TranslationsAudits", testTranslationsAuditsAll)
 	t.Run("Users", testUsersAll)
+	t.Run("UsersAudits", testUsersAuditsAll)
+	t.Run("WatchEvents", testWatchEventsAll)
+	t.Run("WatchfilmTags", testWatchfilmTagsAll)
 	t.Run("Watchfilms", testWatchfilmsAll)
+	t.Run("WatchfilmsAudits", testWatchfilmsAuditsAll)
+	t.Run("YearReviews", testYearReviewsAll)
 }
 
 func TestCount(t *testing.T) {
+	t.Run("AuditPrunes", testAuditPrunesCount)
+	t.Run("CatalogExports", testCatalogExportsCount)
+	t.Run("CollectionItems", testCollectionItemsCount)
+	t.Run("CollectionItemsAudits", testCollectionItemsAuditsCount)
+	t.Run("Collections", testCollectionsCount)
+	t.Run("CollectionsAudits", testCollectionsAuditsCount)
+	t.Run("ContentRatings", testContentRatingsCount)
+	t.Run("ContentRatingsAudits", testContentRatingsAuditsCount)
+	t.Run("Contributions", testContributionsCount)
+	t.Run("ContributorStats", testContributorStatsCount)
+	t.Run("ExternalIds", testExternalIdsCount)
+	t.Run("ExternalIdsAudits", testExternalIdsAuditsCount)
+	t.Run("FilmScoreAggregates", testFilmScoreAggregatesCount)
 	t.Run("Films", testFilmsCount)
 	t.Run("FilmsAudits", testFilmsAuditsCount)
+	t.Run("ImportErrors", testImportErrorsCount)
+	t.Run("ImportJobs", testImportJobsCount)
+	t.Run("ListActivities", testListActivitiesCount)
+	t.Run("ListInvites", testListInvitesCount)
+	t.Run("ListItems", testListItemsCount)
+	t.Run("ListMembers", testListMembersCount)
+	t.Run("Lists", testListsCount)
+	t.Run("MediaItems", testMediaItemsCount)
+	t.Run("MediaItemsAudits", testMediaItemsAuditsCount)
+	t.Run("PlaybackProgresses", testPlaybackProgressesCount)
+	t.Run("Releases", testReleasesCount)
+	t.Run("ReleasesAudits", testReleasesAuditsCount)
+	t.Run("ReviewVotes", testReviewVotesCount)
+	t.Run("Reviews", testReviewsCount)
+	t.Run("ReviewsAudits", testReviewsAuditsCount)
+	t.Run("Scores", testScoresCount)
+	t.Run("SeriesAggregates", testSeriesAggregatesCount)
+	t.Run("SeriesFollows", testSeriesFollowsCount)
+	t.Run("SeriesScoreAggregates", testSeriesScoreAggregatesCount)
 	t.Run("Serieses", testSeriesesCount)
 	t.Run("SeriesesAudits", testSeriesesAuditsCount)
 	t.Run("Tokens", testTokensCount)
+	t.Run("Translations", testTranslationsCount)
+	t.Run("TranslationsAudits", testTranslationsAuditsCount)
 	t.Run("Users", testUsersCount)
+	t.Run("UsersAudits", testUsersAuditsCount)
+	t.Run("WatchEvents", testWatchEventsCount)
+	t.Run("WatchfilmTags", testWatchfilmTagsCount)
 	t.Run("Watchfilms", testWatchfilmsCount)
+	t.Run("WatchfilmsAudits", testWatchfilmsAuditsCount)
+	t.Run("YearReviews", testYearReviewsCount)
 }
 
 func TestHooks(t *testing.T) {
+	t.Run("AuditPrunes", testAuditPrunesHooks)
+	t.Run("CatalogExports", testCatalogExportsHooks)
+	t.Run("CollectionItems", testCollectionItemsHooks)
+	t.Run("CollectionItemsAudits", testCollectionItemsAuditsHooks)
+	t.Run("Collections", testCollectionsHooks)
+	t.Run("CollectionsAudits", testCollectionsAuditsHooks)
+	t.Run("ContentRatings", testContentRatingsHooks)
+	t.Run("ContentRatingsAudits", testContentRatingsAuditsHooks)
+	t.Run("Contributions", testContributionsHooks)
+	t.Run("ContributorStats", testContributorStatsHooks)
+	t.Run("ExternalIds", testExternalIdsHooks)
+	t.Run("ExternalIdsAudits", testExternalIdsAuditsHooks)
+	t.Run("FilmScoreAggregates", testFilmScoreAggregatesHooks)
 	t.Run("Films", testFilmsHooks)
 	t.Run("FilmsAudits", testFilmsAuditsHooks)
+	t.Run("ImportErrors", testImportErrorsHooks)
+	t.Run("ImportJobs", testImportJobsHooks)
+	t.Run("ListActivities", testListActivitiesHooks)
+	t.Run("ListInvites", testListInvitesHooks)
+	t.Run("ListItems", testListItemsHooks)
+	t.Run("ListMembers", testListMembersHooks)
+	t.Run("Lists", testListsHooks)
+	t.Run("MediaItems", testMediaItemsHooks)
+	t.Run("MediaItemsAudits", testMediaItemsAuditsHooks)
+	t.Run("PlaybackProgresses", testPlaybackProgressesHooks)
+	t.Run("Releases", testReleasesHooks)
+	t.Run("ReleasesAudits", testReleasesAuditsHooks)
+	t.Run("ReviewVotes", testReviewVotesHooks)
+	t.Run("Reviews", testReviewsHooks)
+	t.Run("ReviewsAudits", testReviewsAuditsHooks)
+	t.Run("Scores", testScoresHooks)
+	t.Run("SeriesAggregates", testSeriesAggregatesHooks)
+	t.Run("SeriesFollows", testSeriesFollowsHooks)
+	t.Run("SeriesScoreAggregates", testSeriesScoreAggregatesHooks)
 	t.Run("Serieses", testSeriesesHooks)
 	t.Run("SeriesesAudits", testSeriesesAuditsHooks)
 	t.Run("Tokens", testTokensHooks)
+	t.Run("Translations", testTranslationsHooks)
+	t.Run("TranslationsAudits", testTranslationsAuditsHooks)
 	t.Run("Users", testUsersHooks)
+	t.Run("UsersAudits", testUsersAuditsHooks)
+	t.Run("WatchEvents", testWatchEventsHooks)
+	t.Run("WatchfilmTags", testWatchfilmTagsHooks)
 	t.Run("Watchfilms", testWatchfilmsHooks)
+	t.Run("WatchfilmsAudits", testWatchfilmsAuditsHooks)
+	t.Run("YearReviews", testYearReviewsHooks)
 }
 
 func TestInsert(t *testing.T) {
+	t.Run("AuditPrunes", testAuditPrunesInsert)
+	t.Run("AuditPrunes", testAuditPrunesInsertWhitelist)
+	t.Run("CatalogExports", testCatalogExportsInsert)
+	t.Run("CatalogExports", testCatalogExportsInsertWhitelist)
+	t.Run("CollectionItems", testCollectionItemsInsert)
+	t.Run("CollectionItems", testCollectionItemsInsertWhitelist)
+	t.Run("CollectionItemsAudits", testCollectionItemsAuditsInsert)
+	t.Run("CollectionItemsAudits", testCollectionItemsAuditsInsertWhitelist)
+	t.Run("Collections", testCollectionsInsert)
+	t.Run("Collections", testCollectionsInsertWhitelist)
+	t.Run("CollectionsAudits", testCollectionsAuditsInsert)
+	t.Run("CollectionsAudits", testCollectionsAuditsInsertWhitelist)
+	t.Run("ContentRatings", testContentRatingsInsert)
+	t.Run("ContentRatings", testContentRatingsInsertWhitelist)
+	t.Run("ContentRatingsAudits", testContentRatingsAuditsInsert)
+	t.Run("ContentRatingsAudits", testContentRatingsAuditsInsertWhitelist)
+	t.Run("Contributions", testContributionsInsert)
+	t.Run("Contributions", testContributionsInsertWhitelist)
+	t.Run("ContributorStats", testContributorStatsInsert)
+	t.Run("ContributorStats", testContributorStatsInsertWhitelist)
+	t.Run("ExternalIds", testExternalIdsInsert)
+	t.Run("ExternalIds", testExternalIdsInsertWhitelist)
+	t.Run("ExternalIdsAudits", testExternalIdsAuditsInsert)
+	t.Run("ExternalIdsAudits", testExternalIdsAuditsInsertWhitelist)
+	t.Run("FilmScoreAggregates", testFilmScoreAggregatesInsert)
+	t.Run("FilmScoreAggregates", testFilmScoreAggregatesInsertWhitelist)
 	t.Run("Films", testFilmsInsert)
 	t.Run("Films", testFilmsInsertWhitelist)
 	t.Run("FilmsAudits", testFilmsAuditsInsert)
 	t.Run("FilmsAudits", testFilmsAuditsInsertWhitelist)
+	t.Run("ImportErrors", testImportErrorsInsert)
+	t.Run("ImportErrors", testImportErrorsInsertWhitelist)
+	t.Run("ImportJobs", testImportJobsInsert)
+	t.Run("ImportJobs", testImportJobsInsertWhitelist)
+	t.Run("ListActivities", testListActivitiesInsert)
+	t.Run("ListActivities", testListActivitiesInsertWhitelist)
+	t.Run("ListInvites", testListInvitesInsert)
+	t.Run("ListInvites", testListInvitesInsertWhitelist)
+	t.Run("ListItems", testListItemsInsert)
+	t.Run("ListItems", testListItemsInsertWhitelist)
+	t.Run("ListMembers", testListMembersInsert)
+	t.Run("ListMembers", testListMembersInsertWhitelist)
+	t.Run("Lists", testListsInsert)
+	t.Run("Lists", testListsInsertWhitelist)
+	t.Run("MediaItems", testMediaItemsInsert)
+	t.Run("MediaItems", testMediaItemsInsertWhitelist)
+	t.Run("MediaItemsAudits", testMediaItemsAuditsInsert)
+	t.Run("MediaItemsAudits", testMediaItemsAuditsInsertWhitelist)
+	t.Run("PlaybackProgresses", testPlaybackProgressesInsert)
+	t.Run("PlaybackProgresses", testPlaybackProgressesInsertWhitelist)
+	t.Run("Releases", testReleasesInsert)
+	t.Run("Releases", testReleasesInsertWhitelist)
+	t.Run("ReleasesAudits", testReleasesAuditsInsert)
+	t.Run("ReleasesAudits", testReleasesAuditsInsertWhitelist)
+	t.Run("ReviewVotes", testReviewVotesInsert)
+	t.Run("ReviewVotes", testReviewVotesInsertWhitelist)
+	t.Run("Reviews", testReviewsInsert)
+	t.Run("Reviews", testReviewsInsertWhitelist)
+	t.Run("ReviewsAudits", testReviewsAuditsInsert)
+	t.Run("ReviewsAudits", testReviewsAuditsInsertWhitelist)
+	t.Run("Scores", testScoresInsert)
+	t.Run("Scores", testScoresInsertWhitelist)
+	t.Run("SeriesAggregates", testSeriesAggregatesInsert)
+	t.Run("SeriesAggregates", testSeriesAggregatesInsertWhitelist)
+	t.Run("SeriesFollows", testSeriesFollowsInsert)
+	t.Run("SeriesFollows", testSeriesFollowsInsertWhitelist)
+	t.Run("SeriesScoreAggregates", testSeriesScoreAggregatesInsert)
+	t.Run("SeriesScoreAggregates", testSeriesScoreAggregatesInsertWhitelist)
 	t.Run("Serieses", testSeriesesInsert)
 	t.Run("Serieses", testSeriesesInsertWhitelist)
 	t.Run("SeriesesAudits", testSeriesesAuditsInsert)
 	t.Run("SeriesesAudits", testSeriesesAuditsInsertWhitelist)
 	t.Run("Tokens", testTokensInsert)
 	t.Run("Tokens", testTokensInsertWhitelist)
+	t.Run("Translations", testTranslationsInsert)
+	t.Run("Translations", testTranslationsInsertWhitelist)
+	t.Run("TranslationsAudits", testTranslationsAuditsInsert)
+	t.Run("TranslationsAudits", testTranslationsAuditsInsertWhitelist)
 	t.Run("Users", testUsersInsert)
 	t.Run("Users", testUsersInsertWhitelist)
+	t.Run("UsersAudits", testUsersAuditsInsert)
+	t.Run("UsersAudits", testUsersAuditsInsertWhitelist)
+	t.Run("WatchEvents", testWatchEventsInsert)
+	t.Run("WatchEvents", testWatchEventsInsertWhitelist)
+	t.Run("WatchfilmTags", testWatchfilmTagsInsert)
+	t.Run("WatchfilmTags", testWatchfilmTagsInsertWhitelist)
 	t.Run("Watchfilms", testWatchfilmsInsert)
 	t.Run("Watchfilms", testWatchfilmsInsertWhitelist)
+	t.Run("WatchfilmsAudits", testWatchfilmsAuditsInsert)
+	t.Run("WatchfilmsAudits", testWatchfilmsAuditsInsertWhitelist)
+	t.Run("YearReviews", testYearReviewsInsert)
+	t.Run("YearReviews", testYearReviewsInsertWhitelist)
 }
 
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
+	t.Run("CollectionItemToUserUsingContributingUser", testCollectionItemToOneUserUsingContributingUser)
+	t.Run("CollectionItemToCollectionUsingCollection", testCollectionItemToOneCollectionUsingCollection)
+	t.Run("CollectionItemToFilmUsingFilm", testCollectionItemToOneFilmUsingFilm)
+	t.Run("CollectionItemToSeriesUsingSeries", testCollectionItemToOneSeriesUsingSeries)
+	t.Run("CollectionToUserUsingContributingUser", testCollectionToOneUserUsingContributingUser)
+	t.Run("ContentRatingToUserUsingContributingUser", testContentRatingToOneUserUsingContributingUser)
+	t.Run("ContentRatingToFilmUsingFilm", testContentRatingToOneFilmUsingFilm)
+	t.Run("ContributionToUserUsingUser", testContributionToOneUserUsingUser)
+	t.Run("ContributorStatToUserUsingUser", testContributorStatToOneUserUsingUser)
+	t.Run("ExternalIDToUserUsingContributingUser", testExternalIDToOneUserUsingContributingUser)
+	t.Run("ExternalIDToFilmUsingFilm", testExternalIDToOneFilmUsingFilm)
+	t.Run("ExternalIDToSeriesUsingSeries", testExternalIDToOneSeriesUsingSeries)
+	t.Run("FilmScoreAggregateToFilmUsingFilm", testFilmScoreAggregateToOneFilmUsingFilm)
 	t.Run("FilmToUserUsingContributingUser", testFilmToOneUserUsingContributingUser)
 	t.Run("FilmToSeriesUsingSeries", testFilmToOneSeriesUsingSeries)
+	t.Run("ImportErrorToImportJobUsingJob", testImportErrorToOneImportJobUsingJob)
+	t.Run("ImportJobToUserUsingUser", testImportJobToOneUserUsingUser)
+	t.Run("ListActivityToFilmUsingFilm", testListActivityToOneFilmUsingFilm)
+	t.Run("ListActivityToListUsingList", testListActivityToOneListUsingList)
+	t.Run("ListActivityToSeriesUsingSeries", testListActivityToOneSeriesUsingSeries)
+	t.Run("ListActivityToUserUsingUser", testListActivityToOneUserUsingUser)
+	t.Run("ListActivityToUserUsingMember", testListActivityToOneUserUsingMember)
+	t.Run("ListInviteToListUsingList", testListInviteToOneListUsingList)
+	t.Run("ListInviteToUserUsingUser", testListInviteToOneUserUsingUser)
+	t.Run("ListInviteToUserUsingInvitedByUser", testListInviteToOneUserUsingInvitedByUser)
+	t.Run("ListItemToFilmUsingFilm", testListItemToOneFilmUsingFilm)
+	t.Run("ListItemToListUsingList", testListItemToOneListUsingList)
+	t.Run("ListItemToSeriesUsingSeries", testListItemToOneSeriesUsingSeries)
+	t.Run("ListItemToUserUsingAddedByUser", testListItemToOneUserUsingAddedByUser)
+	t.Run("ListMemberToListUsingList", testListMemberToOneListUsingList)
+	t.Run("ListMemberToUserUsingUser", testListMemberToOneUserUsingUser)
+	t.Run("ListToUserUsingUser", testListToOneUserUsingUser)
+	t.Run("MediaItemToUserUsingContributingUser", testMediaItemToOneUserUsingContributingUser)
+	t.Run("MediaItemToFilmUsingFilm", testMediaItemToOneFilmUsingFilm)
+	t.Run("MediaItemToSeriesUsingSeries", testMediaItemToOneSeriesUsingSeries)
+	t.Run("PlaybackProgressToFilmUsingFilm", testPlaybackProgressToOneFilmUsingFilm)
+	t.Run("PlaybackProgressToUserUsingUser", testPlaybackProgressToOneUserUsingUser)
+	t.Run("ReleaseToUserUsingContributingUser", testReleaseToOneUserUsingContributingUser)
+	t.Run("ReleaseToFilmUsingFilm", testReleaseToOneFilmUsingFilm)
+	t.Run("ReviewVoteToReviewUsingReview", testReviewVoteToOneReviewUsingReview)
+	t.Run("ReviewVoteToUserUsingUser", testReviewVoteToOneUserUsingUser)
+	t.Run("ReviewToFilmUsingFilm", testReviewToOneFilmUsingFilm)
+	t.Run("ReviewToSeriesUsingSeries", testReviewToOneSeriesUsingSeries)
+	t.Run("ReviewToUserUsingUser", testReviewToOneUserUsingUser)
+	t.Run("ScoreToFilmUsingFilm", testScoreToOneFilmUsingFilm)
+	t.Run("ScoreToSeriesUsingSeries", testScoreToOneSeriesUsingSeries)
+	t.Run("ScoreToUserUsingUser", testScoreToOneUserUsingUser)
+	t.Run("SeriesAggregateToSeriesUsingSeries", testSeriesAggregateToOneSeriesUsingSeries)
+	t.Run("SeriesFollowToSeriesUsingSeries", testSeriesFollowToOneSeriesUsingSeries)
+	t.Run("SeriesFollowToUserUsingUser", testSeriesFollowToOneUserUsingUser)
+	t.Run("SeriesScoreAggregateToSeriesUsingSeries", testSeriesScoreAggregateToOneSeriesUsingSeries)
 	t.Run("SeriesToUserUsingContributingUser", testSeriesToOneUserUsingContributingUser)
 	t.Run("TokenToUserUsingUser", testTokenToOneUserUsingUser)
+	t.Run("TranslationToUserUsingContributingUser", testTranslationToOneUserUsingContributingUser)
+	t.Run("TranslationToFilmUsingFilm", testTranslationToOneFilmUsingFilm)
+	t.Run("TranslationToSeriesUsingSeries", testTranslationToOneSeriesUsingSeries)
+	t.Run("WatchEventToWatchfilmUsingWatch", testWatchEventToOneWatchfilmUsingWatch)
+	t.Run("WatchfilmTagToWatchfilmUsingWatch", testWatchfilmTagToOneWatchfilmUsingWatch)
 	t.Run("WatchfilmToFilmUsingFilm", testWatchfilmToOneFilmUsingFilm)
 	t.Run("WatchfilmToUserUsingUser", testWatchfilmToOneUserUsingUser)
+	t.Run("YearReviewToUserUsingUser", testYearReviewToOneUserUsingUser)
 }
 
 // TestOneToOne tests cannot be run in parallel
@@ -156,29 +718,161 @@ func TestOneToOne(t *testing.T) {}
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
+	t.Run("CollectionToCollectionItems", testCollectionToManyCollectionItems)
+	t.Run("FilmToCollectionItems", testFilmToManyCollectionItems)
+	t.Run("FilmToContentRatings", testFilmToManyContentRatings)
+	t.Run("FilmToExternalIds", testFilmToManyExternalIds)
+	t.Run("FilmToFilmScoreAggregates", testFilmToManyFilmScoreAggregates)
+	t.Run("FilmToListActivities", testFilmToManyListActivities)
+	t.Run("FilmToListItems", testFilmToManyListItems)
+	t.Run("FilmToMediaItems", testFilmToManyMediaItems)
+	t.Run("FilmToPlaybackProgresses", testFilmToManyPlaybackProgresses)
+	t.Run("FilmToReleases", testFilmToManyReleases)
+	t.Run("FilmToReviews", testFilmToManyReviews)
+	t.Run("FilmToScores", testFilmToManyScores)
+	t.Run("FilmToTranslations", testFilmToManyTranslations)
 	t.Run("FilmToWatchfilms", testFilmToManyWatchfilms)
+	t.Run("ImportJobToJobImportErrors", testImportJobToManyJobImportErrors)
+	t.Run("ListToListActivities", testListToManyListActivities)
+	t.Run("ListToListInvites", testListToManyListInvites)
+	t.Run("ListToListItems", testListToManyListItems)
+	t.Run("ListToListMembers", testListToManyListMembers)
+	t.Run("ReviewToReviewVotes", testReviewToManyReviewVotes)
+	t.Run("SeriesToSeriesCollectionItems", testSeriesToManySeriesCollectionItems)
+	t.Run("SeriesToSeriesExternalIds", testSeriesToManySeriesExternalIds)
 	t.Run("SeriesToSeriesFilms", testSeriesToManySeriesFilms)
+	t.Run("SeriesToSeriesListActivities", testSeriesToManySeriesListActivities)
+	t.Run("SeriesToSeriesListItems", testSeriesToManySeriesListItems)
+	t.Run("SeriesToSeriesMediaItems", testSeriesToManySeriesMediaItems)
+	t.Run("SeriesToSeriesReviews", testSeriesToManySeriesReviews)
+	t.Run("SeriesToSeriesScores", testSeriesToManySeriesScores)
+	t.Run("SeriesToSeriesSeriesAggregates", testSeriesToManySeriesSeriesAggregates)
+	t.Run("SeriesToSeriesSeriesFollows", testSeriesToManySeriesSeriesFollows)
+	t.Run("SeriesToSeriesSeriesScoreAggregates", testSeriesToManySeriesSeriesScoreAggregates)
+	t.Run("SeriesToSeriesTranslations", testSeriesToManySeriesTranslations)
+	t.Run("UserToContributedCollectionItems", testUserToManyContributedCollectionItems)
+	t.Run("UserToContributedCollections", testUserToManyContributedCollections)
+	t.Run("UserToContributedContentRatings", testUserToManyContributedContentRatings)
+	t.Run("UserToContributions", testUserToManyContributions)
+	t.Run("UserToContributorStats", testUserToManyContributorStats)
+	t.Run("UserToContributedExternalIds", testUserToManyContributedExternalIds)
 	t.Run("UserToContributedFilms", testUserToManyContributedFilms)
+	t.Run("UserToImportJobs", testUserToManyImportJobs)
+	t.Run("UserToListActivities", testUserToManyListActivities)
+	t.Run("UserToMemberListActivities", testUserToManyMemberListActivities)
+	t.Run("UserToListInvites", testUserToManyListInvites)
+	t.Run("UserToInvitedByListInvites", testUserToManyInvitedByListInvites)
+	t.Run("UserToAddedByListItems", testUserToManyAddedByListItems)
+	t.Run("UserToListMembers", testUserToManyListMembers)
+	t.Run("UserToLists", testUserToManyLists)
+	t.Run("UserToContributedMediaItems", testUserToManyContributedMediaItems)
+	t.Run("UserToPlaybackProgresses", testUserToManyPlaybackProgresses)
+	t.Run("UserToContributedReleases", testUserToManyContributedReleases)
+	t.Run("UserToReviewVotes", testUserToManyReviewVotes)
+	t.Run("UserToReviews", testUserToManyReviews)
+	t.Run("UserToScores", testUserToManyScores)
+	t.Run("UserToSeriesFollows", testUserToManySeriesFollows)
 	t.Run("UserToContributedSerieses", testUserToManyContributedSerieses)
 	t.Run("UserToTokens", testUserToManyTokens)
+	t.Run("UserToContributedTranslations", testUserToManyContributedTranslations)
 	t.Run("UserToWatchfilms", testUserToManyWatchfilms)
+	t.Run("UserToYearReviews", testUserToManyYearReviews)
+	t.Run("WatchfilmToWatchWatchEvents", testWatchfilmToManyWatchWatchEvents)
+	t.Run("WatchfilmToWatchWatchfilmTags", testWatchfilmToManyWatchWatchfilmTags)
 }
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
+	t.Run("CollectionItemToUserUsingContributedCollectionItems", testCollectionItemToOneSetOpUserUsingContributingUser)
+	t.Run("CollectionItemToCollectionUsingCollectionItems", testCollectionItemToOneSetOpCollectionUsingCollection)
+	t.Run("CollectionItemToFilmUsingCollectionItems", testCollectionItemToOneSetOpFilmUsingFilm)
+	t.Run("CollectionItemToSeriesUsingSeriesCollectionItems", testCollectionItemToOneSetOpSeriesUsingSeries)
+	t.Run("CollectionToUserUsingContributedCollections", testCollectionToOneSetOpUserUsingContributingUser)
+	t.Run("ContentRatingToUserUsingContributedContentRatings", testContentRatingToOneSetOpUserUsingContributingUser)
+	t.Run("ContentRatingToFilmUsingContentRatings", testContentRatingToOneSetOpFilmUsingFilm)
+	t.Run("ContributionToUserUsingContributions", testContributionToOneSetOpUserUsingUser)
+	t.Run("ContributorStatToUserUsingContributorStats", testContributorStatToOneSetOpUserUsingUser)
+	t.Run("ExternalIDToUserUsingContributedExternalIds", testExternalIDToOneSetOpUserUsingContributingUser)
+	t.Run("ExternalIDToFilmUsingExternalIds", testExternalIDToOneSetOpFilmUsingFilm)
+	t.Run("ExternalIDToSeriesUsingSeriesExternalIds", testExternalIDToOneSetOpSeriesUsingSeries)
+	t.Run("FilmScoreAggregateToFilmUsingFilmScoreAggregates", testFilmScoreAggregateToOneSetOpFilmUsingFilm)
 	t.Run("FilmToUserUsingContributedFilms", testFilmToOneSetOpUserUsingContributingUser)
 	t.Run("FilmToSeriesUsingSeriesFilms", testFilmToOneSetOpSeriesUsingSeries)
+	t.Run("ImportErrorToImportJobUsingJobImportErrors", testImportErrorToOneSetOpImportJobUsingJob)
+	t.Run("ImportJobToUserUsingImportJobs", testImportJobToOneSetOpUserUsingUser)
+	t.Run("ListActivityToFilmUsingListActivities", testListActivityToOneSetOpFilmUsingFilm)
+	t.Run("ListActivityToListUsingListActivities", testListActivityToOneSetOpListUsingList)
+	t.Run("ListActivityToSeriesUsingSeriesListActivities", testListActivityToOneSetOpSeriesUsingSeries)
+	t.Run("ListActivityToUserUsingListActivities", testListActivityToOneSetOpUserUsingUser)
+	t.Run("ListActivityToUserUsingMemberListActivities", testListActivityToOneSetOpUserUsingMember)
+	t.Run("ListInviteToListUsingListInvites", testListInviteToOneSetOpListUsingList)
+	t.Run("ListInviteToUserUsingListInvites", testListInviteToOneSetOpUserUsingUser)
+	t.Run("ListInviteToUserUsingInvitedByListInvites", testListInviteToOneSetOpUserUsingInvitedByUser)
+	t.Run("ListItemToFilmUsingListItems", testListItemToOneSetOpFilmUsingFilm)
+	t.Run("ListItemToListUsingListItems", testListItemToOneSetOpListUsingList)
+	t.Run("ListItemToSeriesUsingSeriesListItems", testListItemToOneSetOpSeriesUsingSeries)
+	t.Run("ListItemToUserUsingAddedByListItems", testListItemToOneSetOpUserUsingAddedByUser)
+	t.Run("ListMemberToListUsingListMembers", testListMemberToOneSetOpListUsingList)
+	t.Run("ListMemberToUserUsingListMembers", testListMemberToOneSetOpUserUsingUser)
+	t.Run("ListToUserUsingLists", testListToOneSetOpUserUsingUser)
+	t.Run("MediaItemToUserUsingContributedMediaItems", testMediaItemToOneSetOpUserUsingContributingUser)
+	t.Run("MediaItemToFilmUsingMediaItems", testMediaItemToOneSetOpFilmUsingFilm)
+	t.Run("MediaItemToSeriesUsingSeriesMediaItems", testMediaItemToOneSetOpSeriesUsingSeries)
+	t.Run("PlaybackProgressToFilmUsingPlaybackProgresses", testPlaybackProgressToOneSetOpFilmUsingFilm)
+	t.Run("PlaybackProgressToUserUsingPlaybackProgresses", testPlaybackProgressToOneSetOpUserUsingUser)
+	t.Run("ReleaseToUserUsingContributedReleases", testReleaseToOneSetOpUserUsingContributingUser)
+	t.Run("ReleaseToFilmUsingReleases", testReleaseToOneSetOpFilmUsingFilm)
+	t.Run("ReviewVoteToReviewUsingReviewVotes", testReviewVoteToOneSetOpReviewUsingReview)
+	t.Run("ReviewVoteToUserUsingReviewVotes", testReviewVoteToOneSetOpUserUsingUser)
+	t.Run("ReviewToFilmUsingReviews", testReviewToOneSetOpFilmUsingFilm)
+	t.Run("ReviewToSeriesUsingSeriesReviews", testReviewToOneSetOpSeriesUsingSeries)
+	t.Run("ReviewToUserUsingReviews", testReviewToOneSetOpUserUsingUser)
+	t.Run("ScoreToFilmUsingScores", testScoreToOneSetOpFilmUsingFilm)
+	t.Run("ScoreToSeriesUsingSeriesScores", testScoreToOneSetOpSeriesUsingSeries)
+	t.Run("ScoreToUserUsingScores", testScoreToOneSetOpUserUsingUser)
+	t.Run("SeriesAggregateToSeriesUsingSeriesSeriesAggregates", testSeriesAggregateToOneSetOpSeriesUsingSeries)
+	t.Run("SeriesFollowToSeriesUsingSeriesSeriesFollows", testSeriesFollowToOneSetOpSeriesUsingSeries)
+	t.Run("SeriesFollowToUserUsingSeriesFollows", testSeriesFollowToOneSetOpUserUsingUser)
+	t.Run("SeriesScoreAggregateToSeriesUsingSeriesSeriesScoreAggregates", testSeriesScoreAggregateToOneSetOpSeriesUsingSeries)
 	t.Run("SeriesToUserUsingContributedSerieses", testSeriesToOneSetOpUserUsingContributingUser)
 	t.Run("TokenToUserUsingTokens", testTokenToOneSetOpUserUsingUser)
+	t.Run("TranslationToUserUsingContributedTranslations", testTranslationToOneSetOpUserUsingContributingUser)
+	t.Run("TranslationToFilmUsingTranslations", testTranslationToOneSetOpFilmUsingFilm)
+	t.Run("TranslationToSeriesUsingSeriesTranslations", testTranslationToOneSetOpSeriesUsingSeries)
+	t.Run("WatchEventToWatchfilmUsingWatchWatchEvents", testWatchEventToOneSetOpWatchfilmUsingWatch)
+	t.Run("WatchfilmTagToWatchfilmUsingWatchWatchfilmTags", testWatchfilmTagToOneSetOpWatchfilmUsingWatch)
 	t.Run("WatchfilmToFilmUsingWatchfilms", testWatchfilmToOneSetOpFilmUsingFilm)
 	t.Run("WatchfilmToUserUsingWatchfilms", testWatchfilmToOneSetOpUserUsingUser)
+	t.Run("YearReviewToUserUsingYearReviews", testYearReviewToOneSetOpUserUsingUser)
 }
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneRemove(t *testing.T) {
+	t.Run("CollectionItemToFilmUsingCollectionItems", testCollectionItemToOneRemoveOpFilmUsingFilm)
+	t.Run("CollectionItemToSeriesUsingSeriesCollectionItems", testCollectionItemToOneRemoveOpSeriesUsingSeries)
+	t.Run("ContributionToUserUsingContributions", testContributionToOneRemoveOpUserUsingUser)
+	t.Run("ExternalIDToFilmUsingExternalIds", testExternalIDToOneRemoveOpFilmUsingFilm)
+	t.Run("ExternalIDToSeriesUsingSeriesExternalIds", testExternalIDToOneRemoveOpSeriesUsingSeries)
 	t.Run("FilmToSeriesUsingSeriesFilms", testFilmToOneRemoveOpSeriesUsingSeries)
+	t.Run("ListActivityToFilmUsingListActivities", testListActivityToOneRemoveOpFilmUsingFilm)
+	t.Run("ListActivityToSeriesUsingSeriesListActivities", testListActivityToOneRemoveOpSeriesUsingSeries)
+	t.Run("ListActivityToUserUsingListActivities", testListActivityToOneRemoveOpUserUsingUser)
+	t.Run("ListActivityToUserUsingMemberListActivities", testListActivityToOneRemoveOpUserUsingMember)
+	t.Run("ListInviteToUserUsingListInvites", testListInviteToOneRemoveOpUserUsingUser)
+	t.Run("ListInviteToUserUsingInvitedByListInvites", testListInviteToOneRemoveOpUserUsingInvitedByUser)
+	t.Run("ListItemToFilmUsingListItems", testListItemToOneRemoveOpFilmUsingFilm)
+	t.Run("ListItemToSeriesUsingSeriesListItems", testListItemToOneRemoveOpSeriesUsingSeries)
+	t.Run("ListItemToUserUsingAddedByListItems", testListItemToOneRemoveOpUserUsingAddedByUser)
+	t.Run("MediaItemToFilmUsingMediaItems", testMediaItemToOneRemoveOpFilmUsingFilm)
+	t.Run("MediaItemToSeriesUsingSeriesMediaItems", testMediaItemToOneRemoveOpSeriesUsingSeries)
+	t.Run("ReviewToFilmUsingReviews", testReviewToOneRemoveOpFilmUsingFilm)
+	t.Run("ReviewToSeriesUsingSeriesReviews", testReviewToOneRemoveOpSeriesUsingSeries)
+	t.Run("ScoreToFilmUsingScores", testScoreToOneRemoveOpFilmUsingFilm)
+	t.Run("ScoreToSeriesUsingSeriesScores", testScoreToOneRemoveOpSeriesUsingSeries)
+	t.Run("TranslationToFilmUsingTranslations", testTranslationToOneRemoveOpFilmUsingFilm)
+	t.Run("TranslationToSeriesUsingSeriesTranslations", testTranslationToOneRemoveOpSeriesUsingSeries)
 }
 
 // TestOneToOneSet tests cannot be run in parallel
@@ -192,72 +886,366 @@ func TestOneToOneRemove(t *testing.T) {}
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
+	t.Run("CollectionToCollectionItems", testCollectionToManyAddOpCollectionItems)
+	t.Run("FilmToCollectionItems", testFilmToManyAddOpCollectionItems)
+	t.Run("FilmToContentRatings", testFilmToManyAddOpContentRatings)
+	t.Run("FilmToExternalIds", testFilmToManyAddOpExternalIds)
+	t.Run("FilmToFilmScoreAggregates", testFilmToManyAddOpFilmScoreAggregates)
+	t.Run("FilmToListActivities", testFilmToManyAddOpListActivities)
+	t.Run("FilmToListItems", testFilmToManyAddOpListItems)
+	t.Run("FilmToMediaItems", testFilmToManyAddOpMediaItems)
+	t.Run("FilmToPlaybackProgresses", testFilmToManyAddOpPlaybackProgresses)
+	t.Run("FilmToReleases", testFilmToManyAddOpReleases)
+	t.Run("FilmToReviews", testFilmToManyAddOpReviews)
+	t.Run("FilmToScores", testFilmToManyAddOpScores)
+	t.Run("FilmToTranslations", testFilmToManyAddOpTranslations)
 	t.Run("FilmToWatchfilms", testFilmToManyAddOpWatchfilms)
+	t.Run("ImportJobToJobImportErrors", testImportJobToManyAddOpJobImportErrors)
+	t.Run("ListToListActivities", testListToManyAddOpListActivities)
+	t.Run("ListToListInvites", testListToManyAddOpListInvites)
+	t.Run("ListToListItems", testListToManyAddOpListItems)
+	t.Run("ListToListMembers", testListToManyAddOpListMembers)
+	t.Run("ReviewToReviewVotes", testReviewToManyAddOpReviewVotes)
+	t.Run("SeriesToSeriesCollectionItems", testSeriesToManyAddOpSeriesCollectionItems)
+	t.Run("SeriesToSeriesExternalIds", testSeriesToManyAddOpSeriesExternalIds)
 	t.Run("SeriesToSeriesFilms", testSeriesToManyAddOpSeriesFilms)
+	t.Run("SeriesToSeriesListActivities", testSeriesToManyAddOpSeriesListActivities)
+	t.Run("SeriesToSeriesListItems", testSeriesToManyAddOpSeriesListItems)
+	t.Run("SeriesToSeriesMediaItems", testSeriesToManyAddOpSeriesMediaItems)
+	t.Run("SeriesToSeriesReviews", testSeriesToManyAddOpSeriesReviews)
+	t.Run("SeriesToSeriesScores", testSeriesToManyAddOpSeriesScores)
+	t.Run("SeriesToSeriesSeriesAggregates", testSeriesToManyAddOpSeriesSeriesAggregates)
+	t.Run("SeriesToSeriesSeriesFollows", testSeriesToManyAddOpSeriesSeriesFollows)
+	t.Run("SeriesToSeriesSeriesScoreAggregates", testSeriesToManyAddOpSeriesSeriesScoreAggregates)
+	t.Run("SeriesToSeriesTranslations", testSeriesToManyAddOpSeriesTranslations)
+	t.Run("UserToContributedCollectionItems", testUserToManyAddOpContributedCollectionItems)
+	t.Run("UserToContributedCollections", testUserToManyAddOpContributedCollections)
+	t.Run("UserToContributedContentRatings", testUserToManyAddOpContributedContentRatings)
+	t.Run("UserToContributions", testUserToManyAddOpContributions)
+	t.Run("UserToContributorStats", testUserToManyAddOpContributorStats)
+	t.Run("UserToContributedExternalIds", testUserToManyAddOpContributedExternalIds)
 	t.Run("UserToContributedFilms", testUserToManyAddOpContributedFilms)
+	t.Run("UserToImportJobs", testUserToManyAddOpImportJobs)
+	t.Run("UserToListActivities", testUserToManyAddOpListActivities)
+	t.Run("UserToMemberListActivities", testUserToManyAddOpMemberListActivities)
+	t.Run("UserToListInvites", testUserToManyAddOpListInvites)
+	t.Run("UserToInvitedByListInvites", testUserToManyAddOpInvitedByListInvites)
+	t.Run("UserToAddedByListItems", testUserToManyAddOpAddedByListItems)
+	t.Run("UserToListMembers", testUserToManyAddOpListMembers)
+	t.Run("UserToLists", testUserToManyAddOpLists)
+	t.Run("UserToContributedMediaItems", testUserToManyAddOpContributedMediaItems)
+	t.Run("UserToPlaybackProgresses", testUserToManyAddOpPlaybackProgresses)
+	t.Run("UserToContributedReleases", testUserToManyAddOpContributedReleases)
+	t.Run("UserToReviewVotes", testUserToManyAddOpReviewVotes)
+	t.Run("UserToReviews", testUserToManyAddOpReviews)
+	t.Run("UserToScores", testUserToManyAddOpScores)
+	t.Run("UserToSeriesFollows", testUserToManyAddOpSeriesFollows)
 	t.Run("UserToContributedSerieses", testUserToManyAddOpContributedSerieses)
 	t.Run("UserToTokens", testUserToManyAddOpTokens)
+	t.Run("UserToContributedTranslations", testUserToManyAddOpContributedTranslations)
 	t.Run("UserToWatchfilms", testUserToManyAddOpWatchfilms)
+	t.Run("UserToYearReviews", testUserToManyAddOpYearReviews)
+	t.Run("WatchfilmToWatchWatchEvents", testWatchfilmToManyAddOpWatchWatchEvents)
+	t.Run("WatchfilmToWatchWatchfilmTags", testWatchfilmToManyAddOpWatchWatchfilmTags)
 }
 
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManySet(t *testing.T) {
+	t.Run("FilmToCollectionItems", testFilmToManySetOpCollectionItems)
+	t.Run("FilmToExternalIds", testFilmToManySetOpExternalIds)
+	t.Run("FilmToListActivities", testFilmToManySetOpListActivities)
+	t.Run("FilmToListItems", testFilmToManySetOpListItems)
+	t.Run("FilmToMediaItems", testFilmToManySetOpMediaItems)
+	t.Run("FilmToReviews", testFilmToManySetOpReviews)
+	t.Run("FilmToScores", testFilmToManySetOpScores)
+	t.Run("FilmToTranslations", testFilmToManySetOpTranslations)
+	t.Run("SeriesToSeriesCollectionItems", testSeriesToManySetOpSeriesCollectionItems)
+	t.Run("SeriesToSeriesExternalIds", testSeriesToManySetOpSeriesExternalIds)
 	t.Run("SeriesToSeriesFilms", testSeriesToManySetOpSeriesFilms)
+	t.Run("SeriesToSeriesListActivities", testSeriesToManySetOpSeriesListActivities)
+	t.Run("SeriesToSeriesListItems", testSeriesToManySetOpSeriesListItems)
+	t.Run("SeriesToSeriesMediaItems", testSeriesToManySetOpSeriesMediaItems)
+	t.Run("SeriesToSeriesReviews", testSeriesToManySetOpSeriesReviews)
+	t.Run("SeriesToSeriesScores", testSeriesToManySetOpSeriesScores)
+	t.Run("SeriesToSeriesTranslations", testSeriesToManySetOpSeriesTranslations)
+	t.Run("UserToContributions", testUserToManySetOpContributions)
+	t.Run("UserToListActivities", testUserToManySetOpListActivities)
+	t.Run("UserToMemberListActivities", testUserToManySetOpMemberListActivities)
+	t.Run("UserToListInvites", testUserToManySetOpListInvites)
+	t.Run("UserToInvitedByListInvites", testUserToManySetOpInvitedByListInvites)
+	t.Run("UserToAddedByListItems", testUserToManySetOpAddedByListItems)
 }
 
 // TestToManyRemove tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyRemove(t *testing.T) {
+	t.Run("FilmToCollectionItems", testFilmToManyRemoveOpCollectionItems)
+	t.Run("FilmToExternalIds", testFilmToManyRemoveOpExternalIds)
+	t.Run("FilmToListActivities", testFilmToManyRemoveOpListActivities)
+	t.Run("FilmToListItems", testFilmToManyRemoveOpListItems)
+	t.Run("FilmToMediaItems", testFilmToManyRemoveOpMediaItems)
+	t.Run("FilmToReviews", testFilmToManyRemoveOpReviews)
+	t.Run("FilmToScores", testFilmToManyRemoveOpScores)
+	t.Run("FilmToTranslations", testFilmToManyRemoveOpTranslations)
+	t.Run("SeriesToSeriesCollectionItems", testSeriesToManyRemoveOpSeriesCollectionItems)
+	t.Run("SeriesToSeriesExternalIds", testSeriesToManyRemoveOpSeriesExternalIds)
 	t.Run("SeriesToSeriesFilms", testSeriesToManyRemoveOpSeriesFilms)
+	t.Run("SeriesToSeriesListActivities", testSeriesToManyRemoveOpSeriesListActivities)
+	t.Run("SeriesToSeriesListItems", testSeriesToManyRemoveOpSeriesListItems)
+	t.Run("SeriesToSeriesMediaItems", testSeriesToManyRemoveOpSeriesMediaItems)
+	t.Run("SeriesToSeriesReviews", testSeriesToManyRemoveOpSeriesReviews)
+	t.Run("SeriesToSeriesScores", testSeriesToManyRemoveOpSeriesScores)
+	t.Run("SeriesToSeriesTranslations", testSeriesToManyRemoveOpSeriesTranslations)
+	t.Run("UserToContributions", testUserToManyRemoveOpContributions)
+	t.Run("UserToListActivities", testUserToManyRemoveOpListActivities)
+	t.Run("UserToMemberListActivities", testUserToManyRemoveOpMemberListActivities)
+	t.Run("UserToListInvites", testUserToManyRemoveOpListInvites)
+	t.Run("UserToInvitedByListInvites", testUserToManyRemoveOpInvitedByListInvites)
+	t.Run("UserToAddedByListItems", testUserToManyRemoveOpAddedByListItems)
 }
 
 func TestReload(t *testing.T) {
+	t.Run("AuditPrunes", testAuditPrunesReload)
+	t.Run("CatalogExports", testCatalogExportsReload)
+	t.Run("CollectionItems", testCollectionItemsReload)
+	t.Run("CollectionItemsAudits", testCollectionItemsAuditsReload)
+	t.Run("Collections", testCollectionsReload)
+	t.Run("CollectionsAudits", testCollectionsAuditsReload)
+	t.Run("ContentRatings", testContentRatingsReload)
+	t.Run("ContentRatingsAudits", testContentRatingsAuditsReload)
+	t.Run("Contributions", testContributionsReload)
+	t.Run("ContributorStats", testContributorStatsReload)
+	t.Run("ExternalIds", testExternalIdsReload)
+	t.Run("ExternalIdsAudits", testExternalIdsAuditsReload)
+	t.Run("FilmScoreAggregates", testFilmScoreAggregatesReload)
 	t.Run("Films", testFilmsReload)
 	t.Run("FilmsAudits", testFilmsAuditsReload)
+	t.Run("ImportErrors", testImportErrorsReload)
+	t.Run("ImportJobs", testImportJobsReload)
+	t.Run("ListActivities", testListActivitiesReload)
+	t.Run("ListInvites", testListInvitesReload)
+	t.Run("ListItems", testListItemsReload)
+	t.Run("ListMembers", testListMembersReload)
+	t.Run("Lists", testListsReload)
+	t.Run("MediaItems", testMediaItemsReload)
+	t.Run("MediaItemsAudits", testMediaItemsAuditsReload)
+	t.Run("PlaybackProgresses", testPlaybackProgressesReload)
+	t.Run("Releases", testReleasesReload)
+	t.Run("ReleasesAudits", testReleasesAuditsReload)
+	t.Run("ReviewVotes", testReviewVotesReload)
+	t.Run("Reviews", testReviewsReload)
+	t.Run("ReviewsAudits", testReviewsAuditsReload)
+	t.Run("Scores", testScoresReload)
+	t.Run("SeriesAggregates", testSeriesAggregatesReload)
+	t.Run("SeriesFollows", testSeriesFollowsReload)
+	t.Run("SeriesScoreAggregates", testSeriesScoreAggregatesReload)
 	t.Run("Serieses", testSeriesesReload)
 	t.Run("SeriesesAudits", testSeriesesAuditsReload)
 	t.Run("Tokens", testTokensReload)
+	t.Run("Translations", testTranslationsReload)
+	t.Run("TranslationsAudits", testTranslationsAuditsReload)
 	t.Run("Users", testUsersReload)
+	t.Run("UsersAudits", testUsersAuditsReload)
+	t.Run("WatchEvents", testWatchEventsReload)
+	t.Run("WatchfilmTags", testWatchfilmTagsReload)
 	t.Run("Watchfilms", testWatchfilmsReload)
+	t.Run("WatchfilmsAudits", testWatchfilmsAuditsReload)
+	t.Run("YearReviews", testYearReviewsReload)
 }
 
 func TestReloadAll(t *testing.T) {
+	t.Run("AuditPrunes", testAuditPrunesReloadAll)
+	t.Run("CatalogExports", testCatalogExportsReloadAll)
+	t.Run("CollectionItems", testCollectionItemsReloadAll)
+	t.Run("CollectionItemsAudits", testCollectionItemsAuditsReloadAll)
+	t.Run("Collections", testCollectionsReloadAll)
+	t.Run("CollectionsAudits", testCollectionsAuditsReloadAll)
+	t.Run("ContentRatings", testContentRatingsReloadAll)
+	t.Run("ContentRatingsAudits", testContentRatingsAuditsReloadAll)
+	t.Run("Contributions", testContributionsReloadAll)
+	t.Run("ContributorStats", testContributorStatsReloadAll)
+	t.Run("ExternalIds", testExternalIdsReloadAll)
+	t.Run("ExternalIdsAudits", testExternalIdsAuditsReloadAll)
+	t.Run("FilmScoreAggregates", testFilmScoreAggregatesReloadAll)
 	t.Run("Films", testFilmsReloadAll)
 	t.Run("FilmsAudits", testFilmsAuditsReloadAll)
+	t.Run("ImportErrors", testImportErrorsReloadAll)
+	t.Run("ImportJobs", testImportJobsReloadAll)
+	t.Run("ListActivities", testListActivitiesReloadAll)
+	t.Run("ListInvites", testListInvitesReloadAll)
+	t.Run("ListItems", testListItemsReloadAll)
+	t.Run("ListMembers", testListMembersReloadAll)
+	t.Run("Lists", testListsReloadAll)
+	t.Run("MediaItems", testMediaItemsReloadAll)
+	t.Run("MediaItemsAudits", testMediaItemsAuditsReloadAll)
+	t.Run("PlaybackProgresses", testPlaybackProgressesReloadAll)
+	t.Run("Releases", testReleasesReloadAll)
+	t.Run("ReleasesAudits", testReleasesAuditsReloadAll)
+	t.Run("ReviewVotes", testReviewVotesReloadAll)
+	t.Run("Reviews", testReviewsReloadAll)
+	t.Run("ReviewsAudits", testReviewsAuditsReloadAll)
+	t.Run("Scores", testScoresReloadAll)
+	t.Run("SeriesAggregates", testSeriesAggregatesReloadAll)
+	t.Run("SeriesFollows", testSeriesFollowsReloadAll)
+	t.Run("SeriesScoreAggregates", testSeriesScoreAggregatesReloadAll)
 	t.Run("Serieses", testSeriesesReloadAll)
 	t.Run("SeriesesAudits", testSeriesesAuditsReloadAll)
 	t.Run("Tokens", testTokensReloadAll)
+	t.Run("Translations", testTranslationsReloadAll)
+	t.Run("TranslationsAudits", testTranslationsAuditsReloadAll)
 	t.Run("Users", testUsersReloadAll)
+	t.Run("UsersAudits", testUsersAuditsReloadAll)
+	t.Run("WatchEvents", testWatchEventsReloadAll)
+	t.Run("WatchfilmTags", testWatchfilmTagsReloadAll)
 	t.Run("Watchfilms", testWatchfilmsReloadAll)
+	t.Run("WatchfilmsAudits", testWatchfilmsAuditsReloadAll)
+	t.Run("YearReviews", testYearReviewsReloadAll)
 }
 
 func TestSelect(t *testing.T) {
+	t.Run("AuditPrunes", testAuditPrunesSelect)
+	t.Run("CatalogExports", testCatalogExportsSelect)
+	t.Run("CollectionItems", testCollectionItemsSelect)
+	t.Run("CollectionItemsAudits", testCollectionItemsAuditsSelect)
+	t.Run("Collections", testCollectionsSelect)
+	t.Run("CollectionsAudits", testCollectionsAuditsSelect)
+	t.Run("ContentRatings", testContentRatingsSelect)
+	t.Run("ContentRatingsAudits", testContentRatingsAuditsSelect)
+	t.Run("Contributions", testContributionsSelect)
+	t.Run("ContributorStats", testContributorStatsSelect)
+	t.Run("ExternalIds", testExternalIdsSelect)
+	t.Run("ExternalIdsAudits", testExternalIdsAuditsSelect)
+	t.Run("FilmScoreAggregates", testFilmScoreAggregatesSelect)
 	t.Run("Films", testFilmsSelect)
 	t.Run("FilmsAudits", testFilmsAuditsSelect)
+	t.Run("ImportErrors", testImportErrorsSelect)
+	t.Run("ImportJobs", testImportJobsSelect)
+	t.Run("ListActivities", testListActivitiesSelect)
+	t.Run("ListInvites", testListInvitesSelect)
+	t.Run("ListItems", testListItemsSelect)
+	t.Run("ListMembers", testListMembersSelect)
+	t.Run("Lists", testListsSelect)
+	t.Run("MediaItems", testMediaItemsSelect)
+	t.Run("MediaItemsAudits", testMediaItemsAuditsSelect)
+	t.Run("PlaybackProgresses", testPlaybackProgressesSelect)
+	t.Run("Releases", testReleasesSelect)
+	t.Run("ReleasesAudits", testReleasesAuditsSelect)
+	t.Run("ReviewVotes", testReviewVotesSelect)
+	t.Run("Reviews", testReviewsSelect)
+	t.Run("ReviewsAudits", testReviewsAuditsSelect)
+	t.Run("Scores", testScoresSelect)
+	t.Run("SeriesAggregates", testSeriesAggregatesSelect)
+	t.Run("SeriesFollows", testSeriesFollowsSelect)
+	t.Run("SeriesScoreAggregates", testSeriesScoreAggregatesSelect)
 	t.Run("Serieses", testSeriesesSelect)
 	t.Run("SeriesesAudits", testSeriesesAuditsSelect)
 	t.Run("Tokens", testTokensSelect)
+	t.Run("Translations", testTranslationsSelect)
+	t.Run("TranslationsAudits", testTranslationsAuditsSelect)
 	t.Run("Users", testUsersSelect)
+	t.Run("UsersAudits", testUsersAuditsSelect)
+	t.Run("WatchEvents", testWatchEventsSelect)
+	t.Run("WatchfilmTags", testWatchfilmTagsSelect)
 	t.Run("Watchfilms", testWatchfilmsSelect)
+	t.Run("WatchfilmsAudits", testWatchfilmsAuditsSelect)
+	t.Run("YearReviews", testYearReviewsSelect)
 }
 
 func TestUpdate(t *testing.T) {
+	t.Run("AuditPrunes", testAuditPrunesUpdate)
+	t.Run("CatalogExports", testCatalogExportsUpdate)
+	t.Run("CollectionItems", testCollectionItemsUpdate)
+	t.Run("CollectionItemsAudits", testCollectionItemsAuditsUpdate)
+	t.Run("Collections", testCollectionsUpdate)
+	t.Run("CollectionsAudits", testCollectionsAuditsUpdate)
+	t.Run("ContentRatings", testContentRatingsUpdate)
+	t.Run("ContentRatingsAudits", testContentRatingsAuditsUpdate)
+	t.Run("Contributions", testContributionsUpdate)
+	t.Run("ContributorStats", testContributorStatsUpdate)
+	t.Run("ExternalIds", testExternalIdsUpdate)
+	t.Run("ExternalIdsAudits", testExternalIdsAuditsUpdate)
+	t.Run("FilmScoreAggregates", testFilmScoreAggregatesUpdate)
 	t.Run("Films", testFilmsUpdate)
 	t.Run("FilmsAudits", testFilmsAuditsUpdate)
+	t.Run("ImportErrors", testImportErrorsUpdate)
+	t.Run("ImportJobs", testImportJobsUpdate)
+	t.Run("ListActivities", testListActivitiesUpdate)
+	t.Run("ListInvites", testListInvitesUpdate)
+	t.Run("ListItems", testListItemsUpdate)
+	t.Run("ListMembers", testListMembersUpdate)
+	t.Run("Lists", testListsUpdate)
+	t.Run("MediaItems", testMediaItemsUpdate)
+	t.Run("MediaItemsAudits", testMediaItemsAuditsUpdate)
+	t.Run("PlaybackProgresses", testPlaybackProgressesUpdate)
+	t.Run("Releases", testReleasesUpdate)
+	t.Run("ReleasesAudits", testReleasesAuditsUpdate)
+	t.Run("ReviewVotes", testReviewVotesUpdate)
+	t.Run("Reviews", testReviewsUpdate)
+	t.Run("ReviewsAudits", testReviewsAuditsUpdate)
+	t.Run("Scores", testScoresUpdate)
+	t.Run("SeriesAggregates", testSeriesAggregatesUpdate)
+	t.Run("SeriesFollows", testSeriesFollowsUpdate)
+	t.Run("SeriesScoreAggregates", testSeriesScoreAggregatesUpdate)
 	t.Run("Serieses", testSeriesesUpdate)
 	t.Run("SeriesesAudits", testSeriesesAuditsUpdate)
 	t.Run("Tokens", testTokensUpdate)
+	t.Run("Translations", testTranslationsUpdate)
+	t.Run("TranslationsAudits", testTranslationsAuditsUpdate)
 	t.Run("Users", testUsersUpdate)
+	t.Run("UsersAudits", testUsersAuditsUpdate)
+	t.Run("WatchEvents", testWatchEventsUpdate)
+	t.Run("WatchfilmTags", testWatchfilmTagsUpdate)
 	t.Run("Watchfilms", testWatchfilmsUpdate)
+	t.Run("WatchfilmsAudits", testWatchfilmsAuditsUpdate)
+	t.Run("YearReviews", testYearReviewsUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
+	t.Run("AuditPrunes", testAuditPrunesSliceUpdateAll)
+	t.Run("CatalogExports", testCatalogExportsSliceUpdateAll)
+	t.Run("CollectionItems", testCollectionItemsSliceUpdateAll)
+	t.Run("CollectionItemsAudits", testCollectionItemsAuditsSliceUpdateAll)
+	t.Run("Collections", testCollectionsSliceUpdateAll)
+	t.Run("CollectionsAudits", testCollectionsAuditsSliceUpdateAll)
+	t.Run("ContentRatings", testContentRatingsSliceUpdateAll)
+	t.Run("ContentRatingsAudits", testContentRatingsAuditsSliceUpdateAll)
+	t.Run("Contributions", testContributionsSliceUpdateAll)
+	t.Run("ContributorStats", testContributorStatsSliceUpdateAll)
+	t.Run("ExternalIds", testExternalIdsSliceUpdateAll)
+	t.Run("ExternalIdsAudits", testExternalIdsAuditsSliceUpdateAll)
+	t.Run("FilmScoreAggregates", testFilmScoreAggregatesSliceUpdateAll)
 	t.Run("Films", testFilmsSliceUpdateAll)
 	t.Run("FilmsAudits", testFilmsAuditsSliceUpdateAll)
+	t.Run("ImportErrors", testImportErrorsSliceUpdateAll)
+	t.Run("ImportJobs", testImportJobsSliceUpdateAll)
+	t.Run("ListActivities", testListActivitiesSliceUpdateAll)
+	t.Run("ListInvites", testListInvitesSliceUpdateAll)
+	t.Run("ListItems", testListItemsSliceUpdateAll)
+	t.Run("ListMembers", testListMembersSliceUpdateAll)
+	t.Run("Lists", testListsSliceUpdateAll)
+	t.Run("MediaItems", testMediaItemsSliceUpdateAll)
+	t.Run("MediaItemsAudits", testMediaItemsAuditsSliceUpdateAll)
+	t.Run("PlaybackProgresses", testPlaybackProgressesSliceUpdateAll)
+	t.Run("Releases", testReleasesSliceUpdateAll)
+	t.Run("ReleasesAudits", testReleasesAuditsSliceUpdateAll)
+	t.Run("ReviewVotes", testReviewVotesSliceUpdateAll)
+	t.Run("Reviews", testReviewsSliceUpdateAll)
+	t.Run("ReviewsAudits", testReviewsAuditsSliceUpdateAll)
+	t.Run("Scores", testScoresSliceUpdateAll)
+	t.Run("SeriesAggregates", testSeriesAggregatesSliceUpdateAll)
+	t.Run("SeriesFollows", testSeriesFollowsSliceUpdateAll)
+	t.Run("SeriesScoreAggregates", testSeriesScoreAggregatesSliceUpdateAll)
 	t.Run("Serieses", testSeriesesSliceUpdateAll)
 	t.Run("SeriesesAudits", testSeriesesAuditsSliceUpdateAll)
 	t.Run("Tokens", testTokensSliceUpdateAll)
+	t.Run("Translations", testTranslationsSliceUpdateAll)
+	t.Run("TranslationsAudits", testTranslationsAuditsSliceUpdateAll)
 	t.Run("Users", testUsersSliceUpdateAll)
+	t.Run("UsersAudits", testUsersAuditsSliceUpdateAll)
+	t.Run("WatchEvents", testWatchEventsSliceUpdateAll)
+	t.Run("WatchfilmTags", testWatchfilmTagsSliceUpdateAll)
 	t.Run("Watchfilms", testWatchfilmsSliceUpdateAll)
+	t.Run("WatchfilmsAudits", testWatchfilmsAuditsSliceUpdateAll)
+	t.Run("YearReviews", testYearReviewsSliceUpdateAll)
 }
@@ -4,19 +4,97 @@
 package models
 
 var TableNames = struct {
-	Films         string
-	FilmsAudit    string
-	Serieses      string
-	SeriesesAudit string
-	Tokens        string
-	Users         string
-	Watchfilms    string
+	AuditPrunes           string
+	CatalogExports        string
+	CollectionItems       string
+	CollectionItemsAudit  string
+	Collections           string
+	CollectionsAudit      string
+	ContentRatings        string
+	ContentRatingsAudit   string
+	Contributions         string
+	ContributorStats      string
+	ExternalIds           string
+	ExternalIdsAudit      string
+	FilmScoreAggregates   string
+	Films                 string
+	FilmsAudit            string
+	ImportErrors          string
+	ImportJobs            string
+	ListActivities        string
+	ListInvites           string
+	ListItems             string
+	ListMembers           string
+	Lists                 string
+	MediaItems            string
+	MediaItemsAudit       string
+	PlaybackProgress      string
+	Releases              string
+	ReleasesAudit         string
+	ReviewVotes           string
+	Reviews               string
+	ReviewsAudit          string
+	Scores                string
+	SeriesAggregates      string
+	SeriesFollows         string
+	SeriesScoreAggregates string
+	Serieses              string
+	SeriesesAudit         string
+	Tokens                string
+	Translations          string
+	TranslationsAudit     string
+	Users                 string
+	UsersAudit            string
+	WatchEvents           string
+	WatchfilmTags         string
+	Watchfilms            string
+	WatchfilmsAudit       string
+	YearReviews           string
 }{
-	Films:         "films",
-	FilmsAudit:    "films_audit",
-	Serieses:      "serieses",
-	SeriesesAudit: "serieses_audit",
-	Tokens:        "tokens",
-	Users:         "users",
-	Watchfilms:    "watchfilms",
+	AuditPrunes:           "audit_prunes",
+	CatalogExports:        "catalog_exports",
+	CollectionItems:       "collection_items",
+	CollectionItemsAudit:  "collection_items_audit",
+	Collections:           "collections",
+	CollectionsAudit:      "collections_audit",
+	ContentRatings:        "content_ratings",
+	ContentRatingsAudit:   "content_ratings_audit",
+	Contributions:         "contributions",
+	ContributorStats:      "contributor_stats",
+	ExternalIds:           "external_ids",
+	ExternalIdsAudit:      "external_ids_audit",
+	FilmScoreAggregates:   "film_score_aggregates",
+	Films:                 "films",
+	FilmsAudit:            "films_audit",
+	ImportErrors:          "import_errors",
+	ImportJobs:            "import_jobs",
+	ListActivities:        "list_activities",
+	ListInvites:           "list_invites",
+	ListItems:             "list_items",
+	ListMembers:           "list_members",
+	Lists:                 "lists",
+	MediaItems:            "media_items",
+	MediaItemsAudit:       "media_items_audit",
+	PlaybackProgress:      "playback_progress",
+	Releases:              "releases",
+	ReleasesAudit:         "releases_audit",
+	ReviewVotes:           "review_votes",
+	Reviews:               "reviews",
+	ReviewsAudit:          "reviews_audit",
+	Scores:                "scores",
+	SeriesAggregates:      "series_aggregates",
+	SeriesFollows:         "series_follows",
+	SeriesScoreAggregates: "series_score_aggregates",
+	Serieses:              "serieses",
+	SeriesesAudit:         "serieses_audit",
+	Tokens:                "tokens",
+	Translations:          "translations",
+	TranslationsAudit:     "translations_audit",
+	Users:                 "users",
+	UsersAudit:            "users_audit",
+	WatchEvents:           "watch_events",
+	WatchfilmTags:         "watchfilm_tags",
+	Watchfilms:            "watchfilms",
+	WatchfilmsAudit:       "watchfilms_audit",
+	YearReviews:           "year_reviews",
 }
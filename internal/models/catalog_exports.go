@@ -0,0 +1,901 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// CatalogExport is an object representing the database table.
+type CatalogExport struct {
+	ID         int       `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	Status     string    `db:"status" boil:"status" json:"status" toml:"status" yaml:"status"`
+	CreatedAt  time.Time `db:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	FinishedAt null.Time `db:"finished_at" boil:"finished_at" json:"finished_at,omitempty" toml:"finished_at" yaml:"finished_at,omitempty"`
+
+	R *catalogExportR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L catalogExportL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CatalogExportColumns = struct {
+	ID         string
+	Status     string
+	CreatedAt  string
+	FinishedAt string
+}{
+	ID:         "id",
+	Status:     "status",
+	CreatedAt:  "created_at",
+	FinishedAt: "finished_at",
+}
+
+var CatalogExportTableColumns = struct {
+	ID         string
+	Status     string
+	CreatedAt  string
+	FinishedAt string
+}{
+	ID:         "catalog_exports.id",
+	Status:     "catalog_exports.status",
+	CreatedAt:  "catalog_exports.created_at",
+	FinishedAt: "catalog_exports.finished_at",
+}
+
+// Generated where
+
+var CatalogExportWhere = struct {
+	ID         whereHelperint
+	Status     whereHelperstring
+	CreatedAt  whereHelpertime_Time
+	FinishedAt whereHelpernull_Time
+}{
+	ID:         whereHelperint{field: "\"catalog_exports\".\"id\""},
+	Status:     whereHelperstring{field: "\"catalog_exports\".\"status\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"catalog_exports\".\"created_at\""},
+	FinishedAt: whereHelpernull_Time{field: "\"catalog_exports\".\"finished_at\""},
+}
+
+// CatalogExportRels is where relationship names are stored.
+var CatalogExportRels = struct {
+}{}
+
+// catalogExportR is where relationships are stored.
+type catalogExportR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*catalogExportR) NewStruct() *catalogExportR {
+	return &catalogExportR{}
+}
+
+// catalogExportL is where Load methods for each relationship are stored.
+type catalogExportL struct{}
+
+var (
+	catalogExportAllColumns            = []string{"id", "status", "created_at", "finished_at"}
+	catalogExportColumnsWithoutDefault = []string{}
+	catalogExportColumnsWithDefault    = []string{"id", "status", "created_at", "finished_at"}
+	catalogExportPrimaryKeyColumns     = []string{"id"}
+	catalogExportGeneratedColumns      = []string{}
+)
+
+type (
+	// CatalogExportSlice is an alias for a slice of pointers to CatalogExport.
+	// This should almost always be used instead of []CatalogExport.
+	CatalogExportSlice []*CatalogExport
+	// CatalogExportHook is the signature for custom CatalogExport hook methods
+	CatalogExportHook func(context.Context, boil.ContextExecutor, *CatalogExport) error
+
+	catalogExportQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	catalogExportType                 = reflect.TypeOf(&CatalogExport{})
+	catalogExportMapping              = queries.MakeStructMapping(catalogExportType)
+	catalogExportPrimaryKeyMapping, _ = queries.BindMapping(catalogExportType, catalogExportMapping, catalogExportPrimaryKeyColumns)
+	catalogExportInsertCacheMut       sync.RWMutex
+	catalogExportInsertCache          = make(map[string]insertCache)
+	catalogExportUpdateCacheMut       sync.RWMutex
+	catalogExportUpdateCache          = make(map[string]updateCache)
+	catalogExportUpsertCacheMut       sync.RWMutex
+	catalogExportUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var catalogExportAfterSelectHooks []CatalogExportHook
+
+var catalogExportBeforeInsertHooks []CatalogExportHook
+var catalogExportAfterInsertHooks []CatalogExportHook
+
+var catalogExportBeforeUpdateHooks []CatalogExportHook
+var catalogExportAfterUpdateHooks []CatalogExportHook
+
+var catalogExportBeforeDeleteHooks []CatalogExportHook
+var catalogExportAfterDeleteHooks []CatalogExportHook
+
+var catalogExportBeforeUpsertHooks []CatalogExportHook
+var catalogExportAfterUpsertHooks []CatalogExportHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *CatalogExport) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range catalogExportAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *CatalogExport) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range catalogExportBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *CatalogExport) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range catalogExportAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *CatalogExport) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range catalogExportBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *CatalogExport) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range catalogExportAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *CatalogExport) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range catalogExportBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *CatalogExport) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range catalogExportAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *CatalogExport) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range catalogExportBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *CatalogExport) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range catalogExportAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCatalogExportHook registers your hook function for all future operations.
+func AddCatalogExportHook(hookPoint boil.HookPoint, catalogExportHook CatalogExportHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		catalogExportAfterSelectHooks = append(catalogExportAfterSelectHooks, catalogExportHook)
+	case boil.BeforeInsertHook:
+		catalogExportBeforeInsertHooks = append(catalogExportBeforeInsertHooks, catalogExportHook)
+	case boil.AfterInsertHook:
+		catalogExportAfterInsertHooks = append(catalogExportAfterInsertHooks, catalogExportHook)
+	case boil.BeforeUpdateHook:
+		catalogExportBeforeUpdateHooks = append(catalogExportBeforeUpdateHooks, catalogExportHook)
+	case boil.AfterUpdateHook:
+		catalogExportAfterUpdateHooks = append(catalogExportAfterUpdateHooks, catalogExportHook)
+	case boil.BeforeDeleteHook:
+		catalogExportBeforeDeleteHooks = append(catalogExportBeforeDeleteHooks, catalogExportHook)
+	case boil.AfterDeleteHook:
+		catalogExportAfterDeleteHooks = append(catalogExportAfterDeleteHooks, catalogExportHook)
+	case boil.BeforeUpsertHook:
+		catalogExportBeforeUpsertHooks = append(catalogExportBeforeUpsertHooks, catalogExportHook)
+	case boil.AfterUpsertHook:
+		catalogExportAfterUpsertHooks = append(catalogExportAfterUpsertHooks, catalogExportHook)
+	}
+}
+
+// One returns a single catalogExport record from the query.
+func (q catalogExportQuery) One(ctx context.Context, exec boil.ContextExecutor) (*CatalogExport, error) {
+	o := &CatalogExport{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for catalog_exports")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all CatalogExport records from the query.
+func (q catalogExportQuery) All(ctx context.Context, exec boil.ContextExecutor) (CatalogExportSlice, error) {
+	var o []*CatalogExport
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to CatalogExport slice")
+	}
+
+	if len(catalogExportAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all CatalogExport records in the query.
+func (q catalogExportQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count catalog_exports rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q catalogExportQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if catalog_exports exists")
+	}
+
+	return count > 0, nil
+}
+
+// CatalogExports retrieves all the records using an executor.
+func CatalogExports(mods ...qm.QueryMod) catalogExportQuery {
+	mods = append(mods, qm.From("\"catalog_exports\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"catalog_exports\".*"})
+	}
+
+	return catalogExportQuery{q}
+}
+
+// FindCatalogExport retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCatalogExport(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*CatalogExport, error) {
+	catalogExportObj := &CatalogExport{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"catalog_exports\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, catalogExportObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from catalog_exports")
+	}
+
+	if err = catalogExportObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return catalogExportObj, err
+	}
+
+	return catalogExportObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *CatalogExport) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no catalog_exports provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(catalogExportColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	catalogExportInsertCacheMut.RLock()
+	cache, cached := catalogExportInsertCache[key]
+	catalogExportInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			catalogExportAllColumns,
+			catalogExportColumnsWithDefault,
+			catalogExportColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(catalogExportType, catalogExportMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(catalogExportType, catalogExportMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"catalog_exports\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"catalog_exports\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into catalog_exports")
+	}
+
+	if !cached {
+		catalogExportInsertCacheMut.Lock()
+		catalogExportInsertCache[key] = cache
+		catalogExportInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the CatalogExport.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *CatalogExport) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	catalogExportUpdateCacheMut.RLock()
+	cache, cached := catalogExportUpdateCache[key]
+	catalogExportUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			catalogExportAllColumns,
+			catalogExportPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update catalog_exports, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"catalog_exports\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, catalogExportPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(catalogExportType, catalogExportMapping, append(wl, catalogExportPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update catalog_exports row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for catalog_exports")
+	}
+
+	if !cached {
+		catalogExportUpdateCacheMut.Lock()
+		catalogExportUpdateCache[key] = cache
+		catalogExportUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q catalogExportQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for catalog_exports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for catalog_exports")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CatalogExportSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), catalogExportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"catalog_exports\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, catalogExportPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in catalogExport slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all catalogExport")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *CatalogExport) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no catalog_exports provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(catalogExportColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	catalogExportUpsertCacheMut.RLock()
+	cache, cached := catalogExportUpsertCache[key]
+	catalogExportUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			catalogExportAllColumns,
+			catalogExportColumnsWithDefault,
+			catalogExportColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			catalogExportAllColumns,
+			catalogExportPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert catalog_exports, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(catalogExportPrimaryKeyColumns))
+			copy(conflict, catalogExportPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"catalog_exports\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(catalogExportType, catalogExportMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(catalogExportType, catalogExportMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert catalog_exports")
+	}
+
+	if !cached {
+		catalogExportUpsertCacheMut.Lock()
+		catalogExportUpsertCache[key] = cache
+		catalogExportUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single CatalogExport record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *CatalogExport) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no CatalogExport provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), catalogExportPrimaryKeyMapping)
+	sql := "DELETE FROM \"catalog_exports\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from catalog_exports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for catalog_exports")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q catalogExportQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no catalogExportQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from catalog_exports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for catalog_exports")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CatalogExportSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(catalogExportBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), catalogExportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"catalog_exports\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, catalogExportPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from catalogExport slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for catalog_exports")
+	}
+
+	if len(catalogExportAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *CatalogExport) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindCatalogExport(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CatalogExportSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CatalogExportSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), catalogExportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"catalog_exports\".* FROM \"catalog_exports\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, catalogExportPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in CatalogExportSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CatalogExportExists checks if the CatalogExport row exists.
+func CatalogExportExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"catalog_exports\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if catalog_exports exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testCatalogExports(t *testing.T) {
+	t.Parallel()
+
+	query := CatalogExports()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testCatalogExportsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CatalogExport{}
+	if err = randomize.Struct(seed, o, catalogExportDBTypes, true, catalogExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CatalogExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CatalogExports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCatalogExportsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CatalogExport{}
+	if err = randomize.Struct(seed, o, catalogExportDBTypes, true, catalogExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CatalogExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := CatalogExports().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CatalogExports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCatalogExportsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CatalogExport{}
+	if err = randomize.Struct(seed, o, catalogExportDBTypes, true, catalogExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CatalogExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CatalogExportSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CatalogExports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCatalogExportsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CatalogExport{}
+	if err = randomize.Struct(seed, o, catalogExportDBTypes, true, catalogExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CatalogExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := CatalogExportExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if CatalogExport exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected CatalogExportExists to return true, but got false.")
+	}
+}
+
+func testCatalogExportsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CatalogExport{}
+	if err = randomize.Struct(seed, o, catalogExportDBTypes, true, catalogExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CatalogExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	catalogExportFound, err := FindCatalogExport(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if catalogExportFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testCatalogExportsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CatalogExport{}
+	if err = randomize.Struct(seed, o, catalogExportDBTypes, true, catalogExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CatalogExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = CatalogExports().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCatalogExportsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CatalogExport{}
+	if err = randomize.Struct(seed, o, catalogExportDBTypes, true, catalogExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CatalogExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := CatalogExports().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testCatalogExportsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	catalogExportOne := &CatalogExport{}
+	catalogExportTwo := &CatalogExport{}
+	if err = randomize.Struct(seed, catalogExportOne, catalogExportDBTypes, false, catalogExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CatalogExport struct: %s", err)
+	}
+	if err = randomize.Struct(seed, catalogExportTwo, catalogExportDBTypes, false, catalogExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CatalogExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = catalogExportOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = catalogExportTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := CatalogExports().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testCatalogExportsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	catalogExportOne := &CatalogExport{}
+	catalogExportTwo := &CatalogExport{}
+	if err = randomize.Struct(seed, catalogExportOne, catalogExportDBTypes, false, catalogExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CatalogExport struct: %s", err)
+	}
+	if err = randomize.Struct(seed, catalogExportTwo, catalogExportDBTypes, false, catalogExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CatalogExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = catalogExportOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = catalogExportTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CatalogExports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func catalogExportBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *CatalogExport) error {
+	*o = CatalogExport{}
+	return nil
+}
+
+func catalogExportAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *CatalogExport) error {
+	*o = CatalogExport{}
+	return nil
+}
+
+func catalogExportAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *CatalogExport) error {
+	*o = CatalogExport{}
+	return nil
+}
+
+func catalogExportBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *CatalogExport) error {
+	*o = CatalogExport{}
+	return nil
+}
+
+func catalogExportAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *CatalogExport) error {
+	*o = CatalogExport{}
+	return nil
+}
+
+func catalogExportBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *CatalogExport) error {
+	*o = CatalogExport{}
+	return nil
+}
+
+func catalogExportAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *CatalogExport) error {
+	*o = CatalogExport{}
+	return nil
+}
+
+func catalogExportBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *CatalogExport) error {
+	*o = CatalogExport{}
+	return nil
+}
+
+func catalogExportAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *CatalogExport) error {
+	*o = CatalogExport{}
+	return nil
+}
+
+func testCatalogExportsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &CatalogExport{}
+	o := &CatalogExport{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, catalogExportDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize CatalogExport object: %s", err)
+	}
+
+	AddCatalogExportHook(boil.BeforeInsertHook, catalogExportBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	catalogExportBeforeInsertHooks = []CatalogExportHook{}
+
+	AddCatalogExportHook(boil.AfterInsertHook, catalogExportAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	catalogExportAfterInsertHooks = []CatalogExportHook{}
+
+	AddCatalogExportHook(boil.AfterSelectHook, catalogExportAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	catalogExportAfterSelectHooks = []CatalogExportHook{}
+
+	AddCatalogExportHook(boil.BeforeUpdateHook, catalogExportBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	catalogExportBeforeUpdateHooks = []CatalogExportHook{}
+
+	AddCatalogExportHook(boil.AfterUpdateHook, catalogExportAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	catalogExportAfterUpdateHooks = []CatalogExportHook{}
+
+	AddCatalogExportHook(boil.BeforeDeleteHook, catalogExportBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	catalogExportBeforeDeleteHooks = []CatalogExportHook{}
+
+	AddCatalogExportHook(boil.AfterDeleteHook, catalogExportAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	catalogExportAfterDeleteHooks = []CatalogExportHook{}
+
+	AddCatalogExportHook(boil.BeforeUpsertHook, catalogExportBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	catalogExportBeforeUpsertHooks = []CatalogExportHook{}
+
+	AddCatalogExportHook(boil.AfterUpsertHook, catalogExportAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	catalogExportAfterUpsertHooks = []CatalogExportHook{}
+}
+
+func testCatalogExportsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CatalogExport{}
+	if err = randomize.Struct(seed, o, catalogExportDBTypes, true, catalogExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CatalogExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CatalogExports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCatalogExportsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CatalogExport{}
+	if err = randomize.Struct(seed, o, catalogExportDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize CatalogExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(catalogExportColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CatalogExports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCatalogExportsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CatalogExport{}
+	if err = randomize.Struct(seed, o, catalogExportDBTypes, true, catalogExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CatalogExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCatalogExportsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CatalogExport{}
+	if err = randomize.Struct(seed, o, catalogExportDBTypes, true, catalogExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CatalogExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CatalogExportSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCatalogExportsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CatalogExport{}
+	if err = randomize.Struct(seed, o, catalogExportDBTypes, true, catalogExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CatalogExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := CatalogExports().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	catalogExportDBTypes = map[string]string{`ID`: `integer`, `Status`: `character varying`, `CreatedAt`: `timestamp with time zone`, `FinishedAt`: `timestamp with time zone`}
+	_                    = bytes.MinRead
+)
+
+func testCatalogExportsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(catalogExportPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(catalogExportAllColumns) == len(catalogExportPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CatalogExport{}
+	if err = randomize.Struct(seed, o, catalogExportDBTypes, true, catalogExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CatalogExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CatalogExports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, catalogExportDBTypes, true, catalogExportPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CatalogExport struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testCatalogExportsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(catalogExportAllColumns) == len(catalogExportPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CatalogExport{}
+	if err = randomize.Struct(seed, o, catalogExportDBTypes, true, catalogExportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CatalogExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CatalogExports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, catalogExportDBTypes, true, catalogExportPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CatalogExport struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(catalogExportAllColumns, catalogExportPrimaryKeyColumns) {
+		fields = catalogExportAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			catalogExportAllColumns,
+			catalogExportPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := CatalogExportSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testCatalogExportsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(catalogExportAllColumns) == len(catalogExportPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := CatalogExport{}
+	if err = randomize.Struct(seed, &o, catalogExportDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize CatalogExport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert CatalogExport: %s", err)
+	}
+
+	count, err := CatalogExports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, catalogExportDBTypes, false, catalogExportPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CatalogExport struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert CatalogExport: %s", err)
+	}
+
+	count, err = CatalogExports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1832 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// CollectionItem is an object representing the database table.
+type CollectionItem struct {
+	ID            int         `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	CollectionID  int         `db:"collection_id" boil:"collection_id" json:"collection_id" toml:"collection_id" yaml:"collection_id"`
+	Position      int         `db:"position" boil:"position" json:"position" toml:"position" yaml:"position"`
+	FilmID        null.Int    `db:"film_id" boil:"film_id" json:"film_id,omitempty" toml:"film_id" yaml:"film_id,omitempty"`
+	SeriesID      null.Int    `db:"series_id" boil:"series_id" json:"series_id,omitempty" toml:"series_id" yaml:"series_id,omitempty"`
+	ContributedBy int         `db:"contributed_by" boil:"contributed_by" json:"contributed_by" toml:"contributed_by" yaml:"contributed_by"`
+	ContributedAt time.Time   `db:"contributed_at" boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
+	Invalidation  null.String `db:"invalidation" boil:"invalidation" json:"invalidation,omitempty" toml:"invalidation" yaml:"invalidation,omitempty"`
+
+	R *collectionItemR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L collectionItemL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CollectionItemColumns = struct {
+	ID            string
+	CollectionID  string
+	Position      string
+	FilmID        string
+	SeriesID      string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+}{
+	ID:            "id",
+	CollectionID:  "collection_id",
+	Position:      "position",
+	FilmID:        "film_id",
+	SeriesID:      "series_id",
+	ContributedBy: "contributed_by",
+	ContributedAt: "contributed_at",
+	Invalidation:  "invalidation",
+}
+
+var CollectionItemTableColumns = struct {
+	ID            string
+	CollectionID  string
+	Position      string
+	FilmID        string
+	SeriesID      string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+}{
+	ID:            "collection_items.id",
+	CollectionID:  "collection_items.collection_id",
+	Position:      "collection_items.position",
+	FilmID:        "collection_items.film_id",
+	SeriesID:      "collection_items.series_id",
+	ContributedBy: "collection_items.contributed_by",
+	ContributedAt: "collection_items.contributed_at",
+	Invalidation:  "collection_items.invalidation",
+}
+
+// Generated where
+
+type whereHelpernull_Int struct{ field string }
+
+func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Int) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelpernull_String struct{ field string }
+
+func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var CollectionItemWhere = struct {
+	ID            whereHelperint
+	CollectionID  whereHelperint
+	Position      whereHelperint
+	FilmID        whereHelpernull_Int
+	SeriesID      whereHelpernull_Int
+	ContributedBy whereHelperint
+	ContributedAt whereHelpertime_Time
+	Invalidation  whereHelpernull_String
+}{
+	ID:            whereHelperint{field: "\"collection_items\".\"id\""},
+	CollectionID:  whereHelperint{field: "\"collection_items\".\"collection_id\""},
+	Position:      whereHelperint{field: "\"collection_items\".\"position\""},
+	FilmID:        whereHelpernull_Int{field: "\"collection_items\".\"film_id\""},
+	SeriesID:      whereHelpernull_Int{field: "\"collection_items\".\"series_id\""},
+	ContributedBy: whereHelperint{field: "\"collection_items\".\"contributed_by\""},
+	ContributedAt: whereHelpertime_Time{field: "\"collection_items\".\"contributed_at\""},
+	Invalidation:  whereHelpernull_String{field: "\"collection_items\".\"invalidation\""},
+}
+
+// CollectionItemRels is where relationship names are stored.
+var CollectionItemRels = struct {
+	ContributingUser string
+	Collection       string
+	Film             string
+	Series           string
+}{
+	ContributingUser: "ContributingUser",
+	Collection:       "Collection",
+	Film:             "Film",
+	Series:           "Series",
+}
+
+// collectionItemR is where relationships are stored.
+type collectionItemR struct {
+	ContributingUser *User       `db:"ContributingUser" boil:"ContributingUser" json:"ContributingUser" toml:"ContributingUser" yaml:"ContributingUser"`
+	Collection       *Collection `db:"Collection" boil:"Collection" json:"Collection" toml:"Collection" yaml:"Collection"`
+	Film             *Film       `db:"Film" boil:"Film" json:"Film" toml:"Film" yaml:"Film"`
+	Series           *Series     `db:"Series" boil:"Series" json:"Series" toml:"Series" yaml:"Series"`
+}
+
+// NewStruct creates a new relationship struct
+func (*collectionItemR) NewStruct() *collectionItemR {
+	return &collectionItemR{}
+}
+
+func (r *collectionItemR) GetContributingUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.ContributingUser
+}
+
+func (r *collectionItemR) GetCollection() *Collection {
+	if r == nil {
+		return nil
+	}
+	return r.Collection
+}
+
+func (r *collectionItemR) GetFilm() *Film {
+	if r == nil {
+		return nil
+	}
+	return r.Film
+}
+
+func (r *collectionItemR) GetSeries() *Series {
+	if r == nil {
+		return nil
+	}
+	return r.Series
+}
+
+// collectionItemL is where Load methods for each relationship are stored.
+type collectionItemL struct{}
+
+var (
+	collectionItemAllColumns            = []string{"id", "collection_id", "position", "film_id", "series_id", "contributed_by", "contributed_at", "invalidation"}
+	collectionItemColumnsWithoutDefault = []string{"collection_id", "position", "contributed_by"}
+	collectionItemColumnsWithDefault    = []string{"id", "film_id", "series_id", "contributed_at", "invalidation"}
+	collectionItemPrimaryKeyColumns     = []string{"id"}
+	collectionItemGeneratedColumns      = []string{}
+)
+
+type (
+	// CollectionItemSlice is an alias for a slice of pointers to CollectionItem.
+	// This should almost always be used instead of []CollectionItem.
+	CollectionItemSlice []*CollectionItem
+	// CollectionItemHook is the signature for custom CollectionItem hook methods
+	CollectionItemHook func(context.Context, boil.ContextExecutor, *CollectionItem) error
+
+	collectionItemQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	collectionItemType                 = reflect.TypeOf(&CollectionItem{})
+	collectionItemMapping              = queries.MakeStructMapping(collectionItemType)
+	collectionItemPrimaryKeyMapping, _ = queries.BindMapping(collectionItemType, collectionItemMapping, collectionItemPrimaryKeyColumns)
+	collectionItemInsertCacheMut       sync.RWMutex
+	collectionItemInsertCache          = make(map[string]insertCache)
+	collectionItemUpdateCacheMut       sync.RWMutex
+	collectionItemUpdateCache          = make(map[string]updateCache)
+	collectionItemUpsertCacheMut       sync.RWMutex
+	collectionItemUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var collectionItemAfterSelectHooks []CollectionItemHook
+
+var collectionItemBeforeInsertHooks []CollectionItemHook
+var collectionItemAfterInsertHooks []CollectionItemHook
+
+var collectionItemBeforeUpdateHooks []CollectionItemHook
+var collectionItemAfterUpdateHooks []CollectionItemHook
+
+var collectionItemBeforeDeleteHooks []CollectionItemHook
+var collectionItemAfterDeleteHooks []CollectionItemHook
+
+var collectionItemBeforeUpsertHooks []CollectionItemHook
+var collectionItemAfterUpsertHooks []CollectionItemHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *CollectionItem) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionItemAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *CollectionItem) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionItemBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *CollectionItem) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionItemAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *CollectionItem) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionItemBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *CollectionItem) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionItemAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *CollectionItem) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionItemBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *CollectionItem) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionItemAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *CollectionItem) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionItemBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *CollectionItem) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionItemAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCollectionItemHook registers your hook function for all future operations.
+func AddCollectionItemHook(hookPoint boil.HookPoint, collectionItemHook CollectionItemHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		collectionItemAfterSelectHooks = append(collectionItemAfterSelectHooks, collectionItemHook)
+	case boil.BeforeInsertHook:
+		collectionItemBeforeInsertHooks = append(collectionItemBeforeInsertHooks, collectionItemHook)
+	case boil.AfterInsertHook:
+		collectionItemAfterInsertHooks = append(collectionItemAfterInsertHooks, collectionItemHook)
+	case boil.BeforeUpdateHook:
+		collectionItemBeforeUpdateHooks = append(collectionItemBeforeUpdateHooks, collectionItemHook)
+	case boil.AfterUpdateHook:
+		collectionItemAfterUpdateHooks = append(collectionItemAfterUpdateHooks, collectionItemHook)
+	case boil.BeforeDeleteHook:
+		collectionItemBeforeDeleteHooks = append(collectionItemBeforeDeleteHooks, collectionItemHook)
+	case boil.AfterDeleteHook:
+		collectionItemAfterDeleteHooks = append(collectionItemAfterDeleteHooks, collectionItemHook)
+	case boil.BeforeUpsertHook:
+		collectionItemBeforeUpsertHooks = append(collectionItemBeforeUpsertHooks, collectionItemHook)
+	case boil.AfterUpsertHook:
+		collectionItemAfterUpsertHooks = append(collectionItemAfterUpsertHooks, collectionItemHook)
+	}
+}
+
+// One returns a single collectionItem record from the query.
+func (q collectionItemQuery) One(ctx context.Context, exec boil.ContextExecutor) (*CollectionItem, error) {
+	o := &CollectionItem{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for collection_items")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all CollectionItem records from the query.
+func (q collectionItemQuery) All(ctx context.Context, exec boil.ContextExecutor) (CollectionItemSlice, error) {
+	var o []*CollectionItem
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to CollectionItem slice")
+	}
+
+	if len(collectionItemAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all CollectionItem records in the query.
+func (q collectionItemQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count collection_items rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q collectionItemQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if collection_items exists")
+	}
+
+	return count > 0, nil
+}
+
+// ContributingUser pointed to by the foreign key.
+func (o *CollectionItem) ContributingUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ContributedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Collection pointed to by the foreign key.
+func (o *CollectionItem) Collection(mods ...qm.QueryMod) collectionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CollectionID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Collections(queryMods...)
+}
+
+// Film pointed to by the foreign key.
+func (o *CollectionItem) Film(mods ...qm.QueryMod) filmQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FilmID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Films(queryMods...)
+}
+
+// Series pointed to by the foreign key.
+func (o *CollectionItem) Series(mods ...qm.QueryMod) seriesQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SeriesID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Serieses(queryMods...)
+}
+
+// LoadContributingUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (collectionItemL) LoadContributingUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCollectionItem interface{}, mods queries.Applicator) error {
+	var slice []*CollectionItem
+	var object *CollectionItem
+
+	if singular {
+		var ok bool
+		object, ok = maybeCollectionItem.(*CollectionItem)
+		if !ok {
+			object = new(CollectionItem)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCollectionItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCollectionItem))
+			}
+		}
+	} else {
+		s, ok := maybeCollectionItem.(*[]*CollectionItem)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCollectionItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCollectionItem))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &collectionItemR{}
+		}
+		args = append(args, object.ContributedBy)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &collectionItemR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ContributedBy {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ContributedBy)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(collectionItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ContributingUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ContributedCollectionItems = append(foreign.R.ContributedCollectionItems, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ContributedBy == foreign.ID {
+				local.R.ContributingUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ContributedCollectionItems = append(foreign.R.ContributedCollectionItems, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCollection allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (collectionItemL) LoadCollection(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCollectionItem interface{}, mods queries.Applicator) error {
+	var slice []*CollectionItem
+	var object *CollectionItem
+
+	if singular {
+		var ok bool
+		object, ok = maybeCollectionItem.(*CollectionItem)
+		if !ok {
+			object = new(CollectionItem)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCollectionItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCollectionItem))
+			}
+		}
+	} else {
+		s, ok := maybeCollectionItem.(*[]*CollectionItem)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCollectionItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCollectionItem))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &collectionItemR{}
+		}
+		args = append(args, object.CollectionID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &collectionItemR{}
+			}
+
+			for _, a := range args {
+				if a == obj.CollectionID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CollectionID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`collections`),
+		qm.WhereIn(`collections.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Collection")
+	}
+
+	var resultSlice []*Collection
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Collection")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for collections")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for collections")
+	}
+
+	if len(collectionItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Collection = foreign
+		if foreign.R == nil {
+			foreign.R = &collectionR{}
+		}
+		foreign.R.CollectionItems = append(foreign.R.CollectionItems, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CollectionID == foreign.ID {
+				local.R.Collection = foreign
+				if foreign.R == nil {
+					foreign.R = &collectionR{}
+				}
+				foreign.R.CollectionItems = append(foreign.R.CollectionItems, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFilm allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (collectionItemL) LoadFilm(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCollectionItem interface{}, mods queries.Applicator) error {
+	var slice []*CollectionItem
+	var object *CollectionItem
+
+	if singular {
+		var ok bool
+		object, ok = maybeCollectionItem.(*CollectionItem)
+		if !ok {
+			object = new(CollectionItem)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCollectionItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCollectionItem))
+			}
+		}
+	} else {
+		s, ok := maybeCollectionItem.(*[]*CollectionItem)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCollectionItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCollectionItem))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &collectionItemR{}
+		}
+		if !queries.IsNil(object.FilmID) {
+			args = append(args, object.FilmID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &collectionItemR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.FilmID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.FilmID) {
+				args = append(args, obj.FilmID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`films`),
+		qm.WhereIn(`films.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Film")
+	}
+
+	var resultSlice []*Film
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Film")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for films")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for films")
+	}
+
+	if len(collectionItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Film = foreign
+		if foreign.R == nil {
+			foreign.R = &filmR{}
+		}
+		foreign.R.CollectionItems = append(foreign.R.CollectionItems, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.FilmID, foreign.ID) {
+				local.R.Film = foreign
+				if foreign.R == nil {
+					foreign.R = &filmR{}
+				}
+				foreign.R.CollectionItems = append(foreign.R.CollectionItems, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSeries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (collectionItemL) LoadSeries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCollectionItem interface{}, mods queries.Applicator) error {
+	var slice []*CollectionItem
+	var object *CollectionItem
+
+	if singular {
+		var ok bool
+		object, ok = maybeCollectionItem.(*CollectionItem)
+		if !ok {
+			object = new(CollectionItem)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCollectionItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCollectionItem))
+			}
+		}
+	} else {
+		s, ok := maybeCollectionItem.(*[]*CollectionItem)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCollectionItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCollectionItem))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &collectionItemR{}
+		}
+		if !queries.IsNil(object.SeriesID) {
+			args = append(args, object.SeriesID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &collectionItemR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.SeriesID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.SeriesID) {
+				args = append(args, obj.SeriesID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`serieses`),
+		qm.WhereIn(`serieses.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Series")
+	}
+
+	var resultSlice []*Series
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Series")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for serieses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for serieses")
+	}
+
+	if len(collectionItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Series = foreign
+		if foreign.R == nil {
+			foreign.R = &seriesR{}
+		}
+		foreign.R.SeriesCollectionItems = append(foreign.R.SeriesCollectionItems, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.SeriesID, foreign.ID) {
+				local.R.Series = foreign
+				if foreign.R == nil {
+					foreign.R = &seriesR{}
+				}
+				foreign.R.SeriesCollectionItems = append(foreign.R.SeriesCollectionItems, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetContributingUser of the collectionItem to the related item.
+// Sets o.R.ContributingUser to related.
+// Adds o to related.R.ContributedCollectionItems.
+func (o *CollectionItem) SetContributingUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"collection_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
+		strmangle.WhereClause("\"", "\"", 2, collectionItemPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ContributedBy = related.ID
+	if o.R == nil {
+		o.R = &collectionItemR{
+			ContributingUser: related,
+		}
+	} else {
+		o.R.ContributingUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ContributedCollectionItems: CollectionItemSlice{o},
+		}
+	} else {
+		related.R.ContributedCollectionItems = append(related.R.ContributedCollectionItems, o)
+	}
+
+	return nil
+}
+
+// SetCollection of the collectionItem to the related item.
+// Sets o.R.Collection to related.
+// Adds o to related.R.CollectionItems.
+func (o *CollectionItem) SetCollection(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Collection) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"collection_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"collection_id"}),
+		strmangle.WhereClause("\"", "\"", 2, collectionItemPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CollectionID = related.ID
+	if o.R == nil {
+		o.R = &collectionItemR{
+			Collection: related,
+		}
+	} else {
+		o.R.Collection = related
+	}
+
+	if related.R == nil {
+		related.R = &collectionR{
+			CollectionItems: CollectionItemSlice{o},
+		}
+	} else {
+		related.R.CollectionItems = append(related.R.CollectionItems, o)
+	}
+
+	return nil
+}
+
+// SetFilm of the collectionItem to the related item.
+// Sets o.R.Film to related.
+// Adds o to related.R.CollectionItems.
+func (o *CollectionItem) SetFilm(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Film) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"collection_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+		strmangle.WhereClause("\"", "\"", 2, collectionItemPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.FilmID, related.ID)
+	if o.R == nil {
+		o.R = &collectionItemR{
+			Film: related,
+		}
+	} else {
+		o.R.Film = related
+	}
+
+	if related.R == nil {
+		related.R = &filmR{
+			CollectionItems: CollectionItemSlice{o},
+		}
+	} else {
+		related.R.CollectionItems = append(related.R.CollectionItems, o)
+	}
+
+	return nil
+}
+
+// RemoveFilm relationship.
+// Sets o.R.Film to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *CollectionItem) RemoveFilm(ctx context.Context, exec boil.ContextExecutor, related *Film) error {
+	var err error
+
+	queries.SetScanner(&o.FilmID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("film_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Film = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.CollectionItems {
+		if queries.Equal(o.FilmID, ri.FilmID) {
+			continue
+		}
+
+		ln := len(related.R.CollectionItems)
+		if ln > 1 && i < ln-1 {
+			related.R.CollectionItems[i] = related.R.CollectionItems[ln-1]
+		}
+		related.R.CollectionItems = related.R.CollectionItems[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetSeries of the collectionItem to the related item.
+// Sets o.R.Series to related.
+// Adds o to related.R.SeriesCollectionItems.
+func (o *CollectionItem) SetSeries(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Series) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"collection_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+		strmangle.WhereClause("\"", "\"", 2, collectionItemPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.SeriesID, related.ID)
+	if o.R == nil {
+		o.R = &collectionItemR{
+			Series: related,
+		}
+	} else {
+		o.R.Series = related
+	}
+
+	if related.R == nil {
+		related.R = &seriesR{
+			SeriesCollectionItems: CollectionItemSlice{o},
+		}
+	} else {
+		related.R.SeriesCollectionItems = append(related.R.SeriesCollectionItems, o)
+	}
+
+	return nil
+}
+
+// RemoveSeries relationship.
+// Sets o.R.Series to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *CollectionItem) RemoveSeries(ctx context.Context, exec boil.ContextExecutor, related *Series) error {
+	var err error
+
+	queries.SetScanner(&o.SeriesID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("series_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Series = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.SeriesCollectionItems {
+		if queries.Equal(o.SeriesID, ri.SeriesID) {
+			continue
+		}
+
+		ln := len(related.R.SeriesCollectionItems)
+		if ln > 1 && i < ln-1 {
+			related.R.SeriesCollectionItems[i] = related.R.SeriesCollectionItems[ln-1]
+		}
+		related.R.SeriesCollectionItems = related.R.SeriesCollectionItems[:ln-1]
+		break
+	}
+	return nil
+}
+
+// CollectionItems retrieves all the records using an executor.
+func CollectionItems(mods ...qm.QueryMod) collectionItemQuery {
+	mods = append(mods, qm.From("\"collection_items\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"collection_items\".*"})
+	}
+
+	return collectionItemQuery{q}
+}
+
+// FindCollectionItem retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCollectionItem(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*CollectionItem, error) {
+	collectionItemObj := &CollectionItem{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"collection_items\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, collectionItemObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from collection_items")
+	}
+
+	if err = collectionItemObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return collectionItemObj, err
+	}
+
+	return collectionItemObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *CollectionItem) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no collection_items provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(collectionItemColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	collectionItemInsertCacheMut.RLock()
+	cache, cached := collectionItemInsertCache[key]
+	collectionItemInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			collectionItemAllColumns,
+			collectionItemColumnsWithDefault,
+			collectionItemColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(collectionItemType, collectionItemMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(collectionItemType, collectionItemMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"collection_items\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"collection_items\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into collection_items")
+	}
+
+	if !cached {
+		collectionItemInsertCacheMut.Lock()
+		collectionItemInsertCache[key] = cache
+		collectionItemInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the CollectionItem.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *CollectionItem) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	collectionItemUpdateCacheMut.RLock()
+	cache, cached := collectionItemUpdateCache[key]
+	collectionItemUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			collectionItemAllColumns,
+			collectionItemPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update collection_items, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"collection_items\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, collectionItemPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(collectionItemType, collectionItemMapping, append(wl, collectionItemPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update collection_items row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for collection_items")
+	}
+
+	if !cached {
+		collectionItemUpdateCacheMut.Lock()
+		collectionItemUpdateCache[key] = cache
+		collectionItemUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q collectionItemQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for collection_items")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for collection_items")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CollectionItemSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), collectionItemPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"collection_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, collectionItemPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in collectionItem slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all collectionItem")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *CollectionItem) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no collection_items provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(collectionItemColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	collectionItemUpsertCacheMut.RLock()
+	cache, cached := collectionItemUpsertCache[key]
+	collectionItemUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			collectionItemAllColumns,
+			collectionItemColumnsWithDefault,
+			collectionItemColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			collectionItemAllColumns,
+			collectionItemPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert collection_items, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(collectionItemPrimaryKeyColumns))
+			copy(conflict, collectionItemPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"collection_items\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(collectionItemType, collectionItemMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(collectionItemType, collectionItemMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert collection_items")
+	}
+
+	if !cached {
+		collectionItemUpsertCacheMut.Lock()
+		collectionItemUpsertCache[key] = cache
+		collectionItemUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single CollectionItem record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *CollectionItem) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no CollectionItem provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), collectionItemPrimaryKeyMapping)
+	sql := "DELETE FROM \"collection_items\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from collection_items")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for collection_items")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q collectionItemQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no collectionItemQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from collection_items")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for collection_items")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CollectionItemSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(collectionItemBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), collectionItemPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"collection_items\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, collectionItemPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from collectionItem slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for collection_items")
+	}
+
+	if len(collectionItemAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *CollectionItem) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindCollectionItem(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CollectionItemSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CollectionItemSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), collectionItemPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"collection_items\".* FROM \"collection_items\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, collectionItemPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in CollectionItemSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CollectionItemExists checks if the CollectionItem row exists.
+func CollectionItemExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"collection_items\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if collection_items exists")
+	}
+
+	return exists, nil
+}
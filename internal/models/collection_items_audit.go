@@ -0,0 +1,943 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// CollectionItemsAudit is an object representing the database table.
+type CollectionItemsAudit struct {
+	ID            int         `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	CollectionID  int         `db:"collection_id" boil:"collection_id" json:"collection_id" toml:"collection_id" yaml:"collection_id"`
+	Position      int         `db:"position" boil:"position" json:"position" toml:"position" yaml:"position"`
+	FilmID        null.Int    `db:"film_id" boil:"film_id" json:"film_id,omitempty" toml:"film_id" yaml:"film_id,omitempty"`
+	SeriesID      null.Int    `db:"series_id" boil:"series_id" json:"series_id,omitempty" toml:"series_id" yaml:"series_id,omitempty"`
+	ContributedBy int         `db:"contributed_by" boil:"contributed_by" json:"contributed_by" toml:"contributed_by" yaml:"contributed_by"`
+	ContributedAt time.Time   `db:"contributed_at" boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
+	Invalidation  null.String `db:"invalidation" boil:"invalidation" json:"invalidation,omitempty" toml:"invalidation" yaml:"invalidation,omitempty"`
+	AuditAction   string      `db:"audit_action" boil:"audit_action" json:"audit_action" toml:"audit_action" yaml:"audit_action"`
+	AuditActor    null.Int    `db:"audit_actor" boil:"audit_actor" json:"audit_actor,omitempty" toml:"audit_actor" yaml:"audit_actor,omitempty"`
+
+	R *collectionItemsAuditR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L collectionItemsAuditL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CollectionItemsAuditColumns = struct {
+	ID            string
+	CollectionID  string
+	Position      string
+	FilmID        string
+	SeriesID      string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+	AuditAction   string
+	AuditActor    string
+}{
+	ID:            "id",
+	CollectionID:  "collection_id",
+	Position:      "position",
+	FilmID:        "film_id",
+	SeriesID:      "series_id",
+	ContributedBy: "contributed_by",
+	ContributedAt: "contributed_at",
+	Invalidation:  "invalidation",
+	AuditAction:   "audit_action",
+	AuditActor:    "audit_actor",
+}
+
+var CollectionItemsAuditTableColumns = struct {
+	ID            string
+	CollectionID  string
+	Position      string
+	FilmID        string
+	SeriesID      string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+	AuditAction   string
+	AuditActor    string
+}{
+	ID:            "collection_items_audit.id",
+	CollectionID:  "collection_items_audit.collection_id",
+	Position:      "collection_items_audit.position",
+	FilmID:        "collection_items_audit.film_id",
+	SeriesID:      "collection_items_audit.series_id",
+	ContributedBy: "collection_items_audit.contributed_by",
+	ContributedAt: "collection_items_audit.contributed_at",
+	Invalidation:  "collection_items_audit.invalidation",
+	AuditAction:   "collection_items_audit.audit_action",
+	AuditActor:    "collection_items_audit.audit_actor",
+}
+
+// Generated where
+
+var CollectionItemsAuditWhere = struct {
+	ID            whereHelperint
+	CollectionID  whereHelperint
+	Position      whereHelperint
+	FilmID        whereHelpernull_Int
+	SeriesID      whereHelpernull_Int
+	ContributedBy whereHelperint
+	ContributedAt whereHelpertime_Time
+	Invalidation  whereHelpernull_String
+	AuditAction   whereHelperstring
+	AuditActor    whereHelpernull_Int
+}{
+	ID:            whereHelperint{field: "\"collection_items_audit\".\"id\""},
+	CollectionID:  whereHelperint{field: "\"collection_items_audit\".\"collection_id\""},
+	Position:      whereHelperint{field: "\"collection_items_audit\".\"position\""},
+	FilmID:        whereHelpernull_Int{field: "\"collection_items_audit\".\"film_id\""},
+	SeriesID:      whereHelpernull_Int{field: "\"collection_items_audit\".\"series_id\""},
+	ContributedBy: whereHelperint{field: "\"collection_items_audit\".\"contributed_by\""},
+	ContributedAt: whereHelpertime_Time{field: "\"collection_items_audit\".\"contributed_at\""},
+	Invalidation:  whereHelpernull_String{field: "\"collection_items_audit\".\"invalidation\""},
+	AuditAction:   whereHelperstring{field: "\"collection_items_audit\".\"audit_action\""},
+	AuditActor:    whereHelpernull_Int{field: "\"collection_items_audit\".\"audit_actor\""},
+}
+
+// CollectionItemsAuditRels is where relationship names are stored.
+var CollectionItemsAuditRels = struct {
+}{}
+
+// collectionItemsAuditR is where relationships are stored.
+type collectionItemsAuditR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*collectionItemsAuditR) NewStruct() *collectionItemsAuditR {
+	return &collectionItemsAuditR{}
+}
+
+// collectionItemsAuditL is where Load methods for each relationship are stored.
+type collectionItemsAuditL struct{}
+
+var (
+	collectionItemsAuditAllColumns            = []string{"id", "collection_id", "position", "film_id", "series_id", "contributed_by", "contributed_at", "invalidation", "audit_action", "audit_actor"}
+	collectionItemsAuditColumnsWithoutDefault = []string{"id", "collection_id", "position", "contributed_by", "contributed_at"}
+	collectionItemsAuditColumnsWithDefault    = []string{"film_id", "series_id", "invalidation", "audit_action", "audit_actor"}
+	collectionItemsAuditPrimaryKeyColumns     = []string{"id", "contributed_by", "contributed_at"}
+	collectionItemsAuditGeneratedColumns      = []string{}
+)
+
+type (
+	// CollectionItemsAuditSlice is an alias for a slice of pointers to CollectionItemsAudit.
+	// This should almost always be used instead of []CollectionItemsAudit.
+	CollectionItemsAuditSlice []*CollectionItemsAudit
+	// CollectionItemsAuditHook is the signature for custom CollectionItemsAudit hook methods
+	CollectionItemsAuditHook func(context.Context, boil.ContextExecutor, *CollectionItemsAudit) error
+
+	collectionItemsAuditQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	collectionItemsAuditType                 = reflect.TypeOf(&CollectionItemsAudit{})
+	collectionItemsAuditMapping              = queries.MakeStructMapping(collectionItemsAuditType)
+	collectionItemsAuditPrimaryKeyMapping, _ = queries.BindMapping(collectionItemsAuditType, collectionItemsAuditMapping, collectionItemsAuditPrimaryKeyColumns)
+	collectionItemsAuditInsertCacheMut       sync.RWMutex
+	collectionItemsAuditInsertCache          = make(map[string]insertCache)
+	collectionItemsAuditUpdateCacheMut       sync.RWMutex
+	collectionItemsAuditUpdateCache          = make(map[string]updateCache)
+	collectionItemsAuditUpsertCacheMut       sync.RWMutex
+	collectionItemsAuditUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var collectionItemsAuditAfterSelectHooks []CollectionItemsAuditHook
+
+var collectionItemsAuditBeforeInsertHooks []CollectionItemsAuditHook
+var collectionItemsAuditAfterInsertHooks []CollectionItemsAuditHook
+
+var collectionItemsAuditBeforeUpdateHooks []CollectionItemsAuditHook
+var collectionItemsAuditAfterUpdateHooks []CollectionItemsAuditHook
+
+var collectionItemsAuditBeforeDeleteHooks []CollectionItemsAuditHook
+var collectionItemsAuditAfterDeleteHooks []CollectionItemsAuditHook
+
+var collectionItemsAuditBeforeUpsertHooks []CollectionItemsAuditHook
+var collectionItemsAuditAfterUpsertHooks []CollectionItemsAuditHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *CollectionItemsAudit) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionItemsAuditAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *CollectionItemsAudit) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionItemsAuditBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *CollectionItemsAudit) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionItemsAuditAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *CollectionItemsAudit) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionItemsAuditBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *CollectionItemsAudit) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionItemsAuditAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *CollectionItemsAudit) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionItemsAuditBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *CollectionItemsAudit) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionItemsAuditAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *CollectionItemsAudit) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionItemsAuditBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *CollectionItemsAudit) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionItemsAuditAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCollectionItemsAuditHook registers your hook function for all future operations.
+func AddCollectionItemsAuditHook(hookPoint boil.HookPoint, collectionItemsAuditHook CollectionItemsAuditHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		collectionItemsAuditAfterSelectHooks = append(collectionItemsAuditAfterSelectHooks, collectionItemsAuditHook)
+	case boil.BeforeInsertHook:
+		collectionItemsAuditBeforeInsertHooks = append(collectionItemsAuditBeforeInsertHooks, collectionItemsAuditHook)
+	case boil.AfterInsertHook:
+		collectionItemsAuditAfterInsertHooks = append(collectionItemsAuditAfterInsertHooks, collectionItemsAuditHook)
+	case boil.BeforeUpdateHook:
+		collectionItemsAuditBeforeUpdateHooks = append(collectionItemsAuditBeforeUpdateHooks, collectionItemsAuditHook)
+	case boil.AfterUpdateHook:
+		collectionItemsAuditAfterUpdateHooks = append(collectionItemsAuditAfterUpdateHooks, collectionItemsAuditHook)
+	case boil.BeforeDeleteHook:
+		collectionItemsAuditBeforeDeleteHooks = append(collectionItemsAuditBeforeDeleteHooks, collectionItemsAuditHook)
+	case boil.AfterDeleteHook:
+		collectionItemsAuditAfterDeleteHooks = append(collectionItemsAuditAfterDeleteHooks, collectionItemsAuditHook)
+	case boil.BeforeUpsertHook:
+		collectionItemsAuditBeforeUpsertHooks = append(collectionItemsAuditBeforeUpsertHooks, collectionItemsAuditHook)
+	case boil.AfterUpsertHook:
+		collectionItemsAuditAfterUpsertHooks = append(collectionItemsAuditAfterUpsertHooks, collectionItemsAuditHook)
+	}
+}
+
+// One returns a single collectionItemsAudit record from the query.
+func (q collectionItemsAuditQuery) One(ctx context.Context, exec boil.ContextExecutor) (*CollectionItemsAudit, error) {
+	o := &CollectionItemsAudit{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for collection_items_audit")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all CollectionItemsAudit records from the query.
+func (q collectionItemsAuditQuery) All(ctx context.Context, exec boil.ContextExecutor) (CollectionItemsAuditSlice, error) {
+	var o []*CollectionItemsAudit
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to CollectionItemsAudit slice")
+	}
+
+	if len(collectionItemsAuditAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all CollectionItemsAudit records in the query.
+func (q collectionItemsAuditQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count collection_items_audit rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q collectionItemsAuditQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if collection_items_audit exists")
+	}
+
+	return count > 0, nil
+}
+
+// CollectionItemsAudits retrieves all the records using an executor.
+func CollectionItemsAudits(mods ...qm.QueryMod) collectionItemsAuditQuery {
+	mods = append(mods, qm.From("\"collection_items_audit\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"collection_items_audit\".*"})
+	}
+
+	return collectionItemsAuditQuery{q}
+}
+
+// FindCollectionItemsAudit retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCollectionItemsAudit(ctx context.Context, exec boil.ContextExecutor, iD int, contributedBy int, contributedAt time.Time, selectCols ...string) (*CollectionItemsAudit, error) {
+	collectionItemsAuditObj := &CollectionItemsAudit{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"collection_items_audit\" where \"id\"=$1 AND \"contributed_by\"=$2 AND \"contributed_at\"=$3", sel,
+	)
+
+	q := queries.Raw(query, iD, contributedBy, contributedAt)
+
+	err := q.Bind(ctx, exec, collectionItemsAuditObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from collection_items_audit")
+	}
+
+	if err = collectionItemsAuditObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return collectionItemsAuditObj, err
+	}
+
+	return collectionItemsAuditObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *CollectionItemsAudit) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no collection_items_audit provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(collectionItemsAuditColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	collectionItemsAuditInsertCacheMut.RLock()
+	cache, cached := collectionItemsAuditInsertCache[key]
+	collectionItemsAuditInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			collectionItemsAuditAllColumns,
+			collectionItemsAuditColumnsWithDefault,
+			collectionItemsAuditColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(collectionItemsAuditType, collectionItemsAuditMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(collectionItemsAuditType, collectionItemsAuditMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"collection_items_audit\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"collection_items_audit\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into collection_items_audit")
+	}
+
+	if !cached {
+		collectionItemsAuditInsertCacheMut.Lock()
+		collectionItemsAuditInsertCache[key] = cache
+		collectionItemsAuditInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the CollectionItemsAudit.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *CollectionItemsAudit) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	collectionItemsAuditUpdateCacheMut.RLock()
+	cache, cached := collectionItemsAuditUpdateCache[key]
+	collectionItemsAuditUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			collectionItemsAuditAllColumns,
+			collectionItemsAuditPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update collection_items_audit, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"collection_items_audit\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, collectionItemsAuditPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(collectionItemsAuditType, collectionItemsAuditMapping, append(wl, collectionItemsAuditPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update collection_items_audit row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for collection_items_audit")
+	}
+
+	if !cached {
+		collectionItemsAuditUpdateCacheMut.Lock()
+		collectionItemsAuditUpdateCache[key] = cache
+		collectionItemsAuditUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q collectionItemsAuditQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for collection_items_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for collection_items_audit")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CollectionItemsAuditSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), collectionItemsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"collection_items_audit\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, collectionItemsAuditPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in collectionItemsAudit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all collectionItemsAudit")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *CollectionItemsAudit) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no collection_items_audit provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(collectionItemsAuditColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	collectionItemsAuditUpsertCacheMut.RLock()
+	cache, cached := collectionItemsAuditUpsertCache[key]
+	collectionItemsAuditUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			collectionItemsAuditAllColumns,
+			collectionItemsAuditColumnsWithDefault,
+			collectionItemsAuditColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			collectionItemsAuditAllColumns,
+			collectionItemsAuditPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert collection_items_audit, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(collectionItemsAuditPrimaryKeyColumns))
+			copy(conflict, collectionItemsAuditPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"collection_items_audit\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(collectionItemsAuditType, collectionItemsAuditMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(collectionItemsAuditType, collectionItemsAuditMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert collection_items_audit")
+	}
+
+	if !cached {
+		collectionItemsAuditUpsertCacheMut.Lock()
+		collectionItemsAuditUpsertCache[key] = cache
+		collectionItemsAuditUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single CollectionItemsAudit record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *CollectionItemsAudit) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no CollectionItemsAudit provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), collectionItemsAuditPrimaryKeyMapping)
+	sql := "DELETE FROM \"collection_items_audit\" WHERE \"id\"=$1 AND \"contributed_by\"=$2 AND \"contributed_at\"=$3"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from collection_items_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for collection_items_audit")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q collectionItemsAuditQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no collectionItemsAuditQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from collection_items_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for collection_items_audit")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CollectionItemsAuditSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(collectionItemsAuditBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), collectionItemsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"collection_items_audit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, collectionItemsAuditPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from collectionItemsAudit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for collection_items_audit")
+	}
+
+	if len(collectionItemsAuditAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *CollectionItemsAudit) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindCollectionItemsAudit(ctx, exec, o.ID, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CollectionItemsAuditSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CollectionItemsAuditSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), collectionItemsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"collection_items_audit\".* FROM \"collection_items_audit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, collectionItemsAuditPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in CollectionItemsAuditSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CollectionItemsAuditExists checks if the CollectionItemsAudit row exists.
+func CollectionItemsAuditExists(ctx context.Context, exec boil.ContextExecutor, iD int, contributedBy int, contributedAt time.Time) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"collection_items_audit\" where \"id\"=$1 AND \"contributed_by\"=$2 AND \"contributed_at\"=$3 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD, contributedBy, contributedAt)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD, contributedBy, contributedAt)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if collection_items_audit exists")
+	}
+
+	return exists, nil
+}
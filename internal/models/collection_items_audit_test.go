@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testCollectionItemsAudits(t *testing.T) {
+	t.Parallel()
+
+	query := CollectionItemsAudits()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testCollectionItemsAuditsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItemsAudit{}
+	if err = randomize.Struct(seed, o, collectionItemsAuditDBTypes, true, collectionItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CollectionItemsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCollectionItemsAuditsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItemsAudit{}
+	if err = randomize.Struct(seed, o, collectionItemsAuditDBTypes, true, collectionItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := CollectionItemsAudits().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CollectionItemsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCollectionItemsAuditsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItemsAudit{}
+	if err = randomize.Struct(seed, o, collectionItemsAuditDBTypes, true, collectionItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CollectionItemsAuditSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CollectionItemsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCollectionItemsAuditsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItemsAudit{}
+	if err = randomize.Struct(seed, o, collectionItemsAuditDBTypes, true, collectionItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := CollectionItemsAuditExists(ctx, tx, o.ID, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		t.Errorf("Unable to check if CollectionItemsAudit exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected CollectionItemsAuditExists to return true, but got false.")
+	}
+}
+
+func testCollectionItemsAuditsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItemsAudit{}
+	if err = randomize.Struct(seed, o, collectionItemsAuditDBTypes, true, collectionItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	collectionItemsAuditFound, err := FindCollectionItemsAudit(ctx, tx, o.ID, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if collectionItemsAuditFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testCollectionItemsAuditsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItemsAudit{}
+	if err = randomize.Struct(seed, o, collectionItemsAuditDBTypes, true, collectionItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = CollectionItemsAudits().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCollectionItemsAuditsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItemsAudit{}
+	if err = randomize.Struct(seed, o, collectionItemsAuditDBTypes, true, collectionItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := CollectionItemsAudits().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testCollectionItemsAuditsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	collectionItemsAuditOne := &CollectionItemsAudit{}
+	collectionItemsAuditTwo := &CollectionItemsAudit{}
+	if err = randomize.Struct(seed, collectionItemsAuditOne, collectionItemsAuditDBTypes, false, collectionItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItemsAudit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, collectionItemsAuditTwo, collectionItemsAuditDBTypes, false, collectionItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = collectionItemsAuditOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = collectionItemsAuditTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := CollectionItemsAudits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testCollectionItemsAuditsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	collectionItemsAuditOne := &CollectionItemsAudit{}
+	collectionItemsAuditTwo := &CollectionItemsAudit{}
+	if err = randomize.Struct(seed, collectionItemsAuditOne, collectionItemsAuditDBTypes, false, collectionItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItemsAudit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, collectionItemsAuditTwo, collectionItemsAuditDBTypes, false, collectionItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = collectionItemsAuditOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = collectionItemsAuditTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CollectionItemsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func collectionItemsAuditBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *CollectionItemsAudit) error {
+	*o = CollectionItemsAudit{}
+	return nil
+}
+
+func collectionItemsAuditAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *CollectionItemsAudit) error {
+	*o = CollectionItemsAudit{}
+	return nil
+}
+
+func collectionItemsAuditAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *CollectionItemsAudit) error {
+	*o = CollectionItemsAudit{}
+	return nil
+}
+
+func collectionItemsAuditBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *CollectionItemsAudit) error {
+	*o = CollectionItemsAudit{}
+	return nil
+}
+
+func collectionItemsAuditAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *CollectionItemsAudit) error {
+	*o = CollectionItemsAudit{}
+	return nil
+}
+
+func collectionItemsAuditBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *CollectionItemsAudit) error {
+	*o = CollectionItemsAudit{}
+	return nil
+}
+
+func collectionItemsAuditAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *CollectionItemsAudit) error {
+	*o = CollectionItemsAudit{}
+	return nil
+}
+
+func collectionItemsAuditBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *CollectionItemsAudit) error {
+	*o = CollectionItemsAudit{}
+	return nil
+}
+
+func collectionItemsAuditAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *CollectionItemsAudit) error {
+	*o = CollectionItemsAudit{}
+	return nil
+}
+
+func testCollectionItemsAuditsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &CollectionItemsAudit{}
+	o := &CollectionItemsAudit{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, collectionItemsAuditDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize CollectionItemsAudit object: %s", err)
+	}
+
+	AddCollectionItemsAuditHook(boil.BeforeInsertHook, collectionItemsAuditBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	collectionItemsAuditBeforeInsertHooks = []CollectionItemsAuditHook{}
+
+	AddCollectionItemsAuditHook(boil.AfterInsertHook, collectionItemsAuditAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	collectionItemsAuditAfterInsertHooks = []CollectionItemsAuditHook{}
+
+	AddCollectionItemsAuditHook(boil.AfterSelectHook, collectionItemsAuditAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	collectionItemsAuditAfterSelectHooks = []CollectionItemsAuditHook{}
+
+	AddCollectionItemsAuditHook(boil.BeforeUpdateHook, collectionItemsAuditBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	collectionItemsAuditBeforeUpdateHooks = []CollectionItemsAuditHook{}
+
+	AddCollectionItemsAuditHook(boil.AfterUpdateHook, collectionItemsAuditAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	collectionItemsAuditAfterUpdateHooks = []CollectionItemsAuditHook{}
+
+	AddCollectionItemsAuditHook(boil.BeforeDeleteHook, collectionItemsAuditBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	collectionItemsAuditBeforeDeleteHooks = []CollectionItemsAuditHook{}
+
+	AddCollectionItemsAuditHook(boil.AfterDeleteHook, collectionItemsAuditAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	collectionItemsAuditAfterDeleteHooks = []CollectionItemsAuditHook{}
+
+	AddCollectionItemsAuditHook(boil.BeforeUpsertHook, collectionItemsAuditBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	collectionItemsAuditBeforeUpsertHooks = []CollectionItemsAuditHook{}
+
+	AddCollectionItemsAuditHook(boil.AfterUpsertHook, collectionItemsAuditAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	collectionItemsAuditAfterUpsertHooks = []CollectionItemsAuditHook{}
+}
+
+func testCollectionItemsAuditsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItemsAudit{}
+	if err = randomize.Struct(seed, o, collectionItemsAuditDBTypes, true, collectionItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CollectionItemsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCollectionItemsAuditsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItemsAudit{}
+	if err = randomize.Struct(seed, o, collectionItemsAuditDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize CollectionItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(collectionItemsAuditColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CollectionItemsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCollectionItemsAuditsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItemsAudit{}
+	if err = randomize.Struct(seed, o, collectionItemsAuditDBTypes, true, collectionItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCollectionItemsAuditsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItemsAudit{}
+	if err = randomize.Struct(seed, o, collectionItemsAuditDBTypes, true, collectionItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CollectionItemsAuditSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCollectionItemsAuditsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItemsAudit{}
+	if err = randomize.Struct(seed, o, collectionItemsAuditDBTypes, true, collectionItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := CollectionItemsAudits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	collectionItemsAuditDBTypes = map[string]string{`ID`: `integer`, `CollectionID`: `integer`, `Position`: `integer`, `FilmID`: `integer`, `SeriesID`: `integer`, `ContributedBy`: `integer`, `ContributedAt`: `timestamp with time zone`, `Invalidation`: `character varying`, `AuditAction`: `character varying`, `AuditActor`: `integer`}
+	_                           = bytes.MinRead
+)
+
+func testCollectionItemsAuditsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(collectionItemsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(collectionItemsAuditAllColumns) == len(collectionItemsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItemsAudit{}
+	if err = randomize.Struct(seed, o, collectionItemsAuditDBTypes, true, collectionItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CollectionItemsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, collectionItemsAuditDBTypes, true, collectionItemsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CollectionItemsAudit struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testCollectionItemsAuditsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(collectionItemsAuditAllColumns) == len(collectionItemsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItemsAudit{}
+	if err = randomize.Struct(seed, o, collectionItemsAuditDBTypes, true, collectionItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CollectionItemsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, collectionItemsAuditDBTypes, true, collectionItemsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CollectionItemsAudit struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(collectionItemsAuditAllColumns, collectionItemsAuditPrimaryKeyColumns) {
+		fields = collectionItemsAuditAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			collectionItemsAuditAllColumns,
+			collectionItemsAuditPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := CollectionItemsAuditSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testCollectionItemsAuditsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(collectionItemsAuditAllColumns) == len(collectionItemsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := CollectionItemsAudit{}
+	if err = randomize.Struct(seed, &o, collectionItemsAuditDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize CollectionItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert CollectionItemsAudit: %s", err)
+	}
+
+	count, err := CollectionItemsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, collectionItemsAuditDBTypes, false, collectionItemsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CollectionItemsAudit struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert CollectionItemsAudit: %s", err)
+	}
+
+	count, err = CollectionItemsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1268 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testCollectionItems(t *testing.T) {
+	t.Parallel()
+
+	query := CollectionItems()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testCollectionItemsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItem{}
+	if err = randomize.Struct(seed, o, collectionItemDBTypes, true, collectionItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CollectionItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCollectionItemsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItem{}
+	if err = randomize.Struct(seed, o, collectionItemDBTypes, true, collectionItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := CollectionItems().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CollectionItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCollectionItemsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItem{}
+	if err = randomize.Struct(seed, o, collectionItemDBTypes, true, collectionItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CollectionItemSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CollectionItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCollectionItemsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItem{}
+	if err = randomize.Struct(seed, o, collectionItemDBTypes, true, collectionItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := CollectionItemExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if CollectionItem exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected CollectionItemExists to return true, but got false.")
+	}
+}
+
+func testCollectionItemsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItem{}
+	if err = randomize.Struct(seed, o, collectionItemDBTypes, true, collectionItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	collectionItemFound, err := FindCollectionItem(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if collectionItemFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testCollectionItemsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItem{}
+	if err = randomize.Struct(seed, o, collectionItemDBTypes, true, collectionItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = CollectionItems().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCollectionItemsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItem{}
+	if err = randomize.Struct(seed, o, collectionItemDBTypes, true, collectionItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := CollectionItems().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testCollectionItemsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	collectionItemOne := &CollectionItem{}
+	collectionItemTwo := &CollectionItem{}
+	if err = randomize.Struct(seed, collectionItemOne, collectionItemDBTypes, false, collectionItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+	if err = randomize.Struct(seed, collectionItemTwo, collectionItemDBTypes, false, collectionItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = collectionItemOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = collectionItemTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := CollectionItems().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testCollectionItemsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	collectionItemOne := &CollectionItem{}
+	collectionItemTwo := &CollectionItem{}
+	if err = randomize.Struct(seed, collectionItemOne, collectionItemDBTypes, false, collectionItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+	if err = randomize.Struct(seed, collectionItemTwo, collectionItemDBTypes, false, collectionItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = collectionItemOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = collectionItemTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CollectionItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func collectionItemBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *CollectionItem) error {
+	*o = CollectionItem{}
+	return nil
+}
+
+func collectionItemAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *CollectionItem) error {
+	*o = CollectionItem{}
+	return nil
+}
+
+func collectionItemAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *CollectionItem) error {
+	*o = CollectionItem{}
+	return nil
+}
+
+func collectionItemBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *CollectionItem) error {
+	*o = CollectionItem{}
+	return nil
+}
+
+func collectionItemAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *CollectionItem) error {
+	*o = CollectionItem{}
+	return nil
+}
+
+func collectionItemBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *CollectionItem) error {
+	*o = CollectionItem{}
+	return nil
+}
+
+func collectionItemAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *CollectionItem) error {
+	*o = CollectionItem{}
+	return nil
+}
+
+func collectionItemBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *CollectionItem) error {
+	*o = CollectionItem{}
+	return nil
+}
+
+func collectionItemAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *CollectionItem) error {
+	*o = CollectionItem{}
+	return nil
+}
+
+func testCollectionItemsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &CollectionItem{}
+	o := &CollectionItem{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, collectionItemDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize CollectionItem object: %s", err)
+	}
+
+	AddCollectionItemHook(boil.BeforeInsertHook, collectionItemBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	collectionItemBeforeInsertHooks = []CollectionItemHook{}
+
+	AddCollectionItemHook(boil.AfterInsertHook, collectionItemAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	collectionItemAfterInsertHooks = []CollectionItemHook{}
+
+	AddCollectionItemHook(boil.AfterSelectHook, collectionItemAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	collectionItemAfterSelectHooks = []CollectionItemHook{}
+
+	AddCollectionItemHook(boil.BeforeUpdateHook, collectionItemBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	collectionItemBeforeUpdateHooks = []CollectionItemHook{}
+
+	AddCollectionItemHook(boil.AfterUpdateHook, collectionItemAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	collectionItemAfterUpdateHooks = []CollectionItemHook{}
+
+	AddCollectionItemHook(boil.BeforeDeleteHook, collectionItemBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	collectionItemBeforeDeleteHooks = []CollectionItemHook{}
+
+	AddCollectionItemHook(boil.AfterDeleteHook, collectionItemAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	collectionItemAfterDeleteHooks = []CollectionItemHook{}
+
+	AddCollectionItemHook(boil.BeforeUpsertHook, collectionItemBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	collectionItemBeforeUpsertHooks = []CollectionItemHook{}
+
+	AddCollectionItemHook(boil.AfterUpsertHook, collectionItemAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	collectionItemAfterUpsertHooks = []CollectionItemHook{}
+}
+
+func testCollectionItemsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItem{}
+	if err = randomize.Struct(seed, o, collectionItemDBTypes, true, collectionItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CollectionItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCollectionItemsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItem{}
+	if err = randomize.Struct(seed, o, collectionItemDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(collectionItemColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CollectionItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCollectionItemToOneUserUsingContributingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local CollectionItem
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, collectionItemDBTypes, false, collectionItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ContributedBy = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ContributingUser().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := CollectionItemSlice{&local}
+	if err = local.L.LoadContributingUser(ctx, tx, false, (*[]*CollectionItem)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ContributingUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ContributingUser = nil
+	if err = local.L.LoadContributingUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ContributingUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testCollectionItemToOneCollectionUsingCollection(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local CollectionItem
+	var foreign Collection
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, collectionItemDBTypes, false, collectionItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, collectionDBTypes, false, collectionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Collection struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.CollectionID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Collection().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := CollectionItemSlice{&local}
+	if err = local.L.LoadCollection(ctx, tx, false, (*[]*CollectionItem)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Collection == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Collection = nil
+	if err = local.L.LoadCollection(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Collection == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testCollectionItemToOneFilmUsingFilm(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local CollectionItem
+	var foreign Film
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, collectionItemDBTypes, true, collectionItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, filmDBTypes, false, filmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Film struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.FilmID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Film().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := CollectionItemSlice{&local}
+	if err = local.L.LoadFilm(ctx, tx, false, (*[]*CollectionItem)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Film == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Film = nil
+	if err = local.L.LoadFilm(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Film == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testCollectionItemToOneSeriesUsingSeries(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local CollectionItem
+	var foreign Series
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, collectionItemDBTypes, true, collectionItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, seriesDBTypes, false, seriesColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Series struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.SeriesID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Series().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := CollectionItemSlice{&local}
+	if err = local.L.LoadSeries(ctx, tx, false, (*[]*CollectionItem)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Series == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Series = nil
+	if err = local.L.LoadSeries(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Series == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testCollectionItemToOneSetOpUserUsingContributingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollectionItem
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collectionItemDBTypes, false, strmangle.SetComplement(collectionItemPrimaryKeyColumns, collectionItemColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetContributingUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ContributingUser != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ContributedCollectionItems[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ContributedBy != x.ID {
+			t.Error("foreign key was wrong value", a.ContributedBy)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ContributedBy))
+		reflect.Indirect(reflect.ValueOf(&a.ContributedBy)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ContributedBy != x.ID {
+			t.Error("foreign key was wrong value", a.ContributedBy, x.ID)
+		}
+	}
+}
+func testCollectionItemToOneSetOpCollectionUsingCollection(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollectionItem
+	var b, c Collection
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collectionItemDBTypes, false, strmangle.SetComplement(collectionItemPrimaryKeyColumns, collectionItemColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, collectionDBTypes, false, strmangle.SetComplement(collectionPrimaryKeyColumns, collectionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, collectionDBTypes, false, strmangle.SetComplement(collectionPrimaryKeyColumns, collectionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Collection{&b, &c} {
+		err = a.SetCollection(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Collection != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.CollectionItems[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.CollectionID != x.ID {
+			t.Error("foreign key was wrong value", a.CollectionID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.CollectionID))
+		reflect.Indirect(reflect.ValueOf(&a.CollectionID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.CollectionID != x.ID {
+			t.Error("foreign key was wrong value", a.CollectionID, x.ID)
+		}
+	}
+}
+func testCollectionItemToOneSetOpFilmUsingFilm(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollectionItem
+	var b, c Film
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collectionItemDBTypes, false, strmangle.SetComplement(collectionItemPrimaryKeyColumns, collectionItemColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Film{&b, &c} {
+		err = a.SetFilm(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Film != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.CollectionItems[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.FilmID, x.ID) {
+			t.Error("foreign key was wrong value", a.FilmID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.FilmID))
+		reflect.Indirect(reflect.ValueOf(&a.FilmID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.FilmID, x.ID) {
+			t.Error("foreign key was wrong value", a.FilmID, x.ID)
+		}
+	}
+}
+
+func testCollectionItemToOneRemoveOpFilmUsingFilm(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollectionItem
+	var b Film
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collectionItemDBTypes, false, strmangle.SetComplement(collectionItemPrimaryKeyColumns, collectionItemColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetFilm(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveFilm(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Film().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Film != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.FilmID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.CollectionItems) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testCollectionItemToOneSetOpSeriesUsingSeries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollectionItem
+	var b, c Series
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collectionItemDBTypes, false, strmangle.SetComplement(collectionItemPrimaryKeyColumns, collectionItemColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Series{&b, &c} {
+		err = a.SetSeries(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Series != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.SeriesCollectionItems[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.SeriesID, x.ID) {
+			t.Error("foreign key was wrong value", a.SeriesID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.SeriesID))
+		reflect.Indirect(reflect.ValueOf(&a.SeriesID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.SeriesID, x.ID) {
+			t.Error("foreign key was wrong value", a.SeriesID, x.ID)
+		}
+	}
+}
+
+func testCollectionItemToOneRemoveOpSeriesUsingSeries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a CollectionItem
+	var b Series
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, collectionItemDBTypes, false, strmangle.SetComplement(collectionItemPrimaryKeyColumns, collectionItemColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetSeries(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveSeries(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Series().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Series != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.SeriesID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.SeriesCollectionItems) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testCollectionItemsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItem{}
+	if err = randomize.Struct(seed, o, collectionItemDBTypes, true, collectionItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCollectionItemsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItem{}
+	if err = randomize.Struct(seed, o, collectionItemDBTypes, true, collectionItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CollectionItemSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCollectionItemsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItem{}
+	if err = randomize.Struct(seed, o, collectionItemDBTypes, true, collectionItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := CollectionItems().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	collectionItemDBTypes = map[string]string{`ID`: `integer`, `CollectionID`: `integer`, `Position`: `integer`, `FilmID`: `integer`, `SeriesID`: `integer`, `ContributedBy`: `integer`, `ContributedAt`: `timestamp with time zone`, `Invalidation`: `character varying`}
+	_                     = bytes.MinRead
+)
+
+func testCollectionItemsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(collectionItemPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(collectionItemAllColumns) == len(collectionItemPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItem{}
+	if err = randomize.Struct(seed, o, collectionItemDBTypes, true, collectionItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CollectionItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, collectionItemDBTypes, true, collectionItemPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testCollectionItemsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(collectionItemAllColumns) == len(collectionItemPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionItem{}
+	if err = randomize.Struct(seed, o, collectionItemDBTypes, true, collectionItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CollectionItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, collectionItemDBTypes, true, collectionItemPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(collectionItemAllColumns, collectionItemPrimaryKeyColumns) {
+		fields = collectionItemAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			collectionItemAllColumns,
+			collectionItemPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := CollectionItemSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testCollectionItemsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(collectionItemAllColumns) == len(collectionItemPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := CollectionItem{}
+	if err = randomize.Struct(seed, &o, collectionItemDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert CollectionItem: %s", err)
+	}
+
+	count, err := CollectionItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, collectionItemDBTypes, false, collectionItemPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CollectionItem struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert CollectionItem: %s", err)
+	}
+
+	count, err = CollectionItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
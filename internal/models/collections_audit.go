@@ -0,0 +1,929 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// CollectionsAudit is an object representing the database table.
+type CollectionsAudit struct {
+	ID            int         `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	Title         string      `db:"title" boil:"title" json:"title" toml:"title" yaml:"title"`
+	Descriptions  null.String `db:"descriptions" boil:"descriptions" json:"descriptions,omitempty" toml:"descriptions" yaml:"descriptions,omitempty"`
+	ContributedBy int         `db:"contributed_by" boil:"contributed_by" json:"contributed_by" toml:"contributed_by" yaml:"contributed_by"`
+	ContributedAt time.Time   `db:"contributed_at" boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
+	Invalidation  null.String `db:"invalidation" boil:"invalidation" json:"invalidation,omitempty" toml:"invalidation" yaml:"invalidation,omitempty"`
+	AuditAction   string      `db:"audit_action" boil:"audit_action" json:"audit_action" toml:"audit_action" yaml:"audit_action"`
+	AuditActor    null.Int    `db:"audit_actor" boil:"audit_actor" json:"audit_actor,omitempty" toml:"audit_actor" yaml:"audit_actor,omitempty"`
+
+	R *collectionsAuditR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L collectionsAuditL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CollectionsAuditColumns = struct {
+	ID            string
+	Title         string
+	Descriptions  string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+	AuditAction   string
+	AuditActor    string
+}{
+	ID:            "id",
+	Title:         "title",
+	Descriptions:  "descriptions",
+	ContributedBy: "contributed_by",
+	ContributedAt: "contributed_at",
+	Invalidation:  "invalidation",
+	AuditAction:   "audit_action",
+	AuditActor:    "audit_actor",
+}
+
+var CollectionsAuditTableColumns = struct {
+	ID            string
+	Title         string
+	Descriptions  string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+	AuditAction   string
+	AuditActor    string
+}{
+	ID:            "collections_audit.id",
+	Title:         "collections_audit.title",
+	Descriptions:  "collections_audit.descriptions",
+	ContributedBy: "collections_audit.contributed_by",
+	ContributedAt: "collections_audit.contributed_at",
+	Invalidation:  "collections_audit.invalidation",
+	AuditAction:   "collections_audit.audit_action",
+	AuditActor:    "collections_audit.audit_actor",
+}
+
+// Generated where
+
+var CollectionsAuditWhere = struct {
+	ID            whereHelperint
+	Title         whereHelperstring
+	Descriptions  whereHelpernull_String
+	ContributedBy whereHelperint
+	ContributedAt whereHelpertime_Time
+	Invalidation  whereHelpernull_String
+	AuditAction   whereHelperstring
+	AuditActor    whereHelpernull_Int
+}{
+	ID:            whereHelperint{field: "\"collections_audit\".\"id\""},
+	Title:         whereHelperstring{field: "\"collections_audit\".\"title\""},
+	Descriptions:  whereHelpernull_String{field: "\"collections_audit\".\"descriptions\""},
+	ContributedBy: whereHelperint{field: "\"collections_audit\".\"contributed_by\""},
+	ContributedAt: whereHelpertime_Time{field: "\"collections_audit\".\"contributed_at\""},
+	Invalidation:  whereHelpernull_String{field: "\"collections_audit\".\"invalidation\""},
+	AuditAction:   whereHelperstring{field: "\"collections_audit\".\"audit_action\""},
+	AuditActor:    whereHelpernull_Int{field: "\"collections_audit\".\"audit_actor\""},
+}
+
+// CollectionsAuditRels is where relationship names are stored.
+var CollectionsAuditRels = struct {
+}{}
+
+// collectionsAuditR is where relationships are stored.
+type collectionsAuditR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*collectionsAuditR) NewStruct() *collectionsAuditR {
+	return &collectionsAuditR{}
+}
+
+// collectionsAuditL is where Load methods for each relationship are stored.
+type collectionsAuditL struct{}
+
+var (
+	collectionsAuditAllColumns            = []string{"id", "title", "descriptions", "contributed_by", "contributed_at", "invalidation", "audit_action", "audit_actor"}
+	collectionsAuditColumnsWithoutDefault = []string{"id", "title", "contributed_by", "contributed_at"}
+	collectionsAuditColumnsWithDefault    = []string{"descriptions", "invalidation", "audit_action", "audit_actor"}
+	collectionsAuditPrimaryKeyColumns     = []string{"id", "contributed_by", "contributed_at"}
+	collectionsAuditGeneratedColumns      = []string{}
+)
+
+type (
+	// CollectionsAuditSlice is an alias for a slice of pointers to CollectionsAudit.
+	// This should almost always be used instead of []CollectionsAudit.
+	CollectionsAuditSlice []*CollectionsAudit
+	// CollectionsAuditHook is the signature for custom CollectionsAudit hook methods
+	CollectionsAuditHook func(context.Context, boil.ContextExecutor, *CollectionsAudit) error
+
+	collectionsAuditQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	collectionsAuditType                 = reflect.TypeOf(&CollectionsAudit{})
+	collectionsAuditMapping              = queries.MakeStructMapping(collectionsAuditType)
+	collectionsAuditPrimaryKeyMapping, _ = queries.BindMapping(collectionsAuditType, collectionsAuditMapping, collectionsAuditPrimaryKeyColumns)
+	collectionsAuditInsertCacheMut       sync.RWMutex
+	collectionsAuditInsertCache          = make(map[string]insertCache)
+	collectionsAuditUpdateCacheMut       sync.RWMutex
+	collectionsAuditUpdateCache          = make(map[string]updateCache)
+	collectionsAuditUpsertCacheMut       sync.RWMutex
+	collectionsAuditUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var collectionsAuditAfterSelectHooks []CollectionsAuditHook
+
+var collectionsAuditBeforeInsertHooks []CollectionsAuditHook
+var collectionsAuditAfterInsertHooks []CollectionsAuditHook
+
+var collectionsAuditBeforeUpdateHooks []CollectionsAuditHook
+var collectionsAuditAfterUpdateHooks []CollectionsAuditHook
+
+var collectionsAuditBeforeDeleteHooks []CollectionsAuditHook
+var collectionsAuditAfterDeleteHooks []CollectionsAuditHook
+
+var collectionsAuditBeforeUpsertHooks []CollectionsAuditHook
+var collectionsAuditAfterUpsertHooks []CollectionsAuditHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *CollectionsAudit) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionsAuditAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *CollectionsAudit) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionsAuditBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *CollectionsAudit) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionsAuditAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *CollectionsAudit) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionsAuditBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *CollectionsAudit) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionsAuditAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *CollectionsAudit) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionsAuditBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *CollectionsAudit) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionsAuditAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *CollectionsAudit) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionsAuditBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *CollectionsAudit) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range collectionsAuditAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCollectionsAuditHook registers your hook function for all future operations.
+func AddCollectionsAuditHook(hookPoint boil.HookPoint, collectionsAuditHook CollectionsAuditHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		collectionsAuditAfterSelectHooks = append(collectionsAuditAfterSelectHooks, collectionsAuditHook)
+	case boil.BeforeInsertHook:
+		collectionsAuditBeforeInsertHooks = append(collectionsAuditBeforeInsertHooks, collectionsAuditHook)
+	case boil.AfterInsertHook:
+		collectionsAuditAfterInsertHooks = append(collectionsAuditAfterInsertHooks, collectionsAuditHook)
+	case boil.BeforeUpdateHook:
+		collectionsAuditBeforeUpdateHooks = append(collectionsAuditBeforeUpdateHooks, collectionsAuditHook)
+	case boil.AfterUpdateHook:
+		collectionsAuditAfterUpdateHooks = append(collectionsAuditAfterUpdateHooks, collectionsAuditHook)
+	case boil.BeforeDeleteHook:
+		collectionsAuditBeforeDeleteHooks = append(collectionsAuditBeforeDeleteHooks, collectionsAuditHook)
+	case boil.AfterDeleteHook:
+		collectionsAuditAfterDeleteHooks = append(collectionsAuditAfterDeleteHooks, collectionsAuditHook)
+	case boil.BeforeUpsertHook:
+		collectionsAuditBeforeUpsertHooks = append(collectionsAuditBeforeUpsertHooks, collectionsAuditHook)
+	case boil.AfterUpsertHook:
+		collectionsAuditAfterUpsertHooks = append(collectionsAuditAfterUpsertHooks, collectionsAuditHook)
+	}
+}
+
+// One returns a single collectionsAudit record from the query.
+func (q collectionsAuditQuery) One(ctx context.Context, exec boil.ContextExecutor) (*CollectionsAudit, error) {
+	o := &CollectionsAudit{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for collections_audit")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all CollectionsAudit records from the query.
+func (q collectionsAuditQuery) All(ctx context.Context, exec boil.ContextExecutor) (CollectionsAuditSlice, error) {
+	var o []*CollectionsAudit
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to CollectionsAudit slice")
+	}
+
+	if len(collectionsAuditAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all CollectionsAudit records in the query.
+func (q collectionsAuditQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count collections_audit rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q collectionsAuditQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if collections_audit exists")
+	}
+
+	return count > 0, nil
+}
+
+// CollectionsAudits retrieves all the records using an executor.
+func CollectionsAudits(mods ...qm.QueryMod) collectionsAuditQuery {
+	mods = append(mods, qm.From("\"collections_audit\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"collections_audit\".*"})
+	}
+
+	return collectionsAuditQuery{q}
+}
+
+// FindCollectionsAudit retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCollectionsAudit(ctx context.Context, exec boil.ContextExecutor, iD int, contributedBy int, contributedAt time.Time, selectCols ...string) (*CollectionsAudit, error) {
+	collectionsAuditObj := &CollectionsAudit{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"collections_audit\" where \"id\"=$1 AND \"contributed_by\"=$2 AND \"contributed_at\"=$3", sel,
+	)
+
+	q := queries.Raw(query, iD, contributedBy, contributedAt)
+
+	err := q.Bind(ctx, exec, collectionsAuditObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from collections_audit")
+	}
+
+	if err = collectionsAuditObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return collectionsAuditObj, err
+	}
+
+	return collectionsAuditObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *CollectionsAudit) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no collections_audit provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(collectionsAuditColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	collectionsAuditInsertCacheMut.RLock()
+	cache, cached := collectionsAuditInsertCache[key]
+	collectionsAuditInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			collectionsAuditAllColumns,
+			collectionsAuditColumnsWithDefault,
+			collectionsAuditColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(collectionsAuditType, collectionsAuditMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(collectionsAuditType, collectionsAuditMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"collections_audit\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"collections_audit\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into collections_audit")
+	}
+
+	if !cached {
+		collectionsAuditInsertCacheMut.Lock()
+		collectionsAuditInsertCache[key] = cache
+		collectionsAuditInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the CollectionsAudit.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *CollectionsAudit) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	collectionsAuditUpdateCacheMut.RLock()
+	cache, cached := collectionsAuditUpdateCache[key]
+	collectionsAuditUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			collectionsAuditAllColumns,
+			collectionsAuditPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update collections_audit, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"collections_audit\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, collectionsAuditPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(collectionsAuditType, collectionsAuditMapping, append(wl, collectionsAuditPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update collections_audit row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for collections_audit")
+	}
+
+	if !cached {
+		collectionsAuditUpdateCacheMut.Lock()
+		collectionsAuditUpdateCache[key] = cache
+		collectionsAuditUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q collectionsAuditQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for collections_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for collections_audit")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CollectionsAuditSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), collectionsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"collections_audit\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, collectionsAuditPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in collectionsAudit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all collectionsAudit")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *CollectionsAudit) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no collections_audit provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(collectionsAuditColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	collectionsAuditUpsertCacheMut.RLock()
+	cache, cached := collectionsAuditUpsertCache[key]
+	collectionsAuditUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			collectionsAuditAllColumns,
+			collectionsAuditColumnsWithDefault,
+			collectionsAuditColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			collectionsAuditAllColumns,
+			collectionsAuditPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert collections_audit, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(collectionsAuditPrimaryKeyColumns))
+			copy(conflict, collectionsAuditPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"collections_audit\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(collectionsAuditType, collectionsAuditMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(collectionsAuditType, collectionsAuditMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert collections_audit")
+	}
+
+	if !cached {
+		collectionsAuditUpsertCacheMut.Lock()
+		collectionsAuditUpsertCache[key] = cache
+		collectionsAuditUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single CollectionsAudit record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *CollectionsAudit) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no CollectionsAudit provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), collectionsAuditPrimaryKeyMapping)
+	sql := "DELETE FROM \"collections_audit\" WHERE \"id\"=$1 AND \"contributed_by\"=$2 AND \"contributed_at\"=$3"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from collections_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for collections_audit")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q collectionsAuditQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no collectionsAuditQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from collections_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for collections_audit")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CollectionsAuditSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(collectionsAuditBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), collectionsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"collections_audit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, collectionsAuditPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from collectionsAudit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for collections_audit")
+	}
+
+	if len(collectionsAuditAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *CollectionsAudit) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindCollectionsAudit(ctx, exec, o.ID, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CollectionsAuditSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CollectionsAuditSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), collectionsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"collections_audit\".* FROM \"collections_audit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, collectionsAuditPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in CollectionsAuditSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CollectionsAuditExists checks if the CollectionsAudit row exists.
+func CollectionsAuditExists(ctx context.Context, exec boil.ContextExecutor, iD int, contributedBy int, contributedAt time.Time) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"collections_audit\" where \"id\"=$1 AND \"contributed_by\"=$2 AND \"contributed_at\"=$3 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD, contributedBy, contributedAt)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD, contributedBy, contributedAt)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if collections_audit exists")
+	}
+
+	return exists, nil
+}
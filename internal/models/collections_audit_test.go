@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testCollectionsAudits(t *testing.T) {
+	t.Parallel()
+
+	query := CollectionsAudits()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testCollectionsAuditsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionsAudit{}
+	if err = randomize.Struct(seed, o, collectionsAuditDBTypes, true, collectionsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CollectionsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCollectionsAuditsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionsAudit{}
+	if err = randomize.Struct(seed, o, collectionsAuditDBTypes, true, collectionsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := CollectionsAudits().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CollectionsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCollectionsAuditsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionsAudit{}
+	if err = randomize.Struct(seed, o, collectionsAuditDBTypes, true, collectionsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CollectionsAuditSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CollectionsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCollectionsAuditsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionsAudit{}
+	if err = randomize.Struct(seed, o, collectionsAuditDBTypes, true, collectionsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := CollectionsAuditExists(ctx, tx, o.ID, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		t.Errorf("Unable to check if CollectionsAudit exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected CollectionsAuditExists to return true, but got false.")
+	}
+}
+
+func testCollectionsAuditsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionsAudit{}
+	if err = randomize.Struct(seed, o, collectionsAuditDBTypes, true, collectionsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	collectionsAuditFound, err := FindCollectionsAudit(ctx, tx, o.ID, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if collectionsAuditFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testCollectionsAuditsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionsAudit{}
+	if err = randomize.Struct(seed, o, collectionsAuditDBTypes, true, collectionsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = CollectionsAudits().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCollectionsAuditsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionsAudit{}
+	if err = randomize.Struct(seed, o, collectionsAuditDBTypes, true, collectionsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := CollectionsAudits().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testCollectionsAuditsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	collectionsAuditOne := &CollectionsAudit{}
+	collectionsAuditTwo := &CollectionsAudit{}
+	if err = randomize.Struct(seed, collectionsAuditOne, collectionsAuditDBTypes, false, collectionsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionsAudit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, collectionsAuditTwo, collectionsAuditDBTypes, false, collectionsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = collectionsAuditOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = collectionsAuditTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := CollectionsAudits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testCollectionsAuditsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	collectionsAuditOne := &CollectionsAudit{}
+	collectionsAuditTwo := &CollectionsAudit{}
+	if err = randomize.Struct(seed, collectionsAuditOne, collectionsAuditDBTypes, false, collectionsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionsAudit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, collectionsAuditTwo, collectionsAuditDBTypes, false, collectionsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = collectionsAuditOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = collectionsAuditTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CollectionsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func collectionsAuditBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *CollectionsAudit) error {
+	*o = CollectionsAudit{}
+	return nil
+}
+
+func collectionsAuditAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *CollectionsAudit) error {
+	*o = CollectionsAudit{}
+	return nil
+}
+
+func collectionsAuditAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *CollectionsAudit) error {
+	*o = CollectionsAudit{}
+	return nil
+}
+
+func collectionsAuditBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *CollectionsAudit) error {
+	*o = CollectionsAudit{}
+	return nil
+}
+
+func collectionsAuditAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *CollectionsAudit) error {
+	*o = CollectionsAudit{}
+	return nil
+}
+
+func collectionsAuditBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *CollectionsAudit) error {
+	*o = CollectionsAudit{}
+	return nil
+}
+
+func collectionsAuditAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *CollectionsAudit) error {
+	*o = CollectionsAudit{}
+	return nil
+}
+
+func collectionsAuditBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *CollectionsAudit) error {
+	*o = CollectionsAudit{}
+	return nil
+}
+
+func collectionsAuditAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *CollectionsAudit) error {
+	*o = CollectionsAudit{}
+	return nil
+}
+
+func testCollectionsAuditsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &CollectionsAudit{}
+	o := &CollectionsAudit{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, collectionsAuditDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize CollectionsAudit object: %s", err)
+	}
+
+	AddCollectionsAuditHook(boil.BeforeInsertHook, collectionsAuditBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	collectionsAuditBeforeInsertHooks = []CollectionsAuditHook{}
+
+	AddCollectionsAuditHook(boil.AfterInsertHook, collectionsAuditAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	collectionsAuditAfterInsertHooks = []CollectionsAuditHook{}
+
+	AddCollectionsAuditHook(boil.AfterSelectHook, collectionsAuditAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	collectionsAuditAfterSelectHooks = []CollectionsAuditHook{}
+
+	AddCollectionsAuditHook(boil.BeforeUpdateHook, collectionsAuditBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	collectionsAuditBeforeUpdateHooks = []CollectionsAuditHook{}
+
+	AddCollectionsAuditHook(boil.AfterUpdateHook, collectionsAuditAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	collectionsAuditAfterUpdateHooks = []CollectionsAuditHook{}
+
+	AddCollectionsAuditHook(boil.BeforeDeleteHook, collectionsAuditBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	collectionsAuditBeforeDeleteHooks = []CollectionsAuditHook{}
+
+	AddCollectionsAuditHook(boil.AfterDeleteHook, collectionsAuditAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	collectionsAuditAfterDeleteHooks = []CollectionsAuditHook{}
+
+	AddCollectionsAuditHook(boil.BeforeUpsertHook, collectionsAuditBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	collectionsAuditBeforeUpsertHooks = []CollectionsAuditHook{}
+
+	AddCollectionsAuditHook(boil.AfterUpsertHook, collectionsAuditAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	collectionsAuditAfterUpsertHooks = []CollectionsAuditHook{}
+}
+
+func testCollectionsAuditsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionsAudit{}
+	if err = randomize.Struct(seed, o, collectionsAuditDBTypes, true, collectionsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CollectionsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCollectionsAuditsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionsAudit{}
+	if err = randomize.Struct(seed, o, collectionsAuditDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize CollectionsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(collectionsAuditColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CollectionsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCollectionsAuditsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionsAudit{}
+	if err = randomize.Struct(seed, o, collectionsAuditDBTypes, true, collectionsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCollectionsAuditsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionsAudit{}
+	if err = randomize.Struct(seed, o, collectionsAuditDBTypes, true, collectionsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CollectionsAuditSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCollectionsAuditsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionsAudit{}
+	if err = randomize.Struct(seed, o, collectionsAuditDBTypes, true, collectionsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := CollectionsAudits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	collectionsAuditDBTypes = map[string]string{`ID`: `integer`, `Title`: `character varying`, `Descriptions`: `character varying`, `ContributedBy`: `integer`, `ContributedAt`: `timestamp with time zone`, `Invalidation`: `character varying`, `AuditAction`: `character varying`, `AuditActor`: `integer`}
+	_                       = bytes.MinRead
+)
+
+func testCollectionsAuditsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(collectionsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(collectionsAuditAllColumns) == len(collectionsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionsAudit{}
+	if err = randomize.Struct(seed, o, collectionsAuditDBTypes, true, collectionsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CollectionsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, collectionsAuditDBTypes, true, collectionsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CollectionsAudit struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testCollectionsAuditsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(collectionsAuditAllColumns) == len(collectionsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CollectionsAudit{}
+	if err = randomize.Struct(seed, o, collectionsAuditDBTypes, true, collectionsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CollectionsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CollectionsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, collectionsAuditDBTypes, true, collectionsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CollectionsAudit struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(collectionsAuditAllColumns, collectionsAuditPrimaryKeyColumns) {
+		fields = collectionsAuditAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			collectionsAuditAllColumns,
+			collectionsAuditPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := CollectionsAuditSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testCollectionsAuditsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(collectionsAuditAllColumns) == len(collectionsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := CollectionsAudit{}
+	if err = randomize.Struct(seed, &o, collectionsAuditDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize CollectionsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert CollectionsAudit: %s", err)
+	}
+
+	count, err := CollectionsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, collectionsAuditDBTypes, false, collectionsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CollectionsAudit struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert CollectionsAudit: %s", err)
+	}
+
+	count, err = CollectionsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1313 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ContentRating is an object representing the database table.
+type ContentRating struct {
+	ID            int         `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	FilmID        int         `db:"film_id" boil:"film_id" json:"film_id" toml:"film_id" yaml:"film_id"`
+	Region        string      `db:"region" boil:"region" json:"region" toml:"region" yaml:"region"`
+	RatingSystem  string      `db:"rating_system" boil:"rating_system" json:"rating_system" toml:"rating_system" yaml:"rating_system"`
+	Rating        string      `db:"rating" boil:"rating" json:"rating" toml:"rating" yaml:"rating"`
+	MinAge        int         `db:"min_age" boil:"min_age" json:"min_age" toml:"min_age" yaml:"min_age"`
+	ContributedBy int         `db:"contributed_by" boil:"contributed_by" json:"contributed_by" toml:"contributed_by" yaml:"contributed_by"`
+	ContributedAt time.Time   `db:"contributed_at" boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
+	Invalidation  null.String `db:"invalidation" boil:"invalidation" json:"invalidation,omitempty" toml:"invalidation" yaml:"invalidation,omitempty"`
+
+	R *contentRatingR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L contentRatingL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ContentRatingColumns = struct {
+	ID            string
+	FilmID        string
+	Region        string
+	RatingSystem  string
+	Rating        string
+	MinAge        string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+}{
+	ID:            "id",
+	FilmID:        "film_id",
+	Region:        "region",
+	RatingSystem:  "rating_system",
+	Rating:        "rating",
+	MinAge:        "min_age",
+	ContributedBy: "contributed_by",
+	ContributedAt: "contributed_at",
+	Invalidation:  "invalidation",
+}
+
+var ContentRatingTableColumns = struct {
+	ID            string
+	FilmID        string
+	Region        string
+	RatingSystem  string
+	Rating        string
+	MinAge        string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+}{
+	ID:            "content_ratings.id",
+	FilmID:        "content_ratings.film_id",
+	Region:        "content_ratings.region",
+	RatingSystem:  "content_ratings.rating_system",
+	Rating:        "content_ratings.rating",
+	MinAge:        "content_ratings.min_age",
+	ContributedBy: "content_ratings.contributed_by",
+	ContributedAt: "content_ratings.contributed_at",
+	Invalidation:  "content_ratings.invalidation",
+}
+
+// Generated where
+
+var ContentRatingWhere = struct {
+	ID            whereHelperint
+	FilmID        whereHelperint
+	Region        whereHelperstring
+	RatingSystem  whereHelperstring
+	Rating        whereHelperstring
+	MinAge        whereHelperint
+	ContributedBy whereHelperint
+	ContributedAt whereHelpertime_Time
+	Invalidation  whereHelpernull_String
+}{
+	ID:            whereHelperint{field: "\"content_ratings\".\"id\""},
+	FilmID:        whereHelperint{field: "\"content_ratings\".\"film_id\""},
+	Region:        whereHelperstring{field: "\"content_ratings\".\"region\""},
+	RatingSystem:  whereHelperstring{field: "\"content_ratings\".\"rating_system\""},
+	Rating:        whereHelperstring{field: "\"content_ratings\".\"rating\""},
+	MinAge:        whereHelperint{field: "\"content_ratings\".\"min_age\""},
+	ContributedBy: whereHelperint{field: "\"content_ratings\".\"contributed_by\""},
+	ContributedAt: whereHelpertime_Time{field: "\"content_ratings\".\"contributed_at\""},
+	Invalidation:  whereHelpernull_String{field: "\"content_ratings\".\"invalidation\""},
+}
+
+// ContentRatingRels is where relationship names are stored.
+var ContentRatingRels = struct {
+	ContributingUser string
+	Film             string
+}{
+	ContributingUser: "ContributingUser",
+	Film:             "Film",
+}
+
+// contentRatingR is where relationships are stored.
+type contentRatingR struct {
+	ContributingUser *User `db:"ContributingUser" boil:"ContributingUser" json:"ContributingUser" toml:"ContributingUser" yaml:"ContributingUser"`
+	Film             *Film `db:"Film" boil:"Film" json:"Film" toml:"Film" yaml:"Film"`
+}
+
+// NewStruct creates a new relationship struct
+func (*contentRatingR) NewStruct() *contentRatingR {
+	return &contentRatingR{}
+}
+
+func (r *contentRatingR) GetContributingUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.ContributingUser
+}
+
+func (r *contentRatingR) GetFilm() *Film {
+	if r == nil {
+		return nil
+	}
+	return r.Film
+}
+
+// contentRatingL is where Load methods for each relationship are stored.
+type contentRatingL struct{}
+
+var (
+	contentRatingAllColumns            = []string{"id", "film_id", "region", "rating_system", "rating", "min_age", "contributed_by", "contributed_at", "invalidation"}
+	contentRatingColumnsWithoutDefault = []string{"film_id", "region", "rating_system", "rating", "min_age", "contributed_by"}
+	contentRatingColumnsWithDefault    = []string{"id", "contributed_at", "invalidation"}
+	contentRatingPrimaryKeyColumns     = []string{"id"}
+	contentRatingGeneratedColumns      = []string{}
+)
+
+type (
+	// ContentRatingSlice is an alias for a slice of pointers to ContentRating.
+	// This should almost always be used instead of []ContentRating.
+	ContentRatingSlice []*ContentRating
+	// ContentRatingHook is the signature for custom ContentRating hook methods
+	ContentRatingHook func(context.Context, boil.ContextExecutor, *ContentRating) error
+
+	contentRatingQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	contentRatingType                 = reflect.TypeOf(&ContentRating{})
+	contentRatingMapping              = queries.MakeStructMapping(contentRatingType)
+	contentRatingPrimaryKeyMapping, _ = queries.BindMapping(contentRatingType, contentRatingMapping, contentRatingPrimaryKeyColumns)
+	contentRatingInsertCacheMut       sync.RWMutex
+	contentRatingInsertCache          = make(map[string]insertCache)
+	contentRatingUpdateCacheMut       sync.RWMutex
+	contentRatingUpdateCache          = make(map[string]updateCache)
+	contentRatingUpsertCacheMut       sync.RWMutex
+	contentRatingUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var contentRatingAfterSelectHooks []ContentRatingHook
+
+var contentRatingBeforeInsertHooks []ContentRatingHook
+var contentRatingAfterInsertHooks []ContentRatingHook
+
+var contentRatingBeforeUpdateHooks []ContentRatingHook
+var contentRatingAfterUpdateHooks []ContentRatingHook
+
+var contentRatingBeforeDeleteHooks []ContentRatingHook
+var contentRatingAfterDeleteHooks []ContentRatingHook
+
+var contentRatingBeforeUpsertHooks []ContentRatingHook
+var contentRatingAfterUpsertHooks []ContentRatingHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ContentRating) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentRatingAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ContentRating) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentRatingBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ContentRating) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentRatingAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ContentRating) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentRatingBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ContentRating) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentRatingAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ContentRating) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentRatingBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ContentRating) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentRatingAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ContentRating) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentRatingBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ContentRating) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentRatingAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddContentRatingHook registers your hook function for all future operations.
+func AddContentRatingHook(hookPoint boil.HookPoint, contentRatingHook ContentRatingHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		contentRatingAfterSelectHooks = append(contentRatingAfterSelectHooks, contentRatingHook)
+	case boil.BeforeInsertHook:
+		contentRatingBeforeInsertHooks = append(contentRatingBeforeInsertHooks, contentRatingHook)
+	case boil.AfterInsertHook:
+		contentRatingAfterInsertHooks = append(contentRatingAfterInsertHooks, contentRatingHook)
+	case boil.BeforeUpdateHook:
+		contentRatingBeforeUpdateHooks = append(contentRatingBeforeUpdateHooks, contentRatingHook)
+	case boil.AfterUpdateHook:
+		contentRatingAfterUpdateHooks = append(contentRatingAfterUpdateHooks, contentRatingHook)
+	case boil.BeforeDeleteHook:
+		contentRatingBeforeDeleteHooks = append(contentRatingBeforeDeleteHooks, contentRatingHook)
+	case boil.AfterDeleteHook:
+		contentRatingAfterDeleteHooks = append(contentRatingAfterDeleteHooks, contentRatingHook)
+	case boil.BeforeUpsertHook:
+		contentRatingBeforeUpsertHooks = append(contentRatingBeforeUpsertHooks, contentRatingHook)
+	case boil.AfterUpsertHook:
+		contentRatingAfterUpsertHooks = append(contentRatingAfterUpsertHooks, contentRatingHook)
+	}
+}
+
+// One returns a single contentRating record from the query.
+func (q contentRatingQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ContentRating, error) {
+	o := &ContentRating{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for content_ratings")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ContentRating records from the query.
+func (q contentRatingQuery) All(ctx context.Context, exec boil.ContextExecutor) (ContentRatingSlice, error) {
+	var o []*ContentRating
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ContentRating slice")
+	}
+
+	if len(contentRatingAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ContentRating records in the query.
+func (q contentRatingQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count content_ratings rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q contentRatingQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if content_ratings exists")
+	}
+
+	return count > 0, nil
+}
+
+// ContributingUser pointed to by the foreign key.
+func (o *ContentRating) ContributingUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ContributedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Film pointed to by the foreign key.
+func (o *ContentRating) Film(mods ...qm.QueryMod) filmQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FilmID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Films(queryMods...)
+}
+
+// LoadContributingUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (contentRatingL) LoadContributingUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeContentRating interface{}, mods queries.Applicator) error {
+	var slice []*ContentRating
+	var object *ContentRating
+
+	if singular {
+		var ok bool
+		object, ok = maybeContentRating.(*ContentRating)
+		if !ok {
+			object = new(ContentRating)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeContentRating)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeContentRating))
+			}
+		}
+	} else {
+		s, ok := maybeContentRating.(*[]*ContentRating)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeContentRating)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeContentRating))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &contentRatingR{}
+		}
+		args = append(args, object.ContributedBy)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &contentRatingR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ContributedBy {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ContributedBy)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(contentRatingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ContributingUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ContributedContentRatings = append(foreign.R.ContributedContentRatings, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ContributedBy == foreign.ID {
+				local.R.ContributingUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ContributedContentRatings = append(foreign.R.ContributedContentRatings, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFilm allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (contentRatingL) LoadFilm(ctx context.Context, e boil.ContextExecutor, singular bool, maybeContentRating interface{}, mods queries.Applicator) error {
+	var slice []*ContentRating
+	var object *ContentRating
+
+	if singular {
+		var ok bool
+		object, ok = maybeContentRating.(*ContentRating)
+		if !ok {
+			object = new(ContentRating)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeContentRating)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeContentRating))
+			}
+		}
+	} else {
+		s, ok := maybeContentRating.(*[]*ContentRating)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeContentRating)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeContentRating))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &contentRatingR{}
+		}
+		args = append(args, object.FilmID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &contentRatingR{}
+			}
+
+			for _, a := range args {
+				if a == obj.FilmID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.FilmID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`films`),
+		qm.WhereIn(`films.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Film")
+	}
+
+	var resultSlice []*Film
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Film")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for films")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for films")
+	}
+
+	if len(contentRatingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Film = foreign
+		if foreign.R == nil {
+			foreign.R = &filmR{}
+		}
+		foreign.R.ContentRatings = append(foreign.R.ContentRatings, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.FilmID == foreign.ID {
+				local.R.Film = foreign
+				if foreign.R == nil {
+					foreign.R = &filmR{}
+				}
+				foreign.R.ContentRatings = append(foreign.R.ContentRatings, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetContributingUser of the contentRating to the related item.
+// Sets o.R.ContributingUser to related.
+// Adds o to related.R.ContributedContentRatings.
+func (o *ContentRating) SetContributingUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"content_ratings\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
+		strmangle.WhereClause("\"", "\"", 2, contentRatingPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ContributedBy = related.ID
+	if o.R == nil {
+		o.R = &contentRatingR{
+			ContributingUser: related,
+		}
+	} else {
+		o.R.ContributingUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ContributedContentRatings: ContentRatingSlice{o},
+		}
+	} else {
+		related.R.ContributedContentRatings = append(related.R.ContributedContentRatings, o)
+	}
+
+	return nil
+}
+
+// SetFilm of the contentRating to the related item.
+// Sets o.R.Film to related.
+// Adds o to related.R.ContentRatings.
+func (o *ContentRating) SetFilm(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Film) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"content_ratings\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+		strmangle.WhereClause("\"", "\"", 2, contentRatingPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.FilmID = related.ID
+	if o.R == nil {
+		o.R = &contentRatingR{
+			Film: related,
+		}
+	} else {
+		o.R.Film = related
+	}
+
+	if related.R == nil {
+		related.R = &filmR{
+			ContentRatings: ContentRatingSlice{o},
+		}
+	} else {
+		related.R.ContentRatings = append(related.R.ContentRatings, o)
+	}
+
+	return nil
+}
+
+// ContentRatings retrieves all the records using an executor.
+func ContentRatings(mods ...qm.QueryMod) contentRatingQuery {
+	mods = append(mods, qm.From("\"content_ratings\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"content_ratings\".*"})
+	}
+
+	return contentRatingQuery{q}
+}
+
+// FindContentRating retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindContentRating(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*ContentRating, error) {
+	contentRatingObj := &ContentRating{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"content_ratings\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, contentRatingObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from content_ratings")
+	}
+
+	if err = contentRatingObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return contentRatingObj, err
+	}
+
+	return contentRatingObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ContentRating) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no content_ratings provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(contentRatingColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	contentRatingInsertCacheMut.RLock()
+	cache, cached := contentRatingInsertCache[key]
+	contentRatingInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			contentRatingAllColumns,
+			contentRatingColumnsWithDefault,
+			contentRatingColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(contentRatingType, contentRatingMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(contentRatingType, contentRatingMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"content_ratings\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"content_ratings\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into content_ratings")
+	}
+
+	if !cached {
+		contentRatingInsertCacheMut.Lock()
+		contentRatingInsertCache[key] = cache
+		contentRatingInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ContentRating.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ContentRating) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	contentRatingUpdateCacheMut.RLock()
+	cache, cached := contentRatingUpdateCache[key]
+	contentRatingUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			contentRatingAllColumns,
+			contentRatingPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update content_ratings, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"content_ratings\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, contentRatingPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(contentRatingType, contentRatingMapping, append(wl, contentRatingPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update content_ratings row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for content_ratings")
+	}
+
+	if !cached {
+		contentRatingUpdateCacheMut.Lock()
+		contentRatingUpdateCache[key] = cache
+		contentRatingUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q contentRatingQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for content_ratings")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for content_ratings")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ContentRatingSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), contentRatingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"content_ratings\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, contentRatingPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in contentRating slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all contentRating")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ContentRating) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no content_ratings provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(contentRatingColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	contentRatingUpsertCacheMut.RLock()
+	cache, cached := contentRatingUpsertCache[key]
+	contentRatingUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			contentRatingAllColumns,
+			contentRatingColumnsWithDefault,
+			contentRatingColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			contentRatingAllColumns,
+			contentRatingPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert content_ratings, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(contentRatingPrimaryKeyColumns))
+			copy(conflict, contentRatingPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"content_ratings\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(contentRatingType, contentRatingMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(contentRatingType, contentRatingMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert content_ratings")
+	}
+
+	if !cached {
+		contentRatingUpsertCacheMut.Lock()
+		contentRatingUpsertCache[key] = cache
+		contentRatingUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ContentRating record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ContentRating) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ContentRating provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), contentRatingPrimaryKeyMapping)
+	sql := "DELETE FROM \"content_ratings\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from content_ratings")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for content_ratings")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q contentRatingQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no contentRatingQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from content_ratings")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for content_ratings")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ContentRatingSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(contentRatingBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), contentRatingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"content_ratings\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, contentRatingPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from contentRating slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for content_ratings")
+	}
+
+	if len(contentRatingAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ContentRating) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindContentRating(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ContentRatingSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ContentRatingSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), contentRatingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"content_ratings\".* FROM \"content_ratings\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, contentRatingPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ContentRatingSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ContentRatingExists checks if the ContentRating row exists.
+func ContentRatingExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"content_ratings\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if content_ratings exists")
+	}
+
+	return exists, nil
+}
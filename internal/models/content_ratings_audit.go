@@ -0,0 +1,950 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ContentRatingsAudit is an object representing the database table.
+type ContentRatingsAudit struct {
+	ID            int         `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	FilmID        int         `db:"film_id" boil:"film_id" json:"film_id" toml:"film_id" yaml:"film_id"`
+	Region        string      `db:"region" boil:"region" json:"region" toml:"region" yaml:"region"`
+	RatingSystem  string      `db:"rating_system" boil:"rating_system" json:"rating_system" toml:"rating_system" yaml:"rating_system"`
+	Rating        string      `db:"rating" boil:"rating" json:"rating" toml:"rating" yaml:"rating"`
+	MinAge        int         `db:"min_age" boil:"min_age" json:"min_age" toml:"min_age" yaml:"min_age"`
+	ContributedBy int         `db:"contributed_by" boil:"contributed_by" json:"contributed_by" toml:"contributed_by" yaml:"contributed_by"`
+	ContributedAt time.Time   `db:"contributed_at" boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
+	Invalidation  null.String `db:"invalidation" boil:"invalidation" json:"invalidation,omitempty" toml:"invalidation" yaml:"invalidation,omitempty"`
+	AuditAction   string      `db:"audit_action" boil:"audit_action" json:"audit_action" toml:"audit_action" yaml:"audit_action"`
+	AuditActor    null.Int    `db:"audit_actor" boil:"audit_actor" json:"audit_actor,omitempty" toml:"audit_actor" yaml:"audit_actor,omitempty"`
+
+	R *contentRatingsAuditR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L contentRatingsAuditL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ContentRatingsAuditColumns = struct {
+	ID            string
+	FilmID        string
+	Region        string
+	RatingSystem  string
+	Rating        string
+	MinAge        string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+	AuditAction   string
+	AuditActor    string
+}{
+	ID:            "id",
+	FilmID:        "film_id",
+	Region:        "region",
+	RatingSystem:  "rating_system",
+	Rating:        "rating",
+	MinAge:        "min_age",
+	ContributedBy: "contributed_by",
+	ContributedAt: "contributed_at",
+	Invalidation:  "invalidation",
+	AuditAction:   "audit_action",
+	AuditActor:    "audit_actor",
+}
+
+var ContentRatingsAuditTableColumns = struct {
+	ID            string
+	FilmID        string
+	Region        string
+	RatingSystem  string
+	Rating        string
+	MinAge        string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+	AuditAction   string
+	AuditActor    string
+}{
+	ID:            "content_ratings_audit.id",
+	FilmID:        "content_ratings_audit.film_id",
+	Region:        "content_ratings_audit.region",
+	RatingSystem:  "content_ratings_audit.rating_system",
+	Rating:        "content_ratings_audit.rating",
+	MinAge:        "content_ratings_audit.min_age",
+	ContributedBy: "content_ratings_audit.contributed_by",
+	ContributedAt: "content_ratings_audit.contributed_at",
+	Invalidation:  "content_ratings_audit.invalidation",
+	AuditAction:   "content_ratings_audit.audit_action",
+	AuditActor:    "content_ratings_audit.audit_actor",
+}
+
+// Generated where
+
+var ContentRatingsAuditWhere = struct {
+	ID            whereHelperint
+	FilmID        whereHelperint
+	Region        whereHelperstring
+	RatingSystem  whereHelperstring
+	Rating        whereHelperstring
+	MinAge        whereHelperint
+	ContributedBy whereHelperint
+	ContributedAt whereHelpertime_Time
+	Invalidation  whereHelpernull_String
+	AuditAction   whereHelperstring
+	AuditActor    whereHelpernull_Int
+}{
+	ID:            whereHelperint{field: "\"content_ratings_audit\".\"id\""},
+	FilmID:        whereHelperint{field: "\"content_ratings_audit\".\"film_id\""},
+	Region:        whereHelperstring{field: "\"content_ratings_audit\".\"region\""},
+	RatingSystem:  whereHelperstring{field: "\"content_ratings_audit\".\"rating_system\""},
+	Rating:        whereHelperstring{field: "\"content_ratings_audit\".\"rating\""},
+	MinAge:        whereHelperint{field: "\"content_ratings_audit\".\"min_age\""},
+	ContributedBy: whereHelperint{field: "\"content_ratings_audit\".\"contributed_by\""},
+	ContributedAt: whereHelpertime_Time{field: "\"content_ratings_audit\".\"contributed_at\""},
+	Invalidation:  whereHelpernull_String{field: "\"content_ratings_audit\".\"invalidation\""},
+	AuditAction:   whereHelperstring{field: "\"content_ratings_audit\".\"audit_action\""},
+	AuditActor:    whereHelpernull_Int{field: "\"content_ratings_audit\".\"audit_actor\""},
+}
+
+// ContentRatingsAuditRels is where relationship names are stored.
+var ContentRatingsAuditRels = struct {
+}{}
+
+// contentRatingsAuditR is where relationships are stored.
+type contentRatingsAuditR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*contentRatingsAuditR) NewStruct() *contentRatingsAuditR {
+	return &contentRatingsAuditR{}
+}
+
+// contentRatingsAuditL is where Load methods for each relationship are stored.
+type contentRatingsAuditL struct{}
+
+var (
+	contentRatingsAuditAllColumns            = []string{"id", "film_id", "region", "rating_system", "rating", "min_age", "contributed_by", "contributed_at", "invalidation", "audit_action", "audit_actor"}
+	contentRatingsAuditColumnsWithoutDefault = []string{"id", "film_id", "region", "rating_system", "rating", "min_age", "contributed_by", "contributed_at"}
+	contentRatingsAuditColumnsWithDefault    = []string{"invalidation", "audit_action", "audit_actor"}
+	contentRatingsAuditPrimaryKeyColumns     = []string{"id", "contributed_by", "contributed_at"}
+	contentRatingsAuditGeneratedColumns      = []string{}
+)
+
+type (
+	// ContentRatingsAuditSlice is an alias for a slice of pointers to ContentRatingsAudit.
+	// This should almost always be used instead of []ContentRatingsAudit.
+	ContentRatingsAuditSlice []*ContentRatingsAudit
+	// ContentRatingsAuditHook is the signature for custom ContentRatingsAudit hook methods
+	ContentRatingsAuditHook func(context.Context, boil.ContextExecutor, *ContentRatingsAudit) error
+
+	contentRatingsAuditQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	contentRatingsAuditType                 = reflect.TypeOf(&ContentRatingsAudit{})
+	contentRatingsAuditMapping              = queries.MakeStructMapping(contentRatingsAuditType)
+	contentRatingsAuditPrimaryKeyMapping, _ = queries.BindMapping(contentRatingsAuditType, contentRatingsAuditMapping, contentRatingsAuditPrimaryKeyColumns)
+	contentRatingsAuditInsertCacheMut       sync.RWMutex
+	contentRatingsAuditInsertCache          = make(map[string]insertCache)
+	contentRatingsAuditUpdateCacheMut       sync.RWMutex
+	contentRatingsAuditUpdateCache          = make(map[string]updateCache)
+	contentRatingsAuditUpsertCacheMut       sync.RWMutex
+	contentRatingsAuditUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var contentRatingsAuditAfterSelectHooks []ContentRatingsAuditHook
+
+var contentRatingsAuditBeforeInsertHooks []ContentRatingsAuditHook
+var contentRatingsAuditAfterInsertHooks []ContentRatingsAuditHook
+
+var contentRatingsAuditBeforeUpdateHooks []ContentRatingsAuditHook
+var contentRatingsAuditAfterUpdateHooks []ContentRatingsAuditHook
+
+var contentRatingsAuditBeforeDeleteHooks []ContentRatingsAuditHook
+var contentRatingsAuditAfterDeleteHooks []ContentRatingsAuditHook
+
+var contentRatingsAuditBeforeUpsertHooks []ContentRatingsAuditHook
+var contentRatingsAuditAfterUpsertHooks []ContentRatingsAuditHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ContentRatingsAudit) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentRatingsAuditAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ContentRatingsAudit) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentRatingsAuditBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ContentRatingsAudit) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentRatingsAuditAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ContentRatingsAudit) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentRatingsAuditBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ContentRatingsAudit) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentRatingsAuditAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ContentRatingsAudit) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentRatingsAuditBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ContentRatingsAudit) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentRatingsAuditAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ContentRatingsAudit) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentRatingsAuditBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ContentRatingsAudit) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contentRatingsAuditAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddContentRatingsAuditHook registers your hook function for all future operations.
+func AddContentRatingsAuditHook(hookPoint boil.HookPoint, contentRatingsAuditHook ContentRatingsAuditHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		contentRatingsAuditAfterSelectHooks = append(contentRatingsAuditAfterSelectHooks, contentRatingsAuditHook)
+	case boil.BeforeInsertHook:
+		contentRatingsAuditBeforeInsertHooks = append(contentRatingsAuditBeforeInsertHooks, contentRatingsAuditHook)
+	case boil.AfterInsertHook:
+		contentRatingsAuditAfterInsertHooks = append(contentRatingsAuditAfterInsertHooks, contentRatingsAuditHook)
+	case boil.BeforeUpdateHook:
+		contentRatingsAuditBeforeUpdateHooks = append(contentRatingsAuditBeforeUpdateHooks, contentRatingsAuditHook)
+	case boil.AfterUpdateHook:
+		contentRatingsAuditAfterUpdateHooks = append(contentRatingsAuditAfterUpdateHooks, contentRatingsAuditHook)
+	case boil.BeforeDeleteHook:
+		contentRatingsAuditBeforeDeleteHooks = append(contentRatingsAuditBeforeDeleteHooks, contentRatingsAuditHook)
+	case boil.AfterDeleteHook:
+		contentRatingsAuditAfterDeleteHooks = append(contentRatingsAuditAfterDeleteHooks, contentRatingsAuditHook)
+	case boil.BeforeUpsertHook:
+		contentRatingsAuditBeforeUpsertHooks = append(contentRatingsAuditBeforeUpsertHooks, contentRatingsAuditHook)
+	case boil.AfterUpsertHook:
+		contentRatingsAuditAfterUpsertHooks = append(contentRatingsAuditAfterUpsertHooks, contentRatingsAuditHook)
+	}
+}
+
+// One returns a single contentRatingsAudit record from the query.
+func (q contentRatingsAuditQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ContentRatingsAudit, error) {
+	o := &ContentRatingsAudit{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for content_ratings_audit")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ContentRatingsAudit records from the query.
+func (q contentRatingsAuditQuery) All(ctx context.Context, exec boil.ContextExecutor) (ContentRatingsAuditSlice, error) {
+	var o []*ContentRatingsAudit
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ContentRatingsAudit slice")
+	}
+
+	if len(contentRatingsAuditAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ContentRatingsAudit records in the query.
+func (q contentRatingsAuditQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count content_ratings_audit rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q contentRatingsAuditQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if content_ratings_audit exists")
+	}
+
+	return count > 0, nil
+}
+
+// ContentRatingsAudits retrieves all the records using an executor.
+func ContentRatingsAudits(mods ...qm.QueryMod) contentRatingsAuditQuery {
+	mods = append(mods, qm.From("\"content_ratings_audit\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"content_ratings_audit\".*"})
+	}
+
+	return contentRatingsAuditQuery{q}
+}
+
+// FindContentRatingsAudit retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindContentRatingsAudit(ctx context.Context, exec boil.ContextExecutor, iD int, contributedBy int, contributedAt time.Time, selectCols ...string) (*ContentRatingsAudit, error) {
+	contentRatingsAuditObj := &ContentRatingsAudit{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"content_ratings_audit\" where \"id\"=$1 AND \"contributed_by\"=$2 AND \"contributed_at\"=$3", sel,
+	)
+
+	q := queries.Raw(query, iD, contributedBy, contributedAt)
+
+	err := q.Bind(ctx, exec, contentRatingsAuditObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from content_ratings_audit")
+	}
+
+	if err = contentRatingsAuditObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return contentRatingsAuditObj, err
+	}
+
+	return contentRatingsAuditObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ContentRatingsAudit) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no content_ratings_audit provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(contentRatingsAuditColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	contentRatingsAuditInsertCacheMut.RLock()
+	cache, cached := contentRatingsAuditInsertCache[key]
+	contentRatingsAuditInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			contentRatingsAuditAllColumns,
+			contentRatingsAuditColumnsWithDefault,
+			contentRatingsAuditColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(contentRatingsAuditType, contentRatingsAuditMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(contentRatingsAuditType, contentRatingsAuditMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"content_ratings_audit\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"content_ratings_audit\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into content_ratings_audit")
+	}
+
+	if !cached {
+		contentRatingsAuditInsertCacheMut.Lock()
+		contentRatingsAuditInsertCache[key] = cache
+		contentRatingsAuditInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ContentRatingsAudit.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ContentRatingsAudit) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	contentRatingsAuditUpdateCacheMut.RLock()
+	cache, cached := contentRatingsAuditUpdateCache[key]
+	contentRatingsAuditUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			contentRatingsAuditAllColumns,
+			contentRatingsAuditPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update content_ratings_audit, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"content_ratings_audit\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, contentRatingsAuditPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(contentRatingsAuditType, contentRatingsAuditMapping, append(wl, contentRatingsAuditPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update content_ratings_audit row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for content_ratings_audit")
+	}
+
+	if !cached {
+		contentRatingsAuditUpdateCacheMut.Lock()
+		contentRatingsAuditUpdateCache[key] = cache
+		contentRatingsAuditUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q contentRatingsAuditQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for content_ratings_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for content_ratings_audit")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ContentRatingsAuditSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), contentRatingsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"content_ratings_audit\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, contentRatingsAuditPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in contentRatingsAudit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all contentRatingsAudit")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ContentRatingsAudit) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no content_ratings_audit provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(contentRatingsAuditColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	contentRatingsAuditUpsertCacheMut.RLock()
+	cache, cached := contentRatingsAuditUpsertCache[key]
+	contentRatingsAuditUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			contentRatingsAuditAllColumns,
+			contentRatingsAuditColumnsWithDefault,
+			contentRatingsAuditColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			contentRatingsAuditAllColumns,
+			contentRatingsAuditPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert content_ratings_audit, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(contentRatingsAuditPrimaryKeyColumns))
+			copy(conflict, contentRatingsAuditPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"content_ratings_audit\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(contentRatingsAuditType, contentRatingsAuditMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(contentRatingsAuditType, contentRatingsAuditMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert content_ratings_audit")
+	}
+
+	if !cached {
+		contentRatingsAuditUpsertCacheMut.Lock()
+		contentRatingsAuditUpsertCache[key] = cache
+		contentRatingsAuditUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ContentRatingsAudit record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ContentRatingsAudit) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ContentRatingsAudit provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), contentRatingsAuditPrimaryKeyMapping)
+	sql := "DELETE FROM \"content_ratings_audit\" WHERE \"id\"=$1 AND \"contributed_by\"=$2 AND \"contributed_at\"=$3"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from content_ratings_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for content_ratings_audit")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q contentRatingsAuditQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no contentRatingsAuditQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from content_ratings_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for content_ratings_audit")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ContentRatingsAuditSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(contentRatingsAuditBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), contentRatingsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"content_ratings_audit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, contentRatingsAuditPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from contentRatingsAudit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for content_ratings_audit")
+	}
+
+	if len(contentRatingsAuditAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ContentRatingsAudit) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindContentRatingsAudit(ctx, exec, o.ID, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ContentRatingsAuditSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ContentRatingsAuditSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), contentRatingsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"content_ratings_audit\".* FROM \"content_ratings_audit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, contentRatingsAuditPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ContentRatingsAuditSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ContentRatingsAuditExists checks if the ContentRatingsAudit row exists.
+func ContentRatingsAuditExists(ctx context.Context, exec boil.ContextExecutor, iD int, contributedBy int, contributedAt time.Time) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"content_ratings_audit\" where \"id\"=$1 AND \"contributed_by\"=$2 AND \"contributed_at\"=$3 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD, contributedBy, contributedAt)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD, contributedBy, contributedAt)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if content_ratings_audit exists")
+	}
+
+	return exists, nil
+}
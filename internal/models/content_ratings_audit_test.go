@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testContentRatingsAudits(t *testing.T) {
+	t.Parallel()
+
+	query := ContentRatingsAudits()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testContentRatingsAuditsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRatingsAudit{}
+	if err = randomize.Struct(seed, o, contentRatingsAuditDBTypes, true, contentRatingsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRatingsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ContentRatingsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testContentRatingsAuditsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRatingsAudit{}
+	if err = randomize.Struct(seed, o, contentRatingsAuditDBTypes, true, contentRatingsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRatingsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ContentRatingsAudits().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ContentRatingsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testContentRatingsAuditsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRatingsAudit{}
+	if err = randomize.Struct(seed, o, contentRatingsAuditDBTypes, true, contentRatingsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRatingsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ContentRatingsAuditSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ContentRatingsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testContentRatingsAuditsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRatingsAudit{}
+	if err = randomize.Struct(seed, o, contentRatingsAuditDBTypes, true, contentRatingsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRatingsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ContentRatingsAuditExists(ctx, tx, o.ID, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		t.Errorf("Unable to check if ContentRatingsAudit exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ContentRatingsAuditExists to return true, but got false.")
+	}
+}
+
+func testContentRatingsAuditsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRatingsAudit{}
+	if err = randomize.Struct(seed, o, contentRatingsAuditDBTypes, true, contentRatingsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRatingsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	contentRatingsAuditFound, err := FindContentRatingsAudit(ctx, tx, o.ID, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if contentRatingsAuditFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testContentRatingsAuditsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRatingsAudit{}
+	if err = randomize.Struct(seed, o, contentRatingsAuditDBTypes, true, contentRatingsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRatingsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ContentRatingsAudits().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testContentRatingsAuditsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRatingsAudit{}
+	if err = randomize.Struct(seed, o, contentRatingsAuditDBTypes, true, contentRatingsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRatingsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ContentRatingsAudits().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testContentRatingsAuditsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	contentRatingsAuditOne := &ContentRatingsAudit{}
+	contentRatingsAuditTwo := &ContentRatingsAudit{}
+	if err = randomize.Struct(seed, contentRatingsAuditOne, contentRatingsAuditDBTypes, false, contentRatingsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRatingsAudit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, contentRatingsAuditTwo, contentRatingsAuditDBTypes, false, contentRatingsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRatingsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = contentRatingsAuditOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = contentRatingsAuditTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ContentRatingsAudits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testContentRatingsAuditsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	contentRatingsAuditOne := &ContentRatingsAudit{}
+	contentRatingsAuditTwo := &ContentRatingsAudit{}
+	if err = randomize.Struct(seed, contentRatingsAuditOne, contentRatingsAuditDBTypes, false, contentRatingsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRatingsAudit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, contentRatingsAuditTwo, contentRatingsAuditDBTypes, false, contentRatingsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRatingsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = contentRatingsAuditOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = contentRatingsAuditTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ContentRatingsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func contentRatingsAuditBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ContentRatingsAudit) error {
+	*o = ContentRatingsAudit{}
+	return nil
+}
+
+func contentRatingsAuditAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ContentRatingsAudit) error {
+	*o = ContentRatingsAudit{}
+	return nil
+}
+
+func contentRatingsAuditAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ContentRatingsAudit) error {
+	*o = ContentRatingsAudit{}
+	return nil
+}
+
+func contentRatingsAuditBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ContentRatingsAudit) error {
+	*o = ContentRatingsAudit{}
+	return nil
+}
+
+func contentRatingsAuditAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ContentRatingsAudit) error {
+	*o = ContentRatingsAudit{}
+	return nil
+}
+
+func contentRatingsAuditBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ContentRatingsAudit) error {
+	*o = ContentRatingsAudit{}
+	return nil
+}
+
+func contentRatingsAuditAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ContentRatingsAudit) error {
+	*o = ContentRatingsAudit{}
+	return nil
+}
+
+func contentRatingsAuditBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ContentRatingsAudit) error {
+	*o = ContentRatingsAudit{}
+	return nil
+}
+
+func contentRatingsAuditAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ContentRatingsAudit) error {
+	*o = ContentRatingsAudit{}
+	return nil
+}
+
+func testContentRatingsAuditsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ContentRatingsAudit{}
+	o := &ContentRatingsAudit{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, contentRatingsAuditDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ContentRatingsAudit object: %s", err)
+	}
+
+	AddContentRatingsAuditHook(boil.BeforeInsertHook, contentRatingsAuditBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	contentRatingsAuditBeforeInsertHooks = []ContentRatingsAuditHook{}
+
+	AddContentRatingsAuditHook(boil.AfterInsertHook, contentRatingsAuditAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	contentRatingsAuditAfterInsertHooks = []ContentRatingsAuditHook{}
+
+	AddContentRatingsAuditHook(boil.AfterSelectHook, contentRatingsAuditAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	contentRatingsAuditAfterSelectHooks = []ContentRatingsAuditHook{}
+
+	AddContentRatingsAuditHook(boil.BeforeUpdateHook, contentRatingsAuditBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	contentRatingsAuditBeforeUpdateHooks = []ContentRatingsAuditHook{}
+
+	AddContentRatingsAuditHook(boil.AfterUpdateHook, contentRatingsAuditAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	contentRatingsAuditAfterUpdateHooks = []ContentRatingsAuditHook{}
+
+	AddContentRatingsAuditHook(boil.BeforeDeleteHook, contentRatingsAuditBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	contentRatingsAuditBeforeDeleteHooks = []ContentRatingsAuditHook{}
+
+	AddContentRatingsAuditHook(boil.AfterDeleteHook, contentRatingsAuditAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	contentRatingsAuditAfterDeleteHooks = []ContentRatingsAuditHook{}
+
+	AddContentRatingsAuditHook(boil.BeforeUpsertHook, contentRatingsAuditBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	contentRatingsAuditBeforeUpsertHooks = []ContentRatingsAuditHook{}
+
+	AddContentRatingsAuditHook(boil.AfterUpsertHook, contentRatingsAuditAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	contentRatingsAuditAfterUpsertHooks = []ContentRatingsAuditHook{}
+}
+
+func testContentRatingsAuditsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRatingsAudit{}
+	if err = randomize.Struct(seed, o, contentRatingsAuditDBTypes, true, contentRatingsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRatingsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ContentRatingsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testContentRatingsAuditsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRatingsAudit{}
+	if err = randomize.Struct(seed, o, contentRatingsAuditDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ContentRatingsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(contentRatingsAuditColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ContentRatingsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testContentRatingsAuditsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRatingsAudit{}
+	if err = randomize.Struct(seed, o, contentRatingsAuditDBTypes, true, contentRatingsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRatingsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testContentRatingsAuditsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRatingsAudit{}
+	if err = randomize.Struct(seed, o, contentRatingsAuditDBTypes, true, contentRatingsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRatingsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ContentRatingsAuditSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testContentRatingsAuditsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRatingsAudit{}
+	if err = randomize.Struct(seed, o, contentRatingsAuditDBTypes, true, contentRatingsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRatingsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ContentRatingsAudits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	contentRatingsAuditDBTypes = map[string]string{`ID`: `integer`, `FilmID`: `integer`, `Region`: `character varying`, `RatingSystem`: `character varying`, `Rating`: `character varying`, `MinAge`: `integer`, `ContributedBy`: `integer`, `ContributedAt`: `timestamp with time zone`, `Invalidation`: `character varying`, `AuditAction`: `character varying`, `AuditActor`: `integer`}
+	_                          = bytes.MinRead
+)
+
+func testContentRatingsAuditsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(contentRatingsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(contentRatingsAuditAllColumns) == len(contentRatingsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRatingsAudit{}
+	if err = randomize.Struct(seed, o, contentRatingsAuditDBTypes, true, contentRatingsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRatingsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ContentRatingsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, contentRatingsAuditDBTypes, true, contentRatingsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ContentRatingsAudit struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testContentRatingsAuditsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(contentRatingsAuditAllColumns) == len(contentRatingsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRatingsAudit{}
+	if err = randomize.Struct(seed, o, contentRatingsAuditDBTypes, true, contentRatingsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRatingsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ContentRatingsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, contentRatingsAuditDBTypes, true, contentRatingsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ContentRatingsAudit struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(contentRatingsAuditAllColumns, contentRatingsAuditPrimaryKeyColumns) {
+		fields = contentRatingsAuditAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			contentRatingsAuditAllColumns,
+			contentRatingsAuditPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ContentRatingsAuditSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testContentRatingsAuditsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(contentRatingsAuditAllColumns) == len(contentRatingsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ContentRatingsAudit{}
+	if err = randomize.Struct(seed, &o, contentRatingsAuditDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ContentRatingsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ContentRatingsAudit: %s", err)
+	}
+
+	count, err := ContentRatingsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, contentRatingsAuditDBTypes, false, contentRatingsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ContentRatingsAudit struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ContentRatingsAudit: %s", err)
+	}
+
+	count, err = ContentRatingsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
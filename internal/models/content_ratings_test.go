@@ -0,0 +1,949 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testContentRatings(t *testing.T) {
+	t.Parallel()
+
+	query := ContentRatings()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testContentRatingsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRating{}
+	if err = randomize.Struct(seed, o, contentRatingDBTypes, true, contentRatingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRating struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ContentRatings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testContentRatingsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRating{}
+	if err = randomize.Struct(seed, o, contentRatingDBTypes, true, contentRatingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRating struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ContentRatings().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ContentRatings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testContentRatingsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRating{}
+	if err = randomize.Struct(seed, o, contentRatingDBTypes, true, contentRatingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRating struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ContentRatingSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ContentRatings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testContentRatingsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRating{}
+	if err = randomize.Struct(seed, o, contentRatingDBTypes, true, contentRatingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRating struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ContentRatingExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if ContentRating exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ContentRatingExists to return true, but got false.")
+	}
+}
+
+func testContentRatingsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRating{}
+	if err = randomize.Struct(seed, o, contentRatingDBTypes, true, contentRatingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRating struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	contentRatingFound, err := FindContentRating(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if contentRatingFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testContentRatingsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRating{}
+	if err = randomize.Struct(seed, o, contentRatingDBTypes, true, contentRatingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRating struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ContentRatings().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testContentRatingsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRating{}
+	if err = randomize.Struct(seed, o, contentRatingDBTypes, true, contentRatingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRating struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ContentRatings().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testContentRatingsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	contentRatingOne := &ContentRating{}
+	contentRatingTwo := &ContentRating{}
+	if err = randomize.Struct(seed, contentRatingOne, contentRatingDBTypes, false, contentRatingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRating struct: %s", err)
+	}
+	if err = randomize.Struct(seed, contentRatingTwo, contentRatingDBTypes, false, contentRatingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRating struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = contentRatingOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = contentRatingTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ContentRatings().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testContentRatingsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	contentRatingOne := &ContentRating{}
+	contentRatingTwo := &ContentRating{}
+	if err = randomize.Struct(seed, contentRatingOne, contentRatingDBTypes, false, contentRatingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRating struct: %s", err)
+	}
+	if err = randomize.Struct(seed, contentRatingTwo, contentRatingDBTypes, false, contentRatingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRating struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = contentRatingOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = contentRatingTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ContentRatings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func contentRatingBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ContentRating) error {
+	*o = ContentRating{}
+	return nil
+}
+
+func contentRatingAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ContentRating) error {
+	*o = ContentRating{}
+	return nil
+}
+
+func contentRatingAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ContentRating) error {
+	*o = ContentRating{}
+	return nil
+}
+
+func contentRatingBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ContentRating) error {
+	*o = ContentRating{}
+	return nil
+}
+
+func contentRatingAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ContentRating) error {
+	*o = ContentRating{}
+	return nil
+}
+
+func contentRatingBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ContentRating) error {
+	*o = ContentRating{}
+	return nil
+}
+
+func contentRatingAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ContentRating) error {
+	*o = ContentRating{}
+	return nil
+}
+
+func contentRatingBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ContentRating) error {
+	*o = ContentRating{}
+	return nil
+}
+
+func contentRatingAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ContentRating) error {
+	*o = ContentRating{}
+	return nil
+}
+
+func testContentRatingsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ContentRating{}
+	o := &ContentRating{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, contentRatingDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ContentRating object: %s", err)
+	}
+
+	AddContentRatingHook(boil.BeforeInsertHook, contentRatingBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	contentRatingBeforeInsertHooks = []ContentRatingHook{}
+
+	AddContentRatingHook(boil.AfterInsertHook, contentRatingAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	contentRatingAfterInsertHooks = []ContentRatingHook{}
+
+	AddContentRatingHook(boil.AfterSelectHook, contentRatingAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	contentRatingAfterSelectHooks = []ContentRatingHook{}
+
+	AddContentRatingHook(boil.BeforeUpdateHook, contentRatingBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	contentRatingBeforeUpdateHooks = []ContentRatingHook{}
+
+	AddContentRatingHook(boil.AfterUpdateHook, contentRatingAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	contentRatingAfterUpdateHooks = []ContentRatingHook{}
+
+	AddContentRatingHook(boil.BeforeDeleteHook, contentRatingBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	contentRatingBeforeDeleteHooks = []ContentRatingHook{}
+
+	AddContentRatingHook(boil.AfterDeleteHook, contentRatingAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	contentRatingAfterDeleteHooks = []ContentRatingHook{}
+
+	AddContentRatingHook(boil.BeforeUpsertHook, contentRatingBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	contentRatingBeforeUpsertHooks = []ContentRatingHook{}
+
+	AddContentRatingHook(boil.AfterUpsertHook, contentRatingAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	contentRatingAfterUpsertHooks = []ContentRatingHook{}
+}
+
+func testContentRatingsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRating{}
+	if err = randomize.Struct(seed, o, contentRatingDBTypes, true, contentRatingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRating struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ContentRatings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testContentRatingsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRating{}
+	if err = randomize.Struct(seed, o, contentRatingDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ContentRating struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(contentRatingColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ContentRatings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testContentRatingToOneUserUsingContributingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ContentRating
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, contentRatingDBTypes, false, contentRatingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRating struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ContributedBy = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ContributingUser().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ContentRatingSlice{&local}
+	if err = local.L.LoadContributingUser(ctx, tx, false, (*[]*ContentRating)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ContributingUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ContributingUser = nil
+	if err = local.L.LoadContributingUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ContributingUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testContentRatingToOneFilmUsingFilm(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ContentRating
+	var foreign Film
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, contentRatingDBTypes, false, contentRatingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRating struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, filmDBTypes, false, filmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Film struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.FilmID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Film().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ContentRatingSlice{&local}
+	if err = local.L.LoadFilm(ctx, tx, false, (*[]*ContentRating)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Film == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Film = nil
+	if err = local.L.LoadFilm(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Film == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testContentRatingToOneSetOpUserUsingContributingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ContentRating
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, contentRatingDBTypes, false, strmangle.SetComplement(contentRatingPrimaryKeyColumns, contentRatingColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetContributingUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ContributingUser != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ContributedContentRatings[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ContributedBy != x.ID {
+			t.Error("foreign key was wrong value", a.ContributedBy)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ContributedBy))
+		reflect.Indirect(reflect.ValueOf(&a.ContributedBy)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ContributedBy != x.ID {
+			t.Error("foreign key was wrong value", a.ContributedBy, x.ID)
+		}
+	}
+}
+func testContentRatingToOneSetOpFilmUsingFilm(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ContentRating
+	var b, c Film
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, contentRatingDBTypes, false, strmangle.SetComplement(contentRatingPrimaryKeyColumns, contentRatingColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Film{&b, &c} {
+		err = a.SetFilm(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Film != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ContentRatings[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.FilmID != x.ID {
+			t.Error("foreign key was wrong value", a.FilmID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.FilmID))
+		reflect.Indirect(reflect.ValueOf(&a.FilmID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.FilmID != x.ID {
+			t.Error("foreign key was wrong value", a.FilmID, x.ID)
+		}
+	}
+}
+
+func testContentRatingsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRating{}
+	if err = randomize.Struct(seed, o, contentRatingDBTypes, true, contentRatingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRating struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testContentRatingsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRating{}
+	if err = randomize.Struct(seed, o, contentRatingDBTypes, true, contentRatingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRating struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ContentRatingSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testContentRatingsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRating{}
+	if err = randomize.Struct(seed, o, contentRatingDBTypes, true, contentRatingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRating struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ContentRatings().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	contentRatingDBTypes = map[string]string{`ID`: `integer`, `FilmID`: `integer`, `Region`: `character varying`, `RatingSystem`: `character varying`, `Rating`: `character varying`, `MinAge`: `integer`, `ContributedBy`: `integer`, `ContributedAt`: `timestamp with time zone`, `Invalidation`: `character varying`}
+	_                    = bytes.MinRead
+)
+
+func testContentRatingsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(contentRatingPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(contentRatingAllColumns) == len(contentRatingPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRating{}
+	if err = randomize.Struct(seed, o, contentRatingDBTypes, true, contentRatingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRating struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ContentRatings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, contentRatingDBTypes, true, contentRatingPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ContentRating struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testContentRatingsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(contentRatingAllColumns) == len(contentRatingPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContentRating{}
+	if err = randomize.Struct(seed, o, contentRatingDBTypes, true, contentRatingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContentRating struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ContentRatings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, contentRatingDBTypes, true, contentRatingPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ContentRating struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(contentRatingAllColumns, contentRatingPrimaryKeyColumns) {
+		fields = contentRatingAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			contentRatingAllColumns,
+			contentRatingPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ContentRatingSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testContentRatingsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(contentRatingAllColumns) == len(contentRatingPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ContentRating{}
+	if err = randomize.Struct(seed, &o, contentRatingDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ContentRating struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ContentRating: %s", err)
+	}
+
+	count, err := ContentRatings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, contentRatingDBTypes, false, contentRatingPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ContentRating struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ContentRating: %s", err)
+	}
+
+	count, err = ContentRatings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
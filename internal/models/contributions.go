@@ -0,0 +1,1176 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Contribution is an object representing the database table.
+type Contribution struct {
+	ID                  int         `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID              null.Int    `db:"user_id" boil:"user_id" json:"user_id,omitempty" toml:"user_id" yaml:"user_id,omitempty"`
+	Kind                string      `db:"kind" boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	TargetID            int         `db:"target_id" boil:"target_id" json:"target_id" toml:"target_id" yaml:"target_id"`
+	Title               string      `db:"title" boil:"title" json:"title" toml:"title" yaml:"title"`
+	Action              string      `db:"action" boil:"action" json:"action" toml:"action" yaml:"action"`
+	ContentHash         string      `db:"content_hash" boil:"content_hash" json:"content_hash" toml:"content_hash" yaml:"content_hash"`
+	PreviousContentHash null.String `db:"previous_content_hash" boil:"previous_content_hash" json:"previous_content_hash,omitempty" toml:"previous_content_hash" yaml:"previous_content_hash,omitempty"`
+	Reverted            bool        `db:"reverted" boil:"reverted" json:"reverted" toml:"reverted" yaml:"reverted"`
+	Invalidated         bool        `db:"invalidated" boil:"invalidated" json:"invalidated" toml:"invalidated" yaml:"invalidated"`
+	ContributedAt       time.Time   `db:"contributed_at" boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
+
+	R *contributionR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L contributionL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ContributionColumns = struct {
+	ID                  string
+	UserID              string
+	Kind                string
+	TargetID            string
+	Title               string
+	Action              string
+	ContentHash         string
+	PreviousContentHash string
+	Reverted            string
+	Invalidated         string
+	ContributedAt       string
+}{
+	ID:                  "id",
+	UserID:              "user_id",
+	Kind:                "kind",
+	TargetID:            "target_id",
+	Title:               "title",
+	Action:              "action",
+	ContentHash:         "content_hash",
+	PreviousContentHash: "previous_content_hash",
+	Reverted:            "reverted",
+	Invalidated:         "invalidated",
+	ContributedAt:       "contributed_at",
+}
+
+var ContributionTableColumns = struct {
+	ID                  string
+	UserID              string
+	Kind                string
+	TargetID            string
+	Title               string
+	Action              string
+	ContentHash         string
+	PreviousContentHash string
+	Reverted            string
+	Invalidated         string
+	ContributedAt       string
+}{
+	ID:                  "contributions.id",
+	UserID:              "contributions.user_id",
+	Kind:                "contributions.kind",
+	TargetID:            "contributions.target_id",
+	Title:               "contributions.title",
+	Action:              "contributions.action",
+	ContentHash:         "contributions.content_hash",
+	PreviousContentHash: "contributions.previous_content_hash",
+	Reverted:            "contributions.reverted",
+	Invalidated:         "contributions.invalidated",
+	ContributedAt:       "contributions.contributed_at",
+}
+
+// Generated where
+
+var ContributionWhere = struct {
+	ID                  whereHelperint
+	UserID              whereHelpernull_Int
+	Kind                whereHelperstring
+	TargetID            whereHelperint
+	Title               whereHelperstring
+	Action              whereHelperstring
+	ContentHash         whereHelperstring
+	PreviousContentHash whereHelpernull_String
+	Reverted            whereHelperbool
+	Invalidated         whereHelperbool
+	ContributedAt       whereHelpertime_Time
+}{
+	ID:                  whereHelperint{field: "\"contributions\".\"id\""},
+	UserID:              whereHelpernull_Int{field: "\"contributions\".\"user_id\""},
+	Kind:                whereHelperstring{field: "\"contributions\".\"kind\""},
+	TargetID:            whereHelperint{field: "\"contributions\".\"target_id\""},
+	Title:               whereHelperstring{field: "\"contributions\".\"title\""},
+	Action:              whereHelperstring{field: "\"contributions\".\"action\""},
+	ContentHash:         whereHelperstring{field: "\"contributions\".\"content_hash\""},
+	PreviousContentHash: whereHelpernull_String{field: "\"contributions\".\"previous_content_hash\""},
+	Reverted:            whereHelperbool{field: "\"contributions\".\"reverted\""},
+	Invalidated:         whereHelperbool{field: "\"contributions\".\"invalidated\""},
+	ContributedAt:       whereHelpertime_Time{field: "\"contributions\".\"contributed_at\""},
+}
+
+// ContributionRels is where relationship names are stored.
+var ContributionRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// contributionR is where relationships are stored.
+type contributionR struct {
+	User *User `db:"User" boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*contributionR) NewStruct() *contributionR {
+	return &contributionR{}
+}
+
+func (r *contributionR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// contributionL is where Load methods for each relationship are stored.
+type contributionL struct{}
+
+var (
+	contributionAllColumns            = []string{"id", "user_id", "kind", "target_id", "title", "action", "content_hash", "previous_content_hash", "reverted", "invalidated", "contributed_at"}
+	contributionColumnsWithoutDefault = []string{"kind", "target_id", "title", "action", "content_hash", "contributed_at"}
+	contributionColumnsWithDefault    = []string{"id", "user_id", "previous_content_hash", "reverted", "invalidated"}
+	contributionPrimaryKeyColumns     = []string{"id"}
+	contributionGeneratedColumns      = []string{}
+)
+
+type (
+	// ContributionSlice is an alias for a slice of pointers to Contribution.
+	// This should almost always be used instead of []Contribution.
+	ContributionSlice []*Contribution
+	// ContributionHook is the signature for custom Contribution hook methods
+	ContributionHook func(context.Context, boil.ContextExecutor, *Contribution) error
+
+	contributionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	contributionType                 = reflect.TypeOf(&Contribution{})
+	contributionMapping              = queries.MakeStructMapping(contributionType)
+	contributionPrimaryKeyMapping, _ = queries.BindMapping(contributionType, contributionMapping, contributionPrimaryKeyColumns)
+	contributionInsertCacheMut       sync.RWMutex
+	contributionInsertCache          = make(map[string]insertCache)
+	contributionUpdateCacheMut       sync.RWMutex
+	contributionUpdateCache          = make(map[string]updateCache)
+	contributionUpsertCacheMut       sync.RWMutex
+	contributionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var contributionAfterSelectHooks []ContributionHook
+
+var contributionBeforeInsertHooks []ContributionHook
+var contributionAfterInsertHooks []ContributionHook
+
+var contributionBeforeUpdateHooks []ContributionHook
+var contributionAfterUpdateHooks []ContributionHook
+
+var contributionBeforeDeleteHooks []ContributionHook
+var contributionAfterDeleteHooks []ContributionHook
+
+var contributionBeforeUpsertHooks []ContributionHook
+var contributionAfterUpsertHooks []ContributionHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Contribution) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contributionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Contribution) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contributionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Contribution) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contributionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Contribution) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contributionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Contribution) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contributionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Contribution) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contributionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Contribution) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contributionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Contribution) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contributionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Contribution) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contributionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddContributionHook registers your hook function for all future operations.
+func AddContributionHook(hookPoint boil.HookPoint, contributionHook ContributionHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		contributionAfterSelectHooks = append(contributionAfterSelectHooks, contributionHook)
+	case boil.BeforeInsertHook:
+		contributionBeforeInsertHooks = append(contributionBeforeInsertHooks, contributionHook)
+	case boil.AfterInsertHook:
+		contributionAfterInsertHooks = append(contributionAfterInsertHooks, contributionHook)
+	case boil.BeforeUpdateHook:
+		contributionBeforeUpdateHooks = append(contributionBeforeUpdateHooks, contributionHook)
+	case boil.AfterUpdateHook:
+		contributionAfterUpdateHooks = append(contributionAfterUpdateHooks, contributionHook)
+	case boil.BeforeDeleteHook:
+		contributionBeforeDeleteHooks = append(contributionBeforeDeleteHooks, contributionHook)
+	case boil.AfterDeleteHook:
+		contributionAfterDeleteHooks = append(contributionAfterDeleteHooks, contributionHook)
+	case boil.BeforeUpsertHook:
+		contributionBeforeUpsertHooks = append(contributionBeforeUpsertHooks, contributionHook)
+	case boil.AfterUpsertHook:
+		contributionAfterUpsertHooks = append(contributionAfterUpsertHooks, contributionHook)
+	}
+}
+
+// One returns a single contribution record from the query.
+func (q contributionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Contribution, error) {
+	o := &Contribution{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for contributions")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Contribution records from the query.
+func (q contributionQuery) All(ctx context.Context, exec boil.ContextExecutor) (ContributionSlice, error) {
+	var o []*Contribution
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Contribution slice")
+	}
+
+	if len(contributionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Contribution records in the query.
+func (q contributionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count contributions rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q contributionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if contributions exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *Contribution) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (contributionL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeContribution interface{}, mods queries.Applicator) error {
+	var slice []*Contribution
+	var object *Contribution
+
+	if singular {
+		var ok bool
+		object, ok = maybeContribution.(*Contribution)
+		if !ok {
+			object = new(Contribution)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeContribution)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeContribution))
+			}
+		}
+	} else {
+		s, ok := maybeContribution.(*[]*Contribution)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeContribution)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeContribution))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &contributionR{}
+		}
+		if !queries.IsNil(object.UserID) {
+			args = append(args, object.UserID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &contributionR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.UserID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.UserID) {
+				args = append(args, obj.UserID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(contributionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.Contributions = append(foreign.R.Contributions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserID, foreign.ID) {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.Contributions = append(foreign.R.Contributions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the contribution to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.Contributions.
+func (o *Contribution) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"contributions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, contributionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserID, related.ID)
+	if o.R == nil {
+		o.R = &contributionR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			Contributions: ContributionSlice{o},
+		}
+	} else {
+		related.R.Contributions = append(related.R.Contributions, o)
+	}
+
+	return nil
+}
+
+// RemoveUser relationship.
+// Sets o.R.User to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Contribution) RemoveUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.UserID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.User = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Contributions {
+		if queries.Equal(o.UserID, ri.UserID) {
+			continue
+		}
+
+		ln := len(related.R.Contributions)
+		if ln > 1 && i < ln-1 {
+			related.R.Contributions[i] = related.R.Contributions[ln-1]
+		}
+		related.R.Contributions = related.R.Contributions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// Contributions retrieves all the records using an executor.
+func Contributions(mods ...qm.QueryMod) contributionQuery {
+	mods = append(mods, qm.From("\"contributions\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"contributions\".*"})
+	}
+
+	return contributionQuery{q}
+}
+
+// FindContribution retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindContribution(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*Contribution, error) {
+	contributionObj := &Contribution{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"contributions\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, contributionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from contributions")
+	}
+
+	if err = contributionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return contributionObj, err
+	}
+
+	return contributionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Contribution) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no contributions provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(contributionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	contributionInsertCacheMut.RLock()
+	cache, cached := contributionInsertCache[key]
+	contributionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			contributionAllColumns,
+			contributionColumnsWithDefault,
+			contributionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(contributionType, contributionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(contributionType, contributionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"contributions\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"contributions\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into contributions")
+	}
+
+	if !cached {
+		contributionInsertCacheMut.Lock()
+		contributionInsertCache[key] = cache
+		contributionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Contribution.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Contribution) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	contributionUpdateCacheMut.RLock()
+	cache, cached := contributionUpdateCache[key]
+	contributionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			contributionAllColumns,
+			contributionPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update contributions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"contributions\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, contributionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(contributionType, contributionMapping, append(wl, contributionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update contributions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for contributions")
+	}
+
+	if !cached {
+		contributionUpdateCacheMut.Lock()
+		contributionUpdateCache[key] = cache
+		contributionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q contributionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for contributions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for contributions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ContributionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), contributionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"contributions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, contributionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in contribution slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all contribution")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Contribution) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no contributions provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(contributionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	contributionUpsertCacheMut.RLock()
+	cache, cached := contributionUpsertCache[key]
+	contributionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			contributionAllColumns,
+			contributionColumnsWithDefault,
+			contributionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			contributionAllColumns,
+			contributionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert contributions, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(contributionPrimaryKeyColumns))
+			copy(conflict, contributionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"contributions\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(contributionType, contributionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(contributionType, contributionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert contributions")
+	}
+
+	if !cached {
+		contributionUpsertCacheMut.Lock()
+		contributionUpsertCache[key] = cache
+		contributionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Contribution record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Contribution) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Contribution provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), contributionPrimaryKeyMapping)
+	sql := "DELETE FROM \"contributions\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from contributions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for contributions")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q contributionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no contributionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from contributions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for contributions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ContributionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(contributionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), contributionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"contributions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, contributionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from contribution slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for contributions")
+	}
+
+	if len(contributionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Contribution) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindContribution(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ContributionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ContributionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), contributionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"contributions\".* FROM \"contributions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, contributionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ContributionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ContributionExists checks if the Contribution row exists.
+func ContributionExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"contributions\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if contributions exists")
+	}
+
+	return exists, nil
+}
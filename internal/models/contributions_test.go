@@ -0,0 +1,892 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testContributions(t *testing.T) {
+	t.Parallel()
+
+	query := Contributions()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testContributionsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Contribution{}
+	if err = randomize.Struct(seed, o, contributionDBTypes, true, contributionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Contribution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Contributions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testContributionsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Contribution{}
+	if err = randomize.Struct(seed, o, contributionDBTypes, true, contributionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Contribution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Contributions().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Contributions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testContributionsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Contribution{}
+	if err = randomize.Struct(seed, o, contributionDBTypes, true, contributionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Contribution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ContributionSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Contributions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testContributionsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Contribution{}
+	if err = randomize.Struct(seed, o, contributionDBTypes, true, contributionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Contribution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ContributionExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Contribution exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ContributionExists to return true, but got false.")
+	}
+}
+
+func testContributionsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Contribution{}
+	if err = randomize.Struct(seed, o, contributionDBTypes, true, contributionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Contribution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	contributionFound, err := FindContribution(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if contributionFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testContributionsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Contribution{}
+	if err = randomize.Struct(seed, o, contributionDBTypes, true, contributionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Contribution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Contributions().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testContributionsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Contribution{}
+	if err = randomize.Struct(seed, o, contributionDBTypes, true, contributionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Contribution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Contributions().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testContributionsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	contributionOne := &Contribution{}
+	contributionTwo := &Contribution{}
+	if err = randomize.Struct(seed, contributionOne, contributionDBTypes, false, contributionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Contribution struct: %s", err)
+	}
+	if err = randomize.Struct(seed, contributionTwo, contributionDBTypes, false, contributionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Contribution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = contributionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = contributionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Contributions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testContributionsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	contributionOne := &Contribution{}
+	contributionTwo := &Contribution{}
+	if err = randomize.Struct(seed, contributionOne, contributionDBTypes, false, contributionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Contribution struct: %s", err)
+	}
+	if err = randomize.Struct(seed, contributionTwo, contributionDBTypes, false, contributionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Contribution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = contributionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = contributionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Contributions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func contributionBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Contribution) error {
+	*o = Contribution{}
+	return nil
+}
+
+func contributionAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Contribution) error {
+	*o = Contribution{}
+	return nil
+}
+
+func contributionAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Contribution) error {
+	*o = Contribution{}
+	return nil
+}
+
+func contributionBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Contribution) error {
+	*o = Contribution{}
+	return nil
+}
+
+func contributionAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Contribution) error {
+	*o = Contribution{}
+	return nil
+}
+
+func contributionBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Contribution) error {
+	*o = Contribution{}
+	return nil
+}
+
+func contributionAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Contribution) error {
+	*o = Contribution{}
+	return nil
+}
+
+func contributionBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Contribution) error {
+	*o = Contribution{}
+	return nil
+}
+
+func contributionAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Contribution) error {
+	*o = Contribution{}
+	return nil
+}
+
+func testContributionsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Contribution{}
+	o := &Contribution{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, contributionDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Contribution object: %s", err)
+	}
+
+	AddContributionHook(boil.BeforeInsertHook, contributionBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	contributionBeforeInsertHooks = []ContributionHook{}
+
+	AddContributionHook(boil.AfterInsertHook, contributionAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	contributionAfterInsertHooks = []ContributionHook{}
+
+	AddContributionHook(boil.AfterSelectHook, contributionAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	contributionAfterSelectHooks = []ContributionHook{}
+
+	AddContributionHook(boil.BeforeUpdateHook, contributionBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	contributionBeforeUpdateHooks = []ContributionHook{}
+
+	AddContributionHook(boil.AfterUpdateHook, contributionAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	contributionAfterUpdateHooks = []ContributionHook{}
+
+	AddContributionHook(boil.BeforeDeleteHook, contributionBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	contributionBeforeDeleteHooks = []ContributionHook{}
+
+	AddContributionHook(boil.AfterDeleteHook, contributionAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	contributionAfterDeleteHooks = []ContributionHook{}
+
+	AddContributionHook(boil.BeforeUpsertHook, contributionBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	contributionBeforeUpsertHooks = []ContributionHook{}
+
+	AddContributionHook(boil.AfterUpsertHook, contributionAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	contributionAfterUpsertHooks = []ContributionHook{}
+}
+
+func testContributionsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Contribution{}
+	if err = randomize.Struct(seed, o, contributionDBTypes, true, contributionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Contribution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Contributions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testContributionsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Contribution{}
+	if err = randomize.Struct(seed, o, contributionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Contribution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(contributionColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Contributions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testContributionToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Contribution
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, contributionDBTypes, true, contributionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Contribution struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.UserID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ContributionSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*Contribution)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testContributionToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Contribution
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, contributionDBTypes, false, strmangle.SetComplement(contributionPrimaryKeyColumns, contributionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Contributions[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.UserID, x.ID) {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.UserID, x.ID) {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testContributionToOneRemoveOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Contribution
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, contributionDBTypes, false, strmangle.SetComplement(contributionPrimaryKeyColumns, contributionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetUser(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveUser(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.User().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.User != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.UserID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.Contributions) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testContributionsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Contribution{}
+	if err = randomize.Struct(seed, o, contributionDBTypes, true, contributionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Contribution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testContributionsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Contribution{}
+	if err = randomize.Struct(seed, o, contributionDBTypes, true, contributionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Contribution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ContributionSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testContributionsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Contribution{}
+	if err = randomize.Struct(seed, o, contributionDBTypes, true, contributionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Contribution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Contributions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	contributionDBTypes = map[string]string{`ID`: `integer`, `UserID`: `integer`, `Kind`: `character varying`, `TargetID`: `integer`, `Title`: `character varying`, `Action`: `character varying`, `ContentHash`: `text`, `PreviousContentHash`: `text`, `Reverted`: `boolean`, `Invalidated`: `boolean`, `ContributedAt`: `timestamp with time zone`}
+	_                   = bytes.MinRead
+)
+
+func testContributionsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(contributionPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(contributionAllColumns) == len(contributionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Contribution{}
+	if err = randomize.Struct(seed, o, contributionDBTypes, true, contributionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Contribution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Contributions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, contributionDBTypes, true, contributionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Contribution struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testContributionsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(contributionAllColumns) == len(contributionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Contribution{}
+	if err = randomize.Struct(seed, o, contributionDBTypes, true, contributionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Contribution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Contributions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, contributionDBTypes, true, contributionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Contribution struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(contributionAllColumns, contributionPrimaryKeyColumns) {
+		fields = contributionAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			contributionAllColumns,
+			contributionPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ContributionSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testContributionsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(contributionAllColumns) == len(contributionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Contribution{}
+	if err = randomize.Struct(seed, &o, contributionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Contribution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Contribution: %s", err)
+	}
+
+	count, err := Contributions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, contributionDBTypes, false, contributionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Contribution struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Contribution: %s", err)
+	}
+
+	count, err = Contributions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1096 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ContributorStat is an object representing the database table.
+type ContributorStat struct {
+	UserID                int `db:"user_id" boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Creations             int `db:"creations" boil:"creations" json:"creations" toml:"creations" yaml:"creations"`
+	Edits                 int `db:"edits" boil:"edits" json:"edits" toml:"edits" yaml:"edits"`
+	RevertedEdits         int `db:"reverted_edits" boil:"reverted_edits" json:"reverted_edits" toml:"reverted_edits" yaml:"reverted_edits"`
+	InvalidationsReceived int `db:"invalidations_received" boil:"invalidations_received" json:"invalidations_received" toml:"invalidations_received" yaml:"invalidations_received"`
+
+	R *contributorStatR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L contributorStatL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ContributorStatColumns = struct {
+	UserID                string
+	Creations             string
+	Edits                 string
+	RevertedEdits         string
+	InvalidationsReceived string
+}{
+	UserID:                "user_id",
+	Creations:             "creations",
+	Edits:                 "edits",
+	RevertedEdits:         "reverted_edits",
+	InvalidationsReceived: "invalidations_received",
+}
+
+var ContributorStatTableColumns = struct {
+	UserID                string
+	Creations             string
+	Edits                 string
+	RevertedEdits         string
+	InvalidationsReceived string
+}{
+	UserID:                "contributor_stats.user_id",
+	Creations:             "contributor_stats.creations",
+	Edits:                 "contributor_stats.edits",
+	RevertedEdits:         "contributor_stats.reverted_edits",
+	InvalidationsReceived: "contributor_stats.invalidations_received",
+}
+
+// Generated where
+
+var ContributorStatWhere = struct {
+	UserID                whereHelperint
+	Creations             whereHelperint
+	Edits                 whereHelperint
+	RevertedEdits         whereHelperint
+	InvalidationsReceived whereHelperint
+}{
+	UserID:                whereHelperint{field: "\"contributor_stats\".\"user_id\""},
+	Creations:             whereHelperint{field: "\"contributor_stats\".\"creations\""},
+	Edits:                 whereHelperint{field: "\"contributor_stats\".\"edits\""},
+	RevertedEdits:         whereHelperint{field: "\"contributor_stats\".\"reverted_edits\""},
+	InvalidationsReceived: whereHelperint{field: "\"contributor_stats\".\"invalidations_received\""},
+}
+
+// ContributorStatRels is where relationship names are stored.
+var ContributorStatRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// contributorStatR is where relationships are stored.
+type contributorStatR struct {
+	User *User `db:"User" boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*contributorStatR) NewStruct() *contributorStatR {
+	return &contributorStatR{}
+}
+
+func (r *contributorStatR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// contributorStatL is where Load methods for each relationship are stored.
+type contributorStatL struct{}
+
+var (
+	contributorStatAllColumns            = []string{"user_id", "creations", "edits", "reverted_edits", "invalidations_received"}
+	contributorStatColumnsWithoutDefault = []string{"user_id"}
+	contributorStatColumnsWithDefault    = []string{"creations", "edits", "reverted_edits", "invalidations_received"}
+	contributorStatPrimaryKeyColumns     = []string{"user_id"}
+	contributorStatGeneratedColumns      = []string{}
+)
+
+type (
+	// ContributorStatSlice is an alias for a slice of pointers to ContributorStat.
+	// This should almost always be used instead of []ContributorStat.
+	ContributorStatSlice []*ContributorStat
+	// ContributorStatHook is the signature for custom ContributorStat hook methods
+	ContributorStatHook func(context.Context, boil.ContextExecutor, *ContributorStat) error
+
+	contributorStatQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	contributorStatType                 = reflect.TypeOf(&ContributorStat{})
+	contributorStatMapping              = queries.MakeStructMapping(contributorStatType)
+	contributorStatPrimaryKeyMapping, _ = queries.BindMapping(contributorStatType, contributorStatMapping, contributorStatPrimaryKeyColumns)
+	contributorStatInsertCacheMut       sync.RWMutex
+	contributorStatInsertCache          = make(map[string]insertCache)
+	contributorStatUpdateCacheMut       sync.RWMutex
+	contributorStatUpdateCache          = make(map[string]updateCache)
+	contributorStatUpsertCacheMut       sync.RWMutex
+	contributorStatUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var contributorStatAfterSelectHooks []ContributorStatHook
+
+var contributorStatBeforeInsertHooks []ContributorStatHook
+var contributorStatAfterInsertHooks []ContributorStatHook
+
+var contributorStatBeforeUpdateHooks []ContributorStatHook
+var contributorStatAfterUpdateHooks []ContributorStatHook
+
+var contributorStatBeforeDeleteHooks []ContributorStatHook
+var contributorStatAfterDeleteHooks []ContributorStatHook
+
+var contributorStatBeforeUpsertHooks []ContributorStatHook
+var contributorStatAfterUpsertHooks []ContributorStatHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ContributorStat) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contributorStatAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ContributorStat) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contributorStatBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ContributorStat) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contributorStatAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ContributorStat) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contributorStatBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ContributorStat) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contributorStatAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ContributorStat) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contributorStatBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ContributorStat) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contributorStatAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ContributorStat) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contributorStatBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ContributorStat) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range contributorStatAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddContributorStatHook registers your hook function for all future operations.
+func AddContributorStatHook(hookPoint boil.HookPoint, contributorStatHook ContributorStatHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		contributorStatAfterSelectHooks = append(contributorStatAfterSelectHooks, contributorStatHook)
+	case boil.BeforeInsertHook:
+		contributorStatBeforeInsertHooks = append(contributorStatBeforeInsertHooks, contributorStatHook)
+	case boil.AfterInsertHook:
+		contributorStatAfterInsertHooks = append(contributorStatAfterInsertHooks, contributorStatHook)
+	case boil.BeforeUpdateHook:
+		contributorStatBeforeUpdateHooks = append(contributorStatBeforeUpdateHooks, contributorStatHook)
+	case boil.AfterUpdateHook:
+		contributorStatAfterUpdateHooks = append(contributorStatAfterUpdateHooks, contributorStatHook)
+	case boil.BeforeDeleteHook:
+		contributorStatBeforeDeleteHooks = append(contributorStatBeforeDeleteHooks, contributorStatHook)
+	case boil.AfterDeleteHook:
+		contributorStatAfterDeleteHooks = append(contributorStatAfterDeleteHooks, contributorStatHook)
+	case boil.BeforeUpsertHook:
+		contributorStatBeforeUpsertHooks = append(contributorStatBeforeUpsertHooks, contributorStatHook)
+	case boil.AfterUpsertHook:
+		contributorStatAfterUpsertHooks = append(contributorStatAfterUpsertHooks, contributorStatHook)
+	}
+}
+
+// One returns a single contributorStat record from the query.
+func (q contributorStatQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ContributorStat, error) {
+	o := &ContributorStat{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for contributor_stats")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ContributorStat records from the query.
+func (q contributorStatQuery) All(ctx context.Context, exec boil.ContextExecutor) (ContributorStatSlice, error) {
+	var o []*ContributorStat
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ContributorStat slice")
+	}
+
+	if len(contributorStatAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ContributorStat records in the query.
+func (q contributorStatQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count contributor_stats rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q contributorStatQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if contributor_stats exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *ContributorStat) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (contributorStatL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeContributorStat interface{}, mods queries.Applicator) error {
+	var slice []*ContributorStat
+	var object *ContributorStat
+
+	if singular {
+		var ok bool
+		object, ok = maybeContributorStat.(*ContributorStat)
+		if !ok {
+			object = new(ContributorStat)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeContributorStat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeContributorStat))
+			}
+		}
+	} else {
+		s, ok := maybeContributorStat.(*[]*ContributorStat)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeContributorStat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeContributorStat))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &contributorStatR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &contributorStatR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(contributorStatAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ContributorStats = append(foreign.R.ContributorStats, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ContributorStats = append(foreign.R.ContributorStats, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the contributorStat to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.ContributorStats.
+func (o *ContributorStat) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"contributor_stats\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, contributorStatPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &contributorStatR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ContributorStats: ContributorStatSlice{o},
+		}
+	} else {
+		related.R.ContributorStats = append(related.R.ContributorStats, o)
+	}
+
+	return nil
+}
+
+// ContributorStats retrieves all the records using an executor.
+func ContributorStats(mods ...qm.QueryMod) contributorStatQuery {
+	mods = append(mods, qm.From("\"contributor_stats\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"contributor_stats\".*"})
+	}
+
+	return contributorStatQuery{q}
+}
+
+// FindContributorStat retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindContributorStat(ctx context.Context, exec boil.ContextExecutor, userID int, selectCols ...string) (*ContributorStat, error) {
+	contributorStatObj := &ContributorStat{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"contributor_stats\" where \"user_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, userID)
+
+	err := q.Bind(ctx, exec, contributorStatObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from contributor_stats")
+	}
+
+	if err = contributorStatObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return contributorStatObj, err
+	}
+
+	return contributorStatObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ContributorStat) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no contributor_stats provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(contributorStatColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	contributorStatInsertCacheMut.RLock()
+	cache, cached := contributorStatInsertCache[key]
+	contributorStatInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			contributorStatAllColumns,
+			contributorStatColumnsWithDefault,
+			contributorStatColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(contributorStatType, contributorStatMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(contributorStatType, contributorStatMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"contributor_stats\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"contributor_stats\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into contributor_stats")
+	}
+
+	if !cached {
+		contributorStatInsertCacheMut.Lock()
+		contributorStatInsertCache[key] = cache
+		contributorStatInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ContributorStat.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ContributorStat) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	contributorStatUpdateCacheMut.RLock()
+	cache, cached := contributorStatUpdateCache[key]
+	contributorStatUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			contributorStatAllColumns,
+			contributorStatPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update contributor_stats, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"contributor_stats\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, contributorStatPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(contributorStatType, contributorStatMapping, append(wl, contributorStatPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update contributor_stats row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for contributor_stats")
+	}
+
+	if !cached {
+		contributorStatUpdateCacheMut.Lock()
+		contributorStatUpdateCache[key] = cache
+		contributorStatUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q contributorStatQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for contributor_stats")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for contributor_stats")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ContributorStatSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), contributorStatPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"contributor_stats\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, contributorStatPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in contributorStat slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all contributorStat")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ContributorStat) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no contributor_stats provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(contributorStatColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	contributorStatUpsertCacheMut.RLock()
+	cache, cached := contributorStatUpsertCache[key]
+	contributorStatUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			contributorStatAllColumns,
+			contributorStatColumnsWithDefault,
+			contributorStatColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			contributorStatAllColumns,
+			contributorStatPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert contributor_stats, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(contributorStatPrimaryKeyColumns))
+			copy(conflict, contributorStatPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"contributor_stats\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(contributorStatType, contributorStatMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(contributorStatType, contributorStatMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert contributor_stats")
+	}
+
+	if !cached {
+		contributorStatUpsertCacheMut.Lock()
+		contributorStatUpsertCache[key] = cache
+		contributorStatUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ContributorStat record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ContributorStat) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ContributorStat provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), contributorStatPrimaryKeyMapping)
+	sql := "DELETE FROM \"contributor_stats\" WHERE \"user_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from contributor_stats")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for contributor_stats")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q contributorStatQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no contributorStatQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from contributor_stats")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for contributor_stats")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ContributorStatSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(contributorStatBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), contributorStatPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"contributor_stats\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, contributorStatPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from contributorStat slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for contributor_stats")
+	}
+
+	if len(contributorStatAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ContributorStat) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindContributorStat(ctx, exec, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ContributorStatSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ContributorStatSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), contributorStatPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"contributor_stats\".* FROM \"contributor_stats\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, contributorStatPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ContributorStatSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ContributorStatExists checks if the ContributorStat row exists.
+func ContributorStatExists(ctx context.Context, exec boil.ContextExecutor, userID int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"contributor_stats\" where \"user_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, userID)
+	}
+	row := exec.QueryRowContext(ctx, sql, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if contributor_stats exists")
+	}
+
+	return exists, nil
+}
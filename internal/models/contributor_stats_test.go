@@ -0,0 +1,837 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testContributorStats(t *testing.T) {
+	t.Parallel()
+
+	query := ContributorStats()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testContributorStatsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContributorStat{}
+	if err = randomize.Struct(seed, o, contributorStatDBTypes, true, contributorStatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContributorStat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ContributorStats().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testContributorStatsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContributorStat{}
+	if err = randomize.Struct(seed, o, contributorStatDBTypes, true, contributorStatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContributorStat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ContributorStats().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ContributorStats().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testContributorStatsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContributorStat{}
+	if err = randomize.Struct(seed, o, contributorStatDBTypes, true, contributorStatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContributorStat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ContributorStatSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ContributorStats().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testContributorStatsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContributorStat{}
+	if err = randomize.Struct(seed, o, contributorStatDBTypes, true, contributorStatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContributorStat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ContributorStatExists(ctx, tx, o.UserID)
+	if err != nil {
+		t.Errorf("Unable to check if ContributorStat exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ContributorStatExists to return true, but got false.")
+	}
+}
+
+func testContributorStatsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContributorStat{}
+	if err = randomize.Struct(seed, o, contributorStatDBTypes, true, contributorStatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContributorStat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	contributorStatFound, err := FindContributorStat(ctx, tx, o.UserID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if contributorStatFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testContributorStatsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContributorStat{}
+	if err = randomize.Struct(seed, o, contributorStatDBTypes, true, contributorStatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContributorStat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ContributorStats().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testContributorStatsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContributorStat{}
+	if err = randomize.Struct(seed, o, contributorStatDBTypes, true, contributorStatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContributorStat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ContributorStats().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testContributorStatsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	contributorStatOne := &ContributorStat{}
+	contributorStatTwo := &ContributorStat{}
+	if err = randomize.Struct(seed, contributorStatOne, contributorStatDBTypes, false, contributorStatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContributorStat struct: %s", err)
+	}
+	if err = randomize.Struct(seed, contributorStatTwo, contributorStatDBTypes, false, contributorStatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContributorStat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = contributorStatOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = contributorStatTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ContributorStats().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testContributorStatsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	contributorStatOne := &ContributorStat{}
+	contributorStatTwo := &ContributorStat{}
+	if err = randomize.Struct(seed, contributorStatOne, contributorStatDBTypes, false, contributorStatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContributorStat struct: %s", err)
+	}
+	if err = randomize.Struct(seed, contributorStatTwo, contributorStatDBTypes, false, contributorStatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContributorStat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = contributorStatOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = contributorStatTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ContributorStats().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func contributorStatBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ContributorStat) error {
+	*o = ContributorStat{}
+	return nil
+}
+
+func contributorStatAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ContributorStat) error {
+	*o = ContributorStat{}
+	return nil
+}
+
+func contributorStatAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ContributorStat) error {
+	*o = ContributorStat{}
+	return nil
+}
+
+func contributorStatBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ContributorStat) error {
+	*o = ContributorStat{}
+	return nil
+}
+
+func contributorStatAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ContributorStat) error {
+	*o = ContributorStat{}
+	return nil
+}
+
+func contributorStatBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ContributorStat) error {
+	*o = ContributorStat{}
+	return nil
+}
+
+func contributorStatAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ContributorStat) error {
+	*o = ContributorStat{}
+	return nil
+}
+
+func contributorStatBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ContributorStat) error {
+	*o = ContributorStat{}
+	return nil
+}
+
+func contributorStatAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ContributorStat) error {
+	*o = ContributorStat{}
+	return nil
+}
+
+func testContributorStatsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ContributorStat{}
+	o := &ContributorStat{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, contributorStatDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ContributorStat object: %s", err)
+	}
+
+	AddContributorStatHook(boil.BeforeInsertHook, contributorStatBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	contributorStatBeforeInsertHooks = []ContributorStatHook{}
+
+	AddContributorStatHook(boil.AfterInsertHook, contributorStatAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	contributorStatAfterInsertHooks = []ContributorStatHook{}
+
+	AddContributorStatHook(boil.AfterSelectHook, contributorStatAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	contributorStatAfterSelectHooks = []ContributorStatHook{}
+
+	AddContributorStatHook(boil.BeforeUpdateHook, contributorStatBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	contributorStatBeforeUpdateHooks = []ContributorStatHook{}
+
+	AddContributorStatHook(boil.AfterUpdateHook, contributorStatAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	contributorStatAfterUpdateHooks = []ContributorStatHook{}
+
+	AddContributorStatHook(boil.BeforeDeleteHook, contributorStatBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	contributorStatBeforeDeleteHooks = []ContributorStatHook{}
+
+	AddContributorStatHook(boil.AfterDeleteHook, contributorStatAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	contributorStatAfterDeleteHooks = []ContributorStatHook{}
+
+	AddContributorStatHook(boil.BeforeUpsertHook, contributorStatBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	contributorStatBeforeUpsertHooks = []ContributorStatHook{}
+
+	AddContributorStatHook(boil.AfterUpsertHook, contributorStatAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	contributorStatAfterUpsertHooks = []ContributorStatHook{}
+}
+
+func testContributorStatsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContributorStat{}
+	if err = randomize.Struct(seed, o, contributorStatDBTypes, true, contributorStatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContributorStat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ContributorStats().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testContributorStatsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContributorStat{}
+	if err = randomize.Struct(seed, o, contributorStatDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ContributorStat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(contributorStatColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ContributorStats().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testContributorStatToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ContributorStat
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, contributorStatDBTypes, false, contributorStatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContributorStat struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ContributorStatSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*ContributorStat)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testContributorStatToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ContributorStat
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, contributorStatDBTypes, false, strmangle.SetComplement(contributorStatPrimaryKeyColumns, contributorStatColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ContributorStats[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		if exists, err := ContributorStatExists(ctx, tx, a.UserID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testContributorStatsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContributorStat{}
+	if err = randomize.Struct(seed, o, contributorStatDBTypes, true, contributorStatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContributorStat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testContributorStatsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContributorStat{}
+	if err = randomize.Struct(seed, o, contributorStatDBTypes, true, contributorStatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContributorStat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ContributorStatSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testContributorStatsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContributorStat{}
+	if err = randomize.Struct(seed, o, contributorStatDBTypes, true, contributorStatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContributorStat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ContributorStats().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	contributorStatDBTypes = map[string]string{`UserID`: `integer`, `Creations`: `integer`, `Edits`: `integer`, `RevertedEdits`: `integer`, `InvalidationsReceived`: `integer`}
+	_                      = bytes.MinRead
+)
+
+func testContributorStatsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(contributorStatPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(contributorStatAllColumns) == len(contributorStatPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContributorStat{}
+	if err = randomize.Struct(seed, o, contributorStatDBTypes, true, contributorStatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContributorStat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ContributorStats().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, contributorStatDBTypes, true, contributorStatPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ContributorStat struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testContributorStatsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(contributorStatAllColumns) == len(contributorStatPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ContributorStat{}
+	if err = randomize.Struct(seed, o, contributorStatDBTypes, true, contributorStatColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ContributorStat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ContributorStats().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, contributorStatDBTypes, true, contributorStatPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ContributorStat struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(contributorStatAllColumns, contributorStatPrimaryKeyColumns) {
+		fields = contributorStatAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			contributorStatAllColumns,
+			contributorStatPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ContributorStatSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testContributorStatsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(contributorStatAllColumns) == len(contributorStatPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ContributorStat{}
+	if err = randomize.Struct(seed, &o, contributorStatDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ContributorStat struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ContributorStat: %s", err)
+	}
+
+	count, err := ContributorStats().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, contributorStatDBTypes, false, contributorStatPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ContributorStat struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ContributorStat: %s", err)
+	}
+
+	count, err = ContributorStats().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1568 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ExternalID is an object representing the database table.
+type ExternalID struct {
+	ID            int         `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	FilmID        null.Int    `db:"film_id" boil:"film_id" json:"film_id,omitempty" toml:"film_id" yaml:"film_id,omitempty"`
+	SeriesID      null.Int    `db:"series_id" boil:"series_id" json:"series_id,omitempty" toml:"series_id" yaml:"series_id,omitempty"`
+	Provider      string      `db:"provider" boil:"provider" json:"provider" toml:"provider" yaml:"provider"`
+	ExternalID    string      `db:"external_id" boil:"external_id" json:"external_id" toml:"external_id" yaml:"external_id"`
+	ContributedBy int         `db:"contributed_by" boil:"contributed_by" json:"contributed_by" toml:"contributed_by" yaml:"contributed_by"`
+	ContributedAt time.Time   `db:"contributed_at" boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
+	Invalidation  null.String `db:"invalidation" boil:"invalidation" json:"invalidation,omitempty" toml:"invalidation" yaml:"invalidation,omitempty"`
+
+	R *externalIDR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L externalIDL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ExternalIDColumns = struct {
+	ID            string
+	FilmID        string
+	SeriesID      string
+	Provider      string
+	ExternalID    string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+}{
+	ID:            "id",
+	FilmID:        "film_id",
+	SeriesID:      "series_id",
+	Provider:      "provider",
+	ExternalID:    "external_id",
+	ContributedBy: "contributed_by",
+	ContributedAt: "contributed_at",
+	Invalidation:  "invalidation",
+}
+
+var ExternalIDTableColumns = struct {
+	ID            string
+	FilmID        string
+	SeriesID      string
+	Provider      string
+	ExternalID    string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+}{
+	ID:            "external_ids.id",
+	FilmID:        "external_ids.film_id",
+	SeriesID:      "external_ids.series_id",
+	Provider:      "external_ids.provider",
+	ExternalID:    "external_ids.external_id",
+	ContributedBy: "external_ids.contributed_by",
+	ContributedAt: "external_ids.contributed_at",
+	Invalidation:  "external_ids.invalidation",
+}
+
+// Generated where
+
+var ExternalIDWhere = struct {
+	ID            whereHelperint
+	FilmID        whereHelpernull_Int
+	SeriesID      whereHelpernull_Int
+	Provider      whereHelperstring
+	ExternalID    whereHelperstring
+	ContributedBy whereHelperint
+	ContributedAt whereHelpertime_Time
+	Invalidation  whereHelpernull_String
+}{
+	ID:            whereHelperint{field: "\"external_ids\".\"id\""},
+	FilmID:        whereHelpernull_Int{field: "\"external_ids\".\"film_id\""},
+	SeriesID:      whereHelpernull_Int{field: "\"external_ids\".\"series_id\""},
+	Provider:      whereHelperstring{field: "\"external_ids\".\"provider\""},
+	ExternalID:    whereHelperstring{field: "\"external_ids\".\"external_id\""},
+	ContributedBy: whereHelperint{field: "\"external_ids\".\"contributed_by\""},
+	ContributedAt: whereHelpertime_Time{field: "\"external_ids\".\"contributed_at\""},
+	Invalidation:  whereHelpernull_String{field: "\"external_ids\".\"invalidation\""},
+}
+
+// ExternalIDRels is where relationship names are stored.
+var ExternalIDRels = struct {
+	ContributingUser string
+	Film             string
+	Series           string
+}{
+	ContributingUser: "ContributingUser",
+	Film:             "Film",
+	Series:           "Series",
+}
+
+// externalIDR is where relationships are stored.
+type externalIDR struct {
+	ContributingUser *User   `db:"ContributingUser" boil:"ContributingUser" json:"ContributingUser" toml:"ContributingUser" yaml:"ContributingUser"`
+	Film             *Film   `db:"Film" boil:"Film" json:"Film" toml:"Film" yaml:"Film"`
+	Series           *Series `db:"Series" boil:"Series" json:"Series" toml:"Series" yaml:"Series"`
+}
+
+// NewStruct creates a new relationship struct
+func (*externalIDR) NewStruct() *externalIDR {
+	return &externalIDR{}
+}
+
+func (r *externalIDR) GetContributingUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.ContributingUser
+}
+
+func (r *externalIDR) GetFilm() *Film {
+	if r == nil {
+		return nil
+	}
+	return r.Film
+}
+
+func (r *externalIDR) GetSeries() *Series {
+	if r == nil {
+		return nil
+	}
+	return r.Series
+}
+
+// externalIDL is where Load methods for each relationship are stored.
+type externalIDL struct{}
+
+var (
+	externalIDAllColumns            = []string{"id", "film_id", "series_id", "provider", "external_id", "contributed_by", "contributed_at", "invalidation"}
+	externalIDColumnsWithoutDefault = []string{"provider", "external_id", "contributed_by"}
+	externalIDColumnsWithDefault    = []string{"id", "film_id", "series_id", "contributed_at", "invalidation"}
+	externalIDPrimaryKeyColumns     = []string{"id"}
+	externalIDGeneratedColumns      = []string{}
+)
+
+type (
+	// ExternalIDSlice is an alias for a slice of pointers to ExternalID.
+	// This should almost always be used instead of []ExternalID.
+	ExternalIDSlice []*ExternalID
+	// ExternalIDHook is the signature for custom ExternalID hook methods
+	ExternalIDHook func(context.Context, boil.ContextExecutor, *ExternalID) error
+
+	externalIDQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	externalIDType                 = reflect.TypeOf(&ExternalID{})
+	externalIDMapping              = queries.MakeStructMapping(externalIDType)
+	externalIDPrimaryKeyMapping, _ = queries.BindMapping(externalIDType, externalIDMapping, externalIDPrimaryKeyColumns)
+	externalIDInsertCacheMut       sync.RWMutex
+	externalIDInsertCache          = make(map[string]insertCache)
+	externalIDUpdateCacheMut       sync.RWMutex
+	externalIDUpdateCache          = make(map[string]updateCache)
+	externalIDUpsertCacheMut       sync.RWMutex
+	externalIDUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var externalIDAfterSelectHooks []ExternalIDHook
+
+var externalIDBeforeInsertHooks []ExternalIDHook
+var externalIDAfterInsertHooks []ExternalIDHook
+
+var externalIDBeforeUpdateHooks []ExternalIDHook
+var externalIDAfterUpdateHooks []ExternalIDHook
+
+var externalIDBeforeDeleteHooks []ExternalIDHook
+var externalIDAfterDeleteHooks []ExternalIDHook
+
+var externalIDBeforeUpsertHooks []ExternalIDHook
+var externalIDAfterUpsertHooks []ExternalIDHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ExternalID) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range externalIDAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ExternalID) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range externalIDBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ExternalID) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range externalIDAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ExternalID) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range externalIDBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ExternalID) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range externalIDAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ExternalID) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range externalIDBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ExternalID) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range externalIDAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ExternalID) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range externalIDBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ExternalID) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range externalIDAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddExternalIDHook registers your hook function for all future operations.
+func AddExternalIDHook(hookPoint boil.HookPoint, externalIDHook ExternalIDHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		externalIDAfterSelectHooks = append(externalIDAfterSelectHooks, externalIDHook)
+	case boil.BeforeInsertHook:
+		externalIDBeforeInsertHooks = append(externalIDBeforeInsertHooks, externalIDHook)
+	case boil.AfterInsertHook:
+		externalIDAfterInsertHooks = append(externalIDAfterInsertHooks, externalIDHook)
+	case boil.BeforeUpdateHook:
+		externalIDBeforeUpdateHooks = append(externalIDBeforeUpdateHooks, externalIDHook)
+	case boil.AfterUpdateHook:
+		externalIDAfterUpdateHooks = append(externalIDAfterUpdateHooks, externalIDHook)
+	case boil.BeforeDeleteHook:
+		externalIDBeforeDeleteHooks = append(externalIDBeforeDeleteHooks, externalIDHook)
+	case boil.AfterDeleteHook:
+		externalIDAfterDeleteHooks = append(externalIDAfterDeleteHooks, externalIDHook)
+	case boil.BeforeUpsertHook:
+		externalIDBeforeUpsertHooks = append(externalIDBeforeUpsertHooks, externalIDHook)
+	case boil.AfterUpsertHook:
+		externalIDAfterUpsertHooks = append(externalIDAfterUpsertHooks, externalIDHook)
+	}
+}
+
+// One returns a single externalID record from the query.
+func (q externalIDQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ExternalID, error) {
+	o := &ExternalID{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for external_ids")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ExternalID records from the query.
+func (q externalIDQuery) All(ctx context.Context, exec boil.ContextExecutor) (ExternalIDSlice, error) {
+	var o []*ExternalID
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ExternalID slice")
+	}
+
+	if len(externalIDAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ExternalID records in the query.
+func (q externalIDQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count external_ids rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q externalIDQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if external_ids exists")
+	}
+
+	return count > 0, nil
+}
+
+// ContributingUser pointed to by the foreign key.
+func (o *ExternalID) ContributingUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ContributedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Film pointed to by the foreign key.
+func (o *ExternalID) Film(mods ...qm.QueryMod) filmQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FilmID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Films(queryMods...)
+}
+
+// Series pointed to by the foreign key.
+func (o *ExternalID) Series(mods ...qm.QueryMod) seriesQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SeriesID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Serieses(queryMods...)
+}
+
+// LoadContributingUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (externalIDL) LoadContributingUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExternalID interface{}, mods queries.Applicator) error {
+	var slice []*ExternalID
+	var object *ExternalID
+
+	if singular {
+		var ok bool
+		object, ok = maybeExternalID.(*ExternalID)
+		if !ok {
+			object = new(ExternalID)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeExternalID)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeExternalID))
+			}
+		}
+	} else {
+		s, ok := maybeExternalID.(*[]*ExternalID)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeExternalID)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeExternalID))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &externalIDR{}
+		}
+		args = append(args, object.ContributedBy)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &externalIDR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ContributedBy {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ContributedBy)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(externalIDAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ContributingUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ContributedExternalIds = append(foreign.R.ContributedExternalIds, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ContributedBy == foreign.ID {
+				local.R.ContributingUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ContributedExternalIds = append(foreign.R.ContributedExternalIds, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFilm allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (externalIDL) LoadFilm(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExternalID interface{}, mods queries.Applicator) error {
+	var slice []*ExternalID
+	var object *ExternalID
+
+	if singular {
+		var ok bool
+		object, ok = maybeExternalID.(*ExternalID)
+		if !ok {
+			object = new(ExternalID)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeExternalID)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeExternalID))
+			}
+		}
+	} else {
+		s, ok := maybeExternalID.(*[]*ExternalID)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeExternalID)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeExternalID))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &externalIDR{}
+		}
+		if !queries.IsNil(object.FilmID) {
+			args = append(args, object.FilmID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &externalIDR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.FilmID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.FilmID) {
+				args = append(args, obj.FilmID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`films`),
+		qm.WhereIn(`films.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Film")
+	}
+
+	var resultSlice []*Film
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Film")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for films")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for films")
+	}
+
+	if len(externalIDAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Film = foreign
+		if foreign.R == nil {
+			foreign.R = &filmR{}
+		}
+		foreign.R.ExternalIds = append(foreign.R.ExternalIds, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.FilmID, foreign.ID) {
+				local.R.Film = foreign
+				if foreign.R == nil {
+					foreign.R = &filmR{}
+				}
+				foreign.R.ExternalIds = append(foreign.R.ExternalIds, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSeries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (externalIDL) LoadSeries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExternalID interface{}, mods queries.Applicator) error {
+	var slice []*ExternalID
+	var object *ExternalID
+
+	if singular {
+		var ok bool
+		object, ok = maybeExternalID.(*ExternalID)
+		if !ok {
+			object = new(ExternalID)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeExternalID)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeExternalID))
+			}
+		}
+	} else {
+		s, ok := maybeExternalID.(*[]*ExternalID)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeExternalID)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeExternalID))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &externalIDR{}
+		}
+		if !queries.IsNil(object.SeriesID) {
+			args = append(args, object.SeriesID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &externalIDR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.SeriesID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.SeriesID) {
+				args = append(args, obj.SeriesID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`serieses`),
+		qm.WhereIn(`serieses.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Series")
+	}
+
+	var resultSlice []*Series
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Series")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for serieses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for serieses")
+	}
+
+	if len(externalIDAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Series = foreign
+		if foreign.R == nil {
+			foreign.R = &seriesR{}
+		}
+		foreign.R.SeriesExternalIds = append(foreign.R.SeriesExternalIds, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.SeriesID, foreign.ID) {
+				local.R.Series = foreign
+				if foreign.R == nil {
+					foreign.R = &seriesR{}
+				}
+				foreign.R.SeriesExternalIds = append(foreign.R.SeriesExternalIds, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetContributingUser of the externalID to the related item.
+// Sets o.R.ContributingUser to related.
+// Adds o to related.R.ContributedExternalIds.
+func (o *ExternalID) SetContributingUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"external_ids\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
+		strmangle.WhereClause("\"", "\"", 2, externalIDPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ContributedBy = related.ID
+	if o.R == nil {
+		o.R = &externalIDR{
+			ContributingUser: related,
+		}
+	} else {
+		o.R.ContributingUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ContributedExternalIds: ExternalIDSlice{o},
+		}
+	} else {
+		related.R.ContributedExternalIds = append(related.R.ContributedExternalIds, o)
+	}
+
+	return nil
+}
+
+// SetFilm of the externalID to the related item.
+// Sets o.R.Film to related.
+// Adds o to related.R.ExternalIds.
+func (o *ExternalID) SetFilm(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Film) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"external_ids\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+		strmangle.WhereClause("\"", "\"", 2, externalIDPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.FilmID, related.ID)
+	if o.R == nil {
+		o.R = &externalIDR{
+			Film: related,
+		}
+	} else {
+		o.R.Film = related
+	}
+
+	if related.R == nil {
+		related.R = &filmR{
+			ExternalIds: ExternalIDSlice{o},
+		}
+	} else {
+		related.R.ExternalIds = append(related.R.ExternalIds, o)
+	}
+
+	return nil
+}
+
+// RemoveFilm relationship.
+// Sets o.R.Film to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *ExternalID) RemoveFilm(ctx context.Context, exec boil.ContextExecutor, related *Film) error {
+	var err error
+
+	queries.SetScanner(&o.FilmID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("film_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Film = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ExternalIds {
+		if queries.Equal(o.FilmID, ri.FilmID) {
+			continue
+		}
+
+		ln := len(related.R.ExternalIds)
+		if ln > 1 && i < ln-1 {
+			related.R.ExternalIds[i] = related.R.ExternalIds[ln-1]
+		}
+		related.R.ExternalIds = related.R.ExternalIds[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetSeries of the externalID to the related item.
+// Sets o.R.Series to related.
+// Adds o to related.R.SeriesExternalIds.
+func (o *ExternalID) SetSeries(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Series) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"external_ids\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+		strmangle.WhereClause("\"", "\"", 2, externalIDPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.SeriesID, related.ID)
+	if o.R == nil {
+		o.R = &externalIDR{
+			Series: related,
+		}
+	} else {
+		o.R.Series = related
+	}
+
+	if related.R == nil {
+		related.R = &seriesR{
+			SeriesExternalIds: ExternalIDSlice{o},
+		}
+	} else {
+		related.R.SeriesExternalIds = append(related.R.SeriesExternalIds, o)
+	}
+
+	return nil
+}
+
+// RemoveSeries relationship.
+// Sets o.R.Series to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *ExternalID) RemoveSeries(ctx context.Context, exec boil.ContextExecutor, related *Series) error {
+	var err error
+
+	queries.SetScanner(&o.SeriesID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("series_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Series = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.SeriesExternalIds {
+		if queries.Equal(o.SeriesID, ri.SeriesID) {
+			continue
+		}
+
+		ln := len(related.R.SeriesExternalIds)
+		if ln > 1 && i < ln-1 {
+			related.R.SeriesExternalIds[i] = related.R.SeriesExternalIds[ln-1]
+		}
+		related.R.SeriesExternalIds = related.R.SeriesExternalIds[:ln-1]
+		break
+	}
+	return nil
+}
+
+// ExternalIds retrieves all the records using an executor.
+func ExternalIds(mods ...qm.QueryMod) externalIDQuery {
+	mods = append(mods, qm.From("\"external_ids\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"external_ids\".*"})
+	}
+
+	return externalIDQuery{q}
+}
+
+// FindExternalID retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindExternalID(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*ExternalID, error) {
+	externalIDObj := &ExternalID{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"external_ids\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, externalIDObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from external_ids")
+	}
+
+	if err = externalIDObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return externalIDObj, err
+	}
+
+	return externalIDObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ExternalID) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no external_ids provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(externalIDColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	externalIDInsertCacheMut.RLock()
+	cache, cached := externalIDInsertCache[key]
+	externalIDInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			externalIDAllColumns,
+			externalIDColumnsWithDefault,
+			externalIDColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(externalIDType, externalIDMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(externalIDType, externalIDMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"external_ids\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"external_ids\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into external_ids")
+	}
+
+	if !cached {
+		externalIDInsertCacheMut.Lock()
+		externalIDInsertCache[key] = cache
+		externalIDInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ExternalID.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ExternalID) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	externalIDUpdateCacheMut.RLock()
+	cache, cached := externalIDUpdateCache[key]
+	externalIDUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			externalIDAllColumns,
+			externalIDPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update external_ids, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"external_ids\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, externalIDPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(externalIDType, externalIDMapping, append(wl, externalIDPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update external_ids row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for external_ids")
+	}
+
+	if !cached {
+		externalIDUpdateCacheMut.Lock()
+		externalIDUpdateCache[key] = cache
+		externalIDUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q externalIDQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for external_ids")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for external_ids")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ExternalIDSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), externalIDPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"external_ids\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, externalIDPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in externalID slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all externalID")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ExternalID) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no external_ids provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(externalIDColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	externalIDUpsertCacheMut.RLock()
+	cache, cached := externalIDUpsertCache[key]
+	externalIDUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			externalIDAllColumns,
+			externalIDColumnsWithDefault,
+			externalIDColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			externalIDAllColumns,
+			externalIDPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert external_ids, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(externalIDPrimaryKeyColumns))
+			copy(conflict, externalIDPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"external_ids\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(externalIDType, externalIDMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(externalIDType, externalIDMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert external_ids")
+	}
+
+	if !cached {
+		externalIDUpsertCacheMut.Lock()
+		externalIDUpsertCache[key] = cache
+		externalIDUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ExternalID record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ExternalID) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ExternalID provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), externalIDPrimaryKeyMapping)
+	sql := "DELETE FROM \"external_ids\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from external_ids")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for external_ids")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q externalIDQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no externalIDQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from external_ids")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for external_ids")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ExternalIDSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(externalIDBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), externalIDPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"external_ids\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, externalIDPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from externalID slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for external_ids")
+	}
+
+	if len(externalIDAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ExternalID) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindExternalID(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ExternalIDSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ExternalIDSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), externalIDPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"external_ids\".* FROM \"external_ids\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, externalIDPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ExternalIDSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ExternalIDExists checks if the ExternalID row exists.
+func ExternalIDExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"external_ids\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if external_ids exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,943 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ExternalIdsAudit is an object representing the database table.
+type ExternalIdsAudit struct {
+	ID            int         `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	FilmID        null.Int    `db:"film_id" boil:"film_id" json:"film_id,omitempty" toml:"film_id" yaml:"film_id,omitempty"`
+	SeriesID      null.Int    `db:"series_id" boil:"series_id" json:"series_id,omitempty" toml:"series_id" yaml:"series_id,omitempty"`
+	Provider      string      `db:"provider" boil:"provider" json:"provider" toml:"provider" yaml:"provider"`
+	ExternalID    string      `db:"external_id" boil:"external_id" json:"external_id" toml:"external_id" yaml:"external_id"`
+	ContributedBy int         `db:"contributed_by" boil:"contributed_by" json:"contributed_by" toml:"contributed_by" yaml:"contributed_by"`
+	ContributedAt time.Time   `db:"contributed_at" boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
+	Invalidation  null.String `db:"invalidation" boil:"invalidation" json:"invalidation,omitempty" toml:"invalidation" yaml:"invalidation,omitempty"`
+	AuditAction   string      `db:"audit_action" boil:"audit_action" json:"audit_action" toml:"audit_action" yaml:"audit_action"`
+	AuditActor    null.Int    `db:"audit_actor" boil:"audit_actor" json:"audit_actor,omitempty" toml:"audit_actor" yaml:"audit_actor,omitempty"`
+
+	R *externalIdsAuditR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L externalIdsAuditL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ExternalIdsAuditColumns = struct {
+	ID            string
+	FilmID        string
+	SeriesID      string
+	Provider      string
+	ExternalID    string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+	AuditAction   string
+	AuditActor    string
+}{
+	ID:            "id",
+	FilmID:        "film_id",
+	SeriesID:      "series_id",
+	Provider:      "provider",
+	ExternalID:    "external_id",
+	ContributedBy: "contributed_by",
+	ContributedAt: "contributed_at",
+	Invalidation:  "invalidation",
+	AuditAction:   "audit_action",
+	AuditActor:    "audit_actor",
+}
+
+var ExternalIdsAuditTableColumns = struct {
+	ID            string
+	FilmID        string
+	SeriesID      string
+	Provider      string
+	ExternalID    string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+	AuditAction   string
+	AuditActor    string
+}{
+	ID:            "external_ids_audit.id",
+	FilmID:        "external_ids_audit.film_id",
+	SeriesID:      "external_ids_audit.series_id",
+	Provider:      "external_ids_audit.provider",
+	ExternalID:    "external_ids_audit.external_id",
+	ContributedBy: "external_ids_audit.contributed_by",
+	ContributedAt: "external_ids_audit.contributed_at",
+	Invalidation:  "external_ids_audit.invalidation",
+	AuditAction:   "external_ids_audit.audit_action",
+	AuditActor:    "external_ids_audit.audit_actor",
+}
+
+// Generated where
+
+var ExternalIdsAuditWhere = struct {
+	ID            whereHelperint
+	FilmID        whereHelpernull_Int
+	SeriesID      whereHelpernull_Int
+	Provider      whereHelperstring
+	ExternalID    whereHelperstring
+	ContributedBy whereHelperint
+	ContributedAt whereHelpertime_Time
+	Invalidation  whereHelpernull_String
+	AuditAction   whereHelperstring
+	AuditActor    whereHelpernull_Int
+}{
+	ID:            whereHelperint{field: "\"external_ids_audit\".\"id\""},
+	FilmID:        whereHelpernull_Int{field: "\"external_ids_audit\".\"film_id\""},
+	SeriesID:      whereHelpernull_Int{field: "\"external_ids_audit\".\"series_id\""},
+	Provider:      whereHelperstring{field: "\"external_ids_audit\".\"provider\""},
+	ExternalID:    whereHelperstring{field: "\"external_ids_audit\".\"external_id\""},
+	ContributedBy: whereHelperint{field: "\"external_ids_audit\".\"contributed_by\""},
+	ContributedAt: whereHelpertime_Time{field: "\"external_ids_audit\".\"contributed_at\""},
+	Invalidation:  whereHelpernull_String{field: "\"external_ids_audit\".\"invalidation\""},
+	AuditAction:   whereHelperstring{field: "\"external_ids_audit\".\"audit_action\""},
+	AuditActor:    whereHelpernull_Int{field: "\"external_ids_audit\".\"audit_actor\""},
+}
+
+// ExternalIdsAuditRels is where relationship names are stored.
+var ExternalIdsAuditRels = struct {
+}{}
+
+// externalIdsAuditR is where relationships are stored.
+type externalIdsAuditR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*externalIdsAuditR) NewStruct() *externalIdsAuditR {
+	return &externalIdsAuditR{}
+}
+
+// externalIdsAuditL is where Load methods for each relationship are stored.
+type externalIdsAuditL struct{}
+
+var (
+	externalIdsAuditAllColumns            = []string{"id", "film_id", "series_id", "provider", "external_id", "contributed_by", "contributed_at", "invalidation", "audit_action", "audit_actor"}
+	externalIdsAuditColumnsWithoutDefault = []string{"id", "provider", "external_id", "contributed_by", "contributed_at"}
+	externalIdsAuditColumnsWithDefault    = []string{"film_id", "series_id", "invalidation", "audit_action", "audit_actor"}
+	externalIdsAuditPrimaryKeyColumns     = []string{"id", "contributed_by", "contributed_at"}
+	externalIdsAuditGeneratedColumns      = []string{}
+)
+
+type (
+	// ExternalIdsAuditSlice is an alias for a slice of pointers to ExternalIdsAudit.
+	// This should almost always be used instead of []ExternalIdsAudit.
+	ExternalIdsAuditSlice []*ExternalIdsAudit
+	// ExternalIdsAuditHook is the signature for custom ExternalIdsAudit hook methods
+	ExternalIdsAuditHook func(context.Context, boil.ContextExecutor, *ExternalIdsAudit) error
+
+	externalIdsAuditQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	externalIdsAuditType                 = reflect.TypeOf(&ExternalIdsAudit{})
+	externalIdsAuditMapping              = queries.MakeStructMapping(externalIdsAuditType)
+	externalIdsAuditPrimaryKeyMapping, _ = queries.BindMapping(externalIdsAuditType, externalIdsAuditMapping, externalIdsAuditPrimaryKeyColumns)
+	externalIdsAuditInsertCacheMut       sync.RWMutex
+	externalIdsAuditInsertCache          = make(map[string]insertCache)
+	externalIdsAuditUpdateCacheMut       sync.RWMutex
+	externalIdsAuditUpdateCache          = make(map[string]updateCache)
+	externalIdsAuditUpsertCacheMut       sync.RWMutex
+	externalIdsAuditUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var externalIdsAuditAfterSelectHooks []ExternalIdsAuditHook
+
+var externalIdsAuditBeforeInsertHooks []ExternalIdsAuditHook
+var externalIdsAuditAfterInsertHooks []ExternalIdsAuditHook
+
+var externalIdsAuditBeforeUpdateHooks []ExternalIdsAuditHook
+var externalIdsAuditAfterUpdateHooks []ExternalIdsAuditHook
+
+var externalIdsAuditBeforeDeleteHooks []ExternalIdsAuditHook
+var externalIdsAuditAfterDeleteHooks []ExternalIdsAuditHook
+
+var externalIdsAuditBeforeUpsertHooks []ExternalIdsAuditHook
+var externalIdsAuditAfterUpsertHooks []ExternalIdsAuditHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ExternalIdsAudit) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range externalIdsAuditAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ExternalIdsAudit) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range externalIdsAuditBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ExternalIdsAudit) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range externalIdsAuditAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ExternalIdsAudit) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range externalIdsAuditBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ExternalIdsAudit) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range externalIdsAuditAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ExternalIdsAudit) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range externalIdsAuditBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ExternalIdsAudit) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range externalIdsAuditAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ExternalIdsAudit) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range externalIdsAuditBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ExternalIdsAudit) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range externalIdsAuditAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddExternalIdsAuditHook registers your hook function for all future operations.
+func AddExternalIdsAuditHook(hookPoint boil.HookPoint, externalIdsAuditHook ExternalIdsAuditHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		externalIdsAuditAfterSelectHooks = append(externalIdsAuditAfterSelectHooks, externalIdsAuditHook)
+	case boil.BeforeInsertHook:
+		externalIdsAuditBeforeInsertHooks = append(externalIdsAuditBeforeInsertHooks, externalIdsAuditHook)
+	case boil.AfterInsertHook:
+		externalIdsAuditAfterInsertHooks = append(externalIdsAuditAfterInsertHooks, externalIdsAuditHook)
+	case boil.BeforeUpdateHook:
+		externalIdsAuditBeforeUpdateHooks = append(externalIdsAuditBeforeUpdateHooks, externalIdsAuditHook)
+	case boil.AfterUpdateHook:
+		externalIdsAuditAfterUpdateHooks = append(externalIdsAuditAfterUpdateHooks, externalIdsAuditHook)
+	case boil.BeforeDeleteHook:
+		externalIdsAuditBeforeDeleteHooks = append(externalIdsAuditBeforeDeleteHooks, externalIdsAuditHook)
+	case boil.AfterDeleteHook:
+		externalIdsAuditAfterDeleteHooks = append(externalIdsAuditAfterDeleteHooks, externalIdsAuditHook)
+	case boil.BeforeUpsertHook:
+		externalIdsAuditBeforeUpsertHooks = append(externalIdsAuditBeforeUpsertHooks, externalIdsAuditHook)
+	case boil.AfterUpsertHook:
+		externalIdsAuditAfterUpsertHooks = append(externalIdsAuditAfterUpsertHooks, externalIdsAuditHook)
+	}
+}
+
+// One returns a single externalIdsAudit record from the query.
+func (q externalIdsAuditQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ExternalIdsAudit, error) {
+	o := &ExternalIdsAudit{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for external_ids_audit")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ExternalIdsAudit records from the query.
+func (q externalIdsAuditQuery) All(ctx context.Context, exec boil.ContextExecutor) (ExternalIdsAuditSlice, error) {
+	var o []*ExternalIdsAudit
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ExternalIdsAudit slice")
+	}
+
+	if len(externalIdsAuditAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ExternalIdsAudit records in the query.
+func (q externalIdsAuditQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count external_ids_audit rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q externalIdsAuditQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if external_ids_audit exists")
+	}
+
+	return count > 0, nil
+}
+
+// ExternalIdsAudits retrieves all the records using an executor.
+func ExternalIdsAudits(mods ...qm.QueryMod) externalIdsAuditQuery {
+	mods = append(mods, qm.From("\"external_ids_audit\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"external_ids_audit\".*"})
+	}
+
+	return externalIdsAuditQuery{q}
+}
+
+// FindExternalIdsAudit retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindExternalIdsAudit(ctx context.Context, exec boil.ContextExecutor, iD int, contributedBy int, contributedAt time.Time, selectCols ...string) (*ExternalIdsAudit, error) {
+	externalIdsAuditObj := &ExternalIdsAudit{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"external_ids_audit\" where \"id\"=$1 AND \"contributed_by\"=$2 AND \"contributed_at\"=$3", sel,
+	)
+
+	q := queries.Raw(query, iD, contributedBy, contributedAt)
+
+	err := q.Bind(ctx, exec, externalIdsAuditObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from external_ids_audit")
+	}
+
+	if err = externalIdsAuditObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return externalIdsAuditObj, err
+	}
+
+	return externalIdsAuditObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ExternalIdsAudit) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no external_ids_audit provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(externalIdsAuditColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	externalIdsAuditInsertCacheMut.RLock()
+	cache, cached := externalIdsAuditInsertCache[key]
+	externalIdsAuditInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			externalIdsAuditAllColumns,
+			externalIdsAuditColumnsWithDefault,
+			externalIdsAuditColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(externalIdsAuditType, externalIdsAuditMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(externalIdsAuditType, externalIdsAuditMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"external_ids_audit\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"external_ids_audit\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into external_ids_audit")
+	}
+
+	if !cached {
+		externalIdsAuditInsertCacheMut.Lock()
+		externalIdsAuditInsertCache[key] = cache
+		externalIdsAuditInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ExternalIdsAudit.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ExternalIdsAudit) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	externalIdsAuditUpdateCacheMut.RLock()
+	cache, cached := externalIdsAuditUpdateCache[key]
+	externalIdsAuditUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			externalIdsAuditAllColumns,
+			externalIdsAuditPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update external_ids_audit, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"external_ids_audit\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, externalIdsAuditPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(externalIdsAuditType, externalIdsAuditMapping, append(wl, externalIdsAuditPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update external_ids_audit row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for external_ids_audit")
+	}
+
+	if !cached {
+		externalIdsAuditUpdateCacheMut.Lock()
+		externalIdsAuditUpdateCache[key] = cache
+		externalIdsAuditUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q externalIdsAuditQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for external_ids_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for external_ids_audit")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ExternalIdsAuditSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), externalIdsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"external_ids_audit\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, externalIdsAuditPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in externalIdsAudit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all externalIdsAudit")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ExternalIdsAudit) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no external_ids_audit provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(externalIdsAuditColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	externalIdsAuditUpsertCacheMut.RLock()
+	cache, cached := externalIdsAuditUpsertCache[key]
+	externalIdsAuditUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			externalIdsAuditAllColumns,
+			externalIdsAuditColumnsWithDefault,
+			externalIdsAuditColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			externalIdsAuditAllColumns,
+			externalIdsAuditPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert external_ids_audit, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(externalIdsAuditPrimaryKeyColumns))
+			copy(conflict, externalIdsAuditPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"external_ids_audit\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(externalIdsAuditType, externalIdsAuditMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(externalIdsAuditType, externalIdsAuditMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert external_ids_audit")
+	}
+
+	if !cached {
+		externalIdsAuditUpsertCacheMut.Lock()
+		externalIdsAuditUpsertCache[key] = cache
+		externalIdsAuditUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ExternalIdsAudit record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ExternalIdsAudit) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ExternalIdsAudit provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), externalIdsAuditPrimaryKeyMapping)
+	sql := "DELETE FROM \"external_ids_audit\" WHERE \"id\"=$1 AND \"contributed_by\"=$2 AND \"contributed_at\"=$3"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from external_ids_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for external_ids_audit")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q externalIdsAuditQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no externalIdsAuditQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from external_ids_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for external_ids_audit")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ExternalIdsAuditSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(externalIdsAuditBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), externalIdsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"external_ids_audit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, externalIdsAuditPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from externalIdsAudit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for external_ids_audit")
+	}
+
+	if len(externalIdsAuditAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ExternalIdsAudit) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindExternalIdsAudit(ctx, exec, o.ID, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ExternalIdsAuditSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ExternalIdsAuditSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), externalIdsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"external_ids_audit\".* FROM \"external_ids_audit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, externalIdsAuditPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ExternalIdsAuditSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ExternalIdsAuditExists checks if the ExternalIdsAudit row exists.
+func ExternalIdsAuditExists(ctx context.Context, exec boil.ContextExecutor, iD int, contributedBy int, contributedAt time.Time) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"external_ids_audit\" where \"id\"=$1 AND \"contributed_by\"=$2 AND \"contributed_at\"=$3 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD, contributedBy, contributedAt)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD, contributedBy, contributedAt)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if external_ids_audit exists")
+	}
+
+	return exists, nil
+}
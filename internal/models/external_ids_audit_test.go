@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testExternalIdsAudits(t *testing.T) {
+	t.Parallel()
+
+	query := ExternalIdsAudits()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testExternalIdsAuditsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdsAudit{}
+	if err = randomize.Struct(seed, o, externalIdsAuditDBTypes, true, externalIdsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ExternalIdsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testExternalIdsAuditsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdsAudit{}
+	if err = randomize.Struct(seed, o, externalIdsAuditDBTypes, true, externalIdsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ExternalIdsAudits().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ExternalIdsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testExternalIdsAuditsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdsAudit{}
+	if err = randomize.Struct(seed, o, externalIdsAuditDBTypes, true, externalIdsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ExternalIdsAuditSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ExternalIdsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testExternalIdsAuditsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdsAudit{}
+	if err = randomize.Struct(seed, o, externalIdsAuditDBTypes, true, externalIdsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ExternalIdsAuditExists(ctx, tx, o.ID, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		t.Errorf("Unable to check if ExternalIdsAudit exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ExternalIdsAuditExists to return true, but got false.")
+	}
+}
+
+func testExternalIdsAuditsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdsAudit{}
+	if err = randomize.Struct(seed, o, externalIdsAuditDBTypes, true, externalIdsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	externalIdsAuditFound, err := FindExternalIdsAudit(ctx, tx, o.ID, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if externalIdsAuditFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testExternalIdsAuditsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdsAudit{}
+	if err = randomize.Struct(seed, o, externalIdsAuditDBTypes, true, externalIdsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ExternalIdsAudits().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testExternalIdsAuditsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdsAudit{}
+	if err = randomize.Struct(seed, o, externalIdsAuditDBTypes, true, externalIdsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ExternalIdsAudits().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testExternalIdsAuditsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	externalIdsAuditOne := &ExternalIdsAudit{}
+	externalIdsAuditTwo := &ExternalIdsAudit{}
+	if err = randomize.Struct(seed, externalIdsAuditOne, externalIdsAuditDBTypes, false, externalIdsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdsAudit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, externalIdsAuditTwo, externalIdsAuditDBTypes, false, externalIdsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = externalIdsAuditOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = externalIdsAuditTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ExternalIdsAudits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testExternalIdsAuditsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	externalIdsAuditOne := &ExternalIdsAudit{}
+	externalIdsAuditTwo := &ExternalIdsAudit{}
+	if err = randomize.Struct(seed, externalIdsAuditOne, externalIdsAuditDBTypes, false, externalIdsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdsAudit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, externalIdsAuditTwo, externalIdsAuditDBTypes, false, externalIdsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = externalIdsAuditOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = externalIdsAuditTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ExternalIdsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func externalIdsAuditBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ExternalIdsAudit) error {
+	*o = ExternalIdsAudit{}
+	return nil
+}
+
+func externalIdsAuditAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ExternalIdsAudit) error {
+	*o = ExternalIdsAudit{}
+	return nil
+}
+
+func externalIdsAuditAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ExternalIdsAudit) error {
+	*o = ExternalIdsAudit{}
+	return nil
+}
+
+func externalIdsAuditBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ExternalIdsAudit) error {
+	*o = ExternalIdsAudit{}
+	return nil
+}
+
+func externalIdsAuditAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ExternalIdsAudit) error {
+	*o = ExternalIdsAudit{}
+	return nil
+}
+
+func externalIdsAuditBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ExternalIdsAudit) error {
+	*o = ExternalIdsAudit{}
+	return nil
+}
+
+func externalIdsAuditAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ExternalIdsAudit) error {
+	*o = ExternalIdsAudit{}
+	return nil
+}
+
+func externalIdsAuditBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ExternalIdsAudit) error {
+	*o = ExternalIdsAudit{}
+	return nil
+}
+
+func externalIdsAuditAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ExternalIdsAudit) error {
+	*o = ExternalIdsAudit{}
+	return nil
+}
+
+func testExternalIdsAuditsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ExternalIdsAudit{}
+	o := &ExternalIdsAudit{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, externalIdsAuditDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ExternalIdsAudit object: %s", err)
+	}
+
+	AddExternalIdsAuditHook(boil.BeforeInsertHook, externalIdsAuditBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	externalIdsAuditBeforeInsertHooks = []ExternalIdsAuditHook{}
+
+	AddExternalIdsAuditHook(boil.AfterInsertHook, externalIdsAuditAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	externalIdsAuditAfterInsertHooks = []ExternalIdsAuditHook{}
+
+	AddExternalIdsAuditHook(boil.AfterSelectHook, externalIdsAuditAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	externalIdsAuditAfterSelectHooks = []ExternalIdsAuditHook{}
+
+	AddExternalIdsAuditHook(boil.BeforeUpdateHook, externalIdsAuditBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	externalIdsAuditBeforeUpdateHooks = []ExternalIdsAuditHook{}
+
+	AddExternalIdsAuditHook(boil.AfterUpdateHook, externalIdsAuditAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	externalIdsAuditAfterUpdateHooks = []ExternalIdsAuditHook{}
+
+	AddExternalIdsAuditHook(boil.BeforeDeleteHook, externalIdsAuditBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	externalIdsAuditBeforeDeleteHooks = []ExternalIdsAuditHook{}
+
+	AddExternalIdsAuditHook(boil.AfterDeleteHook, externalIdsAuditAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	externalIdsAuditAfterDeleteHooks = []ExternalIdsAuditHook{}
+
+	AddExternalIdsAuditHook(boil.BeforeUpsertHook, externalIdsAuditBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	externalIdsAuditBeforeUpsertHooks = []ExternalIdsAuditHook{}
+
+	AddExternalIdsAuditHook(boil.AfterUpsertHook, externalIdsAuditAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	externalIdsAuditAfterUpsertHooks = []ExternalIdsAuditHook{}
+}
+
+func testExternalIdsAuditsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdsAudit{}
+	if err = randomize.Struct(seed, o, externalIdsAuditDBTypes, true, externalIdsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ExternalIdsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testExternalIdsAuditsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdsAudit{}
+	if err = randomize.Struct(seed, o, externalIdsAuditDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ExternalIdsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(externalIdsAuditColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ExternalIdsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testExternalIdsAuditsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdsAudit{}
+	if err = randomize.Struct(seed, o, externalIdsAuditDBTypes, true, externalIdsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testExternalIdsAuditsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdsAudit{}
+	if err = randomize.Struct(seed, o, externalIdsAuditDBTypes, true, externalIdsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ExternalIdsAuditSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testExternalIdsAuditsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdsAudit{}
+	if err = randomize.Struct(seed, o, externalIdsAuditDBTypes, true, externalIdsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ExternalIdsAudits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	externalIdsAuditDBTypes = map[string]string{`ID`: `integer`, `FilmID`: `integer`, `SeriesID`: `integer`, `Provider`: `character varying`, `ExternalID`: `character varying`, `ContributedBy`: `integer`, `ContributedAt`: `timestamp with time zone`, `Invalidation`: `character varying`, `AuditAction`: `character varying`, `AuditActor`: `integer`}
+	_                       = bytes.MinRead
+)
+
+func testExternalIdsAuditsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(externalIdsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(externalIdsAuditAllColumns) == len(externalIdsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdsAudit{}
+	if err = randomize.Struct(seed, o, externalIdsAuditDBTypes, true, externalIdsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ExternalIdsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, externalIdsAuditDBTypes, true, externalIdsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdsAudit struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testExternalIdsAuditsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(externalIdsAuditAllColumns) == len(externalIdsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalIdsAudit{}
+	if err = randomize.Struct(seed, o, externalIdsAuditDBTypes, true, externalIdsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ExternalIdsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, externalIdsAuditDBTypes, true, externalIdsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdsAudit struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(externalIdsAuditAllColumns, externalIdsAuditPrimaryKeyColumns) {
+		fields = externalIdsAuditAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			externalIdsAuditAllColumns,
+			externalIdsAuditPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ExternalIdsAuditSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testExternalIdsAuditsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(externalIdsAuditAllColumns) == len(externalIdsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ExternalIdsAudit{}
+	if err = randomize.Struct(seed, &o, externalIdsAuditDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ExternalIdsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ExternalIdsAudit: %s", err)
+	}
+
+	count, err := ExternalIdsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, externalIdsAuditDBTypes, false, externalIdsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ExternalIdsAudit struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ExternalIdsAudit: %s", err)
+	}
+
+	count, err = ExternalIdsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
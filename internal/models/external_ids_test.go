@@ -0,0 +1,1160 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testExternalIds(t *testing.T) {
+	t.Parallel()
+
+	query := ExternalIds()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testExternalIdsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalID{}
+	if err = randomize.Struct(seed, o, externalIDDBTypes, true, externalIDColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalID struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ExternalIds().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testExternalIdsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalID{}
+	if err = randomize.Struct(seed, o, externalIDDBTypes, true, externalIDColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalID struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ExternalIds().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ExternalIds().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testExternalIdsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalID{}
+	if err = randomize.Struct(seed, o, externalIDDBTypes, true, externalIDColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalID struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ExternalIDSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ExternalIds().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testExternalIdsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalID{}
+	if err = randomize.Struct(seed, o, externalIDDBTypes, true, externalIDColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalID struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ExternalIDExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if ExternalID exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ExternalIDExists to return true, but got false.")
+	}
+}
+
+func testExternalIdsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalID{}
+	if err = randomize.Struct(seed, o, externalIDDBTypes, true, externalIDColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalID struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	externalIDFound, err := FindExternalID(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if externalIDFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testExternalIdsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalID{}
+	if err = randomize.Struct(seed, o, externalIDDBTypes, true, externalIDColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalID struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ExternalIds().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testExternalIdsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalID{}
+	if err = randomize.Struct(seed, o, externalIDDBTypes, true, externalIDColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalID struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ExternalIds().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testExternalIdsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	externalIDOne := &ExternalID{}
+	externalIDTwo := &ExternalID{}
+	if err = randomize.Struct(seed, externalIDOne, externalIDDBTypes, false, externalIDColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalID struct: %s", err)
+	}
+	if err = randomize.Struct(seed, externalIDTwo, externalIDDBTypes, false, externalIDColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalID struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = externalIDOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = externalIDTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ExternalIds().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testExternalIdsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	externalIDOne := &ExternalID{}
+	externalIDTwo := &ExternalID{}
+	if err = randomize.Struct(seed, externalIDOne, externalIDDBTypes, false, externalIDColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalID struct: %s", err)
+	}
+	if err = randomize.Struct(seed, externalIDTwo, externalIDDBTypes, false, externalIDColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalID struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = externalIDOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = externalIDTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ExternalIds().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func externalIDBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ExternalID) error {
+	*o = ExternalID{}
+	return nil
+}
+
+func externalIDAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ExternalID) error {
+	*o = ExternalID{}
+	return nil
+}
+
+func externalIDAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ExternalID) error {
+	*o = ExternalID{}
+	return nil
+}
+
+func externalIDBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ExternalID) error {
+	*o = ExternalID{}
+	return nil
+}
+
+func externalIDAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ExternalID) error {
+	*o = ExternalID{}
+	return nil
+}
+
+func externalIDBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ExternalID) error {
+	*o = ExternalID{}
+	return nil
+}
+
+func externalIDAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ExternalID) error {
+	*o = ExternalID{}
+	return nil
+}
+
+func externalIDBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ExternalID) error {
+	*o = ExternalID{}
+	return nil
+}
+
+func externalIDAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ExternalID) error {
+	*o = ExternalID{}
+	return nil
+}
+
+func testExternalIdsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ExternalID{}
+	o := &ExternalID{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, externalIDDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ExternalID object: %s", err)
+	}
+
+	AddExternalIDHook(boil.BeforeInsertHook, externalIDBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	externalIDBeforeInsertHooks = []ExternalIDHook{}
+
+	AddExternalIDHook(boil.AfterInsertHook, externalIDAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	externalIDAfterInsertHooks = []ExternalIDHook{}
+
+	AddExternalIDHook(boil.AfterSelectHook, externalIDAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	externalIDAfterSelectHooks = []ExternalIDHook{}
+
+	AddExternalIDHook(boil.BeforeUpdateHook, externalIDBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	externalIDBeforeUpdateHooks = []ExternalIDHook{}
+
+	AddExternalIDHook(boil.AfterUpdateHook, externalIDAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	externalIDAfterUpdateHooks = []ExternalIDHook{}
+
+	AddExternalIDHook(boil.BeforeDeleteHook, externalIDBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	externalIDBeforeDeleteHooks = []ExternalIDHook{}
+
+	AddExternalIDHook(boil.AfterDeleteHook, externalIDAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	externalIDAfterDeleteHooks = []ExternalIDHook{}
+
+	AddExternalIDHook(boil.BeforeUpsertHook, externalIDBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	externalIDBeforeUpsertHooks = []ExternalIDHook{}
+
+	AddExternalIDHook(boil.AfterUpsertHook, externalIDAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	externalIDAfterUpsertHooks = []ExternalIDHook{}
+}
+
+func testExternalIdsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalID{}
+	if err = randomize.Struct(seed, o, externalIDDBTypes, true, externalIDColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalID struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ExternalIds().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testExternalIdsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalID{}
+	if err = randomize.Struct(seed, o, externalIDDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ExternalID struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(externalIDColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ExternalIds().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testExternalIDToOneUserUsingContributingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ExternalID
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, externalIDDBTypes, false, externalIDColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalID struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ContributedBy = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ContributingUser().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ExternalIDSlice{&local}
+	if err = local.L.LoadContributingUser(ctx, tx, false, (*[]*ExternalID)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ContributingUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ContributingUser = nil
+	if err = local.L.LoadContributingUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ContributingUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testExternalIDToOneFilmUsingFilm(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ExternalID
+	var foreign Film
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, externalIDDBTypes, true, externalIDColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalID struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, filmDBTypes, false, filmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Film struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.FilmID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Film().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ExternalIDSlice{&local}
+	if err = local.L.LoadFilm(ctx, tx, false, (*[]*ExternalID)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Film == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Film = nil
+	if err = local.L.LoadFilm(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Film == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testExternalIDToOneSeriesUsingSeries(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ExternalID
+	var foreign Series
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, externalIDDBTypes, true, externalIDColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalID struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, seriesDBTypes, false, seriesColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Series struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.SeriesID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Series().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ExternalIDSlice{&local}
+	if err = local.L.LoadSeries(ctx, tx, false, (*[]*ExternalID)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Series == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Series = nil
+	if err = local.L.LoadSeries(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Series == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testExternalIDToOneSetOpUserUsingContributingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ExternalID
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, externalIDDBTypes, false, strmangle.SetComplement(externalIDPrimaryKeyColumns, externalIDColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetContributingUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ContributingUser != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ContributedExternalIds[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ContributedBy != x.ID {
+			t.Error("foreign key was wrong value", a.ContributedBy)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ContributedBy))
+		reflect.Indirect(reflect.ValueOf(&a.ContributedBy)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ContributedBy != x.ID {
+			t.Error("foreign key was wrong value", a.ContributedBy, x.ID)
+		}
+	}
+}
+func testExternalIDToOneSetOpFilmUsingFilm(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ExternalID
+	var b, c Film
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, externalIDDBTypes, false, strmangle.SetComplement(externalIDPrimaryKeyColumns, externalIDColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Film{&b, &c} {
+		err = a.SetFilm(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Film != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ExternalIds[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.FilmID, x.ID) {
+			t.Error("foreign key was wrong value", a.FilmID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.FilmID))
+		reflect.Indirect(reflect.ValueOf(&a.FilmID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.FilmID, x.ID) {
+			t.Error("foreign key was wrong value", a.FilmID, x.ID)
+		}
+	}
+}
+
+func testExternalIDToOneRemoveOpFilmUsingFilm(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ExternalID
+	var b Film
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, externalIDDBTypes, false, strmangle.SetComplement(externalIDPrimaryKeyColumns, externalIDColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetFilm(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveFilm(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Film().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Film != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.FilmID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.ExternalIds) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testExternalIDToOneSetOpSeriesUsingSeries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ExternalID
+	var b, c Series
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, externalIDDBTypes, false, strmangle.SetComplement(externalIDPrimaryKeyColumns, externalIDColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Series{&b, &c} {
+		err = a.SetSeries(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Series != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.SeriesExternalIds[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.SeriesID, x.ID) {
+			t.Error("foreign key was wrong value", a.SeriesID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.SeriesID))
+		reflect.Indirect(reflect.ValueOf(&a.SeriesID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.SeriesID, x.ID) {
+			t.Error("foreign key was wrong value", a.SeriesID, x.ID)
+		}
+	}
+}
+
+func testExternalIDToOneRemoveOpSeriesUsingSeries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ExternalID
+	var b Series
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, externalIDDBTypes, false, strmangle.SetComplement(externalIDPrimaryKeyColumns, externalIDColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetSeries(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveSeries(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Series().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Series != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.SeriesID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.SeriesExternalIds) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testExternalIdsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalID{}
+	if err = randomize.Struct(seed, o, externalIDDBTypes, true, externalIDColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalID struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testExternalIdsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalID{}
+	if err = randomize.Struct(seed, o, externalIDDBTypes, true, externalIDColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalID struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ExternalIDSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testExternalIdsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalID{}
+	if err = randomize.Struct(seed, o, externalIDDBTypes, true, externalIDColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalID struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ExternalIds().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	externalIDDBTypes = map[string]string{`ID`: `integer`, `FilmID`: `integer`, `SeriesID`: `integer`, `Provider`: `character varying`, `ExternalID`: `character varying`, `ContributedBy`: `integer`, `ContributedAt`: `timestamp with time zone`, `Invalidation`: `character varying`}
+	_                 = bytes.MinRead
+)
+
+func testExternalIdsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(externalIDPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(externalIDAllColumns) == len(externalIDPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalID{}
+	if err = randomize.Struct(seed, o, externalIDDBTypes, true, externalIDColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalID struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ExternalIds().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, externalIDDBTypes, true, externalIDPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ExternalID struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testExternalIdsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(externalIDAllColumns) == len(externalIDPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ExternalID{}
+	if err = randomize.Struct(seed, o, externalIDDBTypes, true, externalIDColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ExternalID struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ExternalIds().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, externalIDDBTypes, true, externalIDPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ExternalID struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(externalIDAllColumns, externalIDPrimaryKeyColumns) {
+		fields = externalIDAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			externalIDAllColumns,
+			externalIDPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ExternalIDSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testExternalIdsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(externalIDAllColumns) == len(externalIDPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ExternalID{}
+	if err = randomize.Struct(seed, &o, externalIDDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ExternalID struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ExternalID: %s", err)
+	}
+
+	count, err := ExternalIds().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, externalIDDBTypes, false, externalIDPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ExternalID struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ExternalID: %s", err)
+	}
+
+	count, err = ExternalIds().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
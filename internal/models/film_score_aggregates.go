@@ -0,0 +1,1157 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// FilmScoreAggregate is an object representing the database table.
+type FilmScoreAggregate struct {
+	FilmID         int          `db:"film_id" boil:"film_id" json:"film_id" toml:"film_id" yaml:"film_id"`
+	ScoresCount    int          `db:"scores_count" boil:"scores_count" json:"scores_count" toml:"scores_count" yaml:"scores_count"`
+	ScoresSum      int          `db:"scores_sum" boil:"scores_sum" json:"scores_sum" toml:"scores_sum" yaml:"scores_sum"`
+	ScoreMean      null.Float64 `db:"score_mean" boil:"score_mean" json:"score_mean,omitempty" toml:"score_mean" yaml:"score_mean,omitempty"`
+	ScoreHistogram types.JSON   `db:"score_histogram" boil:"score_histogram" json:"score_histogram" toml:"score_histogram" yaml:"score_histogram"`
+
+	R *filmScoreAggregateR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L filmScoreAggregateL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var FilmScoreAggregateColumns = struct {
+	FilmID         string
+	ScoresCount    string
+	ScoresSum      string
+	ScoreMean      string
+	ScoreHistogram string
+}{
+	FilmID:         "film_id",
+	ScoresCount:    "scores_count",
+	ScoresSum:      "scores_sum",
+	ScoreMean:      "score_mean",
+	ScoreHistogram: "score_histogram",
+}
+
+var FilmScoreAggregateTableColumns = struct {
+	FilmID         string
+	ScoresCount    string
+	ScoresSum      string
+	ScoreMean      string
+	ScoreHistogram string
+}{
+	FilmID:         "film_score_aggregates.film_id",
+	ScoresCount:    "film_score_aggregates.scores_count",
+	ScoresSum:      "film_score_aggregates.scores_sum",
+	ScoreMean:      "film_score_aggregates.score_mean",
+	ScoreHistogram: "film_score_aggregates.score_histogram",
+}
+
+// Generated where
+
+type whereHelpernull_Float64 struct{ field string }
+
+func (w whereHelpernull_Float64) EQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Float64) NEQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Float64) LT(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Float64) LTE(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Float64) GT(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Float64) GTE(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Float64) IN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Float64) NIN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Float64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Float64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelpertypes_JSON struct{ field string }
+
+func (w whereHelpertypes_JSON) EQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_JSON) NEQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_JSON) LT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_JSON) LTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_JSON) GT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_JSON) GTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var FilmScoreAggregateWhere = struct {
+	FilmID         whereHelperint
+	ScoresCount    whereHelperint
+	ScoresSum      whereHelperint
+	ScoreMean      whereHelpernull_Float64
+	ScoreHistogram whereHelpertypes_JSON
+}{
+	FilmID:         whereHelperint{field: "\"film_score_aggregates\".\"film_id\""},
+	ScoresCount:    whereHelperint{field: "\"film_score_aggregates\".\"scores_count\""},
+	ScoresSum:      whereHelperint{field: "\"film_score_aggregates\".\"scores_sum\""},
+	ScoreMean:      whereHelpernull_Float64{field: "\"film_score_aggregates\".\"score_mean\""},
+	ScoreHistogram: whereHelpertypes_JSON{field: "\"film_score_aggregates\".\"score_histogram\""},
+}
+
+// FilmScoreAggregateRels is where relationship names are stored.
+var FilmScoreAggregateRels = struct {
+	Film string
+}{
+	Film: "Film",
+}
+
+// filmScoreAggregateR is where relationships are stored.
+type filmScoreAggregateR struct {
+	Film *Film `db:"Film" boil:"Film" json:"Film" toml:"Film" yaml:"Film"`
+}
+
+// NewStruct creates a new relationship struct
+func (*filmScoreAggregateR) NewStruct() *filmScoreAggregateR {
+	return &filmScoreAggregateR{}
+}
+
+func (r *filmScoreAggregateR) GetFilm() *Film {
+	if r == nil {
+		return nil
+	}
+	return r.Film
+}
+
+// filmScoreAggregateL is where Load methods for each relationship are stored.
+type filmScoreAggregateL struct{}
+
+var (
+	filmScoreAggregateAllColumns            = []string{"film_id", "scores_count", "scores_sum", "score_mean", "score_histogram"}
+	filmScoreAggregateColumnsWithoutDefault = []string{"film_id"}
+	filmScoreAggregateColumnsWithDefault    = []string{"scores_count", "scores_sum", "score_mean", "score_histogram"}
+	filmScoreAggregatePrimaryKeyColumns     = []string{"film_id"}
+	filmScoreAggregateGeneratedColumns      = []string{}
+)
+
+type (
+	// FilmScoreAggregateSlice is an alias for a slice of pointers to FilmScoreAggregate.
+	// This should almost always be used instead of []FilmScoreAggregate.
+	FilmScoreAggregateSlice []*FilmScoreAggregate
+	// FilmScoreAggregateHook is the signature for custom FilmScoreAggregate hook methods
+	FilmScoreAggregateHook func(context.Context, boil.ContextExecutor, *FilmScoreAggregate) error
+
+	filmScoreAggregateQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	filmScoreAggregateType                 = reflect.TypeOf(&FilmScoreAggregate{})
+	filmScoreAggregateMapping              = queries.MakeStructMapping(filmScoreAggregateType)
+	filmScoreAggregatePrimaryKeyMapping, _ = queries.BindMapping(filmScoreAggregateType, filmScoreAggregateMapping, filmScoreAggregatePrimaryKeyColumns)
+	filmScoreAggregateInsertCacheMut       sync.RWMutex
+	filmScoreAggregateInsertCache          = make(map[string]insertCache)
+	filmScoreAggregateUpdateCacheMut       sync.RWMutex
+	filmScoreAggregateUpdateCache          = make(map[string]updateCache)
+	filmScoreAggregateUpsertCacheMut       sync.RWMutex
+	filmScoreAggregateUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var filmScoreAggregateAfterSelectHooks []FilmScoreAggregateHook
+
+var filmScoreAggregateBeforeInsertHooks []FilmScoreAggregateHook
+var filmScoreAggregateAfterInsertHooks []FilmScoreAggregateHook
+
+var filmScoreAggregateBeforeUpdateHooks []FilmScoreAggregateHook
+var filmScoreAggregateAfterUpdateHooks []FilmScoreAggregateHook
+
+var filmScoreAggregateBeforeDeleteHooks []FilmScoreAggregateHook
+var filmScoreAggregateAfterDeleteHooks []FilmScoreAggregateHook
+
+var filmScoreAggregateBeforeUpsertHooks []FilmScoreAggregateHook
+var filmScoreAggregateAfterUpsertHooks []FilmScoreAggregateHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *FilmScoreAggregate) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmScoreAggregateAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *FilmScoreAggregate) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmScoreAggregateBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *FilmScoreAggregate) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmScoreAggregateAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *FilmScoreAggregate) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmScoreAggregateBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *FilmScoreAggregate) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmScoreAggregateAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *FilmScoreAggregate) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmScoreAggregateBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *FilmScoreAggregate) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmScoreAggregateAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *FilmScoreAggregate) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmScoreAggregateBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *FilmScoreAggregate) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range filmScoreAggregateAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddFilmScoreAggregateHook registers your hook function for all future operations.
+func AddFilmScoreAggregateHook(hookPoint boil.HookPoint, filmScoreAggregateHook FilmScoreAggregateHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		filmScoreAggregateAfterSelectHooks = append(filmScoreAggregateAfterSelectHooks, filmScoreAggregateHook)
+	case boil.BeforeInsertHook:
+		filmScoreAggregateBeforeInsertHooks = append(filmScoreAggregateBeforeInsertHooks, filmScoreAggregateHook)
+	case boil.AfterInsertHook:
+		filmScoreAggregateAfterInsertHooks = append(filmScoreAggregateAfterInsertHooks, filmScoreAggregateHook)
+	case boil.BeforeUpdateHook:
+		filmScoreAggregateBeforeUpdateHooks = append(filmScoreAggregateBeforeUpdateHooks, filmScoreAggregateHook)
+	case boil.AfterUpdateHook:
+		filmScoreAggregateAfterUpdateHooks = append(filmScoreAggregateAfterUpdateHooks, filmScoreAggregateHook)
+	case boil.BeforeDeleteHook:
+		filmScoreAggregateBeforeDeleteHooks = append(filmScoreAggregateBeforeDeleteHooks, filmScoreAggregateHook)
+	case boil.AfterDeleteHook:
+		filmScoreAggregateAfterDeleteHooks = append(filmScoreAggregateAfterDeleteHooks, filmScoreAggregateHook)
+	case boil.BeforeUpsertHook:
+		filmScoreAggregateBeforeUpsertHooks = append(filmScoreAggregateBeforeUpsertHooks, filmScoreAggregateHook)
+	case boil.AfterUpsertHook:
+		filmScoreAggregateAfterUpsertHooks = append(filmScoreAggregateAfterUpsertHooks, filmScoreAggregateHook)
+	}
+}
+
+// One returns a single filmScoreAggregate record from the query.
+func (q filmScoreAggregateQuery) One(ctx context.Context, exec boil.ContextExecutor) (*FilmScoreAggregate, error) {
+	o := &FilmScoreAggregate{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for film_score_aggregates")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all FilmScoreAggregate records from the query.
+func (q filmScoreAggregateQuery) All(ctx context.Context, exec boil.ContextExecutor) (FilmScoreAggregateSlice, error) {
+	var o []*FilmScoreAggregate
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to FilmScoreAggregate slice")
+	}
+
+	if len(filmScoreAggregateAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all FilmScoreAggregate records in the query.
+func (q filmScoreAggregateQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count film_score_aggregates rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q filmScoreAggregateQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if film_score_aggregates exists")
+	}
+
+	return count > 0, nil
+}
+
+// Film pointed to by the foreign key.
+func (o *FilmScoreAggregate) Film(mods ...qm.QueryMod) filmQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FilmID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Films(queryMods...)
+}
+
+// LoadFilm allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (filmScoreAggregateL) LoadFilm(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFilmScoreAggregate interface{}, mods queries.Applicator) error {
+	var slice []*FilmScoreAggregate
+	var object *FilmScoreAggregate
+
+	if singular {
+		var ok bool
+		object, ok = maybeFilmScoreAggregate.(*FilmScoreAggregate)
+		if !ok {
+			object = new(FilmScoreAggregate)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFilmScoreAggregate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFilmScoreAggregate))
+			}
+		}
+	} else {
+		s, ok := maybeFilmScoreAggregate.(*[]*FilmScoreAggregate)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFilmScoreAggregate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFilmScoreAggregate))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &filmScoreAggregateR{}
+		}
+		args = append(args, object.FilmID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &filmScoreAggregateR{}
+			}
+
+			for _, a := range args {
+				if a == obj.FilmID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.FilmID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`films`),
+		qm.WhereIn(`films.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Film")
+	}
+
+	var resultSlice []*Film
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Film")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for films")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for films")
+	}
+
+	if len(filmScoreAggregateAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Film = foreign
+		if foreign.R == nil {
+			foreign.R = &filmR{}
+		}
+		foreign.R.FilmScoreAggregates = append(foreign.R.FilmScoreAggregates, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.FilmID == foreign.ID {
+				local.R.Film = foreign
+				if foreign.R == nil {
+					foreign.R = &filmR{}
+				}
+				foreign.R.FilmScoreAggregates = append(foreign.R.FilmScoreAggregates, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetFilm of the filmScoreAggregate to the related item.
+// Sets o.R.Film to related.
+// Adds o to related.R.FilmScoreAggregates.
+func (o *FilmScoreAggregate) SetFilm(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Film) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"film_score_aggregates\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+		strmangle.WhereClause("\"", "\"", 2, filmScoreAggregatePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.FilmID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.FilmID = related.ID
+	if o.R == nil {
+		o.R = &filmScoreAggregateR{
+			Film: related,
+		}
+	} else {
+		o.R.Film = related
+	}
+
+	if related.R == nil {
+		related.R = &filmR{
+			FilmScoreAggregates: FilmScoreAggregateSlice{o},
+		}
+	} else {
+		related.R.FilmScoreAggregates = append(related.R.FilmScoreAggregates, o)
+	}
+
+	return nil
+}
+
+// FilmScoreAggregates retrieves all the records using an executor.
+func FilmScoreAggregates(mods ...qm.QueryMod) filmScoreAggregateQuery {
+	mods = append(mods, qm.From("\"film_score_aggregates\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"film_score_aggregates\".*"})
+	}
+
+	return filmScoreAggregateQuery{q}
+}
+
+// FindFilmScoreAggregate retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindFilmScoreAggregate(ctx context.Context, exec boil.ContextExecutor, filmID int, selectCols ...string) (*FilmScoreAggregate, error) {
+	filmScoreAggregateObj := &FilmScoreAggregate{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"film_score_aggregates\" where \"film_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, filmID)
+
+	err := q.Bind(ctx, exec, filmScoreAggregateObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from film_score_aggregates")
+	}
+
+	if err = filmScoreAggregateObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return filmScoreAggregateObj, err
+	}
+
+	return filmScoreAggregateObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *FilmScoreAggregate) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no film_score_aggregates provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(filmScoreAggregateColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	filmScoreAggregateInsertCacheMut.RLock()
+	cache, cached := filmScoreAggregateInsertCache[key]
+	filmScoreAggregateInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			filmScoreAggregateAllColumns,
+			filmScoreAggregateColumnsWithDefault,
+			filmScoreAggregateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(filmScoreAggregateType, filmScoreAggregateMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(filmScoreAggregateType, filmScoreAggregateMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"film_score_aggregates\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"film_score_aggregates\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into film_score_aggregates")
+	}
+
+	if !cached {
+		filmScoreAggregateInsertCacheMut.Lock()
+		filmScoreAggregateInsertCache[key] = cache
+		filmScoreAggregateInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the FilmScoreAggregate.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *FilmScoreAggregate) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	filmScoreAggregateUpdateCacheMut.RLock()
+	cache, cached := filmScoreAggregateUpdateCache[key]
+	filmScoreAggregateUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			filmScoreAggregateAllColumns,
+			filmScoreAggregatePrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update film_score_aggregates, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"film_score_aggregates\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, filmScoreAggregatePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(filmScoreAggregateType, filmScoreAggregateMapping, append(wl, filmScoreAggregatePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update film_score_aggregates row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for film_score_aggregates")
+	}
+
+	if !cached {
+		filmScoreAggregateUpdateCacheMut.Lock()
+		filmScoreAggregateUpdateCache[key] = cache
+		filmScoreAggregateUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q filmScoreAggregateQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for film_score_aggregates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for film_score_aggregates")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o FilmScoreAggregateSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), filmScoreAggregatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"film_score_aggregates\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, filmScoreAggregatePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in filmScoreAggregate slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all filmScoreAggregate")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *FilmScoreAggregate) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no film_score_aggregates provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(filmScoreAggregateColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	filmScoreAggregateUpsertCacheMut.RLock()
+	cache, cached := filmScoreAggregateUpsertCache[key]
+	filmScoreAggregateUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			filmScoreAggregateAllColumns,
+			filmScoreAggregateColumnsWithDefault,
+			filmScoreAggregateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			filmScoreAggregateAllColumns,
+			filmScoreAggregatePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert film_score_aggregates, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(filmScoreAggregatePrimaryKeyColumns))
+			copy(conflict, filmScoreAggregatePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"film_score_aggregates\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(filmScoreAggregateType, filmScoreAggregateMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(filmScoreAggregateType, filmScoreAggregateMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert film_score_aggregates")
+	}
+
+	if !cached {
+		filmScoreAggregateUpsertCacheMut.Lock()
+		filmScoreAggregateUpsertCache[key] = cache
+		filmScoreAggregateUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single FilmScoreAggregate record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *FilmScoreAggregate) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no FilmScoreAggregate provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), filmScoreAggregatePrimaryKeyMapping)
+	sql := "DELETE FROM \"film_score_aggregates\" WHERE \"film_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from film_score_aggregates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for film_score_aggregates")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q filmScoreAggregateQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no filmScoreAggregateQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from film_score_aggregates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for film_score_aggregates")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o FilmScoreAggregateSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(filmScoreAggregateBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), filmScoreAggregatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"film_score_aggregates\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, filmScoreAggregatePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from filmScoreAggregate slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for film_score_aggregates")
+	}
+
+	if len(filmScoreAggregateAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *FilmScoreAggregate) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindFilmScoreAggregate(ctx, exec, o.FilmID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *FilmScoreAggregateSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := FilmScoreAggregateSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), filmScoreAggregatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"film_score_aggregates\".* FROM \"film_score_aggregates\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, filmScoreAggregatePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in FilmScoreAggregateSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// FilmScoreAggregateExists checks if the FilmScoreAggregate row exists.
+func FilmScoreAggregateExists(ctx context.Context, exec boil.ContextExecutor, filmID int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"film_score_aggregates\" where \"film_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, filmID)
+	}
+	row := exec.QueryRowContext(ctx, sql, filmID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if film_score_aggregates exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,837 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testFilmScoreAggregates(t *testing.T) {
+	t.Parallel()
+
+	query := FilmScoreAggregates()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testFilmScoreAggregatesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmScoreAggregate{}
+	if err = randomize.Struct(seed, o, filmScoreAggregateDBTypes, true, filmScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FilmScoreAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFilmScoreAggregatesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmScoreAggregate{}
+	if err = randomize.Struct(seed, o, filmScoreAggregateDBTypes, true, filmScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := FilmScoreAggregates().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FilmScoreAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFilmScoreAggregatesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmScoreAggregate{}
+	if err = randomize.Struct(seed, o, filmScoreAggregateDBTypes, true, filmScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FilmScoreAggregateSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FilmScoreAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFilmScoreAggregatesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmScoreAggregate{}
+	if err = randomize.Struct(seed, o, filmScoreAggregateDBTypes, true, filmScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := FilmScoreAggregateExists(ctx, tx, o.FilmID)
+	if err != nil {
+		t.Errorf("Unable to check if FilmScoreAggregate exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected FilmScoreAggregateExists to return true, but got false.")
+	}
+}
+
+func testFilmScoreAggregatesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmScoreAggregate{}
+	if err = randomize.Struct(seed, o, filmScoreAggregateDBTypes, true, filmScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	filmScoreAggregateFound, err := FindFilmScoreAggregate(ctx, tx, o.FilmID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if filmScoreAggregateFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testFilmScoreAggregatesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmScoreAggregate{}
+	if err = randomize.Struct(seed, o, filmScoreAggregateDBTypes, true, filmScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = FilmScoreAggregates().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFilmScoreAggregatesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmScoreAggregate{}
+	if err = randomize.Struct(seed, o, filmScoreAggregateDBTypes, true, filmScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := FilmScoreAggregates().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testFilmScoreAggregatesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	filmScoreAggregateOne := &FilmScoreAggregate{}
+	filmScoreAggregateTwo := &FilmScoreAggregate{}
+	if err = randomize.Struct(seed, filmScoreAggregateOne, filmScoreAggregateDBTypes, false, filmScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmScoreAggregate struct: %s", err)
+	}
+	if err = randomize.Struct(seed, filmScoreAggregateTwo, filmScoreAggregateDBTypes, false, filmScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = filmScoreAggregateOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = filmScoreAggregateTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FilmScoreAggregates().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testFilmScoreAggregatesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	filmScoreAggregateOne := &FilmScoreAggregate{}
+	filmScoreAggregateTwo := &FilmScoreAggregate{}
+	if err = randomize.Struct(seed, filmScoreAggregateOne, filmScoreAggregateDBTypes, false, filmScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmScoreAggregate struct: %s", err)
+	}
+	if err = randomize.Struct(seed, filmScoreAggregateTwo, filmScoreAggregateDBTypes, false, filmScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = filmScoreAggregateOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = filmScoreAggregateTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FilmScoreAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func filmScoreAggregateBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *FilmScoreAggregate) error {
+	*o = FilmScoreAggregate{}
+	return nil
+}
+
+func filmScoreAggregateAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *FilmScoreAggregate) error {
+	*o = FilmScoreAggregate{}
+	return nil
+}
+
+func filmScoreAggregateAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *FilmScoreAggregate) error {
+	*o = FilmScoreAggregate{}
+	return nil
+}
+
+func filmScoreAggregateBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FilmScoreAggregate) error {
+	*o = FilmScoreAggregate{}
+	return nil
+}
+
+func filmScoreAggregateAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FilmScoreAggregate) error {
+	*o = FilmScoreAggregate{}
+	return nil
+}
+
+func filmScoreAggregateBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FilmScoreAggregate) error {
+	*o = FilmScoreAggregate{}
+	return nil
+}
+
+func filmScoreAggregateAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FilmScoreAggregate) error {
+	*o = FilmScoreAggregate{}
+	return nil
+}
+
+func filmScoreAggregateBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FilmScoreAggregate) error {
+	*o = FilmScoreAggregate{}
+	return nil
+}
+
+func filmScoreAggregateAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FilmScoreAggregate) error {
+	*o = FilmScoreAggregate{}
+	return nil
+}
+
+func testFilmScoreAggregatesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &FilmScoreAggregate{}
+	o := &FilmScoreAggregate{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, filmScoreAggregateDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize FilmScoreAggregate object: %s", err)
+	}
+
+	AddFilmScoreAggregateHook(boil.BeforeInsertHook, filmScoreAggregateBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	filmScoreAggregateBeforeInsertHooks = []FilmScoreAggregateHook{}
+
+	AddFilmScoreAggregateHook(boil.AfterInsertHook, filmScoreAggregateAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	filmScoreAggregateAfterInsertHooks = []FilmScoreAggregateHook{}
+
+	AddFilmScoreAggregateHook(boil.AfterSelectHook, filmScoreAggregateAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	filmScoreAggregateAfterSelectHooks = []FilmScoreAggregateHook{}
+
+	AddFilmScoreAggregateHook(boil.BeforeUpdateHook, filmScoreAggregateBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	filmScoreAggregateBeforeUpdateHooks = []FilmScoreAggregateHook{}
+
+	AddFilmScoreAggregateHook(boil.AfterUpdateHook, filmScoreAggregateAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	filmScoreAggregateAfterUpdateHooks = []FilmScoreAggregateHook{}
+
+	AddFilmScoreAggregateHook(boil.BeforeDeleteHook, filmScoreAggregateBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	filmScoreAggregateBeforeDeleteHooks = []FilmScoreAggregateHook{}
+
+	AddFilmScoreAggregateHook(boil.AfterDeleteHook, filmScoreAggregateAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	filmScoreAggregateAfterDeleteHooks = []FilmScoreAggregateHook{}
+
+	AddFilmScoreAggregateHook(boil.BeforeUpsertHook, filmScoreAggregateBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	filmScoreAggregateBeforeUpsertHooks = []FilmScoreAggregateHook{}
+
+	AddFilmScoreAggregateHook(boil.AfterUpsertHook, filmScoreAggregateAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	filmScoreAggregateAfterUpsertHooks = []FilmScoreAggregateHook{}
+}
+
+func testFilmScoreAggregatesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmScoreAggregate{}
+	if err = randomize.Struct(seed, o, filmScoreAggregateDBTypes, true, filmScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FilmScoreAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFilmScoreAggregatesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmScoreAggregate{}
+	if err = randomize.Struct(seed, o, filmScoreAggregateDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize FilmScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(filmScoreAggregateColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FilmScoreAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFilmScoreAggregateToOneFilmUsingFilm(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local FilmScoreAggregate
+	var foreign Film
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, filmScoreAggregateDBTypes, false, filmScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmScoreAggregate struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, filmDBTypes, false, filmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Film struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.FilmID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Film().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := FilmScoreAggregateSlice{&local}
+	if err = local.L.LoadFilm(ctx, tx, false, (*[]*FilmScoreAggregate)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Film == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Film = nil
+	if err = local.L.LoadFilm(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Film == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testFilmScoreAggregateToOneSetOpFilmUsingFilm(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a FilmScoreAggregate
+	var b, c Film
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmScoreAggregateDBTypes, false, strmangle.SetComplement(filmScoreAggregatePrimaryKeyColumns, filmScoreAggregateColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Film{&b, &c} {
+		err = a.SetFilm(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Film != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.FilmScoreAggregates[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.FilmID != x.ID {
+			t.Error("foreign key was wrong value", a.FilmID)
+		}
+
+		if exists, err := FilmScoreAggregateExists(ctx, tx, a.FilmID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testFilmScoreAggregatesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmScoreAggregate{}
+	if err = randomize.Struct(seed, o, filmScoreAggregateDBTypes, true, filmScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFilmScoreAggregatesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmScoreAggregate{}
+	if err = randomize.Struct(seed, o, filmScoreAggregateDBTypes, true, filmScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FilmScoreAggregateSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFilmScoreAggregatesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmScoreAggregate{}
+	if err = randomize.Struct(seed, o, filmScoreAggregateDBTypes, true, filmScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FilmScoreAggregates().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	filmScoreAggregateDBTypes = map[string]string{`FilmID`: `integer`, `ScoresCount`: `integer`, `ScoresSum`: `integer`, `ScoreMean`: `double precision`, `ScoreHistogram`: `jsonb`}
+	_                         = bytes.MinRead
+)
+
+func testFilmScoreAggregatesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(filmScoreAggregatePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(filmScoreAggregateAllColumns) == len(filmScoreAggregatePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmScoreAggregate{}
+	if err = randomize.Struct(seed, o, filmScoreAggregateDBTypes, true, filmScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FilmScoreAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, filmScoreAggregateDBTypes, true, filmScoreAggregatePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FilmScoreAggregate struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testFilmScoreAggregatesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(filmScoreAggregateAllColumns) == len(filmScoreAggregatePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FilmScoreAggregate{}
+	if err = randomize.Struct(seed, o, filmScoreAggregateDBTypes, true, filmScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FilmScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FilmScoreAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, filmScoreAggregateDBTypes, true, filmScoreAggregatePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FilmScoreAggregate struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(filmScoreAggregateAllColumns, filmScoreAggregatePrimaryKeyColumns) {
+		fields = filmScoreAggregateAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			filmScoreAggregateAllColumns,
+			filmScoreAggregatePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := FilmScoreAggregateSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testFilmScoreAggregatesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(filmScoreAggregateAllColumns) == len(filmScoreAggregatePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := FilmScoreAggregate{}
+	if err = randomize.Struct(seed, &o, filmScoreAggregateDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize FilmScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FilmScoreAggregate: %s", err)
+	}
+
+	count, err := FilmScoreAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, filmScoreAggregateDBTypes, false, filmScoreAggregatePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FilmScoreAggregate struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FilmScoreAggregate: %s", err)
+	}
+
+	count, err = FilmScoreAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -24,287 +24,285 @@ import (
 
 // Film is an object representing the database table.
 type Film struct {
-	ID            int         `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
-	Title         string      `db:"title" boil:"title" json:"title" toml:"title" yaml:"title"`
-	Descriptions  null.String `db:"descriptions" boil:"descriptions" json:"descriptions,omitempty" toml:"descriptions" yaml:"descriptions,omitempty"`
-	DateReleased  time.Time   `db:"date_released" boil:"date_released" json:"date_released" toml:"date_released" yaml:"date_released"`
-	Duration      null.Int    `db:"duration" boil:"duration" json:"duration,omitempty" toml:"duration" yaml:"duration,omitempty"`
-	SeriesID      null.Int    `db:"series_id" boil:"series_id" json:"series_id,omitempty" toml:"series_id" yaml:"series_id,omitempty"`
-	SeasonNumber  null.Int    `db:"season_number" boil:"season_number" json:"season_number,omitempty" toml:"season_number" yaml:"season_number,omitempty"`
-	EpisodeNumber null.Int    `db:"episode_number" boil:"episode_number" json:"episode_number,omitempty" toml:"episode_number" yaml:"episode_number,omitempty"`
-	Poster        null.String `db:"poster" boil:"poster" json:"poster,omitempty" toml:"poster" yaml:"poster,omitempty"`
-	ContributedBy int         `db:"contributed_by" boil:"contributed_by" json:"contributed_by" toml:"contributed_by" yaml:"contributed_by"`
-	ContributedAt time.Time   `db:"contributed_at" boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
-	Invalidation  null.String `db:"invalidation" boil:"invalidation" json:"invalidation,omitempty" toml:"invalidation" yaml:"invalidation,omitempty"`
+	ID             int         `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	Title          string      `db:"title" boil:"title" json:"title" toml:"title" yaml:"title"`
+	Descriptions   null.String `db:"descriptions" boil:"descriptions" json:"descriptions,omitempty" toml:"descriptions" yaml:"descriptions,omitempty"`
+	DateReleased   time.Time   `db:"date_released" boil:"date_released" json:"date_released" toml:"date_released" yaml:"date_released"`
+	Duration       null.Int    `db:"duration" boil:"duration" json:"duration,omitempty" toml:"duration" yaml:"duration,omitempty"`
+	SeriesID       null.Int    `db:"series_id" boil:"series_id" json:"series_id,omitempty" toml:"series_id" yaml:"series_id,omitempty"`
+	SeasonNumber   null.Int    `db:"season_number" boil:"season_number" json:"season_number,omitempty" toml:"season_number" yaml:"season_number,omitempty"`
+	EpisodeNumber  null.Int    `db:"episode_number" boil:"episode_number" json:"episode_number,omitempty" toml:"episode_number" yaml:"episode_number,omitempty"`
+	Poster         null.String `db:"poster" boil:"poster" json:"poster,omitempty" toml:"poster" yaml:"poster,omitempty"`
+	ContributedBy  int         `db:"contributed_by" boil:"contributed_by" json:"contributed_by" toml:"contributed_by" yaml:"contributed_by"`
+	ContributedAt  time.Time   `db:"contributed_at" boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
+	Invalidation   null.String `db:"invalidation" boil:"invalidation" json:"invalidation,omitempty" toml:"invalidation" yaml:"invalidation,omitempty"`
+	AbsoluteNumber null.Int    `db:"absolute_number" boil:"absolute_number" json:"absolute_number,omitempty" toml:"absolute_number" yaml:"absolute_number,omitempty"`
+	PartNumber     null.Int    `db:"part_number" boil:"part_number" json:"part_number,omitempty" toml:"part_number" yaml:"part_number,omitempty"`
+	DeletedAt      null.Time   `db:"deleted_at" boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
 
 	R *filmR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L filmL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var FilmColumns = struct {
-	ID            string
-	Title         string
-	Descriptions  string
-	DateReleased  string
-	Duration      string
-	SeriesID      string
-	SeasonNumber  string
-	EpisodeNumber string
-	Poster        string
-	ContributedBy string
-	ContributedAt string
-	Invalidation  string
+	ID             string
+	Title          string
+	Descriptions   string
+	DateReleased   string
+	Duration       string
+	SeriesID       string
+	SeasonNumber   string
+	EpisodeNumber  string
+	Poster         string
+	ContributedBy  string
+	ContributedAt  string
+	Invalidation   string
+	AbsoluteNumber string
+	PartNumber     string
+	DeletedAt      string
 }{
-	ID:            "id",
-	Title:         "title",
-	Descriptions:  "descriptions",
-	DateReleased:  "date_released",
-	Duration:      "duration",
-	SeriesID:      "series_id",
-	SeasonNumber:  "season_number",
-	EpisodeNumber: "episode_number",
-	Poster:        "poster",
-	ContributedBy: "contributed_by",
-	ContributedAt: "contributed_at",
-	Invalidation:  "invalidation",
+	ID:             "id",
+	Title:          "title",
+	Descriptions:   "descriptions",
+	DateReleased:   "date_released",
+	Duration:       "duration",
+	SeriesID:       "series_id",
+	SeasonNumber:   "season_number",
+	EpisodeNumber:  "episode_number",
+	Poster:         "poster",
+	ContributedBy:  "contributed_by",
+	ContributedAt:  "contributed_at",
+	Invalidation:   "invalidation",
+	AbsoluteNumber: "absolute_number",
+	PartNumber:     "part_number",
+	DeletedAt:      "deleted_at",
 }
 
 var FilmTableColumns = struct {
-	ID            string
-	Title         string
-	Descriptions  string
-	DateReleased  string
-	Duration      string
-	SeriesID      string
-	SeasonNumber  string
-	EpisodeNumber string
-	Poster        string
-	ContributedBy string
-	ContributedAt string
-	Invalidation  string
+	ID             string
+	Title          string
+	Descriptions   string
+	DateReleased   string
+	Duration       string
+	SeriesID       string
+	SeasonNumber   string
+	EpisodeNumber  string
+	Poster         string
+	ContributedBy  string
+	ContributedAt  string
+	Invalidation   string
+	AbsoluteNumber string
+	PartNumber     string
+	DeletedAt      string
 }{
-	ID:            "films.id",
-	Title:         "films.title",
-	Descriptions:  "films.descriptions",
-	DateReleased:  "films.date_released",
-	Duration:      "films.duration",
-	SeriesID:      "films.series_id",
-	SeasonNumber:  "films.season_number",
-	EpisodeNumber: "films.episode_number",
-	Poster:        "films.poster",
-	ContributedBy: "films.contributed_by",
-	ContributedAt: "films.contributed_at",
-	Invalidation:  "films.invalidation",
+	ID:             "films.id",
+	Title:          "films.title",
+	Descriptions:   "films.descriptions",
+	DateReleased:   "films.date_released",
+	Duration:       "films.duration",
+	SeriesID:       "films.series_id",
+	SeasonNumber:   "films.season_number",
+	EpisodeNumber:  "films.episode_number",
+	Poster:         "films.poster",
+	ContributedBy:  "films.contributed_by",
+	ContributedAt:  "films.contributed_at",
+	Invalidation:   "films.invalidation",
+	AbsoluteNumber: "films.absolute_number",
+	PartNumber:     "films.part_number",
+	DeletedAt:      "films.deleted_at",
 }
 
 // Generated where
 
-type whereHelperint struct{ field string }
+var FilmWhere = struct {
+	ID             whereHelperint
+	Title          whereHelperstring
+	Descriptions   whereHelpernull_String
+	DateReleased   whereHelpertime_Time
+	Duration       whereHelpernull_Int
+	SeriesID       whereHelpernull_Int
+	SeasonNumber   whereHelpernull_Int
+	EpisodeNumber  whereHelpernull_Int
+	Poster         whereHelpernull_String
+	ContributedBy  whereHelperint
+	ContributedAt  whereHelpertime_Time
+	Invalidation   whereHelpernull_String
+	AbsoluteNumber whereHelpernull_Int
+	PartNumber     whereHelpernull_Int
+	DeletedAt      whereHelpernull_Time
+}{
+	ID:             whereHelperint{field: "\"films\".\"id\""},
+	Title:          whereHelperstring{field: "\"films\".\"title\""},
+	Descriptions:   whereHelpernull_String{field: "\"films\".\"descriptions\""},
+	DateReleased:   whereHelpertime_Time{field: "\"films\".\"date_released\""},
+	Duration:       whereHelpernull_Int{field: "\"films\".\"duration\""},
+	SeriesID:       whereHelpernull_Int{field: "\"films\".\"series_id\""},
+	SeasonNumber:   whereHelpernull_Int{field: "\"films\".\"season_number\""},
+	EpisodeNumber:  whereHelpernull_Int{field: "\"films\".\"episode_number\""},
+	Poster:         whereHelpernull_String{field: "\"films\".\"poster\""},
+	ContributedBy:  whereHelperint{field: "\"films\".\"contributed_by\""},
+	ContributedAt:  whereHelpertime_Time{field: "\"films\".\"contributed_at\""},
+	Invalidation:   whereHelpernull_String{field: "\"films\".\"invalidation\""},
+	AbsoluteNumber: whereHelpernull_Int{field: "\"films\".\"absolute_number\""},
+	PartNumber:     whereHelpernull_Int{field: "\"films\".\"part_number\""},
+	DeletedAt:      whereHelpernull_Time{field: "\"films\".\"deleted_at\""},
+}
 
-func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+// FilmRels is where relationship names are stored.
+var FilmRels = struct {
+	ContributingUser    string
+	Series              string
+	CollectionItems     string
+	ContentRatings      string
+	ExternalIds         string
+	FilmScoreAggregates string
+	ListActivities      string
+	ListItems           string
+	MediaItems          string
+	PlaybackProgresses  string
+	Releases            string
+	Reviews             string
+	Scores              string
+	Translations        string
+	Watchfilms          string
+}{
+	ContributingUser:    "ContributingUser",
+	Series:              "Series",
+	CollectionItems:     "CollectionItems",
+	ContentRatings:      "ContentRatings",
+	ExternalIds:         "ExternalIds",
+	FilmScoreAggregates: "FilmScoreAggregates",
+	ListActivities:      "ListActivities",
+	ListItems:           "ListItems",
+	MediaItems:          "MediaItems",
+	PlaybackProgresses:  "PlaybackProgresses",
+	Releases:            "Releases",
+	Reviews:             "Reviews",
+	Scores:              "Scores",
+	Translations:        "Translations",
+	Watchfilms:          "Watchfilms",
 }
-func (w whereHelperint) NIN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+
+// filmR is where relationships are stored.
+type filmR struct {
+	ContributingUser    *User                   `db:"ContributingUser" boil:"ContributingUser" json:"ContributingUser" toml:"ContributingUser" yaml:"ContributingUser"`
+	Series              *Series                 `db:"Series" boil:"Series" json:"Series" toml:"Series" yaml:"Series"`
+	CollectionItems     CollectionItemSlice     `db:"CollectionItems" boil:"CollectionItems" json:"CollectionItems" toml:"CollectionItems" yaml:"CollectionItems"`
+	ContentRatings      ContentRatingSlice      `db:"ContentRatings" boil:"ContentRatings" json:"ContentRatings" toml:"ContentRatings" yaml:"ContentRatings"`
+	ExternalIds         ExternalIDSlice         `db:"ExternalIds" boil:"ExternalIds" json:"ExternalIds" toml:"ExternalIds" yaml:"ExternalIds"`
+	FilmScoreAggregates FilmScoreAggregateSlice `db:"FilmScoreAggregates" boil:"FilmScoreAggregates" json:"FilmScoreAggregates" toml:"FilmScoreAggregates" yaml:"FilmScoreAggregates"`
+	ListActivities      ListActivitySlice       `db:"ListActivities" boil:"ListActivities" json:"ListActivities" toml:"ListActivities" yaml:"ListActivities"`
+	ListItems           ListItemSlice           `db:"ListItems" boil:"ListItems" json:"ListItems" toml:"ListItems" yaml:"ListItems"`
+	MediaItems          MediaItemSlice          `db:"MediaItems" boil:"MediaItems" json:"MediaItems" toml:"MediaItems" yaml:"MediaItems"`
+	PlaybackProgresses  PlaybackProgressSlice   `db:"PlaybackProgresses" boil:"PlaybackProgresses" json:"PlaybackProgresses" toml:"PlaybackProgresses" yaml:"PlaybackProgresses"`
+	Releases            ReleaseSlice            `db:"Releases" boil:"Releases" json:"Releases" toml:"Releases" yaml:"Releases"`
+	Reviews             ReviewSlice             `db:"Reviews" boil:"Reviews" json:"Reviews" toml:"Reviews" yaml:"Reviews"`
+	Scores              ScoreSlice              `db:"Scores" boil:"Scores" json:"Scores" toml:"Scores" yaml:"Scores"`
+	Translations        TranslationSlice        `db:"Translations" boil:"Translations" json:"Translations" toml:"Translations" yaml:"Translations"`
+	Watchfilms          WatchfilmSlice          `db:"Watchfilms" boil:"Watchfilms" json:"Watchfilms" toml:"Watchfilms" yaml:"Watchfilms"`
 }
 
-type whereHelperstring struct{ field string }
+// NewStruct creates a new relationship struct
+func (*filmR) NewStruct() *filmR {
+	return &filmR{}
+}
 
-func (w whereHelperstring) EQ(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
+func (r *filmR) GetContributingUser() *User {
+	if r == nil {
+		return nil
 	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+	return r.ContributingUser
 }
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
+
+func (r *filmR) GetSeries() *Series {
+	if r == nil {
+		return nil
 	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+	return r.Series
 }
 
-type whereHelpernull_String struct{ field string }
-
-func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
+func (r *filmR) GetCollectionItems() CollectionItemSlice {
+	if r == nil {
+		return nil
 	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+	return r.CollectionItems
 }
-func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
+
+func (r *filmR) GetContentRatings() ContentRatingSlice {
+	if r == nil {
+		return nil
 	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+	return r.ContentRatings
 }
 
-func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
+func (r *filmR) GetExternalIds() ExternalIDSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ExternalIds
 }
 
-type whereHelpernull_Int struct{ field string }
-
-func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-func (w whereHelpernull_Int) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
+func (r *filmR) GetFilmScoreAggregates() FilmScoreAggregateSlice {
+	if r == nil {
+		return nil
 	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+	return r.FilmScoreAggregates
 }
-func (w whereHelpernull_Int) NIN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
+
+func (r *filmR) GetListActivities() ListActivitySlice {
+	if r == nil {
+		return nil
 	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+	return r.ListActivities
 }
 
-func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (r *filmR) GetListItems() ListItemSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ListItems
+}
 
-var FilmWhere = struct {
-	ID            whereHelperint
-	Title         whereHelperstring
-	Descriptions  whereHelpernull_String
-	DateReleased  whereHelpertime_Time
-	Duration      whereHelpernull_Int
-	SeriesID      whereHelpernull_Int
-	SeasonNumber  whereHelpernull_Int
-	EpisodeNumber whereHelpernull_Int
-	Poster        whereHelpernull_String
-	ContributedBy whereHelperint
-	ContributedAt whereHelpertime_Time
-	Invalidation  whereHelpernull_String
-}{
-	ID:            whereHelperint{field: "\"films\".\"id\""},
-	Title:         whereHelperstring{field: "\"films\".\"title\""},
-	Descriptions:  whereHelpernull_String{field: "\"films\".\"descriptions\""},
-	DateReleased:  whereHelpertime_Time{field: "\"films\".\"date_released\""},
-	Duration:      whereHelpernull_Int{field: "\"films\".\"duration\""},
-	SeriesID:      whereHelpernull_Int{field: "\"films\".\"series_id\""},
-	SeasonNumber:  whereHelpernull_Int{field: "\"films\".\"season_number\""},
-	EpisodeNumber: whereHelpernull_Int{field: "\"films\".\"episode_number\""},
-	Poster:        whereHelpernull_String{field: "\"films\".\"poster\""},
-	ContributedBy: whereHelperint{field: "\"films\".\"contributed_by\""},
-	ContributedAt: whereHelpertime_Time{field: "\"films\".\"contributed_at\""},
-	Invalidation:  whereHelpernull_String{field: "\"films\".\"invalidation\""},
+func (r *filmR) GetMediaItems() MediaItemSlice {
+	if r == nil {
+		return nil
+	}
+	return r.MediaItems
 }
 
-// FilmRels is where relationship names are stored.
-var FilmRels = struct {
-	ContributingUser string
-	Series           string
-	Watchfilms       string
-}{
-	ContributingUser: "ContributingUser",
-	Series:           "Series",
-	Watchfilms:       "Watchfilms",
+func (r *filmR) GetPlaybackProgresses() PlaybackProgressSlice {
+	if r == nil {
+		return nil
+	}
+	return r.PlaybackProgresses
 }
 
-// filmR is where relationships are stored.
-type filmR struct {
-	ContributingUser *User          `db:"ContributingUser" boil:"ContributingUser" json:"ContributingUser" toml:"ContributingUser" yaml:"ContributingUser"`
-	Series           *Series        `db:"Series" boil:"Series" json:"Series" toml:"Series" yaml:"Series"`
-	Watchfilms       WatchfilmSlice `db:"Watchfilms" boil:"Watchfilms" json:"Watchfilms" toml:"Watchfilms" yaml:"Watchfilms"`
+func (r *filmR) GetReleases() ReleaseSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Releases
 }
 
-// NewStruct creates a new relationship struct
-func (*filmR) NewStruct() *filmR {
-	return &filmR{}
+func (r *filmR) GetReviews() ReviewSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Reviews
 }
 
-func (r *filmR) GetContributingUser() *User {
+func (r *filmR) GetScores() ScoreSlice {
 	if r == nil {
 		return nil
 	}
-	return r.ContributingUser
+	return r.Scores
 }
 
-func (r *filmR) GetSeries() *Series {
+func (r *filmR) GetTranslations() TranslationSlice {
 	if r == nil {
 		return nil
 	}
-	return r.Series
+	return r.Translations
 }
 
 func (r *filmR) GetWatchfilms() WatchfilmSlice {
@@ -318,9 +316,9 @@ func (r *filmR) GetWatchfilms() WatchfilmSlice {
 type filmL struct{}
 
 var (
-	filmAllColumns            = []string{"id", "title", "descriptions", "date_released", "duration", "series_id", "season_number", "episode_number", "poster", "contributed_by", "contributed_at", "invalidation"}
+	filmAllColumns            = []string{"id", "title", "descriptions", "date_released", "duration", "series_id", "season_number", "episode_number", "poster", "contributed_by", "contributed_at", "invalidation", "absolute_number", "part_number", "deleted_at"}
 	filmColumnsWithoutDefault = []string{"title", "date_released", "contributed_by"}
-	filmColumnsWithDefault    = []string{"id", "descriptions", "duration", "series_id", "season_number", "episode_number", "poster", "contributed_at", "invalidation"}
+	filmColumnsWithDefault    = []string{"id", "descriptions", "duration", "series_id", "season_number", "episode_number", "poster", "contributed_at", "invalidation", "absolute_number", "part_number", "deleted_at"}
 	filmPrimaryKeyColumns     = []string{"id"}
 	filmGeneratedColumns      = []string{}
 )
@@ -625,6 +623,174 @@ func (o *Film) Series(mods ...qm.QueryMod) seriesQuery {
 	return Serieses(queryMods...)
 }
 
+// CollectionItems retrieves all the collection_item's CollectionItems with an executor.
+func (o *Film) CollectionItems(mods ...qm.QueryMod) collectionItemQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"collection_items\".\"film_id\"=?", o.ID),
+	)
+
+	return CollectionItems(queryMods...)
+}
+
+// ContentRatings retrieves all the content_rating's ContentRatings with an executor.
+func (o *Film) ContentRatings(mods ...qm.QueryMod) contentRatingQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"content_ratings\".\"film_id\"=?", o.ID),
+	)
+
+	return ContentRatings(queryMods...)
+}
+
+// ExternalIds retrieves all the external_id's ExternalIds with an executor.
+func (o *Film) ExternalIds(mods ...qm.QueryMod) externalIDQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"external_ids\".\"film_id\"=?", o.ID),
+	)
+
+	return ExternalIds(queryMods...)
+}
+
+// FilmScoreAggregates retrieves all the film_score_aggregate's FilmScoreAggregates with an executor.
+func (o *Film) FilmScoreAggregates(mods ...qm.QueryMod) filmScoreAggregateQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"film_score_aggregates\".\"film_id\"=?", o.ID),
+	)
+
+	return FilmScoreAggregates(queryMods...)
+}
+
+// ListActivities retrieves all the list_activity's ListActivities with an executor.
+func (o *Film) ListActivities(mods ...qm.QueryMod) listActivityQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"list_activities\".\"film_id\"=?", o.ID),
+	)
+
+	return ListActivities(queryMods...)
+}
+
+// ListItems retrieves all the list_item's ListItems with an executor.
+func (o *Film) ListItems(mods ...qm.QueryMod) listItemQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"list_items\".\"film_id\"=?", o.ID),
+	)
+
+	return ListItems(queryMods...)
+}
+
+// MediaItems retrieves all the media_item's MediaItems with an executor.
+func (o *Film) MediaItems(mods ...qm.QueryMod) mediaItemQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"media_items\".\"film_id\"=?", o.ID),
+	)
+
+	return MediaItems(queryMods...)
+}
+
+// PlaybackProgresses retrieves all the playback_progress's PlaybackProgresses with an executor.
+func (o *Film) PlaybackProgresses(mods ...qm.QueryMod) playbackProgressQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"playback_progress\".\"film_id\"=?", o.ID),
+	)
+
+	return PlaybackProgresses(queryMods...)
+}
+
+// Releases retrieves all the release's Releases with an executor.
+func (o *Film) Releases(mods ...qm.QueryMod) releaseQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"releases\".\"film_id\"=?", o.ID),
+	)
+
+	return Releases(queryMods...)
+}
+
+// Reviews retrieves all the review's Reviews with an executor.
+func (o *Film) Reviews(mods ...qm.QueryMod) reviewQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"reviews\".\"film_id\"=?", o.ID),
+	)
+
+	return Reviews(queryMods...)
+}
+
+// Scores retrieves all the score's Scores with an executor.
+func (o *Film) Scores(mods ...qm.QueryMod) scoreQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"scores\".\"film_id\"=?", o.ID),
+	)
+
+	return Scores(queryMods...)
+}
+
+// Translations retrieves all the translation's Translations with an executor.
+func (o *Film) Translations(mods ...qm.QueryMod) translationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"translations\".\"film_id\"=?", o.ID),
+	)
+
+	return Translations(queryMods...)
+}
+
 // Watchfilms retrieves all the watchfilm's Watchfilms with an executor.
 func (o *Film) Watchfilms(mods ...qm.QueryMod) watchfilmQuery {
 	var queryMods []qm.QueryMod
@@ -883,9 +1049,9 @@ func (filmL) LoadSeries(ctx context.Context, e boil.ContextExecutor, singular bo
 	return nil
 }
 
-// LoadWatchfilms allows an eager lookup of values, cached into the
+// LoadCollectionItems allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (filmL) LoadWatchfilms(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFilm interface{}, mods queries.Applicator) error {
+func (filmL) LoadCollectionItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFilm interface{}, mods queries.Applicator) error {
 	var slice []*Film
 	var object *Film
 
@@ -925,7 +1091,7 @@ func (filmL) LoadWatchfilms(ctx context.Context, e boil.ContextExecutor, singula
 			}
 
 			for _, a := range args {
-				if a == obj.ID {
+				if queries.Equal(a, obj.ID) {
 					continue Outer
 				}
 			}
@@ -939,8 +1105,8 @@ func (filmL) LoadWatchfilms(ctx context.Context, e boil.ContextExecutor, singula
 	}
 
 	query := NewQuery(
-		qm.From(`watchfilms`),
-		qm.WhereIn(`watchfilms.film_id in ?`, args...),
+		qm.From(`collection_items`),
+		qm.WhereIn(`collection_items.film_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -948,22 +1114,22 @@ func (filmL) LoadWatchfilms(ctx context.Context, e boil.ContextExecutor, singula
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load watchfilms")
+		return errors.Wrap(err, "failed to eager load collection_items")
 	}
 
-	var resultSlice []*Watchfilm
+	var resultSlice []*CollectionItem
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice watchfilms")
+		return errors.Wrap(err, "failed to bind eager loaded slice collection_items")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on watchfilms")
+		return errors.Wrap(err, "failed to close results in eager load on collection_items")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for watchfilms")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for collection_items")
 	}
 
-	if len(watchfilmAfterSelectHooks) != 0 {
+	if len(collectionItemAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -971,10 +1137,10 @@ func (filmL) LoadWatchfilms(ctx context.Context, e boil.ContextExecutor, singula
 		}
 	}
 	if singular {
-		object.R.Watchfilms = resultSlice
+		object.R.CollectionItems = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &watchfilmR{}
+				foreign.R = &collectionItemR{}
 			}
 			foreign.R.Film = object
 		}
@@ -983,10 +1149,10 @@ func (filmL) LoadWatchfilms(ctx context.Context, e boil.ContextExecutor, singula
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.FilmID {
-				local.R.Watchfilms = append(local.R.Watchfilms, foreign)
+			if queries.Equal(local.ID, foreign.FilmID) {
+				local.R.CollectionItems = append(local.R.CollectionItems, foreign)
 				if foreign.R == nil {
-					foreign.R = &watchfilmR{}
+					foreign.R = &collectionItemR{}
 				}
 				foreign.R.Film = local
 				break
@@ -997,130 +1163,2726 @@ func (filmL) LoadWatchfilms(ctx context.Context, e boil.ContextExecutor, singula
 	return nil
 }
 
-// SetContributingUser of the film to the related item.
-// Sets o.R.ContributingUser to related.
-// Adds o to related.R.ContributedFilms.
-func (o *Film) SetContributingUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+// LoadContentRatings allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (filmL) LoadContentRatings(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFilm interface{}, mods queries.Applicator) error {
+	var slice []*Film
+	var object *Film
+
+	if singular {
+		var ok bool
+		object, ok = maybeFilm.(*Film)
+		if !ok {
+			object = new(Film)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFilm))
+			}
+		}
+	} else {
+		s, ok := maybeFilm.(*[]*Film)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFilm))
+			}
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"films\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
-		strmangle.WhereClause("\"", "\"", 2, filmPrimaryKeyColumns),
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &filmR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &filmR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`content_ratings`),
+		qm.WhereIn(`content_ratings.film_id in ?`, args...),
 	)
-	values := []interface{}{related.ID, o.ID}
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load content_ratings")
+	}
+
+	var resultSlice []*ContentRating
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice content_ratings")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on content_ratings")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for content_ratings")
+	}
+
+	if len(contentRatingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ContentRatings = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &contentRatingR{}
+			}
+			foreign.R.Film = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FilmID {
+				local.R.ContentRatings = append(local.R.ContentRatings, foreign)
+				if foreign.R == nil {
+					foreign.R = &contentRatingR{}
+				}
+				foreign.R.Film = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExternalIds allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (filmL) LoadExternalIds(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFilm interface{}, mods queries.Applicator) error {
+	var slice []*Film
+	var object *Film
+
+	if singular {
+		var ok bool
+		object, ok = maybeFilm.(*Film)
+		if !ok {
+			object = new(Film)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFilm))
+			}
+		}
+	} else {
+		s, ok := maybeFilm.(*[]*Film)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFilm))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &filmR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &filmR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`external_ids`),
+		qm.WhereIn(`external_ids.film_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load external_ids")
+	}
+
+	var resultSlice []*ExternalID
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice external_ids")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on external_ids")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for external_ids")
+	}
+
+	if len(externalIDAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExternalIds = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &externalIDR{}
+			}
+			foreign.R.Film = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.FilmID) {
+				local.R.ExternalIds = append(local.R.ExternalIds, foreign)
+				if foreign.R == nil {
+					foreign.R = &externalIDR{}
+				}
+				foreign.R.Film = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFilmScoreAggregates allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (filmL) LoadFilmScoreAggregates(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFilm interface{}, mods queries.Applicator) error {
+	var slice []*Film
+	var object *Film
+
+	if singular {
+		var ok bool
+		object, ok = maybeFilm.(*Film)
+		if !ok {
+			object = new(Film)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFilm))
+			}
+		}
+	} else {
+		s, ok := maybeFilm.(*[]*Film)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFilm))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &filmR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &filmR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`film_score_aggregates`),
+		qm.WhereIn(`film_score_aggregates.film_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load film_score_aggregates")
+	}
+
+	var resultSlice []*FilmScoreAggregate
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice film_score_aggregates")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on film_score_aggregates")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for film_score_aggregates")
+	}
+
+	if len(filmScoreAggregateAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.FilmScoreAggregates = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &filmScoreAggregateR{}
+			}
+			foreign.R.Film = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FilmID {
+				local.R.FilmScoreAggregates = append(local.R.FilmScoreAggregates, foreign)
+				if foreign.R == nil {
+					foreign.R = &filmScoreAggregateR{}
+				}
+				foreign.R.Film = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadListActivities allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (filmL) LoadListActivities(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFilm interface{}, mods queries.Applicator) error {
+	var slice []*Film
+	var object *Film
+
+	if singular {
+		var ok bool
+		object, ok = maybeFilm.(*Film)
+		if !ok {
+			object = new(Film)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFilm))
+			}
+		}
+	} else {
+		s, ok := maybeFilm.(*[]*Film)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFilm))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &filmR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &filmR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`list_activities`),
+		qm.WhereIn(`list_activities.film_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load list_activities")
+	}
+
+	var resultSlice []*ListActivity
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice list_activities")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on list_activities")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for list_activities")
+	}
+
+	if len(listActivityAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ListActivities = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &listActivityR{}
+			}
+			foreign.R.Film = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.FilmID) {
+				local.R.ListActivities = append(local.R.ListActivities, foreign)
+				if foreign.R == nil {
+					foreign.R = &listActivityR{}
+				}
+				foreign.R.Film = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadListItems allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (filmL) LoadListItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFilm interface{}, mods queries.Applicator) error {
+	var slice []*Film
+	var object *Film
+
+	if singular {
+		var ok bool
+		object, ok = maybeFilm.(*Film)
+		if !ok {
+			object = new(Film)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFilm))
+			}
+		}
+	} else {
+		s, ok := maybeFilm.(*[]*Film)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFilm))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &filmR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &filmR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`list_items`),
+		qm.WhereIn(`list_items.film_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load list_items")
+	}
+
+	var resultSlice []*ListItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice list_items")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on list_items")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for list_items")
+	}
+
+	if len(listItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ListItems = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &listItemR{}
+			}
+			foreign.R.Film = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.FilmID) {
+				local.R.ListItems = append(local.R.ListItems, foreign)
+				if foreign.R == nil {
+					foreign.R = &listItemR{}
+				}
+				foreign.R.Film = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMediaItems allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (filmL) LoadMediaItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFilm interface{}, mods queries.Applicator) error {
+	var slice []*Film
+	var object *Film
+
+	if singular {
+		var ok bool
+		object, ok = maybeFilm.(*Film)
+		if !ok {
+			object = new(Film)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFilm))
+			}
+		}
+	} else {
+		s, ok := maybeFilm.(*[]*Film)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFilm))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &filmR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &filmR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`media_items`),
+		qm.WhereIn(`media_items.film_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load media_items")
+	}
+
+	var resultSlice []*MediaItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice media_items")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on media_items")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for media_items")
+	}
+
+	if len(mediaItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.MediaItems = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &mediaItemR{}
+			}
+			foreign.R.Film = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.FilmID) {
+				local.R.MediaItems = append(local.R.MediaItems, foreign)
+				if foreign.R == nil {
+					foreign.R = &mediaItemR{}
+				}
+				foreign.R.Film = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPlaybackProgresses allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (filmL) LoadPlaybackProgresses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFilm interface{}, mods queries.Applicator) error {
+	var slice []*Film
+	var object *Film
+
+	if singular {
+		var ok bool
+		object, ok = maybeFilm.(*Film)
+		if !ok {
+			object = new(Film)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFilm))
+			}
+		}
+	} else {
+		s, ok := maybeFilm.(*[]*Film)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFilm))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &filmR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &filmR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`playback_progress`),
+		qm.WhereIn(`playback_progress.film_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load playback_progress")
+	}
+
+	var resultSlice []*PlaybackProgress
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice playback_progress")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on playback_progress")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for playback_progress")
+	}
+
+	if len(playbackProgressAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PlaybackProgresses = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &playbackProgressR{}
+			}
+			foreign.R.Film = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FilmID {
+				local.R.PlaybackProgresses = append(local.R.PlaybackProgresses, foreign)
+				if foreign.R == nil {
+					foreign.R = &playbackProgressR{}
+				}
+				foreign.R.Film = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReleases allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (filmL) LoadReleases(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFilm interface{}, mods queries.Applicator) error {
+	var slice []*Film
+	var object *Film
+
+	if singular {
+		var ok bool
+		object, ok = maybeFilm.(*Film)
+		if !ok {
+			object = new(Film)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFilm))
+			}
+		}
+	} else {
+		s, ok := maybeFilm.(*[]*Film)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFilm))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &filmR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &filmR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`releases`),
+		qm.WhereIn(`releases.film_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load releases")
+	}
+
+	var resultSlice []*Release
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice releases")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on releases")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for releases")
+	}
+
+	if len(releaseAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Releases = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &releaseR{}
+			}
+			foreign.R.Film = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FilmID {
+				local.R.Releases = append(local.R.Releases, foreign)
+				if foreign.R == nil {
+					foreign.R = &releaseR{}
+				}
+				foreign.R.Film = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReviews allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (filmL) LoadReviews(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFilm interface{}, mods queries.Applicator) error {
+	var slice []*Film
+	var object *Film
+
+	if singular {
+		var ok bool
+		object, ok = maybeFilm.(*Film)
+		if !ok {
+			object = new(Film)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFilm))
+			}
+		}
+	} else {
+		s, ok := maybeFilm.(*[]*Film)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFilm))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &filmR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &filmR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`reviews`),
+		qm.WhereIn(`reviews.film_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load reviews")
+	}
+
+	var resultSlice []*Review
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice reviews")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on reviews")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reviews")
+	}
+
+	if len(reviewAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Reviews = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reviewR{}
+			}
+			foreign.R.Film = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.FilmID) {
+				local.R.Reviews = append(local.R.Reviews, foreign)
+				if foreign.R == nil {
+					foreign.R = &reviewR{}
+				}
+				foreign.R.Film = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadScores allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (filmL) LoadScores(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFilm interface{}, mods queries.Applicator) error {
+	var slice []*Film
+	var object *Film
+
+	if singular {
+		var ok bool
+		object, ok = maybeFilm.(*Film)
+		if !ok {
+			object = new(Film)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFilm))
+			}
+		}
+	} else {
+		s, ok := maybeFilm.(*[]*Film)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFilm))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &filmR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &filmR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`scores`),
+		qm.WhereIn(`scores.film_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load scores")
+	}
+
+	var resultSlice []*Score
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice scores")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on scores")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for scores")
+	}
+
+	if len(scoreAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Scores = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &scoreR{}
+			}
+			foreign.R.Film = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.FilmID) {
+				local.R.Scores = append(local.R.Scores, foreign)
+				if foreign.R == nil {
+					foreign.R = &scoreR{}
+				}
+				foreign.R.Film = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTranslations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (filmL) LoadTranslations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFilm interface{}, mods queries.Applicator) error {
+	var slice []*Film
+	var object *Film
+
+	if singular {
+		var ok bool
+		object, ok = maybeFilm.(*Film)
+		if !ok {
+			object = new(Film)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFilm))
+			}
+		}
+	} else {
+		s, ok := maybeFilm.(*[]*Film)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFilm))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &filmR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &filmR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`translations`),
+		qm.WhereIn(`translations.film_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load translations")
+	}
+
+	var resultSlice []*Translation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice translations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on translations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for translations")
+	}
+
+	if len(translationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Translations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &translationR{}
+			}
+			foreign.R.Film = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.FilmID) {
+				local.R.Translations = append(local.R.Translations, foreign)
+				if foreign.R == nil {
+					foreign.R = &translationR{}
+				}
+				foreign.R.Film = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWatchfilms allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (filmL) LoadWatchfilms(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFilm interface{}, mods queries.Applicator) error {
+	var slice []*Film
+	var object *Film
+
+	if singular {
+		var ok bool
+		object, ok = maybeFilm.(*Film)
+		if !ok {
+			object = new(Film)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFilm))
+			}
+		}
+	} else {
+		s, ok := maybeFilm.(*[]*Film)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFilm))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &filmR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &filmR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`watchfilms`),
+		qm.WhereIn(`watchfilms.film_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load watchfilms")
+	}
+
+	var resultSlice []*Watchfilm
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice watchfilms")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on watchfilms")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for watchfilms")
+	}
+
+	if len(watchfilmAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Watchfilms = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &watchfilmR{}
+			}
+			foreign.R.Film = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FilmID {
+				local.R.Watchfilms = append(local.R.Watchfilms, foreign)
+				if foreign.R == nil {
+					foreign.R = &watchfilmR{}
+				}
+				foreign.R.Film = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetContributingUser of the film to the related item.
+// Sets o.R.ContributingUser to related.
+// Adds o to related.R.ContributedFilms.
+func (o *Film) SetContributingUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"films\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
+		strmangle.WhereClause("\"", "\"", 2, filmPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ContributedBy = related.ID
+	if o.R == nil {
+		o.R = &filmR{
+			ContributingUser: related,
+		}
+	} else {
+		o.R.ContributingUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ContributedFilms: FilmSlice{o},
+		}
+	} else {
+		related.R.ContributedFilms = append(related.R.ContributedFilms, o)
+	}
+
+	return nil
+}
+
+// SetSeries of the film to the related item.
+// Sets o.R.Series to related.
+// Adds o to related.R.SeriesFilms.
+func (o *Film) SetSeries(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Series) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"films\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+		strmangle.WhereClause("\"", "\"", 2, filmPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.SeriesID, related.ID)
+	if o.R == nil {
+		o.R = &filmR{
+			Series: related,
+		}
+	} else {
+		o.R.Series = related
+	}
+
+	if related.R == nil {
+		related.R = &seriesR{
+			SeriesFilms: FilmSlice{o},
+		}
+	} else {
+		related.R.SeriesFilms = append(related.R.SeriesFilms, o)
+	}
+
+	return nil
+}
+
+// RemoveSeries relationship.
+// Sets o.R.Series to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Film) RemoveSeries(ctx context.Context, exec boil.ContextExecutor, related *Series) error {
+	var err error
+
+	queries.SetScanner(&o.SeriesID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("series_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Series = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.SeriesFilms {
+		if queries.Equal(o.SeriesID, ri.SeriesID) {
+			continue
+		}
+
+		ln := len(related.R.SeriesFilms)
+		if ln > 1 && i < ln-1 {
+			related.R.SeriesFilms[i] = related.R.SeriesFilms[ln-1]
+		}
+		related.R.SeriesFilms = related.R.SeriesFilms[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddCollectionItems adds the given related objects to the existing relationships
+// of the film, optionally inserting them as new records.
+// Appends related to o.R.CollectionItems.
+// Sets related.R.Film appropriately.
+func (o *Film) AddCollectionItems(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CollectionItem) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.FilmID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"collection_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+				strmangle.WhereClause("\"", "\"", 2, collectionItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.FilmID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &filmR{
+			CollectionItems: related,
+		}
+	} else {
+		o.R.CollectionItems = append(o.R.CollectionItems, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &collectionItemR{
+				Film: o,
+			}
+		} else {
+			rel.R.Film = o
+		}
+	}
+	return nil
+}
+
+// SetCollectionItems removes all previously related items of the
+// film replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Film's CollectionItems accordingly.
+// Replaces o.R.CollectionItems with related.
+// Sets related.R.Film's CollectionItems accordingly.
+func (o *Film) SetCollectionItems(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CollectionItem) error {
+	query := "update \"collection_items\" set \"film_id\" = null where \"film_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CollectionItems {
+			queries.SetScanner(&rel.FilmID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Film = nil
+		}
+		o.R.CollectionItems = nil
+	}
+
+	return o.AddCollectionItems(ctx, exec, insert, related...)
+}
+
+// RemoveCollectionItems relationships from objects passed in.
+// Removes related items from R.CollectionItems (uses pointer comparison, removal does not keep order)
+// Sets related.R.Film.
+func (o *Film) RemoveCollectionItems(ctx context.Context, exec boil.ContextExecutor, related ...*CollectionItem) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.FilmID, nil)
+		if rel.R != nil {
+			rel.R.Film = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("film_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CollectionItems {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CollectionItems)
+			if ln > 1 && i < ln-1 {
+				o.R.CollectionItems[i] = o.R.CollectionItems[ln-1]
+			}
+			o.R.CollectionItems = o.R.CollectionItems[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddContentRatings adds the given related objects to the existing relationships
+// of the film, optionally inserting them as new records.
+// Appends related to o.R.ContentRatings.
+// Sets related.R.Film appropriately.
+func (o *Film) AddContentRatings(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ContentRating) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FilmID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"content_ratings\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+				strmangle.WhereClause("\"", "\"", 2, contentRatingPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FilmID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &filmR{
+			ContentRatings: related,
+		}
+	} else {
+		o.R.ContentRatings = append(o.R.ContentRatings, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &contentRatingR{
+				Film: o,
+			}
+		} else {
+			rel.R.Film = o
+		}
+	}
+	return nil
+}
+
+// AddExternalIds adds the given related objects to the existing relationships
+// of the film, optionally inserting them as new records.
+// Appends related to o.R.ExternalIds.
+// Sets related.R.Film appropriately.
+func (o *Film) AddExternalIds(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ExternalID) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.FilmID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"external_ids\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+				strmangle.WhereClause("\"", "\"", 2, externalIDPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.FilmID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &filmR{
+			ExternalIds: related,
+		}
+	} else {
+		o.R.ExternalIds = append(o.R.ExternalIds, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &externalIDR{
+				Film: o,
+			}
+		} else {
+			rel.R.Film = o
+		}
+	}
+	return nil
+}
+
+// SetExternalIds removes all previously related items of the
+// film replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Film's ExternalIds accordingly.
+// Replaces o.R.ExternalIds with related.
+// Sets related.R.Film's ExternalIds accordingly.
+func (o *Film) SetExternalIds(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ExternalID) error {
+	query := "update \"external_ids\" set \"film_id\" = null where \"film_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ExternalIds {
+			queries.SetScanner(&rel.FilmID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Film = nil
+		}
+		o.R.ExternalIds = nil
+	}
+
+	return o.AddExternalIds(ctx, exec, insert, related...)
+}
+
+// RemoveExternalIds relationships from objects passed in.
+// Removes related items from R.ExternalIds (uses pointer comparison, removal does not keep order)
+// Sets related.R.Film.
+func (o *Film) RemoveExternalIds(ctx context.Context, exec boil.ContextExecutor, related ...*ExternalID) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.FilmID, nil)
+		if rel.R != nil {
+			rel.R.Film = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("film_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ExternalIds {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ExternalIds)
+			if ln > 1 && i < ln-1 {
+				o.R.ExternalIds[i] = o.R.ExternalIds[ln-1]
+			}
+			o.R.ExternalIds = o.R.ExternalIds[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddFilmScoreAggregates adds the given related objects to the existing relationships
+// of the film, optionally inserting them as new records.
+// Appends related to o.R.FilmScoreAggregates.
+// Sets related.R.Film appropriately.
+func (o *Film) AddFilmScoreAggregates(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FilmScoreAggregate) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FilmID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"film_score_aggregates\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+				strmangle.WhereClause("\"", "\"", 2, filmScoreAggregatePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.FilmID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FilmID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &filmR{
+			FilmScoreAggregates: related,
+		}
+	} else {
+		o.R.FilmScoreAggregates = append(o.R.FilmScoreAggregates, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &filmScoreAggregateR{
+				Film: o,
+			}
+		} else {
+			rel.R.Film = o
+		}
+	}
+	return nil
+}
+
+// AddListActivities adds the given related objects to the existing relationships
+// of the film, optionally inserting them as new records.
+// Appends related to o.R.ListActivities.
+// Sets related.R.Film appropriately.
+func (o *Film) AddListActivities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListActivity) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.FilmID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"list_activities\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+				strmangle.WhereClause("\"", "\"", 2, listActivityPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.FilmID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &filmR{
+			ListActivities: related,
+		}
+	} else {
+		o.R.ListActivities = append(o.R.ListActivities, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &listActivityR{
+				Film: o,
+			}
+		} else {
+			rel.R.Film = o
+		}
+	}
+	return nil
+}
+
+// SetListActivities removes all previously related items of the
+// film replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Film's ListActivities accordingly.
+// Replaces o.R.ListActivities with related.
+// Sets related.R.Film's ListActivities accordingly.
+func (o *Film) SetListActivities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListActivity) error {
+	query := "update \"list_activities\" set \"film_id\" = null where \"film_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ListActivities {
+			queries.SetScanner(&rel.FilmID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Film = nil
+		}
+		o.R.ListActivities = nil
+	}
+
+	return o.AddListActivities(ctx, exec, insert, related...)
+}
+
+// RemoveListActivities relationships from objects passed in.
+// Removes related items from R.ListActivities (uses pointer comparison, removal does not keep order)
+// Sets related.R.Film.
+func (o *Film) RemoveListActivities(ctx context.Context, exec boil.ContextExecutor, related ...*ListActivity) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.FilmID, nil)
+		if rel.R != nil {
+			rel.R.Film = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("film_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ListActivities {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ListActivities)
+			if ln > 1 && i < ln-1 {
+				o.R.ListActivities[i] = o.R.ListActivities[ln-1]
+			}
+			o.R.ListActivities = o.R.ListActivities[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddListItems adds the given related objects to the existing relationships
+// of the film, optionally inserting them as new records.
+// Appends related to o.R.ListItems.
+// Sets related.R.Film appropriately.
+func (o *Film) AddListItems(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListItem) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.FilmID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"list_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+				strmangle.WhereClause("\"", "\"", 2, listItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.FilmID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &filmR{
+			ListItems: related,
+		}
+	} else {
+		o.R.ListItems = append(o.R.ListItems, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &listItemR{
+				Film: o,
+			}
+		} else {
+			rel.R.Film = o
+		}
+	}
+	return nil
+}
+
+// SetListItems removes all previously related items of the
+// film replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Film's ListItems accordingly.
+// Replaces o.R.ListItems with related.
+// Sets related.R.Film's ListItems accordingly.
+func (o *Film) SetListItems(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListItem) error {
+	query := "update \"list_items\" set \"film_id\" = null where \"film_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ListItems {
+			queries.SetScanner(&rel.FilmID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Film = nil
+		}
+		o.R.ListItems = nil
+	}
+
+	return o.AddListItems(ctx, exec, insert, related...)
+}
+
+// RemoveListItems relationships from objects passed in.
+// Removes related items from R.ListItems (uses pointer comparison, removal does not keep order)
+// Sets related.R.Film.
+func (o *Film) RemoveListItems(ctx context.Context, exec boil.ContextExecutor, related ...*ListItem) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.FilmID, nil)
+		if rel.R != nil {
+			rel.R.Film = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("film_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ListItems {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ListItems)
+			if ln > 1 && i < ln-1 {
+				o.R.ListItems[i] = o.R.ListItems[ln-1]
+			}
+			o.R.ListItems = o.R.ListItems[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddMediaItems adds the given related objects to the existing relationships
+// of the film, optionally inserting them as new records.
+// Appends related to o.R.MediaItems.
+// Sets related.R.Film appropriately.
+func (o *Film) AddMediaItems(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MediaItem) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.FilmID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"media_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+				strmangle.WhereClause("\"", "\"", 2, mediaItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.FilmID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &filmR{
+			MediaItems: related,
+		}
+	} else {
+		o.R.MediaItems = append(o.R.MediaItems, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &mediaItemR{
+				Film: o,
+			}
+		} else {
+			rel.R.Film = o
+		}
+	}
+	return nil
+}
+
+// SetMediaItems removes all previously related items of the
+// film replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Film's MediaItems accordingly.
+// Replaces o.R.MediaItems with related.
+// Sets related.R.Film's MediaItems accordingly.
+func (o *Film) SetMediaItems(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MediaItem) error {
+	query := "update \"media_items\" set \"film_id\" = null where \"film_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.MediaItems {
+			queries.SetScanner(&rel.FilmID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Film = nil
+		}
+		o.R.MediaItems = nil
+	}
+
+	return o.AddMediaItems(ctx, exec, insert, related...)
+}
+
+// RemoveMediaItems relationships from objects passed in.
+// Removes related items from R.MediaItems (uses pointer comparison, removal does not keep order)
+// Sets related.R.Film.
+func (o *Film) RemoveMediaItems(ctx context.Context, exec boil.ContextExecutor, related ...*MediaItem) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.FilmID, nil)
+		if rel.R != nil {
+			rel.R.Film = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("film_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.MediaItems {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.MediaItems)
+			if ln > 1 && i < ln-1 {
+				o.R.MediaItems[i] = o.R.MediaItems[ln-1]
+			}
+			o.R.MediaItems = o.R.MediaItems[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddPlaybackProgresses adds the given related objects to the existing relationships
+// of the film, optionally inserting them as new records.
+// Appends related to o.R.PlaybackProgresses.
+// Sets related.R.Film appropriately.
+func (o *Film) AddPlaybackProgresses(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PlaybackProgress) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FilmID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"playback_progress\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+				strmangle.WhereClause("\"", "\"", 2, playbackProgressPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.FilmID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FilmID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &filmR{
+			PlaybackProgresses: related,
+		}
+	} else {
+		o.R.PlaybackProgresses = append(o.R.PlaybackProgresses, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &playbackProgressR{
+				Film: o,
+			}
+		} else {
+			rel.R.Film = o
+		}
+	}
+	return nil
+}
+
+// AddReleases adds the given related objects to the existing relationships
+// of the film, optionally inserting them as new records.
+// Appends related to o.R.Releases.
+// Sets related.R.Film appropriately.
+func (o *Film) AddReleases(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Release) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FilmID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"releases\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+				strmangle.WhereClause("\"", "\"", 2, releasePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FilmID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &filmR{
+			Releases: related,
+		}
+	} else {
+		o.R.Releases = append(o.R.Releases, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &releaseR{
+				Film: o,
+			}
+		} else {
+			rel.R.Film = o
+		}
+	}
+	return nil
+}
+
+// AddReviews adds the given related objects to the existing relationships
+// of the film, optionally inserting them as new records.
+// Appends related to o.R.Reviews.
+// Sets related.R.Film appropriately.
+func (o *Film) AddReviews(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Review) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.FilmID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"reviews\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+				strmangle.WhereClause("\"", "\"", 2, reviewPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.FilmID, o.ID)
+		}
+	}
 
+	if o.R == nil {
+		o.R = &filmR{
+			Reviews: related,
+		}
+	} else {
+		o.R.Reviews = append(o.R.Reviews, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &reviewR{
+				Film: o,
+			}
+		} else {
+			rel.R.Film = o
+		}
+	}
+	return nil
+}
+
+// SetReviews removes all previously related items of the
+// film replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Film's Reviews accordingly.
+// Replaces o.R.Reviews with related.
+// Sets related.R.Film's Reviews accordingly.
+func (o *Film) SetReviews(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Review) error {
+	query := "update \"reviews\" set \"film_id\" = null where \"film_id\" = $1"
+	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, query)
 		fmt.Fprintln(writer, values)
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	o.ContributedBy = related.ID
-	if o.R == nil {
-		o.R = &filmR{
-			ContributingUser: related,
+	if o.R != nil {
+		for _, rel := range o.R.Reviews {
+			queries.SetScanner(&rel.FilmID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Film = nil
 		}
-	} else {
-		o.R.ContributingUser = related
+		o.R.Reviews = nil
 	}
 
-	if related.R == nil {
-		related.R = &userR{
-			ContributedFilms: FilmSlice{o},
+	return o.AddReviews(ctx, exec, insert, related...)
+}
+
+// RemoveReviews relationships from objects passed in.
+// Removes related items from R.Reviews (uses pointer comparison, removal does not keep order)
+// Sets related.R.Film.
+func (o *Film) RemoveReviews(ctx context.Context, exec boil.ContextExecutor, related ...*Review) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.FilmID, nil)
+		if rel.R != nil {
+			rel.R.Film = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("film_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Reviews {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Reviews)
+			if ln > 1 && i < ln-1 {
+				o.R.Reviews[i] = o.R.Reviews[ln-1]
+			}
+			o.R.Reviews = o.R.Reviews[:ln-1]
+			break
 		}
-	} else {
-		related.R.ContributedFilms = append(related.R.ContributedFilms, o)
 	}
 
 	return nil
 }
 
-// SetSeries of the film to the related item.
-// Sets o.R.Series to related.
-// Adds o to related.R.SeriesFilms.
-func (o *Film) SetSeries(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Series) error {
+// AddScores adds the given related objects to the existing relationships
+// of the film, optionally inserting them as new records.
+// Appends related to o.R.Scores.
+// Sets related.R.Film appropriately.
+func (o *Film) AddScores(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Score) error {
 	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.FilmID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"scores\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+				strmangle.WhereClause("\"", "\"", 2, scorePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.FilmID, o.ID)
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"films\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
-		strmangle.WhereClause("\"", "\"", 2, filmPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
+	if o.R == nil {
+		o.R = &filmR{
+			Scores: related,
+		}
+	} else {
+		o.R.Scores = append(o.R.Scores, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &scoreR{
+				Film: o,
+			}
+		} else {
+			rel.R.Film = o
+		}
+	}
+	return nil
+}
 
+// SetScores removes all previously related items of the
+// film replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Film's Scores accordingly.
+// Replaces o.R.Scores with related.
+// Sets related.R.Film's Scores accordingly.
+func (o *Film) SetScores(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Score) error {
+	query := "update \"scores\" set \"film_id\" = null where \"film_id\" = $1"
+	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, query)
 		fmt.Fprintln(writer, values)
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	queries.Assign(&o.SeriesID, related.ID)
-	if o.R == nil {
-		o.R = &filmR{
-			Series: related,
+	if o.R != nil {
+		for _, rel := range o.R.Scores {
+			queries.SetScanner(&rel.FilmID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Film = nil
 		}
-	} else {
-		o.R.Series = related
+		o.R.Scores = nil
 	}
 
-	if related.R == nil {
-		related.R = &seriesR{
-			SeriesFilms: FilmSlice{o},
+	return o.AddScores(ctx, exec, insert, related...)
+}
+
+// RemoveScores relationships from objects passed in.
+// Removes related items from R.Scores (uses pointer comparison, removal does not keep order)
+// Sets related.R.Film.
+func (o *Film) RemoveScores(ctx context.Context, exec boil.ContextExecutor, related ...*Score) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.FilmID, nil)
+		if rel.R != nil {
+			rel.R.Film = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("film_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Scores {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Scores)
+			if ln > 1 && i < ln-1 {
+				o.R.Scores[i] = o.R.Scores[ln-1]
+			}
+			o.R.Scores = o.R.Scores[:ln-1]
+			break
 		}
-	} else {
-		related.R.SeriesFilms = append(related.R.SeriesFilms, o)
 	}
 
 	return nil
 }
 
-// RemoveSeries relationship.
-// Sets o.R.Series to nil.
-// Removes o from all passed in related items' relationships struct.
-func (o *Film) RemoveSeries(ctx context.Context, exec boil.ContextExecutor, related *Series) error {
+// AddTranslations adds the given related objects to the existing relationships
+// of the film, optionally inserting them as new records.
+// Appends related to o.R.Translations.
+// Sets related.R.Film appropriately.
+func (o *Film) AddTranslations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Translation) error {
 	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.FilmID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"translations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+				strmangle.WhereClause("\"", "\"", 2, translationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	queries.SetScanner(&o.SeriesID, nil)
-	if _, err = o.Update(ctx, exec, boil.Whitelist("series_id")); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.FilmID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &filmR{
+			Translations: related,
+		}
+	} else {
+		o.R.Translations = append(o.R.Translations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &translationR{
+				Film: o,
+			}
+		} else {
+			rel.R.Film = o
+		}
+	}
+	return nil
+}
+
+// SetTranslations removes all previously related items of the
+// film replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Film's Translations accordingly.
+// Replaces o.R.Translations with related.
+// Sets related.R.Film's Translations accordingly.
+func (o *Film) SetTranslations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Translation) error {
+	query := "update \"translations\" set \"film_id\" = null where \"film_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
 	if o.R != nil {
-		o.R.Series = nil
+		for _, rel := range o.R.Translations {
+			queries.SetScanner(&rel.FilmID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Film = nil
+		}
+		o.R.Translations = nil
 	}
-	if related == nil || related.R == nil {
+
+	return o.AddTranslations(ctx, exec, insert, related...)
+}
+
+// RemoveTranslations relationships from objects passed in.
+// Removes related items from R.Translations (uses pointer comparison, removal does not keep order)
+// Sets related.R.Film.
+func (o *Film) RemoveTranslations(ctx context.Context, exec boil.ContextExecutor, related ...*Translation) error {
+	if len(related) == 0 {
 		return nil
 	}
 
-	for i, ri := range related.R.SeriesFilms {
-		if queries.Equal(o.SeriesID, ri.SeriesID) {
-			continue
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.FilmID, nil)
+		if rel.R != nil {
+			rel.R.Film = nil
 		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("film_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
 
-		ln := len(related.R.SeriesFilms)
-		if ln > 1 && i < ln-1 {
-			related.R.SeriesFilms[i] = related.R.SeriesFilms[ln-1]
+	for _, rel := range related {
+		for i, ri := range o.R.Translations {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Translations)
+			if ln > 1 && i < ln-1 {
+				o.R.Translations[i] = o.R.Translations[ln-1]
+			}
+			o.R.Translations = o.R.Translations[:ln-1]
+			break
 		}
-		related.R.SeriesFilms = related.R.SeriesFilms[:ln-1]
-		break
 	}
+
 	return nil
 }
 
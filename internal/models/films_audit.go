@@ -24,107 +24,142 @@ import (
 
 // FilmsAudit is an object representing the database table.
 type FilmsAudit struct {
-	ID            int         `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
-	Title         string      `db:"title" boil:"title" json:"title" toml:"title" yaml:"title"`
-	Descriptions  null.String `db:"descriptions" boil:"descriptions" json:"descriptions,omitempty" toml:"descriptions" yaml:"descriptions,omitempty"`
-	DateReleased  time.Time   `db:"date_released" boil:"date_released" json:"date_released" toml:"date_released" yaml:"date_released"`
-	Duration      null.Int    `db:"duration" boil:"duration" json:"duration,omitempty" toml:"duration" yaml:"duration,omitempty"`
-	SeriesID      null.Int    `db:"series_id" boil:"series_id" json:"series_id,omitempty" toml:"series_id" yaml:"series_id,omitempty"`
-	SeasonNumber  null.Int    `db:"season_number" boil:"season_number" json:"season_number,omitempty" toml:"season_number" yaml:"season_number,omitempty"`
-	EpisodeNumber null.Int    `db:"episode_number" boil:"episode_number" json:"episode_number,omitempty" toml:"episode_number" yaml:"episode_number,omitempty"`
-	Poster        null.String `db:"poster" boil:"poster" json:"poster,omitempty" toml:"poster" yaml:"poster,omitempty"`
-	ContributedBy int         `db:"contributed_by" boil:"contributed_by" json:"contributed_by" toml:"contributed_by" yaml:"contributed_by"`
-	ContributedAt time.Time   `db:"contributed_at" boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
-	Invalidation  null.String `db:"invalidation" boil:"invalidation" json:"invalidation,omitempty" toml:"invalidation" yaml:"invalidation,omitempty"`
+	ID             int         `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	Title          string      `db:"title" boil:"title" json:"title" toml:"title" yaml:"title"`
+	Descriptions   null.String `db:"descriptions" boil:"descriptions" json:"descriptions,omitempty" toml:"descriptions" yaml:"descriptions,omitempty"`
+	DateReleased   time.Time   `db:"date_released" boil:"date_released" json:"date_released" toml:"date_released" yaml:"date_released"`
+	Duration       null.Int    `db:"duration" boil:"duration" json:"duration,omitempty" toml:"duration" yaml:"duration,omitempty"`
+	SeriesID       null.Int    `db:"series_id" boil:"series_id" json:"series_id,omitempty" toml:"series_id" yaml:"series_id,omitempty"`
+	SeasonNumber   null.Int    `db:"season_number" boil:"season_number" json:"season_number,omitempty" toml:"season_number" yaml:"season_number,omitempty"`
+	EpisodeNumber  null.Int    `db:"episode_number" boil:"episode_number" json:"episode_number,omitempty" toml:"episode_number" yaml:"episode_number,omitempty"`
+	Poster         null.String `db:"poster" boil:"poster" json:"poster,omitempty" toml:"poster" yaml:"poster,omitempty"`
+	ContributedBy  int         `db:"contributed_by" boil:"contributed_by" json:"contributed_by" toml:"contributed_by" yaml:"contributed_by"`
+	ContributedAt  time.Time   `db:"contributed_at" boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
+	Invalidation   null.String `db:"invalidation" boil:"invalidation" json:"invalidation,omitempty" toml:"invalidation" yaml:"invalidation,omitempty"`
+	AbsoluteNumber null.Int    `db:"absolute_number" boil:"absolute_number" json:"absolute_number,omitempty" toml:"absolute_number" yaml:"absolute_number,omitempty"`
+	PartNumber     null.Int    `db:"part_number" boil:"part_number" json:"part_number,omitempty" toml:"part_number" yaml:"part_number,omitempty"`
+	DeletedAt      null.Time   `db:"deleted_at" boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	AuditAction    string      `db:"audit_action" boil:"audit_action" json:"audit_action" toml:"audit_action" yaml:"audit_action"`
+	AuditActor     null.Int    `db:"audit_actor" boil:"audit_actor" json:"audit_actor,omitempty" toml:"audit_actor" yaml:"audit_actor,omitempty"`
 
 	R *filmsAuditR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L filmsAuditL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var FilmsAuditColumns = struct {
-	ID            string
-	Title         string
-	Descriptions  string
-	DateReleased  string
-	Duration      string
-	SeriesID      string
-	SeasonNumber  string
-	EpisodeNumber string
-	Poster        string
-	ContributedBy string
-	ContributedAt string
-	Invalidation  string
+	ID             string
+	Title          string
+	Descriptions   string
+	DateReleased   string
+	Duration       string
+	SeriesID       string
+	SeasonNumber   string
+	EpisodeNumber  string
+	Poster         string
+	ContributedBy  string
+	ContributedAt  string
+	Invalidation   string
+	AbsoluteNumber string
+	PartNumber     string
+	DeletedAt      string
+	AuditAction    string
+	AuditActor     string
 }{
-	ID:            "id",
-	Title:         "title",
-	Descriptions:  "descriptions",
-	DateReleased:  "date_released",
-	Duration:      "duration",
-	SeriesID:      "series_id",
-	SeasonNumber:  "season_number",
-	EpisodeNumber: "episode_number",
-	Poster:        "poster",
-	ContributedBy: "contributed_by",
-	ContributedAt: "contributed_at",
-	Invalidation:  "invalidation",
+	ID:             "id",
+	Title:          "title",
+	Descriptions:   "descriptions",
+	DateReleased:   "date_released",
+	Duration:       "duration",
+	SeriesID:       "series_id",
+	SeasonNumber:   "season_number",
+	EpisodeNumber:  "episode_number",
+	Poster:         "poster",
+	ContributedBy:  "contributed_by",
+	ContributedAt:  "contributed_at",
+	Invalidation:   "invalidation",
+	AbsoluteNumber: "absolute_number",
+	PartNumber:     "part_number",
+	DeletedAt:      "deleted_at",
+	AuditAction:    "audit_action",
+	AuditActor:     "audit_actor",
 }
 
 var FilmsAuditTableColumns = struct {
-	ID            string
-	Title         string
-	Descriptions  string
-	DateReleased  string
-	Duration      string
-	SeriesID      string
-	SeasonNumber  string
-	EpisodeNumber string
-	Poster        string
-	ContributedBy string
-	ContributedAt string
-	Invalidation  string
+	ID             string
+	Title          string
+	Descriptions   string
+	DateReleased   string
+	Duration       string
+	SeriesID       string
+	SeasonNumber   string
+	EpisodeNumber  string
+	Poster         string
+	ContributedBy  string
+	ContributedAt  string
+	Invalidation   string
+	AbsoluteNumber string
+	PartNumber     string
+	DeletedAt      string
+	AuditAction    string
+	AuditActor     string
 }{
-	ID:            "films_audit.id",
-	Title:         "films_audit.title",
-	Descriptions:  "films_audit.descriptions",
-	DateReleased:  "films_audit.date_released",
-	Duration:      "films_audit.duration",
-	SeriesID:      "films_audit.series_id",
-	SeasonNumber:  "films_audit.season_number",
-	EpisodeNumber: "films_audit.episode_number",
-	Poster:        "films_audit.poster",
-	ContributedBy: "films_audit.contributed_by",
-	ContributedAt: "films_audit.contributed_at",
-	Invalidation:  "films_audit.invalidation",
+	ID:             "films_audit.id",
+	Title:          "films_audit.title",
+	Descriptions:   "films_audit.descriptions",
+	DateReleased:   "films_audit.date_released",
+	Duration:       "films_audit.duration",
+	SeriesID:       "films_audit.series_id",
+	SeasonNumber:   "films_audit.season_number",
+	EpisodeNumber:  "films_audit.episode_number",
+	Poster:         "films_audit.poster",
+	ContributedBy:  "films_audit.contributed_by",
+	ContributedAt:  "films_audit.contributed_at",
+	Invalidation:   "films_audit.invalidation",
+	AbsoluteNumber: "films_audit.absolute_number",
+	PartNumber:     "films_audit.part_number",
+	DeletedAt:      "films_audit.deleted_at",
+	AuditAction:    "films_audit.audit_action",
+	AuditActor:     "films_audit.audit_actor",
 }
 
 // Generated where
 
 var FilmsAuditWhere = struct {
-	ID            whereHelperint
-	Title         whereHelperstring
-	Descriptions  whereHelpernull_String
-	DateReleased  whereHelpertime_Time
-	Duration      whereHelpernull_Int
-	SeriesID      whereHelpernull_Int
-	SeasonNumber  whereHelpernull_Int
-	EpisodeNumber whereHelpernull_Int
-	Poster        whereHelpernull_String
-	ContributedBy whereHelperint
-	ContributedAt whereHelpertime_Time
-	Invalidation  whereHelpernull_String
+	ID             whereHelperint
+	Title          whereHelperstring
+	Descriptions   whereHelpernull_String
+	DateReleased   whereHelpertime_Time
+	Duration       whereHelpernull_Int
+	SeriesID       whereHelpernull_Int
+	SeasonNumber   whereHelpernull_Int
+	EpisodeNumber  whereHelpernull_Int
+	Poster         whereHelpernull_String
+	ContributedBy  whereHelperint
+	ContributedAt  whereHelpertime_Time
+	Invalidation   whereHelpernull_String
+	AbsoluteNumber whereHelpernull_Int
+	PartNumber     whereHelpernull_Int
+	DeletedAt      whereHelpernull_Time
+	AuditAction    whereHelperstring
+	AuditActor     whereHelpernull_Int
 }{
-	ID:            whereHelperint{field: "\"films_audit\".\"id\""},
-	Title:         whereHelperstring{field: "\"films_audit\".\"title\""},
-	Descriptions:  whereHelpernull_String{field: "\"films_audit\".\"descriptions\""},
-	DateReleased:  whereHelpertime_Time{field: "\"films_audit\".\"date_released\""},
-	Duration:      whereHelpernull_Int{field: "\"films_audit\".\"duration\""},
-	SeriesID:      whereHelpernull_Int{field: "\"films_audit\".\"series_id\""},
-	SeasonNumber:  whereHelpernull_Int{field: "\"films_audit\".\"season_number\""},
-	EpisodeNumber: whereHelpernull_Int{field: "\"films_audit\".\"episode_number\""},
-	Poster:        whereHelpernull_String{field: "\"films_audit\".\"poster\""},
-	ContributedBy: whereHelperint{field: "\"films_audit\".\"contributed_by\""},
-	ContributedAt: whereHelpertime_Time{field: "\"films_audit\".\"contributed_at\""},
-	Invalidation:  whereHelpernull_String{field: "\"films_audit\".\"invalidation\""},
+	ID:             whereHelperint{field: "\"films_audit\".\"id\""},
+	Title:          whereHelperstring{field: "\"films_audit\".\"title\""},
+	Descriptions:   whereHelpernull_String{field: "\"films_audit\".\"descriptions\""},
+	DateReleased:   whereHelpertime_Time{field: "\"films_audit\".\"date_released\""},
+	Duration:       whereHelpernull_Int{field: "\"films_audit\".\"duration\""},
+	SeriesID:       whereHelpernull_Int{field: "\"films_audit\".\"series_id\""},
+	SeasonNumber:   whereHelpernull_Int{field: "\"films_audit\".\"season_number\""},
+	EpisodeNumber:  whereHelpernull_Int{field: "\"films_audit\".\"episode_number\""},
+	Poster:         whereHelpernull_String{field: "\"films_audit\".\"poster\""},
+	ContributedBy:  whereHelperint{field: "\"films_audit\".\"contributed_by\""},
+	ContributedAt:  whereHelpertime_Time{field: "\"films_audit\".\"contributed_at\""},
+	Invalidation:   whereHelpernull_String{field: "\"films_audit\".\"invalidation\""},
+	AbsoluteNumber: whereHelpernull_Int{field: "\"films_audit\".\"absolute_number\""},
+	PartNumber:     whereHelpernull_Int{field: "\"films_audit\".\"part_number\""},
+	DeletedAt:      whereHelpernull_Time{field: "\"films_audit\".\"deleted_at\""},
+	AuditAction:    whereHelperstring{field: "\"films_audit\".\"audit_action\""},
+	AuditActor:     whereHelpernull_Int{field: "\"films_audit\".\"audit_actor\""},
 }
 
 // FilmsAuditRels is where relationship names are stored.
@@ -144,9 +179,9 @@ func (*filmsAuditR) NewStruct() *filmsAuditR {
 type filmsAuditL struct{}
 
 var (
-	filmsAuditAllColumns            = []string{"id", "title", "descriptions", "date_released", "duration", "series_id", "season_number", "episode_number", "poster", "contributed_by", "contributed_at", "invalidation"}
+	filmsAuditAllColumns            = []string{"id", "title", "descriptions", "date_released", "duration", "series_id", "season_number", "episode_number", "poster", "contributed_by", "contributed_at", "invalidation", "absolute_number", "part_number", "deleted_at", "audit_action", "audit_actor"}
 	filmsAuditColumnsWithoutDefault = []string{"id", "title", "date_released", "contributed_by", "contributed_at"}
-	filmsAuditColumnsWithDefault    = []string{"descriptions", "duration", "series_id", "season_number", "episode_number", "poster", "invalidation"}
+	filmsAuditColumnsWithDefault    = []string{"descriptions", "duration", "series_id", "season_number", "episode_number", "poster", "invalidation", "absolute_number", "part_number", "deleted_at", "audit_action", "audit_actor"}
 	filmsAuditPrimaryKeyColumns     = []string{"id", "contributed_by", "contributed_at"}
 	filmsAuditGeneratedColumns      = []string{}
 )
@@ -568,7 +568,7 @@ func testFilmsAuditsSelect(t *testing.T) {
 }
 
 var (
-	filmsAuditDBTypes = map[string]string{`ID`: `integer`, `Title`: `character varying`, `Descriptions`: `character varying`, `DateReleased`: `date`, `Duration`: `integer`, `SeriesID`: `integer`, `SeasonNumber`: `integer`, `EpisodeNumber`: `integer`, `Poster`: `character varying`, `ContributedBy`: `integer`, `ContributedAt`: `timestamp with time zone`, `Invalidation`: `character varying`}
+	filmsAuditDBTypes = map[string]string{`ID`: `integer`, `Title`: `character varying`, `Descriptions`: `character varying`, `DateReleased`: `date`, `Duration`: `integer`, `SeriesID`: `integer`, `SeasonNumber`: `integer`, `EpisodeNumber`: `integer`, `Poster`: `character varying`, `ContributedBy`: `integer`, `ContributedAt`: `timestamp with time zone`, `Invalidation`: `character varying`, `AbsoluteNumber`: `integer`, `PartNumber`: `integer`, `DeletedAt`: `timestamp with time zone`, `AuditAction`: `character varying`, `AuditActor`: `integer`}
 	_                 = bytes.MinRead
 )
 
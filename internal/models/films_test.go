@@ -494,14 +494,14 @@ func testFilmsInsertWhitelist(t *testing.T) {
 	}
 }
 
-func testFilmToManyWatchfilms(t *testing.T) {
+func testFilmToManyCollectionItems(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Film
-	var b, c Watchfilm
+	var b, c CollectionItem
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, filmDBTypes, true, filmColumnsWithDefault...); err != nil {
@@ -512,10 +512,87 @@ func testFilmToManyWatchfilms(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, watchfilmDBTypes, false, watchfilmColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, collectionItemDBTypes, false, collectionItemColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, watchfilmDBTypes, false, watchfilmColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, collectionItemDBTypes, false, collectionItemColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.FilmID, a.ID)
+	queries.Assign(&c.FilmID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.CollectionItems().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.FilmID, b.FilmID) {
+			bFound = true
+		}
+		if queries.Equal(v.FilmID, c.FilmID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := FilmSlice{&a}
+	if err = a.L.LoadCollectionItems(ctx, tx, false, (*[]*Film)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CollectionItems); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.CollectionItems = nil
+	if err = a.L.LoadCollectionItems(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CollectionItems); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testFilmToManyContentRatings(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c ContentRating
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, true, filmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Film struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, contentRatingDBTypes, false, contentRatingColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, contentRatingDBTypes, false, contentRatingColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
@@ -529,7 +606,7 @@ func testFilmToManyWatchfilms(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.Watchfilms().All(ctx, tx)
+	check, err := a.ContentRatings().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -552,18 +629,173 @@ func testFilmToManyWatchfilms(t *testing.T) {
 	}
 
 	slice := FilmSlice{&a}
-	if err = a.L.LoadWatchfilms(ctx, tx, false, (*[]*Film)(&slice), nil); err != nil {
+	if err = a.L.LoadContentRatings(ctx, tx, false, (*[]*Film)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.Watchfilms); got != 2 {
+	if got := len(a.R.ContentRatings); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.Watchfilms = nil
-	if err = a.L.LoadWatchfilms(ctx, tx, true, &a, nil); err != nil {
+	a.R.ContentRatings = nil
+	if err = a.L.LoadContentRatings(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.Watchfilms); got != 2 {
+	if got := len(a.R.ContentRatings); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testFilmToManyExternalIds(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c ExternalID
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, true, filmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Film struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, externalIDDBTypes, false, externalIDColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, externalIDDBTypes, false, externalIDColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.FilmID, a.ID)
+	queries.Assign(&c.FilmID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ExternalIds().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.FilmID, b.FilmID) {
+			bFound = true
+		}
+		if queries.Equal(v.FilmID, c.FilmID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := FilmSlice{&a}
+	if err = a.L.LoadExternalIds(ctx, tx, false, (*[]*Film)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ExternalIds); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ExternalIds = nil
+	if err = a.L.LoadExternalIds(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ExternalIds); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testFilmToManyFilmScoreAggregates(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c FilmScoreAggregate
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, true, filmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Film struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, filmScoreAggregateDBTypes, false, filmScoreAggregateColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, filmScoreAggregateDBTypes, false, filmScoreAggregateColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.FilmID = a.ID
+	c.FilmID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.FilmScoreAggregates().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.FilmID == b.FilmID {
+			bFound = true
+		}
+		if v.FilmID == c.FilmID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := FilmSlice{&a}
+	if err = a.L.LoadFilmScoreAggregates(ctx, tx, false, (*[]*Film)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.FilmScoreAggregates); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.FilmScoreAggregates = nil
+	if err = a.L.LoadFilmScoreAggregates(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.FilmScoreAggregates); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -572,6 +804,3010 @@ func testFilmToManyWatchfilms(t *testing.T) {
 	}
 }
 
+func testFilmToManyListActivities(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c ListActivity
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, true, filmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Film struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, listActivityDBTypes, false, listActivityColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, listActivityDBTypes, false, listActivityColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.FilmID, a.ID)
+	queries.Assign(&c.FilmID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ListActivities().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.FilmID, b.FilmID) {
+			bFound = true
+		}
+		if queries.Equal(v.FilmID, c.FilmID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := FilmSlice{&a}
+	if err = a.L.LoadListActivities(ctx, tx, false, (*[]*Film)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ListActivities); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ListActivities = nil
+	if err = a.L.LoadListActivities(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ListActivities); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testFilmToManyListItems(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c ListItem
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, true, filmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Film struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, listItemDBTypes, false, listItemColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, listItemDBTypes, false, listItemColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.FilmID, a.ID)
+	queries.Assign(&c.FilmID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ListItems().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.FilmID, b.FilmID) {
+			bFound = true
+		}
+		if queries.Equal(v.FilmID, c.FilmID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := FilmSlice{&a}
+	if err = a.L.LoadListItems(ctx, tx, false, (*[]*Film)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ListItems); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ListItems = nil
+	if err = a.L.LoadListItems(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ListItems); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testFilmToManyMediaItems(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c MediaItem
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, true, filmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Film struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, mediaItemDBTypes, false, mediaItemColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, mediaItemDBTypes, false, mediaItemColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.FilmID, a.ID)
+	queries.Assign(&c.FilmID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.MediaItems().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.FilmID, b.FilmID) {
+			bFound = true
+		}
+		if queries.Equal(v.FilmID, c.FilmID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := FilmSlice{&a}
+	if err = a.L.LoadMediaItems(ctx, tx, false, (*[]*Film)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.MediaItems); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.MediaItems = nil
+	if err = a.L.LoadMediaItems(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.MediaItems); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testFilmToManyPlaybackProgresses(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c PlaybackProgress
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, true, filmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Film struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, playbackProgressDBTypes, false, playbackProgressColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, playbackProgressDBTypes, false, playbackProgressColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.FilmID = a.ID
+	c.FilmID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.PlaybackProgresses().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.FilmID == b.FilmID {
+			bFound = true
+		}
+		if v.FilmID == c.FilmID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := FilmSlice{&a}
+	if err = a.L.LoadPlaybackProgresses(ctx, tx, false, (*[]*Film)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PlaybackProgresses); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.PlaybackProgresses = nil
+	if err = a.L.LoadPlaybackProgresses(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PlaybackProgresses); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testFilmToManyReleases(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c Release
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, true, filmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Film struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, releaseDBTypes, false, releaseColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, releaseDBTypes, false, releaseColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.FilmID = a.ID
+	c.FilmID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Releases().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.FilmID == b.FilmID {
+			bFound = true
+		}
+		if v.FilmID == c.FilmID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := FilmSlice{&a}
+	if err = a.L.LoadReleases(ctx, tx, false, (*[]*Film)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Releases); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Releases = nil
+	if err = a.L.LoadReleases(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Releases); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testFilmToManyReviews(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c Review
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, true, filmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Film struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, reviewDBTypes, false, reviewColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, reviewDBTypes, false, reviewColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.FilmID, a.ID)
+	queries.Assign(&c.FilmID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Reviews().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.FilmID, b.FilmID) {
+			bFound = true
+		}
+		if queries.Equal(v.FilmID, c.FilmID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := FilmSlice{&a}
+	if err = a.L.LoadReviews(ctx, tx, false, (*[]*Film)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Reviews); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Reviews = nil
+	if err = a.L.LoadReviews(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Reviews); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testFilmToManyScores(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c Score
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, true, filmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Film struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, scoreDBTypes, false, scoreColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, scoreDBTypes, false, scoreColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.FilmID, a.ID)
+	queries.Assign(&c.FilmID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Scores().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.FilmID, b.FilmID) {
+			bFound = true
+		}
+		if queries.Equal(v.FilmID, c.FilmID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := FilmSlice{&a}
+	if err = a.L.LoadScores(ctx, tx, false, (*[]*Film)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Scores); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Scores = nil
+	if err = a.L.LoadScores(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Scores); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testFilmToManyTranslations(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c Translation
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, true, filmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Film struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, translationDBTypes, false, translationColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, translationDBTypes, false, translationColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.FilmID, a.ID)
+	queries.Assign(&c.FilmID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Translations().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.FilmID, b.FilmID) {
+			bFound = true
+		}
+		if queries.Equal(v.FilmID, c.FilmID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := FilmSlice{&a}
+	if err = a.L.LoadTranslations(ctx, tx, false, (*[]*Film)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Translations); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Translations = nil
+	if err = a.L.LoadTranslations(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Translations); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testFilmToManyWatchfilms(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c Watchfilm
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, true, filmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Film struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, watchfilmDBTypes, false, watchfilmColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, watchfilmDBTypes, false, watchfilmColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.FilmID = a.ID
+	c.FilmID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Watchfilms().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.FilmID == b.FilmID {
+			bFound = true
+		}
+		if v.FilmID == c.FilmID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := FilmSlice{&a}
+	if err = a.L.LoadWatchfilms(ctx, tx, false, (*[]*Film)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Watchfilms); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Watchfilms = nil
+	if err = a.L.LoadWatchfilms(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Watchfilms); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testFilmToManyAddOpCollectionItems(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e CollectionItem
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*CollectionItem{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, collectionItemDBTypes, false, strmangle.SetComplement(collectionItemPrimaryKeyColumns, collectionItemColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*CollectionItem{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddCollectionItems(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.FilmID) {
+			t.Error("foreign key was wrong value", a.ID, first.FilmID)
+		}
+		if !queries.Equal(a.ID, second.FilmID) {
+			t.Error("foreign key was wrong value", a.ID, second.FilmID)
+		}
+
+		if first.R.Film != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Film != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.CollectionItems[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.CollectionItems[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.CollectionItems().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testFilmToManySetOpCollectionItems(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e CollectionItem
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*CollectionItem{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, collectionItemDBTypes, false, strmangle.SetComplement(collectionItemPrimaryKeyColumns, collectionItemColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetCollectionItems(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.CollectionItems().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetCollectionItems(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.CollectionItems().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.FilmID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.FilmID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.FilmID) {
+		t.Error("foreign key was wrong value", a.ID, d.FilmID)
+	}
+	if !queries.Equal(a.ID, e.FilmID) {
+		t.Error("foreign key was wrong value", a.ID, e.FilmID)
+	}
+
+	if b.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Film != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Film != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.CollectionItems[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.CollectionItems[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testFilmToManyRemoveOpCollectionItems(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e CollectionItem
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*CollectionItem{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, collectionItemDBTypes, false, strmangle.SetComplement(collectionItemPrimaryKeyColumns, collectionItemColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddCollectionItems(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.CollectionItems().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveCollectionItems(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.CollectionItems().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.FilmID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.FilmID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Film != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Film != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.CollectionItems) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.CollectionItems[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.CollectionItems[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testFilmToManyAddOpContentRatings(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e ContentRating
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ContentRating{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, contentRatingDBTypes, false, strmangle.SetComplement(contentRatingPrimaryKeyColumns, contentRatingColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*ContentRating{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddContentRatings(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.FilmID {
+			t.Error("foreign key was wrong value", a.ID, first.FilmID)
+		}
+		if a.ID != second.FilmID {
+			t.Error("foreign key was wrong value", a.ID, second.FilmID)
+		}
+
+		if first.R.Film != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Film != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ContentRatings[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ContentRatings[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ContentRatings().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testFilmToManyAddOpExternalIds(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e ExternalID
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ExternalID{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, externalIDDBTypes, false, strmangle.SetComplement(externalIDPrimaryKeyColumns, externalIDColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*ExternalID{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddExternalIds(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.FilmID) {
+			t.Error("foreign key was wrong value", a.ID, first.FilmID)
+		}
+		if !queries.Equal(a.ID, second.FilmID) {
+			t.Error("foreign key was wrong value", a.ID, second.FilmID)
+		}
+
+		if first.R.Film != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Film != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ExternalIds[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ExternalIds[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ExternalIds().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testFilmToManySetOpExternalIds(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e ExternalID
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ExternalID{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, externalIDDBTypes, false, strmangle.SetComplement(externalIDPrimaryKeyColumns, externalIDColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetExternalIds(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ExternalIds().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetExternalIds(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ExternalIds().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.FilmID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.FilmID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.FilmID) {
+		t.Error("foreign key was wrong value", a.ID, d.FilmID)
+	}
+	if !queries.Equal(a.ID, e.FilmID) {
+		t.Error("foreign key was wrong value", a.ID, e.FilmID)
+	}
+
+	if b.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Film != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Film != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.ExternalIds[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.ExternalIds[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testFilmToManyRemoveOpExternalIds(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e ExternalID
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ExternalID{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, externalIDDBTypes, false, strmangle.SetComplement(externalIDPrimaryKeyColumns, externalIDColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddExternalIds(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ExternalIds().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveExternalIds(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ExternalIds().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.FilmID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.FilmID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Film != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Film != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.ExternalIds) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.ExternalIds[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.ExternalIds[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testFilmToManyAddOpFilmScoreAggregates(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e FilmScoreAggregate
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*FilmScoreAggregate{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, filmScoreAggregateDBTypes, false, strmangle.SetComplement(filmScoreAggregatePrimaryKeyColumns, filmScoreAggregateColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*FilmScoreAggregate{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddFilmScoreAggregates(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.FilmID {
+			t.Error("foreign key was wrong value", a.ID, first.FilmID)
+		}
+		if a.ID != second.FilmID {
+			t.Error("foreign key was wrong value", a.ID, second.FilmID)
+		}
+
+		if first.R.Film != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Film != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.FilmScoreAggregates[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.FilmScoreAggregates[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.FilmScoreAggregates().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testFilmToManyAddOpListActivities(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e ListActivity
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ListActivity{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, listActivityDBTypes, false, strmangle.SetComplement(listActivityPrimaryKeyColumns, listActivityColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*ListActivity{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddListActivities(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.FilmID) {
+			t.Error("foreign key was wrong value", a.ID, first.FilmID)
+		}
+		if !queries.Equal(a.ID, second.FilmID) {
+			t.Error("foreign key was wrong value", a.ID, second.FilmID)
+		}
+
+		if first.R.Film != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Film != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ListActivities[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ListActivities[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ListActivities().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testFilmToManySetOpListActivities(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e ListActivity
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ListActivity{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, listActivityDBTypes, false, strmangle.SetComplement(listActivityPrimaryKeyColumns, listActivityColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetListActivities(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ListActivities().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetListActivities(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ListActivities().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.FilmID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.FilmID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.FilmID) {
+		t.Error("foreign key was wrong value", a.ID, d.FilmID)
+	}
+	if !queries.Equal(a.ID, e.FilmID) {
+		t.Error("foreign key was wrong value", a.ID, e.FilmID)
+	}
+
+	if b.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Film != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Film != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.ListActivities[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.ListActivities[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testFilmToManyRemoveOpListActivities(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e ListActivity
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ListActivity{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, listActivityDBTypes, false, strmangle.SetComplement(listActivityPrimaryKeyColumns, listActivityColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddListActivities(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ListActivities().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveListActivities(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ListActivities().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.FilmID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.FilmID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Film != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Film != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.ListActivities) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.ListActivities[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.ListActivities[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testFilmToManyAddOpListItems(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e ListItem
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ListItem{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, listItemDBTypes, false, strmangle.SetComplement(listItemPrimaryKeyColumns, listItemColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*ListItem{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddListItems(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.FilmID) {
+			t.Error("foreign key was wrong value", a.ID, first.FilmID)
+		}
+		if !queries.Equal(a.ID, second.FilmID) {
+			t.Error("foreign key was wrong value", a.ID, second.FilmID)
+		}
+
+		if first.R.Film != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Film != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ListItems[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ListItems[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ListItems().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testFilmToManySetOpListItems(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e ListItem
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ListItem{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, listItemDBTypes, false, strmangle.SetComplement(listItemPrimaryKeyColumns, listItemColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetListItems(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ListItems().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetListItems(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ListItems().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.FilmID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.FilmID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.FilmID) {
+		t.Error("foreign key was wrong value", a.ID, d.FilmID)
+	}
+	if !queries.Equal(a.ID, e.FilmID) {
+		t.Error("foreign key was wrong value", a.ID, e.FilmID)
+	}
+
+	if b.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Film != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Film != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.ListItems[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.ListItems[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testFilmToManyRemoveOpListItems(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e ListItem
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ListItem{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, listItemDBTypes, false, strmangle.SetComplement(listItemPrimaryKeyColumns, listItemColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddListItems(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.ListItems().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveListItems(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.ListItems().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.FilmID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.FilmID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Film != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Film != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.ListItems) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.ListItems[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.ListItems[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testFilmToManyAddOpMediaItems(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e MediaItem
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*MediaItem{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, mediaItemDBTypes, false, strmangle.SetComplement(mediaItemPrimaryKeyColumns, mediaItemColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*MediaItem{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddMediaItems(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.FilmID) {
+			t.Error("foreign key was wrong value", a.ID, first.FilmID)
+		}
+		if !queries.Equal(a.ID, second.FilmID) {
+			t.Error("foreign key was wrong value", a.ID, second.FilmID)
+		}
+
+		if first.R.Film != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Film != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.MediaItems[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.MediaItems[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.MediaItems().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testFilmToManySetOpMediaItems(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e MediaItem
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*MediaItem{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, mediaItemDBTypes, false, strmangle.SetComplement(mediaItemPrimaryKeyColumns, mediaItemColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetMediaItems(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.MediaItems().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetMediaItems(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.MediaItems().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.FilmID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.FilmID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.FilmID) {
+		t.Error("foreign key was wrong value", a.ID, d.FilmID)
+	}
+	if !queries.Equal(a.ID, e.FilmID) {
+		t.Error("foreign key was wrong value", a.ID, e.FilmID)
+	}
+
+	if b.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Film != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Film != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.MediaItems[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.MediaItems[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testFilmToManyRemoveOpMediaItems(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e MediaItem
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*MediaItem{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, mediaItemDBTypes, false, strmangle.SetComplement(mediaItemPrimaryKeyColumns, mediaItemColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddMediaItems(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.MediaItems().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveMediaItems(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.MediaItems().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.FilmID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.FilmID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Film != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Film != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.MediaItems) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.MediaItems[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.MediaItems[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testFilmToManyAddOpPlaybackProgresses(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e PlaybackProgress
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*PlaybackProgress{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, playbackProgressDBTypes, false, strmangle.SetComplement(playbackProgressPrimaryKeyColumns, playbackProgressColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*PlaybackProgress{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddPlaybackProgresses(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.FilmID {
+			t.Error("foreign key was wrong value", a.ID, first.FilmID)
+		}
+		if a.ID != second.FilmID {
+			t.Error("foreign key was wrong value", a.ID, second.FilmID)
+		}
+
+		if first.R.Film != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Film != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.PlaybackProgresses[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.PlaybackProgresses[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.PlaybackProgresses().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testFilmToManyAddOpReleases(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e Release
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Release{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, releaseDBTypes, false, strmangle.SetComplement(releasePrimaryKeyColumns, releaseColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Release{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddReleases(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.FilmID {
+			t.Error("foreign key was wrong value", a.ID, first.FilmID)
+		}
+		if a.ID != second.FilmID {
+			t.Error("foreign key was wrong value", a.ID, second.FilmID)
+		}
+
+		if first.R.Film != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Film != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Releases[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Releases[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Releases().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testFilmToManyAddOpReviews(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e Review
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Review{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, reviewDBTypes, false, strmangle.SetComplement(reviewPrimaryKeyColumns, reviewColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Review{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddReviews(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.FilmID) {
+			t.Error("foreign key was wrong value", a.ID, first.FilmID)
+		}
+		if !queries.Equal(a.ID, second.FilmID) {
+			t.Error("foreign key was wrong value", a.ID, second.FilmID)
+		}
+
+		if first.R.Film != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Film != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Reviews[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Reviews[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Reviews().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testFilmToManySetOpReviews(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e Review
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Review{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, reviewDBTypes, false, strmangle.SetComplement(reviewPrimaryKeyColumns, reviewColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetReviews(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Reviews().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetReviews(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Reviews().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.FilmID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.FilmID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.FilmID) {
+		t.Error("foreign key was wrong value", a.ID, d.FilmID)
+	}
+	if !queries.Equal(a.ID, e.FilmID) {
+		t.Error("foreign key was wrong value", a.ID, e.FilmID)
+	}
+
+	if b.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Film != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Film != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.Reviews[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.Reviews[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testFilmToManyRemoveOpReviews(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e Review
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Review{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, reviewDBTypes, false, strmangle.SetComplement(reviewPrimaryKeyColumns, reviewColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddReviews(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Reviews().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveReviews(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Reviews().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.FilmID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.FilmID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Film != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Film != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.Reviews) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.Reviews[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.Reviews[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testFilmToManyAddOpScores(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e Score
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Score{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, scoreDBTypes, false, strmangle.SetComplement(scorePrimaryKeyColumns, scoreColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Score{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddScores(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.FilmID) {
+			t.Error("foreign key was wrong value", a.ID, first.FilmID)
+		}
+		if !queries.Equal(a.ID, second.FilmID) {
+			t.Error("foreign key was wrong value", a.ID, second.FilmID)
+		}
+
+		if first.R.Film != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Film != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Scores[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Scores[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Scores().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testFilmToManySetOpScores(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e Score
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Score{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, scoreDBTypes, false, strmangle.SetComplement(scorePrimaryKeyColumns, scoreColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetScores(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Scores().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetScores(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Scores().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.FilmID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.FilmID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.FilmID) {
+		t.Error("foreign key was wrong value", a.ID, d.FilmID)
+	}
+	if !queries.Equal(a.ID, e.FilmID) {
+		t.Error("foreign key was wrong value", a.ID, e.FilmID)
+	}
+
+	if b.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Film != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Film != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.Scores[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.Scores[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testFilmToManyRemoveOpScores(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e Score
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Score{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, scoreDBTypes, false, strmangle.SetComplement(scorePrimaryKeyColumns, scoreColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddScores(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Scores().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveScores(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Scores().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.FilmID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.FilmID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Film != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Film != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.Scores) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.Scores[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.Scores[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testFilmToManyAddOpTranslations(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e Translation
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Translation{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, translationDBTypes, false, strmangle.SetComplement(translationPrimaryKeyColumns, translationColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Translation{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddTranslations(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.FilmID) {
+			t.Error("foreign key was wrong value", a.ID, first.FilmID)
+		}
+		if !queries.Equal(a.ID, second.FilmID) {
+			t.Error("foreign key was wrong value", a.ID, second.FilmID)
+		}
+
+		if first.R.Film != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Film != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Translations[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Translations[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Translations().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testFilmToManySetOpTranslations(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e Translation
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Translation{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, translationDBTypes, false, strmangle.SetComplement(translationPrimaryKeyColumns, translationColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetTranslations(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Translations().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetTranslations(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Translations().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.FilmID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.FilmID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.FilmID) {
+		t.Error("foreign key was wrong value", a.ID, d.FilmID)
+	}
+	if !queries.Equal(a.ID, e.FilmID) {
+		t.Error("foreign key was wrong value", a.ID, e.FilmID)
+	}
+
+	if b.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Film != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Film != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.Translations[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.Translations[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testFilmToManyRemoveOpTranslations(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Film
+	var b, c, d, e Translation
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Translation{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, translationDBTypes, false, strmangle.SetComplement(translationPrimaryKeyColumns, translationColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddTranslations(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Translations().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveTranslations(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Translations().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.FilmID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.FilmID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Film != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Film != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Film != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.Translations) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.Translations[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.Translations[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
 func testFilmToManyAddOpWatchfilms(t *testing.T) {
 	var err error
 
@@ -989,7 +4225,7 @@ func testFilmsSelect(t *testing.T) {
 }
 
 var (
-	filmDBTypes = map[string]string{`ID`: `integer`, `Title`: `character varying`, `Descriptions`: `character varying`, `DateReleased`: `date`, `Duration`: `integer`, `SeriesID`: `integer`, `SeasonNumber`: `integer`, `EpisodeNumber`: `integer`, `Poster`: `character varying`, `ContributedBy`: `integer`, `ContributedAt`: `timestamp with time zone`, `Invalidation`: `character varying`}
+	filmDBTypes = map[string]string{`ID`: `integer`, `Title`: `character varying`, `Descriptions`: `character varying`, `DateReleased`: `date`, `Duration`: `integer`, `SeriesID`: `integer`, `SeasonNumber`: `integer`, `EpisodeNumber`: `integer`, `Poster`: `character varying`, `ContributedBy`: `integer`, `ContributedAt`: `timestamp with time zone`, `Invalidation`: `character varying`, `AbsoluteNumber`: `integer`, `PartNumber`: `integer`, `DeletedAt`: `timestamp with time zone`}
 	_           = bytes.MinRead
 )
 
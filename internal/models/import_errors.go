@@ -0,0 +1,1089 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ImportError is an object representing the database table.
+type ImportError struct {
+	ID        int    `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	JobID     int    `db:"job_id" boil:"job_id" json:"job_id" toml:"job_id" yaml:"job_id"`
+	RowNumber int    `db:"row_number" boil:"row_number" json:"row_number" toml:"row_number" yaml:"row_number"`
+	Message   string `db:"message" boil:"message" json:"message" toml:"message" yaml:"message"`
+
+	R *importErrorR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L importErrorL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ImportErrorColumns = struct {
+	ID        string
+	JobID     string
+	RowNumber string
+	Message   string
+}{
+	ID:        "id",
+	JobID:     "job_id",
+	RowNumber: "row_number",
+	Message:   "message",
+}
+
+var ImportErrorTableColumns = struct {
+	ID        string
+	JobID     string
+	RowNumber string
+	Message   string
+}{
+	ID:        "import_errors.id",
+	JobID:     "import_errors.job_id",
+	RowNumber: "import_errors.row_number",
+	Message:   "import_errors.message",
+}
+
+// Generated where
+
+var ImportErrorWhere = struct {
+	ID        whereHelperint
+	JobID     whereHelperint
+	RowNumber whereHelperint
+	Message   whereHelperstring
+}{
+	ID:        whereHelperint{field: "\"import_errors\".\"id\""},
+	JobID:     whereHelperint{field: "\"import_errors\".\"job_id\""},
+	RowNumber: whereHelperint{field: "\"import_errors\".\"row_number\""},
+	Message:   whereHelperstring{field: "\"import_errors\".\"message\""},
+}
+
+// ImportErrorRels is where relationship names are stored.
+var ImportErrorRels = struct {
+	Job string
+}{
+	Job: "Job",
+}
+
+// importErrorR is where relationships are stored.
+type importErrorR struct {
+	Job *ImportJob `db:"Job" boil:"Job" json:"Job" toml:"Job" yaml:"Job"`
+}
+
+// NewStruct creates a new relationship struct
+func (*importErrorR) NewStruct() *importErrorR {
+	return &importErrorR{}
+}
+
+func (r *importErrorR) GetJob() *ImportJob {
+	if r == nil {
+		return nil
+	}
+	return r.Job
+}
+
+// importErrorL is where Load methods for each relationship are stored.
+type importErrorL struct{}
+
+var (
+	importErrorAllColumns            = []string{"id", "job_id", "row_number", "message"}
+	importErrorColumnsWithoutDefault = []string{"job_id", "row_number", "message"}
+	importErrorColumnsWithDefault    = []string{"id"}
+	importErrorPrimaryKeyColumns     = []string{"id"}
+	importErrorGeneratedColumns      = []string{}
+)
+
+type (
+	// ImportErrorSlice is an alias for a slice of pointers to ImportError.
+	// This should almost always be used instead of []ImportError.
+	ImportErrorSlice []*ImportError
+	// ImportErrorHook is the signature for custom ImportError hook methods
+	ImportErrorHook func(context.Context, boil.ContextExecutor, *ImportError) error
+
+	importErrorQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	importErrorType                 = reflect.TypeOf(&ImportError{})
+	importErrorMapping              = queries.MakeStructMapping(importErrorType)
+	importErrorPrimaryKeyMapping, _ = queries.BindMapping(importErrorType, importErrorMapping, importErrorPrimaryKeyColumns)
+	importErrorInsertCacheMut       sync.RWMutex
+	importErrorInsertCache          = make(map[string]insertCache)
+	importErrorUpdateCacheMut       sync.RWMutex
+	importErrorUpdateCache          = make(map[string]updateCache)
+	importErrorUpsertCacheMut       sync.RWMutex
+	importErrorUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var importErrorAfterSelectHooks []ImportErrorHook
+
+var importErrorBeforeInsertHooks []ImportErrorHook
+var importErrorAfterInsertHooks []ImportErrorHook
+
+var importErrorBeforeUpdateHooks []ImportErrorHook
+var importErrorAfterUpdateHooks []ImportErrorHook
+
+var importErrorBeforeDeleteHooks []ImportErrorHook
+var importErrorAfterDeleteHooks []ImportErrorHook
+
+var importErrorBeforeUpsertHooks []ImportErrorHook
+var importErrorAfterUpsertHooks []ImportErrorHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ImportError) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range importErrorAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ImportError) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range importErrorBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ImportError) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range importErrorAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ImportError) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range importErrorBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ImportError) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range importErrorAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ImportError) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range importErrorBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ImportError) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range importErrorAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ImportError) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range importErrorBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ImportError) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range importErrorAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddImportErrorHook registers your hook function for all future operations.
+func AddImportErrorHook(hookPoint boil.HookPoint, importErrorHook ImportErrorHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		importErrorAfterSelectHooks = append(importErrorAfterSelectHooks, importErrorHook)
+	case boil.BeforeInsertHook:
+		importErrorBeforeInsertHooks = append(importErrorBeforeInsertHooks, importErrorHook)
+	case boil.AfterInsertHook:
+		importErrorAfterInsertHooks = append(importErrorAfterInsertHooks, importErrorHook)
+	case boil.BeforeUpdateHook:
+		importErrorBeforeUpdateHooks = append(importErrorBeforeUpdateHooks, importErrorHook)
+	case boil.AfterUpdateHook:
+		importErrorAfterUpdateHooks = append(importErrorAfterUpdateHooks, importErrorHook)
+	case boil.BeforeDeleteHook:
+		importErrorBeforeDeleteHooks = append(importErrorBeforeDeleteHooks, importErrorHook)
+	case boil.AfterDeleteHook:
+		importErrorAfterDeleteHooks = append(importErrorAfterDeleteHooks, importErrorHook)
+	case boil.BeforeUpsertHook:
+		importErrorBeforeUpsertHooks = append(importErrorBeforeUpsertHooks, importErrorHook)
+	case boil.AfterUpsertHook:
+		importErrorAfterUpsertHooks = append(importErrorAfterUpsertHooks, importErrorHook)
+	}
+}
+
+// One returns a single importError record from the query.
+func (q importErrorQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ImportError, error) {
+	o := &ImportError{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for import_errors")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ImportError records from the query.
+func (q importErrorQuery) All(ctx context.Context, exec boil.ContextExecutor) (ImportErrorSlice, error) {
+	var o []*ImportError
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ImportError slice")
+	}
+
+	if len(importErrorAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ImportError records in the query.
+func (q importErrorQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count import_errors rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q importErrorQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if import_errors exists")
+	}
+
+	return count > 0, nil
+}
+
+// Job pointed to by the foreign key.
+func (o *ImportError) Job(mods ...qm.QueryMod) importJobQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.JobID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return ImportJobs(queryMods...)
+}
+
+// LoadJob allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (importErrorL) LoadJob(ctx context.Context, e boil.ContextExecutor, singular bool, maybeImportError interface{}, mods queries.Applicator) error {
+	var slice []*ImportError
+	var object *ImportError
+
+	if singular {
+		var ok bool
+		object, ok = maybeImportError.(*ImportError)
+		if !ok {
+			object = new(ImportError)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeImportError)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeImportError))
+			}
+		}
+	} else {
+		s, ok := maybeImportError.(*[]*ImportError)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeImportError)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeImportError))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &importErrorR{}
+		}
+		args = append(args, object.JobID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &importErrorR{}
+			}
+
+			for _, a := range args {
+				if a == obj.JobID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.JobID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`import_jobs`),
+		qm.WhereIn(`import_jobs.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ImportJob")
+	}
+
+	var resultSlice []*ImportJob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ImportJob")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for import_jobs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for import_jobs")
+	}
+
+	if len(importErrorAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Job = foreign
+		if foreign.R == nil {
+			foreign.R = &importJobR{}
+		}
+		foreign.R.JobImportErrors = append(foreign.R.JobImportErrors, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.JobID == foreign.ID {
+				local.R.Job = foreign
+				if foreign.R == nil {
+					foreign.R = &importJobR{}
+				}
+				foreign.R.JobImportErrors = append(foreign.R.JobImportErrors, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetJob of the importError to the related item.
+// Sets o.R.Job to related.
+// Adds o to related.R.JobImportErrors.
+func (o *ImportError) SetJob(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ImportJob) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"import_errors\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"job_id"}),
+		strmangle.WhereClause("\"", "\"", 2, importErrorPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.JobID = related.ID
+	if o.R == nil {
+		o.R = &importErrorR{
+			Job: related,
+		}
+	} else {
+		o.R.Job = related
+	}
+
+	if related.R == nil {
+		related.R = &importJobR{
+			JobImportErrors: ImportErrorSlice{o},
+		}
+	} else {
+		related.R.JobImportErrors = append(related.R.JobImportErrors, o)
+	}
+
+	return nil
+}
+
+// ImportErrors retrieves all the records using an executor.
+func ImportErrors(mods ...qm.QueryMod) importErrorQuery {
+	mods = append(mods, qm.From("\"import_errors\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"import_errors\".*"})
+	}
+
+	return importErrorQuery{q}
+}
+
+// FindImportError retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindImportError(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*ImportError, error) {
+	importErrorObj := &ImportError{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"import_errors\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, importErrorObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from import_errors")
+	}
+
+	if err = importErrorObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return importErrorObj, err
+	}
+
+	return importErrorObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ImportError) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no import_errors provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(importErrorColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	importErrorInsertCacheMut.RLock()
+	cache, cached := importErrorInsertCache[key]
+	importErrorInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			importErrorAllColumns,
+			importErrorColumnsWithDefault,
+			importErrorColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(importErrorType, importErrorMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(importErrorType, importErrorMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"import_errors\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"import_errors\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into import_errors")
+	}
+
+	if !cached {
+		importErrorInsertCacheMut.Lock()
+		importErrorInsertCache[key] = cache
+		importErrorInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ImportError.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ImportError) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	importErrorUpdateCacheMut.RLock()
+	cache, cached := importErrorUpdateCache[key]
+	importErrorUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			importErrorAllColumns,
+			importErrorPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update import_errors, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"import_errors\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, importErrorPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(importErrorType, importErrorMapping, append(wl, importErrorPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update import_errors row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for import_errors")
+	}
+
+	if !cached {
+		importErrorUpdateCacheMut.Lock()
+		importErrorUpdateCache[key] = cache
+		importErrorUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q importErrorQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for import_errors")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for import_errors")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ImportErrorSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), importErrorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"import_errors\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, importErrorPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in importError slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all importError")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ImportError) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no import_errors provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(importErrorColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	importErrorUpsertCacheMut.RLock()
+	cache, cached := importErrorUpsertCache[key]
+	importErrorUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			importErrorAllColumns,
+			importErrorColumnsWithDefault,
+			importErrorColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			importErrorAllColumns,
+			importErrorPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert import_errors, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(importErrorPrimaryKeyColumns))
+			copy(conflict, importErrorPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"import_errors\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(importErrorType, importErrorMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(importErrorType, importErrorMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert import_errors")
+	}
+
+	if !cached {
+		importErrorUpsertCacheMut.Lock()
+		importErrorUpsertCache[key] = cache
+		importErrorUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ImportError record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ImportError) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ImportError provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), importErrorPrimaryKeyMapping)
+	sql := "DELETE FROM \"import_errors\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from import_errors")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for import_errors")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q importErrorQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no importErrorQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from import_errors")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for import_errors")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ImportErrorSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(importErrorBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), importErrorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"import_errors\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, importErrorPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from importError slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for import_errors")
+	}
+
+	if len(importErrorAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ImportError) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindImportError(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ImportErrorSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ImportErrorSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), importErrorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"import_errors\".* FROM \"import_errors\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, importErrorPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ImportErrorSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ImportErrorExists checks if the ImportError row exists.
+func ImportErrorExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"import_errors\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if import_errors exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,841 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testImportErrors(t *testing.T) {
+	t.Parallel()
+
+	query := ImportErrors()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testImportErrorsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportError{}
+	if err = randomize.Struct(seed, o, importErrorDBTypes, true, importErrorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportError struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ImportErrors().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testImportErrorsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportError{}
+	if err = randomize.Struct(seed, o, importErrorDBTypes, true, importErrorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportError struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ImportErrors().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ImportErrors().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testImportErrorsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportError{}
+	if err = randomize.Struct(seed, o, importErrorDBTypes, true, importErrorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportError struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ImportErrorSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ImportErrors().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testImportErrorsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportError{}
+	if err = randomize.Struct(seed, o, importErrorDBTypes, true, importErrorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportError struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ImportErrorExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if ImportError exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ImportErrorExists to return true, but got false.")
+	}
+}
+
+func testImportErrorsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportError{}
+	if err = randomize.Struct(seed, o, importErrorDBTypes, true, importErrorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportError struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	importErrorFound, err := FindImportError(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if importErrorFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testImportErrorsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportError{}
+	if err = randomize.Struct(seed, o, importErrorDBTypes, true, importErrorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportError struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ImportErrors().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testImportErrorsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportError{}
+	if err = randomize.Struct(seed, o, importErrorDBTypes, true, importErrorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportError struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ImportErrors().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testImportErrorsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	importErrorOne := &ImportError{}
+	importErrorTwo := &ImportError{}
+	if err = randomize.Struct(seed, importErrorOne, importErrorDBTypes, false, importErrorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportError struct: %s", err)
+	}
+	if err = randomize.Struct(seed, importErrorTwo, importErrorDBTypes, false, importErrorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportError struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = importErrorOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = importErrorTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ImportErrors().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testImportErrorsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	importErrorOne := &ImportError{}
+	importErrorTwo := &ImportError{}
+	if err = randomize.Struct(seed, importErrorOne, importErrorDBTypes, false, importErrorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportError struct: %s", err)
+	}
+	if err = randomize.Struct(seed, importErrorTwo, importErrorDBTypes, false, importErrorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportError struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = importErrorOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = importErrorTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ImportErrors().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func importErrorBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ImportError) error {
+	*o = ImportError{}
+	return nil
+}
+
+func importErrorAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ImportError) error {
+	*o = ImportError{}
+	return nil
+}
+
+func importErrorAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ImportError) error {
+	*o = ImportError{}
+	return nil
+}
+
+func importErrorBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ImportError) error {
+	*o = ImportError{}
+	return nil
+}
+
+func importErrorAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ImportError) error {
+	*o = ImportError{}
+	return nil
+}
+
+func importErrorBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ImportError) error {
+	*o = ImportError{}
+	return nil
+}
+
+func importErrorAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ImportError) error {
+	*o = ImportError{}
+	return nil
+}
+
+func importErrorBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ImportError) error {
+	*o = ImportError{}
+	return nil
+}
+
+func importErrorAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ImportError) error {
+	*o = ImportError{}
+	return nil
+}
+
+func testImportErrorsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ImportError{}
+	o := &ImportError{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, importErrorDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ImportError object: %s", err)
+	}
+
+	AddImportErrorHook(boil.BeforeInsertHook, importErrorBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	importErrorBeforeInsertHooks = []ImportErrorHook{}
+
+	AddImportErrorHook(boil.AfterInsertHook, importErrorAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	importErrorAfterInsertHooks = []ImportErrorHook{}
+
+	AddImportErrorHook(boil.AfterSelectHook, importErrorAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	importErrorAfterSelectHooks = []ImportErrorHook{}
+
+	AddImportErrorHook(boil.BeforeUpdateHook, importErrorBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	importErrorBeforeUpdateHooks = []ImportErrorHook{}
+
+	AddImportErrorHook(boil.AfterUpdateHook, importErrorAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	importErrorAfterUpdateHooks = []ImportErrorHook{}
+
+	AddImportErrorHook(boil.BeforeDeleteHook, importErrorBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	importErrorBeforeDeleteHooks = []ImportErrorHook{}
+
+	AddImportErrorHook(boil.AfterDeleteHook, importErrorAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	importErrorAfterDeleteHooks = []ImportErrorHook{}
+
+	AddImportErrorHook(boil.BeforeUpsertHook, importErrorBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	importErrorBeforeUpsertHooks = []ImportErrorHook{}
+
+	AddImportErrorHook(boil.AfterUpsertHook, importErrorAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	importErrorAfterUpsertHooks = []ImportErrorHook{}
+}
+
+func testImportErrorsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportError{}
+	if err = randomize.Struct(seed, o, importErrorDBTypes, true, importErrorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportError struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ImportErrors().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testImportErrorsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportError{}
+	if err = randomize.Struct(seed, o, importErrorDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ImportError struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(importErrorColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ImportErrors().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testImportErrorToOneImportJobUsingJob(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ImportError
+	var foreign ImportJob
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, importErrorDBTypes, false, importErrorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportError struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, importJobDBTypes, false, importJobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportJob struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.JobID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Job().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ImportErrorSlice{&local}
+	if err = local.L.LoadJob(ctx, tx, false, (*[]*ImportError)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Job == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Job = nil
+	if err = local.L.LoadJob(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Job == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testImportErrorToOneSetOpImportJobUsingJob(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ImportError
+	var b, c ImportJob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, importErrorDBTypes, false, strmangle.SetComplement(importErrorPrimaryKeyColumns, importErrorColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, importJobDBTypes, false, strmangle.SetComplement(importJobPrimaryKeyColumns, importJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, importJobDBTypes, false, strmangle.SetComplement(importJobPrimaryKeyColumns, importJobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*ImportJob{&b, &c} {
+		err = a.SetJob(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Job != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.JobImportErrors[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.JobID != x.ID {
+			t.Error("foreign key was wrong value", a.JobID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.JobID))
+		reflect.Indirect(reflect.ValueOf(&a.JobID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.JobID != x.ID {
+			t.Error("foreign key was wrong value", a.JobID, x.ID)
+		}
+	}
+}
+
+func testImportErrorsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportError{}
+	if err = randomize.Struct(seed, o, importErrorDBTypes, true, importErrorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportError struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testImportErrorsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportError{}
+	if err = randomize.Struct(seed, o, importErrorDBTypes, true, importErrorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportError struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ImportErrorSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testImportErrorsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportError{}
+	if err = randomize.Struct(seed, o, importErrorDBTypes, true, importErrorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportError struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ImportErrors().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	importErrorDBTypes = map[string]string{`ID`: `integer`, `JobID`: `integer`, `RowNumber`: `integer`, `Message`: `text`}
+	_                  = bytes.MinRead
+)
+
+func testImportErrorsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(importErrorPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(importErrorAllColumns) == len(importErrorPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportError{}
+	if err = randomize.Struct(seed, o, importErrorDBTypes, true, importErrorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportError struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ImportErrors().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, importErrorDBTypes, true, importErrorPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ImportError struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testImportErrorsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(importErrorAllColumns) == len(importErrorPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ImportError{}
+	if err = randomize.Struct(seed, o, importErrorDBTypes, true, importErrorColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ImportError struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ImportErrors().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, importErrorDBTypes, true, importErrorPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ImportError struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(importErrorAllColumns, importErrorPrimaryKeyColumns) {
+		fields = importErrorAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			importErrorAllColumns,
+			importErrorPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ImportErrorSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testImportErrorsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(importErrorAllColumns) == len(importErrorPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ImportError{}
+	if err = randomize.Struct(seed, &o, importErrorDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ImportError struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ImportError: %s", err)
+	}
+
+	count, err := ImportErrors().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, importErrorDBTypes, false, importErrorPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ImportError struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ImportError: %s", err)
+	}
+
+	count, err = ImportErrors().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,2018 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ListActivity is an object representing the database table.
+type ListActivity struct {
+	ID         int       `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	ListID     int       `db:"list_id" boil:"list_id" json:"list_id" toml:"list_id" yaml:"list_id"`
+	UserID     null.Int  `db:"user_id" boil:"user_id" json:"user_id,omitempty" toml:"user_id" yaml:"user_id,omitempty"`
+	Action     string    `db:"action" boil:"action" json:"action" toml:"action" yaml:"action"`
+	FilmID     null.Int  `db:"film_id" boil:"film_id" json:"film_id,omitempty" toml:"film_id" yaml:"film_id,omitempty"`
+	SeriesID   null.Int  `db:"series_id" boil:"series_id" json:"series_id,omitempty" toml:"series_id" yaml:"series_id,omitempty"`
+	MemberID   null.Int  `db:"member_id" boil:"member_id" json:"member_id,omitempty" toml:"member_id" yaml:"member_id,omitempty"`
+	TimeLogged time.Time `db:"time_logged" boil:"time_logged" json:"time_logged" toml:"time_logged" yaml:"time_logged"`
+
+	R *listActivityR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L listActivityL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ListActivityColumns = struct {
+	ID         string
+	ListID     string
+	UserID     string
+	Action     string
+	FilmID     string
+	SeriesID   string
+	MemberID   string
+	TimeLogged string
+}{
+	ID:         "id",
+	ListID:     "list_id",
+	UserID:     "user_id",
+	Action:     "action",
+	FilmID:     "film_id",
+	SeriesID:   "series_id",
+	MemberID:   "member_id",
+	TimeLogged: "time_logged",
+}
+
+var ListActivityTableColumns = struct {
+	ID         string
+	ListID     string
+	UserID     string
+	Action     string
+	FilmID     string
+	SeriesID   string
+	MemberID   string
+	TimeLogged string
+}{
+	ID:         "list_activities.id",
+	ListID:     "list_activities.list_id",
+	UserID:     "list_activities.user_id",
+	Action:     "list_activities.action",
+	FilmID:     "list_activities.film_id",
+	SeriesID:   "list_activities.series_id",
+	MemberID:   "list_activities.member_id",
+	TimeLogged: "list_activities.time_logged",
+}
+
+// Generated where
+
+var ListActivityWhere = struct {
+	ID         whereHelperint
+	ListID     whereHelperint
+	UserID     whereHelpernull_Int
+	Action     whereHelperstring
+	FilmID     whereHelpernull_Int
+	SeriesID   whereHelpernull_Int
+	MemberID   whereHelpernull_Int
+	TimeLogged whereHelpertime_Time
+}{
+	ID:         whereHelperint{field: "\"list_activities\".\"id\""},
+	ListID:     whereHelperint{field: "\"list_activities\".\"list_id\""},
+	UserID:     whereHelpernull_Int{field: "\"list_activities\".\"user_id\""},
+	Action:     whereHelperstring{field: "\"list_activities\".\"action\""},
+	FilmID:     whereHelpernull_Int{field: "\"list_activities\".\"film_id\""},
+	SeriesID:   whereHelpernull_Int{field: "\"list_activities\".\"series_id\""},
+	MemberID:   whereHelpernull_Int{field: "\"list_activities\".\"member_id\""},
+	TimeLogged: whereHelpertime_Time{field: "\"list_activities\".\"time_logged\""},
+}
+
+// ListActivityRels is where relationship names are stored.
+var ListActivityRels = struct {
+	Film   string
+	List   string
+	Series string
+	User   string
+	Member string
+}{
+	Film:   "Film",
+	List:   "List",
+	Series: "Series",
+	User:   "User",
+	Member: "Member",
+}
+
+// listActivityR is where relationships are stored.
+type listActivityR struct {
+	Film   *Film   `db:"Film" boil:"Film" json:"Film" toml:"Film" yaml:"Film"`
+	List   *List   `db:"List" boil:"List" json:"List" toml:"List" yaml:"List"`
+	Series *Series `db:"Series" boil:"Series" json:"Series" toml:"Series" yaml:"Series"`
+	User   *User   `db:"User" boil:"User" json:"User" toml:"User" yaml:"User"`
+	Member *User   `db:"Member" boil:"Member" json:"Member" toml:"Member" yaml:"Member"`
+}
+
+// NewStruct creates a new relationship struct
+func (*listActivityR) NewStruct() *listActivityR {
+	return &listActivityR{}
+}
+
+func (r *listActivityR) GetFilm() *Film {
+	if r == nil {
+		return nil
+	}
+	return r.Film
+}
+
+func (r *listActivityR) GetList() *List {
+	if r == nil {
+		return nil
+	}
+	return r.List
+}
+
+func (r *listActivityR) GetSeries() *Series {
+	if r == nil {
+		return nil
+	}
+	return r.Series
+}
+
+func (r *listActivityR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+func (r *listActivityR) GetMember() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Member
+}
+
+// listActivityL is where Load methods for each relationship are stored.
+type listActivityL struct{}
+
+var (
+	listActivityAllColumns            = []string{"id", "list_id", "user_id", "action", "film_id", "series_id", "member_id", "time_logged"}
+	listActivityColumnsWithoutDefault = []string{"list_id", "action"}
+	listActivityColumnsWithDefault    = []string{"id", "user_id", "film_id", "series_id", "member_id", "time_logged"}
+	listActivityPrimaryKeyColumns     = []string{"id"}
+	listActivityGeneratedColumns      = []string{}
+)
+
+type (
+	// ListActivitySlice is an alias for a slice of pointers to ListActivity.
+	// This should almost always be used instead of []ListActivity.
+	ListActivitySlice []*ListActivity
+	// ListActivityHook is the signature for custom ListActivity hook methods
+	ListActivityHook func(context.Context, boil.ContextExecutor, *ListActivity) error
+
+	listActivityQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	listActivityType                 = reflect.TypeOf(&ListActivity{})
+	listActivityMapping              = queries.MakeStructMapping(listActivityType)
+	listActivityPrimaryKeyMapping, _ = queries.BindMapping(listActivityType, listActivityMapping, listActivityPrimaryKeyColumns)
+	listActivityInsertCacheMut       sync.RWMutex
+	listActivityInsertCache          = make(map[string]insertCache)
+	listActivityUpdateCacheMut       sync.RWMutex
+	listActivityUpdateCache          = make(map[string]updateCache)
+	listActivityUpsertCacheMut       sync.RWMutex
+	listActivityUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var listActivityAfterSelectHooks []ListActivityHook
+
+var listActivityBeforeInsertHooks []ListActivityHook
+var listActivityAfterInsertHooks []ListActivityHook
+
+var listActivityBeforeUpdateHooks []ListActivityHook
+var listActivityAfterUpdateHooks []ListActivityHook
+
+var listActivityBeforeDeleteHooks []ListActivityHook
+var listActivityAfterDeleteHooks []ListActivityHook
+
+var listActivityBeforeUpsertHooks []ListActivityHook
+var listActivityAfterUpsertHooks []ListActivityHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ListActivity) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listActivityAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ListActivity) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listActivityBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ListActivity) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listActivityAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ListActivity) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listActivityBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ListActivity) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listActivityAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ListActivity) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listActivityBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ListActivity) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listActivityAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ListActivity) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listActivityBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ListActivity) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listActivityAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddListActivityHook registers your hook function for all future operations.
+func AddListActivityHook(hookPoint boil.HookPoint, listActivityHook ListActivityHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		listActivityAfterSelectHooks = append(listActivityAfterSelectHooks, listActivityHook)
+	case boil.BeforeInsertHook:
+		listActivityBeforeInsertHooks = append(listActivityBeforeInsertHooks, listActivityHook)
+	case boil.AfterInsertHook:
+		listActivityAfterInsertHooks = append(listActivityAfterInsertHooks, listActivityHook)
+	case boil.BeforeUpdateHook:
+		listActivityBeforeUpdateHooks = append(listActivityBeforeUpdateHooks, listActivityHook)
+	case boil.AfterUpdateHook:
+		listActivityAfterUpdateHooks = append(listActivityAfterUpdateHooks, listActivityHook)
+	case boil.BeforeDeleteHook:
+		listActivityBeforeDeleteHooks = append(listActivityBeforeDeleteHooks, listActivityHook)
+	case boil.AfterDeleteHook:
+		listActivityAfterDeleteHooks = append(listActivityAfterDeleteHooks, listActivityHook)
+	case boil.BeforeUpsertHook:
+		listActivityBeforeUpsertHooks = append(listActivityBeforeUpsertHooks, listActivityHook)
+	case boil.AfterUpsertHook:
+		listActivityAfterUpsertHooks = append(listActivityAfterUpsertHooks, listActivityHook)
+	}
+}
+
+// One returns a single listActivity record from the query.
+func (q listActivityQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ListActivity, error) {
+	o := &ListActivity{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for list_activities")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ListActivity records from the query.
+func (q listActivityQuery) All(ctx context.Context, exec boil.ContextExecutor) (ListActivitySlice, error) {
+	var o []*ListActivity
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ListActivity slice")
+	}
+
+	if len(listActivityAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ListActivity records in the query.
+func (q listActivityQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count list_activities rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q listActivityQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if list_activities exists")
+	}
+
+	return count > 0, nil
+}
+
+// Film pointed to by the foreign key.
+func (o *ListActivity) Film(mods ...qm.QueryMod) filmQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FilmID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Films(queryMods...)
+}
+
+// List pointed to by the foreign key.
+func (o *ListActivity) List(mods ...qm.QueryMod) listQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ListID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Lists(queryMods...)
+}
+
+// Series pointed to by the foreign key.
+func (o *ListActivity) Series(mods ...qm.QueryMod) seriesQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SeriesID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Serieses(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *ListActivity) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Member pointed to by the foreign key.
+func (o *ListActivity) Member(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.MemberID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadFilm allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (listActivityL) LoadFilm(ctx context.Context, e boil.ContextExecutor, singular bool, maybeListActivity interface{}, mods queries.Applicator) error {
+	var slice []*ListActivity
+	var object *ListActivity
+
+	if singular {
+		var ok bool
+		object, ok = maybeListActivity.(*ListActivity)
+		if !ok {
+			object = new(ListActivity)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeListActivity)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeListActivity))
+			}
+		}
+	} else {
+		s, ok := maybeListActivity.(*[]*ListActivity)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeListActivity)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeListActivity))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listActivityR{}
+		}
+		if !queries.IsNil(object.FilmID) {
+			args = append(args, object.FilmID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listActivityR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.FilmID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.FilmID) {
+				args = append(args, obj.FilmID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`films`),
+		qm.WhereIn(`films.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Film")
+	}
+
+	var resultSlice []*Film
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Film")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for films")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for films")
+	}
+
+	if len(listActivityAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Film = foreign
+		if foreign.R == nil {
+			foreign.R = &filmR{}
+		}
+		foreign.R.ListActivities = append(foreign.R.ListActivities, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.FilmID, foreign.ID) {
+				local.R.Film = foreign
+				if foreign.R == nil {
+					foreign.R = &filmR{}
+				}
+				foreign.R.ListActivities = append(foreign.R.ListActivities, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadList allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (listActivityL) LoadList(ctx context.Context, e boil.ContextExecutor, singular bool, maybeListActivity interface{}, mods queries.Applicator) error {
+	var slice []*ListActivity
+	var object *ListActivity
+
+	if singular {
+		var ok bool
+		object, ok = maybeListActivity.(*ListActivity)
+		if !ok {
+			object = new(ListActivity)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeListActivity)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeListActivity))
+			}
+		}
+	} else {
+		s, ok := maybeListActivity.(*[]*ListActivity)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeListActivity)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeListActivity))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listActivityR{}
+		}
+		args = append(args, object.ListID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listActivityR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ListID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ListID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`lists`),
+		qm.WhereIn(`lists.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load List")
+	}
+
+	var resultSlice []*List
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice List")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for lists")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for lists")
+	}
+
+	if len(listActivityAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.List = foreign
+		if foreign.R == nil {
+			foreign.R = &listR{}
+		}
+		foreign.R.ListActivities = append(foreign.R.ListActivities, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ListID == foreign.ID {
+				local.R.List = foreign
+				if foreign.R == nil {
+					foreign.R = &listR{}
+				}
+				foreign.R.ListActivities = append(foreign.R.ListActivities, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSeries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (listActivityL) LoadSeries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeListActivity interface{}, mods queries.Applicator) error {
+	var slice []*ListActivity
+	var object *ListActivity
+
+	if singular {
+		var ok bool
+		object, ok = maybeListActivity.(*ListActivity)
+		if !ok {
+			object = new(ListActivity)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeListActivity)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeListActivity))
+			}
+		}
+	} else {
+		s, ok := maybeListActivity.(*[]*ListActivity)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeListActivity)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeListActivity))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listActivityR{}
+		}
+		if !queries.IsNil(object.SeriesID) {
+			args = append(args, object.SeriesID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listActivityR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.SeriesID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.SeriesID) {
+				args = append(args, obj.SeriesID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`serieses`),
+		qm.WhereIn(`serieses.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Series")
+	}
+
+	var resultSlice []*Series
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Series")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for serieses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for serieses")
+	}
+
+	if len(listActivityAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Series = foreign
+		if foreign.R == nil {
+			foreign.R = &seriesR{}
+		}
+		foreign.R.SeriesListActivities = append(foreign.R.SeriesListActivities, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.SeriesID, foreign.ID) {
+				local.R.Series = foreign
+				if foreign.R == nil {
+					foreign.R = &seriesR{}
+				}
+				foreign.R.SeriesListActivities = append(foreign.R.SeriesListActivities, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (listActivityL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeListActivity interface{}, mods queries.Applicator) error {
+	var slice []*ListActivity
+	var object *ListActivity
+
+	if singular {
+		var ok bool
+		object, ok = maybeListActivity.(*ListActivity)
+		if !ok {
+			object = new(ListActivity)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeListActivity)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeListActivity))
+			}
+		}
+	} else {
+		s, ok := maybeListActivity.(*[]*ListActivity)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeListActivity)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeListActivity))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listActivityR{}
+		}
+		if !queries.IsNil(object.UserID) {
+			args = append(args, object.UserID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listActivityR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.UserID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.UserID) {
+				args = append(args, obj.UserID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(listActivityAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ListActivities = append(foreign.R.ListActivities, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserID, foreign.ID) {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ListActivities = append(foreign.R.ListActivities, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMember allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (listActivityL) LoadMember(ctx context.Context, e boil.ContextExecutor, singular bool, maybeListActivity interface{}, mods queries.Applicator) error {
+	var slice []*ListActivity
+	var object *ListActivity
+
+	if singular {
+		var ok bool
+		object, ok = maybeListActivity.(*ListActivity)
+		if !ok {
+			object = new(ListActivity)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeListActivity)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeListActivity))
+			}
+		}
+	} else {
+		s, ok := maybeListActivity.(*[]*ListActivity)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeListActivity)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeListActivity))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listActivityR{}
+		}
+		if !queries.IsNil(object.MemberID) {
+			args = append(args, object.MemberID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listActivityR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.MemberID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.MemberID) {
+				args = append(args, obj.MemberID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(listActivityAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Member = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.MemberListActivities = append(foreign.R.MemberListActivities, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.MemberID, foreign.ID) {
+				local.R.Member = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.MemberListActivities = append(foreign.R.MemberListActivities, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetFilm of the listActivity to the related item.
+// Sets o.R.Film to related.
+// Adds o to related.R.ListActivities.
+func (o *ListActivity) SetFilm(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Film) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"list_activities\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+		strmangle.WhereClause("\"", "\"", 2, listActivityPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.FilmID, related.ID)
+	if o.R == nil {
+		o.R = &listActivityR{
+			Film: related,
+		}
+	} else {
+		o.R.Film = related
+	}
+
+	if related.R == nil {
+		related.R = &filmR{
+			ListActivities: ListActivitySlice{o},
+		}
+	} else {
+		related.R.ListActivities = append(related.R.ListActivities, o)
+	}
+
+	return nil
+}
+
+// RemoveFilm relationship.
+// Sets o.R.Film to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *ListActivity) RemoveFilm(ctx context.Context, exec boil.ContextExecutor, related *Film) error {
+	var err error
+
+	queries.SetScanner(&o.FilmID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("film_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Film = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ListActivities {
+		if queries.Equal(o.FilmID, ri.FilmID) {
+			continue
+		}
+
+		ln := len(related.R.ListActivities)
+		if ln > 1 && i < ln-1 {
+			related.R.ListActivities[i] = related.R.ListActivities[ln-1]
+		}
+		related.R.ListActivities = related.R.ListActivities[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetList of the listActivity to the related item.
+// Sets o.R.List to related.
+// Adds o to related.R.ListActivities.
+func (o *ListActivity) SetList(ctx context.Context, exec boil.ContextExecutor, insert bool, related *List) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"list_activities\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"list_id"}),
+		strmangle.WhereClause("\"", "\"", 2, listActivityPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ListID = related.ID
+	if o.R == nil {
+		o.R = &listActivityR{
+			List: related,
+		}
+	} else {
+		o.R.List = related
+	}
+
+	if related.R == nil {
+		related.R = &listR{
+			ListActivities: ListActivitySlice{o},
+		}
+	} else {
+		related.R.ListActivities = append(related.R.ListActivities, o)
+	}
+
+	return nil
+}
+
+// SetSeries of the listActivity to the related item.
+// Sets o.R.Series to related.
+// Adds o to related.R.SeriesListActivities.
+func (o *ListActivity) SetSeries(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Series) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"list_activities\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+		strmangle.WhereClause("\"", "\"", 2, listActivityPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.SeriesID, related.ID)
+	if o.R == nil {
+		o.R = &listActivityR{
+			Series: related,
+		}
+	} else {
+		o.R.Series = related
+	}
+
+	if related.R == nil {
+		related.R = &seriesR{
+			SeriesListActivities: ListActivitySlice{o},
+		}
+	} else {
+		related.R.SeriesListActivities = append(related.R.SeriesListActivities, o)
+	}
+
+	return nil
+}
+
+// RemoveSeries relationship.
+// Sets o.R.Series to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *ListActivity) RemoveSeries(ctx context.Context, exec boil.ContextExecutor, related *Series) error {
+	var err error
+
+	queries.SetScanner(&o.SeriesID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("series_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Series = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.SeriesListActivities {
+		if queries.Equal(o.SeriesID, ri.SeriesID) {
+			continue
+		}
+
+		ln := len(related.R.SeriesListActivities)
+		if ln > 1 && i < ln-1 {
+			related.R.SeriesListActivities[i] = related.R.SeriesListActivities[ln-1]
+		}
+		related.R.SeriesListActivities = related.R.SeriesListActivities[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetUser of the listActivity to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.ListActivities.
+func (o *ListActivity) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"list_activities\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, listActivityPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserID, related.ID)
+	if o.R == nil {
+		o.R = &listActivityR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ListActivities: ListActivitySlice{o},
+		}
+	} else {
+		related.R.ListActivities = append(related.R.ListActivities, o)
+	}
+
+	return nil
+}
+
+// RemoveUser relationship.
+// Sets o.R.User to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *ListActivity) RemoveUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.UserID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.User = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ListActivities {
+		if queries.Equal(o.UserID, ri.UserID) {
+			continue
+		}
+
+		ln := len(related.R.ListActivities)
+		if ln > 1 && i < ln-1 {
+			related.R.ListActivities[i] = related.R.ListActivities[ln-1]
+		}
+		related.R.ListActivities = related.R.ListActivities[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetMember of the listActivity to the related item.
+// Sets o.R.Member to related.
+// Adds o to related.R.MemberListActivities.
+func (o *ListActivity) SetMember(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"list_activities\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"member_id"}),
+		strmangle.WhereClause("\"", "\"", 2, listActivityPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.MemberID, related.ID)
+	if o.R == nil {
+		o.R = &listActivityR{
+			Member: related,
+		}
+	} else {
+		o.R.Member = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			MemberListActivities: ListActivitySlice{o},
+		}
+	} else {
+		related.R.MemberListActivities = append(related.R.MemberListActivities, o)
+	}
+
+	return nil
+}
+
+// RemoveMember relationship.
+// Sets o.R.Member to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *ListActivity) RemoveMember(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.MemberID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("member_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Member = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.MemberListActivities {
+		if queries.Equal(o.MemberID, ri.MemberID) {
+			continue
+		}
+
+		ln := len(related.R.MemberListActivities)
+		if ln > 1 && i < ln-1 {
+			related.R.MemberListActivities[i] = related.R.MemberListActivities[ln-1]
+		}
+		related.R.MemberListActivities = related.R.MemberListActivities[:ln-1]
+		break
+	}
+	return nil
+}
+
+// ListActivities retrieves all the records using an executor.
+func ListActivities(mods ...qm.QueryMod) listActivityQuery {
+	mods = append(mods, qm.From("\"list_activities\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"list_activities\".*"})
+	}
+
+	return listActivityQuery{q}
+}
+
+// FindListActivity retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindListActivity(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*ListActivity, error) {
+	listActivityObj := &ListActivity{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"list_activities\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, listActivityObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from list_activities")
+	}
+
+	if err = listActivityObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return listActivityObj, err
+	}
+
+	return listActivityObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ListActivity) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no list_activities provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(listActivityColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	listActivityInsertCacheMut.RLock()
+	cache, cached := listActivityInsertCache[key]
+	listActivityInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			listActivityAllColumns,
+			listActivityColumnsWithDefault,
+			listActivityColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(listActivityType, listActivityMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(listActivityType, listActivityMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"list_activities\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"list_activities\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into list_activities")
+	}
+
+	if !cached {
+		listActivityInsertCacheMut.Lock()
+		listActivityInsertCache[key] = cache
+		listActivityInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ListActivity.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ListActivity) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	listActivityUpdateCacheMut.RLock()
+	cache, cached := listActivityUpdateCache[key]
+	listActivityUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			listActivityAllColumns,
+			listActivityPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update list_activities, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"list_activities\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, listActivityPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(listActivityType, listActivityMapping, append(wl, listActivityPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update list_activities row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for list_activities")
+	}
+
+	if !cached {
+		listActivityUpdateCacheMut.Lock()
+		listActivityUpdateCache[key] = cache
+		listActivityUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q listActivityQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for list_activities")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for list_activities")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ListActivitySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listActivityPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"list_activities\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, listActivityPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in listActivity slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all listActivity")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ListActivity) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no list_activities provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(listActivityColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	listActivityUpsertCacheMut.RLock()
+	cache, cached := listActivityUpsertCache[key]
+	listActivityUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			listActivityAllColumns,
+			listActivityColumnsWithDefault,
+			listActivityColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			listActivityAllColumns,
+			listActivityPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert list_activities, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(listActivityPrimaryKeyColumns))
+			copy(conflict, listActivityPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"list_activities\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(listActivityType, listActivityMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(listActivityType, listActivityMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert list_activities")
+	}
+
+	if !cached {
+		listActivityUpsertCacheMut.Lock()
+		listActivityUpsertCache[key] = cache
+		listActivityUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ListActivity record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ListActivity) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ListActivity provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), listActivityPrimaryKeyMapping)
+	sql := "DELETE FROM \"list_activities\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from list_activities")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for list_activities")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q listActivityQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no listActivityQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from list_activities")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for list_activities")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ListActivitySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(listActivityBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listActivityPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"list_activities\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, listActivityPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from listActivity slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for list_activities")
+	}
+
+	if len(listActivityAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ListActivity) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindListActivity(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ListActivitySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ListActivitySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listActivityPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"list_activities\".* FROM \"list_activities\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, listActivityPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ListActivitySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ListActivityExists checks if the ListActivity row exists.
+func ListActivityExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"list_activities\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if list_activities exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1480 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testListActivities(t *testing.T) {
+	t.Parallel()
+
+	query := ListActivities()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testListActivitiesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListActivity{}
+	if err = randomize.Struct(seed, o, listActivityDBTypes, true, listActivityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ListActivities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testListActivitiesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListActivity{}
+	if err = randomize.Struct(seed, o, listActivityDBTypes, true, listActivityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ListActivities().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ListActivities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testListActivitiesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListActivity{}
+	if err = randomize.Struct(seed, o, listActivityDBTypes, true, listActivityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ListActivitySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ListActivities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testListActivitiesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListActivity{}
+	if err = randomize.Struct(seed, o, listActivityDBTypes, true, listActivityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ListActivityExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if ListActivity exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ListActivityExists to return true, but got false.")
+	}
+}
+
+func testListActivitiesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListActivity{}
+	if err = randomize.Struct(seed, o, listActivityDBTypes, true, listActivityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	listActivityFound, err := FindListActivity(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if listActivityFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testListActivitiesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListActivity{}
+	if err = randomize.Struct(seed, o, listActivityDBTypes, true, listActivityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ListActivities().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testListActivitiesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListActivity{}
+	if err = randomize.Struct(seed, o, listActivityDBTypes, true, listActivityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ListActivities().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testListActivitiesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	listActivityOne := &ListActivity{}
+	listActivityTwo := &ListActivity{}
+	if err = randomize.Struct(seed, listActivityOne, listActivityDBTypes, false, listActivityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+	if err = randomize.Struct(seed, listActivityTwo, listActivityDBTypes, false, listActivityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = listActivityOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = listActivityTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ListActivities().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testListActivitiesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	listActivityOne := &ListActivity{}
+	listActivityTwo := &ListActivity{}
+	if err = randomize.Struct(seed, listActivityOne, listActivityDBTypes, false, listActivityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+	if err = randomize.Struct(seed, listActivityTwo, listActivityDBTypes, false, listActivityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = listActivityOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = listActivityTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ListActivities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func listActivityBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ListActivity) error {
+	*o = ListActivity{}
+	return nil
+}
+
+func listActivityAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ListActivity) error {
+	*o = ListActivity{}
+	return nil
+}
+
+func listActivityAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ListActivity) error {
+	*o = ListActivity{}
+	return nil
+}
+
+func listActivityBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ListActivity) error {
+	*o = ListActivity{}
+	return nil
+}
+
+func listActivityAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ListActivity) error {
+	*o = ListActivity{}
+	return nil
+}
+
+func listActivityBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ListActivity) error {
+	*o = ListActivity{}
+	return nil
+}
+
+func listActivityAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ListActivity) error {
+	*o = ListActivity{}
+	return nil
+}
+
+func listActivityBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ListActivity) error {
+	*o = ListActivity{}
+	return nil
+}
+
+func listActivityAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ListActivity) error {
+	*o = ListActivity{}
+	return nil
+}
+
+func testListActivitiesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ListActivity{}
+	o := &ListActivity{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, listActivityDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ListActivity object: %s", err)
+	}
+
+	AddListActivityHook(boil.BeforeInsertHook, listActivityBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	listActivityBeforeInsertHooks = []ListActivityHook{}
+
+	AddListActivityHook(boil.AfterInsertHook, listActivityAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	listActivityAfterInsertHooks = []ListActivityHook{}
+
+	AddListActivityHook(boil.AfterSelectHook, listActivityAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	listActivityAfterSelectHooks = []ListActivityHook{}
+
+	AddListActivityHook(boil.BeforeUpdateHook, listActivityBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	listActivityBeforeUpdateHooks = []ListActivityHook{}
+
+	AddListActivityHook(boil.AfterUpdateHook, listActivityAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	listActivityAfterUpdateHooks = []ListActivityHook{}
+
+	AddListActivityHook(boil.BeforeDeleteHook, listActivityBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	listActivityBeforeDeleteHooks = []ListActivityHook{}
+
+	AddListActivityHook(boil.AfterDeleteHook, listActivityAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	listActivityAfterDeleteHooks = []ListActivityHook{}
+
+	AddListActivityHook(boil.BeforeUpsertHook, listActivityBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	listActivityBeforeUpsertHooks = []ListActivityHook{}
+
+	AddListActivityHook(boil.AfterUpsertHook, listActivityAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	listActivityAfterUpsertHooks = []ListActivityHook{}
+}
+
+func testListActivitiesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListActivity{}
+	if err = randomize.Struct(seed, o, listActivityDBTypes, true, listActivityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ListActivities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testListActivitiesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListActivity{}
+	if err = randomize.Struct(seed, o, listActivityDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(listActivityColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ListActivities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testListActivityToOneFilmUsingFilm(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ListActivity
+	var foreign Film
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, listActivityDBTypes, true, listActivityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, filmDBTypes, false, filmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Film struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.FilmID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Film().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ListActivitySlice{&local}
+	if err = local.L.LoadFilm(ctx, tx, false, (*[]*ListActivity)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Film == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Film = nil
+	if err = local.L.LoadFilm(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Film == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testListActivityToOneListUsingList(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ListActivity
+	var foreign List
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, listActivityDBTypes, false, listActivityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, listDBTypes, false, listColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize List struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ListID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.List().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ListActivitySlice{&local}
+	if err = local.L.LoadList(ctx, tx, false, (*[]*ListActivity)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.List == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.List = nil
+	if err = local.L.LoadList(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.List == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testListActivityToOneSeriesUsingSeries(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ListActivity
+	var foreign Series
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, listActivityDBTypes, true, listActivityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, seriesDBTypes, false, seriesColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Series struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.SeriesID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Series().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ListActivitySlice{&local}
+	if err = local.L.LoadSeries(ctx, tx, false, (*[]*ListActivity)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Series == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Series = nil
+	if err = local.L.LoadSeries(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Series == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testListActivityToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ListActivity
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, listActivityDBTypes, true, listActivityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.UserID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ListActivitySlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*ListActivity)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testListActivityToOneUserUsingMember(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ListActivity
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, listActivityDBTypes, true, listActivityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.MemberID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Member().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ListActivitySlice{&local}
+	if err = local.L.LoadMember(ctx, tx, false, (*[]*ListActivity)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Member == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Member = nil
+	if err = local.L.LoadMember(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Member == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testListActivityToOneSetOpFilmUsingFilm(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ListActivity
+	var b, c Film
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, listActivityDBTypes, false, strmangle.SetComplement(listActivityPrimaryKeyColumns, listActivityColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Film{&b, &c} {
+		err = a.SetFilm(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Film != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ListActivities[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.FilmID, x.ID) {
+			t.Error("foreign key was wrong value", a.FilmID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.FilmID))
+		reflect.Indirect(reflect.ValueOf(&a.FilmID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.FilmID, x.ID) {
+			t.Error("foreign key was wrong value", a.FilmID, x.ID)
+		}
+	}
+}
+
+func testListActivityToOneRemoveOpFilmUsingFilm(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ListActivity
+	var b Film
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, listActivityDBTypes, false, strmangle.SetComplement(listActivityPrimaryKeyColumns, listActivityColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetFilm(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveFilm(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Film().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Film != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.FilmID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.ListActivities) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testListActivityToOneSetOpListUsingList(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ListActivity
+	var b, c List
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, listActivityDBTypes, false, strmangle.SetComplement(listActivityPrimaryKeyColumns, listActivityColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, listDBTypes, false, strmangle.SetComplement(listPrimaryKeyColumns, listColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, listDBTypes, false, strmangle.SetComplement(listPrimaryKeyColumns, listColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*List{&b, &c} {
+		err = a.SetList(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.List != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ListActivities[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ListID != x.ID {
+			t.Error("foreign key was wrong value", a.ListID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ListID))
+		reflect.Indirect(reflect.ValueOf(&a.ListID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ListID != x.ID {
+			t.Error("foreign key was wrong value", a.ListID, x.ID)
+		}
+	}
+}
+func testListActivityToOneSetOpSeriesUsingSeries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ListActivity
+	var b, c Series
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, listActivityDBTypes, false, strmangle.SetComplement(listActivityPrimaryKeyColumns, listActivityColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Series{&b, &c} {
+		err = a.SetSeries(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Series != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.SeriesListActivities[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.SeriesID, x.ID) {
+			t.Error("foreign key was wrong value", a.SeriesID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.SeriesID))
+		reflect.Indirect(reflect.ValueOf(&a.SeriesID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.SeriesID, x.ID) {
+			t.Error("foreign key was wrong value", a.SeriesID, x.ID)
+		}
+	}
+}
+
+func testListActivityToOneRemoveOpSeriesUsingSeries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ListActivity
+	var b Series
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, listActivityDBTypes, false, strmangle.SetComplement(listActivityPrimaryKeyColumns, listActivityColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetSeries(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveSeries(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Series().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Series != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.SeriesID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.SeriesListActivities) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testListActivityToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ListActivity
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, listActivityDBTypes, false, strmangle.SetComplement(listActivityPrimaryKeyColumns, listActivityColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ListActivities[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.UserID, x.ID) {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.UserID, x.ID) {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testListActivityToOneRemoveOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ListActivity
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, listActivityDBTypes, false, strmangle.SetComplement(listActivityPrimaryKeyColumns, listActivityColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetUser(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveUser(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.User().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.User != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.UserID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.ListActivities) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testListActivityToOneSetOpUserUsingMember(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ListActivity
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, listActivityDBTypes, false, strmangle.SetComplement(listActivityPrimaryKeyColumns, listActivityColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetMember(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Member != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.MemberListActivities[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.MemberID, x.ID) {
+			t.Error("foreign key was wrong value", a.MemberID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.MemberID))
+		reflect.Indirect(reflect.ValueOf(&a.MemberID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.MemberID, x.ID) {
+			t.Error("foreign key was wrong value", a.MemberID, x.ID)
+		}
+	}
+}
+
+func testListActivityToOneRemoveOpUserUsingMember(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ListActivity
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, listActivityDBTypes, false, strmangle.SetComplement(listActivityPrimaryKeyColumns, listActivityColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetMember(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveMember(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Member().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Member != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.MemberID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.MemberListActivities) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testListActivitiesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListActivity{}
+	if err = randomize.Struct(seed, o, listActivityDBTypes, true, listActivityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testListActivitiesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListActivity{}
+	if err = randomize.Struct(seed, o, listActivityDBTypes, true, listActivityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ListActivitySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testListActivitiesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListActivity{}
+	if err = randomize.Struct(seed, o, listActivityDBTypes, true, listActivityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ListActivities().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	listActivityDBTypes = map[string]string{`ID`: `integer`, `ListID`: `integer`, `UserID`: `integer`, `Action`: `character varying`, `FilmID`: `integer`, `SeriesID`: `integer`, `MemberID`: `integer`, `TimeLogged`: `timestamp with time zone`}
+	_                   = bytes.MinRead
+)
+
+func testListActivitiesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(listActivityPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(listActivityAllColumns) == len(listActivityPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListActivity{}
+	if err = randomize.Struct(seed, o, listActivityDBTypes, true, listActivityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ListActivities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, listActivityDBTypes, true, listActivityPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testListActivitiesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(listActivityAllColumns) == len(listActivityPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListActivity{}
+	if err = randomize.Struct(seed, o, listActivityDBTypes, true, listActivityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ListActivities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, listActivityDBTypes, true, listActivityPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(listActivityAllColumns, listActivityPrimaryKeyColumns) {
+		fields = listActivityAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			listActivityAllColumns,
+			listActivityPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ListActivitySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testListActivitiesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(listActivityAllColumns) == len(listActivityPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ListActivity{}
+	if err = randomize.Struct(seed, &o, listActivityDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ListActivity: %s", err)
+	}
+
+	count, err := ListActivities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, listActivityDBTypes, false, listActivityPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ListActivity struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ListActivity: %s", err)
+	}
+
+	count, err = ListActivities().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
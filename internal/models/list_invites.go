@@ -0,0 +1,1568 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ListInvite is an object representing the database table.
+type ListInvite struct {
+	ID        int         `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	ListID    int         `db:"list_id" boil:"list_id" json:"list_id" toml:"list_id" yaml:"list_id"`
+	Role      string      `db:"role" boil:"role" json:"role" toml:"role" yaml:"role"`
+	InvitedBy null.Int    `db:"invited_by" boil:"invited_by" json:"invited_by,omitempty" toml:"invited_by" yaml:"invited_by,omitempty"`
+	UserID    null.Int    `db:"user_id" boil:"user_id" json:"user_id,omitempty" toml:"user_id" yaml:"user_id,omitempty"`
+	TokenHash null.String `db:"-" boil:"token_hash" json:"-" toml:"-" yaml:"-"`
+	CreatedAt time.Time   `db:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ExpiresAt time.Time   `db:"expires_at" boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+
+	R *listInviteR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L listInviteL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ListInviteColumns = struct {
+	ID        string
+	ListID    string
+	Role      string
+	InvitedBy string
+	UserID    string
+	TokenHash string
+	CreatedAt string
+	ExpiresAt string
+}{
+	ID:        "id",
+	ListID:    "list_id",
+	Role:      "role",
+	InvitedBy: "invited_by",
+	UserID:    "user_id",
+	TokenHash: "token_hash",
+	CreatedAt: "created_at",
+	ExpiresAt: "expires_at",
+}
+
+var ListInviteTableColumns = struct {
+	ID        string
+	ListID    string
+	Role      string
+	InvitedBy string
+	UserID    string
+	TokenHash string
+	CreatedAt string
+	ExpiresAt string
+}{
+	ID:        "list_invites.id",
+	ListID:    "list_invites.list_id",
+	Role:      "list_invites.role",
+	InvitedBy: "list_invites.invited_by",
+	UserID:    "list_invites.user_id",
+	TokenHash: "list_invites.token_hash",
+	CreatedAt: "list_invites.created_at",
+	ExpiresAt: "list_invites.expires_at",
+}
+
+// Generated where
+
+var ListInviteWhere = struct {
+	ID        whereHelperint
+	ListID    whereHelperint
+	Role      whereHelperstring
+	InvitedBy whereHelpernull_Int
+	UserID    whereHelpernull_Int
+	TokenHash whereHelpernull_String
+	CreatedAt whereHelpertime_Time
+	ExpiresAt whereHelpertime_Time
+}{
+	ID:        whereHelperint{field: "\"list_invites\".\"id\""},
+	ListID:    whereHelperint{field: "\"list_invites\".\"list_id\""},
+	Role:      whereHelperstring{field: "\"list_invites\".\"role\""},
+	InvitedBy: whereHelpernull_Int{field: "\"list_invites\".\"invited_by\""},
+	UserID:    whereHelpernull_Int{field: "\"list_invites\".\"user_id\""},
+	TokenHash: whereHelpernull_String{field: "\"list_invites\".\"token_hash\""},
+	CreatedAt: whereHelpertime_Time{field: "\"list_invites\".\"created_at\""},
+	ExpiresAt: whereHelpertime_Time{field: "\"list_invites\".\"expires_at\""},
+}
+
+// ListInviteRels is where relationship names are stored.
+var ListInviteRels = struct {
+	List          string
+	User          string
+	InvitedByUser string
+}{
+	List:          "List",
+	User:          "User",
+	InvitedByUser: "InvitedByUser",
+}
+
+// listInviteR is where relationships are stored.
+type listInviteR struct {
+	List          *List `db:"List" boil:"List" json:"List" toml:"List" yaml:"List"`
+	User          *User `db:"User" boil:"User" json:"User" toml:"User" yaml:"User"`
+	InvitedByUser *User `db:"InvitedByUser" boil:"InvitedByUser" json:"InvitedByUser" toml:"InvitedByUser" yaml:"InvitedByUser"`
+}
+
+// NewStruct creates a new relationship struct
+func (*listInviteR) NewStruct() *listInviteR {
+	return &listInviteR{}
+}
+
+func (r *listInviteR) GetList() *List {
+	if r == nil {
+		return nil
+	}
+	return r.List
+}
+
+func (r *listInviteR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+func (r *listInviteR) GetInvitedByUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.InvitedByUser
+}
+
+// listInviteL is where Load methods for each relationship are stored.
+type listInviteL struct{}
+
+var (
+	listInviteAllColumns            = []string{"id", "list_id", "role", "invited_by", "user_id", "token_hash", "created_at", "expires_at"}
+	listInviteColumnsWithoutDefault = []string{"list_id", "role", "expires_at"}
+	listInviteColumnsWithDefault    = []string{"id", "invited_by", "user_id", "token_hash", "created_at"}
+	listInvitePrimaryKeyColumns     = []string{"id"}
+	listInviteGeneratedColumns      = []string{}
+)
+
+type (
+	// ListInviteSlice is an alias for a slice of pointers to ListInvite.
+	// This should almost always be used instead of []ListInvite.
+	ListInviteSlice []*ListInvite
+	// ListInviteHook is the signature for custom ListInvite hook methods
+	ListInviteHook func(context.Context, boil.ContextExecutor, *ListInvite) error
+
+	listInviteQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	listInviteType                 = reflect.TypeOf(&ListInvite{})
+	listInviteMapping              = queries.MakeStructMapping(listInviteType)
+	listInvitePrimaryKeyMapping, _ = queries.BindMapping(listInviteType, listInviteMapping, listInvitePrimaryKeyColumns)
+	listInviteInsertCacheMut       sync.RWMutex
+	listInviteInsertCache          = make(map[string]insertCache)
+	listInviteUpdateCacheMut       sync.RWMutex
+	listInviteUpdateCache          = make(map[string]updateCache)
+	listInviteUpsertCacheMut       sync.RWMutex
+	listInviteUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var listInviteAfterSelectHooks []ListInviteHook
+
+var listInviteBeforeInsertHooks []ListInviteHook
+var listInviteAfterInsertHooks []ListInviteHook
+
+var listInviteBeforeUpdateHooks []ListInviteHook
+var listInviteAfterUpdateHooks []ListInviteHook
+
+var listInviteBeforeDeleteHooks []ListInviteHook
+var listInviteAfterDeleteHooks []ListInviteHook
+
+var listInviteBeforeUpsertHooks []ListInviteHook
+var listInviteAfterUpsertHooks []ListInviteHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ListInvite) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listInviteAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ListInvite) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listInviteBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ListInvite) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listInviteAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ListInvite) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listInviteBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ListInvite) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listInviteAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ListInvite) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listInviteBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ListInvite) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listInviteAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ListInvite) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listInviteBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ListInvite) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listInviteAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddListInviteHook registers your hook function for all future operations.
+func AddListInviteHook(hookPoint boil.HookPoint, listInviteHook ListInviteHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		listInviteAfterSelectHooks = append(listInviteAfterSelectHooks, listInviteHook)
+	case boil.BeforeInsertHook:
+		listInviteBeforeInsertHooks = append(listInviteBeforeInsertHooks, listInviteHook)
+	case boil.AfterInsertHook:
+		listInviteAfterInsertHooks = append(listInviteAfterInsertHooks, listInviteHook)
+	case boil.BeforeUpdateHook:
+		listInviteBeforeUpdateHooks = append(listInviteBeforeUpdateHooks, listInviteHook)
+	case boil.AfterUpdateHook:
+		listInviteAfterUpdateHooks = append(listInviteAfterUpdateHooks, listInviteHook)
+	case boil.BeforeDeleteHook:
+		listInviteBeforeDeleteHooks = append(listInviteBeforeDeleteHooks, listInviteHook)
+	case boil.AfterDeleteHook:
+		listInviteAfterDeleteHooks = append(listInviteAfterDeleteHooks, listInviteHook)
+	case boil.BeforeUpsertHook:
+		listInviteBeforeUpsertHooks = append(listInviteBeforeUpsertHooks, listInviteHook)
+	case boil.AfterUpsertHook:
+		listInviteAfterUpsertHooks = append(listInviteAfterUpsertHooks, listInviteHook)
+	}
+}
+
+// One returns a single listInvite record from the query.
+func (q listInviteQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ListInvite, error) {
+	o := &ListInvite{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for list_invites")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ListInvite records from the query.
+func (q listInviteQuery) All(ctx context.Context, exec boil.ContextExecutor) (ListInviteSlice, error) {
+	var o []*ListInvite
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ListInvite slice")
+	}
+
+	if len(listInviteAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ListInvite records in the query.
+func (q listInviteQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count list_invites rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q listInviteQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if list_invites exists")
+	}
+
+	return count > 0, nil
+}
+
+// List pointed to by the foreign key.
+func (o *ListInvite) List(mods ...qm.QueryMod) listQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ListID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Lists(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *ListInvite) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// InvitedByUser pointed to by the foreign key.
+func (o *ListInvite) InvitedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.InvitedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadList allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (listInviteL) LoadList(ctx context.Context, e boil.ContextExecutor, singular bool, maybeListInvite interface{}, mods queries.Applicator) error {
+	var slice []*ListInvite
+	var object *ListInvite
+
+	if singular {
+		var ok bool
+		object, ok = maybeListInvite.(*ListInvite)
+		if !ok {
+			object = new(ListInvite)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeListInvite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeListInvite))
+			}
+		}
+	} else {
+		s, ok := maybeListInvite.(*[]*ListInvite)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeListInvite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeListInvite))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listInviteR{}
+		}
+		args = append(args, object.ListID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listInviteR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ListID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ListID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`lists`),
+		qm.WhereIn(`lists.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load List")
+	}
+
+	var resultSlice []*List
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice List")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for lists")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for lists")
+	}
+
+	if len(listInviteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.List = foreign
+		if foreign.R == nil {
+			foreign.R = &listR{}
+		}
+		foreign.R.ListInvites = append(foreign.R.ListInvites, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ListID == foreign.ID {
+				local.R.List = foreign
+				if foreign.R == nil {
+					foreign.R = &listR{}
+				}
+				foreign.R.ListInvites = append(foreign.R.ListInvites, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (listInviteL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeListInvite interface{}, mods queries.Applicator) error {
+	var slice []*ListInvite
+	var object *ListInvite
+
+	if singular {
+		var ok bool
+		object, ok = maybeListInvite.(*ListInvite)
+		if !ok {
+			object = new(ListInvite)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeListInvite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeListInvite))
+			}
+		}
+	} else {
+		s, ok := maybeListInvite.(*[]*ListInvite)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeListInvite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeListInvite))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listInviteR{}
+		}
+		if !queries.IsNil(object.UserID) {
+			args = append(args, object.UserID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listInviteR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.UserID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.UserID) {
+				args = append(args, obj.UserID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(listInviteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ListInvites = append(foreign.R.ListInvites, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserID, foreign.ID) {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ListInvites = append(foreign.R.ListInvites, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadInvitedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (listInviteL) LoadInvitedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeListInvite interface{}, mods queries.Applicator) error {
+	var slice []*ListInvite
+	var object *ListInvite
+
+	if singular {
+		var ok bool
+		object, ok = maybeListInvite.(*ListInvite)
+		if !ok {
+			object = new(ListInvite)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeListInvite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeListInvite))
+			}
+		}
+	} else {
+		s, ok := maybeListInvite.(*[]*ListInvite)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeListInvite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeListInvite))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listInviteR{}
+		}
+		if !queries.IsNil(object.InvitedBy) {
+			args = append(args, object.InvitedBy)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listInviteR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.InvitedBy) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.InvitedBy) {
+				args = append(args, obj.InvitedBy)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(listInviteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.InvitedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.InvitedByListInvites = append(foreign.R.InvitedByListInvites, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.InvitedBy, foreign.ID) {
+				local.R.InvitedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.InvitedByListInvites = append(foreign.R.InvitedByListInvites, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetList of the listInvite to the related item.
+// Sets o.R.List to related.
+// Adds o to related.R.ListInvites.
+func (o *ListInvite) SetList(ctx context.Context, exec boil.ContextExecutor, insert bool, related *List) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"list_invites\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"list_id"}),
+		strmangle.WhereClause("\"", "\"", 2, listInvitePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ListID = related.ID
+	if o.R == nil {
+		o.R = &listInviteR{
+			List: related,
+		}
+	} else {
+		o.R.List = related
+	}
+
+	if related.R == nil {
+		related.R = &listR{
+			ListInvites: ListInviteSlice{o},
+		}
+	} else {
+		related.R.ListInvites = append(related.R.ListInvites, o)
+	}
+
+	return nil
+}
+
+// SetUser of the listInvite to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.ListInvites.
+func (o *ListInvite) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"list_invites\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, listInvitePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserID, related.ID)
+	if o.R == nil {
+		o.R = &listInviteR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ListInvites: ListInviteSlice{o},
+		}
+	} else {
+		related.R.ListInvites = append(related.R.ListInvites, o)
+	}
+
+	return nil
+}
+
+// RemoveUser relationship.
+// Sets o.R.User to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *ListInvite) RemoveUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.UserID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.User = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ListInvites {
+		if queries.Equal(o.UserID, ri.UserID) {
+			continue
+		}
+
+		ln := len(related.R.ListInvites)
+		if ln > 1 && i < ln-1 {
+			related.R.ListInvites[i] = related.R.ListInvites[ln-1]
+		}
+		related.R.ListInvites = related.R.ListInvites[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetInvitedByUser of the listInvite to the related item.
+// Sets o.R.InvitedByUser to related.
+// Adds o to related.R.InvitedByListInvites.
+func (o *ListInvite) SetInvitedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"list_invites\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"invited_by"}),
+		strmangle.WhereClause("\"", "\"", 2, listInvitePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.InvitedBy, related.ID)
+	if o.R == nil {
+		o.R = &listInviteR{
+			InvitedByUser: related,
+		}
+	} else {
+		o.R.InvitedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			InvitedByListInvites: ListInviteSlice{o},
+		}
+	} else {
+		related.R.InvitedByListInvites = append(related.R.InvitedByListInvites, o)
+	}
+
+	return nil
+}
+
+// RemoveInvitedByUser relationship.
+// Sets o.R.InvitedByUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *ListInvite) RemoveInvitedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.InvitedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("invited_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.InvitedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.InvitedByListInvites {
+		if queries.Equal(o.InvitedBy, ri.InvitedBy) {
+			continue
+		}
+
+		ln := len(related.R.InvitedByListInvites)
+		if ln > 1 && i < ln-1 {
+			related.R.InvitedByListInvites[i] = related.R.InvitedByListInvites[ln-1]
+		}
+		related.R.InvitedByListInvites = related.R.InvitedByListInvites[:ln-1]
+		break
+	}
+	return nil
+}
+
+// ListInvites retrieves all the records using an executor.
+func ListInvites(mods ...qm.QueryMod) listInviteQuery {
+	mods = append(mods, qm.From("\"list_invites\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"list_invites\".*"})
+	}
+
+	return listInviteQuery{q}
+}
+
+// FindListInvite retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindListInvite(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*ListInvite, error) {
+	listInviteObj := &ListInvite{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"list_invites\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, listInviteObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from list_invites")
+	}
+
+	if err = listInviteObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return listInviteObj, err
+	}
+
+	return listInviteObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ListInvite) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no list_invites provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(listInviteColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	listInviteInsertCacheMut.RLock()
+	cache, cached := listInviteInsertCache[key]
+	listInviteInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			listInviteAllColumns,
+			listInviteColumnsWithDefault,
+			listInviteColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(listInviteType, listInviteMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(listInviteType, listInviteMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"list_invites\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"list_invites\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into list_invites")
+	}
+
+	if !cached {
+		listInviteInsertCacheMut.Lock()
+		listInviteInsertCache[key] = cache
+		listInviteInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ListInvite.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ListInvite) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	listInviteUpdateCacheMut.RLock()
+	cache, cached := listInviteUpdateCache[key]
+	listInviteUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			listInviteAllColumns,
+			listInvitePrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update list_invites, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"list_invites\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, listInvitePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(listInviteType, listInviteMapping, append(wl, listInvitePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update list_invites row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for list_invites")
+	}
+
+	if !cached {
+		listInviteUpdateCacheMut.Lock()
+		listInviteUpdateCache[key] = cache
+		listInviteUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q listInviteQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for list_invites")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for list_invites")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ListInviteSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listInvitePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"list_invites\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, listInvitePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in listInvite slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all listInvite")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ListInvite) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no list_invites provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(listInviteColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	listInviteUpsertCacheMut.RLock()
+	cache, cached := listInviteUpsertCache[key]
+	listInviteUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			listInviteAllColumns,
+			listInviteColumnsWithDefault,
+			listInviteColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			listInviteAllColumns,
+			listInvitePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert list_invites, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(listInvitePrimaryKeyColumns))
+			copy(conflict, listInvitePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"list_invites\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(listInviteType, listInviteMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(listInviteType, listInviteMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert list_invites")
+	}
+
+	if !cached {
+		listInviteUpsertCacheMut.Lock()
+		listInviteUpsertCache[key] = cache
+		listInviteUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ListInvite record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ListInvite) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ListInvite provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), listInvitePrimaryKeyMapping)
+	sql := "DELETE FROM \"list_invites\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from list_invites")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for list_invites")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q listInviteQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no listInviteQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from list_invites")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for list_invites")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ListInviteSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(listInviteBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listInvitePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"list_invites\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, listInvitePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from listInvite slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for list_invites")
+	}
+
+	if len(listInviteAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ListInvite) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindListInvite(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ListInviteSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ListInviteSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listInvitePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"list_invites\".* FROM \"list_invites\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, listInvitePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ListInviteSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ListInviteExists checks if the ListInvite row exists.
+func ListInviteExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"list_invites\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if list_invites exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1160 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testListInvites(t *testing.T) {
+	t.Parallel()
+
+	query := ListInvites()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testListInvitesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListInvite{}
+	if err = randomize.Struct(seed, o, listInviteDBTypes, true, listInviteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListInvite struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ListInvites().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testListInvitesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListInvite{}
+	if err = randomize.Struct(seed, o, listInviteDBTypes, true, listInviteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListInvite struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ListInvites().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ListInvites().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testListInvitesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListInvite{}
+	if err = randomize.Struct(seed, o, listInviteDBTypes, true, listInviteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListInvite struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ListInviteSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ListInvites().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testListInvitesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListInvite{}
+	if err = randomize.Struct(seed, o, listInviteDBTypes, true, listInviteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListInvite struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ListInviteExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if ListInvite exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ListInviteExists to return true, but got false.")
+	}
+}
+
+func testListInvitesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListInvite{}
+	if err = randomize.Struct(seed, o, listInviteDBTypes, true, listInviteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListInvite struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	listInviteFound, err := FindListInvite(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if listInviteFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testListInvitesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListInvite{}
+	if err = randomize.Struct(seed, o, listInviteDBTypes, true, listInviteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListInvite struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ListInvites().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testListInvitesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListInvite{}
+	if err = randomize.Struct(seed, o, listInviteDBTypes, true, listInviteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListInvite struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ListInvites().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testListInvitesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	listInviteOne := &ListInvite{}
+	listInviteTwo := &ListInvite{}
+	if err = randomize.Struct(seed, listInviteOne, listInviteDBTypes, false, listInviteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListInvite struct: %s", err)
+	}
+	if err = randomize.Struct(seed, listInviteTwo, listInviteDBTypes, false, listInviteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListInvite struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = listInviteOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = listInviteTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ListInvites().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testListInvitesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	listInviteOne := &ListInvite{}
+	listInviteTwo := &ListInvite{}
+	if err = randomize.Struct(seed, listInviteOne, listInviteDBTypes, false, listInviteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListInvite struct: %s", err)
+	}
+	if err = randomize.Struct(seed, listInviteTwo, listInviteDBTypes, false, listInviteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListInvite struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = listInviteOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = listInviteTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ListInvites().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func listInviteBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ListInvite) error {
+	*o = ListInvite{}
+	return nil
+}
+
+func listInviteAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ListInvite) error {
+	*o = ListInvite{}
+	return nil
+}
+
+func listInviteAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ListInvite) error {
+	*o = ListInvite{}
+	return nil
+}
+
+func listInviteBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ListInvite) error {
+	*o = ListInvite{}
+	return nil
+}
+
+func listInviteAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ListInvite) error {
+	*o = ListInvite{}
+	return nil
+}
+
+func listInviteBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ListInvite) error {
+	*o = ListInvite{}
+	return nil
+}
+
+func listInviteAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ListInvite) error {
+	*o = ListInvite{}
+	return nil
+}
+
+func listInviteBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ListInvite) error {
+	*o = ListInvite{}
+	return nil
+}
+
+func listInviteAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ListInvite) error {
+	*o = ListInvite{}
+	return nil
+}
+
+func testListInvitesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ListInvite{}
+	o := &ListInvite{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, listInviteDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ListInvite object: %s", err)
+	}
+
+	AddListInviteHook(boil.BeforeInsertHook, listInviteBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	listInviteBeforeInsertHooks = []ListInviteHook{}
+
+	AddListInviteHook(boil.AfterInsertHook, listInviteAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	listInviteAfterInsertHooks = []ListInviteHook{}
+
+	AddListInviteHook(boil.AfterSelectHook, listInviteAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	listInviteAfterSelectHooks = []ListInviteHook{}
+
+	AddListInviteHook(boil.BeforeUpdateHook, listInviteBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	listInviteBeforeUpdateHooks = []ListInviteHook{}
+
+	AddListInviteHook(boil.AfterUpdateHook, listInviteAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	listInviteAfterUpdateHooks = []ListInviteHook{}
+
+	AddListInviteHook(boil.BeforeDeleteHook, listInviteBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	listInviteBeforeDeleteHooks = []ListInviteHook{}
+
+	AddListInviteHook(boil.AfterDeleteHook, listInviteAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	listInviteAfterDeleteHooks = []ListInviteHook{}
+
+	AddListInviteHook(boil.BeforeUpsertHook, listInviteBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	listInviteBeforeUpsertHooks = []ListInviteHook{}
+
+	AddListInviteHook(boil.AfterUpsertHook, listInviteAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	listInviteAfterUpsertHooks = []ListInviteHook{}
+}
+
+func testListInvitesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListInvite{}
+	if err = randomize.Struct(seed, o, listInviteDBTypes, true, listInviteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListInvite struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ListInvites().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testListInvitesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListInvite{}
+	if err = randomize.Struct(seed, o, listInviteDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ListInvite struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(listInviteColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ListInvites().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testListInviteToOneListUsingList(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ListInvite
+	var foreign List
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, listInviteDBTypes, false, listInviteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListInvite struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, listDBTypes, false, listColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize List struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ListID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.List().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ListInviteSlice{&local}
+	if err = local.L.LoadList(ctx, tx, false, (*[]*ListInvite)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.List == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.List = nil
+	if err = local.L.LoadList(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.List == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testListInviteToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ListInvite
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, listInviteDBTypes, true, listInviteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListInvite struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.UserID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ListInviteSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*ListInvite)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testListInviteToOneUserUsingInvitedByUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ListInvite
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, listInviteDBTypes, true, listInviteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListInvite struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.InvitedBy, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.InvitedByUser().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ListInviteSlice{&local}
+	if err = local.L.LoadInvitedByUser(ctx, tx, false, (*[]*ListInvite)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.InvitedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.InvitedByUser = nil
+	if err = local.L.LoadInvitedByUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.InvitedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testListInviteToOneSetOpListUsingList(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ListInvite
+	var b, c List
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, listInviteDBTypes, false, strmangle.SetComplement(listInvitePrimaryKeyColumns, listInviteColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, listDBTypes, false, strmangle.SetComplement(listPrimaryKeyColumns, listColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, listDBTypes, false, strmangle.SetComplement(listPrimaryKeyColumns, listColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*List{&b, &c} {
+		err = a.SetList(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.List != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ListInvites[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ListID != x.ID {
+			t.Error("foreign key was wrong value", a.ListID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ListID))
+		reflect.Indirect(reflect.ValueOf(&a.ListID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ListID != x.ID {
+			t.Error("foreign key was wrong value", a.ListID, x.ID)
+		}
+	}
+}
+func testListInviteToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ListInvite
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, listInviteDBTypes, false, strmangle.SetComplement(listInvitePrimaryKeyColumns, listInviteColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ListInvites[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.UserID, x.ID) {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.UserID, x.ID) {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testListInviteToOneRemoveOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ListInvite
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, listInviteDBTypes, false, strmangle.SetComplement(listInvitePrimaryKeyColumns, listInviteColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetUser(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveUser(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.User().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.User != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.UserID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.ListInvites) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testListInviteToOneSetOpUserUsingInvitedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ListInvite
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, listInviteDBTypes, false, strmangle.SetComplement(listInvitePrimaryKeyColumns, listInviteColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetInvitedByUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.InvitedByUser != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.InvitedByListInvites[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.InvitedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.InvitedBy)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.InvitedBy))
+		reflect.Indirect(reflect.ValueOf(&a.InvitedBy)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.InvitedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.InvitedBy, x.ID)
+		}
+	}
+}
+
+func testListInviteToOneRemoveOpUserUsingInvitedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ListInvite
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, listInviteDBTypes, false, strmangle.SetComplement(listInvitePrimaryKeyColumns, listInviteColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetInvitedByUser(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveInvitedByUser(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.InvitedByUser().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.InvitedByUser != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.InvitedBy) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.InvitedByListInvites) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testListInvitesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListInvite{}
+	if err = randomize.Struct(seed, o, listInviteDBTypes, true, listInviteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListInvite struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testListInvitesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListInvite{}
+	if err = randomize.Struct(seed, o, listInviteDBTypes, true, listInviteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListInvite struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ListInviteSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testListInvitesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListInvite{}
+	if err = randomize.Struct(seed, o, listInviteDBTypes, true, listInviteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListInvite struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ListInvites().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	listInviteDBTypes = map[string]string{`ID`: `integer`, `ListID`: `integer`, `Role`: `character varying`, `InvitedBy`: `integer`, `UserID`: `integer`, `TokenHash`: `character varying`, `CreatedAt`: `timestamp with time zone`, `ExpiresAt`: `timestamp with time zone`}
+	_                 = bytes.MinRead
+)
+
+func testListInvitesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(listInvitePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(listInviteAllColumns) == len(listInvitePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListInvite{}
+	if err = randomize.Struct(seed, o, listInviteDBTypes, true, listInviteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListInvite struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ListInvites().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, listInviteDBTypes, true, listInvitePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ListInvite struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testListInvitesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(listInviteAllColumns) == len(listInvitePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListInvite{}
+	if err = randomize.Struct(seed, o, listInviteDBTypes, true, listInviteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListInvite struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ListInvites().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, listInviteDBTypes, true, listInvitePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ListInvite struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(listInviteAllColumns, listInvitePrimaryKeyColumns) {
+		fields = listInviteAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			listInviteAllColumns,
+			listInvitePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ListInviteSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testListInvitesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(listInviteAllColumns) == len(listInvitePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ListInvite{}
+	if err = randomize.Struct(seed, &o, listInviteDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ListInvite struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ListInvite: %s", err)
+	}
+
+	count, err := ListInvites().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, listInviteDBTypes, false, listInvitePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ListInvite struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ListInvite: %s", err)
+	}
+
+	count, err = ListInvites().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1786 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ListItem is an object representing the database table.
+type ListItem struct {
+	ID        int       `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	ListID    int       `db:"list_id" boil:"list_id" json:"list_id" toml:"list_id" yaml:"list_id"`
+	Position  int       `db:"position" boil:"position" json:"position" toml:"position" yaml:"position"`
+	FilmID    null.Int  `db:"film_id" boil:"film_id" json:"film_id,omitempty" toml:"film_id" yaml:"film_id,omitempty"`
+	SeriesID  null.Int  `db:"series_id" boil:"series_id" json:"series_id,omitempty" toml:"series_id" yaml:"series_id,omitempty"`
+	TimeAdded time.Time `db:"time_added" boil:"time_added" json:"time_added" toml:"time_added" yaml:"time_added"`
+	AddedBy   null.Int  `db:"added_by" boil:"added_by" json:"added_by,omitempty" toml:"added_by" yaml:"added_by,omitempty"`
+
+	R *listItemR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L listItemL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ListItemColumns = struct {
+	ID        string
+	ListID    string
+	Position  string
+	FilmID    string
+	SeriesID  string
+	TimeAdded string
+	AddedBy   string
+}{
+	ID:        "id",
+	ListID:    "list_id",
+	Position:  "position",
+	FilmID:    "film_id",
+	SeriesID:  "series_id",
+	TimeAdded: "time_added",
+	AddedBy:   "added_by",
+}
+
+var ListItemTableColumns = struct {
+	ID        string
+	ListID    string
+	Position  string
+	FilmID    string
+	SeriesID  string
+	TimeAdded string
+	AddedBy   string
+}{
+	ID:        "list_items.id",
+	ListID:    "list_items.list_id",
+	Position:  "list_items.position",
+	FilmID:    "list_items.film_id",
+	SeriesID:  "list_items.series_id",
+	TimeAdded: "list_items.time_added",
+	AddedBy:   "list_items.added_by",
+}
+
+// Generated where
+
+var ListItemWhere = struct {
+	ID        whereHelperint
+	ListID    whereHelperint
+	Position  whereHelperint
+	FilmID    whereHelpernull_Int
+	SeriesID  whereHelpernull_Int
+	TimeAdded whereHelpertime_Time
+	AddedBy   whereHelpernull_Int
+}{
+	ID:        whereHelperint{field: "\"list_items\".\"id\""},
+	ListID:    whereHelperint{field: "\"list_items\".\"list_id\""},
+	Position:  whereHelperint{field: "\"list_items\".\"position\""},
+	FilmID:    whereHelpernull_Int{field: "\"list_items\".\"film_id\""},
+	SeriesID:  whereHelpernull_Int{field: "\"list_items\".\"series_id\""},
+	TimeAdded: whereHelpertime_Time{field: "\"list_items\".\"time_added\""},
+	AddedBy:   whereHelpernull_Int{field: "\"list_items\".\"added_by\""},
+}
+
+// ListItemRels is where relationship names are stored.
+var ListItemRels = struct {
+	Film        string
+	List        string
+	Series      string
+	AddedByUser string
+}{
+	Film:        "Film",
+	List:        "List",
+	Series:      "Series",
+	AddedByUser: "AddedByUser",
+}
+
+// listItemR is where relationships are stored.
+type listItemR struct {
+	Film        *Film   `db:"Film" boil:"Film" json:"Film" toml:"Film" yaml:"Film"`
+	List        *List   `db:"List" boil:"List" json:"List" toml:"List" yaml:"List"`
+	Series      *Series `db:"Series" boil:"Series" json:"Series" toml:"Series" yaml:"Series"`
+	AddedByUser *User   `db:"AddedByUser" boil:"AddedByUser" json:"AddedByUser" toml:"AddedByUser" yaml:"AddedByUser"`
+}
+
+// NewStruct creates a new relationship struct
+func (*listItemR) NewStruct() *listItemR {
+	return &listItemR{}
+}
+
+func (r *listItemR) GetFilm() *Film {
+	if r == nil {
+		return nil
+	}
+	return r.Film
+}
+
+func (r *listItemR) GetList() *List {
+	if r == nil {
+		return nil
+	}
+	return r.List
+}
+
+func (r *listItemR) GetSeries() *Series {
+	if r == nil {
+		return nil
+	}
+	return r.Series
+}
+
+func (r *listItemR) GetAddedByUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.AddedByUser
+}
+
+// listItemL is where Load methods for each relationship are stored.
+type listItemL struct{}
+
+var (
+	listItemAllColumns            = []string{"id", "list_id", "position", "film_id", "series_id", "time_added", "added_by"}
+	listItemColumnsWithoutDefault = []string{"list_id", "position"}
+	listItemColumnsWithDefault    = []string{"id", "film_id", "series_id", "time_added", "added_by"}
+	listItemPrimaryKeyColumns     = []string{"id"}
+	listItemGeneratedColumns      = []string{}
+)
+
+type (
+	// ListItemSlice is an alias for a slice of pointers to ListItem.
+	// This should almost always be used instead of []ListItem.
+	ListItemSlice []*ListItem
+	// ListItemHook is the signature for custom ListItem hook methods
+	ListItemHook func(context.Context, boil.ContextExecutor, *ListItem) error
+
+	listItemQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	listItemType                 = reflect.TypeOf(&ListItem{})
+	listItemMapping              = queries.MakeStructMapping(listItemType)
+	listItemPrimaryKeyMapping, _ = queries.BindMapping(listItemType, listItemMapping, listItemPrimaryKeyColumns)
+	listItemInsertCacheMut       sync.RWMutex
+	listItemInsertCache          = make(map[string]insertCache)
+	listItemUpdateCacheMut       sync.RWMutex
+	listItemUpdateCache          = make(map[string]updateCache)
+	listItemUpsertCacheMut       sync.RWMutex
+	listItemUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var listItemAfterSelectHooks []ListItemHook
+
+var listItemBeforeInsertHooks []ListItemHook
+var listItemAfterInsertHooks []ListItemHook
+
+var listItemBeforeUpdateHooks []ListItemHook
+var listItemAfterUpdateHooks []ListItemHook
+
+var listItemBeforeDeleteHooks []ListItemHook
+var listItemAfterDeleteHooks []ListItemHook
+
+var listItemBeforeUpsertHooks []ListItemHook
+var listItemAfterUpsertHooks []ListItemHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ListItem) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listItemAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ListItem) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listItemBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ListItem) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listItemAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ListItem) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listItemBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ListItem) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listItemAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ListItem) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listItemBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ListItem) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listItemAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ListItem) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listItemBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ListItem) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listItemAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddListItemHook registers your hook function for all future operations.
+func AddListItemHook(hookPoint boil.HookPoint, listItemHook ListItemHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		listItemAfterSelectHooks = append(listItemAfterSelectHooks, listItemHook)
+	case boil.BeforeInsertHook:
+		listItemBeforeInsertHooks = append(listItemBeforeInsertHooks, listItemHook)
+	case boil.AfterInsertHook:
+		listItemAfterInsertHooks = append(listItemAfterInsertHooks, listItemHook)
+	case boil.BeforeUpdateHook:
+		listItemBeforeUpdateHooks = append(listItemBeforeUpdateHooks, listItemHook)
+	case boil.AfterUpdateHook:
+		listItemAfterUpdateHooks = append(listItemAfterUpdateHooks, listItemHook)
+	case boil.BeforeDeleteHook:
+		listItemBeforeDeleteHooks = append(listItemBeforeDeleteHooks, listItemHook)
+	case boil.AfterDeleteHook:
+		listItemAfterDeleteHooks = append(listItemAfterDeleteHooks, listItemHook)
+	case boil.BeforeUpsertHook:
+		listItemBeforeUpsertHooks = append(listItemBeforeUpsertHooks, listItemHook)
+	case boil.AfterUpsertHook:
+		listItemAfterUpsertHooks = append(listItemAfterUpsertHooks, listItemHook)
+	}
+}
+
+// One returns a single listItem record from the query.
+func (q listItemQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ListItem, error) {
+	o := &ListItem{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for list_items")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ListItem records from the query.
+func (q listItemQuery) All(ctx context.Context, exec boil.ContextExecutor) (ListItemSlice, error) {
+	var o []*ListItem
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ListItem slice")
+	}
+
+	if len(listItemAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ListItem records in the query.
+func (q listItemQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count list_items rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q listItemQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if list_items exists")
+	}
+
+	return count > 0, nil
+}
+
+// Film pointed to by the foreign key.
+func (o *ListItem) Film(mods ...qm.QueryMod) filmQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FilmID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Films(queryMods...)
+}
+
+// List pointed to by the foreign key.
+func (o *ListItem) List(mods ...qm.QueryMod) listQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ListID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Lists(queryMods...)
+}
+
+// Series pointed to by the foreign key.
+func (o *ListItem) Series(mods ...qm.QueryMod) seriesQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SeriesID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Serieses(queryMods...)
+}
+
+// AddedByUser pointed to by the foreign key.
+func (o *ListItem) AddedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AddedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadFilm allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (listItemL) LoadFilm(ctx context.Context, e boil.ContextExecutor, singular bool, maybeListItem interface{}, mods queries.Applicator) error {
+	var slice []*ListItem
+	var object *ListItem
+
+	if singular {
+		var ok bool
+		object, ok = maybeListItem.(*ListItem)
+		if !ok {
+			object = new(ListItem)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeListItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeListItem))
+			}
+		}
+	} else {
+		s, ok := maybeListItem.(*[]*ListItem)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeListItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeListItem))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listItemR{}
+		}
+		if !queries.IsNil(object.FilmID) {
+			args = append(args, object.FilmID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listItemR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.FilmID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.FilmID) {
+				args = append(args, obj.FilmID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`films`),
+		qm.WhereIn(`films.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Film")
+	}
+
+	var resultSlice []*Film
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Film")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for films")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for films")
+	}
+
+	if len(listItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Film = foreign
+		if foreign.R == nil {
+			foreign.R = &filmR{}
+		}
+		foreign.R.ListItems = append(foreign.R.ListItems, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.FilmID, foreign.ID) {
+				local.R.Film = foreign
+				if foreign.R == nil {
+					foreign.R = &filmR{}
+				}
+				foreign.R.ListItems = append(foreign.R.ListItems, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadList allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (listItemL) LoadList(ctx context.Context, e boil.ContextExecutor, singular bool, maybeListItem interface{}, mods queries.Applicator) error {
+	var slice []*ListItem
+	var object *ListItem
+
+	if singular {
+		var ok bool
+		object, ok = maybeListItem.(*ListItem)
+		if !ok {
+			object = new(ListItem)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeListItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeListItem))
+			}
+		}
+	} else {
+		s, ok := maybeListItem.(*[]*ListItem)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeListItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeListItem))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listItemR{}
+		}
+		args = append(args, object.ListID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listItemR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ListID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ListID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`lists`),
+		qm.WhereIn(`lists.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load List")
+	}
+
+	var resultSlice []*List
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice List")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for lists")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for lists")
+	}
+
+	if len(listItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.List = foreign
+		if foreign.R == nil {
+			foreign.R = &listR{}
+		}
+		foreign.R.ListItems = append(foreign.R.ListItems, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ListID == foreign.ID {
+				local.R.List = foreign
+				if foreign.R == nil {
+					foreign.R = &listR{}
+				}
+				foreign.R.ListItems = append(foreign.R.ListItems, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSeries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (listItemL) LoadSeries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeListItem interface{}, mods queries.Applicator) error {
+	var slice []*ListItem
+	var object *ListItem
+
+	if singular {
+		var ok bool
+		object, ok = maybeListItem.(*ListItem)
+		if !ok {
+			object = new(ListItem)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeListItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeListItem))
+			}
+		}
+	} else {
+		s, ok := maybeListItem.(*[]*ListItem)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeListItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeListItem))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listItemR{}
+		}
+		if !queries.IsNil(object.SeriesID) {
+			args = append(args, object.SeriesID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listItemR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.SeriesID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.SeriesID) {
+				args = append(args, obj.SeriesID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`serieses`),
+		qm.WhereIn(`serieses.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Series")
+	}
+
+	var resultSlice []*Series
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Series")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for serieses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for serieses")
+	}
+
+	if len(listItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Series = foreign
+		if foreign.R == nil {
+			foreign.R = &seriesR{}
+		}
+		foreign.R.SeriesListItems = append(foreign.R.SeriesListItems, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.SeriesID, foreign.ID) {
+				local.R.Series = foreign
+				if foreign.R == nil {
+					foreign.R = &seriesR{}
+				}
+				foreign.R.SeriesListItems = append(foreign.R.SeriesListItems, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAddedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (listItemL) LoadAddedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeListItem interface{}, mods queries.Applicator) error {
+	var slice []*ListItem
+	var object *ListItem
+
+	if singular {
+		var ok bool
+		object, ok = maybeListItem.(*ListItem)
+		if !ok {
+			object = new(ListItem)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeListItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeListItem))
+			}
+		}
+	} else {
+		s, ok := maybeListItem.(*[]*ListItem)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeListItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeListItem))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listItemR{}
+		}
+		if !queries.IsNil(object.AddedBy) {
+			args = append(args, object.AddedBy)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listItemR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.AddedBy) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.AddedBy) {
+				args = append(args, obj.AddedBy)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(listItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.AddedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.AddedByListItems = append(foreign.R.AddedByListItems, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.AddedBy, foreign.ID) {
+				local.R.AddedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.AddedByListItems = append(foreign.R.AddedByListItems, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetFilm of the listItem to the related item.
+// Sets o.R.Film to related.
+// Adds o to related.R.ListItems.
+func (o *ListItem) SetFilm(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Film) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"list_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+		strmangle.WhereClause("\"", "\"", 2, listItemPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.FilmID, related.ID)
+	if o.R == nil {
+		o.R = &listItemR{
+			Film: related,
+		}
+	} else {
+		o.R.Film = related
+	}
+
+	if related.R == nil {
+		related.R = &filmR{
+			ListItems: ListItemSlice{o},
+		}
+	} else {
+		related.R.ListItems = append(related.R.ListItems, o)
+	}
+
+	return nil
+}
+
+// RemoveFilm relationship.
+// Sets o.R.Film to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *ListItem) RemoveFilm(ctx context.Context, exec boil.ContextExecutor, related *Film) error {
+	var err error
+
+	queries.SetScanner(&o.FilmID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("film_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Film = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ListItems {
+		if queries.Equal(o.FilmID, ri.FilmID) {
+			continue
+		}
+
+		ln := len(related.R.ListItems)
+		if ln > 1 && i < ln-1 {
+			related.R.ListItems[i] = related.R.ListItems[ln-1]
+		}
+		related.R.ListItems = related.R.ListItems[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetList of the listItem to the related item.
+// Sets o.R.List to related.
+// Adds o to related.R.ListItems.
+func (o *ListItem) SetList(ctx context.Context, exec boil.ContextExecutor, insert bool, related *List) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"list_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"list_id"}),
+		strmangle.WhereClause("\"", "\"", 2, listItemPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ListID = related.ID
+	if o.R == nil {
+		o.R = &listItemR{
+			List: related,
+		}
+	} else {
+		o.R.List = related
+	}
+
+	if related.R == nil {
+		related.R = &listR{
+			ListItems: ListItemSlice{o},
+		}
+	} else {
+		related.R.ListItems = append(related.R.ListItems, o)
+	}
+
+	return nil
+}
+
+// SetSeries of the listItem to the related item.
+// Sets o.R.Series to related.
+// Adds o to related.R.SeriesListItems.
+func (o *ListItem) SetSeries(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Series) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"list_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+		strmangle.WhereClause("\"", "\"", 2, listItemPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.SeriesID, related.ID)
+	if o.R == nil {
+		o.R = &listItemR{
+			Series: related,
+		}
+	} else {
+		o.R.Series = related
+	}
+
+	if related.R == nil {
+		related.R = &seriesR{
+			SeriesListItems: ListItemSlice{o},
+		}
+	} else {
+		related.R.SeriesListItems = append(related.R.SeriesListItems, o)
+	}
+
+	return nil
+}
+
+// RemoveSeries relationship.
+// Sets o.R.Series to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *ListItem) RemoveSeries(ctx context.Context, exec boil.ContextExecutor, related *Series) error {
+	var err error
+
+	queries.SetScanner(&o.SeriesID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("series_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Series = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.SeriesListItems {
+		if queries.Equal(o.SeriesID, ri.SeriesID) {
+			continue
+		}
+
+		ln := len(related.R.SeriesListItems)
+		if ln > 1 && i < ln-1 {
+			related.R.SeriesListItems[i] = related.R.SeriesListItems[ln-1]
+		}
+		related.R.SeriesListItems = related.R.SeriesListItems[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetAddedByUser of the listItem to the related item.
+// Sets o.R.AddedByUser to related.
+// Adds o to related.R.AddedByListItems.
+func (o *ListItem) SetAddedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"list_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"added_by"}),
+		strmangle.WhereClause("\"", "\"", 2, listItemPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.AddedBy, related.ID)
+	if o.R == nil {
+		o.R = &listItemR{
+			AddedByUser: related,
+		}
+	} else {
+		o.R.AddedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			AddedByListItems: ListItemSlice{o},
+		}
+	} else {
+		related.R.AddedByListItems = append(related.R.AddedByListItems, o)
+	}
+
+	return nil
+}
+
+// RemoveAddedByUser relationship.
+// Sets o.R.AddedByUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *ListItem) RemoveAddedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.AddedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("added_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.AddedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.AddedByListItems {
+		if queries.Equal(o.AddedBy, ri.AddedBy) {
+			continue
+		}
+
+		ln := len(related.R.AddedByListItems)
+		if ln > 1 && i < ln-1 {
+			related.R.AddedByListItems[i] = related.R.AddedByListItems[ln-1]
+		}
+		related.R.AddedByListItems = related.R.AddedByListItems[:ln-1]
+		break
+	}
+	return nil
+}
+
+// ListItems retrieves all the records using an executor.
+func ListItems(mods ...qm.QueryMod) listItemQuery {
+	mods = append(mods, qm.From("\"list_items\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"list_items\".*"})
+	}
+
+	return listItemQuery{q}
+}
+
+// FindListItem retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindListItem(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*ListItem, error) {
+	listItemObj := &ListItem{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"list_items\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, listItemObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from list_items")
+	}
+
+	if err = listItemObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return listItemObj, err
+	}
+
+	return listItemObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ListItem) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no list_items provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(listItemColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	listItemInsertCacheMut.RLock()
+	cache, cached := listItemInsertCache[key]
+	listItemInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			listItemAllColumns,
+			listItemColumnsWithDefault,
+			listItemColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(listItemType, listItemMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(listItemType, listItemMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"list_items\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"list_items\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into list_items")
+	}
+
+	if !cached {
+		listItemInsertCacheMut.Lock()
+		listItemInsertCache[key] = cache
+		listItemInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ListItem.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ListItem) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	listItemUpdateCacheMut.RLock()
+	cache, cached := listItemUpdateCache[key]
+	listItemUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			listItemAllColumns,
+			listItemPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update list_items, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"list_items\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, listItemPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(listItemType, listItemMapping, append(wl, listItemPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update list_items row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for list_items")
+	}
+
+	if !cached {
+		listItemUpdateCacheMut.Lock()
+		listItemUpdateCache[key] = cache
+		listItemUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q listItemQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for list_items")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for list_items")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ListItemSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listItemPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"list_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, listItemPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in listItem slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all listItem")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ListItem) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no list_items provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(listItemColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	listItemUpsertCacheMut.RLock()
+	cache, cached := listItemUpsertCache[key]
+	listItemUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			listItemAllColumns,
+			listItemColumnsWithDefault,
+			listItemColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			listItemAllColumns,
+			listItemPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert list_items, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(listItemPrimaryKeyColumns))
+			copy(conflict, listItemPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"list_items\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(listItemType, listItemMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(listItemType, listItemMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert list_items")
+	}
+
+	if !cached {
+		listItemUpsertCacheMut.Lock()
+		listItemUpsertCache[key] = cache
+		listItemUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ListItem record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ListItem) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ListItem provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), listItemPrimaryKeyMapping)
+	sql := "DELETE FROM \"list_items\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from list_items")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for list_items")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q listItemQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no listItemQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from list_items")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for list_items")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ListItemSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(listItemBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listItemPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"list_items\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, listItemPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from listItem slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for list_items")
+	}
+
+	if len(listItemAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ListItem) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindListItem(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ListItemSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ListItemSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listItemPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"list_items\".* FROM \"list_items\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, listItemPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ListItemSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ListItemExists checks if the ListItem row exists.
+func ListItemExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"list_items\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if list_items exists")
+	}
+
+	return exists, nil
+}
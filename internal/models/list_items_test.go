@@ -0,0 +1,1320 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testListItems(t *testing.T) {
+	t.Parallel()
+
+	query := ListItems()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testListItemsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListItem{}
+	if err = randomize.Struct(seed, o, listItemDBTypes, true, listItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ListItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testListItemsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListItem{}
+	if err = randomize.Struct(seed, o, listItemDBTypes, true, listItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ListItems().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ListItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testListItemsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListItem{}
+	if err = randomize.Struct(seed, o, listItemDBTypes, true, listItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ListItemSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ListItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testListItemsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListItem{}
+	if err = randomize.Struct(seed, o, listItemDBTypes, true, listItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ListItemExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if ListItem exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ListItemExists to return true, but got false.")
+	}
+}
+
+func testListItemsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListItem{}
+	if err = randomize.Struct(seed, o, listItemDBTypes, true, listItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	listItemFound, err := FindListItem(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if listItemFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testListItemsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListItem{}
+	if err = randomize.Struct(seed, o, listItemDBTypes, true, listItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ListItems().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testListItemsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListItem{}
+	if err = randomize.Struct(seed, o, listItemDBTypes, true, listItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ListItems().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testListItemsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	listItemOne := &ListItem{}
+	listItemTwo := &ListItem{}
+	if err = randomize.Struct(seed, listItemOne, listItemDBTypes, false, listItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+	if err = randomize.Struct(seed, listItemTwo, listItemDBTypes, false, listItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = listItemOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = listItemTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ListItems().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testListItemsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	listItemOne := &ListItem{}
+	listItemTwo := &ListItem{}
+	if err = randomize.Struct(seed, listItemOne, listItemDBTypes, false, listItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+	if err = randomize.Struct(seed, listItemTwo, listItemDBTypes, false, listItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = listItemOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = listItemTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ListItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func listItemBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ListItem) error {
+	*o = ListItem{}
+	return nil
+}
+
+func listItemAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ListItem) error {
+	*o = ListItem{}
+	return nil
+}
+
+func listItemAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ListItem) error {
+	*o = ListItem{}
+	return nil
+}
+
+func listItemBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ListItem) error {
+	*o = ListItem{}
+	return nil
+}
+
+func listItemAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ListItem) error {
+	*o = ListItem{}
+	return nil
+}
+
+func listItemBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ListItem) error {
+	*o = ListItem{}
+	return nil
+}
+
+func listItemAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ListItem) error {
+	*o = ListItem{}
+	return nil
+}
+
+func listItemBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ListItem) error {
+	*o = ListItem{}
+	return nil
+}
+
+func listItemAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ListItem) error {
+	*o = ListItem{}
+	return nil
+}
+
+func testListItemsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ListItem{}
+	o := &ListItem{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, listItemDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ListItem object: %s", err)
+	}
+
+	AddListItemHook(boil.BeforeInsertHook, listItemBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	listItemBeforeInsertHooks = []ListItemHook{}
+
+	AddListItemHook(boil.AfterInsertHook, listItemAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	listItemAfterInsertHooks = []ListItemHook{}
+
+	AddListItemHook(boil.AfterSelectHook, listItemAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	listItemAfterSelectHooks = []ListItemHook{}
+
+	AddListItemHook(boil.BeforeUpdateHook, listItemBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	listItemBeforeUpdateHooks = []ListItemHook{}
+
+	AddListItemHook(boil.AfterUpdateHook, listItemAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	listItemAfterUpdateHooks = []ListItemHook{}
+
+	AddListItemHook(boil.BeforeDeleteHook, listItemBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	listItemBeforeDeleteHooks = []ListItemHook{}
+
+	AddListItemHook(boil.AfterDeleteHook, listItemAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	listItemAfterDeleteHooks = []ListItemHook{}
+
+	AddListItemHook(boil.BeforeUpsertHook, listItemBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	listItemBeforeUpsertHooks = []ListItemHook{}
+
+	AddListItemHook(boil.AfterUpsertHook, listItemAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	listItemAfterUpsertHooks = []ListItemHook{}
+}
+
+func testListItemsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListItem{}
+	if err = randomize.Struct(seed, o, listItemDBTypes, true, listItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ListItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testListItemsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListItem{}
+	if err = randomize.Struct(seed, o, listItemDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(listItemColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ListItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testListItemToOneFilmUsingFilm(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ListItem
+	var foreign Film
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, listItemDBTypes, true, listItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, filmDBTypes, false, filmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Film struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.FilmID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Film().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ListItemSlice{&local}
+	if err = local.L.LoadFilm(ctx, tx, false, (*[]*ListItem)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Film == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Film = nil
+	if err = local.L.LoadFilm(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Film == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testListItemToOneListUsingList(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ListItem
+	var foreign List
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, listItemDBTypes, false, listItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, listDBTypes, false, listColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize List struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ListID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.List().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ListItemSlice{&local}
+	if err = local.L.LoadList(ctx, tx, false, (*[]*ListItem)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.List == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.List = nil
+	if err = local.L.LoadList(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.List == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testListItemToOneSeriesUsingSeries(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ListItem
+	var foreign Series
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, listItemDBTypes, true, listItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, seriesDBTypes, false, seriesColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Series struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.SeriesID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Series().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ListItemSlice{&local}
+	if err = local.L.LoadSeries(ctx, tx, false, (*[]*ListItem)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Series == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Series = nil
+	if err = local.L.LoadSeries(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Series == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testListItemToOneUserUsingAddedByUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ListItem
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, listItemDBTypes, true, listItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.AddedBy, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.AddedByUser().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ListItemSlice{&local}
+	if err = local.L.LoadAddedByUser(ctx, tx, false, (*[]*ListItem)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.AddedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.AddedByUser = nil
+	if err = local.L.LoadAddedByUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.AddedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testListItemToOneSetOpFilmUsingFilm(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ListItem
+	var b, c Film
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, listItemDBTypes, false, strmangle.SetComplement(listItemPrimaryKeyColumns, listItemColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Film{&b, &c} {
+		err = a.SetFilm(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Film != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ListItems[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.FilmID, x.ID) {
+			t.Error("foreign key was wrong value", a.FilmID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.FilmID))
+		reflect.Indirect(reflect.ValueOf(&a.FilmID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.FilmID, x.ID) {
+			t.Error("foreign key was wrong value", a.FilmID, x.ID)
+		}
+	}
+}
+
+func testListItemToOneRemoveOpFilmUsingFilm(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ListItem
+	var b Film
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, listItemDBTypes, false, strmangle.SetComplement(listItemPrimaryKeyColumns, listItemColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetFilm(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveFilm(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Film().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Film != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.FilmID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.ListItems) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testListItemToOneSetOpListUsingList(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ListItem
+	var b, c List
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, listItemDBTypes, false, strmangle.SetComplement(listItemPrimaryKeyColumns, listItemColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, listDBTypes, false, strmangle.SetComplement(listPrimaryKeyColumns, listColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, listDBTypes, false, strmangle.SetComplement(listPrimaryKeyColumns, listColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*List{&b, &c} {
+		err = a.SetList(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.List != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ListItems[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ListID != x.ID {
+			t.Error("foreign key was wrong value", a.ListID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ListID))
+		reflect.Indirect(reflect.ValueOf(&a.ListID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ListID != x.ID {
+			t.Error("foreign key was wrong value", a.ListID, x.ID)
+		}
+	}
+}
+func testListItemToOneSetOpSeriesUsingSeries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ListItem
+	var b, c Series
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, listItemDBTypes, false, strmangle.SetComplement(listItemPrimaryKeyColumns, listItemColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Series{&b, &c} {
+		err = a.SetSeries(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Series != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.SeriesListItems[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.SeriesID, x.ID) {
+			t.Error("foreign key was wrong value", a.SeriesID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.SeriesID))
+		reflect.Indirect(reflect.ValueOf(&a.SeriesID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.SeriesID, x.ID) {
+			t.Error("foreign key was wrong value", a.SeriesID, x.ID)
+		}
+	}
+}
+
+func testListItemToOneRemoveOpSeriesUsingSeries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ListItem
+	var b Series
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, listItemDBTypes, false, strmangle.SetComplement(listItemPrimaryKeyColumns, listItemColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetSeries(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveSeries(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Series().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Series != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.SeriesID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.SeriesListItems) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testListItemToOneSetOpUserUsingAddedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ListItem
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, listItemDBTypes, false, strmangle.SetComplement(listItemPrimaryKeyColumns, listItemColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetAddedByUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.AddedByUser != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.AddedByListItems[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.AddedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.AddedBy)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.AddedBy))
+		reflect.Indirect(reflect.ValueOf(&a.AddedBy)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.AddedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.AddedBy, x.ID)
+		}
+	}
+}
+
+func testListItemToOneRemoveOpUserUsingAddedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ListItem
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, listItemDBTypes, false, strmangle.SetComplement(listItemPrimaryKeyColumns, listItemColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetAddedByUser(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveAddedByUser(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.AddedByUser().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.AddedByUser != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.AddedBy) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.AddedByListItems) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testListItemsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListItem{}
+	if err = randomize.Struct(seed, o, listItemDBTypes, true, listItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testListItemsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListItem{}
+	if err = randomize.Struct(seed, o, listItemDBTypes, true, listItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ListItemSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testListItemsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListItem{}
+	if err = randomize.Struct(seed, o, listItemDBTypes, true, listItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ListItems().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	listItemDBTypes = map[string]string{`ID`: `integer`, `ListID`: `integer`, `Position`: `integer`, `FilmID`: `integer`, `SeriesID`: `integer`, `TimeAdded`: `timestamp with time zone`, `AddedBy`: `integer`}
+	_               = bytes.MinRead
+)
+
+func testListItemsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(listItemPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(listItemAllColumns) == len(listItemPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListItem{}
+	if err = randomize.Struct(seed, o, listItemDBTypes, true, listItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ListItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, listItemDBTypes, true, listItemPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testListItemsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(listItemAllColumns) == len(listItemPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListItem{}
+	if err = randomize.Struct(seed, o, listItemDBTypes, true, listItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ListItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, listItemDBTypes, true, listItemPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(listItemAllColumns, listItemPrimaryKeyColumns) {
+		fields = listItemAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			listItemAllColumns,
+			listItemPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ListItemSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testListItemsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(listItemAllColumns) == len(listItemPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ListItem{}
+	if err = randomize.Struct(seed, &o, listItemDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ListItem: %s", err)
+	}
+
+	count, err := ListItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, listItemDBTypes, false, listItemPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ListItem struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ListItem: %s", err)
+	}
+
+	count, err = ListItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1277 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ListMember is an object representing the database table.
+type ListMember struct {
+	ListID     int       `db:"list_id" boil:"list_id" json:"list_id" toml:"list_id" yaml:"list_id"`
+	UserID     int       `db:"user_id" boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Role       string    `db:"role" boil:"role" json:"role" toml:"role" yaml:"role"`
+	TimeJoined time.Time `db:"time_joined" boil:"time_joined" json:"time_joined" toml:"time_joined" yaml:"time_joined"`
+
+	R *listMemberR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L listMemberL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ListMemberColumns = struct {
+	ListID     string
+	UserID     string
+	Role       string
+	TimeJoined string
+}{
+	ListID:     "list_id",
+	UserID:     "user_id",
+	Role:       "role",
+	TimeJoined: "time_joined",
+}
+
+var ListMemberTableColumns = struct {
+	ListID     string
+	UserID     string
+	Role       string
+	TimeJoined string
+}{
+	ListID:     "list_members.list_id",
+	UserID:     "list_members.user_id",
+	Role:       "list_members.role",
+	TimeJoined: "list_members.time_joined",
+}
+
+// Generated where
+
+var ListMemberWhere = struct {
+	ListID     whereHelperint
+	UserID     whereHelperint
+	Role       whereHelperstring
+	TimeJoined whereHelpertime_Time
+}{
+	ListID:     whereHelperint{field: "\"list_members\".\"list_id\""},
+	UserID:     whereHelperint{field: "\"list_members\".\"user_id\""},
+	Role:       whereHelperstring{field: "\"list_members\".\"role\""},
+	TimeJoined: whereHelpertime_Time{field: "\"list_members\".\"time_joined\""},
+}
+
+// ListMemberRels is where relationship names are stored.
+var ListMemberRels = struct {
+	List string
+	User string
+}{
+	List: "List",
+	User: "User",
+}
+
+// listMemberR is where relationships are stored.
+type listMemberR struct {
+	List *List `db:"List" boil:"List" json:"List" toml:"List" yaml:"List"`
+	User *User `db:"User" boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*listMemberR) NewStruct() *listMemberR {
+	return &listMemberR{}
+}
+
+func (r *listMemberR) GetList() *List {
+	if r == nil {
+		return nil
+	}
+	return r.List
+}
+
+func (r *listMemberR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// listMemberL is where Load methods for each relationship are stored.
+type listMemberL struct{}
+
+var (
+	listMemberAllColumns            = []string{"list_id", "user_id", "role", "time_joined"}
+	listMemberColumnsWithoutDefault = []string{"list_id", "user_id", "role"}
+	listMemberColumnsWithDefault    = []string{"time_joined"}
+	listMemberPrimaryKeyColumns     = []string{"list_id", "user_id"}
+	listMemberGeneratedColumns      = []string{}
+)
+
+type (
+	// ListMemberSlice is an alias for a slice of pointers to ListMember.
+	// This should almost always be used instead of []ListMember.
+	ListMemberSlice []*ListMember
+	// ListMemberHook is the signature for custom ListMember hook methods
+	ListMemberHook func(context.Context, boil.ContextExecutor, *ListMember) error
+
+	listMemberQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	listMemberType                 = reflect.TypeOf(&ListMember{})
+	listMemberMapping              = queries.MakeStructMapping(listMemberType)
+	listMemberPrimaryKeyMapping, _ = queries.BindMapping(listMemberType, listMemberMapping, listMemberPrimaryKeyColumns)
+	listMemberInsertCacheMut       sync.RWMutex
+	listMemberInsertCache          = make(map[string]insertCache)
+	listMemberUpdateCacheMut       sync.RWMutex
+	listMemberUpdateCache          = make(map[string]updateCache)
+	listMemberUpsertCacheMut       sync.RWMutex
+	listMemberUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var listMemberAfterSelectHooks []ListMemberHook
+
+var listMemberBeforeInsertHooks []ListMemberHook
+var listMemberAfterInsertHooks []ListMemberHook
+
+var listMemberBeforeUpdateHooks []ListMemberHook
+var listMemberAfterUpdateHooks []ListMemberHook
+
+var listMemberBeforeDeleteHooks []ListMemberHook
+var listMemberAfterDeleteHooks []ListMemberHook
+
+var listMemberBeforeUpsertHooks []ListMemberHook
+var listMemberAfterUpsertHooks []ListMemberHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ListMember) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listMemberAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ListMember) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listMemberBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ListMember) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listMemberAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ListMember) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listMemberBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ListMember) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listMemberAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ListMember) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listMemberBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ListMember) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listMemberAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ListMember) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listMemberBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ListMember) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listMemberAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddListMemberHook registers your hook function for all future operations.
+func AddListMemberHook(hookPoint boil.HookPoint, listMemberHook ListMemberHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		listMemberAfterSelectHooks = append(listMemberAfterSelectHooks, listMemberHook)
+	case boil.BeforeInsertHook:
+		listMemberBeforeInsertHooks = append(listMemberBeforeInsertHooks, listMemberHook)
+	case boil.AfterInsertHook:
+		listMemberAfterInsertHooks = append(listMemberAfterInsertHooks, listMemberHook)
+	case boil.BeforeUpdateHook:
+		listMemberBeforeUpdateHooks = append(listMemberBeforeUpdateHooks, listMemberHook)
+	case boil.AfterUpdateHook:
+		listMemberAfterUpdateHooks = append(listMemberAfterUpdateHooks, listMemberHook)
+	case boil.BeforeDeleteHook:
+		listMemberBeforeDeleteHooks = append(listMemberBeforeDeleteHooks, listMemberHook)
+	case boil.AfterDeleteHook:
+		listMemberAfterDeleteHooks = append(listMemberAfterDeleteHooks, listMemberHook)
+	case boil.BeforeUpsertHook:
+		listMemberBeforeUpsertHooks = append(listMemberBeforeUpsertHooks, listMemberHook)
+	case boil.AfterUpsertHook:
+		listMemberAfterUpsertHooks = append(listMemberAfterUpsertHooks, listMemberHook)
+	}
+}
+
+// One returns a single listMember record from the query.
+func (q listMemberQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ListMember, error) {
+	o := &ListMember{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for list_members")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ListMember records from the query.
+func (q listMemberQuery) All(ctx context.Context, exec boil.ContextExecutor) (ListMemberSlice, error) {
+	var o []*ListMember
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ListMember slice")
+	}
+
+	if len(listMemberAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ListMember records in the query.
+func (q listMemberQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count list_members rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q listMemberQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if list_members exists")
+	}
+
+	return count > 0, nil
+}
+
+// List pointed to by the foreign key.
+func (o *ListMember) List(mods ...qm.QueryMod) listQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ListID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Lists(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *ListMember) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadList allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (listMemberL) LoadList(ctx context.Context, e boil.ContextExecutor, singular bool, maybeListMember interface{}, mods queries.Applicator) error {
+	var slice []*ListMember
+	var object *ListMember
+
+	if singular {
+		var ok bool
+		object, ok = maybeListMember.(*ListMember)
+		if !ok {
+			object = new(ListMember)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeListMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeListMember))
+			}
+		}
+	} else {
+		s, ok := maybeListMember.(*[]*ListMember)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeListMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeListMember))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listMemberR{}
+		}
+		args = append(args, object.ListID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listMemberR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ListID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ListID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`lists`),
+		qm.WhereIn(`lists.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load List")
+	}
+
+	var resultSlice []*List
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice List")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for lists")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for lists")
+	}
+
+	if len(listMemberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.List = foreign
+		if foreign.R == nil {
+			foreign.R = &listR{}
+		}
+		foreign.R.ListMembers = append(foreign.R.ListMembers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ListID == foreign.ID {
+				local.R.List = foreign
+				if foreign.R == nil {
+					foreign.R = &listR{}
+				}
+				foreign.R.ListMembers = append(foreign.R.ListMembers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (listMemberL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeListMember interface{}, mods queries.Applicator) error {
+	var slice []*ListMember
+	var object *ListMember
+
+	if singular {
+		var ok bool
+		object, ok = maybeListMember.(*ListMember)
+		if !ok {
+			object = new(ListMember)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeListMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeListMember))
+			}
+		}
+	} else {
+		s, ok := maybeListMember.(*[]*ListMember)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeListMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeListMember))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listMemberR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listMemberR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(listMemberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ListMembers = append(foreign.R.ListMembers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ListMembers = append(foreign.R.ListMembers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetList of the listMember to the related item.
+// Sets o.R.List to related.
+// Adds o to related.R.ListMembers.
+func (o *ListMember) SetList(ctx context.Context, exec boil.ContextExecutor, insert bool, related *List) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"list_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"list_id"}),
+		strmangle.WhereClause("\"", "\"", 2, listMemberPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ListID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ListID = related.ID
+	if o.R == nil {
+		o.R = &listMemberR{
+			List: related,
+		}
+	} else {
+		o.R.List = related
+	}
+
+	if related.R == nil {
+		related.R = &listR{
+			ListMembers: ListMemberSlice{o},
+		}
+	} else {
+		related.R.ListMembers = append(related.R.ListMembers, o)
+	}
+
+	return nil
+}
+
+// SetUser of the listMember to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.ListMembers.
+func (o *ListMember) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"list_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, listMemberPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ListID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &listMemberR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ListMembers: ListMemberSlice{o},
+		}
+	} else {
+		related.R.ListMembers = append(related.R.ListMembers, o)
+	}
+
+	return nil
+}
+
+// ListMembers retrieves all the records using an executor.
+func ListMembers(mods ...qm.QueryMod) listMemberQuery {
+	mods = append(mods, qm.From("\"list_members\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"list_members\".*"})
+	}
+
+	return listMemberQuery{q}
+}
+
+// FindListMember retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindListMember(ctx context.Context, exec boil.ContextExecutor, listID int, userID int, selectCols ...string) (*ListMember, error) {
+	listMemberObj := &ListMember{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"list_members\" where \"list_id\"=$1 AND \"user_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, listID, userID)
+
+	err := q.Bind(ctx, exec, listMemberObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from list_members")
+	}
+
+	if err = listMemberObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return listMemberObj, err
+	}
+
+	return listMemberObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ListMember) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no list_members provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(listMemberColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	listMemberInsertCacheMut.RLock()
+	cache, cached := listMemberInsertCache[key]
+	listMemberInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			listMemberAllColumns,
+			listMemberColumnsWithDefault,
+			listMemberColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(listMemberType, listMemberMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(listMemberType, listMemberMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"list_members\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"list_members\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into list_members")
+	}
+
+	if !cached {
+		listMemberInsertCacheMut.Lock()
+		listMemberInsertCache[key] = cache
+		listMemberInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ListMember.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ListMember) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	listMemberUpdateCacheMut.RLock()
+	cache, cached := listMemberUpdateCache[key]
+	listMemberUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			listMemberAllColumns,
+			listMemberPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update list_members, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"list_members\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, listMemberPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(listMemberType, listMemberMapping, append(wl, listMemberPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update list_members row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for list_members")
+	}
+
+	if !cached {
+		listMemberUpdateCacheMut.Lock()
+		listMemberUpdateCache[key] = cache
+		listMemberUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q listMemberQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for list_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for list_members")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ListMemberSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"list_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, listMemberPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in listMember slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all listMember")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ListMember) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no list_members provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(listMemberColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	listMemberUpsertCacheMut.RLock()
+	cache, cached := listMemberUpsertCache[key]
+	listMemberUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			listMemberAllColumns,
+			listMemberColumnsWithDefault,
+			listMemberColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			listMemberAllColumns,
+			listMemberPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert list_members, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(listMemberPrimaryKeyColumns))
+			copy(conflict, listMemberPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"list_members\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(listMemberType, listMemberMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(listMemberType, listMemberMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert list_members")
+	}
+
+	if !cached {
+		listMemberUpsertCacheMut.Lock()
+		listMemberUpsertCache[key] = cache
+		listMemberUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ListMember record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ListMember) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ListMember provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), listMemberPrimaryKeyMapping)
+	sql := "DELETE FROM \"list_members\" WHERE \"list_id\"=$1 AND \"user_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from list_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for list_members")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q listMemberQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no listMemberQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from list_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for list_members")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ListMemberSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(listMemberBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"list_members\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, listMemberPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from listMember slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for list_members")
+	}
+
+	if len(listMemberAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ListMember) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindListMember(ctx, exec, o.ListID, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ListMemberSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ListMemberSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"list_members\".* FROM \"list_members\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, listMemberPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ListMemberSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ListMemberExists checks if the ListMember row exists.
+func ListMemberExists(ctx context.Context, exec boil.ContextExecutor, listID int, userID int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"list_members\" where \"list_id\"=$1 AND \"user_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, listID, userID)
+	}
+	row := exec.QueryRowContext(ctx, sql, listID, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if list_members exists")
+	}
+
+	return exists, nil
+}
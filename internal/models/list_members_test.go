@@ -0,0 +1,941 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testListMembers(t *testing.T) {
+	t.Parallel()
+
+	query := ListMembers()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testListMembersDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListMember{}
+	if err = randomize.Struct(seed, o, listMemberDBTypes, true, listMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ListMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testListMembersQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListMember{}
+	if err = randomize.Struct(seed, o, listMemberDBTypes, true, listMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ListMembers().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ListMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testListMembersSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListMember{}
+	if err = randomize.Struct(seed, o, listMemberDBTypes, true, listMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ListMemberSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ListMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testListMembersExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListMember{}
+	if err = randomize.Struct(seed, o, listMemberDBTypes, true, listMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ListMemberExists(ctx, tx, o.ListID, o.UserID)
+	if err != nil {
+		t.Errorf("Unable to check if ListMember exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ListMemberExists to return true, but got false.")
+	}
+}
+
+func testListMembersFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListMember{}
+	if err = randomize.Struct(seed, o, listMemberDBTypes, true, listMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	listMemberFound, err := FindListMember(ctx, tx, o.ListID, o.UserID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if listMemberFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testListMembersBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListMember{}
+	if err = randomize.Struct(seed, o, listMemberDBTypes, true, listMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ListMembers().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testListMembersOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListMember{}
+	if err = randomize.Struct(seed, o, listMemberDBTypes, true, listMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ListMembers().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testListMembersAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	listMemberOne := &ListMember{}
+	listMemberTwo := &ListMember{}
+	if err = randomize.Struct(seed, listMemberOne, listMemberDBTypes, false, listMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListMember struct: %s", err)
+	}
+	if err = randomize.Struct(seed, listMemberTwo, listMemberDBTypes, false, listMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = listMemberOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = listMemberTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ListMembers().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testListMembersCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	listMemberOne := &ListMember{}
+	listMemberTwo := &ListMember{}
+	if err = randomize.Struct(seed, listMemberOne, listMemberDBTypes, false, listMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListMember struct: %s", err)
+	}
+	if err = randomize.Struct(seed, listMemberTwo, listMemberDBTypes, false, listMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = listMemberOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = listMemberTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ListMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func listMemberBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ListMember) error {
+	*o = ListMember{}
+	return nil
+}
+
+func listMemberAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ListMember) error {
+	*o = ListMember{}
+	return nil
+}
+
+func listMemberAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ListMember) error {
+	*o = ListMember{}
+	return nil
+}
+
+func listMemberBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ListMember) error {
+	*o = ListMember{}
+	return nil
+}
+
+func listMemberAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ListMember) error {
+	*o = ListMember{}
+	return nil
+}
+
+func listMemberBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ListMember) error {
+	*o = ListMember{}
+	return nil
+}
+
+func listMemberAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ListMember) error {
+	*o = ListMember{}
+	return nil
+}
+
+func listMemberBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ListMember) error {
+	*o = ListMember{}
+	return nil
+}
+
+func listMemberAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ListMember) error {
+	*o = ListMember{}
+	return nil
+}
+
+func testListMembersHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ListMember{}
+	o := &ListMember{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, listMemberDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ListMember object: %s", err)
+	}
+
+	AddListMemberHook(boil.BeforeInsertHook, listMemberBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	listMemberBeforeInsertHooks = []ListMemberHook{}
+
+	AddListMemberHook(boil.AfterInsertHook, listMemberAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	listMemberAfterInsertHooks = []ListMemberHook{}
+
+	AddListMemberHook(boil.AfterSelectHook, listMemberAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	listMemberAfterSelectHooks = []ListMemberHook{}
+
+	AddListMemberHook(boil.BeforeUpdateHook, listMemberBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	listMemberBeforeUpdateHooks = []ListMemberHook{}
+
+	AddListMemberHook(boil.AfterUpdateHook, listMemberAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	listMemberAfterUpdateHooks = []ListMemberHook{}
+
+	AddListMemberHook(boil.BeforeDeleteHook, listMemberBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	listMemberBeforeDeleteHooks = []ListMemberHook{}
+
+	AddListMemberHook(boil.AfterDeleteHook, listMemberAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	listMemberAfterDeleteHooks = []ListMemberHook{}
+
+	AddListMemberHook(boil.BeforeUpsertHook, listMemberBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	listMemberBeforeUpsertHooks = []ListMemberHook{}
+
+	AddListMemberHook(boil.AfterUpsertHook, listMemberAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	listMemberAfterUpsertHooks = []ListMemberHook{}
+}
+
+func testListMembersInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListMember{}
+	if err = randomize.Struct(seed, o, listMemberDBTypes, true, listMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ListMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testListMembersInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListMember{}
+	if err = randomize.Struct(seed, o, listMemberDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ListMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(listMemberColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ListMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testListMemberToOneListUsingList(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ListMember
+	var foreign List
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, listMemberDBTypes, false, listMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListMember struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, listDBTypes, false, listColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize List struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ListID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.List().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ListMemberSlice{&local}
+	if err = local.L.LoadList(ctx, tx, false, (*[]*ListMember)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.List == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.List = nil
+	if err = local.L.LoadList(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.List == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testListMemberToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ListMember
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, listMemberDBTypes, false, listMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListMember struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ListMemberSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*ListMember)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testListMemberToOneSetOpListUsingList(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ListMember
+	var b, c List
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, listMemberDBTypes, false, strmangle.SetComplement(listMemberPrimaryKeyColumns, listMemberColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, listDBTypes, false, strmangle.SetComplement(listPrimaryKeyColumns, listColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, listDBTypes, false, strmangle.SetComplement(listPrimaryKeyColumns, listColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*List{&b, &c} {
+		err = a.SetList(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.List != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ListMembers[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ListID != x.ID {
+			t.Error("foreign key was wrong value", a.ListID)
+		}
+
+		if exists, err := ListMemberExists(ctx, tx, a.ListID, a.UserID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+func testListMemberToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ListMember
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, listMemberDBTypes, false, strmangle.SetComplement(listMemberPrimaryKeyColumns, listMemberColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ListMembers[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		if exists, err := ListMemberExists(ctx, tx, a.ListID, a.UserID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testListMembersReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListMember{}
+	if err = randomize.Struct(seed, o, listMemberDBTypes, true, listMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testListMembersReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListMember{}
+	if err = randomize.Struct(seed, o, listMemberDBTypes, true, listMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ListMemberSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testListMembersSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListMember{}
+	if err = randomize.Struct(seed, o, listMemberDBTypes, true, listMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ListMembers().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	listMemberDBTypes = map[string]string{`ListID`: `integer`, `UserID`: `integer`, `Role`: `character varying`, `TimeJoined`: `timestamp with time zone`}
+	_                 = bytes.MinRead
+)
+
+func testListMembersUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(listMemberPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(listMemberAllColumns) == len(listMemberPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListMember{}
+	if err = randomize.Struct(seed, o, listMemberDBTypes, true, listMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ListMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, listMemberDBTypes, true, listMemberPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ListMember struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testListMembersSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(listMemberAllColumns) == len(listMemberPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ListMember{}
+	if err = randomize.Struct(seed, o, listMemberDBTypes, true, listMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ListMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ListMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, listMemberDBTypes, true, listMemberPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ListMember struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(listMemberAllColumns, listMemberPrimaryKeyColumns) {
+		fields = listMemberAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			listMemberAllColumns,
+			listMemberPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ListMemberSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testListMembersUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(listMemberAllColumns) == len(listMemberPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ListMember{}
+	if err = randomize.Struct(seed, &o, listMemberDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ListMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ListMember: %s", err)
+	}
+
+	count, err := ListMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, listMemberDBTypes, false, listMemberPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ListMember struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ListMember: %s", err)
+	}
+
+	count, err = ListMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
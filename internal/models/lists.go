@@ -0,0 +1,1875 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// List is an object representing the database table.
+type List struct {
+	ID           int         `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID       int         `db:"user_id" boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Title        string      `db:"title" boil:"title" json:"title" toml:"title" yaml:"title"`
+	Descriptions null.String `db:"descriptions" boil:"descriptions" json:"descriptions,omitempty" toml:"descriptions" yaml:"descriptions,omitempty"`
+	Visibility   string      `db:"visibility" boil:"visibility" json:"visibility" toml:"visibility" yaml:"visibility"`
+	CreatedAt    time.Time   `db:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt    time.Time   `db:"updated_at" boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *listR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L listL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ListColumns = struct {
+	ID           string
+	UserID       string
+	Title        string
+	Descriptions string
+	Visibility   string
+	CreatedAt    string
+	UpdatedAt    string
+}{
+	ID:           "id",
+	UserID:       "user_id",
+	Title:        "title",
+	Descriptions: "descriptions",
+	Visibility:   "visibility",
+	CreatedAt:    "created_at",
+	UpdatedAt:    "updated_at",
+}
+
+var ListTableColumns = struct {
+	ID           string
+	UserID       string
+	Title        string
+	Descriptions string
+	Visibility   string
+	CreatedAt    string
+	UpdatedAt    string
+}{
+	ID:           "lists.id",
+	UserID:       "lists.user_id",
+	Title:        "lists.title",
+	Descriptions: "lists.descriptions",
+	Visibility:   "lists.visibility",
+	CreatedAt:    "lists.created_at",
+	UpdatedAt:    "lists.updated_at",
+}
+
+// Generated where
+
+var ListWhere = struct {
+	ID           whereHelperint
+	UserID       whereHelperint
+	Title        whereHelperstring
+	Descriptions whereHelpernull_String
+	Visibility   whereHelperstring
+	CreatedAt    whereHelpertime_Time
+	UpdatedAt    whereHelpertime_Time
+}{
+	ID:           whereHelperint{field: "\"lists\".\"id\""},
+	UserID:       whereHelperint{field: "\"lists\".\"user_id\""},
+	Title:        whereHelperstring{field: "\"lists\".\"title\""},
+	Descriptions: whereHelpernull_String{field: "\"lists\".\"descriptions\""},
+	Visibility:   whereHelperstring{field: "\"lists\".\"visibility\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"lists\".\"created_at\""},
+	UpdatedAt:    whereHelpertime_Time{field: "\"lists\".\"updated_at\""},
+}
+
+// ListRels is where relationship names are stored.
+var ListRels = struct {
+	User           string
+	ListActivities string
+	ListInvites    string
+	ListItems      string
+	ListMembers    string
+}{
+	User:           "User",
+	ListActivities: "ListActivities",
+	ListInvites:    "ListInvites",
+	ListItems:      "ListItems",
+	ListMembers:    "ListMembers",
+}
+
+// listR is where relationships are stored.
+type listR struct {
+	User           *User             `db:"User" boil:"User" json:"User" toml:"User" yaml:"User"`
+	ListActivities ListActivitySlice `db:"ListActivities" boil:"ListActivities" json:"ListActivities" toml:"ListActivities" yaml:"ListActivities"`
+	ListInvites    ListInviteSlice   `db:"ListInvites" boil:"ListInvites" json:"ListInvites" toml:"ListInvites" yaml:"ListInvites"`
+	ListItems      ListItemSlice     `db:"ListItems" boil:"ListItems" json:"ListItems" toml:"ListItems" yaml:"ListItems"`
+	ListMembers    ListMemberSlice   `db:"ListMembers" boil:"ListMembers" json:"ListMembers" toml:"ListMembers" yaml:"ListMembers"`
+}
+
+// NewStruct creates a new relationship struct
+func (*listR) NewStruct() *listR {
+	return &listR{}
+}
+
+func (r *listR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+func (r *listR) GetListActivities() ListActivitySlice {
+	if r == nil {
+		return nil
+	}
+	return r.ListActivities
+}
+
+func (r *listR) GetListInvites() ListInviteSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ListInvites
+}
+
+func (r *listR) GetListItems() ListItemSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ListItems
+}
+
+func (r *listR) GetListMembers() ListMemberSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ListMembers
+}
+
+// listL is where Load methods for each relationship are stored.
+type listL struct{}
+
+var (
+	listAllColumns            = []string{"id", "user_id", "title", "descriptions", "visibility", "created_at", "updated_at"}
+	listColumnsWithoutDefault = []string{"user_id", "title"}
+	listColumnsWithDefault    = []string{"id", "descriptions", "visibility", "created_at", "updated_at"}
+	listPrimaryKeyColumns     = []string{"id"}
+	listGeneratedColumns      = []string{}
+)
+
+type (
+	// ListSlice is an alias for a slice of pointers to List.
+	// This should almost always be used instead of []List.
+	ListSlice []*List
+	// ListHook is the signature for custom List hook methods
+	ListHook func(context.Context, boil.ContextExecutor, *List) error
+
+	listQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	listType                 = reflect.TypeOf(&List{})
+	listMapping              = queries.MakeStructMapping(listType)
+	listPrimaryKeyMapping, _ = queries.BindMapping(listType, listMapping, listPrimaryKeyColumns)
+	listInsertCacheMut       sync.RWMutex
+	listInsertCache          = make(map[string]insertCache)
+	listUpdateCacheMut       sync.RWMutex
+	listUpdateCache          = make(map[string]updateCache)
+	listUpsertCacheMut       sync.RWMutex
+	listUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var listAfterSelectHooks []ListHook
+
+var listBeforeInsertHooks []ListHook
+var listAfterInsertHooks []ListHook
+
+var listBeforeUpdateHooks []ListHook
+var listAfterUpdateHooks []ListHook
+
+var listBeforeDeleteHooks []ListHook
+var listAfterDeleteHooks []ListHook
+
+var listBeforeUpsertHooks []ListHook
+var listAfterUpsertHooks []ListHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *List) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *List) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *List) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *List) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *List) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *List) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *List) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *List) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *List) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range listAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddListHook registers your hook function for all future operations.
+func AddListHook(hookPoint boil.HookPoint, listHook ListHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		listAfterSelectHooks = append(listAfterSelectHooks, listHook)
+	case boil.BeforeInsertHook:
+		listBeforeInsertHooks = append(listBeforeInsertHooks, listHook)
+	case boil.AfterInsertHook:
+		listAfterInsertHooks = append(listAfterInsertHooks, listHook)
+	case boil.BeforeUpdateHook:
+		listBeforeUpdateHooks = append(listBeforeUpdateHooks, listHook)
+	case boil.AfterUpdateHook:
+		listAfterUpdateHooks = append(listAfterUpdateHooks, listHook)
+	case boil.BeforeDeleteHook:
+		listBeforeDeleteHooks = append(listBeforeDeleteHooks, listHook)
+	case boil.AfterDeleteHook:
+		listAfterDeleteHooks = append(listAfterDeleteHooks, listHook)
+	case boil.BeforeUpsertHook:
+		listBeforeUpsertHooks = append(listBeforeUpsertHooks, listHook)
+	case boil.AfterUpsertHook:
+		listAfterUpsertHooks = append(listAfterUpsertHooks, listHook)
+	}
+}
+
+// One returns a single list record from the query.
+func (q listQuery) One(ctx context.Context, exec boil.ContextExecutor) (*List, error) {
+	o := &List{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for lists")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all List records from the query.
+func (q listQuery) All(ctx context.Context, exec boil.ContextExecutor) (ListSlice, error) {
+	var o []*List
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to List slice")
+	}
+
+	if len(listAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all List records in the query.
+func (q listQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count lists rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q listQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if lists exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *List) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// ListActivities retrieves all the list_activity's ListActivities with an executor.
+func (o *List) ListActivities(mods ...qm.QueryMod) listActivityQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"list_activities\".\"list_id\"=?", o.ID),
+	)
+
+	return ListActivities(queryMods...)
+}
+
+// ListInvites retrieves all the list_invite's ListInvites with an executor.
+func (o *List) ListInvites(mods ...qm.QueryMod) listInviteQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"list_invites\".\"list_id\"=?", o.ID),
+	)
+
+	return ListInvites(queryMods...)
+}
+
+// ListItems retrieves all the list_item's ListItems with an executor.
+func (o *List) ListItems(mods ...qm.QueryMod) listItemQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"list_items\".\"list_id\"=?", o.ID),
+	)
+
+	return ListItems(queryMods...)
+}
+
+// ListMembers retrieves all the list_member's ListMembers with an executor.
+func (o *List) ListMembers(mods ...qm.QueryMod) listMemberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"list_members\".\"list_id\"=?", o.ID),
+	)
+
+	return ListMembers(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (listL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeList interface{}, mods queries.Applicator) error {
+	var slice []*List
+	var object *List
+
+	if singular {
+		var ok bool
+		object, ok = maybeList.(*List)
+		if !ok {
+			object = new(List)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeList)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeList))
+			}
+		}
+	} else {
+		s, ok := maybeList.(*[]*List)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeList)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeList))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(listAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.Lists = append(foreign.R.Lists, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.Lists = append(foreign.R.Lists, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadListActivities allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (listL) LoadListActivities(ctx context.Context, e boil.ContextExecutor, singular bool, maybeList interface{}, mods queries.Applicator) error {
+	var slice []*List
+	var object *List
+
+	if singular {
+		var ok bool
+		object, ok = maybeList.(*List)
+		if !ok {
+			object = new(List)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeList)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeList))
+			}
+		}
+	} else {
+		s, ok := maybeList.(*[]*List)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeList)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeList))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`list_activities`),
+		qm.WhereIn(`list_activities.list_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load list_activities")
+	}
+
+	var resultSlice []*ListActivity
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice list_activities")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on list_activities")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for list_activities")
+	}
+
+	if len(listActivityAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ListActivities = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &listActivityR{}
+			}
+			foreign.R.List = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ListID {
+				local.R.ListActivities = append(local.R.ListActivities, foreign)
+				if foreign.R == nil {
+					foreign.R = &listActivityR{}
+				}
+				foreign.R.List = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadListInvites allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (listL) LoadListInvites(ctx context.Context, e boil.ContextExecutor, singular bool, maybeList interface{}, mods queries.Applicator) error {
+	var slice []*List
+	var object *List
+
+	if singular {
+		var ok bool
+		object, ok = maybeList.(*List)
+		if !ok {
+			object = new(List)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeList)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeList))
+			}
+		}
+	} else {
+		s, ok := maybeList.(*[]*List)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeList)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeList))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`list_invites`),
+		qm.WhereIn(`list_invites.list_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load list_invites")
+	}
+
+	var resultSlice []*ListInvite
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice list_invites")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on list_invites")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for list_invites")
+	}
+
+	if len(listInviteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ListInvites = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &listInviteR{}
+			}
+			foreign.R.List = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ListID {
+				local.R.ListInvites = append(local.R.ListInvites, foreign)
+				if foreign.R == nil {
+					foreign.R = &listInviteR{}
+				}
+				foreign.R.List = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadListItems allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (listL) LoadListItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeList interface{}, mods queries.Applicator) error {
+	var slice []*List
+	var object *List
+
+	if singular {
+		var ok bool
+		object, ok = maybeList.(*List)
+		if !ok {
+			object = new(List)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeList)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeList))
+			}
+		}
+	} else {
+		s, ok := maybeList.(*[]*List)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeList)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeList))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`list_items`),
+		qm.WhereIn(`list_items.list_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load list_items")
+	}
+
+	var resultSlice []*ListItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice list_items")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on list_items")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for list_items")
+	}
+
+	if len(listItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ListItems = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &listItemR{}
+			}
+			foreign.R.List = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ListID {
+				local.R.ListItems = append(local.R.ListItems, foreign)
+				if foreign.R == nil {
+					foreign.R = &listItemR{}
+				}
+				foreign.R.List = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadListMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (listL) LoadListMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeList interface{}, mods queries.Applicator) error {
+	var slice []*List
+	var object *List
+
+	if singular {
+		var ok bool
+		object, ok = maybeList.(*List)
+		if !ok {
+			object = new(List)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeList)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeList))
+			}
+		}
+	} else {
+		s, ok := maybeList.(*[]*List)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeList)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeList))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`list_members`),
+		qm.WhereIn(`list_members.list_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load list_members")
+	}
+
+	var resultSlice []*ListMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice list_members")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on list_members")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for list_members")
+	}
+
+	if len(listMemberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ListMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &listMemberR{}
+			}
+			foreign.R.List = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ListID {
+				local.R.ListMembers = append(local.R.ListMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &listMemberR{}
+				}
+				foreign.R.List = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the list to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.Lists.
+func (o *List) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"lists\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, listPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &listR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			Lists: ListSlice{o},
+		}
+	} else {
+		related.R.Lists = append(related.R.Lists, o)
+	}
+
+	return nil
+}
+
+// AddListActivities adds the given related objects to the existing relationships
+// of the list, optionally inserting them as new records.
+// Appends related to o.R.ListActivities.
+// Sets related.R.List appropriately.
+func (o *List) AddListActivities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListActivity) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ListID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"list_activities\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"list_id"}),
+				strmangle.WhereClause("\"", "\"", 2, listActivityPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ListID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &listR{
+			ListActivities: related,
+		}
+	} else {
+		o.R.ListActivities = append(o.R.ListActivities, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &listActivityR{
+				List: o,
+			}
+		} else {
+			rel.R.List = o
+		}
+	}
+	return nil
+}
+
+// AddListInvites adds the given related objects to the existing relationships
+// of the list, optionally inserting them as new records.
+// Appends related to o.R.ListInvites.
+// Sets related.R.List appropriately.
+func (o *List) AddListInvites(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListInvite) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ListID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"list_invites\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"list_id"}),
+				strmangle.WhereClause("\"", "\"", 2, listInvitePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ListID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &listR{
+			ListInvites: related,
+		}
+	} else {
+		o.R.ListInvites = append(o.R.ListInvites, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &listInviteR{
+				List: o,
+			}
+		} else {
+			rel.R.List = o
+		}
+	}
+	return nil
+}
+
+// AddListItems adds the given related objects to the existing relationships
+// of the list, optionally inserting them as new records.
+// Appends related to o.R.ListItems.
+// Sets related.R.List appropriately.
+func (o *List) AddListItems(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListItem) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ListID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"list_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"list_id"}),
+				strmangle.WhereClause("\"", "\"", 2, listItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ListID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &listR{
+			ListItems: related,
+		}
+	} else {
+		o.R.ListItems = append(o.R.ListItems, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &listItemR{
+				List: o,
+			}
+		} else {
+			rel.R.List = o
+		}
+	}
+	return nil
+}
+
+// AddListMembers adds the given related objects to the existing relationships
+// of the list, optionally inserting them as new records.
+// Appends related to o.R.ListMembers.
+// Sets related.R.List appropriately.
+func (o *List) AddListMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListMember) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ListID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"list_members\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"list_id"}),
+				strmangle.WhereClause("\"", "\"", 2, listMemberPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ListID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ListID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &listR{
+			ListMembers: related,
+		}
+	} else {
+		o.R.ListMembers = append(o.R.ListMembers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &listMemberR{
+				List: o,
+			}
+		} else {
+			rel.R.List = o
+		}
+	}
+	return nil
+}
+
+// Lists retrieves all the records using an executor.
+func Lists(mods ...qm.QueryMod) listQuery {
+	mods = append(mods, qm.From("\"lists\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"lists\".*"})
+	}
+
+	return listQuery{q}
+}
+
+// FindList retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindList(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*List, error) {
+	listObj := &List{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"lists\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, listObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from lists")
+	}
+
+	if err = listObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return listObj, err
+	}
+
+	return listObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *List) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no lists provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(listColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	listInsertCacheMut.RLock()
+	cache, cached := listInsertCache[key]
+	listInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			listAllColumns,
+			listColumnsWithDefault,
+			listColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(listType, listMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(listType, listMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"lists\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"lists\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into lists")
+	}
+
+	if !cached {
+		listInsertCacheMut.Lock()
+		listInsertCache[key] = cache
+		listInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the List.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *List) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	listUpdateCacheMut.RLock()
+	cache, cached := listUpdateCache[key]
+	listUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			listAllColumns,
+			listPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update lists, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"lists\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, listPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(listType, listMapping, append(wl, listPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update lists row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for lists")
+	}
+
+	if !cached {
+		listUpdateCacheMut.Lock()
+		listUpdateCache[key] = cache
+		listUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q listQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for lists")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for lists")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ListSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"lists\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, listPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in list slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all list")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *List) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no lists provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(listColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	listUpsertCacheMut.RLock()
+	cache, cached := listUpsertCache[key]
+	listUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			listAllColumns,
+			listColumnsWithDefault,
+			listColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			listAllColumns,
+			listPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert lists, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(listPrimaryKeyColumns))
+			copy(conflict, listPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"lists\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(listType, listMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(listType, listMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert lists")
+	}
+
+	if !cached {
+		listUpsertCacheMut.Lock()
+		listUpsertCache[key] = cache
+		listUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single List record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *List) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no List provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), listPrimaryKeyMapping)
+	sql := "DELETE FROM \"lists\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from lists")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for lists")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q listQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no listQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from lists")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for lists")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ListSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(listBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"lists\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, listPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from list slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for lists")
+	}
+
+	if len(listAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *List) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindList(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ListSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ListSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"lists\".* FROM \"lists\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, listPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ListSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ListExists checks if the List row exists.
+func ListExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"lists\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if lists exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1589 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// MediaItem is an object representing the database table.
+type MediaItem struct {
+	ID            int         `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	FilmID        null.Int    `db:"film_id" boil:"film_id" json:"film_id,omitempty" toml:"film_id" yaml:"film_id,omitempty"`
+	SeriesID      null.Int    `db:"series_id" boil:"series_id" json:"series_id,omitempty" toml:"series_id" yaml:"series_id,omitempty"`
+	Kind          string      `db:"kind" boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	URI           string      `db:"uri" boil:"uri" json:"uri" toml:"uri" yaml:"uri"`
+	Position      int         `db:"position" boil:"position" json:"position" toml:"position" yaml:"position"`
+	IsPrimary     bool        `db:"is_primary" boil:"is_primary" json:"is_primary" toml:"is_primary" yaml:"is_primary"`
+	RemovedAt     null.Time   `db:"removed_at" boil:"removed_at" json:"removed_at,omitempty" toml:"removed_at" yaml:"removed_at,omitempty"`
+	ContributedBy int         `db:"contributed_by" boil:"contributed_by" json:"contributed_by" toml:"contributed_by" yaml:"contributed_by"`
+	ContributedAt time.Time   `db:"contributed_at" boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
+	Invalidation  null.String `db:"invalidation" boil:"invalidation" json:"invalidation,omitempty" toml:"invalidation" yaml:"invalidation,omitempty"`
+
+	R *mediaItemR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L mediaItemL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MediaItemColumns = struct {
+	ID            string
+	FilmID        string
+	SeriesID      string
+	Kind          string
+	URI           string
+	Position      string
+	IsPrimary     string
+	RemovedAt     string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+}{
+	ID:            "id",
+	FilmID:        "film_id",
+	SeriesID:      "series_id",
+	Kind:          "kind",
+	URI:           "uri",
+	Position:      "position",
+	IsPrimary:     "is_primary",
+	RemovedAt:     "removed_at",
+	ContributedBy: "contributed_by",
+	ContributedAt: "contributed_at",
+	Invalidation:  "invalidation",
+}
+
+var MediaItemTableColumns = struct {
+	ID            string
+	FilmID        string
+	SeriesID      string
+	Kind          string
+	URI           string
+	Position      string
+	IsPrimary     string
+	RemovedAt     string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+}{
+	ID:            "media_items.id",
+	FilmID:        "media_items.film_id",
+	SeriesID:      "media_items.series_id",
+	Kind:          "media_items.kind",
+	URI:           "media_items.uri",
+	Position:      "media_items.position",
+	IsPrimary:     "media_items.is_primary",
+	RemovedAt:     "media_items.removed_at",
+	ContributedBy: "media_items.contributed_by",
+	ContributedAt: "media_items.contributed_at",
+	Invalidation:  "media_items.invalidation",
+}
+
+// Generated where
+
+var MediaItemWhere = struct {
+	ID            whereHelperint
+	FilmID        whereHelpernull_Int
+	SeriesID      whereHelpernull_Int
+	Kind          whereHelperstring
+	URI           whereHelperstring
+	Position      whereHelperint
+	IsPrimary     whereHelperbool
+	RemovedAt     whereHelpernull_Time
+	ContributedBy whereHelperint
+	ContributedAt whereHelpertime_Time
+	Invalidation  whereHelpernull_String
+}{
+	ID:            whereHelperint{field: "\"media_items\".\"id\""},
+	FilmID:        whereHelpernull_Int{field: "\"media_items\".\"film_id\""},
+	SeriesID:      whereHelpernull_Int{field: "\"media_items\".\"series_id\""},
+	Kind:          whereHelperstring{field: "\"media_items\".\"kind\""},
+	URI:           whereHelperstring{field: "\"media_items\".\"uri\""},
+	Position:      whereHelperint{field: "\"media_items\".\"position\""},
+	IsPrimary:     whereHelperbool{field: "\"media_items\".\"is_primary\""},
+	RemovedAt:     whereHelpernull_Time{field: "\"media_items\".\"removed_at\""},
+	ContributedBy: whereHelperint{field: "\"media_items\".\"contributed_by\""},
+	ContributedAt: whereHelpertime_Time{field: "\"media_items\".\"contributed_at\""},
+	Invalidation:  whereHelpernull_String{field: "\"media_items\".\"invalidation\""},
+}
+
+// MediaItemRels is where relationship names are stored.
+var MediaItemRels = struct {
+	ContributingUser string
+	Film             string
+	Series           string
+}{
+	ContributingUser: "ContributingUser",
+	Film:             "Film",
+	Series:           "Series",
+}
+
+// mediaItemR is where relationships are stored.
+type mediaItemR struct {
+	ContributingUser *User   `db:"ContributingUser" boil:"ContributingUser" json:"ContributingUser" toml:"ContributingUser" yaml:"ContributingUser"`
+	Film             *Film   `db:"Film" boil:"Film" json:"Film" toml:"Film" yaml:"Film"`
+	Series           *Series `db:"Series" boil:"Series" json:"Series" toml:"Series" yaml:"Series"`
+}
+
+// NewStruct creates a new relationship struct
+func (*mediaItemR) NewStruct() *mediaItemR {
+	return &mediaItemR{}
+}
+
+func (r *mediaItemR) GetContributingUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.ContributingUser
+}
+
+func (r *mediaItemR) GetFilm() *Film {
+	if r == nil {
+		return nil
+	}
+	return r.Film
+}
+
+func (r *mediaItemR) GetSeries() *Series {
+	if r == nil {
+		return nil
+	}
+	return r.Series
+}
+
+// mediaItemL is where Load methods for each relationship are stored.
+type mediaItemL struct{}
+
+var (
+	mediaItemAllColumns            = []string{"id", "film_id", "series_id", "kind", "uri", "position", "is_primary", "removed_at", "contributed_by", "contributed_at", "invalidation"}
+	mediaItemColumnsWithoutDefault = []string{"kind", "uri", "position", "contributed_by"}
+	mediaItemColumnsWithDefault    = []string{"id", "film_id", "series_id", "is_primary", "removed_at", "contributed_at", "invalidation"}
+	mediaItemPrimaryKeyColumns     = []string{"id"}
+	mediaItemGeneratedColumns      = []string{}
+)
+
+type (
+	// MediaItemSlice is an alias for a slice of pointers to MediaItem.
+	// This should almost always be used instead of []MediaItem.
+	MediaItemSlice []*MediaItem
+	// MediaItemHook is the signature for custom MediaItem hook methods
+	MediaItemHook func(context.Context, boil.ContextExecutor, *MediaItem) error
+
+	mediaItemQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	mediaItemType                 = reflect.TypeOf(&MediaItem{})
+	mediaItemMapping              = queries.MakeStructMapping(mediaItemType)
+	mediaItemPrimaryKeyMapping, _ = queries.BindMapping(mediaItemType, mediaItemMapping, mediaItemPrimaryKeyColumns)
+	mediaItemInsertCacheMut       sync.RWMutex
+	mediaItemInsertCache          = make(map[string]insertCache)
+	mediaItemUpdateCacheMut       sync.RWMutex
+	mediaItemUpdateCache          = make(map[string]updateCache)
+	mediaItemUpsertCacheMut       sync.RWMutex
+	mediaItemUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var mediaItemAfterSelectHooks []MediaItemHook
+
+var mediaItemBeforeInsertHooks []MediaItemHook
+var mediaItemAfterInsertHooks []MediaItemHook
+
+var mediaItemBeforeUpdateHooks []MediaItemHook
+var mediaItemAfterUpdateHooks []MediaItemHook
+
+var mediaItemBeforeDeleteHooks []MediaItemHook
+var mediaItemAfterDeleteHooks []MediaItemHook
+
+var mediaItemBeforeUpsertHooks []MediaItemHook
+var mediaItemAfterUpsertHooks []MediaItemHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *MediaItem) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mediaItemAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *MediaItem) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mediaItemBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *MediaItem) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mediaItemAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *MediaItem) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mediaItemBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *MediaItem) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mediaItemAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *MediaItem) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mediaItemBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *MediaItem) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mediaItemAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *MediaItem) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mediaItemBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *MediaItem) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mediaItemAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMediaItemHook registers your hook function for all future operations.
+func AddMediaItemHook(hookPoint boil.HookPoint, mediaItemHook MediaItemHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		mediaItemAfterSelectHooks = append(mediaItemAfterSelectHooks, mediaItemHook)
+	case boil.BeforeInsertHook:
+		mediaItemBeforeInsertHooks = append(mediaItemBeforeInsertHooks, mediaItemHook)
+	case boil.AfterInsertHook:
+		mediaItemAfterInsertHooks = append(mediaItemAfterInsertHooks, mediaItemHook)
+	case boil.BeforeUpdateHook:
+		mediaItemBeforeUpdateHooks = append(mediaItemBeforeUpdateHooks, mediaItemHook)
+	case boil.AfterUpdateHook:
+		mediaItemAfterUpdateHooks = append(mediaItemAfterUpdateHooks, mediaItemHook)
+	case boil.BeforeDeleteHook:
+		mediaItemBeforeDeleteHooks = append(mediaItemBeforeDeleteHooks, mediaItemHook)
+	case boil.AfterDeleteHook:
+		mediaItemAfterDeleteHooks = append(mediaItemAfterDeleteHooks, mediaItemHook)
+	case boil.BeforeUpsertHook:
+		mediaItemBeforeUpsertHooks = append(mediaItemBeforeUpsertHooks, mediaItemHook)
+	case boil.AfterUpsertHook:
+		mediaItemAfterUpsertHooks = append(mediaItemAfterUpsertHooks, mediaItemHook)
+	}
+}
+
+// One returns a single mediaItem record from the query.
+func (q mediaItemQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MediaItem, error) {
+	o := &MediaItem{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for media_items")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all MediaItem records from the query.
+func (q mediaItemQuery) All(ctx context.Context, exec boil.ContextExecutor) (MediaItemSlice, error) {
+	var o []*MediaItem
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to MediaItem slice")
+	}
+
+	if len(mediaItemAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all MediaItem records in the query.
+func (q mediaItemQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count media_items rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q mediaItemQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if media_items exists")
+	}
+
+	return count > 0, nil
+}
+
+// ContributingUser pointed to by the foreign key.
+func (o *MediaItem) ContributingUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ContributedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Film pointed to by the foreign key.
+func (o *MediaItem) Film(mods ...qm.QueryMod) filmQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FilmID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Films(queryMods...)
+}
+
+// Series pointed to by the foreign key.
+func (o *MediaItem) Series(mods ...qm.QueryMod) seriesQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SeriesID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Serieses(queryMods...)
+}
+
+// LoadContributingUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (mediaItemL) LoadContributingUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMediaItem interface{}, mods queries.Applicator) error {
+	var slice []*MediaItem
+	var object *MediaItem
+
+	if singular {
+		var ok bool
+		object, ok = maybeMediaItem.(*MediaItem)
+		if !ok {
+			object = new(MediaItem)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMediaItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMediaItem))
+			}
+		}
+	} else {
+		s, ok := maybeMediaItem.(*[]*MediaItem)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMediaItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMediaItem))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &mediaItemR{}
+		}
+		args = append(args, object.ContributedBy)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &mediaItemR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ContributedBy {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ContributedBy)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(mediaItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ContributingUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ContributedMediaItems = append(foreign.R.ContributedMediaItems, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ContributedBy == foreign.ID {
+				local.R.ContributingUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ContributedMediaItems = append(foreign.R.ContributedMediaItems, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFilm allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (mediaItemL) LoadFilm(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMediaItem interface{}, mods queries.Applicator) error {
+	var slice []*MediaItem
+	var object *MediaItem
+
+	if singular {
+		var ok bool
+		object, ok = maybeMediaItem.(*MediaItem)
+		if !ok {
+			object = new(MediaItem)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMediaItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMediaItem))
+			}
+		}
+	} else {
+		s, ok := maybeMediaItem.(*[]*MediaItem)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMediaItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMediaItem))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &mediaItemR{}
+		}
+		if !queries.IsNil(object.FilmID) {
+			args = append(args, object.FilmID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &mediaItemR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.FilmID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.FilmID) {
+				args = append(args, obj.FilmID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`films`),
+		qm.WhereIn(`films.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Film")
+	}
+
+	var resultSlice []*Film
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Film")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for films")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for films")
+	}
+
+	if len(mediaItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Film = foreign
+		if foreign.R == nil {
+			foreign.R = &filmR{}
+		}
+		foreign.R.MediaItems = append(foreign.R.MediaItems, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.FilmID, foreign.ID) {
+				local.R.Film = foreign
+				if foreign.R == nil {
+					foreign.R = &filmR{}
+				}
+				foreign.R.MediaItems = append(foreign.R.MediaItems, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSeries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (mediaItemL) LoadSeries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMediaItem interface{}, mods queries.Applicator) error {
+	var slice []*MediaItem
+	var object *MediaItem
+
+	if singular {
+		var ok bool
+		object, ok = maybeMediaItem.(*MediaItem)
+		if !ok {
+			object = new(MediaItem)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMediaItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMediaItem))
+			}
+		}
+	} else {
+		s, ok := maybeMediaItem.(*[]*MediaItem)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMediaItem)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMediaItem))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &mediaItemR{}
+		}
+		if !queries.IsNil(object.SeriesID) {
+			args = append(args, object.SeriesID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &mediaItemR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.SeriesID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.SeriesID) {
+				args = append(args, obj.SeriesID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`serieses`),
+		qm.WhereIn(`serieses.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Series")
+	}
+
+	var resultSlice []*Series
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Series")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for serieses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for serieses")
+	}
+
+	if len(mediaItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Series = foreign
+		if foreign.R == nil {
+			foreign.R = &seriesR{}
+		}
+		foreign.R.SeriesMediaItems = append(foreign.R.SeriesMediaItems, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.SeriesID, foreign.ID) {
+				local.R.Series = foreign
+				if foreign.R == nil {
+					foreign.R = &seriesR{}
+				}
+				foreign.R.SeriesMediaItems = append(foreign.R.SeriesMediaItems, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetContributingUser of the mediaItem to the related item.
+// Sets o.R.ContributingUser to related.
+// Adds o to related.R.ContributedMediaItems.
+func (o *MediaItem) SetContributingUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"media_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
+		strmangle.WhereClause("\"", "\"", 2, mediaItemPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ContributedBy = related.ID
+	if o.R == nil {
+		o.R = &mediaItemR{
+			ContributingUser: related,
+		}
+	} else {
+		o.R.ContributingUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ContributedMediaItems: MediaItemSlice{o},
+		}
+	} else {
+		related.R.ContributedMediaItems = append(related.R.ContributedMediaItems, o)
+	}
+
+	return nil
+}
+
+// SetFilm of the mediaItem to the related item.
+// Sets o.R.Film to related.
+// Adds o to related.R.MediaItems.
+func (o *MediaItem) SetFilm(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Film) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"media_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+		strmangle.WhereClause("\"", "\"", 2, mediaItemPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.FilmID, related.ID)
+	if o.R == nil {
+		o.R = &mediaItemR{
+			Film: related,
+		}
+	} else {
+		o.R.Film = related
+	}
+
+	if related.R == nil {
+		related.R = &filmR{
+			MediaItems: MediaItemSlice{o},
+		}
+	} else {
+		related.R.MediaItems = append(related.R.MediaItems, o)
+	}
+
+	return nil
+}
+
+// RemoveFilm relationship.
+// Sets o.R.Film to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *MediaItem) RemoveFilm(ctx context.Context, exec boil.ContextExecutor, related *Film) error {
+	var err error
+
+	queries.SetScanner(&o.FilmID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("film_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Film = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.MediaItems {
+		if queries.Equal(o.FilmID, ri.FilmID) {
+			continue
+		}
+
+		ln := len(related.R.MediaItems)
+		if ln > 1 && i < ln-1 {
+			related.R.MediaItems[i] = related.R.MediaItems[ln-1]
+		}
+		related.R.MediaItems = related.R.MediaItems[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetSeries of the mediaItem to the related item.
+// Sets o.R.Series to related.
+// Adds o to related.R.SeriesMediaItems.
+func (o *MediaItem) SetSeries(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Series) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"media_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+		strmangle.WhereClause("\"", "\"", 2, mediaItemPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.SeriesID, related.ID)
+	if o.R == nil {
+		o.R = &mediaItemR{
+			Series: related,
+		}
+	} else {
+		o.R.Series = related
+	}
+
+	if related.R == nil {
+		related.R = &seriesR{
+			SeriesMediaItems: MediaItemSlice{o},
+		}
+	} else {
+		related.R.SeriesMediaItems = append(related.R.SeriesMediaItems, o)
+	}
+
+	return nil
+}
+
+// RemoveSeries relationship.
+// Sets o.R.Series to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *MediaItem) RemoveSeries(ctx context.Context, exec boil.ContextExecutor, related *Series) error {
+	var err error
+
+	queries.SetScanner(&o.SeriesID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("series_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Series = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.SeriesMediaItems {
+		if queries.Equal(o.SeriesID, ri.SeriesID) {
+			continue
+		}
+
+		ln := len(related.R.SeriesMediaItems)
+		if ln > 1 && i < ln-1 {
+			related.R.SeriesMediaItems[i] = related.R.SeriesMediaItems[ln-1]
+		}
+		related.R.SeriesMediaItems = related.R.SeriesMediaItems[:ln-1]
+		break
+	}
+	return nil
+}
+
+// MediaItems retrieves all the records using an executor.
+func MediaItems(mods ...qm.QueryMod) mediaItemQuery {
+	mods = append(mods, qm.From("\"media_items\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"media_items\".*"})
+	}
+
+	return mediaItemQuery{q}
+}
+
+// FindMediaItem retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMediaItem(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*MediaItem, error) {
+	mediaItemObj := &MediaItem{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"media_items\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, mediaItemObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from media_items")
+	}
+
+	if err = mediaItemObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return mediaItemObj, err
+	}
+
+	return mediaItemObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MediaItem) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no media_items provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(mediaItemColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	mediaItemInsertCacheMut.RLock()
+	cache, cached := mediaItemInsertCache[key]
+	mediaItemInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			mediaItemAllColumns,
+			mediaItemColumnsWithDefault,
+			mediaItemColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(mediaItemType, mediaItemMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(mediaItemType, mediaItemMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"media_items\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"media_items\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into media_items")
+	}
+
+	if !cached {
+		mediaItemInsertCacheMut.Lock()
+		mediaItemInsertCache[key] = cache
+		mediaItemInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the MediaItem.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MediaItem) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	mediaItemUpdateCacheMut.RLock()
+	cache, cached := mediaItemUpdateCache[key]
+	mediaItemUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			mediaItemAllColumns,
+			mediaItemPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update media_items, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"media_items\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, mediaItemPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(mediaItemType, mediaItemMapping, append(wl, mediaItemPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update media_items row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for media_items")
+	}
+
+	if !cached {
+		mediaItemUpdateCacheMut.Lock()
+		mediaItemUpdateCache[key] = cache
+		mediaItemUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q mediaItemQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for media_items")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for media_items")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MediaItemSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mediaItemPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"media_items\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, mediaItemPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in mediaItem slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all mediaItem")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MediaItem) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no media_items provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(mediaItemColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	mediaItemUpsertCacheMut.RLock()
+	cache, cached := mediaItemUpsertCache[key]
+	mediaItemUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			mediaItemAllColumns,
+			mediaItemColumnsWithDefault,
+			mediaItemColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			mediaItemAllColumns,
+			mediaItemPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert media_items, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(mediaItemPrimaryKeyColumns))
+			copy(conflict, mediaItemPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"media_items\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(mediaItemType, mediaItemMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(mediaItemType, mediaItemMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert media_items")
+	}
+
+	if !cached {
+		mediaItemUpsertCacheMut.Lock()
+		mediaItemUpsertCache[key] = cache
+		mediaItemUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single MediaItem record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MediaItem) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no MediaItem provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), mediaItemPrimaryKeyMapping)
+	sql := "DELETE FROM \"media_items\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from media_items")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for media_items")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q mediaItemQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no mediaItemQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from media_items")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for media_items")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MediaItemSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(mediaItemBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mediaItemPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"media_items\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, mediaItemPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from mediaItem slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for media_items")
+	}
+
+	if len(mediaItemAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MediaItem) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMediaItem(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MediaItemSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MediaItemSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mediaItemPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"media_items\".* FROM \"media_items\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, mediaItemPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in MediaItemSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MediaItemExists checks if the MediaItem row exists.
+func MediaItemExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"media_items\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if media_items exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,964 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// MediaItemsAudit is an object representing the database table.
+type MediaItemsAudit struct {
+	ID            int         `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	FilmID        null.Int    `db:"film_id" boil:"film_id" json:"film_id,omitempty" toml:"film_id" yaml:"film_id,omitempty"`
+	SeriesID      null.Int    `db:"series_id" boil:"series_id" json:"series_id,omitempty" toml:"series_id" yaml:"series_id,omitempty"`
+	Kind          string      `db:"kind" boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	URI           string      `db:"uri" boil:"uri" json:"uri" toml:"uri" yaml:"uri"`
+	Position      int         `db:"position" boil:"position" json:"position" toml:"position" yaml:"position"`
+	IsPrimary     bool        `db:"is_primary" boil:"is_primary" json:"is_primary" toml:"is_primary" yaml:"is_primary"`
+	RemovedAt     null.Time   `db:"removed_at" boil:"removed_at" json:"removed_at,omitempty" toml:"removed_at" yaml:"removed_at,omitempty"`
+	ContributedBy int         `db:"contributed_by" boil:"contributed_by" json:"contributed_by" toml:"contributed_by" yaml:"contributed_by"`
+	ContributedAt time.Time   `db:"contributed_at" boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
+	Invalidation  null.String `db:"invalidation" boil:"invalidation" json:"invalidation,omitempty" toml:"invalidation" yaml:"invalidation,omitempty"`
+	AuditAction   string      `db:"audit_action" boil:"audit_action" json:"audit_action" toml:"audit_action" yaml:"audit_action"`
+	AuditActor    null.Int    `db:"audit_actor" boil:"audit_actor" json:"audit_actor,omitempty" toml:"audit_actor" yaml:"audit_actor,omitempty"`
+
+	R *mediaItemsAuditR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L mediaItemsAuditL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MediaItemsAuditColumns = struct {
+	ID            string
+	FilmID        string
+	SeriesID      string
+	Kind          string
+	URI           string
+	Position      string
+	IsPrimary     string
+	RemovedAt     string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+	AuditAction   string
+	AuditActor    string
+}{
+	ID:            "id",
+	FilmID:        "film_id",
+	SeriesID:      "series_id",
+	Kind:          "kind",
+	URI:           "uri",
+	Position:      "position",
+	IsPrimary:     "is_primary",
+	RemovedAt:     "removed_at",
+	ContributedBy: "contributed_by",
+	ContributedAt: "contributed_at",
+	Invalidation:  "invalidation",
+	AuditAction:   "audit_action",
+	AuditActor:    "audit_actor",
+}
+
+var MediaItemsAuditTableColumns = struct {
+	ID            string
+	FilmID        string
+	SeriesID      string
+	Kind          string
+	URI           string
+	Position      string
+	IsPrimary     string
+	RemovedAt     string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+	AuditAction   string
+	AuditActor    string
+}{
+	ID:            "media_items_audit.id",
+	FilmID:        "media_items_audit.film_id",
+	SeriesID:      "media_items_audit.series_id",
+	Kind:          "media_items_audit.kind",
+	URI:           "media_items_audit.uri",
+	Position:      "media_items_audit.position",
+	IsPrimary:     "media_items_audit.is_primary",
+	RemovedAt:     "media_items_audit.removed_at",
+	ContributedBy: "media_items_audit.contributed_by",
+	ContributedAt: "media_items_audit.contributed_at",
+	Invalidation:  "media_items_audit.invalidation",
+	AuditAction:   "media_items_audit.audit_action",
+	AuditActor:    "media_items_audit.audit_actor",
+}
+
+// Generated where
+
+var MediaItemsAuditWhere = struct {
+	ID            whereHelperint
+	FilmID        whereHelpernull_Int
+	SeriesID      whereHelpernull_Int
+	Kind          whereHelperstring
+	URI           whereHelperstring
+	Position      whereHelperint
+	IsPrimary     whereHelperbool
+	RemovedAt     whereHelpernull_Time
+	ContributedBy whereHelperint
+	ContributedAt whereHelpertime_Time
+	Invalidation  whereHelpernull_String
+	AuditAction   whereHelperstring
+	AuditActor    whereHelpernull_Int
+}{
+	ID:            whereHelperint{field: "\"media_items_audit\".\"id\""},
+	FilmID:        whereHelpernull_Int{field: "\"media_items_audit\".\"film_id\""},
+	SeriesID:      whereHelpernull_Int{field: "\"media_items_audit\".\"series_id\""},
+	Kind:          whereHelperstring{field: "\"media_items_audit\".\"kind\""},
+	URI:           whereHelperstring{field: "\"media_items_audit\".\"uri\""},
+	Position:      whereHelperint{field: "\"media_items_audit\".\"position\""},
+	IsPrimary:     whereHelperbool{field: "\"media_items_audit\".\"is_primary\""},
+	RemovedAt:     whereHelpernull_Time{field: "\"media_items_audit\".\"removed_at\""},
+	ContributedBy: whereHelperint{field: "\"media_items_audit\".\"contributed_by\""},
+	ContributedAt: whereHelpertime_Time{field: "\"media_items_audit\".\"contributed_at\""},
+	Invalidation:  whereHelpernull_String{field: "\"media_items_audit\".\"invalidation\""},
+	AuditAction:   whereHelperstring{field: "\"media_items_audit\".\"audit_action\""},
+	AuditActor:    whereHelpernull_Int{field: "\"media_items_audit\".\"audit_actor\""},
+}
+
+// MediaItemsAuditRels is where relationship names are stored.
+var MediaItemsAuditRels = struct {
+}{}
+
+// mediaItemsAuditR is where relationships are stored.
+type mediaItemsAuditR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*mediaItemsAuditR) NewStruct() *mediaItemsAuditR {
+	return &mediaItemsAuditR{}
+}
+
+// mediaItemsAuditL is where Load methods for each relationship are stored.
+type mediaItemsAuditL struct{}
+
+var (
+	mediaItemsAuditAllColumns            = []string{"id", "film_id", "series_id", "kind", "uri", "position", "is_primary", "removed_at", "contributed_by", "contributed_at", "invalidation", "audit_action", "audit_actor"}
+	mediaItemsAuditColumnsWithoutDefault = []string{"id", "kind", "uri", "position", "is_primary", "contributed_by", "contributed_at"}
+	mediaItemsAuditColumnsWithDefault    = []string{"film_id", "series_id", "removed_at", "invalidation", "audit_action", "audit_actor"}
+	mediaItemsAuditPrimaryKeyColumns     = []string{"id", "contributed_by", "contributed_at"}
+	mediaItemsAuditGeneratedColumns      = []string{}
+)
+
+type (
+	// MediaItemsAuditSlice is an alias for a slice of pointers to MediaItemsAudit.
+	// This should almost always be used instead of []MediaItemsAudit.
+	MediaItemsAuditSlice []*MediaItemsAudit
+	// MediaItemsAuditHook is the signature for custom MediaItemsAudit hook methods
+	MediaItemsAuditHook func(context.Context, boil.ContextExecutor, *MediaItemsAudit) error
+
+	mediaItemsAuditQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	mediaItemsAuditType                 = reflect.TypeOf(&MediaItemsAudit{})
+	mediaItemsAuditMapping              = queries.MakeStructMapping(mediaItemsAuditType)
+	mediaItemsAuditPrimaryKeyMapping, _ = queries.BindMapping(mediaItemsAuditType, mediaItemsAuditMapping, mediaItemsAuditPrimaryKeyColumns)
+	mediaItemsAuditInsertCacheMut       sync.RWMutex
+	mediaItemsAuditInsertCache          = make(map[string]insertCache)
+	mediaItemsAuditUpdateCacheMut       sync.RWMutex
+	mediaItemsAuditUpdateCache          = make(map[string]updateCache)
+	mediaItemsAuditUpsertCacheMut       sync.RWMutex
+	mediaItemsAuditUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var mediaItemsAuditAfterSelectHooks []MediaItemsAuditHook
+
+var mediaItemsAuditBeforeInsertHooks []MediaItemsAuditHook
+var mediaItemsAuditAfterInsertHooks []MediaItemsAuditHook
+
+var mediaItemsAuditBeforeUpdateHooks []MediaItemsAuditHook
+var mediaItemsAuditAfterUpdateHooks []MediaItemsAuditHook
+
+var mediaItemsAuditBeforeDeleteHooks []MediaItemsAuditHook
+var mediaItemsAuditAfterDeleteHooks []MediaItemsAuditHook
+
+var mediaItemsAuditBeforeUpsertHooks []MediaItemsAuditHook
+var mediaItemsAuditAfterUpsertHooks []MediaItemsAuditHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *MediaItemsAudit) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mediaItemsAuditAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *MediaItemsAudit) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mediaItemsAuditBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *MediaItemsAudit) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mediaItemsAuditAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *MediaItemsAudit) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mediaItemsAuditBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *MediaItemsAudit) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mediaItemsAuditAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *MediaItemsAudit) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mediaItemsAuditBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *MediaItemsAudit) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mediaItemsAuditAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *MediaItemsAudit) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mediaItemsAuditBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *MediaItemsAudit) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mediaItemsAuditAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMediaItemsAuditHook registers your hook function for all future operations.
+func AddMediaItemsAuditHook(hookPoint boil.HookPoint, mediaItemsAuditHook MediaItemsAuditHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		mediaItemsAuditAfterSelectHooks = append(mediaItemsAuditAfterSelectHooks, mediaItemsAuditHook)
+	case boil.BeforeInsertHook:
+		mediaItemsAuditBeforeInsertHooks = append(mediaItemsAuditBeforeInsertHooks, mediaItemsAuditHook)
+	case boil.AfterInsertHook:
+		mediaItemsAuditAfterInsertHooks = append(mediaItemsAuditAfterInsertHooks, mediaItemsAuditHook)
+	case boil.BeforeUpdateHook:
+		mediaItemsAuditBeforeUpdateHooks = append(mediaItemsAuditBeforeUpdateHooks, mediaItemsAuditHook)
+	case boil.AfterUpdateHook:
+		mediaItemsAuditAfterUpdateHooks = append(mediaItemsAuditAfterUpdateHooks, mediaItemsAuditHook)
+	case boil.BeforeDeleteHook:
+		mediaItemsAuditBeforeDeleteHooks = append(mediaItemsAuditBeforeDeleteHooks, mediaItemsAuditHook)
+	case boil.AfterDeleteHook:
+		mediaItemsAuditAfterDeleteHooks = append(mediaItemsAuditAfterDeleteHooks, mediaItemsAuditHook)
+	case boil.BeforeUpsertHook:
+		mediaItemsAuditBeforeUpsertHooks = append(mediaItemsAuditBeforeUpsertHooks, mediaItemsAuditHook)
+	case boil.AfterUpsertHook:
+		mediaItemsAuditAfterUpsertHooks = append(mediaItemsAuditAfterUpsertHooks, mediaItemsAuditHook)
+	}
+}
+
+// One returns a single mediaItemsAudit record from the query.
+func (q mediaItemsAuditQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MediaItemsAudit, error) {
+	o := &MediaItemsAudit{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for media_items_audit")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all MediaItemsAudit records from the query.
+func (q mediaItemsAuditQuery) All(ctx context.Context, exec boil.ContextExecutor) (MediaItemsAuditSlice, error) {
+	var o []*MediaItemsAudit
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to MediaItemsAudit slice")
+	}
+
+	if len(mediaItemsAuditAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all MediaItemsAudit records in the query.
+func (q mediaItemsAuditQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count media_items_audit rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q mediaItemsAuditQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if media_items_audit exists")
+	}
+
+	return count > 0, nil
+}
+
+// MediaItemsAudits retrieves all the records using an executor.
+func MediaItemsAudits(mods ...qm.QueryMod) mediaItemsAuditQuery {
+	mods = append(mods, qm.From("\"media_items_audit\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"media_items_audit\".*"})
+	}
+
+	return mediaItemsAuditQuery{q}
+}
+
+// FindMediaItemsAudit retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMediaItemsAudit(ctx context.Context, exec boil.ContextExecutor, iD int, contributedBy int, contributedAt time.Time, selectCols ...string) (*MediaItemsAudit, error) {
+	mediaItemsAuditObj := &MediaItemsAudit{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"media_items_audit\" where \"id\"=$1 AND \"contributed_by\"=$2 AND \"contributed_at\"=$3", sel,
+	)
+
+	q := queries.Raw(query, iD, contributedBy, contributedAt)
+
+	err := q.Bind(ctx, exec, mediaItemsAuditObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from media_items_audit")
+	}
+
+	if err = mediaItemsAuditObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return mediaItemsAuditObj, err
+	}
+
+	return mediaItemsAuditObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MediaItemsAudit) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no media_items_audit provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(mediaItemsAuditColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	mediaItemsAuditInsertCacheMut.RLock()
+	cache, cached := mediaItemsAuditInsertCache[key]
+	mediaItemsAuditInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			mediaItemsAuditAllColumns,
+			mediaItemsAuditColumnsWithDefault,
+			mediaItemsAuditColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(mediaItemsAuditType, mediaItemsAuditMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(mediaItemsAuditType, mediaItemsAuditMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"media_items_audit\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"media_items_audit\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into media_items_audit")
+	}
+
+	if !cached {
+		mediaItemsAuditInsertCacheMut.Lock()
+		mediaItemsAuditInsertCache[key] = cache
+		mediaItemsAuditInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the MediaItemsAudit.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MediaItemsAudit) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	mediaItemsAuditUpdateCacheMut.RLock()
+	cache, cached := mediaItemsAuditUpdateCache[key]
+	mediaItemsAuditUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			mediaItemsAuditAllColumns,
+			mediaItemsAuditPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update media_items_audit, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"media_items_audit\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, mediaItemsAuditPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(mediaItemsAuditType, mediaItemsAuditMapping, append(wl, mediaItemsAuditPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update media_items_audit row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for media_items_audit")
+	}
+
+	if !cached {
+		mediaItemsAuditUpdateCacheMut.Lock()
+		mediaItemsAuditUpdateCache[key] = cache
+		mediaItemsAuditUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q mediaItemsAuditQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for media_items_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for media_items_audit")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MediaItemsAuditSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mediaItemsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"media_items_audit\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, mediaItemsAuditPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in mediaItemsAudit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all mediaItemsAudit")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MediaItemsAudit) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no media_items_audit provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(mediaItemsAuditColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	mediaItemsAuditUpsertCacheMut.RLock()
+	cache, cached := mediaItemsAuditUpsertCache[key]
+	mediaItemsAuditUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			mediaItemsAuditAllColumns,
+			mediaItemsAuditColumnsWithDefault,
+			mediaItemsAuditColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			mediaItemsAuditAllColumns,
+			mediaItemsAuditPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert media_items_audit, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(mediaItemsAuditPrimaryKeyColumns))
+			copy(conflict, mediaItemsAuditPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"media_items_audit\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(mediaItemsAuditType, mediaItemsAuditMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(mediaItemsAuditType, mediaItemsAuditMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert media_items_audit")
+	}
+
+	if !cached {
+		mediaItemsAuditUpsertCacheMut.Lock()
+		mediaItemsAuditUpsertCache[key] = cache
+		mediaItemsAuditUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single MediaItemsAudit record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MediaItemsAudit) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no MediaItemsAudit provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), mediaItemsAuditPrimaryKeyMapping)
+	sql := "DELETE FROM \"media_items_audit\" WHERE \"id\"=$1 AND \"contributed_by\"=$2 AND \"contributed_at\"=$3"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from media_items_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for media_items_audit")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q mediaItemsAuditQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no mediaItemsAuditQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from media_items_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for media_items_audit")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MediaItemsAuditSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(mediaItemsAuditBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mediaItemsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"media_items_audit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, mediaItemsAuditPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from mediaItemsAudit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for media_items_audit")
+	}
+
+	if len(mediaItemsAuditAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MediaItemsAudit) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMediaItemsAudit(ctx, exec, o.ID, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MediaItemsAuditSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MediaItemsAuditSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mediaItemsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"media_items_audit\".* FROM \"media_items_audit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, mediaItemsAuditPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in MediaItemsAuditSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MediaItemsAuditExists checks if the MediaItemsAudit row exists.
+func MediaItemsAuditExists(ctx context.Context, exec boil.ContextExecutor, iD int, contributedBy int, contributedAt time.Time) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"media_items_audit\" where \"id\"=$1 AND \"contributed_by\"=$2 AND \"contributed_at\"=$3 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD, contributedBy, contributedAt)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD, contributedBy, contributedAt)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if media_items_audit exists")
+	}
+
+	return exists, nil
+}
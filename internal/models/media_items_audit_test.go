@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testMediaItemsAudits(t *testing.T) {
+	t.Parallel()
+
+	query := MediaItemsAudits()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testMediaItemsAuditsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItemsAudit{}
+	if err = randomize.Struct(seed, o, mediaItemsAuditDBTypes, true, mediaItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := MediaItemsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMediaItemsAuditsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItemsAudit{}
+	if err = randomize.Struct(seed, o, mediaItemsAuditDBTypes, true, mediaItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := MediaItemsAudits().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := MediaItemsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMediaItemsAuditsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItemsAudit{}
+	if err = randomize.Struct(seed, o, mediaItemsAuditDBTypes, true, mediaItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := MediaItemsAuditSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := MediaItemsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMediaItemsAuditsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItemsAudit{}
+	if err = randomize.Struct(seed, o, mediaItemsAuditDBTypes, true, mediaItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := MediaItemsAuditExists(ctx, tx, o.ID, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		t.Errorf("Unable to check if MediaItemsAudit exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected MediaItemsAuditExists to return true, but got false.")
+	}
+}
+
+func testMediaItemsAuditsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItemsAudit{}
+	if err = randomize.Struct(seed, o, mediaItemsAuditDBTypes, true, mediaItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	mediaItemsAuditFound, err := FindMediaItemsAudit(ctx, tx, o.ID, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if mediaItemsAuditFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testMediaItemsAuditsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItemsAudit{}
+	if err = randomize.Struct(seed, o, mediaItemsAuditDBTypes, true, mediaItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = MediaItemsAudits().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMediaItemsAuditsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItemsAudit{}
+	if err = randomize.Struct(seed, o, mediaItemsAuditDBTypes, true, mediaItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := MediaItemsAudits().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testMediaItemsAuditsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	mediaItemsAuditOne := &MediaItemsAudit{}
+	mediaItemsAuditTwo := &MediaItemsAudit{}
+	if err = randomize.Struct(seed, mediaItemsAuditOne, mediaItemsAuditDBTypes, false, mediaItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItemsAudit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, mediaItemsAuditTwo, mediaItemsAuditDBTypes, false, mediaItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = mediaItemsAuditOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = mediaItemsAuditTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := MediaItemsAudits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testMediaItemsAuditsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	mediaItemsAuditOne := &MediaItemsAudit{}
+	mediaItemsAuditTwo := &MediaItemsAudit{}
+	if err = randomize.Struct(seed, mediaItemsAuditOne, mediaItemsAuditDBTypes, false, mediaItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItemsAudit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, mediaItemsAuditTwo, mediaItemsAuditDBTypes, false, mediaItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = mediaItemsAuditOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = mediaItemsAuditTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MediaItemsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func mediaItemsAuditBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *MediaItemsAudit) error {
+	*o = MediaItemsAudit{}
+	return nil
+}
+
+func mediaItemsAuditAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *MediaItemsAudit) error {
+	*o = MediaItemsAudit{}
+	return nil
+}
+
+func mediaItemsAuditAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *MediaItemsAudit) error {
+	*o = MediaItemsAudit{}
+	return nil
+}
+
+func mediaItemsAuditBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *MediaItemsAudit) error {
+	*o = MediaItemsAudit{}
+	return nil
+}
+
+func mediaItemsAuditAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *MediaItemsAudit) error {
+	*o = MediaItemsAudit{}
+	return nil
+}
+
+func mediaItemsAuditBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *MediaItemsAudit) error {
+	*o = MediaItemsAudit{}
+	return nil
+}
+
+func mediaItemsAuditAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *MediaItemsAudit) error {
+	*o = MediaItemsAudit{}
+	return nil
+}
+
+func mediaItemsAuditBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *MediaItemsAudit) error {
+	*o = MediaItemsAudit{}
+	return nil
+}
+
+func mediaItemsAuditAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *MediaItemsAudit) error {
+	*o = MediaItemsAudit{}
+	return nil
+}
+
+func testMediaItemsAuditsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &MediaItemsAudit{}
+	o := &MediaItemsAudit{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, mediaItemsAuditDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize MediaItemsAudit object: %s", err)
+	}
+
+	AddMediaItemsAuditHook(boil.BeforeInsertHook, mediaItemsAuditBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	mediaItemsAuditBeforeInsertHooks = []MediaItemsAuditHook{}
+
+	AddMediaItemsAuditHook(boil.AfterInsertHook, mediaItemsAuditAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	mediaItemsAuditAfterInsertHooks = []MediaItemsAuditHook{}
+
+	AddMediaItemsAuditHook(boil.AfterSelectHook, mediaItemsAuditAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	mediaItemsAuditAfterSelectHooks = []MediaItemsAuditHook{}
+
+	AddMediaItemsAuditHook(boil.BeforeUpdateHook, mediaItemsAuditBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	mediaItemsAuditBeforeUpdateHooks = []MediaItemsAuditHook{}
+
+	AddMediaItemsAuditHook(boil.AfterUpdateHook, mediaItemsAuditAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	mediaItemsAuditAfterUpdateHooks = []MediaItemsAuditHook{}
+
+	AddMediaItemsAuditHook(boil.BeforeDeleteHook, mediaItemsAuditBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	mediaItemsAuditBeforeDeleteHooks = []MediaItemsAuditHook{}
+
+	AddMediaItemsAuditHook(boil.AfterDeleteHook, mediaItemsAuditAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	mediaItemsAuditAfterDeleteHooks = []MediaItemsAuditHook{}
+
+	AddMediaItemsAuditHook(boil.BeforeUpsertHook, mediaItemsAuditBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	mediaItemsAuditBeforeUpsertHooks = []MediaItemsAuditHook{}
+
+	AddMediaItemsAuditHook(boil.AfterUpsertHook, mediaItemsAuditAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	mediaItemsAuditAfterUpsertHooks = []MediaItemsAuditHook{}
+}
+
+func testMediaItemsAuditsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItemsAudit{}
+	if err = randomize.Struct(seed, o, mediaItemsAuditDBTypes, true, mediaItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MediaItemsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testMediaItemsAuditsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItemsAudit{}
+	if err = randomize.Struct(seed, o, mediaItemsAuditDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize MediaItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(mediaItemsAuditColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MediaItemsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testMediaItemsAuditsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItemsAudit{}
+	if err = randomize.Struct(seed, o, mediaItemsAuditDBTypes, true, mediaItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMediaItemsAuditsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItemsAudit{}
+	if err = randomize.Struct(seed, o, mediaItemsAuditDBTypes, true, mediaItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := MediaItemsAuditSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMediaItemsAuditsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItemsAudit{}
+	if err = randomize.Struct(seed, o, mediaItemsAuditDBTypes, true, mediaItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := MediaItemsAudits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	mediaItemsAuditDBTypes = map[string]string{`ID`: `integer`, `FilmID`: `integer`, `SeriesID`: `integer`, `Kind`: `character varying`, `URI`: `character varying`, `Position`: `integer`, `IsPrimary`: `boolean`, `RemovedAt`: `timestamp with time zone`, `ContributedBy`: `integer`, `ContributedAt`: `timestamp with time zone`, `Invalidation`: `character varying`, `AuditAction`: `character varying`, `AuditActor`: `integer`}
+	_                      = bytes.MinRead
+)
+
+func testMediaItemsAuditsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(mediaItemsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(mediaItemsAuditAllColumns) == len(mediaItemsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItemsAudit{}
+	if err = randomize.Struct(seed, o, mediaItemsAuditDBTypes, true, mediaItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MediaItemsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, mediaItemsAuditDBTypes, true, mediaItemsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize MediaItemsAudit struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testMediaItemsAuditsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(mediaItemsAuditAllColumns) == len(mediaItemsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItemsAudit{}
+	if err = randomize.Struct(seed, o, mediaItemsAuditDBTypes, true, mediaItemsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MediaItemsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, mediaItemsAuditDBTypes, true, mediaItemsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize MediaItemsAudit struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(mediaItemsAuditAllColumns, mediaItemsAuditPrimaryKeyColumns) {
+		fields = mediaItemsAuditAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			mediaItemsAuditAllColumns,
+			mediaItemsAuditPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := MediaItemsAuditSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testMediaItemsAuditsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(mediaItemsAuditAllColumns) == len(mediaItemsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := MediaItemsAudit{}
+	if err = randomize.Struct(seed, &o, mediaItemsAuditDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize MediaItemsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert MediaItemsAudit: %s", err)
+	}
+
+	count, err := MediaItemsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, mediaItemsAuditDBTypes, false, mediaItemsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize MediaItemsAudit struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert MediaItemsAudit: %s", err)
+	}
+
+	count, err = MediaItemsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
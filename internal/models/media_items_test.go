@@ -0,0 +1,1160 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testMediaItems(t *testing.T) {
+	t.Parallel()
+
+	query := MediaItems()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testMediaItemsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItem{}
+	if err = randomize.Struct(seed, o, mediaItemDBTypes, true, mediaItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := MediaItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMediaItemsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItem{}
+	if err = randomize.Struct(seed, o, mediaItemDBTypes, true, mediaItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := MediaItems().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := MediaItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMediaItemsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItem{}
+	if err = randomize.Struct(seed, o, mediaItemDBTypes, true, mediaItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := MediaItemSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := MediaItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testMediaItemsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItem{}
+	if err = randomize.Struct(seed, o, mediaItemDBTypes, true, mediaItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := MediaItemExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if MediaItem exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected MediaItemExists to return true, but got false.")
+	}
+}
+
+func testMediaItemsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItem{}
+	if err = randomize.Struct(seed, o, mediaItemDBTypes, true, mediaItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	mediaItemFound, err := FindMediaItem(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if mediaItemFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testMediaItemsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItem{}
+	if err = randomize.Struct(seed, o, mediaItemDBTypes, true, mediaItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = MediaItems().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMediaItemsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItem{}
+	if err = randomize.Struct(seed, o, mediaItemDBTypes, true, mediaItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := MediaItems().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testMediaItemsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	mediaItemOne := &MediaItem{}
+	mediaItemTwo := &MediaItem{}
+	if err = randomize.Struct(seed, mediaItemOne, mediaItemDBTypes, false, mediaItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItem struct: %s", err)
+	}
+	if err = randomize.Struct(seed, mediaItemTwo, mediaItemDBTypes, false, mediaItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = mediaItemOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = mediaItemTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := MediaItems().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testMediaItemsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	mediaItemOne := &MediaItem{}
+	mediaItemTwo := &MediaItem{}
+	if err = randomize.Struct(seed, mediaItemOne, mediaItemDBTypes, false, mediaItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItem struct: %s", err)
+	}
+	if err = randomize.Struct(seed, mediaItemTwo, mediaItemDBTypes, false, mediaItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = mediaItemOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = mediaItemTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MediaItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func mediaItemBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *MediaItem) error {
+	*o = MediaItem{}
+	return nil
+}
+
+func mediaItemAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *MediaItem) error {
+	*o = MediaItem{}
+	return nil
+}
+
+func mediaItemAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *MediaItem) error {
+	*o = MediaItem{}
+	return nil
+}
+
+func mediaItemBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *MediaItem) error {
+	*o = MediaItem{}
+	return nil
+}
+
+func mediaItemAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *MediaItem) error {
+	*o = MediaItem{}
+	return nil
+}
+
+func mediaItemBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *MediaItem) error {
+	*o = MediaItem{}
+	return nil
+}
+
+func mediaItemAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *MediaItem) error {
+	*o = MediaItem{}
+	return nil
+}
+
+func mediaItemBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *MediaItem) error {
+	*o = MediaItem{}
+	return nil
+}
+
+func mediaItemAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *MediaItem) error {
+	*o = MediaItem{}
+	return nil
+}
+
+func testMediaItemsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &MediaItem{}
+	o := &MediaItem{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, mediaItemDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize MediaItem object: %s", err)
+	}
+
+	AddMediaItemHook(boil.BeforeInsertHook, mediaItemBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	mediaItemBeforeInsertHooks = []MediaItemHook{}
+
+	AddMediaItemHook(boil.AfterInsertHook, mediaItemAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	mediaItemAfterInsertHooks = []MediaItemHook{}
+
+	AddMediaItemHook(boil.AfterSelectHook, mediaItemAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	mediaItemAfterSelectHooks = []MediaItemHook{}
+
+	AddMediaItemHook(boil.BeforeUpdateHook, mediaItemBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	mediaItemBeforeUpdateHooks = []MediaItemHook{}
+
+	AddMediaItemHook(boil.AfterUpdateHook, mediaItemAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	mediaItemAfterUpdateHooks = []MediaItemHook{}
+
+	AddMediaItemHook(boil.BeforeDeleteHook, mediaItemBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	mediaItemBeforeDeleteHooks = []MediaItemHook{}
+
+	AddMediaItemHook(boil.AfterDeleteHook, mediaItemAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	mediaItemAfterDeleteHooks = []MediaItemHook{}
+
+	AddMediaItemHook(boil.BeforeUpsertHook, mediaItemBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	mediaItemBeforeUpsertHooks = []MediaItemHook{}
+
+	AddMediaItemHook(boil.AfterUpsertHook, mediaItemAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	mediaItemAfterUpsertHooks = []MediaItemHook{}
+}
+
+func testMediaItemsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItem{}
+	if err = randomize.Struct(seed, o, mediaItemDBTypes, true, mediaItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MediaItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testMediaItemsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItem{}
+	if err = randomize.Struct(seed, o, mediaItemDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize MediaItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(mediaItemColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MediaItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testMediaItemToOneUserUsingContributingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local MediaItem
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, mediaItemDBTypes, false, mediaItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItem struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ContributedBy = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ContributingUser().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := MediaItemSlice{&local}
+	if err = local.L.LoadContributingUser(ctx, tx, false, (*[]*MediaItem)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ContributingUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ContributingUser = nil
+	if err = local.L.LoadContributingUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ContributingUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testMediaItemToOneFilmUsingFilm(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local MediaItem
+	var foreign Film
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, mediaItemDBTypes, true, mediaItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItem struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, filmDBTypes, false, filmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Film struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.FilmID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Film().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := MediaItemSlice{&local}
+	if err = local.L.LoadFilm(ctx, tx, false, (*[]*MediaItem)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Film == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Film = nil
+	if err = local.L.LoadFilm(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Film == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testMediaItemToOneSeriesUsingSeries(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local MediaItem
+	var foreign Series
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, mediaItemDBTypes, true, mediaItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItem struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, seriesDBTypes, false, seriesColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Series struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.SeriesID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Series().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := MediaItemSlice{&local}
+	if err = local.L.LoadSeries(ctx, tx, false, (*[]*MediaItem)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Series == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Series = nil
+	if err = local.L.LoadSeries(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Series == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testMediaItemToOneSetOpUserUsingContributingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a MediaItem
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, mediaItemDBTypes, false, strmangle.SetComplement(mediaItemPrimaryKeyColumns, mediaItemColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetContributingUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ContributingUser != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ContributedMediaItems[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ContributedBy != x.ID {
+			t.Error("foreign key was wrong value", a.ContributedBy)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ContributedBy))
+		reflect.Indirect(reflect.ValueOf(&a.ContributedBy)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ContributedBy != x.ID {
+			t.Error("foreign key was wrong value", a.ContributedBy, x.ID)
+		}
+	}
+}
+func testMediaItemToOneSetOpFilmUsingFilm(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a MediaItem
+	var b, c Film
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, mediaItemDBTypes, false, strmangle.SetComplement(mediaItemPrimaryKeyColumns, mediaItemColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Film{&b, &c} {
+		err = a.SetFilm(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Film != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.MediaItems[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.FilmID, x.ID) {
+			t.Error("foreign key was wrong value", a.FilmID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.FilmID))
+		reflect.Indirect(reflect.ValueOf(&a.FilmID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.FilmID, x.ID) {
+			t.Error("foreign key was wrong value", a.FilmID, x.ID)
+		}
+	}
+}
+
+func testMediaItemToOneRemoveOpFilmUsingFilm(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a MediaItem
+	var b Film
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, mediaItemDBTypes, false, strmangle.SetComplement(mediaItemPrimaryKeyColumns, mediaItemColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetFilm(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveFilm(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Film().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Film != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.FilmID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.MediaItems) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testMediaItemToOneSetOpSeriesUsingSeries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a MediaItem
+	var b, c Series
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, mediaItemDBTypes, false, strmangle.SetComplement(mediaItemPrimaryKeyColumns, mediaItemColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Series{&b, &c} {
+		err = a.SetSeries(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Series != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.SeriesMediaItems[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.SeriesID, x.ID) {
+			t.Error("foreign key was wrong value", a.SeriesID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.SeriesID))
+		reflect.Indirect(reflect.ValueOf(&a.SeriesID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.SeriesID, x.ID) {
+			t.Error("foreign key was wrong value", a.SeriesID, x.ID)
+		}
+	}
+}
+
+func testMediaItemToOneRemoveOpSeriesUsingSeries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a MediaItem
+	var b Series
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, mediaItemDBTypes, false, strmangle.SetComplement(mediaItemPrimaryKeyColumns, mediaItemColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetSeries(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveSeries(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Series().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Series != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.SeriesID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.SeriesMediaItems) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testMediaItemsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItem{}
+	if err = randomize.Struct(seed, o, mediaItemDBTypes, true, mediaItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMediaItemsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItem{}
+	if err = randomize.Struct(seed, o, mediaItemDBTypes, true, mediaItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := MediaItemSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testMediaItemsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItem{}
+	if err = randomize.Struct(seed, o, mediaItemDBTypes, true, mediaItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := MediaItems().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	mediaItemDBTypes = map[string]string{`ID`: `integer`, `FilmID`: `integer`, `SeriesID`: `integer`, `Kind`: `character varying`, `URI`: `character varying`, `Position`: `integer`, `IsPrimary`: `boolean`, `RemovedAt`: `timestamp with time zone`, `ContributedBy`: `integer`, `ContributedAt`: `timestamp with time zone`, `Invalidation`: `character varying`}
+	_                = bytes.MinRead
+)
+
+func testMediaItemsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(mediaItemPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(mediaItemAllColumns) == len(mediaItemPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItem{}
+	if err = randomize.Struct(seed, o, mediaItemDBTypes, true, mediaItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MediaItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, mediaItemDBTypes, true, mediaItemPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize MediaItem struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testMediaItemsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(mediaItemAllColumns) == len(mediaItemPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &MediaItem{}
+	if err = randomize.Struct(seed, o, mediaItemDBTypes, true, mediaItemColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize MediaItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := MediaItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, mediaItemDBTypes, true, mediaItemPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize MediaItem struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(mediaItemAllColumns, mediaItemPrimaryKeyColumns) {
+		fields = mediaItemAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			mediaItemAllColumns,
+			mediaItemPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := MediaItemSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testMediaItemsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(mediaItemAllColumns) == len(mediaItemPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := MediaItem{}
+	if err = randomize.Struct(seed, &o, mediaItemDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize MediaItem struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert MediaItem: %s", err)
+	}
+
+	count, err := MediaItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, mediaItemDBTypes, false, mediaItemPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize MediaItem struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert MediaItem: %s", err)
+	}
+
+	count, err = MediaItems().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1285 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// PlaybackProgress is an object representing the database table.
+type PlaybackProgress struct {
+	UserID    int         `db:"user_id" boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	FilmID    int         `db:"film_id" boil:"film_id" json:"film_id" toml:"film_id" yaml:"film_id"`
+	Position  int         `db:"position" boil:"position" json:"position" toml:"position" yaml:"position"`
+	Device    null.String `db:"device" boil:"device" json:"device,omitempty" toml:"device" yaml:"device,omitempty"`
+	UpdatedAt time.Time   `db:"updated_at" boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *playbackProgressR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L playbackProgressL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PlaybackProgressColumns = struct {
+	UserID    string
+	FilmID    string
+	Position  string
+	Device    string
+	UpdatedAt string
+}{
+	UserID:    "user_id",
+	FilmID:    "film_id",
+	Position:  "position",
+	Device:    "device",
+	UpdatedAt: "updated_at",
+}
+
+var PlaybackProgressTableColumns = struct {
+	UserID    string
+	FilmID    string
+	Position  string
+	Device    string
+	UpdatedAt string
+}{
+	UserID:    "playback_progress.user_id",
+	FilmID:    "playback_progress.film_id",
+	Position:  "playback_progress.position",
+	Device:    "playback_progress.device",
+	UpdatedAt: "playback_progress.updated_at",
+}
+
+// Generated where
+
+var PlaybackProgressWhere = struct {
+	UserID    whereHelperint
+	FilmID    whereHelperint
+	Position  whereHelperint
+	Device    whereHelpernull_String
+	UpdatedAt whereHelpertime_Time
+}{
+	UserID:    whereHelperint{field: "\"playback_progress\".\"user_id\""},
+	FilmID:    whereHelperint{field: "\"playback_progress\".\"film_id\""},
+	Position:  whereHelperint{field: "\"playback_progress\".\"position\""},
+	Device:    whereHelpernull_String{field: "\"playback_progress\".\"device\""},
+	UpdatedAt: whereHelpertime_Time{field: "\"playback_progress\".\"updated_at\""},
+}
+
+// PlaybackProgressRels is where relationship names are stored.
+var PlaybackProgressRels = struct {
+	Film string
+	User string
+}{
+	Film: "Film",
+	User: "User",
+}
+
+// playbackProgressR is where relationships are stored.
+type playbackProgressR struct {
+	Film *Film `db:"Film" boil:"Film" json:"Film" toml:"Film" yaml:"Film"`
+	User *User `db:"User" boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*playbackProgressR) NewStruct() *playbackProgressR {
+	return &playbackProgressR{}
+}
+
+func (r *playbackProgressR) GetFilm() *Film {
+	if r == nil {
+		return nil
+	}
+	return r.Film
+}
+
+func (r *playbackProgressR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// playbackProgressL is where Load methods for each relationship are stored.
+type playbackProgressL struct{}
+
+var (
+	playbackProgressAllColumns            = []string{"user_id", "film_id", "position", "device", "updated_at"}
+	playbackProgressColumnsWithoutDefault = []string{"user_id", "film_id", "position"}
+	playbackProgressColumnsWithDefault    = []string{"device", "updated_at"}
+	playbackProgressPrimaryKeyColumns     = []string{"user_id", "film_id"}
+	playbackProgressGeneratedColumns      = []string{}
+)
+
+type (
+	// PlaybackProgressSlice is an alias for a slice of pointers to PlaybackProgress.
+	// This should almost always be used instead of []PlaybackProgress.
+	PlaybackProgressSlice []*PlaybackProgress
+	// PlaybackProgressHook is the signature for custom PlaybackProgress hook methods
+	PlaybackProgressHook func(context.Context, boil.ContextExecutor, *PlaybackProgress) error
+
+	playbackProgressQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	playbackProgressType                 = reflect.TypeOf(&PlaybackProgress{})
+	playbackProgressMapping              = queries.MakeStructMapping(playbackProgressType)
+	playbackProgressPrimaryKeyMapping, _ = queries.BindMapping(playbackProgressType, playbackProgressMapping, playbackProgressPrimaryKeyColumns)
+	playbackProgressInsertCacheMut       sync.RWMutex
+	playbackProgressInsertCache          = make(map[string]insertCache)
+	playbackProgressUpdateCacheMut       sync.RWMutex
+	playbackProgressUpdateCache          = make(map[string]updateCache)
+	playbackProgressUpsertCacheMut       sync.RWMutex
+	playbackProgressUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var playbackProgressAfterSelectHooks []PlaybackProgressHook
+
+var playbackProgressBeforeInsertHooks []PlaybackProgressHook
+var playbackProgressAfterInsertHooks []PlaybackProgressHook
+
+var playbackProgressBeforeUpdateHooks []PlaybackProgressHook
+var playbackProgressAfterUpdateHooks []PlaybackProgressHook
+
+var playbackProgressBeforeDeleteHooks []PlaybackProgressHook
+var playbackProgressAfterDeleteHooks []PlaybackProgressHook
+
+var playbackProgressBeforeUpsertHooks []PlaybackProgressHook
+var playbackProgressAfterUpsertHooks []PlaybackProgressHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PlaybackProgress) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playbackProgressAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PlaybackProgress) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playbackProgressBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PlaybackProgress) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playbackProgressAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PlaybackProgress) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playbackProgressBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PlaybackProgress) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playbackProgressAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PlaybackProgress) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playbackProgressBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PlaybackProgress) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playbackProgressAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PlaybackProgress) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playbackProgressBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PlaybackProgress) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range playbackProgressAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPlaybackProgressHook registers your hook function for all future operations.
+func AddPlaybackProgressHook(hookPoint boil.HookPoint, playbackProgressHook PlaybackProgressHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		playbackProgressAfterSelectHooks = append(playbackProgressAfterSelectHooks, playbackProgressHook)
+	case boil.BeforeInsertHook:
+		playbackProgressBeforeInsertHooks = append(playbackProgressBeforeInsertHooks, playbackProgressHook)
+	case boil.AfterInsertHook:
+		playbackProgressAfterInsertHooks = append(playbackProgressAfterInsertHooks, playbackProgressHook)
+	case boil.BeforeUpdateHook:
+		playbackProgressBeforeUpdateHooks = append(playbackProgressBeforeUpdateHooks, playbackProgressHook)
+	case boil.AfterUpdateHook:
+		playbackProgressAfterUpdateHooks = append(playbackProgressAfterUpdateHooks, playbackProgressHook)
+	case boil.BeforeDeleteHook:
+		playbackProgressBeforeDeleteHooks = append(playbackProgressBeforeDeleteHooks, playbackProgressHook)
+	case boil.AfterDeleteHook:
+		playbackProgressAfterDeleteHooks = append(playbackProgressAfterDeleteHooks, playbackProgressHook)
+	case boil.BeforeUpsertHook:
+		playbackProgressBeforeUpsertHooks = append(playbackProgressBeforeUpsertHooks, playbackProgressHook)
+	case boil.AfterUpsertHook:
+		playbackProgressAfterUpsertHooks = append(playbackProgressAfterUpsertHooks, playbackProgressHook)
+	}
+}
+
+// One returns a single playbackProgress record from the query.
+func (q playbackProgressQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PlaybackProgress, error) {
+	o := &PlaybackProgress{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for playback_progress")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all PlaybackProgress records from the query.
+func (q playbackProgressQuery) All(ctx context.Context, exec boil.ContextExecutor) (PlaybackProgressSlice, error) {
+	var o []*PlaybackProgress
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PlaybackProgress slice")
+	}
+
+	if len(playbackProgressAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PlaybackProgress records in the query.
+func (q playbackProgressQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count playback_progress rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q playbackProgressQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if playback_progress exists")
+	}
+
+	return count > 0, nil
+}
+
+// Film pointed to by the foreign key.
+func (o *PlaybackProgress) Film(mods ...qm.QueryMod) filmQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FilmID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Films(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *PlaybackProgress) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadFilm allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (playbackProgressL) LoadFilm(ctx context.Context, e boil.ContextExecutor, singular bool, maybePlaybackProgress interface{}, mods queries.Applicator) error {
+	var slice []*PlaybackProgress
+	var object *PlaybackProgress
+
+	if singular {
+		var ok bool
+		object, ok = maybePlaybackProgress.(*PlaybackProgress)
+		if !ok {
+			object = new(PlaybackProgress)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePlaybackProgress)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePlaybackProgress))
+			}
+		}
+	} else {
+		s, ok := maybePlaybackProgress.(*[]*PlaybackProgress)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePlaybackProgress)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePlaybackProgress))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &playbackProgressR{}
+		}
+		args = append(args, object.FilmID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &playbackProgressR{}
+			}
+
+			for _, a := range args {
+				if a == obj.FilmID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.FilmID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`films`),
+		qm.WhereIn(`films.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Film")
+	}
+
+	var resultSlice []*Film
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Film")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for films")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for films")
+	}
+
+	if len(playbackProgressAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Film = foreign
+		if foreign.R == nil {
+			foreign.R = &filmR{}
+		}
+		foreign.R.PlaybackProgresses = append(foreign.R.PlaybackProgresses, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.FilmID == foreign.ID {
+				local.R.Film = foreign
+				if foreign.R == nil {
+					foreign.R = &filmR{}
+				}
+				foreign.R.PlaybackProgresses = append(foreign.R.PlaybackProgresses, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (playbackProgressL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybePlaybackProgress interface{}, mods queries.Applicator) error {
+	var slice []*PlaybackProgress
+	var object *PlaybackProgress
+
+	if singular {
+		var ok bool
+		object, ok = maybePlaybackProgress.(*PlaybackProgress)
+		if !ok {
+			object = new(PlaybackProgress)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePlaybackProgress)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePlaybackProgress))
+			}
+		}
+	} else {
+		s, ok := maybePlaybackProgress.(*[]*PlaybackProgress)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePlaybackProgress)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePlaybackProgress))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &playbackProgressR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &playbackProgressR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(playbackProgressAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.PlaybackProgresses = append(foreign.R.PlaybackProgresses, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.PlaybackProgresses = append(foreign.R.PlaybackProgresses, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetFilm of the playbackProgress to the related item.
+// Sets o.R.Film to related.
+// Adds o to related.R.PlaybackProgresses.
+func (o *PlaybackProgress) SetFilm(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Film) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"playback_progress\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"film_id"}),
+		strmangle.WhereClause("\"", "\"", 2, playbackProgressPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID, o.FilmID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.FilmID = related.ID
+	if o.R == nil {
+		o.R = &playbackProgressR{
+			Film: related,
+		}
+	} else {
+		o.R.Film = related
+	}
+
+	if related.R == nil {
+		related.R = &filmR{
+			PlaybackProgresses: PlaybackProgressSlice{o},
+		}
+	} else {
+		related.R.PlaybackProgresses = append(related.R.PlaybackProgresses, o)
+	}
+
+	return nil
+}
+
+// SetUser of the playbackProgress to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.PlaybackProgresses.
+func (o *PlaybackProgress) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"playback_progress\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, playbackProgressPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID, o.FilmID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &playbackProgressR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			PlaybackProgresses: PlaybackProgressSlice{o},
+		}
+	} else {
+		related.R.PlaybackProgresses = append(related.R.PlaybackProgresses, o)
+	}
+
+	return nil
+}
+
+// PlaybackProgresses retrieves all the records using an executor.
+func PlaybackProgresses(mods ...qm.QueryMod) playbackProgressQuery {
+	mods = append(mods, qm.From("\"playback_progress\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"playback_progress\".*"})
+	}
+
+	return playbackProgressQuery{q}
+}
+
+// FindPlaybackProgress retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPlaybackProgress(ctx context.Context, exec boil.ContextExecutor, userID int, filmID int, selectCols ...string) (*PlaybackProgress, error) {
+	playbackProgressObj := &PlaybackProgress{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"playback_progress\" where \"user_id\"=$1 AND \"film_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, userID, filmID)
+
+	err := q.Bind(ctx, exec, playbackProgressObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from playback_progress")
+	}
+
+	if err = playbackProgressObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return playbackProgressObj, err
+	}
+
+	return playbackProgressObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PlaybackProgress) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no playback_progress provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(playbackProgressColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	playbackProgressInsertCacheMut.RLock()
+	cache, cached := playbackProgressInsertCache[key]
+	playbackProgressInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			playbackProgressAllColumns,
+			playbackProgressColumnsWithDefault,
+			playbackProgressColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(playbackProgressType, playbackProgressMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(playbackProgressType, playbackProgressMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"playback_progress\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"playback_progress\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into playback_progress")
+	}
+
+	if !cached {
+		playbackProgressInsertCacheMut.Lock()
+		playbackProgressInsertCache[key] = cache
+		playbackProgressInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the PlaybackProgress.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PlaybackProgress) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	playbackProgressUpdateCacheMut.RLock()
+	cache, cached := playbackProgressUpdateCache[key]
+	playbackProgressUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			playbackProgressAllColumns,
+			playbackProgressPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update playback_progress, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"playback_progress\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, playbackProgressPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(playbackProgressType, playbackProgressMapping, append(wl, playbackProgressPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update playback_progress row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for playback_progress")
+	}
+
+	if !cached {
+		playbackProgressUpdateCacheMut.Lock()
+		playbackProgressUpdateCache[key] = cache
+		playbackProgressUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q playbackProgressQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for playback_progress")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for playback_progress")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PlaybackProgressSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), playbackProgressPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"playback_progress\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, playbackProgressPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in playbackProgress slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all playbackProgress")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PlaybackProgress) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no playback_progress provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(playbackProgressColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	playbackProgressUpsertCacheMut.RLock()
+	cache, cached := playbackProgressUpsertCache[key]
+	playbackProgressUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			playbackProgressAllColumns,
+			playbackProgressColumnsWithDefault,
+			playbackProgressColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			playbackProgressAllColumns,
+			playbackProgressPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert playback_progress, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(playbackProgressPrimaryKeyColumns))
+			copy(conflict, playbackProgressPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"playback_progress\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(playbackProgressType, playbackProgressMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(playbackProgressType, playbackProgressMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert playback_progress")
+	}
+
+	if !cached {
+		playbackProgressUpsertCacheMut.Lock()
+		playbackProgressUpsertCache[key] = cache
+		playbackProgressUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single PlaybackProgress record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PlaybackProgress) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PlaybackProgress provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), playbackProgressPrimaryKeyMapping)
+	sql := "DELETE FROM \"playback_progress\" WHERE \"user_id\"=$1 AND \"film_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from playback_progress")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for playback_progress")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q playbackProgressQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no playbackProgressQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from playback_progress")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for playback_progress")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PlaybackProgressSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(playbackProgressBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), playbackProgressPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"playback_progress\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, playbackProgressPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from playbackProgress slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for playback_progress")
+	}
+
+	if len(playbackProgressAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PlaybackProgress) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPlaybackProgress(ctx, exec, o.UserID, o.FilmID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PlaybackProgressSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PlaybackProgressSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), playbackProgressPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"playback_progress\".* FROM \"playback_progress\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, playbackProgressPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PlaybackProgressSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PlaybackProgressExists checks if the PlaybackProgress row exists.
+func PlaybackProgressExists(ctx context.Context, exec boil.ContextExecutor, userID int, filmID int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"playback_progress\" where \"user_id\"=$1 AND \"film_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, userID, filmID)
+	}
+	row := exec.QueryRowContext(ctx, sql, userID, filmID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if playback_progress exists")
+	}
+
+	return exists, nil
+}
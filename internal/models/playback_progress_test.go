@@ -0,0 +1,941 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPlaybackProgresses(t *testing.T) {
+	t.Parallel()
+
+	query := PlaybackProgresses()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPlaybackProgressesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaybackProgress{}
+	if err = randomize.Struct(seed, o, playbackProgressDBTypes, true, playbackProgressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaybackProgress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PlaybackProgresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPlaybackProgressesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaybackProgress{}
+	if err = randomize.Struct(seed, o, playbackProgressDBTypes, true, playbackProgressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaybackProgress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PlaybackProgresses().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PlaybackProgresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPlaybackProgressesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaybackProgress{}
+	if err = randomize.Struct(seed, o, playbackProgressDBTypes, true, playbackProgressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaybackProgress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PlaybackProgressSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PlaybackProgresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPlaybackProgressesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaybackProgress{}
+	if err = randomize.Struct(seed, o, playbackProgressDBTypes, true, playbackProgressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaybackProgress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PlaybackProgressExists(ctx, tx, o.UserID, o.FilmID)
+	if err != nil {
+		t.Errorf("Unable to check if PlaybackProgress exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PlaybackProgressExists to return true, but got false.")
+	}
+}
+
+func testPlaybackProgressesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaybackProgress{}
+	if err = randomize.Struct(seed, o, playbackProgressDBTypes, true, playbackProgressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaybackProgress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	playbackProgressFound, err := FindPlaybackProgress(ctx, tx, o.UserID, o.FilmID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if playbackProgressFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPlaybackProgressesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaybackProgress{}
+	if err = randomize.Struct(seed, o, playbackProgressDBTypes, true, playbackProgressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaybackProgress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PlaybackProgresses().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPlaybackProgressesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaybackProgress{}
+	if err = randomize.Struct(seed, o, playbackProgressDBTypes, true, playbackProgressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaybackProgress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PlaybackProgresses().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPlaybackProgressesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	playbackProgressOne := &PlaybackProgress{}
+	playbackProgressTwo := &PlaybackProgress{}
+	if err = randomize.Struct(seed, playbackProgressOne, playbackProgressDBTypes, false, playbackProgressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaybackProgress struct: %s", err)
+	}
+	if err = randomize.Struct(seed, playbackProgressTwo, playbackProgressDBTypes, false, playbackProgressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaybackProgress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = playbackProgressOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = playbackProgressTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PlaybackProgresses().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPlaybackProgressesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	playbackProgressOne := &PlaybackProgress{}
+	playbackProgressTwo := &PlaybackProgress{}
+	if err = randomize.Struct(seed, playbackProgressOne, playbackProgressDBTypes, false, playbackProgressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaybackProgress struct: %s", err)
+	}
+	if err = randomize.Struct(seed, playbackProgressTwo, playbackProgressDBTypes, false, playbackProgressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaybackProgress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = playbackProgressOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = playbackProgressTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PlaybackProgresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func playbackProgressBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *PlaybackProgress) error {
+	*o = PlaybackProgress{}
+	return nil
+}
+
+func playbackProgressAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *PlaybackProgress) error {
+	*o = PlaybackProgress{}
+	return nil
+}
+
+func playbackProgressAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *PlaybackProgress) error {
+	*o = PlaybackProgress{}
+	return nil
+}
+
+func playbackProgressBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PlaybackProgress) error {
+	*o = PlaybackProgress{}
+	return nil
+}
+
+func playbackProgressAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PlaybackProgress) error {
+	*o = PlaybackProgress{}
+	return nil
+}
+
+func playbackProgressBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PlaybackProgress) error {
+	*o = PlaybackProgress{}
+	return nil
+}
+
+func playbackProgressAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PlaybackProgress) error {
+	*o = PlaybackProgress{}
+	return nil
+}
+
+func playbackProgressBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PlaybackProgress) error {
+	*o = PlaybackProgress{}
+	return nil
+}
+
+func playbackProgressAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PlaybackProgress) error {
+	*o = PlaybackProgress{}
+	return nil
+}
+
+func testPlaybackProgressesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &PlaybackProgress{}
+	o := &PlaybackProgress{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, playbackProgressDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize PlaybackProgress object: %s", err)
+	}
+
+	AddPlaybackProgressHook(boil.BeforeInsertHook, playbackProgressBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	playbackProgressBeforeInsertHooks = []PlaybackProgressHook{}
+
+	AddPlaybackProgressHook(boil.AfterInsertHook, playbackProgressAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	playbackProgressAfterInsertHooks = []PlaybackProgressHook{}
+
+	AddPlaybackProgressHook(boil.AfterSelectHook, playbackProgressAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	playbackProgressAfterSelectHooks = []PlaybackProgressHook{}
+
+	AddPlaybackProgressHook(boil.BeforeUpdateHook, playbackProgressBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	playbackProgressBeforeUpdateHooks = []PlaybackProgressHook{}
+
+	AddPlaybackProgressHook(boil.AfterUpdateHook, playbackProgressAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	playbackProgressAfterUpdateHooks = []PlaybackProgressHook{}
+
+	AddPlaybackProgressHook(boil.BeforeDeleteHook, playbackProgressBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	playbackProgressBeforeDeleteHooks = []PlaybackProgressHook{}
+
+	AddPlaybackProgressHook(boil.AfterDeleteHook, playbackProgressAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	playbackProgressAfterDeleteHooks = []PlaybackProgressHook{}
+
+	AddPlaybackProgressHook(boil.BeforeUpsertHook, playbackProgressBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	playbackProgressBeforeUpsertHooks = []PlaybackProgressHook{}
+
+	AddPlaybackProgressHook(boil.AfterUpsertHook, playbackProgressAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	playbackProgressAfterUpsertHooks = []PlaybackProgressHook{}
+}
+
+func testPlaybackProgressesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaybackProgress{}
+	if err = randomize.Struct(seed, o, playbackProgressDBTypes, true, playbackProgressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaybackProgress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PlaybackProgresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPlaybackProgressesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaybackProgress{}
+	if err = randomize.Struct(seed, o, playbackProgressDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PlaybackProgress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(playbackProgressColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PlaybackProgresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPlaybackProgressToOneFilmUsingFilm(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PlaybackProgress
+	var foreign Film
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, playbackProgressDBTypes, false, playbackProgressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaybackProgress struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, filmDBTypes, false, filmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Film struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.FilmID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Film().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := PlaybackProgressSlice{&local}
+	if err = local.L.LoadFilm(ctx, tx, false, (*[]*PlaybackProgress)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Film == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Film = nil
+	if err = local.L.LoadFilm(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Film == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testPlaybackProgressToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PlaybackProgress
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, playbackProgressDBTypes, false, playbackProgressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaybackProgress struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := PlaybackProgressSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*PlaybackProgress)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testPlaybackProgressToOneSetOpFilmUsingFilm(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PlaybackProgress
+	var b, c Film
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, playbackProgressDBTypes, false, strmangle.SetComplement(playbackProgressPrimaryKeyColumns, playbackProgressColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Film{&b, &c} {
+		err = a.SetFilm(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Film != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.PlaybackProgresses[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.FilmID != x.ID {
+			t.Error("foreign key was wrong value", a.FilmID)
+		}
+
+		if exists, err := PlaybackProgressExists(ctx, tx, a.UserID, a.FilmID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+func testPlaybackProgressToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PlaybackProgress
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, playbackProgressDBTypes, false, strmangle.SetComplement(playbackProgressPrimaryKeyColumns, playbackProgressColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.PlaybackProgresses[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		if exists, err := PlaybackProgressExists(ctx, tx, a.UserID, a.FilmID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testPlaybackProgressesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaybackProgress{}
+	if err = randomize.Struct(seed, o, playbackProgressDBTypes, true, playbackProgressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaybackProgress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPlaybackProgressesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaybackProgress{}
+	if err = randomize.Struct(seed, o, playbackProgressDBTypes, true, playbackProgressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaybackProgress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PlaybackProgressSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPlaybackProgressesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaybackProgress{}
+	if err = randomize.Struct(seed, o, playbackProgressDBTypes, true, playbackProgressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaybackProgress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PlaybackProgresses().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	playbackProgressDBTypes = map[string]string{`UserID`: `integer`, `FilmID`: `integer`, `Position`: `integer`, `Device`: `character varying`, `UpdatedAt`: `timestamp with time zone`}
+	_                       = bytes.MinRead
+)
+
+func testPlaybackProgressesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(playbackProgressPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(playbackProgressAllColumns) == len(playbackProgressPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaybackProgress{}
+	if err = randomize.Struct(seed, o, playbackProgressDBTypes, true, playbackProgressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaybackProgress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PlaybackProgresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, playbackProgressDBTypes, true, playbackProgressPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PlaybackProgress struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPlaybackProgressesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(playbackProgressAllColumns) == len(playbackProgressPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PlaybackProgress{}
+	if err = randomize.Struct(seed, o, playbackProgressDBTypes, true, playbackProgressColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PlaybackProgress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PlaybackProgresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, playbackProgressDBTypes, true, playbackProgressPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PlaybackProgress struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(playbackProgressAllColumns, playbackProgressPrimaryKeyColumns) {
+		fields = playbackProgressAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			playbackProgressAllColumns,
+			playbackProgressPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PlaybackProgressSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testPlaybackProgressesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(playbackProgressAllColumns) == len(playbackProgressPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := PlaybackProgress{}
+	if err = randomize.Struct(seed, &o, playbackProgressDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PlaybackProgress struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PlaybackProgress: %s", err)
+	}
+
+	count, err := PlaybackProgresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, playbackProgressDBTypes, false, playbackProgressPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PlaybackProgress struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PlaybackProgress: %s", err)
+	}
+
+	count, err = PlaybackProgresses().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -6,17 +6,95 @@ package models
 import "testing"
 
 func TestUpsert(t *testing.T) {
+	t.Run("AuditPrunes", testAuditPrunesUpsert)
+
+	t.Run("CatalogExports", testCatalogExportsUpsert)
+
+	t.Run("CollectionItems", testCollectionItemsUpsert)
+
+	t.Run("CollectionItemsAudits", testCollectionItemsAuditsUpsert)
+
+	t.Run("Collections", testCollectionsUpsert)
+
+	t.Run("CollectionsAudits", testCollectionsAuditsUpsert)
+
+	t.Run("ContentRatings", testContentRatingsUpsert)
+
+	t.Run("ContentRatingsAudits", testContentRatingsAuditsUpsert)
+
+	t.Run("Contributions", testContributionsUpsert)
+
+	t.Run("ContributorStats", testContributorStatsUpsert)
+
+	t.Run("ExternalIds", testExternalIdsUpsert)
+
+	t.Run("ExternalIdsAudits", testExternalIdsAuditsUpsert)
+
+	t.Run("FilmScoreAggregates", testFilmScoreAggregatesUpsert)
+
 	t.Run("Films", testFilmsUpsert)
 
 	t.Run("FilmsAudits", testFilmsAuditsUpsert)
 
+	t.Run("ImportErrors", testImportErrorsUpsert)
+
+	t.Run("ImportJobs", testImportJobsUpsert)
+
+	t.Run("ListActivities", testListActivitiesUpsert)
+
+	t.Run("ListInvites", testListInvitesUpsert)
+
+	t.Run("ListItems", testListItemsUpsert)
+
+	t.Run("ListMembers", testListMembersUpsert)
+
+	t.Run("Lists", testListsUpsert)
+
+	t.Run("MediaItems", testMediaItemsUpsert)
+
+	t.Run("MediaItemsAudits", testMediaItemsAuditsUpsert)
+
+	t.Run("PlaybackProgresses", testPlaybackProgressesUpsert)
+
+	t.Run("Releases", testReleasesUpsert)
+
+	t.Run("ReleasesAudits", testReleasesAuditsUpsert)
+
+	t.Run("ReviewVotes", testReviewVotesUpsert)
+
+	t.Run("Reviews", testReviewsUpsert)
+
+	t.Run("ReviewsAudits", testReviewsAuditsUpsert)
+
+	t.Run("Scores", testScoresUpsert)
+
+	t.Run("SeriesAggregates", testSeriesAggregatesUpsert)
+
+	t.Run("SeriesFollows", testSeriesFollowsUpsert)
+
+	t.Run("SeriesScoreAggregates", testSeriesScoreAggregatesUpsert)
+
 	t.Run("Serieses", testSeriesesUpsert)
 
 	t.Run("SeriesesAudits", testSeriesesAuditsUpsert)
 
 	t.Run("Tokens", testTokensUpsert)
 
+	t.Run("Translations", testTranslationsUpsert)
+
+	t.Run("TranslationsAudits", testTranslationsAuditsUpsert)
+
 	t.Run("Users", testUsersUpsert)
 
+	t.Run("UsersAudits", testUsersAuditsUpsert)
+
+	t.Run("WatchEvents", testWatchEventsUpsert)
+
+	t.Run("WatchfilmTags", testWatchfilmTagsUpsert)
+
 	t.Run("Watchfilms", testWatchfilmsUpsert)
+
+	t.Run("WatchfilmsAudits", testWatchfilmsAuditsUpsert)
+
+	t.Run("YearReviews", testYearReviewsUpsert)
 }
@@ -0,0 +1,943 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ReleasesAudit is an object representing the database table.
+type ReleasesAudit struct {
+	ID            int         `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	FilmID        int         `db:"film_id" boil:"film_id" json:"film_id" toml:"film_id" yaml:"film_id"`
+	Region        string      `db:"region" boil:"region" json:"region" toml:"region" yaml:"region"`
+	ReleaseType   string      `db:"release_type" boil:"release_type" json:"release_type" toml:"release_type" yaml:"release_type"`
+	DateReleased  time.Time   `db:"date_released" boil:"date_released" json:"date_released" toml:"date_released" yaml:"date_released"`
+	ContributedBy int         `db:"contributed_by" boil:"contributed_by" json:"contributed_by" toml:"contributed_by" yaml:"contributed_by"`
+	ContributedAt time.Time   `db:"contributed_at" boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
+	Invalidation  null.String `db:"invalidation" boil:"invalidation" json:"invalidation,omitempty" toml:"invalidation" yaml:"invalidation,omitempty"`
+	AuditAction   string      `db:"audit_action" boil:"audit_action" json:"audit_action" toml:"audit_action" yaml:"audit_action"`
+	AuditActor    null.Int    `db:"audit_actor" boil:"audit_actor" json:"audit_actor,omitempty" toml:"audit_actor" yaml:"audit_actor,omitempty"`
+
+	R *releasesAuditR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L releasesAuditL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ReleasesAuditColumns = struct {
+	ID            string
+	FilmID        string
+	Region        string
+	ReleaseType   string
+	DateReleased  string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+	AuditAction   string
+	AuditActor    string
+}{
+	ID:            "id",
+	FilmID:        "film_id",
+	Region:        "region",
+	ReleaseType:   "release_type",
+	DateReleased:  "date_released",
+	ContributedBy: "contributed_by",
+	ContributedAt: "contributed_at",
+	Invalidation:  "invalidation",
+	AuditAction:   "audit_action",
+	AuditActor:    "audit_actor",
+}
+
+var ReleasesAuditTableColumns = struct {
+	ID            string
+	FilmID        string
+	Region        string
+	ReleaseType   string
+	DateReleased  string
+	ContributedBy string
+	ContributedAt string
+	Invalidation  string
+	AuditAction   string
+	AuditActor    string
+}{
+	ID:            "releases_audit.id",
+	FilmID:        "releases_audit.film_id",
+	Region:        "releases_audit.region",
+	ReleaseType:   "releases_audit.release_type",
+	DateReleased:  "releases_audit.date_released",
+	ContributedBy: "releases_audit.contributed_by",
+	ContributedAt: "releases_audit.contributed_at",
+	Invalidation:  "releases_audit.invalidation",
+	AuditAction:   "releases_audit.audit_action",
+	AuditActor:    "releases_audit.audit_actor",
+}
+
+// Generated where
+
+var ReleasesAuditWhere = struct {
+	ID            whereHelperint
+	FilmID        whereHelperint
+	Region        whereHelperstring
+	ReleaseType   whereHelperstring
+	DateReleased  whereHelpertime_Time
+	ContributedBy whereHelperint
+	ContributedAt whereHelpertime_Time
+	Invalidation  whereHelpernull_String
+	AuditAction   whereHelperstring
+	AuditActor    whereHelpernull_Int
+}{
+	ID:            whereHelperint{field: "\"releases_audit\".\"id\""},
+	FilmID:        whereHelperint{field: "\"releases_audit\".\"film_id\""},
+	Region:        whereHelperstring{field: "\"releases_audit\".\"region\""},
+	ReleaseType:   whereHelperstring{field: "\"releases_audit\".\"release_type\""},
+	DateReleased:  whereHelpertime_Time{field: "\"releases_audit\".\"date_released\""},
+	ContributedBy: whereHelperint{field: "\"releases_audit\".\"contributed_by\""},
+	ContributedAt: whereHelpertime_Time{field: "\"releases_audit\".\"contributed_at\""},
+	Invalidation:  whereHelpernull_String{field: "\"releases_audit\".\"invalidation\""},
+	AuditAction:   whereHelperstring{field: "\"releases_audit\".\"audit_action\""},
+	AuditActor:    whereHelpernull_Int{field: "\"releases_audit\".\"audit_actor\""},
+}
+
+// ReleasesAuditRels is where relationship names are stored.
+var ReleasesAuditRels = struct {
+}{}
+
+// releasesAuditR is where relationships are stored.
+type releasesAuditR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*releasesAuditR) NewStruct() *releasesAuditR {
+	return &releasesAuditR{}
+}
+
+// releasesAuditL is where Load methods for each relationship are stored.
+type releasesAuditL struct{}
+
+var (
+	releasesAuditAllColumns            = []string{"id", "film_id", "region", "release_type", "date_released", "contributed_by", "contributed_at", "invalidation", "audit_action", "audit_actor"}
+	releasesAuditColumnsWithoutDefault = []string{"id", "film_id", "region", "release_type", "date_released", "contributed_by", "contributed_at"}
+	releasesAuditColumnsWithDefault    = []string{"invalidation", "audit_action", "audit_actor"}
+	releasesAuditPrimaryKeyColumns     = []string{"id", "contributed_by", "contributed_at"}
+	releasesAuditGeneratedColumns      = []string{}
+)
+
+type (
+	// ReleasesAuditSlice is an alias for a slice of pointers to ReleasesAudit.
+	// This should almost always be used instead of []ReleasesAudit.
+	ReleasesAuditSlice []*ReleasesAudit
+	// ReleasesAuditHook is the signature for custom ReleasesAudit hook methods
+	ReleasesAuditHook func(context.Context, boil.ContextExecutor, *ReleasesAudit) error
+
+	releasesAuditQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	releasesAuditType                 = reflect.TypeOf(&ReleasesAudit{})
+	releasesAuditMapping              = queries.MakeStructMapping(releasesAuditType)
+	releasesAuditPrimaryKeyMapping, _ = queries.BindMapping(releasesAuditType, releasesAuditMapping, releasesAuditPrimaryKeyColumns)
+	releasesAuditInsertCacheMut       sync.RWMutex
+	releasesAuditInsertCache          = make(map[string]insertCache)
+	releasesAuditUpdateCacheMut       sync.RWMutex
+	releasesAuditUpdateCache          = make(map[string]updateCache)
+	releasesAuditUpsertCacheMut       sync.RWMutex
+	releasesAuditUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var releasesAuditAfterSelectHooks []ReleasesAuditHook
+
+var releasesAuditBeforeInsertHooks []ReleasesAuditHook
+var releasesAuditAfterInsertHooks []ReleasesAuditHook
+
+var releasesAuditBeforeUpdateHooks []ReleasesAuditHook
+var releasesAuditAfterUpdateHooks []ReleasesAuditHook
+
+var releasesAuditBeforeDeleteHooks []ReleasesAuditHook
+var releasesAuditAfterDeleteHooks []ReleasesAuditHook
+
+var releasesAuditBeforeUpsertHooks []ReleasesAuditHook
+var releasesAuditAfterUpsertHooks []ReleasesAuditHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ReleasesAudit) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range releasesAuditAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ReleasesAudit) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range releasesAuditBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ReleasesAudit) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range releasesAuditAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ReleasesAudit) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range releasesAuditBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ReleasesAudit) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range releasesAuditAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ReleasesAudit) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range releasesAuditBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ReleasesAudit) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range releasesAuditAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ReleasesAudit) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range releasesAuditBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ReleasesAudit) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range releasesAuditAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddReleasesAuditHook registers your hook function for all future operations.
+func AddReleasesAuditHook(hookPoint boil.HookPoint, releasesAuditHook ReleasesAuditHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		releasesAuditAfterSelectHooks = append(releasesAuditAfterSelectHooks, releasesAuditHook)
+	case boil.BeforeInsertHook:
+		releasesAuditBeforeInsertHooks = append(releasesAuditBeforeInsertHooks, releasesAuditHook)
+	case boil.AfterInsertHook:
+		releasesAuditAfterInsertHooks = append(releasesAuditAfterInsertHooks, releasesAuditHook)
+	case boil.BeforeUpdateHook:
+		releasesAuditBeforeUpdateHooks = append(releasesAuditBeforeUpdateHooks, releasesAuditHook)
+	case boil.AfterUpdateHook:
+		releasesAuditAfterUpdateHooks = append(releasesAuditAfterUpdateHooks, releasesAuditHook)
+	case boil.BeforeDeleteHook:
+		releasesAuditBeforeDeleteHooks = append(releasesAuditBeforeDeleteHooks, releasesAuditHook)
+	case boil.AfterDeleteHook:
+		releasesAuditAfterDeleteHooks = append(releasesAuditAfterDeleteHooks, releasesAuditHook)
+	case boil.BeforeUpsertHook:
+		releasesAuditBeforeUpsertHooks = append(releasesAuditBeforeUpsertHooks, releasesAuditHook)
+	case boil.AfterUpsertHook:
+		releasesAuditAfterUpsertHooks = append(releasesAuditAfterUpsertHooks, releasesAuditHook)
+	}
+}
+
+// One returns a single releasesAudit record from the query.
+func (q releasesAuditQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ReleasesAudit, error) {
+	o := &ReleasesAudit{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for releases_audit")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ReleasesAudit records from the query.
+func (q releasesAuditQuery) All(ctx context.Context, exec boil.ContextExecutor) (ReleasesAuditSlice, error) {
+	var o []*ReleasesAudit
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ReleasesAudit slice")
+	}
+
+	if len(releasesAuditAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ReleasesAudit records in the query.
+func (q releasesAuditQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count releases_audit rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q releasesAuditQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if releases_audit exists")
+	}
+
+	return count > 0, nil
+}
+
+// ReleasesAudits retrieves all the records using an executor.
+func ReleasesAudits(mods ...qm.QueryMod) releasesAuditQuery {
+	mods = append(mods, qm.From("\"releases_audit\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"releases_audit\".*"})
+	}
+
+	return releasesAuditQuery{q}
+}
+
+// FindReleasesAudit retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindReleasesAudit(ctx context.Context, exec boil.ContextExecutor, iD int, contributedBy int, contributedAt time.Time, selectCols ...string) (*ReleasesAudit, error) {
+	releasesAuditObj := &ReleasesAudit{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"releases_audit\" where \"id\"=$1 AND \"contributed_by\"=$2 AND \"contributed_at\"=$3", sel,
+	)
+
+	q := queries.Raw(query, iD, contributedBy, contributedAt)
+
+	err := q.Bind(ctx, exec, releasesAuditObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from releases_audit")
+	}
+
+	if err = releasesAuditObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return releasesAuditObj, err
+	}
+
+	return releasesAuditObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ReleasesAudit) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no releases_audit provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(releasesAuditColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	releasesAuditInsertCacheMut.RLock()
+	cache, cached := releasesAuditInsertCache[key]
+	releasesAuditInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			releasesAuditAllColumns,
+			releasesAuditColumnsWithDefault,
+			releasesAuditColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(releasesAuditType, releasesAuditMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(releasesAuditType, releasesAuditMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"releases_audit\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"releases_audit\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into releases_audit")
+	}
+
+	if !cached {
+		releasesAuditInsertCacheMut.Lock()
+		releasesAuditInsertCache[key] = cache
+		releasesAuditInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ReleasesAudit.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ReleasesAudit) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	releasesAuditUpdateCacheMut.RLock()
+	cache, cached := releasesAuditUpdateCache[key]
+	releasesAuditUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			releasesAuditAllColumns,
+			releasesAuditPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update releases_audit, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"releases_audit\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, releasesAuditPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(releasesAuditType, releasesAuditMapping, append(wl, releasesAuditPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update releases_audit row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for releases_audit")
+	}
+
+	if !cached {
+		releasesAuditUpdateCacheMut.Lock()
+		releasesAuditUpdateCache[key] = cache
+		releasesAuditUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q releasesAuditQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for releases_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for releases_audit")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ReleasesAuditSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), releasesAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"releases_audit\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, releasesAuditPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in releasesAudit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all releasesAudit")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ReleasesAudit) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no releases_audit provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(releasesAuditColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	releasesAuditUpsertCacheMut.RLock()
+	cache, cached := releasesAuditUpsertCache[key]
+	releasesAuditUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			releasesAuditAllColumns,
+			releasesAuditColumnsWithDefault,
+			releasesAuditColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			releasesAuditAllColumns,
+			releasesAuditPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert releases_audit, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(releasesAuditPrimaryKeyColumns))
+			copy(conflict, releasesAuditPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"releases_audit\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(releasesAuditType, releasesAuditMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(releasesAuditType, releasesAuditMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert releases_audit")
+	}
+
+	if !cached {
+		releasesAuditUpsertCacheMut.Lock()
+		releasesAuditUpsertCache[key] = cache
+		releasesAuditUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ReleasesAudit record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ReleasesAudit) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ReleasesAudit provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), releasesAuditPrimaryKeyMapping)
+	sql := "DELETE FROM \"releases_audit\" WHERE \"id\"=$1 AND \"contributed_by\"=$2 AND \"contributed_at\"=$3"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from releases_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for releases_audit")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q releasesAuditQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no releasesAuditQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from releases_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for releases_audit")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ReleasesAuditSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(releasesAuditBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), releasesAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"releases_audit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, releasesAuditPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from releasesAudit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for releases_audit")
+	}
+
+	if len(releasesAuditAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ReleasesAudit) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindReleasesAudit(ctx, exec, o.ID, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ReleasesAuditSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ReleasesAuditSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), releasesAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"releases_audit\".* FROM \"releases_audit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, releasesAuditPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ReleasesAuditSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ReleasesAuditExists checks if the ReleasesAudit row exists.
+func ReleasesAuditExists(ctx context.Context, exec boil.ContextExecutor, iD int, contributedBy int, contributedAt time.Time) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"releases_audit\" where \"id\"=$1 AND \"contributed_by\"=$2 AND \"contributed_at\"=$3 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD, contributedBy, contributedAt)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD, contributedBy, contributedAt)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if releases_audit exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testReleasesAudits(t *testing.T) {
+	t.Parallel()
+
+	query := ReleasesAudits()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testReleasesAuditsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReleasesAudit{}
+	if err = randomize.Struct(seed, o, releasesAuditDBTypes, true, releasesAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReleasesAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ReleasesAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReleasesAuditsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReleasesAudit{}
+	if err = randomize.Struct(seed, o, releasesAuditDBTypes, true, releasesAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReleasesAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ReleasesAudits().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ReleasesAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReleasesAuditsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReleasesAudit{}
+	if err = randomize.Struct(seed, o, releasesAuditDBTypes, true, releasesAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReleasesAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ReleasesAuditSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ReleasesAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReleasesAuditsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReleasesAudit{}
+	if err = randomize.Struct(seed, o, releasesAuditDBTypes, true, releasesAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReleasesAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ReleasesAuditExists(ctx, tx, o.ID, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		t.Errorf("Unable to check if ReleasesAudit exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ReleasesAuditExists to return true, but got false.")
+	}
+}
+
+func testReleasesAuditsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReleasesAudit{}
+	if err = randomize.Struct(seed, o, releasesAuditDBTypes, true, releasesAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReleasesAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	releasesAuditFound, err := FindReleasesAudit(ctx, tx, o.ID, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if releasesAuditFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testReleasesAuditsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReleasesAudit{}
+	if err = randomize.Struct(seed, o, releasesAuditDBTypes, true, releasesAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReleasesAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ReleasesAudits().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testReleasesAuditsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReleasesAudit{}
+	if err = randomize.Struct(seed, o, releasesAuditDBTypes, true, releasesAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReleasesAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ReleasesAudits().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testReleasesAuditsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	releasesAuditOne := &ReleasesAudit{}
+	releasesAuditTwo := &ReleasesAudit{}
+	if err = randomize.Struct(seed, releasesAuditOne, releasesAuditDBTypes, false, releasesAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReleasesAudit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, releasesAuditTwo, releasesAuditDBTypes, false, releasesAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReleasesAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = releasesAuditOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = releasesAuditTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ReleasesAudits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testReleasesAuditsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	releasesAuditOne := &ReleasesAudit{}
+	releasesAuditTwo := &ReleasesAudit{}
+	if err = randomize.Struct(seed, releasesAuditOne, releasesAuditDBTypes, false, releasesAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReleasesAudit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, releasesAuditTwo, releasesAuditDBTypes, false, releasesAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReleasesAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = releasesAuditOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = releasesAuditTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReleasesAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func releasesAuditBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ReleasesAudit) error {
+	*o = ReleasesAudit{}
+	return nil
+}
+
+func releasesAuditAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ReleasesAudit) error {
+	*o = ReleasesAudit{}
+	return nil
+}
+
+func releasesAuditAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ReleasesAudit) error {
+	*o = ReleasesAudit{}
+	return nil
+}
+
+func releasesAuditBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ReleasesAudit) error {
+	*o = ReleasesAudit{}
+	return nil
+}
+
+func releasesAuditAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ReleasesAudit) error {
+	*o = ReleasesAudit{}
+	return nil
+}
+
+func releasesAuditBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ReleasesAudit) error {
+	*o = ReleasesAudit{}
+	return nil
+}
+
+func releasesAuditAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ReleasesAudit) error {
+	*o = ReleasesAudit{}
+	return nil
+}
+
+func releasesAuditBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ReleasesAudit) error {
+	*o = ReleasesAudit{}
+	return nil
+}
+
+func releasesAuditAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ReleasesAudit) error {
+	*o = ReleasesAudit{}
+	return nil
+}
+
+func testReleasesAuditsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ReleasesAudit{}
+	o := &ReleasesAudit{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, releasesAuditDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ReleasesAudit object: %s", err)
+	}
+
+	AddReleasesAuditHook(boil.BeforeInsertHook, releasesAuditBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	releasesAuditBeforeInsertHooks = []ReleasesAuditHook{}
+
+	AddReleasesAuditHook(boil.AfterInsertHook, releasesAuditAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	releasesAuditAfterInsertHooks = []ReleasesAuditHook{}
+
+	AddReleasesAuditHook(boil.AfterSelectHook, releasesAuditAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	releasesAuditAfterSelectHooks = []ReleasesAuditHook{}
+
+	AddReleasesAuditHook(boil.BeforeUpdateHook, releasesAuditBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	releasesAuditBeforeUpdateHooks = []ReleasesAuditHook{}
+
+	AddReleasesAuditHook(boil.AfterUpdateHook, releasesAuditAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	releasesAuditAfterUpdateHooks = []ReleasesAuditHook{}
+
+	AddReleasesAuditHook(boil.BeforeDeleteHook, releasesAuditBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	releasesAuditBeforeDeleteHooks = []ReleasesAuditHook{}
+
+	AddReleasesAuditHook(boil.AfterDeleteHook, releasesAuditAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	releasesAuditAfterDeleteHooks = []ReleasesAuditHook{}
+
+	AddReleasesAuditHook(boil.BeforeUpsertHook, releasesAuditBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	releasesAuditBeforeUpsertHooks = []ReleasesAuditHook{}
+
+	AddReleasesAuditHook(boil.AfterUpsertHook, releasesAuditAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	releasesAuditAfterUpsertHooks = []ReleasesAuditHook{}
+}
+
+func testReleasesAuditsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReleasesAudit{}
+	if err = randomize.Struct(seed, o, releasesAuditDBTypes, true, releasesAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReleasesAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReleasesAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testReleasesAuditsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReleasesAudit{}
+	if err = randomize.Struct(seed, o, releasesAuditDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ReleasesAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(releasesAuditColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReleasesAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testReleasesAuditsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReleasesAudit{}
+	if err = randomize.Struct(seed, o, releasesAuditDBTypes, true, releasesAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReleasesAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testReleasesAuditsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReleasesAudit{}
+	if err = randomize.Struct(seed, o, releasesAuditDBTypes, true, releasesAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReleasesAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ReleasesAuditSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testReleasesAuditsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReleasesAudit{}
+	if err = randomize.Struct(seed, o, releasesAuditDBTypes, true, releasesAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReleasesAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ReleasesAudits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	releasesAuditDBTypes = map[string]string{`ID`: `integer`, `FilmID`: `integer`, `Region`: `character varying`, `ReleaseType`: `character varying`, `DateReleased`: `date`, `ContributedBy`: `integer`, `ContributedAt`: `timestamp with time zone`, `Invalidation`: `character varying`, `AuditAction`: `character varying`, `AuditActor`: `integer`}
+	_                    = bytes.MinRead
+)
+
+func testReleasesAuditsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(releasesAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(releasesAuditAllColumns) == len(releasesAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReleasesAudit{}
+	if err = randomize.Struct(seed, o, releasesAuditDBTypes, true, releasesAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReleasesAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReleasesAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, releasesAuditDBTypes, true, releasesAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ReleasesAudit struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testReleasesAuditsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(releasesAuditAllColumns) == len(releasesAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReleasesAudit{}
+	if err = randomize.Struct(seed, o, releasesAuditDBTypes, true, releasesAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReleasesAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReleasesAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, releasesAuditDBTypes, true, releasesAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ReleasesAudit struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(releasesAuditAllColumns, releasesAuditPrimaryKeyColumns) {
+		fields = releasesAuditAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			releasesAuditAllColumns,
+			releasesAuditPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ReleasesAuditSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testReleasesAuditsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(releasesAuditAllColumns) == len(releasesAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ReleasesAudit{}
+	if err = randomize.Struct(seed, &o, releasesAuditDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ReleasesAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ReleasesAudit: %s", err)
+	}
+
+	count, err := ReleasesAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, releasesAuditDBTypes, false, releasesAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ReleasesAudit struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ReleasesAudit: %s", err)
+	}
+
+	count, err = ReleasesAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1270 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ReviewVote is an object representing the database table.
+type ReviewVote struct {
+	ReviewID  int       `db:"review_id" boil:"review_id" json:"review_id" toml:"review_id" yaml:"review_id"`
+	UserID    int       `db:"user_id" boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	TimeVoted time.Time `db:"time_voted" boil:"time_voted" json:"time_voted" toml:"time_voted" yaml:"time_voted"`
+
+	R *reviewVoteR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L reviewVoteL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ReviewVoteColumns = struct {
+	ReviewID  string
+	UserID    string
+	TimeVoted string
+}{
+	ReviewID:  "review_id",
+	UserID:    "user_id",
+	TimeVoted: "time_voted",
+}
+
+var ReviewVoteTableColumns = struct {
+	ReviewID  string
+	UserID    string
+	TimeVoted string
+}{
+	ReviewID:  "review_votes.review_id",
+	UserID:    "review_votes.user_id",
+	TimeVoted: "review_votes.time_voted",
+}
+
+// Generated where
+
+var ReviewVoteWhere = struct {
+	ReviewID  whereHelperint
+	UserID    whereHelperint
+	TimeVoted whereHelpertime_Time
+}{
+	ReviewID:  whereHelperint{field: "\"review_votes\".\"review_id\""},
+	UserID:    whereHelperint{field: "\"review_votes\".\"user_id\""},
+	TimeVoted: whereHelpertime_Time{field: "\"review_votes\".\"time_voted\""},
+}
+
+// ReviewVoteRels is where relationship names are stored.
+var ReviewVoteRels = struct {
+	Review string
+	User   string
+}{
+	Review: "Review",
+	User:   "User",
+}
+
+// reviewVoteR is where relationships are stored.
+type reviewVoteR struct {
+	Review *Review `db:"Review" boil:"Review" json:"Review" toml:"Review" yaml:"Review"`
+	User   *User   `db:"User" boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*reviewVoteR) NewStruct() *reviewVoteR {
+	return &reviewVoteR{}
+}
+
+func (r *reviewVoteR) GetReview() *Review {
+	if r == nil {
+		return nil
+	}
+	return r.Review
+}
+
+func (r *reviewVoteR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// reviewVoteL is where Load methods for each relationship are stored.
+type reviewVoteL struct{}
+
+var (
+	reviewVoteAllColumns            = []string{"review_id", "user_id", "time_voted"}
+	reviewVoteColumnsWithoutDefault = []string{"review_id", "user_id"}
+	reviewVoteColumnsWithDefault    = []string{"time_voted"}
+	reviewVotePrimaryKeyColumns     = []string{"review_id", "user_id"}
+	reviewVoteGeneratedColumns      = []string{}
+)
+
+type (
+	// ReviewVoteSlice is an alias for a slice of pointers to ReviewVote.
+	// This should almost always be used instead of []ReviewVote.
+	ReviewVoteSlice []*ReviewVote
+	// ReviewVoteHook is the signature for custom ReviewVote hook methods
+	ReviewVoteHook func(context.Context, boil.ContextExecutor, *ReviewVote) error
+
+	reviewVoteQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	reviewVoteType                 = reflect.TypeOf(&ReviewVote{})
+	reviewVoteMapping              = queries.MakeStructMapping(reviewVoteType)
+	reviewVotePrimaryKeyMapping, _ = queries.BindMapping(reviewVoteType, reviewVoteMapping, reviewVotePrimaryKeyColumns)
+	reviewVoteInsertCacheMut       sync.RWMutex
+	reviewVoteInsertCache          = make(map[string]insertCache)
+	reviewVoteUpdateCacheMut       sync.RWMutex
+	reviewVoteUpdateCache          = make(map[string]updateCache)
+	reviewVoteUpsertCacheMut       sync.RWMutex
+	reviewVoteUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var reviewVoteAfterSelectHooks []ReviewVoteHook
+
+var reviewVoteBeforeInsertHooks []ReviewVoteHook
+var reviewVoteAfterInsertHooks []ReviewVoteHook
+
+var reviewVoteBeforeUpdateHooks []ReviewVoteHook
+var reviewVoteAfterUpdateHooks []ReviewVoteHook
+
+var reviewVoteBeforeDeleteHooks []ReviewVoteHook
+var reviewVoteAfterDeleteHooks []ReviewVoteHook
+
+var reviewVoteBeforeUpsertHooks []ReviewVoteHook
+var reviewVoteAfterUpsertHooks []ReviewVoteHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ReviewVote) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewVoteAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ReviewVote) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewVoteBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ReviewVote) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewVoteAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ReviewVote) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewVoteBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ReviewVote) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewVoteAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ReviewVote) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewVoteBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ReviewVote) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewVoteAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ReviewVote) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewVoteBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ReviewVote) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewVoteAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddReviewVoteHook registers your hook function for all future operations.
+func AddReviewVoteHook(hookPoint boil.HookPoint, reviewVoteHook ReviewVoteHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		reviewVoteAfterSelectHooks = append(reviewVoteAfterSelectHooks, reviewVoteHook)
+	case boil.BeforeInsertHook:
+		reviewVoteBeforeInsertHooks = append(reviewVoteBeforeInsertHooks, reviewVoteHook)
+	case boil.AfterInsertHook:
+		reviewVoteAfterInsertHooks = append(reviewVoteAfterInsertHooks, reviewVoteHook)
+	case boil.BeforeUpdateHook:
+		reviewVoteBeforeUpdateHooks = append(reviewVoteBeforeUpdateHooks, reviewVoteHook)
+	case boil.AfterUpdateHook:
+		reviewVoteAfterUpdateHooks = append(reviewVoteAfterUpdateHooks, reviewVoteHook)
+	case boil.BeforeDeleteHook:
+		reviewVoteBeforeDeleteHooks = append(reviewVoteBeforeDeleteHooks, reviewVoteHook)
+	case boil.AfterDeleteHook:
+		reviewVoteAfterDeleteHooks = append(reviewVoteAfterDeleteHooks, reviewVoteHook)
+	case boil.BeforeUpsertHook:
+		reviewVoteBeforeUpsertHooks = append(reviewVoteBeforeUpsertHooks, reviewVoteHook)
+	case boil.AfterUpsertHook:
+		reviewVoteAfterUpsertHooks = append(reviewVoteAfterUpsertHooks, reviewVoteHook)
+	}
+}
+
+// One returns a single reviewVote record from the query.
+func (q reviewVoteQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ReviewVote, error) {
+	o := &ReviewVote{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for review_votes")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ReviewVote records from the query.
+func (q reviewVoteQuery) All(ctx context.Context, exec boil.ContextExecutor) (ReviewVoteSlice, error) {
+	var o []*ReviewVote
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ReviewVote slice")
+	}
+
+	if len(reviewVoteAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ReviewVote records in the query.
+func (q reviewVoteQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count review_votes rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q reviewVoteQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if review_votes exists")
+	}
+
+	return count > 0, nil
+}
+
+// Review pointed to by the foreign key.
+func (o *ReviewVote) Review(mods ...qm.QueryMod) reviewQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ReviewID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Reviews(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *ReviewVote) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadReview allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (reviewVoteL) LoadReview(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReviewVote interface{}, mods queries.Applicator) error {
+	var slice []*ReviewVote
+	var object *ReviewVote
+
+	if singular {
+		var ok bool
+		object, ok = maybeReviewVote.(*ReviewVote)
+		if !ok {
+			object = new(ReviewVote)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReviewVote)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReviewVote))
+			}
+		}
+	} else {
+		s, ok := maybeReviewVote.(*[]*ReviewVote)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReviewVote)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReviewVote))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &reviewVoteR{}
+		}
+		args = append(args, object.ReviewID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reviewVoteR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ReviewID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ReviewID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`reviews`),
+		qm.WhereIn(`reviews.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Review")
+	}
+
+	var resultSlice []*Review
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Review")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for reviews")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reviews")
+	}
+
+	if len(reviewVoteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Review = foreign
+		if foreign.R == nil {
+			foreign.R = &reviewR{}
+		}
+		foreign.R.ReviewVotes = append(foreign.R.ReviewVotes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ReviewID == foreign.ID {
+				local.R.Review = foreign
+				if foreign.R == nil {
+					foreign.R = &reviewR{}
+				}
+				foreign.R.ReviewVotes = append(foreign.R.ReviewVotes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (reviewVoteL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReviewVote interface{}, mods queries.Applicator) error {
+	var slice []*ReviewVote
+	var object *ReviewVote
+
+	if singular {
+		var ok bool
+		object, ok = maybeReviewVote.(*ReviewVote)
+		if !ok {
+			object = new(ReviewVote)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReviewVote)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReviewVote))
+			}
+		}
+	} else {
+		s, ok := maybeReviewVote.(*[]*ReviewVote)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReviewVote)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReviewVote))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &reviewVoteR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reviewVoteR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(reviewVoteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ReviewVotes = append(foreign.R.ReviewVotes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ReviewVotes = append(foreign.R.ReviewVotes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetReview of the reviewVote to the related item.
+// Sets o.R.Review to related.
+// Adds o to related.R.ReviewVotes.
+func (o *ReviewVote) SetReview(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Review) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"review_votes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"review_id"}),
+		strmangle.WhereClause("\"", "\"", 2, reviewVotePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ReviewID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ReviewID = related.ID
+	if o.R == nil {
+		o.R = &reviewVoteR{
+			Review: related,
+		}
+	} else {
+		o.R.Review = related
+	}
+
+	if related.R == nil {
+		related.R = &reviewR{
+			ReviewVotes: ReviewVoteSlice{o},
+		}
+	} else {
+		related.R.ReviewVotes = append(related.R.ReviewVotes, o)
+	}
+
+	return nil
+}
+
+// SetUser of the reviewVote to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.ReviewVotes.
+func (o *ReviewVote) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"review_votes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, reviewVotePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ReviewID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &reviewVoteR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ReviewVotes: ReviewVoteSlice{o},
+		}
+	} else {
+		related.R.ReviewVotes = append(related.R.ReviewVotes, o)
+	}
+
+	return nil
+}
+
+// ReviewVotes retrieves all the records using an executor.
+func ReviewVotes(mods ...qm.QueryMod) reviewVoteQuery {
+	mods = append(mods, qm.From("\"review_votes\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"review_votes\".*"})
+	}
+
+	return reviewVoteQuery{q}
+}
+
+// FindReviewVote retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindReviewVote(ctx context.Context, exec boil.ContextExecutor, reviewID int, userID int, selectCols ...string) (*ReviewVote, error) {
+	reviewVoteObj := &ReviewVote{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"review_votes\" where \"review_id\"=$1 AND \"user_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, reviewID, userID)
+
+	err := q.Bind(ctx, exec, reviewVoteObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from review_votes")
+	}
+
+	if err = reviewVoteObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return reviewVoteObj, err
+	}
+
+	return reviewVoteObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ReviewVote) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no review_votes provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(reviewVoteColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	reviewVoteInsertCacheMut.RLock()
+	cache, cached := reviewVoteInsertCache[key]
+	reviewVoteInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			reviewVoteAllColumns,
+			reviewVoteColumnsWithDefault,
+			reviewVoteColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(reviewVoteType, reviewVoteMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(reviewVoteType, reviewVoteMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"review_votes\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"review_votes\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into review_votes")
+	}
+
+	if !cached {
+		reviewVoteInsertCacheMut.Lock()
+		reviewVoteInsertCache[key] = cache
+		reviewVoteInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ReviewVote.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ReviewVote) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	reviewVoteUpdateCacheMut.RLock()
+	cache, cached := reviewVoteUpdateCache[key]
+	reviewVoteUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			reviewVoteAllColumns,
+			reviewVotePrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update review_votes, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"review_votes\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, reviewVotePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(reviewVoteType, reviewVoteMapping, append(wl, reviewVotePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update review_votes row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for review_votes")
+	}
+
+	if !cached {
+		reviewVoteUpdateCacheMut.Lock()
+		reviewVoteUpdateCache[key] = cache
+		reviewVoteUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q reviewVoteQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for review_votes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for review_votes")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ReviewVoteSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reviewVotePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"review_votes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, reviewVotePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in reviewVote slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all reviewVote")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ReviewVote) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no review_votes provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(reviewVoteColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	reviewVoteUpsertCacheMut.RLock()
+	cache, cached := reviewVoteUpsertCache[key]
+	reviewVoteUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			reviewVoteAllColumns,
+			reviewVoteColumnsWithDefault,
+			reviewVoteColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			reviewVoteAllColumns,
+			reviewVotePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert review_votes, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(reviewVotePrimaryKeyColumns))
+			copy(conflict, reviewVotePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"review_votes\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(reviewVoteType, reviewVoteMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(reviewVoteType, reviewVoteMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert review_votes")
+	}
+
+	if !cached {
+		reviewVoteUpsertCacheMut.Lock()
+		reviewVoteUpsertCache[key] = cache
+		reviewVoteUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ReviewVote record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ReviewVote) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ReviewVote provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), reviewVotePrimaryKeyMapping)
+	sql := "DELETE FROM \"review_votes\" WHERE \"review_id\"=$1 AND \"user_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from review_votes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for review_votes")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q reviewVoteQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no reviewVoteQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from review_votes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for review_votes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ReviewVoteSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(reviewVoteBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reviewVotePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"review_votes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, reviewVotePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from reviewVote slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for review_votes")
+	}
+
+	if len(reviewVoteAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ReviewVote) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindReviewVote(ctx, exec, o.ReviewID, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ReviewVoteSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ReviewVoteSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reviewVotePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"review_votes\".* FROM \"review_votes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, reviewVotePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ReviewVoteSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ReviewVoteExists checks if the ReviewVote row exists.
+func ReviewVoteExists(ctx context.Context, exec boil.ContextExecutor, reviewID int, userID int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"review_votes\" where \"review_id\"=$1 AND \"user_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, reviewID, userID)
+	}
+	row := exec.QueryRowContext(ctx, sql, reviewID, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if review_votes exists")
+	}
+
+	return exists, nil
+}
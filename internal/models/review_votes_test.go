@@ -0,0 +1,941 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testReviewVotes(t *testing.T) {
+	t.Parallel()
+
+	query := ReviewVotes()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testReviewVotesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewVote{}
+	if err = randomize.Struct(seed, o, reviewVoteDBTypes, true, reviewVoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewVote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ReviewVotes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReviewVotesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewVote{}
+	if err = randomize.Struct(seed, o, reviewVoteDBTypes, true, reviewVoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewVote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ReviewVotes().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ReviewVotes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReviewVotesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewVote{}
+	if err = randomize.Struct(seed, o, reviewVoteDBTypes, true, reviewVoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewVote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ReviewVoteSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ReviewVotes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReviewVotesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewVote{}
+	if err = randomize.Struct(seed, o, reviewVoteDBTypes, true, reviewVoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewVote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ReviewVoteExists(ctx, tx, o.ReviewID, o.UserID)
+	if err != nil {
+		t.Errorf("Unable to check if ReviewVote exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ReviewVoteExists to return true, but got false.")
+	}
+}
+
+func testReviewVotesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewVote{}
+	if err = randomize.Struct(seed, o, reviewVoteDBTypes, true, reviewVoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewVote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	reviewVoteFound, err := FindReviewVote(ctx, tx, o.ReviewID, o.UserID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if reviewVoteFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testReviewVotesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewVote{}
+	if err = randomize.Struct(seed, o, reviewVoteDBTypes, true, reviewVoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewVote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ReviewVotes().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testReviewVotesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewVote{}
+	if err = randomize.Struct(seed, o, reviewVoteDBTypes, true, reviewVoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewVote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ReviewVotes().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testReviewVotesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	reviewVoteOne := &ReviewVote{}
+	reviewVoteTwo := &ReviewVote{}
+	if err = randomize.Struct(seed, reviewVoteOne, reviewVoteDBTypes, false, reviewVoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewVote struct: %s", err)
+	}
+	if err = randomize.Struct(seed, reviewVoteTwo, reviewVoteDBTypes, false, reviewVoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewVote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = reviewVoteOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = reviewVoteTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ReviewVotes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testReviewVotesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	reviewVoteOne := &ReviewVote{}
+	reviewVoteTwo := &ReviewVote{}
+	if err = randomize.Struct(seed, reviewVoteOne, reviewVoteDBTypes, false, reviewVoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewVote struct: %s", err)
+	}
+	if err = randomize.Struct(seed, reviewVoteTwo, reviewVoteDBTypes, false, reviewVoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewVote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = reviewVoteOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = reviewVoteTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReviewVotes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func reviewVoteBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ReviewVote) error {
+	*o = ReviewVote{}
+	return nil
+}
+
+func reviewVoteAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ReviewVote) error {
+	*o = ReviewVote{}
+	return nil
+}
+
+func reviewVoteAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ReviewVote) error {
+	*o = ReviewVote{}
+	return nil
+}
+
+func reviewVoteBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ReviewVote) error {
+	*o = ReviewVote{}
+	return nil
+}
+
+func reviewVoteAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ReviewVote) error {
+	*o = ReviewVote{}
+	return nil
+}
+
+func reviewVoteBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ReviewVote) error {
+	*o = ReviewVote{}
+	return nil
+}
+
+func reviewVoteAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ReviewVote) error {
+	*o = ReviewVote{}
+	return nil
+}
+
+func reviewVoteBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ReviewVote) error {
+	*o = ReviewVote{}
+	return nil
+}
+
+func reviewVoteAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ReviewVote) error {
+	*o = ReviewVote{}
+	return nil
+}
+
+func testReviewVotesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ReviewVote{}
+	o := &ReviewVote{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, reviewVoteDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ReviewVote object: %s", err)
+	}
+
+	AddReviewVoteHook(boil.BeforeInsertHook, reviewVoteBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	reviewVoteBeforeInsertHooks = []ReviewVoteHook{}
+
+	AddReviewVoteHook(boil.AfterInsertHook, reviewVoteAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	reviewVoteAfterInsertHooks = []ReviewVoteHook{}
+
+	AddReviewVoteHook(boil.AfterSelectHook, reviewVoteAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	reviewVoteAfterSelectHooks = []ReviewVoteHook{}
+
+	AddReviewVoteHook(boil.BeforeUpdateHook, reviewVoteBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	reviewVoteBeforeUpdateHooks = []ReviewVoteHook{}
+
+	AddReviewVoteHook(boil.AfterUpdateHook, reviewVoteAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	reviewVoteAfterUpdateHooks = []ReviewVoteHook{}
+
+	AddReviewVoteHook(boil.BeforeDeleteHook, reviewVoteBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	reviewVoteBeforeDeleteHooks = []ReviewVoteHook{}
+
+	AddReviewVoteHook(boil.AfterDeleteHook, reviewVoteAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	reviewVoteAfterDeleteHooks = []ReviewVoteHook{}
+
+	AddReviewVoteHook(boil.BeforeUpsertHook, reviewVoteBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	reviewVoteBeforeUpsertHooks = []ReviewVoteHook{}
+
+	AddReviewVoteHook(boil.AfterUpsertHook, reviewVoteAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	reviewVoteAfterUpsertHooks = []ReviewVoteHook{}
+}
+
+func testReviewVotesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewVote{}
+	if err = randomize.Struct(seed, o, reviewVoteDBTypes, true, reviewVoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewVote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReviewVotes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testReviewVotesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewVote{}
+	if err = randomize.Struct(seed, o, reviewVoteDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ReviewVote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(reviewVoteColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReviewVotes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testReviewVoteToOneReviewUsingReview(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ReviewVote
+	var foreign Review
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, reviewVoteDBTypes, false, reviewVoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewVote struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, reviewDBTypes, false, reviewColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Review struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ReviewID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Review().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ReviewVoteSlice{&local}
+	if err = local.L.LoadReview(ctx, tx, false, (*[]*ReviewVote)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Review == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Review = nil
+	if err = local.L.LoadReview(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Review == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testReviewVoteToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ReviewVote
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, reviewVoteDBTypes, false, reviewVoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewVote struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := ReviewVoteSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*ReviewVote)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testReviewVoteToOneSetOpReviewUsingReview(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ReviewVote
+	var b, c Review
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, reviewVoteDBTypes, false, strmangle.SetComplement(reviewVotePrimaryKeyColumns, reviewVoteColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, reviewDBTypes, false, strmangle.SetComplement(reviewPrimaryKeyColumns, reviewColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, reviewDBTypes, false, strmangle.SetComplement(reviewPrimaryKeyColumns, reviewColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Review{&b, &c} {
+		err = a.SetReview(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Review != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ReviewVotes[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ReviewID != x.ID {
+			t.Error("foreign key was wrong value", a.ReviewID)
+		}
+
+		if exists, err := ReviewVoteExists(ctx, tx, a.ReviewID, a.UserID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+func testReviewVoteToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ReviewVote
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, reviewVoteDBTypes, false, strmangle.SetComplement(reviewVotePrimaryKeyColumns, reviewVoteColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ReviewVotes[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		if exists, err := ReviewVoteExists(ctx, tx, a.ReviewID, a.UserID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testReviewVotesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewVote{}
+	if err = randomize.Struct(seed, o, reviewVoteDBTypes, true, reviewVoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewVote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testReviewVotesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewVote{}
+	if err = randomize.Struct(seed, o, reviewVoteDBTypes, true, reviewVoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewVote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ReviewVoteSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testReviewVotesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewVote{}
+	if err = randomize.Struct(seed, o, reviewVoteDBTypes, true, reviewVoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewVote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ReviewVotes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	reviewVoteDBTypes = map[string]string{`ReviewID`: `integer`, `UserID`: `integer`, `TimeVoted`: `timestamp with time zone`}
+	_                 = bytes.MinRead
+)
+
+func testReviewVotesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(reviewVotePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(reviewVoteAllColumns) == len(reviewVotePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewVote{}
+	if err = randomize.Struct(seed, o, reviewVoteDBTypes, true, reviewVoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewVote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReviewVotes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, reviewVoteDBTypes, true, reviewVotePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ReviewVote struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testReviewVotesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(reviewVoteAllColumns) == len(reviewVotePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewVote{}
+	if err = randomize.Struct(seed, o, reviewVoteDBTypes, true, reviewVoteColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewVote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReviewVotes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, reviewVoteDBTypes, true, reviewVotePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ReviewVote struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(reviewVoteAllColumns, reviewVotePrimaryKeyColumns) {
+		fields = reviewVoteAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			reviewVoteAllColumns,
+			reviewVotePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ReviewVoteSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testReviewVotesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(reviewVoteAllColumns) == len(reviewVotePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ReviewVote{}
+	if err = randomize.Struct(seed, &o, reviewVoteDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ReviewVote struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ReviewVote: %s", err)
+	}
+
+	count, err := ReviewVotes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, reviewVoteDBTypes, false, reviewVotePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ReviewVote struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ReviewVote: %s", err)
+	}
+
+	count, err = ReviewVotes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
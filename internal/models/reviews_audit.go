@@ -0,0 +1,957 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ReviewsAudit is an object representing the database table.
+type ReviewsAudit struct {
+	ID          int       `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID      int       `db:"user_id" boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	FilmID      null.Int  `db:"film_id" boil:"film_id" json:"film_id,omitempty" toml:"film_id" yaml:"film_id,omitempty"`
+	SeriesID    null.Int  `db:"series_id" boil:"series_id" json:"series_id,omitempty" toml:"series_id" yaml:"series_id,omitempty"`
+	Body        string    `db:"body" boil:"body" json:"body" toml:"body" yaml:"body"`
+	Spoiler     bool      `db:"spoiler" boil:"spoiler" json:"spoiler" toml:"spoiler" yaml:"spoiler"`
+	Status      string    `db:"status" boil:"status" json:"status" toml:"status" yaml:"status"`
+	CreatedAt   time.Time `db:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	EditedAt    time.Time `db:"edited_at" boil:"edited_at" json:"edited_at" toml:"edited_at" yaml:"edited_at"`
+	AuditAction string    `db:"audit_action" boil:"audit_action" json:"audit_action" toml:"audit_action" yaml:"audit_action"`
+	AuditActor  null.Int  `db:"audit_actor" boil:"audit_actor" json:"audit_actor,omitempty" toml:"audit_actor" yaml:"audit_actor,omitempty"`
+	AuditedAt   time.Time `db:"audited_at" boil:"audited_at" json:"audited_at" toml:"audited_at" yaml:"audited_at"`
+
+	R *reviewsAuditR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L reviewsAuditL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ReviewsAuditColumns = struct {
+	ID          string
+	UserID      string
+	FilmID      string
+	SeriesID    string
+	Body        string
+	Spoiler     string
+	Status      string
+	CreatedAt   string
+	EditedAt    string
+	AuditAction string
+	AuditActor  string
+	AuditedAt   string
+}{
+	ID:          "id",
+	UserID:      "user_id",
+	FilmID:      "film_id",
+	SeriesID:    "series_id",
+	Body:        "body",
+	Spoiler:     "spoiler",
+	Status:      "status",
+	CreatedAt:   "created_at",
+	EditedAt:    "edited_at",
+	AuditAction: "audit_action",
+	AuditActor:  "audit_actor",
+	AuditedAt:   "audited_at",
+}
+
+var ReviewsAuditTableColumns = struct {
+	ID          string
+	UserID      string
+	FilmID      string
+	SeriesID    string
+	Body        string
+	Spoiler     string
+	Status      string
+	CreatedAt   string
+	EditedAt    string
+	AuditAction string
+	AuditActor  string
+	AuditedAt   string
+}{
+	ID:          "reviews_audit.id",
+	UserID:      "reviews_audit.user_id",
+	FilmID:      "reviews_audit.film_id",
+	SeriesID:    "reviews_audit.series_id",
+	Body:        "reviews_audit.body",
+	Spoiler:     "reviews_audit.spoiler",
+	Status:      "reviews_audit.status",
+	CreatedAt:   "reviews_audit.created_at",
+	EditedAt:    "reviews_audit.edited_at",
+	AuditAction: "reviews_audit.audit_action",
+	AuditActor:  "reviews_audit.audit_actor",
+	AuditedAt:   "reviews_audit.audited_at",
+}
+
+// Generated where
+
+var ReviewsAuditWhere = struct {
+	ID          whereHelperint
+	UserID      whereHelperint
+	FilmID      whereHelpernull_Int
+	SeriesID    whereHelpernull_Int
+	Body        whereHelperstring
+	Spoiler     whereHelperbool
+	Status      whereHelperstring
+	CreatedAt   whereHelpertime_Time
+	EditedAt    whereHelpertime_Time
+	AuditAction whereHelperstring
+	AuditActor  whereHelpernull_Int
+	AuditedAt   whereHelpertime_Time
+}{
+	ID:          whereHelperint{field: "\"reviews_audit\".\"id\""},
+	UserID:      whereHelperint{field: "\"reviews_audit\".\"user_id\""},
+	FilmID:      whereHelpernull_Int{field: "\"reviews_audit\".\"film_id\""},
+	SeriesID:    whereHelpernull_Int{field: "\"reviews_audit\".\"series_id\""},
+	Body:        whereHelperstring{field: "\"reviews_audit\".\"body\""},
+	Spoiler:     whereHelperbool{field: "\"reviews_audit\".\"spoiler\""},
+	Status:      whereHelperstring{field: "\"reviews_audit\".\"status\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"reviews_audit\".\"created_at\""},
+	EditedAt:    whereHelpertime_Time{field: "\"reviews_audit\".\"edited_at\""},
+	AuditAction: whereHelperstring{field: "\"reviews_audit\".\"audit_action\""},
+	AuditActor:  whereHelpernull_Int{field: "\"reviews_audit\".\"audit_actor\""},
+	AuditedAt:   whereHelpertime_Time{field: "\"reviews_audit\".\"audited_at\""},
+}
+
+// ReviewsAuditRels is where relationship names are stored.
+var ReviewsAuditRels = struct {
+}{}
+
+// reviewsAuditR is where relationships are stored.
+type reviewsAuditR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*reviewsAuditR) NewStruct() *reviewsAuditR {
+	return &reviewsAuditR{}
+}
+
+// reviewsAuditL is where Load methods for each relationship are stored.
+type reviewsAuditL struct{}
+
+var (
+	reviewsAuditAllColumns            = []string{"id", "user_id", "film_id", "series_id", "body", "spoiler", "status", "created_at", "edited_at", "audit_action", "audit_actor", "audited_at"}
+	reviewsAuditColumnsWithoutDefault = []string{"id", "user_id", "body", "spoiler", "status", "created_at", "edited_at"}
+	reviewsAuditColumnsWithDefault    = []string{"film_id", "series_id", "audit_action", "audit_actor", "audited_at"}
+	reviewsAuditPrimaryKeyColumns     = []string{"id", "audited_at"}
+	reviewsAuditGeneratedColumns      = []string{}
+)
+
+type (
+	// ReviewsAuditSlice is an alias for a slice of pointers to ReviewsAudit.
+	// This should almost always be used instead of []ReviewsAudit.
+	ReviewsAuditSlice []*ReviewsAudit
+	// ReviewsAuditHook is the signature for custom ReviewsAudit hook methods
+	ReviewsAuditHook func(context.Context, boil.ContextExecutor, *ReviewsAudit) error
+
+	reviewsAuditQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	reviewsAuditType                 = reflect.TypeOf(&ReviewsAudit{})
+	reviewsAuditMapping              = queries.MakeStructMapping(reviewsAuditType)
+	reviewsAuditPrimaryKeyMapping, _ = queries.BindMapping(reviewsAuditType, reviewsAuditMapping, reviewsAuditPrimaryKeyColumns)
+	reviewsAuditInsertCacheMut       sync.RWMutex
+	reviewsAuditInsertCache          = make(map[string]insertCache)
+	reviewsAuditUpdateCacheMut       sync.RWMutex
+	reviewsAuditUpdateCache          = make(map[string]updateCache)
+	reviewsAuditUpsertCacheMut       sync.RWMutex
+	reviewsAuditUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var reviewsAuditAfterSelectHooks []ReviewsAuditHook
+
+var reviewsAuditBeforeInsertHooks []ReviewsAuditHook
+var reviewsAuditAfterInsertHooks []ReviewsAuditHook
+
+var reviewsAuditBeforeUpdateHooks []ReviewsAuditHook
+var reviewsAuditAfterUpdateHooks []ReviewsAuditHook
+
+var reviewsAuditBeforeDeleteHooks []ReviewsAuditHook
+var reviewsAuditAfterDeleteHooks []ReviewsAuditHook
+
+var reviewsAuditBeforeUpsertHooks []ReviewsAuditHook
+var reviewsAuditAfterUpsertHooks []ReviewsAuditHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ReviewsAudit) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewsAuditAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ReviewsAudit) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewsAuditBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ReviewsAudit) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewsAuditAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ReviewsAudit) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewsAuditBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ReviewsAudit) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewsAuditAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ReviewsAudit) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewsAuditBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ReviewsAudit) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewsAuditAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ReviewsAudit) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewsAuditBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ReviewsAudit) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reviewsAuditAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddReviewsAuditHook registers your hook function for all future operations.
+func AddReviewsAuditHook(hookPoint boil.HookPoint, reviewsAuditHook ReviewsAuditHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		reviewsAuditAfterSelectHooks = append(reviewsAuditAfterSelectHooks, reviewsAuditHook)
+	case boil.BeforeInsertHook:
+		reviewsAuditBeforeInsertHooks = append(reviewsAuditBeforeInsertHooks, reviewsAuditHook)
+	case boil.AfterInsertHook:
+		reviewsAuditAfterInsertHooks = append(reviewsAuditAfterInsertHooks, reviewsAuditHook)
+	case boil.BeforeUpdateHook:
+		reviewsAuditBeforeUpdateHooks = append(reviewsAuditBeforeUpdateHooks, reviewsAuditHook)
+	case boil.AfterUpdateHook:
+		reviewsAuditAfterUpdateHooks = append(reviewsAuditAfterUpdateHooks, reviewsAuditHook)
+	case boil.BeforeDeleteHook:
+		reviewsAuditBeforeDeleteHooks = append(reviewsAuditBeforeDeleteHooks, reviewsAuditHook)
+	case boil.AfterDeleteHook:
+		reviewsAuditAfterDeleteHooks = append(reviewsAuditAfterDeleteHooks, reviewsAuditHook)
+	case boil.BeforeUpsertHook:
+		reviewsAuditBeforeUpsertHooks = append(reviewsAuditBeforeUpsertHooks, reviewsAuditHook)
+	case boil.AfterUpsertHook:
+		reviewsAuditAfterUpsertHooks = append(reviewsAuditAfterUpsertHooks, reviewsAuditHook)
+	}
+}
+
+// One returns a single reviewsAudit record from the query.
+func (q reviewsAuditQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ReviewsAudit, error) {
+	o := &ReviewsAudit{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for reviews_audit")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ReviewsAudit records from the query.
+func (q reviewsAuditQuery) All(ctx context.Context, exec boil.ContextExecutor) (ReviewsAuditSlice, error) {
+	var o []*ReviewsAudit
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ReviewsAudit slice")
+	}
+
+	if len(reviewsAuditAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ReviewsAudit records in the query.
+func (q reviewsAuditQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count reviews_audit rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q reviewsAuditQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if reviews_audit exists")
+	}
+
+	return count > 0, nil
+}
+
+// ReviewsAudits retrieves all the records using an executor.
+func ReviewsAudits(mods ...qm.QueryMod) reviewsAuditQuery {
+	mods = append(mods, qm.From("\"reviews_audit\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"reviews_audit\".*"})
+	}
+
+	return reviewsAuditQuery{q}
+}
+
+// FindReviewsAudit retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindReviewsAudit(ctx context.Context, exec boil.ContextExecutor, iD int, auditedAt time.Time, selectCols ...string) (*ReviewsAudit, error) {
+	reviewsAuditObj := &ReviewsAudit{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"reviews_audit\" where \"id\"=$1 AND \"audited_at\"=$2", sel,
+	)
+
+	q := queries.Raw(query, iD, auditedAt)
+
+	err := q.Bind(ctx, exec, reviewsAuditObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from reviews_audit")
+	}
+
+	if err = reviewsAuditObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return reviewsAuditObj, err
+	}
+
+	return reviewsAuditObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ReviewsAudit) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no reviews_audit provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(reviewsAuditColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	reviewsAuditInsertCacheMut.RLock()
+	cache, cached := reviewsAuditInsertCache[key]
+	reviewsAuditInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			reviewsAuditAllColumns,
+			reviewsAuditColumnsWithDefault,
+			reviewsAuditColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(reviewsAuditType, reviewsAuditMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(reviewsAuditType, reviewsAuditMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"reviews_audit\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"reviews_audit\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into reviews_audit")
+	}
+
+	if !cached {
+		reviewsAuditInsertCacheMut.Lock()
+		reviewsAuditInsertCache[key] = cache
+		reviewsAuditInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ReviewsAudit.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ReviewsAudit) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	reviewsAuditUpdateCacheMut.RLock()
+	cache, cached := reviewsAuditUpdateCache[key]
+	reviewsAuditUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			reviewsAuditAllColumns,
+			reviewsAuditPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update reviews_audit, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"reviews_audit\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, reviewsAuditPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(reviewsAuditType, reviewsAuditMapping, append(wl, reviewsAuditPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update reviews_audit row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for reviews_audit")
+	}
+
+	if !cached {
+		reviewsAuditUpdateCacheMut.Lock()
+		reviewsAuditUpdateCache[key] = cache
+		reviewsAuditUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q reviewsAuditQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for reviews_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for reviews_audit")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ReviewsAuditSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reviewsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"reviews_audit\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, reviewsAuditPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in reviewsAudit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all reviewsAudit")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ReviewsAudit) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no reviews_audit provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(reviewsAuditColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	reviewsAuditUpsertCacheMut.RLock()
+	cache, cached := reviewsAuditUpsertCache[key]
+	reviewsAuditUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			reviewsAuditAllColumns,
+			reviewsAuditColumnsWithDefault,
+			reviewsAuditColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			reviewsAuditAllColumns,
+			reviewsAuditPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert reviews_audit, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(reviewsAuditPrimaryKeyColumns))
+			copy(conflict, reviewsAuditPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"reviews_audit\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(reviewsAuditType, reviewsAuditMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(reviewsAuditType, reviewsAuditMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert reviews_audit")
+	}
+
+	if !cached {
+		reviewsAuditUpsertCacheMut.Lock()
+		reviewsAuditUpsertCache[key] = cache
+		reviewsAuditUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ReviewsAudit record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ReviewsAudit) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ReviewsAudit provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), reviewsAuditPrimaryKeyMapping)
+	sql := "DELETE FROM \"reviews_audit\" WHERE \"id\"=$1 AND \"audited_at\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from reviews_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for reviews_audit")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q reviewsAuditQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no reviewsAuditQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from reviews_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for reviews_audit")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ReviewsAuditSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(reviewsAuditBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reviewsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"reviews_audit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, reviewsAuditPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from reviewsAudit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for reviews_audit")
+	}
+
+	if len(reviewsAuditAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ReviewsAudit) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindReviewsAudit(ctx, exec, o.ID, o.AuditedAt)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ReviewsAuditSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ReviewsAuditSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reviewsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"reviews_audit\".* FROM \"reviews_audit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, reviewsAuditPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ReviewsAuditSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ReviewsAuditExists checks if the ReviewsAudit row exists.
+func ReviewsAuditExists(ctx context.Context, exec boil.ContextExecutor, iD int, auditedAt time.Time) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"reviews_audit\" where \"id\"=$1 AND \"audited_at\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD, auditedAt)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD, auditedAt)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if reviews_audit exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testReviewsAudits(t *testing.T) {
+	t.Parallel()
+
+	query := ReviewsAudits()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testReviewsAuditsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewsAudit{}
+	if err = randomize.Struct(seed, o, reviewsAuditDBTypes, true, reviewsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ReviewsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReviewsAuditsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewsAudit{}
+	if err = randomize.Struct(seed, o, reviewsAuditDBTypes, true, reviewsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ReviewsAudits().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ReviewsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReviewsAuditsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewsAudit{}
+	if err = randomize.Struct(seed, o, reviewsAuditDBTypes, true, reviewsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ReviewsAuditSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ReviewsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testReviewsAuditsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewsAudit{}
+	if err = randomize.Struct(seed, o, reviewsAuditDBTypes, true, reviewsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ReviewsAuditExists(ctx, tx, o.ID, o.AuditedAt)
+	if err != nil {
+		t.Errorf("Unable to check if ReviewsAudit exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ReviewsAuditExists to return true, but got false.")
+	}
+}
+
+func testReviewsAuditsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewsAudit{}
+	if err = randomize.Struct(seed, o, reviewsAuditDBTypes, true, reviewsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	reviewsAuditFound, err := FindReviewsAudit(ctx, tx, o.ID, o.AuditedAt)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if reviewsAuditFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testReviewsAuditsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewsAudit{}
+	if err = randomize.Struct(seed, o, reviewsAuditDBTypes, true, reviewsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ReviewsAudits().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testReviewsAuditsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewsAudit{}
+	if err = randomize.Struct(seed, o, reviewsAuditDBTypes, true, reviewsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ReviewsAudits().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testReviewsAuditsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	reviewsAuditOne := &ReviewsAudit{}
+	reviewsAuditTwo := &ReviewsAudit{}
+	if err = randomize.Struct(seed, reviewsAuditOne, reviewsAuditDBTypes, false, reviewsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewsAudit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, reviewsAuditTwo, reviewsAuditDBTypes, false, reviewsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = reviewsAuditOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = reviewsAuditTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ReviewsAudits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testReviewsAuditsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	reviewsAuditOne := &ReviewsAudit{}
+	reviewsAuditTwo := &ReviewsAudit{}
+	if err = randomize.Struct(seed, reviewsAuditOne, reviewsAuditDBTypes, false, reviewsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewsAudit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, reviewsAuditTwo, reviewsAuditDBTypes, false, reviewsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = reviewsAuditOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = reviewsAuditTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReviewsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func reviewsAuditBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ReviewsAudit) error {
+	*o = ReviewsAudit{}
+	return nil
+}
+
+func reviewsAuditAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ReviewsAudit) error {
+	*o = ReviewsAudit{}
+	return nil
+}
+
+func reviewsAuditAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ReviewsAudit) error {
+	*o = ReviewsAudit{}
+	return nil
+}
+
+func reviewsAuditBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ReviewsAudit) error {
+	*o = ReviewsAudit{}
+	return nil
+}
+
+func reviewsAuditAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ReviewsAudit) error {
+	*o = ReviewsAudit{}
+	return nil
+}
+
+func reviewsAuditBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ReviewsAudit) error {
+	*o = ReviewsAudit{}
+	return nil
+}
+
+func reviewsAuditAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ReviewsAudit) error {
+	*o = ReviewsAudit{}
+	return nil
+}
+
+func reviewsAuditBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ReviewsAudit) error {
+	*o = ReviewsAudit{}
+	return nil
+}
+
+func reviewsAuditAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ReviewsAudit) error {
+	*o = ReviewsAudit{}
+	return nil
+}
+
+func testReviewsAuditsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ReviewsAudit{}
+	o := &ReviewsAudit{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, reviewsAuditDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ReviewsAudit object: %s", err)
+	}
+
+	AddReviewsAuditHook(boil.BeforeInsertHook, reviewsAuditBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	reviewsAuditBeforeInsertHooks = []ReviewsAuditHook{}
+
+	AddReviewsAuditHook(boil.AfterInsertHook, reviewsAuditAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	reviewsAuditAfterInsertHooks = []ReviewsAuditHook{}
+
+	AddReviewsAuditHook(boil.AfterSelectHook, reviewsAuditAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	reviewsAuditAfterSelectHooks = []ReviewsAuditHook{}
+
+	AddReviewsAuditHook(boil.BeforeUpdateHook, reviewsAuditBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	reviewsAuditBeforeUpdateHooks = []ReviewsAuditHook{}
+
+	AddReviewsAuditHook(boil.AfterUpdateHook, reviewsAuditAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	reviewsAuditAfterUpdateHooks = []ReviewsAuditHook{}
+
+	AddReviewsAuditHook(boil.BeforeDeleteHook, reviewsAuditBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	reviewsAuditBeforeDeleteHooks = []ReviewsAuditHook{}
+
+	AddReviewsAuditHook(boil.AfterDeleteHook, reviewsAuditAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	reviewsAuditAfterDeleteHooks = []ReviewsAuditHook{}
+
+	AddReviewsAuditHook(boil.BeforeUpsertHook, reviewsAuditBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	reviewsAuditBeforeUpsertHooks = []ReviewsAuditHook{}
+
+	AddReviewsAuditHook(boil.AfterUpsertHook, reviewsAuditAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	reviewsAuditAfterUpsertHooks = []ReviewsAuditHook{}
+}
+
+func testReviewsAuditsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewsAudit{}
+	if err = randomize.Struct(seed, o, reviewsAuditDBTypes, true, reviewsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReviewsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testReviewsAuditsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewsAudit{}
+	if err = randomize.Struct(seed, o, reviewsAuditDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ReviewsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(reviewsAuditColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReviewsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testReviewsAuditsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewsAudit{}
+	if err = randomize.Struct(seed, o, reviewsAuditDBTypes, true, reviewsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testReviewsAuditsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewsAudit{}
+	if err = randomize.Struct(seed, o, reviewsAuditDBTypes, true, reviewsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ReviewsAuditSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testReviewsAuditsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewsAudit{}
+	if err = randomize.Struct(seed, o, reviewsAuditDBTypes, true, reviewsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ReviewsAudits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	reviewsAuditDBTypes = map[string]string{`ID`: `integer`, `UserID`: `integer`, `FilmID`: `integer`, `SeriesID`: `integer`, `Body`: `text`, `Spoiler`: `boolean`, `Status`: `character varying`, `CreatedAt`: `timestamp with time zone`, `EditedAt`: `timestamp with time zone`, `AuditAction`: `character varying`, `AuditActor`: `integer`, `AuditedAt`: `timestamp with time zone`}
+	_                   = bytes.MinRead
+)
+
+func testReviewsAuditsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(reviewsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(reviewsAuditAllColumns) == len(reviewsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewsAudit{}
+	if err = randomize.Struct(seed, o, reviewsAuditDBTypes, true, reviewsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReviewsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, reviewsAuditDBTypes, true, reviewsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ReviewsAudit struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testReviewsAuditsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(reviewsAuditAllColumns) == len(reviewsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ReviewsAudit{}
+	if err = randomize.Struct(seed, o, reviewsAuditDBTypes, true, reviewsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ReviewsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ReviewsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, reviewsAuditDBTypes, true, reviewsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ReviewsAudit struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(reviewsAuditAllColumns, reviewsAuditPrimaryKeyColumns) {
+		fields = reviewsAuditAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			reviewsAuditAllColumns,
+			reviewsAuditPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ReviewsAuditSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testReviewsAuditsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(reviewsAuditAllColumns) == len(reviewsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ReviewsAudit{}
+	if err = randomize.Struct(seed, &o, reviewsAuditDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ReviewsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ReviewsAudit: %s", err)
+	}
+
+	count, err := ReviewsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, reviewsAuditDBTypes, false, reviewsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ReviewsAudit struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ReviewsAudit: %s", err)
+	}
+
+	count, err = ReviewsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1112 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// SeriesAggregate is an object representing the database table.
+type SeriesAggregate struct {
+	SeriesID          int        `db:"series_id" boil:"series_id" json:"series_id" toml:"series_id" yaml:"series_id"`
+	SeasonsCount      int        `db:"seasons_count" boil:"seasons_count" json:"seasons_count" toml:"seasons_count" yaml:"seasons_count"`
+	EpisodesCount     int        `db:"episodes_count" boil:"episodes_count" json:"episodes_count" toml:"episodes_count" yaml:"episodes_count"`
+	TotalRuntime      int        `db:"total_runtime" boil:"total_runtime" json:"total_runtime" toml:"total_runtime" yaml:"total_runtime"`
+	FirstEpisodeDate  null.Time  `db:"first_episode_date" boil:"first_episode_date" json:"first_episode_date,omitempty" toml:"first_episode_date" yaml:"first_episode_date,omitempty"`
+	LatestEpisodeDate null.Time  `db:"latest_episode_date" boil:"latest_episode_date" json:"latest_episode_date,omitempty" toml:"latest_episode_date" yaml:"latest_episode_date,omitempty"`
+	SeasonEpisodes    types.JSON `db:"season_episodes" boil:"season_episodes" json:"season_episodes" toml:"season_episodes" yaml:"season_episodes"`
+
+	R *seriesAggregateR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L seriesAggregateL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SeriesAggregateColumns = struct {
+	SeriesID          string
+	SeasonsCount      string
+	EpisodesCount     string
+	TotalRuntime      string
+	FirstEpisodeDate  string
+	LatestEpisodeDate string
+	SeasonEpisodes    string
+}{
+	SeriesID:          "series_id",
+	SeasonsCount:      "seasons_count",
+	EpisodesCount:     "episodes_count",
+	TotalRuntime:      "total_runtime",
+	FirstEpisodeDate:  "first_episode_date",
+	LatestEpisodeDate: "latest_episode_date",
+	SeasonEpisodes:    "season_episodes",
+}
+
+var SeriesAggregateTableColumns = struct {
+	SeriesID          string
+	SeasonsCount      string
+	EpisodesCount     string
+	TotalRuntime      string
+	FirstEpisodeDate  string
+	LatestEpisodeDate string
+	SeasonEpisodes    string
+}{
+	SeriesID:          "series_aggregates.series_id",
+	SeasonsCount:      "series_aggregates.seasons_count",
+	EpisodesCount:     "series_aggregates.episodes_count",
+	TotalRuntime:      "series_aggregates.total_runtime",
+	FirstEpisodeDate:  "series_aggregates.first_episode_date",
+	LatestEpisodeDate: "series_aggregates.latest_episode_date",
+	SeasonEpisodes:    "series_aggregates.season_episodes",
+}
+
+// Generated where
+
+var SeriesAggregateWhere = struct {
+	SeriesID          whereHelperint
+	SeasonsCount      whereHelperint
+	EpisodesCount     whereHelperint
+	TotalRuntime      whereHelperint
+	FirstEpisodeDate  whereHelpernull_Time
+	LatestEpisodeDate whereHelpernull_Time
+	SeasonEpisodes    whereHelpertypes_JSON
+}{
+	SeriesID:          whereHelperint{field: "\"series_aggregates\".\"series_id\""},
+	SeasonsCount:      whereHelperint{field: "\"series_aggregates\".\"seasons_count\""},
+	EpisodesCount:     whereHelperint{field: "\"series_aggregates\".\"episodes_count\""},
+	TotalRuntime:      whereHelperint{field: "\"series_aggregates\".\"total_runtime\""},
+	FirstEpisodeDate:  whereHelpernull_Time{field: "\"series_aggregates\".\"first_episode_date\""},
+	LatestEpisodeDate: whereHelpernull_Time{field: "\"series_aggregates\".\"latest_episode_date\""},
+	SeasonEpisodes:    whereHelpertypes_JSON{field: "\"series_aggregates\".\"season_episodes\""},
+}
+
+// SeriesAggregateRels is where relationship names are stored.
+var SeriesAggregateRels = struct {
+	Series string
+}{
+	Series: "Series",
+}
+
+// seriesAggregateR is where relationships are stored.
+type seriesAggregateR struct {
+	Series *Series `db:"Series" boil:"Series" json:"Series" toml:"Series" yaml:"Series"`
+}
+
+// NewStruct creates a new relationship struct
+func (*seriesAggregateR) NewStruct() *seriesAggregateR {
+	return &seriesAggregateR{}
+}
+
+func (r *seriesAggregateR) GetSeries() *Series {
+	if r == nil {
+		return nil
+	}
+	return r.Series
+}
+
+// seriesAggregateL is where Load methods for each relationship are stored.
+type seriesAggregateL struct{}
+
+var (
+	seriesAggregateAllColumns            = []string{"series_id", "seasons_count", "episodes_count", "total_runtime", "first_episode_date", "latest_episode_date", "season_episodes"}
+	seriesAggregateColumnsWithoutDefault = []string{"series_id"}
+	seriesAggregateColumnsWithDefault    = []string{"seasons_count", "episodes_count", "total_runtime", "first_episode_date", "latest_episode_date", "season_episodes"}
+	seriesAggregatePrimaryKeyColumns     = []string{"series_id"}
+	seriesAggregateGeneratedColumns      = []string{}
+)
+
+type (
+	// SeriesAggregateSlice is an alias for a slice of pointers to SeriesAggregate.
+	// This should almost always be used instead of []SeriesAggregate.
+	SeriesAggregateSlice []*SeriesAggregate
+	// SeriesAggregateHook is the signature for custom SeriesAggregate hook methods
+	SeriesAggregateHook func(context.Context, boil.ContextExecutor, *SeriesAggregate) error
+
+	seriesAggregateQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	seriesAggregateType                 = reflect.TypeOf(&SeriesAggregate{})
+	seriesAggregateMapping              = queries.MakeStructMapping(seriesAggregateType)
+	seriesAggregatePrimaryKeyMapping, _ = queries.BindMapping(seriesAggregateType, seriesAggregateMapping, seriesAggregatePrimaryKeyColumns)
+	seriesAggregateInsertCacheMut       sync.RWMutex
+	seriesAggregateInsertCache          = make(map[string]insertCache)
+	seriesAggregateUpdateCacheMut       sync.RWMutex
+	seriesAggregateUpdateCache          = make(map[string]updateCache)
+	seriesAggregateUpsertCacheMut       sync.RWMutex
+	seriesAggregateUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var seriesAggregateAfterSelectHooks []SeriesAggregateHook
+
+var seriesAggregateBeforeInsertHooks []SeriesAggregateHook
+var seriesAggregateAfterInsertHooks []SeriesAggregateHook
+
+var seriesAggregateBeforeUpdateHooks []SeriesAggregateHook
+var seriesAggregateAfterUpdateHooks []SeriesAggregateHook
+
+var seriesAggregateBeforeDeleteHooks []SeriesAggregateHook
+var seriesAggregateAfterDeleteHooks []SeriesAggregateHook
+
+var seriesAggregateBeforeUpsertHooks []SeriesAggregateHook
+var seriesAggregateAfterUpsertHooks []SeriesAggregateHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *SeriesAggregate) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesAggregateAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *SeriesAggregate) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesAggregateBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *SeriesAggregate) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesAggregateAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *SeriesAggregate) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesAggregateBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *SeriesAggregate) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesAggregateAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *SeriesAggregate) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesAggregateBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *SeriesAggregate) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesAggregateAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *SeriesAggregate) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesAggregateBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *SeriesAggregate) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesAggregateAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSeriesAggregateHook registers your hook function for all future operations.
+func AddSeriesAggregateHook(hookPoint boil.HookPoint, seriesAggregateHook SeriesAggregateHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		seriesAggregateAfterSelectHooks = append(seriesAggregateAfterSelectHooks, seriesAggregateHook)
+	case boil.BeforeInsertHook:
+		seriesAggregateBeforeInsertHooks = append(seriesAggregateBeforeInsertHooks, seriesAggregateHook)
+	case boil.AfterInsertHook:
+		seriesAggregateAfterInsertHooks = append(seriesAggregateAfterInsertHooks, seriesAggregateHook)
+	case boil.BeforeUpdateHook:
+		seriesAggregateBeforeUpdateHooks = append(seriesAggregateBeforeUpdateHooks, seriesAggregateHook)
+	case boil.AfterUpdateHook:
+		seriesAggregateAfterUpdateHooks = append(seriesAggregateAfterUpdateHooks, seriesAggregateHook)
+	case boil.BeforeDeleteHook:
+		seriesAggregateBeforeDeleteHooks = append(seriesAggregateBeforeDeleteHooks, seriesAggregateHook)
+	case boil.AfterDeleteHook:
+		seriesAggregateAfterDeleteHooks = append(seriesAggregateAfterDeleteHooks, seriesAggregateHook)
+	case boil.BeforeUpsertHook:
+		seriesAggregateBeforeUpsertHooks = append(seriesAggregateBeforeUpsertHooks, seriesAggregateHook)
+	case boil.AfterUpsertHook:
+		seriesAggregateAfterUpsertHooks = append(seriesAggregateAfterUpsertHooks, seriesAggregateHook)
+	}
+}
+
+// One returns a single seriesAggregate record from the query.
+func (q seriesAggregateQuery) One(ctx context.Context, exec boil.ContextExecutor) (*SeriesAggregate, error) {
+	o := &SeriesAggregate{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for series_aggregates")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all SeriesAggregate records from the query.
+func (q seriesAggregateQuery) All(ctx context.Context, exec boil.ContextExecutor) (SeriesAggregateSlice, error) {
+	var o []*SeriesAggregate
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to SeriesAggregate slice")
+	}
+
+	if len(seriesAggregateAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all SeriesAggregate records in the query.
+func (q seriesAggregateQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count series_aggregates rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q seriesAggregateQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if series_aggregates exists")
+	}
+
+	return count > 0, nil
+}
+
+// Series pointed to by the foreign key.
+func (o *SeriesAggregate) Series(mods ...qm.QueryMod) seriesQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SeriesID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Serieses(queryMods...)
+}
+
+// LoadSeries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (seriesAggregateL) LoadSeries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSeriesAggregate interface{}, mods queries.Applicator) error {
+	var slice []*SeriesAggregate
+	var object *SeriesAggregate
+
+	if singular {
+		var ok bool
+		object, ok = maybeSeriesAggregate.(*SeriesAggregate)
+		if !ok {
+			object = new(SeriesAggregate)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSeriesAggregate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSeriesAggregate))
+			}
+		}
+	} else {
+		s, ok := maybeSeriesAggregate.(*[]*SeriesAggregate)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSeriesAggregate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSeriesAggregate))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &seriesAggregateR{}
+		}
+		args = append(args, object.SeriesID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &seriesAggregateR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SeriesID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SeriesID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`serieses`),
+		qm.WhereIn(`serieses.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Series")
+	}
+
+	var resultSlice []*Series
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Series")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for serieses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for serieses")
+	}
+
+	if len(seriesAggregateAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Series = foreign
+		if foreign.R == nil {
+			foreign.R = &seriesR{}
+		}
+		foreign.R.SeriesSeriesAggregates = append(foreign.R.SeriesSeriesAggregates, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SeriesID == foreign.ID {
+				local.R.Series = foreign
+				if foreign.R == nil {
+					foreign.R = &seriesR{}
+				}
+				foreign.R.SeriesSeriesAggregates = append(foreign.R.SeriesSeriesAggregates, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSeries of the seriesAggregate to the related item.
+// Sets o.R.Series to related.
+// Adds o to related.R.SeriesSeriesAggregates.
+func (o *SeriesAggregate) SetSeries(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Series) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"series_aggregates\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+		strmangle.WhereClause("\"", "\"", 2, seriesAggregatePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.SeriesID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SeriesID = related.ID
+	if o.R == nil {
+		o.R = &seriesAggregateR{
+			Series: related,
+		}
+	} else {
+		o.R.Series = related
+	}
+
+	if related.R == nil {
+		related.R = &seriesR{
+			SeriesSeriesAggregates: SeriesAggregateSlice{o},
+		}
+	} else {
+		related.R.SeriesSeriesAggregates = append(related.R.SeriesSeriesAggregates, o)
+	}
+
+	return nil
+}
+
+// SeriesAggregates retrieves all the records using an executor.
+func SeriesAggregates(mods ...qm.QueryMod) seriesAggregateQuery {
+	mods = append(mods, qm.From("\"series_aggregates\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"series_aggregates\".*"})
+	}
+
+	return seriesAggregateQuery{q}
+}
+
+// FindSeriesAggregate retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSeriesAggregate(ctx context.Context, exec boil.ContextExecutor, seriesID int, selectCols ...string) (*SeriesAggregate, error) {
+	seriesAggregateObj := &SeriesAggregate{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"series_aggregates\" where \"series_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, seriesID)
+
+	err := q.Bind(ctx, exec, seriesAggregateObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from series_aggregates")
+	}
+
+	if err = seriesAggregateObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return seriesAggregateObj, err
+	}
+
+	return seriesAggregateObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SeriesAggregate) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no series_aggregates provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(seriesAggregateColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	seriesAggregateInsertCacheMut.RLock()
+	cache, cached := seriesAggregateInsertCache[key]
+	seriesAggregateInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			seriesAggregateAllColumns,
+			seriesAggregateColumnsWithDefault,
+			seriesAggregateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(seriesAggregateType, seriesAggregateMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(seriesAggregateType, seriesAggregateMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"series_aggregates\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"series_aggregates\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into series_aggregates")
+	}
+
+	if !cached {
+		seriesAggregateInsertCacheMut.Lock()
+		seriesAggregateInsertCache[key] = cache
+		seriesAggregateInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the SeriesAggregate.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SeriesAggregate) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	seriesAggregateUpdateCacheMut.RLock()
+	cache, cached := seriesAggregateUpdateCache[key]
+	seriesAggregateUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			seriesAggregateAllColumns,
+			seriesAggregatePrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update series_aggregates, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"series_aggregates\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, seriesAggregatePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(seriesAggregateType, seriesAggregateMapping, append(wl, seriesAggregatePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update series_aggregates row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for series_aggregates")
+	}
+
+	if !cached {
+		seriesAggregateUpdateCacheMut.Lock()
+		seriesAggregateUpdateCache[key] = cache
+		seriesAggregateUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q seriesAggregateQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for series_aggregates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for series_aggregates")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SeriesAggregateSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), seriesAggregatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"series_aggregates\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, seriesAggregatePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in seriesAggregate slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all seriesAggregate")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SeriesAggregate) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no series_aggregates provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(seriesAggregateColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	seriesAggregateUpsertCacheMut.RLock()
+	cache, cached := seriesAggregateUpsertCache[key]
+	seriesAggregateUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			seriesAggregateAllColumns,
+			seriesAggregateColumnsWithDefault,
+			seriesAggregateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			seriesAggregateAllColumns,
+			seriesAggregatePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert series_aggregates, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(seriesAggregatePrimaryKeyColumns))
+			copy(conflict, seriesAggregatePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"series_aggregates\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(seriesAggregateType, seriesAggregateMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(seriesAggregateType, seriesAggregateMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert series_aggregates")
+	}
+
+	if !cached {
+		seriesAggregateUpsertCacheMut.Lock()
+		seriesAggregateUpsertCache[key] = cache
+		seriesAggregateUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single SeriesAggregate record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SeriesAggregate) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no SeriesAggregate provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), seriesAggregatePrimaryKeyMapping)
+	sql := "DELETE FROM \"series_aggregates\" WHERE \"series_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from series_aggregates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for series_aggregates")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q seriesAggregateQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no seriesAggregateQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from series_aggregates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for series_aggregates")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SeriesAggregateSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(seriesAggregateBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), seriesAggregatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"series_aggregates\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, seriesAggregatePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from seriesAggregate slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for series_aggregates")
+	}
+
+	if len(seriesAggregateAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SeriesAggregate) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSeriesAggregate(ctx, exec, o.SeriesID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SeriesAggregateSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SeriesAggregateSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), seriesAggregatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"series_aggregates\".* FROM \"series_aggregates\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, seriesAggregatePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in SeriesAggregateSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SeriesAggregateExists checks if the SeriesAggregate row exists.
+func SeriesAggregateExists(ctx context.Context, exec boil.ContextExecutor, seriesID int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"series_aggregates\" where \"series_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, seriesID)
+	}
+	row := exec.QueryRowContext(ctx, sql, seriesID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if series_aggregates exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,837 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testSeriesAggregates(t *testing.T) {
+	t.Parallel()
+
+	query := SeriesAggregates()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testSeriesAggregatesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesAggregate{}
+	if err = randomize.Struct(seed, o, seriesAggregateDBTypes, true, seriesAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SeriesAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSeriesAggregatesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesAggregate{}
+	if err = randomize.Struct(seed, o, seriesAggregateDBTypes, true, seriesAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := SeriesAggregates().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SeriesAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSeriesAggregatesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesAggregate{}
+	if err = randomize.Struct(seed, o, seriesAggregateDBTypes, true, seriesAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SeriesAggregateSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SeriesAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSeriesAggregatesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesAggregate{}
+	if err = randomize.Struct(seed, o, seriesAggregateDBTypes, true, seriesAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := SeriesAggregateExists(ctx, tx, o.SeriesID)
+	if err != nil {
+		t.Errorf("Unable to check if SeriesAggregate exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected SeriesAggregateExists to return true, but got false.")
+	}
+}
+
+func testSeriesAggregatesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesAggregate{}
+	if err = randomize.Struct(seed, o, seriesAggregateDBTypes, true, seriesAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	seriesAggregateFound, err := FindSeriesAggregate(ctx, tx, o.SeriesID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if seriesAggregateFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testSeriesAggregatesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesAggregate{}
+	if err = randomize.Struct(seed, o, seriesAggregateDBTypes, true, seriesAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = SeriesAggregates().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSeriesAggregatesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesAggregate{}
+	if err = randomize.Struct(seed, o, seriesAggregateDBTypes, true, seriesAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := SeriesAggregates().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testSeriesAggregatesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	seriesAggregateOne := &SeriesAggregate{}
+	seriesAggregateTwo := &SeriesAggregate{}
+	if err = randomize.Struct(seed, seriesAggregateOne, seriesAggregateDBTypes, false, seriesAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesAggregate struct: %s", err)
+	}
+	if err = randomize.Struct(seed, seriesAggregateTwo, seriesAggregateDBTypes, false, seriesAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = seriesAggregateOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = seriesAggregateTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := SeriesAggregates().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testSeriesAggregatesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	seriesAggregateOne := &SeriesAggregate{}
+	seriesAggregateTwo := &SeriesAggregate{}
+	if err = randomize.Struct(seed, seriesAggregateOne, seriesAggregateDBTypes, false, seriesAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesAggregate struct: %s", err)
+	}
+	if err = randomize.Struct(seed, seriesAggregateTwo, seriesAggregateDBTypes, false, seriesAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = seriesAggregateOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = seriesAggregateTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SeriesAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func seriesAggregateBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *SeriesAggregate) error {
+	*o = SeriesAggregate{}
+	return nil
+}
+
+func seriesAggregateAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *SeriesAggregate) error {
+	*o = SeriesAggregate{}
+	return nil
+}
+
+func seriesAggregateAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *SeriesAggregate) error {
+	*o = SeriesAggregate{}
+	return nil
+}
+
+func seriesAggregateBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *SeriesAggregate) error {
+	*o = SeriesAggregate{}
+	return nil
+}
+
+func seriesAggregateAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *SeriesAggregate) error {
+	*o = SeriesAggregate{}
+	return nil
+}
+
+func seriesAggregateBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *SeriesAggregate) error {
+	*o = SeriesAggregate{}
+	return nil
+}
+
+func seriesAggregateAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *SeriesAggregate) error {
+	*o = SeriesAggregate{}
+	return nil
+}
+
+func seriesAggregateBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *SeriesAggregate) error {
+	*o = SeriesAggregate{}
+	return nil
+}
+
+func seriesAggregateAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *SeriesAggregate) error {
+	*o = SeriesAggregate{}
+	return nil
+}
+
+func testSeriesAggregatesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &SeriesAggregate{}
+	o := &SeriesAggregate{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, seriesAggregateDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize SeriesAggregate object: %s", err)
+	}
+
+	AddSeriesAggregateHook(boil.BeforeInsertHook, seriesAggregateBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	seriesAggregateBeforeInsertHooks = []SeriesAggregateHook{}
+
+	AddSeriesAggregateHook(boil.AfterInsertHook, seriesAggregateAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	seriesAggregateAfterInsertHooks = []SeriesAggregateHook{}
+
+	AddSeriesAggregateHook(boil.AfterSelectHook, seriesAggregateAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	seriesAggregateAfterSelectHooks = []SeriesAggregateHook{}
+
+	AddSeriesAggregateHook(boil.BeforeUpdateHook, seriesAggregateBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	seriesAggregateBeforeUpdateHooks = []SeriesAggregateHook{}
+
+	AddSeriesAggregateHook(boil.AfterUpdateHook, seriesAggregateAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	seriesAggregateAfterUpdateHooks = []SeriesAggregateHook{}
+
+	AddSeriesAggregateHook(boil.BeforeDeleteHook, seriesAggregateBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	seriesAggregateBeforeDeleteHooks = []SeriesAggregateHook{}
+
+	AddSeriesAggregateHook(boil.AfterDeleteHook, seriesAggregateAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	seriesAggregateAfterDeleteHooks = []SeriesAggregateHook{}
+
+	AddSeriesAggregateHook(boil.BeforeUpsertHook, seriesAggregateBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	seriesAggregateBeforeUpsertHooks = []SeriesAggregateHook{}
+
+	AddSeriesAggregateHook(boil.AfterUpsertHook, seriesAggregateAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	seriesAggregateAfterUpsertHooks = []SeriesAggregateHook{}
+}
+
+func testSeriesAggregatesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesAggregate{}
+	if err = randomize.Struct(seed, o, seriesAggregateDBTypes, true, seriesAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SeriesAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSeriesAggregatesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesAggregate{}
+	if err = randomize.Struct(seed, o, seriesAggregateDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize SeriesAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(seriesAggregateColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SeriesAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSeriesAggregateToOneSeriesUsingSeries(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local SeriesAggregate
+	var foreign Series
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, seriesAggregateDBTypes, false, seriesAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesAggregate struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, seriesDBTypes, false, seriesColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Series struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.SeriesID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Series().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := SeriesAggregateSlice{&local}
+	if err = local.L.LoadSeries(ctx, tx, false, (*[]*SeriesAggregate)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Series == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Series = nil
+	if err = local.L.LoadSeries(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Series == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testSeriesAggregateToOneSetOpSeriesUsingSeries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a SeriesAggregate
+	var b, c Series
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesAggregateDBTypes, false, strmangle.SetComplement(seriesAggregatePrimaryKeyColumns, seriesAggregateColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Series{&b, &c} {
+		err = a.SetSeries(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Series != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.SeriesSeriesAggregates[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.SeriesID != x.ID {
+			t.Error("foreign key was wrong value", a.SeriesID)
+		}
+
+		if exists, err := SeriesAggregateExists(ctx, tx, a.SeriesID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testSeriesAggregatesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesAggregate{}
+	if err = randomize.Struct(seed, o, seriesAggregateDBTypes, true, seriesAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSeriesAggregatesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesAggregate{}
+	if err = randomize.Struct(seed, o, seriesAggregateDBTypes, true, seriesAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SeriesAggregateSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSeriesAggregatesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesAggregate{}
+	if err = randomize.Struct(seed, o, seriesAggregateDBTypes, true, seriesAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := SeriesAggregates().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	seriesAggregateDBTypes = map[string]string{`SeriesID`: `integer`, `SeasonsCount`: `integer`, `EpisodesCount`: `integer`, `TotalRuntime`: `integer`, `FirstEpisodeDate`: `date`, `LatestEpisodeDate`: `date`, `SeasonEpisodes`: `jsonb`}
+	_                      = bytes.MinRead
+)
+
+func testSeriesAggregatesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(seriesAggregatePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(seriesAggregateAllColumns) == len(seriesAggregatePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesAggregate{}
+	if err = randomize.Struct(seed, o, seriesAggregateDBTypes, true, seriesAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SeriesAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, seriesAggregateDBTypes, true, seriesAggregatePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SeriesAggregate struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testSeriesAggregatesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(seriesAggregateAllColumns) == len(seriesAggregatePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesAggregate{}
+	if err = randomize.Struct(seed, o, seriesAggregateDBTypes, true, seriesAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SeriesAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, seriesAggregateDBTypes, true, seriesAggregatePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SeriesAggregate struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(seriesAggregateAllColumns, seriesAggregatePrimaryKeyColumns) {
+		fields = seriesAggregateAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			seriesAggregateAllColumns,
+			seriesAggregatePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := SeriesAggregateSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testSeriesAggregatesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(seriesAggregateAllColumns) == len(seriesAggregatePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := SeriesAggregate{}
+	if err = randomize.Struct(seed, &o, seriesAggregateDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize SeriesAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert SeriesAggregate: %s", err)
+	}
+
+	count, err := SeriesAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, seriesAggregateDBTypes, false, seriesAggregatePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SeriesAggregate struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert SeriesAggregate: %s", err)
+	}
+
+	count, err = SeriesAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
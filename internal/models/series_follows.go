@@ -0,0 +1,1270 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// SeriesFollow is an object representing the database table.
+type SeriesFollow struct {
+	UserID       int       `db:"user_id" boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	SeriesID     int       `db:"series_id" boil:"series_id" json:"series_id" toml:"series_id" yaml:"series_id"`
+	TimeFollowed time.Time `db:"time_followed" boil:"time_followed" json:"time_followed" toml:"time_followed" yaml:"time_followed"`
+
+	R *seriesFollowR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L seriesFollowL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SeriesFollowColumns = struct {
+	UserID       string
+	SeriesID     string
+	TimeFollowed string
+}{
+	UserID:       "user_id",
+	SeriesID:     "series_id",
+	TimeFollowed: "time_followed",
+}
+
+var SeriesFollowTableColumns = struct {
+	UserID       string
+	SeriesID     string
+	TimeFollowed string
+}{
+	UserID:       "series_follows.user_id",
+	SeriesID:     "series_follows.series_id",
+	TimeFollowed: "series_follows.time_followed",
+}
+
+// Generated where
+
+var SeriesFollowWhere = struct {
+	UserID       whereHelperint
+	SeriesID     whereHelperint
+	TimeFollowed whereHelpertime_Time
+}{
+	UserID:       whereHelperint{field: "\"series_follows\".\"user_id\""},
+	SeriesID:     whereHelperint{field: "\"series_follows\".\"series_id\""},
+	TimeFollowed: whereHelpertime_Time{field: "\"series_follows\".\"time_followed\""},
+}
+
+// SeriesFollowRels is where relationship names are stored.
+var SeriesFollowRels = struct {
+	Series string
+	User   string
+}{
+	Series: "Series",
+	User:   "User",
+}
+
+// seriesFollowR is where relationships are stored.
+type seriesFollowR struct {
+	Series *Series `db:"Series" boil:"Series" json:"Series" toml:"Series" yaml:"Series"`
+	User   *User   `db:"User" boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*seriesFollowR) NewStruct() *seriesFollowR {
+	return &seriesFollowR{}
+}
+
+func (r *seriesFollowR) GetSeries() *Series {
+	if r == nil {
+		return nil
+	}
+	return r.Series
+}
+
+func (r *seriesFollowR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// seriesFollowL is where Load methods for each relationship are stored.
+type seriesFollowL struct{}
+
+var (
+	seriesFollowAllColumns            = []string{"user_id", "series_id", "time_followed"}
+	seriesFollowColumnsWithoutDefault = []string{"user_id", "series_id"}
+	seriesFollowColumnsWithDefault    = []string{"time_followed"}
+	seriesFollowPrimaryKeyColumns     = []string{"user_id", "series_id"}
+	seriesFollowGeneratedColumns      = []string{}
+)
+
+type (
+	// SeriesFollowSlice is an alias for a slice of pointers to SeriesFollow.
+	// This should almost always be used instead of []SeriesFollow.
+	SeriesFollowSlice []*SeriesFollow
+	// SeriesFollowHook is the signature for custom SeriesFollow hook methods
+	SeriesFollowHook func(context.Context, boil.ContextExecutor, *SeriesFollow) error
+
+	seriesFollowQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	seriesFollowType                 = reflect.TypeOf(&SeriesFollow{})
+	seriesFollowMapping              = queries.MakeStructMapping(seriesFollowType)
+	seriesFollowPrimaryKeyMapping, _ = queries.BindMapping(seriesFollowType, seriesFollowMapping, seriesFollowPrimaryKeyColumns)
+	seriesFollowInsertCacheMut       sync.RWMutex
+	seriesFollowInsertCache          = make(map[string]insertCache)
+	seriesFollowUpdateCacheMut       sync.RWMutex
+	seriesFollowUpdateCache          = make(map[string]updateCache)
+	seriesFollowUpsertCacheMut       sync.RWMutex
+	seriesFollowUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var seriesFollowAfterSelectHooks []SeriesFollowHook
+
+var seriesFollowBeforeInsertHooks []SeriesFollowHook
+var seriesFollowAfterInsertHooks []SeriesFollowHook
+
+var seriesFollowBeforeUpdateHooks []SeriesFollowHook
+var seriesFollowAfterUpdateHooks []SeriesFollowHook
+
+var seriesFollowBeforeDeleteHooks []SeriesFollowHook
+var seriesFollowAfterDeleteHooks []SeriesFollowHook
+
+var seriesFollowBeforeUpsertHooks []SeriesFollowHook
+var seriesFollowAfterUpsertHooks []SeriesFollowHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *SeriesFollow) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesFollowAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *SeriesFollow) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesFollowBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *SeriesFollow) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesFollowAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *SeriesFollow) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesFollowBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *SeriesFollow) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesFollowAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *SeriesFollow) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesFollowBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *SeriesFollow) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesFollowAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *SeriesFollow) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesFollowBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *SeriesFollow) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesFollowAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSeriesFollowHook registers your hook function for all future operations.
+func AddSeriesFollowHook(hookPoint boil.HookPoint, seriesFollowHook SeriesFollowHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		seriesFollowAfterSelectHooks = append(seriesFollowAfterSelectHooks, seriesFollowHook)
+	case boil.BeforeInsertHook:
+		seriesFollowBeforeInsertHooks = append(seriesFollowBeforeInsertHooks, seriesFollowHook)
+	case boil.AfterInsertHook:
+		seriesFollowAfterInsertHooks = append(seriesFollowAfterInsertHooks, seriesFollowHook)
+	case boil.BeforeUpdateHook:
+		seriesFollowBeforeUpdateHooks = append(seriesFollowBeforeUpdateHooks, seriesFollowHook)
+	case boil.AfterUpdateHook:
+		seriesFollowAfterUpdateHooks = append(seriesFollowAfterUpdateHooks, seriesFollowHook)
+	case boil.BeforeDeleteHook:
+		seriesFollowBeforeDeleteHooks = append(seriesFollowBeforeDeleteHooks, seriesFollowHook)
+	case boil.AfterDeleteHook:
+		seriesFollowAfterDeleteHooks = append(seriesFollowAfterDeleteHooks, seriesFollowHook)
+	case boil.BeforeUpsertHook:
+		seriesFollowBeforeUpsertHooks = append(seriesFollowBeforeUpsertHooks, seriesFollowHook)
+	case boil.AfterUpsertHook:
+		seriesFollowAfterUpsertHooks = append(seriesFollowAfterUpsertHooks, seriesFollowHook)
+	}
+}
+
+// One returns a single seriesFollow record from the query.
+func (q seriesFollowQuery) One(ctx context.Context, exec boil.ContextExecutor) (*SeriesFollow, error) {
+	o := &SeriesFollow{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for series_follows")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all SeriesFollow records from the query.
+func (q seriesFollowQuery) All(ctx context.Context, exec boil.ContextExecutor) (SeriesFollowSlice, error) {
+	var o []*SeriesFollow
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to SeriesFollow slice")
+	}
+
+	if len(seriesFollowAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all SeriesFollow records in the query.
+func (q seriesFollowQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count series_follows rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q seriesFollowQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if series_follows exists")
+	}
+
+	return count > 0, nil
+}
+
+// Series pointed to by the foreign key.
+func (o *SeriesFollow) Series(mods ...qm.QueryMod) seriesQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SeriesID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Serieses(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *SeriesFollow) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadSeries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (seriesFollowL) LoadSeries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSeriesFollow interface{}, mods queries.Applicator) error {
+	var slice []*SeriesFollow
+	var object *SeriesFollow
+
+	if singular {
+		var ok bool
+		object, ok = maybeSeriesFollow.(*SeriesFollow)
+		if !ok {
+			object = new(SeriesFollow)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSeriesFollow)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSeriesFollow))
+			}
+		}
+	} else {
+		s, ok := maybeSeriesFollow.(*[]*SeriesFollow)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSeriesFollow)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSeriesFollow))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &seriesFollowR{}
+		}
+		args = append(args, object.SeriesID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &seriesFollowR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SeriesID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SeriesID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`serieses`),
+		qm.WhereIn(`serieses.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Series")
+	}
+
+	var resultSlice []*Series
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Series")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for serieses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for serieses")
+	}
+
+	if len(seriesFollowAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Series = foreign
+		if foreign.R == nil {
+			foreign.R = &seriesR{}
+		}
+		foreign.R.SeriesSeriesFollows = append(foreign.R.SeriesSeriesFollows, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SeriesID == foreign.ID {
+				local.R.Series = foreign
+				if foreign.R == nil {
+					foreign.R = &seriesR{}
+				}
+				foreign.R.SeriesSeriesFollows = append(foreign.R.SeriesSeriesFollows, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (seriesFollowL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSeriesFollow interface{}, mods queries.Applicator) error {
+	var slice []*SeriesFollow
+	var object *SeriesFollow
+
+	if singular {
+		var ok bool
+		object, ok = maybeSeriesFollow.(*SeriesFollow)
+		if !ok {
+			object = new(SeriesFollow)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSeriesFollow)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSeriesFollow))
+			}
+		}
+	} else {
+		s, ok := maybeSeriesFollow.(*[]*SeriesFollow)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSeriesFollow)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSeriesFollow))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &seriesFollowR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &seriesFollowR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(seriesFollowAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.SeriesFollows = append(foreign.R.SeriesFollows, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.SeriesFollows = append(foreign.R.SeriesFollows, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSeries of the seriesFollow to the related item.
+// Sets o.R.Series to related.
+// Adds o to related.R.SeriesSeriesFollows.
+func (o *SeriesFollow) SetSeries(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Series) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"series_follows\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+		strmangle.WhereClause("\"", "\"", 2, seriesFollowPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID, o.SeriesID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SeriesID = related.ID
+	if o.R == nil {
+		o.R = &seriesFollowR{
+			Series: related,
+		}
+	} else {
+		o.R.Series = related
+	}
+
+	if related.R == nil {
+		related.R = &seriesR{
+			SeriesSeriesFollows: SeriesFollowSlice{o},
+		}
+	} else {
+		related.R.SeriesSeriesFollows = append(related.R.SeriesSeriesFollows, o)
+	}
+
+	return nil
+}
+
+// SetUser of the seriesFollow to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.SeriesFollows.
+func (o *SeriesFollow) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"series_follows\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, seriesFollowPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID, o.SeriesID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &seriesFollowR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			SeriesFollows: SeriesFollowSlice{o},
+		}
+	} else {
+		related.R.SeriesFollows = append(related.R.SeriesFollows, o)
+	}
+
+	return nil
+}
+
+// SeriesFollows retrieves all the records using an executor.
+func SeriesFollows(mods ...qm.QueryMod) seriesFollowQuery {
+	mods = append(mods, qm.From("\"series_follows\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"series_follows\".*"})
+	}
+
+	return seriesFollowQuery{q}
+}
+
+// FindSeriesFollow retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSeriesFollow(ctx context.Context, exec boil.ContextExecutor, userID int, seriesID int, selectCols ...string) (*SeriesFollow, error) {
+	seriesFollowObj := &SeriesFollow{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"series_follows\" where \"user_id\"=$1 AND \"series_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, userID, seriesID)
+
+	err := q.Bind(ctx, exec, seriesFollowObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from series_follows")
+	}
+
+	if err = seriesFollowObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return seriesFollowObj, err
+	}
+
+	return seriesFollowObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SeriesFollow) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no series_follows provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(seriesFollowColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	seriesFollowInsertCacheMut.RLock()
+	cache, cached := seriesFollowInsertCache[key]
+	seriesFollowInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			seriesFollowAllColumns,
+			seriesFollowColumnsWithDefault,
+			seriesFollowColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(seriesFollowType, seriesFollowMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(seriesFollowType, seriesFollowMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"series_follows\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"series_follows\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into series_follows")
+	}
+
+	if !cached {
+		seriesFollowInsertCacheMut.Lock()
+		seriesFollowInsertCache[key] = cache
+		seriesFollowInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the SeriesFollow.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SeriesFollow) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	seriesFollowUpdateCacheMut.RLock()
+	cache, cached := seriesFollowUpdateCache[key]
+	seriesFollowUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			seriesFollowAllColumns,
+			seriesFollowPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update series_follows, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"series_follows\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, seriesFollowPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(seriesFollowType, seriesFollowMapping, append(wl, seriesFollowPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update series_follows row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for series_follows")
+	}
+
+	if !cached {
+		seriesFollowUpdateCacheMut.Lock()
+		seriesFollowUpdateCache[key] = cache
+		seriesFollowUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q seriesFollowQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for series_follows")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for series_follows")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SeriesFollowSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), seriesFollowPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"series_follows\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, seriesFollowPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in seriesFollow slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all seriesFollow")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SeriesFollow) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no series_follows provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(seriesFollowColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	seriesFollowUpsertCacheMut.RLock()
+	cache, cached := seriesFollowUpsertCache[key]
+	seriesFollowUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			seriesFollowAllColumns,
+			seriesFollowColumnsWithDefault,
+			seriesFollowColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			seriesFollowAllColumns,
+			seriesFollowPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert series_follows, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(seriesFollowPrimaryKeyColumns))
+			copy(conflict, seriesFollowPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"series_follows\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(seriesFollowType, seriesFollowMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(seriesFollowType, seriesFollowMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert series_follows")
+	}
+
+	if !cached {
+		seriesFollowUpsertCacheMut.Lock()
+		seriesFollowUpsertCache[key] = cache
+		seriesFollowUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single SeriesFollow record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SeriesFollow) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no SeriesFollow provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), seriesFollowPrimaryKeyMapping)
+	sql := "DELETE FROM \"series_follows\" WHERE \"user_id\"=$1 AND \"series_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from series_follows")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for series_follows")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q seriesFollowQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no seriesFollowQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from series_follows")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for series_follows")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SeriesFollowSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(seriesFollowBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), seriesFollowPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"series_follows\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, seriesFollowPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from seriesFollow slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for series_follows")
+	}
+
+	if len(seriesFollowAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SeriesFollow) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSeriesFollow(ctx, exec, o.UserID, o.SeriesID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SeriesFollowSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SeriesFollowSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), seriesFollowPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"series_follows\".* FROM \"series_follows\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, seriesFollowPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in SeriesFollowSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SeriesFollowExists checks if the SeriesFollow row exists.
+func SeriesFollowExists(ctx context.Context, exec boil.ContextExecutor, userID int, seriesID int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"series_follows\" where \"user_id\"=$1 AND \"series_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, userID, seriesID)
+	}
+	row := exec.QueryRowContext(ctx, sql, userID, seriesID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if series_follows exists")
+	}
+
+	return exists, nil
+}
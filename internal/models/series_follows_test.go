@@ -0,0 +1,941 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testSeriesFollows(t *testing.T) {
+	t.Parallel()
+
+	query := SeriesFollows()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testSeriesFollowsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesFollow{}
+	if err = randomize.Struct(seed, o, seriesFollowDBTypes, true, seriesFollowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesFollow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SeriesFollows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSeriesFollowsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesFollow{}
+	if err = randomize.Struct(seed, o, seriesFollowDBTypes, true, seriesFollowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesFollow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := SeriesFollows().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SeriesFollows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSeriesFollowsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesFollow{}
+	if err = randomize.Struct(seed, o, seriesFollowDBTypes, true, seriesFollowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesFollow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SeriesFollowSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SeriesFollows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSeriesFollowsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesFollow{}
+	if err = randomize.Struct(seed, o, seriesFollowDBTypes, true, seriesFollowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesFollow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := SeriesFollowExists(ctx, tx, o.UserID, o.SeriesID)
+	if err != nil {
+		t.Errorf("Unable to check if SeriesFollow exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected SeriesFollowExists to return true, but got false.")
+	}
+}
+
+func testSeriesFollowsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesFollow{}
+	if err = randomize.Struct(seed, o, seriesFollowDBTypes, true, seriesFollowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesFollow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	seriesFollowFound, err := FindSeriesFollow(ctx, tx, o.UserID, o.SeriesID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if seriesFollowFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testSeriesFollowsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesFollow{}
+	if err = randomize.Struct(seed, o, seriesFollowDBTypes, true, seriesFollowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesFollow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = SeriesFollows().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSeriesFollowsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesFollow{}
+	if err = randomize.Struct(seed, o, seriesFollowDBTypes, true, seriesFollowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesFollow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := SeriesFollows().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testSeriesFollowsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	seriesFollowOne := &SeriesFollow{}
+	seriesFollowTwo := &SeriesFollow{}
+	if err = randomize.Struct(seed, seriesFollowOne, seriesFollowDBTypes, false, seriesFollowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesFollow struct: %s", err)
+	}
+	if err = randomize.Struct(seed, seriesFollowTwo, seriesFollowDBTypes, false, seriesFollowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesFollow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = seriesFollowOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = seriesFollowTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := SeriesFollows().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testSeriesFollowsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	seriesFollowOne := &SeriesFollow{}
+	seriesFollowTwo := &SeriesFollow{}
+	if err = randomize.Struct(seed, seriesFollowOne, seriesFollowDBTypes, false, seriesFollowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesFollow struct: %s", err)
+	}
+	if err = randomize.Struct(seed, seriesFollowTwo, seriesFollowDBTypes, false, seriesFollowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesFollow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = seriesFollowOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = seriesFollowTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SeriesFollows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func seriesFollowBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *SeriesFollow) error {
+	*o = SeriesFollow{}
+	return nil
+}
+
+func seriesFollowAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *SeriesFollow) error {
+	*o = SeriesFollow{}
+	return nil
+}
+
+func seriesFollowAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *SeriesFollow) error {
+	*o = SeriesFollow{}
+	return nil
+}
+
+func seriesFollowBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *SeriesFollow) error {
+	*o = SeriesFollow{}
+	return nil
+}
+
+func seriesFollowAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *SeriesFollow) error {
+	*o = SeriesFollow{}
+	return nil
+}
+
+func seriesFollowBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *SeriesFollow) error {
+	*o = SeriesFollow{}
+	return nil
+}
+
+func seriesFollowAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *SeriesFollow) error {
+	*o = SeriesFollow{}
+	return nil
+}
+
+func seriesFollowBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *SeriesFollow) error {
+	*o = SeriesFollow{}
+	return nil
+}
+
+func seriesFollowAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *SeriesFollow) error {
+	*o = SeriesFollow{}
+	return nil
+}
+
+func testSeriesFollowsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &SeriesFollow{}
+	o := &SeriesFollow{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, seriesFollowDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize SeriesFollow object: %s", err)
+	}
+
+	AddSeriesFollowHook(boil.BeforeInsertHook, seriesFollowBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	seriesFollowBeforeInsertHooks = []SeriesFollowHook{}
+
+	AddSeriesFollowHook(boil.AfterInsertHook, seriesFollowAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	seriesFollowAfterInsertHooks = []SeriesFollowHook{}
+
+	AddSeriesFollowHook(boil.AfterSelectHook, seriesFollowAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	seriesFollowAfterSelectHooks = []SeriesFollowHook{}
+
+	AddSeriesFollowHook(boil.BeforeUpdateHook, seriesFollowBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	seriesFollowBeforeUpdateHooks = []SeriesFollowHook{}
+
+	AddSeriesFollowHook(boil.AfterUpdateHook, seriesFollowAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	seriesFollowAfterUpdateHooks = []SeriesFollowHook{}
+
+	AddSeriesFollowHook(boil.BeforeDeleteHook, seriesFollowBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	seriesFollowBeforeDeleteHooks = []SeriesFollowHook{}
+
+	AddSeriesFollowHook(boil.AfterDeleteHook, seriesFollowAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	seriesFollowAfterDeleteHooks = []SeriesFollowHook{}
+
+	AddSeriesFollowHook(boil.BeforeUpsertHook, seriesFollowBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	seriesFollowBeforeUpsertHooks = []SeriesFollowHook{}
+
+	AddSeriesFollowHook(boil.AfterUpsertHook, seriesFollowAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	seriesFollowAfterUpsertHooks = []SeriesFollowHook{}
+}
+
+func testSeriesFollowsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesFollow{}
+	if err = randomize.Struct(seed, o, seriesFollowDBTypes, true, seriesFollowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesFollow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SeriesFollows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSeriesFollowsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesFollow{}
+	if err = randomize.Struct(seed, o, seriesFollowDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize SeriesFollow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(seriesFollowColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SeriesFollows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSeriesFollowToOneSeriesUsingSeries(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local SeriesFollow
+	var foreign Series
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, seriesFollowDBTypes, false, seriesFollowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesFollow struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, seriesDBTypes, false, seriesColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Series struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.SeriesID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Series().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := SeriesFollowSlice{&local}
+	if err = local.L.LoadSeries(ctx, tx, false, (*[]*SeriesFollow)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Series == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Series = nil
+	if err = local.L.LoadSeries(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Series == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testSeriesFollowToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local SeriesFollow
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, seriesFollowDBTypes, false, seriesFollowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesFollow struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := SeriesFollowSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*SeriesFollow)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testSeriesFollowToOneSetOpSeriesUsingSeries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a SeriesFollow
+	var b, c Series
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesFollowDBTypes, false, strmangle.SetComplement(seriesFollowPrimaryKeyColumns, seriesFollowColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Series{&b, &c} {
+		err = a.SetSeries(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Series != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.SeriesSeriesFollows[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.SeriesID != x.ID {
+			t.Error("foreign key was wrong value", a.SeriesID)
+		}
+
+		if exists, err := SeriesFollowExists(ctx, tx, a.UserID, a.SeriesID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+func testSeriesFollowToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a SeriesFollow
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesFollowDBTypes, false, strmangle.SetComplement(seriesFollowPrimaryKeyColumns, seriesFollowColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.SeriesFollows[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		if exists, err := SeriesFollowExists(ctx, tx, a.UserID, a.SeriesID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testSeriesFollowsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesFollow{}
+	if err = randomize.Struct(seed, o, seriesFollowDBTypes, true, seriesFollowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesFollow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSeriesFollowsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesFollow{}
+	if err = randomize.Struct(seed, o, seriesFollowDBTypes, true, seriesFollowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesFollow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SeriesFollowSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSeriesFollowsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesFollow{}
+	if err = randomize.Struct(seed, o, seriesFollowDBTypes, true, seriesFollowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesFollow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := SeriesFollows().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	seriesFollowDBTypes = map[string]string{`UserID`: `integer`, `SeriesID`: `integer`, `TimeFollowed`: `timestamp with time zone`}
+	_                   = bytes.MinRead
+)
+
+func testSeriesFollowsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(seriesFollowPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(seriesFollowAllColumns) == len(seriesFollowPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesFollow{}
+	if err = randomize.Struct(seed, o, seriesFollowDBTypes, true, seriesFollowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesFollow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SeriesFollows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, seriesFollowDBTypes, true, seriesFollowPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SeriesFollow struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testSeriesFollowsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(seriesFollowAllColumns) == len(seriesFollowPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesFollow{}
+	if err = randomize.Struct(seed, o, seriesFollowDBTypes, true, seriesFollowColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesFollow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SeriesFollows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, seriesFollowDBTypes, true, seriesFollowPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SeriesFollow struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(seriesFollowAllColumns, seriesFollowPrimaryKeyColumns) {
+		fields = seriesFollowAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			seriesFollowAllColumns,
+			seriesFollowPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := SeriesFollowSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testSeriesFollowsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(seriesFollowAllColumns) == len(seriesFollowPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := SeriesFollow{}
+	if err = randomize.Struct(seed, &o, seriesFollowDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize SeriesFollow struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert SeriesFollow: %s", err)
+	}
+
+	count, err := SeriesFollows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, seriesFollowDBTypes, false, seriesFollowPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SeriesFollow struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert SeriesFollow: %s", err)
+	}
+
+	count, err = SeriesFollows().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
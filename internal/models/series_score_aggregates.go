@@ -0,0 +1,1098 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// SeriesScoreAggregate is an object representing the database table.
+type SeriesScoreAggregate struct {
+	SeriesID       int          `db:"series_id" boil:"series_id" json:"series_id" toml:"series_id" yaml:"series_id"`
+	ScoresCount    int          `db:"scores_count" boil:"scores_count" json:"scores_count" toml:"scores_count" yaml:"scores_count"`
+	ScoresSum      int          `db:"scores_sum" boil:"scores_sum" json:"scores_sum" toml:"scores_sum" yaml:"scores_sum"`
+	ScoreMean      null.Float64 `db:"score_mean" boil:"score_mean" json:"score_mean,omitempty" toml:"score_mean" yaml:"score_mean,omitempty"`
+	ScoreHistogram types.JSON   `db:"score_histogram" boil:"score_histogram" json:"score_histogram" toml:"score_histogram" yaml:"score_histogram"`
+
+	R *seriesScoreAggregateR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L seriesScoreAggregateL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SeriesScoreAggregateColumns = struct {
+	SeriesID       string
+	ScoresCount    string
+	ScoresSum      string
+	ScoreMean      string
+	ScoreHistogram string
+}{
+	SeriesID:       "series_id",
+	ScoresCount:    "scores_count",
+	ScoresSum:      "scores_sum",
+	ScoreMean:      "score_mean",
+	ScoreHistogram: "score_histogram",
+}
+
+var SeriesScoreAggregateTableColumns = struct {
+	SeriesID       string
+	ScoresCount    string
+	ScoresSum      string
+	ScoreMean      string
+	ScoreHistogram string
+}{
+	SeriesID:       "series_score_aggregates.series_id",
+	ScoresCount:    "series_score_aggregates.scores_count",
+	ScoresSum:      "series_score_aggregates.scores_sum",
+	ScoreMean:      "series_score_aggregates.score_mean",
+	ScoreHistogram: "series_score_aggregates.score_histogram",
+}
+
+// Generated where
+
+var SeriesScoreAggregateWhere = struct {
+	SeriesID       whereHelperint
+	ScoresCount    whereHelperint
+	ScoresSum      whereHelperint
+	ScoreMean      whereHelpernull_Float64
+	ScoreHistogram whereHelpertypes_JSON
+}{
+	SeriesID:       whereHelperint{field: "\"series_score_aggregates\".\"series_id\""},
+	ScoresCount:    whereHelperint{field: "\"series_score_aggregates\".\"scores_count\""},
+	ScoresSum:      whereHelperint{field: "\"series_score_aggregates\".\"scores_sum\""},
+	ScoreMean:      whereHelpernull_Float64{field: "\"series_score_aggregates\".\"score_mean\""},
+	ScoreHistogram: whereHelpertypes_JSON{field: "\"series_score_aggregates\".\"score_histogram\""},
+}
+
+// SeriesScoreAggregateRels is where relationship names are stored.
+var SeriesScoreAggregateRels = struct {
+	Series string
+}{
+	Series: "Series",
+}
+
+// seriesScoreAggregateR is where relationships are stored.
+type seriesScoreAggregateR struct {
+	Series *Series `db:"Series" boil:"Series" json:"Series" toml:"Series" yaml:"Series"`
+}
+
+// NewStruct creates a new relationship struct
+func (*seriesScoreAggregateR) NewStruct() *seriesScoreAggregateR {
+	return &seriesScoreAggregateR{}
+}
+
+func (r *seriesScoreAggregateR) GetSeries() *Series {
+	if r == nil {
+		return nil
+	}
+	return r.Series
+}
+
+// seriesScoreAggregateL is where Load methods for each relationship are stored.
+type seriesScoreAggregateL struct{}
+
+var (
+	seriesScoreAggregateAllColumns            = []string{"series_id", "scores_count", "scores_sum", "score_mean", "score_histogram"}
+	seriesScoreAggregateColumnsWithoutDefault = []string{"series_id"}
+	seriesScoreAggregateColumnsWithDefault    = []string{"scores_count", "scores_sum", "score_mean", "score_histogram"}
+	seriesScoreAggregatePrimaryKeyColumns     = []string{"series_id"}
+	seriesScoreAggregateGeneratedColumns      = []string{}
+)
+
+type (
+	// SeriesScoreAggregateSlice is an alias for a slice of pointers to SeriesScoreAggregate.
+	// This should almost always be used instead of []SeriesScoreAggregate.
+	SeriesScoreAggregateSlice []*SeriesScoreAggregate
+	// SeriesScoreAggregateHook is the signature for custom SeriesScoreAggregate hook methods
+	SeriesScoreAggregateHook func(context.Context, boil.ContextExecutor, *SeriesScoreAggregate) error
+
+	seriesScoreAggregateQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	seriesScoreAggregateType                 = reflect.TypeOf(&SeriesScoreAggregate{})
+	seriesScoreAggregateMapping              = queries.MakeStructMapping(seriesScoreAggregateType)
+	seriesScoreAggregatePrimaryKeyMapping, _ = queries.BindMapping(seriesScoreAggregateType, seriesScoreAggregateMapping, seriesScoreAggregatePrimaryKeyColumns)
+	seriesScoreAggregateInsertCacheMut       sync.RWMutex
+	seriesScoreAggregateInsertCache          = make(map[string]insertCache)
+	seriesScoreAggregateUpdateCacheMut       sync.RWMutex
+	seriesScoreAggregateUpdateCache          = make(map[string]updateCache)
+	seriesScoreAggregateUpsertCacheMut       sync.RWMutex
+	seriesScoreAggregateUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var seriesScoreAggregateAfterSelectHooks []SeriesScoreAggregateHook
+
+var seriesScoreAggregateBeforeInsertHooks []SeriesScoreAggregateHook
+var seriesScoreAggregateAfterInsertHooks []SeriesScoreAggregateHook
+
+var seriesScoreAggregateBeforeUpdateHooks []SeriesScoreAggregateHook
+var seriesScoreAggregateAfterUpdateHooks []SeriesScoreAggregateHook
+
+var seriesScoreAggregateBeforeDeleteHooks []SeriesScoreAggregateHook
+var seriesScoreAggregateAfterDeleteHooks []SeriesScoreAggregateHook
+
+var seriesScoreAggregateBeforeUpsertHooks []SeriesScoreAggregateHook
+var seriesScoreAggregateAfterUpsertHooks []SeriesScoreAggregateHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *SeriesScoreAggregate) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesScoreAggregateAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *SeriesScoreAggregate) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesScoreAggregateBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *SeriesScoreAggregate) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesScoreAggregateAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *SeriesScoreAggregate) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesScoreAggregateBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *SeriesScoreAggregate) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesScoreAggregateAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *SeriesScoreAggregate) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesScoreAggregateBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *SeriesScoreAggregate) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesScoreAggregateAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *SeriesScoreAggregate) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesScoreAggregateBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *SeriesScoreAggregate) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range seriesScoreAggregateAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSeriesScoreAggregateHook registers your hook function for all future operations.
+func AddSeriesScoreAggregateHook(hookPoint boil.HookPoint, seriesScoreAggregateHook SeriesScoreAggregateHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		seriesScoreAggregateAfterSelectHooks = append(seriesScoreAggregateAfterSelectHooks, seriesScoreAggregateHook)
+	case boil.BeforeInsertHook:
+		seriesScoreAggregateBeforeInsertHooks = append(seriesScoreAggregateBeforeInsertHooks, seriesScoreAggregateHook)
+	case boil.AfterInsertHook:
+		seriesScoreAggregateAfterInsertHooks = append(seriesScoreAggregateAfterInsertHooks, seriesScoreAggregateHook)
+	case boil.BeforeUpdateHook:
+		seriesScoreAggregateBeforeUpdateHooks = append(seriesScoreAggregateBeforeUpdateHooks, seriesScoreAggregateHook)
+	case boil.AfterUpdateHook:
+		seriesScoreAggregateAfterUpdateHooks = append(seriesScoreAggregateAfterUpdateHooks, seriesScoreAggregateHook)
+	case boil.BeforeDeleteHook:
+		seriesScoreAggregateBeforeDeleteHooks = append(seriesScoreAggregateBeforeDeleteHooks, seriesScoreAggregateHook)
+	case boil.AfterDeleteHook:
+		seriesScoreAggregateAfterDeleteHooks = append(seriesScoreAggregateAfterDeleteHooks, seriesScoreAggregateHook)
+	case boil.BeforeUpsertHook:
+		seriesScoreAggregateBeforeUpsertHooks = append(seriesScoreAggregateBeforeUpsertHooks, seriesScoreAggregateHook)
+	case boil.AfterUpsertHook:
+		seriesScoreAggregateAfterUpsertHooks = append(seriesScoreAggregateAfterUpsertHooks, seriesScoreAggregateHook)
+	}
+}
+
+// One returns a single seriesScoreAggregate record from the query.
+func (q seriesScoreAggregateQuery) One(ctx context.Context, exec boil.ContextExecutor) (*SeriesScoreAggregate, error) {
+	o := &SeriesScoreAggregate{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for series_score_aggregates")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all SeriesScoreAggregate records from the query.
+func (q seriesScoreAggregateQuery) All(ctx context.Context, exec boil.ContextExecutor) (SeriesScoreAggregateSlice, error) {
+	var o []*SeriesScoreAggregate
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to SeriesScoreAggregate slice")
+	}
+
+	if len(seriesScoreAggregateAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all SeriesScoreAggregate records in the query.
+func (q seriesScoreAggregateQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count series_score_aggregates rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q seriesScoreAggregateQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if series_score_aggregates exists")
+	}
+
+	return count > 0, nil
+}
+
+// Series pointed to by the foreign key.
+func (o *SeriesScoreAggregate) Series(mods ...qm.QueryMod) seriesQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SeriesID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Serieses(queryMods...)
+}
+
+// LoadSeries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (seriesScoreAggregateL) LoadSeries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSeriesScoreAggregate interface{}, mods queries.Applicator) error {
+	var slice []*SeriesScoreAggregate
+	var object *SeriesScoreAggregate
+
+	if singular {
+		var ok bool
+		object, ok = maybeSeriesScoreAggregate.(*SeriesScoreAggregate)
+		if !ok {
+			object = new(SeriesScoreAggregate)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSeriesScoreAggregate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSeriesScoreAggregate))
+			}
+		}
+	} else {
+		s, ok := maybeSeriesScoreAggregate.(*[]*SeriesScoreAggregate)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSeriesScoreAggregate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSeriesScoreAggregate))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &seriesScoreAggregateR{}
+		}
+		args = append(args, object.SeriesID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &seriesScoreAggregateR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SeriesID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SeriesID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`serieses`),
+		qm.WhereIn(`serieses.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Series")
+	}
+
+	var resultSlice []*Series
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Series")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for serieses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for serieses")
+	}
+
+	if len(seriesScoreAggregateAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Series = foreign
+		if foreign.R == nil {
+			foreign.R = &seriesR{}
+		}
+		foreign.R.SeriesSeriesScoreAggregates = append(foreign.R.SeriesSeriesScoreAggregates, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SeriesID == foreign.ID {
+				local.R.Series = foreign
+				if foreign.R == nil {
+					foreign.R = &seriesR{}
+				}
+				foreign.R.SeriesSeriesScoreAggregates = append(foreign.R.SeriesSeriesScoreAggregates, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSeries of the seriesScoreAggregate to the related item.
+// Sets o.R.Series to related.
+// Adds o to related.R.SeriesSeriesScoreAggregates.
+func (o *SeriesScoreAggregate) SetSeries(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Series) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"series_score_aggregates\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+		strmangle.WhereClause("\"", "\"", 2, seriesScoreAggregatePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.SeriesID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SeriesID = related.ID
+	if o.R == nil {
+		o.R = &seriesScoreAggregateR{
+			Series: related,
+		}
+	} else {
+		o.R.Series = related
+	}
+
+	if related.R == nil {
+		related.R = &seriesR{
+			SeriesSeriesScoreAggregates: SeriesScoreAggregateSlice{o},
+		}
+	} else {
+		related.R.SeriesSeriesScoreAggregates = append(related.R.SeriesSeriesScoreAggregates, o)
+	}
+
+	return nil
+}
+
+// SeriesScoreAggregates retrieves all the records using an executor.
+func SeriesScoreAggregates(mods ...qm.QueryMod) seriesScoreAggregateQuery {
+	mods = append(mods, qm.From("\"series_score_aggregates\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"series_score_aggregates\".*"})
+	}
+
+	return seriesScoreAggregateQuery{q}
+}
+
+// FindSeriesScoreAggregate retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSeriesScoreAggregate(ctx context.Context, exec boil.ContextExecutor, seriesID int, selectCols ...string) (*SeriesScoreAggregate, error) {
+	seriesScoreAggregateObj := &SeriesScoreAggregate{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"series_score_aggregates\" where \"series_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, seriesID)
+
+	err := q.Bind(ctx, exec, seriesScoreAggregateObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from series_score_aggregates")
+	}
+
+	if err = seriesScoreAggregateObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return seriesScoreAggregateObj, err
+	}
+
+	return seriesScoreAggregateObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SeriesScoreAggregate) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no series_score_aggregates provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(seriesScoreAggregateColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	seriesScoreAggregateInsertCacheMut.RLock()
+	cache, cached := seriesScoreAggregateInsertCache[key]
+	seriesScoreAggregateInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			seriesScoreAggregateAllColumns,
+			seriesScoreAggregateColumnsWithDefault,
+			seriesScoreAggregateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(seriesScoreAggregateType, seriesScoreAggregateMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(seriesScoreAggregateType, seriesScoreAggregateMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"series_score_aggregates\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"series_score_aggregates\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into series_score_aggregates")
+	}
+
+	if !cached {
+		seriesScoreAggregateInsertCacheMut.Lock()
+		seriesScoreAggregateInsertCache[key] = cache
+		seriesScoreAggregateInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the SeriesScoreAggregate.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SeriesScoreAggregate) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	seriesScoreAggregateUpdateCacheMut.RLock()
+	cache, cached := seriesScoreAggregateUpdateCache[key]
+	seriesScoreAggregateUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			seriesScoreAggregateAllColumns,
+			seriesScoreAggregatePrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update series_score_aggregates, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"series_score_aggregates\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, seriesScoreAggregatePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(seriesScoreAggregateType, seriesScoreAggregateMapping, append(wl, seriesScoreAggregatePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update series_score_aggregates row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for series_score_aggregates")
+	}
+
+	if !cached {
+		seriesScoreAggregateUpdateCacheMut.Lock()
+		seriesScoreAggregateUpdateCache[key] = cache
+		seriesScoreAggregateUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q seriesScoreAggregateQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for series_score_aggregates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for series_score_aggregates")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SeriesScoreAggregateSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), seriesScoreAggregatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"series_score_aggregates\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, seriesScoreAggregatePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in seriesScoreAggregate slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all seriesScoreAggregate")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SeriesScoreAggregate) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no series_score_aggregates provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(seriesScoreAggregateColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	seriesScoreAggregateUpsertCacheMut.RLock()
+	cache, cached := seriesScoreAggregateUpsertCache[key]
+	seriesScoreAggregateUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			seriesScoreAggregateAllColumns,
+			seriesScoreAggregateColumnsWithDefault,
+			seriesScoreAggregateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			seriesScoreAggregateAllColumns,
+			seriesScoreAggregatePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert series_score_aggregates, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(seriesScoreAggregatePrimaryKeyColumns))
+			copy(conflict, seriesScoreAggregatePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"series_score_aggregates\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(seriesScoreAggregateType, seriesScoreAggregateMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(seriesScoreAggregateType, seriesScoreAggregateMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert series_score_aggregates")
+	}
+
+	if !cached {
+		seriesScoreAggregateUpsertCacheMut.Lock()
+		seriesScoreAggregateUpsertCache[key] = cache
+		seriesScoreAggregateUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single SeriesScoreAggregate record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SeriesScoreAggregate) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no SeriesScoreAggregate provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), seriesScoreAggregatePrimaryKeyMapping)
+	sql := "DELETE FROM \"series_score_aggregates\" WHERE \"series_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from series_score_aggregates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for series_score_aggregates")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q seriesScoreAggregateQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no seriesScoreAggregateQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from series_score_aggregates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for series_score_aggregates")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SeriesScoreAggregateSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(seriesScoreAggregateBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), seriesScoreAggregatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"series_score_aggregates\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, seriesScoreAggregatePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from seriesScoreAggregate slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for series_score_aggregates")
+	}
+
+	if len(seriesScoreAggregateAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SeriesScoreAggregate) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSeriesScoreAggregate(ctx, exec, o.SeriesID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SeriesScoreAggregateSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SeriesScoreAggregateSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), seriesScoreAggregatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"series_score_aggregates\".* FROM \"series_score_aggregates\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, seriesScoreAggregatePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in SeriesScoreAggregateSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SeriesScoreAggregateExists checks if the SeriesScoreAggregate row exists.
+func SeriesScoreAggregateExists(ctx context.Context, exec boil.ContextExecutor, seriesID int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"series_score_aggregates\" where \"series_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, seriesID)
+	}
+	row := exec.QueryRowContext(ctx, sql, seriesID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if series_score_aggregates exists")
+	}
+
+	return exists, nil
+}
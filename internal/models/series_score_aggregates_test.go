@@ -0,0 +1,837 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testSeriesScoreAggregates(t *testing.T) {
+	t.Parallel()
+
+	query := SeriesScoreAggregates()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testSeriesScoreAggregatesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesScoreAggregate{}
+	if err = randomize.Struct(seed, o, seriesScoreAggregateDBTypes, true, seriesScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SeriesScoreAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSeriesScoreAggregatesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesScoreAggregate{}
+	if err = randomize.Struct(seed, o, seriesScoreAggregateDBTypes, true, seriesScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := SeriesScoreAggregates().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SeriesScoreAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSeriesScoreAggregatesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesScoreAggregate{}
+	if err = randomize.Struct(seed, o, seriesScoreAggregateDBTypes, true, seriesScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SeriesScoreAggregateSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := SeriesScoreAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSeriesScoreAggregatesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesScoreAggregate{}
+	if err = randomize.Struct(seed, o, seriesScoreAggregateDBTypes, true, seriesScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := SeriesScoreAggregateExists(ctx, tx, o.SeriesID)
+	if err != nil {
+		t.Errorf("Unable to check if SeriesScoreAggregate exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected SeriesScoreAggregateExists to return true, but got false.")
+	}
+}
+
+func testSeriesScoreAggregatesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesScoreAggregate{}
+	if err = randomize.Struct(seed, o, seriesScoreAggregateDBTypes, true, seriesScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	seriesScoreAggregateFound, err := FindSeriesScoreAggregate(ctx, tx, o.SeriesID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if seriesScoreAggregateFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testSeriesScoreAggregatesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesScoreAggregate{}
+	if err = randomize.Struct(seed, o, seriesScoreAggregateDBTypes, true, seriesScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = SeriesScoreAggregates().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSeriesScoreAggregatesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesScoreAggregate{}
+	if err = randomize.Struct(seed, o, seriesScoreAggregateDBTypes, true, seriesScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := SeriesScoreAggregates().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testSeriesScoreAggregatesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	seriesScoreAggregateOne := &SeriesScoreAggregate{}
+	seriesScoreAggregateTwo := &SeriesScoreAggregate{}
+	if err = randomize.Struct(seed, seriesScoreAggregateOne, seriesScoreAggregateDBTypes, false, seriesScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesScoreAggregate struct: %s", err)
+	}
+	if err = randomize.Struct(seed, seriesScoreAggregateTwo, seriesScoreAggregateDBTypes, false, seriesScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = seriesScoreAggregateOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = seriesScoreAggregateTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := SeriesScoreAggregates().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testSeriesScoreAggregatesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	seriesScoreAggregateOne := &SeriesScoreAggregate{}
+	seriesScoreAggregateTwo := &SeriesScoreAggregate{}
+	if err = randomize.Struct(seed, seriesScoreAggregateOne, seriesScoreAggregateDBTypes, false, seriesScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesScoreAggregate struct: %s", err)
+	}
+	if err = randomize.Struct(seed, seriesScoreAggregateTwo, seriesScoreAggregateDBTypes, false, seriesScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = seriesScoreAggregateOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = seriesScoreAggregateTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SeriesScoreAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func seriesScoreAggregateBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *SeriesScoreAggregate) error {
+	*o = SeriesScoreAggregate{}
+	return nil
+}
+
+func seriesScoreAggregateAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *SeriesScoreAggregate) error {
+	*o = SeriesScoreAggregate{}
+	return nil
+}
+
+func seriesScoreAggregateAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *SeriesScoreAggregate) error {
+	*o = SeriesScoreAggregate{}
+	return nil
+}
+
+func seriesScoreAggregateBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *SeriesScoreAggregate) error {
+	*o = SeriesScoreAggregate{}
+	return nil
+}
+
+func seriesScoreAggregateAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *SeriesScoreAggregate) error {
+	*o = SeriesScoreAggregate{}
+	return nil
+}
+
+func seriesScoreAggregateBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *SeriesScoreAggregate) error {
+	*o = SeriesScoreAggregate{}
+	return nil
+}
+
+func seriesScoreAggregateAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *SeriesScoreAggregate) error {
+	*o = SeriesScoreAggregate{}
+	return nil
+}
+
+func seriesScoreAggregateBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *SeriesScoreAggregate) error {
+	*o = SeriesScoreAggregate{}
+	return nil
+}
+
+func seriesScoreAggregateAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *SeriesScoreAggregate) error {
+	*o = SeriesScoreAggregate{}
+	return nil
+}
+
+func testSeriesScoreAggregatesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &SeriesScoreAggregate{}
+	o := &SeriesScoreAggregate{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, seriesScoreAggregateDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize SeriesScoreAggregate object: %s", err)
+	}
+
+	AddSeriesScoreAggregateHook(boil.BeforeInsertHook, seriesScoreAggregateBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	seriesScoreAggregateBeforeInsertHooks = []SeriesScoreAggregateHook{}
+
+	AddSeriesScoreAggregateHook(boil.AfterInsertHook, seriesScoreAggregateAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	seriesScoreAggregateAfterInsertHooks = []SeriesScoreAggregateHook{}
+
+	AddSeriesScoreAggregateHook(boil.AfterSelectHook, seriesScoreAggregateAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	seriesScoreAggregateAfterSelectHooks = []SeriesScoreAggregateHook{}
+
+	AddSeriesScoreAggregateHook(boil.BeforeUpdateHook, seriesScoreAggregateBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	seriesScoreAggregateBeforeUpdateHooks = []SeriesScoreAggregateHook{}
+
+	AddSeriesScoreAggregateHook(boil.AfterUpdateHook, seriesScoreAggregateAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	seriesScoreAggregateAfterUpdateHooks = []SeriesScoreAggregateHook{}
+
+	AddSeriesScoreAggregateHook(boil.BeforeDeleteHook, seriesScoreAggregateBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	seriesScoreAggregateBeforeDeleteHooks = []SeriesScoreAggregateHook{}
+
+	AddSeriesScoreAggregateHook(boil.AfterDeleteHook, seriesScoreAggregateAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	seriesScoreAggregateAfterDeleteHooks = []SeriesScoreAggregateHook{}
+
+	AddSeriesScoreAggregateHook(boil.BeforeUpsertHook, seriesScoreAggregateBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	seriesScoreAggregateBeforeUpsertHooks = []SeriesScoreAggregateHook{}
+
+	AddSeriesScoreAggregateHook(boil.AfterUpsertHook, seriesScoreAggregateAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	seriesScoreAggregateAfterUpsertHooks = []SeriesScoreAggregateHook{}
+}
+
+func testSeriesScoreAggregatesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesScoreAggregate{}
+	if err = randomize.Struct(seed, o, seriesScoreAggregateDBTypes, true, seriesScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SeriesScoreAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSeriesScoreAggregatesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesScoreAggregate{}
+	if err = randomize.Struct(seed, o, seriesScoreAggregateDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize SeriesScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(seriesScoreAggregateColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SeriesScoreAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSeriesScoreAggregateToOneSeriesUsingSeries(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local SeriesScoreAggregate
+	var foreign Series
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, seriesScoreAggregateDBTypes, false, seriesScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesScoreAggregate struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, seriesDBTypes, false, seriesColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Series struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.SeriesID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Series().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := SeriesScoreAggregateSlice{&local}
+	if err = local.L.LoadSeries(ctx, tx, false, (*[]*SeriesScoreAggregate)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Series == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Series = nil
+	if err = local.L.LoadSeries(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Series == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testSeriesScoreAggregateToOneSetOpSeriesUsingSeries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a SeriesScoreAggregate
+	var b, c Series
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesScoreAggregateDBTypes, false, strmangle.SetComplement(seriesScoreAggregatePrimaryKeyColumns, seriesScoreAggregateColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Series{&b, &c} {
+		err = a.SetSeries(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Series != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.SeriesSeriesScoreAggregates[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.SeriesID != x.ID {
+			t.Error("foreign key was wrong value", a.SeriesID)
+		}
+
+		if exists, err := SeriesScoreAggregateExists(ctx, tx, a.SeriesID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testSeriesScoreAggregatesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesScoreAggregate{}
+	if err = randomize.Struct(seed, o, seriesScoreAggregateDBTypes, true, seriesScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSeriesScoreAggregatesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesScoreAggregate{}
+	if err = randomize.Struct(seed, o, seriesScoreAggregateDBTypes, true, seriesScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SeriesScoreAggregateSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSeriesScoreAggregatesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesScoreAggregate{}
+	if err = randomize.Struct(seed, o, seriesScoreAggregateDBTypes, true, seriesScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := SeriesScoreAggregates().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	seriesScoreAggregateDBTypes = map[string]string{`SeriesID`: `integer`, `ScoresCount`: `integer`, `ScoresSum`: `integer`, `ScoreMean`: `double precision`, `ScoreHistogram`: `jsonb`}
+	_                           = bytes.MinRead
+)
+
+func testSeriesScoreAggregatesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(seriesScoreAggregatePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(seriesScoreAggregateAllColumns) == len(seriesScoreAggregatePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesScoreAggregate{}
+	if err = randomize.Struct(seed, o, seriesScoreAggregateDBTypes, true, seriesScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SeriesScoreAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, seriesScoreAggregateDBTypes, true, seriesScoreAggregatePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SeriesScoreAggregate struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testSeriesScoreAggregatesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(seriesScoreAggregateAllColumns) == len(seriesScoreAggregatePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &SeriesScoreAggregate{}
+	if err = randomize.Struct(seed, o, seriesScoreAggregateDBTypes, true, seriesScoreAggregateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize SeriesScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := SeriesScoreAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, seriesScoreAggregateDBTypes, true, seriesScoreAggregatePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SeriesScoreAggregate struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(seriesScoreAggregateAllColumns, seriesScoreAggregatePrimaryKeyColumns) {
+		fields = seriesScoreAggregateAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			seriesScoreAggregateAllColumns,
+			seriesScoreAggregatePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := SeriesScoreAggregateSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testSeriesScoreAggregatesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(seriesScoreAggregateAllColumns) == len(seriesScoreAggregatePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := SeriesScoreAggregate{}
+	if err = randomize.Struct(seed, &o, seriesScoreAggregateDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize SeriesScoreAggregate struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert SeriesScoreAggregate: %s", err)
+	}
+
+	count, err := SeriesScoreAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, seriesScoreAggregateDBTypes, false, seriesScoreAggregatePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize SeriesScoreAggregate struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert SeriesScoreAggregate: %s", err)
+	}
+
+	count, err = SeriesScoreAggregates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -33,6 +33,7 @@ type Series struct {
 	ContributedBy int         `db:"contributed_by" boil:"contributed_by" json:"contributed_by" toml:"contributed_by" yaml:"contributed_by"`
 	ContributedAt time.Time   `db:"contributed_at" boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
 	Invalidation  null.String `db:"invalidation" boil:"invalidation" json:"invalidation,omitempty" toml:"invalidation" yaml:"invalidation,omitempty"`
+	DeletedAt     null.Time   `db:"deleted_at" boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
 
 	R *seriesR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L seriesL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -48,6 +49,7 @@ var SeriesColumns = struct {
 	ContributedBy string
 	ContributedAt string
 	Invalidation  string
+	DeletedAt     string
 }{
 	ID:            "id",
 	Title:         "title",
@@ -58,6 +60,7 @@ var SeriesColumns = struct {
 	ContributedBy: "contributed_by",
 	ContributedAt: "contributed_at",
 	Invalidation:  "invalidation",
+	DeletedAt:     "deleted_at",
 }
 
 var SeriesTableColumns = struct {
@@ -70,6 +73,7 @@ var SeriesTableColumns = struct {
 	ContributedBy string
 	ContributedAt string
 	Invalidation  string
+	DeletedAt     string
 }{
 	ID:            "serieses.id",
 	Title:         "serieses.title",
@@ -80,34 +84,11 @@ var SeriesTableColumns = struct {
 	ContributedBy: "serieses.contributed_by",
 	ContributedAt: "serieses.contributed_at",
 	Invalidation:  "serieses.invalidation",
+	DeletedAt:     "serieses.deleted_at",
 }
 
 // Generated where
 
-type whereHelpernull_Time struct{ field string }
-
-func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
 var SeriesWhere = struct {
 	ID            whereHelperint
 	Title         whereHelperstring
@@ -118,6 +99,7 @@ var SeriesWhere = struct {
 	ContributedBy whereHelperint
 	ContributedAt whereHelpertime_Time
 	Invalidation  whereHelpernull_String
+	DeletedAt     whereHelpernull_Time
 }{
 	ID:            whereHelperint{field: "\"serieses\".\"id\""},
 	Title:         whereHelperstring{field: "\"serieses\".\"title\""},
@@ -128,21 +110,55 @@ var SeriesWhere = struct {
 	ContributedBy: whereHelperint{field: "\"serieses\".\"contributed_by\""},
 	ContributedAt: whereHelpertime_Time{field: "\"serieses\".\"contributed_at\""},
 	Invalidation:  whereHelpernull_String{field: "\"serieses\".\"invalidation\""},
+	DeletedAt:     whereHelpernull_Time{field: "\"serieses\".\"deleted_at\""},
 }
 
 // SeriesRels is where relationship names are stored.
 var SeriesRels = struct {
-	ContributingUser string
-	SeriesFilms      string
+	ContributingUser            string
+	SeriesCollectionItems       string
+	SeriesExternalIds           string
+	SeriesFilms                 string
+	SeriesListActivities        string
+	SeriesListItems             string
+	SeriesMediaItems            string
+	SeriesReviews               string
+	SeriesScores                string
+	SeriesSeriesAggregates      string
+	SeriesSeriesFollows         string
+	SeriesSeriesScoreAggregates string
+	SeriesTranslations          string
 }{
-	ContributingUser: "ContributingUser",
-	SeriesFilms:      "SeriesFilms",
+	ContributingUser:            "ContributingUser",
+	SeriesCollectionItems:       "SeriesCollectionItems",
+	SeriesExternalIds:           "SeriesExternalIds",
+	SeriesFilms:                 "SeriesFilms",
+	SeriesListActivities:        "SeriesListActivities",
+	SeriesListItems:             "SeriesListItems",
+	SeriesMediaItems:            "SeriesMediaItems",
+	SeriesReviews:               "SeriesReviews",
+	SeriesScores:                "SeriesScores",
+	SeriesSeriesAggregates:      "SeriesSeriesAggregates",
+	SeriesSeriesFollows:         "SeriesSeriesFollows",
+	SeriesSeriesScoreAggregates: "SeriesSeriesScoreAggregates",
+	SeriesTranslations:          "SeriesTranslations",
 }
 
 // seriesR is where relationships are stored.
 type seriesR struct {
-	ContributingUser *User     `db:"ContributingUser" boil:"ContributingUser" json:"ContributingUser" toml:"ContributingUser" yaml:"ContributingUser"`
-	SeriesFilms      FilmSlice `db:"SeriesFilms" boil:"SeriesFilms" json:"SeriesFilms" toml:"SeriesFilms" yaml:"SeriesFilms"`
+	ContributingUser            *User                     `db:"ContributingUser" boil:"ContributingUser" json:"ContributingUser" toml:"ContributingUser" yaml:"ContributingUser"`
+	SeriesCollectionItems       CollectionItemSlice       `db:"SeriesCollectionItems" boil:"SeriesCollectionItems" json:"SeriesCollectionItems" toml:"SeriesCollectionItems" yaml:"SeriesCollectionItems"`
+	SeriesExternalIds           ExternalIDSlice           `db:"SeriesExternalIds" boil:"SeriesExternalIds" json:"SeriesExternalIds" toml:"SeriesExternalIds" yaml:"SeriesExternalIds"`
+	SeriesFilms                 FilmSlice                 `db:"SeriesFilms" boil:"SeriesFilms" json:"SeriesFilms" toml:"SeriesFilms" yaml:"SeriesFilms"`
+	SeriesListActivities        ListActivitySlice         `db:"SeriesListActivities" boil:"SeriesListActivities" json:"SeriesListActivities" toml:"SeriesListActivities" yaml:"SeriesListActivities"`
+	SeriesListItems             ListItemSlice             `db:"SeriesListItems" boil:"SeriesListItems" json:"SeriesListItems" toml:"SeriesListItems" yaml:"SeriesListItems"`
+	SeriesMediaItems            MediaItemSlice            `db:"SeriesMediaItems" boil:"SeriesMediaItems" json:"SeriesMediaItems" toml:"SeriesMediaItems" yaml:"SeriesMediaItems"`
+	SeriesReviews               ReviewSlice               `db:"SeriesReviews" boil:"SeriesReviews" json:"SeriesReviews" toml:"SeriesReviews" yaml:"SeriesReviews"`
+	SeriesScores                ScoreSlice                `db:"SeriesScores" boil:"SeriesScores" json:"SeriesScores" toml:"SeriesScores" yaml:"SeriesScores"`
+	SeriesSeriesAggregates      SeriesAggregateSlice      `db:"SeriesSeriesAggregates" boil:"SeriesSeriesAggregates" json:"SeriesSeriesAggregates" toml:"SeriesSeriesAggregates" yaml:"SeriesSeriesAggregates"`
+	SeriesSeriesFollows         SeriesFollowSlice         `db:"SeriesSeriesFollows" boil:"SeriesSeriesFollows" json:"SeriesSeriesFollows" toml:"SeriesSeriesFollows" yaml:"SeriesSeriesFollows"`
+	SeriesSeriesScoreAggregates SeriesScoreAggregateSlice `db:"SeriesSeriesScoreAggregates" boil:"SeriesSeriesScoreAggregates" json:"SeriesSeriesScoreAggregates" toml:"SeriesSeriesScoreAggregates" yaml:"SeriesSeriesScoreAggregates"`
+	SeriesTranslations          TranslationSlice          `db:"SeriesTranslations" boil:"SeriesTranslations" json:"SeriesTranslations" toml:"SeriesTranslations" yaml:"SeriesTranslations"`
 }
 
 // NewStruct creates a new relationship struct
@@ -157,6 +173,20 @@ func (r *seriesR) GetContributingUser() *User {
 	return r.ContributingUser
 }
 
+func (r *seriesR) GetSeriesCollectionItems() CollectionItemSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SeriesCollectionItems
+}
+
+func (r *seriesR) GetSeriesExternalIds() ExternalIDSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SeriesExternalIds
+}
+
 func (r *seriesR) GetSeriesFilms() FilmSlice {
 	if r == nil {
 		return nil
@@ -164,13 +194,76 @@ func (r *seriesR) GetSeriesFilms() FilmSlice {
 	return r.SeriesFilms
 }
 
+func (r *seriesR) GetSeriesListActivities() ListActivitySlice {
+	if r == nil {
+		return nil
+	}
+	return r.SeriesListActivities
+}
+
+func (r *seriesR) GetSeriesListItems() ListItemSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SeriesListItems
+}
+
+func (r *seriesR) GetSeriesMediaItems() MediaItemSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SeriesMediaItems
+}
+
+func (r *seriesR) GetSeriesReviews() ReviewSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SeriesReviews
+}
+
+func (r *seriesR) GetSeriesScores() ScoreSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SeriesScores
+}
+
+func (r *seriesR) GetSeriesSeriesAggregates() SeriesAggregateSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SeriesSeriesAggregates
+}
+
+func (r *seriesR) GetSeriesSeriesFollows() SeriesFollowSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SeriesSeriesFollows
+}
+
+func (r *seriesR) GetSeriesSeriesScoreAggregates() SeriesScoreAggregateSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SeriesSeriesScoreAggregates
+}
+
+func (r *seriesR) GetSeriesTranslations() TranslationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SeriesTranslations
+}
+
 // seriesL is where Load methods for each relationship are stored.
 type seriesL struct{}
 
 var (
-	seriesAllColumns            = []string{"id", "title", "descriptions", "date_started", "date_ended", "poster", "contributed_by", "contributed_at", "invalidation"}
+	seriesAllColumns            = []string{"id", "title", "descriptions", "date_started", "date_ended", "poster", "contributed_by", "contributed_at", "invalidation", "deleted_at"}
 	seriesColumnsWithoutDefault = []string{"title", "date_started", "contributed_by"}
-	seriesColumnsWithDefault    = []string{"id", "descriptions", "date_ended", "poster", "contributed_at", "invalidation"}
+	seriesColumnsWithDefault    = []string{"id", "descriptions", "date_ended", "poster", "contributed_at", "invalidation", "deleted_at"}
 	seriesPrimaryKeyColumns     = []string{"id"}
 	seriesGeneratedColumns      = []string{}
 )
@@ -464,6 +557,34 @@ func (o *Series) ContributingUser(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// SeriesCollectionItems retrieves all the collection_item's CollectionItems with an executor via series_id column.
+func (o *Series) SeriesCollectionItems(mods ...qm.QueryMod) collectionItemQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"collection_items\".\"series_id\"=?", o.ID),
+	)
+
+	return CollectionItems(queryMods...)
+}
+
+// SeriesExternalIds retrieves all the external_id's ExternalIds with an executor via series_id column.
+func (o *Series) SeriesExternalIds(mods ...qm.QueryMod) externalIDQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"external_ids\".\"series_id\"=?", o.ID),
+	)
+
+	return ExternalIds(queryMods...)
+}
+
 // SeriesFilms retrieves all the film's Films with an executor via series_id column.
 func (o *Series) SeriesFilms(mods ...qm.QueryMod) filmQuery {
 	var queryMods []qm.QueryMod
@@ -478,6 +599,132 @@ func (o *Series) SeriesFilms(mods ...qm.QueryMod) filmQuery {
 	return Films(queryMods...)
 }
 
+// SeriesListActivities retrieves all the list_activity's ListActivities with an executor via series_id column.
+func (o *Series) SeriesListActivities(mods ...qm.QueryMod) listActivityQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"list_activities\".\"series_id\"=?", o.ID),
+	)
+
+	return ListActivities(queryMods...)
+}
+
+// SeriesListItems retrieves all the list_item's ListItems with an executor via series_id column.
+func (o *Series) SeriesListItems(mods ...qm.QueryMod) listItemQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"list_items\".\"series_id\"=?", o.ID),
+	)
+
+	return ListItems(queryMods...)
+}
+
+// SeriesMediaItems retrieves all the media_item's MediaItems with an executor via series_id column.
+func (o *Series) SeriesMediaItems(mods ...qm.QueryMod) mediaItemQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"media_items\".\"series_id\"=?", o.ID),
+	)
+
+	return MediaItems(queryMods...)
+}
+
+// SeriesReviews retrieves all the review's Reviews with an executor via series_id column.
+func (o *Series) SeriesReviews(mods ...qm.QueryMod) reviewQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"reviews\".\"series_id\"=?", o.ID),
+	)
+
+	return Reviews(queryMods...)
+}
+
+// SeriesScores retrieves all the score's Scores with an executor via series_id column.
+func (o *Series) SeriesScores(mods ...qm.QueryMod) scoreQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"scores\".\"series_id\"=?", o.ID),
+	)
+
+	return Scores(queryMods...)
+}
+
+// SeriesSeriesAggregates retrieves all the series_aggregate's SeriesAggregates with an executor via series_id column.
+func (o *Series) SeriesSeriesAggregates(mods ...qm.QueryMod) seriesAggregateQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"series_aggregates\".\"series_id\"=?", o.ID),
+	)
+
+	return SeriesAggregates(queryMods...)
+}
+
+// SeriesSeriesFollows retrieves all the series_follow's SeriesFollows with an executor via series_id column.
+func (o *Series) SeriesSeriesFollows(mods ...qm.QueryMod) seriesFollowQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"series_follows\".\"series_id\"=?", o.ID),
+	)
+
+	return SeriesFollows(queryMods...)
+}
+
+// SeriesSeriesScoreAggregates retrieves all the series_score_aggregate's SeriesScoreAggregates with an executor via series_id column.
+func (o *Series) SeriesSeriesScoreAggregates(mods ...qm.QueryMod) seriesScoreAggregateQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"series_score_aggregates\".\"series_id\"=?", o.ID),
+	)
+
+	return SeriesScoreAggregates(queryMods...)
+}
+
+// SeriesTranslations retrieves all the translation's Translations with an executor via series_id column.
+func (o *Series) SeriesTranslations(mods ...qm.QueryMod) translationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"translations\".\"series_id\"=?", o.ID),
+	)
+
+	return Translations(queryMods...)
+}
+
 // LoadContributingUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (seriesL) LoadContributingUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSeries interface{}, mods queries.Applicator) error {
@@ -598,9 +845,9 @@ func (seriesL) LoadContributingUser(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
-// LoadSeriesFilms allows an eager lookup of values, cached into the
+// LoadSeriesCollectionItems allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (seriesL) LoadSeriesFilms(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSeries interface{}, mods queries.Applicator) error {
+func (seriesL) LoadSeriesCollectionItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSeries interface{}, mods queries.Applicator) error {
 	var slice []*Series
 	var object *Series
 
@@ -654,8 +901,8 @@ func (seriesL) LoadSeriesFilms(ctx context.Context, e boil.ContextExecutor, sing
 	}
 
 	query := NewQuery(
-		qm.From(`films`),
-		qm.WhereIn(`films.series_id in ?`, args...),
+		qm.From(`collection_items`),
+		qm.WhereIn(`collection_items.series_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -663,22 +910,22 @@ func (seriesL) LoadSeriesFilms(ctx context.Context, e boil.ContextExecutor, sing
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load films")
+		return errors.Wrap(err, "failed to eager load collection_items")
 	}
 
-	var resultSlice []*Film
+	var resultSlice []*CollectionItem
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice films")
+		return errors.Wrap(err, "failed to bind eager loaded slice collection_items")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on films")
+		return errors.Wrap(err, "failed to close results in eager load on collection_items")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for films")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for collection_items")
 	}
 
-	if len(filmAfterSelectHooks) != 0 {
+	if len(collectionItemAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -686,10 +933,10 @@ func (seriesL) LoadSeriesFilms(ctx context.Context, e boil.ContextExecutor, sing
 		}
 	}
 	if singular {
-		object.R.SeriesFilms = resultSlice
+		object.R.SeriesCollectionItems = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &filmR{}
+				foreign.R = &collectionItemR{}
 			}
 			foreign.R.Series = object
 		}
@@ -699,9 +946,9 @@ func (seriesL) LoadSeriesFilms(ctx context.Context, e boil.ContextExecutor, sing
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if queries.Equal(local.ID, foreign.SeriesID) {
-				local.R.SeriesFilms = append(local.R.SeriesFilms, foreign)
+				local.R.SeriesCollectionItems = append(local.R.SeriesCollectionItems, foreign)
 				if foreign.R == nil {
-					foreign.R = &filmR{}
+					foreign.R = &collectionItemR{}
 				}
 				foreign.R.Series = local
 				break
@@ -712,58 +959,2201 @@ func (seriesL) LoadSeriesFilms(ctx context.Context, e boil.ContextExecutor, sing
 	return nil
 }
 
-// SetContributingUser of the series to the related item.
-// Sets o.R.ContributingUser to related.
-// Adds o to related.R.ContributedSerieses.
-func (o *Series) SetContributingUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"serieses\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
-		strmangle.WhereClause("\"", "\"", 2, seriesPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+// LoadSeriesExternalIds allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (seriesL) LoadSeriesExternalIds(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSeries interface{}, mods queries.Applicator) error {
+	var slice []*Series
+	var object *Series
 
-	o.ContributedBy = related.ID
-	if o.R == nil {
-		o.R = &seriesR{
-			ContributingUser: related,
+	if singular {
+		var ok bool
+		object, ok = maybeSeries.(*Series)
+		if !ok {
+			object = new(Series)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSeries))
+			}
 		}
 	} else {
-		o.R.ContributingUser = related
+		s, ok := maybeSeries.(*[]*Series)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSeries))
+			}
+		}
 	}
 
-	if related.R == nil {
-		related.R = &userR{
-			ContributedSerieses: SeriesSlice{o},
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &seriesR{}
 		}
+		args = append(args, object.ID)
 	} else {
-		related.R.ContributedSerieses = append(related.R.ContributedSerieses, o)
-	}
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &seriesR{}
+			}
 
-	return nil
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`external_ids`),
+		qm.WhereIn(`external_ids.series_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load external_ids")
+	}
+
+	var resultSlice []*ExternalID
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice external_ids")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on external_ids")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for external_ids")
+	}
+
+	if len(externalIDAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SeriesExternalIds = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &externalIDR{}
+			}
+			foreign.R.Series = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.SeriesID) {
+				local.R.SeriesExternalIds = append(local.R.SeriesExternalIds, foreign)
+				if foreign.R == nil {
+					foreign.R = &externalIDR{}
+				}
+				foreign.R.Series = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSeriesFilms allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (seriesL) LoadSeriesFilms(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSeries interface{}, mods queries.Applicator) error {
+	var slice []*Series
+	var object *Series
+
+	if singular {
+		var ok bool
+		object, ok = maybeSeries.(*Series)
+		if !ok {
+			object = new(Series)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSeries))
+			}
+		}
+	} else {
+		s, ok := maybeSeries.(*[]*Series)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSeries))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &seriesR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &seriesR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`films`),
+		qm.WhereIn(`films.series_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load films")
+	}
+
+	var resultSlice []*Film
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice films")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on films")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for films")
+	}
+
+	if len(filmAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SeriesFilms = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &filmR{}
+			}
+			foreign.R.Series = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.SeriesID) {
+				local.R.SeriesFilms = append(local.R.SeriesFilms, foreign)
+				if foreign.R == nil {
+					foreign.R = &filmR{}
+				}
+				foreign.R.Series = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSeriesListActivities allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (seriesL) LoadSeriesListActivities(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSeries interface{}, mods queries.Applicator) error {
+	var slice []*Series
+	var object *Series
+
+	if singular {
+		var ok bool
+		object, ok = maybeSeries.(*Series)
+		if !ok {
+			object = new(Series)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSeries))
+			}
+		}
+	} else {
+		s, ok := maybeSeries.(*[]*Series)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSeries))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &seriesR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &seriesR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`list_activities`),
+		qm.WhereIn(`list_activities.series_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load list_activities")
+	}
+
+	var resultSlice []*ListActivity
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice list_activities")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on list_activities")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for list_activities")
+	}
+
+	if len(listActivityAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SeriesListActivities = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &listActivityR{}
+			}
+			foreign.R.Series = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.SeriesID) {
+				local.R.SeriesListActivities = append(local.R.SeriesListActivities, foreign)
+				if foreign.R == nil {
+					foreign.R = &listActivityR{}
+				}
+				foreign.R.Series = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSeriesListItems allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (seriesL) LoadSeriesListItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSeries interface{}, mods queries.Applicator) error {
+	var slice []*Series
+	var object *Series
+
+	if singular {
+		var ok bool
+		object, ok = maybeSeries.(*Series)
+		if !ok {
+			object = new(Series)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSeries))
+			}
+		}
+	} else {
+		s, ok := maybeSeries.(*[]*Series)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSeries))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &seriesR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &seriesR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`list_items`),
+		qm.WhereIn(`list_items.series_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load list_items")
+	}
+
+	var resultSlice []*ListItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice list_items")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on list_items")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for list_items")
+	}
+
+	if len(listItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SeriesListItems = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &listItemR{}
+			}
+			foreign.R.Series = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.SeriesID) {
+				local.R.SeriesListItems = append(local.R.SeriesListItems, foreign)
+				if foreign.R == nil {
+					foreign.R = &listItemR{}
+				}
+				foreign.R.Series = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSeriesMediaItems allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (seriesL) LoadSeriesMediaItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSeries interface{}, mods queries.Applicator) error {
+	var slice []*Series
+	var object *Series
+
+	if singular {
+		var ok bool
+		object, ok = maybeSeries.(*Series)
+		if !ok {
+			object = new(Series)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSeries))
+			}
+		}
+	} else {
+		s, ok := maybeSeries.(*[]*Series)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSeries))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &seriesR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &seriesR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`media_items`),
+		qm.WhereIn(`media_items.series_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load media_items")
+	}
+
+	var resultSlice []*MediaItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice media_items")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on media_items")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for media_items")
+	}
+
+	if len(mediaItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SeriesMediaItems = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &mediaItemR{}
+			}
+			foreign.R.Series = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.SeriesID) {
+				local.R.SeriesMediaItems = append(local.R.SeriesMediaItems, foreign)
+				if foreign.R == nil {
+					foreign.R = &mediaItemR{}
+				}
+				foreign.R.Series = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSeriesReviews allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (seriesL) LoadSeriesReviews(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSeries interface{}, mods queries.Applicator) error {
+	var slice []*Series
+	var object *Series
+
+	if singular {
+		var ok bool
+		object, ok = maybeSeries.(*Series)
+		if !ok {
+			object = new(Series)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSeries))
+			}
+		}
+	} else {
+		s, ok := maybeSeries.(*[]*Series)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSeries))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &seriesR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &seriesR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`reviews`),
+		qm.WhereIn(`reviews.series_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load reviews")
+	}
+
+	var resultSlice []*Review
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice reviews")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on reviews")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reviews")
+	}
+
+	if len(reviewAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SeriesReviews = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reviewR{}
+			}
+			foreign.R.Series = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.SeriesID) {
+				local.R.SeriesReviews = append(local.R.SeriesReviews, foreign)
+				if foreign.R == nil {
+					foreign.R = &reviewR{}
+				}
+				foreign.R.Series = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSeriesScores allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (seriesL) LoadSeriesScores(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSeries interface{}, mods queries.Applicator) error {
+	var slice []*Series
+	var object *Series
+
+	if singular {
+		var ok bool
+		object, ok = maybeSeries.(*Series)
+		if !ok {
+			object = new(Series)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSeries))
+			}
+		}
+	} else {
+		s, ok := maybeSeries.(*[]*Series)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSeries))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &seriesR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &seriesR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`scores`),
+		qm.WhereIn(`scores.series_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load scores")
+	}
+
+	var resultSlice []*Score
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice scores")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on scores")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for scores")
+	}
+
+	if len(scoreAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SeriesScores = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &scoreR{}
+			}
+			foreign.R.Series = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.SeriesID) {
+				local.R.SeriesScores = append(local.R.SeriesScores, foreign)
+				if foreign.R == nil {
+					foreign.R = &scoreR{}
+				}
+				foreign.R.Series = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSeriesSeriesAggregates allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (seriesL) LoadSeriesSeriesAggregates(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSeries interface{}, mods queries.Applicator) error {
+	var slice []*Series
+	var object *Series
+
+	if singular {
+		var ok bool
+		object, ok = maybeSeries.(*Series)
+		if !ok {
+			object = new(Series)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSeries))
+			}
+		}
+	} else {
+		s, ok := maybeSeries.(*[]*Series)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSeries))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &seriesR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &seriesR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`series_aggregates`),
+		qm.WhereIn(`series_aggregates.series_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load series_aggregates")
+	}
+
+	var resultSlice []*SeriesAggregate
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice series_aggregates")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on series_aggregates")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for series_aggregates")
+	}
+
+	if len(seriesAggregateAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SeriesSeriesAggregates = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &seriesAggregateR{}
+			}
+			foreign.R.Series = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SeriesID {
+				local.R.SeriesSeriesAggregates = append(local.R.SeriesSeriesAggregates, foreign)
+				if foreign.R == nil {
+					foreign.R = &seriesAggregateR{}
+				}
+				foreign.R.Series = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSeriesSeriesFollows allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (seriesL) LoadSeriesSeriesFollows(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSeries interface{}, mods queries.Applicator) error {
+	var slice []*Series
+	var object *Series
+
+	if singular {
+		var ok bool
+		object, ok = maybeSeries.(*Series)
+		if !ok {
+			object = new(Series)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSeries))
+			}
+		}
+	} else {
+		s, ok := maybeSeries.(*[]*Series)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSeries))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &seriesR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &seriesR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`series_follows`),
+		qm.WhereIn(`series_follows.series_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load series_follows")
+	}
+
+	var resultSlice []*SeriesFollow
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice series_follows")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on series_follows")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for series_follows")
+	}
+
+	if len(seriesFollowAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SeriesSeriesFollows = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &seriesFollowR{}
+			}
+			foreign.R.Series = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SeriesID {
+				local.R.SeriesSeriesFollows = append(local.R.SeriesSeriesFollows, foreign)
+				if foreign.R == nil {
+					foreign.R = &seriesFollowR{}
+				}
+				foreign.R.Series = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSeriesSeriesScoreAggregates allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (seriesL) LoadSeriesSeriesScoreAggregates(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSeries interface{}, mods queries.Applicator) error {
+	var slice []*Series
+	var object *Series
+
+	if singular {
+		var ok bool
+		object, ok = maybeSeries.(*Series)
+		if !ok {
+			object = new(Series)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSeries))
+			}
+		}
+	} else {
+		s, ok := maybeSeries.(*[]*Series)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSeries))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &seriesR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &seriesR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`series_score_aggregates`),
+		qm.WhereIn(`series_score_aggregates.series_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load series_score_aggregates")
+	}
+
+	var resultSlice []*SeriesScoreAggregate
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice series_score_aggregates")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on series_score_aggregates")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for series_score_aggregates")
+	}
+
+	if len(seriesScoreAggregateAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SeriesSeriesScoreAggregates = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &seriesScoreAggregateR{}
+			}
+			foreign.R.Series = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SeriesID {
+				local.R.SeriesSeriesScoreAggregates = append(local.R.SeriesSeriesScoreAggregates, foreign)
+				if foreign.R == nil {
+					foreign.R = &seriesScoreAggregateR{}
+				}
+				foreign.R.Series = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSeriesTranslations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (seriesL) LoadSeriesTranslations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSeries interface{}, mods queries.Applicator) error {
+	var slice []*Series
+	var object *Series
+
+	if singular {
+		var ok bool
+		object, ok = maybeSeries.(*Series)
+		if !ok {
+			object = new(Series)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSeries))
+			}
+		}
+	} else {
+		s, ok := maybeSeries.(*[]*Series)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSeries)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSeries))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &seriesR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &seriesR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`translations`),
+		qm.WhereIn(`translations.series_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load translations")
+	}
+
+	var resultSlice []*Translation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice translations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on translations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for translations")
+	}
+
+	if len(translationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SeriesTranslations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &translationR{}
+			}
+			foreign.R.Series = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.SeriesID) {
+				local.R.SeriesTranslations = append(local.R.SeriesTranslations, foreign)
+				if foreign.R == nil {
+					foreign.R = &translationR{}
+				}
+				foreign.R.Series = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetContributingUser of the series to the related item.
+// Sets o.R.ContributingUser to related.
+// Adds o to related.R.ContributedSerieses.
+func (o *Series) SetContributingUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"serieses\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
+		strmangle.WhereClause("\"", "\"", 2, seriesPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ContributedBy = related.ID
+	if o.R == nil {
+		o.R = &seriesR{
+			ContributingUser: related,
+		}
+	} else {
+		o.R.ContributingUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ContributedSerieses: SeriesSlice{o},
+		}
+	} else {
+		related.R.ContributedSerieses = append(related.R.ContributedSerieses, o)
+	}
+
+	return nil
+}
+
+// AddSeriesCollectionItems adds the given related objects to the existing relationships
+// of the seriese, optionally inserting them as new records.
+// Appends related to o.R.SeriesCollectionItems.
+// Sets related.R.Series appropriately.
+func (o *Series) AddSeriesCollectionItems(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CollectionItem) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.SeriesID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"collection_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+				strmangle.WhereClause("\"", "\"", 2, collectionItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.SeriesID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &seriesR{
+			SeriesCollectionItems: related,
+		}
+	} else {
+		o.R.SeriesCollectionItems = append(o.R.SeriesCollectionItems, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &collectionItemR{
+				Series: o,
+			}
+		} else {
+			rel.R.Series = o
+		}
+	}
+	return nil
+}
+
+// SetSeriesCollectionItems removes all previously related items of the
+// seriese replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Series's SeriesCollectionItems accordingly.
+// Replaces o.R.SeriesCollectionItems with related.
+// Sets related.R.Series's SeriesCollectionItems accordingly.
+func (o *Series) SetSeriesCollectionItems(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CollectionItem) error {
+	query := "update \"collection_items\" set \"series_id\" = null where \"series_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.SeriesCollectionItems {
+			queries.SetScanner(&rel.SeriesID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Series = nil
+		}
+		o.R.SeriesCollectionItems = nil
+	}
+
+	return o.AddSeriesCollectionItems(ctx, exec, insert, related...)
+}
+
+// RemoveSeriesCollectionItems relationships from objects passed in.
+// Removes related items from R.SeriesCollectionItems (uses pointer comparison, removal does not keep order)
+// Sets related.R.Series.
+func (o *Series) RemoveSeriesCollectionItems(ctx context.Context, exec boil.ContextExecutor, related ...*CollectionItem) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.SeriesID, nil)
+		if rel.R != nil {
+			rel.R.Series = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("series_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.SeriesCollectionItems {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.SeriesCollectionItems)
+			if ln > 1 && i < ln-1 {
+				o.R.SeriesCollectionItems[i] = o.R.SeriesCollectionItems[ln-1]
+			}
+			o.R.SeriesCollectionItems = o.R.SeriesCollectionItems[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddSeriesExternalIds adds the given related objects to the existing relationships
+// of the seriese, optionally inserting them as new records.
+// Appends related to o.R.SeriesExternalIds.
+// Sets related.R.Series appropriately.
+func (o *Series) AddSeriesExternalIds(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ExternalID) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.SeriesID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"external_ids\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+				strmangle.WhereClause("\"", "\"", 2, externalIDPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.SeriesID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &seriesR{
+			SeriesExternalIds: related,
+		}
+	} else {
+		o.R.SeriesExternalIds = append(o.R.SeriesExternalIds, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &externalIDR{
+				Series: o,
+			}
+		} else {
+			rel.R.Series = o
+		}
+	}
+	return nil
+}
+
+// SetSeriesExternalIds removes all previously related items of the
+// seriese replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Series's SeriesExternalIds accordingly.
+// Replaces o.R.SeriesExternalIds with related.
+// Sets related.R.Series's SeriesExternalIds accordingly.
+func (o *Series) SetSeriesExternalIds(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ExternalID) error {
+	query := "update \"external_ids\" set \"series_id\" = null where \"series_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.SeriesExternalIds {
+			queries.SetScanner(&rel.SeriesID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Series = nil
+		}
+		o.R.SeriesExternalIds = nil
+	}
+
+	return o.AddSeriesExternalIds(ctx, exec, insert, related...)
+}
+
+// RemoveSeriesExternalIds relationships from objects passed in.
+// Removes related items from R.SeriesExternalIds (uses pointer comparison, removal does not keep order)
+// Sets related.R.Series.
+func (o *Series) RemoveSeriesExternalIds(ctx context.Context, exec boil.ContextExecutor, related ...*ExternalID) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.SeriesID, nil)
+		if rel.R != nil {
+			rel.R.Series = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("series_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.SeriesExternalIds {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.SeriesExternalIds)
+			if ln > 1 && i < ln-1 {
+				o.R.SeriesExternalIds[i] = o.R.SeriesExternalIds[ln-1]
+			}
+			o.R.SeriesExternalIds = o.R.SeriesExternalIds[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddSeriesFilms adds the given related objects to the existing relationships
+// of the seriese, optionally inserting them as new records.
+// Appends related to o.R.SeriesFilms.
+// Sets related.R.Series appropriately.
+func (o *Series) AddSeriesFilms(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Film) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.SeriesID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"films\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+				strmangle.WhereClause("\"", "\"", 2, filmPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.SeriesID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &seriesR{
+			SeriesFilms: related,
+		}
+	} else {
+		o.R.SeriesFilms = append(o.R.SeriesFilms, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &filmR{
+				Series: o,
+			}
+		} else {
+			rel.R.Series = o
+		}
+	}
+	return nil
+}
+
+// SetSeriesFilms removes all previously related items of the
+// seriese replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Series's SeriesFilms accordingly.
+// Replaces o.R.SeriesFilms with related.
+// Sets related.R.Series's SeriesFilms accordingly.
+func (o *Series) SetSeriesFilms(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Film) error {
+	query := "update \"films\" set \"series_id\" = null where \"series_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.SeriesFilms {
+			queries.SetScanner(&rel.SeriesID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Series = nil
+		}
+		o.R.SeriesFilms = nil
+	}
+
+	return o.AddSeriesFilms(ctx, exec, insert, related...)
+}
+
+// RemoveSeriesFilms relationships from objects passed in.
+// Removes related items from R.SeriesFilms (uses pointer comparison, removal does not keep order)
+// Sets related.R.Series.
+func (o *Series) RemoveSeriesFilms(ctx context.Context, exec boil.ContextExecutor, related ...*Film) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.SeriesID, nil)
+		if rel.R != nil {
+			rel.R.Series = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("series_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.SeriesFilms {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.SeriesFilms)
+			if ln > 1 && i < ln-1 {
+				o.R.SeriesFilms[i] = o.R.SeriesFilms[ln-1]
+			}
+			o.R.SeriesFilms = o.R.SeriesFilms[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddSeriesListActivities adds the given related objects to the existing relationships
+// of the seriese, optionally inserting them as new records.
+// Appends related to o.R.SeriesListActivities.
+// Sets related.R.Series appropriately.
+func (o *Series) AddSeriesListActivities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListActivity) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.SeriesID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"list_activities\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+				strmangle.WhereClause("\"", "\"", 2, listActivityPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.SeriesID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &seriesR{
+			SeriesListActivities: related,
+		}
+	} else {
+		o.R.SeriesListActivities = append(o.R.SeriesListActivities, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &listActivityR{
+				Series: o,
+			}
+		} else {
+			rel.R.Series = o
+		}
+	}
+	return nil
+}
+
+// SetSeriesListActivities removes all previously related items of the
+// seriese replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Series's SeriesListActivities accordingly.
+// Replaces o.R.SeriesListActivities with related.
+// Sets related.R.Series's SeriesListActivities accordingly.
+func (o *Series) SetSeriesListActivities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListActivity) error {
+	query := "update \"list_activities\" set \"series_id\" = null where \"series_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.SeriesListActivities {
+			queries.SetScanner(&rel.SeriesID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Series = nil
+		}
+		o.R.SeriesListActivities = nil
+	}
+
+	return o.AddSeriesListActivities(ctx, exec, insert, related...)
+}
+
+// RemoveSeriesListActivities relationships from objects passed in.
+// Removes related items from R.SeriesListActivities (uses pointer comparison, removal does not keep order)
+// Sets related.R.Series.
+func (o *Series) RemoveSeriesListActivities(ctx context.Context, exec boil.ContextExecutor, related ...*ListActivity) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.SeriesID, nil)
+		if rel.R != nil {
+			rel.R.Series = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("series_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.SeriesListActivities {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.SeriesListActivities)
+			if ln > 1 && i < ln-1 {
+				o.R.SeriesListActivities[i] = o.R.SeriesListActivities[ln-1]
+			}
+			o.R.SeriesListActivities = o.R.SeriesListActivities[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddSeriesListItems adds the given related objects to the existing relationships
+// of the seriese, optionally inserting them as new records.
+// Appends related to o.R.SeriesListItems.
+// Sets related.R.Series appropriately.
+func (o *Series) AddSeriesListItems(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListItem) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.SeriesID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"list_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+				strmangle.WhereClause("\"", "\"", 2, listItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.SeriesID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &seriesR{
+			SeriesListItems: related,
+		}
+	} else {
+		o.R.SeriesListItems = append(o.R.SeriesListItems, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &listItemR{
+				Series: o,
+			}
+		} else {
+			rel.R.Series = o
+		}
+	}
+	return nil
+}
+
+// SetSeriesListItems removes all previously related items of the
+// seriese replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Series's SeriesListItems accordingly.
+// Replaces o.R.SeriesListItems with related.
+// Sets related.R.Series's SeriesListItems accordingly.
+func (o *Series) SetSeriesListItems(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListItem) error {
+	query := "update \"list_items\" set \"series_id\" = null where \"series_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.SeriesListItems {
+			queries.SetScanner(&rel.SeriesID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Series = nil
+		}
+		o.R.SeriesListItems = nil
+	}
+
+	return o.AddSeriesListItems(ctx, exec, insert, related...)
+}
+
+// RemoveSeriesListItems relationships from objects passed in.
+// Removes related items from R.SeriesListItems (uses pointer comparison, removal does not keep order)
+// Sets related.R.Series.
+func (o *Series) RemoveSeriesListItems(ctx context.Context, exec boil.ContextExecutor, related ...*ListItem) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.SeriesID, nil)
+		if rel.R != nil {
+			rel.R.Series = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("series_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.SeriesListItems {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.SeriesListItems)
+			if ln > 1 && i < ln-1 {
+				o.R.SeriesListItems[i] = o.R.SeriesListItems[ln-1]
+			}
+			o.R.SeriesListItems = o.R.SeriesListItems[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddSeriesMediaItems adds the given related objects to the existing relationships
+// of the seriese, optionally inserting them as new records.
+// Appends related to o.R.SeriesMediaItems.
+// Sets related.R.Series appropriately.
+func (o *Series) AddSeriesMediaItems(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MediaItem) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.SeriesID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"media_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+				strmangle.WhereClause("\"", "\"", 2, mediaItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.SeriesID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &seriesR{
+			SeriesMediaItems: related,
+		}
+	} else {
+		o.R.SeriesMediaItems = append(o.R.SeriesMediaItems, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &mediaItemR{
+				Series: o,
+			}
+		} else {
+			rel.R.Series = o
+		}
+	}
+	return nil
+}
+
+// SetSeriesMediaItems removes all previously related items of the
+// seriese replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Series's SeriesMediaItems accordingly.
+// Replaces o.R.SeriesMediaItems with related.
+// Sets related.R.Series's SeriesMediaItems accordingly.
+func (o *Series) SetSeriesMediaItems(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MediaItem) error {
+	query := "update \"media_items\" set \"series_id\" = null where \"series_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.SeriesMediaItems {
+			queries.SetScanner(&rel.SeriesID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Series = nil
+		}
+		o.R.SeriesMediaItems = nil
+	}
+
+	return o.AddSeriesMediaItems(ctx, exec, insert, related...)
+}
+
+// RemoveSeriesMediaItems relationships from objects passed in.
+// Removes related items from R.SeriesMediaItems (uses pointer comparison, removal does not keep order)
+// Sets related.R.Series.
+func (o *Series) RemoveSeriesMediaItems(ctx context.Context, exec boil.ContextExecutor, related ...*MediaItem) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.SeriesID, nil)
+		if rel.R != nil {
+			rel.R.Series = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("series_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.SeriesMediaItems {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.SeriesMediaItems)
+			if ln > 1 && i < ln-1 {
+				o.R.SeriesMediaItems[i] = o.R.SeriesMediaItems[ln-1]
+			}
+			o.R.SeriesMediaItems = o.R.SeriesMediaItems[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddSeriesReviews adds the given related objects to the existing relationships
+// of the seriese, optionally inserting them as new records.
+// Appends related to o.R.SeriesReviews.
+// Sets related.R.Series appropriately.
+func (o *Series) AddSeriesReviews(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Review) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.SeriesID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"reviews\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+				strmangle.WhereClause("\"", "\"", 2, reviewPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.SeriesID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &seriesR{
+			SeriesReviews: related,
+		}
+	} else {
+		o.R.SeriesReviews = append(o.R.SeriesReviews, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &reviewR{
+				Series: o,
+			}
+		} else {
+			rel.R.Series = o
+		}
+	}
+	return nil
+}
+
+// SetSeriesReviews removes all previously related items of the
+// seriese replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Series's SeriesReviews accordingly.
+// Replaces o.R.SeriesReviews with related.
+// Sets related.R.Series's SeriesReviews accordingly.
+func (o *Series) SetSeriesReviews(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Review) error {
+	query := "update \"reviews\" set \"series_id\" = null where \"series_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.SeriesReviews {
+			queries.SetScanner(&rel.SeriesID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Series = nil
+		}
+		o.R.SeriesReviews = nil
+	}
+
+	return o.AddSeriesReviews(ctx, exec, insert, related...)
+}
+
+// RemoveSeriesReviews relationships from objects passed in.
+// Removes related items from R.SeriesReviews (uses pointer comparison, removal does not keep order)
+// Sets related.R.Series.
+func (o *Series) RemoveSeriesReviews(ctx context.Context, exec boil.ContextExecutor, related ...*Review) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.SeriesID, nil)
+		if rel.R != nil {
+			rel.R.Series = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("series_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.SeriesReviews {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.SeriesReviews)
+			if ln > 1 && i < ln-1 {
+				o.R.SeriesReviews[i] = o.R.SeriesReviews[ln-1]
+			}
+			o.R.SeriesReviews = o.R.SeriesReviews[:ln-1]
+			break
+		}
+	}
+
+	return nil
 }
 
-// AddSeriesFilms adds the given related objects to the existing relationships
+// AddSeriesScores adds the given related objects to the existing relationships
 // of the seriese, optionally inserting them as new records.
-// Appends related to o.R.SeriesFilms.
+// Appends related to o.R.SeriesScores.
 // Sets related.R.Series appropriately.
-func (o *Series) AddSeriesFilms(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Film) error {
+func (o *Series) AddSeriesScores(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Score) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -773,9 +3163,9 @@ func (o *Series) AddSeriesFilms(ctx context.Context, exec boil.ContextExecutor,
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"films\" SET %s WHERE %s",
+				"UPDATE \"scores\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
-				strmangle.WhereClause("\"", "\"", 2, filmPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, scorePrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -794,15 +3184,15 @@ func (o *Series) AddSeriesFilms(ctx context.Context, exec boil.ContextExecutor,
 
 	if o.R == nil {
 		o.R = &seriesR{
-			SeriesFilms: related,
+			SeriesScores: related,
 		}
 	} else {
-		o.R.SeriesFilms = append(o.R.SeriesFilms, related...)
+		o.R.SeriesScores = append(o.R.SeriesScores, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &filmR{
+			rel.R = &scoreR{
 				Series: o,
 			}
 		} else {
@@ -812,14 +3202,14 @@ func (o *Series) AddSeriesFilms(ctx context.Context, exec boil.ContextExecutor,
 	return nil
 }
 
-// SetSeriesFilms removes all previously related items of the
+// SetSeriesScores removes all previously related items of the
 // seriese replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.Series's SeriesFilms accordingly.
-// Replaces o.R.SeriesFilms with related.
-// Sets related.R.Series's SeriesFilms accordingly.
-func (o *Series) SetSeriesFilms(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Film) error {
-	query := "update \"films\" set \"series_id\" = null where \"series_id\" = $1"
+// Sets o.R.Series's SeriesScores accordingly.
+// Replaces o.R.SeriesScores with related.
+// Sets related.R.Series's SeriesScores accordingly.
+func (o *Series) SetSeriesScores(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Score) error {
+	query := "update \"scores\" set \"series_id\" = null where \"series_id\" = $1"
 	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -832,7 +3222,7 @@ func (o *Series) SetSeriesFilms(ctx context.Context, exec boil.ContextExecutor,
 	}
 
 	if o.R != nil {
-		for _, rel := range o.R.SeriesFilms {
+		for _, rel := range o.R.SeriesScores {
 			queries.SetScanner(&rel.SeriesID, nil)
 			if rel.R == nil {
 				continue
@@ -840,16 +3230,16 @@ func (o *Series) SetSeriesFilms(ctx context.Context, exec boil.ContextExecutor,
 
 			rel.R.Series = nil
 		}
-		o.R.SeriesFilms = nil
+		o.R.SeriesScores = nil
 	}
 
-	return o.AddSeriesFilms(ctx, exec, insert, related...)
+	return o.AddSeriesScores(ctx, exec, insert, related...)
 }
 
-// RemoveSeriesFilms relationships from objects passed in.
-// Removes related items from R.SeriesFilms (uses pointer comparison, removal does not keep order)
+// RemoveSeriesScores relationships from objects passed in.
+// Removes related items from R.SeriesScores (uses pointer comparison, removal does not keep order)
 // Sets related.R.Series.
-func (o *Series) RemoveSeriesFilms(ctx context.Context, exec boil.ContextExecutor, related ...*Film) error {
+func (o *Series) RemoveSeriesScores(ctx context.Context, exec boil.ContextExecutor, related ...*Score) error {
 	if len(related) == 0 {
 		return nil
 	}
@@ -869,16 +3259,302 @@ func (o *Series) RemoveSeriesFilms(ctx context.Context, exec boil.ContextExecuto
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.SeriesFilms {
+		for i, ri := range o.R.SeriesScores {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.SeriesFilms)
+			ln := len(o.R.SeriesScores)
 			if ln > 1 && i < ln-1 {
-				o.R.SeriesFilms[i] = o.R.SeriesFilms[ln-1]
+				o.R.SeriesScores[i] = o.R.SeriesScores[ln-1]
 			}
-			o.R.SeriesFilms = o.R.SeriesFilms[:ln-1]
+			o.R.SeriesScores = o.R.SeriesScores[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddSeriesSeriesAggregates adds the given related objects to the existing relationships
+// of the seriese, optionally inserting them as new records.
+// Appends related to o.R.SeriesSeriesAggregates.
+// Sets related.R.Series appropriately.
+func (o *Series) AddSeriesSeriesAggregates(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SeriesAggregate) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SeriesID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"series_aggregates\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+				strmangle.WhereClause("\"", "\"", 2, seriesAggregatePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.SeriesID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SeriesID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &seriesR{
+			SeriesSeriesAggregates: related,
+		}
+	} else {
+		o.R.SeriesSeriesAggregates = append(o.R.SeriesSeriesAggregates, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &seriesAggregateR{
+				Series: o,
+			}
+		} else {
+			rel.R.Series = o
+		}
+	}
+	return nil
+}
+
+// AddSeriesSeriesFollows adds the given related objects to the existing relationships
+// of the seriese, optionally inserting them as new records.
+// Appends related to o.R.SeriesSeriesFollows.
+// Sets related.R.Series appropriately.
+func (o *Series) AddSeriesSeriesFollows(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SeriesFollow) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SeriesID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"series_follows\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+				strmangle.WhereClause("\"", "\"", 2, seriesFollowPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.SeriesID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SeriesID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &seriesR{
+			SeriesSeriesFollows: related,
+		}
+	} else {
+		o.R.SeriesSeriesFollows = append(o.R.SeriesSeriesFollows, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &seriesFollowR{
+				Series: o,
+			}
+		} else {
+			rel.R.Series = o
+		}
+	}
+	return nil
+}
+
+// AddSeriesSeriesScoreAggregates adds the given related objects to the existing relationships
+// of the seriese, optionally inserting them as new records.
+// Appends related to o.R.SeriesSeriesScoreAggregates.
+// Sets related.R.Series appropriately.
+func (o *Series) AddSeriesSeriesScoreAggregates(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SeriesScoreAggregate) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SeriesID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"series_score_aggregates\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+				strmangle.WhereClause("\"", "\"", 2, seriesScoreAggregatePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.SeriesID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SeriesID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &seriesR{
+			SeriesSeriesScoreAggregates: related,
+		}
+	} else {
+		o.R.SeriesSeriesScoreAggregates = append(o.R.SeriesSeriesScoreAggregates, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &seriesScoreAggregateR{
+				Series: o,
+			}
+		} else {
+			rel.R.Series = o
+		}
+	}
+	return nil
+}
+
+// AddSeriesTranslations adds the given related objects to the existing relationships
+// of the seriese, optionally inserting them as new records.
+// Appends related to o.R.SeriesTranslations.
+// Sets related.R.Series appropriately.
+func (o *Series) AddSeriesTranslations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Translation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.SeriesID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"translations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"series_id"}),
+				strmangle.WhereClause("\"", "\"", 2, translationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.SeriesID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &seriesR{
+			SeriesTranslations: related,
+		}
+	} else {
+		o.R.SeriesTranslations = append(o.R.SeriesTranslations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &translationR{
+				Series: o,
+			}
+		} else {
+			rel.R.Series = o
+		}
+	}
+	return nil
+}
+
+// SetSeriesTranslations removes all previously related items of the
+// seriese replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Series's SeriesTranslations accordingly.
+// Replaces o.R.SeriesTranslations with related.
+// Sets related.R.Series's SeriesTranslations accordingly.
+func (o *Series) SetSeriesTranslations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Translation) error {
+	query := "update \"translations\" set \"series_id\" = null where \"series_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.SeriesTranslations {
+			queries.SetScanner(&rel.SeriesID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Series = nil
+		}
+		o.R.SeriesTranslations = nil
+	}
+
+	return o.AddSeriesTranslations(ctx, exec, insert, related...)
+}
+
+// RemoveSeriesTranslations relationships from objects passed in.
+// Removes related items from R.SeriesTranslations (uses pointer comparison, removal does not keep order)
+// Sets related.R.Series.
+func (o *Series) RemoveSeriesTranslations(ctx context.Context, exec boil.ContextExecutor, related ...*Translation) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.SeriesID, nil)
+		if rel.R != nil {
+			rel.R.Series = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("series_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.SeriesTranslations {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.SeriesTranslations)
+			if ln > 1 && i < ln-1 {
+				o.R.SeriesTranslations[i] = o.R.SeriesTranslations[ln-1]
+			}
+			o.R.SeriesTranslations = o.R.SeriesTranslations[:ln-1]
 			break
 		}
 	}
@@ -33,6 +33,9 @@ type SeriesesAudit struct {
 	ContributedBy int         `db:"contributed_by" boil:"contributed_by" json:"contributed_by" toml:"contributed_by" yaml:"contributed_by"`
 	ContributedAt time.Time   `db:"contributed_at" boil:"contributed_at" json:"contributed_at" toml:"contributed_at" yaml:"contributed_at"`
 	Invalidation  null.String `db:"invalidation" boil:"invalidation" json:"invalidation,omitempty" toml:"invalidation" yaml:"invalidation,omitempty"`
+	DeletedAt     null.Time   `db:"deleted_at" boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	AuditAction   string      `db:"audit_action" boil:"audit_action" json:"audit_action" toml:"audit_action" yaml:"audit_action"`
+	AuditActor    null.Int    `db:"audit_actor" boil:"audit_actor" json:"audit_actor,omitempty" toml:"audit_actor" yaml:"audit_actor,omitempty"`
 
 	R *seriesesAuditR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L seriesesAuditL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -48,6 +51,9 @@ var SeriesesAuditColumns = struct {
 	ContributedBy string
 	ContributedAt string
 	Invalidation  string
+	DeletedAt     string
+	AuditAction   string
+	AuditActor    string
 }{
 	ID:            "id",
 	Title:         "title",
@@ -58,6 +64,9 @@ var SeriesesAuditColumns = struct {
 	ContributedBy: "contributed_by",
 	ContributedAt: "contributed_at",
 	Invalidation:  "invalidation",
+	DeletedAt:     "deleted_at",
+	AuditAction:   "audit_action",
+	AuditActor:    "audit_actor",
 }
 
 var SeriesesAuditTableColumns = struct {
@@ -70,6 +79,9 @@ var SeriesesAuditTableColumns = struct {
 	ContributedBy string
 	ContributedAt string
 	Invalidation  string
+	DeletedAt     string
+	AuditAction   string
+	AuditActor    string
 }{
 	ID:            "serieses_audit.id",
 	Title:         "serieses_audit.title",
@@ -80,6 +92,9 @@ var SeriesesAuditTableColumns = struct {
 	ContributedBy: "serieses_audit.contributed_by",
 	ContributedAt: "serieses_audit.contributed_at",
 	Invalidation:  "serieses_audit.invalidation",
+	DeletedAt:     "serieses_audit.deleted_at",
+	AuditAction:   "serieses_audit.audit_action",
+	AuditActor:    "serieses_audit.audit_actor",
 }
 
 // Generated where
@@ -94,6 +109,9 @@ var SeriesesAuditWhere = struct {
 	ContributedBy whereHelperint
 	ContributedAt whereHelpertime_Time
 	Invalidation  whereHelpernull_String
+	DeletedAt     whereHelpernull_Time
+	AuditAction   whereHelperstring
+	AuditActor    whereHelpernull_Int
 }{
 	ID:            whereHelperint{field: "\"serieses_audit\".\"id\""},
 	Title:         whereHelperstring{field: "\"serieses_audit\".\"title\""},
@@ -104,6 +122,9 @@ var SeriesesAuditWhere = struct {
 	ContributedBy: whereHelperint{field: "\"serieses_audit\".\"contributed_by\""},
 	ContributedAt: whereHelpertime_Time{field: "\"serieses_audit\".\"contributed_at\""},
 	Invalidation:  whereHelpernull_String{field: "\"serieses_audit\".\"invalidation\""},
+	DeletedAt:     whereHelpernull_Time{field: "\"serieses_audit\".\"deleted_at\""},
+	AuditAction:   whereHelperstring{field: "\"serieses_audit\".\"audit_action\""},
+	AuditActor:    whereHelpernull_Int{field: "\"serieses_audit\".\"audit_actor\""},
 }
 
 // SeriesesAuditRels is where relationship names are stored.
@@ -123,9 +144,9 @@ func (*seriesesAuditR) NewStruct() *seriesesAuditR {
 type seriesesAuditL struct{}
 
 var (
-	seriesesAuditAllColumns            = []string{"id", "title", "descriptions", "date_started", "date_ended", "poster", "contributed_by", "contributed_at", "invalidation"}
+	seriesesAuditAllColumns            = []string{"id", "title", "descriptions", "date_started", "date_ended", "poster", "contributed_by", "contributed_at", "invalidation", "deleted_at", "audit_action", "audit_actor"}
 	seriesesAuditColumnsWithoutDefault = []string{"id", "title", "date_started", "contributed_by", "contributed_at"}
-	seriesesAuditColumnsWithDefault    = []string{"descriptions", "date_ended", "poster", "invalidation"}
+	seriesesAuditColumnsWithDefault    = []string{"descriptions", "date_ended", "poster", "invalidation", "deleted_at", "audit_action", "audit_actor"}
 	seriesesAuditPrimaryKeyColumns     = []string{"id", "contributed_by", "contributed_at"}
 	seriesesAuditGeneratedColumns      = []string{}
 )
@@ -494,6 +494,160 @@ func testSeriesesInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testSeriesToManySeriesCollectionItems(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c CollectionItem
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, true, seriesColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Series struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, collectionItemDBTypes, false, collectionItemColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, collectionItemDBTypes, false, collectionItemColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.SeriesID, a.ID)
+	queries.Assign(&c.SeriesID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SeriesCollectionItems().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.SeriesID, b.SeriesID) {
+			bFound = true
+		}
+		if queries.Equal(v.SeriesID, c.SeriesID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := SeriesSlice{&a}
+	if err = a.L.LoadSeriesCollectionItems(ctx, tx, false, (*[]*Series)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SeriesCollectionItems); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SeriesCollectionItems = nil
+	if err = a.L.LoadSeriesCollectionItems(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SeriesCollectionItems); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testSeriesToManySeriesExternalIds(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c ExternalID
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, true, seriesColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Series struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, externalIDDBTypes, false, externalIDColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, externalIDDBTypes, false, externalIDColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.SeriesID, a.ID)
+	queries.Assign(&c.SeriesID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SeriesExternalIds().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.SeriesID, b.SeriesID) {
+			bFound = true
+		}
+		if queries.Equal(v.SeriesID, c.SeriesID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := SeriesSlice{&a}
+	if err = a.L.LoadSeriesExternalIds(ctx, tx, false, (*[]*Series)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SeriesExternalIds); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SeriesExternalIds = nil
+	if err = a.L.LoadSeriesExternalIds(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SeriesExternalIds); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testSeriesToManySeriesFilms(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -501,26 +655,2879 @@ func testSeriesToManySeriesFilms(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Series
-	var b, c Film
+	var b, c Film
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, true, seriesColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Series struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, filmDBTypes, false, filmColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, filmDBTypes, false, filmColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.SeriesID, a.ID)
+	queries.Assign(&c.SeriesID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SeriesFilms().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.SeriesID, b.SeriesID) {
+			bFound = true
+		}
+		if queries.Equal(v.SeriesID, c.SeriesID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := SeriesSlice{&a}
+	if err = a.L.LoadSeriesFilms(ctx, tx, false, (*[]*Series)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SeriesFilms); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SeriesFilms = nil
+	if err = a.L.LoadSeriesFilms(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SeriesFilms); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testSeriesToManySeriesListActivities(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c ListActivity
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, true, seriesColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Series struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, listActivityDBTypes, false, listActivityColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, listActivityDBTypes, false, listActivityColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.SeriesID, a.ID)
+	queries.Assign(&c.SeriesID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SeriesListActivities().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.SeriesID, b.SeriesID) {
+			bFound = true
+		}
+		if queries.Equal(v.SeriesID, c.SeriesID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := SeriesSlice{&a}
+	if err = a.L.LoadSeriesListActivities(ctx, tx, false, (*[]*Series)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SeriesListActivities); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SeriesListActivities = nil
+	if err = a.L.LoadSeriesListActivities(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SeriesListActivities); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testSeriesToManySeriesListItems(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c ListItem
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, true, seriesColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Series struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, listItemDBTypes, false, listItemColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, listItemDBTypes, false, listItemColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.SeriesID, a.ID)
+	queries.Assign(&c.SeriesID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SeriesListItems().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.SeriesID, b.SeriesID) {
+			bFound = true
+		}
+		if queries.Equal(v.SeriesID, c.SeriesID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := SeriesSlice{&a}
+	if err = a.L.LoadSeriesListItems(ctx, tx, false, (*[]*Series)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SeriesListItems); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SeriesListItems = nil
+	if err = a.L.LoadSeriesListItems(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SeriesListItems); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testSeriesToManySeriesMediaItems(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c MediaItem
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, true, seriesColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Series struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, mediaItemDBTypes, false, mediaItemColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, mediaItemDBTypes, false, mediaItemColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.SeriesID, a.ID)
+	queries.Assign(&c.SeriesID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SeriesMediaItems().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.SeriesID, b.SeriesID) {
+			bFound = true
+		}
+		if queries.Equal(v.SeriesID, c.SeriesID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := SeriesSlice{&a}
+	if err = a.L.LoadSeriesMediaItems(ctx, tx, false, (*[]*Series)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SeriesMediaItems); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SeriesMediaItems = nil
+	if err = a.L.LoadSeriesMediaItems(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SeriesMediaItems); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testSeriesToManySeriesReviews(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c Review
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, true, seriesColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Series struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, reviewDBTypes, false, reviewColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, reviewDBTypes, false, reviewColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.SeriesID, a.ID)
+	queries.Assign(&c.SeriesID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SeriesReviews().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.SeriesID, b.SeriesID) {
+			bFound = true
+		}
+		if queries.Equal(v.SeriesID, c.SeriesID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := SeriesSlice{&a}
+	if err = a.L.LoadSeriesReviews(ctx, tx, false, (*[]*Series)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SeriesReviews); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SeriesReviews = nil
+	if err = a.L.LoadSeriesReviews(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SeriesReviews); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testSeriesToManySeriesScores(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c Score
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, true, seriesColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Series struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, scoreDBTypes, false, scoreColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, scoreDBTypes, false, scoreColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.SeriesID, a.ID)
+	queries.Assign(&c.SeriesID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SeriesScores().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.SeriesID, b.SeriesID) {
+			bFound = true
+		}
+		if queries.Equal(v.SeriesID, c.SeriesID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := SeriesSlice{&a}
+	if err = a.L.LoadSeriesScores(ctx, tx, false, (*[]*Series)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SeriesScores); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SeriesScores = nil
+	if err = a.L.LoadSeriesScores(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SeriesScores); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testSeriesToManySeriesSeriesAggregates(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c SeriesAggregate
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, true, seriesColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Series struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, seriesAggregateDBTypes, false, seriesAggregateColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, seriesAggregateDBTypes, false, seriesAggregateColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.SeriesID = a.ID
+	c.SeriesID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SeriesSeriesAggregates().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.SeriesID == b.SeriesID {
+			bFound = true
+		}
+		if v.SeriesID == c.SeriesID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := SeriesSlice{&a}
+	if err = a.L.LoadSeriesSeriesAggregates(ctx, tx, false, (*[]*Series)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SeriesSeriesAggregates); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SeriesSeriesAggregates = nil
+	if err = a.L.LoadSeriesSeriesAggregates(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SeriesSeriesAggregates); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testSeriesToManySeriesSeriesFollows(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c SeriesFollow
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, true, seriesColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Series struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, seriesFollowDBTypes, false, seriesFollowColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, seriesFollowDBTypes, false, seriesFollowColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.SeriesID = a.ID
+	c.SeriesID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SeriesSeriesFollows().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.SeriesID == b.SeriesID {
+			bFound = true
+		}
+		if v.SeriesID == c.SeriesID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := SeriesSlice{&a}
+	if err = a.L.LoadSeriesSeriesFollows(ctx, tx, false, (*[]*Series)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SeriesSeriesFollows); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SeriesSeriesFollows = nil
+	if err = a.L.LoadSeriesSeriesFollows(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SeriesSeriesFollows); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testSeriesToManySeriesSeriesScoreAggregates(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c SeriesScoreAggregate
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, true, seriesColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Series struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, seriesScoreAggregateDBTypes, false, seriesScoreAggregateColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, seriesScoreAggregateDBTypes, false, seriesScoreAggregateColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.SeriesID = a.ID
+	c.SeriesID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SeriesSeriesScoreAggregates().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.SeriesID == b.SeriesID {
+			bFound = true
+		}
+		if v.SeriesID == c.SeriesID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := SeriesSlice{&a}
+	if err = a.L.LoadSeriesSeriesScoreAggregates(ctx, tx, false, (*[]*Series)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SeriesSeriesScoreAggregates); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SeriesSeriesScoreAggregates = nil
+	if err = a.L.LoadSeriesSeriesScoreAggregates(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SeriesSeriesScoreAggregates); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testSeriesToManySeriesTranslations(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c Translation
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, true, seriesColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Series struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, translationDBTypes, false, translationColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, translationDBTypes, false, translationColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.SeriesID, a.ID)
+	queries.Assign(&c.SeriesID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SeriesTranslations().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.SeriesID, b.SeriesID) {
+			bFound = true
+		}
+		if queries.Equal(v.SeriesID, c.SeriesID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := SeriesSlice{&a}
+	if err = a.L.LoadSeriesTranslations(ctx, tx, false, (*[]*Series)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SeriesTranslations); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SeriesTranslations = nil
+	if err = a.L.LoadSeriesTranslations(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SeriesTranslations); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testSeriesToManyAddOpSeriesCollectionItems(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e CollectionItem
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*CollectionItem{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, collectionItemDBTypes, false, strmangle.SetComplement(collectionItemPrimaryKeyColumns, collectionItemColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*CollectionItem{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSeriesCollectionItems(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.SeriesID) {
+			t.Error("foreign key was wrong value", a.ID, first.SeriesID)
+		}
+		if !queries.Equal(a.ID, second.SeriesID) {
+			t.Error("foreign key was wrong value", a.ID, second.SeriesID)
+		}
+
+		if first.R.Series != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Series != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SeriesCollectionItems[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SeriesCollectionItems[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SeriesCollectionItems().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testSeriesToManySetOpSeriesCollectionItems(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e CollectionItem
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*CollectionItem{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, collectionItemDBTypes, false, strmangle.SetComplement(collectionItemPrimaryKeyColumns, collectionItemColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetSeriesCollectionItems(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SeriesCollectionItems().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetSeriesCollectionItems(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SeriesCollectionItems().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SeriesID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SeriesID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.SeriesID) {
+		t.Error("foreign key was wrong value", a.ID, d.SeriesID)
+	}
+	if !queries.Equal(a.ID, e.SeriesID) {
+		t.Error("foreign key was wrong value", a.ID, e.SeriesID)
+	}
+
+	if b.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Series != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Series != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.SeriesCollectionItems[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.SeriesCollectionItems[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testSeriesToManyRemoveOpSeriesCollectionItems(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e CollectionItem
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*CollectionItem{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, collectionItemDBTypes, false, strmangle.SetComplement(collectionItemPrimaryKeyColumns, collectionItemColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddSeriesCollectionItems(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SeriesCollectionItems().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveSeriesCollectionItems(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SeriesCollectionItems().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SeriesID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SeriesID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Series != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Series != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.SeriesCollectionItems) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.SeriesCollectionItems[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.SeriesCollectionItems[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testSeriesToManyAddOpSeriesExternalIds(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e ExternalID
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ExternalID{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, externalIDDBTypes, false, strmangle.SetComplement(externalIDPrimaryKeyColumns, externalIDColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*ExternalID{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSeriesExternalIds(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.SeriesID) {
+			t.Error("foreign key was wrong value", a.ID, first.SeriesID)
+		}
+		if !queries.Equal(a.ID, second.SeriesID) {
+			t.Error("foreign key was wrong value", a.ID, second.SeriesID)
+		}
+
+		if first.R.Series != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Series != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SeriesExternalIds[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SeriesExternalIds[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SeriesExternalIds().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testSeriesToManySetOpSeriesExternalIds(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e ExternalID
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ExternalID{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, externalIDDBTypes, false, strmangle.SetComplement(externalIDPrimaryKeyColumns, externalIDColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetSeriesExternalIds(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SeriesExternalIds().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetSeriesExternalIds(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SeriesExternalIds().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SeriesID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SeriesID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.SeriesID) {
+		t.Error("foreign key was wrong value", a.ID, d.SeriesID)
+	}
+	if !queries.Equal(a.ID, e.SeriesID) {
+		t.Error("foreign key was wrong value", a.ID, e.SeriesID)
+	}
+
+	if b.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Series != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Series != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.SeriesExternalIds[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.SeriesExternalIds[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testSeriesToManyRemoveOpSeriesExternalIds(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e ExternalID
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ExternalID{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, externalIDDBTypes, false, strmangle.SetComplement(externalIDPrimaryKeyColumns, externalIDColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddSeriesExternalIds(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SeriesExternalIds().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveSeriesExternalIds(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SeriesExternalIds().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SeriesID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SeriesID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Series != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Series != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.SeriesExternalIds) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.SeriesExternalIds[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.SeriesExternalIds[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testSeriesToManyAddOpSeriesFilms(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e Film
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Film{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Film{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSeriesFilms(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.SeriesID) {
+			t.Error("foreign key was wrong value", a.ID, first.SeriesID)
+		}
+		if !queries.Equal(a.ID, second.SeriesID) {
+			t.Error("foreign key was wrong value", a.ID, second.SeriesID)
+		}
+
+		if first.R.Series != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Series != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SeriesFilms[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SeriesFilms[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SeriesFilms().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testSeriesToManySetOpSeriesFilms(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e Film
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Film{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetSeriesFilms(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SeriesFilms().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetSeriesFilms(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SeriesFilms().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SeriesID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SeriesID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.SeriesID) {
+		t.Error("foreign key was wrong value", a.ID, d.SeriesID)
+	}
+	if !queries.Equal(a.ID, e.SeriesID) {
+		t.Error("foreign key was wrong value", a.ID, e.SeriesID)
+	}
+
+	if b.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Series != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Series != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.SeriesFilms[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.SeriesFilms[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testSeriesToManyRemoveOpSeriesFilms(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e Film
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Film{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddSeriesFilms(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SeriesFilms().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveSeriesFilms(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SeriesFilms().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SeriesID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SeriesID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Series != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Series != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.SeriesFilms) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.SeriesFilms[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.SeriesFilms[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testSeriesToManyAddOpSeriesListActivities(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e ListActivity
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ListActivity{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, listActivityDBTypes, false, strmangle.SetComplement(listActivityPrimaryKeyColumns, listActivityColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*ListActivity{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSeriesListActivities(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.SeriesID) {
+			t.Error("foreign key was wrong value", a.ID, first.SeriesID)
+		}
+		if !queries.Equal(a.ID, second.SeriesID) {
+			t.Error("foreign key was wrong value", a.ID, second.SeriesID)
+		}
+
+		if first.R.Series != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Series != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SeriesListActivities[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SeriesListActivities[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SeriesListActivities().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testSeriesToManySetOpSeriesListActivities(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e ListActivity
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ListActivity{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, listActivityDBTypes, false, strmangle.SetComplement(listActivityPrimaryKeyColumns, listActivityColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetSeriesListActivities(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SeriesListActivities().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetSeriesListActivities(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SeriesListActivities().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SeriesID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SeriesID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.SeriesID) {
+		t.Error("foreign key was wrong value", a.ID, d.SeriesID)
+	}
+	if !queries.Equal(a.ID, e.SeriesID) {
+		t.Error("foreign key was wrong value", a.ID, e.SeriesID)
+	}
+
+	if b.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Series != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Series != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.SeriesListActivities[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.SeriesListActivities[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testSeriesToManyRemoveOpSeriesListActivities(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e ListActivity
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ListActivity{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, listActivityDBTypes, false, strmangle.SetComplement(listActivityPrimaryKeyColumns, listActivityColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddSeriesListActivities(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SeriesListActivities().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveSeriesListActivities(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SeriesListActivities().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SeriesID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SeriesID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Series != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Series != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.SeriesListActivities) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.SeriesListActivities[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.SeriesListActivities[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testSeriesToManyAddOpSeriesListItems(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e ListItem
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ListItem{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, listItemDBTypes, false, strmangle.SetComplement(listItemPrimaryKeyColumns, listItemColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*ListItem{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSeriesListItems(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.SeriesID) {
+			t.Error("foreign key was wrong value", a.ID, first.SeriesID)
+		}
+		if !queries.Equal(a.ID, second.SeriesID) {
+			t.Error("foreign key was wrong value", a.ID, second.SeriesID)
+		}
+
+		if first.R.Series != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Series != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SeriesListItems[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SeriesListItems[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SeriesListItems().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testSeriesToManySetOpSeriesListItems(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e ListItem
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ListItem{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, listItemDBTypes, false, strmangle.SetComplement(listItemPrimaryKeyColumns, listItemColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetSeriesListItems(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SeriesListItems().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetSeriesListItems(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SeriesListItems().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SeriesID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SeriesID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.SeriesID) {
+		t.Error("foreign key was wrong value", a.ID, d.SeriesID)
+	}
+	if !queries.Equal(a.ID, e.SeriesID) {
+		t.Error("foreign key was wrong value", a.ID, e.SeriesID)
+	}
+
+	if b.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Series != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Series != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.SeriesListItems[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.SeriesListItems[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testSeriesToManyRemoveOpSeriesListItems(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e ListItem
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*ListItem{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, listItemDBTypes, false, strmangle.SetComplement(listItemPrimaryKeyColumns, listItemColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddSeriesListItems(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SeriesListItems().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveSeriesListItems(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SeriesListItems().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SeriesID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SeriesID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Series != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Series != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.SeriesListItems) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.SeriesListItems[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.SeriesListItems[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testSeriesToManyAddOpSeriesMediaItems(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e MediaItem
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*MediaItem{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, mediaItemDBTypes, false, strmangle.SetComplement(mediaItemPrimaryKeyColumns, mediaItemColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*MediaItem{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSeriesMediaItems(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.SeriesID) {
+			t.Error("foreign key was wrong value", a.ID, first.SeriesID)
+		}
+		if !queries.Equal(a.ID, second.SeriesID) {
+			t.Error("foreign key was wrong value", a.ID, second.SeriesID)
+		}
+
+		if first.R.Series != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Series != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SeriesMediaItems[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SeriesMediaItems[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SeriesMediaItems().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testSeriesToManySetOpSeriesMediaItems(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e MediaItem
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*MediaItem{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, mediaItemDBTypes, false, strmangle.SetComplement(mediaItemPrimaryKeyColumns, mediaItemColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetSeriesMediaItems(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SeriesMediaItems().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetSeriesMediaItems(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SeriesMediaItems().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SeriesID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SeriesID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.SeriesID) {
+		t.Error("foreign key was wrong value", a.ID, d.SeriesID)
+	}
+	if !queries.Equal(a.ID, e.SeriesID) {
+		t.Error("foreign key was wrong value", a.ID, e.SeriesID)
+	}
+
+	if b.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Series != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Series != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.SeriesMediaItems[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.SeriesMediaItems[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testSeriesToManyRemoveOpSeriesMediaItems(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e MediaItem
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*MediaItem{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, mediaItemDBTypes, false, strmangle.SetComplement(mediaItemPrimaryKeyColumns, mediaItemColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddSeriesMediaItems(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SeriesMediaItems().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveSeriesMediaItems(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SeriesMediaItems().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SeriesID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SeriesID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Series != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Series != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.SeriesMediaItems) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.SeriesMediaItems[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.SeriesMediaItems[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testSeriesToManyAddOpSeriesReviews(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e Review
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Review{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, reviewDBTypes, false, strmangle.SetComplement(reviewPrimaryKeyColumns, reviewColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Review{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSeriesReviews(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.SeriesID) {
+			t.Error("foreign key was wrong value", a.ID, first.SeriesID)
+		}
+		if !queries.Equal(a.ID, second.SeriesID) {
+			t.Error("foreign key was wrong value", a.ID, second.SeriesID)
+		}
+
+		if first.R.Series != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Series != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SeriesReviews[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SeriesReviews[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SeriesReviews().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testSeriesToManySetOpSeriesReviews(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e Review
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Review{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, reviewDBTypes, false, strmangle.SetComplement(reviewPrimaryKeyColumns, reviewColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetSeriesReviews(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SeriesReviews().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetSeriesReviews(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SeriesReviews().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SeriesID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SeriesID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.SeriesID) {
+		t.Error("foreign key was wrong value", a.ID, d.SeriesID)
+	}
+	if !queries.Equal(a.ID, e.SeriesID) {
+		t.Error("foreign key was wrong value", a.ID, e.SeriesID)
+	}
+
+	if b.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Series != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Series != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.SeriesReviews[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.SeriesReviews[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testSeriesToManyRemoveOpSeriesReviews(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e Review
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Review{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, reviewDBTypes, false, strmangle.SetComplement(reviewPrimaryKeyColumns, reviewColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddSeriesReviews(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SeriesReviews().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveSeriesReviews(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SeriesReviews().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SeriesID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SeriesID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Series != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Series != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.SeriesReviews) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.SeriesReviews[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.SeriesReviews[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testSeriesToManyAddOpSeriesScores(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e Score
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Score{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, scoreDBTypes, false, strmangle.SetComplement(scorePrimaryKeyColumns, scoreColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Score{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSeriesScores(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.SeriesID) {
+			t.Error("foreign key was wrong value", a.ID, first.SeriesID)
+		}
+		if !queries.Equal(a.ID, second.SeriesID) {
+			t.Error("foreign key was wrong value", a.ID, second.SeriesID)
+		}
+
+		if first.R.Series != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Series != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SeriesScores[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SeriesScores[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SeriesScores().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testSeriesToManySetOpSeriesScores(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e Score
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Score{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, scoreDBTypes, false, strmangle.SetComplement(scorePrimaryKeyColumns, scoreColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetSeriesScores(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SeriesScores().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetSeriesScores(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SeriesScores().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SeriesID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SeriesID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.SeriesID) {
+		t.Error("foreign key was wrong value", a.ID, d.SeriesID)
+	}
+	if !queries.Equal(a.ID, e.SeriesID) {
+		t.Error("foreign key was wrong value", a.ID, e.SeriesID)
+	}
+
+	if b.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Series != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Series != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.SeriesScores[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.SeriesScores[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testSeriesToManyRemoveOpSeriesScores(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e Score
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Score{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, scoreDBTypes, false, strmangle.SetComplement(scorePrimaryKeyColumns, scoreColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddSeriesScores(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SeriesScores().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveSeriesScores(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SeriesScores().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SeriesID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SeriesID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Series != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Series != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Series != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.SeriesScores) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.SeriesScores[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.SeriesScores[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testSeriesToManyAddOpSeriesSeriesAggregates(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e SeriesAggregate
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, seriesDBTypes, true, seriesColumnsWithDefault...); err != nil {
-		t.Errorf("Unable to randomize Series struct: %s", err)
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*SeriesAggregate{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, seriesAggregateDBTypes, false, strmangle.SetComplement(seriesAggregatePrimaryKeyColumns, seriesAggregateColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
 	}
 
 	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-
-	if err = randomize.Struct(seed, &b, filmDBTypes, false, filmColumnsWithDefault...); err != nil {
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, filmDBTypes, false, filmColumnsWithDefault...); err != nil {
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
 
-	queries.Assign(&b.SeriesID, a.ID)
-	queries.Assign(&c.SeriesID, a.ID)
+	foreignersSplitByInsertion := [][]*SeriesAggregate{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSeriesSeriesAggregates(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.SeriesID {
+			t.Error("foreign key was wrong value", a.ID, first.SeriesID)
+		}
+		if a.ID != second.SeriesID {
+			t.Error("foreign key was wrong value", a.ID, second.SeriesID)
+		}
+
+		if first.R.Series != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Series != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SeriesSeriesAggregates[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SeriesSeriesAggregates[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SeriesSeriesAggregates().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testSeriesToManyAddOpSeriesSeriesFollows(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e SeriesFollow
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*SeriesFollow{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, seriesFollowDBTypes, false, strmangle.SetComplement(seriesFollowPrimaryKeyColumns, seriesFollowColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -528,50 +3535,126 @@ func testSeriesToManySeriesFilms(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.SeriesFilms().All(ctx, tx)
-	if err != nil {
-		t.Fatal(err)
+	foreignersSplitByInsertion := [][]*SeriesFollow{
+		{&b, &c},
+		{&d, &e},
 	}
 
-	bFound, cFound := false, false
-	for _, v := range check {
-		if queries.Equal(v.SeriesID, b.SeriesID) {
-			bFound = true
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSeriesSeriesFollows(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
 		}
-		if queries.Equal(v.SeriesID, c.SeriesID) {
-			cFound = true
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.SeriesID {
+			t.Error("foreign key was wrong value", a.ID, first.SeriesID)
+		}
+		if a.ID != second.SeriesID {
+			t.Error("foreign key was wrong value", a.ID, second.SeriesID)
+		}
+
+		if first.R.Series != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Series != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SeriesSeriesFollows[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SeriesSeriesFollows[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SeriesSeriesFollows().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
 		}
 	}
+}
+func testSeriesToManyAddOpSeriesSeriesScoreAggregates(t *testing.T) {
+	var err error
 
-	if !bFound {
-		t.Error("expected to find b")
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Series
+	var b, c, d, e SeriesScoreAggregate
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
 	}
-	if !cFound {
-		t.Error("expected to find c")
+	foreigners := []*SeriesScoreAggregate{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, seriesScoreAggregateDBTypes, false, strmangle.SetComplement(seriesScoreAggregatePrimaryKeyColumns, seriesScoreAggregateColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
 	}
 
-	slice := SeriesSlice{&a}
-	if err = a.L.LoadSeriesFilms(ctx, tx, false, (*[]*Series)(&slice), nil); err != nil {
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.SeriesFilms); got != 2 {
-		t.Error("number of eager loaded records wrong, got:", got)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
 	}
-
-	a.R.SeriesFilms = nil
-	if err = a.L.LoadSeriesFilms(ctx, tx, true, &a, nil); err != nil {
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.SeriesFilms); got != 2 {
-		t.Error("number of eager loaded records wrong, got:", got)
+
+	foreignersSplitByInsertion := [][]*SeriesScoreAggregate{
+		{&b, &c},
+		{&d, &e},
 	}
 
-	if t.Failed() {
-		t.Logf("%#v", check)
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSeriesSeriesScoreAggregates(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.SeriesID {
+			t.Error("foreign key was wrong value", a.ID, first.SeriesID)
+		}
+		if a.ID != second.SeriesID {
+			t.Error("foreign key was wrong value", a.ID, second.SeriesID)
+		}
+
+		if first.R.Series != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Series != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SeriesSeriesScoreAggregates[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SeriesSeriesScoreAggregates[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SeriesSeriesScoreAggregates().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
 	}
 }
-
-func testSeriesToManyAddOpSeriesFilms(t *testing.T) {
+func testSeriesToManyAddOpSeriesTranslations(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -579,15 +3662,15 @@ func testSeriesToManyAddOpSeriesFilms(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Series
-	var b, c, d, e Film
+	var b, c, d, e Translation
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Film{&b, &c, &d, &e}
+	foreigners := []*Translation{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, translationDBTypes, false, strmangle.SetComplement(translationPrimaryKeyColumns, translationColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -602,13 +3685,13 @@ func testSeriesToManyAddOpSeriesFilms(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Film{
+	foreignersSplitByInsertion := [][]*Translation{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddSeriesFilms(ctx, tx, i != 0, x...)
+		err = a.AddSeriesTranslations(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -630,14 +3713,14 @@ func testSeriesToManyAddOpSeriesFilms(t *testing.T) {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.SeriesFilms[i*2] != first {
+		if a.R.SeriesTranslations[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.SeriesFilms[i*2+1] != second {
+		if a.R.SeriesTranslations[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.SeriesFilms().Count(ctx, tx)
+		count, err := a.SeriesTranslations().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -647,7 +3730,7 @@ func testSeriesToManyAddOpSeriesFilms(t *testing.T) {
 	}
 }
 
-func testSeriesToManySetOpSeriesFilms(t *testing.T) {
+func testSeriesToManySetOpSeriesTranslations(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -655,15 +3738,15 @@ func testSeriesToManySetOpSeriesFilms(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Series
-	var b, c, d, e Film
+	var b, c, d, e Translation
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Film{&b, &c, &d, &e}
+	foreigners := []*Translation{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, translationDBTypes, false, strmangle.SetComplement(translationPrimaryKeyColumns, translationColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -678,12 +3761,12 @@ func testSeriesToManySetOpSeriesFilms(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.SetSeriesFilms(ctx, tx, false, &b, &c)
+	err = a.SetSeriesTranslations(ctx, tx, false, &b, &c)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.SeriesFilms().Count(ctx, tx)
+	count, err := a.SeriesTranslations().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -691,12 +3774,12 @@ func testSeriesToManySetOpSeriesFilms(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.SetSeriesFilms(ctx, tx, true, &d, &e)
+	err = a.SetSeriesTranslations(ctx, tx, true, &d, &e)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.SeriesFilms().Count(ctx, tx)
+	count, err = a.SeriesTranslations().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -730,15 +3813,15 @@ func testSeriesToManySetOpSeriesFilms(t *testing.T) {
 		t.Error("relationship was not added properly to the foreign struct")
 	}
 
-	if a.R.SeriesFilms[0] != &d {
+	if a.R.SeriesTranslations[0] != &d {
 		t.Error("relationship struct slice not set to correct value")
 	}
-	if a.R.SeriesFilms[1] != &e {
+	if a.R.SeriesTranslations[1] != &e {
 		t.Error("relationship struct slice not set to correct value")
 	}
 }
 
-func testSeriesToManyRemoveOpSeriesFilms(t *testing.T) {
+func testSeriesToManyRemoveOpSeriesTranslations(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -746,15 +3829,15 @@ func testSeriesToManyRemoveOpSeriesFilms(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Series
-	var b, c, d, e Film
+	var b, c, d, e Translation
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, seriesDBTypes, false, strmangle.SetComplement(seriesPrimaryKeyColumns, seriesColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Film{&b, &c, &d, &e}
+	foreigners := []*Translation{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, filmDBTypes, false, strmangle.SetComplement(filmPrimaryKeyColumns, filmColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, translationDBTypes, false, strmangle.SetComplement(translationPrimaryKeyColumns, translationColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -763,12 +3846,12 @@ func testSeriesToManyRemoveOpSeriesFilms(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.AddSeriesFilms(ctx, tx, true, foreigners...)
+	err = a.AddSeriesTranslations(ctx, tx, true, foreigners...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.SeriesFilms().Count(ctx, tx)
+	count, err := a.SeriesTranslations().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -776,12 +3859,12 @@ func testSeriesToManyRemoveOpSeriesFilms(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.RemoveSeriesFilms(ctx, tx, foreigners[:2]...)
+	err = a.RemoveSeriesTranslations(ctx, tx, foreigners[:2]...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.SeriesFilms().Count(ctx, tx)
+	count, err = a.SeriesTranslations().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -809,15 +3892,15 @@ func testSeriesToManyRemoveOpSeriesFilms(t *testing.T) {
 		t.Error("relationship to a should have been preserved")
 	}
 
-	if len(a.R.SeriesFilms) != 2 {
+	if len(a.R.SeriesTranslations) != 2 {
 		t.Error("should have preserved two relationships")
 	}
 
 	// Removal doesn't do a stable deletion for performance so we have to flip the order
-	if a.R.SeriesFilms[1] != &d {
+	if a.R.SeriesTranslations[1] != &d {
 		t.Error("relationship to d should have been preserved")
 	}
-	if a.R.SeriesFilms[0] != &e {
+	if a.R.SeriesTranslations[0] != &e {
 		t.Error("relationship to e should have been preserved")
 	}
 }
@@ -1005,7 +4088,7 @@ func testSeriesesSelect(t *testing.T) {
 }
 
 var (
-	seriesDBTypes = map[string]string{`ID`: `integer`, `Title`: `character varying`, `Descriptions`: `character varying`, `DateStarted`: `date`, `DateEnded`: `date`, `Poster`: `character varying`, `ContributedBy`: `integer`, `ContributedAt`: `timestamp with time zone`, `Invalidation`: `character varying`}
+	seriesDBTypes = map[string]string{`ID`: `integer`, `Title`: `character varying`, `Descriptions`: `character varying`, `DateStarted`: `date`, `DateEnded`: `date`, `Poster`: `character varying`, `ContributedBy`: `integer`, `ContributedAt`: `timestamp with time zone`, `Invalidation`: `character varying`, `DeletedAt`: `timestamp with time zone`}
 	_             = bytes.MinRead
 )
 
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testTranslationsAudits(t *testing.T) {
+	t.Parallel()
+
+	query := TranslationsAudits()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testTranslationsAuditsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TranslationsAudit{}
+	if err = randomize.Struct(seed, o, translationsAuditDBTypes, true, translationsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TranslationsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TranslationsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTranslationsAuditsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TranslationsAudit{}
+	if err = randomize.Struct(seed, o, translationsAuditDBTypes, true, translationsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TranslationsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := TranslationsAudits().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TranslationsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTranslationsAuditsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TranslationsAudit{}
+	if err = randomize.Struct(seed, o, translationsAuditDBTypes, true, translationsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TranslationsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TranslationsAuditSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TranslationsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTranslationsAuditsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TranslationsAudit{}
+	if err = randomize.Struct(seed, o, translationsAuditDBTypes, true, translationsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TranslationsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := TranslationsAuditExists(ctx, tx, o.ID, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		t.Errorf("Unable to check if TranslationsAudit exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected TranslationsAuditExists to return true, but got false.")
+	}
+}
+
+func testTranslationsAuditsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TranslationsAudit{}
+	if err = randomize.Struct(seed, o, translationsAuditDBTypes, true, translationsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TranslationsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	translationsAuditFound, err := FindTranslationsAudit(ctx, tx, o.ID, o.ContributedBy, o.ContributedAt)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if translationsAuditFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testTranslationsAuditsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TranslationsAudit{}
+	if err = randomize.Struct(seed, o, translationsAuditDBTypes, true, translationsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TranslationsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = TranslationsAudits().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTranslationsAuditsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TranslationsAudit{}
+	if err = randomize.Struct(seed, o, translationsAuditDBTypes, true, translationsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TranslationsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := TranslationsAudits().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testTranslationsAuditsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	translationsAuditOne := &TranslationsAudit{}
+	translationsAuditTwo := &TranslationsAudit{}
+	if err = randomize.Struct(seed, translationsAuditOne, translationsAuditDBTypes, false, translationsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TranslationsAudit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, translationsAuditTwo, translationsAuditDBTypes, false, translationsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TranslationsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = translationsAuditOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = translationsAuditTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TranslationsAudits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testTranslationsAuditsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	translationsAuditOne := &TranslationsAudit{}
+	translationsAuditTwo := &TranslationsAudit{}
+	if err = randomize.Struct(seed, translationsAuditOne, translationsAuditDBTypes, false, translationsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TranslationsAudit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, translationsAuditTwo, translationsAuditDBTypes, false, translationsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TranslationsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = translationsAuditOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = translationsAuditTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TranslationsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func translationsAuditBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *TranslationsAudit) error {
+	*o = TranslationsAudit{}
+	return nil
+}
+
+func translationsAuditAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *TranslationsAudit) error {
+	*o = TranslationsAudit{}
+	return nil
+}
+
+func translationsAuditAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *TranslationsAudit) error {
+	*o = TranslationsAudit{}
+	return nil
+}
+
+func translationsAuditBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *TranslationsAudit) error {
+	*o = TranslationsAudit{}
+	return nil
+}
+
+func translationsAuditAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *TranslationsAudit) error {
+	*o = TranslationsAudit{}
+	return nil
+}
+
+func translationsAuditBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *TranslationsAudit) error {
+	*o = TranslationsAudit{}
+	return nil
+}
+
+func translationsAuditAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *TranslationsAudit) error {
+	*o = TranslationsAudit{}
+	return nil
+}
+
+func translationsAuditBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *TranslationsAudit) error {
+	*o = TranslationsAudit{}
+	return nil
+}
+
+func translationsAuditAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *TranslationsAudit) error {
+	*o = TranslationsAudit{}
+	return nil
+}
+
+func testTranslationsAuditsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &TranslationsAudit{}
+	o := &TranslationsAudit{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, translationsAuditDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize TranslationsAudit object: %s", err)
+	}
+
+	AddTranslationsAuditHook(boil.BeforeInsertHook, translationsAuditBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	translationsAuditBeforeInsertHooks = []TranslationsAuditHook{}
+
+	AddTranslationsAuditHook(boil.AfterInsertHook, translationsAuditAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	translationsAuditAfterInsertHooks = []TranslationsAuditHook{}
+
+	AddTranslationsAuditHook(boil.AfterSelectHook, translationsAuditAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	translationsAuditAfterSelectHooks = []TranslationsAuditHook{}
+
+	AddTranslationsAuditHook(boil.BeforeUpdateHook, translationsAuditBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	translationsAuditBeforeUpdateHooks = []TranslationsAuditHook{}
+
+	AddTranslationsAuditHook(boil.AfterUpdateHook, translationsAuditAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	translationsAuditAfterUpdateHooks = []TranslationsAuditHook{}
+
+	AddTranslationsAuditHook(boil.BeforeDeleteHook, translationsAuditBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	translationsAuditBeforeDeleteHooks = []TranslationsAuditHook{}
+
+	AddTranslationsAuditHook(boil.AfterDeleteHook, translationsAuditAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	translationsAuditAfterDeleteHooks = []TranslationsAuditHook{}
+
+	AddTranslationsAuditHook(boil.BeforeUpsertHook, translationsAuditBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	translationsAuditBeforeUpsertHooks = []TranslationsAuditHook{}
+
+	AddTranslationsAuditHook(boil.AfterUpsertHook, translationsAuditAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	translationsAuditAfterUpsertHooks = []TranslationsAuditHook{}
+}
+
+func testTranslationsAuditsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TranslationsAudit{}
+	if err = randomize.Struct(seed, o, translationsAuditDBTypes, true, translationsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TranslationsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TranslationsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTranslationsAuditsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TranslationsAudit{}
+	if err = randomize.Struct(seed, o, translationsAuditDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize TranslationsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(translationsAuditColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TranslationsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTranslationsAuditsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TranslationsAudit{}
+	if err = randomize.Struct(seed, o, translationsAuditDBTypes, true, translationsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TranslationsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTranslationsAuditsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TranslationsAudit{}
+	if err = randomize.Struct(seed, o, translationsAuditDBTypes, true, translationsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TranslationsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TranslationsAuditSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTranslationsAuditsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TranslationsAudit{}
+	if err = randomize.Struct(seed, o, translationsAuditDBTypes, true, translationsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TranslationsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TranslationsAudits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	translationsAuditDBTypes = map[string]string{`ID`: `integer`, `FilmID`: `integer`, `SeriesID`: `integer`, `Language`: `character varying`, `Title`: `character varying`, `Descriptions`: `character varying`, `ContributedBy`: `integer`, `ContributedAt`: `timestamp with time zone`, `Invalidation`: `character varying`, `AuditAction`: `character varying`, `AuditActor`: `integer`}
+	_                        = bytes.MinRead
+)
+
+func testTranslationsAuditsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(translationsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(translationsAuditAllColumns) == len(translationsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TranslationsAudit{}
+	if err = randomize.Struct(seed, o, translationsAuditDBTypes, true, translationsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TranslationsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TranslationsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, translationsAuditDBTypes, true, translationsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TranslationsAudit struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testTranslationsAuditsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(translationsAuditAllColumns) == len(translationsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TranslationsAudit{}
+	if err = randomize.Struct(seed, o, translationsAuditDBTypes, true, translationsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TranslationsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TranslationsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, translationsAuditDBTypes, true, translationsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TranslationsAudit struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(translationsAuditAllColumns, translationsAuditPrimaryKeyColumns) {
+		fields = translationsAuditAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			translationsAuditAllColumns,
+			translationsAuditPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := TranslationsAuditSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testTranslationsAuditsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(translationsAuditAllColumns) == len(translationsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := TranslationsAudit{}
+	if err = randomize.Struct(seed, &o, translationsAuditDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize TranslationsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TranslationsAudit: %s", err)
+	}
+
+	count, err := TranslationsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, translationsAuditDBTypes, false, translationsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TranslationsAudit struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TranslationsAudit: %s", err)
+	}
+
+	count, err = TranslationsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -33,6 +33,8 @@ type User struct {
 	Birthdate    null.Time   `db:"birthdate" boil:"birthdate" json:"birthdate,omitempty" toml:"birthdate" yaml:"birthdate,omitempty"`
 	Jointime     time.Time   `db:"jointime" boil:"jointime" json:"jointime" toml:"jointime" yaml:"jointime"`
 	Avatar       null.String `db:"avatar" boil:"avatar" json:"avatar,omitempty" toml:"avatar" yaml:"avatar,omitempty"`
+	Locale       null.String `db:"locale" boil:"locale" json:"locale,omitempty" toml:"locale" yaml:"locale,omitempty"`
+	Moderator    bool        `db:"moderator" boil:"moderator" json:"moderator" toml:"moderator" yaml:"moderator"`
 
 	R *userR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -48,6 +50,8 @@ var UserColumns = struct {
 	Birthdate    string
 	Jointime     string
 	Avatar       string
+	Locale       string
+	Moderator    string
 }{
 	ID:           "id",
 	Email:        "email",
@@ -58,6 +62,8 @@ var UserColumns = struct {
 	Birthdate:    "birthdate",
 	Jointime:     "jointime",
 	Avatar:       "avatar",
+	Locale:       "locale",
+	Moderator:    "moderator",
 }
 
 var UserTableColumns = struct {
@@ -70,6 +76,8 @@ var UserTableColumns = struct {
 	Birthdate    string
 	Jointime     string
 	Avatar       string
+	Locale       string
+	Moderator    string
 }{
 	ID:           "users.id",
 	Email:        "users.email",
@@ -80,6 +88,8 @@ var UserTableColumns = struct {
 	Birthdate:    "users.birthdate",
 	Jointime:     "users.jointime",
 	Avatar:       "users.avatar",
+	Locale:       "users.locale",
+	Moderator:    "users.moderator",
 }
 
 // Generated where
@@ -94,6 +104,8 @@ var UserWhere = struct {
 	Birthdate    whereHelpernull_Time
 	Jointime     whereHelpertime_Time
 	Avatar       whereHelpernull_String
+	Locale       whereHelpernull_String
+	Moderator    whereHelperbool
 }{
 	ID:           whereHelperint{field: "\"users\".\"id\""},
 	Email:        whereHelperstring{field: "\"users\".\"email\""},
@@ -104,27 +116,98 @@ var UserWhere = struct {
 	Birthdate:    whereHelpernull_Time{field: "\"users\".\"birthdate\""},
 	Jointime:     whereHelpertime_Time{field: "\"users\".\"jointime\""},
 	Avatar:       whereHelpernull_String{field: "\"users\".\"avatar\""},
+	Locale:       whereHelpernull_String{field: "\"users\".\"locale\""},
+	Moderator:    whereHelperbool{field: "\"users\".\"moderator\""},
 }
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	ContributedFilms    string
-	ContributedSerieses string
-	Tokens              string
-	Watchfilms          string
+	ContributedCollectionItems string
+	ContributedCollections     string
+	ContributedContentRatings  string
+	Contributions              string
+	ContributorStats           string
+	ContributedExternalIds     string
+	ContributedFilms           string
+	ImportJobs                 string
+	ListActivities             string
+	MemberListActivities       string
+	ListInvites                string
+	InvitedByListInvites       string
+	AddedByListItems           string
+	ListMembers                string
+	Lists                      string
+	ContributedMediaItems      string
+	PlaybackProgresses         string
+	ContributedReleases        string
+	ReviewVotes                string
+	Reviews                    string
+	Scores                     string
+	SeriesFollows              string
+	ContributedSerieses        string
+	Tokens                     string
+	ContributedTranslations    string
+	Watchfilms                 string
+	YearReviews                string
 }{
-	ContributedFilms:    "ContributedFilms",
-	ContributedSerieses: "ContributedSerieses",
-	Tokens:              "Tokens",
-	Watchfilms:          "Watchfilms",
+	ContributedCollectionItems: "ContributedCollectionItems",
+	ContributedCollections:     "ContributedCollections",
+	ContributedContentRatings:  "ContributedContentRatings",
+	Contributions:              "Contributions",
+	ContributorStats:           "ContributorStats",
+	ContributedExternalIds:     "ContributedExternalIds",
+	ContributedFilms:           "ContributedFilms",
+	ImportJobs:                 "ImportJobs",
+	ListActivities:             "ListActivities",
+	MemberListActivities:       "MemberListActivities",
+	ListInvites:                "ListInvites",
+	InvitedByListInvites:       "InvitedByListInvites",
+	AddedByListItems:           "AddedByListItems",
+	ListMembers:                "ListMembers",
+	Lists:                      "Lists",
+	ContributedMediaItems:      "ContributedMediaItems",
+	PlaybackProgresses:         "PlaybackProgresses",
+	ContributedReleases:        "ContributedReleases",
+	ReviewVotes:                "ReviewVotes",
+	Reviews:                    "Reviews",
+	Scores:                     "Scores",
+	SeriesFollows:              "SeriesFollows",
+	ContributedSerieses:        "ContributedSerieses",
+	Tokens:                     "Tokens",
+	ContributedTranslations:    "ContributedTranslations",
+	Watchfilms:                 "Watchfilms",
+	YearReviews:                "YearReviews",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	ContributedFilms    FilmSlice      `db:"ContributedFilms" boil:"ContributedFilms" json:"ContributedFilms" toml:"ContributedFilms" yaml:"ContributedFilms"`
-	ContributedSerieses SeriesSlice    `db:"ContributedSerieses" boil:"ContributedSerieses" json:"ContributedSerieses" toml:"ContributedSerieses" yaml:"ContributedSerieses"`
-	Tokens              TokenSlice     `db:"Tokens" boil:"Tokens" json:"Tokens" toml:"Tokens" yaml:"Tokens"`
-	Watchfilms          WatchfilmSlice `db:"Watchfilms" boil:"Watchfilms" json:"Watchfilms" toml:"Watchfilms" yaml:"Watchfilms"`
+	ContributedCollectionItems CollectionItemSlice   `db:"ContributedCollectionItems" boil:"ContributedCollectionItems" json:"ContributedCollectionItems" toml:"ContributedCollectionItems" yaml:"ContributedCollectionItems"`
+	ContributedCollections     CollectionSlice       `db:"ContributedCollections" boil:"ContributedCollections" json:"ContributedCollections" toml:"ContributedCollections" yaml:"ContributedCollections"`
+	ContributedContentRatings  ContentRatingSlice    `db:"ContributedContentRatings" boil:"ContributedContentRatings" json:"ContributedContentRatings" toml:"ContributedContentRatings" yaml:"ContributedContentRatings"`
+	Contributions              ContributionSlice     `db:"Contributions" boil:"Contributions" json:"Contributions" toml:"Contributions" yaml:"Contributions"`
+	ContributorStats           ContributorStatSlice  `db:"ContributorStats" boil:"ContributorStats" json:"ContributorStats" toml:"ContributorStats" yaml:"ContributorStats"`
+	ContributedExternalIds     ExternalIDSlice       `db:"ContributedExternalIds" boil:"ContributedExternalIds" json:"ContributedExternalIds" toml:"ContributedExternalIds" yaml:"ContributedExternalIds"`
+	ContributedFilms           FilmSlice             `db:"ContributedFilms" boil:"ContributedFilms" json:"ContributedFilms" toml:"ContributedFilms" yaml:"ContributedFilms"`
+	ImportJobs                 ImportJobSlice        `db:"ImportJobs" boil:"ImportJobs" json:"ImportJobs" toml:"ImportJobs" yaml:"ImportJobs"`
+	ListActivities             ListActivitySlice     `db:"ListActivities" boil:"ListActivities" json:"ListActivities" toml:"ListActivities" yaml:"ListActivities"`
+	MemberListActivities       ListActivitySlice     `db:"MemberListActivities" boil:"MemberListActivities" json:"MemberListActivities" toml:"MemberListActivities" yaml:"MemberListActivities"`
+	ListInvites                ListInviteSlice       `db:"ListInvites" boil:"ListInvites" json:"ListInvites" toml:"ListInvites" yaml:"ListInvites"`
+	InvitedByListInvites       ListInviteSlice       `db:"InvitedByListInvites" boil:"InvitedByListInvites" json:"InvitedByListInvites" toml:"InvitedByListInvites" yaml:"InvitedByListInvites"`
+	AddedByListItems           ListItemSlice         `db:"AddedByListItems" boil:"AddedByListItems" json:"AddedByListItems" toml:"AddedByListItems" yaml:"AddedByListItems"`
+	ListMembers                ListMemberSlice       `db:"ListMembers" boil:"ListMembers" json:"ListMembers" toml:"ListMembers" yaml:"ListMembers"`
+	Lists                      ListSlice             `db:"Lists" boil:"Lists" json:"Lists" toml:"Lists" yaml:"Lists"`
+	ContributedMediaItems      MediaItemSlice        `db:"ContributedMediaItems" boil:"ContributedMediaItems" json:"ContributedMediaItems" toml:"ContributedMediaItems" yaml:"ContributedMediaItems"`
+	PlaybackProgresses         PlaybackProgressSlice `db:"PlaybackProgresses" boil:"PlaybackProgresses" json:"PlaybackProgresses" toml:"PlaybackProgresses" yaml:"PlaybackProgresses"`
+	ContributedReleases        ReleaseSlice          `db:"ContributedReleases" boil:"ContributedReleases" json:"ContributedReleases" toml:"ContributedReleases" yaml:"ContributedReleases"`
+	ReviewVotes                ReviewVoteSlice       `db:"ReviewVotes" boil:"ReviewVotes" json:"ReviewVotes" toml:"ReviewVotes" yaml:"ReviewVotes"`
+	Reviews                    ReviewSlice           `db:"Reviews" boil:"Reviews" json:"Reviews" toml:"Reviews" yaml:"Reviews"`
+	Scores                     ScoreSlice            `db:"Scores" boil:"Scores" json:"Scores" toml:"Scores" yaml:"Scores"`
+	SeriesFollows              SeriesFollowSlice     `db:"SeriesFollows" boil:"SeriesFollows" json:"SeriesFollows" toml:"SeriesFollows" yaml:"SeriesFollows"`
+	ContributedSerieses        SeriesSlice           `db:"ContributedSerieses" boil:"ContributedSerieses" json:"ContributedSerieses" toml:"ContributedSerieses" yaml:"ContributedSerieses"`
+	Tokens                     TokenSlice            `db:"Tokens" boil:"Tokens" json:"Tokens" toml:"Tokens" yaml:"Tokens"`
+	ContributedTranslations    TranslationSlice      `db:"ContributedTranslations" boil:"ContributedTranslations" json:"ContributedTranslations" toml:"ContributedTranslations" yaml:"ContributedTranslations"`
+	Watchfilms                 WatchfilmSlice        `db:"Watchfilms" boil:"Watchfilms" json:"Watchfilms" toml:"Watchfilms" yaml:"Watchfilms"`
+	YearReviews                YearReviewSlice       `db:"YearReviews" boil:"YearReviews" json:"YearReviews" toml:"YearReviews" yaml:"YearReviews"`
 }
 
 // NewStruct creates a new relationship struct
@@ -132,6 +215,48 @@ func (*userR) NewStruct() *userR {
 	return &userR{}
 }
 
+func (r *userR) GetContributedCollectionItems() CollectionItemSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ContributedCollectionItems
+}
+
+func (r *userR) GetContributedCollections() CollectionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ContributedCollections
+}
+
+func (r *userR) GetContributedContentRatings() ContentRatingSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ContributedContentRatings
+}
+
+func (r *userR) GetContributions() ContributionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Contributions
+}
+
+func (r *userR) GetContributorStats() ContributorStatSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ContributorStats
+}
+
+func (r *userR) GetContributedExternalIds() ExternalIDSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ContributedExternalIds
+}
+
 func (r *userR) GetContributedFilms() FilmSlice {
 	if r == nil {
 		return nil
@@ -139,6 +264,111 @@ func (r *userR) GetContributedFilms() FilmSlice {
 	return r.ContributedFilms
 }
 
+func (r *userR) GetImportJobs() ImportJobSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ImportJobs
+}
+
+func (r *userR) GetListActivities() ListActivitySlice {
+	if r == nil {
+		return nil
+	}
+	return r.ListActivities
+}
+
+func (r *userR) GetMemberListActivities() ListActivitySlice {
+	if r == nil {
+		return nil
+	}
+	return r.MemberListActivities
+}
+
+func (r *userR) GetListInvites() ListInviteSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ListInvites
+}
+
+func (r *userR) GetInvitedByListInvites() ListInviteSlice {
+	if r == nil {
+		return nil
+	}
+	return r.InvitedByListInvites
+}
+
+func (r *userR) GetAddedByListItems() ListItemSlice {
+	if r == nil {
+		return nil
+	}
+	return r.AddedByListItems
+}
+
+func (r *userR) GetListMembers() ListMemberSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ListMembers
+}
+
+func (r *userR) GetLists() ListSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Lists
+}
+
+func (r *userR) GetContributedMediaItems() MediaItemSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ContributedMediaItems
+}
+
+func (r *userR) GetPlaybackProgresses() PlaybackProgressSlice {
+	if r == nil {
+		return nil
+	}
+	return r.PlaybackProgresses
+}
+
+func (r *userR) GetContributedReleases() ReleaseSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ContributedReleases
+}
+
+func (r *userR) GetReviewVotes() ReviewVoteSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ReviewVotes
+}
+
+func (r *userR) GetReviews() ReviewSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Reviews
+}
+
+func (r *userR) GetScores() ScoreSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Scores
+}
+
+func (r *userR) GetSeriesFollows() SeriesFollowSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SeriesFollows
+}
+
 func (r *userR) GetContributedSerieses() SeriesSlice {
 	if r == nil {
 		return nil
@@ -153,6 +383,13 @@ func (r *userR) GetTokens() TokenSlice {
 	return r.Tokens
 }
 
+func (r *userR) GetContributedTranslations() TranslationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ContributedTranslations
+}
+
 func (r *userR) GetWatchfilms() WatchfilmSlice {
 	if r == nil {
 		return nil
@@ -160,13 +397,20 @@ func (r *userR) GetWatchfilms() WatchfilmSlice {
 	return r.Watchfilms
 }
 
+func (r *userR) GetYearReviews() YearReviewSlice {
+	if r == nil {
+		return nil
+	}
+	return r.YearReviews
+}
+
 // userL is where Load methods for each relationship are stored.
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "email", "password_hash", "first_name", "last_name", "bio", "birthdate", "jointime", "avatar"}
+	userAllColumns            = []string{"id", "email", "password_hash", "first_name", "last_name", "bio", "birthdate", "jointime", "avatar", "locale", "moderator"}
 	userColumnsWithoutDefault = []string{"email", "password_hash"}
-	userColumnsWithDefault    = []string{"id", "first_name", "last_name", "bio", "birthdate", "jointime", "avatar"}
+	userColumnsWithDefault    = []string{"id", "first_name", "last_name", "bio", "birthdate", "jointime", "avatar", "locale", "moderator"}
 	userPrimaryKeyColumns     = []string{"id"}
 	userGeneratedColumns      = []string{}
 )
@@ -449,179 +693,387 @@ func (q userQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
-// ContributedFilms retrieves all the film's Films with an executor via contributed_by column.
-func (o *User) ContributedFilms(mods ...qm.QueryMod) filmQuery {
+// ContributedCollectionItems retrieves all the collection_item's CollectionItems with an executor via contributed_by column.
+func (o *User) ContributedCollectionItems(mods ...qm.QueryMod) collectionItemQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"films\".\"contributed_by\"=?", o.ID),
+		qm.Where("\"collection_items\".\"contributed_by\"=?", o.ID),
 	)
 
-	return Films(queryMods...)
+	return CollectionItems(queryMods...)
 }
 
-// ContributedSerieses retrieves all the seriese's Serieses with an executor via contributed_by column.
-func (o *User) ContributedSerieses(mods ...qm.QueryMod) seriesQuery {
+// ContributedCollections retrieves all the collection's Collections with an executor via contributed_by column.
+func (o *User) ContributedCollections(mods ...qm.QueryMod) collectionQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"serieses\".\"contributed_by\"=?", o.ID),
+		qm.Where("\"collections\".\"contributed_by\"=?", o.ID),
 	)
 
-	return Serieses(queryMods...)
+	return Collections(queryMods...)
 }
 
-// Tokens retrieves all the token's Tokens with an executor.
-func (o *User) Tokens(mods ...qm.QueryMod) tokenQuery {
+// ContributedContentRatings retrieves all the content_rating's ContentRatings with an executor via contributed_by column.
+func (o *User) ContributedContentRatings(mods ...qm.QueryMod) contentRatingQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"tokens\".\"user_id\"=?", o.ID),
+		qm.Where("\"content_ratings\".\"contributed_by\"=?", o.ID),
 	)
 
-	return Tokens(queryMods...)
+	return ContentRatings(queryMods...)
 }
 
-// Watchfilms retrieves all the watchfilm's Watchfilms with an executor.
-func (o *User) Watchfilms(mods ...qm.QueryMod) watchfilmQuery {
+// Contributions retrieves all the contribution's Contributions with an executor.
+func (o *User) Contributions(mods ...qm.QueryMod) contributionQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"watchfilms\".\"user_id\"=?", o.ID),
+		qm.Where("\"contributions\".\"user_id\"=?", o.ID),
 	)
 
-	return Watchfilms(queryMods...)
+	return Contributions(queryMods...)
 }
 
-// LoadContributedFilms allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadContributedFilms(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		var ok bool
-		object, ok = maybeUser.(*User)
-		if !ok {
-			object = new(User)
-			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
-			}
-		}
-	} else {
-		s, ok := maybeUser.(*[]*User)
-		if ok {
-			slice = *s
-		} else {
-			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
-			}
-		}
+// ContributorStats retrieves all the contributor_stat's ContributorStats with an executor.
+func (o *User) ContributorStats(mods ...qm.QueryMod) contributorStatQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
-		}
-		args = append(args, object.ID)
-	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
-			}
+	queryMods = append(queryMods,
+		qm.Where("\"contributor_stats\".\"user_id\"=?", o.ID),
+	)
 
-			for _, a := range args {
-				if a == obj.ID {
-					continue Outer
-				}
-			}
+	return ContributorStats(queryMods...)
+}
 
-			args = append(args, obj.ID)
-		}
+// ContributedExternalIds retrieves all the external_id's ExternalIds with an executor via contributed_by column.
+func (o *User) ContributedExternalIds(mods ...qm.QueryMod) externalIDQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	if len(args) == 0 {
-		return nil
+	queryMods = append(queryMods,
+		qm.Where("\"external_ids\".\"contributed_by\"=?", o.ID),
+	)
+
+	return ExternalIds(queryMods...)
+}
+
+// ContributedFilms retrieves all the film's Films with an executor via contributed_by column.
+func (o *User) ContributedFilms(mods ...qm.QueryMod) filmQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	query := NewQuery(
-		qm.From(`films`),
-		qm.WhereIn(`films.contributed_by in ?`, args...),
+	queryMods = append(queryMods,
+		qm.Where("\"films\".\"contributed_by\"=?", o.ID),
 	)
-	if mods != nil {
-		mods.Apply(query)
+
+	return Films(queryMods...)
+}
+
+// ImportJobs retrieves all the import_job's ImportJobs with an executor.
+func (o *User) ImportJobs(mods ...qm.QueryMod) importJobQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load films")
+	queryMods = append(queryMods,
+		qm.Where("\"import_jobs\".\"user_id\"=?", o.ID),
+	)
+
+	return ImportJobs(queryMods...)
+}
+
+// ListActivities retrieves all the list_activity's ListActivities with an executor.
+func (o *User) ListActivities(mods ...qm.QueryMod) listActivityQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	var resultSlice []*Film
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice films")
+	queryMods = append(queryMods,
+		qm.Where("\"list_activities\".\"user_id\"=?", o.ID),
+	)
+
+	return ListActivities(queryMods...)
+}
+
+// MemberListActivities retrieves all the list_activity's ListActivities with an executor via member_id column.
+func (o *User) MemberListActivities(mods ...qm.QueryMod) listActivityQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on films")
+	queryMods = append(queryMods,
+		qm.Where("\"list_activities\".\"member_id\"=?", o.ID),
+	)
+
+	return ListActivities(queryMods...)
+}
+
+// ListInvites retrieves all the list_invite's ListInvites with an executor.
+func (o *User) ListInvites(mods ...qm.QueryMod) listInviteQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for films")
+
+	queryMods = append(queryMods,
+		qm.Where("\"list_invites\".\"user_id\"=?", o.ID),
+	)
+
+	return ListInvites(queryMods...)
+}
+
+// InvitedByListInvites retrieves all the list_invite's ListInvites with an executor via invited_by column.
+func (o *User) InvitedByListInvites(mods ...qm.QueryMod) listInviteQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	if len(filmAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
-				return err
-			}
-		}
+	queryMods = append(queryMods,
+		qm.Where("\"list_invites\".\"invited_by\"=?", o.ID),
+	)
+
+	return ListInvites(queryMods...)
+}
+
+// AddedByListItems retrieves all the list_item's ListItems with an executor via added_by column.
+func (o *User) AddedByListItems(mods ...qm.QueryMod) listItemQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
-	if singular {
-		object.R.ContributedFilms = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &filmR{}
-			}
-			foreign.R.ContributingUser = object
-		}
-		return nil
+
+	queryMods = append(queryMods,
+		qm.Where("\"list_items\".\"added_by\"=?", o.ID),
+	)
+
+	return ListItems(queryMods...)
+}
+
+// ListMembers retrieves all the list_member's ListMembers with an executor.
+func (o *User) ListMembers(mods ...qm.QueryMod) listMemberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.ContributedBy {
-				local.R.ContributedFilms = append(local.R.ContributedFilms, foreign)
-				if foreign.R == nil {
-					foreign.R = &filmR{}
-				}
-				foreign.R.ContributingUser = local
-				break
-			}
-		}
+	queryMods = append(queryMods,
+		qm.Where("\"list_members\".\"user_id\"=?", o.ID),
+	)
+
+	return ListMembers(queryMods...)
+}
+
+// Lists retrieves all the list's Lists with an executor.
+func (o *User) Lists(mods ...qm.QueryMod) listQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	return nil
+	queryMods = append(queryMods,
+		qm.Where("\"lists\".\"user_id\"=?", o.ID),
+	)
+
+	return Lists(queryMods...)
 }
 
-// LoadContributedSerieses allows an eager lookup of values, cached into the
+// ContributedMediaItems retrieves all the media_item's MediaItems with an executor via contributed_by column.
+func (o *User) ContributedMediaItems(mods ...qm.QueryMod) mediaItemQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"media_items\".\"contributed_by\"=?", o.ID),
+	)
+
+	return MediaItems(queryMods...)
+}
+
+// PlaybackProgresses retrieves all the playback_progress's PlaybackProgresses with an executor.
+func (o *User) PlaybackProgresses(mods ...qm.QueryMod) playbackProgressQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"playback_progress\".\"user_id\"=?", o.ID),
+	)
+
+	return PlaybackProgresses(queryMods...)
+}
+
+// ContributedReleases retrieves all the release's Releases with an executor via contributed_by column.
+func (o *User) ContributedReleases(mods ...qm.QueryMod) releaseQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"releases\".\"contributed_by\"=?", o.ID),
+	)
+
+	return Releases(queryMods...)
+}
+
+// ReviewVotes retrieves all the review_vote's ReviewVotes with an executor.
+func (o *User) ReviewVotes(mods ...qm.QueryMod) reviewVoteQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"review_votes\".\"user_id\"=?", o.ID),
+	)
+
+	return ReviewVotes(queryMods...)
+}
+
+// Reviews retrieves all the review's Reviews with an executor.
+func (o *User) Reviews(mods ...qm.QueryMod) reviewQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"reviews\".\"user_id\"=?", o.ID),
+	)
+
+	return Reviews(queryMods...)
+}
+
+// Scores retrieves all the score's Scores with an executor.
+func (o *User) Scores(mods ...qm.QueryMod) scoreQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"scores\".\"user_id\"=?", o.ID),
+	)
+
+	return Scores(queryMods...)
+}
+
+// SeriesFollows retrieves all the series_follow's SeriesFollows with an executor.
+func (o *User) SeriesFollows(mods ...qm.QueryMod) seriesFollowQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"series_follows\".\"user_id\"=?", o.ID),
+	)
+
+	return SeriesFollows(queryMods...)
+}
+
+// ContributedSerieses retrieves all the seriese's Serieses with an executor via contributed_by column.
+func (o *User) ContributedSerieses(mods ...qm.QueryMod) seriesQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"serieses\".\"contributed_by\"=?", o.ID),
+	)
+
+	return Serieses(queryMods...)
+}
+
+// Tokens retrieves all the token's Tokens with an executor.
+func (o *User) Tokens(mods ...qm.QueryMod) tokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"tokens\".\"user_id\"=?", o.ID),
+	)
+
+	return Tokens(queryMods...)
+}
+
+// ContributedTranslations retrieves all the translation's Translations with an executor via contributed_by column.
+func (o *User) ContributedTranslations(mods ...qm.QueryMod) translationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"translations\".\"contributed_by\"=?", o.ID),
+	)
+
+	return Translations(queryMods...)
+}
+
+// Watchfilms retrieves all the watchfilm's Watchfilms with an executor.
+func (o *User) Watchfilms(mods ...qm.QueryMod) watchfilmQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"watchfilms\".\"user_id\"=?", o.ID),
+	)
+
+	return Watchfilms(queryMods...)
+}
+
+// YearReviews retrieves all the year_review's YearReviews with an executor.
+func (o *User) YearReviews(mods ...qm.QueryMod) yearReviewQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"year_reviews\".\"user_id\"=?", o.ID),
+	)
+
+	return YearReviews(queryMods...)
+}
+
+// LoadContributedCollectionItems allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadContributedSerieses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadContributedCollectionItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -675,8 +1127,8 @@ func (userL) LoadContributedSerieses(ctx context.Context, e boil.ContextExecutor
 	}
 
 	query := NewQuery(
-		qm.From(`serieses`),
-		qm.WhereIn(`serieses.contributed_by in ?`, args...),
+		qm.From(`collection_items`),
+		qm.WhereIn(`collection_items.contributed_by in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -684,22 +1136,22 @@ func (userL) LoadContributedSerieses(ctx context.Context, e boil.ContextExecutor
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load serieses")
+		return errors.Wrap(err, "failed to eager load collection_items")
 	}
 
-	var resultSlice []*Series
+	var resultSlice []*CollectionItem
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice serieses")
+		return errors.Wrap(err, "failed to bind eager loaded slice collection_items")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on serieses")
+		return errors.Wrap(err, "failed to close results in eager load on collection_items")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for serieses")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for collection_items")
 	}
 
-	if len(seriesAfterSelectHooks) != 0 {
+	if len(collectionItemAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -707,10 +1159,10 @@ func (userL) LoadContributedSerieses(ctx context.Context, e boil.ContextExecutor
 		}
 	}
 	if singular {
-		object.R.ContributedSerieses = resultSlice
+		object.R.ContributedCollectionItems = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &seriesR{}
+				foreign.R = &collectionItemR{}
 			}
 			foreign.R.ContributingUser = object
 		}
@@ -720,9 +1172,9 @@ func (userL) LoadContributedSerieses(ctx context.Context, e boil.ContextExecutor
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.ContributedBy {
-				local.R.ContributedSerieses = append(local.R.ContributedSerieses, foreign)
+				local.R.ContributedCollectionItems = append(local.R.ContributedCollectionItems, foreign)
 				if foreign.R == nil {
-					foreign.R = &seriesR{}
+					foreign.R = &collectionItemR{}
 				}
 				foreign.R.ContributingUser = local
 				break
@@ -733,9 +1185,9 @@ func (userL) LoadContributedSerieses(ctx context.Context, e boil.ContextExecutor
 	return nil
 }
 
-// LoadTokens allows an eager lookup of values, cached into the
+// LoadContributedCollections allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadContributedCollections(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -789,8 +1241,8 @@ func (userL) LoadTokens(ctx context.Context, e boil.ContextExecutor, singular bo
 	}
 
 	query := NewQuery(
-		qm.From(`tokens`),
-		qm.WhereIn(`tokens.user_id in ?`, args...),
+		qm.From(`collections`),
+		qm.WhereIn(`collections.contributed_by in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -798,22 +1250,22 @@ func (userL) LoadTokens(ctx context.Context, e boil.ContextExecutor, singular bo
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load tokens")
+		return errors.Wrap(err, "failed to eager load collections")
 	}
 
-	var resultSlice []*Token
+	var resultSlice []*Collection
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice tokens")
+		return errors.Wrap(err, "failed to bind eager loaded slice collections")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on tokens")
+		return errors.Wrap(err, "failed to close results in eager load on collections")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tokens")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for collections")
 	}
 
-	if len(tokenAfterSelectHooks) != 0 {
+	if len(collectionAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -821,24 +1273,24 @@ func (userL) LoadTokens(ctx context.Context, e boil.ContextExecutor, singular bo
 		}
 	}
 	if singular {
-		object.R.Tokens = resultSlice
+		object.R.ContributedCollections = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &tokenR{}
+				foreign.R = &collectionR{}
 			}
-			foreign.R.User = object
+			foreign.R.ContributingUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.Tokens = append(local.R.Tokens, foreign)
+			if local.ID == foreign.ContributedBy {
+				local.R.ContributedCollections = append(local.R.ContributedCollections, foreign)
 				if foreign.R == nil {
-					foreign.R = &tokenR{}
+					foreign.R = &collectionR{}
 				}
-				foreign.R.User = local
+				foreign.R.ContributingUser = local
 				break
 			}
 		}
@@ -847,9 +1299,9 @@ func (userL) LoadTokens(ctx context.Context, e boil.ContextExecutor, singular bo
 	return nil
 }
 
-// LoadWatchfilms allows an eager lookup of values, cached into the
+// LoadContributedContentRatings allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadWatchfilms(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadContributedContentRatings(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -903,8 +1355,8 @@ func (userL) LoadWatchfilms(ctx context.Context, e boil.ContextExecutor, singula
 	}
 
 	query := NewQuery(
-		qm.From(`watchfilms`),
-		qm.WhereIn(`watchfilms.user_id in ?`, args...),
+		qm.From(`content_ratings`),
+		qm.WhereIn(`content_ratings.contributed_by in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -912,22 +1364,22 @@ func (userL) LoadWatchfilms(ctx context.Context, e boil.ContextExecutor, singula
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load watchfilms")
+		return errors.Wrap(err, "failed to eager load content_ratings")
 	}
 
-	var resultSlice []*Watchfilm
+	var resultSlice []*ContentRating
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice watchfilms")
+		return errors.Wrap(err, "failed to bind eager loaded slice content_ratings")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on watchfilms")
+		return errors.Wrap(err, "failed to close results in eager load on content_ratings")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for watchfilms")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for content_ratings")
 	}
 
-	if len(watchfilmAfterSelectHooks) != 0 {
+	if len(contentRatingAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -935,24 +1387,24 @@ func (userL) LoadWatchfilms(ctx context.Context, e boil.ContextExecutor, singula
 		}
 	}
 	if singular {
-		object.R.Watchfilms = resultSlice
+		object.R.ContributedContentRatings = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &watchfilmR{}
+				foreign.R = &contentRatingR{}
 			}
-			foreign.R.User = object
+			foreign.R.ContributingUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.Watchfilms = append(local.R.Watchfilms, foreign)
+			if local.ID == foreign.ContributedBy {
+				local.R.ContributedContentRatings = append(local.R.ContributedContentRatings, foreign)
 				if foreign.R == nil {
-					foreign.R = &watchfilmR{}
+					foreign.R = &contentRatingR{}
 				}
-				foreign.R.User = local
+				foreign.R.ContributingUser = local
 				break
 			}
 		}
@@ -961,23 +1413,4210 @@ func (userL) LoadWatchfilms(ctx context.Context, e boil.ContextExecutor, singula
 	return nil
 }
 
-// AddContributedFilms adds the given related objects to the existing relationships
-// of the user, optionally inserting them as new records.
-// Appends related to o.R.ContributedFilms.
-// Sets related.R.ContributingUser appropriately.
-func (o *User) AddContributedFilms(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Film) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.ContributedBy = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"films\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
-				strmangle.WhereClause("\"", "\"", 2, filmPrimaryKeyColumns),
+// LoadContributions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadContributions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`contributions`),
+		qm.WhereIn(`contributions.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load contributions")
+	}
+
+	var resultSlice []*Contribution
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice contributions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on contributions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for contributions")
+	}
+
+	if len(contributionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Contributions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &contributionR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UserID) {
+				local.R.Contributions = append(local.R.Contributions, foreign)
+				if foreign.R == nil {
+					foreign.R = &contributionR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadContributorStats allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadContributorStats(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`contributor_stats`),
+		qm.WhereIn(`contributor_stats.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load contributor_stats")
+	}
+
+	var resultSlice []*ContributorStat
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice contributor_stats")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on contributor_stats")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for contributor_stats")
+	}
+
+	if len(contributorStatAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ContributorStats = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &contributorStatR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.ContributorStats = append(local.R.ContributorStats, foreign)
+				if foreign.R == nil {
+					foreign.R = &contributorStatR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadContributedExternalIds allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadContributedExternalIds(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`external_ids`),
+		qm.WhereIn(`external_ids.contributed_by in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load external_ids")
+	}
+
+	var resultSlice []*ExternalID
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice external_ids")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on external_ids")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for external_ids")
+	}
+
+	if len(externalIDAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ContributedExternalIds = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &externalIDR{}
+			}
+			foreign.R.ContributingUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ContributedBy {
+				local.R.ContributedExternalIds = append(local.R.ContributedExternalIds, foreign)
+				if foreign.R == nil {
+					foreign.R = &externalIDR{}
+				}
+				foreign.R.ContributingUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadContributedFilms allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadContributedFilms(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`films`),
+		qm.WhereIn(`films.contributed_by in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load films")
+	}
+
+	var resultSlice []*Film
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice films")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on films")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for films")
+	}
+
+	if len(filmAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ContributedFilms = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &filmR{}
+			}
+			foreign.R.ContributingUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ContributedBy {
+				local.R.ContributedFilms = append(local.R.ContributedFilms, foreign)
+				if foreign.R == nil {
+					foreign.R = &filmR{}
+				}
+				foreign.R.ContributingUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImportJobs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadImportJobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`import_jobs`),
+		qm.WhereIn(`import_jobs.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load import_jobs")
+	}
+
+	var resultSlice []*ImportJob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice import_jobs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on import_jobs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for import_jobs")
+	}
+
+	if len(importJobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImportJobs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &importJobR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.ImportJobs = append(local.R.ImportJobs, foreign)
+				if foreign.R == nil {
+					foreign.R = &importJobR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadListActivities allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadListActivities(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`list_activities`),
+		qm.WhereIn(`list_activities.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load list_activities")
+	}
+
+	var resultSlice []*ListActivity
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice list_activities")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on list_activities")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for list_activities")
+	}
+
+	if len(listActivityAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ListActivities = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &listActivityR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UserID) {
+				local.R.ListActivities = append(local.R.ListActivities, foreign)
+				if foreign.R == nil {
+					foreign.R = &listActivityR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMemberListActivities allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadMemberListActivities(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`list_activities`),
+		qm.WhereIn(`list_activities.member_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load list_activities")
+	}
+
+	var resultSlice []*ListActivity
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice list_activities")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on list_activities")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for list_activities")
+	}
+
+	if len(listActivityAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.MemberListActivities = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &listActivityR{}
+			}
+			foreign.R.Member = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.MemberID) {
+				local.R.MemberListActivities = append(local.R.MemberListActivities, foreign)
+				if foreign.R == nil {
+					foreign.R = &listActivityR{}
+				}
+				foreign.R.Member = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadListInvites allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadListInvites(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`list_invites`),
+		qm.WhereIn(`list_invites.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load list_invites")
+	}
+
+	var resultSlice []*ListInvite
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice list_invites")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on list_invites")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for list_invites")
+	}
+
+	if len(listInviteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ListInvites = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &listInviteR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UserID) {
+				local.R.ListInvites = append(local.R.ListInvites, foreign)
+				if foreign.R == nil {
+					foreign.R = &listInviteR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadInvitedByListInvites allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadInvitedByListInvites(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`list_invites`),
+		qm.WhereIn(`list_invites.invited_by in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load list_invites")
+	}
+
+	var resultSlice []*ListInvite
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice list_invites")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on list_invites")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for list_invites")
+	}
+
+	if len(listInviteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.InvitedByListInvites = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &listInviteR{}
+			}
+			foreign.R.InvitedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.InvitedBy) {
+				local.R.InvitedByListInvites = append(local.R.InvitedByListInvites, foreign)
+				if foreign.R == nil {
+					foreign.R = &listInviteR{}
+				}
+				foreign.R.InvitedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAddedByListItems allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAddedByListItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`list_items`),
+		qm.WhereIn(`list_items.added_by in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load list_items")
+	}
+
+	var resultSlice []*ListItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice list_items")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on list_items")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for list_items")
+	}
+
+	if len(listItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AddedByListItems = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &listItemR{}
+			}
+			foreign.R.AddedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.AddedBy) {
+				local.R.AddedByListItems = append(local.R.AddedByListItems, foreign)
+				if foreign.R == nil {
+					foreign.R = &listItemR{}
+				}
+				foreign.R.AddedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadListMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadListMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`list_members`),
+		qm.WhereIn(`list_members.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load list_members")
+	}
+
+	var resultSlice []*ListMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice list_members")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on list_members")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for list_members")
+	}
+
+	if len(listMemberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ListMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &listMemberR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.ListMembers = append(local.R.ListMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &listMemberR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLists allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadLists(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`lists`),
+		qm.WhereIn(`lists.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load lists")
+	}
+
+	var resultSlice []*List
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice lists")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on lists")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for lists")
+	}
+
+	if len(listAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Lists = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &listR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Lists = append(local.R.Lists, foreign)
+				if foreign.R == nil {
+					foreign.R = &listR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadContributedMediaItems allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadContributedMediaItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`media_items`),
+		qm.WhereIn(`media_items.contributed_by in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load media_items")
+	}
+
+	var resultSlice []*MediaItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice media_items")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on media_items")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for media_items")
+	}
+
+	if len(mediaItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ContributedMediaItems = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &mediaItemR{}
+			}
+			foreign.R.ContributingUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ContributedBy {
+				local.R.ContributedMediaItems = append(local.R.ContributedMediaItems, foreign)
+				if foreign.R == nil {
+					foreign.R = &mediaItemR{}
+				}
+				foreign.R.ContributingUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPlaybackProgresses allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPlaybackProgresses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`playback_progress`),
+		qm.WhereIn(`playback_progress.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load playback_progress")
+	}
+
+	var resultSlice []*PlaybackProgress
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice playback_progress")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on playback_progress")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for playback_progress")
+	}
+
+	if len(playbackProgressAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PlaybackProgresses = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &playbackProgressR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.PlaybackProgresses = append(local.R.PlaybackProgresses, foreign)
+				if foreign.R == nil {
+					foreign.R = &playbackProgressR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadContributedReleases allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadContributedReleases(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`releases`),
+		qm.WhereIn(`releases.contributed_by in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load releases")
+	}
+
+	var resultSlice []*Release
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice releases")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on releases")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for releases")
+	}
+
+	if len(releaseAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ContributedReleases = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &releaseR{}
+			}
+			foreign.R.ContributingUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ContributedBy {
+				local.R.ContributedReleases = append(local.R.ContributedReleases, foreign)
+				if foreign.R == nil {
+					foreign.R = &releaseR{}
+				}
+				foreign.R.ContributingUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReviewVotes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadReviewVotes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`review_votes`),
+		qm.WhereIn(`review_votes.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load review_votes")
+	}
+
+	var resultSlice []*ReviewVote
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice review_votes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on review_votes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for review_votes")
+	}
+
+	if len(reviewVoteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ReviewVotes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reviewVoteR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.ReviewVotes = append(local.R.ReviewVotes, foreign)
+				if foreign.R == nil {
+					foreign.R = &reviewVoteR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReviews allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadReviews(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`reviews`),
+		qm.WhereIn(`reviews.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load reviews")
+	}
+
+	var resultSlice []*Review
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice reviews")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on reviews")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reviews")
+	}
+
+	if len(reviewAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Reviews = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reviewR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Reviews = append(local.R.Reviews, foreign)
+				if foreign.R == nil {
+					foreign.R = &reviewR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadScores allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadScores(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`scores`),
+		qm.WhereIn(`scores.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load scores")
+	}
+
+	var resultSlice []*Score
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice scores")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on scores")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for scores")
+	}
+
+	if len(scoreAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Scores = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &scoreR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Scores = append(local.R.Scores, foreign)
+				if foreign.R == nil {
+					foreign.R = &scoreR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSeriesFollows allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSeriesFollows(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`series_follows`),
+		qm.WhereIn(`series_follows.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load series_follows")
+	}
+
+	var resultSlice []*SeriesFollow
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice series_follows")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on series_follows")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for series_follows")
+	}
+
+	if len(seriesFollowAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SeriesFollows = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &seriesFollowR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.SeriesFollows = append(local.R.SeriesFollows, foreign)
+				if foreign.R == nil {
+					foreign.R = &seriesFollowR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadContributedSerieses allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadContributedSerieses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`serieses`),
+		qm.WhereIn(`serieses.contributed_by in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load serieses")
+	}
+
+	var resultSlice []*Series
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice serieses")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on serieses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for serieses")
+	}
+
+	if len(seriesAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ContributedSerieses = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &seriesR{}
+			}
+			foreign.R.ContributingUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ContributedBy {
+				local.R.ContributedSerieses = append(local.R.ContributedSerieses, foreign)
+				if foreign.R == nil {
+					foreign.R = &seriesR{}
+				}
+				foreign.R.ContributingUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`tokens`),
+		qm.WhereIn(`tokens.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tokens")
+	}
+
+	var resultSlice []*Token
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tokens")
+	}
+
+	if len(tokenAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Tokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Tokens = append(local.R.Tokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &tokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadContributedTranslations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadContributedTranslations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`translations`),
+		qm.WhereIn(`translations.contributed_by in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load translations")
+	}
+
+	var resultSlice []*Translation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice translations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on translations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for translations")
+	}
+
+	if len(translationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ContributedTranslations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &translationR{}
+			}
+			foreign.R.ContributingUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ContributedBy {
+				local.R.ContributedTranslations = append(local.R.ContributedTranslations, foreign)
+				if foreign.R == nil {
+					foreign.R = &translationR{}
+				}
+				foreign.R.ContributingUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWatchfilms allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadWatchfilms(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`watchfilms`),
+		qm.WhereIn(`watchfilms.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load watchfilms")
+	}
+
+	var resultSlice []*Watchfilm
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice watchfilms")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on watchfilms")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for watchfilms")
+	}
+
+	if len(watchfilmAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Watchfilms = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &watchfilmR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Watchfilms = append(local.R.Watchfilms, foreign)
+				if foreign.R == nil {
+					foreign.R = &watchfilmR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadYearReviews allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadYearReviews(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`year_reviews`),
+		qm.WhereIn(`year_reviews.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load year_reviews")
+	}
+
+	var resultSlice []*YearReview
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice year_reviews")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on year_reviews")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for year_reviews")
+	}
+
+	if len(yearReviewAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.YearReviews = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &yearReviewR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.YearReviews = append(local.R.YearReviews, foreign)
+				if foreign.R == nil {
+					foreign.R = &yearReviewR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddContributedCollectionItems adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ContributedCollectionItems.
+// Sets related.R.ContributingUser appropriately.
+func (o *User) AddContributedCollectionItems(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CollectionItem) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ContributedBy = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"collection_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
+				strmangle.WhereClause("\"", "\"", 2, collectionItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ContributedBy = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ContributedCollectionItems: related,
+		}
+	} else {
+		o.R.ContributedCollectionItems = append(o.R.ContributedCollectionItems, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &collectionItemR{
+				ContributingUser: o,
+			}
+		} else {
+			rel.R.ContributingUser = o
+		}
+	}
+	return nil
+}
+
+// AddContributedCollections adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ContributedCollections.
+// Sets related.R.ContributingUser appropriately.
+func (o *User) AddContributedCollections(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Collection) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ContributedBy = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"collections\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
+				strmangle.WhereClause("\"", "\"", 2, collectionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ContributedBy = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ContributedCollections: related,
+		}
+	} else {
+		o.R.ContributedCollections = append(o.R.ContributedCollections, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &collectionR{
+				ContributingUser: o,
+			}
+		} else {
+			rel.R.ContributingUser = o
+		}
+	}
+	return nil
+}
+
+// AddContributedContentRatings adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ContributedContentRatings.
+// Sets related.R.ContributingUser appropriately.
+func (o *User) AddContributedContentRatings(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ContentRating) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ContributedBy = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"content_ratings\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
+				strmangle.WhereClause("\"", "\"", 2, contentRatingPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ContributedBy = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ContributedContentRatings: related,
+		}
+	} else {
+		o.R.ContributedContentRatings = append(o.R.ContributedContentRatings, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &contentRatingR{
+				ContributingUser: o,
+			}
+		} else {
+			rel.R.ContributingUser = o
+		}
+	}
+	return nil
+}
+
+// AddContributions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Contributions.
+// Sets related.R.User appropriately.
+func (o *User) AddContributions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Contribution) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"contributions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, contributionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.UserID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Contributions: related,
+		}
+	} else {
+		o.R.Contributions = append(o.R.Contributions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &contributionR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// SetContributions removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.User's Contributions accordingly.
+// Replaces o.R.Contributions with related.
+// Sets related.R.User's Contributions accordingly.
+func (o *User) SetContributions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Contribution) error {
+	query := "update \"contributions\" set \"user_id\" = null where \"user_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Contributions {
+			queries.SetScanner(&rel.UserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.User = nil
+		}
+		o.R.Contributions = nil
+	}
+
+	return o.AddContributions(ctx, exec, insert, related...)
+}
+
+// RemoveContributions relationships from objects passed in.
+// Removes related items from R.Contributions (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+func (o *User) RemoveContributions(ctx context.Context, exec boil.ContextExecutor, related ...*Contribution) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserID, nil)
+		if rel.R != nil {
+			rel.R.User = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Contributions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Contributions)
+			if ln > 1 && i < ln-1 {
+				o.R.Contributions[i] = o.R.Contributions[ln-1]
+			}
+			o.R.Contributions = o.R.Contributions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddContributorStats adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ContributorStats.
+// Sets related.R.User appropriately.
+func (o *User) AddContributorStats(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ContributorStat) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"contributor_stats\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, contributorStatPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ContributorStats: related,
+		}
+	} else {
+		o.R.ContributorStats = append(o.R.ContributorStats, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &contributorStatR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddContributedExternalIds adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ContributedExternalIds.
+// Sets related.R.ContributingUser appropriately.
+func (o *User) AddContributedExternalIds(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ExternalID) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ContributedBy = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"external_ids\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
+				strmangle.WhereClause("\"", "\"", 2, externalIDPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ContributedBy = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ContributedExternalIds: related,
+		}
+	} else {
+		o.R.ContributedExternalIds = append(o.R.ContributedExternalIds, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &externalIDR{
+				ContributingUser: o,
+			}
+		} else {
+			rel.R.ContributingUser = o
+		}
+	}
+	return nil
+}
+
+// AddContributedFilms adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ContributedFilms.
+// Sets related.R.ContributingUser appropriately.
+func (o *User) AddContributedFilms(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Film) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ContributedBy = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"films\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
+				strmangle.WhereClause("\"", "\"", 2, filmPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ContributedBy = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ContributedFilms: related,
+		}
+	} else {
+		o.R.ContributedFilms = append(o.R.ContributedFilms, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &filmR{
+				ContributingUser: o,
+			}
+		} else {
+			rel.R.ContributingUser = o
+		}
+	}
+	return nil
+}
+
+// AddImportJobs adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ImportJobs.
+// Sets related.R.User appropriately.
+func (o *User) AddImportJobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ImportJob) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"import_jobs\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, importJobPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ImportJobs: related,
+		}
+	} else {
+		o.R.ImportJobs = append(o.R.ImportJobs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &importJobR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddListActivities adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ListActivities.
+// Sets related.R.User appropriately.
+func (o *User) AddListActivities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListActivity) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"list_activities\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, listActivityPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.UserID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ListActivities: related,
+		}
+	} else {
+		o.R.ListActivities = append(o.R.ListActivities, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &listActivityR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// SetListActivities removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.User's ListActivities accordingly.
+// Replaces o.R.ListActivities with related.
+// Sets related.R.User's ListActivities accordingly.
+func (o *User) SetListActivities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListActivity) error {
+	query := "update \"list_activities\" set \"user_id\" = null where \"user_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ListActivities {
+			queries.SetScanner(&rel.UserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.User = nil
+		}
+		o.R.ListActivities = nil
+	}
+
+	return o.AddListActivities(ctx, exec, insert, related...)
+}
+
+// RemoveListActivities relationships from objects passed in.
+// Removes related items from R.ListActivities (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+func (o *User) RemoveListActivities(ctx context.Context, exec boil.ContextExecutor, related ...*ListActivity) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserID, nil)
+		if rel.R != nil {
+			rel.R.User = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ListActivities {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ListActivities)
+			if ln > 1 && i < ln-1 {
+				o.R.ListActivities[i] = o.R.ListActivities[ln-1]
+			}
+			o.R.ListActivities = o.R.ListActivities[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddMemberListActivities adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.MemberListActivities.
+// Sets related.R.Member appropriately.
+func (o *User) AddMemberListActivities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListActivity) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.MemberID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"list_activities\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"member_id"}),
+				strmangle.WhereClause("\"", "\"", 2, listActivityPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.MemberID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			MemberListActivities: related,
+		}
+	} else {
+		o.R.MemberListActivities = append(o.R.MemberListActivities, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &listActivityR{
+				Member: o,
+			}
+		} else {
+			rel.R.Member = o
+		}
+	}
+	return nil
+}
+
+// SetMemberListActivities removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Member's MemberListActivities accordingly.
+// Replaces o.R.MemberListActivities with related.
+// Sets related.R.Member's MemberListActivities accordingly.
+func (o *User) SetMemberListActivities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListActivity) error {
+	query := "update \"list_activities\" set \"member_id\" = null where \"member_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.MemberListActivities {
+			queries.SetScanner(&rel.MemberID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Member = nil
+		}
+		o.R.MemberListActivities = nil
+	}
+
+	return o.AddMemberListActivities(ctx, exec, insert, related...)
+}
+
+// RemoveMemberListActivities relationships from objects passed in.
+// Removes related items from R.MemberListActivities (uses pointer comparison, removal does not keep order)
+// Sets related.R.Member.
+func (o *User) RemoveMemberListActivities(ctx context.Context, exec boil.ContextExecutor, related ...*ListActivity) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.MemberID, nil)
+		if rel.R != nil {
+			rel.R.Member = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("member_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.MemberListActivities {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.MemberListActivities)
+			if ln > 1 && i < ln-1 {
+				o.R.MemberListActivities[i] = o.R.MemberListActivities[ln-1]
+			}
+			o.R.MemberListActivities = o.R.MemberListActivities[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddListInvites adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ListInvites.
+// Sets related.R.User appropriately.
+func (o *User) AddListInvites(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListInvite) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"list_invites\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, listInvitePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.UserID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ListInvites: related,
+		}
+	} else {
+		o.R.ListInvites = append(o.R.ListInvites, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &listInviteR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// SetListInvites removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.User's ListInvites accordingly.
+// Replaces o.R.ListInvites with related.
+// Sets related.R.User's ListInvites accordingly.
+func (o *User) SetListInvites(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListInvite) error {
+	query := "update \"list_invites\" set \"user_id\" = null where \"user_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ListInvites {
+			queries.SetScanner(&rel.UserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.User = nil
+		}
+		o.R.ListInvites = nil
+	}
+
+	return o.AddListInvites(ctx, exec, insert, related...)
+}
+
+// RemoveListInvites relationships from objects passed in.
+// Removes related items from R.ListInvites (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+func (o *User) RemoveListInvites(ctx context.Context, exec boil.ContextExecutor, related ...*ListInvite) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserID, nil)
+		if rel.R != nil {
+			rel.R.User = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ListInvites {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ListInvites)
+			if ln > 1 && i < ln-1 {
+				o.R.ListInvites[i] = o.R.ListInvites[ln-1]
+			}
+			o.R.ListInvites = o.R.ListInvites[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddInvitedByListInvites adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.InvitedByListInvites.
+// Sets related.R.InvitedByUser appropriately.
+func (o *User) AddInvitedByListInvites(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListInvite) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.InvitedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"list_invites\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"invited_by"}),
+				strmangle.WhereClause("\"", "\"", 2, listInvitePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.InvitedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			InvitedByListInvites: related,
+		}
+	} else {
+		o.R.InvitedByListInvites = append(o.R.InvitedByListInvites, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &listInviteR{
+				InvitedByUser: o,
+			}
+		} else {
+			rel.R.InvitedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetInvitedByListInvites removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.InvitedByUser's InvitedByListInvites accordingly.
+// Replaces o.R.InvitedByListInvites with related.
+// Sets related.R.InvitedByUser's InvitedByListInvites accordingly.
+func (o *User) SetInvitedByListInvites(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListInvite) error {
+	query := "update \"list_invites\" set \"invited_by\" = null where \"invited_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.InvitedByListInvites {
+			queries.SetScanner(&rel.InvitedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.InvitedByUser = nil
+		}
+		o.R.InvitedByListInvites = nil
+	}
+
+	return o.AddInvitedByListInvites(ctx, exec, insert, related...)
+}
+
+// RemoveInvitedByListInvites relationships from objects passed in.
+// Removes related items from R.InvitedByListInvites (uses pointer comparison, removal does not keep order)
+// Sets related.R.InvitedByUser.
+func (o *User) RemoveInvitedByListInvites(ctx context.Context, exec boil.ContextExecutor, related ...*ListInvite) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.InvitedBy, nil)
+		if rel.R != nil {
+			rel.R.InvitedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("invited_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.InvitedByListInvites {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.InvitedByListInvites)
+			if ln > 1 && i < ln-1 {
+				o.R.InvitedByListInvites[i] = o.R.InvitedByListInvites[ln-1]
+			}
+			o.R.InvitedByListInvites = o.R.InvitedByListInvites[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddAddedByListItems adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AddedByListItems.
+// Sets related.R.AddedByUser appropriately.
+func (o *User) AddAddedByListItems(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListItem) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AddedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"list_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"added_by"}),
+				strmangle.WhereClause("\"", "\"", 2, listItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.AddedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AddedByListItems: related,
+		}
+	} else {
+		o.R.AddedByListItems = append(o.R.AddedByListItems, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &listItemR{
+				AddedByUser: o,
+			}
+		} else {
+			rel.R.AddedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetAddedByListItems removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.AddedByUser's AddedByListItems accordingly.
+// Replaces o.R.AddedByListItems with related.
+// Sets related.R.AddedByUser's AddedByListItems accordingly.
+func (o *User) SetAddedByListItems(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListItem) error {
+	query := "update \"list_items\" set \"added_by\" = null where \"added_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.AddedByListItems {
+			queries.SetScanner(&rel.AddedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.AddedByUser = nil
+		}
+		o.R.AddedByListItems = nil
+	}
+
+	return o.AddAddedByListItems(ctx, exec, insert, related...)
+}
+
+// RemoveAddedByListItems relationships from objects passed in.
+// Removes related items from R.AddedByListItems (uses pointer comparison, removal does not keep order)
+// Sets related.R.AddedByUser.
+func (o *User) RemoveAddedByListItems(ctx context.Context, exec boil.ContextExecutor, related ...*ListItem) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.AddedBy, nil)
+		if rel.R != nil {
+			rel.R.AddedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("added_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.AddedByListItems {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.AddedByListItems)
+			if ln > 1 && i < ln-1 {
+				o.R.AddedByListItems[i] = o.R.AddedByListItems[ln-1]
+			}
+			o.R.AddedByListItems = o.R.AddedByListItems[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddListMembers adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ListMembers.
+// Sets related.R.User appropriately.
+func (o *User) AddListMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListMember) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"list_members\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, listMemberPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ListID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ListMembers: related,
+		}
+	} else {
+		o.R.ListMembers = append(o.R.ListMembers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &listMemberR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddLists adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Lists.
+// Sets related.R.User appropriately.
+func (o *User) AddLists(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*List) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"lists\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, listPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Lists: related,
+		}
+	} else {
+		o.R.Lists = append(o.R.Lists, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &listR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddContributedMediaItems adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ContributedMediaItems.
+// Sets related.R.ContributingUser appropriately.
+func (o *User) AddContributedMediaItems(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MediaItem) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ContributedBy = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"media_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
+				strmangle.WhereClause("\"", "\"", 2, mediaItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ContributedBy = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ContributedMediaItems: related,
+		}
+	} else {
+		o.R.ContributedMediaItems = append(o.R.ContributedMediaItems, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &mediaItemR{
+				ContributingUser: o,
+			}
+		} else {
+			rel.R.ContributingUser = o
+		}
+	}
+	return nil
+}
+
+// AddPlaybackProgresses adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PlaybackProgresses.
+// Sets related.R.User appropriately.
+func (o *User) AddPlaybackProgresses(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PlaybackProgress) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"playback_progress\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, playbackProgressPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.FilmID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			PlaybackProgresses: related,
+		}
+	} else {
+		o.R.PlaybackProgresses = append(o.R.PlaybackProgresses, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &playbackProgressR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddContributedReleases adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ContributedReleases.
+// Sets related.R.ContributingUser appropriately.
+func (o *User) AddContributedReleases(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Release) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ContributedBy = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"releases\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
+				strmangle.WhereClause("\"", "\"", 2, releasePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ContributedBy = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ContributedReleases: related,
+		}
+	} else {
+		o.R.ContributedReleases = append(o.R.ContributedReleases, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &releaseR{
+				ContributingUser: o,
+			}
+		} else {
+			rel.R.ContributingUser = o
+		}
+	}
+	return nil
+}
+
+// AddReviewVotes adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ReviewVotes.
+// Sets related.R.User appropriately.
+func (o *User) AddReviewVotes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ReviewVote) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"review_votes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, reviewVotePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ReviewID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ReviewVotes: related,
+		}
+	} else {
+		o.R.ReviewVotes = append(o.R.ReviewVotes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &reviewVoteR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddReviews adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Reviews.
+// Sets related.R.User appropriately.
+func (o *User) AddReviews(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Review) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"reviews\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, reviewPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -990,25 +5629,131 @@ func (o *User) AddContributedFilms(ctx context.Context, exec boil.ContextExecuto
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.ContributedBy = o.ID
+			rel.UserID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			ContributedFilms: related,
+			Reviews: related,
 		}
 	} else {
-		o.R.ContributedFilms = append(o.R.ContributedFilms, related...)
+		o.R.Reviews = append(o.R.Reviews, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &filmR{
-				ContributingUser: o,
+			rel.R = &reviewR{
+				User: o,
 			}
 		} else {
-			rel.R.ContributingUser = o
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddScores adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Scores.
+// Sets related.R.User appropriately.
+func (o *User) AddScores(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Score) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"scores\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, scorePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Scores: related,
+		}
+	} else {
+		o.R.Scores = append(o.R.Scores, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &scoreR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddSeriesFollows adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.SeriesFollows.
+// Sets related.R.User appropriately.
+func (o *User) AddSeriesFollows(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SeriesFollow) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"series_follows\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, seriesFollowPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.SeriesID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			SeriesFollows: related,
+		}
+	} else {
+		o.R.SeriesFollows = append(o.R.SeriesFollows, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &seriesFollowR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
 		}
 	}
 	return nil
@@ -1120,6 +5865,59 @@ func (o *User) AddTokens(ctx context.Context, exec boil.ContextExecutor, insert
 	return nil
 }
 
+// AddContributedTranslations adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ContributedTranslations.
+// Sets related.R.ContributingUser appropriately.
+func (o *User) AddContributedTranslations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Translation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ContributedBy = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"translations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"contributed_by"}),
+				strmangle.WhereClause("\"", "\"", 2, translationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ContributedBy = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ContributedTranslations: related,
+		}
+	} else {
+		o.R.ContributedTranslations = append(o.R.ContributedTranslations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &translationR{
+				ContributingUser: o,
+			}
+		} else {
+			rel.R.ContributingUser = o
+		}
+	}
+	return nil
+}
+
 // AddWatchfilms adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
 // Appends related to o.R.Watchfilms.
@@ -1173,6 +5971,59 @@ func (o *User) AddWatchfilms(ctx context.Context, exec boil.ContextExecutor, ins
 	return nil
 }
 
+// AddYearReviews adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.YearReviews.
+// Sets related.R.User appropriately.
+func (o *User) AddYearReviews(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*YearReview) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"year_reviews\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, yearReviewPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.Year, rel.Timezone}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			YearReviews: related,
+		}
+	} else {
+		o.R.YearReviews = append(o.R.YearReviews, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &yearReviewR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // Users retrieves all the records using an executor.
 func Users(mods ...qm.QueryMod) userQuery {
 	mods = append(mods, qm.From("\"users\""))
@@ -0,0 +1,964 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// UsersAudit is an object representing the database table.
+type UsersAudit struct {
+	ID          int         `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	Email       string      `db:"email" boil:"email" json:"email" toml:"email" yaml:"email"`
+	FirstName   null.String `db:"first_name" boil:"first_name" json:"first_name,omitempty" toml:"first_name" yaml:"first_name,omitempty"`
+	LastName    null.String `db:"last_name" boil:"last_name" json:"last_name,omitempty" toml:"last_name" yaml:"last_name,omitempty"`
+	Bio         null.String `db:"bio" boil:"bio" json:"bio,omitempty" toml:"bio" yaml:"bio,omitempty"`
+	Birthdate   null.Time   `db:"birthdate" boil:"birthdate" json:"birthdate,omitempty" toml:"birthdate" yaml:"birthdate,omitempty"`
+	Jointime    time.Time   `db:"jointime" boil:"jointime" json:"jointime" toml:"jointime" yaml:"jointime"`
+	Avatar      null.String `db:"avatar" boil:"avatar" json:"avatar,omitempty" toml:"avatar" yaml:"avatar,omitempty"`
+	Locale      null.String `db:"locale" boil:"locale" json:"locale,omitempty" toml:"locale" yaml:"locale,omitempty"`
+	Moderator   bool        `db:"moderator" boil:"moderator" json:"moderator" toml:"moderator" yaml:"moderator"`
+	AuditAction string      `db:"audit_action" boil:"audit_action" json:"audit_action" toml:"audit_action" yaml:"audit_action"`
+	AuditActor  null.Int    `db:"audit_actor" boil:"audit_actor" json:"audit_actor,omitempty" toml:"audit_actor" yaml:"audit_actor,omitempty"`
+	AuditedAt   time.Time   `db:"audited_at" boil:"audited_at" json:"audited_at" toml:"audited_at" yaml:"audited_at"`
+
+	R *usersAuditR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L usersAuditL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var UsersAuditColumns = struct {
+	ID          string
+	Email       string
+	FirstName   string
+	LastName    string
+	Bio         string
+	Birthdate   string
+	Jointime    string
+	Avatar      string
+	Locale      string
+	Moderator   string
+	AuditAction string
+	AuditActor  string
+	AuditedAt   string
+}{
+	ID:          "id",
+	Email:       "email",
+	FirstName:   "first_name",
+	LastName:    "last_name",
+	Bio:         "bio",
+	Birthdate:   "birthdate",
+	Jointime:    "jointime",
+	Avatar:      "avatar",
+	Locale:      "locale",
+	Moderator:   "moderator",
+	AuditAction: "audit_action",
+	AuditActor:  "audit_actor",
+	AuditedAt:   "audited_at",
+}
+
+var UsersAuditTableColumns = struct {
+	ID          string
+	Email       string
+	FirstName   string
+	LastName    string
+	Bio         string
+	Birthdate   string
+	Jointime    string
+	Avatar      string
+	Locale      string
+	Moderator   string
+	AuditAction string
+	AuditActor  string
+	AuditedAt   string
+}{
+	ID:          "users_audit.id",
+	Email:       "users_audit.email",
+	FirstName:   "users_audit.first_name",
+	LastName:    "users_audit.last_name",
+	Bio:         "users_audit.bio",
+	Birthdate:   "users_audit.birthdate",
+	Jointime:    "users_audit.jointime",
+	Avatar:      "users_audit.avatar",
+	Locale:      "users_audit.locale",
+	Moderator:   "users_audit.moderator",
+	AuditAction: "users_audit.audit_action",
+	AuditActor:  "users_audit.audit_actor",
+	AuditedAt:   "users_audit.audited_at",
+}
+
+// Generated where
+
+var UsersAuditWhere = struct {
+	ID          whereHelperint
+	Email       whereHelperstring
+	FirstName   whereHelpernull_String
+	LastName    whereHelpernull_String
+	Bio         whereHelpernull_String
+	Birthdate   whereHelpernull_Time
+	Jointime    whereHelpertime_Time
+	Avatar      whereHelpernull_String
+	Locale      whereHelpernull_String
+	Moderator   whereHelperbool
+	AuditAction whereHelperstring
+	AuditActor  whereHelpernull_Int
+	AuditedAt   whereHelpertime_Time
+}{
+	ID:          whereHelperint{field: "\"users_audit\".\"id\""},
+	Email:       whereHelperstring{field: "\"users_audit\".\"email\""},
+	FirstName:   whereHelpernull_String{field: "\"users_audit\".\"first_name\""},
+	LastName:    whereHelpernull_String{field: "\"users_audit\".\"last_name\""},
+	Bio:         whereHelpernull_String{field: "\"users_audit\".\"bio\""},
+	Birthdate:   whereHelpernull_Time{field: "\"users_audit\".\"birthdate\""},
+	Jointime:    whereHelpertime_Time{field: "\"users_audit\".\"jointime\""},
+	Avatar:      whereHelpernull_String{field: "\"users_audit\".\"avatar\""},
+	Locale:      whereHelpernull_String{field: "\"users_audit\".\"locale\""},
+	Moderator:   whereHelperbool{field: "\"users_audit\".\"moderator\""},
+	AuditAction: whereHelperstring{field: "\"users_audit\".\"audit_action\""},
+	AuditActor:  whereHelpernull_Int{field: "\"users_audit\".\"audit_actor\""},
+	AuditedAt:   whereHelpertime_Time{field: "\"users_audit\".\"audited_at\""},
+}
+
+// UsersAuditRels is where relationship names are stored.
+var UsersAuditRels = struct {
+}{}
+
+// usersAuditR is where relationships are stored.
+type usersAuditR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*usersAuditR) NewStruct() *usersAuditR {
+	return &usersAuditR{}
+}
+
+// usersAuditL is where Load methods for each relationship are stored.
+type usersAuditL struct{}
+
+var (
+	usersAuditAllColumns            = []string{"id", "email", "first_name", "last_name", "bio", "birthdate", "jointime", "avatar", "locale", "moderator", "audit_action", "audit_actor", "audited_at"}
+	usersAuditColumnsWithoutDefault = []string{"id", "email", "jointime"}
+	usersAuditColumnsWithDefault    = []string{"first_name", "last_name", "bio", "birthdate", "avatar", "locale", "moderator", "audit_action", "audit_actor", "audited_at"}
+	usersAuditPrimaryKeyColumns     = []string{"id", "audited_at"}
+	usersAuditGeneratedColumns      = []string{}
+)
+
+type (
+	// UsersAuditSlice is an alias for a slice of pointers to UsersAudit.
+	// This should almost always be used instead of []UsersAudit.
+	UsersAuditSlice []*UsersAudit
+	// UsersAuditHook is the signature for custom UsersAudit hook methods
+	UsersAuditHook func(context.Context, boil.ContextExecutor, *UsersAudit) error
+
+	usersAuditQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	usersAuditType                 = reflect.TypeOf(&UsersAudit{})
+	usersAuditMapping              = queries.MakeStructMapping(usersAuditType)
+	usersAuditPrimaryKeyMapping, _ = queries.BindMapping(usersAuditType, usersAuditMapping, usersAuditPrimaryKeyColumns)
+	usersAuditInsertCacheMut       sync.RWMutex
+	usersAuditInsertCache          = make(map[string]insertCache)
+	usersAuditUpdateCacheMut       sync.RWMutex
+	usersAuditUpdateCache          = make(map[string]updateCache)
+	usersAuditUpsertCacheMut       sync.RWMutex
+	usersAuditUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var usersAuditAfterSelectHooks []UsersAuditHook
+
+var usersAuditBeforeInsertHooks []UsersAuditHook
+var usersAuditAfterInsertHooks []UsersAuditHook
+
+var usersAuditBeforeUpdateHooks []UsersAuditHook
+var usersAuditAfterUpdateHooks []UsersAuditHook
+
+var usersAuditBeforeDeleteHooks []UsersAuditHook
+var usersAuditAfterDeleteHooks []UsersAuditHook
+
+var usersAuditBeforeUpsertHooks []UsersAuditHook
+var usersAuditAfterUpsertHooks []UsersAuditHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *UsersAudit) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range usersAuditAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *UsersAudit) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range usersAuditBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *UsersAudit) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range usersAuditAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *UsersAudit) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range usersAuditBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *UsersAudit) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range usersAuditAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *UsersAudit) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range usersAuditBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *UsersAudit) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range usersAuditAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *UsersAudit) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range usersAuditBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *UsersAudit) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range usersAuditAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddUsersAuditHook registers your hook function for all future operations.
+func AddUsersAuditHook(hookPoint boil.HookPoint, usersAuditHook UsersAuditHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		usersAuditAfterSelectHooks = append(usersAuditAfterSelectHooks, usersAuditHook)
+	case boil.BeforeInsertHook:
+		usersAuditBeforeInsertHooks = append(usersAuditBeforeInsertHooks, usersAuditHook)
+	case boil.AfterInsertHook:
+		usersAuditAfterInsertHooks = append(usersAuditAfterInsertHooks, usersAuditHook)
+	case boil.BeforeUpdateHook:
+		usersAuditBeforeUpdateHooks = append(usersAuditBeforeUpdateHooks, usersAuditHook)
+	case boil.AfterUpdateHook:
+		usersAuditAfterUpdateHooks = append(usersAuditAfterUpdateHooks, usersAuditHook)
+	case boil.BeforeDeleteHook:
+		usersAuditBeforeDeleteHooks = append(usersAuditBeforeDeleteHooks, usersAuditHook)
+	case boil.AfterDeleteHook:
+		usersAuditAfterDeleteHooks = append(usersAuditAfterDeleteHooks, usersAuditHook)
+	case boil.BeforeUpsertHook:
+		usersAuditBeforeUpsertHooks = append(usersAuditBeforeUpsertHooks, usersAuditHook)
+	case boil.AfterUpsertHook:
+		usersAuditAfterUpsertHooks = append(usersAuditAfterUpsertHooks, usersAuditHook)
+	}
+}
+
+// One returns a single usersAudit record from the query.
+func (q usersAuditQuery) One(ctx context.Context, exec boil.ContextExecutor) (*UsersAudit, error) {
+	o := &UsersAudit{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for users_audit")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all UsersAudit records from the query.
+func (q usersAuditQuery) All(ctx context.Context, exec boil.ContextExecutor) (UsersAuditSlice, error) {
+	var o []*UsersAudit
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to UsersAudit slice")
+	}
+
+	if len(usersAuditAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all UsersAudit records in the query.
+func (q usersAuditQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count users_audit rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q usersAuditQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if users_audit exists")
+	}
+
+	return count > 0, nil
+}
+
+// UsersAudits retrieves all the records using an executor.
+func UsersAudits(mods ...qm.QueryMod) usersAuditQuery {
+	mods = append(mods, qm.From("\"users_audit\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"users_audit\".*"})
+	}
+
+	return usersAuditQuery{q}
+}
+
+// FindUsersAudit retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindUsersAudit(ctx context.Context, exec boil.ContextExecutor, iD int, auditedAt time.Time, selectCols ...string) (*UsersAudit, error) {
+	usersAuditObj := &UsersAudit{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"users_audit\" where \"id\"=$1 AND \"audited_at\"=$2", sel,
+	)
+
+	q := queries.Raw(query, iD, auditedAt)
+
+	err := q.Bind(ctx, exec, usersAuditObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from users_audit")
+	}
+
+	if err = usersAuditObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return usersAuditObj, err
+	}
+
+	return usersAuditObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *UsersAudit) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no users_audit provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(usersAuditColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	usersAuditInsertCacheMut.RLock()
+	cache, cached := usersAuditInsertCache[key]
+	usersAuditInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			usersAuditAllColumns,
+			usersAuditColumnsWithDefault,
+			usersAuditColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(usersAuditType, usersAuditMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(usersAuditType, usersAuditMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"users_audit\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"users_audit\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into users_audit")
+	}
+
+	if !cached {
+		usersAuditInsertCacheMut.Lock()
+		usersAuditInsertCache[key] = cache
+		usersAuditInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the UsersAudit.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *UsersAudit) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	usersAuditUpdateCacheMut.RLock()
+	cache, cached := usersAuditUpdateCache[key]
+	usersAuditUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			usersAuditAllColumns,
+			usersAuditPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update users_audit, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"users_audit\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, usersAuditPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(usersAuditType, usersAuditMapping, append(wl, usersAuditPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update users_audit row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for users_audit")
+	}
+
+	if !cached {
+		usersAuditUpdateCacheMut.Lock()
+		usersAuditUpdateCache[key] = cache
+		usersAuditUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q usersAuditQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for users_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for users_audit")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o UsersAuditSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), usersAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"users_audit\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, usersAuditPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in usersAudit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all usersAudit")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *UsersAudit) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no users_audit provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(usersAuditColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	usersAuditUpsertCacheMut.RLock()
+	cache, cached := usersAuditUpsertCache[key]
+	usersAuditUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			usersAuditAllColumns,
+			usersAuditColumnsWithDefault,
+			usersAuditColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			usersAuditAllColumns,
+			usersAuditPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert users_audit, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(usersAuditPrimaryKeyColumns))
+			copy(conflict, usersAuditPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"users_audit\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(usersAuditType, usersAuditMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(usersAuditType, usersAuditMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert users_audit")
+	}
+
+	if !cached {
+		usersAuditUpsertCacheMut.Lock()
+		usersAuditUpsertCache[key] = cache
+		usersAuditUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single UsersAudit record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *UsersAudit) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no UsersAudit provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), usersAuditPrimaryKeyMapping)
+	sql := "DELETE FROM \"users_audit\" WHERE \"id\"=$1 AND \"audited_at\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from users_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for users_audit")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q usersAuditQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no usersAuditQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from users_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for users_audit")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o UsersAuditSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(usersAuditBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), usersAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"users_audit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, usersAuditPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from usersAudit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for users_audit")
+	}
+
+	if len(usersAuditAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *UsersAudit) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindUsersAudit(ctx, exec, o.ID, o.AuditedAt)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *UsersAuditSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := UsersAuditSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), usersAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"users_audit\".* FROM \"users_audit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, usersAuditPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in UsersAuditSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// UsersAuditExists checks if the UsersAudit row exists.
+func UsersAuditExists(ctx context.Context, exec boil.ContextExecutor, iD int, auditedAt time.Time) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"users_audit\" where \"id\"=$1 AND \"audited_at\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD, auditedAt)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD, auditedAt)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if users_audit exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1097 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// WatchEvent is an object representing the database table.
+type WatchEvent struct {
+	ID          int         `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	WatchID     int         `db:"watch_id" boil:"watch_id" json:"watch_id" toml:"watch_id" yaml:"watch_id"`
+	TimeWatched time.Time   `db:"time_watched" boil:"time_watched" json:"time_watched" toml:"time_watched" yaml:"time_watched"`
+	Rating      null.Int    `db:"rating" boil:"rating" json:"rating,omitempty" toml:"rating" yaml:"rating,omitempty"`
+	Device      null.String `db:"device" boil:"device" json:"device,omitempty" toml:"device" yaml:"device,omitempty"`
+
+	R *watchEventR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L watchEventL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WatchEventColumns = struct {
+	ID          string
+	WatchID     string
+	TimeWatched string
+	Rating      string
+	Device      string
+}{
+	ID:          "id",
+	WatchID:     "watch_id",
+	TimeWatched: "time_watched",
+	Rating:      "rating",
+	Device:      "device",
+}
+
+var WatchEventTableColumns = struct {
+	ID          string
+	WatchID     string
+	TimeWatched string
+	Rating      string
+	Device      string
+}{
+	ID:          "watch_events.id",
+	WatchID:     "watch_events.watch_id",
+	TimeWatched: "watch_events.time_watched",
+	Rating:      "watch_events.rating",
+	Device:      "watch_events.device",
+}
+
+// Generated where
+
+var WatchEventWhere = struct {
+	ID          whereHelperint
+	WatchID     whereHelperint
+	TimeWatched whereHelpertime_Time
+	Rating      whereHelpernull_Int
+	Device      whereHelpernull_String
+}{
+	ID:          whereHelperint{field: "\"watch_events\".\"id\""},
+	WatchID:     whereHelperint{field: "\"watch_events\".\"watch_id\""},
+	TimeWatched: whereHelpertime_Time{field: "\"watch_events\".\"time_watched\""},
+	Rating:      whereHelpernull_Int{field: "\"watch_events\".\"rating\""},
+	Device:      whereHelpernull_String{field: "\"watch_events\".\"device\""},
+}
+
+// WatchEventRels is where relationship names are stored.
+var WatchEventRels = struct {
+	Watch string
+}{
+	Watch: "Watch",
+}
+
+// watchEventR is where relationships are stored.
+type watchEventR struct {
+	Watch *Watchfilm `db:"Watch" boil:"Watch" json:"Watch" toml:"Watch" yaml:"Watch"`
+}
+
+// NewStruct creates a new relationship struct
+func (*watchEventR) NewStruct() *watchEventR {
+	return &watchEventR{}
+}
+
+func (r *watchEventR) GetWatch() *Watchfilm {
+	if r == nil {
+		return nil
+	}
+	return r.Watch
+}
+
+// watchEventL is where Load methods for each relationship are stored.
+type watchEventL struct{}
+
+var (
+	watchEventAllColumns            = []string{"id", "watch_id", "time_watched", "rating", "device"}
+	watchEventColumnsWithoutDefault = []string{"watch_id"}
+	watchEventColumnsWithDefault    = []string{"id", "time_watched", "rating", "device"}
+	watchEventPrimaryKeyColumns     = []string{"id"}
+	watchEventGeneratedColumns      = []string{}
+)
+
+type (
+	// WatchEventSlice is an alias for a slice of pointers to WatchEvent.
+	// This should almost always be used instead of []WatchEvent.
+	WatchEventSlice []*WatchEvent
+	// WatchEventHook is the signature for custom WatchEvent hook methods
+	WatchEventHook func(context.Context, boil.ContextExecutor, *WatchEvent) error
+
+	watchEventQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	watchEventType                 = reflect.TypeOf(&WatchEvent{})
+	watchEventMapping              = queries.MakeStructMapping(watchEventType)
+	watchEventPrimaryKeyMapping, _ = queries.BindMapping(watchEventType, watchEventMapping, watchEventPrimaryKeyColumns)
+	watchEventInsertCacheMut       sync.RWMutex
+	watchEventInsertCache          = make(map[string]insertCache)
+	watchEventUpdateCacheMut       sync.RWMutex
+	watchEventUpdateCache          = make(map[string]updateCache)
+	watchEventUpsertCacheMut       sync.RWMutex
+	watchEventUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var watchEventAfterSelectHooks []WatchEventHook
+
+var watchEventBeforeInsertHooks []WatchEventHook
+var watchEventAfterInsertHooks []WatchEventHook
+
+var watchEventBeforeUpdateHooks []WatchEventHook
+var watchEventAfterUpdateHooks []WatchEventHook
+
+var watchEventBeforeDeleteHooks []WatchEventHook
+var watchEventAfterDeleteHooks []WatchEventHook
+
+var watchEventBeforeUpsertHooks []WatchEventHook
+var watchEventAfterUpsertHooks []WatchEventHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WatchEvent) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchEventAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WatchEvent) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchEventBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WatchEvent) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchEventAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WatchEvent) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchEventBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WatchEvent) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchEventAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WatchEvent) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchEventBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WatchEvent) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchEventAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WatchEvent) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchEventBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WatchEvent) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchEventAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWatchEventHook registers your hook function for all future operations.
+func AddWatchEventHook(hookPoint boil.HookPoint, watchEventHook WatchEventHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		watchEventAfterSelectHooks = append(watchEventAfterSelectHooks, watchEventHook)
+	case boil.BeforeInsertHook:
+		watchEventBeforeInsertHooks = append(watchEventBeforeInsertHooks, watchEventHook)
+	case boil.AfterInsertHook:
+		watchEventAfterInsertHooks = append(watchEventAfterInsertHooks, watchEventHook)
+	case boil.BeforeUpdateHook:
+		watchEventBeforeUpdateHooks = append(watchEventBeforeUpdateHooks, watchEventHook)
+	case boil.AfterUpdateHook:
+		watchEventAfterUpdateHooks = append(watchEventAfterUpdateHooks, watchEventHook)
+	case boil.BeforeDeleteHook:
+		watchEventBeforeDeleteHooks = append(watchEventBeforeDeleteHooks, watchEventHook)
+	case boil.AfterDeleteHook:
+		watchEventAfterDeleteHooks = append(watchEventAfterDeleteHooks, watchEventHook)
+	case boil.BeforeUpsertHook:
+		watchEventBeforeUpsertHooks = append(watchEventBeforeUpsertHooks, watchEventHook)
+	case boil.AfterUpsertHook:
+		watchEventAfterUpsertHooks = append(watchEventAfterUpsertHooks, watchEventHook)
+	}
+}
+
+// One returns a single watchEvent record from the query.
+func (q watchEventQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WatchEvent, error) {
+	o := &WatchEvent{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for watch_events")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WatchEvent records from the query.
+func (q watchEventQuery) All(ctx context.Context, exec boil.ContextExecutor) (WatchEventSlice, error) {
+	var o []*WatchEvent
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WatchEvent slice")
+	}
+
+	if len(watchEventAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WatchEvent records in the query.
+func (q watchEventQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count watch_events rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q watchEventQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if watch_events exists")
+	}
+
+	return count > 0, nil
+}
+
+// Watch pointed to by the foreign key.
+func (o *WatchEvent) Watch(mods ...qm.QueryMod) watchfilmQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.WatchID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Watchfilms(queryMods...)
+}
+
+// LoadWatch allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (watchEventL) LoadWatch(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWatchEvent interface{}, mods queries.Applicator) error {
+	var slice []*WatchEvent
+	var object *WatchEvent
+
+	if singular {
+		var ok bool
+		object, ok = maybeWatchEvent.(*WatchEvent)
+		if !ok {
+			object = new(WatchEvent)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWatchEvent)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWatchEvent))
+			}
+		}
+	} else {
+		s, ok := maybeWatchEvent.(*[]*WatchEvent)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWatchEvent)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWatchEvent))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &watchEventR{}
+		}
+		args = append(args, object.WatchID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &watchEventR{}
+			}
+
+			for _, a := range args {
+				if a == obj.WatchID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.WatchID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`watchfilms`),
+		qm.WhereIn(`watchfilms.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Watchfilm")
+	}
+
+	var resultSlice []*Watchfilm
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Watchfilm")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for watchfilms")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for watchfilms")
+	}
+
+	if len(watchEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Watch = foreign
+		if foreign.R == nil {
+			foreign.R = &watchfilmR{}
+		}
+		foreign.R.WatchWatchEvents = append(foreign.R.WatchWatchEvents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.WatchID == foreign.ID {
+				local.R.Watch = foreign
+				if foreign.R == nil {
+					foreign.R = &watchfilmR{}
+				}
+				foreign.R.WatchWatchEvents = append(foreign.R.WatchWatchEvents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetWatch of the watchEvent to the related item.
+// Sets o.R.Watch to related.
+// Adds o to related.R.WatchWatchEvents.
+func (o *WatchEvent) SetWatch(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Watchfilm) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"watch_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"watch_id"}),
+		strmangle.WhereClause("\"", "\"", 2, watchEventPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.WatchID = related.ID
+	if o.R == nil {
+		o.R = &watchEventR{
+			Watch: related,
+		}
+	} else {
+		o.R.Watch = related
+	}
+
+	if related.R == nil {
+		related.R = &watchfilmR{
+			WatchWatchEvents: WatchEventSlice{o},
+		}
+	} else {
+		related.R.WatchWatchEvents = append(related.R.WatchWatchEvents, o)
+	}
+
+	return nil
+}
+
+// WatchEvents retrieves all the records using an executor.
+func WatchEvents(mods ...qm.QueryMod) watchEventQuery {
+	mods = append(mods, qm.From("\"watch_events\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"watch_events\".*"})
+	}
+
+	return watchEventQuery{q}
+}
+
+// FindWatchEvent retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWatchEvent(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*WatchEvent, error) {
+	watchEventObj := &WatchEvent{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"watch_events\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, watchEventObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from watch_events")
+	}
+
+	if err = watchEventObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return watchEventObj, err
+	}
+
+	return watchEventObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WatchEvent) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no watch_events provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(watchEventColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	watchEventInsertCacheMut.RLock()
+	cache, cached := watchEventInsertCache[key]
+	watchEventInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			watchEventAllColumns,
+			watchEventColumnsWithDefault,
+			watchEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(watchEventType, watchEventMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(watchEventType, watchEventMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"watch_events\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"watch_events\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into watch_events")
+	}
+
+	if !cached {
+		watchEventInsertCacheMut.Lock()
+		watchEventInsertCache[key] = cache
+		watchEventInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WatchEvent.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WatchEvent) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	watchEventUpdateCacheMut.RLock()
+	cache, cached := watchEventUpdateCache[key]
+	watchEventUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			watchEventAllColumns,
+			watchEventPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update watch_events, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"watch_events\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, watchEventPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(watchEventType, watchEventMapping, append(wl, watchEventPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update watch_events row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for watch_events")
+	}
+
+	if !cached {
+		watchEventUpdateCacheMut.Lock()
+		watchEventUpdateCache[key] = cache
+		watchEventUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q watchEventQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for watch_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for watch_events")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WatchEventSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), watchEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"watch_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, watchEventPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in watchEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all watchEvent")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WatchEvent) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no watch_events provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(watchEventColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	watchEventUpsertCacheMut.RLock()
+	cache, cached := watchEventUpsertCache[key]
+	watchEventUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			watchEventAllColumns,
+			watchEventColumnsWithDefault,
+			watchEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			watchEventAllColumns,
+			watchEventPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert watch_events, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(watchEventPrimaryKeyColumns))
+			copy(conflict, watchEventPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"watch_events\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(watchEventType, watchEventMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(watchEventType, watchEventMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert watch_events")
+	}
+
+	if !cached {
+		watchEventUpsertCacheMut.Lock()
+		watchEventUpsertCache[key] = cache
+		watchEventUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WatchEvent record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WatchEvent) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WatchEvent provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), watchEventPrimaryKeyMapping)
+	sql := "DELETE FROM \"watch_events\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from watch_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for watch_events")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q watchEventQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no watchEventQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from watch_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for watch_events")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WatchEventSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(watchEventBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), watchEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"watch_events\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, watchEventPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from watchEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for watch_events")
+	}
+
+	if len(watchEventAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WatchEvent) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWatchEvent(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WatchEventSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WatchEventSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), watchEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"watch_events\".* FROM \"watch_events\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, watchEventPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WatchEventSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WatchEventExists checks if the WatchEvent row exists.
+func WatchEventExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"watch_events\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if watch_events exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,841 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testWatchEvents(t *testing.T) {
+	t.Parallel()
+
+	query := WatchEvents()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testWatchEventsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchEvent{}
+	if err = randomize.Struct(seed, o, watchEventDBTypes, true, watchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WatchEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWatchEventsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchEvent{}
+	if err = randomize.Struct(seed, o, watchEventDBTypes, true, watchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := WatchEvents().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WatchEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWatchEventsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchEvent{}
+	if err = randomize.Struct(seed, o, watchEventDBTypes, true, watchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WatchEventSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WatchEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWatchEventsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchEvent{}
+	if err = randomize.Struct(seed, o, watchEventDBTypes, true, watchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := WatchEventExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if WatchEvent exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected WatchEventExists to return true, but got false.")
+	}
+}
+
+func testWatchEventsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchEvent{}
+	if err = randomize.Struct(seed, o, watchEventDBTypes, true, watchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	watchEventFound, err := FindWatchEvent(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if watchEventFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testWatchEventsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchEvent{}
+	if err = randomize.Struct(seed, o, watchEventDBTypes, true, watchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = WatchEvents().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWatchEventsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchEvent{}
+	if err = randomize.Struct(seed, o, watchEventDBTypes, true, watchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := WatchEvents().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testWatchEventsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	watchEventOne := &WatchEvent{}
+	watchEventTwo := &WatchEvent{}
+	if err = randomize.Struct(seed, watchEventOne, watchEventDBTypes, false, watchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchEvent struct: %s", err)
+	}
+	if err = randomize.Struct(seed, watchEventTwo, watchEventDBTypes, false, watchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = watchEventOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = watchEventTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WatchEvents().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testWatchEventsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	watchEventOne := &WatchEvent{}
+	watchEventTwo := &WatchEvent{}
+	if err = randomize.Struct(seed, watchEventOne, watchEventDBTypes, false, watchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchEvent struct: %s", err)
+	}
+	if err = randomize.Struct(seed, watchEventTwo, watchEventDBTypes, false, watchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = watchEventOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = watchEventTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WatchEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func watchEventBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *WatchEvent) error {
+	*o = WatchEvent{}
+	return nil
+}
+
+func watchEventAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *WatchEvent) error {
+	*o = WatchEvent{}
+	return nil
+}
+
+func watchEventAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *WatchEvent) error {
+	*o = WatchEvent{}
+	return nil
+}
+
+func watchEventBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *WatchEvent) error {
+	*o = WatchEvent{}
+	return nil
+}
+
+func watchEventAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *WatchEvent) error {
+	*o = WatchEvent{}
+	return nil
+}
+
+func watchEventBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *WatchEvent) error {
+	*o = WatchEvent{}
+	return nil
+}
+
+func watchEventAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *WatchEvent) error {
+	*o = WatchEvent{}
+	return nil
+}
+
+func watchEventBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *WatchEvent) error {
+	*o = WatchEvent{}
+	return nil
+}
+
+func watchEventAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *WatchEvent) error {
+	*o = WatchEvent{}
+	return nil
+}
+
+func testWatchEventsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &WatchEvent{}
+	o := &WatchEvent{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, watchEventDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize WatchEvent object: %s", err)
+	}
+
+	AddWatchEventHook(boil.BeforeInsertHook, watchEventBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	watchEventBeforeInsertHooks = []WatchEventHook{}
+
+	AddWatchEventHook(boil.AfterInsertHook, watchEventAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	watchEventAfterInsertHooks = []WatchEventHook{}
+
+	AddWatchEventHook(boil.AfterSelectHook, watchEventAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	watchEventAfterSelectHooks = []WatchEventHook{}
+
+	AddWatchEventHook(boil.BeforeUpdateHook, watchEventBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	watchEventBeforeUpdateHooks = []WatchEventHook{}
+
+	AddWatchEventHook(boil.AfterUpdateHook, watchEventAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	watchEventAfterUpdateHooks = []WatchEventHook{}
+
+	AddWatchEventHook(boil.BeforeDeleteHook, watchEventBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	watchEventBeforeDeleteHooks = []WatchEventHook{}
+
+	AddWatchEventHook(boil.AfterDeleteHook, watchEventAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	watchEventAfterDeleteHooks = []WatchEventHook{}
+
+	AddWatchEventHook(boil.BeforeUpsertHook, watchEventBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	watchEventBeforeUpsertHooks = []WatchEventHook{}
+
+	AddWatchEventHook(boil.AfterUpsertHook, watchEventAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	watchEventAfterUpsertHooks = []WatchEventHook{}
+}
+
+func testWatchEventsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchEvent{}
+	if err = randomize.Struct(seed, o, watchEventDBTypes, true, watchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WatchEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWatchEventsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchEvent{}
+	if err = randomize.Struct(seed, o, watchEventDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize WatchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(watchEventColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WatchEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWatchEventToOneWatchfilmUsingWatch(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local WatchEvent
+	var foreign Watchfilm
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, watchEventDBTypes, false, watchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchEvent struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, watchfilmDBTypes, false, watchfilmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Watchfilm struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.WatchID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Watch().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := WatchEventSlice{&local}
+	if err = local.L.LoadWatch(ctx, tx, false, (*[]*WatchEvent)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Watch == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Watch = nil
+	if err = local.L.LoadWatch(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Watch == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testWatchEventToOneSetOpWatchfilmUsingWatch(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a WatchEvent
+	var b, c Watchfilm
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, watchEventDBTypes, false, strmangle.SetComplement(watchEventPrimaryKeyColumns, watchEventColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, watchfilmDBTypes, false, strmangle.SetComplement(watchfilmPrimaryKeyColumns, watchfilmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, watchfilmDBTypes, false, strmangle.SetComplement(watchfilmPrimaryKeyColumns, watchfilmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Watchfilm{&b, &c} {
+		err = a.SetWatch(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Watch != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.WatchWatchEvents[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.WatchID != x.ID {
+			t.Error("foreign key was wrong value", a.WatchID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.WatchID))
+		reflect.Indirect(reflect.ValueOf(&a.WatchID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.WatchID != x.ID {
+			t.Error("foreign key was wrong value", a.WatchID, x.ID)
+		}
+	}
+}
+
+func testWatchEventsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchEvent{}
+	if err = randomize.Struct(seed, o, watchEventDBTypes, true, watchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWatchEventsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchEvent{}
+	if err = randomize.Struct(seed, o, watchEventDBTypes, true, watchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WatchEventSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWatchEventsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchEvent{}
+	if err = randomize.Struct(seed, o, watchEventDBTypes, true, watchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WatchEvents().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	watchEventDBTypes = map[string]string{`ID`: `integer`, `WatchID`: `integer`, `TimeWatched`: `timestamp with time zone`, `Rating`: `integer`, `Device`: `character varying`}
+	_                 = bytes.MinRead
+)
+
+func testWatchEventsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(watchEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(watchEventAllColumns) == len(watchEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchEvent{}
+	if err = randomize.Struct(seed, o, watchEventDBTypes, true, watchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WatchEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, watchEventDBTypes, true, watchEventPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WatchEvent struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testWatchEventsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(watchEventAllColumns) == len(watchEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchEvent{}
+	if err = randomize.Struct(seed, o, watchEventDBTypes, true, watchEventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WatchEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, watchEventDBTypes, true, watchEventPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WatchEvent struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(watchEventAllColumns, watchEventPrimaryKeyColumns) {
+		fields = watchEventAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			watchEventAllColumns,
+			watchEventPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := WatchEventSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testWatchEventsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(watchEventAllColumns) == len(watchEventPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := WatchEvent{}
+	if err = randomize.Struct(seed, &o, watchEventDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize WatchEvent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert WatchEvent: %s", err)
+	}
+
+	count, err := WatchEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, watchEventDBTypes, false, watchEventPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WatchEvent struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert WatchEvent: %s", err)
+	}
+
+	count, err = WatchEvents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1075 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// WatchfilmTag is an object representing the database table.
+type WatchfilmTag struct {
+	WatchID int    `db:"watch_id" boil:"watch_id" json:"watch_id" toml:"watch_id" yaml:"watch_id"`
+	Tag     string `db:"tag" boil:"tag" json:"tag" toml:"tag" yaml:"tag"`
+
+	R *watchfilmTagR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L watchfilmTagL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WatchfilmTagColumns = struct {
+	WatchID string
+	Tag     string
+}{
+	WatchID: "watch_id",
+	Tag:     "tag",
+}
+
+var WatchfilmTagTableColumns = struct {
+	WatchID string
+	Tag     string
+}{
+	WatchID: "watchfilm_tags.watch_id",
+	Tag:     "watchfilm_tags.tag",
+}
+
+// Generated where
+
+var WatchfilmTagWhere = struct {
+	WatchID whereHelperint
+	Tag     whereHelperstring
+}{
+	WatchID: whereHelperint{field: "\"watchfilm_tags\".\"watch_id\""},
+	Tag:     whereHelperstring{field: "\"watchfilm_tags\".\"tag\""},
+}
+
+// WatchfilmTagRels is where relationship names are stored.
+var WatchfilmTagRels = struct {
+	Watch string
+}{
+	Watch: "Watch",
+}
+
+// watchfilmTagR is where relationships are stored.
+type watchfilmTagR struct {
+	Watch *Watchfilm `db:"Watch" boil:"Watch" json:"Watch" toml:"Watch" yaml:"Watch"`
+}
+
+// NewStruct creates a new relationship struct
+func (*watchfilmTagR) NewStruct() *watchfilmTagR {
+	return &watchfilmTagR{}
+}
+
+func (r *watchfilmTagR) GetWatch() *Watchfilm {
+	if r == nil {
+		return nil
+	}
+	return r.Watch
+}
+
+// watchfilmTagL is where Load methods for each relationship are stored.
+type watchfilmTagL struct{}
+
+var (
+	watchfilmTagAllColumns            = []string{"watch_id", "tag"}
+	watchfilmTagColumnsWithoutDefault = []string{"watch_id", "tag"}
+	watchfilmTagColumnsWithDefault    = []string{}
+	watchfilmTagPrimaryKeyColumns     = []string{"watch_id", "tag"}
+	watchfilmTagGeneratedColumns      = []string{}
+)
+
+type (
+	// WatchfilmTagSlice is an alias for a slice of pointers to WatchfilmTag.
+	// This should almost always be used instead of []WatchfilmTag.
+	WatchfilmTagSlice []*WatchfilmTag
+	// WatchfilmTagHook is the signature for custom WatchfilmTag hook methods
+	WatchfilmTagHook func(context.Context, boil.ContextExecutor, *WatchfilmTag) error
+
+	watchfilmTagQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	watchfilmTagType                 = reflect.TypeOf(&WatchfilmTag{})
+	watchfilmTagMapping              = queries.MakeStructMapping(watchfilmTagType)
+	watchfilmTagPrimaryKeyMapping, _ = queries.BindMapping(watchfilmTagType, watchfilmTagMapping, watchfilmTagPrimaryKeyColumns)
+	watchfilmTagInsertCacheMut       sync.RWMutex
+	watchfilmTagInsertCache          = make(map[string]insertCache)
+	watchfilmTagUpdateCacheMut       sync.RWMutex
+	watchfilmTagUpdateCache          = make(map[string]updateCache)
+	watchfilmTagUpsertCacheMut       sync.RWMutex
+	watchfilmTagUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var watchfilmTagAfterSelectHooks []WatchfilmTagHook
+
+var watchfilmTagBeforeInsertHooks []WatchfilmTagHook
+var watchfilmTagAfterInsertHooks []WatchfilmTagHook
+
+var watchfilmTagBeforeUpdateHooks []WatchfilmTagHook
+var watchfilmTagAfterUpdateHooks []WatchfilmTagHook
+
+var watchfilmTagBeforeDeleteHooks []WatchfilmTagHook
+var watchfilmTagAfterDeleteHooks []WatchfilmTagHook
+
+var watchfilmTagBeforeUpsertHooks []WatchfilmTagHook
+var watchfilmTagAfterUpsertHooks []WatchfilmTagHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WatchfilmTag) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchfilmTagAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WatchfilmTag) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchfilmTagBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WatchfilmTag) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchfilmTagAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WatchfilmTag) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchfilmTagBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WatchfilmTag) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchfilmTagAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WatchfilmTag) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchfilmTagBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WatchfilmTag) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchfilmTagAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WatchfilmTag) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchfilmTagBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WatchfilmTag) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchfilmTagAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWatchfilmTagHook registers your hook function for all future operations.
+func AddWatchfilmTagHook(hookPoint boil.HookPoint, watchfilmTagHook WatchfilmTagHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		watchfilmTagAfterSelectHooks = append(watchfilmTagAfterSelectHooks, watchfilmTagHook)
+	case boil.BeforeInsertHook:
+		watchfilmTagBeforeInsertHooks = append(watchfilmTagBeforeInsertHooks, watchfilmTagHook)
+	case boil.AfterInsertHook:
+		watchfilmTagAfterInsertHooks = append(watchfilmTagAfterInsertHooks, watchfilmTagHook)
+	case boil.BeforeUpdateHook:
+		watchfilmTagBeforeUpdateHooks = append(watchfilmTagBeforeUpdateHooks, watchfilmTagHook)
+	case boil.AfterUpdateHook:
+		watchfilmTagAfterUpdateHooks = append(watchfilmTagAfterUpdateHooks, watchfilmTagHook)
+	case boil.BeforeDeleteHook:
+		watchfilmTagBeforeDeleteHooks = append(watchfilmTagBeforeDeleteHooks, watchfilmTagHook)
+	case boil.AfterDeleteHook:
+		watchfilmTagAfterDeleteHooks = append(watchfilmTagAfterDeleteHooks, watchfilmTagHook)
+	case boil.BeforeUpsertHook:
+		watchfilmTagBeforeUpsertHooks = append(watchfilmTagBeforeUpsertHooks, watchfilmTagHook)
+	case boil.AfterUpsertHook:
+		watchfilmTagAfterUpsertHooks = append(watchfilmTagAfterUpsertHooks, watchfilmTagHook)
+	}
+}
+
+// One returns a single watchfilmTag record from the query.
+func (q watchfilmTagQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WatchfilmTag, error) {
+	o := &WatchfilmTag{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for watchfilm_tags")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WatchfilmTag records from the query.
+func (q watchfilmTagQuery) All(ctx context.Context, exec boil.ContextExecutor) (WatchfilmTagSlice, error) {
+	var o []*WatchfilmTag
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WatchfilmTag slice")
+	}
+
+	if len(watchfilmTagAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WatchfilmTag records in the query.
+func (q watchfilmTagQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count watchfilm_tags rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q watchfilmTagQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if watchfilm_tags exists")
+	}
+
+	return count > 0, nil
+}
+
+// Watch pointed to by the foreign key.
+func (o *WatchfilmTag) Watch(mods ...qm.QueryMod) watchfilmQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.WatchID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Watchfilms(queryMods...)
+}
+
+// LoadWatch allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (watchfilmTagL) LoadWatch(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWatchfilmTag interface{}, mods queries.Applicator) error {
+	var slice []*WatchfilmTag
+	var object *WatchfilmTag
+
+	if singular {
+		var ok bool
+		object, ok = maybeWatchfilmTag.(*WatchfilmTag)
+		if !ok {
+			object = new(WatchfilmTag)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWatchfilmTag)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWatchfilmTag))
+			}
+		}
+	} else {
+		s, ok := maybeWatchfilmTag.(*[]*WatchfilmTag)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWatchfilmTag)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWatchfilmTag))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &watchfilmTagR{}
+		}
+		args = append(args, object.WatchID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &watchfilmTagR{}
+			}
+
+			for _, a := range args {
+				if a == obj.WatchID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.WatchID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`watchfilms`),
+		qm.WhereIn(`watchfilms.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Watchfilm")
+	}
+
+	var resultSlice []*Watchfilm
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Watchfilm")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for watchfilms")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for watchfilms")
+	}
+
+	if len(watchfilmTagAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Watch = foreign
+		if foreign.R == nil {
+			foreign.R = &watchfilmR{}
+		}
+		foreign.R.WatchWatchfilmTags = append(foreign.R.WatchWatchfilmTags, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.WatchID == foreign.ID {
+				local.R.Watch = foreign
+				if foreign.R == nil {
+					foreign.R = &watchfilmR{}
+				}
+				foreign.R.WatchWatchfilmTags = append(foreign.R.WatchWatchfilmTags, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetWatch of the watchfilmTag to the related item.
+// Sets o.R.Watch to related.
+// Adds o to related.R.WatchWatchfilmTags.
+func (o *WatchfilmTag) SetWatch(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Watchfilm) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"watchfilm_tags\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"watch_id"}),
+		strmangle.WhereClause("\"", "\"", 2, watchfilmTagPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.WatchID, o.Tag}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.WatchID = related.ID
+	if o.R == nil {
+		o.R = &watchfilmTagR{
+			Watch: related,
+		}
+	} else {
+		o.R.Watch = related
+	}
+
+	if related.R == nil {
+		related.R = &watchfilmR{
+			WatchWatchfilmTags: WatchfilmTagSlice{o},
+		}
+	} else {
+		related.R.WatchWatchfilmTags = append(related.R.WatchWatchfilmTags, o)
+	}
+
+	return nil
+}
+
+// WatchfilmTags retrieves all the records using an executor.
+func WatchfilmTags(mods ...qm.QueryMod) watchfilmTagQuery {
+	mods = append(mods, qm.From("\"watchfilm_tags\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"watchfilm_tags\".*"})
+	}
+
+	return watchfilmTagQuery{q}
+}
+
+// FindWatchfilmTag retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWatchfilmTag(ctx context.Context, exec boil.ContextExecutor, watchID int, tag string, selectCols ...string) (*WatchfilmTag, error) {
+	watchfilmTagObj := &WatchfilmTag{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"watchfilm_tags\" where \"watch_id\"=$1 AND \"tag\"=$2", sel,
+	)
+
+	q := queries.Raw(query, watchID, tag)
+
+	err := q.Bind(ctx, exec, watchfilmTagObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from watchfilm_tags")
+	}
+
+	if err = watchfilmTagObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return watchfilmTagObj, err
+	}
+
+	return watchfilmTagObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WatchfilmTag) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no watchfilm_tags provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(watchfilmTagColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	watchfilmTagInsertCacheMut.RLock()
+	cache, cached := watchfilmTagInsertCache[key]
+	watchfilmTagInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			watchfilmTagAllColumns,
+			watchfilmTagColumnsWithDefault,
+			watchfilmTagColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(watchfilmTagType, watchfilmTagMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(watchfilmTagType, watchfilmTagMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"watchfilm_tags\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"watchfilm_tags\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into watchfilm_tags")
+	}
+
+	if !cached {
+		watchfilmTagInsertCacheMut.Lock()
+		watchfilmTagInsertCache[key] = cache
+		watchfilmTagInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WatchfilmTag.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WatchfilmTag) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	watchfilmTagUpdateCacheMut.RLock()
+	cache, cached := watchfilmTagUpdateCache[key]
+	watchfilmTagUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			watchfilmTagAllColumns,
+			watchfilmTagPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update watchfilm_tags, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"watchfilm_tags\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, watchfilmTagPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(watchfilmTagType, watchfilmTagMapping, append(wl, watchfilmTagPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update watchfilm_tags row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for watchfilm_tags")
+	}
+
+	if !cached {
+		watchfilmTagUpdateCacheMut.Lock()
+		watchfilmTagUpdateCache[key] = cache
+		watchfilmTagUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q watchfilmTagQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for watchfilm_tags")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for watchfilm_tags")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WatchfilmTagSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), watchfilmTagPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"watchfilm_tags\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, watchfilmTagPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in watchfilmTag slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all watchfilmTag")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WatchfilmTag) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no watchfilm_tags provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(watchfilmTagColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	watchfilmTagUpsertCacheMut.RLock()
+	cache, cached := watchfilmTagUpsertCache[key]
+	watchfilmTagUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			watchfilmTagAllColumns,
+			watchfilmTagColumnsWithDefault,
+			watchfilmTagColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			watchfilmTagAllColumns,
+			watchfilmTagPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert watchfilm_tags, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(watchfilmTagPrimaryKeyColumns))
+			copy(conflict, watchfilmTagPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"watchfilm_tags\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(watchfilmTagType, watchfilmTagMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(watchfilmTagType, watchfilmTagMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert watchfilm_tags")
+	}
+
+	if !cached {
+		watchfilmTagUpsertCacheMut.Lock()
+		watchfilmTagUpsertCache[key] = cache
+		watchfilmTagUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WatchfilmTag record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WatchfilmTag) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WatchfilmTag provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), watchfilmTagPrimaryKeyMapping)
+	sql := "DELETE FROM \"watchfilm_tags\" WHERE \"watch_id\"=$1 AND \"tag\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from watchfilm_tags")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for watchfilm_tags")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q watchfilmTagQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no watchfilmTagQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from watchfilm_tags")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for watchfilm_tags")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WatchfilmTagSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(watchfilmTagBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), watchfilmTagPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"watchfilm_tags\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, watchfilmTagPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from watchfilmTag slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for watchfilm_tags")
+	}
+
+	if len(watchfilmTagAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WatchfilmTag) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWatchfilmTag(ctx, exec, o.WatchID, o.Tag)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WatchfilmTagSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WatchfilmTagSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), watchfilmTagPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"watchfilm_tags\".* FROM \"watchfilm_tags\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, watchfilmTagPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WatchfilmTagSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WatchfilmTagExists checks if the WatchfilmTag row exists.
+func WatchfilmTagExists(ctx context.Context, exec boil.ContextExecutor, watchID int, tag string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"watchfilm_tags\" where \"watch_id\"=$1 AND \"tag\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, watchID, tag)
+	}
+	row := exec.QueryRowContext(ctx, sql, watchID, tag)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if watchfilm_tags exists")
+	}
+
+	return exists, nil
+}
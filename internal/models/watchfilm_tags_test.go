@@ -0,0 +1,837 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testWatchfilmTags(t *testing.T) {
+	t.Parallel()
+
+	query := WatchfilmTags()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testWatchfilmTagsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmTag{}
+	if err = randomize.Struct(seed, o, watchfilmTagDBTypes, true, watchfilmTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WatchfilmTags().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWatchfilmTagsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmTag{}
+	if err = randomize.Struct(seed, o, watchfilmTagDBTypes, true, watchfilmTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := WatchfilmTags().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WatchfilmTags().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWatchfilmTagsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmTag{}
+	if err = randomize.Struct(seed, o, watchfilmTagDBTypes, true, watchfilmTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WatchfilmTagSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WatchfilmTags().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWatchfilmTagsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmTag{}
+	if err = randomize.Struct(seed, o, watchfilmTagDBTypes, true, watchfilmTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := WatchfilmTagExists(ctx, tx, o.WatchID, o.Tag)
+	if err != nil {
+		t.Errorf("Unable to check if WatchfilmTag exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected WatchfilmTagExists to return true, but got false.")
+	}
+}
+
+func testWatchfilmTagsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmTag{}
+	if err = randomize.Struct(seed, o, watchfilmTagDBTypes, true, watchfilmTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	watchfilmTagFound, err := FindWatchfilmTag(ctx, tx, o.WatchID, o.Tag)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if watchfilmTagFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testWatchfilmTagsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmTag{}
+	if err = randomize.Struct(seed, o, watchfilmTagDBTypes, true, watchfilmTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = WatchfilmTags().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWatchfilmTagsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmTag{}
+	if err = randomize.Struct(seed, o, watchfilmTagDBTypes, true, watchfilmTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := WatchfilmTags().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testWatchfilmTagsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	watchfilmTagOne := &WatchfilmTag{}
+	watchfilmTagTwo := &WatchfilmTag{}
+	if err = randomize.Struct(seed, watchfilmTagOne, watchfilmTagDBTypes, false, watchfilmTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmTag struct: %s", err)
+	}
+	if err = randomize.Struct(seed, watchfilmTagTwo, watchfilmTagDBTypes, false, watchfilmTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = watchfilmTagOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = watchfilmTagTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WatchfilmTags().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testWatchfilmTagsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	watchfilmTagOne := &WatchfilmTag{}
+	watchfilmTagTwo := &WatchfilmTag{}
+	if err = randomize.Struct(seed, watchfilmTagOne, watchfilmTagDBTypes, false, watchfilmTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmTag struct: %s", err)
+	}
+	if err = randomize.Struct(seed, watchfilmTagTwo, watchfilmTagDBTypes, false, watchfilmTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = watchfilmTagOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = watchfilmTagTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WatchfilmTags().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func watchfilmTagBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *WatchfilmTag) error {
+	*o = WatchfilmTag{}
+	return nil
+}
+
+func watchfilmTagAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *WatchfilmTag) error {
+	*o = WatchfilmTag{}
+	return nil
+}
+
+func watchfilmTagAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *WatchfilmTag) error {
+	*o = WatchfilmTag{}
+	return nil
+}
+
+func watchfilmTagBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *WatchfilmTag) error {
+	*o = WatchfilmTag{}
+	return nil
+}
+
+func watchfilmTagAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *WatchfilmTag) error {
+	*o = WatchfilmTag{}
+	return nil
+}
+
+func watchfilmTagBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *WatchfilmTag) error {
+	*o = WatchfilmTag{}
+	return nil
+}
+
+func watchfilmTagAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *WatchfilmTag) error {
+	*o = WatchfilmTag{}
+	return nil
+}
+
+func watchfilmTagBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *WatchfilmTag) error {
+	*o = WatchfilmTag{}
+	return nil
+}
+
+func watchfilmTagAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *WatchfilmTag) error {
+	*o = WatchfilmTag{}
+	return nil
+}
+
+func testWatchfilmTagsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &WatchfilmTag{}
+	o := &WatchfilmTag{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, watchfilmTagDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize WatchfilmTag object: %s", err)
+	}
+
+	AddWatchfilmTagHook(boil.BeforeInsertHook, watchfilmTagBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	watchfilmTagBeforeInsertHooks = []WatchfilmTagHook{}
+
+	AddWatchfilmTagHook(boil.AfterInsertHook, watchfilmTagAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	watchfilmTagAfterInsertHooks = []WatchfilmTagHook{}
+
+	AddWatchfilmTagHook(boil.AfterSelectHook, watchfilmTagAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	watchfilmTagAfterSelectHooks = []WatchfilmTagHook{}
+
+	AddWatchfilmTagHook(boil.BeforeUpdateHook, watchfilmTagBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	watchfilmTagBeforeUpdateHooks = []WatchfilmTagHook{}
+
+	AddWatchfilmTagHook(boil.AfterUpdateHook, watchfilmTagAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	watchfilmTagAfterUpdateHooks = []WatchfilmTagHook{}
+
+	AddWatchfilmTagHook(boil.BeforeDeleteHook, watchfilmTagBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	watchfilmTagBeforeDeleteHooks = []WatchfilmTagHook{}
+
+	AddWatchfilmTagHook(boil.AfterDeleteHook, watchfilmTagAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	watchfilmTagAfterDeleteHooks = []WatchfilmTagHook{}
+
+	AddWatchfilmTagHook(boil.BeforeUpsertHook, watchfilmTagBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	watchfilmTagBeforeUpsertHooks = []WatchfilmTagHook{}
+
+	AddWatchfilmTagHook(boil.AfterUpsertHook, watchfilmTagAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	watchfilmTagAfterUpsertHooks = []WatchfilmTagHook{}
+}
+
+func testWatchfilmTagsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmTag{}
+	if err = randomize.Struct(seed, o, watchfilmTagDBTypes, true, watchfilmTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WatchfilmTags().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWatchfilmTagsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmTag{}
+	if err = randomize.Struct(seed, o, watchfilmTagDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize WatchfilmTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(watchfilmTagColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WatchfilmTags().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWatchfilmTagToOneWatchfilmUsingWatch(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local WatchfilmTag
+	var foreign Watchfilm
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, watchfilmTagDBTypes, false, watchfilmTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmTag struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, watchfilmDBTypes, false, watchfilmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Watchfilm struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.WatchID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Watch().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := WatchfilmTagSlice{&local}
+	if err = local.L.LoadWatch(ctx, tx, false, (*[]*WatchfilmTag)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Watch == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Watch = nil
+	if err = local.L.LoadWatch(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Watch == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testWatchfilmTagToOneSetOpWatchfilmUsingWatch(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a WatchfilmTag
+	var b, c Watchfilm
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, watchfilmTagDBTypes, false, strmangle.SetComplement(watchfilmTagPrimaryKeyColumns, watchfilmTagColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, watchfilmDBTypes, false, strmangle.SetComplement(watchfilmPrimaryKeyColumns, watchfilmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, watchfilmDBTypes, false, strmangle.SetComplement(watchfilmPrimaryKeyColumns, watchfilmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Watchfilm{&b, &c} {
+		err = a.SetWatch(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Watch != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.WatchWatchfilmTags[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.WatchID != x.ID {
+			t.Error("foreign key was wrong value", a.WatchID)
+		}
+
+		if exists, err := WatchfilmTagExists(ctx, tx, a.WatchID, a.Tag); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testWatchfilmTagsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmTag{}
+	if err = randomize.Struct(seed, o, watchfilmTagDBTypes, true, watchfilmTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWatchfilmTagsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmTag{}
+	if err = randomize.Struct(seed, o, watchfilmTagDBTypes, true, watchfilmTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WatchfilmTagSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWatchfilmTagsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmTag{}
+	if err = randomize.Struct(seed, o, watchfilmTagDBTypes, true, watchfilmTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WatchfilmTags().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	watchfilmTagDBTypes = map[string]string{`WatchID`: `integer`, `Tag`: `character varying`}
+	_                   = bytes.MinRead
+)
+
+func testWatchfilmTagsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(watchfilmTagPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(watchfilmTagAllColumns) == len(watchfilmTagPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmTag{}
+	if err = randomize.Struct(seed, o, watchfilmTagDBTypes, true, watchfilmTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WatchfilmTags().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, watchfilmTagDBTypes, true, watchfilmTagPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmTag struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testWatchfilmTagsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(watchfilmTagAllColumns) == len(watchfilmTagPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmTag{}
+	if err = randomize.Struct(seed, o, watchfilmTagDBTypes, true, watchfilmTagColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WatchfilmTags().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, watchfilmTagDBTypes, true, watchfilmTagPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmTag struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(watchfilmTagAllColumns, watchfilmTagPrimaryKeyColumns) {
+		fields = watchfilmTagAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			watchfilmTagAllColumns,
+			watchfilmTagPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := WatchfilmTagSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testWatchfilmTagsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(watchfilmTagAllColumns) == len(watchfilmTagPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := WatchfilmTag{}
+	if err = randomize.Struct(seed, &o, watchfilmTagDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize WatchfilmTag struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert WatchfilmTag: %s", err)
+	}
+
+	count, err := WatchfilmTags().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, watchfilmTagDBTypes, false, watchfilmTagPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmTag struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert WatchfilmTag: %s", err)
+	}
+
+	count, err = WatchfilmTags().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
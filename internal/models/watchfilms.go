@@ -24,11 +24,14 @@ import (
 
 // Watchfilm is an object representing the database table.
 type Watchfilm struct {
-	ID          int       `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
-	UserID      int       `db:"-" boil:"user_id" json:"-" toml:"-" yaml:"-"`
-	FilmID      int       `db:"-" boil:"film_id" json:"-" toml:"-" yaml:"-"`
-	TimeAdded   time.Time `db:"time_added" boil:"time_added" json:"time_added" toml:"time_added" yaml:"time_added"`
-	TimeWatched null.Time `db:"time_watched" boil:"time_watched" json:"time_watched,omitempty" toml:"time_watched" yaml:"time_watched,omitempty"`
+	ID          int         `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID      int         `db:"-" boil:"user_id" json:"-" toml:"-" yaml:"-"`
+	FilmID      int         `db:"-" boil:"film_id" json:"-" toml:"-" yaml:"-"`
+	TimeAdded   time.Time   `db:"time_added" boil:"time_added" json:"time_added" toml:"time_added" yaml:"time_added"`
+	TimeWatched null.Time   `db:"time_watched" boil:"time_watched" json:"time_watched,omitempty" toml:"time_watched" yaml:"time_watched,omitempty"`
+	Position    int         `db:"position" boil:"position" json:"position" toml:"position" yaml:"position"`
+	Priority    int         `db:"priority" boil:"priority" json:"priority" toml:"priority" yaml:"priority"`
+	Note        null.String `db:"note" boil:"note" json:"note,omitempty" toml:"note" yaml:"note,omitempty"`
 
 	R *watchfilmR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L watchfilmL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -40,12 +43,18 @@ var WatchfilmColumns = struct {
 	FilmID      string
 	TimeAdded   string
 	TimeWatched string
+	Position    string
+	Priority    string
+	Note        string
 }{
 	ID:          "id",
 	UserID:      "user_id",
 	FilmID:      "film_id",
 	TimeAdded:   "time_added",
 	TimeWatched: "time_watched",
+	Position:    "position",
+	Priority:    "priority",
+	Note:        "note",
 }
 
 var WatchfilmTableColumns = struct {
@@ -54,12 +63,18 @@ var WatchfilmTableColumns = struct {
 	FilmID      string
 	TimeAdded   string
 	TimeWatched string
+	Position    string
+	Priority    string
+	Note        string
 }{
 	ID:          "watchfilms.id",
 	UserID:      "watchfilms.user_id",
 	FilmID:      "watchfilms.film_id",
 	TimeAdded:   "watchfilms.time_added",
 	TimeWatched: "watchfilms.time_watched",
+	Position:    "watchfilms.position",
+	Priority:    "watchfilms.priority",
+	Note:        "watchfilms.note",
 }
 
 // Generated where
@@ -70,27 +85,39 @@ var WatchfilmWhere = struct {
 	FilmID      whereHelperint
 	TimeAdded   whereHelpertime_Time
 	TimeWatched whereHelpernull_Time
+	Position    whereHelperint
+	Priority    whereHelperint
+	Note        whereHelpernull_String
 }{
 	ID:          whereHelperint{field: "\"watchfilms\".\"id\""},
 	UserID:      whereHelperint{field: "\"watchfilms\".\"user_id\""},
 	FilmID:      whereHelperint{field: "\"watchfilms\".\"film_id\""},
 	TimeAdded:   whereHelpertime_Time{field: "\"watchfilms\".\"time_added\""},
 	TimeWatched: whereHelpernull_Time{field: "\"watchfilms\".\"time_watched\""},
+	Position:    whereHelperint{field: "\"watchfilms\".\"position\""},
+	Priority:    whereHelperint{field: "\"watchfilms\".\"priority\""},
+	Note:        whereHelpernull_String{field: "\"watchfilms\".\"note\""},
 }
 
 // WatchfilmRels is where relationship names are stored.
 var WatchfilmRels = struct {
-	Film string
-	User string
+	Film               string
+	User               string
+	WatchWatchEvents   string
+	WatchWatchfilmTags string
 }{
-	Film: "Film",
-	User: "User",
+	Film:               "Film",
+	User:               "User",
+	WatchWatchEvents:   "WatchWatchEvents",
+	WatchWatchfilmTags: "WatchWatchfilmTags",
 }
 
 // watchfilmR is where relationships are stored.
 type watchfilmR struct {
-	Film *Film `db:"Film" boil:"Film" json:"Film" toml:"Film" yaml:"Film"`
-	User *User `db:"User" boil:"User" json:"User" toml:"User" yaml:"User"`
+	Film               *Film             `db:"Film" boil:"Film" json:"Film" toml:"Film" yaml:"Film"`
+	User               *User             `db:"User" boil:"User" json:"User" toml:"User" yaml:"User"`
+	WatchWatchEvents   WatchEventSlice   `db:"WatchWatchEvents" boil:"WatchWatchEvents" json:"WatchWatchEvents" toml:"WatchWatchEvents" yaml:"WatchWatchEvents"`
+	WatchWatchfilmTags WatchfilmTagSlice `db:"WatchWatchfilmTags" boil:"WatchWatchfilmTags" json:"WatchWatchfilmTags" toml:"WatchWatchfilmTags" yaml:"WatchWatchfilmTags"`
 }
 
 // NewStruct creates a new relationship struct
@@ -112,13 +139,27 @@ func (r *watchfilmR) GetUser() *User {
 	return r.User
 }
 
+func (r *watchfilmR) GetWatchWatchEvents() WatchEventSlice {
+	if r == nil {
+		return nil
+	}
+	return r.WatchWatchEvents
+}
+
+func (r *watchfilmR) GetWatchWatchfilmTags() WatchfilmTagSlice {
+	if r == nil {
+		return nil
+	}
+	return r.WatchWatchfilmTags
+}
+
 // watchfilmL is where Load methods for each relationship are stored.
 type watchfilmL struct{}
 
 var (
-	watchfilmAllColumns            = []string{"id", "user_id", "film_id", "time_added", "time_watched"}
-	watchfilmColumnsWithoutDefault = []string{"user_id", "film_id"}
-	watchfilmColumnsWithDefault    = []string{"id", "time_added", "time_watched"}
+	watchfilmAllColumns            = []string{"id", "user_id", "film_id", "time_added", "time_watched", "position", "priority", "note"}
+	watchfilmColumnsWithoutDefault = []string{"user_id", "film_id", "position"}
+	watchfilmColumnsWithDefault    = []string{"id", "time_added", "time_watched", "priority", "note"}
 	watchfilmPrimaryKeyColumns     = []string{"id"}
 	watchfilmGeneratedColumns      = []string{}
 )
@@ -423,6 +464,34 @@ func (o *Watchfilm) User(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// WatchWatchEvents retrieves all the watch_event's WatchEvents with an executor via watch_id column.
+func (o *Watchfilm) WatchWatchEvents(mods ...qm.QueryMod) watchEventQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"watch_events\".\"watch_id\"=?", o.ID),
+	)
+
+	return WatchEvents(queryMods...)
+}
+
+// WatchWatchfilmTags retrieves all the watchfilm_tag's WatchfilmTags with an executor via watch_id column.
+func (o *Watchfilm) WatchWatchfilmTags(mods ...qm.QueryMod) watchfilmTagQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"watchfilm_tags\".\"watch_id\"=?", o.ID),
+	)
+
+	return WatchfilmTags(queryMods...)
+}
+
 // LoadFilm allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (watchfilmL) LoadFilm(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWatchfilm interface{}, mods queries.Applicator) error {
@@ -663,6 +732,234 @@ func (watchfilmL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
+// LoadWatchWatchEvents allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (watchfilmL) LoadWatchWatchEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWatchfilm interface{}, mods queries.Applicator) error {
+	var slice []*Watchfilm
+	var object *Watchfilm
+
+	if singular {
+		var ok bool
+		object, ok = maybeWatchfilm.(*Watchfilm)
+		if !ok {
+			object = new(Watchfilm)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWatchfilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWatchfilm))
+			}
+		}
+	} else {
+		s, ok := maybeWatchfilm.(*[]*Watchfilm)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWatchfilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWatchfilm))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &watchfilmR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &watchfilmR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`watch_events`),
+		qm.WhereIn(`watch_events.watch_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load watch_events")
+	}
+
+	var resultSlice []*WatchEvent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice watch_events")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on watch_events")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for watch_events")
+	}
+
+	if len(watchEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WatchWatchEvents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &watchEventR{}
+			}
+			foreign.R.Watch = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.WatchID {
+				local.R.WatchWatchEvents = append(local.R.WatchWatchEvents, foreign)
+				if foreign.R == nil {
+					foreign.R = &watchEventR{}
+				}
+				foreign.R.Watch = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWatchWatchfilmTags allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (watchfilmL) LoadWatchWatchfilmTags(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWatchfilm interface{}, mods queries.Applicator) error {
+	var slice []*Watchfilm
+	var object *Watchfilm
+
+	if singular {
+		var ok bool
+		object, ok = maybeWatchfilm.(*Watchfilm)
+		if !ok {
+			object = new(Watchfilm)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWatchfilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWatchfilm))
+			}
+		}
+	} else {
+		s, ok := maybeWatchfilm.(*[]*Watchfilm)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWatchfilm)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWatchfilm))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &watchfilmR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &watchfilmR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`watchfilm_tags`),
+		qm.WhereIn(`watchfilm_tags.watch_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load watchfilm_tags")
+	}
+
+	var resultSlice []*WatchfilmTag
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice watchfilm_tags")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on watchfilm_tags")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for watchfilm_tags")
+	}
+
+	if len(watchfilmTagAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WatchWatchfilmTags = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &watchfilmTagR{}
+			}
+			foreign.R.Watch = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.WatchID {
+				local.R.WatchWatchfilmTags = append(local.R.WatchWatchfilmTags, foreign)
+				if foreign.R == nil {
+					foreign.R = &watchfilmTagR{}
+				}
+				foreign.R.Watch = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetFilm of the watchfilm to the related item.
 // Sets o.R.Film to related.
 // Adds o to related.R.Watchfilms.
@@ -757,6 +1054,112 @@ func (o *Watchfilm) SetUser(ctx context.Context, exec boil.ContextExecutor, inse
 	return nil
 }
 
+// AddWatchWatchEvents adds the given related objects to the existing relationships
+// of the watchfilm, optionally inserting them as new records.
+// Appends related to o.R.WatchWatchEvents.
+// Sets related.R.Watch appropriately.
+func (o *Watchfilm) AddWatchWatchEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WatchEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.WatchID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"watch_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"watch_id"}),
+				strmangle.WhereClause("\"", "\"", 2, watchEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.WatchID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &watchfilmR{
+			WatchWatchEvents: related,
+		}
+	} else {
+		o.R.WatchWatchEvents = append(o.R.WatchWatchEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &watchEventR{
+				Watch: o,
+			}
+		} else {
+			rel.R.Watch = o
+		}
+	}
+	return nil
+}
+
+// AddWatchWatchfilmTags adds the given related objects to the existing relationships
+// of the watchfilm, optionally inserting them as new records.
+// Appends related to o.R.WatchWatchfilmTags.
+// Sets related.R.Watch appropriately.
+func (o *Watchfilm) AddWatchWatchfilmTags(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WatchfilmTag) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.WatchID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"watchfilm_tags\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"watch_id"}),
+				strmangle.WhereClause("\"", "\"", 2, watchfilmTagPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.WatchID, rel.Tag}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.WatchID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &watchfilmR{
+			WatchWatchfilmTags: related,
+		}
+	} else {
+		o.R.WatchWatchfilmTags = append(o.R.WatchWatchfilmTags, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &watchfilmTagR{
+				Watch: o,
+			}
+		} else {
+			rel.R.Watch = o
+		}
+	}
+	return nil
+}
+
 // Watchfilms retrieves all the records using an executor.
 func Watchfilms(mods ...qm.QueryMod) watchfilmQuery {
 	mods = append(mods, qm.From("\"watchfilms\""))
@@ -0,0 +1,950 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// WatchfilmsAudit is an object representing the database table.
+type WatchfilmsAudit struct {
+	ID          int         `db:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID      int         `db:"user_id" boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	FilmID      int         `db:"film_id" boil:"film_id" json:"film_id" toml:"film_id" yaml:"film_id"`
+	TimeAdded   time.Time   `db:"time_added" boil:"time_added" json:"time_added" toml:"time_added" yaml:"time_added"`
+	TimeWatched null.Time   `db:"time_watched" boil:"time_watched" json:"time_watched,omitempty" toml:"time_watched" yaml:"time_watched,omitempty"`
+	AuditAction string      `db:"audit_action" boil:"audit_action" json:"audit_action" toml:"audit_action" yaml:"audit_action"`
+	AuditActor  null.Int    `db:"audit_actor" boil:"audit_actor" json:"audit_actor,omitempty" toml:"audit_actor" yaml:"audit_actor,omitempty"`
+	AuditedAt   time.Time   `db:"audited_at" boil:"audited_at" json:"audited_at" toml:"audited_at" yaml:"audited_at"`
+	Position    null.Int    `db:"position" boil:"position" json:"position,omitempty" toml:"position" yaml:"position,omitempty"`
+	Priority    int         `db:"priority" boil:"priority" json:"priority" toml:"priority" yaml:"priority"`
+	Note        null.String `db:"note" boil:"note" json:"note,omitempty" toml:"note" yaml:"note,omitempty"`
+
+	R *watchfilmsAuditR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L watchfilmsAuditL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WatchfilmsAuditColumns = struct {
+	ID          string
+	UserID      string
+	FilmID      string
+	TimeAdded   string
+	TimeWatched string
+	AuditAction string
+	AuditActor  string
+	AuditedAt   string
+	Position    string
+	Priority    string
+	Note        string
+}{
+	ID:          "id",
+	UserID:      "user_id",
+	FilmID:      "film_id",
+	TimeAdded:   "time_added",
+	TimeWatched: "time_watched",
+	AuditAction: "audit_action",
+	AuditActor:  "audit_actor",
+	AuditedAt:   "audited_at",
+	Position:    "position",
+	Priority:    "priority",
+	Note:        "note",
+}
+
+var WatchfilmsAuditTableColumns = struct {
+	ID          string
+	UserID      string
+	FilmID      string
+	TimeAdded   string
+	TimeWatched string
+	AuditAction string
+	AuditActor  string
+	AuditedAt   string
+	Position    string
+	Priority    string
+	Note        string
+}{
+	ID:          "watchfilms_audit.id",
+	UserID:      "watchfilms_audit.user_id",
+	FilmID:      "watchfilms_audit.film_id",
+	TimeAdded:   "watchfilms_audit.time_added",
+	TimeWatched: "watchfilms_audit.time_watched",
+	AuditAction: "watchfilms_audit.audit_action",
+	AuditActor:  "watchfilms_audit.audit_actor",
+	AuditedAt:   "watchfilms_audit.audited_at",
+	Position:    "watchfilms_audit.position",
+	Priority:    "watchfilms_audit.priority",
+	Note:        "watchfilms_audit.note",
+}
+
+// Generated where
+
+var WatchfilmsAuditWhere = struct {
+	ID          whereHelperint
+	UserID      whereHelperint
+	FilmID      whereHelperint
+	TimeAdded   whereHelpertime_Time
+	TimeWatched whereHelpernull_Time
+	AuditAction whereHelperstring
+	AuditActor  whereHelpernull_Int
+	AuditedAt   whereHelpertime_Time
+	Position    whereHelpernull_Int
+	Priority    whereHelperint
+	Note        whereHelpernull_String
+}{
+	ID:          whereHelperint{field: "\"watchfilms_audit\".\"id\""},
+	UserID:      whereHelperint{field: "\"watchfilms_audit\".\"user_id\""},
+	FilmID:      whereHelperint{field: "\"watchfilms_audit\".\"film_id\""},
+	TimeAdded:   whereHelpertime_Time{field: "\"watchfilms_audit\".\"time_added\""},
+	TimeWatched: whereHelpernull_Time{field: "\"watchfilms_audit\".\"time_watched\""},
+	AuditAction: whereHelperstring{field: "\"watchfilms_audit\".\"audit_action\""},
+	AuditActor:  whereHelpernull_Int{field: "\"watchfilms_audit\".\"audit_actor\""},
+	AuditedAt:   whereHelpertime_Time{field: "\"watchfilms_audit\".\"audited_at\""},
+	Position:    whereHelpernull_Int{field: "\"watchfilms_audit\".\"position\""},
+	Priority:    whereHelperint{field: "\"watchfilms_audit\".\"priority\""},
+	Note:        whereHelpernull_String{field: "\"watchfilms_audit\".\"note\""},
+}
+
+// WatchfilmsAuditRels is where relationship names are stored.
+var WatchfilmsAuditRels = struct {
+}{}
+
+// watchfilmsAuditR is where relationships are stored.
+type watchfilmsAuditR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*watchfilmsAuditR) NewStruct() *watchfilmsAuditR {
+	return &watchfilmsAuditR{}
+}
+
+// watchfilmsAuditL is where Load methods for each relationship are stored.
+type watchfilmsAuditL struct{}
+
+var (
+	watchfilmsAuditAllColumns            = []string{"id", "user_id", "film_id", "time_added", "time_watched", "audit_action", "audit_actor", "audited_at", "position", "priority", "note"}
+	watchfilmsAuditColumnsWithoutDefault = []string{"id", "user_id", "film_id", "time_added"}
+	watchfilmsAuditColumnsWithDefault    = []string{"time_watched", "audit_action", "audit_actor", "audited_at", "position", "priority", "note"}
+	watchfilmsAuditPrimaryKeyColumns     = []string{"id", "audited_at"}
+	watchfilmsAuditGeneratedColumns      = []string{}
+)
+
+type (
+	// WatchfilmsAuditSlice is an alias for a slice of pointers to WatchfilmsAudit.
+	// This should almost always be used instead of []WatchfilmsAudit.
+	WatchfilmsAuditSlice []*WatchfilmsAudit
+	// WatchfilmsAuditHook is the signature for custom WatchfilmsAudit hook methods
+	WatchfilmsAuditHook func(context.Context, boil.ContextExecutor, *WatchfilmsAudit) error
+
+	watchfilmsAuditQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	watchfilmsAuditType                 = reflect.TypeOf(&WatchfilmsAudit{})
+	watchfilmsAuditMapping              = queries.MakeStructMapping(watchfilmsAuditType)
+	watchfilmsAuditPrimaryKeyMapping, _ = queries.BindMapping(watchfilmsAuditType, watchfilmsAuditMapping, watchfilmsAuditPrimaryKeyColumns)
+	watchfilmsAuditInsertCacheMut       sync.RWMutex
+	watchfilmsAuditInsertCache          = make(map[string]insertCache)
+	watchfilmsAuditUpdateCacheMut       sync.RWMutex
+	watchfilmsAuditUpdateCache          = make(map[string]updateCache)
+	watchfilmsAuditUpsertCacheMut       sync.RWMutex
+	watchfilmsAuditUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var watchfilmsAuditAfterSelectHooks []WatchfilmsAuditHook
+
+var watchfilmsAuditBeforeInsertHooks []WatchfilmsAuditHook
+var watchfilmsAuditAfterInsertHooks []WatchfilmsAuditHook
+
+var watchfilmsAuditBeforeUpdateHooks []WatchfilmsAuditHook
+var watchfilmsAuditAfterUpdateHooks []WatchfilmsAuditHook
+
+var watchfilmsAuditBeforeDeleteHooks []WatchfilmsAuditHook
+var watchfilmsAuditAfterDeleteHooks []WatchfilmsAuditHook
+
+var watchfilmsAuditBeforeUpsertHooks []WatchfilmsAuditHook
+var watchfilmsAuditAfterUpsertHooks []WatchfilmsAuditHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WatchfilmsAudit) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchfilmsAuditAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WatchfilmsAudit) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchfilmsAuditBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WatchfilmsAudit) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchfilmsAuditAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WatchfilmsAudit) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchfilmsAuditBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WatchfilmsAudit) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchfilmsAuditAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WatchfilmsAudit) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchfilmsAuditBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WatchfilmsAudit) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchfilmsAuditAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WatchfilmsAudit) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchfilmsAuditBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WatchfilmsAudit) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range watchfilmsAuditAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWatchfilmsAuditHook registers your hook function for all future operations.
+func AddWatchfilmsAuditHook(hookPoint boil.HookPoint, watchfilmsAuditHook WatchfilmsAuditHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		watchfilmsAuditAfterSelectHooks = append(watchfilmsAuditAfterSelectHooks, watchfilmsAuditHook)
+	case boil.BeforeInsertHook:
+		watchfilmsAuditBeforeInsertHooks = append(watchfilmsAuditBeforeInsertHooks, watchfilmsAuditHook)
+	case boil.AfterInsertHook:
+		watchfilmsAuditAfterInsertHooks = append(watchfilmsAuditAfterInsertHooks, watchfilmsAuditHook)
+	case boil.BeforeUpdateHook:
+		watchfilmsAuditBeforeUpdateHooks = append(watchfilmsAuditBeforeUpdateHooks, watchfilmsAuditHook)
+	case boil.AfterUpdateHook:
+		watchfilmsAuditAfterUpdateHooks = append(watchfilmsAuditAfterUpdateHooks, watchfilmsAuditHook)
+	case boil.BeforeDeleteHook:
+		watchfilmsAuditBeforeDeleteHooks = append(watchfilmsAuditBeforeDeleteHooks, watchfilmsAuditHook)
+	case boil.AfterDeleteHook:
+		watchfilmsAuditAfterDeleteHooks = append(watchfilmsAuditAfterDeleteHooks, watchfilmsAuditHook)
+	case boil.BeforeUpsertHook:
+		watchfilmsAuditBeforeUpsertHooks = append(watchfilmsAuditBeforeUpsertHooks, watchfilmsAuditHook)
+	case boil.AfterUpsertHook:
+		watchfilmsAuditAfterUpsertHooks = append(watchfilmsAuditAfterUpsertHooks, watchfilmsAuditHook)
+	}
+}
+
+// One returns a single watchfilmsAudit record from the query.
+func (q watchfilmsAuditQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WatchfilmsAudit, error) {
+	o := &WatchfilmsAudit{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for watchfilms_audit")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WatchfilmsAudit records from the query.
+func (q watchfilmsAuditQuery) All(ctx context.Context, exec boil.ContextExecutor) (WatchfilmsAuditSlice, error) {
+	var o []*WatchfilmsAudit
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WatchfilmsAudit slice")
+	}
+
+	if len(watchfilmsAuditAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WatchfilmsAudit records in the query.
+func (q watchfilmsAuditQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count watchfilms_audit rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q watchfilmsAuditQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if watchfilms_audit exists")
+	}
+
+	return count > 0, nil
+}
+
+// WatchfilmsAudits retrieves all the records using an executor.
+func WatchfilmsAudits(mods ...qm.QueryMod) watchfilmsAuditQuery {
+	mods = append(mods, qm.From("\"watchfilms_audit\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"watchfilms_audit\".*"})
+	}
+
+	return watchfilmsAuditQuery{q}
+}
+
+// FindWatchfilmsAudit retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWatchfilmsAudit(ctx context.Context, exec boil.ContextExecutor, iD int, auditedAt time.Time, selectCols ...string) (*WatchfilmsAudit, error) {
+	watchfilmsAuditObj := &WatchfilmsAudit{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"watchfilms_audit\" where \"id\"=$1 AND \"audited_at\"=$2", sel,
+	)
+
+	q := queries.Raw(query, iD, auditedAt)
+
+	err := q.Bind(ctx, exec, watchfilmsAuditObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from watchfilms_audit")
+	}
+
+	if err = watchfilmsAuditObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return watchfilmsAuditObj, err
+	}
+
+	return watchfilmsAuditObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WatchfilmsAudit) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no watchfilms_audit provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(watchfilmsAuditColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	watchfilmsAuditInsertCacheMut.RLock()
+	cache, cached := watchfilmsAuditInsertCache[key]
+	watchfilmsAuditInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			watchfilmsAuditAllColumns,
+			watchfilmsAuditColumnsWithDefault,
+			watchfilmsAuditColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(watchfilmsAuditType, watchfilmsAuditMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(watchfilmsAuditType, watchfilmsAuditMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"watchfilms_audit\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"watchfilms_audit\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into watchfilms_audit")
+	}
+
+	if !cached {
+		watchfilmsAuditInsertCacheMut.Lock()
+		watchfilmsAuditInsertCache[key] = cache
+		watchfilmsAuditInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WatchfilmsAudit.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WatchfilmsAudit) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	watchfilmsAuditUpdateCacheMut.RLock()
+	cache, cached := watchfilmsAuditUpdateCache[key]
+	watchfilmsAuditUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			watchfilmsAuditAllColumns,
+			watchfilmsAuditPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update watchfilms_audit, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"watchfilms_audit\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, watchfilmsAuditPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(watchfilmsAuditType, watchfilmsAuditMapping, append(wl, watchfilmsAuditPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update watchfilms_audit row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for watchfilms_audit")
+	}
+
+	if !cached {
+		watchfilmsAuditUpdateCacheMut.Lock()
+		watchfilmsAuditUpdateCache[key] = cache
+		watchfilmsAuditUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q watchfilmsAuditQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for watchfilms_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for watchfilms_audit")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WatchfilmsAuditSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), watchfilmsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"watchfilms_audit\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, watchfilmsAuditPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in watchfilmsAudit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all watchfilmsAudit")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WatchfilmsAudit) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no watchfilms_audit provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(watchfilmsAuditColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	watchfilmsAuditUpsertCacheMut.RLock()
+	cache, cached := watchfilmsAuditUpsertCache[key]
+	watchfilmsAuditUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			watchfilmsAuditAllColumns,
+			watchfilmsAuditColumnsWithDefault,
+			watchfilmsAuditColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			watchfilmsAuditAllColumns,
+			watchfilmsAuditPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert watchfilms_audit, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(watchfilmsAuditPrimaryKeyColumns))
+			copy(conflict, watchfilmsAuditPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"watchfilms_audit\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(watchfilmsAuditType, watchfilmsAuditMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(watchfilmsAuditType, watchfilmsAuditMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert watchfilms_audit")
+	}
+
+	if !cached {
+		watchfilmsAuditUpsertCacheMut.Lock()
+		watchfilmsAuditUpsertCache[key] = cache
+		watchfilmsAuditUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WatchfilmsAudit record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WatchfilmsAudit) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WatchfilmsAudit provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), watchfilmsAuditPrimaryKeyMapping)
+	sql := "DELETE FROM \"watchfilms_audit\" WHERE \"id\"=$1 AND \"audited_at\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from watchfilms_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for watchfilms_audit")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q watchfilmsAuditQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no watchfilmsAuditQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from watchfilms_audit")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for watchfilms_audit")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WatchfilmsAuditSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(watchfilmsAuditBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), watchfilmsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"watchfilms_audit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, watchfilmsAuditPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from watchfilmsAudit slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for watchfilms_audit")
+	}
+
+	if len(watchfilmsAuditAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WatchfilmsAudit) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWatchfilmsAudit(ctx, exec, o.ID, o.AuditedAt)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WatchfilmsAuditSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WatchfilmsAuditSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), watchfilmsAuditPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"watchfilms_audit\".* FROM \"watchfilms_audit\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, watchfilmsAuditPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WatchfilmsAuditSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WatchfilmsAuditExists checks if the WatchfilmsAudit row exists.
+func WatchfilmsAuditExists(ctx context.Context, exec boil.ContextExecutor, iD int, auditedAt time.Time) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"watchfilms_audit\" where \"id\"=$1 AND \"audited_at\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD, auditedAt)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD, auditedAt)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if watchfilms_audit exists")
+	}
+
+	return exists, nil
+}
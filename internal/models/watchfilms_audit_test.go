@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testWatchfilmsAudits(t *testing.T) {
+	t.Parallel()
+
+	query := WatchfilmsAudits()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testWatchfilmsAuditsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmsAudit{}
+	if err = randomize.Struct(seed, o, watchfilmsAuditDBTypes, true, watchfilmsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WatchfilmsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWatchfilmsAuditsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmsAudit{}
+	if err = randomize.Struct(seed, o, watchfilmsAuditDBTypes, true, watchfilmsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := WatchfilmsAudits().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WatchfilmsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWatchfilmsAuditsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmsAudit{}
+	if err = randomize.Struct(seed, o, watchfilmsAuditDBTypes, true, watchfilmsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WatchfilmsAuditSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WatchfilmsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWatchfilmsAuditsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmsAudit{}
+	if err = randomize.Struct(seed, o, watchfilmsAuditDBTypes, true, watchfilmsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := WatchfilmsAuditExists(ctx, tx, o.ID, o.AuditedAt)
+	if err != nil {
+		t.Errorf("Unable to check if WatchfilmsAudit exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected WatchfilmsAuditExists to return true, but got false.")
+	}
+}
+
+func testWatchfilmsAuditsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmsAudit{}
+	if err = randomize.Struct(seed, o, watchfilmsAuditDBTypes, true, watchfilmsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	watchfilmsAuditFound, err := FindWatchfilmsAudit(ctx, tx, o.ID, o.AuditedAt)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if watchfilmsAuditFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testWatchfilmsAuditsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmsAudit{}
+	if err = randomize.Struct(seed, o, watchfilmsAuditDBTypes, true, watchfilmsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = WatchfilmsAudits().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWatchfilmsAuditsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmsAudit{}
+	if err = randomize.Struct(seed, o, watchfilmsAuditDBTypes, true, watchfilmsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := WatchfilmsAudits().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testWatchfilmsAuditsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	watchfilmsAuditOne := &WatchfilmsAudit{}
+	watchfilmsAuditTwo := &WatchfilmsAudit{}
+	if err = randomize.Struct(seed, watchfilmsAuditOne, watchfilmsAuditDBTypes, false, watchfilmsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmsAudit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, watchfilmsAuditTwo, watchfilmsAuditDBTypes, false, watchfilmsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = watchfilmsAuditOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = watchfilmsAuditTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WatchfilmsAudits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testWatchfilmsAuditsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	watchfilmsAuditOne := &WatchfilmsAudit{}
+	watchfilmsAuditTwo := &WatchfilmsAudit{}
+	if err = randomize.Struct(seed, watchfilmsAuditOne, watchfilmsAuditDBTypes, false, watchfilmsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmsAudit struct: %s", err)
+	}
+	if err = randomize.Struct(seed, watchfilmsAuditTwo, watchfilmsAuditDBTypes, false, watchfilmsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = watchfilmsAuditOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = watchfilmsAuditTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WatchfilmsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func watchfilmsAuditBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *WatchfilmsAudit) error {
+	*o = WatchfilmsAudit{}
+	return nil
+}
+
+func watchfilmsAuditAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *WatchfilmsAudit) error {
+	*o = WatchfilmsAudit{}
+	return nil
+}
+
+func watchfilmsAuditAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *WatchfilmsAudit) error {
+	*o = WatchfilmsAudit{}
+	return nil
+}
+
+func watchfilmsAuditBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *WatchfilmsAudit) error {
+	*o = WatchfilmsAudit{}
+	return nil
+}
+
+func watchfilmsAuditAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *WatchfilmsAudit) error {
+	*o = WatchfilmsAudit{}
+	return nil
+}
+
+func watchfilmsAuditBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *WatchfilmsAudit) error {
+	*o = WatchfilmsAudit{}
+	return nil
+}
+
+func watchfilmsAuditAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *WatchfilmsAudit) error {
+	*o = WatchfilmsAudit{}
+	return nil
+}
+
+func watchfilmsAuditBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *WatchfilmsAudit) error {
+	*o = WatchfilmsAudit{}
+	return nil
+}
+
+func watchfilmsAuditAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *WatchfilmsAudit) error {
+	*o = WatchfilmsAudit{}
+	return nil
+}
+
+func testWatchfilmsAuditsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &WatchfilmsAudit{}
+	o := &WatchfilmsAudit{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, watchfilmsAuditDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize WatchfilmsAudit object: %s", err)
+	}
+
+	AddWatchfilmsAuditHook(boil.BeforeInsertHook, watchfilmsAuditBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	watchfilmsAuditBeforeInsertHooks = []WatchfilmsAuditHook{}
+
+	AddWatchfilmsAuditHook(boil.AfterInsertHook, watchfilmsAuditAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	watchfilmsAuditAfterInsertHooks = []WatchfilmsAuditHook{}
+
+	AddWatchfilmsAuditHook(boil.AfterSelectHook, watchfilmsAuditAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	watchfilmsAuditAfterSelectHooks = []WatchfilmsAuditHook{}
+
+	AddWatchfilmsAuditHook(boil.BeforeUpdateHook, watchfilmsAuditBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	watchfilmsAuditBeforeUpdateHooks = []WatchfilmsAuditHook{}
+
+	AddWatchfilmsAuditHook(boil.AfterUpdateHook, watchfilmsAuditAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	watchfilmsAuditAfterUpdateHooks = []WatchfilmsAuditHook{}
+
+	AddWatchfilmsAuditHook(boil.BeforeDeleteHook, watchfilmsAuditBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	watchfilmsAuditBeforeDeleteHooks = []WatchfilmsAuditHook{}
+
+	AddWatchfilmsAuditHook(boil.AfterDeleteHook, watchfilmsAuditAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	watchfilmsAuditAfterDeleteHooks = []WatchfilmsAuditHook{}
+
+	AddWatchfilmsAuditHook(boil.BeforeUpsertHook, watchfilmsAuditBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	watchfilmsAuditBeforeUpsertHooks = []WatchfilmsAuditHook{}
+
+	AddWatchfilmsAuditHook(boil.AfterUpsertHook, watchfilmsAuditAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	watchfilmsAuditAfterUpsertHooks = []WatchfilmsAuditHook{}
+}
+
+func testWatchfilmsAuditsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmsAudit{}
+	if err = randomize.Struct(seed, o, watchfilmsAuditDBTypes, true, watchfilmsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WatchfilmsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWatchfilmsAuditsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmsAudit{}
+	if err = randomize.Struct(seed, o, watchfilmsAuditDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize WatchfilmsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(watchfilmsAuditColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WatchfilmsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWatchfilmsAuditsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmsAudit{}
+	if err = randomize.Struct(seed, o, watchfilmsAuditDBTypes, true, watchfilmsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWatchfilmsAuditsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmsAudit{}
+	if err = randomize.Struct(seed, o, watchfilmsAuditDBTypes, true, watchfilmsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WatchfilmsAuditSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWatchfilmsAuditsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmsAudit{}
+	if err = randomize.Struct(seed, o, watchfilmsAuditDBTypes, true, watchfilmsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WatchfilmsAudits().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	watchfilmsAuditDBTypes = map[string]string{`ID`: `integer`, `UserID`: `integer`, `FilmID`: `integer`, `TimeAdded`: `timestamp with time zone`, `TimeWatched`: `timestamp with time zone`, `AuditAction`: `character varying`, `AuditActor`: `integer`, `AuditedAt`: `timestamp with time zone`, `Position`: `integer`, `Priority`: `integer`, `Note`: `character varying`}
+	_                      = bytes.MinRead
+)
+
+func testWatchfilmsAuditsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(watchfilmsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(watchfilmsAuditAllColumns) == len(watchfilmsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmsAudit{}
+	if err = randomize.Struct(seed, o, watchfilmsAuditDBTypes, true, watchfilmsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WatchfilmsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, watchfilmsAuditDBTypes, true, watchfilmsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmsAudit struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testWatchfilmsAuditsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(watchfilmsAuditAllColumns) == len(watchfilmsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WatchfilmsAudit{}
+	if err = randomize.Struct(seed, o, watchfilmsAuditDBTypes, true, watchfilmsAuditColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WatchfilmsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, watchfilmsAuditDBTypes, true, watchfilmsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmsAudit struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(watchfilmsAuditAllColumns, watchfilmsAuditPrimaryKeyColumns) {
+		fields = watchfilmsAuditAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			watchfilmsAuditAllColumns,
+			watchfilmsAuditPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := WatchfilmsAuditSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testWatchfilmsAuditsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(watchfilmsAuditAllColumns) == len(watchfilmsAuditPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := WatchfilmsAudit{}
+	if err = randomize.Struct(seed, &o, watchfilmsAuditDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize WatchfilmsAudit struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert WatchfilmsAudit: %s", err)
+	}
+
+	count, err := WatchfilmsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, watchfilmsAuditDBTypes, false, watchfilmsAuditPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WatchfilmsAudit struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert WatchfilmsAudit: %s", err)
+	}
+
+	count, err = WatchfilmsAudits().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
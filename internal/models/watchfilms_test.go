@@ -494,6 +494,312 @@ func testWatchfilmsInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testWatchfilmToManyWatchWatchEvents(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Watchfilm
+	var b, c WatchEvent
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, watchfilmDBTypes, true, watchfilmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Watchfilm struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, watchEventDBTypes, false, watchEventColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, watchEventDBTypes, false, watchEventColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.WatchID = a.ID
+	c.WatchID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.WatchWatchEvents().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.WatchID == b.WatchID {
+			bFound = true
+		}
+		if v.WatchID == c.WatchID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := WatchfilmSlice{&a}
+	if err = a.L.LoadWatchWatchEvents(ctx, tx, false, (*[]*Watchfilm)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.WatchWatchEvents); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.WatchWatchEvents = nil
+	if err = a.L.LoadWatchWatchEvents(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.WatchWatchEvents); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testWatchfilmToManyWatchWatchfilmTags(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Watchfilm
+	var b, c WatchfilmTag
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, watchfilmDBTypes, true, watchfilmColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Watchfilm struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, watchfilmTagDBTypes, false, watchfilmTagColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, watchfilmTagDBTypes, false, watchfilmTagColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.WatchID = a.ID
+	c.WatchID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.WatchWatchfilmTags().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.WatchID == b.WatchID {
+			bFound = true
+		}
+		if v.WatchID == c.WatchID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := WatchfilmSlice{&a}
+	if err = a.L.LoadWatchWatchfilmTags(ctx, tx, false, (*[]*Watchfilm)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.WatchWatchfilmTags); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.WatchWatchfilmTags = nil
+	if err = a.L.LoadWatchWatchfilmTags(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.WatchWatchfilmTags); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testWatchfilmToManyAddOpWatchWatchEvents(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Watchfilm
+	var b, c, d, e WatchEvent
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, watchfilmDBTypes, false, strmangle.SetComplement(watchfilmPrimaryKeyColumns, watchfilmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*WatchEvent{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, watchEventDBTypes, false, strmangle.SetComplement(watchEventPrimaryKeyColumns, watchEventColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*WatchEvent{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddWatchWatchEvents(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.WatchID {
+			t.Error("foreign key was wrong value", a.ID, first.WatchID)
+		}
+		if a.ID != second.WatchID {
+			t.Error("foreign key was wrong value", a.ID, second.WatchID)
+		}
+
+		if first.R.Watch != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Watch != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.WatchWatchEvents[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.WatchWatchEvents[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.WatchWatchEvents().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testWatchfilmToManyAddOpWatchWatchfilmTags(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Watchfilm
+	var b, c, d, e WatchfilmTag
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, watchfilmDBTypes, false, strmangle.SetComplement(watchfilmPrimaryKeyColumns, watchfilmColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*WatchfilmTag{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, watchfilmTagDBTypes, false, strmangle.SetComplement(watchfilmTagPrimaryKeyColumns, watchfilmTagColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*WatchfilmTag{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddWatchWatchfilmTags(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.WatchID {
+			t.Error("foreign key was wrong value", a.ID, first.WatchID)
+		}
+		if a.ID != second.WatchID {
+			t.Error("foreign key was wrong value", a.ID, second.WatchID)
+		}
+
+		if first.R.Watch != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Watch != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.WatchWatchfilmTags[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.WatchWatchfilmTags[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.WatchWatchfilmTags().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
 func testWatchfilmToOneFilmUsingFilm(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -785,7 +1091,7 @@ func testWatchfilmsSelect(t *testing.T) {
 }
 
 var (
-	watchfilmDBTypes = map[string]string{`ID`: `integer`, `UserID`: `integer`, `FilmID`: `integer`, `TimeAdded`: `timestamp with time zone`, `TimeWatched`: `timestamp with time zone`}
+	watchfilmDBTypes = map[string]string{`ID`: `integer`, `UserID`: `integer`, `FilmID`: `integer`, `TimeAdded`: `timestamp with time zone`, `TimeWatched`: `timestamp with time zone`, `Position`: `integer`, `Priority`: `integer`, `Note`: `character varying`}
 	_                = bytes.MinRead
 )
 
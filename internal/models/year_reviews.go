@@ -0,0 +1,1097 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// YearReview is an object representing the database table.
+type YearReview struct {
+	UserID      int        `db:"user_id" boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Year        int        `db:"year" boil:"year" json:"year" toml:"year" yaml:"year"`
+	Timezone    string     `db:"timezone" boil:"timezone" json:"timezone" toml:"timezone" yaml:"timezone"`
+	Report      types.JSON `db:"report" boil:"report" json:"report" toml:"report" yaml:"report"`
+	GeneratedAt time.Time  `db:"generated_at" boil:"generated_at" json:"generated_at" toml:"generated_at" yaml:"generated_at"`
+
+	R *yearReviewR `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L yearReviewL  `db:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var YearReviewColumns = struct {
+	UserID      string
+	Year        string
+	Timezone    string
+	Report      string
+	GeneratedAt string
+}{
+	UserID:      "user_id",
+	Year:        "year",
+	Timezone:    "timezone",
+	Report:      "report",
+	GeneratedAt: "generated_at",
+}
+
+var YearReviewTableColumns = struct {
+	UserID      string
+	Year        string
+	Timezone    string
+	Report      string
+	GeneratedAt string
+}{
+	UserID:      "year_reviews.user_id",
+	Year:        "year_reviews.year",
+	Timezone:    "year_reviews.timezone",
+	Report:      "year_reviews.report",
+	GeneratedAt: "year_reviews.generated_at",
+}
+
+// Generated where
+
+var YearReviewWhere = struct {
+	UserID      whereHelperint
+	Year        whereHelperint
+	Timezone    whereHelperstring
+	Report      whereHelpertypes_JSON
+	GeneratedAt whereHelpertime_Time
+}{
+	UserID:      whereHelperint{field: "\"year_reviews\".\"user_id\""},
+	Year:        whereHelperint{field: "\"year_reviews\".\"year\""},
+	Timezone:    whereHelperstring{field: "\"year_reviews\".\"timezone\""},
+	Report:      whereHelpertypes_JSON{field: "\"year_reviews\".\"report\""},
+	GeneratedAt: whereHelpertime_Time{field: "\"year_reviews\".\"generated_at\""},
+}
+
+// YearReviewRels is where relationship names are stored.
+var YearReviewRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// yearReviewR is where relationships are stored.
+type yearReviewR struct {
+	User *User `db:"User" boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*yearReviewR) NewStruct() *yearReviewR {
+	return &yearReviewR{}
+}
+
+func (r *yearReviewR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// yearReviewL is where Load methods for each relationship are stored.
+type yearReviewL struct{}
+
+var (
+	yearReviewAllColumns            = []string{"user_id", "year", "timezone", "report", "generated_at"}
+	yearReviewColumnsWithoutDefault = []string{"user_id", "year", "timezone", "report"}
+	yearReviewColumnsWithDefault    = []string{"generated_at"}
+	yearReviewPrimaryKeyColumns     = []string{"user_id", "year", "timezone"}
+	yearReviewGeneratedColumns      = []string{}
+)
+
+type (
+	// YearReviewSlice is an alias for a slice of pointers to YearReview.
+	// This should almost always be used instead of []YearReview.
+	YearReviewSlice []*YearReview
+	// YearReviewHook is the signature for custom YearReview hook methods
+	YearReviewHook func(context.Context, boil.ContextExecutor, *YearReview) error
+
+	yearReviewQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	yearReviewType                 = reflect.TypeOf(&YearReview{})
+	yearReviewMapping              = queries.MakeStructMapping(yearReviewType)
+	yearReviewPrimaryKeyMapping, _ = queries.BindMapping(yearReviewType, yearReviewMapping, yearReviewPrimaryKeyColumns)
+	yearReviewInsertCacheMut       sync.RWMutex
+	yearReviewInsertCache          = make(map[string]insertCache)
+	yearReviewUpdateCacheMut       sync.RWMutex
+	yearReviewUpdateCache          = make(map[string]updateCache)
+	yearReviewUpsertCacheMut       sync.RWMutex
+	yearReviewUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var yearReviewAfterSelectHooks []YearReviewHook
+
+var yearReviewBeforeInsertHooks []YearReviewHook
+var yearReviewAfterInsertHooks []YearReviewHook
+
+var yearReviewBeforeUpdateHooks []YearReviewHook
+var yearReviewAfterUpdateHooks []YearReviewHook
+
+var yearReviewBeforeDeleteHooks []YearReviewHook
+var yearReviewAfterDeleteHooks []YearReviewHook
+
+var yearReviewBeforeUpsertHooks []YearReviewHook
+var yearReviewAfterUpsertHooks []YearReviewHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *YearReview) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range yearReviewAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *YearReview) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range yearReviewBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *YearReview) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range yearReviewAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *YearReview) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range yearReviewBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *YearReview) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range yearReviewAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *YearReview) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range yearReviewBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *YearReview) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range yearReviewAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *YearReview) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range yearReviewBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *YearReview) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range yearReviewAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddYearReviewHook registers your hook function for all future operations.
+func AddYearReviewHook(hookPoint boil.HookPoint, yearReviewHook YearReviewHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		yearReviewAfterSelectHooks = append(yearReviewAfterSelectHooks, yearReviewHook)
+	case boil.BeforeInsertHook:
+		yearReviewBeforeInsertHooks = append(yearReviewBeforeInsertHooks, yearReviewHook)
+	case boil.AfterInsertHook:
+		yearReviewAfterInsertHooks = append(yearReviewAfterInsertHooks, yearReviewHook)
+	case boil.BeforeUpdateHook:
+		yearReviewBeforeUpdateHooks = append(yearReviewBeforeUpdateHooks, yearReviewHook)
+	case boil.AfterUpdateHook:
+		yearReviewAfterUpdateHooks = append(yearReviewAfterUpdateHooks, yearReviewHook)
+	case boil.BeforeDeleteHook:
+		yearReviewBeforeDeleteHooks = append(yearReviewBeforeDeleteHooks, yearReviewHook)
+	case boil.AfterDeleteHook:
+		yearReviewAfterDeleteHooks = append(yearReviewAfterDeleteHooks, yearReviewHook)
+	case boil.BeforeUpsertHook:
+		yearReviewBeforeUpsertHooks = append(yearReviewBeforeUpsertHooks, yearReviewHook)
+	case boil.AfterUpsertHook:
+		yearReviewAfterUpsertHooks = append(yearReviewAfterUpsertHooks, yearReviewHook)
+	}
+}
+
+// One returns a single yearReview record from the query.
+func (q yearReviewQuery) One(ctx context.Context, exec boil.ContextExecutor) (*YearReview, error) {
+	o := &YearReview{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for year_reviews")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all YearReview records from the query.
+func (q yearReviewQuery) All(ctx context.Context, exec boil.ContextExecutor) (YearReviewSlice, error) {
+	var o []*YearReview
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to YearReview slice")
+	}
+
+	if len(yearReviewAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all YearReview records in the query.
+func (q yearReviewQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count year_reviews rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q yearReviewQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if year_reviews exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *YearReview) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (yearReviewL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeYearReview interface{}, mods queries.Applicator) error {
+	var slice []*YearReview
+	var object *YearReview
+
+	if singular {
+		var ok bool
+		object, ok = maybeYearReview.(*YearReview)
+		if !ok {
+			object = new(YearReview)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeYearReview)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeYearReview))
+			}
+		}
+	} else {
+		s, ok := maybeYearReview.(*[]*YearReview)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeYearReview)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeYearReview))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &yearReviewR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &yearReviewR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(yearReviewAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.YearReviews = append(foreign.R.YearReviews, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.YearReviews = append(foreign.R.YearReviews, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the yearReview to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.YearReviews.
+func (o *YearReview) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"year_reviews\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, yearReviewPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID, o.Year, o.Timezone}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &yearReviewR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			YearReviews: YearReviewSlice{o},
+		}
+	} else {
+		related.R.YearReviews = append(related.R.YearReviews, o)
+	}
+
+	return nil
+}
+
+// YearReviews retrieves all the records using an executor.
+func YearReviews(mods ...qm.QueryMod) yearReviewQuery {
+	mods = append(mods, qm.From("\"year_reviews\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"year_reviews\".*"})
+	}
+
+	return yearReviewQuery{q}
+}
+
+// FindYearReview retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindYearReview(ctx context.Context, exec boil.ContextExecutor, userID int, year int, timezone string, selectCols ...string) (*YearReview, error) {
+	yearReviewObj := &YearReview{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"year_reviews\" where \"user_id\"=$1 AND \"year\"=$2 AND \"timezone\"=$3", sel,
+	)
+
+	q := queries.Raw(query, userID, year, timezone)
+
+	err := q.Bind(ctx, exec, yearReviewObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from year_reviews")
+	}
+
+	if err = yearReviewObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return yearReviewObj, err
+	}
+
+	return yearReviewObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *YearReview) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no year_reviews provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(yearReviewColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	yearReviewInsertCacheMut.RLock()
+	cache, cached := yearReviewInsertCache[key]
+	yearReviewInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			yearReviewAllColumns,
+			yearReviewColumnsWithDefault,
+			yearReviewColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(yearReviewType, yearReviewMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(yearReviewType, yearReviewMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"year_reviews\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"year_reviews\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into year_reviews")
+	}
+
+	if !cached {
+		yearReviewInsertCacheMut.Lock()
+		yearReviewInsertCache[key] = cache
+		yearReviewInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the YearReview.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *YearReview) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	yearReviewUpdateCacheMut.RLock()
+	cache, cached := yearReviewUpdateCache[key]
+	yearReviewUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			yearReviewAllColumns,
+			yearReviewPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update year_reviews, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"year_reviews\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, yearReviewPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(yearReviewType, yearReviewMapping, append(wl, yearReviewPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update year_reviews row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for year_reviews")
+	}
+
+	if !cached {
+		yearReviewUpdateCacheMut.Lock()
+		yearReviewUpdateCache[key] = cache
+		yearReviewUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q yearReviewQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for year_reviews")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for year_reviews")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o YearReviewSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), yearReviewPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"year_reviews\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, yearReviewPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in yearReview slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all yearReview")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *YearReview) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no year_reviews provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(yearReviewColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	yearReviewUpsertCacheMut.RLock()
+	cache, cached := yearReviewUpsertCache[key]
+	yearReviewUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			yearReviewAllColumns,
+			yearReviewColumnsWithDefault,
+			yearReviewColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			yearReviewAllColumns,
+			yearReviewPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert year_reviews, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(yearReviewPrimaryKeyColumns))
+			copy(conflict, yearReviewPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"year_reviews\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(yearReviewType, yearReviewMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(yearReviewType, yearReviewMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert year_reviews")
+	}
+
+	if !cached {
+		yearReviewUpsertCacheMut.Lock()
+		yearReviewUpsertCache[key] = cache
+		yearReviewUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single YearReview record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *YearReview) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no YearReview provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), yearReviewPrimaryKeyMapping)
+	sql := "DELETE FROM \"year_reviews\" WHERE \"user_id\"=$1 AND \"year\"=$2 AND \"timezone\"=$3"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from year_reviews")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for year_reviews")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q yearReviewQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no yearReviewQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from year_reviews")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for year_reviews")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o YearReviewSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(yearReviewBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), yearReviewPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"year_reviews\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, yearReviewPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from yearReview slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for year_reviews")
+	}
+
+	if len(yearReviewAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *YearReview) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindYearReview(ctx, exec, o.UserID, o.Year, o.Timezone)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *YearReviewSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := YearReviewSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), yearReviewPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"year_reviews\".* FROM \"year_reviews\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, yearReviewPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in YearReviewSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// YearReviewExists checks if the YearReview row exists.
+func YearReviewExists(ctx context.Context, exec boil.ContextExecutor, userID int, year int, timezone string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"year_reviews\" where \"user_id\"=$1 AND \"year\"=$2 AND \"timezone\"=$3 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, userID, year, timezone)
+	}
+	row := exec.QueryRowContext(ctx, sql, userID, year, timezone)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if year_reviews exists")
+	}
+
+	return exists, nil
+}
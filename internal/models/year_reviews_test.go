@@ -0,0 +1,837 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testYearReviews(t *testing.T) {
+	t.Parallel()
+
+	query := YearReviews()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testYearReviewsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YearReview{}
+	if err = randomize.Struct(seed, o, yearReviewDBTypes, true, yearReviewColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YearReview struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := YearReviews().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testYearReviewsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YearReview{}
+	if err = randomize.Struct(seed, o, yearReviewDBTypes, true, yearReviewColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YearReview struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := YearReviews().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := YearReviews().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testYearReviewsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YearReview{}
+	if err = randomize.Struct(seed, o, yearReviewDBTypes, true, yearReviewColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YearReview struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := YearReviewSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := YearReviews().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testYearReviewsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YearReview{}
+	if err = randomize.Struct(seed, o, yearReviewDBTypes, true, yearReviewColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YearReview struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := YearReviewExists(ctx, tx, o.UserID, o.Year, o.Timezone)
+	if err != nil {
+		t.Errorf("Unable to check if YearReview exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected YearReviewExists to return true, but got false.")
+	}
+}
+
+func testYearReviewsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YearReview{}
+	if err = randomize.Struct(seed, o, yearReviewDBTypes, true, yearReviewColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YearReview struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	yearReviewFound, err := FindYearReview(ctx, tx, o.UserID, o.Year, o.Timezone)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if yearReviewFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testYearReviewsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YearReview{}
+	if err = randomize.Struct(seed, o, yearReviewDBTypes, true, yearReviewColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YearReview struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = YearReviews().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testYearReviewsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YearReview{}
+	if err = randomize.Struct(seed, o, yearReviewDBTypes, true, yearReviewColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YearReview struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := YearReviews().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testYearReviewsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	yearReviewOne := &YearReview{}
+	yearReviewTwo := &YearReview{}
+	if err = randomize.Struct(seed, yearReviewOne, yearReviewDBTypes, false, yearReviewColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YearReview struct: %s", err)
+	}
+	if err = randomize.Struct(seed, yearReviewTwo, yearReviewDBTypes, false, yearReviewColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YearReview struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = yearReviewOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = yearReviewTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := YearReviews().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testYearReviewsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	yearReviewOne := &YearReview{}
+	yearReviewTwo := &YearReview{}
+	if err = randomize.Struct(seed, yearReviewOne, yearReviewDBTypes, false, yearReviewColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YearReview struct: %s", err)
+	}
+	if err = randomize.Struct(seed, yearReviewTwo, yearReviewDBTypes, false, yearReviewColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YearReview struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = yearReviewOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = yearReviewTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := YearReviews().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func yearReviewBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *YearReview) error {
+	*o = YearReview{}
+	return nil
+}
+
+func yearReviewAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *YearReview) error {
+	*o = YearReview{}
+	return nil
+}
+
+func yearReviewAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *YearReview) error {
+	*o = YearReview{}
+	return nil
+}
+
+func yearReviewBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *YearReview) error {
+	*o = YearReview{}
+	return nil
+}
+
+func yearReviewAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *YearReview) error {
+	*o = YearReview{}
+	return nil
+}
+
+func yearReviewBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *YearReview) error {
+	*o = YearReview{}
+	return nil
+}
+
+func yearReviewAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *YearReview) error {
+	*o = YearReview{}
+	return nil
+}
+
+func yearReviewBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *YearReview) error {
+	*o = YearReview{}
+	return nil
+}
+
+func yearReviewAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *YearReview) error {
+	*o = YearReview{}
+	return nil
+}
+
+func testYearReviewsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &YearReview{}
+	o := &YearReview{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, yearReviewDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize YearReview object: %s", err)
+	}
+
+	AddYearReviewHook(boil.BeforeInsertHook, yearReviewBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	yearReviewBeforeInsertHooks = []YearReviewHook{}
+
+	AddYearReviewHook(boil.AfterInsertHook, yearReviewAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	yearReviewAfterInsertHooks = []YearReviewHook{}
+
+	AddYearReviewHook(boil.AfterSelectHook, yearReviewAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	yearReviewAfterSelectHooks = []YearReviewHook{}
+
+	AddYearReviewHook(boil.BeforeUpdateHook, yearReviewBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	yearReviewBeforeUpdateHooks = []YearReviewHook{}
+
+	AddYearReviewHook(boil.AfterUpdateHook, yearReviewAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	yearReviewAfterUpdateHooks = []YearReviewHook{}
+
+	AddYearReviewHook(boil.BeforeDeleteHook, yearReviewBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	yearReviewBeforeDeleteHooks = []YearReviewHook{}
+
+	AddYearReviewHook(boil.AfterDeleteHook, yearReviewAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	yearReviewAfterDeleteHooks = []YearReviewHook{}
+
+	AddYearReviewHook(boil.BeforeUpsertHook, yearReviewBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	yearReviewBeforeUpsertHooks = []YearReviewHook{}
+
+	AddYearReviewHook(boil.AfterUpsertHook, yearReviewAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	yearReviewAfterUpsertHooks = []YearReviewHook{}
+}
+
+func testYearReviewsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YearReview{}
+	if err = randomize.Struct(seed, o, yearReviewDBTypes, true, yearReviewColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YearReview struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := YearReviews().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testYearReviewsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YearReview{}
+	if err = randomize.Struct(seed, o, yearReviewDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize YearReview struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(yearReviewColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := YearReviews().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testYearReviewToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local YearReview
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, yearReviewDBTypes, false, yearReviewColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YearReview struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := YearReviewSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*YearReview)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testYearReviewToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a YearReview
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, yearReviewDBTypes, false, strmangle.SetComplement(yearReviewPrimaryKeyColumns, yearReviewColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.YearReviews[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		if exists, err := YearReviewExists(ctx, tx, a.UserID, a.Year, a.Timezone); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testYearReviewsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YearReview{}
+	if err = randomize.Struct(seed, o, yearReviewDBTypes, true, yearReviewColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YearReview struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testYearReviewsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YearReview{}
+	if err = randomize.Struct(seed, o, yearReviewDBTypes, true, yearReviewColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YearReview struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := YearReviewSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testYearReviewsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YearReview{}
+	if err = randomize.Struct(seed, o, yearReviewDBTypes, true, yearReviewColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YearReview struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := YearReviews().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	yearReviewDBTypes = map[string]string{`UserID`: `integer`, `Year`: `integer`, `Timezone`: `character varying`, `Report`: `jsonb`, `GeneratedAt`: `timestamp with time zone`}
+	_                 = bytes.MinRead
+)
+
+func testYearReviewsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(yearReviewPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(yearReviewAllColumns) == len(yearReviewPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YearReview{}
+	if err = randomize.Struct(seed, o, yearReviewDBTypes, true, yearReviewColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YearReview struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := YearReviews().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, yearReviewDBTypes, true, yearReviewPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize YearReview struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testYearReviewsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(yearReviewAllColumns) == len(yearReviewPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &YearReview{}
+	if err = randomize.Struct(seed, o, yearReviewDBTypes, true, yearReviewColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize YearReview struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := YearReviews().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, yearReviewDBTypes, true, yearReviewPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize YearReview struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(yearReviewAllColumns, yearReviewPrimaryKeyColumns) {
+		fields = yearReviewAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			yearReviewAllColumns,
+			yearReviewPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := YearReviewSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testYearReviewsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(yearReviewAllColumns) == len(yearReviewPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := YearReview{}
+	if err = randomize.Struct(seed, &o, yearReviewDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize YearReview struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert YearReview: %s", err)
+	}
+
+	count, err := YearReviews().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, yearReviewDBTypes, false, yearReviewPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize YearReview struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert YearReview: %s", err)
+	}
+
+	count, err = YearReviews().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -6,6 +6,14 @@ import (
 	"github.com/aria3ppp/watchlist-server/internal/models"
 )
 
+// SeriesesWithAggregates is the pseudo model of the serieses joined to their
+// aggregates and score aggregates
+const SeriesesWithAggregates = "serieses_with_aggregates"
+
+// MoviesWithScores is the pseudo model of the movies joined to their score
+// aggregates
+const MoviesWithScores = "movies_with_scores"
+
 func Exists(model, field string) bool {
 	_, exists := modelFields[model][field]
 	return exists
@@ -23,13 +31,109 @@ func init() {
 }
 
 var modelFields = map[string]map[string]struct{}{
-	models.TableNames.Users:         fieldMap(models.UserColumns),
-	models.TableNames.Tokens:        fieldMap(models.TokenColumns),
-	models.TableNames.Films:         fieldMap(models.FilmColumns),
-	models.TableNames.FilmsAudit:    fieldMap(models.FilmsAuditColumns),
-	models.TableNames.Serieses:      fieldMap(models.SeriesColumns),
-	models.TableNames.SeriesesAudit: fieldMap(models.SeriesesAuditColumns),
-	models.TableNames.Watchfilms:    fieldMap(models.WatchfilmColumns),
+	models.TableNames.Users:             fieldMap(models.UserColumns),
+	models.TableNames.Tokens:            fieldMap(models.TokenColumns),
+	models.TableNames.Films:             fieldMap(models.FilmColumns),
+	models.TableNames.FilmsAudit:        fieldMap(models.FilmsAuditColumns),
+	models.TableNames.Serieses:          fieldMap(models.SeriesColumns),
+	models.TableNames.SeriesesAudit:     fieldMap(models.SeriesesAuditColumns),
+	models.TableNames.Watchfilms:        fieldMap(models.WatchfilmColumns),
+	models.TableNames.ExternalIds:       fieldMap(models.ExternalIDColumns),
+	models.TableNames.ExternalIdsAudit:  fieldMap(models.ExternalIdsAuditColumns),
+	models.TableNames.ImportJobs:        fieldMap(models.ImportJobColumns),
+	models.TableNames.ImportErrors:      fieldMap(models.ImportErrorColumns),
+	models.TableNames.CatalogExports:    fieldMap(models.CatalogExportColumns),
+	models.TableNames.Translations:      fieldMap(models.TranslationColumns),
+	models.TableNames.TranslationsAudit: fieldMap(models.TranslationsAuditColumns),
+	models.TableNames.Releases:          fieldMap(models.ReleaseColumns),
+	models.TableNames.ReleasesAudit:     fieldMap(models.ReleasesAuditColumns),
+	models.TableNames.ContentRatings:    fieldMap(models.ContentRatingColumns),
+	models.TableNames.ContentRatingsAudit: fieldMap(
+		models.ContentRatingsAuditColumns,
+	),
+	models.TableNames.Collections:      fieldMap(models.CollectionColumns),
+	models.TableNames.CollectionsAudit: fieldMap(models.CollectionsAuditColumns),
+	models.TableNames.CollectionItems:  fieldMap(models.CollectionItemColumns),
+	models.TableNames.CollectionItemsAudit: fieldMap(
+		models.CollectionItemsAuditColumns,
+	),
+	models.TableNames.MediaItems:      fieldMap(models.MediaItemColumns),
+	models.TableNames.MediaItemsAudit: fieldMap(models.MediaItemsAuditColumns),
+	models.TableNames.AuditPrunes:     fieldMap(models.AuditPruneColumns),
+	models.TableNames.UsersAudit:      fieldMap(models.UsersAuditColumns),
+	models.TableNames.WatchfilmsAudit: fieldMap(models.WatchfilmsAuditColumns),
+	models.TableNames.SeriesAggregates: fieldMap(
+		models.SeriesAggregateColumns,
+	),
+	models.TableNames.SeriesFollows: fieldMap(models.SeriesFollowColumns),
+	models.TableNames.WatchEvents:   fieldMap(models.WatchEventColumns),
+	models.TableNames.PlaybackProgress: fieldMap(
+		models.PlaybackProgressColumns,
+	),
+	models.TableNames.Scores: fieldMap(models.ScoreColumns),
+	models.TableNames.FilmScoreAggregates: fieldMap(
+		models.FilmScoreAggregateColumns,
+	),
+	models.TableNames.SeriesScoreAggregates: fieldMap(
+		models.SeriesScoreAggregateColumns,
+	),
+	models.TableNames.Reviews:       fieldMap(models.ReviewColumns),
+	models.TableNames.ReviewsAudit:  fieldMap(models.ReviewsAuditColumns),
+	models.TableNames.ReviewVotes:   fieldMap(models.ReviewVoteColumns),
+	models.TableNames.Lists:         fieldMap(models.ListColumns),
+	models.TableNames.ListItems:     fieldMap(models.ListItemColumns),
+	models.TableNames.ListMembers:   fieldMap(models.ListMemberColumns),
+	models.TableNames.ListInvites:   fieldMap(models.ListInviteColumns),
+	models.TableNames.WatchfilmTags: fieldMap(models.WatchfilmTagColumns),
+	models.TableNames.YearReviews:   fieldMap(models.YearReviewColumns),
+	models.TableNames.ListActivities: fieldMap(
+		models.ListActivityColumns,
+	),
+	models.TableNames.Contributions: fieldMap(
+		models.ContributionColumns,
+	),
+	models.TableNames.ContributorStats: fieldMap(
+		models.ContributorStatColumns,
+	),
+	// serieses are sortable by their aggregates too
+	SeriesesWithAggregates: union(
+		fieldMap(models.SeriesColumns),
+		fieldMap(struct {
+			SeasonsCount      string
+			EpisodesCount     string
+			TotalRuntime      string
+			FirstEpisodeDate  string
+			LatestEpisodeDate string
+		}{
+			SeasonsCount:      models.SeriesAggregateColumns.SeasonsCount,
+			EpisodesCount:     models.SeriesAggregateColumns.EpisodesCount,
+			TotalRuntime:      models.SeriesAggregateColumns.TotalRuntime,
+			FirstEpisodeDate:  models.SeriesAggregateColumns.FirstEpisodeDate,
+			LatestEpisodeDate: models.SeriesAggregateColumns.LatestEpisodeDate,
+		}),
+		scoreFields,
+	),
+	// movies are sortable by their score aggregates too
+	MoviesWithScores: union(fieldMap(models.FilmColumns), scoreFields),
+}
+
+// scoreFields are the sortable fields of the score aggregates
+var scoreFields = fieldMap(struct {
+	ScoresCount string
+	ScoreMean   string
+}{
+	ScoresCount: models.FilmScoreAggregateColumns.ScoresCount,
+	ScoreMean:   models.FilmScoreAggregateColumns.ScoreMean,
+})
+
+func union(fieldMaps ...map[string]struct{}) map[string]struct{} {
+	fields := map[string]struct{}{}
+	for _, fieldMap := range fieldMaps {
+		for field := range fieldMap {
+			fields[field] = struct{}{}
+		}
+	}
+	return fields
 }
 
 func fieldMap(modelColumnsStruct any) map[string]struct{} {
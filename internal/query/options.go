@@ -1,10 +1,17 @@
 package query
 
+import (
+	"time"
+
+	"github.com/volatiletech/null/v8"
+)
+
 type Options struct {
-	Offset    int
-	Limit     int
-	SortField string
-	SortOrder string
+	Offset       int
+	Limit        int
+	SortField    string
+	SortOrder    string
+	Invalidation string
 }
 
 type SortOrderOptions struct {
@@ -14,14 +21,141 @@ type SortOrderOptions struct {
 }
 
 type SearchOptions struct {
-	Query string
-	From  int
-	Size  int
+	Query        string
+	From         int
+	Size         int
+	Invalidation string
 }
 
+// Invalidation filters of the listings and searches: exclude drops the
+// invalidated records, include keeps all of them and only keeps only the
+// invalidated ones. An empty filter is the same as include.
+const (
+	InvalidationExclude = "exclude"
+	InvalidationInclude = "include"
+	InvalidationOnly    = "only"
+)
+
+// Sort fields of the watchlist: the score fields sort by the score aggregates
+// of the films, position by the manual order of the user and the date
+// released, title and duration fields by the films. An empty sort field is the
+// same as time added.
+const (
+	WatchlistSortTimeAdded    = "time_added"
+	WatchlistSortTimeWatched  = "time_watched"
+	WatchlistSortScoreMean    = "score_mean"
+	WatchlistSortScoresCount  = "scores_count"
+	WatchlistSortPosition     = "position"
+	WatchlistSortPriority     = "priority"
+	WatchlistSortDateReleased = "date_released"
+	WatchlistSortTitle        = "title"
+	WatchlistSortDuration     = "duration"
+)
+
 type WatchlistOptions struct {
 	Offset           int
 	Limit            int
+	SortField        string
 	SortOrder        string
 	WhereTimeWatched string
+	Release          ReleaseOptions
+	Watched          WatchedOptions
+	Item             WatchlistItemOptions
+}
+
+// WatchlistItemOptions filters the watchlist items by the user set details: a
+// non-empty Tag keeps the items tagged by it and a valid Priority keeps the
+// items of that priority. The zero value filters nothing.
+type WatchlistItemOptions struct {
+	Tag      string
+	Priority null.Int
+}
+
+// Sort fields of the reviews listings: helpful sorts by the helpfulness votes
+// count and recent by the creation time. An empty sort field is the same as
+// recent.
+const (
+	ReviewSortHelpful = "helpful"
+	ReviewSortRecent  = "recent"
+)
+
+// ReviewOptions paginates and sorts the published reviews of a film or series
+type ReviewOptions struct {
+	Offset    int
+	Limit     int
+	SortField string
+	SortOrder string
+}
+
+// WatchedOptions filters the watchlist items by their watch events: a valid
+// Times keeps the items watched exactly that many times and a valid From or
+// To keeps the items watched at least once between them, both inclusive. The
+// zero value filters nothing.
+type WatchedOptions struct {
+	Times null.Int
+	From  null.Time
+	To    null.Time
+}
+
+// AuditCursor points to the last audit read by a keyset paginated read.
+// The zero value points before the first audit.
+type AuditCursor struct {
+	ID            int
+	ContributedAt time.Time
+	ContributedBy int
+}
+
+// LocaleOptions selects the translation of the reads: Languages are the
+// canonical BCP-47 tags by preference and if there's none the locale of the
+// user is preferred. The zero value reads the originals.
+type LocaleOptions struct {
+	Languages []string
+	UserID    int
+}
+
+// ReleaseOptions filters the films by Region: Released keeps the films already
+// released in the region (of ReleaseType if set) and a valid MaxAge keeps the
+// films rated suitable for viewers of that age in the region. The zero value
+// filters nothing.
+type ReleaseOptions struct {
+	Region      string
+	Released    bool
+	ReleaseType string
+	MaxAge      null.Int
+}
+
+// AuditRetentionOptions selects the audits retained of every record: KeepLast
+// keeps the last audits of a record if positive and a valid Before keeps the
+// audits contributed since. An audit not retained by either is pruned. The
+// zero value retains all audits.
+type AuditRetentionOptions struct {
+	KeepLast int
+	Before   null.Time
+}
+
+// ReputationOptions weights the contribution statistics of the reputation
+// score: the creations and edits earn points and the reverted edits and
+// invalidations received lose them. The score is never negative.
+type ReputationOptions struct {
+	CreationPoints      int
+	EditPoints          int
+	RevertedEditPenalty int
+	InvalidationPenalty int
+}
+
+// StatsOptions selects the watch events of the statistics: a valid From or To
+// keeps the events watched between them, both inclusive, and Timezone is the
+// IANA time zone the days, weekdays and hours are computed in.
+type StatsOptions struct {
+	From     null.Time
+	To       null.Time
+	Timezone string
 }
+
+// Units of the statistics buckets in the time zone of the user: weekday counts
+// from 0 as sunday and month from 1 as january.
+const (
+	StatsBucketWeekday = "weekday"
+	StatsBucketHour    = "hour"
+	StatsBucketMonth   = "month"
+)
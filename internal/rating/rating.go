@@ -0,0 +1,133 @@
+// Package rating holds the content rating systems of the supported regions.
+package rating
+
+import "sort"
+
+// Rating is a rating of a content rating system: MinAge is the minimum age
+// of the viewers the rated content is suitable for without guidance.
+type Rating struct {
+	Rating string
+	MinAge int
+}
+
+// System is the content rating system of a region: Ratings are ordered from
+// the least to the most restrictive.
+type System struct {
+	Name    string
+	Ratings []Rating
+}
+
+// content rating systems keyed by ISO 3166-1 alpha-2 region codes
+var systems = map[string]System{
+	"AU": {
+		Name: "ACB",
+		Ratings: []Rating{
+			{"G", 0}, {"PG", 0}, {"M", 15}, {"MA15+", 15}, {"R18+", 18},
+			{"X18+", 18},
+		},
+	},
+	"BR": {
+		Name: "ClassInd",
+		Ratings: []Rating{
+			{"L", 0}, {"10", 10}, {"12", 12}, {"14", 14}, {"16", 16},
+			{"18", 18},
+		},
+	},
+	"DE": {
+		Name: "FSK",
+		Ratings: []Rating{
+			{"0", 0}, {"6", 6}, {"12", 12}, {"16", 16}, {"18", 18},
+		},
+	},
+	"ES": {
+		Name: "ICAA",
+		Ratings: []Rating{
+			{"A", 0}, {"7", 7}, {"12", 12}, {"16", 16}, {"18", 18},
+		},
+	},
+	"FR": {
+		Name: "CNC",
+		Ratings: []Rating{
+			{"U", 0}, {"10", 10}, {"12", 12}, {"16", 16}, {"18", 18},
+		},
+	},
+	"GB": {
+		Name: "BBFC",
+		Ratings: []Rating{
+			{"U", 0}, {"PG", 0}, {"12A", 12}, {"12", 12}, {"15", 15},
+			{"18", 18}, {"R18", 18},
+		},
+	},
+	"IN": {
+		Name: "CBFC",
+		Ratings: []Rating{
+			{"U", 0}, {"UA", 12}, {"A", 18}, {"S", 18},
+		},
+	},
+	"IT": {
+		Name: "MiC",
+		Ratings: []Rating{
+			{"T", 0}, {"6+", 6}, {"14+", 14}, {"18+", 18},
+		},
+	},
+	"JP": {
+		Name: "Eirin",
+		Ratings: []Rating{
+			{"G", 0}, {"PG12", 12}, {"R15+", 15}, {"R18+", 18},
+		},
+	},
+	"KR": {
+		Name: "KMRB",
+		Ratings: []Rating{
+			{"ALL", 0}, {"12", 12}, {"15", 15}, {"18", 18},
+		},
+	},
+	"NL": {
+		Name: "Kijkwijzer",
+		Ratings: []Rating{
+			{"AL", 0}, {"6", 6}, {"9", 9}, {"12", 12}, {"14", 14},
+			{"16", 16}, {"18", 18},
+		},
+	},
+	"US": {
+		Name: "MPA",
+		Ratings: []Rating{
+			{"G", 0}, {"PG", 0}, {"PG-13", 13}, {"R", 17}, {"NC-17", 18},
+		},
+	},
+}
+
+// Regions returns the sorted regions having a content rating system
+func Regions() []string {
+	regions := make([]string, 0, len(systems))
+	for region := range systems {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+// Lookup returns the content rating system of the region
+func Lookup(region string) (System, bool) {
+	system, exists := systems[region]
+	return system, exists
+}
+
+// Rating returns the rating of the system
+func (s System) Rating(rating string) (Rating, bool) {
+	for _, r := range s.Ratings {
+		if r.Rating == rating {
+			return r, true
+		}
+	}
+	return Rating{}, false
+}
+
+// RatingNames returns the ratings of the system
+func (s System) RatingNames() []string {
+	names := make([]string, len(s.Ratings))
+	for i, r := range s.Ratings {
+		names[i] = r.Rating
+	}
+	return names
+}
@@ -0,0 +1,55 @@
+package rating_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/rating"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegions(t *testing.T) {
+	require := require.New(t)
+
+	regions := rating.Regions()
+	require.True(sort.StringsAreSorted(regions))
+	require.Contains(regions, "US")
+	require.Contains(regions, "DE")
+}
+
+func TestLookup(t *testing.T) {
+	require := require.New(t)
+
+	_, exists := rating.Lookup("XX")
+	require.False(exists)
+
+	system, exists := rating.Lookup("DE")
+	require.True(exists)
+	require.Equal("FSK", system.Name)
+	require.Equal([]string{"0", "6", "12", "16", "18"}, system.RatingNames())
+
+	r, exists := system.Rating("12")
+	require.True(exists)
+	require.Equal(rating.Rating{Rating: "12", MinAge: 12}, r)
+
+	_, exists = system.Rating("PG-13")
+	require.False(exists)
+}
+
+func TestSystemsOrder(t *testing.T) {
+	require := require.New(t)
+
+	// ratings are ordered from the least to the most restrictive
+	for _, region := range rating.Regions() {
+		system, _ := rating.Lookup(region)
+		require.NotEmpty(system.Name, region)
+		require.NotEmpty(system.Ratings, region)
+		for i := 1; i < len(system.Ratings); i++ {
+			require.LessOrEqual(
+				system.Ratings[i-1].MinAge,
+				system.Ratings[i].MinAge,
+				region,
+			)
+		}
+	}
+}
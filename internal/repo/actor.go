@@ -0,0 +1,24 @@
+package repo
+
+import "context"
+
+// the actions recorded in the audits
+const (
+	AuditActionUpdate = "update"
+	AuditActionDelete = "delete"
+)
+
+type actorContextKey struct{}
+
+// WithActor returns a copy of ctx carrying the id of the acting user. The
+// transactions begun with the returned context record the acting user in the
+// audits of the records they update or delete.
+func WithActor(ctx context.Context, userID int) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, userID)
+}
+
+// ActorFromContext returns the id of the acting user carried by ctx
+func ActorFromContext(ctx context.Context) (userID int, ok bool) {
+	userID, ok = ctx.Value(actorContextKey{}).(int)
+	return userID, ok
+}
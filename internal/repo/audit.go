@@ -0,0 +1,161 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/lib/pq"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// AuditsPrune deletes up to limit audits of the audit table not retained by
+// the retention options and returns the deleted audits encoded as json
+func (repo *Repository) AuditsPrune(
+	ctx context.Context,
+	auditTable string,
+	retentionOptions query.AuditRetentionOptions,
+	limit int,
+) (audits []string, err error) {
+	// placeholders start after limit
+	where, whereArgs := rawSqlWhereNotRetained(retentionOptions, 2)
+	if where == "" {
+		return nil, nil
+	}
+	rows, err := repo.exec.QueryContext(
+		ctx,
+		fmt.Sprintf(
+			auditsPruneQuery,
+			pq.QuoteIdentifier(auditTable),
+			where,
+			pq.QuoteIdentifier(auditTimeColumn(auditTable)),
+		),
+		append([]any{limit}, whereArgs...)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var audit string
+		if err := rows.Scan(&audit); err != nil {
+			return nil, err
+		}
+		audits = append(audits, audit)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return audits, nil
+}
+
+// AuditsPruneCount counts the audits of the audit table not retained by the
+// retention options
+func (repo *Repository) AuditsPruneCount(
+	ctx context.Context,
+	auditTable string,
+	retentionOptions query.AuditRetentionOptions,
+) (count int, err error) {
+	where, whereArgs := rawSqlWhereNotRetained(retentionOptions, 1)
+	if where == "" {
+		return 0, nil
+	}
+	err = repo.exec.QueryRowContext(
+		ctx,
+		fmt.Sprintf(
+			auditsPruneCountQuery,
+			pq.QuoteIdentifier(auditTable),
+			where,
+			pq.QuoteIdentifier(auditTimeColumn(auditTable)),
+		),
+		whereArgs...,
+	).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// auditTimeColumn returns the column audits of the audit table are ordered by.
+// The audits of the contributed tables are ordered by their contribution time
+// and the others by their audit time.
+func auditTimeColumn(auditTable string) string {
+	switch auditTable {
+	case models.TableNames.UsersAudit:
+		return models.UsersAuditColumns.AuditedAt
+	case models.TableNames.WatchfilmsAudit:
+		return models.WatchfilmsAuditColumns.AuditedAt
+	default:
+		return models.FilmsAuditColumns.ContributedAt
+	}
+}
+
+// rawSqlWhereNotRetained returns the where clause of the ranked audits not
+// retained by the retention options having its first placeholder numbered
+// firstPlaceholder. The where clause is empty if all audits are retained.
+func rawSqlWhereNotRetained(
+	retentionOptions query.AuditRetentionOptions,
+	firstPlaceholder int,
+) (whereClause string, args []any) {
+	var clauses []string
+	if retentionOptions.KeepLast > 0 {
+		args = append(args, retentionOptions.KeepLast)
+		clauses = append(
+			clauses,
+			fmt.Sprintf("audit_rank > $%d", firstPlaceholder+len(args)-1),
+		)
+	}
+	if retentionOptions.Before.Valid {
+		args = append(args, retentionOptions.Before.Time)
+		clauses = append(
+			clauses,
+			fmt.Sprintf("audit_time < $%d", firstPlaceholder+len(args)-1),
+		)
+	}
+	return strings.Join(clauses, " OR "), args
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (repo *Repository) AuditPruneGetLatest(
+	ctx context.Context,
+) (*models.AuditPrune, error) {
+	prune, err := models.AuditPrunes(
+		qm.OrderBy(models.AuditPruneColumns.ID+" DESC"),
+	).One(ctx, repo.exec)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return prune, nil
+}
+
+func (repo *Repository) AuditPruneCreate(
+	ctx context.Context,
+	prune *models.AuditPrune,
+) error {
+	return prune.Insert(ctx, repo.exec, boil.Infer())
+}
+
+func (repo *Repository) AuditPruneUpdate(
+	ctx context.Context,
+	id int,
+	cols map[string]any,
+) error {
+	rowsAff, err := models.AuditPrunes(
+		models.AuditPruneWhere.ID.EQ(id),
+	).UpdateAll(ctx, repo.exec, cols)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
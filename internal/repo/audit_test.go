@@ -0,0 +1,266 @@
+package repo_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func TestAuditsPrune(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+
+	// a movie with 3 audits
+	movie := &models.Film{
+		Title:        "title 0",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+	err = r.MovieCreate(ctx, user.ID, movie)
+	require.NoError(err)
+	for _, title := range []string{"title 1", "title 2", "title 3"} {
+		err = r.MovieUpdate(ctx, movie.ID, user.ID, map[string]any{
+			models.FilmColumns.Title: title,
+		})
+		require.NoError(err)
+	}
+
+	table := models.TableNames.FilmsAudit
+	keepLast := query.AuditRetentionOptions{KeepLast: 1}
+
+	// all audits are retained by the zero retention options
+	count, err := r.AuditsPruneCount(ctx, table, query.AuditRetentionOptions{})
+	require.NoError(err)
+	require.Equal(0, count)
+	audits, err := r.AuditsPrune(ctx, table, query.AuditRetentionOptions{}, 10)
+	require.NoError(err)
+	require.Empty(audits)
+
+	// all audits are older than an hour later
+	count, err = r.AuditsPruneCount(ctx, table, query.AuditRetentionOptions{
+		Before: null.TimeFrom(time.Now().Add(time.Hour)),
+	})
+	require.NoError(err)
+	require.Equal(3, count)
+
+	// the last audit is retained
+	count, err = r.AuditsPruneCount(ctx, table, keepLast)
+	require.NoError(err)
+	require.Equal(2, count)
+
+	// prune a batch of one audit
+	audits, err = r.AuditsPrune(ctx, table, keepLast, 1)
+	require.NoError(err)
+	require.Equal(1, len(audits))
+
+	// pruned audits are encoded as json
+	var audit map[string]any
+	err = json.Unmarshal([]byte(audits[0]), &audit)
+	require.NoError(err)
+	require.Equal(float64(movie.ID), audit[models.FilmsAuditColumns.ID])
+	require.Contains(
+		[]any{"title 0", "title 1"},
+		audit[models.FilmsAuditColumns.Title],
+	)
+
+	// prune the rest
+	audits, err = r.AuditsPrune(ctx, table, keepLast, 10)
+	require.NoError(err)
+	require.Equal(1, len(audits))
+
+	count, err = r.AuditsPruneCount(ctx, table, keepLast)
+	require.NoError(err)
+	require.Equal(0, count)
+
+	// the last audit is kept
+	filmAudits, err := r.MovieAuditsGetAll(
+		ctx,
+		movie.ID,
+		query.SortOrderOptions{SortOrder: "asc", Limit: 10},
+	)
+	require.NoError(err)
+	require.Equal(1, len(filmAudits))
+	require.Equal("title 2", filmAudits[0].Title)
+}
+
+func TestAuditPruneGetLatest(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	// no prune
+	_, err := r.AuditPruneGetLatest(ctx)
+	require.Equal(repo.ErrNoRecord, err)
+
+	// create prunes
+	prunes := []*models.AuditPrune{
+		{Status: "succeeded"},
+		{Status: "running", DryRun: true},
+	}
+	for _, p := range prunes {
+		err = r.AuditPruneCreate(ctx, p)
+		require.NoError(err)
+	}
+
+	// update a prune
+	err = r.AuditPruneUpdate(ctx, prunes[1].ID, map[string]any{
+		models.AuditPruneColumns.Status:     "succeeded",
+		models.AuditPruneColumns.PrunedRows: 5,
+	})
+	require.NoError(err)
+
+	latest, err := r.AuditPruneGetLatest(ctx)
+	require.NoError(err)
+	require.Equal(prunes[1].ID, latest.ID)
+	require.Equal("succeeded", latest.Status)
+	require.True(latest.DryRun)
+	require.Equal(5, latest.PrunedRows)
+
+	// update a prune not exists
+	err = r.AuditPruneUpdate(ctx, 999, map[string]any{
+		models.AuditPruneColumns.Status: "failed",
+	})
+	require.Equal(repo.ErrNoRecord, err)
+}
+
+func TestAuditsActor(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	moderator := &models.User{Email: "moderator"}
+	err = r.UserCreate(ctx, moderator)
+	require.NoError(err)
+
+	movie := &models.Film{
+		Title:        "title",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+	err = r.MovieCreate(ctx, user.ID, movie)
+	require.NoError(err)
+
+	watchID, err := r.WatchlistAdd(ctx, user.ID, movie.ID)
+	require.NoError(err)
+
+	// the owner is the actor out of transactions
+	err = r.WatchlistSetWatched(ctx, user.ID, watchID)
+	require.NoError(err)
+
+	// the acting user of the transaction is the actor
+	err = r.Tx(
+		repo.WithActor(ctx, moderator.ID),
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			return tx.WatchlistDelete(ctx, user.ID, watchID)
+		},
+	)
+	require.NoError(err)
+
+	audits, err := models.WatchfilmsAudits(
+		models.WatchfilmsAuditWhere.ID.EQ(watchID),
+		qm.OrderBy(models.WatchfilmsAuditColumns.AuditedAt),
+	).All(ctx, db)
+	require.NoError(err)
+	require.Equal(2, len(audits))
+
+	require.Equal(repo.AuditActionUpdate, audits[0].AuditAction)
+	require.Equal(null.IntFrom(user.ID), audits[0].AuditActor)
+	require.False(audits[0].TimeWatched.Valid)
+
+	// the final record is audited on delete
+	require.Equal(repo.AuditActionDelete, audits[1].AuditAction)
+	require.Equal(null.IntFrom(moderator.ID), audits[1].AuditActor)
+	require.Equal(user.ID, audits[1].UserID)
+	require.Equal(movie.ID, audits[1].FilmID)
+	require.True(audits[1].TimeWatched.Valid)
+
+	// watchfilms audits are ranked by their audit time
+	count, err := r.AuditsPruneCount(
+		ctx,
+		models.TableNames.WatchfilmsAudit,
+		query.AuditRetentionOptions{KeepLast: 1},
+	)
+	require.NoError(err)
+	require.Equal(1, count)
+
+	// the acting user is local to the transaction
+	err = r.MovieUpdate(ctx, movie.ID, user.ID, map[string]any{
+		models.FilmColumns.Title: "updated title",
+	})
+	require.NoError(err)
+
+	movieAudits, err := r.MovieAuditsGetAll(
+		ctx,
+		movie.ID,
+		query.SortOrderOptions{SortOrder: "asc", Limit: 10},
+	)
+	require.NoError(err)
+	require.Equal(1, len(movieAudits))
+	require.Equal(repo.AuditActionUpdate, movieAudits[0].AuditAction)
+	require.Equal(null.IntFrom(user.ID), movieAudits[0].AuditActor)
+}
+
+func TestUsersAuditsNoCredentials(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email", PasswordHash: "password hash"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+
+	err = r.UserUpdate(ctx, user.ID, map[string]any{
+		models.UserColumns.PasswordHash: "new password hash",
+	})
+	require.NoError(err)
+
+	// the archived audit keeps no password hash
+	audits, err := r.AuditsPrune(
+		ctx,
+		models.TableNames.UsersAudit,
+		query.AuditRetentionOptions{
+			Before: null.TimeFrom(time.Now().Add(time.Hour)),
+		},
+		10,
+	)
+	require.NoError(err)
+	require.Equal(1, len(audits))
+	var audit map[string]any
+	err = json.Unmarshal([]byte(audits[0]), &audit)
+	require.NoError(err)
+	require.Equal(user.Email, audit[models.UsersAuditColumns.Email])
+	require.NotContains(audit, models.UserColumns.PasswordHash)
+	require.NotContains(audits[0], "password hash")
+}
@@ -0,0 +1,220 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aria3ppp/watchlist-server/internal/collection"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (repo *Repository) CollectionGet(
+	ctx context.Context,
+	id int,
+) (*models.Collection, error) {
+	c, err := models.Collections(
+		models.CollectionWhere.ID.EQ(id),
+	).One(ctx, repo.exec)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+func (repo *Repository) CollectionsGetAll(
+	ctx context.Context,
+	queryOptions query.Options,
+) ([]*models.Collection, error) {
+	collections, err := models.Collections(
+		append(
+			whereInvalidation(
+				models.CollectionTableColumns.Invalidation,
+				queryOptions.Invalidation,
+			),
+			qm.Offset(queryOptions.Offset),
+			qm.Limit(queryOptions.Limit),
+			qm.OrderBy(queryOptions.SortField+" "+queryOptions.SortOrder),
+		)...,
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return collections, nil
+}
+
+func (repo *Repository) CollectionsCount(
+	ctx context.Context,
+	invalidation string,
+) (int, error) {
+	nCollection, err := models.Collections(
+		whereInvalidation(
+			models.CollectionTableColumns.Invalidation,
+			invalidation,
+		)...,
+	).Count(ctx, repo.exec)
+	return int(nCollection), err
+}
+
+func (repo *Repository) CollectionCreate(
+	ctx context.Context,
+	contributorID int,
+	collection *models.Collection,
+) error {
+	collection.ContributedBy = contributorID
+	return collection.Insert(ctx, repo.exec, boil.Infer())
+}
+
+func (repo *Repository) CollectionUpdate(
+	ctx context.Context,
+	collectionID int,
+	contributorID int,
+	cols map[string]any,
+) error {
+	cols[models.CollectionColumns.ContributedBy] = contributorID
+	rowsAff, err := models.Collections(
+		models.CollectionWhere.ID.EQ(collectionID),
+	).UpdateAll(ctx, repo.exec, cols)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+func (repo *Repository) CollectionAuditsGetAll(
+	ctx context.Context,
+	id int,
+	queryOptions query.SortOrderOptions,
+) ([]*models.CollectionsAudit, error) {
+	audits, err := models.CollectionsAudits(
+		models.CollectionsAuditWhere.ID.EQ(id),
+		qm.Offset(queryOptions.Offset),
+		qm.Limit(queryOptions.Limit),
+		qm.OrderBy(
+			models.CollectionColumns.ContributedAt+" "+queryOptions.SortOrder,
+		),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return audits, nil
+}
+
+func (repo *Repository) CollectionAuditsCount(
+	ctx context.Context,
+	id int,
+) (int, error) {
+	auditsCount, err := models.CollectionsAudits(
+		models.CollectionsAuditWhere.ID.EQ(id),
+	).Count(ctx, repo.exec)
+	if err != nil {
+		return 0, err
+	}
+	return int(auditsCount), nil
+}
+
+// CollectionItemsGetAll returns the items of the collection by position along
+// with the movie or series they refer to
+func (repo *Repository) CollectionItemsGetAll(
+	ctx context.Context,
+	collectionID int,
+) ([]*collection.Item, error) {
+	items, err := models.CollectionItems(
+		models.CollectionItemWhere.CollectionID.EQ(collectionID),
+		qm.Load(models.CollectionItemRels.Film),
+		qm.Load(models.CollectionItemRels.Series),
+		qm.OrderBy(models.CollectionItemColumns.Position+" asc"),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	collectionItems := make([]*collection.Item, len(items))
+	for i, item := range items {
+		collectionItems[i] = &collection.Item{CollectionItem: *item}
+		if item.R != nil {
+			collectionItems[i].Film = item.R.Film
+			collectionItems[i].Series = item.R.Series
+		}
+	}
+	return collectionItems, nil
+}
+
+// CollectionItemsPut replaces the items of the collection: items are upserted
+// by position and positions past the new items are removed. a member moving
+// between positions is allowed as member uniqueness is checked on commit
+func (repo *Repository) CollectionItemsPut(
+	ctx context.Context,
+	collectionID int,
+	contributorID int,
+	items []*models.CollectionItem,
+) error {
+	for i, item := range items {
+		item.CollectionID = collectionID
+		item.Position = i + 1
+		item.ContributedBy = contributorID
+		err := item.Upsert(
+			ctx,
+			repo.exec,
+			true, // update on conflict
+			[]string{
+				// upsert on conflict on unique collection position
+				models.CollectionItemColumns.CollectionID,
+				models.CollectionItemColumns.Position,
+			},
+			boil.Whitelist(
+				models.CollectionItemColumns.FilmID,
+				models.CollectionItemColumns.SeriesID,
+				models.CollectionItemColumns.ContributedBy,
+			),
+			boil.Infer(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	_, err := models.CollectionItems(
+		models.CollectionItemWhere.CollectionID.EQ(collectionID),
+		models.CollectionItemWhere.Position.GT(len(items)),
+	).DeleteAll(ctx, repo.exec)
+	return err
+}
+
+func (repo *Repository) CollectionItemAuditsGetAll(
+	ctx context.Context,
+	collectionID int,
+	queryOptions query.SortOrderOptions,
+) ([]*models.CollectionItemsAudit, error) {
+	audits, err := models.CollectionItemsAudits(
+		models.CollectionItemsAuditWhere.CollectionID.EQ(collectionID),
+		qm.Offset(queryOptions.Offset),
+		qm.Limit(queryOptions.Limit),
+		qm.OrderBy(
+			models.CollectionItemColumns.ContributedAt+" "+queryOptions.SortOrder,
+		),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return audits, nil
+}
+
+func (repo *Repository) CollectionItemAuditsCount(
+	ctx context.Context,
+	collectionID int,
+) (int, error) {
+	auditsCount, err := models.CollectionItemsAudits(
+		models.CollectionItemsAuditWhere.CollectionID.EQ(collectionID),
+	).Count(ctx, repo.exec)
+	if err != nil {
+		return 0, err
+	}
+	return int(auditsCount), nil
+}
@@ -0,0 +1,165 @@
+package repo_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestCollectionItemsPut(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	movies := make([]*models.Film, 2)
+	for i := range movies {
+		movies[i] = &models.Film{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		}
+		err = r.MovieCreate(ctx, user.ID, movies[i])
+		require.NoError(err)
+	}
+	series := &models.Series{Title: "series"}
+	err = r.SeriesCreate(ctx, user.ID, series)
+	require.NoError(err)
+	collection := &models.Collection{Title: "collection"}
+	err = r.CollectionCreate(ctx, user.ID, collection)
+	require.NoError(err)
+
+	getItems := func() (filmIDs []null.Int, seriesIDs []null.Int) {
+		items, err := r.CollectionItemsGetAll(ctx, collection.ID)
+		require.NoError(err)
+		for i, item := range items {
+			require.Equal(i+1, item.Position)
+			require.Equal(item.FilmID.Valid, item.Film != nil)
+			require.Equal(item.SeriesID.Valid, item.Series != nil)
+			filmIDs = append(filmIDs, item.FilmID)
+			seriesIDs = append(seriesIDs, item.SeriesID)
+		}
+		return filmIDs, seriesIDs
+	}
+
+	// put movie 0, series, movie 1
+	err = r.Tx(ctx, nil, func(ctx context.Context, tx repo.Service) error {
+		return tx.CollectionItemsPut(
+			ctx,
+			collection.ID,
+			user.ID,
+			[]*models.CollectionItem{
+				{FilmID: null.IntFrom(movies[0].ID)},
+				{SeriesID: null.IntFrom(series.ID)},
+				{FilmID: null.IntFrom(movies[1].ID)},
+			},
+		)
+	})
+	require.NoError(err)
+
+	filmIDs, seriesIDs := getItems()
+	require.Equal(
+		[]null.Int{null.IntFrom(movies[0].ID), {}, null.IntFrom(movies[1].ID)},
+		filmIDs,
+	)
+	require.Equal([]null.Int{{}, null.IntFrom(series.ID), {}}, seriesIDs)
+
+	// reorder movies and drop the series
+	err = r.Tx(ctx, nil, func(ctx context.Context, tx repo.Service) error {
+		return tx.CollectionItemsPut(
+			ctx,
+			collection.ID,
+			user.ID,
+			[]*models.CollectionItem{
+				{FilmID: null.IntFrom(movies[1].ID)},
+				{FilmID: null.IntFrom(movies[0].ID)},
+			},
+		)
+	})
+	require.NoError(err)
+
+	filmIDs, seriesIDs = getItems()
+	require.Equal(
+		[]null.Int{null.IntFrom(movies[1].ID), null.IntFrom(movies[0].ID)},
+		filmIDs,
+	)
+	require.Equal([]null.Int{{}, {}}, seriesIDs)
+
+	// replaced positions are audited
+	audits, err := r.CollectionItemAuditsGetAll(
+		ctx,
+		collection.ID,
+		query.SortOrderOptions{
+			SortOrder: "asc",
+			Offset:    0,
+			Limit:     math.MaxInt,
+		},
+	)
+	require.NoError(err)
+	require.Equal(2, len(audits))
+	count, err := r.CollectionItemAuditsCount(ctx, collection.ID)
+	require.NoError(err)
+	require.Equal(2, count)
+
+}
+
+func TestWatchlistAddAll(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	movies := make([]*models.Film, 3)
+	for i := range movies {
+		movies[i] = &models.Film{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		}
+		err = r.MovieCreate(ctx, user.ID, movies[i])
+		require.NoError(err)
+	}
+
+	// movie 1 is already in watchlist
+	watchID, err := r.WatchlistAdd(ctx, user.ID, movies[1].ID)
+	require.NoError(err)
+
+	watchIDs, err := r.WatchlistAddAll(
+		ctx,
+		user.ID,
+		[]int{movies[2].ID, movies[1].ID, movies[0].ID, movies[2].ID},
+	)
+	require.NoError(err)
+	require.Equal(2, len(watchIDs))
+
+	watchlist, err := r.WatchlistGet(ctx, user.ID, query.WatchlistOptions{
+		Offset:           0,
+		Limit:            math.MaxInt,
+		SortOrder:        "asc",
+		WhereTimeWatched: repo.RawSqlWhereTimeWatchedEmptyClause,
+	})
+	require.NoError(err)
+	require.Equal(3, len(watchlist))
+	require.Equal(watchID, watchlist[0].ID)
+	require.Equal(watchIDs[0], watchlist[1].ID)
+	require.Equal(movies[2].ID, watchlist[1].FilmID)
+	require.Equal(watchIDs[1], watchlist[2].ID)
+	require.Equal(movies[0].ID, watchlist[2].FilmID)
+}
@@ -0,0 +1,101 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/aria3ppp/watchlist-server/internal/contribution"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// ContributorStatsGet reads the contribution statistics of the user. A user
+// without contributions has zero statistics.
+func (repo *Repository) ContributorStatsGet(
+	ctx context.Context,
+	userID int,
+	reputationOptions query.ReputationOptions,
+) (*contribution.Stats, error) {
+	stats := &contribution.Stats{}
+	err := queries.Raw(
+		contributorStatsGetQuery,
+		append(reputationArgs(reputationOptions), userID)...,
+	).Bind(ctx, repo.exec, stats)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &contribution.Stats{UserID: userID}, nil
+		}
+		return nil, err
+	}
+	return stats, nil
+}
+
+// ContributorStatsGetAll reads the contribution statistics of the contributors
+// from the highest reputation score
+func (repo *Repository) ContributorStatsGetAll(
+	ctx context.Context,
+	reputationOptions query.ReputationOptions,
+	offset int,
+	limit int,
+) (stats []*contribution.Stats, err error) {
+	err = queries.Raw(
+		contributorStatsGetAllQuery,
+		append(reputationArgs(reputationOptions), offset, limit)...,
+	).Bind(ctx, repo.exec, &stats)
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+func (repo *Repository) ContributorsCount(
+	ctx context.Context,
+) (count int, err error) {
+	err = repo.exec.QueryRowContext(ctx, contributorsCountQuery).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (repo *Repository) ContributionsGetAll(
+	ctx context.Context,
+	userID int,
+	queryOptions query.SortOrderOptions,
+) (contributions []*contribution.Contribution, err error) {
+	err = queries.Raw(
+		fmt.Sprintf(contributionsGetAllQuery, queryOptions.SortOrder),
+		userID,
+		queryOptions.Offset,
+		queryOptions.Limit,
+	).Bind(ctx, repo.exec, &contributions)
+	if err != nil {
+		return nil, err
+	}
+	return contributions, nil
+}
+
+func (repo *Repository) ContributionsCount(
+	ctx context.Context,
+	userID int,
+) (count int, err error) {
+	err = repo.exec.QueryRowContext(
+		ctx,
+		contributionsCountQuery,
+		userID,
+	).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func reputationArgs(reputationOptions query.ReputationOptions) []any {
+	return []any{
+		reputationOptions.CreationPoints,
+		reputationOptions.EditPoints,
+		reputationOptions.RevertedEditPenalty,
+		reputationOptions.InvalidationPenalty,
+	}
+}
@@ -0,0 +1,170 @@
+package repo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/contribution"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContributions(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	reputationOptions := query.ReputationOptions{
+		CreationPoints:      10,
+		EditPoints:          2,
+		RevertedEditPenalty: 5,
+		InvalidationPenalty: 10,
+	}
+
+	author := &models.User{Email: "author"}
+	err := r.UserCreate(ctx, author)
+	require.NoError(err)
+	vandal := &models.User{Email: "vandal"}
+	err = r.UserCreate(ctx, vandal)
+	require.NoError(err)
+	idle := &models.User{Email: "idle"}
+	err = r.UserCreate(ctx, idle)
+	require.NoError(err)
+
+	// the author creates a movie
+	movie := &models.Film{
+		Title:        "title",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+	err = r.MovieCreate(ctx, author.ID, movie)
+	require.NoError(err)
+
+	// the vandal edits the movie and the author reverts the edit
+	err = r.MovieUpdate(ctx, movie.ID, vandal.ID, map[string]any{
+		models.FilmColumns.Title: "vandalized",
+	})
+	require.NoError(err)
+	err = r.MovieUpdate(ctx, movie.ID, author.ID, map[string]any{
+		models.FilmColumns.Title: "title",
+	})
+	require.NoError(err)
+
+	// the vandal invalidates the movie
+	err = r.MovieUpdate(ctx, movie.ID, vandal.ID, map[string]any{
+		models.FilmColumns.Invalidation: "invalidation",
+	})
+	require.NoError(err)
+
+	// the author creates a series
+	series := &models.Series{Title: "series"}
+	err = r.SeriesCreate(ctx, author.ID, series)
+	require.NoError(err)
+
+	expAuthorStats := &contribution.Stats{
+		UserID:                author.ID,
+		Creations:             2,
+		Edits:                 1,
+		InvalidationsReceived: 1,
+		Score:                 2*10 + 2 - 10,
+	}
+	expVandalStats := &contribution.Stats{
+		UserID:        vandal.ID,
+		Edits:         1,
+		RevertedEdits: 1,
+		// the score is never negative
+		Score: 0,
+	}
+
+	stats, err := r.ContributorStatsGet(ctx, author.ID, reputationOptions)
+	require.NoError(err)
+	require.Equal(expAuthorStats, stats)
+
+	stats, err = r.ContributorStatsGet(ctx, vandal.ID, reputationOptions)
+	require.NoError(err)
+	require.Equal(expVandalStats, stats)
+
+	// a user without contributions has zero stats
+	stats, err = r.ContributorStatsGet(ctx, idle.ID, reputationOptions)
+	require.NoError(err)
+	require.Equal(&contribution.Stats{UserID: idle.ID}, stats)
+
+	// the leaderboard starts from the highest score
+	leaderboard, err := r.ContributorStatsGetAll(ctx, reputationOptions, 0, 10)
+	require.NoError(err)
+	require.Equal(
+		[]*contribution.Stats{expAuthorStats, expVandalStats},
+		leaderboard,
+	)
+	count, err := r.ContributorsCount(ctx)
+	require.NoError(err)
+	require.Equal(2, count)
+
+	// contributions history
+	contributions, err := r.ContributionsGetAll(
+		ctx,
+		vandal.ID,
+		query.SortOrderOptions{SortOrder: "desc", Limit: 10},
+	)
+	require.NoError(err)
+	require.Equal(2, len(contributions))
+	require.Equal(contribution.ActionInvalidate, contributions[0].Action)
+	require.False(contributions[0].Reverted)
+	require.Equal(contribution.ActionEdit, contributions[1].Action)
+	require.True(contributions[1].Reverted)
+	require.Equal("vandalized", contributions[1].Title)
+
+	contributions, err = r.ContributionsGetAll(
+		ctx,
+		author.ID,
+		query.SortOrderOptions{SortOrder: "asc", Limit: 10},
+	)
+	require.NoError(err)
+	require.Equal(3, len(contributions))
+	require.Equal(contribution.KindMovie, contributions[0].Kind)
+	require.Equal(contribution.ActionCreate, contributions[0].Action)
+	require.Equal(contribution.KindMovie, contributions[1].Kind)
+	require.Equal(contribution.ActionEdit, contributions[1].Action)
+	require.Equal(contribution.KindSeries, contributions[2].Kind)
+	require.Equal(series.ID, contributions[2].ID)
+
+	count, err = r.ContributionsCount(ctx, author.ID)
+	require.NoError(err)
+	require.Equal(3, count)
+
+	// pruning the audits leaves the contributions and the statistics unchanged
+	for _, auditTable := range []string{
+		models.TableNames.FilmsAudit,
+		models.TableNames.SeriesesAudit,
+	} {
+		_, err = r.AuditsPrune(
+			ctx,
+			auditTable,
+			query.AuditRetentionOptions{KeepLast: 1},
+			100,
+		)
+		require.NoError(err)
+	}
+
+	stats, err = r.ContributorStatsGet(ctx, author.ID, reputationOptions)
+	require.NoError(err)
+	require.Equal(expAuthorStats, stats)
+
+	stats, err = r.ContributorStatsGet(ctx, vandal.ID, reputationOptions)
+	require.NoError(err)
+	require.Equal(expVandalStats, stats)
+
+	prunedContributions, err := r.ContributionsGetAll(
+		ctx,
+		author.ID,
+		query.SortOrderOptions{SortOrder: "asc", Limit: 10},
+	)
+	require.NoError(err)
+	require.Equal(contributions, prunedContributions)
+}
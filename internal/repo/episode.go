@@ -38,6 +38,31 @@ func (repo *Repository) EpisodeGet(
 		models.FilmWhere.SeriesID.EQ(null.IntFrom(seriesID)),
 		models.FilmWhere.SeasonNumber.EQ(null.IntFrom(seasonNumber)),
 		models.FilmWhere.EpisodeNumber.EQ(null.IntFrom(episodeNumber)),
+		models.FilmWhere.DeletedAt.IsNull(),
+	).One(ctx, repo.exec)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return episode, nil
+}
+
+// EpisodeGetByAbsoluteNumber gets the episode using the absolute number to
+// check it is unused: a hidden episode is returned as well as it still holds
+// its absolute number. the series is locked so an unused absolute number stays
+// unused until the end of the transaction.
+func (repo *Repository) EpisodeGetByAbsoluteNumber(
+	ctx context.Context,
+	seriesID, absoluteNumber int,
+) (*models.Film, error) {
+	if err := repo.seriesLock(ctx, seriesID); err != nil {
+		return nil, err
+	}
+	episode, err := models.Films(
+		models.FilmWhere.SeriesID.EQ(null.IntFrom(seriesID)),
+		models.FilmWhere.AbsoluteNumber.EQ(null.IntFrom(absoluteNumber)),
 	).One(ctx, repo.exec)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -57,6 +82,7 @@ func (repo *Repository) EpisodesGetAllBySeries(
 		models.FilmWhere.SeriesID.EQ(null.IntFrom(seriesID)),
 		models.FilmWhere.SeasonNumber.IsNotNull(),
 		models.FilmWhere.EpisodeNumber.IsNotNull(),
+		models.FilmWhere.DeletedAt.IsNull(),
 		qm.Offset(queryOptions.Offset),
 		qm.Limit(queryOptions.Limit),
 		qm.OrderBy(models.FilmColumns.SeasonNumber+" "+queryOptions.SortOrder),
@@ -78,6 +104,7 @@ func (repo *Repository) EpisodesGetAllBySeason(
 		models.FilmWhere.SeriesID.EQ(null.IntFrom(seriesID)),
 		models.FilmWhere.SeasonNumber.EQ(null.IntFrom(seasonNumber)),
 		models.FilmWhere.EpisodeNumber.IsNotNull(),
+		models.FilmWhere.DeletedAt.IsNull(),
 		qm.Offset(queryOptions.Offset),
 		qm.Limit(queryOptions.Limit),
 		qm.OrderBy(models.FilmColumns.EpisodeNumber+" "+queryOptions.SortOrder),
@@ -90,6 +117,23 @@ func (repo *Repository) EpisodesGetAllBySeason(
 
 ////////////////////////////////////////////////////////////////////////////////
 
+// seriesLock locks the series until the end of the transaction to serialize
+// the checked writes of its episodes: the episode constraints are deferred so
+// a conflicting write would otherwise only fail on commit
+func (repo *Repository) seriesLock(ctx context.Context, seriesID int) error {
+	_, err := models.Serieses(
+		qm.Select(models.SeriesColumns.ID),
+		models.SeriesWhere.ID.EQ(seriesID),
+		qm.For("UPDATE"),
+	).One(ctx, repo.exec)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
 func (repo *Repository) EpisodesCountBySeries(
 	ctx context.Context,
 	seriesID int,
@@ -98,6 +142,7 @@ func (repo *Repository) EpisodesCountBySeries(
 		models.FilmWhere.SeriesID.EQ(null.IntFrom(seriesID)),
 		models.FilmWhere.SeasonNumber.IsNotNull(),
 		models.FilmWhere.EpisodeNumber.IsNotNull(),
+		models.FilmWhere.DeletedAt.IsNull(),
 	).Count(ctx, repo.exec)
 	return int(nEpisodes), err
 }
@@ -111,6 +156,7 @@ func (repo *Repository) EpisodesCountBySeason(
 		models.FilmWhere.SeriesID.EQ(null.IntFrom(seriesID)),
 		models.FilmWhere.SeasonNumber.EQ(null.IntFrom(seasonNumber)),
 		models.FilmWhere.EpisodeNumber.IsNotNull(),
+		models.FilmWhere.DeletedAt.IsNull(),
 	).Count(ctx, repo.exec)
 	return int(nEpisodes), err
 }
@@ -127,19 +173,42 @@ func (repo *Repository) EpisodePut(
 	episode.SeasonNumber = null.IntFrom(seasonNumber)
 	episode.EpisodeNumber = null.IntFrom(episodeNumber)
 	episode.ContributedBy = contributorID
-	return episode.Upsert(
-		ctx,
-		repo.exec,
-		true, // update on conflict
-		[]string{
-			// upsert on conflict on unique episode
-			models.FilmColumns.SeriesID,
-			models.FilmColumns.SeasonNumber,
-			models.FilmColumns.EpisodeNumber,
-		},
-		boil.Infer(),
-		boil.Infer(),
-	)
+
+	// films_unique_episode_cnst is deferrable to allow renumbering episodes,
+	// so it could not be the arbiter of an upsert: replace the existing
+	// episode by its id instead. a hidden episode is looked up as well and
+	// stays hidden. the series is locked first so concurrent puts of a new
+	// episode do not both insert it
+	if err := repo.seriesLock(ctx, seriesID); err != nil {
+		return err
+	}
+	existing, err := models.Films(
+		models.FilmWhere.SeriesID.EQ(null.IntFrom(seriesID)),
+		models.FilmWhere.SeasonNumber.EQ(null.IntFrom(seasonNumber)),
+		models.FilmWhere.EpisodeNumber.EQ(null.IntFrom(episodeNumber)),
+	).One(ctx, repo.exec)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return err
+		}
+		if err := episode.Insert(ctx, repo.exec, boil.Infer()); err != nil {
+			return err
+		}
+		err := repo.watchlistAppendToFollowers(ctx, seriesID, episode.ID)
+		if err != nil {
+			return err
+		}
+		return repo.seriesAggregatesRefresh(ctx, seriesID)
+	}
+	episode.ID = existing.ID
+	episode.DeletedAt = existing.DeletedAt
+	if _, err := episode.Update(ctx, repo.exec, boil.Infer()); err != nil {
+		return err
+	}
+	if err := episode.Reload(ctx, repo.exec); err != nil {
+		return err
+	}
+	return repo.seriesAggregatesRefresh(ctx, seriesID)
 }
 
 func (repo *Repository) EpisodeUpdate(
@@ -153,6 +222,7 @@ func (repo *Repository) EpisodeUpdate(
 		models.FilmWhere.SeriesID.EQ(null.IntFrom(seriesID)),
 		models.FilmWhere.SeasonNumber.EQ(null.IntFrom(seasonNumber)),
 		models.FilmWhere.EpisodeNumber.EQ(null.IntFrom(episodeNumber)),
+		models.FilmWhere.DeletedAt.IsNull(),
 	).UpdateAll(ctx, repo.exec, cols)
 	if err != nil {
 		return err
@@ -160,7 +230,7 @@ func (repo *Repository) EpisodeUpdate(
 	if rowsAff == 0 {
 		return ErrNoRecord
 	}
-	return nil
+	return repo.seriesAggregatesRefresh(ctx, seriesID)
 }
 
 func (repo *Repository) EpisodesInvalidateAllBySeason(
@@ -174,6 +244,7 @@ func (repo *Repository) EpisodesInvalidateAllBySeason(
 		models.FilmWhere.SeriesID.EQ(null.IntFrom(seriesID)),
 		models.FilmWhere.SeasonNumber.EQ(null.IntFrom(seasonNumber)),
 		models.FilmWhere.EpisodeNumber.IsNotNull(),
+		models.FilmWhere.DeletedAt.IsNull(),
 	).UpdateAll(
 		ctx,
 		repo.exec,
@@ -191,6 +262,66 @@ func (repo *Repository) EpisodesInvalidateAllBySeason(
 	return nil
 }
 
+// EpisodesRenumber moves episodes to their season, episode and absolute
+// numbers in one go. the unique episode constraints are deferred while
+// updating so episodes could swap their numbers, and each episode is updated
+// once so its audit history gets a single record of the move.
+// it must be called in a transaction.
+func (repo *Repository) EpisodesRenumber(
+	ctx context.Context,
+	contributorID int,
+	episodes []*models.Film,
+) error {
+	_, err := repo.exec.ExecContext(
+		ctx,
+		"SET CONSTRAINTS films_unique_episode_cnst, films_unique_absolute_number_cnst DEFERRED",
+	)
+	if err != nil {
+		return err
+	}
+	for _, e := range episodes {
+		rowsAff, err := models.Films(
+			models.FilmWhere.ID.EQ(e.ID),
+			models.FilmWhere.SeriesID.IsNotNull(),
+		).UpdateAll(
+			ctx,
+			repo.exec,
+			map[string]any{
+				models.FilmColumns.SeasonNumber:   e.SeasonNumber,
+				models.FilmColumns.EpisodeNumber:  e.EpisodeNumber,
+				models.FilmColumns.AbsoluteNumber: e.AbsoluteNumber,
+				models.FilmColumns.ContributedBy:  contributorID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		if rowsAff == 0 {
+			return ErrNoRecord
+		}
+	}
+	// check the constraints now rather than on commit
+	_, err = repo.exec.ExecContext(
+		ctx,
+		"SET CONSTRAINTS films_unique_episode_cnst, films_unique_absolute_number_cnst IMMEDIATE",
+	)
+	if err != nil {
+		return err
+	}
+	// refresh the aggregates of the serieses of the moved episodes
+	refreshed := map[int]bool{}
+	for _, e := range episodes {
+		if !e.SeriesID.Valid || refreshed[e.SeriesID.Int] {
+			continue
+		}
+		if err := repo.seriesAggregatesRefresh(ctx, e.SeriesID.Int); err != nil {
+			return err
+		}
+		refreshed[e.SeriesID.Int] = true
+	}
+	return nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 
 // func (repo *Repo) EpisodeAuditsGetAllByID(
@@ -219,9 +350,7 @@ func (repo *Repository) EpisodeAuditsGetAll(
 	queryOptions query.SortOrderOptions,
 ) ([]*models.FilmsAudit, error) {
 	audits, err := models.FilmsAudits(
-		models.FilmsAuditWhere.SeriesID.EQ(null.IntFrom(seriesID)),
-		models.FilmsAuditWhere.SeasonNumber.EQ(null.IntFrom(seasonNumber)),
-		models.FilmsAuditWhere.EpisodeNumber.EQ(null.IntFrom(episodeNumber)),
+		whereEpisodeAuditID(seriesID, seasonNumber, episodeNumber),
 		qm.Offset(queryOptions.Offset),
 		qm.Limit(queryOptions.Limit),
 		qm.OrderBy(
@@ -239,9 +368,7 @@ func (repo *Repository) EpisodeAuditsCount(
 	seriesID, seasonNumber, episodeNumber int,
 ) (int, error) {
 	auditsCount, err := models.FilmsAudits(
-		models.FilmsAuditWhere.SeriesID.EQ(null.IntFrom(seriesID)),
-		models.FilmsAuditWhere.SeasonNumber.EQ(null.IntFrom(seasonNumber)),
-		models.FilmsAuditWhere.EpisodeNumber.EQ(null.IntFrom(episodeNumber)),
+		whereEpisodeAuditID(seriesID, seasonNumber, episodeNumber),
 	).Count(ctx, repo.exec)
 	if err != nil {
 		return 0, err
@@ -249,6 +376,21 @@ func (repo *Repository) EpisodeAuditsCount(
 	return int(auditsCount), nil
 }
 
+// whereEpisodeAuditID matches the audits of an episode by its id rather than
+// its numbers so the history of a renumbered episode follows the episode.
+func whereEpisodeAuditID(
+	seriesID, seasonNumber, episodeNumber int,
+) qm.QueryMod {
+	return qm.Where(
+		models.FilmsAuditColumns.ID+" = (SELECT "+models.FilmColumns.ID+
+			" FROM "+models.TableNames.Films+
+			" WHERE "+models.FilmColumns.SeriesID+" = ?"+
+			" AND "+models.FilmColumns.SeasonNumber+" = ?"+
+			" AND "+models.FilmColumns.EpisodeNumber+" = ?)",
+		seriesID, seasonNumber, episodeNumber,
+	)
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 
 func (repo *Repository) EpisodesAuditsGetAllBySeason(
@@ -4,6 +4,7 @@ import (
 	"context"
 	"math"
 	"testing"
+	"time"
 
 	"github.com/aria3ppp/watchlist-server/internal/models"
 	"github.com/aria3ppp/watchlist-server/internal/query"
@@ -113,6 +114,58 @@ func TestEpisodeGet(t *testing.T) {
 	require.Equal(episode, fetchedEpisode)
 }
 
+func TestEpisodeGetByAbsoluteNumber(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	series := &models.Series{Title: "series"}
+	err = r.SeriesCreate(ctx, user.ID, series)
+	require.NoError(err)
+
+	// first there's no episode
+
+	fetchedEpisode, err := r.EpisodeGetByAbsoluteNumber(ctx, series.ID, 10)
+	require.Equal(repo.ErrNoRecord, err)
+	require.Nil(fetchedEpisode)
+
+	// put an episode
+
+	err = r.EpisodePut(ctx, series.ID, 1, 1, user.ID, &models.Film{
+		Title:          "episode",
+		DateReleased:   testutils.Date(2000, 1, 1),
+		AbsoluteNumber: null.IntFrom(10),
+	})
+	require.NoError(err)
+
+	fetchedEpisode, err = r.EpisodeGetByAbsoluteNumber(ctx, series.ID, 10)
+	require.NoError(err)
+	require.Equal(1, fetchedEpisode.SeasonNumber.Int)
+	require.Equal(1, fetchedEpisode.EpisodeNumber.Int)
+
+	// a hidden episode still holds its absolute number
+
+	err = r.EpisodeSetDeletedAt(
+		ctx,
+		series.ID,
+		1,
+		1,
+		null.TimeFrom(time.Now()),
+	)
+	require.NoError(err)
+
+	fetchedEpisode, err = r.EpisodeGetByAbsoluteNumber(ctx, series.ID, 10)
+	require.NoError(err)
+	require.True(fetchedEpisode.DeletedAt.Valid)
+}
+
 func TestEpisodesGetAllBySeries(t *testing.T) {
 	require := require.New(t)
 
@@ -565,6 +618,77 @@ func TestEpisodePut(t *testing.T) {
 	)
 }
 
+func TestEpisodePutConcurrently(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	series := &models.Series{Title: "series"}
+	err = r.SeriesCreate(ctx, user.ID, series)
+	require.NoError(err)
+
+	// put the same new episode in two transactions: the second put waits for
+	// the first transaction and then replaces its episode
+
+	put := func(title string) func(context.Context, repo.Service) error {
+		return func(ctx context.Context, tx repo.Service) error {
+			return tx.EpisodePut(ctx, series.ID, 1, 1, user.ID, &models.Film{
+				Title:        title,
+				DateReleased: testutils.Date(2000, 1, 1),
+			})
+		}
+	}
+
+	firstPut := make(chan struct{})
+	commitFirst := make(chan struct{})
+	firstErr := make(chan error)
+	go func() {
+		firstErr <- r.Tx(
+			ctx,
+			nil,
+			func(ctx context.Context, tx repo.Service) error {
+				if err := put("first")(ctx, tx); err != nil {
+					return err
+				}
+				close(firstPut)
+				<-commitFirst
+				return nil
+			},
+		)
+	}()
+	<-firstPut
+
+	secondErr := make(chan error)
+	go func() {
+		secondErr <- r.Tx(ctx, nil, put("second"))
+	}()
+	close(commitFirst)
+
+	require.NoError(<-firstErr)
+	require.NoError(<-secondErr)
+
+	fetchedEpisodes, err := r.EpisodesGetAllBySeason(
+		ctx,
+		series.ID,
+		1,
+		query.SortOrderOptions{
+			SortOrder: "asc",
+			Offset:    0,
+			Limit:     math.MaxInt,
+		},
+	)
+	require.NoError(err)
+	require.Equal(1, len(fetchedEpisodes))
+	require.Equal("second", fetchedEpisodes[0].Title)
+}
+
 func TestEpisodeUpdate(t *testing.T) {
 	require := require.New(t)
 
@@ -1522,3 +1646,115 @@ func TestEpisodesAuditsCountBySeries(t *testing.T) {
 	require.NoError(err)
 	require.Equal(len(episodeNewVersions), auditsCount)
 }
+
+func TestEpisodesRenumber(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	series := &models.Series{Title: "series"}
+	err = r.SeriesCreate(ctx, user.ID, series)
+	require.NoError(err)
+
+	// put 3 episodes of season 1
+	episodes := make([]*models.Film, 3)
+	for i := range episodes {
+		episodes[i] = &models.Film{
+			Title:          "episode",
+			DateReleased:   testutils.Date(2000, 1, 1),
+			AbsoluteNumber: null.IntFrom(i + 1),
+		}
+		err = r.EpisodePut(ctx, series.ID, 1, i+1, user.ID, episodes[i])
+		require.NoError(err)
+	}
+
+	// swap episodes 1 and 2 and their absolute numbers and move episode 3
+	// to specials
+	err = r.Tx(ctx, nil, func(ctx context.Context, tx repo.Service) error {
+		return tx.EpisodesRenumber(
+			ctx,
+			user.ID,
+			[]*models.Film{
+				{
+					ID:             episodes[0].ID,
+					SeasonNumber:   null.IntFrom(1),
+					EpisodeNumber:  null.IntFrom(2),
+					AbsoluteNumber: null.IntFrom(2),
+				},
+				{
+					ID:             episodes[1].ID,
+					SeasonNumber:   null.IntFrom(1),
+					EpisodeNumber:  null.IntFrom(1),
+					AbsoluteNumber: null.IntFrom(1),
+				},
+				{
+					ID:            episodes[2].ID,
+					SeasonNumber:  null.IntFrom(0),
+					EpisodeNumber: null.IntFrom(1),
+				},
+			},
+		)
+	})
+	require.NoError(err)
+
+	for _, exp := range []struct {
+		season, number int
+		id             int
+	}{
+		{1, 1, episodes[1].ID},
+		{1, 2, episodes[0].ID},
+		{0, 1, episodes[2].ID},
+	} {
+		episode, err := r.EpisodeGet(ctx, series.ID, exp.season, exp.number)
+		require.NoError(err)
+		require.Equal(exp.id, episode.ID)
+	}
+	special, err := r.EpisodeGet(ctx, series.ID, 0, 1)
+	require.NoError(err)
+	require.False(special.AbsoluteNumber.Valid)
+
+	// each renumbered episode is audited once and its history follows it
+	audits, err := r.EpisodeAuditsGetAll(
+		ctx,
+		series.ID,
+		1,
+		2,
+		query.SortOrderOptions{
+			SortOrder: "asc",
+			Offset:    0,
+			Limit:     math.MaxInt,
+		},
+	)
+	require.NoError(err)
+	require.Equal(1, len(audits))
+	require.Equal(episodes[0].ID, audits[0].ID)
+	require.Equal(1, audits[0].EpisodeNumber.Int)
+
+	// moving to the numbers of an episode not moved fails
+	err = r.Tx(ctx, nil, func(ctx context.Context, tx repo.Service) error {
+		return tx.EpisodesRenumber(
+			ctx,
+			user.ID,
+			[]*models.Film{
+				{
+					ID:            episodes[2].ID,
+					SeasonNumber:  null.IntFrom(1),
+					EpisodeNumber: null.IntFrom(1),
+				},
+			},
+		)
+	})
+	require.Error(err)
+
+	// and changes nothing
+	special, err = r.EpisodeGet(ctx, series.ID, 0, 1)
+	require.NoError(err)
+	require.Equal(episodes[2].ID, special.ID)
+}
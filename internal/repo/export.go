@@ -0,0 +1,148 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (repo *Repository) CatalogExportGetLatest(
+	ctx context.Context,
+	status string,
+) (*models.CatalogExport, error) {
+	export, err := models.CatalogExports(
+		models.CatalogExportWhere.Status.EQ(status),
+		qm.OrderBy(models.CatalogExportColumns.ID+" DESC"),
+	).One(ctx, repo.exec)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return export, nil
+}
+
+func (repo *Repository) CatalogExportCreate(
+	ctx context.Context,
+	export *models.CatalogExport,
+) error {
+	return export.Insert(ctx, repo.exec, boil.Infer())
+}
+
+func (repo *Repository) CatalogExportUpdate(
+	ctx context.Context,
+	id int,
+	cols map[string]any,
+) error {
+	rowsAff, err := models.CatalogExports(
+		models.CatalogExportWhere.ID.EQ(id),
+	).UpdateAll(ctx, repo.exec, cols)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// FilmsGetAllByCursor reads up to limit films with ids greater than cursor.
+// Films include both movies and episodes.
+func (repo *Repository) FilmsGetAllByCursor(
+	ctx context.Context,
+	cursor int,
+	limit int,
+) ([]*models.Film, error) {
+	films, err := models.Films(
+		models.FilmWhere.ID.GT(cursor),
+		models.FilmWhere.DeletedAt.IsNull(),
+		qm.Limit(limit),
+		qm.OrderBy(models.FilmColumns.ID+" ASC"),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return films, nil
+}
+
+func (repo *Repository) SeriesesGetAllByCursor(
+	ctx context.Context,
+	cursor int,
+	limit int,
+) ([]*models.Series, error) {
+	serieses, err := models.Serieses(
+		models.SeriesWhere.ID.GT(cursor),
+		models.SeriesWhere.DeletedAt.IsNull(),
+		qm.Limit(limit),
+		qm.OrderBy(models.SeriesColumns.ID+" ASC"),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return serieses, nil
+}
+
+// auditCursorWhere compares the audits primary key to the cursor
+func auditCursorWhere(cursor query.AuditCursor) qm.QueryMod {
+	return qm.Where(
+		fmt.Sprintf(
+			"(%s, %s, %s) > (?, ?, ?)",
+			models.FilmsAuditColumns.ID,
+			models.FilmsAuditColumns.ContributedAt,
+			models.FilmsAuditColumns.ContributedBy,
+		),
+		cursor.ID,
+		cursor.ContributedAt,
+		cursor.ContributedBy,
+	)
+}
+
+// auditCursorOrderBy orders audits by their primary key
+var auditCursorOrderBy = qm.OrderBy(
+	fmt.Sprintf(
+		"%s ASC, %s ASC, %s ASC",
+		models.FilmsAuditColumns.ID,
+		models.FilmsAuditColumns.ContributedAt,
+		models.FilmsAuditColumns.ContributedBy,
+	),
+)
+
+func (repo *Repository) FilmAuditsGetAllByCursor(
+	ctx context.Context,
+	cursor query.AuditCursor,
+	limit int,
+) ([]*models.FilmsAudit, error) {
+	audits, err := models.FilmsAudits(
+		auditCursorWhere(cursor),
+		qm.Limit(limit),
+		auditCursorOrderBy,
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return audits, nil
+}
+
+func (repo *Repository) SeriesAuditsGetAllByCursor(
+	ctx context.Context,
+	cursor query.AuditCursor,
+	limit int,
+) ([]*models.SeriesesAudit, error) {
+	audits, err := models.SeriesesAudits(
+		auditCursorWhere(cursor),
+		qm.Limit(limit),
+		auditCursorOrderBy,
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return audits, nil
+}
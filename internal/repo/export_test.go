@@ -0,0 +1,195 @@
+package repo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestCatalogExport(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	// first there's no export
+
+	export, err := r.CatalogExportGetLatest(ctx, "succeeded")
+	require.Equal(repo.ErrNoRecord, err)
+	require.Nil(export)
+
+	err = r.CatalogExportUpdate(ctx, 1, map[string]any{
+		models.CatalogExportColumns.Status: "succeeded",
+	})
+	require.Equal(repo.ErrNoRecord, err)
+
+	// create exports
+
+	exports := make([]*models.CatalogExport, 3)
+	for i := range exports {
+		exports[i] = &models.CatalogExport{Status: "running"}
+		err = r.CatalogExportCreate(ctx, exports[i])
+		require.NoError(err)
+	}
+
+	// the first two succeed
+	for _, e := range exports[:2] {
+		err = r.CatalogExportUpdate(ctx, e.ID, map[string]any{
+			models.CatalogExportColumns.Status:     "succeeded",
+			models.CatalogExportColumns.FinishedAt: null.TimeFrom(e.CreatedAt),
+		})
+		require.NoError(err)
+	}
+
+	export, err = r.CatalogExportGetLatest(ctx, "succeeded")
+	require.NoError(err)
+	require.Equal(exports[1].ID, export.ID)
+	require.True(export.FinishedAt.Valid)
+
+	export, err = r.CatalogExportGetLatest(ctx, "running")
+	require.NoError(err)
+	require.Equal(exports[2].ID, export.ID)
+	require.False(export.FinishedAt.Valid)
+}
+
+func TestFilmsGetAllByCursor(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	series := &models.Series{Title: "series"}
+	err = r.SeriesCreate(ctx, user.ID, series)
+	require.NoError(err)
+
+	// films are both movies and episodes
+	films := make([]*models.Film, 5)
+	for i := range films {
+		films[i] = &models.Film{
+			Title:        "film",
+			DateReleased: testutils.Date(2000, 1, 1),
+		}
+		if i%2 == 0 {
+			err = r.MovieCreate(ctx, user.ID, films[i])
+		} else {
+			err = r.EpisodePut(ctx, series.ID, 1, i, user.ID, films[i])
+		}
+		require.NoError(err)
+	}
+
+	// read by batches of 2
+	var fetchedIDs []int
+	cursor := 0
+	for {
+		batch, err := r.FilmsGetAllByCursor(ctx, cursor, 2)
+		require.NoError(err)
+		require.LessOrEqual(len(batch), 2)
+		if len(batch) == 0 {
+			break
+		}
+		for _, f := range batch {
+			fetchedIDs = append(fetchedIDs, f.ID)
+		}
+		cursor = batch[len(batch)-1].ID
+	}
+
+	expIDs := make([]int, len(films))
+	for i, f := range films {
+		expIDs[i] = f.ID
+	}
+	require.Equal(expIDs, fetchedIDs)
+
+	// serieses
+	serieses, err := r.SeriesesGetAllByCursor(ctx, 0, 2)
+	require.NoError(err)
+	require.Equal(1, len(serieses))
+	require.Equal(series.ID, serieses[0].ID)
+
+	serieses, err = r.SeriesesGetAllByCursor(ctx, series.ID, 2)
+	require.NoError(err)
+	require.Equal(0, len(serieses))
+}
+
+func TestAuditsGetAllByCursor(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	movie := &models.Film{
+		Title:        "movie",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+	err = r.MovieCreate(ctx, user.ID, movie)
+	require.NoError(err)
+	series := &models.Series{Title: "series"}
+	err = r.SeriesCreate(ctx, user.ID, series)
+	require.NoError(err)
+
+	// every update audits the previous row
+	updates := 3
+	for i := 0; i < updates; i++ {
+		err = r.MovieUpdate(ctx, movie.ID, user.ID, map[string]any{
+			models.FilmColumns.Title: "movie updated",
+		})
+		require.NoError(err)
+		err = r.SeriesUpdate(ctx, series.ID, user.ID, map[string]any{
+			models.SeriesColumns.Title: "series updated",
+		})
+		require.NoError(err)
+	}
+
+	// read film audits by batches of 2
+	var filmAudits []*models.FilmsAudit
+	var cursor query.AuditCursor
+	for {
+		batch, err := r.FilmAuditsGetAllByCursor(ctx, cursor, 2)
+		require.NoError(err)
+		if len(batch) == 0 {
+			break
+		}
+		filmAudits = append(filmAudits, batch...)
+		last := batch[len(batch)-1]
+		cursor = query.AuditCursor{
+			ID:            last.ID,
+			ContributedAt: last.ContributedAt,
+			ContributedBy: last.ContributedBy,
+		}
+	}
+	require.Equal(updates, len(filmAudits))
+	for i := 1; i < len(filmAudits); i++ {
+		require.True(
+			filmAudits[i-1].ContributedAt.Before(filmAudits[i].ContributedAt),
+		)
+	}
+
+	// read series audits at once
+	seriesAudits, err := r.SeriesAuditsGetAllByCursor(
+		ctx,
+		query.AuditCursor{},
+		updates+1,
+	)
+	require.NoError(err)
+	require.Equal(updates, len(seriesAudits))
+}
@@ -0,0 +1,200 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// FilmExternalIDGet reads the external id of a film by its provider and id
+func (repo *Repository) FilmExternalIDGet(
+	ctx context.Context,
+	provider string,
+	externalID string,
+) (*models.ExternalID, error) {
+	return repo.externalIDGet(
+		ctx,
+		provider,
+		externalID,
+		models.ExternalIDWhere.FilmID.IsNotNull(),
+	)
+}
+
+// SeriesExternalIDGet reads the external id of a series by its provider and id
+func (repo *Repository) SeriesExternalIDGet(
+	ctx context.Context,
+	provider string,
+	externalID string,
+) (*models.ExternalID, error) {
+	return repo.externalIDGet(
+		ctx,
+		provider,
+		externalID,
+		models.ExternalIDWhere.SeriesID.IsNotNull(),
+	)
+}
+
+func (repo *Repository) externalIDGet(
+	ctx context.Context,
+	provider string,
+	externalID string,
+	whereTarget qm.QueryMod,
+) (*models.ExternalID, error) {
+	extID, err := models.ExternalIds(
+		models.ExternalIDWhere.Provider.EQ(provider),
+		models.ExternalIDWhere.ExternalID.EQ(externalID),
+		whereTarget,
+	).One(ctx, repo.exec)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return extID, nil
+}
+
+func (repo *Repository) ExternalIDsGetAllByFilm(
+	ctx context.Context,
+	filmID int,
+) ([]*models.ExternalID, error) {
+	extIDs, err := models.ExternalIds(
+		models.ExternalIDWhere.FilmID.EQ(null.IntFrom(filmID)),
+		qm.OrderBy(models.ExternalIDColumns.Provider+" asc"),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return extIDs, nil
+}
+
+func (repo *Repository) ExternalIDsGetAllBySeries(
+	ctx context.Context,
+	seriesID int,
+) ([]*models.ExternalID, error) {
+	extIDs, err := models.ExternalIds(
+		models.ExternalIDWhere.SeriesID.EQ(null.IntFrom(seriesID)),
+		qm.OrderBy(models.ExternalIDColumns.Provider+" asc"),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return extIDs, nil
+}
+
+func (repo *Repository) ExternalIDPutByFilm(
+	ctx context.Context,
+	filmID int,
+	contributorID int,
+	extID *models.ExternalID,
+) error {
+	extID.FilmID = null.IntFrom(filmID)
+	extID.SeriesID = null.Int{}
+	extID.ContributedBy = contributorID
+	return extID.Upsert(
+		ctx,
+		repo.exec,
+		true, // update on conflict
+		[]string{
+			// upsert on conflict on unique film provider
+			models.ExternalIDColumns.FilmID,
+			models.ExternalIDColumns.Provider,
+		},
+		boil.Infer(),
+		boil.Infer(),
+	)
+}
+
+func (repo *Repository) ExternalIDPutBySeries(
+	ctx context.Context,
+	seriesID int,
+	contributorID int,
+	extID *models.ExternalID,
+) error {
+	extID.FilmID = null.Int{}
+	extID.SeriesID = null.IntFrom(seriesID)
+	extID.ContributedBy = contributorID
+	return extID.Upsert(
+		ctx,
+		repo.exec,
+		true, // update on conflict
+		[]string{
+			// upsert on conflict on unique series provider
+			models.ExternalIDColumns.SeriesID,
+			models.ExternalIDColumns.Provider,
+		},
+		boil.Infer(),
+		boil.Infer(),
+	)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (repo *Repository) ExternalIDAuditsGetAllByFilm(
+	ctx context.Context,
+	filmID int,
+	queryOptions query.SortOrderOptions,
+) ([]*models.ExternalIdsAudit, error) {
+	audits, err := models.ExternalIdsAudits(
+		models.ExternalIdsAuditWhere.FilmID.EQ(null.IntFrom(filmID)),
+		qm.Offset(queryOptions.Offset),
+		qm.Limit(queryOptions.Limit),
+		qm.OrderBy(
+			models.ExternalIdsAuditColumns.ContributedAt+" "+queryOptions.SortOrder,
+		),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return audits, nil
+}
+
+func (repo *Repository) ExternalIDAuditsCountByFilm(
+	ctx context.Context,
+	filmID int,
+) (int, error) {
+	auditsCount, err := models.ExternalIdsAudits(
+		models.ExternalIdsAuditWhere.FilmID.EQ(null.IntFrom(filmID)),
+	).Count(ctx, repo.exec)
+	if err != nil {
+		return 0, err
+	}
+	return int(auditsCount), nil
+}
+
+func (repo *Repository) ExternalIDAuditsGetAllBySeries(
+	ctx context.Context,
+	seriesID int,
+	queryOptions query.SortOrderOptions,
+) ([]*models.ExternalIdsAudit, error) {
+	audits, err := models.ExternalIdsAudits(
+		models.ExternalIdsAuditWhere.SeriesID.EQ(null.IntFrom(seriesID)),
+		qm.Offset(queryOptions.Offset),
+		qm.Limit(queryOptions.Limit),
+		qm.OrderBy(
+			models.ExternalIdsAuditColumns.ContributedAt+" "+queryOptions.SortOrder,
+		),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return audits, nil
+}
+
+func (repo *Repository) ExternalIDAuditsCountBySeries(
+	ctx context.Context,
+	seriesID int,
+) (int, error) {
+	auditsCount, err := models.ExternalIdsAudits(
+		models.ExternalIdsAuditWhere.SeriesID.EQ(null.IntFrom(seriesID)),
+	).Count(ctx, repo.exec)
+	if err != nil {
+		return 0, err
+	}
+	return int(auditsCount), nil
+}
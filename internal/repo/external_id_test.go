@@ -0,0 +1,441 @@
+package repo_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestFilmExternalIDGet(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	movie := &models.Film{
+		Title:        "movie",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+	err = r.MovieCreate(ctx, user.ID, movie)
+	require.NoError(err)
+
+	// first there's no external id
+
+	fetchedExtID, err := r.FilmExternalIDGet(ctx, "imdb", "tt0111161")
+	require.Equal(repo.ErrNoRecord, err)
+	require.Nil(fetchedExtID)
+
+	// put an external id
+
+	extID := &models.ExternalID{Provider: "imdb", ExternalID: "tt0111161"}
+	err = r.ExternalIDPutByFilm(ctx, movie.ID, user.ID, extID)
+	require.NoError(err)
+
+	// fetch the external id
+
+	fetchedExtID, err = r.FilmExternalIDGet(ctx, "imdb", "tt0111161")
+	require.NoError(err)
+	require.Equal(extID.ID, fetchedExtID.ID)
+	require.Equal(null.IntFrom(movie.ID), fetchedExtID.FilmID)
+	require.Equal(null.Int{}, fetchedExtID.SeriesID)
+
+	// the same external id of another provider does not exist
+
+	fetchedExtID, err = r.FilmExternalIDGet(ctx, "tmdb", "tt0111161")
+	require.Equal(repo.ErrNoRecord, err)
+	require.Nil(fetchedExtID)
+
+	// the external id of the film is not a series external id
+
+	fetchedExtID, err = r.SeriesExternalIDGet(ctx, "imdb", "tt0111161")
+	require.Equal(repo.ErrNoRecord, err)
+	require.Nil(fetchedExtID)
+}
+
+func TestExternalIDsGetAllByFilm(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	movie := &models.Film{
+		Title:        "movie",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+	err = r.MovieCreate(ctx, user.ID, movie)
+	require.NoError(err)
+
+	// first there's no external ids
+
+	fetchedExtIDs, err := r.ExternalIDsGetAllByFilm(ctx, movie.ID)
+	require.NoError(err)
+	require.Equal(0, len(fetchedExtIDs))
+
+	// put external ids
+
+	extIDs := []*models.ExternalID{
+		{Provider: "wikidata", ExternalID: "Q172241"},
+		{Provider: "imdb", ExternalID: "tt0111161"},
+		{Provider: "tmdb", ExternalID: "278"},
+	}
+	for _, e := range extIDs {
+		err = r.ExternalIDPutByFilm(ctx, movie.ID, user.ID, e)
+		require.NoError(err)
+	}
+
+	// fetch external ids: ordered by provider
+
+	fetchedExtIDs, err = r.ExternalIDsGetAllByFilm(ctx, movie.ID)
+	require.NoError(err)
+	require.Equal(len(extIDs), len(fetchedExtIDs))
+
+	for i, expIndex := range []int{1, 2, 0} {
+		require.Equal(extIDs[expIndex].ID, fetchedExtIDs[i].ID)
+		require.Equal(extIDs[expIndex].Provider, fetchedExtIDs[i].Provider)
+		require.Equal(
+			extIDs[expIndex].ExternalID,
+			fetchedExtIDs[i].ExternalID,
+		)
+	}
+}
+
+func TestExternalIDsGetAllBySeries(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	series := &models.Series{
+		Title:       "series",
+		DateStarted: testutils.Date(2000, 1, 1),
+	}
+	err = r.SeriesCreate(ctx, user.ID, series)
+	require.NoError(err)
+
+	// first there's no external ids
+
+	fetchedExtIDs, err := r.ExternalIDsGetAllBySeries(ctx, series.ID)
+	require.NoError(err)
+	require.Equal(0, len(fetchedExtIDs))
+
+	// put external ids
+
+	extIDs := []*models.ExternalID{
+		{Provider: "imdb", ExternalID: "tt0903747"},
+		{Provider: "tmdb", ExternalID: "1396"},
+	}
+	for _, e := range extIDs {
+		err = r.ExternalIDPutBySeries(ctx, series.ID, user.ID, e)
+		require.NoError(err)
+	}
+
+	// fetch external ids
+
+	fetchedExtIDs, err = r.ExternalIDsGetAllBySeries(ctx, series.ID)
+	require.NoError(err)
+	require.Equal(len(extIDs), len(fetchedExtIDs))
+
+	for i, fe := range fetchedExtIDs {
+		require.Equal(extIDs[i].ID, fe.ID)
+		require.Equal(null.IntFrom(series.ID), fe.SeriesID)
+		require.Equal(null.Int{}, fe.FilmID)
+		require.Equal(extIDs[i].ExternalID, fe.ExternalID)
+	}
+}
+
+func TestExternalIDPutByFilm(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	movies := []*models.Film{
+		{Title: "m1", DateReleased: testutils.Date(2000, 1, 1)},
+		{Title: "m2", DateReleased: testutils.Date(2001, 1, 1)},
+	}
+	for _, m := range movies {
+		err = r.MovieCreate(ctx, user.ID, m)
+		require.NoError(err)
+	}
+
+	// put an external id
+
+	extID := &models.ExternalID{Provider: "imdb", ExternalID: "tt0111161"}
+	err = r.ExternalIDPutByFilm(ctx, movies[0].ID, user.ID, extID)
+	require.NoError(err)
+
+	// replace the external id of the same provider
+
+	newExtID := &models.ExternalID{Provider: "imdb", ExternalID: "tt0068646"}
+	err = r.ExternalIDPutByFilm(ctx, movies[0].ID, user.ID, newExtID)
+	require.NoError(err)
+	require.Equal(extID.ID, newExtID.ID)
+
+	fetchedExtIDs, err := r.ExternalIDsGetAllByFilm(ctx, movies[0].ID)
+	require.NoError(err)
+	require.Equal(1, len(fetchedExtIDs))
+	require.Equal("tt0068646", fetchedExtIDs[0].ExternalID)
+
+	// the replaced external id is audited
+
+	nAudits, err := r.ExternalIDAuditsCountByFilm(ctx, movies[0].ID)
+	require.NoError(err)
+	require.Equal(1, nAudits)
+
+	// an external id could not identify two films
+
+	dupExtID := &models.ExternalID{Provider: "imdb", ExternalID: "tt0068646"}
+	err = r.ExternalIDPutByFilm(ctx, movies[1].ID, user.ID, dupExtID)
+	require.Error(err)
+	var pqErr *pq.Error
+	require.True(errors.As(err, &pqErr))
+	require.Equal(
+		"external_ids_unique_film_provider_external_id_idx",
+		pqErr.Constraint,
+	)
+}
+
+func TestExternalIDPutBySeries(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	series := &models.Series{
+		Title:       "series",
+		DateStarted: testutils.Date(2000, 1, 1),
+	}
+	err = r.SeriesCreate(ctx, user.ID, series)
+	require.NoError(err)
+	movie := &models.Film{
+		Title:        "movie",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+	err = r.MovieCreate(ctx, user.ID, movie)
+	require.NoError(err)
+	otherSeries := &models.Series{
+		Title:       "other series",
+		DateStarted: testutils.Date(2000, 1, 1),
+	}
+	err = r.SeriesCreate(ctx, user.ID, otherSeries)
+	require.NoError(err)
+
+	// put an external id
+
+	extID := &models.ExternalID{Provider: "wikidata", ExternalID: "Q1079"}
+	err = r.ExternalIDPutBySeries(ctx, series.ID, user.ID, extID)
+	require.NoError(err)
+
+	// replace the external id of the same provider
+
+	newExtID := &models.ExternalID{Provider: "wikidata", ExternalID: "Q1080"}
+	err = r.ExternalIDPutBySeries(ctx, series.ID, user.ID, newExtID)
+	require.NoError(err)
+	require.Equal(extID.ID, newExtID.ID)
+
+	nAudits, err := r.ExternalIDAuditsCountBySeries(ctx, series.ID)
+	require.NoError(err)
+	require.Equal(1, nAudits)
+
+	// the films and the serieses of a provider are numbered apart: tmdb movie
+	// and tv ids overlap
+
+	filmExtID := &models.ExternalID{Provider: "tmdb", ExternalID: "1399"}
+	err = r.ExternalIDPutByFilm(ctx, movie.ID, user.ID, filmExtID)
+	require.NoError(err)
+	seriesExtID := &models.ExternalID{Provider: "tmdb", ExternalID: "1399"}
+	err = r.ExternalIDPutBySeries(ctx, series.ID, user.ID, seriesExtID)
+	require.NoError(err)
+
+	fetchedExtID, err := r.SeriesExternalIDGet(ctx, "tmdb", "1399")
+	require.NoError(err)
+	require.Equal(null.IntFrom(series.ID), fetchedExtID.SeriesID)
+	fetchedExtID, err = r.FilmExternalIDGet(ctx, "tmdb", "1399")
+	require.NoError(err)
+	require.Equal(null.IntFrom(movie.ID), fetchedExtID.FilmID)
+
+	// an external id could not identify two serieses
+
+	dupExtID := &models.ExternalID{Provider: "wikidata", ExternalID: "Q1080"}
+	err = r.ExternalIDPutBySeries(ctx, otherSeries.ID, user.ID, dupExtID)
+	require.Error(err)
+	var pqErr *pq.Error
+	require.True(errors.As(err, &pqErr))
+	require.Equal(
+		"external_ids_unique_series_provider_external_id_idx",
+		pqErr.Constraint,
+	)
+}
+
+func TestExternalIDAuditsGetAllByFilm(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	movie := &models.Film{
+		Title:        "movie",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+	err = r.MovieCreate(ctx, user.ID, movie)
+	require.NoError(err)
+
+	queryOptions := query.SortOrderOptions{
+		Offset:    0,
+		Limit:     math.MaxInt,
+		SortOrder: "desc",
+	}
+
+	// first there's no audits
+
+	audits, err := r.ExternalIDAuditsGetAllByFilm(ctx, movie.ID, queryOptions)
+	require.NoError(err)
+	require.Equal(0, len(audits))
+
+	// audits for movie external id
+
+	values := []string{"tt0000001", "tt0000002", "tt0000003", "tt0000004"}
+	for _, v := range values {
+		err = r.ExternalIDPutByFilm(
+			ctx,
+			movie.ID,
+			user.ID,
+			&models.ExternalID{Provider: "imdb", ExternalID: v},
+		)
+		require.NoError(err)
+	}
+
+	// fetch audits: the latest audit holds the previous value
+
+	audits, err = r.ExternalIDAuditsGetAllByFilm(ctx, movie.ID, queryOptions)
+	require.NoError(err)
+	require.Equal(len(values)-1, len(audits))
+
+	for i, a := range audits {
+		require.Equal(null.IntFrom(movie.ID), a.FilmID)
+		require.Equal("imdb", a.Provider)
+		require.Equal(values[len(values)-i-2], a.ExternalID)
+		require.Equal(user.ID, a.ContributedBy)
+	}
+
+	// count audits
+
+	nAudits, err := r.ExternalIDAuditsCountByFilm(ctx, movie.ID)
+	require.NoError(err)
+	require.Equal(len(values)-1, nAudits)
+}
+
+func TestExternalIDAuditsGetAllBySeries(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	series := &models.Series{
+		Title:       "series",
+		DateStarted: testutils.Date(2000, 1, 1),
+	}
+	err = r.SeriesCreate(ctx, user.ID, series)
+	require.NoError(err)
+
+	queryOptions := query.SortOrderOptions{
+		Offset:    0,
+		Limit:     math.MaxInt,
+		SortOrder: "asc",
+	}
+
+	// first there's no audits
+
+	audits, err := r.ExternalIDAuditsGetAllBySeries(
+		ctx,
+		series.ID,
+		queryOptions,
+	)
+	require.NoError(err)
+	require.Equal(0, len(audits))
+
+	// audits for series external id
+
+	values := []string{"1", "2", "3"}
+	for _, v := range values {
+		err = r.ExternalIDPutBySeries(
+			ctx,
+			series.ID,
+			user.ID,
+			&models.ExternalID{Provider: "tmdb", ExternalID: v},
+		)
+		require.NoError(err)
+	}
+
+	// fetch audits: the oldest audit holds the first value
+
+	audits, err = r.ExternalIDAuditsGetAllBySeries(
+		ctx,
+		series.ID,
+		queryOptions,
+	)
+	require.NoError(err)
+	require.Equal(len(values)-1, len(audits))
+
+	for i, a := range audits {
+		require.Equal(null.IntFrom(series.ID), a.SeriesID)
+		require.Equal(values[i], a.ExternalID)
+	}
+
+	// count audits
+
+	nAudits, err := r.ExternalIDAuditsCountBySeries(ctx, series.ID)
+	require.NoError(err)
+	require.Equal(len(values)-1, nAudits)
+}
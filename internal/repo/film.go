@@ -2,13 +2,32 @@ package repo
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/aria3ppp/watchlist-server/internal/models"
 )
 
+func (repo *Repository) FilmGet(
+	ctx context.Context,
+	filmID int,
+) (*models.Film, error) {
+	film, err := models.Films(
+		models.FilmWhere.ID.EQ(filmID),
+		models.FilmWhere.DeletedAt.IsNull(),
+	).One(ctx, repo.exec)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return film, nil
+}
+
 func (repo *Repository) FilmExists(ctx context.Context, filmID int) error {
 	exists, err := models.Films(
 		models.FilmWhere.ID.EQ(filmID),
+		models.FilmWhere.DeletedAt.IsNull(),
 	).Exists(ctx, repo.exec)
 	if err != nil {
 		return err
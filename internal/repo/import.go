@@ -0,0 +1,104 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (repo *Repository) ImportJobGet(
+	ctx context.Context,
+	id int,
+) (*models.ImportJob, error) {
+	job, err := models.FindImportJob(ctx, repo.exec, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return job, nil
+}
+
+func (repo *Repository) ImportJobCreate(
+	ctx context.Context,
+	job *models.ImportJob,
+) error {
+	return job.Insert(ctx, repo.exec, boil.Infer())
+}
+
+func (repo *Repository) ImportJobUpdate(
+	ctx context.Context,
+	id int,
+	cols map[string]any,
+) error {
+	rowsAff, err := models.ImportJobs(
+		models.ImportJobWhere.ID.EQ(id),
+	).UpdateAll(ctx, repo.exec, cols)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+func (repo *Repository) ImportJobsUpdateAllByStatus(
+	ctx context.Context,
+	statuses []string,
+	cols map[string]any,
+) (int, error) {
+	rowsAff, err := models.ImportJobs(
+		models.ImportJobWhere.Status.IN(statuses),
+	).UpdateAll(ctx, repo.exec, cols)
+	if err != nil {
+		return 0, err
+	}
+	return int(rowsAff), nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (repo *Repository) ImportErrorCreate(
+	ctx context.Context,
+	importError *models.ImportError,
+) error {
+	return importError.Insert(ctx, repo.exec, boil.Infer())
+}
+
+func (repo *Repository) ImportErrorsGetAll(
+	ctx context.Context,
+	jobID int,
+	queryOptions query.SortOrderOptions,
+) ([]*models.ImportError, error) {
+	importErrors, err := models.ImportErrors(
+		models.ImportErrorWhere.JobID.EQ(jobID),
+		qm.Offset(queryOptions.Offset),
+		qm.Limit(queryOptions.Limit),
+		qm.OrderBy(
+			models.ImportErrorColumns.RowNumber+" "+queryOptions.SortOrder,
+		),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return importErrors, nil
+}
+
+func (repo *Repository) ImportErrorsCount(
+	ctx context.Context,
+	jobID int,
+) (int, error) {
+	count, err := models.ImportErrors(
+		models.ImportErrorWhere.JobID.EQ(jobID),
+	).Count(ctx, repo.exec)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
@@ -0,0 +1,173 @@
+package repo_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestImportJob(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+
+	// first there's no job
+
+	fetchedJob, err := r.ImportJobGet(ctx, 1)
+	require.Equal(repo.ErrNoRecord, err)
+	require.Nil(fetchedJob)
+
+	err = r.ImportJobUpdate(ctx, 1, map[string]any{
+		models.ImportJobColumns.Status: "running",
+	})
+	require.Equal(repo.ErrNoRecord, err)
+
+	// create job
+
+	job := &models.ImportJob{
+		UserID:    user.ID,
+		Format:    "csv",
+		DryRun:    true,
+		Status:    "pending",
+		TotalRows: 10,
+	}
+	err = r.ImportJobCreate(ctx, job)
+	require.NoError(err)
+
+	fetchedJob, err = r.ImportJobGet(ctx, job.ID)
+	require.NoError(err)
+	require.Equal(job.UserID, fetchedJob.UserID)
+	require.Equal(job.Format, fetchedJob.Format)
+	require.Equal(job.DryRun, fetchedJob.DryRun)
+	require.Equal(job.Status, fetchedJob.Status)
+	require.Equal(job.TotalRows, fetchedJob.TotalRows)
+	require.Equal(0, fetchedJob.ProcessedRows)
+	require.Equal(0, fetchedJob.FailedRows)
+	require.False(fetchedJob.FinishedAt.Valid)
+
+	// update job
+
+	err = r.ImportJobUpdate(ctx, job.ID, map[string]any{
+		models.ImportJobColumns.Status:        "succeeded",
+		models.ImportJobColumns.ProcessedRows: 10,
+		models.ImportJobColumns.FinishedAt:    null.TimeFrom(job.CreatedAt),
+	})
+	require.NoError(err)
+
+	fetchedJob, err = r.ImportJobGet(ctx, job.ID)
+	require.NoError(err)
+	require.Equal("succeeded", fetchedJob.Status)
+	require.Equal(10, fetchedJob.ProcessedRows)
+	require.True(fetchedJob.FinishedAt.Valid)
+
+	// update jobs by status
+
+	runningJob := &models.ImportJob{
+		UserID:    user.ID,
+		Format:    "csv",
+		Status:    "running",
+		TotalRows: 10,
+	}
+	err = r.ImportJobCreate(ctx, runningJob)
+	require.NoError(err)
+
+	updated, err := r.ImportJobsUpdateAllByStatus(
+		ctx,
+		[]string{"pending", "running"},
+		map[string]any{models.ImportJobColumns.Status: "failed"},
+	)
+	require.NoError(err)
+	require.Equal(1, updated)
+
+	fetchedJob, err = r.ImportJobGet(ctx, runningJob.ID)
+	require.NoError(err)
+	require.Equal("failed", fetchedJob.Status)
+
+	// the finished job is left alone
+	fetchedJob, err = r.ImportJobGet(ctx, job.ID)
+	require.NoError(err)
+	require.Equal("succeeded", fetchedJob.Status)
+}
+
+func TestImportErrors(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	job := &models.ImportJob{UserID: user.ID, Format: "csv", Status: "pending"}
+	err = r.ImportJobCreate(ctx, job)
+	require.NoError(err)
+
+	// first there's no errors
+
+	count, err := r.ImportErrorsCount(ctx, job.ID)
+	require.NoError(err)
+	require.Equal(0, count)
+
+	// create errors out of row order
+
+	rowNumbers := []int{3, 1, 2}
+	for _, n := range rowNumbers {
+		err = r.ImportErrorCreate(ctx, &models.ImportError{
+			JobID:     job.ID,
+			RowNumber: n,
+			Message:   "title: cannot be blank.",
+		})
+		require.NoError(err)
+	}
+
+	count, err = r.ImportErrorsCount(ctx, job.ID)
+	require.NoError(err)
+	require.Equal(len(rowNumbers), count)
+
+	// errors are sorted by row number
+
+	importErrors, err := r.ImportErrorsGetAll(
+		ctx,
+		job.ID,
+		query.SortOrderOptions{
+			Offset:    0,
+			Limit:     math.MaxInt,
+			SortOrder: "asc",
+		},
+	)
+	require.NoError(err)
+	require.Equal(len(rowNumbers), len(importErrors))
+	for i, ie := range importErrors {
+		require.Equal(i+1, ie.RowNumber)
+	}
+
+	importErrors, err = r.ImportErrorsGetAll(
+		ctx,
+		job.ID,
+		query.SortOrderOptions{
+			Offset:    1,
+			Limit:     1,
+			SortOrder: "desc",
+		},
+	)
+	require.NoError(err)
+	require.Equal(1, len(importErrors))
+	require.Equal(2, importErrors[0].RowNumber)
+}
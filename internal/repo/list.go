@@ -0,0 +1,544 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/list"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (repo *Repository) ListGet(
+	ctx context.Context,
+	id int,
+) (*models.List, error) {
+	l, err := models.FindList(ctx, repo.exec, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return l, nil
+}
+
+// ListsGetAllByUser returns the lists the user is a member of by their update
+// time: publicOnly returns the public lists the user owns only
+func (repo *Repository) ListsGetAllByUser(
+	ctx context.Context,
+	userID int,
+	publicOnly bool,
+	queryOptions query.SortOrderOptions,
+) ([]*models.List, error) {
+	lists, err := models.Lists(
+		append(
+			whereListsOfUser(userID, publicOnly),
+			qm.Offset(queryOptions.Offset),
+			qm.Limit(queryOptions.Limit),
+			qm.OrderBy(
+				models.ListColumns.UpdatedAt+" "+queryOptions.SortOrder,
+			),
+			qm.OrderBy(models.ListColumns.ID+" "+queryOptions.SortOrder),
+		)...,
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return lists, nil
+}
+
+func (repo *Repository) ListsCountByUser(
+	ctx context.Context,
+	userID int,
+	publicOnly bool,
+) (int, error) {
+	count, err := models.Lists(
+		whereListsOfUser(userID, publicOnly)...,
+	).Count(ctx, repo.exec)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func whereListsOfUser(userID int, publicOnly bool) []qm.QueryMod {
+	if publicOnly {
+		return []qm.QueryMod{
+			models.ListWhere.UserID.EQ(userID),
+			models.ListWhere.Visibility.EQ(list.VisibilityPublic),
+		}
+	}
+	return []qm.QueryMod{
+		whereListMember(models.ListTableColumns.ID, userID),
+	}
+}
+
+// whereListMember filters the records by the list of listIDColumn the user is
+// a member of in one of the roles: no roles accepts all the roles
+func whereListMember(
+	listIDColumn string,
+	userID int,
+	roles ...string,
+) qm.QueryMod {
+	clause, args := listMemberClause(listIDColumn, userID, roles...)
+	return qm.Where(clause, args...)
+}
+
+func listMemberClause(
+	listIDColumn string,
+	userID int,
+	roles ...string,
+) (clause string, args []any) {
+	clause = fmt.Sprintf(
+		"EXISTS (SELECT 1 FROM %[1]s AS m WHERE m.%[2]s = %[3]s AND m.%[4]s = ?",
+		models.TableNames.ListMembers,
+		models.ListMemberColumns.ListID,
+		listIDColumn,
+		models.ListMemberColumns.UserID,
+	)
+	args = []any{userID}
+	if len(roles) > 0 {
+		clause += fmt.Sprintf(
+			" AND m.%s IN (?%s)",
+			models.ListMemberColumns.Role,
+			strings.Repeat(", ?", len(roles)-1),
+		)
+		for _, role := range roles {
+			args = append(args, role)
+		}
+	}
+	return clause + ")", args
+}
+
+// ListCreate creates the list owned by the user: the user is the owner member
+// of the list
+func (repo *Repository) ListCreate(
+	ctx context.Context,
+	userID int,
+	l *models.List,
+) error {
+	l.UserID = userID
+	err := l.Insert(ctx, repo.exec, boil.Infer())
+	if err != nil {
+		return err
+	}
+	return repo.ListMemberAdd(ctx, &models.ListMember{
+		ListID: l.ID,
+		UserID: userID,
+		Role:   list.RoleOwner,
+	})
+}
+
+// As userID is not provided by the user, they cannot maliciously/inadvertently
+// update a list they are not the owner of
+func (repo *Repository) ListUpdate(
+	ctx context.Context,
+	userID int,
+	listID int,
+	cols map[string]any,
+) error {
+	cols[models.ListColumns.UpdatedAt] = time.Now()
+	rowsAff, err := models.Lists(
+		models.ListWhere.ID.EQ(listID),
+		whereListMember(models.ListTableColumns.ID, userID, list.RoleOwner),
+	).UpdateAll(ctx, repo.exec, cols)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+// As userID is not provided by the user, they cannot maliciously/inadvertently
+// delete a list they are not the owner of
+func (repo *Repository) ListDelete(
+	ctx context.Context,
+	userID int,
+	listID int,
+) error {
+	rowsAff, err := models.Lists(
+		models.ListWhere.ID.EQ(listID),
+		whereListMember(models.ListTableColumns.ID, userID, list.RoleOwner),
+	).DeleteAll(ctx, repo.exec)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+// ListItemsGetAll returns the items of the list by position along with the
+// film or series they refer to
+func (repo *Repository) ListItemsGetAll(
+	ctx context.Context,
+	listID int,
+) ([]*list.Item, error) {
+	items, err := models.ListItems(
+		models.ListItemWhere.ListID.EQ(listID),
+		qm.Load(models.ListItemRels.Film),
+		qm.Load(models.ListItemRels.Series),
+		qm.OrderBy(models.ListItemColumns.Position+" asc"),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	listItems := make([]*list.Item, len(items))
+	for i, item := range items {
+		listItems[i] = &list.Item{ListItem: *item}
+		if item.R != nil {
+			listItems[i].Film = item.R.Film
+			listItems[i].Series = item.R.Series
+		}
+	}
+	return listItems, nil
+}
+
+// ListItemAdd appends the item to the list added by the user
+func (repo *Repository) ListItemAdd(
+	ctx context.Context,
+	userID int,
+	listID int,
+	item *models.ListItem,
+) error {
+	count, err := models.ListItems(
+		models.ListItemWhere.ListID.EQ(listID),
+	).Count(ctx, repo.exec)
+	if err != nil {
+		return err
+	}
+	item.ListID = listID
+	item.AddedBy = null.IntFrom(userID)
+	item.Position = int(count) + 1
+	return item.Insert(ctx, repo.exec, boil.Infer())
+}
+
+// ListItemSetPosition moves the item of the list to the position: the list
+// positions must be unique again by the end of the transaction.
+// As userID is not provided by the user, they cannot maliciously/inadvertently
+// move the items of a list they are not an owner or editor of
+func (repo *Repository) ListItemSetPosition(
+	ctx context.Context,
+	userID int,
+	listID int,
+	itemID int,
+	position int,
+) error {
+	rowsAff, err := models.ListItems(
+		models.ListItemWhere.ID.EQ(itemID),
+		models.ListItemWhere.ListID.EQ(listID),
+		whereListMember(
+			models.ListItemTableColumns.ListID,
+			userID,
+			list.RoleOwner,
+			list.RoleEditor,
+		),
+	).UpdateAll(ctx, repo.exec, map[string]any{
+		models.ListItemColumns.Position: position,
+	})
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+// ListItemDelete removes the item from the list and moves the items past it
+// up a position.
+// As userID is not provided by the user, they cannot maliciously/inadvertently
+// remove the items of a list they are not an owner or editor of
+func (repo *Repository) ListItemDelete(
+	ctx context.Context,
+	userID int,
+	listID int,
+	itemID int,
+) error {
+	item, err := models.ListItems(
+		models.ListItemWhere.ID.EQ(itemID),
+		models.ListItemWhere.ListID.EQ(listID),
+		whereListMember(
+			models.ListItemTableColumns.ListID,
+			userID,
+			list.RoleOwner,
+			list.RoleEditor,
+		),
+	).One(ctx, repo.exec)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrNoRecord
+		}
+		return err
+	}
+	_, err = item.Delete(ctx, repo.exec)
+	if err != nil {
+		return err
+	}
+	_, err = repo.exec.ExecContext(
+		ctx,
+		listItemsCloseGapQuery,
+		listID,
+		item.Position,
+	)
+	return err
+}
+
+func (repo *Repository) ListMemberGet(
+	ctx context.Context,
+	listID int,
+	userID int,
+) (*models.ListMember, error) {
+	member, err := models.FindListMember(ctx, repo.exec, listID, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return member, nil
+}
+
+// ListMembersGetAll returns the members of the list by their join time along
+// with their users
+func (repo *Repository) ListMembersGetAll(
+	ctx context.Context,
+	listID int,
+) ([]*list.Member, error) {
+	members, err := models.ListMembers(
+		models.ListMemberWhere.ListID.EQ(listID),
+		qm.Load(models.ListMemberRels.User),
+		qm.OrderBy(models.ListMemberColumns.TimeJoined+" asc"),
+		qm.OrderBy(models.ListMemberColumns.UserID+" asc"),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	listMembers := make([]*list.Member, len(members))
+	for i, member := range members {
+		listMembers[i] = &list.Member{ListMember: *member}
+		if member.R != nil {
+			listMembers[i].User = member.R.User
+		}
+	}
+	return listMembers, nil
+}
+
+func (repo *Repository) ListMemberAdd(
+	ctx context.Context,
+	member *models.ListMember,
+) error {
+	return member.Insert(ctx, repo.exec, boil.Infer())
+}
+
+// ListMemberSetRole sets the role of the member of the list: the owner role
+// is not changed.
+// As userID is not provided by the user, they cannot maliciously/inadvertently
+// set the roles of a list they are not the owner of
+func (repo *Repository) ListMemberSetRole(
+	ctx context.Context,
+	userID int,
+	listID int,
+	memberID int,
+	role string,
+) error {
+	rowsAff, err := models.ListMembers(
+		models.ListMemberWhere.ListID.EQ(listID),
+		models.ListMemberWhere.UserID.EQ(memberID),
+		models.ListMemberWhere.Role.NEQ(list.RoleOwner),
+		whereListMember(
+			models.ListMemberTableColumns.ListID,
+			userID,
+			list.RoleOwner,
+		),
+	).UpdateAll(ctx, repo.exec, map[string]any{
+		models.ListMemberColumns.Role: role,
+	})
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+// ListMemberDelete removes the member from the list: the owner is not
+// removed.
+// As userID is not provided by the user, they cannot maliciously/inadvertently
+// remove the members of a list they are not the owner of: members can remove
+// themselves only
+func (repo *Repository) ListMemberDelete(
+	ctx context.Context,
+	userID int,
+	listID int,
+	memberID int,
+) error {
+	mods := []qm.QueryMod{
+		models.ListMemberWhere.ListID.EQ(listID),
+		models.ListMemberWhere.UserID.EQ(memberID),
+		models.ListMemberWhere.Role.NEQ(list.RoleOwner),
+	}
+	if memberID != userID {
+		mods = append(
+			mods,
+			whereListMember(
+				models.ListMemberTableColumns.ListID,
+				userID,
+				list.RoleOwner,
+			),
+		)
+	}
+	rowsAff, err := models.ListMembers(mods...).DeleteAll(ctx, repo.exec)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (repo *Repository) ListInviteGet(
+	ctx context.Context,
+	id int,
+) (*models.ListInvite, error) {
+	invite, err := models.FindListInvite(ctx, repo.exec, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return invite, nil
+}
+
+// ListInvitesGetAllByList returns the pending invites of the list by their
+// creation time
+func (repo *Repository) ListInvitesGetAllByList(
+	ctx context.Context,
+	listID int,
+) ([]*models.ListInvite, error) {
+	invites, err := models.ListInvites(
+		models.ListInviteWhere.ListID.EQ(listID),
+		models.ListInviteWhere.ExpiresAt.GT(time.Now()),
+		qm.OrderBy(models.ListInviteColumns.CreatedAt+" asc"),
+		qm.OrderBy(models.ListInviteColumns.ID+" asc"),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return invites, nil
+}
+
+// ListInvitesGetAllByUser returns the pending invites of the user by their
+// creation time
+func (repo *Repository) ListInvitesGetAllByUser(
+	ctx context.Context,
+	userID int,
+) ([]*models.ListInvite, error) {
+	invites, err := models.ListInvites(
+		models.ListInviteWhere.UserID.EQ(null.IntFrom(userID)),
+		models.ListInviteWhere.ExpiresAt.GT(time.Now()),
+		qm.OrderBy(models.ListInviteColumns.CreatedAt+" asc"),
+		qm.OrderBy(models.ListInviteColumns.ID+" asc"),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return invites, nil
+}
+
+// ListInviteCreate creates the invite invited by the user
+func (repo *Repository) ListInviteCreate(
+	ctx context.Context,
+	userID int,
+	invite *models.ListInvite,
+) error {
+	invite.InvitedBy = null.IntFrom(userID)
+	return invite.Insert(ctx, repo.exec, boil.Infer())
+}
+
+// As userID is not provided by the user, they cannot maliciously/inadvertently
+// delete an invite they are neither invited by nor the list owner of
+func (repo *Repository) ListInviteDelete(
+	ctx context.Context,
+	userID int,
+	inviteID int,
+) error {
+	clause, args := listMemberClause(
+		models.ListInviteTableColumns.ListID,
+		userID,
+		list.RoleOwner,
+	)
+	rowsAff, err := models.ListInvites(
+		models.ListInviteWhere.ID.EQ(inviteID),
+		qm.Where(
+			"("+models.ListInviteTableColumns.UserID+" = ? OR "+clause+")",
+			append([]any{userID}, args...)...,
+		),
+	).DeleteAll(ctx, repo.exec)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// ListActivityCreate logs the activity on the list
+func (repo *Repository) ListActivityCreate(
+	ctx context.Context,
+	activity *models.ListActivity,
+) error {
+	return activity.Insert(ctx, repo.exec, boil.Infer())
+}
+
+// ListActivitiesGetAll returns the activity log of the list by its log time
+func (repo *Repository) ListActivitiesGetAll(
+	ctx context.Context,
+	listID int,
+	queryOptions query.SortOrderOptions,
+) ([]*models.ListActivity, error) {
+	activities, err := models.ListActivities(
+		models.ListActivityWhere.ListID.EQ(listID),
+		qm.Offset(queryOptions.Offset),
+		qm.Limit(queryOptions.Limit),
+		qm.OrderBy(
+			models.ListActivityColumns.TimeLogged+" "+queryOptions.SortOrder,
+		),
+		qm.OrderBy(models.ListActivityColumns.ID+" "+queryOptions.SortOrder),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return activities, nil
+}
+
+func (repo *Repository) ListActivitiesCount(
+	ctx context.Context,
+	listID int,
+) (int, error) {
+	count, err := models.ListActivities(
+		models.ListActivityWhere.ListID.EQ(listID),
+	).Count(ctx, repo.exec)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
@@ -0,0 +1,353 @@
+package repo_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/list"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestList(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	users := []*models.User{{Email: "u1"}, {Email: "u2"}}
+	for _, user := range users {
+		err := r.UserCreate(ctx, user)
+		require.NoError(err)
+	}
+
+	// create lists
+	lists := []*models.List{
+		{Title: "Halloween marathon", Visibility: list.VisibilityPrivate},
+		{Title: "Best of 2023", Visibility: list.VisibilityPublic},
+	}
+	for _, l := range lists {
+		err := r.ListCreate(ctx, users[0].ID, l)
+		require.NoError(err)
+	}
+
+	got, err := r.ListGet(ctx, lists[0].ID)
+	require.NoError(err)
+	require.Equal(users[0].ID, got.UserID)
+	require.Equal("Halloween marathon", got.Title)
+
+	_, err = r.ListGet(ctx, lists[1].ID+1)
+	require.Equal(repo.ErrNoRecord, err)
+
+	// list the lists of the user
+	for _, tc := range []struct {
+		publicOnly bool
+		expCount   int
+	}{
+		{publicOnly: false, expCount: 2},
+		{publicOnly: true, expCount: 1},
+	} {
+		fetched, err := r.ListsGetAllByUser(
+			ctx,
+			users[0].ID,
+			tc.publicOnly,
+			query.SortOrderOptions{
+				Offset:    0,
+				Limit:     math.MaxInt,
+				SortOrder: "desc",
+			},
+		)
+		require.NoError(err)
+		require.Equal(tc.expCount, len(fetched))
+
+		count, err := r.ListsCountByUser(ctx, users[0].ID, tc.publicOnly)
+		require.NoError(err)
+		require.Equal(tc.expCount, count)
+	}
+
+	// update the list: another user cannot update it
+	err = r.ListUpdate(
+		ctx,
+		users[1].ID,
+		lists[0].ID,
+		map[string]any{models.ListColumns.Title: "title"},
+	)
+	require.Equal(repo.ErrNoRecord, err)
+
+	err = r.ListUpdate(
+		ctx,
+		users[0].ID,
+		lists[0].ID,
+		map[string]any{
+			models.ListColumns.Visibility: list.VisibilityPublic,
+		},
+	)
+	require.NoError(err)
+
+	got, err = r.ListGet(ctx, lists[0].ID)
+	require.NoError(err)
+	require.Equal(list.VisibilityPublic, got.Visibility)
+	require.True(got.UpdatedAt.After(lists[0].UpdatedAt))
+
+	// add items mixing movies, episodes and serieses
+	movie := &models.Film{
+		Title:        "movie",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+	err = r.MovieCreate(ctx, users[0].ID, movie)
+	require.NoError(err)
+
+	series := &models.Series{Title: "series"}
+	err = r.SeriesCreate(ctx, users[0].ID, series)
+	require.NoError(err)
+
+	episode := &models.Film{
+		Title:        "episode",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+	err = r.EpisodePut(ctx, series.ID, 1, 1, users[0].ID, episode)
+	require.NoError(err)
+
+	items := []*models.ListItem{
+		{FilmID: null.IntFrom(movie.ID)},
+		{SeriesID: null.IntFrom(series.ID)},
+		{FilmID: null.IntFrom(episode.ID)},
+	}
+	for i, item := range items {
+		err = r.ListItemAdd(ctx, users[0].ID, lists[0].ID, item)
+		require.NoError(err)
+		require.Equal(i+1, item.Position)
+		require.Equal(null.IntFrom(users[0].ID), item.AddedBy)
+	}
+
+	fetchedItems, err := r.ListItemsGetAll(ctx, lists[0].ID)
+	require.NoError(err)
+	require.Equal(3, len(fetchedItems))
+	require.Equal(movie.ID, fetchedItems[0].Film.ID)
+	require.Equal(series.ID, fetchedItems[1].Series.ID)
+	require.Nil(fetchedItems[1].Film)
+	require.Equal(episode.ID, fetchedItems[2].Film.ID)
+
+	// swap the first and last items in a transaction
+	err = r.Tx(ctx, nil, func(ctx context.Context, tx repo.Service) error {
+		err := tx.ListItemSetPosition(
+			ctx,
+			users[0].ID,
+			lists[0].ID,
+			items[0].ID,
+			3,
+		)
+		if err != nil {
+			return err
+		}
+		return tx.ListItemSetPosition(
+			ctx,
+			users[0].ID,
+			lists[0].ID,
+			items[2].ID,
+			1,
+		)
+	})
+	require.NoError(err)
+
+	err = r.ListItemSetPosition(ctx, users[0].ID, lists[1].ID, items[0].ID, 1)
+	require.Equal(repo.ErrNoRecord, err)
+
+	// another user cannot move the items
+	err = r.ListItemSetPosition(ctx, users[1].ID, lists[0].ID, items[0].ID, 3)
+	require.Equal(repo.ErrNoRecord, err)
+
+	// remove the middle item: the last item moves up
+	err = r.Tx(ctx, nil, func(ctx context.Context, tx repo.Service) error {
+		return tx.ListItemDelete(ctx, users[0].ID, lists[0].ID, items[1].ID)
+	})
+	require.NoError(err)
+
+	err = r.ListItemDelete(ctx, users[0].ID, lists[0].ID, items[1].ID)
+	require.Equal(repo.ErrNoRecord, err)
+
+	fetchedItems, err = r.ListItemsGetAll(ctx, lists[0].ID)
+	require.NoError(err)
+	require.Equal(2, len(fetchedItems))
+	require.Equal(items[2].ID, fetchedItems[0].ID)
+	require.Equal(1, fetchedItems[0].Position)
+	require.Equal(items[0].ID, fetchedItems[1].ID)
+	require.Equal(2, fetchedItems[1].Position)
+
+	// delete the list: another user cannot delete it
+	err = r.ListDelete(ctx, users[1].ID, lists[0].ID)
+	require.Equal(repo.ErrNoRecord, err)
+
+	err = r.ListDelete(ctx, users[0].ID, lists[0].ID)
+	require.NoError(err)
+	_, err = r.ListGet(ctx, lists[0].ID)
+	require.Equal(repo.ErrNoRecord, err)
+
+	fetchedItems, err = r.ListItemsGetAll(ctx, lists[0].ID)
+	require.NoError(err)
+	require.Equal(0, len(fetchedItems))
+}
+
+func TestListMember(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	users := []*models.User{{Email: "u1"}, {Email: "u2"}, {Email: "u3"}}
+	for _, user := range users {
+		err := r.UserCreate(ctx, user)
+		require.NoError(err)
+	}
+	owner, editor, stranger := users[0], users[1], users[2]
+
+	// the owner is the first member of their list
+	l := &models.List{Title: "household", Visibility: list.VisibilityPrivate}
+	err := r.ListCreate(ctx, owner.ID, l)
+	require.NoError(err)
+
+	member, err := r.ListMemberGet(ctx, l.ID, owner.ID)
+	require.NoError(err)
+	require.Equal(list.RoleOwner, member.Role)
+
+	_, err = r.ListMemberGet(ctx, l.ID, editor.ID)
+	require.Equal(repo.ErrNoRecord, err)
+
+	// invite a user and a link
+	emailInvite := &models.ListInvite{
+		ListID:    l.ID,
+		Role:      list.RoleViewer,
+		UserID:    null.IntFrom(editor.ID),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	err = r.ListInviteCreate(ctx, owner.ID, emailInvite)
+	require.NoError(err)
+	require.Equal(null.IntFrom(owner.ID), emailInvite.InvitedBy)
+
+	linkInvite := &models.ListInvite{
+		ListID:    l.ID,
+		Role:      list.RoleViewer,
+		TokenHash: null.StringFrom("hash"),
+		ExpiresAt: time.Now().Add(-time.Hour),
+	}
+	err = r.ListInviteCreate(ctx, owner.ID, linkInvite)
+	require.NoError(err)
+
+	// the expired link invite is not pending
+	invites, err := r.ListInvitesGetAllByList(ctx, l.ID)
+	require.NoError(err)
+	require.Equal(1, len(invites))
+	require.Equal(emailInvite.ID, invites[0].ID)
+
+	invites, err = r.ListInvitesGetAllByUser(ctx, editor.ID)
+	require.NoError(err)
+	require.Equal(1, len(invites))
+
+	// a stranger cannot delete the invites: the invitee declines and the owner
+	// revokes them
+	err = r.ListInviteDelete(ctx, stranger.ID, emailInvite.ID)
+	require.Equal(repo.ErrNoRecord, err)
+	err = r.ListInviteDelete(ctx, editor.ID, emailInvite.ID)
+	require.NoError(err)
+	err = r.ListInviteDelete(ctx, owner.ID, linkInvite.ID)
+	require.NoError(err)
+
+	// join the list
+	err = r.ListMemberAdd(ctx, &models.ListMember{
+		ListID: l.ID,
+		UserID: editor.ID,
+		Role:   list.RoleViewer,
+	})
+	require.NoError(err)
+
+	members, err := r.ListMembersGetAll(ctx, l.ID)
+	require.NoError(err)
+	require.Equal(2, len(members))
+	require.Equal(owner.ID, members[0].User.ID)
+	require.Equal(editor.ID, members[1].User.ID)
+
+	// the private list is listed to its members
+	lists, err := r.ListsGetAllByUser(
+		ctx,
+		editor.ID,
+		false,
+		query.SortOrderOptions{Limit: math.MaxInt, SortOrder: "asc"},
+	)
+	require.NoError(err)
+	require.Equal(1, len(lists))
+
+	// a viewer cannot edit the list
+	series := &models.Series{Title: "series"}
+	err = r.SeriesCreate(ctx, owner.ID, series)
+	require.NoError(err)
+	item := &models.ListItem{SeriesID: null.IntFrom(series.ID)}
+	err = r.ListItemAdd(ctx, owner.ID, l.ID, item)
+	require.NoError(err)
+	err = r.ListItemDelete(ctx, editor.ID, l.ID, item.ID)
+	require.Equal(repo.ErrNoRecord, err)
+
+	// only the owner sets the roles and never of the owner
+	err = r.ListMemberSetRole(ctx, editor.ID, l.ID, editor.ID, list.RoleEditor)
+	require.Equal(repo.ErrNoRecord, err)
+	err = r.ListMemberSetRole(ctx, owner.ID, l.ID, owner.ID, list.RoleEditor)
+	require.Equal(repo.ErrNoRecord, err)
+	err = r.ListMemberSetRole(ctx, owner.ID, l.ID, editor.ID, list.RoleEditor)
+	require.NoError(err)
+
+	// an editor edits the list but cannot update or delete it
+	err = r.ListUpdate(
+		ctx,
+		editor.ID,
+		l.ID,
+		map[string]any{models.ListColumns.Title: "title"},
+	)
+	require.Equal(repo.ErrNoRecord, err)
+	err = r.ListDelete(ctx, editor.ID, l.ID)
+	require.Equal(repo.ErrNoRecord, err)
+	err = r.ListItemDelete(ctx, editor.ID, l.ID, item.ID)
+	require.NoError(err)
+
+	// log the activities
+	for _, action := range []string{list.ActivityCreate, list.ActivityUpdate} {
+		err = r.ListActivityCreate(ctx, &models.ListActivity{
+			ListID: l.ID,
+			UserID: null.IntFrom(owner.ID),
+			Action: action,
+		})
+		require.NoError(err)
+	}
+	activities, err := r.ListActivitiesGetAll(
+		ctx,
+		l.ID,
+		query.SortOrderOptions{Limit: math.MaxInt, SortOrder: "desc"},
+	)
+	require.NoError(err)
+	require.Equal(2, len(activities))
+	require.Equal(list.ActivityUpdate, activities[0].Action)
+	count, err := r.ListActivitiesCount(ctx, l.ID)
+	require.NoError(err)
+	require.Equal(2, count)
+
+	// members cannot remove the others but leave the list: the owner never
+	// leaves
+	err = r.ListMemberDelete(ctx, stranger.ID, l.ID, editor.ID)
+	require.Equal(repo.ErrNoRecord, err)
+	err = r.ListMemberDelete(ctx, owner.ID, l.ID, owner.ID)
+	require.Equal(repo.ErrNoRecord, err)
+	err = r.ListMemberDelete(ctx, editor.ID, l.ID, editor.ID)
+	require.NoError(err)
+	_, err = r.ListMemberGet(ctx, l.ID, editor.ID)
+	require.Equal(repo.ErrNoRecord, err)
+}
@@ -0,0 +1,138 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// MediaItemsGetAllByFilm returns the media of the film not removed by position
+func (repo *Repository) MediaItemsGetAllByFilm(
+	ctx context.Context,
+	filmID int,
+) ([]*models.MediaItem, error) {
+	media, err := models.MediaItems(
+		models.MediaItemWhere.FilmID.EQ(null.IntFrom(filmID)),
+		models.MediaItemWhere.RemovedAt.IsNull(),
+		qm.OrderBy(models.MediaItemColumns.Position+" asc"),
+		qm.OrderBy(models.MediaItemColumns.ID+" asc"),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return media, nil
+}
+
+// MediaItemsGetAllBySeries returns the media of the series not removed by
+// position
+func (repo *Repository) MediaItemsGetAllBySeries(
+	ctx context.Context,
+	seriesID int,
+) ([]*models.MediaItem, error) {
+	media, err := models.MediaItems(
+		models.MediaItemWhere.SeriesID.EQ(null.IntFrom(seriesID)),
+		models.MediaItemWhere.RemovedAt.IsNull(),
+		qm.OrderBy(models.MediaItemColumns.Position+" asc"),
+		qm.OrderBy(models.MediaItemColumns.ID+" asc"),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return media, nil
+}
+
+func (repo *Repository) MediaItemCreate(
+	ctx context.Context,
+	contributorID int,
+	media *models.MediaItem,
+) error {
+	media.ContributedBy = contributorID
+	return media.Insert(ctx, repo.exec, boil.Infer())
+}
+
+func (repo *Repository) MediaItemUpdate(
+	ctx context.Context,
+	mediaID int,
+	contributorID int,
+	cols map[string]any,
+) error {
+	cols[models.MediaItemColumns.ContributedBy] = contributorID
+	rowsAff, err := models.MediaItems(
+		models.MediaItemWhere.ID.EQ(mediaID),
+	).UpdateAll(ctx, repo.exec, cols)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+func (repo *Repository) MediaItemAuditsGetAllByFilm(
+	ctx context.Context,
+	filmID int,
+	queryOptions query.SortOrderOptions,
+) ([]*models.MediaItemsAudit, error) {
+	audits, err := models.MediaItemsAudits(
+		models.MediaItemsAuditWhere.FilmID.EQ(null.IntFrom(filmID)),
+		qm.Offset(queryOptions.Offset),
+		qm.Limit(queryOptions.Limit),
+		qm.OrderBy(
+			models.MediaItemsAuditColumns.ContributedAt+" "+queryOptions.SortOrder,
+		),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return audits, nil
+}
+
+func (repo *Repository) MediaItemAuditsCountByFilm(
+	ctx context.Context,
+	filmID int,
+) (int, error) {
+	auditsCount, err := models.MediaItemsAudits(
+		models.MediaItemsAuditWhere.FilmID.EQ(null.IntFrom(filmID)),
+	).Count(ctx, repo.exec)
+	if err != nil {
+		return 0, err
+	}
+	return int(auditsCount), nil
+}
+
+func (repo *Repository) MediaItemAuditsGetAllBySeries(
+	ctx context.Context,
+	seriesID int,
+	queryOptions query.SortOrderOptions,
+) ([]*models.MediaItemsAudit, error) {
+	audits, err := models.MediaItemsAudits(
+		models.MediaItemsAuditWhere.SeriesID.EQ(null.IntFrom(seriesID)),
+		qm.Offset(queryOptions.Offset),
+		qm.Limit(queryOptions.Limit),
+		qm.OrderBy(
+			models.MediaItemsAuditColumns.ContributedAt+" "+queryOptions.SortOrder,
+		),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return audits, nil
+}
+
+func (repo *Repository) MediaItemAuditsCountBySeries(
+	ctx context.Context,
+	seriesID int,
+) (int, error) {
+	auditsCount, err := models.MediaItemsAudits(
+		models.MediaItemsAuditWhere.SeriesID.EQ(null.IntFrom(seriesID)),
+	).Count(ctx, repo.exec)
+	if err != nil {
+		return 0, err
+	}
+	return int(auditsCount), nil
+}
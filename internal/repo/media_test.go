@@ -0,0 +1,112 @@
+package repo_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestMediaItemsGetAllByFilm(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	movie := &models.Film{
+		Title:        "movie",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+	err = r.MovieCreate(ctx, user.ID, movie)
+	require.NoError(err)
+
+	// first there's no media
+	media, err := r.MediaItemsGetAllByFilm(ctx, movie.ID)
+	require.NoError(err)
+	require.Empty(media)
+
+	// add a primary poster and a backdrop
+	poster := &models.MediaItem{
+		FilmID:    null.IntFrom(movie.ID),
+		Kind:      "poster",
+		URI:       "movie/1/media-poster",
+		Position:  2,
+		IsPrimary: true,
+	}
+	err = r.MediaItemCreate(ctx, user.ID, poster)
+	require.NoError(err)
+	backdrop := &models.MediaItem{
+		FilmID:   null.IntFrom(movie.ID),
+		Kind:     "backdrop",
+		URI:      "movie/1/media-backdrop",
+		Position: 1,
+	}
+	err = r.MediaItemCreate(ctx, user.ID, backdrop)
+	require.NoError(err)
+
+	// media are listed by position
+	media, err = r.MediaItemsGetAllByFilm(ctx, movie.ID)
+	require.NoError(err)
+	require.Equal(2, len(media))
+	require.Equal(backdrop.ID, media[0].ID)
+	require.Equal(poster.ID, media[1].ID)
+
+	// a film has a single primary media of a kind
+	err = r.MediaItemCreate(ctx, user.ID, &models.MediaItem{
+		FilmID:    null.IntFrom(movie.ID),
+		Kind:      "poster",
+		URI:       "movie/1/media-another-poster",
+		Position:  3,
+		IsPrimary: true,
+	})
+	require.Error(err)
+
+	// a removed media is not listed but is kept in audits
+	err = r.MediaItemUpdate(ctx, poster.ID, user.ID, map[string]any{
+		models.MediaItemColumns.RemovedAt: null.TimeFrom(time.Now()),
+		models.MediaItemColumns.IsPrimary: false,
+	})
+	require.NoError(err)
+
+	media, err = r.MediaItemsGetAllByFilm(ctx, movie.ID)
+	require.NoError(err)
+	require.Equal(1, len(media))
+	require.Equal(backdrop.ID, media[0].ID)
+
+	audits, err := r.MediaItemAuditsGetAllByFilm(
+		ctx,
+		movie.ID,
+		query.SortOrderOptions{
+			SortOrder: "asc",
+			Offset:    0,
+			Limit:     math.MaxInt,
+		},
+	)
+	require.NoError(err)
+	require.Equal(1, len(audits))
+	require.Equal(poster.ID, audits[0].ID)
+	require.Equal(poster.URI, audits[0].URI)
+	require.True(audits[0].IsPrimary)
+	count, err := r.MediaItemAuditsCountByFilm(ctx, movie.ID)
+	require.NoError(err)
+	require.Equal(1, count)
+
+	// update a media not exists
+	err = r.MediaItemUpdate(ctx, 999, user.ID, map[string]any{
+		models.MediaItemColumns.Position: 1,
+	})
+	require.Equal(repo.ErrNoRecord, err)
+}
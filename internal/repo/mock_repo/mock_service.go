@@ -9,11 +9,17 @@ import (
 	sql "database/sql"
 	reflect "reflect"
 
+	collection "github.com/aria3ppp/watchlist-server/internal/collection"
+	contribution "github.com/aria3ppp/watchlist-server/internal/contribution"
+	list "github.com/aria3ppp/watchlist-server/internal/list"
 	models "github.com/aria3ppp/watchlist-server/internal/models"
 	query "github.com/aria3ppp/watchlist-server/internal/query"
 	repo "github.com/aria3ppp/watchlist-server/internal/repo"
+	review "github.com/aria3ppp/watchlist-server/internal/review"
+	stats "github.com/aria3ppp/watchlist-server/internal/stats"
 	watchlist "github.com/aria3ppp/watchlist-server/internal/watchlist"
 	gomock "github.com/golang/mock/gomock"
+	null "github.com/volatiletech/null/v8"
 )
 
 // MockServiceTx is a mock of ServiceTx interface.
@@ -39,414 +45,2389 @@ func (m *MockServiceTx) EXPECT() *MockServiceTxMockRecorder {
 	return m.recorder
 }
 
-// EpisodeAuditsCount mocks base method.
-func (m *MockServiceTx) EpisodeAuditsCount(arg0 context.Context, arg1, arg2, arg3 int) (int, error) {
+// AuditPruneCreate mocks base method.
+func (m *MockServiceTx) AuditPruneCreate(arg0 context.Context, arg1 *models.AuditPrune) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EpisodeAuditsCount", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "AuditPruneCreate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AuditPruneCreate indicates an expected call of AuditPruneCreate.
+func (mr *MockServiceTxMockRecorder) AuditPruneCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditPruneCreate", reflect.TypeOf((*MockServiceTx)(nil).AuditPruneCreate), arg0, arg1)
+}
+
+// AuditPruneGetLatest mocks base method.
+func (m *MockServiceTx) AuditPruneGetLatest(arg0 context.Context) (*models.AuditPrune, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditPruneGetLatest", arg0)
+	ret0, _ := ret[0].(*models.AuditPrune)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// EpisodeAuditsCount indicates an expected call of EpisodeAuditsCount.
-func (mr *MockServiceTxMockRecorder) EpisodeAuditsCount(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+// AuditPruneGetLatest indicates an expected call of AuditPruneGetLatest.
+func (mr *MockServiceTxMockRecorder) AuditPruneGetLatest(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodeAuditsCount", reflect.TypeOf((*MockServiceTx)(nil).EpisodeAuditsCount), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditPruneGetLatest", reflect.TypeOf((*MockServiceTx)(nil).AuditPruneGetLatest), arg0)
 }
 
-// EpisodeAuditsGetAll mocks base method.
-func (m *MockServiceTx) EpisodeAuditsGetAll(arg0 context.Context, arg1, arg2, arg3 int, arg4 query.SortOrderOptions) ([]*models.FilmsAudit, error) {
+// AuditPruneUpdate mocks base method.
+func (m *MockServiceTx) AuditPruneUpdate(arg0 context.Context, arg1 int, arg2 map[string]interface{}) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EpisodeAuditsGetAll", arg0, arg1, arg2, arg3, arg4)
-	ret0, _ := ret[0].([]*models.FilmsAudit)
+	ret := m.ctrl.Call(m, "AuditPruneUpdate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AuditPruneUpdate indicates an expected call of AuditPruneUpdate.
+func (mr *MockServiceTxMockRecorder) AuditPruneUpdate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditPruneUpdate", reflect.TypeOf((*MockServiceTx)(nil).AuditPruneUpdate), arg0, arg1, arg2)
+}
+
+// AuditsPrune mocks base method.
+func (m *MockServiceTx) AuditsPrune(arg0 context.Context, arg1 string, arg2 query.AuditRetentionOptions, arg3 int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditsPrune", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// EpisodeAuditsGetAll indicates an expected call of EpisodeAuditsGetAll.
-func (mr *MockServiceTxMockRecorder) EpisodeAuditsGetAll(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+// AuditsPrune indicates an expected call of AuditsPrune.
+func (mr *MockServiceTxMockRecorder) AuditsPrune(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodeAuditsGetAll", reflect.TypeOf((*MockServiceTx)(nil).EpisodeAuditsGetAll), arg0, arg1, arg2, arg3, arg4)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditsPrune", reflect.TypeOf((*MockServiceTx)(nil).AuditsPrune), arg0, arg1, arg2, arg3)
 }
 
-// EpisodeGet mocks base method.
-func (m *MockServiceTx) EpisodeGet(arg0 context.Context, arg1, arg2, arg3 int) (*models.Film, error) {
+// AuditsPruneCount mocks base method.
+func (m *MockServiceTx) AuditsPruneCount(arg0 context.Context, arg1 string, arg2 query.AuditRetentionOptions) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EpisodeGet", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].(*models.Film)
+	ret := m.ctrl.Call(m, "AuditsPruneCount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// EpisodeGet indicates an expected call of EpisodeGet.
-func (mr *MockServiceTxMockRecorder) EpisodeGet(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+// AuditsPruneCount indicates an expected call of AuditsPruneCount.
+func (mr *MockServiceTxMockRecorder) AuditsPruneCount(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodeGet", reflect.TypeOf((*MockServiceTx)(nil).EpisodeGet), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditsPruneCount", reflect.TypeOf((*MockServiceTx)(nil).AuditsPruneCount), arg0, arg1, arg2)
 }
 
-// EpisodePut mocks base method.
-func (m *MockServiceTx) EpisodePut(arg0 context.Context, arg1, arg2, arg3, arg4 int, arg5 *models.Film) error {
+// CatalogExportCreate mocks base method.
+func (m *MockServiceTx) CatalogExportCreate(arg0 context.Context, arg1 *models.CatalogExport) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EpisodePut", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret := m.ctrl.Call(m, "CatalogExportCreate", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// EpisodePut indicates an expected call of EpisodePut.
-func (mr *MockServiceTxMockRecorder) EpisodePut(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+// CatalogExportCreate indicates an expected call of CatalogExportCreate.
+func (mr *MockServiceTxMockRecorder) CatalogExportCreate(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodePut", reflect.TypeOf((*MockServiceTx)(nil).EpisodePut), arg0, arg1, arg2, arg3, arg4, arg5)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CatalogExportCreate", reflect.TypeOf((*MockServiceTx)(nil).CatalogExportCreate), arg0, arg1)
 }
 
-// EpisodeUpdate mocks base method.
-func (m *MockServiceTx) EpisodeUpdate(arg0 context.Context, arg1, arg2, arg3, arg4 int, arg5 map[string]interface{}) error {
+// CatalogExportGetLatest mocks base method.
+func (m *MockServiceTx) CatalogExportGetLatest(arg0 context.Context, arg1 string) (*models.CatalogExport, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EpisodeUpdate", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret := m.ctrl.Call(m, "CatalogExportGetLatest", arg0, arg1)
+	ret0, _ := ret[0].(*models.CatalogExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CatalogExportGetLatest indicates an expected call of CatalogExportGetLatest.
+func (mr *MockServiceTxMockRecorder) CatalogExportGetLatest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CatalogExportGetLatest", reflect.TypeOf((*MockServiceTx)(nil).CatalogExportGetLatest), arg0, arg1)
+}
+
+// CatalogExportUpdate mocks base method.
+func (m *MockServiceTx) CatalogExportUpdate(arg0 context.Context, arg1 int, arg2 map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CatalogExportUpdate", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// EpisodeUpdate indicates an expected call of EpisodeUpdate.
-func (mr *MockServiceTxMockRecorder) EpisodeUpdate(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+// CatalogExportUpdate indicates an expected call of CatalogExportUpdate.
+func (mr *MockServiceTxMockRecorder) CatalogExportUpdate(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodeUpdate", reflect.TypeOf((*MockServiceTx)(nil).EpisodeUpdate), arg0, arg1, arg2, arg3, arg4, arg5)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CatalogExportUpdate", reflect.TypeOf((*MockServiceTx)(nil).CatalogExportUpdate), arg0, arg1, arg2)
 }
 
-// EpisodesCountBySeason mocks base method.
-func (m *MockServiceTx) EpisodesCountBySeason(arg0 context.Context, arg1, arg2 int) (int, error) {
+// CollectionAuditsCount mocks base method.
+func (m *MockServiceTx) CollectionAuditsCount(arg0 context.Context, arg1 int) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EpisodesCountBySeason", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "CollectionAuditsCount", arg0, arg1)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// EpisodesCountBySeason indicates an expected call of EpisodesCountBySeason.
-func (mr *MockServiceTxMockRecorder) EpisodesCountBySeason(arg0, arg1, arg2 interface{}) *gomock.Call {
+// CollectionAuditsCount indicates an expected call of CollectionAuditsCount.
+func (mr *MockServiceTxMockRecorder) CollectionAuditsCount(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesCountBySeason", reflect.TypeOf((*MockServiceTx)(nil).EpisodesCountBySeason), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectionAuditsCount", reflect.TypeOf((*MockServiceTx)(nil).CollectionAuditsCount), arg0, arg1)
 }
 
-// EpisodesCountBySeries mocks base method.
-func (m *MockServiceTx) EpisodesCountBySeries(arg0 context.Context, arg1 int) (int, error) {
+// CollectionAuditsGetAll mocks base method.
+func (m *MockServiceTx) CollectionAuditsGetAll(arg0 context.Context, arg1 int, arg2 query.SortOrderOptions) ([]*models.CollectionsAudit, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EpisodesCountBySeries", arg0, arg1)
+	ret := m.ctrl.Call(m, "CollectionAuditsGetAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.CollectionsAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CollectionAuditsGetAll indicates an expected call of CollectionAuditsGetAll.
+func (mr *MockServiceTxMockRecorder) CollectionAuditsGetAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectionAuditsGetAll", reflect.TypeOf((*MockServiceTx)(nil).CollectionAuditsGetAll), arg0, arg1, arg2)
+}
+
+// CollectionCreate mocks base method.
+func (m *MockServiceTx) CollectionCreate(arg0 context.Context, arg1 int, arg2 *models.Collection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CollectionCreate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CollectionCreate indicates an expected call of CollectionCreate.
+func (mr *MockServiceTxMockRecorder) CollectionCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectionCreate", reflect.TypeOf((*MockServiceTx)(nil).CollectionCreate), arg0, arg1, arg2)
+}
+
+// CollectionGet mocks base method.
+func (m *MockServiceTx) CollectionGet(arg0 context.Context, arg1 int) (*models.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CollectionGet", arg0, arg1)
+	ret0, _ := ret[0].(*models.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CollectionGet indicates an expected call of CollectionGet.
+func (mr *MockServiceTxMockRecorder) CollectionGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectionGet", reflect.TypeOf((*MockServiceTx)(nil).CollectionGet), arg0, arg1)
+}
+
+// CollectionItemAuditsCount mocks base method.
+func (m *MockServiceTx) CollectionItemAuditsCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CollectionItemAuditsCount", arg0, arg1)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// EpisodesCountBySeries indicates an expected call of EpisodesCountBySeries.
-func (mr *MockServiceTxMockRecorder) EpisodesCountBySeries(arg0, arg1 interface{}) *gomock.Call {
+// CollectionItemAuditsCount indicates an expected call of CollectionItemAuditsCount.
+func (mr *MockServiceTxMockRecorder) CollectionItemAuditsCount(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesCountBySeries", reflect.TypeOf((*MockServiceTx)(nil).EpisodesCountBySeries), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectionItemAuditsCount", reflect.TypeOf((*MockServiceTx)(nil).CollectionItemAuditsCount), arg0, arg1)
 }
 
-// EpisodesGetAllBySeason mocks base method.
-func (m *MockServiceTx) EpisodesGetAllBySeason(arg0 context.Context, arg1, arg2 int, arg3 query.SortOrderOptions) ([]*models.Film, error) {
+// CollectionItemAuditsGetAll mocks base method.
+func (m *MockServiceTx) CollectionItemAuditsGetAll(arg0 context.Context, arg1 int, arg2 query.SortOrderOptions) ([]*models.CollectionItemsAudit, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EpisodesGetAllBySeason", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].([]*models.Film)
+	ret := m.ctrl.Call(m, "CollectionItemAuditsGetAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.CollectionItemsAudit)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// EpisodesGetAllBySeason indicates an expected call of EpisodesGetAllBySeason.
-func (mr *MockServiceTxMockRecorder) EpisodesGetAllBySeason(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+// CollectionItemAuditsGetAll indicates an expected call of CollectionItemAuditsGetAll.
+func (mr *MockServiceTxMockRecorder) CollectionItemAuditsGetAll(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesGetAllBySeason", reflect.TypeOf((*MockServiceTx)(nil).EpisodesGetAllBySeason), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectionItemAuditsGetAll", reflect.TypeOf((*MockServiceTx)(nil).CollectionItemAuditsGetAll), arg0, arg1, arg2)
 }
 
-// EpisodesGetAllBySeries mocks base method.
-func (m *MockServiceTx) EpisodesGetAllBySeries(arg0 context.Context, arg1 int, arg2 query.SortOrderOptions) ([]*models.Film, error) {
+// CollectionItemsGetAll mocks base method.
+func (m *MockServiceTx) CollectionItemsGetAll(arg0 context.Context, arg1 int) ([]*collection.Item, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EpisodesGetAllBySeries", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]*models.Film)
+	ret := m.ctrl.Call(m, "CollectionItemsGetAll", arg0, arg1)
+	ret0, _ := ret[0].([]*collection.Item)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// EpisodesGetAllBySeries indicates an expected call of EpisodesGetAllBySeries.
-func (mr *MockServiceTxMockRecorder) EpisodesGetAllBySeries(arg0, arg1, arg2 interface{}) *gomock.Call {
+// CollectionItemsGetAll indicates an expected call of CollectionItemsGetAll.
+func (mr *MockServiceTxMockRecorder) CollectionItemsGetAll(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesGetAllBySeries", reflect.TypeOf((*MockServiceTx)(nil).EpisodesGetAllBySeries), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectionItemsGetAll", reflect.TypeOf((*MockServiceTx)(nil).CollectionItemsGetAll), arg0, arg1)
 }
 
-// EpisodesInvalidateAllBySeason mocks base method.
-func (m *MockServiceTx) EpisodesInvalidateAllBySeason(arg0 context.Context, arg1, arg2, arg3 int, arg4 string) error {
+// CollectionItemsPut mocks base method.
+func (m *MockServiceTx) CollectionItemsPut(arg0 context.Context, arg1, arg2 int, arg3 []*models.CollectionItem) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EpisodesInvalidateAllBySeason", arg0, arg1, arg2, arg3, arg4)
+	ret := m.ctrl.Call(m, "CollectionItemsPut", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// EpisodesInvalidateAllBySeason indicates an expected call of EpisodesInvalidateAllBySeason.
-func (mr *MockServiceTxMockRecorder) EpisodesInvalidateAllBySeason(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+// CollectionItemsPut indicates an expected call of CollectionItemsPut.
+func (mr *MockServiceTxMockRecorder) CollectionItemsPut(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesInvalidateAllBySeason", reflect.TypeOf((*MockServiceTx)(nil).EpisodesInvalidateAllBySeason), arg0, arg1, arg2, arg3, arg4)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectionItemsPut", reflect.TypeOf((*MockServiceTx)(nil).CollectionItemsPut), arg0, arg1, arg2, arg3)
 }
 
-// FilmExists mocks base method.
-func (m *MockServiceTx) FilmExists(arg0 context.Context, arg1 int) error {
+// CollectionUpdate mocks base method.
+func (m *MockServiceTx) CollectionUpdate(arg0 context.Context, arg1, arg2 int, arg3 map[string]interface{}) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FilmExists", arg0, arg1)
+	ret := m.ctrl.Call(m, "CollectionUpdate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CollectionUpdate indicates an expected call of CollectionUpdate.
+func (mr *MockServiceTxMockRecorder) CollectionUpdate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectionUpdate", reflect.TypeOf((*MockServiceTx)(nil).CollectionUpdate), arg0, arg1, arg2, arg3)
+}
+
+// CollectionsCount mocks base method.
+func (m *MockServiceTx) CollectionsCount(arg0 context.Context, arg1 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CollectionsCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CollectionsCount indicates an expected call of CollectionsCount.
+func (mr *MockServiceTxMockRecorder) CollectionsCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectionsCount", reflect.TypeOf((*MockServiceTx)(nil).CollectionsCount), arg0, arg1)
+}
+
+// CollectionsGetAll mocks base method.
+func (m *MockServiceTx) CollectionsGetAll(arg0 context.Context, arg1 query.Options) ([]*models.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CollectionsGetAll", arg0, arg1)
+	ret0, _ := ret[0].([]*models.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CollectionsGetAll indicates an expected call of CollectionsGetAll.
+func (mr *MockServiceTxMockRecorder) CollectionsGetAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectionsGetAll", reflect.TypeOf((*MockServiceTx)(nil).CollectionsGetAll), arg0, arg1)
+}
+
+// ContentRatingAuditsCountByFilm mocks base method.
+func (m *MockServiceTx) ContentRatingAuditsCountByFilm(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContentRatingAuditsCountByFilm", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContentRatingAuditsCountByFilm indicates an expected call of ContentRatingAuditsCountByFilm.
+func (mr *MockServiceTxMockRecorder) ContentRatingAuditsCountByFilm(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContentRatingAuditsCountByFilm", reflect.TypeOf((*MockServiceTx)(nil).ContentRatingAuditsCountByFilm), arg0, arg1)
+}
+
+// ContentRatingAuditsGetAllByFilm mocks base method.
+func (m *MockServiceTx) ContentRatingAuditsGetAllByFilm(arg0 context.Context, arg1 int, arg2 query.SortOrderOptions) ([]*models.ContentRatingsAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContentRatingAuditsGetAllByFilm", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.ContentRatingsAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContentRatingAuditsGetAllByFilm indicates an expected call of ContentRatingAuditsGetAllByFilm.
+func (mr *MockServiceTxMockRecorder) ContentRatingAuditsGetAllByFilm(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContentRatingAuditsGetAllByFilm", reflect.TypeOf((*MockServiceTx)(nil).ContentRatingAuditsGetAllByFilm), arg0, arg1, arg2)
+}
+
+// ContentRatingPut mocks base method.
+func (m *MockServiceTx) ContentRatingPut(arg0 context.Context, arg1, arg2 int, arg3 *models.ContentRating) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContentRatingPut", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// FilmExists indicates an expected call of FilmExists.
-func (mr *MockServiceTxMockRecorder) FilmExists(arg0, arg1 interface{}) *gomock.Call {
+// ContentRatingPut indicates an expected call of ContentRatingPut.
+func (mr *MockServiceTxMockRecorder) ContentRatingPut(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContentRatingPut", reflect.TypeOf((*MockServiceTx)(nil).ContentRatingPut), arg0, arg1, arg2, arg3)
+}
+
+// ContentRatingsGetAllByFilm mocks base method.
+func (m *MockServiceTx) ContentRatingsGetAllByFilm(arg0 context.Context, arg1 int) ([]*models.ContentRating, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContentRatingsGetAllByFilm", arg0, arg1)
+	ret0, _ := ret[0].([]*models.ContentRating)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContentRatingsGetAllByFilm indicates an expected call of ContentRatingsGetAllByFilm.
+func (mr *MockServiceTxMockRecorder) ContentRatingsGetAllByFilm(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContentRatingsGetAllByFilm", reflect.TypeOf((*MockServiceTx)(nil).ContentRatingsGetAllByFilm), arg0, arg1)
+}
+
+// ContinueWatchingCount mocks base method.
+func (m *MockServiceTx) ContinueWatchingCount(arg0 context.Context, arg1, arg2 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContinueWatchingCount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContinueWatchingCount indicates an expected call of ContinueWatchingCount.
+func (mr *MockServiceTxMockRecorder) ContinueWatchingCount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContinueWatchingCount", reflect.TypeOf((*MockServiceTx)(nil).ContinueWatchingCount), arg0, arg1, arg2)
+}
+
+// ContinueWatchingGet mocks base method.
+func (m *MockServiceTx) ContinueWatchingGet(arg0 context.Context, arg1, arg2, arg3, arg4 int) ([]*watchlist.Progress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContinueWatchingGet", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*watchlist.Progress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContinueWatchingGet indicates an expected call of ContinueWatchingGet.
+func (mr *MockServiceTxMockRecorder) ContinueWatchingGet(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContinueWatchingGet", reflect.TypeOf((*MockServiceTx)(nil).ContinueWatchingGet), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ContributionsCount mocks base method.
+func (m *MockServiceTx) ContributionsCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContributionsCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContributionsCount indicates an expected call of ContributionsCount.
+func (mr *MockServiceTxMockRecorder) ContributionsCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContributionsCount", reflect.TypeOf((*MockServiceTx)(nil).ContributionsCount), arg0, arg1)
+}
+
+// ContributionsGetAll mocks base method.
+func (m *MockServiceTx) ContributionsGetAll(arg0 context.Context, arg1 int, arg2 query.SortOrderOptions) ([]*contribution.Contribution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContributionsGetAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*contribution.Contribution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContributionsGetAll indicates an expected call of ContributionsGetAll.
+func (mr *MockServiceTxMockRecorder) ContributionsGetAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContributionsGetAll", reflect.TypeOf((*MockServiceTx)(nil).ContributionsGetAll), arg0, arg1, arg2)
+}
+
+// ContributorStatsGet mocks base method.
+func (m *MockServiceTx) ContributorStatsGet(arg0 context.Context, arg1 int, arg2 query.ReputationOptions) (*contribution.Stats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContributorStatsGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*contribution.Stats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContributorStatsGet indicates an expected call of ContributorStatsGet.
+func (mr *MockServiceTxMockRecorder) ContributorStatsGet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContributorStatsGet", reflect.TypeOf((*MockServiceTx)(nil).ContributorStatsGet), arg0, arg1, arg2)
+}
+
+// ContributorStatsGetAll mocks base method.
+func (m *MockServiceTx) ContributorStatsGetAll(arg0 context.Context, arg1 query.ReputationOptions, arg2, arg3 int) ([]*contribution.Stats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContributorStatsGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*contribution.Stats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContributorStatsGetAll indicates an expected call of ContributorStatsGetAll.
+func (mr *MockServiceTxMockRecorder) ContributorStatsGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContributorStatsGetAll", reflect.TypeOf((*MockServiceTx)(nil).ContributorStatsGetAll), arg0, arg1, arg2, arg3)
+}
+
+// ContributorsCount mocks base method.
+func (m *MockServiceTx) ContributorsCount(arg0 context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContributorsCount", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContributorsCount indicates an expected call of ContributorsCount.
+func (mr *MockServiceTxMockRecorder) ContributorsCount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContributorsCount", reflect.TypeOf((*MockServiceTx)(nil).ContributorsCount), arg0)
+}
+
+// EpisodeAuditsCount mocks base method.
+func (m *MockServiceTx) EpisodeAuditsCount(arg0 context.Context, arg1, arg2, arg3 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EpisodeAuditsCount", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EpisodeAuditsCount indicates an expected call of EpisodeAuditsCount.
+func (mr *MockServiceTxMockRecorder) EpisodeAuditsCount(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodeAuditsCount", reflect.TypeOf((*MockServiceTx)(nil).EpisodeAuditsCount), arg0, arg1, arg2, arg3)
+}
+
+// EpisodeAuditsGetAll mocks base method.
+func (m *MockServiceTx) EpisodeAuditsGetAll(arg0 context.Context, arg1, arg2, arg3 int, arg4 query.SortOrderOptions) ([]*models.FilmsAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EpisodeAuditsGetAll", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*models.FilmsAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EpisodeAuditsGetAll indicates an expected call of EpisodeAuditsGetAll.
+func (mr *MockServiceTxMockRecorder) EpisodeAuditsGetAll(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodeAuditsGetAll", reflect.TypeOf((*MockServiceTx)(nil).EpisodeAuditsGetAll), arg0, arg1, arg2, arg3, arg4)
+}
+
+// EpisodeDelete mocks base method.
+func (m *MockServiceTx) EpisodeDelete(arg0 context.Context, arg1, arg2, arg3 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EpisodeDelete", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EpisodeDelete indicates an expected call of EpisodeDelete.
+func (mr *MockServiceTxMockRecorder) EpisodeDelete(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodeDelete", reflect.TypeOf((*MockServiceTx)(nil).EpisodeDelete), arg0, arg1, arg2, arg3)
+}
+
+// EpisodeGet mocks base method.
+func (m *MockServiceTx) EpisodeGet(arg0 context.Context, arg1, arg2, arg3 int) (*models.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EpisodeGet", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EpisodeGet indicates an expected call of EpisodeGet.
+func (mr *MockServiceTxMockRecorder) EpisodeGet(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodeGet", reflect.TypeOf((*MockServiceTx)(nil).EpisodeGet), arg0, arg1, arg2, arg3)
+}
+
+// EpisodeGetByAbsoluteNumber mocks base method.
+func (m *MockServiceTx) EpisodeGetByAbsoluteNumber(arg0 context.Context, arg1, arg2 int) (*models.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EpisodeGetByAbsoluteNumber", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EpisodeGetByAbsoluteNumber indicates an expected call of EpisodeGetByAbsoluteNumber.
+func (mr *MockServiceTxMockRecorder) EpisodeGetByAbsoluteNumber(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodeGetByAbsoluteNumber", reflect.TypeOf((*MockServiceTx)(nil).EpisodeGetByAbsoluteNumber), arg0, arg1, arg2)
+}
+
+// EpisodePut mocks base method.
+func (m *MockServiceTx) EpisodePut(arg0 context.Context, arg1, arg2, arg3, arg4 int, arg5 *models.Film) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EpisodePut", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EpisodePut indicates an expected call of EpisodePut.
+func (mr *MockServiceTxMockRecorder) EpisodePut(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodePut", reflect.TypeOf((*MockServiceTx)(nil).EpisodePut), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// EpisodeSetDeletedAt mocks base method.
+func (m *MockServiceTx) EpisodeSetDeletedAt(arg0 context.Context, arg1, arg2, arg3 int, arg4 null.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EpisodeSetDeletedAt", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EpisodeSetDeletedAt indicates an expected call of EpisodeSetDeletedAt.
+func (mr *MockServiceTxMockRecorder) EpisodeSetDeletedAt(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodeSetDeletedAt", reflect.TypeOf((*MockServiceTx)(nil).EpisodeSetDeletedAt), arg0, arg1, arg2, arg3, arg4)
+}
+
+// EpisodeUpdate mocks base method.
+func (m *MockServiceTx) EpisodeUpdate(arg0 context.Context, arg1, arg2, arg3, arg4 int, arg5 map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EpisodeUpdate", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EpisodeUpdate indicates an expected call of EpisodeUpdate.
+func (mr *MockServiceTxMockRecorder) EpisodeUpdate(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodeUpdate", reflect.TypeOf((*MockServiceTx)(nil).EpisodeUpdate), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// EpisodesCountBySeason mocks base method.
+func (m *MockServiceTx) EpisodesCountBySeason(arg0 context.Context, arg1, arg2 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EpisodesCountBySeason", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EpisodesCountBySeason indicates an expected call of EpisodesCountBySeason.
+func (mr *MockServiceTxMockRecorder) EpisodesCountBySeason(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesCountBySeason", reflect.TypeOf((*MockServiceTx)(nil).EpisodesCountBySeason), arg0, arg1, arg2)
+}
+
+// EpisodesCountBySeries mocks base method.
+func (m *MockServiceTx) EpisodesCountBySeries(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EpisodesCountBySeries", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EpisodesCountBySeries indicates an expected call of EpisodesCountBySeries.
+func (mr *MockServiceTxMockRecorder) EpisodesCountBySeries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesCountBySeries", reflect.TypeOf((*MockServiceTx)(nil).EpisodesCountBySeries), arg0, arg1)
+}
+
+// EpisodesGetAllBySeason mocks base method.
+func (m *MockServiceTx) EpisodesGetAllBySeason(arg0 context.Context, arg1, arg2 int, arg3 query.SortOrderOptions) ([]*models.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EpisodesGetAllBySeason", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EpisodesGetAllBySeason indicates an expected call of EpisodesGetAllBySeason.
+func (mr *MockServiceTxMockRecorder) EpisodesGetAllBySeason(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesGetAllBySeason", reflect.TypeOf((*MockServiceTx)(nil).EpisodesGetAllBySeason), arg0, arg1, arg2, arg3)
+}
+
+// EpisodesGetAllBySeries mocks base method.
+func (m *MockServiceTx) EpisodesGetAllBySeries(arg0 context.Context, arg1 int, arg2 query.SortOrderOptions) ([]*models.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EpisodesGetAllBySeries", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EpisodesGetAllBySeries indicates an expected call of EpisodesGetAllBySeries.
+func (mr *MockServiceTxMockRecorder) EpisodesGetAllBySeries(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesGetAllBySeries", reflect.TypeOf((*MockServiceTx)(nil).EpisodesGetAllBySeries), arg0, arg1, arg2)
+}
+
+// EpisodesInvalidateAllBySeason mocks base method.
+func (m *MockServiceTx) EpisodesInvalidateAllBySeason(arg0 context.Context, arg1, arg2, arg3 int, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EpisodesInvalidateAllBySeason", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EpisodesInvalidateAllBySeason indicates an expected call of EpisodesInvalidateAllBySeason.
+func (mr *MockServiceTxMockRecorder) EpisodesInvalidateAllBySeason(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesInvalidateAllBySeason", reflect.TypeOf((*MockServiceTx)(nil).EpisodesInvalidateAllBySeason), arg0, arg1, arg2, arg3, arg4)
+}
+
+// EpisodesRenumber mocks base method.
+func (m *MockServiceTx) EpisodesRenumber(arg0 context.Context, arg1 int, arg2 []*models.Film) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EpisodesRenumber", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EpisodesRenumber indicates an expected call of EpisodesRenumber.
+func (mr *MockServiceTxMockRecorder) EpisodesRenumber(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpisodesRenumber", reflect.TypeOf((*MockServiceTx)(nil).EpisodesRenumber), arg0, arg1, arg2)
+}
+
+// ExternalIDAuditsCountByFilm mocks base method.
+func (m *MockServiceTx) ExternalIDAuditsCountByFilm(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExternalIDAuditsCountByFilm", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExternalIDAuditsCountByFilm indicates an expected call of ExternalIDAuditsCountByFilm.
+func (mr *MockServiceTxMockRecorder) ExternalIDAuditsCountByFilm(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExternalIDAuditsCountByFilm", reflect.TypeOf((*MockServiceTx)(nil).ExternalIDAuditsCountByFilm), arg0, arg1)
+}
+
+// ExternalIDAuditsCountBySeries mocks base method.
+func (m *MockServiceTx) ExternalIDAuditsCountBySeries(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExternalIDAuditsCountBySeries", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExternalIDAuditsCountBySeries indicates an expected call of ExternalIDAuditsCountBySeries.
+func (mr *MockServiceTxMockRecorder) ExternalIDAuditsCountBySeries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExternalIDAuditsCountBySeries", reflect.TypeOf((*MockServiceTx)(nil).ExternalIDAuditsCountBySeries), arg0, arg1)
+}
+
+// ExternalIDAuditsGetAllByFilm mocks base method.
+func (m *MockServiceTx) ExternalIDAuditsGetAllByFilm(arg0 context.Context, arg1 int, arg2 query.SortOrderOptions) ([]*models.ExternalIdsAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExternalIDAuditsGetAllByFilm", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.ExternalIdsAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExternalIDAuditsGetAllByFilm indicates an expected call of ExternalIDAuditsGetAllByFilm.
+func (mr *MockServiceTxMockRecorder) ExternalIDAuditsGetAllByFilm(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExternalIDAuditsGetAllByFilm", reflect.TypeOf((*MockServiceTx)(nil).ExternalIDAuditsGetAllByFilm), arg0, arg1, arg2)
+}
+
+// ExternalIDAuditsGetAllBySeries mocks base method.
+func (m *MockServiceTx) ExternalIDAuditsGetAllBySeries(arg0 context.Context, arg1 int, arg2 query.SortOrderOptions) ([]*models.ExternalIdsAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExternalIDAuditsGetAllBySeries", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.ExternalIdsAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExternalIDAuditsGetAllBySeries indicates an expected call of ExternalIDAuditsGetAllBySeries.
+func (mr *MockServiceTxMockRecorder) ExternalIDAuditsGetAllBySeries(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExternalIDAuditsGetAllBySeries", reflect.TypeOf((*MockServiceTx)(nil).ExternalIDAuditsGetAllBySeries), arg0, arg1, arg2)
+}
+
+// ExternalIDPutByFilm mocks base method.
+func (m *MockServiceTx) ExternalIDPutByFilm(arg0 context.Context, arg1, arg2 int, arg3 *models.ExternalID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExternalIDPutByFilm", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExternalIDPutByFilm indicates an expected call of ExternalIDPutByFilm.
+func (mr *MockServiceTxMockRecorder) ExternalIDPutByFilm(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExternalIDPutByFilm", reflect.TypeOf((*MockServiceTx)(nil).ExternalIDPutByFilm), arg0, arg1, arg2, arg3)
+}
+
+// ExternalIDPutBySeries mocks base method.
+func (m *MockServiceTx) ExternalIDPutBySeries(arg0 context.Context, arg1, arg2 int, arg3 *models.ExternalID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExternalIDPutBySeries", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExternalIDPutBySeries indicates an expected call of ExternalIDPutBySeries.
+func (mr *MockServiceTxMockRecorder) ExternalIDPutBySeries(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExternalIDPutBySeries", reflect.TypeOf((*MockServiceTx)(nil).ExternalIDPutBySeries), arg0, arg1, arg2, arg3)
+}
+
+// ExternalIDsGetAllByFilm mocks base method.
+func (m *MockServiceTx) ExternalIDsGetAllByFilm(arg0 context.Context, arg1 int) ([]*models.ExternalID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExternalIDsGetAllByFilm", arg0, arg1)
+	ret0, _ := ret[0].([]*models.ExternalID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExternalIDsGetAllByFilm indicates an expected call of ExternalIDsGetAllByFilm.
+func (mr *MockServiceTxMockRecorder) ExternalIDsGetAllByFilm(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExternalIDsGetAllByFilm", reflect.TypeOf((*MockServiceTx)(nil).ExternalIDsGetAllByFilm), arg0, arg1)
+}
+
+// ExternalIDsGetAllBySeries mocks base method.
+func (m *MockServiceTx) ExternalIDsGetAllBySeries(arg0 context.Context, arg1 int) ([]*models.ExternalID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExternalIDsGetAllBySeries", arg0, arg1)
+	ret0, _ := ret[0].([]*models.ExternalID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExternalIDsGetAllBySeries indicates an expected call of ExternalIDsGetAllBySeries.
+func (mr *MockServiceTxMockRecorder) ExternalIDsGetAllBySeries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExternalIDsGetAllBySeries", reflect.TypeOf((*MockServiceTx)(nil).ExternalIDsGetAllBySeries), arg0, arg1)
+}
+
+// FilmAuditsGetAllByCursor mocks base method.
+func (m *MockServiceTx) FilmAuditsGetAllByCursor(arg0 context.Context, arg1 query.AuditCursor, arg2 int) ([]*models.FilmsAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilmAuditsGetAllByCursor", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.FilmsAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilmAuditsGetAllByCursor indicates an expected call of FilmAuditsGetAllByCursor.
+func (mr *MockServiceTxMockRecorder) FilmAuditsGetAllByCursor(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmAuditsGetAllByCursor", reflect.TypeOf((*MockServiceTx)(nil).FilmAuditsGetAllByCursor), arg0, arg1, arg2)
+}
+
+// FilmExists mocks base method.
+func (m *MockServiceTx) FilmExists(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilmExists", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FilmExists indicates an expected call of FilmExists.
+func (mr *MockServiceTxMockRecorder) FilmExists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmExists", reflect.TypeOf((*MockServiceTx)(nil).FilmExists), arg0, arg1)
+}
+
+// FilmExternalIDGet mocks base method.
+func (m *MockServiceTx) FilmExternalIDGet(arg0 context.Context, arg1, arg2 string) (*models.ExternalID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilmExternalIDGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.ExternalID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilmExternalIDGet indicates an expected call of FilmExternalIDGet.
+func (mr *MockServiceTxMockRecorder) FilmExternalIDGet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmExternalIDGet", reflect.TypeOf((*MockServiceTx)(nil).FilmExternalIDGet), arg0, arg1, arg2)
+}
+
+// FilmGet mocks base method.
+func (m *MockServiceTx) FilmGet(arg0 context.Context, arg1 int) (*models.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilmGet", arg0, arg1)
+	ret0, _ := ret[0].(*models.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilmGet indicates an expected call of FilmGet.
+func (mr *MockServiceTxMockRecorder) FilmGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmGet", reflect.TypeOf((*MockServiceTx)(nil).FilmGet), arg0, arg1)
+}
+
+// FilmReviewDelete mocks base method.
+func (m *MockServiceTx) FilmReviewDelete(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilmReviewDelete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FilmReviewDelete indicates an expected call of FilmReviewDelete.
+func (mr *MockServiceTxMockRecorder) FilmReviewDelete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmReviewDelete", reflect.TypeOf((*MockServiceTx)(nil).FilmReviewDelete), arg0, arg1, arg2)
+}
+
+// FilmReviewGet mocks base method.
+func (m *MockServiceTx) FilmReviewGet(arg0 context.Context, arg1, arg2 int) (*models.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilmReviewGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilmReviewGet indicates an expected call of FilmReviewGet.
+func (mr *MockServiceTxMockRecorder) FilmReviewGet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmReviewGet", reflect.TypeOf((*MockServiceTx)(nil).FilmReviewGet), arg0, arg1, arg2)
+}
+
+// FilmReviewPut mocks base method.
+func (m *MockServiceTx) FilmReviewPut(arg0 context.Context, arg1, arg2 int, arg3 *models.Review) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilmReviewPut", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FilmReviewPut indicates an expected call of FilmReviewPut.
+func (mr *MockServiceTxMockRecorder) FilmReviewPut(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmReviewPut", reflect.TypeOf((*MockServiceTx)(nil).FilmReviewPut), arg0, arg1, arg2, arg3)
+}
+
+// FilmScoreDelete mocks base method.
+func (m *MockServiceTx) FilmScoreDelete(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilmScoreDelete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FilmScoreDelete indicates an expected call of FilmScoreDelete.
+func (mr *MockServiceTxMockRecorder) FilmScoreDelete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmScoreDelete", reflect.TypeOf((*MockServiceTx)(nil).FilmScoreDelete), arg0, arg1, arg2)
+}
+
+// FilmScoreGet mocks base method.
+func (m *MockServiceTx) FilmScoreGet(arg0 context.Context, arg1, arg2 int) (*models.Score, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilmScoreGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Score)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilmScoreGet indicates an expected call of FilmScoreGet.
+func (mr *MockServiceTxMockRecorder) FilmScoreGet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmScoreGet", reflect.TypeOf((*MockServiceTx)(nil).FilmScoreGet), arg0, arg1, arg2)
+}
+
+// FilmScorePut mocks base method.
+func (m *MockServiceTx) FilmScorePut(arg0 context.Context, arg1, arg2, arg3 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilmScorePut", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FilmScorePut indicates an expected call of FilmScorePut.
+func (mr *MockServiceTxMockRecorder) FilmScorePut(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmScorePut", reflect.TypeOf((*MockServiceTx)(nil).FilmScorePut), arg0, arg1, arg2, arg3)
+}
+
+// FilmsGetAllByCursor mocks base method.
+func (m *MockServiceTx) FilmsGetAllByCursor(arg0 context.Context, arg1, arg2 int) ([]*models.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilmsGetAllByCursor", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilmsGetAllByCursor indicates an expected call of FilmsGetAllByCursor.
+func (mr *MockServiceTxMockRecorder) FilmsGetAllByCursor(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmsGetAllByCursor", reflect.TypeOf((*MockServiceTx)(nil).FilmsGetAllByCursor), arg0, arg1, arg2)
+}
+
+// ImportErrorCreate mocks base method.
+func (m *MockServiceTx) ImportErrorCreate(arg0 context.Context, arg1 *models.ImportError) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportErrorCreate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportErrorCreate indicates an expected call of ImportErrorCreate.
+func (mr *MockServiceTxMockRecorder) ImportErrorCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportErrorCreate", reflect.TypeOf((*MockServiceTx)(nil).ImportErrorCreate), arg0, arg1)
+}
+
+// ImportErrorsCount mocks base method.
+func (m *MockServiceTx) ImportErrorsCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportErrorsCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportErrorsCount indicates an expected call of ImportErrorsCount.
+func (mr *MockServiceTxMockRecorder) ImportErrorsCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportErrorsCount", reflect.TypeOf((*MockServiceTx)(nil).ImportErrorsCount), arg0, arg1)
+}
+
+// ImportErrorsGetAll mocks base method.
+func (m *MockServiceTx) ImportErrorsGetAll(arg0 context.Context, arg1 int, arg2 query.SortOrderOptions) ([]*models.ImportError, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportErrorsGetAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.ImportError)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportErrorsGetAll indicates an expected call of ImportErrorsGetAll.
+func (mr *MockServiceTxMockRecorder) ImportErrorsGetAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportErrorsGetAll", reflect.TypeOf((*MockServiceTx)(nil).ImportErrorsGetAll), arg0, arg1, arg2)
+}
+
+// ImportJobCreate mocks base method.
+func (m *MockServiceTx) ImportJobCreate(arg0 context.Context, arg1 *models.ImportJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportJobCreate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportJobCreate indicates an expected call of ImportJobCreate.
+func (mr *MockServiceTxMockRecorder) ImportJobCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportJobCreate", reflect.TypeOf((*MockServiceTx)(nil).ImportJobCreate), arg0, arg1)
+}
+
+// ImportJobGet mocks base method.
+func (m *MockServiceTx) ImportJobGet(arg0 context.Context, arg1 int) (*models.ImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportJobGet", arg0, arg1)
+	ret0, _ := ret[0].(*models.ImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportJobGet indicates an expected call of ImportJobGet.
+func (mr *MockServiceTxMockRecorder) ImportJobGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportJobGet", reflect.TypeOf((*MockServiceTx)(nil).ImportJobGet), arg0, arg1)
+}
+
+// ImportJobUpdate mocks base method.
+func (m *MockServiceTx) ImportJobUpdate(arg0 context.Context, arg1 int, arg2 map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportJobUpdate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportJobUpdate indicates an expected call of ImportJobUpdate.
+func (mr *MockServiceTxMockRecorder) ImportJobUpdate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportJobUpdate", reflect.TypeOf((*MockServiceTx)(nil).ImportJobUpdate), arg0, arg1, arg2)
+}
+
+// ImportJobsUpdateAllByStatus mocks base method.
+func (m *MockServiceTx) ImportJobsUpdateAllByStatus(arg0 context.Context, arg1 []string, arg2 map[string]interface{}) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportJobsUpdateAllByStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportJobsUpdateAllByStatus indicates an expected call of ImportJobsUpdateAllByStatus.
+func (mr *MockServiceTxMockRecorder) ImportJobsUpdateAllByStatus(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportJobsUpdateAllByStatus", reflect.TypeOf((*MockServiceTx)(nil).ImportJobsUpdateAllByStatus), arg0, arg1, arg2)
+}
+
+// ListActivitiesCount mocks base method.
+func (m *MockServiceTx) ListActivitiesCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActivitiesCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActivitiesCount indicates an expected call of ListActivitiesCount.
+func (mr *MockServiceTxMockRecorder) ListActivitiesCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActivitiesCount", reflect.TypeOf((*MockServiceTx)(nil).ListActivitiesCount), arg0, arg1)
+}
+
+// ListActivitiesGetAll mocks base method.
+func (m *MockServiceTx) ListActivitiesGetAll(arg0 context.Context, arg1 int, arg2 query.SortOrderOptions) ([]*models.ListActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActivitiesGetAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.ListActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActivitiesGetAll indicates an expected call of ListActivitiesGetAll.
+func (mr *MockServiceTxMockRecorder) ListActivitiesGetAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActivitiesGetAll", reflect.TypeOf((*MockServiceTx)(nil).ListActivitiesGetAll), arg0, arg1, arg2)
+}
+
+// ListActivityCreate mocks base method.
+func (m *MockServiceTx) ListActivityCreate(arg0 context.Context, arg1 *models.ListActivity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActivityCreate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListActivityCreate indicates an expected call of ListActivityCreate.
+func (mr *MockServiceTxMockRecorder) ListActivityCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActivityCreate", reflect.TypeOf((*MockServiceTx)(nil).ListActivityCreate), arg0, arg1)
+}
+
+// ListCreate mocks base method.
+func (m *MockServiceTx) ListCreate(arg0 context.Context, arg1 int, arg2 *models.List) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCreate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListCreate indicates an expected call of ListCreate.
+func (mr *MockServiceTxMockRecorder) ListCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCreate", reflect.TypeOf((*MockServiceTx)(nil).ListCreate), arg0, arg1, arg2)
+}
+
+// ListDelete mocks base method.
+func (m *MockServiceTx) ListDelete(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDelete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListDelete indicates an expected call of ListDelete.
+func (mr *MockServiceTxMockRecorder) ListDelete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDelete", reflect.TypeOf((*MockServiceTx)(nil).ListDelete), arg0, arg1, arg2)
+}
+
+// ListGet mocks base method.
+func (m *MockServiceTx) ListGet(arg0 context.Context, arg1 int) (*models.List, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGet", arg0, arg1)
+	ret0, _ := ret[0].(*models.List)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGet indicates an expected call of ListGet.
+func (mr *MockServiceTxMockRecorder) ListGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGet", reflect.TypeOf((*MockServiceTx)(nil).ListGet), arg0, arg1)
+}
+
+// ListInviteCreate mocks base method.
+func (m *MockServiceTx) ListInviteCreate(arg0 context.Context, arg1 int, arg2 *models.ListInvite) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInviteCreate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListInviteCreate indicates an expected call of ListInviteCreate.
+func (mr *MockServiceTxMockRecorder) ListInviteCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInviteCreate", reflect.TypeOf((*MockServiceTx)(nil).ListInviteCreate), arg0, arg1, arg2)
+}
+
+// ListInviteDelete mocks base method.
+func (m *MockServiceTx) ListInviteDelete(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInviteDelete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListInviteDelete indicates an expected call of ListInviteDelete.
+func (mr *MockServiceTxMockRecorder) ListInviteDelete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInviteDelete", reflect.TypeOf((*MockServiceTx)(nil).ListInviteDelete), arg0, arg1, arg2)
+}
+
+// ListInviteGet mocks base method.
+func (m *MockServiceTx) ListInviteGet(arg0 context.Context, arg1 int) (*models.ListInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInviteGet", arg0, arg1)
+	ret0, _ := ret[0].(*models.ListInvite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInviteGet indicates an expected call of ListInviteGet.
+func (mr *MockServiceTxMockRecorder) ListInviteGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInviteGet", reflect.TypeOf((*MockServiceTx)(nil).ListInviteGet), arg0, arg1)
+}
+
+// ListInvitesGetAllByList mocks base method.
+func (m *MockServiceTx) ListInvitesGetAllByList(arg0 context.Context, arg1 int) ([]*models.ListInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvitesGetAllByList", arg0, arg1)
+	ret0, _ := ret[0].([]*models.ListInvite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInvitesGetAllByList indicates an expected call of ListInvitesGetAllByList.
+func (mr *MockServiceTxMockRecorder) ListInvitesGetAllByList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvitesGetAllByList", reflect.TypeOf((*MockServiceTx)(nil).ListInvitesGetAllByList), arg0, arg1)
+}
+
+// ListInvitesGetAllByUser mocks base method.
+func (m *MockServiceTx) ListInvitesGetAllByUser(arg0 context.Context, arg1 int) ([]*models.ListInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvitesGetAllByUser", arg0, arg1)
+	ret0, _ := ret[0].([]*models.ListInvite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInvitesGetAllByUser indicates an expected call of ListInvitesGetAllByUser.
+func (mr *MockServiceTxMockRecorder) ListInvitesGetAllByUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvitesGetAllByUser", reflect.TypeOf((*MockServiceTx)(nil).ListInvitesGetAllByUser), arg0, arg1)
+}
+
+// ListItemAdd mocks base method.
+func (m *MockServiceTx) ListItemAdd(arg0 context.Context, arg1, arg2 int, arg3 *models.ListItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItemAdd", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListItemAdd indicates an expected call of ListItemAdd.
+func (mr *MockServiceTxMockRecorder) ListItemAdd(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItemAdd", reflect.TypeOf((*MockServiceTx)(nil).ListItemAdd), arg0, arg1, arg2, arg3)
+}
+
+// ListItemDelete mocks base method.
+func (m *MockServiceTx) ListItemDelete(arg0 context.Context, arg1, arg2, arg3 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItemDelete", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListItemDelete indicates an expected call of ListItemDelete.
+func (mr *MockServiceTxMockRecorder) ListItemDelete(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItemDelete", reflect.TypeOf((*MockServiceTx)(nil).ListItemDelete), arg0, arg1, arg2, arg3)
+}
+
+// ListItemSetPosition mocks base method.
+func (m *MockServiceTx) ListItemSetPosition(arg0 context.Context, arg1, arg2, arg3, arg4 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItemSetPosition", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListItemSetPosition indicates an expected call of ListItemSetPosition.
+func (mr *MockServiceTxMockRecorder) ListItemSetPosition(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItemSetPosition", reflect.TypeOf((*MockServiceTx)(nil).ListItemSetPosition), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ListItemsGetAll mocks base method.
+func (m *MockServiceTx) ListItemsGetAll(arg0 context.Context, arg1 int) ([]*list.Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItemsGetAll", arg0, arg1)
+	ret0, _ := ret[0].([]*list.Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListItemsGetAll indicates an expected call of ListItemsGetAll.
+func (mr *MockServiceTxMockRecorder) ListItemsGetAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItemsGetAll", reflect.TypeOf((*MockServiceTx)(nil).ListItemsGetAll), arg0, arg1)
+}
+
+// ListMemberAdd mocks base method.
+func (m *MockServiceTx) ListMemberAdd(arg0 context.Context, arg1 *models.ListMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberAdd", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListMemberAdd indicates an expected call of ListMemberAdd.
+func (mr *MockServiceTxMockRecorder) ListMemberAdd(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberAdd", reflect.TypeOf((*MockServiceTx)(nil).ListMemberAdd), arg0, arg1)
+}
+
+// ListMemberDelete mocks base method.
+func (m *MockServiceTx) ListMemberDelete(arg0 context.Context, arg1, arg2, arg3 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberDelete", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListMemberDelete indicates an expected call of ListMemberDelete.
+func (mr *MockServiceTxMockRecorder) ListMemberDelete(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberDelete", reflect.TypeOf((*MockServiceTx)(nil).ListMemberDelete), arg0, arg1, arg2, arg3)
+}
+
+// ListMemberGet mocks base method.
+func (m *MockServiceTx) ListMemberGet(arg0 context.Context, arg1, arg2 int) (*models.ListMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.ListMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMemberGet indicates an expected call of ListMemberGet.
+func (mr *MockServiceTxMockRecorder) ListMemberGet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberGet", reflect.TypeOf((*MockServiceTx)(nil).ListMemberGet), arg0, arg1, arg2)
+}
+
+// ListMemberSetRole mocks base method.
+func (m *MockServiceTx) ListMemberSetRole(arg0 context.Context, arg1, arg2, arg3 int, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberSetRole", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListMemberSetRole indicates an expected call of ListMemberSetRole.
+func (mr *MockServiceTxMockRecorder) ListMemberSetRole(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberSetRole", reflect.TypeOf((*MockServiceTx)(nil).ListMemberSetRole), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ListMembersGetAll mocks base method.
+func (m *MockServiceTx) ListMembersGetAll(arg0 context.Context, arg1 int) ([]*list.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembersGetAll", arg0, arg1)
+	ret0, _ := ret[0].([]*list.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembersGetAll indicates an expected call of ListMembersGetAll.
+func (mr *MockServiceTxMockRecorder) ListMembersGetAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembersGetAll", reflect.TypeOf((*MockServiceTx)(nil).ListMembersGetAll), arg0, arg1)
+}
+
+// ListUpdate mocks base method.
+func (m *MockServiceTx) ListUpdate(arg0 context.Context, arg1, arg2 int, arg3 map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUpdate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListUpdate indicates an expected call of ListUpdate.
+func (mr *MockServiceTxMockRecorder) ListUpdate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUpdate", reflect.TypeOf((*MockServiceTx)(nil).ListUpdate), arg0, arg1, arg2, arg3)
+}
+
+// ListsCountByUser mocks base method.
+func (m *MockServiceTx) ListsCountByUser(arg0 context.Context, arg1 int, arg2 bool) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListsCountByUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListsCountByUser indicates an expected call of ListsCountByUser.
+func (mr *MockServiceTxMockRecorder) ListsCountByUser(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListsCountByUser", reflect.TypeOf((*MockServiceTx)(nil).ListsCountByUser), arg0, arg1, arg2)
+}
+
+// ListsGetAllByUser mocks base method.
+func (m *MockServiceTx) ListsGetAllByUser(arg0 context.Context, arg1 int, arg2 bool, arg3 query.SortOrderOptions) ([]*models.List, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListsGetAllByUser", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.List)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListsGetAllByUser indicates an expected call of ListsGetAllByUser.
+func (mr *MockServiceTxMockRecorder) ListsGetAllByUser(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListsGetAllByUser", reflect.TypeOf((*MockServiceTx)(nil).ListsGetAllByUser), arg0, arg1, arg2, arg3)
+}
+
+// MediaItemAuditsCountByFilm mocks base method.
+func (m *MockServiceTx) MediaItemAuditsCountByFilm(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MediaItemAuditsCountByFilm", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MediaItemAuditsCountByFilm indicates an expected call of MediaItemAuditsCountByFilm.
+func (mr *MockServiceTxMockRecorder) MediaItemAuditsCountByFilm(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MediaItemAuditsCountByFilm", reflect.TypeOf((*MockServiceTx)(nil).MediaItemAuditsCountByFilm), arg0, arg1)
+}
+
+// MediaItemAuditsCountBySeries mocks base method.
+func (m *MockServiceTx) MediaItemAuditsCountBySeries(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MediaItemAuditsCountBySeries", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MediaItemAuditsCountBySeries indicates an expected call of MediaItemAuditsCountBySeries.
+func (mr *MockServiceTxMockRecorder) MediaItemAuditsCountBySeries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MediaItemAuditsCountBySeries", reflect.TypeOf((*MockServiceTx)(nil).MediaItemAuditsCountBySeries), arg0, arg1)
+}
+
+// MediaItemAuditsGetAllByFilm mocks base method.
+func (m *MockServiceTx) MediaItemAuditsGetAllByFilm(arg0 context.Context, arg1 int, arg2 query.SortOrderOptions) ([]*models.MediaItemsAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MediaItemAuditsGetAllByFilm", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.MediaItemsAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MediaItemAuditsGetAllByFilm indicates an expected call of MediaItemAuditsGetAllByFilm.
+func (mr *MockServiceTxMockRecorder) MediaItemAuditsGetAllByFilm(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MediaItemAuditsGetAllByFilm", reflect.TypeOf((*MockServiceTx)(nil).MediaItemAuditsGetAllByFilm), arg0, arg1, arg2)
+}
+
+// MediaItemAuditsGetAllBySeries mocks base method.
+func (m *MockServiceTx) MediaItemAuditsGetAllBySeries(arg0 context.Context, arg1 int, arg2 query.SortOrderOptions) ([]*models.MediaItemsAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MediaItemAuditsGetAllBySeries", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.MediaItemsAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MediaItemAuditsGetAllBySeries indicates an expected call of MediaItemAuditsGetAllBySeries.
+func (mr *MockServiceTxMockRecorder) MediaItemAuditsGetAllBySeries(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MediaItemAuditsGetAllBySeries", reflect.TypeOf((*MockServiceTx)(nil).MediaItemAuditsGetAllBySeries), arg0, arg1, arg2)
+}
+
+// MediaItemCreate mocks base method.
+func (m *MockServiceTx) MediaItemCreate(arg0 context.Context, arg1 int, arg2 *models.MediaItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MediaItemCreate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MediaItemCreate indicates an expected call of MediaItemCreate.
+func (mr *MockServiceTxMockRecorder) MediaItemCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MediaItemCreate", reflect.TypeOf((*MockServiceTx)(nil).MediaItemCreate), arg0, arg1, arg2)
+}
+
+// MediaItemUpdate mocks base method.
+func (m *MockServiceTx) MediaItemUpdate(arg0 context.Context, arg1, arg2 int, arg3 map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MediaItemUpdate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MediaItemUpdate indicates an expected call of MediaItemUpdate.
+func (mr *MockServiceTxMockRecorder) MediaItemUpdate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MediaItemUpdate", reflect.TypeOf((*MockServiceTx)(nil).MediaItemUpdate), arg0, arg1, arg2, arg3)
+}
+
+// MediaItemsGetAllByFilm mocks base method.
+func (m *MockServiceTx) MediaItemsGetAllByFilm(arg0 context.Context, arg1 int) ([]*models.MediaItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MediaItemsGetAllByFilm", arg0, arg1)
+	ret0, _ := ret[0].([]*models.MediaItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MediaItemsGetAllByFilm indicates an expected call of MediaItemsGetAllByFilm.
+func (mr *MockServiceTxMockRecorder) MediaItemsGetAllByFilm(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MediaItemsGetAllByFilm", reflect.TypeOf((*MockServiceTx)(nil).MediaItemsGetAllByFilm), arg0, arg1)
+}
+
+// MediaItemsGetAllBySeries mocks base method.
+func (m *MockServiceTx) MediaItemsGetAllBySeries(arg0 context.Context, arg1 int) ([]*models.MediaItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MediaItemsGetAllBySeries", arg0, arg1)
+	ret0, _ := ret[0].([]*models.MediaItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MediaItemsGetAllBySeries indicates an expected call of MediaItemsGetAllBySeries.
+func (mr *MockServiceTxMockRecorder) MediaItemsGetAllBySeries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MediaItemsGetAllBySeries", reflect.TypeOf((*MockServiceTx)(nil).MediaItemsGetAllBySeries), arg0, arg1)
+}
+
+// MovieAuditsCount mocks base method.
+func (m *MockServiceTx) MovieAuditsCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovieAuditsCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MovieAuditsCount indicates an expected call of MovieAuditsCount.
+func (mr *MockServiceTxMockRecorder) MovieAuditsCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieAuditsCount", reflect.TypeOf((*MockServiceTx)(nil).MovieAuditsCount), arg0, arg1)
+}
+
+// MovieAuditsGetAll mocks base method.
+func (m *MockServiceTx) MovieAuditsGetAll(arg0 context.Context, arg1 int, arg2 query.SortOrderOptions) ([]*models.FilmsAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovieAuditsGetAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.FilmsAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MovieAuditsGetAll indicates an expected call of MovieAuditsGetAll.
+func (mr *MockServiceTxMockRecorder) MovieAuditsGetAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieAuditsGetAll", reflect.TypeOf((*MockServiceTx)(nil).MovieAuditsGetAll), arg0, arg1, arg2)
+}
+
+// MovieCreate mocks base method.
+func (m *MockServiceTx) MovieCreate(arg0 context.Context, arg1 int, arg2 *models.Film) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovieCreate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MovieCreate indicates an expected call of MovieCreate.
+func (mr *MockServiceTxMockRecorder) MovieCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieCreate", reflect.TypeOf((*MockServiceTx)(nil).MovieCreate), arg0, arg1, arg2)
+}
+
+// MovieDelete mocks base method.
+func (m *MockServiceTx) MovieDelete(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovieDelete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MovieDelete indicates an expected call of MovieDelete.
+func (mr *MockServiceTxMockRecorder) MovieDelete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieDelete", reflect.TypeOf((*MockServiceTx)(nil).MovieDelete), arg0, arg1)
+}
+
+// MovieGet mocks base method.
+func (m *MockServiceTx) MovieGet(arg0 context.Context, arg1 int) (*models.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovieGet", arg0, arg1)
+	ret0, _ := ret[0].(*models.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MovieGet indicates an expected call of MovieGet.
+func (mr *MockServiceTxMockRecorder) MovieGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieGet", reflect.TypeOf((*MockServiceTx)(nil).MovieGet), arg0, arg1)
+}
+
+// MovieSetDeletedAt mocks base method.
+func (m *MockServiceTx) MovieSetDeletedAt(arg0 context.Context, arg1 int, arg2 null.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovieSetDeletedAt", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MovieSetDeletedAt indicates an expected call of MovieSetDeletedAt.
+func (mr *MockServiceTxMockRecorder) MovieSetDeletedAt(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieSetDeletedAt", reflect.TypeOf((*MockServiceTx)(nil).MovieSetDeletedAt), arg0, arg1, arg2)
+}
+
+// MovieUpdate mocks base method.
+func (m *MockServiceTx) MovieUpdate(arg0 context.Context, arg1, arg2 int, arg3 map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovieUpdate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MovieUpdate indicates an expected call of MovieUpdate.
+func (mr *MockServiceTxMockRecorder) MovieUpdate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieUpdate", reflect.TypeOf((*MockServiceTx)(nil).MovieUpdate), arg0, arg1, arg2, arg3)
+}
+
+// MoviesCount mocks base method.
+func (m *MockServiceTx) MoviesCount(arg0 context.Context, arg1 string, arg2 query.ReleaseOptions) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoviesCount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoviesCount indicates an expected call of MoviesCount.
+func (mr *MockServiceTxMockRecorder) MoviesCount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoviesCount", reflect.TypeOf((*MockServiceTx)(nil).MoviesCount), arg0, arg1, arg2)
+}
+
+// MoviesGetAll mocks base method.
+func (m *MockServiceTx) MoviesGetAll(arg0 context.Context, arg1 query.Options, arg2 query.ReleaseOptions) ([]*models.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoviesGetAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoviesGetAll indicates an expected call of MoviesGetAll.
+func (mr *MockServiceTxMockRecorder) MoviesGetAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoviesGetAll", reflect.TypeOf((*MockServiceTx)(nil).MoviesGetAll), arg0, arg1, arg2)
+}
+
+// PlaybackProgressGet mocks base method.
+func (m *MockServiceTx) PlaybackProgressGet(arg0 context.Context, arg1, arg2 int) (*models.PlaybackProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaybackProgressGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.PlaybackProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaybackProgressGet indicates an expected call of PlaybackProgressGet.
+func (mr *MockServiceTxMockRecorder) PlaybackProgressGet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaybackProgressGet", reflect.TypeOf((*MockServiceTx)(nil).PlaybackProgressGet), arg0, arg1, arg2)
+}
+
+// PlaybackProgressPut mocks base method.
+func (m *MockServiceTx) PlaybackProgressPut(arg0 context.Context, arg1 *models.PlaybackProgress) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaybackProgressPut", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaybackProgressPut indicates an expected call of PlaybackProgressPut.
+func (mr *MockServiceTxMockRecorder) PlaybackProgressPut(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaybackProgressPut", reflect.TypeOf((*MockServiceTx)(nil).PlaybackProgressPut), arg0, arg1)
+}
+
+// ReleaseAuditsCountByFilm mocks base method.
+func (m *MockServiceTx) ReleaseAuditsCountByFilm(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseAuditsCountByFilm", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseAuditsCountByFilm indicates an expected call of ReleaseAuditsCountByFilm.
+func (mr *MockServiceTxMockRecorder) ReleaseAuditsCountByFilm(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseAuditsCountByFilm", reflect.TypeOf((*MockServiceTx)(nil).ReleaseAuditsCountByFilm), arg0, arg1)
+}
+
+// ReleaseAuditsGetAllByFilm mocks base method.
+func (m *MockServiceTx) ReleaseAuditsGetAllByFilm(arg0 context.Context, arg1 int, arg2 query.SortOrderOptions) ([]*models.ReleasesAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseAuditsGetAllByFilm", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.ReleasesAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseAuditsGetAllByFilm indicates an expected call of ReleaseAuditsGetAllByFilm.
+func (mr *MockServiceTxMockRecorder) ReleaseAuditsGetAllByFilm(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseAuditsGetAllByFilm", reflect.TypeOf((*MockServiceTx)(nil).ReleaseAuditsGetAllByFilm), arg0, arg1, arg2)
+}
+
+// ReleasePut mocks base method.
+func (m *MockServiceTx) ReleasePut(arg0 context.Context, arg1, arg2 int, arg3 *models.Release) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleasePut", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleasePut indicates an expected call of ReleasePut.
+func (mr *MockServiceTxMockRecorder) ReleasePut(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleasePut", reflect.TypeOf((*MockServiceTx)(nil).ReleasePut), arg0, arg1, arg2, arg3)
+}
+
+// ReleasesGetAllByFilm mocks base method.
+func (m *MockServiceTx) ReleasesGetAllByFilm(arg0 context.Context, arg1 int) ([]*models.Release, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleasesGetAllByFilm", arg0, arg1)
+	ret0, _ := ret[0].([]*models.Release)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleasesGetAllByFilm indicates an expected call of ReleasesGetAllByFilm.
+func (mr *MockServiceTxMockRecorder) ReleasesGetAllByFilm(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleasesGetAllByFilm", reflect.TypeOf((*MockServiceTx)(nil).ReleasesGetAllByFilm), arg0, arg1)
+}
+
+// ReviewAuditsCount mocks base method.
+func (m *MockServiceTx) ReviewAuditsCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewAuditsCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewAuditsCount indicates an expected call of ReviewAuditsCount.
+func (mr *MockServiceTxMockRecorder) ReviewAuditsCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewAuditsCount", reflect.TypeOf((*MockServiceTx)(nil).ReviewAuditsCount), arg0, arg1)
+}
+
+// ReviewAuditsGetAll mocks base method.
+func (m *MockServiceTx) ReviewAuditsGetAll(arg0 context.Context, arg1 int, arg2 query.SortOrderOptions) ([]*models.ReviewsAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewAuditsGetAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.ReviewsAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewAuditsGetAll indicates an expected call of ReviewAuditsGetAll.
+func (mr *MockServiceTxMockRecorder) ReviewAuditsGetAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewAuditsGetAll", reflect.TypeOf((*MockServiceTx)(nil).ReviewAuditsGetAll), arg0, arg1, arg2)
+}
+
+// ReviewGet mocks base method.
+func (m *MockServiceTx) ReviewGet(arg0 context.Context, arg1 int) (*models.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewGet", arg0, arg1)
+	ret0, _ := ret[0].(*models.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewGet indicates an expected call of ReviewGet.
+func (mr *MockServiceTxMockRecorder) ReviewGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewGet", reflect.TypeOf((*MockServiceTx)(nil).ReviewGet), arg0, arg1)
+}
+
+// ReviewSetStatus mocks base method.
+func (m *MockServiceTx) ReviewSetStatus(arg0 context.Context, arg1 int, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewSetStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReviewSetStatus indicates an expected call of ReviewSetStatus.
+func (mr *MockServiceTxMockRecorder) ReviewSetStatus(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewSetStatus", reflect.TypeOf((*MockServiceTx)(nil).ReviewSetStatus), arg0, arg1, arg2)
+}
+
+// ReviewVoteDelete mocks base method.
+func (m *MockServiceTx) ReviewVoteDelete(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewVoteDelete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReviewVoteDelete indicates an expected call of ReviewVoteDelete.
+func (mr *MockServiceTxMockRecorder) ReviewVoteDelete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewVoteDelete", reflect.TypeOf((*MockServiceTx)(nil).ReviewVoteDelete), arg0, arg1, arg2)
+}
+
+// ReviewVotePut mocks base method.
+func (m *MockServiceTx) ReviewVotePut(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewVotePut", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReviewVotePut indicates an expected call of ReviewVotePut.
+func (mr *MockServiceTxMockRecorder) ReviewVotePut(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewVotePut", reflect.TypeOf((*MockServiceTx)(nil).ReviewVotePut), arg0, arg1, arg2)
+}
+
+// ReviewsCountByFilm mocks base method.
+func (m *MockServiceTx) ReviewsCountByFilm(arg0 context.Context, arg1, arg2 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewsCountByFilm", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewsCountByFilm indicates an expected call of ReviewsCountByFilm.
+func (mr *MockServiceTxMockRecorder) ReviewsCountByFilm(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewsCountByFilm", reflect.TypeOf((*MockServiceTx)(nil).ReviewsCountByFilm), arg0, arg1, arg2)
+}
+
+// ReviewsCountBySeries mocks base method.
+func (m *MockServiceTx) ReviewsCountBySeries(arg0 context.Context, arg1, arg2 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewsCountBySeries", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewsCountBySeries indicates an expected call of ReviewsCountBySeries.
+func (mr *MockServiceTxMockRecorder) ReviewsCountBySeries(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewsCountBySeries", reflect.TypeOf((*MockServiceTx)(nil).ReviewsCountBySeries), arg0, arg1, arg2)
+}
+
+// ReviewsGetAllByFilm mocks base method.
+func (m *MockServiceTx) ReviewsGetAllByFilm(arg0 context.Context, arg1, arg2 int, arg3 query.ReviewOptions) ([]*review.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewsGetAllByFilm", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*review.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewsGetAllByFilm indicates an expected call of ReviewsGetAllByFilm.
+func (mr *MockServiceTxMockRecorder) ReviewsGetAllByFilm(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewsGetAllByFilm", reflect.TypeOf((*MockServiceTx)(nil).ReviewsGetAllByFilm), arg0, arg1, arg2, arg3)
+}
+
+// ReviewsGetAllBySeries mocks base method.
+func (m *MockServiceTx) ReviewsGetAllBySeries(arg0 context.Context, arg1, arg2 int, arg3 query.ReviewOptions) ([]*review.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewsGetAllBySeries", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*review.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewsGetAllBySeries indicates an expected call of ReviewsGetAllBySeries.
+func (mr *MockServiceTxMockRecorder) ReviewsGetAllBySeries(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewsGetAllBySeries", reflect.TypeOf((*MockServiceTx)(nil).ReviewsGetAllBySeries), arg0, arg1, arg2, arg3)
+}
+
+// SeriesAuditsCount mocks base method.
+func (m *MockServiceTx) SeriesAuditsCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesAuditsCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeriesAuditsCount indicates an expected call of SeriesAuditsCount.
+func (mr *MockServiceTxMockRecorder) SeriesAuditsCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesAuditsCount", reflect.TypeOf((*MockServiceTx)(nil).SeriesAuditsCount), arg0, arg1)
+}
+
+// SeriesAuditsGetAll mocks base method.
+func (m *MockServiceTx) SeriesAuditsGetAll(arg0 context.Context, arg1 int, arg2 query.SortOrderOptions) ([]*models.SeriesesAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesAuditsGetAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.SeriesesAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeriesAuditsGetAll indicates an expected call of SeriesAuditsGetAll.
+func (mr *MockServiceTxMockRecorder) SeriesAuditsGetAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesAuditsGetAll", reflect.TypeOf((*MockServiceTx)(nil).SeriesAuditsGetAll), arg0, arg1, arg2)
+}
+
+// SeriesAuditsGetAllByCursor mocks base method.
+func (m *MockServiceTx) SeriesAuditsGetAllByCursor(arg0 context.Context, arg1 query.AuditCursor, arg2 int) ([]*models.SeriesesAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesAuditsGetAllByCursor", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.SeriesesAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeriesAuditsGetAllByCursor indicates an expected call of SeriesAuditsGetAllByCursor.
+func (mr *MockServiceTxMockRecorder) SeriesAuditsGetAllByCursor(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesAuditsGetAllByCursor", reflect.TypeOf((*MockServiceTx)(nil).SeriesAuditsGetAllByCursor), arg0, arg1, arg2)
+}
+
+// SeriesCreate mocks base method.
+func (m *MockServiceTx) SeriesCreate(arg0 context.Context, arg1 int, arg2 *models.Series) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesCreate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeriesCreate indicates an expected call of SeriesCreate.
+func (mr *MockServiceTxMockRecorder) SeriesCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesCreate", reflect.TypeOf((*MockServiceTx)(nil).SeriesCreate), arg0, arg1, arg2)
+}
+
+// SeriesDelete mocks base method.
+func (m *MockServiceTx) SeriesDelete(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesDelete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeriesDelete indicates an expected call of SeriesDelete.
+func (mr *MockServiceTxMockRecorder) SeriesDelete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesDelete", reflect.TypeOf((*MockServiceTx)(nil).SeriesDelete), arg0, arg1)
+}
+
+// SeriesExternalIDGet mocks base method.
+func (m *MockServiceTx) SeriesExternalIDGet(arg0 context.Context, arg1, arg2 string) (*models.ExternalID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesExternalIDGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.ExternalID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeriesExternalIDGet indicates an expected call of SeriesExternalIDGet.
+func (mr *MockServiceTxMockRecorder) SeriesExternalIDGet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesExternalIDGet", reflect.TypeOf((*MockServiceTx)(nil).SeriesExternalIDGet), arg0, arg1, arg2)
+}
+
+// SeriesFollow mocks base method.
+func (m *MockServiceTx) SeriesFollow(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesFollow", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeriesFollow indicates an expected call of SeriesFollow.
+func (mr *MockServiceTxMockRecorder) SeriesFollow(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesFollow", reflect.TypeOf((*MockServiceTx)(nil).SeriesFollow), arg0, arg1, arg2)
+}
+
+// SeriesGet mocks base method.
+func (m *MockServiceTx) SeriesGet(arg0 context.Context, arg1 int) (*models.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesGet", arg0, arg1)
+	ret0, _ := ret[0].(*models.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeriesGet indicates an expected call of SeriesGet.
+func (mr *MockServiceTxMockRecorder) SeriesGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesGet", reflect.TypeOf((*MockServiceTx)(nil).SeriesGet), arg0, arg1)
+}
+
+// SeriesReviewDelete mocks base method.
+func (m *MockServiceTx) SeriesReviewDelete(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesReviewDelete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeriesReviewDelete indicates an expected call of SeriesReviewDelete.
+func (mr *MockServiceTxMockRecorder) SeriesReviewDelete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesReviewDelete", reflect.TypeOf((*MockServiceTx)(nil).SeriesReviewDelete), arg0, arg1, arg2)
+}
+
+// SeriesReviewGet mocks base method.
+func (m *MockServiceTx) SeriesReviewGet(arg0 context.Context, arg1, arg2 int) (*models.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesReviewGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeriesReviewGet indicates an expected call of SeriesReviewGet.
+func (mr *MockServiceTxMockRecorder) SeriesReviewGet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesReviewGet", reflect.TypeOf((*MockServiceTx)(nil).SeriesReviewGet), arg0, arg1, arg2)
+}
+
+// SeriesReviewPut mocks base method.
+func (m *MockServiceTx) SeriesReviewPut(arg0 context.Context, arg1, arg2 int, arg3 *models.Review) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesReviewPut", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeriesReviewPut indicates an expected call of SeriesReviewPut.
+func (mr *MockServiceTxMockRecorder) SeriesReviewPut(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesReviewPut", reflect.TypeOf((*MockServiceTx)(nil).SeriesReviewPut), arg0, arg1, arg2, arg3)
+}
+
+// SeriesScoreDelete mocks base method.
+func (m *MockServiceTx) SeriesScoreDelete(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesScoreDelete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeriesScoreDelete indicates an expected call of SeriesScoreDelete.
+func (mr *MockServiceTxMockRecorder) SeriesScoreDelete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesScoreDelete", reflect.TypeOf((*MockServiceTx)(nil).SeriesScoreDelete), arg0, arg1, arg2)
+}
+
+// SeriesScoreGet mocks base method.
+func (m *MockServiceTx) SeriesScoreGet(arg0 context.Context, arg1, arg2 int) (*models.Score, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesScoreGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Score)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeriesScoreGet indicates an expected call of SeriesScoreGet.
+func (mr *MockServiceTxMockRecorder) SeriesScoreGet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesScoreGet", reflect.TypeOf((*MockServiceTx)(nil).SeriesScoreGet), arg0, arg1, arg2)
+}
+
+// SeriesScorePut mocks base method.
+func (m *MockServiceTx) SeriesScorePut(arg0 context.Context, arg1, arg2, arg3 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesScorePut", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeriesScorePut indicates an expected call of SeriesScorePut.
+func (mr *MockServiceTxMockRecorder) SeriesScorePut(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesScorePut", reflect.TypeOf((*MockServiceTx)(nil).SeriesScorePut), arg0, arg1, arg2, arg3)
+}
+
+// SeriesSetDeletedAt mocks base method.
+func (m *MockServiceTx) SeriesSetDeletedAt(arg0 context.Context, arg1 int, arg2 null.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesSetDeletedAt", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeriesSetDeletedAt indicates an expected call of SeriesSetDeletedAt.
+func (mr *MockServiceTxMockRecorder) SeriesSetDeletedAt(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesSetDeletedAt", reflect.TypeOf((*MockServiceTx)(nil).SeriesSetDeletedAt), arg0, arg1, arg2)
+}
+
+// SeriesUnfollow mocks base method.
+func (m *MockServiceTx) SeriesUnfollow(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesUnfollow", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeriesUnfollow indicates an expected call of SeriesUnfollow.
+func (mr *MockServiceTxMockRecorder) SeriesUnfollow(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesUnfollow", reflect.TypeOf((*MockServiceTx)(nil).SeriesUnfollow), arg0, arg1, arg2)
+}
+
+// SeriesUpdate mocks base method.
+func (m *MockServiceTx) SeriesUpdate(arg0 context.Context, arg1, arg2 int, arg3 map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesUpdate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeriesUpdate indicates an expected call of SeriesUpdate.
+func (mr *MockServiceTxMockRecorder) SeriesUpdate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesUpdate", reflect.TypeOf((*MockServiceTx)(nil).SeriesUpdate), arg0, arg1, arg2, arg3)
+}
+
+// SeriesesCount mocks base method.
+func (m *MockServiceTx) SeriesesCount(arg0 context.Context, arg1 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesesCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeriesesCount indicates an expected call of SeriesesCount.
+func (mr *MockServiceTxMockRecorder) SeriesesCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesesCount", reflect.TypeOf((*MockServiceTx)(nil).SeriesesCount), arg0, arg1)
+}
+
+// SeriesesGetAll mocks base method.
+func (m *MockServiceTx) SeriesesGetAll(arg0 context.Context, arg1 query.Options) ([]*models.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesesGetAll", arg0, arg1)
+	ret0, _ := ret[0].([]*models.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeriesesGetAll indicates an expected call of SeriesesGetAll.
+func (mr *MockServiceTxMockRecorder) SeriesesGetAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesesGetAll", reflect.TypeOf((*MockServiceTx)(nil).SeriesesGetAll), arg0, arg1)
+}
+
+// SeriesesGetAllByCursor mocks base method.
+func (m *MockServiceTx) SeriesesGetAllByCursor(arg0 context.Context, arg1, arg2 int) ([]*models.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesesGetAllByCursor", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeriesesGetAllByCursor indicates an expected call of SeriesesGetAllByCursor.
+func (mr *MockServiceTxMockRecorder) SeriesesGetAllByCursor(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmExists", reflect.TypeOf((*MockServiceTx)(nil).FilmExists), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesesGetAllByCursor", reflect.TypeOf((*MockServiceTx)(nil).SeriesesGetAllByCursor), arg0, arg1, arg2)
 }
 
-// MovieAuditsCount mocks base method.
-func (m *MockServiceTx) MovieAuditsCount(arg0 context.Context, arg1 int) (int, error) {
+// StatsBucketsGet mocks base method.
+func (m *MockServiceTx) StatsBucketsGet(arg0 context.Context, arg1 int, arg2 query.StatsOptions, arg3 string) ([]*stats.Bucket, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MovieAuditsCount", arg0, arg1)
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "StatsBucketsGet", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*stats.Bucket)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// MovieAuditsCount indicates an expected call of MovieAuditsCount.
-func (mr *MockServiceTxMockRecorder) MovieAuditsCount(arg0, arg1 interface{}) *gomock.Call {
+// StatsBucketsGet indicates an expected call of StatsBucketsGet.
+func (mr *MockServiceTxMockRecorder) StatsBucketsGet(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieAuditsCount", reflect.TypeOf((*MockServiceTx)(nil).MovieAuditsCount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatsBucketsGet", reflect.TypeOf((*MockServiceTx)(nil).StatsBucketsGet), arg0, arg1, arg2, arg3)
 }
 
-// MovieAuditsGetAll mocks base method.
-func (m *MockServiceTx) MovieAuditsGetAll(arg0 context.Context, arg1 int, arg2 query.SortOrderOptions) ([]*models.FilmsAudit, error) {
+// StatsGet mocks base method.
+func (m *MockServiceTx) StatsGet(arg0 context.Context, arg1 int, arg2 query.StatsOptions) (*stats.Stats, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MovieAuditsGetAll", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]*models.FilmsAudit)
+	ret := m.ctrl.Call(m, "StatsGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*stats.Stats)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// MovieAuditsGetAll indicates an expected call of MovieAuditsGetAll.
-func (mr *MockServiceTxMockRecorder) MovieAuditsGetAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+// StatsGet indicates an expected call of StatsGet.
+func (mr *MockServiceTxMockRecorder) StatsGet(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieAuditsGetAll", reflect.TypeOf((*MockServiceTx)(nil).MovieAuditsGetAll), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatsGet", reflect.TypeOf((*MockServiceTx)(nil).StatsGet), arg0, arg1, arg2)
 }
 
-// MovieCreate mocks base method.
-func (m *MockServiceTx) MovieCreate(arg0 context.Context, arg1 int, arg2 *models.Film) error {
+// StatsTopFilmsGet mocks base method.
+func (m *MockServiceTx) StatsTopFilmsGet(arg0 context.Context, arg1 int, arg2 query.StatsOptions, arg3 int) ([]*stats.FilmTime, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MovieCreate", arg0, arg1, arg2)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "StatsTopFilmsGet", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*stats.FilmTime)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MovieCreate indicates an expected call of MovieCreate.
-func (mr *MockServiceTxMockRecorder) MovieCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+// StatsTopFilmsGet indicates an expected call of StatsTopFilmsGet.
+func (mr *MockServiceTxMockRecorder) StatsTopFilmsGet(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieCreate", reflect.TypeOf((*MockServiceTx)(nil).MovieCreate), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatsTopFilmsGet", reflect.TypeOf((*MockServiceTx)(nil).StatsTopFilmsGet), arg0, arg1, arg2, arg3)
 }
 
-// MovieGet mocks base method.
-func (m *MockServiceTx) MovieGet(arg0 context.Context, arg1 int) (*models.Film, error) {
+// StatsTopSeriesGet mocks base method.
+func (m *MockServiceTx) StatsTopSeriesGet(arg0 context.Context, arg1 int, arg2 query.StatsOptions, arg3 int) ([]*stats.SeriesTime, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MovieGet", arg0, arg1)
-	ret0, _ := ret[0].(*models.Film)
+	ret := m.ctrl.Call(m, "StatsTopSeriesGet", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*stats.SeriesTime)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// MovieGet indicates an expected call of MovieGet.
-func (mr *MockServiceTxMockRecorder) MovieGet(arg0, arg1 interface{}) *gomock.Call {
+// StatsTopSeriesGet indicates an expected call of StatsTopSeriesGet.
+func (mr *MockServiceTxMockRecorder) StatsTopSeriesGet(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieGet", reflect.TypeOf((*MockServiceTx)(nil).MovieGet), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatsTopSeriesGet", reflect.TypeOf((*MockServiceTx)(nil).StatsTopSeriesGet), arg0, arg1, arg2, arg3)
 }
 
-// MovieUpdate mocks base method.
-func (m *MockServiceTx) MovieUpdate(arg0 context.Context, arg1, arg2 int, arg3 map[string]interface{}) error {
+// TokenCreate mocks base method.
+func (m *MockServiceTx) TokenCreate(arg0 context.Context, arg1 *models.Token) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MovieUpdate", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "TokenCreate", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// MovieUpdate indicates an expected call of MovieUpdate.
-func (mr *MockServiceTxMockRecorder) MovieUpdate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+// TokenCreate indicates an expected call of TokenCreate.
+func (mr *MockServiceTxMockRecorder) TokenCreate(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovieUpdate", reflect.TypeOf((*MockServiceTx)(nil).MovieUpdate), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TokenCreate", reflect.TypeOf((*MockServiceTx)(nil).TokenCreate), arg0, arg1)
 }
 
-// MoviesCount mocks base method.
-func (m *MockServiceTx) MoviesCount(arg0 context.Context) (int, error) {
+// TokenGet mocks base method.
+func (m *MockServiceTx) TokenGet(arg0 context.Context, arg1 int, arg2 string) (*models.Token, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MoviesCount", arg0)
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "TokenGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Token)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// MoviesCount indicates an expected call of MoviesCount.
-func (mr *MockServiceTxMockRecorder) MoviesCount(arg0 interface{}) *gomock.Call {
+// TokenGet indicates an expected call of TokenGet.
+func (mr *MockServiceTxMockRecorder) TokenGet(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoviesCount", reflect.TypeOf((*MockServiceTx)(nil).MoviesCount), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TokenGet", reflect.TypeOf((*MockServiceTx)(nil).TokenGet), arg0, arg1, arg2)
 }
 
-// MoviesGetAll mocks base method.
-func (m *MockServiceTx) MoviesGetAll(arg0 context.Context, arg1 query.Options) ([]*models.Film, error) {
+// TokenUpdate mocks base method.
+func (m *MockServiceTx) TokenUpdate(arg0 context.Context, arg1 int, arg2 map[string]interface{}) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MoviesGetAll", arg0, arg1)
-	ret0, _ := ret[0].([]*models.Film)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "TokenUpdate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MoviesGetAll indicates an expected call of MoviesGetAll.
-func (mr *MockServiceTxMockRecorder) MoviesGetAll(arg0, arg1 interface{}) *gomock.Call {
+// TokenUpdate indicates an expected call of TokenUpdate.
+func (mr *MockServiceTxMockRecorder) TokenUpdate(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoviesGetAll", reflect.TypeOf((*MockServiceTx)(nil).MoviesGetAll), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TokenUpdate", reflect.TypeOf((*MockServiceTx)(nil).TokenUpdate), arg0, arg1, arg2)
 }
 
-// SeriesAuditsCount mocks base method.
-func (m *MockServiceTx) SeriesAuditsCount(arg0 context.Context, arg1 int) (int, error) {
+// TranslationAuditsCountByFilm mocks base method.
+func (m *MockServiceTx) TranslationAuditsCountByFilm(arg0 context.Context, arg1 int) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SeriesAuditsCount", arg0, arg1)
+	ret := m.ctrl.Call(m, "TranslationAuditsCountByFilm", arg0, arg1)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SeriesAuditsCount indicates an expected call of SeriesAuditsCount.
-func (mr *MockServiceTxMockRecorder) SeriesAuditsCount(arg0, arg1 interface{}) *gomock.Call {
+// TranslationAuditsCountByFilm indicates an expected call of TranslationAuditsCountByFilm.
+func (mr *MockServiceTxMockRecorder) TranslationAuditsCountByFilm(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesAuditsCount", reflect.TypeOf((*MockServiceTx)(nil).SeriesAuditsCount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TranslationAuditsCountByFilm", reflect.TypeOf((*MockServiceTx)(nil).TranslationAuditsCountByFilm), arg0, arg1)
 }
 
-// SeriesAuditsGetAll mocks base method.
-func (m *MockServiceTx) SeriesAuditsGetAll(arg0 context.Context, arg1 int, arg2 query.SortOrderOptions) ([]*models.SeriesesAudit, error) {
+// TranslationAuditsCountBySeries mocks base method.
+func (m *MockServiceTx) TranslationAuditsCountBySeries(arg0 context.Context, arg1 int) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SeriesAuditsGetAll", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]*models.SeriesesAudit)
+	ret := m.ctrl.Call(m, "TranslationAuditsCountBySeries", arg0, arg1)
+	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SeriesAuditsGetAll indicates an expected call of SeriesAuditsGetAll.
-func (mr *MockServiceTxMockRecorder) SeriesAuditsGetAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+// TranslationAuditsCountBySeries indicates an expected call of TranslationAuditsCountBySeries.
+func (mr *MockServiceTxMockRecorder) TranslationAuditsCountBySeries(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesAuditsGetAll", reflect.TypeOf((*MockServiceTx)(nil).SeriesAuditsGetAll), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TranslationAuditsCountBySeries", reflect.TypeOf((*MockServiceTx)(nil).TranslationAuditsCountBySeries), arg0, arg1)
 }
 
-// SeriesCreate mocks base method.
-func (m *MockServiceTx) SeriesCreate(arg0 context.Context, arg1 int, arg2 *models.Series) error {
+// TranslationAuditsGetAllByFilm mocks base method.
+func (m *MockServiceTx) TranslationAuditsGetAllByFilm(arg0 context.Context, arg1 int, arg2 query.SortOrderOptions) ([]*models.TranslationsAudit, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SeriesCreate", arg0, arg1, arg2)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "TranslationAuditsGetAllByFilm", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.TranslationsAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// SeriesCreate indicates an expected call of SeriesCreate.
-func (mr *MockServiceTxMockRecorder) SeriesCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+// TranslationAuditsGetAllByFilm indicates an expected call of TranslationAuditsGetAllByFilm.
+func (mr *MockServiceTxMockRecorder) TranslationAuditsGetAllByFilm(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesCreate", reflect.TypeOf((*MockServiceTx)(nil).SeriesCreate), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TranslationAuditsGetAllByFilm", reflect.TypeOf((*MockServiceTx)(nil).TranslationAuditsGetAllByFilm), arg0, arg1, arg2)
 }
 
-// SeriesGet mocks base method.
-func (m *MockServiceTx) SeriesGet(arg0 context.Context, arg1 int) (*models.Series, error) {
+// TranslationAuditsGetAllBySeries mocks base method.
+func (m *MockServiceTx) TranslationAuditsGetAllBySeries(arg0 context.Context, arg1 int, arg2 query.SortOrderOptions) ([]*models.TranslationsAudit, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SeriesGet", arg0, arg1)
-	ret0, _ := ret[0].(*models.Series)
+	ret := m.ctrl.Call(m, "TranslationAuditsGetAllBySeries", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.TranslationsAudit)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SeriesGet indicates an expected call of SeriesGet.
-func (mr *MockServiceTxMockRecorder) SeriesGet(arg0, arg1 interface{}) *gomock.Call {
+// TranslationAuditsGetAllBySeries indicates an expected call of TranslationAuditsGetAllBySeries.
+func (mr *MockServiceTxMockRecorder) TranslationAuditsGetAllBySeries(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesGet", reflect.TypeOf((*MockServiceTx)(nil).SeriesGet), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TranslationAuditsGetAllBySeries", reflect.TypeOf((*MockServiceTx)(nil).TranslationAuditsGetAllBySeries), arg0, arg1, arg2)
 }
 
-// SeriesUpdate mocks base method.
-func (m *MockServiceTx) SeriesUpdate(arg0 context.Context, arg1, arg2 int, arg3 map[string]interface{}) error {
+// TranslationPutByFilm mocks base method.
+func (m *MockServiceTx) TranslationPutByFilm(arg0 context.Context, arg1, arg2 int, arg3 *models.Translation) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SeriesUpdate", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "TranslationPutByFilm", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SeriesUpdate indicates an expected call of SeriesUpdate.
-func (mr *MockServiceTxMockRecorder) SeriesUpdate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+// TranslationPutByFilm indicates an expected call of TranslationPutByFilm.
+func (mr *MockServiceTxMockRecorder) TranslationPutByFilm(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesUpdate", reflect.TypeOf((*MockServiceTx)(nil).SeriesUpdate), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TranslationPutByFilm", reflect.TypeOf((*MockServiceTx)(nil).TranslationPutByFilm), arg0, arg1, arg2, arg3)
 }
 
-// SeriesesCount mocks base method.
-func (m *MockServiceTx) SeriesesCount(arg0 context.Context) (int, error) {
+// TranslationPutBySeries mocks base method.
+func (m *MockServiceTx) TranslationPutBySeries(arg0 context.Context, arg1, arg2 int, arg3 *models.Translation) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SeriesesCount", arg0)
-	ret0, _ := ret[0].(int)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "TranslationPutBySeries", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// SeriesesCount indicates an expected call of SeriesesCount.
-func (mr *MockServiceTxMockRecorder) SeriesesCount(arg0 interface{}) *gomock.Call {
+// TranslationPutBySeries indicates an expected call of TranslationPutBySeries.
+func (mr *MockServiceTxMockRecorder) TranslationPutBySeries(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesesCount", reflect.TypeOf((*MockServiceTx)(nil).SeriesesCount), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TranslationPutBySeries", reflect.TypeOf((*MockServiceTx)(nil).TranslationPutBySeries), arg0, arg1, arg2, arg3)
 }
 
-// SeriesesGetAll mocks base method.
-func (m *MockServiceTx) SeriesesGetAll(arg0 context.Context, arg1 query.Options) ([]*models.Series, error) {
+// TranslationsGetAllByFilm mocks base method.
+func (m *MockServiceTx) TranslationsGetAllByFilm(arg0 context.Context, arg1 int) ([]*models.Translation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SeriesesGetAll", arg0, arg1)
-	ret0, _ := ret[0].([]*models.Series)
+	ret := m.ctrl.Call(m, "TranslationsGetAllByFilm", arg0, arg1)
+	ret0, _ := ret[0].([]*models.Translation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SeriesesGetAll indicates an expected call of SeriesesGetAll.
-func (mr *MockServiceTxMockRecorder) SeriesesGetAll(arg0, arg1 interface{}) *gomock.Call {
+// TranslationsGetAllByFilm indicates an expected call of TranslationsGetAllByFilm.
+func (mr *MockServiceTxMockRecorder) TranslationsGetAllByFilm(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesesGetAll", reflect.TypeOf((*MockServiceTx)(nil).SeriesesGetAll), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TranslationsGetAllByFilm", reflect.TypeOf((*MockServiceTx)(nil).TranslationsGetAllByFilm), arg0, arg1)
 }
 
-// TokenCreate mocks base method.
-func (m *MockServiceTx) TokenCreate(arg0 context.Context, arg1 *models.Token) error {
+// TranslationsGetAllByFilms mocks base method.
+func (m *MockServiceTx) TranslationsGetAllByFilms(arg0 context.Context, arg1 []int, arg2 []string) ([]*models.Translation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TokenCreate", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "TranslationsGetAllByFilms", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// TokenCreate indicates an expected call of TokenCreate.
-func (mr *MockServiceTxMockRecorder) TokenCreate(arg0, arg1 interface{}) *gomock.Call {
+// TranslationsGetAllByFilms indicates an expected call of TranslationsGetAllByFilms.
+func (mr *MockServiceTxMockRecorder) TranslationsGetAllByFilms(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TokenCreate", reflect.TypeOf((*MockServiceTx)(nil).TokenCreate), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TranslationsGetAllByFilms", reflect.TypeOf((*MockServiceTx)(nil).TranslationsGetAllByFilms), arg0, arg1, arg2)
 }
 
-// TokenGet mocks base method.
-func (m *MockServiceTx) TokenGet(arg0 context.Context, arg1 int, arg2 string) (*models.Token, error) {
+// TranslationsGetAllBySeries mocks base method.
+func (m *MockServiceTx) TranslationsGetAllBySeries(arg0 context.Context, arg1 int) ([]*models.Translation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TokenGet", arg0, arg1, arg2)
-	ret0, _ := ret[0].(*models.Token)
+	ret := m.ctrl.Call(m, "TranslationsGetAllBySeries", arg0, arg1)
+	ret0, _ := ret[0].([]*models.Translation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// TokenGet indicates an expected call of TokenGet.
-func (mr *MockServiceTxMockRecorder) TokenGet(arg0, arg1, arg2 interface{}) *gomock.Call {
+// TranslationsGetAllBySeries indicates an expected call of TranslationsGetAllBySeries.
+func (mr *MockServiceTxMockRecorder) TranslationsGetAllBySeries(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TokenGet", reflect.TypeOf((*MockServiceTx)(nil).TokenGet), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TranslationsGetAllBySeries", reflect.TypeOf((*MockServiceTx)(nil).TranslationsGetAllBySeries), arg0, arg1)
 }
 
-// TokenUpdate mocks base method.
-func (m *MockServiceTx) TokenUpdate(arg0 context.Context, arg1 int, arg2 map[string]interface{}) error {
+// TranslationsGetAllBySerieses mocks base method.
+func (m *MockServiceTx) TranslationsGetAllBySerieses(arg0 context.Context, arg1 []int, arg2 []string) ([]*models.Translation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TokenUpdate", arg0, arg1, arg2)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "TranslationsGetAllBySerieses", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// TokenUpdate indicates an expected call of TokenUpdate.
-func (mr *MockServiceTxMockRecorder) TokenUpdate(arg0, arg1, arg2 interface{}) *gomock.Call {
+// TranslationsGetAllBySerieses indicates an expected call of TranslationsGetAllBySerieses.
+func (mr *MockServiceTxMockRecorder) TranslationsGetAllBySerieses(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TokenUpdate", reflect.TypeOf((*MockServiceTx)(nil).TokenUpdate), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TranslationsGetAllBySerieses", reflect.TypeOf((*MockServiceTx)(nil).TranslationsGetAllBySerieses), arg0, arg1, arg2)
 }
 
 // Tx mocks base method.
@@ -463,6 +2444,36 @@ func (mr *MockServiceTxMockRecorder) Tx(arg0, arg1, arg2 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tx", reflect.TypeOf((*MockServiceTx)(nil).Tx), arg0, arg1, arg2)
 }
 
+// UpNextCount mocks base method.
+func (m *MockServiceTx) UpNextCount(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpNextCount", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpNextCount indicates an expected call of UpNextCount.
+func (mr *MockServiceTxMockRecorder) UpNextCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpNextCount", reflect.TypeOf((*MockServiceTx)(nil).UpNextCount), arg0, arg1)
+}
+
+// UpNextGet mocks base method.
+func (m *MockServiceTx) UpNextGet(arg0 context.Context, arg1, arg2, arg3 int) ([]*watchlist.UpNext, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpNextGet", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*watchlist.UpNext)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpNextGet indicates an expected call of UpNextGet.
+func (mr *MockServiceTxMockRecorder) UpNextGet(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpNextGet", reflect.TypeOf((*MockServiceTx)(nil).UpNextGet), arg0, arg1, arg2, arg3)
+}
+
 // UserCreate mocks base method.
 func (m *MockServiceTx) UserCreate(arg0 context.Context, arg1 *models.User) error {
 	m.ctrl.T.Helper()
@@ -550,6 +2561,64 @@ func (mr *MockServiceTxMockRecorder) UsersCount(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsersCount", reflect.TypeOf((*MockServiceTx)(nil).UsersCount), arg0)
 }
 
+// WatchEventCreate mocks base method.
+func (m *MockServiceTx) WatchEventCreate(arg0 context.Context, arg1, arg2 int, arg3 *models.WatchEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchEventCreate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchEventCreate indicates an expected call of WatchEventCreate.
+func (mr *MockServiceTxMockRecorder) WatchEventCreate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchEventCreate", reflect.TypeOf((*MockServiceTx)(nil).WatchEventCreate), arg0, arg1, arg2, arg3)
+}
+
+// WatchEventUndo mocks base method.
+func (m *MockServiceTx) WatchEventUndo(arg0 context.Context, arg1, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchEventUndo", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchEventUndo indicates an expected call of WatchEventUndo.
+func (mr *MockServiceTxMockRecorder) WatchEventUndo(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchEventUndo", reflect.TypeOf((*MockServiceTx)(nil).WatchEventUndo), arg0, arg1, arg2)
+}
+
+// WatchEventsCount mocks base method.
+func (m *MockServiceTx) WatchEventsCount(arg0 context.Context, arg1, arg2 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchEventsCount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchEventsCount indicates an expected call of WatchEventsCount.
+func (mr *MockServiceTxMockRecorder) WatchEventsCount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchEventsCount", reflect.TypeOf((*MockServiceTx)(nil).WatchEventsCount), arg0, arg1, arg2)
+}
+
+// WatchEventsGetAll mocks base method.
+func (m *MockServiceTx) WatchEventsGetAll(arg0 context.Context, arg1, arg2 int, arg3 query.SortOrderOptions) ([]*models.WatchEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchEventsGetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*models.WatchEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchEventsGetAll indicates an expected call of WatchEventsGetAll.
+func (mr *MockServiceTxMockRecorder) WatchEventsGetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchEventsGetAll", reflect.TypeOf((*MockServiceTx)(nil).WatchEventsGetAll), arg0, arg1, arg2, arg3)
+}
+
 // WatchlistAdd mocks base method.
 func (m *MockServiceTx) WatchlistAdd(arg0 context.Context, arg1, arg2 int) (int, error) {
 	m.ctrl.T.Helper()
@@ -565,19 +2634,34 @@ func (mr *MockServiceTxMockRecorder) WatchlistAdd(arg0, arg1, arg2 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchlistAdd", reflect.TypeOf((*MockServiceTx)(nil).WatchlistAdd), arg0, arg1, arg2)
 }
 
+// WatchlistAddAll mocks base method.
+func (m *MockServiceTx) WatchlistAddAll(arg0 context.Context, arg1 int, arg2 []int) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchlistAddAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchlistAddAll indicates an expected call of WatchlistAddAll.
+func (mr *MockServiceTxMockRecorder) WatchlistAddAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchlistAddAll", reflect.TypeOf((*MockServiceTx)(nil).WatchlistAddAll), arg0, arg1, arg2)
+}
+
 // WatchlistCount mocks base method.
-func (m *MockServiceTx) WatchlistCount(arg0 context.Context, arg1 int, arg2 string) (int, error) {
+func (m *MockServiceTx) WatchlistCount(arg0 context.Context, arg1 int, arg2 string, arg3 query.ReleaseOptions, arg4 query.WatchedOptions, arg5 query.WatchlistItemOptions) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WatchlistCount", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "WatchlistCount", arg0, arg1, arg2, arg3, arg4, arg5)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // WatchlistCount indicates an expected call of WatchlistCount.
-func (mr *MockServiceTxMockRecorder) WatchlistCount(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockServiceTxMockRecorder) WatchlistCount(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchlistCount", reflect.TypeOf((*MockServiceTx)(nil).WatchlistCount), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchlistCount", reflect.TypeOf((*MockServiceTx)(nil).WatchlistCount), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
 // WatchlistDelete mocks base method.
@@ -609,6 +2693,49 @@ func (mr *MockServiceTxMockRecorder) WatchlistGet(arg0, arg1, arg2 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchlistGet", reflect.TypeOf((*MockServiceTx)(nil).WatchlistGet), arg0, arg1, arg2)
 }
 
+// WatchlistGetIDByFilm mocks base method.
+func (m *MockServiceTx) WatchlistGetIDByFilm(arg0 context.Context, arg1, arg2 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchlistGetIDByFilm", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchlistGetIDByFilm indicates an expected call of WatchlistGetIDByFilm.
+func (mr *MockServiceTxMockRecorder) WatchlistGetIDByFilm(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchlistGetIDByFilm", reflect.TypeOf((*MockServiceTx)(nil).WatchlistGetIDByFilm), arg0, arg1, arg2)
+}
+
+// WatchlistItemUpdate mocks base method.
+func (m *MockServiceTx) WatchlistItemUpdate(arg0 context.Context, arg1, arg2 int, arg3 map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchlistItemUpdate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchlistItemUpdate indicates an expected call of WatchlistItemUpdate.
+func (mr *MockServiceTxMockRecorder) WatchlistItemUpdate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchlistItemUpdate", reflect.TypeOf((*MockServiceTx)(nil).WatchlistItemUpdate), arg0, arg1, arg2, arg3)
+}
+
+// WatchlistMove mocks base method.
+func (m *MockServiceTx) WatchlistMove(arg0 context.Context, arg1, arg2 int, arg3 null.Int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchlistMove", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchlistMove indicates an expected call of WatchlistMove.
+func (mr *MockServiceTxMockRecorder) WatchlistMove(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchlistMove", reflect.TypeOf((*MockServiceTx)(nil).WatchlistMove), arg0, arg1, arg2, arg3)
+}
+
 // WatchlistSetWatched mocks base method.
 func (m *MockServiceTx) WatchlistSetWatched(arg0 context.Context, arg1, arg2 int) error {
 	m.ctrl.T.Helper()
@@ -622,3 +2749,46 @@ func (mr *MockServiceTxMockRecorder) WatchlistSetWatched(arg0, arg1, arg2 interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchlistSetWatched", reflect.TypeOf((*MockServiceTx)(nil).WatchlistSetWatched), arg0, arg1, arg2)
 }
+
+// WatchlistTagsSet mocks base method.
+func (m *MockServiceTx) WatchlistTagsSet(arg0 context.Context, arg1, arg2 int, arg3 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchlistTagsSet", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchlistTagsSet indicates an expected call of WatchlistTagsSet.
+func (mr *MockServiceTxMockRecorder) WatchlistTagsSet(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchlistTagsSet", reflect.TypeOf((*MockServiceTx)(nil).WatchlistTagsSet), arg0, arg1, arg2, arg3)
+}
+
+// YearReviewGet mocks base method.
+func (m *MockServiceTx) YearReviewGet(arg0 context.Context, arg1, arg2 int, arg3 string) (*models.YearReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "YearReviewGet", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*models.YearReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// YearReviewGet indicates an expected call of YearReviewGet.
+func (mr *MockServiceTxMockRecorder) YearReviewGet(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "YearReviewGet", reflect.TypeOf((*MockServiceTx)(nil).YearReviewGet), arg0, arg1, arg2, arg3)
+}
+
+// YearReviewPut mocks base method.
+func (m *MockServiceTx) YearReviewPut(arg0 context.Context, arg1 *models.YearReview) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "YearReviewPut", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// YearReviewPut indicates an expected call of YearReviewPut.
+func (mr *MockServiceTxMockRecorder) YearReviewPut(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "YearReviewPut", reflect.TypeOf((*MockServiceTx)(nil).YearReviewPut), arg0, arg1)
+}
@@ -0,0 +1,191 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// whereFilmHidden matches the films to hide if deletedAt is valid or else the
+// hidden films to restore
+func whereFilmHidden(deletedAt null.Time) qm.QueryMod {
+	if deletedAt.Valid {
+		return models.FilmWhere.DeletedAt.IsNull()
+	}
+	return models.FilmWhere.DeletedAt.IsNotNull()
+}
+
+// MovieSetDeletedAt hides the visible movie if deletedAt is valid or else
+// restores the hidden movie. the contributor is kept: the moderator is only
+// recorded as the audit actor
+func (repo *Repository) MovieSetDeletedAt(
+	ctx context.Context,
+	movieID int,
+	deletedAt null.Time,
+) error {
+	rowsAff, err := models.Films(
+		models.FilmWhere.ID.EQ(movieID),
+		models.FilmWhere.SeriesID.IsNull(),
+		models.FilmWhere.SeasonNumber.IsNull(),
+		models.FilmWhere.EpisodeNumber.IsNull(),
+		whereFilmHidden(deletedAt),
+	).UpdateAll(
+		ctx,
+		repo.exec,
+		map[string]any{
+			models.FilmColumns.DeletedAt: deletedAt,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+// EpisodeSetDeletedAt hides the visible episode if deletedAt is valid or else
+// restores the hidden episode
+func (repo *Repository) EpisodeSetDeletedAt(
+	ctx context.Context,
+	seriesID, seasonNumber, episodeNumber int,
+	deletedAt null.Time,
+) error {
+	rowsAff, err := models.Films(
+		models.FilmWhere.SeriesID.EQ(null.IntFrom(seriesID)),
+		models.FilmWhere.SeasonNumber.EQ(null.IntFrom(seasonNumber)),
+		models.FilmWhere.EpisodeNumber.EQ(null.IntFrom(episodeNumber)),
+		whereFilmHidden(deletedAt),
+	).UpdateAll(
+		ctx,
+		repo.exec,
+		map[string]any{
+			models.FilmColumns.DeletedAt: deletedAt,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return repo.seriesAggregatesRefresh(ctx, seriesID)
+}
+
+// SeriesSetDeletedAt hides the visible series along with its visible episodes
+// if deletedAt is valid or else restores the hidden series along with the
+// episodes hidden by it, leaving the episodes hidden on their own hidden.
+// it must be called in a transaction.
+func (repo *Repository) SeriesSetDeletedAt(
+	ctx context.Context,
+	seriesID int,
+	deletedAt null.Time,
+) error {
+	seriesHidden := models.SeriesWhere.DeletedAt.IsNull()
+	if !deletedAt.Valid {
+		seriesHidden = models.SeriesWhere.DeletedAt.IsNotNull()
+	}
+	series, err := models.Serieses(
+		models.SeriesWhere.ID.EQ(seriesID),
+		seriesHidden,
+	).One(ctx, repo.exec)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrNoRecord
+		}
+		return err
+	}
+	// episodes hidden along with the series share its deletion time
+	_, err = models.Films(
+		models.FilmWhere.SeriesID.EQ(null.IntFrom(seriesID)),
+		models.FilmWhere.DeletedAt.EQ(series.DeletedAt),
+	).UpdateAll(
+		ctx,
+		repo.exec,
+		map[string]any{
+			models.FilmColumns.DeletedAt: deletedAt,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	_, err = models.Serieses(
+		models.SeriesWhere.ID.EQ(seriesID),
+	).UpdateAll(
+		ctx,
+		repo.exec,
+		map[string]any{
+			models.SeriesColumns.DeletedAt: deletedAt,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	return repo.seriesAggregatesRefresh(ctx, seriesID)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// MovieDelete deletes the movie whether hidden or not. the rows referencing
+// the movie are deleted by cascade
+func (repo *Repository) MovieDelete(ctx context.Context, movieID int) error {
+	rowsAff, err := models.Films(
+		models.FilmWhere.ID.EQ(movieID),
+		models.FilmWhere.SeriesID.IsNull(),
+		models.FilmWhere.SeasonNumber.IsNull(),
+		models.FilmWhere.EpisodeNumber.IsNull(),
+	).DeleteAll(ctx, repo.exec)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+// EpisodeDelete deletes the episode whether hidden or not. the rows
+// referencing the episode are deleted by cascade
+func (repo *Repository) EpisodeDelete(
+	ctx context.Context,
+	seriesID, seasonNumber, episodeNumber int,
+) error {
+	rowsAff, err := models.Films(
+		models.FilmWhere.SeriesID.EQ(null.IntFrom(seriesID)),
+		models.FilmWhere.SeasonNumber.EQ(null.IntFrom(seasonNumber)),
+		models.FilmWhere.EpisodeNumber.EQ(null.IntFrom(episodeNumber)),
+	).DeleteAll(ctx, repo.exec)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return repo.seriesAggregatesRefresh(ctx, seriesID)
+}
+
+// SeriesDelete deletes the series along with its episodes whether hidden or
+// not. episodes do not cascade on their series so they are deleted first.
+// it must be called in a transaction.
+func (repo *Repository) SeriesDelete(ctx context.Context, seriesID int) error {
+	_, err := models.Films(
+		models.FilmWhere.SeriesID.EQ(null.IntFrom(seriesID)),
+	).DeleteAll(ctx, repo.exec)
+	if err != nil {
+		return err
+	}
+	rowsAff, err := models.Serieses(
+		models.SeriesWhere.ID.EQ(seriesID),
+	).DeleteAll(ctx, repo.exec)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+package repo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestMovieModeration(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "user"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	moderator := &models.User{Email: "moderator"}
+	err = r.UserCreate(ctx, moderator)
+	require.NoError(err)
+
+	movie := &models.Film{Title: "title", DateReleased: testutils.Date(2000, 1, 1)}
+	err = r.MovieCreate(ctx, user.ID, movie)
+	require.NoError(err)
+	_, err = r.WatchlistAdd(ctx, user.ID, movie.ID)
+	require.NoError(err)
+
+	// restoring a visible movie is not found
+	err = r.MovieSetDeletedAt(ctx, movie.ID, null.Time{})
+	require.Equal(repo.ErrNoRecord, err)
+
+	// hide the movie
+	err = r.MovieSetDeletedAt(
+		ctx,
+		movie.ID,
+		null.TimeFrom(time.Now()),
+	)
+	require.NoError(err)
+
+	// the hidden movie is not found nor listed
+	_, err = r.MovieGet(ctx, movie.ID)
+	require.Equal(repo.ErrNoRecord, err)
+	count, err := r.MoviesCount(ctx, query.InvalidationInclude, query.ReleaseOptions{})
+	require.NoError(err)
+	require.Equal(0, count)
+	err = r.FilmExists(ctx, movie.ID)
+	require.Equal(repo.ErrNoRecord, err)
+
+	// the hidden movie is kept in the watchlist
+	count, err = r.WatchlistCount(
+		ctx,
+		user.ID,
+		repo.RawSqlWhereTimeWatchedEmptyClause,
+		query.ReleaseOptions{},
+		query.WatchedOptions{},
+		query.WatchlistItemOptions{},
+	)
+	require.NoError(err)
+	require.Equal(1, count)
+
+	// hiding a hidden movie is not found
+	err = r.MovieSetDeletedAt(
+		ctx,
+		movie.ID,
+		null.TimeFrom(time.Now()),
+	)
+	require.Equal(repo.ErrNoRecord, err)
+
+	// restore the movie: the moderator is the audit actor only
+	err = r.Tx(
+		repo.WithActor(ctx, moderator.ID),
+		nil,
+		func(ctx context.Context, tx repo.Service) error {
+			return tx.MovieSetDeletedAt(ctx, movie.ID, null.Time{})
+		},
+	)
+	require.NoError(err)
+	gotMovie, err := r.MovieGet(ctx, movie.ID)
+	require.NoError(err)
+	require.False(gotMovie.DeletedAt.Valid)
+	require.Equal(user.ID, gotMovie.ContributedBy)
+	audits, err := r.MovieAuditsGetAll(
+		ctx,
+		movie.ID,
+		query.SortOrderOptions{SortOrder: "desc", Limit: 1},
+	)
+	require.NoError(err)
+	require.Equal(1, len(audits))
+	require.Equal(user.ID, audits[0].ContributedBy)
+	require.Equal(null.IntFrom(moderator.ID), audits[0].AuditActor)
+
+	// delete the movie along with its watchlist items
+	err = r.MovieDelete(ctx, movie.ID)
+	require.NoError(err)
+	err = r.MovieDelete(ctx, movie.ID)
+	require.Equal(repo.ErrNoRecord, err)
+	count, err = r.WatchlistCount(
+		ctx,
+		user.ID,
+		repo.RawSqlWhereTimeWatchedEmptyClause,
+		query.ReleaseOptions{},
+		query.WatchedOptions{},
+		query.WatchlistItemOptions{},
+	)
+	require.NoError(err)
+	require.Equal(0, count)
+}
+
+func TestSeriesModeration(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	moderator := &models.User{Email: "moderator"}
+	err := r.UserCreate(ctx, moderator)
+	require.NoError(err)
+
+	series := &models.Series{Title: "series"}
+	err = r.SeriesCreate(ctx, moderator.ID, series)
+	require.NoError(err)
+	for episodeNumber := 1; episodeNumber <= 3; episodeNumber++ {
+		err = r.EpisodePut(
+			ctx,
+			series.ID, 1, episodeNumber,
+			moderator.ID,
+			&models.Film{Title: "episode"},
+		)
+		require.NoError(err)
+	}
+
+	// hide episode 3 on its own
+	err = r.EpisodeSetDeletedAt(
+		ctx,
+		series.ID, 1, 3,
+		null.TimeFrom(time.Now()),
+	)
+	require.NoError(err)
+	_, err = r.EpisodeGet(ctx, series.ID, 1, 3)
+	require.Equal(repo.ErrNoRecord, err)
+
+	// putting the hidden episode keeps it hidden
+	err = r.EpisodePut(
+		ctx,
+		series.ID, 1, 3,
+		moderator.ID,
+		&models.Film{Title: "new episode"},
+	)
+	require.NoError(err)
+	_, err = r.EpisodeGet(ctx, series.ID, 1, 3)
+	require.Equal(repo.ErrNoRecord, err)
+
+	// hide the series along with its visible episodes
+	err = r.SeriesSetDeletedAt(
+		ctx,
+		series.ID,
+		null.TimeFrom(time.Now()),
+	)
+	require.NoError(err)
+	_, err = r.SeriesGet(ctx, series.ID)
+	require.Equal(repo.ErrNoRecord, err)
+	count, err := r.EpisodesCountBySeries(ctx, series.ID)
+	require.NoError(err)
+	require.Equal(0, count)
+
+	// restore the series along with the episodes hidden by it
+	err = r.SeriesSetDeletedAt(ctx, series.ID, null.Time{})
+	require.NoError(err)
+	_, err = r.SeriesGet(ctx, series.ID)
+	require.NoError(err)
+	count, err = r.EpisodesCountBySeries(ctx, series.ID)
+	require.NoError(err)
+	require.Equal(2, count)
+	_, err = r.EpisodeGet(ctx, series.ID, 1, 3)
+	require.Equal(repo.ErrNoRecord, err)
+
+	// restoring a visible series is not found
+	err = r.SeriesSetDeletedAt(ctx, series.ID, null.Time{})
+	require.Equal(repo.ErrNoRecord, err)
+
+	// delete an episode
+	err = r.EpisodeDelete(ctx, series.ID, 1, 1)
+	require.NoError(err)
+	err = r.EpisodeDelete(ctx, series.ID, 1, 1)
+	require.Equal(repo.ErrNoRecord, err)
+
+	// delete the series along with its visible and hidden episodes
+	err = r.SeriesDelete(ctx, series.ID)
+	require.NoError(err)
+	err = r.SeriesDelete(ctx, series.ID)
+	require.Equal(repo.ErrNoRecord, err)
+	films, err := models.Films().Count(ctx, db)
+	require.NoError(err)
+	require.Equal(int64(0), films)
+}
@@ -19,6 +19,8 @@ func (repo *Repository) MovieGet(
 		models.FilmWhere.SeriesID.IsNull(),
 		models.FilmWhere.SeasonNumber.IsNull(),
 		models.FilmWhere.EpisodeNumber.IsNull(),
+		models.FilmWhere.DeletedAt.IsNull(),
+		loadFilmScoreAggregates,
 	).One(ctx, repo.exec)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -32,14 +34,35 @@ func (repo *Repository) MovieGet(
 func (repo *Repository) MoviesGetAll(
 	ctx context.Context,
 	queryOptions query.Options,
+	releaseOptions query.ReleaseOptions,
 ) ([]*models.Film, error) {
+	mods := append(
+		[]qm.QueryMod{
+			models.FilmWhere.SeriesID.IsNull(),
+			models.FilmWhere.SeasonNumber.IsNull(),
+			models.FilmWhere.EpisodeNumber.IsNull(),
+			models.FilmWhere.DeletedAt.IsNull(),
+		},
+		whereRelease(releaseOptions)...,
+	)
+	mods = append(
+		mods,
+		whereInvalidation(
+			models.FilmTableColumns.Invalidation,
+			queryOptions.Invalidation,
+		)...,
+	)
+	// the movies are sortable by their score aggregates
 	movies, err := models.Films(
-		models.FilmWhere.SeriesID.IsNull(),
-		models.FilmWhere.SeasonNumber.IsNull(),
-		models.FilmWhere.EpisodeNumber.IsNull(),
-		qm.Offset(queryOptions.Offset),
-		qm.Limit(queryOptions.Limit),
-		qm.OrderBy(queryOptions.SortField+" "+queryOptions.SortOrder),
+		append(
+			mods,
+			qm.Select(models.TableNames.Films+".*"),
+			joinFilmScoreAggregates,
+			loadFilmScoreAggregates,
+			qm.Offset(queryOptions.Offset),
+			qm.Limit(queryOptions.Limit),
+			orderByScoreAware(queryOptions.SortField, queryOptions.SortOrder),
+		)...,
 	).All(ctx, repo.exec)
 	if err != nil {
 		return nil, err
@@ -47,11 +70,28 @@ func (repo *Repository) MoviesGetAll(
 	return movies, nil
 }
 
-func (repo *Repository) MoviesCount(ctx context.Context) (int, error) {
+func (repo *Repository) MoviesCount(
+	ctx context.Context,
+	invalidation string,
+	releaseOptions query.ReleaseOptions,
+) (int, error) {
+	mods := append(
+		[]qm.QueryMod{
+			models.FilmWhere.SeriesID.IsNull(),
+			models.FilmWhere.SeasonNumber.IsNull(),
+			models.FilmWhere.EpisodeNumber.IsNull(),
+			models.FilmWhere.DeletedAt.IsNull(),
+		},
+		whereRelease(releaseOptions)...,
+	)
 	nMovies, err := models.Films(
-		models.FilmWhere.SeriesID.IsNull(),
-		models.FilmWhere.SeasonNumber.IsNull(),
-		models.FilmWhere.EpisodeNumber.IsNull(),
+		append(
+			mods,
+			whereInvalidation(
+				models.FilmTableColumns.Invalidation,
+				invalidation,
+			)...,
+		)...,
 	).Count(ctx, repo.exec)
 	return int(nMovies), err
 }
@@ -77,6 +117,7 @@ func (repo *Repository) MovieUpdate(
 		models.FilmWhere.SeriesID.IsNull(),
 		models.FilmWhere.SeasonNumber.IsNull(),
 		models.FilmWhere.EpisodeNumber.IsNull(),
+		models.FilmWhere.DeletedAt.IsNull(),
 	).UpdateAll(ctx, repo.exec, cols)
 	if err != nil {
 		return err
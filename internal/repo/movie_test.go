@@ -52,6 +52,7 @@ func TestMovieGet(t *testing.T) {
 		&movie.DateReleased,
 		fetchedMovie.DateReleased.Location(),
 	)
+	movie.R = fetchedMovie.R
 
 	require.Equal(movie, fetchedMovie)
 }
@@ -78,7 +79,7 @@ func TestMoviesGetAll(t *testing.T) {
 
 	// first there's no movie
 
-	fetchedMovies, err := r.MoviesGetAll(ctx, queryOptions)
+	fetchedMovies, err := r.MoviesGetAll(ctx, queryOptions, query.ReleaseOptions{})
 	require.NoError(err)
 	require.Equal(0, len(fetchedMovies))
 
@@ -114,7 +115,7 @@ func TestMoviesGetAll(t *testing.T) {
 
 	// fetch movies
 
-	fetchedMovies, err = r.MoviesGetAll(ctx, queryOptions)
+	fetchedMovies, err = r.MoviesGetAll(ctx, queryOptions, query.ReleaseOptions{})
 	require.NoError(err)
 	require.Equal(len(movies), len(fetchedMovies))
 
@@ -124,6 +125,7 @@ func TestMoviesGetAll(t *testing.T) {
 			&movies[i].DateReleased,
 			fm.DateReleased.Location(),
 		)
+		movies[i].R = fm.R
 		require.Equal(movies[i], fm)
 	}
 }
@@ -143,7 +145,11 @@ func TestMoviesCount(t *testing.T) {
 
 	// first there's no movie
 
-	nMovies, err := r.MoviesCount(ctx)
+	nMovies, err := r.MoviesCount(
+		ctx,
+		query.InvalidationInclude,
+		query.ReleaseOptions{},
+	)
 	require.NoError(err)
 	require.Equal(0, nMovies)
 
@@ -164,11 +170,81 @@ func TestMoviesCount(t *testing.T) {
 
 	// count movies
 
-	nMovies, err = r.MoviesCount(ctx)
+	nMovies, err = r.MoviesCount(
+		ctx,
+		query.InvalidationInclude,
+		query.ReleaseOptions{},
+	)
 	require.NoError(err)
 	require.Equal(len(movies), nMovies)
 }
 
+func TestMoviesGetAllByInvalidation(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+
+	// insert a valid and an invalidated movie
+	valid := &models.Film{Title: "valid"}
+	err = r.MovieCreate(ctx, user.ID, valid)
+	require.NoError(err)
+	invalidated := &models.Film{Title: "invalidated"}
+	err = r.MovieCreate(ctx, user.ID, invalidated)
+	require.NoError(err)
+	err = r.MovieUpdate(ctx, invalidated.ID, user.ID, map[string]any{
+		models.FilmColumns.Invalidation: "invalidation",
+	})
+	require.NoError(err)
+
+	testCases := []struct {
+		invalidation string
+		expIDs       []int
+	}{
+		{invalidation: query.InvalidationExclude, expIDs: []int{valid.ID}},
+		{invalidation: query.InvalidationOnly, expIDs: []int{invalidated.ID}},
+		{
+			invalidation: query.InvalidationInclude,
+			expIDs:       []int{valid.ID, invalidated.ID},
+		},
+	}
+
+	for _, tc := range testCases {
+		fetchedMovies, err := r.MoviesGetAll(
+			ctx,
+			query.Options{
+				Offset:       0,
+				Limit:        math.MaxInt,
+				SortField:    models.FilmColumns.ID,
+				SortOrder:    "asc",
+				Invalidation: tc.invalidation,
+			},
+			query.ReleaseOptions{},
+		)
+		require.NoError(err, tc.invalidation)
+		ids := make([]int, len(fetchedMovies))
+		for i, fm := range fetchedMovies {
+			ids[i] = fm.ID
+		}
+		require.Equal(tc.expIDs, ids, tc.invalidation)
+
+		nMovies, err := r.MoviesCount(
+			ctx,
+			tc.invalidation,
+			query.ReleaseOptions{},
+		)
+		require.NoError(err, tc.invalidation)
+		require.Equal(len(tc.expIDs), nMovies, tc.invalidation)
+	}
+}
+
 func TestMovieCreate(t *testing.T) {
 	require := require.New(t)
 
@@ -189,7 +265,11 @@ func TestMovieCreate(t *testing.T) {
 
 	// first there's no movie
 
-	nMovies, err := r.MoviesCount(ctx)
+	nMovies, err := r.MoviesCount(
+		ctx,
+		query.InvalidationInclude,
+		query.ReleaseOptions{},
+	)
 	require.NoError(err)
 	require.Equal(0, nMovies)
 
@@ -211,6 +291,7 @@ func TestMovieCreate(t *testing.T) {
 		&movie.DateReleased,
 		fetchedMovie.DateReleased.Location(),
 	)
+	movie.R = fetchedMovie.R
 
 	require.Equal(movie, fetchedMovie)
 }
@@ -283,6 +364,7 @@ func TestMovieUpdate(t *testing.T) {
 			// let's imagine we don't care about ContributedAt field for a moment :|
 			ContributedAt: fetchedUpdatedMovie.ContributedAt,
 			Invalidation:  outdatedMovie.Invalidation,
+			R:             fetchedUpdatedMovie.R,
 		},
 		fetchedUpdatedMovie,
 	)
@@ -454,6 +536,8 @@ func TestMovieAuditsGetAll(t *testing.T) {
 		auditedMovie.EpisodeNumber = null.Int{}
 		auditedMovie.Invalidation = null.String{}
 		auditedMovie.ContributedBy = user.ID
+		auditedMovie.AuditAction = repo.AuditActionUpdate
+		auditedMovie.AuditActor = null.IntFrom(user.ID)
 		auditedMovie.ContributedAt = a.ContributedAt
 
 		testutils.SetTimeLocation(
@@ -0,0 +1,98 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/watchlist"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+func (repo *Repository) PlaybackProgressGet(
+	ctx context.Context,
+	userID int,
+	filmID int,
+) (*models.PlaybackProgress, error) {
+	progress, err := models.FindPlaybackProgress(ctx, repo.exec, userID, filmID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return progress, nil
+}
+
+// PlaybackProgressPut puts the progress unless a later update of it is already
+// put: the last write wins. a zero UpdatedAt puts it now. applied reports
+// whether the progress is put
+func (repo *Repository) PlaybackProgressPut(
+	ctx context.Context,
+	progress *models.PlaybackProgress,
+) (applied bool, err error) {
+	var updatedAt null.Time
+	if !progress.UpdatedAt.IsZero() {
+		updatedAt = null.TimeFrom(progress.UpdatedAt)
+	}
+	err = repo.exec.QueryRowContext(
+		ctx,
+		playbackProgressPutQuery,
+		progress.UserID,
+		progress.FilmID,
+		progress.Position,
+		progress.Device,
+		updatedAt,
+	).Scan(&progress.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// ContinueWatchingGet reads the playback progress of the user on the visible
+// films not crossed watchedThresholdPercent of their duration: the latest
+// updated first
+func (repo *Repository) ContinueWatchingGet(
+	ctx context.Context,
+	userID int,
+	offset int,
+	limit int,
+	watchedThresholdPercent int,
+) (progress []*watchlist.Progress, err error) {
+	rows, err := repo.exec.QueryContext(
+		ctx,
+		continueWatchingGetQuery,
+		userID,
+		offset,
+		limit,
+		watchedThresholdPercent,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	err = queries.Bind(rows, &progress)
+	if err != nil {
+		return nil, err
+	}
+	return progress, nil
+}
+
+func (repo *Repository) ContinueWatchingCount(
+	ctx context.Context,
+	userID int,
+	watchedThresholdPercent int,
+) (count int, err error) {
+	err = repo.exec.QueryRowContext(
+		ctx,
+		continueWatchingCountQuery,
+		userID,
+		watchedThresholdPercent,
+	).Scan(&count)
+	return count, err
+}
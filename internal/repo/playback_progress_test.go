@@ -0,0 +1,141 @@
+package repo_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestPlaybackProgress(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "user"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+
+	// an hour long movies
+	movies := make([]*models.Film, 3)
+	for i := range movies {
+		movies[i] = &models.Film{
+			Title:        "movie",
+			Duration:     null.IntFrom(60 * 60),
+			DateReleased: testutils.Date(2000, 1, 1),
+		}
+		err = r.MovieCreate(ctx, user.ID, movies[i])
+		require.NoError(err)
+	}
+
+	// no progress yet
+	_, err = r.PlaybackProgressGet(ctx, user.ID, movies[0].ID)
+	require.Equal(repo.ErrNoRecord, err)
+
+	// put the progress of the first movie
+	t1 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	progress := &models.PlaybackProgress{
+		UserID:    user.ID,
+		FilmID:    movies[0].ID,
+		Position:  2520,
+		Device:    null.StringFrom("tv"),
+		UpdatedAt: t2,
+	}
+	applied, err := r.PlaybackProgressPut(ctx, progress)
+	require.NoError(err)
+	require.True(applied)
+
+	// an earlier update loses
+	applied, err = r.PlaybackProgressPut(ctx, &models.PlaybackProgress{
+		UserID:    user.ID,
+		FilmID:    movies[0].ID,
+		Position:  60,
+		Device:    null.StringFrom("phone"),
+		UpdatedAt: t1,
+	})
+	require.NoError(err)
+	require.False(applied)
+
+	got, err := r.PlaybackProgressGet(ctx, user.ID, movies[0].ID)
+	require.NoError(err)
+	require.Equal(2520, got.Position)
+	require.Equal(null.StringFrom("tv"), got.Device)
+	require.True(t2.Equal(got.UpdatedAt))
+
+	// a zero update time puts it now
+	progress = &models.PlaybackProgress{
+		UserID:   user.ID,
+		FilmID:   movies[1].ID,
+		Position: 600,
+	}
+	applied, err = r.PlaybackProgressPut(ctx, progress)
+	require.NoError(err)
+	require.True(applied)
+	require.False(progress.UpdatedAt.IsZero())
+
+	// the third movie is crossed the threshold
+	applied, err = r.PlaybackProgressPut(ctx, &models.PlaybackProgress{
+		UserID:   user.ID,
+		FilmID:   movies[2].ID,
+		Position: 3300,
+	})
+	require.NoError(err)
+	require.True(applied)
+
+	// continue watching the latest updated first
+	count, err := r.ContinueWatchingCount(ctx, user.ID, 90)
+	require.NoError(err)
+	require.Equal(2, count)
+	continued, err := r.ContinueWatchingGet(ctx, user.ID, 0, math.MaxInt, 90)
+	require.NoError(err)
+	require.Equal(2, len(continued))
+	require.Equal(movies[1].ID, continued[0].FilmID)
+	require.Equal(movies[1].ID, continued[0].Film.ID)
+	require.Equal(movies[0].ID, continued[1].FilmID)
+
+	// a non-positive threshold keeps them all
+	count, err = r.ContinueWatchingCount(ctx, user.ID, 0)
+	require.NoError(err)
+	require.Equal(3, count)
+
+	// the hidden films are not continued
+	err = r.MovieSetDeletedAt(
+		ctx,
+		movies[1].ID,
+		null.TimeFrom(time.Now()),
+	)
+	require.NoError(err)
+	continued, err = r.ContinueWatchingGet(ctx, user.ID, 0, math.MaxInt, 90)
+	require.NoError(err)
+	require.Equal(1, len(continued))
+	require.Equal(movies[0].ID, continued[0].FilmID)
+
+	// hidden films are not found
+	_, err = r.FilmGet(ctx, movies[1].ID)
+	require.Equal(repo.ErrNoRecord, err)
+	film, err := r.FilmGet(ctx, movies[0].ID)
+	require.NoError(err)
+	require.Equal(null.IntFrom(60*60), film.Duration)
+
+	// the watchlist item marked by a crossed progress is the latest added one
+	_, err = r.WatchlistGetIDByFilm(ctx, user.ID, movies[0].ID)
+	require.Equal(repo.ErrNoRecord, err)
+	_, err = r.WatchlistAdd(ctx, user.ID, movies[0].ID)
+	require.NoError(err)
+	latestWatchID, err := r.WatchlistAdd(ctx, user.ID, movies[0].ID)
+	require.NoError(err)
+	watchID, err := r.WatchlistGetIDByFilm(ctx, user.ID, movies[0].ID)
+	require.NoError(err)
+	require.Equal(latestWatchID, watchID)
+}
@@ -5,6 +5,9 @@ import (
 	"reflect"
 
 	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/review"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 var (
@@ -21,10 +24,15 @@ var (
 
 var (
 	watchfilmGetAllQuery = fmt.Sprintf(
-		`SELECT %[1]s, %[2]s
+		`SELECT %[1]s, %[2]s,
+			COALESCE(
+				(SELECT array_agg(%[16]s ORDER BY %[16]s) FROM %[14]s WHERE %[15]s = %[11]s),
+				'{}'
+			) AS "tags"
 		FROM %[3]s INNER JOIN %[4]s ON %[5]s = %[6]s
+			LEFT JOIN %[12]s ON %[13]s = %[6]s
 		WHERE %[7]s = $1 %[8]s
-		ORDER BY %[9]s %[10]s
+		ORDER BY %[9]s, %[11]s %[10]s
 		OFFSET $2 LIMIT $3;`,
 		/*1*/ columnsList(models.WatchfilmTableColumns),
 		/*2*/ columnsList(models.FilmTableColumns),
@@ -34,15 +42,62 @@ var (
 		/*6*/ models.FilmTableColumns.ID,
 		/*7*/ models.WatchfilmTableColumns.UserID,
 		/*8: extended where clause*/ "%s",
-		/*9*/ models.WatchfilmTableColumns.TimeAdded,
+		/*9: order by clause*/ "%s",
 		/*10: sort order*/ "%s",
+		/*11*/ models.WatchfilmTableColumns.ID,
+		/*12*/ models.TableNames.FilmScoreAggregates,
+		/*13*/ models.FilmScoreAggregateTableColumns.FilmID,
+		/*14*/ models.TableNames.WatchfilmTags,
+		/*15*/ models.WatchfilmTagTableColumns.WatchID,
+		/*16*/ models.WatchfilmTagTableColumns.Tag,
 	)
 
 	watchfilmCountQuery = fmt.Sprintf(
-		`SELECT COUNT(*) FROM %[1]s WHERE %[2]s = $1 %[3]s;`,
+		`SELECT COUNT(*)
+		FROM %[1]s INNER JOIN %[2]s ON %[3]s = %[4]s
+		WHERE %[5]s = $1 %[6]s;`,
 		/*1*/ models.TableNames.Watchfilms,
-		/*2*/ models.WatchfilmTableColumns.UserID,
-		/*3: extended where clause*/ "%s",
+		/*2*/ models.TableNames.Films,
+		/*3*/ models.WatchfilmTableColumns.FilmID,
+		/*4*/ models.FilmTableColumns.ID,
+		/*5*/ models.WatchfilmTableColumns.UserID,
+		/*6: extended where clause*/ "%s",
+	)
+
+	// watchlistLastPositionQuery returns the position of the last item of the
+	// watchlist of the user: 0 if empty
+	watchlistLastPositionQuery = fmt.Sprintf(
+		`SELECT COALESCE(MAX(%[1]s), 0) FROM %[2]s WHERE %[3]s = $1;`,
+		/*1*/ models.WatchfilmColumns.Position,
+		/*2*/ models.TableNames.Watchfilms,
+		/*3*/ models.WatchfilmColumns.UserID,
+	)
+
+	// watchlistNextPositionQuery returns the position of the item of the
+	// watchlist of the user next to the position skipping the item of id $3
+	watchlistNextPositionQuery = fmt.Sprintf(
+		`SELECT MIN(%[1]s) FROM %[2]s
+		WHERE %[3]s = $1 AND %[1]s > $2 AND %[4]s <> $3;`,
+		/*1*/ models.WatchfilmColumns.Position,
+		/*2*/ models.TableNames.Watchfilms,
+		/*3*/ models.WatchfilmColumns.UserID,
+		/*4*/ models.WatchfilmColumns.ID,
+	)
+
+	// watchlistRenumberQuery spaces the positions of the watchlist of the user
+	// by $2 again keeping their order
+	watchlistRenumberQuery = fmt.Sprintf(
+		`UPDATE %[2]s SET %[1]s = numbered.row_number * $2
+		FROM (
+			SELECT %[4]s, row_number() OVER (ORDER BY %[1]s, %[4]s)
+			FROM %[2]s WHERE %[3]s = $1
+		) numbered
+		WHERE %[5]s = numbered.%[4]s;`,
+		/*1*/ models.WatchfilmColumns.Position,
+		/*2*/ models.TableNames.Watchfilms,
+		/*3*/ models.WatchfilmColumns.UserID,
+		/*4*/ models.WatchfilmColumns.ID,
+		/*5*/ models.WatchfilmTableColumns.ID,
 	)
 
 	tokenGetQuery = fmt.Sprintf(
@@ -58,6 +113,683 @@ var (
 	)
 )
 
+var (
+	// auditsPruneQuery deletes a batch of the audits of an audit table not
+	// retained by the extended where clause returning them as json
+	auditsPruneQuery = fmt.Sprintf(
+		`WITH pruned AS (
+			SELECT audit_ctid FROM (%[2]s) ranked
+			WHERE %[3]s
+			LIMIT $1
+		)
+		DELETE FROM %[1]s audit USING pruned
+		WHERE audit.ctid = pruned.audit_ctid
+		RETURNING to_jsonb(audit)::text;`,
+		/*1: audit table*/ "%[1]s",
+		/*2*/ auditsRankedQuery,
+		/*3: where clause*/ "%[2]s",
+	)
+	// auditsPruneCountQuery counts the audits of an audit table not retained
+	// by the extended where clause
+	auditsPruneCountQuery = fmt.Sprintf(
+		`SELECT count(*) FROM (%[1]s) ranked WHERE %[2]s;`,
+		/*1*/ auditsRankedQuery,
+		/*2: where clause*/ "%[2]s",
+	)
+	// auditsRankedQuery ranks the audits of every record from the latest by
+	// the audit time column
+	auditsRankedQuery = fmt.Sprintf(
+		`SELECT ctid AS audit_ctid, %[3]s AS audit_time,
+			row_number() OVER (PARTITION BY %[2]s ORDER BY %[3]s DESC) AS audit_rank
+		FROM %[1]s`,
+		/*1: audit table*/ "%[1]s",
+		/*2*/ models.FilmsAuditColumns.ID,
+		/*3: audit time column*/ "%[3]s",
+	)
+)
+
+var (
+	// contributorStatsSelect scores the contribution statistics weighted by
+	// the first four placeholders. the statistics are counted on write as the
+	// contributions could not be derived from the pruned audits
+	contributorStatsSelect = fmt.Sprintf(
+		`SELECT %[2]s, %[3]s, %[4]s, %[5]s, %[6]s,
+			greatest(
+				0,
+				%[3]s * $1 + %[4]s * $2 - %[5]s * $3 - %[6]s * $4
+			) AS score
+		FROM %[1]s`,
+		/*1*/ models.TableNames.ContributorStats,
+		/*2*/ models.ContributorStatColumns.UserID,
+		/*3*/ models.ContributorStatColumns.Creations,
+		/*4*/ models.ContributorStatColumns.Edits,
+		/*5*/ models.ContributorStatColumns.RevertedEdits,
+		/*6*/ models.ContributorStatColumns.InvalidationsReceived,
+	)
+
+	contributorStatsGetQuery = fmt.Sprintf(
+		`%[1]s
+		WHERE %[2]s = $5;`,
+		/*1*/ contributorStatsSelect,
+		/*2*/ models.ContributorStatColumns.UserID,
+	)
+
+	contributorStatsGetAllQuery = fmt.Sprintf(
+		`%[1]s
+		ORDER BY score DESC, %[2]s
+		OFFSET $5 LIMIT $6;`,
+		/*1*/ contributorStatsSelect,
+		/*2*/ models.ContributorStatColumns.UserID,
+	)
+
+	contributorsCountQuery = fmt.Sprintf(
+		`SELECT count(*) FROM %[1]s;`,
+		/*1*/ models.TableNames.ContributorStats,
+	)
+
+	// contributionsGetAllQuery reads the contributions of the user $1 recorded
+	// on write
+	contributionsGetAllQuery = fmt.Sprintf(
+		`SELECT %[2]s, %[3]s AS id, %[4]s, %[5]s, %[6]s, %[7]s
+		FROM %[1]s
+		WHERE %[8]s = $1
+		ORDER BY %[7]s %%[1]s, %[9]s %%[1]s
+		OFFSET $2 LIMIT $3;`,
+		/*1*/ models.TableNames.Contributions,
+		/*2*/ models.ContributionColumns.Kind,
+		/*3*/ models.ContributionColumns.TargetID,
+		/*4*/ models.ContributionColumns.Title,
+		/*5*/ models.ContributionColumns.Action,
+		/*6*/ models.ContributionColumns.Reverted,
+		/*7*/ models.ContributionColumns.ContributedAt,
+		/*8*/ models.ContributionColumns.UserID,
+		/*9*/ models.ContributionColumns.ID,
+	)
+
+	contributionsCountQuery = fmt.Sprintf(
+		`SELECT count(*) FROM %[1]s WHERE %[2]s = $1;`,
+		/*1*/ models.TableNames.Contributions,
+		/*2*/ models.ContributionColumns.UserID,
+	)
+)
+
+// seriesAggregatesRefreshQuery recomputes the aggregates of the visible
+// episodes of the series $1. the specials (season 0) are not counted as a
+// season
+var seriesAggregatesRefreshQuery = fmt.Sprintf(
+	`INSERT INTO %[1]s (
+		%[2]s, %[3]s, %[4]s, %[5]s, %[6]s, %[7]s, %[8]s
+	)
+	SELECT $1,
+		count(*) FILTER (WHERE season_number > 0),
+		coalesce(sum(episodes_count), 0),
+		coalesce(sum(total_runtime), 0),
+		min(first_episode_date),
+		max(latest_episode_date),
+		coalesce(jsonb_object_agg(season_number, episodes_count), '{}')
+	FROM (
+		SELECT %[10]s AS season_number,
+			count(*) AS episodes_count,
+			coalesce(sum(%[11]s), 0) AS total_runtime,
+			min(%[12]s) AS first_episode_date,
+			max(%[12]s) AS latest_episode_date
+		FROM %[9]s
+		WHERE %[13]s = $1
+			AND %[10]s IS NOT NULL
+			AND %[14]s IS NOT NULL
+			AND %[15]s IS NULL
+		GROUP BY %[10]s
+	) seasons
+	ON CONFLICT (%[2]s) DO UPDATE SET
+		%[3]s = EXCLUDED.%[3]s,
+		%[4]s = EXCLUDED.%[4]s,
+		%[5]s = EXCLUDED.%[5]s,
+		%[6]s = EXCLUDED.%[6]s,
+		%[7]s = EXCLUDED.%[7]s,
+		%[8]s = EXCLUDED.%[8]s;`,
+	/*1*/ models.TableNames.SeriesAggregates,
+	/*2*/ models.SeriesAggregateColumns.SeriesID,
+	/*3*/ models.SeriesAggregateColumns.SeasonsCount,
+	/*4*/ models.SeriesAggregateColumns.EpisodesCount,
+	/*5*/ models.SeriesAggregateColumns.TotalRuntime,
+	/*6*/ models.SeriesAggregateColumns.FirstEpisodeDate,
+	/*7*/ models.SeriesAggregateColumns.LatestEpisodeDate,
+	/*8*/ models.SeriesAggregateColumns.SeasonEpisodes,
+	/*9*/ models.TableNames.Films,
+	/*10*/ models.FilmColumns.SeasonNumber,
+	/*11*/ models.FilmColumns.Duration,
+	/*12*/ models.FilmColumns.DateReleased,
+	/*13*/ models.FilmColumns.SeriesID,
+	/*14*/ models.FilmColumns.EpisodeNumber,
+	/*15*/ models.FilmColumns.DeletedAt,
+)
+
+// watchlistAppendToFollowersQuery appends the film $2 to the watchlists of
+// the followers of the series $1 not having it already: the film takes the
+// last position of each watchlist spaced by $3
+var watchlistAppendToFollowersQuery = fmt.Sprintf(
+	`INSERT INTO %[1]s (%[2]s, %[3]s, %[9]s)
+	SELECT %[4]s, $2,
+		coalesce(
+			(SELECT max(%[10]s) FROM %[1]s WHERE %[7]s = %[4]s),
+			0
+		) + $3
+	FROM %[5]s
+	WHERE %[6]s = $1
+		AND NOT EXISTS (
+			SELECT 1 FROM %[1]s
+			WHERE %[7]s = %[4]s AND %[8]s = $2
+		);`,
+	/*1*/ models.TableNames.Watchfilms,
+	/*2*/ models.WatchfilmColumns.UserID,
+	/*3*/ models.WatchfilmColumns.FilmID,
+	/*4*/ models.SeriesFollowTableColumns.UserID,
+	/*5*/ models.TableNames.SeriesFollows,
+	/*6*/ models.SeriesFollowTableColumns.SeriesID,
+	/*7*/ models.WatchfilmTableColumns.UserID,
+	/*8*/ models.WatchfilmTableColumns.FilmID,
+	/*9*/ models.WatchfilmColumns.Position,
+	/*10*/ models.WatchfilmTableColumns.Position,
+)
+
+// upNextWithClause numbers the visible episodes of every series having an
+// episode in the watchlist of the user $1 by season and episode order, the
+// specials (season 0) after the regular seasons, and aggregates the progress
+// of the user per series: the last watched is the number of the last watched
+// episode in order (0 if none)
+var upNextWithClause = fmt.Sprintf(
+	`WITH numbered AS (
+		SELECT %[3]s AS film_id,
+			%[6]s AS series_id,
+			%[10]s AS date_released,
+			%[11]s AS watch_id,
+			%[12]s AS time_added,
+			%[13]s AS time_watched,
+			row_number() OVER (
+				PARTITION BY %[6]s ORDER BY %[7]s = 0, %[7]s, %[8]s, %[3]s
+			) AS number
+		FROM %[1]s LEFT JOIN %[2]s ON %[4]s = %[3]s AND %[5]s = $1
+		WHERE %[6]s IN (
+				SELECT %[6]s FROM %[2]s INNER JOIN %[1]s ON %[3]s = %[4]s
+				WHERE %[5]s = $1
+			)
+			AND %[7]s IS NOT NULL
+			AND %[8]s IS NOT NULL
+			AND %[9]s IS NULL
+	),
+	progress AS (
+		SELECT series_id,
+			count(*) AS episodes_count,
+			count(time_watched) AS watched_count,
+			coalesce(
+				max(number) FILTER (WHERE time_watched IS NOT NULL),
+				0
+			) AS last_watched,
+			max(time_watched) AS time_last_watched,
+			max(greatest(time_added, time_watched)) AS time_active
+		FROM numbered
+		GROUP BY series_id
+	)`,
+	/*1*/ models.TableNames.Films,
+	/*2*/ models.TableNames.Watchfilms,
+	/*3*/ models.FilmTableColumns.ID,
+	/*4*/ models.WatchfilmTableColumns.FilmID,
+	/*5*/ models.WatchfilmTableColumns.UserID,
+	/*6*/ models.FilmTableColumns.SeriesID,
+	/*7*/ models.FilmTableColumns.SeasonNumber,
+	/*8*/ models.FilmTableColumns.EpisodeNumber,
+	/*9*/ models.FilmTableColumns.DeletedAt,
+	/*10*/ models.FilmTableColumns.DateReleased,
+	/*11*/ models.WatchfilmTableColumns.ID,
+	/*12*/ models.WatchfilmTableColumns.TimeAdded,
+	/*13*/ models.WatchfilmTableColumns.TimeWatched,
+)
+
+// upNextFromClause joins the progress of the visible serieses to their
+// episode next to the last watched one: serieses caught up have no next
+// episode
+var upNextFromClause = fmt.Sprintf(
+	`FROM progress
+		INNER JOIN numbered ON numbered.series_id = progress.series_id
+			AND numbered.number = progress.last_watched + 1
+		INNER JOIN %[1]s ON %[2]s = numbered.film_id
+		INNER JOIN %[3]s ON %[4]s = progress.series_id
+	WHERE %[5]s IS NULL`,
+	/*1*/ models.TableNames.Films,
+	/*2*/ models.FilmTableColumns.ID,
+	/*3*/ models.TableNames.Serieses,
+	/*4*/ models.SeriesTableColumns.ID,
+	/*5*/ models.SeriesTableColumns.DeletedAt,
+)
+
+// upNextGetQuery reads the next episode to watch of every series the user $1
+// has activity on with the progress of the user: the recently active first.
+// the new episodes are the episodes left if the next one is released after
+// the user watched the last one, i.e. the user had caught up
+var upNextGetQuery = fmt.Sprintf(
+	`%[1]s
+	SELECT %[2]s, %[3]s,
+		progress.episodes_count AS "episodes_count",
+		progress.watched_count AS "watched_count",
+		CASE WHEN numbered.date_released > progress.time_last_watched
+			THEN progress.episodes_count - progress.last_watched
+			ELSE 0
+		END AS "new_episodes_count",
+		numbered.watch_id AS "watch_id"
+	%[4]s
+	ORDER BY progress.time_active DESC NULLS LAST, progress.series_id DESC
+	OFFSET $2 LIMIT $3;`,
+	/*1*/ upNextWithClause,
+	/*2*/ columnsList(models.SeriesTableColumns),
+	/*3*/ columnsList(models.FilmTableColumns),
+	/*4*/ upNextFromClause,
+)
+
+// upNextCountQuery counts the serieses having a next episode to watch by the
+// user $1
+var upNextCountQuery = fmt.Sprintf(
+	`%[1]s
+	SELECT count(*)
+	%[2]s;`,
+	/*1*/ upNextWithClause,
+	/*2*/ upNextFromClause,
+)
+
+// watchEventCreateQuery logs a watch event at $3 (now if null) of the
+// watchlist item $1 of the user $2 and sets the time watched of the item to
+// its latest watch event. it returns the id of the event
+var watchEventCreateQuery = fmt.Sprintf(
+	`WITH event AS (
+		INSERT INTO %[1]s (%[2]s, %[3]s, %[4]s, %[5]s)
+		SELECT %[6]s,
+			coalesce($3::TIMESTAMPTZ, CURRENT_TIMESTAMP),
+			$4::INT,
+			$5::VARCHAR
+		FROM %[7]s
+		WHERE %[6]s = $1 AND %[8]s = $2
+		RETURNING %[9]s, %[2]s, %[3]s
+	)
+	UPDATE %[7]s
+	SET %[10]s = greatest(%[11]s, event.%[3]s)
+	FROM event
+	WHERE %[6]s = event.%[2]s
+	RETURNING event.%[9]s;`,
+	/*1*/ models.TableNames.WatchEvents,
+	/*2*/ models.WatchEventColumns.WatchID,
+	/*3*/ models.WatchEventColumns.TimeWatched,
+	/*4*/ models.WatchEventColumns.Rating,
+	/*5*/ models.WatchEventColumns.Device,
+	/*6*/ models.WatchfilmTableColumns.ID,
+	/*7*/ models.TableNames.Watchfilms,
+	/*8*/ models.WatchfilmTableColumns.UserID,
+	/*9*/ models.WatchEventColumns.ID,
+	/*10*/ models.WatchfilmColumns.TimeWatched,
+	/*11*/ models.WatchfilmTableColumns.TimeWatched,
+)
+
+// watchEventUndoQuery deletes the latest watch event of the watchlist item $1
+// of the user $2 and sets the time watched of the item to its remaining latest
+// watch event
+var watchEventUndoQuery = fmt.Sprintf(
+	`WITH event AS (
+		DELETE FROM %[1]s
+		WHERE %[2]s = (
+			SELECT %[2]s
+			FROM %[1]s INNER JOIN %[3]s ON %[4]s = %[5]s
+			WHERE %[5]s = $1 AND %[6]s = $2
+			ORDER BY %[7]s DESC, %[2]s DESC
+			LIMIT 1
+		)
+		RETURNING %[8]s, %[9]s
+	)
+	UPDATE %[3]s
+	SET %[10]s = (
+		SELECT max(%[7]s)
+		FROM %[1]s
+		WHERE %[4]s = event.%[9]s AND %[2]s <> event.%[8]s
+	)
+	FROM event
+	WHERE %[5]s = event.%[9]s;`,
+	/*1*/ models.TableNames.WatchEvents,
+	/*2*/ models.WatchEventTableColumns.ID,
+	/*3*/ models.TableNames.Watchfilms,
+	/*4*/ models.WatchEventTableColumns.WatchID,
+	/*5*/ models.WatchfilmTableColumns.ID,
+	/*6*/ models.WatchfilmTableColumns.UserID,
+	/*7*/ models.WatchEventTableColumns.TimeWatched,
+	/*8*/ models.WatchEventColumns.ID,
+	/*9*/ models.WatchEventColumns.WatchID,
+	/*10*/ models.WatchfilmColumns.TimeWatched,
+)
+
+// watchedTimesFilterClause keeps the watchlist items watched exactly ? times
+var watchedTimesFilterClause = fmt.Sprintf(
+	"(SELECT count(*) FROM %[1]s WHERE %[2]s = %[3]s) = ?",
+	/*1*/ models.TableNames.WatchEvents,
+	/*2*/ models.WatchEventTableColumns.WatchID,
+	/*3*/ models.WatchfilmTableColumns.ID,
+)
+
+// watchedBetweenFilterClause keeps the watchlist items watched at least once
+// and is extended by the bounds of the watch time
+var watchedBetweenFilterClause = fmt.Sprintf(
+	"EXISTS (SELECT 1 FROM %[1]s WHERE %[2]s = %[3]s",
+	/*1*/ models.TableNames.WatchEvents,
+	/*2*/ models.WatchEventTableColumns.WatchID,
+	/*3*/ models.WatchfilmTableColumns.ID,
+)
+
+// playbackProgressPutQuery puts the playback progress of the film $2 of the
+// user $1 updated at $5 (now if null) unless a later update is already put. it
+// returns the update time of the put progress
+var playbackProgressPutQuery = fmt.Sprintf(
+	`INSERT INTO %[1]s AS progress (%[2]s, %[3]s, %[4]s, %[5]s, %[6]s)
+	VALUES ($1, $2, $3, $4, coalesce($5::TIMESTAMPTZ, CURRENT_TIMESTAMP))
+	ON CONFLICT (%[2]s, %[3]s) DO UPDATE
+	SET %[4]s = EXCLUDED.%[4]s,
+		%[5]s = EXCLUDED.%[5]s,
+		%[6]s = EXCLUDED.%[6]s
+	WHERE progress.%[6]s <= EXCLUDED.%[6]s
+	RETURNING %[6]s;`,
+	/*1*/ models.TableNames.PlaybackProgress,
+	/*2*/ models.PlaybackProgressColumns.UserID,
+	/*3*/ models.PlaybackProgressColumns.FilmID,
+	/*4*/ models.PlaybackProgressColumns.Position,
+	/*5*/ models.PlaybackProgressColumns.Device,
+	/*6*/ models.PlaybackProgressColumns.UpdatedAt,
+)
+
+// continueWatchingWhereClause keeps the playback progress of the user $1 on
+// the visible films not crossed the watched threshold percent $n of their
+// duration: both the position and the duration are in seconds. a non-positive
+// threshold keeps them all
+const continueWatchingWhereClause = `%[1]s = $1
+	AND %[2]s IS NULL
+	AND (%[3]s IS NULL OR $%[5]d <= 0 OR %[4]s * 100 < %[3]s * $%[5]d)`
+
+// continueWatchingGetQuery reads the playback progress of the user $1 to be
+// continued ordered by recency: $4 is the watched threshold percent
+var continueWatchingGetQuery = fmt.Sprintf(
+	`SELECT %[1]s, %[2]s
+	FROM %[3]s INNER JOIN %[4]s ON %[5]s = %[6]s
+	WHERE %[7]s
+	ORDER BY %[8]s DESC, %[5]s DESC
+	OFFSET $2 LIMIT $3;`,
+	/*1*/ columnsList(models.PlaybackProgressTableColumns),
+	/*2*/ columnsList(models.FilmTableColumns),
+	/*3*/ models.TableNames.PlaybackProgress,
+	/*4*/ models.TableNames.Films,
+	/*5*/ models.PlaybackProgressTableColumns.FilmID,
+	/*6*/ models.FilmTableColumns.ID,
+	/*7*/ fmt.Sprintf(
+		continueWatchingWhereClause,
+		models.PlaybackProgressTableColumns.UserID,
+		models.FilmTableColumns.DeletedAt,
+		models.FilmTableColumns.Duration,
+		models.PlaybackProgressTableColumns.Position,
+		4,
+	),
+	/*8*/ models.PlaybackProgressTableColumns.UpdatedAt,
+)
+
+// continueWatchingCountQuery counts the playback progress of the user $1 to
+// be continued: $2 is the watched threshold percent
+var continueWatchingCountQuery = fmt.Sprintf(
+	`SELECT COUNT(*)
+	FROM %[1]s INNER JOIN %[2]s ON %[3]s = %[4]s
+	WHERE %[5]s;`,
+	/*1*/ models.TableNames.PlaybackProgress,
+	/*2*/ models.TableNames.Films,
+	/*3*/ models.PlaybackProgressTableColumns.FilmID,
+	/*4*/ models.FilmTableColumns.ID,
+	/*5*/ fmt.Sprintf(
+		continueWatchingWhereClause,
+		models.PlaybackProgressTableColumns.UserID,
+		models.FilmTableColumns.DeletedAt,
+		models.FilmTableColumns.Duration,
+		models.PlaybackProgressTableColumns.Position,
+		2,
+	),
+)
+
+// statsWithClause selects the watch events of the user $1 watched between $2
+// and $3 (both inclusive if not null) with their local time in the time zone
+// $4 and the duration of their film in seconds (0 if unknown)
+var statsWithClause = fmt.Sprintf(
+	`WITH events AS (
+		SELECT %[1]s AT TIME ZONE $4 AS local_time,
+			%[2]s AS film_id,
+			%[3]s AS series_id,
+			coalesce(%[4]s, 0) AS duration
+		FROM %[5]s
+			INNER JOIN %[6]s ON %[7]s = %[8]s
+			INNER JOIN %[9]s ON %[2]s = %[10]s
+		WHERE %[11]s = $1
+			AND ($2::TIMESTAMPTZ IS NULL OR %[1]s >= $2)
+			AND ($3::TIMESTAMPTZ IS NULL OR %[1]s <= $3)
+	)`,
+	/*1*/ models.WatchEventTableColumns.TimeWatched,
+	/*2*/ models.FilmTableColumns.ID,
+	/*3*/ models.FilmTableColumns.SeriesID,
+	/*4*/ models.FilmTableColumns.Duration,
+	/*5*/ models.TableNames.WatchEvents,
+	/*6*/ models.TableNames.Watchfilms,
+	/*7*/ models.WatchfilmTableColumns.ID,
+	/*8*/ models.WatchEventTableColumns.WatchID,
+	/*9*/ models.TableNames.Films,
+	/*10*/ models.WatchfilmTableColumns.FilmID,
+	/*11*/ models.WatchfilmTableColumns.UserID,
+)
+
+// statsTotalsQuery sums the watched seconds into whole minutes and hours and
+// counts the watches, the movies and the episodes watched. the streaks are the
+// islands of the consecutive local days having a watch: the current streak is
+// the one reaching today or yesterday (relative to $3 if in the past)
+var statsTotalsQuery = fmt.Sprintf(
+	`%[1]s,
+	streaks AS (
+		SELECT max(day) AS last_day, count(*) AS length
+		FROM (
+			SELECT day, day - (row_number() OVER (ORDER BY day))::INT AS island
+			FROM (SELECT DISTINCT local_time::DATE AS day FROM events) days
+		) numbered
+		GROUP BY island
+	)
+	SELECT totals.seconds_watched / 60,
+		round(totals.seconds_watched / 3600.0, 2)::FLOAT8,
+		totals.watches_count,
+		totals.movies_count,
+		totals.episodes_count,
+		streak_totals.current_streak,
+		streak_totals.longest_streak
+	FROM (
+		SELECT coalesce(sum(duration), 0) AS seconds_watched,
+			count(*) AS watches_count,
+			count(DISTINCT film_id) FILTER (
+				WHERE series_id IS NULL
+			) AS movies_count,
+			count(DISTINCT film_id) FILTER (
+				WHERE series_id IS NOT NULL
+			) AS episodes_count
+		FROM events
+	) totals, (
+		SELECT coalesce(
+				max(length) FILTER (
+					WHERE last_day >= (
+						least(coalesce($3::TIMESTAMPTZ, now()), now())
+							AT TIME ZONE $4
+					)::DATE - 1
+				),
+				0
+			) AS current_streak,
+			coalesce(max(length), 0) AS longest_streak
+		FROM streaks
+	) streak_totals;`,
+	/*1*/ statsWithClause,
+)
+
+// statsBucketsQueries count the watches and sum the watched seconds into whole
+// minutes per local bucket: the busiest first
+var statsBucketsQueries = map[string]string{
+	query.StatsBucketWeekday: fmt.Sprintf(statsBucketsQuery, statsWithClause, "DOW"),
+	query.StatsBucketHour:    fmt.Sprintf(statsBucketsQuery, statsWithClause, "HOUR"),
+	query.StatsBucketMonth:   fmt.Sprintf(statsBucketsQuery, statsWithClause, "MONTH"),
+}
+
+const statsBucketsQuery = `%[1]s
+	SELECT extract(%[2]s FROM local_time)::INT AS "value",
+		count(*) AS "watches_count",
+		sum(duration) / 60 AS "minutes_watched"
+	FROM events
+	GROUP BY 1
+	ORDER BY 2 DESC, 3 DESC, 1;`
+
+// statsTopSeriesQuery reads the $5 visible serieses the most minutes watched
+// of with the count of their episodes watched
+var statsTopSeriesQuery = fmt.Sprintf(
+	`%[1]s
+	SELECT %[2]s,
+		sum(events.duration) / 60 AS "minutes_watched",
+		count(DISTINCT events.film_id) AS "episodes_count"
+	FROM events INNER JOIN %[3]s ON %[4]s = events.series_id
+	WHERE %[5]s IS NULL
+	GROUP BY %[4]s
+	ORDER BY "minutes_watched" DESC, "episodes_count" DESC, %[4]s
+	LIMIT $5;`,
+	/*1*/ statsWithClause,
+	/*2*/ columnsList(models.SeriesTableColumns),
+	/*3*/ models.TableNames.Serieses,
+	/*4*/ models.SeriesTableColumns.ID,
+	/*5*/ models.SeriesTableColumns.DeletedAt,
+)
+
+// statsTopFilmsQuery reads the $5 visible films the most watched with their
+// minutes watched
+var statsTopFilmsQuery = fmt.Sprintf(
+	`%[1]s
+	SELECT %[2]s,
+		count(*) AS "watches_count",
+		sum(events.duration) / 60 AS "minutes_watched"
+	FROM events INNER JOIN %[3]s ON %[4]s = events.film_id
+	WHERE %[5]s IS NULL
+	GROUP BY %[4]s
+	ORDER BY "watches_count" DESC, "minutes_watched" DESC, %[4]s
+	LIMIT $5;`,
+	/*1*/ statsWithClause,
+	/*2*/ columnsList(models.FilmTableColumns),
+	/*3*/ models.TableNames.Films,
+	/*4*/ models.FilmTableColumns.ID,
+	/*5*/ models.FilmTableColumns.DeletedAt,
+)
+
+// scoreAggregatesEnsureQuery creates the empty score aggregates of the target
+// $1 of an aggregates table if missing
+const scoreAggregatesEnsureQuery = `INSERT INTO %[1]s (%[2]s) VALUES ($1)
+	ON CONFLICT (%[2]s) DO NOTHING;`
+
+// scoreAggregatesAddQuery adds the score $3 to the score aggregates of the
+// target $1 of an aggregates table if $2 is 1 and removes it if $2 is -1: $4
+// is the histogram bucket of the score
+const scoreAggregatesAddQuery = `UPDATE %[1]s
+	SET %[3]s = %[3]s + $2,
+		%[4]s = %[4]s + $2 * $3,
+		%[5]s = (%[4]s + $2 * $3)::DOUBLE PRECISION / nullif(%[3]s + $2, 0),
+		%[6]s = jsonb_set(
+			%[6]s,
+			ARRAY[$4::TEXT],
+			to_jsonb(coalesce((%[6]s ->> $4::TEXT)::INT, 0) + $2)
+		)
+	WHERE %[2]s = $1;`
+
+var (
+	filmScoreAggregatesEnsureQuery = fmt.Sprintf(
+		scoreAggregatesEnsureQuery,
+		/*1*/ models.TableNames.FilmScoreAggregates,
+		/*2*/ models.FilmScoreAggregateColumns.FilmID,
+	)
+
+	filmScoreAggregatesAddQuery = fmt.Sprintf(
+		scoreAggregatesAddQuery,
+		/*1*/ models.TableNames.FilmScoreAggregates,
+		/*2*/ models.FilmScoreAggregateColumns.FilmID,
+		/*3*/ models.FilmScoreAggregateColumns.ScoresCount,
+		/*4*/ models.FilmScoreAggregateColumns.ScoresSum,
+		/*5*/ models.FilmScoreAggregateColumns.ScoreMean,
+		/*6*/ models.FilmScoreAggregateColumns.ScoreHistogram,
+	)
+
+	seriesScoreAggregatesEnsureQuery = fmt.Sprintf(
+		scoreAggregatesEnsureQuery,
+		/*1*/ models.TableNames.SeriesScoreAggregates,
+		/*2*/ models.SeriesScoreAggregateColumns.SeriesID,
+	)
+
+	seriesScoreAggregatesAddQuery = fmt.Sprintf(
+		scoreAggregatesAddQuery,
+		/*1*/ models.TableNames.SeriesScoreAggregates,
+		/*2*/ models.SeriesScoreAggregateColumns.SeriesID,
+		/*3*/ models.SeriesScoreAggregateColumns.ScoresCount,
+		/*4*/ models.SeriesScoreAggregateColumns.ScoresSum,
+		/*5*/ models.SeriesScoreAggregateColumns.ScoreMean,
+		/*6*/ models.SeriesScoreAggregateColumns.ScoreHistogram,
+	)
+)
+
+// reviewsGetAllQuery gets the reviews of the target $1 of a target column along
+// with their helpfulness votes count: the hidden reviews are kept for their
+// author $4 only
+const reviewsGetAllQuery = `SELECT %[1]s, COUNT(%[2]s) AS "helpful_votes"
+	FROM %[3]s LEFT JOIN %[4]s ON %[2]s = %[5]s
+	WHERE %[9]s = $1 AND (%[6]s = '%[7]s' OR %[8]s = $4)
+	GROUP BY %[5]s
+	ORDER BY %[10]s, %[5]s %[11]s
+	OFFSET $2 LIMIT $3;`
+
+var (
+	filmReviewsGetAllQuery = fmt.Sprintf(
+		reviewsGetAllQuery,
+		/*1*/ columnsList(models.ReviewTableColumns),
+		/*2*/ models.ReviewVoteTableColumns.ReviewID,
+		/*3*/ models.TableNames.Reviews,
+		/*4*/ models.TableNames.ReviewVotes,
+		/*5*/ models.ReviewTableColumns.ID,
+		/*6*/ models.ReviewTableColumns.Status,
+		/*7*/ review.StatusPublished,
+		/*8*/ models.ReviewTableColumns.UserID,
+		/*9*/ models.ReviewTableColumns.FilmID,
+		/*10: order by clause*/ "%s",
+		/*11: sort order*/ "%s",
+	)
+
+	seriesReviewsGetAllQuery = fmt.Sprintf(
+		reviewsGetAllQuery,
+		/*1*/ columnsList(models.ReviewTableColumns),
+		/*2*/ models.ReviewVoteTableColumns.ReviewID,
+		/*3*/ models.TableNames.Reviews,
+		/*4*/ models.TableNames.ReviewVotes,
+		/*5*/ models.ReviewTableColumns.ID,
+		/*6*/ models.ReviewTableColumns.Status,
+		/*7*/ review.StatusPublished,
+		/*8*/ models.ReviewTableColumns.UserID,
+		/*9*/ models.ReviewTableColumns.SeriesID,
+		/*10: order by clause*/ "%s",
+		/*11: sort order*/ "%s",
+	)
+)
+
+// listItemsCloseGapQuery moves the items of the list $1 past the removed
+// position $2 up a position
+var listItemsCloseGapQuery = fmt.Sprintf(
+	`UPDATE %[1]s SET %[3]s = %[3]s - 1 WHERE %[2]s = $1 AND %[3]s > $2;`,
+	/*1*/ models.TableNames.ListItems,
+	/*2*/ models.ListItemColumns.ListID,
+	/*3*/ models.ListItemColumns.Position,
+)
+
+// txSetActorQuery sets the acting user of the transaction read by the audit
+// triggers
+const txSetActorQuery = `SELECT set_config('watchlist.actor_id', $1, true);`
+
 func columnsList(tableColumnsStruct any) string {
 	v := reflect.ValueOf(tableColumnsStruct)
 	columns := ``
@@ -74,3 +806,16 @@ func columnsList(tableColumnsStruct any) string {
 	}
 	return columns
 }
+
+// whereInvalidation filters the records by the invalidation filter on the
+// invalidation column
+func whereInvalidation(column string, invalidation string) []qm.QueryMod {
+	switch invalidation {
+	case query.InvalidationExclude:
+		return []qm.QueryMod{qm.Where(column + " IS NULL")}
+	case query.InvalidationOnly:
+		return []qm.QueryMod{qm.Where(column + " IS NOT NULL")}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,253 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (repo *Repository) ReleasesGetAllByFilm(
+	ctx context.Context,
+	filmID int,
+) ([]*models.Release, error) {
+	releases, err := models.Releases(
+		models.ReleaseWhere.FilmID.EQ(filmID),
+		qm.OrderBy(models.ReleaseColumns.Region+" asc"),
+		qm.OrderBy(models.ReleaseColumns.DateReleased+" asc"),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return releases, nil
+}
+
+func (repo *Repository) ReleasePut(
+	ctx context.Context,
+	filmID int,
+	contributorID int,
+	release *models.Release,
+) error {
+	release.FilmID = filmID
+	release.ContributedBy = contributorID
+	return release.Upsert(
+		ctx,
+		repo.exec,
+		true, // update on conflict
+		[]string{
+			// upsert on conflict on unique film region release type
+			models.ReleaseColumns.FilmID,
+			models.ReleaseColumns.Region,
+			models.ReleaseColumns.ReleaseType,
+		},
+		boil.Infer(),
+		boil.Infer(),
+	)
+}
+
+func (repo *Repository) ReleaseAuditsGetAllByFilm(
+	ctx context.Context,
+	filmID int,
+	queryOptions query.SortOrderOptions,
+) ([]*models.ReleasesAudit, error) {
+	audits, err := models.ReleasesAudits(
+		models.ReleasesAuditWhere.FilmID.EQ(filmID),
+		qm.Offset(queryOptions.Offset),
+		qm.Limit(queryOptions.Limit),
+		qm.OrderBy(
+			models.ReleasesAuditColumns.ContributedAt+" "+queryOptions.SortOrder,
+		),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return audits, nil
+}
+
+func (repo *Repository) ReleaseAuditsCountByFilm(
+	ctx context.Context,
+	filmID int,
+) (int, error) {
+	auditsCount, err := models.ReleasesAudits(
+		models.ReleasesAuditWhere.FilmID.EQ(filmID),
+	).Count(ctx, repo.exec)
+	if err != nil {
+		return 0, err
+	}
+	return int(auditsCount), nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (repo *Repository) ContentRatingsGetAllByFilm(
+	ctx context.Context,
+	filmID int,
+) ([]*models.ContentRating, error) {
+	contentRatings, err := models.ContentRatings(
+		models.ContentRatingWhere.FilmID.EQ(filmID),
+		qm.OrderBy(models.ContentRatingColumns.Region+" asc"),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return contentRatings, nil
+}
+
+func (repo *Repository) ContentRatingPut(
+	ctx context.Context,
+	filmID int,
+	contributorID int,
+	contentRating *models.ContentRating,
+) error {
+	contentRating.FilmID = filmID
+	contentRating.ContributedBy = contributorID
+	return contentRating.Upsert(
+		ctx,
+		repo.exec,
+		true, // update on conflict
+		[]string{
+			// upsert on conflict on unique film region
+			models.ContentRatingColumns.FilmID,
+			models.ContentRatingColumns.Region,
+		},
+		boil.Infer(),
+		boil.Infer(),
+	)
+}
+
+func (repo *Repository) ContentRatingAuditsGetAllByFilm(
+	ctx context.Context,
+	filmID int,
+	queryOptions query.SortOrderOptions,
+) ([]*models.ContentRatingsAudit, error) {
+	audits, err := models.ContentRatingsAudits(
+		models.ContentRatingsAuditWhere.FilmID.EQ(filmID),
+		qm.Offset(queryOptions.Offset),
+		qm.Limit(queryOptions.Limit),
+		qm.OrderBy(
+			models.ContentRatingsAuditColumns.ContributedAt+" "+queryOptions.SortOrder,
+		),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return audits, nil
+}
+
+func (repo *Repository) ContentRatingAuditsCountByFilm(
+	ctx context.Context,
+	filmID int,
+) (int, error) {
+	auditsCount, err := models.ContentRatingsAudits(
+		models.ContentRatingsAuditWhere.FilmID.EQ(filmID),
+	).Count(ctx, repo.exec)
+	if err != nil {
+		return 0, err
+	}
+	return int(auditsCount), nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+var (
+	releasedFilterClause = fmt.Sprintf(
+		`EXISTS (
+			SELECT 1 FROM %[1]s
+			WHERE %[2]s = %[3]s AND %[4]s = ? AND %[5]s <= CURRENT_DATE`,
+		/*1*/ models.TableNames.Releases,
+		/*2*/ models.ReleaseTableColumns.FilmID,
+		/*3*/ models.FilmTableColumns.ID,
+		/*4*/ models.ReleaseTableColumns.Region,
+		/*5*/ models.ReleaseTableColumns.DateReleased,
+	)
+	releaseTypeFilterClause = fmt.Sprintf(
+		` AND %s = ?`,
+		models.ReleaseTableColumns.ReleaseType,
+	)
+
+	ratedFilterClause = fmt.Sprintf(
+		`EXISTS (
+			SELECT 1 FROM %[1]s
+			WHERE %[2]s = %[3]s AND %[4]s = ? AND %[5]s <= ?
+		)`,
+		/*1*/ models.TableNames.ContentRatings,
+		/*2*/ models.ContentRatingTableColumns.FilmID,
+		/*3*/ models.FilmTableColumns.ID,
+		/*4*/ models.ContentRatingTableColumns.Region,
+		/*5*/ models.ContentRatingTableColumns.MinAge,
+	)
+)
+
+// releaseFilterClauses returns the where clauses of the release options on
+// films with ? placeholders
+func releaseFilterClauses(
+	releaseOptions query.ReleaseOptions,
+) (clauses []string, args [][]any) {
+	if releaseOptions.Released || releaseOptions.ReleaseType != "" {
+		clause := releasedFilterClause
+		clauseArgs := []any{releaseOptions.Region}
+		if releaseOptions.ReleaseType != "" {
+			clause += releaseTypeFilterClause
+			clauseArgs = append(clauseArgs, releaseOptions.ReleaseType)
+		}
+		clauses = append(clauses, clause+")")
+		args = append(args, clauseArgs)
+	}
+	if releaseOptions.MaxAge.Valid {
+		clauses = append(clauses, ratedFilterClause)
+		args = append(
+			args,
+			[]any{releaseOptions.Region, releaseOptions.MaxAge.Int},
+		)
+	}
+	return clauses, args
+}
+
+// whereRelease filters the films by the release options
+func whereRelease(releaseOptions query.ReleaseOptions) []qm.QueryMod {
+	clauses, args := releaseFilterClauses(releaseOptions)
+	mods := make([]qm.QueryMod, len(clauses))
+	for i, clause := range clauses {
+		mods[i] = qm.Where(clause, args[i]...)
+	}
+	return mods
+}
+
+// rawSqlWhereRelease returns the extended where clause of the release options
+// of a raw query having its first placeholder numbered firstPlaceholder
+func rawSqlWhereRelease(
+	releaseOptions query.ReleaseOptions,
+	firstPlaceholder int,
+) (whereClause string, args []any) {
+	clauses, clausesArgs := releaseFilterClauses(releaseOptions)
+	return rawSqlWhereClauses(clauses, clausesArgs, firstPlaceholder)
+}
+
+// rawSqlWhereClauses joins the where clauses with ? placeholders into an
+// extended where clause of a raw query having its first placeholder numbered
+// firstPlaceholder
+func rawSqlWhereClauses(
+	clauses []string,
+	clausesArgs [][]any,
+	firstPlaceholder int,
+) (whereClause string, args []any) {
+	placeholder := firstPlaceholder
+	for i, clause := range clauses {
+		for strings.Contains(clause, "?") {
+			clause = strings.Replace(
+				clause,
+				"?",
+				fmt.Sprintf("$%d", placeholder),
+				1,
+			)
+			placeholder++
+		}
+		whereClause += " AND " + clause
+		args = append(args, clausesArgs[i]...)
+	}
+	return whereClause, args
+}
@@ -0,0 +1,330 @@
+package repo_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestReleasePut(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	movie := &models.Film{
+		Title:        "movie",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+	err = r.MovieCreate(ctx, user.ID, movie)
+	require.NoError(err)
+
+	// first there's no releases
+
+	releases, err := r.ReleasesGetAllByFilm(ctx, movie.ID)
+	require.NoError(err)
+	require.Equal(0, len(releases))
+
+	// put releases
+
+	putReleases := []*models.Release{
+		{
+			Region:       "US",
+			ReleaseType:  "theatrical",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+		{
+			Region:       "DE",
+			ReleaseType:  "streaming",
+			DateReleased: testutils.Date(2001, 1, 1),
+		},
+		{
+			Region:       "DE",
+			ReleaseType:  "theatrical",
+			DateReleased: testutils.Date(2000, 6, 1),
+		},
+	}
+	for _, release := range putReleases {
+		err = r.ReleasePut(ctx, movie.ID, user.ID, release)
+		require.NoError(err)
+	}
+
+	// replace the release of the same region and release type
+
+	replacedRelease := &models.Release{
+		Region:       "DE",
+		ReleaseType:  "streaming",
+		DateReleased: testutils.Date(2001, 2, 1),
+	}
+	err = r.ReleasePut(ctx, movie.ID, user.ID, replacedRelease)
+	require.NoError(err)
+	require.Equal(putReleases[1].ID, replacedRelease.ID)
+
+	// fetch releases: ordered by region and date
+
+	releases, err = r.ReleasesGetAllByFilm(ctx, movie.ID)
+	require.NoError(err)
+	require.Equal(len(putReleases), len(releases))
+
+	for i, expID := range []int{
+		putReleases[2].ID,
+		replacedRelease.ID,
+		putReleases[0].ID,
+	} {
+		require.Equal(expID, releases[i].ID)
+		require.Equal(movie.ID, releases[i].FilmID)
+		require.Equal(user.ID, releases[i].ContributedBy)
+	}
+	require.Equal(
+		replacedRelease.DateReleased.Format("2006-01-02"),
+		releases[1].DateReleased.Format("2006-01-02"),
+	)
+
+	// the replaced release is audited
+
+	audits, err := r.ReleaseAuditsGetAllByFilm(
+		ctx,
+		movie.ID,
+		query.SortOrderOptions{
+			Offset:    0,
+			Limit:     math.MaxInt,
+			SortOrder: "desc",
+		},
+	)
+	require.NoError(err)
+	require.Equal(1, len(audits))
+	require.Equal(putReleases[1].ID, audits[0].ID)
+
+	nAudits, err := r.ReleaseAuditsCountByFilm(ctx, movie.ID)
+	require.NoError(err)
+	require.Equal(1, nAudits)
+}
+
+func TestContentRatingPut(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	movie := &models.Film{
+		Title:        "movie",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+	err = r.MovieCreate(ctx, user.ID, movie)
+	require.NoError(err)
+
+	// put content ratings
+
+	for _, cr := range []*models.ContentRating{
+		{Region: "US", RatingSystem: "MPA", Rating: "R", MinAge: 17},
+		{Region: "DE", RatingSystem: "FSK", Rating: "12", MinAge: 12},
+		// replace the rating of the region
+		{Region: "DE", RatingSystem: "FSK", Rating: "16", MinAge: 16},
+	} {
+		err = r.ContentRatingPut(ctx, movie.ID, user.ID, cr)
+		require.NoError(err)
+	}
+
+	// fetch content ratings: ordered by region
+
+	contentRatings, err := r.ContentRatingsGetAllByFilm(ctx, movie.ID)
+	require.NoError(err)
+	require.Equal(2, len(contentRatings))
+	require.Equal("DE", contentRatings[0].Region)
+	require.Equal("16", contentRatings[0].Rating)
+	require.Equal(16, contentRatings[0].MinAge)
+	require.Equal("US", contentRatings[1].Region)
+
+	// the replaced content rating is audited
+
+	audits, err := r.ContentRatingAuditsGetAllByFilm(
+		ctx,
+		movie.ID,
+		query.SortOrderOptions{
+			Offset:    0,
+			Limit:     math.MaxInt,
+			SortOrder: "desc",
+		},
+	)
+	require.NoError(err)
+	require.Equal(1, len(audits))
+	require.Equal("12", audits[0].Rating)
+
+	nAudits, err := r.ContentRatingAuditsCountByFilm(ctx, movie.ID)
+	require.NoError(err)
+	require.Equal(1, nAudits)
+}
+
+func TestMoviesGetAllByRelease(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+
+	tomorrow := time.Now().AddDate(0, 0, 1)
+
+	// m1 is released in DE and rated 6
+	// m2 is streamed in DE and rated 16
+	// m3 is released in DE tomorrow
+	// m4 is released in US only
+	movies := []*models.Film{
+		{Title: "m1", DateReleased: testutils.Date(2000, 1, 1)},
+		{Title: "m2", DateReleased: testutils.Date(2000, 1, 1)},
+		{Title: "m3", DateReleased: testutils.Date(2000, 1, 1)},
+		{Title: "m4", DateReleased: testutils.Date(2000, 1, 1)},
+	}
+	for _, m := range movies {
+		err = r.MovieCreate(ctx, user.ID, m)
+		require.NoError(err)
+	}
+	for i, release := range []*models.Release{
+		{Region: "DE", ReleaseType: "theatrical", DateReleased: testutils.Date(2000, 1, 1)},
+		{Region: "DE", ReleaseType: "streaming", DateReleased: testutils.Date(2000, 1, 1)},
+		{Region: "DE", ReleaseType: "theatrical", DateReleased: tomorrow},
+		{Region: "US", ReleaseType: "theatrical", DateReleased: testutils.Date(2000, 1, 1)},
+	} {
+		err = r.ReleasePut(ctx, movies[i].ID, user.ID, release)
+		require.NoError(err)
+	}
+	for i, cr := range []*models.ContentRating{
+		{Region: "DE", RatingSystem: "FSK", Rating: "6", MinAge: 6},
+		{Region: "DE", RatingSystem: "FSK", Rating: "16", MinAge: 16},
+	} {
+		err = r.ContentRatingPut(ctx, movies[i].ID, user.ID, cr)
+		require.NoError(err)
+	}
+
+	queryOptions := query.Options{
+		Offset:    0,
+		Limit:     math.MaxInt,
+		SortField: models.FilmColumns.ID,
+		SortOrder: "asc",
+	}
+
+	testCases := []struct {
+		name           string
+		releaseOptions query.ReleaseOptions
+		expMovies      []*models.Film
+	}{
+		{
+			name:      "no filter",
+			expMovies: movies,
+		},
+		{
+			name: "released",
+			releaseOptions: query.ReleaseOptions{
+				Region:   "DE",
+				Released: true,
+			},
+			expMovies: movies[:2],
+		},
+		{
+			name: "released by type",
+			releaseOptions: query.ReleaseOptions{
+				Region:      "DE",
+				ReleaseType: "streaming",
+			},
+			expMovies: movies[1:2],
+		},
+		{
+			name: "max age",
+			releaseOptions: query.ReleaseOptions{
+				Region: "DE",
+				MaxAge: null.IntFrom(12),
+			},
+			expMovies: movies[:1],
+		},
+		{
+			name: "released and max age",
+			releaseOptions: query.ReleaseOptions{
+				Region:   "US",
+				Released: true,
+				MaxAge:   null.IntFrom(18),
+			},
+			expMovies: []*models.Film{},
+		},
+	}
+
+	for _, tc := range testCases {
+		fetchedMovies, err := r.MoviesGetAll(
+			ctx,
+			queryOptions,
+			tc.releaseOptions,
+		)
+		require.NoError(err, tc.name)
+		require.Equal(len(tc.expMovies), len(fetchedMovies), tc.name)
+		for i, m := range fetchedMovies {
+			require.Equal(tc.expMovies[i].ID, m.ID, tc.name)
+		}
+
+		nMovies, err := r.MoviesCount(
+			ctx,
+			query.InvalidationInclude,
+			tc.releaseOptions,
+		)
+		require.NoError(err, tc.name)
+		require.Equal(len(tc.expMovies), nMovies, tc.name)
+
+		// watchlist filters the same way
+		if tc.name == "no filter" {
+			for _, m := range movies {
+				_, err = r.WatchlistAdd(ctx, user.ID, m.ID)
+				require.NoError(err)
+			}
+		}
+		watchlist, err := r.WatchlistGet(
+			ctx,
+			user.ID,
+			query.WatchlistOptions{
+				Offset:           0,
+				Limit:            math.MaxInt,
+				SortOrder:        "asc",
+				WhereTimeWatched: repo.RawSqlWhereTimeWatchedIsNull,
+				Release:          tc.releaseOptions,
+			},
+		)
+		require.NoError(err, tc.name)
+		require.Equal(len(tc.expMovies), len(watchlist), tc.name)
+		for i, item := range watchlist {
+			require.Equal(tc.expMovies[i].ID, item.Film.ID, tc.name)
+		}
+
+		count, err := r.WatchlistCount(
+			ctx,
+			user.ID,
+			repo.RawSqlWhereTimeWatchedIsNull,
+			tc.releaseOptions,
+			query.WatchedOptions{},
+			query.WatchlistItemOptions{},
+		)
+		require.NoError(err, tc.name)
+		require.Equal(len(tc.expMovies), count, tc.name)
+	}
+}
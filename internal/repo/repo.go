@@ -4,9 +4,15 @@ import (
 	"context"
 	"database/sql"
 
+	"github.com/aria3ppp/watchlist-server/internal/collection"
+	"github.com/aria3ppp/watchlist-server/internal/contribution"
+	"github.com/aria3ppp/watchlist-server/internal/list"
 	"github.com/aria3ppp/watchlist-server/internal/models"
 	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/review"
+	"github.com/aria3ppp/watchlist-server/internal/stats"
 	"github.com/aria3ppp/watchlist-server/internal/watchlist"
+	"github.com/volatiletech/null/v8"
 )
 
 //go:generate mockgen -destination mock_repo/mock_service.go . ServiceTx
@@ -51,7 +57,7 @@ type Service interface {
 		ctx context.Context,
 		queryOptions query.Options,
 	) ([]*models.Series, error)
-	SeriesesCount(ctx context.Context) (int, error)
+	SeriesesCount(ctx context.Context, invalidation string) (int, error)
 	SeriesCreate(
 		ctx context.Context,
 		contributorID int,
@@ -82,6 +88,10 @@ type Service interface {
 		ctx context.Context,
 		seriesID, seasonNumber, episodeNumber int,
 	) (*models.Film, error)
+	EpisodeGetByAbsoluteNumber(
+		ctx context.Context,
+		seriesID, absoluteNumber int,
+	) (*models.Film, error)
 	EpisodesGetAllBySeries(
 		ctx context.Context,
 		seriesID int,
@@ -121,6 +131,11 @@ type Service interface {
 		contributorID int,
 		invalidation string,
 	) error
+	EpisodesRenumber(
+		ctx context.Context,
+		contributorID int,
+		episodes []*models.Film,
+	) error
 	// EpisodeAuditsGetAllByID(
 	// 	ctx context.Context,
 	// 	id int,
@@ -144,8 +159,13 @@ type Service interface {
 	MoviesGetAll(
 		ctx context.Context,
 		queryOptions query.Options,
+		releaseOptions query.ReleaseOptions,
 	) ([]*models.Film, error)
-	MoviesCount(ctx context.Context) (int, error)
+	MoviesCount(
+		ctx context.Context,
+		invalidation string,
+		releaseOptions query.ReleaseOptions,
+	) (int, error)
 	MovieCreate(
 		ctx context.Context,
 		contributorID int,
@@ -168,8 +188,344 @@ type Service interface {
 	) (int, error)
 
 	// Film
+	FilmGet(ctx context.Context, filmID int) (*models.Film, error)
 	FilmExists(ctx context.Context, filmID int) error
 
+	// External ID
+	FilmExternalIDGet(
+		ctx context.Context,
+		provider string,
+		externalID string,
+	) (*models.ExternalID, error)
+	SeriesExternalIDGet(
+		ctx context.Context,
+		provider string,
+		externalID string,
+	) (*models.ExternalID, error)
+	ExternalIDsGetAllByFilm(
+		ctx context.Context,
+		filmID int,
+	) ([]*models.ExternalID, error)
+	ExternalIDsGetAllBySeries(
+		ctx context.Context,
+		seriesID int,
+	) ([]*models.ExternalID, error)
+	ExternalIDPutByFilm(
+		ctx context.Context,
+		filmID int,
+		contributorID int,
+		extID *models.ExternalID,
+	) error
+	ExternalIDPutBySeries(
+		ctx context.Context,
+		seriesID int,
+		contributorID int,
+		extID *models.ExternalID,
+	) error
+	ExternalIDAuditsGetAllByFilm(
+		ctx context.Context,
+		filmID int,
+		queryOptions query.SortOrderOptions,
+	) ([]*models.ExternalIdsAudit, error)
+	ExternalIDAuditsCountByFilm(
+		ctx context.Context,
+		filmID int,
+	) (int, error)
+	ExternalIDAuditsGetAllBySeries(
+		ctx context.Context,
+		seriesID int,
+		queryOptions query.SortOrderOptions,
+	) ([]*models.ExternalIdsAudit, error)
+	ExternalIDAuditsCountBySeries(
+		ctx context.Context,
+		seriesID int,
+	) (int, error)
+
+	// Translation
+	TranslationsGetAllByFilm(
+		ctx context.Context,
+		filmID int,
+	) ([]*models.Translation, error)
+	TranslationsGetAllBySeries(
+		ctx context.Context,
+		seriesID int,
+	) ([]*models.Translation, error)
+	TranslationsGetAllByFilms(
+		ctx context.Context,
+		filmIDs []int,
+		languages []string,
+	) ([]*models.Translation, error)
+	TranslationsGetAllBySerieses(
+		ctx context.Context,
+		seriesIDs []int,
+		languages []string,
+	) ([]*models.Translation, error)
+	TranslationPutByFilm(
+		ctx context.Context,
+		filmID int,
+		contributorID int,
+		translation *models.Translation,
+	) error
+	TranslationPutBySeries(
+		ctx context.Context,
+		seriesID int,
+		contributorID int,
+		translation *models.Translation,
+	) error
+	TranslationAuditsGetAllByFilm(
+		ctx context.Context,
+		filmID int,
+		queryOptions query.SortOrderOptions,
+	) ([]*models.TranslationsAudit, error)
+	TranslationAuditsCountByFilm(
+		ctx context.Context,
+		filmID int,
+	) (int, error)
+	TranslationAuditsGetAllBySeries(
+		ctx context.Context,
+		seriesID int,
+		queryOptions query.SortOrderOptions,
+	) ([]*models.TranslationsAudit, error)
+	TranslationAuditsCountBySeries(
+		ctx context.Context,
+		seriesID int,
+	) (int, error)
+
+	// Release
+	ReleasesGetAllByFilm(
+		ctx context.Context,
+		filmID int,
+	) ([]*models.Release, error)
+	ReleasePut(
+		ctx context.Context,
+		filmID int,
+		contributorID int,
+		release *models.Release,
+	) error
+	ReleaseAuditsGetAllByFilm(
+		ctx context.Context,
+		filmID int,
+		queryOptions query.SortOrderOptions,
+	) ([]*models.ReleasesAudit, error)
+	ReleaseAuditsCountByFilm(
+		ctx context.Context,
+		filmID int,
+	) (int, error)
+
+	// Content Rating
+	ContentRatingsGetAllByFilm(
+		ctx context.Context,
+		filmID int,
+	) ([]*models.ContentRating, error)
+	ContentRatingPut(
+		ctx context.Context,
+		filmID int,
+		contributorID int,
+		contentRating *models.ContentRating,
+	) error
+	ContentRatingAuditsGetAllByFilm(
+		ctx context.Context,
+		filmID int,
+		queryOptions query.SortOrderOptions,
+	) ([]*models.ContentRatingsAudit, error)
+	ContentRatingAuditsCountByFilm(
+		ctx context.Context,
+		filmID int,
+	) (int, error)
+
+	// Media
+	MediaItemsGetAllByFilm(
+		ctx context.Context,
+		filmID int,
+	) ([]*models.MediaItem, error)
+	MediaItemsGetAllBySeries(
+		ctx context.Context,
+		seriesID int,
+	) ([]*models.MediaItem, error)
+	MediaItemCreate(
+		ctx context.Context,
+		contributorID int,
+		media *models.MediaItem,
+	) error
+	MediaItemUpdate(
+		ctx context.Context,
+		mediaID int,
+		contributorID int,
+		cols map[string]any,
+	) error
+	MediaItemAuditsGetAllByFilm(
+		ctx context.Context,
+		filmID int,
+		queryOptions query.SortOrderOptions,
+	) ([]*models.MediaItemsAudit, error)
+	MediaItemAuditsCountByFilm(
+		ctx context.Context,
+		filmID int,
+	) (int, error)
+	MediaItemAuditsGetAllBySeries(
+		ctx context.Context,
+		seriesID int,
+		queryOptions query.SortOrderOptions,
+	) ([]*models.MediaItemsAudit, error)
+	MediaItemAuditsCountBySeries(
+		ctx context.Context,
+		seriesID int,
+	) (int, error)
+
+	// Collection
+	CollectionGet(ctx context.Context, id int) (*models.Collection, error)
+	CollectionsGetAll(
+		ctx context.Context,
+		queryOptions query.Options,
+	) ([]*models.Collection, error)
+	CollectionsCount(ctx context.Context, invalidation string) (int, error)
+	CollectionCreate(
+		ctx context.Context,
+		contributorID int,
+		collection *models.Collection,
+	) error
+	CollectionUpdate(
+		ctx context.Context,
+		collectionID int,
+		contributorID int,
+		cols map[string]any,
+	) error
+	CollectionAuditsGetAll(
+		ctx context.Context,
+		id int,
+		queryOptions query.SortOrderOptions,
+	) ([]*models.CollectionsAudit, error)
+	CollectionAuditsCount(
+		ctx context.Context,
+		id int,
+	) (int, error)
+	CollectionItemsGetAll(
+		ctx context.Context,
+		collectionID int,
+	) ([]*collection.Item, error)
+	CollectionItemsPut(
+		ctx context.Context,
+		collectionID int,
+		contributorID int,
+		items []*models.CollectionItem,
+	) error
+	CollectionItemAuditsGetAll(
+		ctx context.Context,
+		collectionID int,
+		queryOptions query.SortOrderOptions,
+	) ([]*models.CollectionItemsAudit, error)
+	CollectionItemAuditsCount(
+		ctx context.Context,
+		collectionID int,
+	) (int, error)
+
+	// Import
+	ImportJobGet(ctx context.Context, id int) (*models.ImportJob, error)
+	ImportJobCreate(ctx context.Context, job *models.ImportJob) error
+	ImportJobUpdate(ctx context.Context, id int, cols map[string]any) error
+	ImportJobsUpdateAllByStatus(
+		ctx context.Context,
+		statuses []string,
+		cols map[string]any,
+	) (int, error)
+	ImportErrorCreate(
+		ctx context.Context,
+		importError *models.ImportError,
+	) error
+	ImportErrorsGetAll(
+		ctx context.Context,
+		jobID int,
+		queryOptions query.SortOrderOptions,
+	) ([]*models.ImportError, error)
+	ImportErrorsCount(ctx context.Context, jobID int) (int, error)
+
+	// Export
+	CatalogExportGetLatest(
+		ctx context.Context,
+		status string,
+	) (*models.CatalogExport, error)
+	CatalogExportCreate(ctx context.Context, export *models.CatalogExport) error
+	CatalogExportUpdate(ctx context.Context, id int, cols map[string]any) error
+	FilmsGetAllByCursor(
+		ctx context.Context,
+		cursor int,
+		limit int,
+	) ([]*models.Film, error)
+	SeriesesGetAllByCursor(
+		ctx context.Context,
+		cursor int,
+		limit int,
+	) ([]*models.Series, error)
+	FilmAuditsGetAllByCursor(
+		ctx context.Context,
+		cursor query.AuditCursor,
+		limit int,
+	) ([]*models.FilmsAudit, error)
+	SeriesAuditsGetAllByCursor(
+		ctx context.Context,
+		cursor query.AuditCursor,
+		limit int,
+	) ([]*models.SeriesesAudit, error)
+
+	// Audit retention
+	AuditsPrune(
+		ctx context.Context,
+		auditTable string,
+		retentionOptions query.AuditRetentionOptions,
+		limit int,
+	) (audits []string, err error)
+	AuditsPruneCount(
+		ctx context.Context,
+		auditTable string,
+		retentionOptions query.AuditRetentionOptions,
+	) (count int, err error)
+	AuditPruneGetLatest(ctx context.Context) (*models.AuditPrune, error)
+	AuditPruneCreate(ctx context.Context, prune *models.AuditPrune) error
+	AuditPruneUpdate(ctx context.Context, id int, cols map[string]any) error
+
+	// Contribution
+	ContributorStatsGet(
+		ctx context.Context,
+		userID int,
+		reputationOptions query.ReputationOptions,
+	) (*contribution.Stats, error)
+	ContributorStatsGetAll(
+		ctx context.Context,
+		reputationOptions query.ReputationOptions,
+		offset int,
+		limit int,
+	) (stats []*contribution.Stats, err error)
+	ContributorsCount(ctx context.Context) (count int, err error)
+	ContributionsGetAll(
+		ctx context.Context,
+		userID int,
+		queryOptions query.SortOrderOptions,
+	) (contributions []*contribution.Contribution, err error)
+	ContributionsCount(ctx context.Context, userID int) (count int, err error)
+
+	// Moderation
+	MovieSetDeletedAt(
+		ctx context.Context,
+		movieID int,
+		deletedAt null.Time,
+	) error
+	EpisodeSetDeletedAt(
+		ctx context.Context,
+		seriesID, seasonNumber, episodeNumber int,
+		deletedAt null.Time,
+	) error
+	SeriesSetDeletedAt(
+		ctx context.Context,
+		seriesID int,
+		deletedAt null.Time,
+	) error
+	MovieDelete(ctx context.Context, movieID int) error
+	EpisodeDelete(
+		ctx context.Context,
+		seriesID, seasonNumber, episodeNumber int,
+	) error
+	SeriesDelete(ctx context.Context, seriesID int) error
+
 	// Watchlist
 	WatchlistGet(
 		ctx context.Context,
@@ -180,12 +536,20 @@ type Service interface {
 		ctx context.Context,
 		userID int,
 		timeWatchedWhereClause string,
+		releaseOptions query.ReleaseOptions,
+		watchedOptions query.WatchedOptions,
+		itemOptions query.WatchlistItemOptions,
 	) (count int, err error)
 	WatchlistAdd(
 		ctx context.Context,
 		userID int,
 		filmID int,
 	) (watchID int, err error)
+	WatchlistAddAll(
+		ctx context.Context,
+		userID int,
+		filmIDs []int,
+	) (watchIDs []int, err error)
 	WatchlistDelete(
 		ctx context.Context,
 		userID int,
@@ -196,6 +560,310 @@ type Service interface {
 		userID int,
 		watchID int,
 	) error
+	WatchlistGetIDByFilm(
+		ctx context.Context,
+		userID int,
+		filmID int,
+	) (watchID int, err error)
+	WatchlistItemUpdate(
+		ctx context.Context,
+		userID int,
+		watchID int,
+		cols map[string]any,
+	) error
+	WatchlistTagsSet(
+		ctx context.Context,
+		userID int,
+		watchID int,
+		tags []string,
+	) error
+	WatchlistMove(
+		ctx context.Context,
+		userID int,
+		watchID int,
+		afterID null.Int,
+	) error
+	UpNextGet(
+		ctx context.Context,
+		userID int,
+		offset int,
+		limit int,
+	) (upNext []*watchlist.UpNext, err error)
+	UpNextCount(ctx context.Context, userID int) (count int, err error)
+	SeriesFollow(
+		ctx context.Context,
+		userID int,
+		seriesID int,
+	) error
+	SeriesUnfollow(
+		ctx context.Context,
+		userID int,
+		seriesID int,
+	) error
+	WatchEventCreate(
+		ctx context.Context,
+		userID int,
+		watchID int,
+		event *models.WatchEvent,
+	) error
+	WatchEventUndo(
+		ctx context.Context,
+		userID int,
+		watchID int,
+	) error
+	WatchEventsGetAll(
+		ctx context.Context,
+		userID int,
+		watchID int,
+		queryOptions query.SortOrderOptions,
+	) ([]*models.WatchEvent, error)
+	WatchEventsCount(
+		ctx context.Context,
+		userID int,
+		watchID int,
+	) (int, error)
+
+	// Playback Progress
+	PlaybackProgressGet(
+		ctx context.Context,
+		userID int,
+		filmID int,
+	) (*models.PlaybackProgress, error)
+	PlaybackProgressPut(
+		ctx context.Context,
+		progress *models.PlaybackProgress,
+	) (applied bool, err error)
+	ContinueWatchingGet(
+		ctx context.Context,
+		userID int,
+		offset int,
+		limit int,
+		watchedThresholdPercent int,
+	) ([]*watchlist.Progress, error)
+	ContinueWatchingCount(
+		ctx context.Context,
+		userID int,
+		watchedThresholdPercent int,
+	) (int, error)
+
+	// Score
+	FilmScoreGet(
+		ctx context.Context,
+		userID int,
+		filmID int,
+	) (*models.Score, error)
+	FilmScorePut(
+		ctx context.Context,
+		userID int,
+		filmID int,
+		score int,
+	) error
+	FilmScoreDelete(
+		ctx context.Context,
+		userID int,
+		filmID int,
+	) error
+	SeriesScoreGet(
+		ctx context.Context,
+		userID int,
+		seriesID int,
+	) (*models.Score, error)
+	SeriesScorePut(
+		ctx context.Context,
+		userID int,
+		seriesID int,
+		score int,
+	) error
+	SeriesScoreDelete(
+		ctx context.Context,
+		userID int,
+		seriesID int,
+	) error
+
+	// Review
+	ReviewGet(ctx context.Context, id int) (*models.Review, error)
+	FilmReviewGet(
+		ctx context.Context,
+		userID int,
+		filmID int,
+	) (*models.Review, error)
+	FilmReviewPut(
+		ctx context.Context,
+		userID int,
+		filmID int,
+		r *models.Review,
+	) error
+	FilmReviewDelete(
+		ctx context.Context,
+		userID int,
+		filmID int,
+	) error
+	ReviewsGetAllByFilm(
+		ctx context.Context,
+		filmID int,
+		viewerID int,
+		queryOptions query.ReviewOptions,
+	) ([]*review.Review, error)
+	ReviewsCountByFilm(
+		ctx context.Context,
+		filmID int,
+		viewerID int,
+	) (int, error)
+	SeriesReviewGet(
+		ctx context.Context,
+		userID int,
+		seriesID int,
+	) (*models.Review, error)
+	SeriesReviewPut(
+		ctx context.Context,
+		userID int,
+		seriesID int,
+		r *models.Review,
+	) error
+	SeriesReviewDelete(
+		ctx context.Context,
+		userID int,
+		seriesID int,
+	) error
+	ReviewsGetAllBySeries(
+		ctx context.Context,
+		seriesID int,
+		viewerID int,
+		queryOptions query.ReviewOptions,
+	) ([]*review.Review, error)
+	ReviewsCountBySeries(
+		ctx context.Context,
+		seriesID int,
+		viewerID int,
+	) (int, error)
+	ReviewSetStatus(ctx context.Context, id int, status string) error
+	ReviewVotePut(ctx context.Context, reviewID int, userID int) error
+	ReviewVoteDelete(ctx context.Context, reviewID int, userID int) error
+	ReviewAuditsGetAll(
+		ctx context.Context,
+		id int,
+		queryOptions query.SortOrderOptions,
+	) ([]*models.ReviewsAudit, error)
+	ReviewAuditsCount(ctx context.Context, id int) (int, error)
+
+	// List
+	ListGet(ctx context.Context, id int) (*models.List, error)
+	ListsGetAllByUser(
+		ctx context.Context,
+		userID int,
+		publicOnly bool,
+		queryOptions query.SortOrderOptions,
+	) ([]*models.List, error)
+	ListsCountByUser(
+		ctx context.Context,
+		userID int,
+		publicOnly bool,
+	) (int, error)
+	ListCreate(ctx context.Context, userID int, l *models.List) error
+	ListUpdate(
+		ctx context.Context,
+		userID int,
+		listID int,
+		cols map[string]any,
+	) error
+	ListDelete(ctx context.Context, userID int, listID int) error
+	ListItemsGetAll(ctx context.Context, listID int) ([]*list.Item, error)
+	ListItemAdd(
+		ctx context.Context,
+		userID int,
+		listID int,
+		item *models.ListItem,
+	) error
+	ListItemSetPosition(
+		ctx context.Context,
+		userID int,
+		listID int,
+		itemID int,
+		position int,
+	) error
+	ListItemDelete(
+		ctx context.Context,
+		userID int,
+		listID int,
+		itemID int,
+	) error
+	ListMemberGet(
+		ctx context.Context,
+		listID int,
+		userID int,
+	) (*models.ListMember, error)
+	ListMembersGetAll(ctx context.Context, listID int) ([]*list.Member, error)
+	ListMemberAdd(ctx context.Context, member *models.ListMember) error
+	ListMemberSetRole(
+		ctx context.Context,
+		userID int,
+		listID int,
+		memberID int,
+		role string,
+	) error
+	ListMemberDelete(
+		ctx context.Context,
+		userID int,
+		listID int,
+		memberID int,
+	) error
+	ListInviteGet(ctx context.Context, id int) (*models.ListInvite, error)
+	ListInvitesGetAllByList(
+		ctx context.Context,
+		listID int,
+	) ([]*models.ListInvite, error)
+	ListInvitesGetAllByUser(
+		ctx context.Context,
+		userID int,
+	) ([]*models.ListInvite, error)
+	ListInviteCreate(
+		ctx context.Context,
+		userID int,
+		invite *models.ListInvite,
+	) error
+	ListInviteDelete(ctx context.Context, userID int, inviteID int) error
+	ListActivityCreate(
+		ctx context.Context,
+		activity *models.ListActivity,
+	) error
+	ListActivitiesGetAll(
+		ctx context.Context,
+		listID int,
+		queryOptions query.SortOrderOptions,
+	) ([]*models.ListActivity, error)
+	ListActivitiesCount(ctx context.Context, listID int) (int, error)
+
+	// Stats
+	StatsGet(
+		ctx context.Context,
+		userID int,
+		statsOptions query.StatsOptions,
+	) (*stats.Stats, error)
+	StatsBucketsGet(
+		ctx context.Context,
+		userID int,
+		statsOptions query.StatsOptions,
+		unit string,
+	) ([]*stats.Bucket, error)
+	StatsTopSeriesGet(
+		ctx context.Context,
+		userID int,
+		statsOptions query.StatsOptions,
+		limit int,
+	) ([]*stats.SeriesTime, error)
+	StatsTopFilmsGet(
+		ctx context.Context,
+		userID int,
+		statsOptions query.StatsOptions,
+		limit int,
+	) ([]*stats.FilmTime, error)
+	YearReviewGet(
+		ctx context.Context,
+		userID int,
+		year int,
+		timezone string,
+	) (*models.YearReview, error)
+	YearReviewPut(ctx context.Context, review *models.YearReview) error
 }
 
 type Repository struct {
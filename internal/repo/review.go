@@ -0,0 +1,372 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/review"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// reviewTarget is the reviewed column of the reviews and the query listing
+// the reviews of its targets
+type reviewTarget struct {
+	column      string
+	getAllQuery string
+}
+
+var (
+	filmReviewTarget = reviewTarget{
+		column:      models.ReviewColumns.FilmID,
+		getAllQuery: filmReviewsGetAllQuery,
+	}
+	seriesReviewTarget = reviewTarget{
+		column:      models.ReviewColumns.SeriesID,
+		getAllQuery: seriesReviewsGetAllQuery,
+	}
+)
+
+// reviewSortColumns maps the reviews sort fields to their columns
+var reviewSortColumns = map[string]string{
+	query.ReviewSortHelpful: "COUNT(" + models.ReviewVoteTableColumns.ReviewID + ")",
+	query.ReviewSortRecent:  models.ReviewTableColumns.CreatedAt,
+}
+
+func (repo *Repository) ReviewGet(
+	ctx context.Context,
+	id int,
+) (*models.Review, error) {
+	r, err := models.FindReview(ctx, repo.exec, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return r, nil
+}
+
+func (repo *Repository) FilmReviewGet(
+	ctx context.Context,
+	userID int,
+	filmID int,
+) (*models.Review, error) {
+	return repo.reviewGet(ctx, filmReviewTarget, userID, filmID)
+}
+
+// FilmReviewPut writes the review of the user on the film or edits it
+func (repo *Repository) FilmReviewPut(
+	ctx context.Context,
+	userID int,
+	filmID int,
+	r *models.Review,
+) error {
+	r.UserID = userID
+	r.FilmID = null.IntFrom(filmID)
+	r.SeriesID = null.Int{}
+	return repo.reviewPut(ctx, filmReviewTarget, r)
+}
+
+func (repo *Repository) FilmReviewDelete(
+	ctx context.Context,
+	userID int,
+	filmID int,
+) error {
+	return repo.reviewDelete(ctx, filmReviewTarget, userID, filmID)
+}
+
+// ReviewsGetAllByFilm returns the published reviews of the film along with
+// the hidden review of the viewer if any
+func (repo *Repository) ReviewsGetAllByFilm(
+	ctx context.Context,
+	filmID int,
+	viewerID int,
+	queryOptions query.ReviewOptions,
+) ([]*review.Review, error) {
+	return repo.reviewsGetAll(
+		ctx,
+		filmReviewTarget,
+		filmID,
+		viewerID,
+		queryOptions,
+	)
+}
+
+func (repo *Repository) ReviewsCountByFilm(
+	ctx context.Context,
+	filmID int,
+	viewerID int,
+) (int, error) {
+	return repo.reviewsCount(ctx, filmReviewTarget, filmID, viewerID)
+}
+
+func (repo *Repository) SeriesReviewGet(
+	ctx context.Context,
+	userID int,
+	seriesID int,
+) (*models.Review, error) {
+	return repo.reviewGet(ctx, seriesReviewTarget, userID, seriesID)
+}
+
+// SeriesReviewPut writes the review of the user on the series or edits it
+func (repo *Repository) SeriesReviewPut(
+	ctx context.Context,
+	userID int,
+	seriesID int,
+	r *models.Review,
+) error {
+	r.UserID = userID
+	r.FilmID = null.Int{}
+	r.SeriesID = null.IntFrom(seriesID)
+	return repo.reviewPut(ctx, seriesReviewTarget, r)
+}
+
+func (repo *Repository) SeriesReviewDelete(
+	ctx context.Context,
+	userID int,
+	seriesID int,
+) error {
+	return repo.reviewDelete(ctx, seriesReviewTarget, userID, seriesID)
+}
+
+// ReviewsGetAllBySeries returns the published reviews of the series along
+// with the hidden review of the viewer if any
+func (repo *Repository) ReviewsGetAllBySeries(
+	ctx context.Context,
+	seriesID int,
+	viewerID int,
+	queryOptions query.ReviewOptions,
+) ([]*review.Review, error) {
+	return repo.reviewsGetAll(
+		ctx,
+		seriesReviewTarget,
+		seriesID,
+		viewerID,
+		queryOptions,
+	)
+}
+
+func (repo *Repository) ReviewsCountBySeries(
+	ctx context.Context,
+	seriesID int,
+	viewerID int,
+) (int, error) {
+	return repo.reviewsCount(ctx, seriesReviewTarget, seriesID, viewerID)
+}
+
+// ReviewSetStatus sets the moderation status of the review
+func (repo *Repository) ReviewSetStatus(
+	ctx context.Context,
+	id int,
+	status string,
+) error {
+	rowsAff, err := models.Reviews(
+		models.ReviewWhere.ID.EQ(id),
+	).UpdateAll(ctx, repo.exec, map[string]any{
+		models.ReviewColumns.Status: status,
+	})
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+// ReviewVotePut votes the review helpful: voting twice is a no-op
+func (repo *Repository) ReviewVotePut(
+	ctx context.Context,
+	reviewID int,
+	userID int,
+) error {
+	vote := &models.ReviewVote{ReviewID: reviewID, UserID: userID}
+	return vote.Upsert(
+		ctx,
+		repo.exec,
+		false,
+		[]string{
+			models.ReviewVoteColumns.ReviewID,
+			models.ReviewVoteColumns.UserID,
+		},
+		boil.None(),
+		boil.Infer(),
+	)
+}
+
+// As userID is not provided by the user, they cannot maliciously/inadvertently
+// withdraw the vote of another user
+func (repo *Repository) ReviewVoteDelete(
+	ctx context.Context,
+	reviewID int,
+	userID int,
+) error {
+	rowsAff, err := models.ReviewVotes(
+		models.ReviewVoteWhere.ReviewID.EQ(reviewID),
+		models.ReviewVoteWhere.UserID.EQ(userID),
+	).DeleteAll(ctx, repo.exec)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+// ReviewAuditsGetAll returns the edit history of the review
+func (repo *Repository) ReviewAuditsGetAll(
+	ctx context.Context,
+	id int,
+	queryOptions query.SortOrderOptions,
+) ([]*models.ReviewsAudit, error) {
+	audits, err := models.ReviewsAudits(
+		models.ReviewsAuditWhere.ID.EQ(id),
+		qm.Offset(queryOptions.Offset),
+		qm.Limit(queryOptions.Limit),
+		qm.OrderBy(
+			models.ReviewsAuditColumns.AuditedAt+" "+queryOptions.SortOrder,
+		),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return audits, nil
+}
+
+func (repo *Repository) ReviewAuditsCount(
+	ctx context.Context,
+	id int,
+) (int, error) {
+	auditsCount, err := models.ReviewsAudits(
+		models.ReviewsAuditWhere.ID.EQ(id),
+	).Count(ctx, repo.exec)
+	if err != nil {
+		return 0, err
+	}
+	return int(auditsCount), nil
+}
+
+func (repo *Repository) reviewGet(
+	ctx context.Context,
+	target reviewTarget,
+	userID int,
+	targetID int,
+) (*models.Review, error) {
+	r, err := models.Reviews(
+		models.ReviewWhere.UserID.EQ(userID),
+		qm.Where(target.column+" = ?", targetID),
+	).One(ctx, repo.exec)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return r, nil
+}
+
+// reviewPut inserts the review or updates the body and the spoiler flag of the
+// review of the user on the target: the previous version is audited
+func (repo *Repository) reviewPut(
+	ctx context.Context,
+	target reviewTarget,
+	r *models.Review,
+) error {
+	r.EditedAt = time.Now()
+	return r.Upsert(
+		ctx,
+		repo.exec,
+		true, // update on conflict
+		[]string{
+			// upsert on conflict on unique user target
+			models.ReviewColumns.UserID,
+			target.column,
+		},
+		boil.Whitelist(
+			models.ReviewColumns.Body,
+			models.ReviewColumns.Spoiler,
+			models.ReviewColumns.EditedAt,
+		),
+		boil.Infer(),
+	)
+}
+
+func (repo *Repository) reviewDelete(
+	ctx context.Context,
+	target reviewTarget,
+	userID int,
+	targetID int,
+) error {
+	rowsAff, err := models.Reviews(
+		models.ReviewWhere.UserID.EQ(userID),
+		qm.Where(target.column+" = ?", targetID),
+	).DeleteAll(ctx, repo.exec)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+func (repo *Repository) reviewsGetAll(
+	ctx context.Context,
+	target reviewTarget,
+	targetID int,
+	viewerID int,
+	queryOptions query.ReviewOptions,
+) (reviews []*review.Review, err error) {
+	column, ok := reviewSortColumns[queryOptions.SortField]
+	if !ok {
+		column = reviewSortColumns[query.ReviewSortRecent]
+	}
+	rows, err := repo.exec.QueryContext(
+		ctx,
+		fmt.Sprintf(
+			target.getAllQuery,
+			column+" "+queryOptions.SortOrder,
+			queryOptions.SortOrder,
+		),
+		targetID,
+		queryOptions.Offset,
+		queryOptions.Limit,
+		viewerID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	err = queries.Bind(rows, &reviews)
+	if err != nil {
+		return nil, err
+	}
+	return reviews, nil
+}
+
+func (repo *Repository) reviewsCount(
+	ctx context.Context,
+	target reviewTarget,
+	targetID int,
+	viewerID int,
+) (int, error) {
+	count, err := models.Reviews(
+		qm.Where(target.column+" = ?", targetID),
+		qm.Expr(
+			models.ReviewWhere.Status.EQ(review.StatusPublished),
+			qm.Or2(models.ReviewWhere.UserID.EQ(viewerID)),
+		),
+	).Count(ctx, repo.exec)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
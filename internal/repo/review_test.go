@@ -0,0 +1,215 @@
+package repo_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/review"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestFilmReview(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	users := []*models.User{{Email: "u1"}, {Email: "u2"}, {Email: "u3"}}
+	for _, user := range users {
+		err := r.UserCreate(ctx, user)
+		require.NoError(err)
+	}
+
+	movie := &models.Film{
+		Title:        "movie",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+	err := r.MovieCreate(ctx, users[0].ID, movie)
+	require.NoError(err)
+
+	// no review yet
+	_, err = r.FilmReviewGet(ctx, users[0].ID, movie.ID)
+	require.Equal(repo.ErrNoRecord, err)
+
+	// write reviews
+	reviews := make([]*models.Review, 2)
+	for i := range reviews {
+		reviews[i] = &models.Review{Body: "body", Spoiler: i == 1}
+		err = r.FilmReviewPut(ctx, users[i].ID, movie.ID, reviews[i])
+		require.NoError(err)
+	}
+
+	got, err := r.FilmReviewGet(ctx, users[1].ID, movie.ID)
+	require.NoError(err)
+	require.Equal(reviews[1].ID, got.ID)
+	require.True(got.Spoiler)
+	require.Equal(null.IntFrom(movie.ID), got.FilmID)
+	require.False(got.SeriesID.Valid)
+	require.Equal(review.StatusPublished, got.Status)
+
+	// edit the first review: the previous version is audited
+	edited := &models.Review{Body: "edited body"}
+	err = r.FilmReviewPut(ctx, users[0].ID, movie.ID, edited)
+	require.NoError(err)
+	require.Equal(reviews[0].ID, edited.ID)
+
+	got, err = r.ReviewGet(ctx, reviews[0].ID)
+	require.NoError(err)
+	require.Equal("edited body", got.Body)
+	require.Equal(reviews[0].CreatedAt.Unix(), got.CreatedAt.Unix())
+
+	audits, err := r.ReviewAuditsGetAll(
+		ctx,
+		reviews[0].ID,
+		query.SortOrderOptions{
+			SortOrder: "asc",
+			Offset:    0,
+			Limit:     math.MaxInt,
+		},
+	)
+	require.NoError(err)
+	require.Equal(1, len(audits))
+	require.Equal("body", audits[0].Body)
+	require.Equal(users[0].ID, audits[0].UserID)
+
+	auditsCount, err := r.ReviewAuditsCount(ctx, reviews[0].ID)
+	require.NoError(err)
+	require.Equal(1, auditsCount)
+
+	// vote the second review helpful: voting twice is a no-op
+	for i := 0; i < 2; i++ {
+		err = r.ReviewVotePut(ctx, reviews[1].ID, users[0].ID)
+		require.NoError(err)
+	}
+	err = r.ReviewVotePut(ctx, reviews[1].ID, users[2].ID)
+	require.NoError(err)
+
+	// sort the reviews by their helpful votes
+	fetched, err := r.ReviewsGetAllByFilm(
+		ctx,
+		movie.ID,
+		users[2].ID,
+		query.ReviewOptions{
+			Offset:    0,
+			Limit:     math.MaxInt,
+			SortField: query.ReviewSortHelpful,
+			SortOrder: "desc",
+		},
+	)
+	require.NoError(err)
+	require.Equal(2, len(fetched))
+	require.Equal(reviews[1].ID, fetched[0].ID)
+	require.Equal(2, fetched[0].HelpfulVotes)
+	require.Equal(reviews[0].ID, fetched[1].ID)
+	require.Equal(0, fetched[1].HelpfulVotes)
+
+	// withdraw a vote
+	err = r.ReviewVoteDelete(ctx, reviews[1].ID, users[2].ID)
+	require.NoError(err)
+	err = r.ReviewVoteDelete(ctx, reviews[1].ID, users[2].ID)
+	require.Equal(repo.ErrNoRecord, err)
+
+	// hide the second review: it is listed for its author only
+	err = r.ReviewSetStatus(ctx, reviews[1].ID, review.StatusHidden)
+	require.NoError(err)
+	err = r.ReviewSetStatus(ctx, math.MaxInt32, review.StatusHidden)
+	require.Equal(repo.ErrNoRecord, err)
+
+	for _, tc := range []struct {
+		viewerID int
+		expCount int
+	}{
+		{viewerID: users[0].ID, expCount: 1},
+		{viewerID: users[1].ID, expCount: 2},
+	} {
+		fetched, err = r.ReviewsGetAllByFilm(
+			ctx,
+			movie.ID,
+			tc.viewerID,
+			query.ReviewOptions{
+				Offset:    0,
+				Limit:     math.MaxInt,
+				SortField: query.ReviewSortRecent,
+				SortOrder: "asc",
+			},
+		)
+		require.NoError(err)
+		require.Equal(tc.expCount, len(fetched))
+
+		count, err := r.ReviewsCountByFilm(ctx, movie.ID, tc.viewerID)
+		require.NoError(err)
+		require.Equal(tc.expCount, count)
+	}
+
+	// delete the reviews
+	err = r.FilmReviewDelete(ctx, users[2].ID, movie.ID)
+	require.Equal(repo.ErrNoRecord, err)
+
+	err = r.FilmReviewDelete(ctx, users[1].ID, movie.ID)
+	require.NoError(err)
+	_, err = r.FilmReviewGet(ctx, users[1].ID, movie.ID)
+	require.Equal(repo.ErrNoRecord, err)
+}
+
+func TestSeriesReview(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "u1"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+
+	series := &models.Series{Title: "series"}
+	err = r.SeriesCreate(ctx, user.ID, series)
+	require.NoError(err)
+
+	// a user writes a single review per series
+	for _, body := range []string{"first", "second"} {
+		err = r.SeriesReviewPut(
+			ctx,
+			user.ID,
+			series.ID,
+			&models.Review{Body: body},
+		)
+		require.NoError(err)
+	}
+
+	got, err := r.SeriesReviewGet(ctx, user.ID, series.ID)
+	require.NoError(err)
+	require.Equal("second", got.Body)
+	require.Equal(null.IntFrom(series.ID), got.SeriesID)
+	require.False(got.FilmID.Valid)
+
+	fetched, err := r.ReviewsGetAllBySeries(
+		ctx,
+		series.ID,
+		user.ID,
+		query.ReviewOptions{Offset: 0, Limit: math.MaxInt},
+	)
+	require.NoError(err)
+	require.Equal(1, len(fetched))
+	require.Equal(got.ID, fetched[0].ID)
+
+	count, err := r.ReviewsCountBySeries(ctx, series.ID, user.ID)
+	require.NoError(err)
+	require.Equal(1, count)
+
+	err = r.SeriesReviewDelete(ctx, user.ID, series.ID)
+	require.NoError(err)
+	err = r.SeriesReviewDelete(ctx, user.ID, series.ID)
+	require.Equal(repo.ErrNoRecord, err)
+}
@@ -0,0 +1,265 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// scoreTarget is the scored column of the scores and the queries maintaining
+// the score aggregates of its targets
+type scoreTarget struct {
+	column                string
+	aggregatesEnsureQuery string
+	aggregatesAddQuery    string
+}
+
+var (
+	filmScoreTarget = scoreTarget{
+		column:                models.ScoreTableColumns.FilmID,
+		aggregatesEnsureQuery: filmScoreAggregatesEnsureQuery,
+		aggregatesAddQuery:    filmScoreAggregatesAddQuery,
+	}
+	seriesScoreTarget = scoreTarget{
+		column:                models.ScoreTableColumns.SeriesID,
+		aggregatesEnsureQuery: seriesScoreAggregatesEnsureQuery,
+		aggregatesAddQuery:    seriesScoreAggregatesAddQuery,
+	}
+)
+
+// loadFilmScoreAggregates loads the score aggregates of the films into their
+// FilmScoreAggregates relationship
+var loadFilmScoreAggregates = qm.Load(models.FilmRels.FilmScoreAggregates)
+
+// loadSeriesScoreAggregates loads the score aggregates of the serieses into
+// their SeriesSeriesScoreAggregates relationship
+var loadSeriesScoreAggregates = qm.Load(
+	models.SeriesRels.SeriesSeriesScoreAggregates,
+)
+
+// joinFilmScoreAggregates joins the films to their score aggregates to sort by
+// them
+var joinFilmScoreAggregates = qm.LeftOuterJoin(
+	models.TableNames.FilmScoreAggregates + " ON " +
+		models.FilmScoreAggregateTableColumns.FilmID + " = " +
+		models.FilmTableColumns.ID,
+)
+
+// joinSeriesScoreAggregates joins the serieses to their score aggregates to
+// sort by them
+var joinSeriesScoreAggregates = qm.LeftOuterJoin(
+	models.TableNames.SeriesScoreAggregates + " ON " +
+		models.SeriesScoreAggregateTableColumns.SeriesID + " = " +
+		models.SeriesTableColumns.ID,
+)
+
+// orderByScoreAware orders by the sort field: the unscored targets come last
+// when sorted by the score aggregates
+func orderByScoreAware(sortField string, sortOrder string) qm.QueryMod {
+	switch sortField {
+	case models.FilmScoreAggregateColumns.ScoresCount,
+		models.FilmScoreAggregateColumns.ScoreMean:
+		return qm.OrderBy(sortField + " " + sortOrder + " NULLS LAST")
+	}
+	return qm.OrderBy(sortField + " " + sortOrder)
+}
+
+func (repo *Repository) FilmScoreGet(
+	ctx context.Context,
+	userID int,
+	filmID int,
+) (*models.Score, error) {
+	return repo.scoreGet(ctx, filmScoreTarget, userID, filmID)
+}
+
+// FilmScorePut scores the film or edits the score of the user on the film
+func (repo *Repository) FilmScorePut(
+	ctx context.Context,
+	userID int,
+	filmID int,
+	score int,
+) error {
+	return repo.scorePut(
+		ctx,
+		filmScoreTarget,
+		&models.Score{
+			UserID: userID,
+			FilmID: null.IntFrom(filmID),
+			Score:  score,
+		},
+		filmID,
+	)
+}
+
+func (repo *Repository) FilmScoreDelete(
+	ctx context.Context,
+	userID int,
+	filmID int,
+) error {
+	return repo.scoreDelete(ctx, filmScoreTarget, userID, filmID)
+}
+
+func (repo *Repository) SeriesScoreGet(
+	ctx context.Context,
+	userID int,
+	seriesID int,
+) (*models.Score, error) {
+	return repo.scoreGet(ctx, seriesScoreTarget, userID, seriesID)
+}
+
+// SeriesScorePut scores the series or edits the score of the user on the
+// series
+func (repo *Repository) SeriesScorePut(
+	ctx context.Context,
+	userID int,
+	seriesID int,
+	score int,
+) error {
+	return repo.scorePut(
+		ctx,
+		seriesScoreTarget,
+		&models.Score{
+			UserID:   userID,
+			SeriesID: null.IntFrom(seriesID),
+			Score:    score,
+		},
+		seriesID,
+	)
+}
+
+func (repo *Repository) SeriesScoreDelete(
+	ctx context.Context,
+	userID int,
+	seriesID int,
+) error {
+	return repo.scoreDelete(ctx, seriesScoreTarget, userID, seriesID)
+}
+
+func (repo *Repository) scoreGet(
+	ctx context.Context,
+	target scoreTarget,
+	userID int,
+	targetID int,
+) (*models.Score, error) {
+	score, err := models.Scores(
+		models.ScoreWhere.UserID.EQ(userID),
+		qm.Where(target.column+" = ?", targetID),
+	).One(ctx, repo.exec)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return score, nil
+}
+
+// scorePut inserts the score or updates the score of the user on the target
+// and adjusts the score aggregates of the target by the difference
+func (repo *Repository) scorePut(
+	ctx context.Context,
+	target scoreTarget,
+	score *models.Score,
+	targetID int,
+) error {
+	old, err := models.Scores(
+		models.ScoreWhere.UserID.EQ(score.UserID),
+		qm.Where(target.column+" = ?", targetID),
+		qm.For("UPDATE"),
+	).One(ctx, repo.exec)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return err
+		}
+		err = score.Insert(ctx, repo.exec, boil.Infer())
+		if err != nil {
+			return err
+		}
+		return repo.scoreAggregatesAdd(ctx, target, targetID, 1, score.Score)
+	}
+	oldScore := old.Score
+	old.Score = score.Score
+	old.TimeScored = time.Now()
+	_, err = old.Update(
+		ctx,
+		repo.exec,
+		boil.Whitelist(
+			models.ScoreColumns.Score,
+			models.ScoreColumns.TimeScored,
+		),
+	)
+	if err != nil {
+		return err
+	}
+	if oldScore == score.Score {
+		return nil
+	}
+	err = repo.scoreAggregatesAdd(ctx, target, targetID, -1, oldScore)
+	if err != nil {
+		return err
+	}
+	return repo.scoreAggregatesAdd(ctx, target, targetID, 1, score.Score)
+}
+
+func (repo *Repository) scoreDelete(
+	ctx context.Context,
+	target scoreTarget,
+	userID int,
+	targetID int,
+) error {
+	score, err := models.Scores(
+		models.ScoreWhere.UserID.EQ(userID),
+		qm.Where(target.column+" = ?", targetID),
+		qm.For("UPDATE"),
+	).One(ctx, repo.exec)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrNoRecord
+		}
+		return err
+	}
+	_, err = score.Delete(ctx, repo.exec)
+	if err != nil {
+		return err
+	}
+	return repo.scoreAggregatesAdd(ctx, target, targetID, -1, score.Score)
+}
+
+// scoreAggregatesAdd adds (sign 1) or removes (sign -1) the score to the score
+// aggregates of the target
+func (repo *Repository) scoreAggregatesAdd(
+	ctx context.Context,
+	target scoreTarget,
+	targetID int,
+	sign int,
+	score int,
+) error {
+	_, err := repo.exec.ExecContext(ctx, target.aggregatesEnsureQuery, targetID)
+	if err != nil {
+		return err
+	}
+	_, err = repo.exec.ExecContext(
+		ctx,
+		target.aggregatesAddQuery,
+		targetID,
+		sign,
+		score,
+		strconv.Itoa(scoreBucket(score)),
+	)
+	return err
+}
+
+// scoreBucket returns the histogram bucket of the score: the lower bound of
+// its ten. 100 falls in 90
+func scoreBucket(score int) int {
+	if score >= 100 {
+		return 90
+	}
+	return score / 10 * 10
+}
@@ -0,0 +1,219 @@
+package repo_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestFilmScore(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	users := []*models.User{{Email: "u1"}, {Email: "u2"}}
+	for _, user := range users {
+		err := r.UserCreate(ctx, user)
+		require.NoError(err)
+	}
+
+	movies := make([]*models.Film, 3)
+	for i := range movies {
+		movies[i] = &models.Film{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		}
+		err := r.MovieCreate(ctx, users[0].ID, movies[i])
+		require.NoError(err)
+	}
+
+	scores := func(movieID int) *models.FilmScoreAggregate {
+		movie, err := r.MovieGet(ctx, movieID)
+		require.NoError(err)
+		if len(movie.R.FilmScoreAggregates) == 0 {
+			return nil
+		}
+		return movie.R.FilmScoreAggregates[0]
+	}
+
+	// no score yet
+	_, err := r.FilmScoreGet(ctx, users[0].ID, movies[0].ID)
+	require.Equal(repo.ErrNoRecord, err)
+	require.Nil(scores(movies[0].ID))
+
+	// score the first movie
+	err = r.FilmScorePut(ctx, users[0].ID, movies[0].ID, 80)
+	require.NoError(err)
+	err = r.FilmScorePut(ctx, users[1].ID, movies[0].ID, 100)
+	require.NoError(err)
+
+	score, err := r.FilmScoreGet(ctx, users[0].ID, movies[0].ID)
+	require.NoError(err)
+	require.Equal(80, score.Score)
+	require.Equal(null.IntFrom(movies[0].ID), score.FilmID)
+	require.False(score.SeriesID.Valid)
+
+	got := scores(movies[0].ID)
+	require.Equal(2, got.ScoresCount)
+	require.Equal(180, got.ScoresSum)
+	require.Equal(null.Float64From(90), got.ScoreMean)
+	require.JSONEq(
+		`{"0": 0, "10": 0, "20": 0, "30": 0, "40": 0, "50": 0, "60": 0, "70": 0, "80": 1, "90": 1}`,
+		string(got.ScoreHistogram),
+	)
+
+	// edit the score
+	err = r.FilmScorePut(ctx, users[0].ID, movies[0].ID, 35)
+	require.NoError(err)
+
+	score, err = r.FilmScoreGet(ctx, users[0].ID, movies[0].ID)
+	require.NoError(err)
+	require.Equal(35, score.Score)
+
+	got = scores(movies[0].ID)
+	require.Equal(2, got.ScoresCount)
+	require.Equal(135, got.ScoresSum)
+	require.Equal(null.Float64From(67.5), got.ScoreMean)
+	require.JSONEq(
+		`{"0": 0, "10": 0, "20": 0, "30": 1, "40": 0, "50": 0, "60": 0, "70": 0, "80": 0, "90": 1}`,
+		string(got.ScoreHistogram),
+	)
+
+	// score the second movie lower
+	err = r.FilmScorePut(ctx, users[0].ID, movies[1].ID, 10)
+	require.NoError(err)
+
+	// sort the movies by their score mean: the unscored movie comes last
+	for _, sortOrder := range []string{"asc", "desc"} {
+		fetchedMovies, err := r.MoviesGetAll(ctx, query.Options{
+			Offset:    0,
+			Limit:     math.MaxInt,
+			SortField: models.FilmScoreAggregateColumns.ScoreMean,
+			SortOrder: sortOrder,
+		}, query.ReleaseOptions{})
+		require.NoError(err)
+		require.Equal(3, len(fetchedMovies))
+		if sortOrder == "asc" {
+			require.Equal(movies[1].ID, fetchedMovies[0].ID)
+			require.Equal(movies[0].ID, fetchedMovies[1].ID)
+		} else {
+			require.Equal(movies[0].ID, fetchedMovies[0].ID)
+			require.Equal(movies[1].ID, fetchedMovies[1].ID)
+		}
+		require.Equal(movies[2].ID, fetchedMovies[2].ID)
+		require.Equal(0, len(fetchedMovies[2].R.FilmScoreAggregates))
+	}
+
+	// sort the watchlist by the score mean of the films
+	for _, movie := range movies {
+		_, err = r.WatchlistAdd(ctx, users[0].ID, movie.ID)
+		require.NoError(err)
+	}
+	items, err := r.WatchlistGet(ctx, users[0].ID, query.WatchlistOptions{
+		Offset:    0,
+		Limit:     math.MaxInt,
+		SortField: query.WatchlistSortScoreMean,
+		SortOrder: "desc",
+	})
+	require.NoError(err)
+	require.Equal(3, len(items))
+	require.Equal(movies[0].ID, items[0].Film.ID)
+	require.Equal(movies[1].ID, items[1].Film.ID)
+	require.Equal(movies[2].ID, items[2].Film.ID)
+
+	// delete the scores
+	err = r.FilmScoreDelete(ctx, users[0].ID, movies[2].ID)
+	require.Equal(repo.ErrNoRecord, err)
+
+	err = r.FilmScoreDelete(ctx, users[0].ID, movies[0].ID)
+	require.NoError(err)
+	_, err = r.FilmScoreGet(ctx, users[0].ID, movies[0].ID)
+	require.Equal(repo.ErrNoRecord, err)
+
+	got = scores(movies[0].ID)
+	require.Equal(1, got.ScoresCount)
+	require.Equal(null.Float64From(100), got.ScoreMean)
+
+	err = r.FilmScoreDelete(ctx, users[1].ID, movies[0].ID)
+	require.NoError(err)
+
+	got = scores(movies[0].ID)
+	require.Equal(0, got.ScoresCount)
+	require.Equal(0, got.ScoresSum)
+	require.False(got.ScoreMean.Valid)
+}
+
+func TestSeriesScore(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+
+	serieses := []*models.Series{{Title: "s1"}, {Title: "s2"}}
+	for _, series := range serieses {
+		err = r.SeriesCreate(ctx, user.ID, series)
+		require.NoError(err)
+	}
+
+	// no score yet
+	_, err = r.SeriesScoreGet(ctx, user.ID, serieses[0].ID)
+	require.Equal(repo.ErrNoRecord, err)
+
+	// score the second series
+	err = r.SeriesScorePut(ctx, user.ID, serieses[1].ID, 70)
+	require.NoError(err)
+
+	score, err := r.SeriesScoreGet(ctx, user.ID, serieses[1].ID)
+	require.NoError(err)
+	require.Equal(70, score.Score)
+	require.Equal(null.IntFrom(serieses[1].ID), score.SeriesID)
+	require.False(score.FilmID.Valid)
+
+	series, err := r.SeriesGet(ctx, serieses[1].ID)
+	require.NoError(err)
+	require.Equal(1, len(series.R.SeriesSeriesScoreAggregates))
+	got := series.R.SeriesSeriesScoreAggregates[0]
+	require.Equal(1, got.ScoresCount)
+	require.Equal(null.Float64From(70), got.ScoreMean)
+
+	// sort the serieses by their scores count: the unscored series comes last
+	fetchedSerieses, err := r.SeriesesGetAll(ctx, query.Options{
+		Offset:    0,
+		Limit:     math.MaxInt,
+		SortField: models.SeriesScoreAggregateColumns.ScoresCount,
+		SortOrder: "asc",
+	})
+	require.NoError(err)
+	require.Equal(2, len(fetchedSerieses))
+	require.Equal(serieses[1].ID, fetchedSerieses[0].ID)
+	require.Equal(serieses[0].ID, fetchedSerieses[1].ID)
+
+	// delete the score
+	err = r.SeriesScoreDelete(ctx, user.ID, serieses[1].ID)
+	require.NoError(err)
+	err = r.SeriesScoreDelete(ctx, user.ID, serieses[1].ID)
+	require.Equal(repo.ErrNoRecord, err)
+
+	series, err = r.SeriesGet(ctx, serieses[1].ID)
+	require.NoError(err)
+	require.Equal(0, series.R.SeriesSeriesScoreAggregates[0].ScoresCount)
+	require.False(series.R.SeriesSeriesScoreAggregates[0].ScoreMean.Valid)
+}
@@ -16,6 +16,9 @@ func (repo *Repository) SeriesGet(
 ) (*models.Series, error) {
 	serie, err := models.Serieses(
 		models.SeriesWhere.ID.EQ(id),
+		models.SeriesWhere.DeletedAt.IsNull(),
+		loadSeriesAggregates,
+		loadSeriesScoreAggregates,
 	).One(ctx, repo.exec)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -30,10 +33,23 @@ func (repo *Repository) SeriesesGetAll(
 	ctx context.Context,
 	queryOptions query.Options,
 ) ([]*models.Series, error) {
+	// the serieses are sortable by their aggregates and score aggregates
 	series, err := models.Serieses(
-		qm.Offset(queryOptions.Offset),
-		qm.Limit(queryOptions.Limit),
-		qm.OrderBy(queryOptions.SortField+" "+queryOptions.SortOrder),
+		append(
+			whereInvalidation(
+				models.SeriesTableColumns.Invalidation,
+				queryOptions.Invalidation,
+			),
+			qm.Select(models.TableNames.Serieses+".*"),
+			joinSeriesAggregates,
+			loadSeriesAggregates,
+			joinSeriesScoreAggregates,
+			loadSeriesScoreAggregates,
+			models.SeriesWhere.DeletedAt.IsNull(),
+			qm.Offset(queryOptions.Offset),
+			qm.Limit(queryOptions.Limit),
+			orderByScoreAware(queryOptions.SortField, queryOptions.SortOrder),
+		)...,
 	).All(ctx, repo.exec)
 	if err != nil {
 		return nil, err
@@ -41,8 +57,19 @@ func (repo *Repository) SeriesesGetAll(
 	return series, nil
 }
 
-func (repo *Repository) SeriesesCount(ctx context.Context) (int, error) {
-	nSerie, err := models.Serieses().Count(ctx, repo.exec)
+func (repo *Repository) SeriesesCount(
+	ctx context.Context,
+	invalidation string,
+) (int, error) {
+	nSerie, err := models.Serieses(
+		append(
+			whereInvalidation(
+				models.SeriesTableColumns.Invalidation,
+				invalidation,
+			),
+			models.SeriesWhere.DeletedAt.IsNull(),
+		)...,
+	).Count(ctx, repo.exec)
 	return int(nSerie), err
 }
 
@@ -52,7 +79,10 @@ func (repo *Repository) SeriesCreate(
 	series *models.Series,
 ) error {
 	series.ContributedBy = contributorID
-	return series.Insert(ctx, repo.exec, boil.Infer())
+	if err := series.Insert(ctx, repo.exec, boil.Infer()); err != nil {
+		return err
+	}
+	return repo.seriesAggregatesRefresh(ctx, series.ID)
 }
 
 func (repo *Repository) SeriesUpdate(
@@ -64,6 +94,7 @@ func (repo *Repository) SeriesUpdate(
 	cols[models.SeriesColumns.ContributedBy] = contributorID
 	rowsAff, err := models.Serieses(
 		models.SeriesWhere.ID.EQ(serieID),
+		models.SeriesWhere.DeletedAt.IsNull(),
 	).UpdateAll(ctx, repo.exec, cols)
 	if err != nil {
 		return err
@@ -0,0 +1,30 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// seriesAggregatesRefresh recomputes the aggregates of the series. it is called
+// by every write on the episodes of the series
+func (repo *Repository) seriesAggregatesRefresh(
+	ctx context.Context,
+	seriesID int,
+) error {
+	_, err := repo.exec.ExecContext(ctx, seriesAggregatesRefreshQuery, seriesID)
+	return err
+}
+
+// loadSeriesAggregates loads the aggregates of the serieses into their
+// SeriesSeriesAggregates relationship
+var loadSeriesAggregates = qm.Load(models.SeriesRels.SeriesSeriesAggregates)
+
+// joinSeriesAggregates joins the serieses to their aggregates to filter or
+// sort by them
+var joinSeriesAggregates = qm.LeftOuterJoin(
+	models.TableNames.SeriesAggregates + " ON " +
+		models.SeriesAggregateTableColumns.SeriesID + " = " +
+		models.SeriesTableColumns.ID,
+)
@@ -0,0 +1,138 @@
+package repo_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestSeriesAggregates(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+
+	serieses := []*models.Series{{Title: "s1"}, {Title: "s2"}}
+	for _, series := range serieses {
+		err = r.SeriesCreate(ctx, user.ID, series)
+		require.NoError(err)
+	}
+
+	aggregates := func(seriesID int) *models.SeriesAggregate {
+		series, err := r.SeriesGet(ctx, seriesID)
+		require.NoError(err)
+		require.Equal(1, len(series.R.SeriesSeriesAggregates))
+		return series.R.SeriesSeriesAggregates[0]
+	}
+
+	// a new series has no episodes
+	got := aggregates(serieses[0].ID)
+	require.Equal(0, got.SeasonsCount)
+	require.Equal(0, got.EpisodesCount)
+	require.Equal(0, got.TotalRuntime)
+	require.False(got.LatestEpisodeDate.Valid)
+	require.JSONEq(`{}`, string(got.SeasonEpisodes))
+
+	// put two episodes on season 1 and one on season 2 of the first series
+	episodes := []struct {
+		seasonNumber, episodeNumber int
+		film                        *models.Film
+	}{
+		{1, 1, &models.Film{
+			Title:        "e1",
+			Duration:     null.IntFrom(40),
+			DateReleased: testutils.Date(2000, 1, 1),
+		}},
+		{1, 2, &models.Film{
+			Title:        "e2",
+			Duration:     null.IntFrom(50),
+			DateReleased: testutils.Date(2000, 1, 8),
+		}},
+		{2, 1, &models.Film{
+			Title:        "e3",
+			Duration:     null.IntFrom(60),
+			DateReleased: testutils.Date(2001, 1, 1),
+		}},
+	}
+	for _, e := range episodes {
+		err = r.EpisodePut(
+			ctx,
+			serieses[0].ID, e.seasonNumber, e.episodeNumber,
+			user.ID,
+			e.film,
+		)
+		require.NoError(err)
+	}
+
+	got = aggregates(serieses[0].ID)
+	require.Equal(2, got.SeasonsCount)
+	require.Equal(3, got.EpisodesCount)
+	require.Equal(150, got.TotalRuntime)
+	require.True(got.FirstEpisodeDate.Valid)
+	require.True(
+		testutils.Date(2000, 1, 1).Equal(got.FirstEpisodeDate.Time.UTC()),
+	)
+	require.True(
+		testutils.Date(2001, 1, 1).Equal(got.LatestEpisodeDate.Time.UTC()),
+	)
+	require.JSONEq(`{"1": 2, "2": 1}`, string(got.SeasonEpisodes))
+
+	// hiding an episode drops it from the aggregates
+	err = r.EpisodeSetDeletedAt(
+		ctx,
+		serieses[0].ID, 2, 1,
+		null.TimeFrom(time.Now()),
+	)
+	require.NoError(err)
+
+	got = aggregates(serieses[0].ID)
+	require.Equal(1, got.SeasonsCount)
+	require.Equal(2, got.EpisodesCount)
+	require.Equal(90, got.TotalRuntime)
+	require.True(
+		testutils.Date(2000, 1, 8).Equal(got.LatestEpisodeDate.Time.UTC()),
+	)
+	require.JSONEq(`{"1": 2}`, string(got.SeasonEpisodes))
+
+	// sort the serieses by their episodes count
+	fetchedSerieses, err := r.SeriesesGetAll(ctx, query.Options{
+		Offset:    0,
+		Limit:     math.MaxInt,
+		SortField: models.SeriesAggregateColumns.EpisodesCount,
+		SortOrder: "desc",
+	})
+	require.NoError(err)
+	require.Equal(2, len(fetchedSerieses))
+	require.Equal(serieses[0].ID, fetchedSerieses[0].ID)
+	require.Equal(serieses[1].ID, fetchedSerieses[1].ID)
+	require.Equal(
+		2,
+		fetchedSerieses[0].R.SeriesSeriesAggregates[0].EpisodesCount,
+	)
+
+	// deleting the episodes empties the aggregates
+	err = r.EpisodeDelete(ctx, serieses[0].ID, 1, 1)
+	require.NoError(err)
+	err = r.EpisodeDelete(ctx, serieses[0].ID, 1, 2)
+	require.NoError(err)
+
+	got = aggregates(serieses[0].ID)
+	require.Equal(0, got.EpisodesCount)
+	require.Equal(0, got.TotalRuntime)
+	require.False(got.LatestEpisodeDate.Valid)
+}
@@ -0,0 +1,66 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// SeriesFollow makes the user follow the series. following a followed series
+// is a no-op
+func (repo *Repository) SeriesFollow(
+	ctx context.Context,
+	userID int,
+	seriesID int,
+) error {
+	follow := &models.SeriesFollow{UserID: userID, SeriesID: seriesID}
+	return follow.Upsert(
+		ctx,
+		repo.exec,
+		false,
+		[]string{
+			models.SeriesFollowColumns.UserID,
+			models.SeriesFollowColumns.SeriesID,
+		},
+		boil.None(),
+		boil.Infer(),
+	)
+}
+
+// As userID is not provided by the user, they cannot maliciously/inadvertently
+// unfollow the series for another user
+func (repo *Repository) SeriesUnfollow(
+	ctx context.Context,
+	userID int,
+	seriesID int,
+) error {
+	rowsAff, err := models.SeriesFollows(
+		models.SeriesFollowWhere.UserID.EQ(userID),
+		models.SeriesFollowWhere.SeriesID.EQ(seriesID),
+	).DeleteAll(ctx, repo.exec)
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+// watchlistAppendToFollowers appends the new episode of the series to the
+// watchlists of its followers. it is called by EpisodePut on inserts
+func (repo *Repository) watchlistAppendToFollowers(
+	ctx context.Context,
+	seriesID int,
+	episodeID int,
+) error {
+	_, err := repo.exec.ExecContext(
+		ctx,
+		watchlistAppendToFollowersQuery,
+		seriesID,
+		episodeID,
+		watchlistPositionSpacing,
+	)
+	return err
+}
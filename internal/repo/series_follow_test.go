@@ -0,0 +1,88 @@
+package repo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeriesFollow(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	follower := &models.User{Email: "follower"}
+	err := r.UserCreate(ctx, follower)
+	require.NoError(err)
+	user := &models.User{Email: "user"}
+	err = r.UserCreate(ctx, user)
+	require.NoError(err)
+
+	series := &models.Series{Title: "series"}
+	err = r.SeriesCreate(ctx, user.ID, series)
+	require.NoError(err)
+
+	watchlistCount := func(userID int) int {
+		count, err := r.WatchlistCount(
+			ctx,
+			userID,
+			repo.RawSqlWhereTimeWatchedEmptyClause,
+			query.ReleaseOptions{},
+			query.WatchedOptions{},
+			query.WatchlistItemOptions{},
+		)
+		require.NoError(err)
+		return count
+	}
+
+	// unfollowing a series not followed is not found
+	err = r.SeriesUnfollow(ctx, follower.ID, series.ID)
+	require.Equal(repo.ErrNoRecord, err)
+
+	// following twice is a no-op
+	err = r.SeriesFollow(ctx, follower.ID, series.ID)
+	require.NoError(err)
+	err = r.SeriesFollow(ctx, follower.ID, series.ID)
+	require.NoError(err)
+
+	// a new episode is appended to the follower watchlist only
+	err = r.EpisodePut(
+		ctx,
+		series.ID, 1, 1,
+		user.ID,
+		&models.Film{Title: "episode"},
+	)
+	require.NoError(err)
+	require.Equal(1, watchlistCount(follower.ID))
+	require.Equal(0, watchlistCount(user.ID))
+
+	// replacing the episode does not append it again
+	err = r.EpisodePut(
+		ctx,
+		series.ID, 1, 1,
+		user.ID,
+		&models.Film{Title: "new episode"},
+	)
+	require.NoError(err)
+	require.Equal(1, watchlistCount(follower.ID))
+
+	// no more episodes are appended once unfollowed
+	err = r.SeriesUnfollow(ctx, follower.ID, series.ID)
+	require.NoError(err)
+	err = r.EpisodePut(
+		ctx,
+		series.ID, 1, 2,
+		user.ID,
+		&models.Film{Title: "episode"},
+	)
+	require.NoError(err)
+	require.Equal(1, watchlistCount(follower.ID))
+}
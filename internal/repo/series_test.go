@@ -55,6 +55,8 @@ func TestSeriesGet(t *testing.T) {
 		&series.DateEnded.Time,
 		fetchedSeries.DateEnded.Time.Location(),
 	)
+	// aggregates are checked by TestSeriesAggregates
+	series.R = fetchedSeries.R
 
 	require.Equal(series, fetchedSeries)
 }
@@ -131,6 +133,7 @@ func TestSeriesesGetAll(t *testing.T) {
 			&serieses[i].DateEnded.Time,
 			fs.DateEnded.Time.Location(),
 		)
+		serieses[i].R = fs.R
 		require.Equal(serieses[i], fs)
 	}
 }
@@ -150,7 +153,7 @@ func TestSeriesesCount(t *testing.T) {
 
 	// first there's no serieses
 
-	nSerieses, err := r.SeriesesCount(ctx)
+	nSerieses, err := r.SeriesesCount(ctx, query.InvalidationInclude)
 	require.NoError(err)
 	require.Equal(0, nSerieses)
 
@@ -186,7 +189,7 @@ func TestSeriesesCount(t *testing.T) {
 
 	// count serieses
 
-	nSerieses, err = r.SeriesesCount(ctx)
+	nSerieses, err = r.SeriesesCount(ctx, query.InvalidationInclude)
 	require.NoError(err)
 	require.Equal(len(serieses), nSerieses)
 }
@@ -210,7 +213,7 @@ func TestSeriesCreate(t *testing.T) {
 
 	// first there's no series
 
-	nSerieses, err := r.SeriesesCount(ctx)
+	nSerieses, err := r.SeriesesCount(ctx, query.InvalidationInclude)
 	require.NoError(err)
 	require.Equal(0, nSerieses)
 
@@ -232,6 +235,8 @@ func TestSeriesCreate(t *testing.T) {
 		&series.DateEnded.Time,
 		fetchedSeries.DateEnded.Time.Location(),
 	)
+	// aggregates are checked by TestSeriesAggregates
+	series.R = fetchedSeries.R
 
 	require.Equal(series, fetchedSeries)
 }
@@ -307,6 +312,7 @@ func TestSeriesUpdate(t *testing.T) {
 			// let's imagine we don't care about ContributedAt field for a moment :|
 			ContributedAt: fetchedUpdatedSeries.ContributedAt,
 			Invalidation:  outdatedSeries.Invalidation,
+			R:             fetchedUpdatedSeries.R,
 		},
 		fetchedUpdatedSeries,
 	)
@@ -479,6 +485,8 @@ func TestSeriesAuditsGetAll(t *testing.T) {
 		}
 		auditedSeries.Invalidation = null.String{}
 		auditedSeries.ContributedBy = user.ID
+		auditedSeries.AuditAction = repo.AuditActionUpdate
+		auditedSeries.AuditActor = null.IntFrom(user.ID)
 		auditedSeries.ContributedAt = a.ContributedAt
 
 		testutils.SetTimeLocation(
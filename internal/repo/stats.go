@@ -0,0 +1,167 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/stats"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// StatsGet reads the totals and the streaks of the watches of the user
+// between the stats options dates
+func (repo *Repository) StatsGet(
+	ctx context.Context,
+	userID int,
+	statsOptions query.StatsOptions,
+) (*stats.Stats, error) {
+	s := new(stats.Stats)
+	err := repo.exec.QueryRowContext(
+		ctx,
+		statsTotalsQuery,
+		userID,
+		statsOptions.From,
+		statsOptions.To,
+		statsOptions.Timezone,
+	).Scan(
+		&s.MinutesWatched,
+		&s.HoursWatched,
+		&s.WatchesCount,
+		&s.MoviesCount,
+		&s.EpisodesCount,
+		&s.CurrentStreak,
+		&s.LongestStreak,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// StatsBucketsGet reads the watches of the user between the stats options
+// dates bucketed by unit: one of query.StatsBucket* constants
+func (repo *Repository) StatsBucketsGet(
+	ctx context.Context,
+	userID int,
+	statsOptions query.StatsOptions,
+	unit string,
+) (buckets []*stats.Bucket, err error) {
+	q, exists := statsBucketsQueries[unit]
+	if !exists {
+		return nil, fmt.Errorf("repo: invalid stats bucket unit %q", unit)
+	}
+	rows, err := repo.exec.QueryContext(
+		ctx,
+		q,
+		userID,
+		statsOptions.From,
+		statsOptions.To,
+		statsOptions.Timezone,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	err = queries.Bind(rows, &buckets)
+	if err != nil {
+		return nil, err
+	}
+	return buckets, nil
+}
+
+func (repo *Repository) StatsTopSeriesGet(
+	ctx context.Context,
+	userID int,
+	statsOptions query.StatsOptions,
+	limit int,
+) (topSeries []*stats.SeriesTime, err error) {
+	rows, err := repo.exec.QueryContext(
+		ctx,
+		statsTopSeriesQuery,
+		userID,
+		statsOptions.From,
+		statsOptions.To,
+		statsOptions.Timezone,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	err = queries.Bind(rows, &topSeries)
+	if err != nil {
+		return nil, err
+	}
+	return topSeries, nil
+}
+
+func (repo *Repository) StatsTopFilmsGet(
+	ctx context.Context,
+	userID int,
+	statsOptions query.StatsOptions,
+	limit int,
+) (topFilms []*stats.FilmTime, err error) {
+	rows, err := repo.exec.QueryContext(
+		ctx,
+		statsTopFilmsQuery,
+		userID,
+		statsOptions.From,
+		statsOptions.To,
+		statsOptions.Timezone,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	err = queries.Bind(rows, &topFilms)
+	if err != nil {
+		return nil, err
+	}
+	return topFilms, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (repo *Repository) YearReviewGet(
+	ctx context.Context,
+	userID int,
+	year int,
+	timezone string,
+) (*models.YearReview, error) {
+	review, err := models.FindYearReview(ctx, repo.exec, userID, year, timezone)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoRecord
+		}
+		return nil, err
+	}
+	return review, nil
+}
+
+// YearReviewPut creates the year review or replaces the report of the
+// existing one
+func (repo *Repository) YearReviewPut(
+	ctx context.Context,
+	review *models.YearReview,
+) error {
+	return review.Upsert(
+		ctx,
+		repo.exec,
+		true, // update on conflict
+		[]string{
+			models.YearReviewColumns.UserID,
+			models.YearReviewColumns.Year,
+			models.YearReviewColumns.Timezone,
+		},
+		boil.Whitelist(
+			models.YearReviewColumns.Report,
+			models.YearReviewColumns.GeneratedAt,
+		),
+		boil.Infer(),
+	)
+}
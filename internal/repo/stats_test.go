@@ -0,0 +1,202 @@
+package repo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/stats"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestStats(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+
+	movie := &models.Film{
+		Title:        "movie",
+		DateReleased: testutils.Date(2000, 1, 1),
+		Duration:     null.IntFrom(120 * 60),
+	}
+	err = r.MovieCreate(ctx, user.ID, movie)
+	require.NoError(err)
+
+	series := &models.Series{Title: "series"}
+	err = r.SeriesCreate(ctx, user.ID, series)
+	require.NoError(err)
+	episodes := make([]*models.Film, 2)
+	for i := range episodes {
+		episodes[i] = &models.Film{
+			Title:        "episode",
+			DateReleased: testutils.Date(2000, 1, 1),
+			Duration:     null.IntFrom(45 * 60),
+		}
+		err = r.EpisodePut(ctx, series.ID, 1, i+1, user.ID, episodes[i])
+		require.NoError(err)
+	}
+
+	// watch the movie twice and every episode once on three consecutive days
+	watchIDs := make(map[int]int)
+	for _, filmID := range []int{movie.ID, episodes[0].ID, episodes[1].ID} {
+		watchIDs[filmID], err = r.WatchlistAdd(ctx, user.ID, filmID)
+		require.NoError(err)
+	}
+	watch := func(filmID int, timeWatched time.Time) {
+		err := r.WatchEventCreate(
+			ctx,
+			user.ID,
+			watchIDs[filmID],
+			&models.WatchEvent{TimeWatched: timeWatched},
+		)
+		require.NoError(err)
+	}
+	watch(movie.ID, time.Date(2022, 3, 7, 22, 30, 0, 0, time.UTC))
+	watch(episodes[0].ID, time.Date(2022, 3, 8, 22, 30, 0, 0, time.UTC))
+	watch(episodes[1].ID, time.Date(2022, 3, 8, 23, 0, 0, 0, time.UTC))
+	watch(movie.ID, time.Date(2022, 3, 9, 10, 0, 0, 0, time.UTC))
+
+	utcOptions := query.StatsOptions{Timezone: "UTC"}
+	s, err := r.StatsGet(ctx, user.ID, utcOptions)
+	require.NoError(err)
+	require.Equal(&stats.Stats{
+		MinutesWatched: 330,
+		HoursWatched:   5.5,
+		WatchesCount:   4,
+		MoviesCount:    1,
+		EpisodesCount:  2,
+		CurrentStreak:  0,
+		LongestStreak:  3,
+	}, s)
+
+	hours, err := r.StatsBucketsGet(
+		ctx,
+		user.ID,
+		utcOptions,
+		query.StatsBucketHour,
+	)
+	require.NoError(err)
+	require.Equal([]*stats.Bucket{
+		{Value: 22, WatchesCount: 2, MinutesWatched: 165},
+		{Value: 10, WatchesCount: 1, MinutesWatched: 120},
+		{Value: 23, WatchesCount: 1, MinutesWatched: 45},
+	}, hours)
+
+	// the days shift in tokyo: the last three watches are on a wednesday
+	tokyoOptions := query.StatsOptions{Timezone: "Asia/Tokyo"}
+	s, err = r.StatsGet(ctx, user.ID, tokyoOptions)
+	require.NoError(err)
+	require.Equal(2, s.LongestStreak)
+	weekdays, err := r.StatsBucketsGet(
+		ctx,
+		user.ID,
+		tokyoOptions,
+		query.StatsBucketWeekday,
+	)
+	require.NoError(err)
+	require.Equal([]*stats.Bucket{
+		{Value: 3, WatchesCount: 3, MinutesWatched: 210},
+		{Value: 2, WatchesCount: 1, MinutesWatched: 120},
+	}, weekdays)
+
+	// the range bounds are inclusive
+	rangeOptions := query.StatsOptions{
+		From:     null.TimeFrom(time.Date(2022, 3, 8, 22, 30, 0, 0, time.UTC)),
+		To:       null.TimeFrom(time.Date(2022, 3, 8, 23, 0, 0, 0, time.UTC)),
+		Timezone: "UTC",
+	}
+	s, err = r.StatsGet(ctx, user.ID, rangeOptions)
+	require.NoError(err)
+	require.Equal(2, s.WatchesCount)
+	require.Equal(0, s.MoviesCount)
+	require.Equal(90, s.MinutesWatched)
+
+	topSeries, err := r.StatsTopSeriesGet(ctx, user.ID, utcOptions, 10)
+	require.NoError(err)
+	require.Equal(1, len(topSeries))
+	require.Equal(series.ID, topSeries[0].Series.ID)
+	require.Equal(90, topSeries[0].MinutesWatched)
+	require.Equal(2, topSeries[0].EpisodesCount)
+
+	topFilms, err := r.StatsTopFilmsGet(ctx, user.ID, utcOptions, 1)
+	require.NoError(err)
+	require.Equal(1, len(topFilms))
+	require.Equal(movie.ID, topFilms[0].Film.ID)
+	require.Equal(2, topFilms[0].WatchesCount)
+	require.Equal(240, topFilms[0].MinutesWatched)
+
+	months, err := r.StatsBucketsGet(
+		ctx,
+		user.ID,
+		utcOptions,
+		query.StatsBucketMonth,
+	)
+	require.NoError(err)
+	require.Equal([]*stats.Bucket{
+		{Value: 3, WatchesCount: 4, MinutesWatched: 330},
+	}, months)
+
+	_, err = r.StatsBucketsGet(ctx, user.ID, utcOptions, "decade")
+	require.Error(err)
+
+	// no stats for another user
+	s, err = r.StatsGet(ctx, user.ID+1, utcOptions)
+	require.NoError(err)
+	require.Equal(&stats.Stats{}, s)
+}
+
+func TestYearReview(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+
+	_, err = r.YearReviewGet(ctx, user.ID, 2022, "UTC")
+	require.Equal(repo.ErrNoRecord, err)
+
+	generatedAt := time.Now().Add(-time.Hour).Truncate(time.Microsecond)
+	review := &models.YearReview{
+		UserID:      user.ID,
+		Year:        2022,
+		Timezone:    "UTC",
+		Report:      []byte(`{"watches_count": 1}`),
+		GeneratedAt: generatedAt,
+	}
+	err = r.YearReviewPut(ctx, review)
+	require.NoError(err)
+
+	// replace the report
+	review.Report = []byte(`{"watches_count": 2}`)
+	review.GeneratedAt = generatedAt.Add(time.Hour)
+	err = r.YearReviewPut(ctx, review)
+	require.NoError(err)
+
+	got, err := r.YearReviewGet(ctx, user.ID, 2022, "UTC")
+	require.NoError(err)
+	require.JSONEq(`{"watches_count": 2}`, string(got.Report))
+	require.True(review.GeneratedAt.Equal(got.GeneratedAt))
+
+	// reviews are kept per time zone
+	_, err = r.YearReviewGet(ctx, user.ID, 2022, "Asia/Tokyo")
+	require.Equal(repo.ErrNoRecord, err)
+}
@@ -3,6 +3,7 @@ package repo
 import (
 	"context"
 	"database/sql"
+	"strconv"
 )
 
 func (repo *Repository) Tx(
@@ -15,6 +16,14 @@ func (repo *Repository) Tx(
 	if err != nil {
 		return err
 	}
+	// set the acting user read by the audit triggers
+	if actorID, ok := ActorFromContext(ctx); ok {
+		_, err = tx.ExecContext(ctx, txSetActorQuery, strconv.Itoa(actorID))
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
 	r := NewRepository(noOpBeginTx{tx})
 	defer func() {
 		if p := recover(); p != nil {
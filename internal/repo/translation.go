@@ -0,0 +1,201 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (repo *Repository) TranslationsGetAllByFilm(
+	ctx context.Context,
+	filmID int,
+) ([]*models.Translation, error) {
+	translations, err := models.Translations(
+		models.TranslationWhere.FilmID.EQ(null.IntFrom(filmID)),
+		qm.OrderBy(models.TranslationColumns.Language+" asc"),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return translations, nil
+}
+
+func (repo *Repository) TranslationsGetAllBySeries(
+	ctx context.Context,
+	seriesID int,
+) ([]*models.Translation, error) {
+	translations, err := models.Translations(
+		models.TranslationWhere.SeriesID.EQ(null.IntFrom(seriesID)),
+		qm.OrderBy(models.TranslationColumns.Language+" asc"),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return translations, nil
+}
+
+// TranslationsGetAllByFilms returns the translations of the films in any of
+// the languages: languages are language subtags e.g. pt matching pt-BR
+func (repo *Repository) TranslationsGetAllByFilms(
+	ctx context.Context,
+	filmIDs []int,
+	languages []string,
+) ([]*models.Translation, error) {
+	translations, err := models.Translations(
+		models.TranslationWhere.FilmID.IN(filmIDs),
+		whereLanguageIn(languages),
+		qm.OrderBy(models.TranslationColumns.Language+" asc"),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return translations, nil
+}
+
+// TranslationsGetAllBySerieses returns the translations of the serieses in any
+// of the languages: languages are language subtags e.g. pt matching pt-BR
+func (repo *Repository) TranslationsGetAllBySerieses(
+	ctx context.Context,
+	seriesIDs []int,
+	languages []string,
+) ([]*models.Translation, error) {
+	translations, err := models.Translations(
+		models.TranslationWhere.SeriesID.IN(seriesIDs),
+		whereLanguageIn(languages),
+		qm.OrderBy(models.TranslationColumns.Language+" asc"),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return translations, nil
+}
+
+func (repo *Repository) TranslationPutByFilm(
+	ctx context.Context,
+	filmID int,
+	contributorID int,
+	translation *models.Translation,
+) error {
+	translation.FilmID = null.IntFrom(filmID)
+	translation.SeriesID = null.Int{}
+	translation.ContributedBy = contributorID
+	return translation.Upsert(
+		ctx,
+		repo.exec,
+		true, // update on conflict
+		[]string{
+			// upsert on conflict on unique film language
+			models.TranslationColumns.FilmID,
+			models.TranslationColumns.Language,
+		},
+		boil.Infer(),
+		boil.Infer(),
+	)
+}
+
+func (repo *Repository) TranslationPutBySeries(
+	ctx context.Context,
+	seriesID int,
+	contributorID int,
+	translation *models.Translation,
+) error {
+	translation.FilmID = null.Int{}
+	translation.SeriesID = null.IntFrom(seriesID)
+	translation.ContributedBy = contributorID
+	return translation.Upsert(
+		ctx,
+		repo.exec,
+		true, // update on conflict
+		[]string{
+			// upsert on conflict on unique series language
+			models.TranslationColumns.SeriesID,
+			models.TranslationColumns.Language,
+		},
+		boil.Infer(),
+		boil.Infer(),
+	)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (repo *Repository) TranslationAuditsGetAllByFilm(
+	ctx context.Context,
+	filmID int,
+	queryOptions query.SortOrderOptions,
+) ([]*models.TranslationsAudit, error) {
+	audits, err := models.TranslationsAudits(
+		models.TranslationsAuditWhere.FilmID.EQ(null.IntFrom(filmID)),
+		qm.Offset(queryOptions.Offset),
+		qm.Limit(queryOptions.Limit),
+		qm.OrderBy(
+			models.TranslationsAuditColumns.ContributedAt+" "+queryOptions.SortOrder,
+		),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return audits, nil
+}
+
+func (repo *Repository) TranslationAuditsCountByFilm(
+	ctx context.Context,
+	filmID int,
+) (int, error) {
+	auditsCount, err := models.TranslationsAudits(
+		models.TranslationsAuditWhere.FilmID.EQ(null.IntFrom(filmID)),
+	).Count(ctx, repo.exec)
+	if err != nil {
+		return 0, err
+	}
+	return int(auditsCount), nil
+}
+
+func (repo *Repository) TranslationAuditsGetAllBySeries(
+	ctx context.Context,
+	seriesID int,
+	queryOptions query.SortOrderOptions,
+) ([]*models.TranslationsAudit, error) {
+	audits, err := models.TranslationsAudits(
+		models.TranslationsAuditWhere.SeriesID.EQ(null.IntFrom(seriesID)),
+		qm.Offset(queryOptions.Offset),
+		qm.Limit(queryOptions.Limit),
+		qm.OrderBy(
+			models.TranslationsAuditColumns.ContributedAt+" "+queryOptions.SortOrder,
+		),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return audits, nil
+}
+
+func (repo *Repository) TranslationAuditsCountBySeries(
+	ctx context.Context,
+	seriesID int,
+) (int, error) {
+	auditsCount, err := models.TranslationsAudits(
+		models.TranslationsAuditWhere.SeriesID.EQ(null.IntFrom(seriesID)),
+	).Count(ctx, repo.exec)
+	if err != nil {
+		return 0, err
+	}
+	return int(auditsCount), nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// whereLanguageIn matches the language subtag of the translation language
+func whereLanguageIn(languages []string) qm.QueryMod {
+	args := make([]any, len(languages))
+	for i, l := range languages {
+		args[i] = l
+	}
+	return qm.WhereIn(
+		"split_part("+models.TranslationColumns.Language+", '-', 1) IN ?",
+		args...,
+	)
+}
@@ -0,0 +1,366 @@
+package repo_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestTranslationsGetAllByFilm(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	movie := &models.Film{
+		Title:        "movie",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+	err = r.MovieCreate(ctx, user.ID, movie)
+	require.NoError(err)
+
+	// first there's no translations
+
+	fetchedTranslations, err := r.TranslationsGetAllByFilm(ctx, movie.ID)
+	require.NoError(err)
+	require.Equal(0, len(fetchedTranslations))
+
+	// put translations
+
+	translations := []*models.Translation{
+		{Language: "pt-BR", Title: "filme"},
+		{Language: "de", Title: "Film"},
+		{
+			Language:     "fa",
+			Title:        "فیلم",
+			Descriptions: null.StringFrom("توضیحات"),
+		},
+	}
+	for _, tr := range translations {
+		err = r.TranslationPutByFilm(ctx, movie.ID, user.ID, tr)
+		require.NoError(err)
+	}
+
+	// fetch translations: ordered by language
+
+	fetchedTranslations, err = r.TranslationsGetAllByFilm(ctx, movie.ID)
+	require.NoError(err)
+	require.Equal(len(translations), len(fetchedTranslations))
+
+	for i, expIndex := range []int{1, 2, 0} {
+		require.Equal(translations[expIndex].ID, fetchedTranslations[i].ID)
+		require.Equal(null.IntFrom(movie.ID), fetchedTranslations[i].FilmID)
+		require.Equal(
+			translations[expIndex].Language,
+			fetchedTranslations[i].Language,
+		)
+		require.Equal(
+			translations[expIndex].Title,
+			fetchedTranslations[i].Title,
+		)
+		require.Equal(
+			translations[expIndex].Descriptions,
+			fetchedTranslations[i].Descriptions,
+		)
+	}
+}
+
+func TestTranslationsGetAllByFilms(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	movies := []*models.Film{
+		{Title: "m1", DateReleased: testutils.Date(2000, 1, 1)},
+		{Title: "m2", DateReleased: testutils.Date(2001, 1, 1)},
+		{Title: "m3", DateReleased: testutils.Date(2002, 1, 1)},
+	}
+	for _, m := range movies {
+		err = r.MovieCreate(ctx, user.ID, m)
+		require.NoError(err)
+	}
+
+	for _, m := range movies {
+		for _, l := range []string{"de", "pt-BR", "pt-PT"} {
+			err = r.TranslationPutByFilm(
+				ctx,
+				m.ID,
+				user.ID,
+				&models.Translation{Language: l, Title: m.Title + " " + l},
+			)
+			require.NoError(err)
+		}
+	}
+
+	// fetch translations of the first two movies matching the language subtag
+
+	fetchedTranslations, err := r.TranslationsGetAllByFilms(
+		ctx,
+		[]int{movies[0].ID, movies[1].ID},
+		[]string{"pt", "fa"},
+	)
+	require.NoError(err)
+	require.Equal(4, len(fetchedTranslations))
+
+	for _, ft := range fetchedTranslations {
+		require.Contains(
+			[]null.Int{null.IntFrom(movies[0].ID), null.IntFrom(movies[1].ID)},
+			ft.FilmID,
+		)
+		require.Contains([]string{"pt-BR", "pt-PT"}, ft.Language)
+	}
+}
+
+func TestTranslationsGetAllBySerieses(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	series := &models.Series{
+		Title:       "series",
+		DateStarted: testutils.Date(2000, 1, 1),
+	}
+	err = r.SeriesCreate(ctx, user.ID, series)
+	require.NoError(err)
+
+	// first there's no translations
+
+	fetchedTranslations, err := r.TranslationsGetAllBySeries(ctx, series.ID)
+	require.NoError(err)
+	require.Equal(0, len(fetchedTranslations))
+
+	// put translations
+
+	translations := []*models.Translation{
+		{Language: "de", Title: "Serie"},
+		{Language: "fr", Title: "série"},
+	}
+	for _, tr := range translations {
+		err = r.TranslationPutBySeries(ctx, series.ID, user.ID, tr)
+		require.NoError(err)
+	}
+
+	fetchedTranslations, err = r.TranslationsGetAllBySeries(ctx, series.ID)
+	require.NoError(err)
+	require.Equal(len(translations), len(fetchedTranslations))
+
+	for i, ft := range fetchedTranslations {
+		require.Equal(translations[i].ID, ft.ID)
+		require.Equal(null.IntFrom(series.ID), ft.SeriesID)
+		require.Equal(null.Int{}, ft.FilmID)
+	}
+
+	// fetch translations by language subtag
+
+	fetchedTranslations, err = r.TranslationsGetAllBySerieses(
+		ctx,
+		[]int{series.ID},
+		[]string{"fr"},
+	)
+	require.NoError(err)
+	require.Equal(1, len(fetchedTranslations))
+	require.Equal(translations[1].ID, fetchedTranslations[0].ID)
+}
+
+func TestTranslationPutByFilm(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	movie := &models.Film{
+		Title:        "movie",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+	err = r.MovieCreate(ctx, user.ID, movie)
+	require.NoError(err)
+
+	// put a translation
+
+	translation := &models.Translation{Language: "de", Title: "Film"}
+	err = r.TranslationPutByFilm(ctx, movie.ID, user.ID, translation)
+	require.NoError(err)
+
+	// replace the translation of the same language
+
+	newTranslation := &models.Translation{
+		Language:     "de",
+		Title:        "Spielfilm",
+		Descriptions: null.StringFrom("Beschreibung"),
+	}
+	err = r.TranslationPutByFilm(ctx, movie.ID, user.ID, newTranslation)
+	require.NoError(err)
+	require.Equal(translation.ID, newTranslation.ID)
+
+	fetchedTranslations, err := r.TranslationsGetAllByFilm(ctx, movie.ID)
+	require.NoError(err)
+	require.Equal(1, len(fetchedTranslations))
+	require.Equal("Spielfilm", fetchedTranslations[0].Title)
+	require.Equal(
+		null.StringFrom("Beschreibung"),
+		fetchedTranslations[0].Descriptions,
+	)
+
+	// the replaced translation is audited
+
+	nAudits, err := r.TranslationAuditsCountByFilm(ctx, movie.ID)
+	require.NoError(err)
+	require.Equal(1, nAudits)
+}
+
+func TestTranslationAuditsGetAllByFilm(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	movie := &models.Film{
+		Title:        "movie",
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+	err = r.MovieCreate(ctx, user.ID, movie)
+	require.NoError(err)
+
+	queryOptions := query.SortOrderOptions{
+		Offset:    0,
+		Limit:     math.MaxInt,
+		SortOrder: "desc",
+	}
+
+	// first there's no audits
+
+	audits, err := r.TranslationAuditsGetAllByFilm(ctx, movie.ID, queryOptions)
+	require.NoError(err)
+	require.Equal(0, len(audits))
+
+	// audits for movie translation
+
+	values := []string{"title 1", "title 2", "title 3", "title 4"}
+	for _, v := range values {
+		err = r.TranslationPutByFilm(
+			ctx,
+			movie.ID,
+			user.ID,
+			&models.Translation{Language: "fr", Title: v},
+		)
+		require.NoError(err)
+	}
+
+	// fetch audits: the latest audit holds the previous value
+
+	audits, err = r.TranslationAuditsGetAllByFilm(ctx, movie.ID, queryOptions)
+	require.NoError(err)
+	require.Equal(len(values)-1, len(audits))
+
+	for i, a := range audits {
+		require.Equal(null.IntFrom(movie.ID), a.FilmID)
+		require.Equal("fr", a.Language)
+		require.Equal(values[len(values)-i-2], a.Title)
+		require.Equal(user.ID, a.ContributedBy)
+	}
+
+	// count audits
+
+	nAudits, err := r.TranslationAuditsCountByFilm(ctx, movie.ID)
+	require.NoError(err)
+	require.Equal(len(values)-1, nAudits)
+}
+
+func TestTranslationAuditsGetAllBySeries(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	series := &models.Series{
+		Title:       "series",
+		DateStarted: testutils.Date(2000, 1, 1),
+	}
+	err = r.SeriesCreate(ctx, user.ID, series)
+	require.NoError(err)
+
+	queryOptions := query.SortOrderOptions{
+		Offset:    0,
+		Limit:     math.MaxInt,
+		SortOrder: "asc",
+	}
+
+	// audits for series translation
+
+	values := []string{"title 1", "title 2", "title 3"}
+	for _, v := range values {
+		err = r.TranslationPutBySeries(
+			ctx,
+			series.ID,
+			user.ID,
+			&models.Translation{Language: "it", Title: v},
+		)
+		require.NoError(err)
+	}
+
+	// fetch audits: the oldest audit holds the first value
+
+	audits, err := r.TranslationAuditsGetAllBySeries(
+		ctx,
+		series.ID,
+		queryOptions,
+	)
+	require.NoError(err)
+	require.Equal(len(values)-1, len(audits))
+
+	for i, a := range audits {
+		require.Equal(null.IntFrom(series.ID), a.SeriesID)
+		require.Equal(values[i], a.Title)
+	}
+
+	// count audits
+
+	nAudits, err := r.TranslationAuditsCountBySeries(ctx, series.ID)
+	require.NoError(err)
+	require.Equal(len(values)-1, nAudits)
+}
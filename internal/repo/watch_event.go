@@ -0,0 +1,151 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// As userID is not provided by the user, they cannot maliciously/inadvertently
+// log a watch on another user watchlist. a zero TimeWatched logs the watch now
+func (repo *Repository) WatchEventCreate(
+	ctx context.Context,
+	userID int,
+	watchID int,
+	event *models.WatchEvent,
+) error {
+	var timeWatched null.Time
+	if !event.TimeWatched.IsZero() {
+		timeWatched = null.TimeFrom(event.TimeWatched)
+	}
+	err := repo.exec.QueryRowContext(
+		ctx,
+		watchEventCreateQuery,
+		watchID,
+		userID,
+		timeWatched,
+		event.Rating,
+		event.Device,
+	).Scan(&event.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrNoRecord
+		}
+		return err
+	}
+	event.WatchID = watchID
+	return nil
+}
+
+// As userID is not provided by the user, they cannot maliciously/inadvertently
+// undo a watch of another user watchlist
+func (repo *Repository) WatchEventUndo(
+	ctx context.Context,
+	userID int,
+	watchID int,
+) error {
+	result, err := repo.exec.ExecContext(
+		ctx,
+		watchEventUndoQuery,
+		watchID,
+		userID,
+	)
+	if err != nil {
+		return err
+	}
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAff == 0 {
+		return ErrNoRecord
+	}
+	return nil
+}
+
+func (repo *Repository) WatchEventsGetAll(
+	ctx context.Context,
+	userID int,
+	watchID int,
+	queryOptions query.SortOrderOptions,
+) ([]*models.WatchEvent, error) {
+	events, err := models.WatchEvents(
+		qm.Select(models.TableNames.WatchEvents+".*"),
+		joinWatchEventsWatchfilms,
+		models.WatchfilmWhere.ID.EQ(watchID),
+		models.WatchfilmWhere.UserID.EQ(userID),
+		qm.Offset(queryOptions.Offset),
+		qm.Limit(queryOptions.Limit),
+		qm.OrderBy(
+			models.WatchEventTableColumns.TimeWatched+" "+queryOptions.SortOrder,
+		),
+		qm.OrderBy(
+			models.WatchEventTableColumns.ID+" "+queryOptions.SortOrder,
+		),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (repo *Repository) WatchEventsCount(
+	ctx context.Context,
+	userID int,
+	watchID int,
+) (int, error) {
+	nEvents, err := models.WatchEvents(
+		joinWatchEventsWatchfilms,
+		models.WatchfilmWhere.ID.EQ(watchID),
+		models.WatchfilmWhere.UserID.EQ(userID),
+	).Count(ctx, repo.exec)
+	return int(nEvents), err
+}
+
+// joinWatchEventsWatchfilms joins the watch events to their watchlist items
+// to filter them by the user
+var joinWatchEventsWatchfilms = qm.InnerJoin(
+	models.TableNames.Watchfilms + " ON " +
+		models.WatchfilmTableColumns.ID + " = " +
+		models.WatchEventTableColumns.WatchID,
+)
+
+// watchedFilterClauses returns the where clauses of the watched options on
+// watchlist items with ? placeholders
+func watchedFilterClauses(
+	watchedOptions query.WatchedOptions,
+) (clauses []string, args [][]any) {
+	if watchedOptions.Times.Valid {
+		clauses = append(clauses, watchedTimesFilterClause)
+		args = append(args, []any{watchedOptions.Times.Int})
+	}
+	if watchedOptions.From.Valid || watchedOptions.To.Valid {
+		clause := watchedBetweenFilterClause
+		var clauseArgs []any
+		if watchedOptions.From.Valid {
+			clause += " AND " + models.WatchEventTableColumns.TimeWatched + " >= ?"
+			clauseArgs = append(clauseArgs, watchedOptions.From.Time)
+		}
+		if watchedOptions.To.Valid {
+			clause += " AND " + models.WatchEventTableColumns.TimeWatched + " <= ?"
+			clauseArgs = append(clauseArgs, watchedOptions.To.Time)
+		}
+		clauses = append(clauses, clause+")")
+		args = append(args, clauseArgs)
+	}
+	return clauses, args
+}
+
+// rawSqlWhereWatched returns the extended where clause of the watched options
+// of a raw query having its first placeholder numbered firstPlaceholder
+func rawSqlWhereWatched(
+	watchedOptions query.WatchedOptions,
+	firstPlaceholder int,
+) (whereClause string, args []any) {
+	clauses, clausesArgs := watchedFilterClauses(watchedOptions)
+	return rawSqlWhereClauses(clauses, clausesArgs, firstPlaceholder)
+}
@@ -0,0 +1,149 @@
+package repo_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestWatchEvents(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "user"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+	other := &models.User{Email: "other"}
+	err = r.UserCreate(ctx, other)
+	require.NoError(err)
+
+	movies := make([]*models.Film, 2)
+	watchIDs := make([]int, len(movies))
+	for i := range movies {
+		movies[i] = &models.Film{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		}
+		err = r.MovieCreate(ctx, user.ID, movies[i])
+		require.NoError(err)
+		watchIDs[i], err = r.WatchlistAdd(ctx, user.ID, movies[i].ID)
+		require.NoError(err)
+	}
+
+	timeWatched := func(watchID int) null.Time {
+		w, err := models.FindWatchfilm(ctx, db, watchID)
+		require.NoError(err)
+		return w.TimeWatched
+	}
+
+	// another user cannot log a watch nor undo it
+	err = r.WatchEventCreate(ctx, other.ID, watchIDs[0], &models.WatchEvent{})
+	require.Equal(repo.ErrNoRecord, err)
+	err = r.WatchEventUndo(ctx, user.ID, watchIDs[0])
+	require.Equal(repo.ErrNoRecord, err)
+
+	// log two watches of the first movie out of order
+	t1 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	event2 := &models.WatchEvent{
+		TimeWatched: t2,
+		Rating:      null.IntFrom(9),
+		Device:      null.StringFrom("tv"),
+	}
+	err = r.WatchEventCreate(ctx, user.ID, watchIDs[0], event2)
+	require.NoError(err)
+	require.NotZero(event2.ID)
+	event1 := &models.WatchEvent{TimeWatched: t1}
+	err = r.WatchEventCreate(ctx, user.ID, watchIDs[0], event1)
+	require.NoError(err)
+
+	// time watched is the latest watch
+	require.True(t2.Equal(timeWatched(watchIDs[0]).Time))
+
+	// log a watch of the second movie now
+	err = r.WatchlistSetWatched(ctx, user.ID, watchIDs[1])
+	require.NoError(err)
+	require.True(timeWatched(watchIDs[1]).Valid)
+
+	// history
+	events, err := r.WatchEventsGetAll(
+		ctx,
+		user.ID,
+		watchIDs[0],
+		query.SortOrderOptions{Offset: 0, Limit: math.MaxInt, SortOrder: "desc"},
+	)
+	require.NoError(err)
+	require.Equal(2, len(events))
+	require.Equal(event2.ID, events[0].ID)
+	require.Equal(null.IntFrom(9), events[0].Rating)
+	require.Equal(null.StringFrom("tv"), events[0].Device)
+	require.Equal(event1.ID, events[1].ID)
+	count, err := r.WatchEventsCount(ctx, user.ID, watchIDs[0])
+	require.NoError(err)
+	require.Equal(2, count)
+	count, err = r.WatchEventsCount(ctx, other.ID, watchIDs[0])
+	require.NoError(err)
+	require.Equal(0, count)
+
+	// filter by times watched and watch time
+	watchlistCount := func(watchedOptions query.WatchedOptions) int {
+		count, err := r.WatchlistCount(
+			ctx,
+			user.ID,
+			repo.RawSqlWhereTimeWatchedEmptyClause,
+			query.ReleaseOptions{},
+			watchedOptions,
+			query.WatchlistItemOptions{},
+		)
+		require.NoError(err)
+		return count
+	}
+	require.Equal(1, watchlistCount(query.WatchedOptions{Times: null.IntFrom(2)}))
+	require.Equal(0, watchlistCount(query.WatchedOptions{Times: null.IntFrom(3)}))
+	require.Equal(1, watchlistCount(query.WatchedOptions{
+		From: null.TimeFrom(t1.Add(-time.Hour)),
+		To:   null.TimeFrom(t1.Add(time.Hour)),
+	}))
+	require.Equal(2, watchlistCount(query.WatchedOptions{
+		From: null.TimeFrom(t1),
+	}))
+	require.Equal(0, watchlistCount(query.WatchedOptions{
+		To: null.TimeFrom(t1.Add(-time.Hour)),
+	}))
+
+	watchlist, err := r.WatchlistGet(ctx, user.ID, query.WatchlistOptions{
+		Offset:           0,
+		Limit:            math.MaxInt,
+		SortOrder:        "asc",
+		WhereTimeWatched: repo.RawSqlWhereTimeWatchedIsNotNull,
+		Watched:          query.WatchedOptions{Times: null.IntFrom(1)},
+	})
+	require.NoError(err)
+	require.Equal(1, len(watchlist))
+	require.Equal(watchIDs[1], watchlist[0].ID)
+
+	// undo the latest watch falls back to the previous one
+	err = r.WatchEventUndo(ctx, user.ID, watchIDs[0])
+	require.NoError(err)
+	require.True(t1.Equal(timeWatched(watchIDs[0]).Time))
+
+	// undo the last watch unmarks the item
+	err = r.WatchEventUndo(ctx, user.ID, watchIDs[0])
+	require.NoError(err)
+	require.False(timeWatched(watchIDs[0]).Valid)
+	err = r.WatchEventUndo(ctx, user.ID, watchIDs[0])
+	require.Equal(repo.ErrNoRecord, err)
+}
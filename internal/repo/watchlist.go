@@ -2,8 +2,8 @@ package repo
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
-	"time"
 
 	"github.com/aria3ppp/watchlist-server/internal/models"
 	"github.com/aria3ppp/watchlist-server/internal/query"
@@ -11,6 +11,7 @@ import (
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 func (repo *Repository) WatchlistGet(
@@ -18,17 +19,35 @@ func (repo *Repository) WatchlistGet(
 	userID int,
 	queryOptions query.WatchlistOptions,
 ) (watchlist []*watchlist.Item, err error) {
+	// extend where clause by release, watched and item options: placeholders
+	// start after user id, offset and limit
+	whereRelease, whereReleaseArgs := rawSqlWhereRelease(
+		queryOptions.Release,
+		4,
+	)
+	whereWatched, whereWatchedArgs := rawSqlWhereWatched(
+		queryOptions.Watched,
+		4+len(whereReleaseArgs),
+	)
+	whereItem, whereItemArgs := rawSqlWhereWatchlistItem(
+		queryOptions.Item,
+		4+len(whereReleaseArgs)+len(whereWatchedArgs),
+	)
+	args := append(
+		[]any{userID, queryOptions.Offset, queryOptions.Limit},
+		whereReleaseArgs...,
+	)
+	args = append(args, whereWatchedArgs...)
 	// query
 	rows, err := repo.exec.QueryContext(
 		ctx,
 		fmt.Sprintf(
 			watchfilmGetAllQuery,
-			queryOptions.WhereTimeWatched,
+			queryOptions.WhereTimeWatched+whereRelease+whereWatched+whereItem,
+			watchlistOrderBy(queryOptions.SortField, queryOptions.SortOrder),
 			queryOptions.SortOrder,
 		),
-		userID,
-		queryOptions.Offset,
-		queryOptions.Limit,
+		append(args, whereItemArgs...)...,
 	)
 	if err != nil {
 		return nil, err
@@ -42,16 +61,104 @@ func (repo *Repository) WatchlistGet(
 	return watchlist, nil
 }
 
+// watchlistSortColumns maps the watchlist sort fields to their columns
+var watchlistSortColumns = map[string]string{
+	query.WatchlistSortTimeAdded:    models.WatchfilmTableColumns.TimeAdded,
+	query.WatchlistSortTimeWatched:  models.WatchfilmTableColumns.TimeWatched,
+	query.WatchlistSortScoreMean:    models.FilmScoreAggregateTableColumns.ScoreMean,
+	query.WatchlistSortScoresCount:  models.FilmScoreAggregateTableColumns.ScoresCount,
+	query.WatchlistSortPosition:     models.WatchfilmTableColumns.Position,
+	query.WatchlistSortPriority:     models.WatchfilmTableColumns.Priority,
+	query.WatchlistSortDateReleased: models.FilmTableColumns.DateReleased,
+	query.WatchlistSortTitle:        models.FilmTableColumns.Title,
+	query.WatchlistSortDuration:     models.FilmTableColumns.Duration,
+}
+
+// watchlistOrderBy returns the order by clause of the watchlist sort field:
+// the unscored films and the films of unknown duration come last when sorted
+// by the score aggregates and the duration
+func watchlistOrderBy(sortField string, sortOrder string) string {
+	column, ok := watchlistSortColumns[sortField]
+	if !ok {
+		column = watchlistSortColumns[query.WatchlistSortTimeAdded]
+	}
+	switch sortField {
+	case query.WatchlistSortScoreMean,
+		query.WatchlistSortScoresCount,
+		query.WatchlistSortDuration:
+		return column + " " + sortOrder + " NULLS LAST"
+	}
+	return column + " " + sortOrder
+}
+
+// watchlistItemFilterClauses returns the where clauses of the item options on
+// watchlist items with ? placeholders
+func watchlistItemFilterClauses(
+	itemOptions query.WatchlistItemOptions,
+) (clauses []string, args [][]any) {
+	if itemOptions.Tag != "" {
+		clauses = append(clauses, watchlistTagFilterClause)
+		args = append(args, []any{itemOptions.Tag})
+	}
+	if itemOptions.Priority.Valid {
+		clauses = append(
+			clauses,
+			models.WatchfilmTableColumns.Priority+" = ?",
+		)
+		args = append(args, []any{itemOptions.Priority.Int})
+	}
+	return clauses, args
+}
+
+// watchlistTagFilterClause keeps the watchlist items tagged by the tag
+var watchlistTagFilterClause = fmt.Sprintf(
+	"EXISTS (SELECT 1 FROM %s WHERE %s = %s AND %s = ?)",
+	models.TableNames.WatchfilmTags,
+	models.WatchfilmTagTableColumns.WatchID,
+	models.WatchfilmTableColumns.ID,
+	models.WatchfilmTagTableColumns.Tag,
+)
+
+// rawSqlWhereWatchlistItem returns the extended where clause of the item
+// options of a raw query having its first placeholder numbered
+// firstPlaceholder
+func rawSqlWhereWatchlistItem(
+	itemOptions query.WatchlistItemOptions,
+	firstPlaceholder int,
+) (whereClause string, args []any) {
+	clauses, clausesArgs := watchlistItemFilterClauses(itemOptions)
+	return rawSqlWhereClauses(clauses, clausesArgs, firstPlaceholder)
+}
+
 func (repo *Repository) WatchlistCount(
 	ctx context.Context,
 	userID int,
 	timeWatchedWhereClause string,
+	releaseOptions query.ReleaseOptions,
+	watchedOptions query.WatchedOptions,
+	itemOptions query.WatchlistItemOptions,
 ) (count int, err error) {
+	// extend where clause by release, watched and item options: placeholders
+	// start after user id
+	whereRelease, whereReleaseArgs := rawSqlWhereRelease(releaseOptions, 2)
+	whereWatched, whereWatchedArgs := rawSqlWhereWatched(
+		watchedOptions,
+		2+len(whereReleaseArgs),
+	)
+	whereItem, whereItemArgs := rawSqlWhereWatchlistItem(
+		itemOptions,
+		2+len(whereReleaseArgs)+len(whereWatchedArgs),
+	)
+	args := append([]any{userID}, whereReleaseArgs...)
+	args = append(args, whereWatchedArgs...)
 	// query
 	row := repo.exec.QueryRowContext(
 		ctx,
-		fmt.Sprintf(watchfilmCountQuery, timeWatchedWhereClause),
-		userID,
+		fmt.Sprintf(
+			watchfilmCountQuery,
+			timeWatchedWhereClause+whereRelease+whereWatched+whereItem,
+		),
+		append(args, whereItemArgs...)...,
 	)
 	if err != nil {
 		return 0, err
@@ -64,12 +171,21 @@ func (repo *Repository) WatchlistCount(
 	return count, nil
 }
 
+// WatchlistAdd appends the film to the watchlist
 func (repo *Repository) WatchlistAdd(
 	ctx context.Context,
 	userID int,
 	filmID int,
 ) (watchID int, err error) {
-	w := &models.Watchfilm{UserID: userID, FilmID: filmID}
+	lastPosition, err := repo.watchlistLastPosition(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	w := &models.Watchfilm{
+		UserID:   userID,
+		FilmID:   filmID,
+		Position: lastPosition + watchlistPositionSpacing,
+	}
 	err = w.Insert(ctx, repo.exec, boil.Infer())
 	if err != nil {
 		return 0, err
@@ -77,6 +193,75 @@ func (repo *Repository) WatchlistAdd(
 	return w.ID, nil
 }
 
+// WatchlistAddAll appends the films to the watchlist in order: films already
+// in the watchlist are skipped. watchIDs are the ids of the added films
+func (repo *Repository) WatchlistAddAll(
+	ctx context.Context,
+	userID int,
+	filmIDs []int,
+) (watchIDs []int, err error) {
+	if len(filmIDs) == 0 {
+		return nil, nil
+	}
+	present, err := models.Watchfilms(
+		qm.Select(models.WatchfilmColumns.FilmID),
+		models.WatchfilmWhere.UserID.EQ(userID),
+		models.WatchfilmWhere.FilmID.IN(filmIDs),
+	).All(ctx, repo.exec)
+	if err != nil {
+		return nil, err
+	}
+	skip := make(map[int]bool, len(filmIDs))
+	for _, w := range present {
+		skip[w.FilmID] = true
+	}
+	position, err := repo.watchlistLastPosition(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, filmID := range filmIDs {
+		if skip[filmID] {
+			continue
+		}
+		skip[filmID] = true
+		position += watchlistPositionSpacing
+		w := &models.Watchfilm{
+			UserID:   userID,
+			FilmID:   filmID,
+			Position: position,
+		}
+		err = w.Insert(ctx, repo.exec, boil.Infer())
+		if err != nil {
+			return nil, err
+		}
+		watchIDs = append(watchIDs, w.ID)
+	}
+	return watchIDs, nil
+}
+
+// WatchlistGetIDByFilm returns the id of the latest added watchlist item of
+// the film
+func (repo *Repository) WatchlistGetIDByFilm(
+	ctx context.Context,
+	userID int,
+	filmID int,
+) (watchID int, err error) {
+	w, err := models.Watchfilms(
+		qm.Select(models.WatchfilmColumns.ID),
+		models.WatchfilmWhere.UserID.EQ(userID),
+		models.WatchfilmWhere.FilmID.EQ(filmID),
+		qm.OrderBy(models.WatchfilmColumns.TimeAdded+" DESC"),
+		qm.OrderBy(models.WatchfilmColumns.ID+" DESC"),
+	).One(ctx, repo.exec)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, ErrNoRecord
+		}
+		return 0, err
+	}
+	return w.ID, nil
+}
+
 // As userID is not provided by the user, they cannot maliciously/inadvertently
 // delete another user watchlist
 func (repo *Repository) WatchlistDelete(
@@ -97,21 +282,43 @@ func (repo *Repository) WatchlistDelete(
 	return nil
 }
 
-// As userID is not provided by the user, they cannot maliciously/inadvertently
-// delete another user watchlist
+// WatchlistSetWatched logs a watch of the watchlist item now
 func (repo *Repository) WatchlistSetWatched(
 	ctx context.Context,
 	userID int,
 	watchID int,
+) error {
+	return repo.WatchEventCreate(ctx, userID, watchID, &models.WatchEvent{})
+}
+
+// watchlistPositionSpacing spaces the positions of the watchlist items so an
+// item moves between two others without renumbering the watchlist
+const watchlistPositionSpacing = 1024
+
+func (repo *Repository) watchlistLastPosition(
+	ctx context.Context,
+	userID int,
+) (position int, err error) {
+	err = repo.exec.QueryRowContext(
+		ctx,
+		watchlistLastPositionQuery,
+		userID,
+	).Scan(&position)
+	return position, err
+}
+
+// As userID is not provided by the user, they cannot maliciously/inadvertently
+// update another user watchlist
+func (repo *Repository) WatchlistItemUpdate(
+	ctx context.Context,
+	userID int,
+	watchID int,
+	cols map[string]any,
 ) error {
 	rowsAff, err := models.Watchfilms(
 		models.WatchfilmWhere.ID.EQ(watchID),
 		models.WatchfilmWhere.UserID.EQ(userID),
-	).UpdateAll(
-		ctx, repo.exec, map[string]any{
-			models.WatchfilmColumns.TimeWatched: null.TimeFrom(time.Now()),
-		},
-	)
+	).UpdateAll(ctx, repo.exec, cols)
 	if err != nil {
 		return err
 	}
@@ -120,3 +327,159 @@ func (repo *Repository) WatchlistSetWatched(
 	}
 	return nil
 }
+
+// WatchlistTagsSet replaces the tags of the watchlist item.
+// As userID is not provided by the user, they cannot maliciously/inadvertently
+// tag another user watchlist
+func (repo *Repository) WatchlistTagsSet(
+	ctx context.Context,
+	userID int,
+	watchID int,
+	tags []string,
+) error {
+	exists, err := models.Watchfilms(
+		models.WatchfilmWhere.ID.EQ(watchID),
+		models.WatchfilmWhere.UserID.EQ(userID),
+	).Exists(ctx, repo.exec)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrNoRecord
+	}
+	_, err = models.WatchfilmTags(
+		models.WatchfilmTagWhere.WatchID.EQ(watchID),
+	).DeleteAll(ctx, repo.exec)
+	if err != nil {
+		return err
+	}
+	for _, tag := range tags {
+		t := &models.WatchfilmTag{WatchID: watchID, Tag: tag}
+		err = t.Insert(ctx, repo.exec, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WatchlistMove moves the watchlist item right after the item of afterID or to
+// the top if not valid: the item takes the middle of the gap between its new
+// neighbours and the watchlist is renumbered only once the gap is used up.
+// As userID is not provided by the user, they cannot maliciously/inadvertently
+// reorder another user watchlist
+func (repo *Repository) WatchlistMove(
+	ctx context.Context,
+	userID int,
+	watchID int,
+	afterID null.Int,
+) error {
+	previous, next, err := repo.watchlistPositionGap(
+		ctx,
+		userID,
+		watchID,
+		afterID,
+	)
+	if err != nil {
+		return err
+	}
+	if next-previous < 2 {
+		_, err = repo.exec.ExecContext(
+			ctx,
+			watchlistRenumberQuery,
+			userID,
+			watchlistPositionSpacing,
+		)
+		if err != nil {
+			return err
+		}
+		previous, next, err = repo.watchlistPositionGap(
+			ctx,
+			userID,
+			watchID,
+			afterID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return repo.WatchlistItemUpdate(ctx, userID, watchID, map[string]any{
+		models.WatchfilmColumns.Position: previous + (next-previous)/2,
+	})
+}
+
+// watchlistPositionGap returns the positions the watchlist item moves between
+// to move after the item of afterID: the gap after the last item is as wide as
+// the position spacing
+func (repo *Repository) watchlistPositionGap(
+	ctx context.Context,
+	userID int,
+	watchID int,
+	afterID null.Int,
+) (previous int, next int, err error) {
+	if afterID.Valid {
+		after, err := models.Watchfilms(
+			qm.Select(models.WatchfilmColumns.Position),
+			models.WatchfilmWhere.ID.EQ(afterID.Int),
+			models.WatchfilmWhere.UserID.EQ(userID),
+		).One(ctx, repo.exec)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return 0, 0, ErrNoRecord
+			}
+			return 0, 0, err
+		}
+		previous = after.Position
+	}
+	var nextPosition null.Int
+	err = repo.exec.QueryRowContext(
+		ctx,
+		watchlistNextPositionQuery,
+		userID,
+		previous,
+		watchID,
+	).Scan(&nextPosition)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !nextPosition.Valid {
+		return previous, previous + 2*watchlistPositionSpacing, nil
+	}
+	return previous, nextPosition.Int, nil
+}
+
+// UpNextGet reads the next episode to watch of every series the user has an
+// episode of in the watchlist by season and episode order with the progress of
+// the user: the serieses caught up are skipped and the recently active come
+// first
+func (repo *Repository) UpNextGet(
+	ctx context.Context,
+	userID int,
+	offset int,
+	limit int,
+) (upNext []*watchlist.UpNext, err error) {
+	rows, err := repo.exec.QueryContext(
+		ctx,
+		upNextGetQuery,
+		userID,
+		offset,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	err = queries.Bind(rows, &upNext)
+	if err != nil {
+		return nil, err
+	}
+	return upNext, nil
+}
+
+func (repo *Repository) UpNextCount(
+	ctx context.Context,
+	userID int,
+) (count int, err error) {
+	err = repo.exec.QueryRowContext(ctx, upNextCountQuery, userID).Scan(&count)
+	return count, err
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/aria3ppp/watchlist-server/internal/query"
 	"github.com/aria3ppp/watchlist-server/internal/repo"
 	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/aria3ppp/watchlist-server/internal/watchlist"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 	"github.com/volatiletech/null/v8"
 )
@@ -88,6 +90,7 @@ func TestWatchlistGet(t *testing.T) {
 				FilmID:      film.ID,
 				TimeAdded:   wf.TimeAdded,
 				TimeWatched: null.Time{},
+				Position:    wf.Position,
 			},
 			wf.Watchfilm,
 		)
@@ -144,6 +147,9 @@ func TestWatchlistCount(t *testing.T) {
 		ctx,
 		user.ID,
 		repo.RawSqlWhereTimeWatchedEmptyClause,
+		query.ReleaseOptions{},
+		query.WatchedOptions{},
+		query.WatchlistItemOptions{},
 	)
 	require.NoError(err)
 	require.Equal(0, count)
@@ -174,6 +180,9 @@ func TestWatchlistCount(t *testing.T) {
 		ctx,
 		user.ID,
 		repo.RawSqlWhereTimeWatchedIsNull,
+		query.ReleaseOptions{},
+		query.WatchedOptions{},
+		query.WatchlistItemOptions{},
 	)
 	require.NoError(err)
 	require.Equal(len(films), count)
@@ -189,6 +198,9 @@ func TestWatchlistCount(t *testing.T) {
 		ctx,
 		user.ID,
 		repo.RawSqlWhereTimeWatchedIsNotNull,
+		query.ReleaseOptions{},
+		query.WatchedOptions{},
+		query.WatchlistItemOptions{},
 	)
 	require.NoError(err)
 	require.Equal(len(watchIDs), count)
@@ -199,6 +211,9 @@ func TestWatchlistCount(t *testing.T) {
 		ctx,
 		user.ID,
 		repo.RawSqlWhereTimeWatchedIsNull,
+		query.ReleaseOptions{},
+		query.WatchedOptions{},
+		query.WatchlistItemOptions{},
 	)
 	require.NoError(err)
 	require.Equal(0, count)
@@ -223,6 +238,9 @@ func TestWatchlistAdd(t *testing.T) {
 		ctx,
 		user.ID,
 		repo.RawSqlWhereTimeWatchedEmptyClause,
+		query.ReleaseOptions{},
+		query.WatchedOptions{},
+		query.WatchlistItemOptions{},
 	)
 	require.NoError(err)
 	require.Equal(0, count)
@@ -257,6 +275,7 @@ func TestWatchlistAdd(t *testing.T) {
 			FilmID:      watchlist[0].FilmID,
 			TimeAdded:   watchlist[0].TimeAdded,
 			TimeWatched: null.Time{},
+			Position:    watchlist[0].Position,
 		},
 		watchlist[0].Watchfilm,
 	)
@@ -287,6 +306,9 @@ func TestWatchlistDelete(t *testing.T) {
 		ctx,
 		user.ID,
 		repo.RawSqlWhereTimeWatchedEmptyClause,
+		query.ReleaseOptions{},
+		query.WatchedOptions{},
+		query.WatchlistItemOptions{},
 	)
 	require.NoError(err)
 	require.Equal(0, count)
@@ -354,6 +376,7 @@ func TestWatchlistSetWatched(t *testing.T) {
 			FilmID:      watchlist[0].FilmID,
 			TimeAdded:   watchlist[0].TimeAdded,
 			TimeWatched: null.Time{},
+			Position:    watchlist[0].Position,
 		},
 		watchlist[0].Watchfilm,
 	)
@@ -390,6 +413,7 @@ func TestWatchlistSetWatched(t *testing.T) {
 			FilmID:      watchlist[0].FilmID,
 			TimeAdded:   watchlist[0].TimeAdded,
 			TimeWatched: null.TimeFrom(watchlist[0].TimeWatched.Time),
+			Position:    watchlist[0].Position,
 		},
 		watchlist[0].Watchfilm,
 	)
@@ -411,3 +435,328 @@ func TestWatchlistSetWatched(t *testing.T) {
 	require.NoError(err)
 	require.Equal(0, len(watchlist))
 }
+
+func TestWatchlistMove(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+
+	watchIDs := make([]int, 3)
+	for i := range watchIDs {
+		film := &models.Film{Title: "film"}
+		err = r.MovieCreate(ctx, user.ID, film)
+		require.NoError(err)
+		watchIDs[i], err = r.WatchlistAdd(ctx, user.ID, film.ID)
+		require.NoError(err)
+	}
+
+	watchlistOrder := func() []int {
+		watchlist, err := r.WatchlistGet(ctx, user.ID, query.WatchlistOptions{
+			Offset:           0,
+			Limit:            math.MaxInt,
+			SortField:        query.WatchlistSortPosition,
+			SortOrder:        "asc",
+			WhereTimeWatched: repo.RawSqlWhereTimeWatchedEmptyClause,
+		})
+		require.NoError(err)
+		ids := make([]int, len(watchlist))
+		for i, item := range watchlist {
+			ids[i] = item.ID
+		}
+		return ids
+	}
+
+	// added films are appended in order
+	require.Equal(watchIDs, watchlistOrder())
+
+	// move to the top
+	err = r.WatchlistMove(ctx, user.ID, watchIDs[2], null.Int{})
+	require.NoError(err)
+	require.Equal(
+		[]int{watchIDs[2], watchIDs[0], watchIDs[1]},
+		watchlistOrder(),
+	)
+
+	// move to the bottom
+	err = r.WatchlistMove(ctx, user.ID, watchIDs[2], null.IntFrom(watchIDs[1]))
+	require.NoError(err)
+	require.Equal(watchIDs, watchlistOrder())
+
+	// moving back and forth between the same items uses up the gap and
+	// renumbers the watchlist
+	for i := 0; i < 20; i++ {
+		err = r.WatchlistMove(
+			ctx,
+			user.ID,
+			watchIDs[i%2+1],
+			null.IntFrom(watchIDs[0]),
+		)
+		require.NoError(err)
+	}
+	require.Equal(
+		[]int{watchIDs[0], watchIDs[2], watchIDs[1]},
+		watchlistOrder(),
+	)
+
+	// the moves are not audited as they only change the positions
+	audits, err := models.WatchfilmsAudits().Count(ctx, db)
+	require.NoError(err)
+	require.Equal(int64(0), audits)
+
+	// not found
+	err = r.WatchlistMove(ctx, user.ID, 0, null.Int{})
+	require.Equal(repo.ErrNoRecord, err)
+	err = r.WatchlistMove(ctx, user.ID, watchIDs[0], null.IntFrom(0))
+	require.Equal(repo.ErrNoRecord, err)
+
+	// cannot move items of another user watchlist
+	err = r.WatchlistMove(ctx, user.ID+1, watchIDs[0], null.Int{})
+	require.Equal(repo.ErrNoRecord, err)
+}
+
+func TestWatchlistItemUpdate(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+
+	watchIDs := make([]int, 2)
+	for i := range watchIDs {
+		film := &models.Film{Title: "film"}
+		err = r.MovieCreate(ctx, user.ID, film)
+		require.NoError(err)
+		watchIDs[i], err = r.WatchlistAdd(ctx, user.ID, film.ID)
+		require.NoError(err)
+	}
+
+	// not found
+	err = r.WatchlistItemUpdate(ctx, user.ID, 0, map[string]any{
+		models.WatchfilmColumns.Priority: 1,
+	})
+	require.Equal(repo.ErrNoRecord, err)
+	err = r.WatchlistTagsSet(ctx, user.ID, 0, []string{"tag"})
+	require.Equal(repo.ErrNoRecord, err)
+
+	// set details of the first item
+	err = r.WatchlistItemUpdate(ctx, user.ID, watchIDs[0], map[string]any{
+		models.WatchfilmColumns.Priority: 3,
+		models.WatchfilmColumns.Note:     null.StringFrom("note"),
+	})
+	require.NoError(err)
+	err = r.WatchlistTagsSet(ctx, user.ID, watchIDs[0], []string{"b", "a"})
+	require.NoError(err)
+
+	// tags are replaced
+	err = r.WatchlistTagsSet(ctx, user.ID, watchIDs[1], []string{"c"})
+	require.NoError(err)
+	err = r.WatchlistTagsSet(ctx, user.ID, watchIDs[1], []string{"b"})
+	require.NoError(err)
+
+	watchlist, err := r.WatchlistGet(ctx, user.ID, query.WatchlistOptions{
+		Offset:           0,
+		Limit:            math.MaxInt,
+		SortField:        query.WatchlistSortPriority,
+		SortOrder:        "desc",
+		WhereTimeWatched: repo.RawSqlWhereTimeWatchedEmptyClause,
+	})
+	require.NoError(err)
+	require.Equal(2, len(watchlist))
+	require.Equal(watchIDs[0], watchlist[0].ID)
+	require.Equal(3, watchlist[0].Priority)
+	require.Equal(null.StringFrom("note"), watchlist[0].Note)
+	require.Equal(pq.StringArray{"a", "b"}, watchlist[0].Tags)
+	require.Equal(watchIDs[1], watchlist[1].ID)
+	require.Equal(0, watchlist[1].Priority)
+	require.Equal(pq.StringArray{"b"}, watchlist[1].Tags)
+
+	// filter by tag and priority
+	filter := func(itemOptions query.WatchlistItemOptions) []int {
+		watchlist, err := r.WatchlistGet(ctx, user.ID, query.WatchlistOptions{
+			Offset:           0,
+			Limit:            math.MaxInt,
+			SortOrder:        "asc",
+			WhereTimeWatched: repo.RawSqlWhereTimeWatchedEmptyClause,
+			Item:             itemOptions,
+		})
+		require.NoError(err)
+		count, err := r.WatchlistCount(
+			ctx,
+			user.ID,
+			repo.RawSqlWhereTimeWatchedEmptyClause,
+			query.ReleaseOptions{},
+			query.WatchedOptions{},
+			itemOptions,
+		)
+		require.NoError(err)
+		require.Equal(len(watchlist), count)
+		ids := make([]int, len(watchlist))
+		for i, item := range watchlist {
+			ids[i] = item.ID
+		}
+		return ids
+	}
+	require.Equal(
+		watchIDs,
+		filter(query.WatchlistItemOptions{Tag: "b"}),
+	)
+	require.Equal(
+		[]int{watchIDs[0]},
+		filter(query.WatchlistItemOptions{Tag: "a"}),
+	)
+	require.Equal(
+		[]int{watchIDs[1]},
+		filter(query.WatchlistItemOptions{Priority: null.IntFrom(0)}),
+	)
+	require.Equal(
+		[]int{},
+		filter(query.WatchlistItemOptions{
+			Tag:      "a",
+			Priority: null.IntFrom(0),
+		}),
+	)
+
+	// clear details
+	err = r.WatchlistItemUpdate(ctx, user.ID, watchIDs[0], map[string]any{
+		models.WatchfilmColumns.Note: null.String{},
+	})
+	require.NoError(err)
+	err = r.WatchlistTagsSet(ctx, user.ID, watchIDs[0], []string{})
+	require.NoError(err)
+	require.Equal(
+		[]int{watchIDs[1]},
+		filter(query.WatchlistItemOptions{Tag: "b"}),
+	)
+}
+
+func TestUpNext(t *testing.T) {
+	require := require.New(t)
+
+	teardown := setup()
+	t.Cleanup(teardown)
+
+	r := repo.NewRepository(db)
+	ctx := context.Background()
+
+	user := &models.User{Email: "email"}
+	err := r.UserCreate(ctx, user)
+	require.NoError(err)
+
+	// put the episodes of two serieses released long ago
+	serieses := make([]*models.Series, 2)
+	episodes := make([][]*models.Film, len(serieses))
+	for i := range serieses {
+		serieses[i] = &models.Series{Title: "series"}
+		err = r.SeriesCreate(ctx, user.ID, serieses[i])
+		require.NoError(err)
+		episodes[i] = make([]*models.Film, 3-i)
+		for j := range episodes[i] {
+			episodes[i][j] = &models.Film{
+				Title:        "episode",
+				DateReleased: testutils.Date(2000, 1, 1),
+			}
+			err = r.EpisodePut(
+				ctx,
+				serieses[i].ID, 1, j+1,
+				user.ID,
+				episodes[i][j],
+			)
+			require.NoError(err)
+		}
+	}
+
+	upNext := func() []*watchlist.UpNext {
+		upNext, err := r.UpNextGet(ctx, user.ID, 0, math.MaxInt)
+		require.NoError(err)
+		count, err := r.UpNextCount(ctx, user.ID)
+		require.NoError(err)
+		require.Equal(len(upNext), count)
+		return upNext
+	}
+
+	// no activity
+	require.Equal(0, len(upNext()))
+
+	// watch the first episode of the first series
+	watchIDs := make([]int, 2)
+	for i := range watchIDs {
+		watchIDs[i], err = r.WatchlistAdd(ctx, user.ID, episodes[0][i].ID)
+		require.NoError(err)
+	}
+	err = r.WatchlistSetWatched(ctx, user.ID, watchIDs[0])
+	require.NoError(err)
+
+	next := upNext()
+	require.Equal(1, len(next))
+	require.Equal(serieses[0].ID, next[0].Series.ID)
+	require.Equal(episodes[0][1].ID, next[0].Episode.ID)
+	require.Equal(3, next[0].EpisodesCount)
+	require.Equal(1, next[0].WatchedCount)
+	require.Equal(0, next[0].NewEpisodesCount)
+	require.Equal(null.IntFrom(watchIDs[1]), next[0].WatchID)
+
+	// the specials come after the regular seasons
+	special := &models.Film{
+		Title:        "special",
+		DateReleased: testutils.Date(1999, 1, 1),
+	}
+	err = r.EpisodePut(ctx, serieses[0].ID, 0, 1, user.ID, special)
+	require.NoError(err)
+	next = upNext()
+	require.Equal(1, len(next))
+	require.Equal(episodes[0][1].ID, next[0].Episode.ID)
+	require.Equal(4, next[0].EpisodesCount)
+	require.Equal(1, next[0].WatchedCount)
+
+	// catch up the second series: it has no next episode
+	for _, e := range episodes[1] {
+		watchID, err := r.WatchlistAdd(ctx, user.ID, e.ID)
+		require.NoError(err)
+		err = r.WatchlistSetWatched(ctx, user.ID, watchID)
+		require.NoError(err)
+	}
+	next = upNext()
+	require.Equal(1, len(next))
+	require.Equal(serieses[0].ID, next[0].Series.ID)
+
+	// a new season of the second series is released after catching up
+	newEpisode := &models.Film{
+		Title:        "new episode",
+		DateReleased: time.Now().AddDate(0, 0, 7),
+	}
+	err = r.EpisodePut(ctx, serieses[1].ID, 2, 1, user.ID, newEpisode)
+	require.NoError(err)
+
+	// the recently active series comes first
+	next = upNext()
+	require.Equal(2, len(next))
+	require.Equal(serieses[1].ID, next[0].Series.ID)
+	require.Equal(newEpisode.ID, next[0].Episode.ID)
+	require.Equal(3, next[0].EpisodesCount)
+	require.Equal(2, next[0].WatchedCount)
+	require.Equal(1, next[0].NewEpisodesCount)
+	require.False(next[0].WatchID.Valid)
+	require.Equal(serieses[0].ID, next[1].Series.ID)
+
+	// the episodes of other users watchlists are not taken into account
+	other := &models.User{Email: "other"}
+	err = r.UserCreate(ctx, other)
+	require.NoError(err)
+	otherNext, err := r.UpNextGet(ctx, other.ID, 0, math.MaxInt)
+	require.NoError(err)
+	require.Equal(0, len(otherNext))
+}
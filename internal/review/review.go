@@ -0,0 +1,16 @@
+package review
+
+import "github.com/aria3ppp/watchlist-server/internal/models"
+
+// the moderation statuses of the reviews: hidden reviews are listed to their
+// authors only
+const (
+	StatusPublished = "published"
+	StatusHidden    = "hidden"
+)
+
+// sync `boil` tag whenever there's a change in model name
+type Review struct {
+	models.Review `boil:"reviews,bind"`
+	HelpfulVotes  int `boil:"helpful_votes" json:"helpful_votes"`
+}
@@ -8,8 +8,119 @@ import (
 )
 
 const (
+	// translationsDynamicTemplates maps the translations of a document keyed by
+	// their language tag to the text analyzer of the language
+	translationsDynamicTemplates = `"dynamic_templates": [
+			{
+				"translations_ar": {
+					"path_match": "translations.ar*",
+					"match_mapping_type": "string",
+					"mapping": { "type": "text", "analyzer": "arabic" }
+				}
+			},
+			{
+				"translations_de": {
+					"path_match": "translations.de*",
+					"match_mapping_type": "string",
+					"mapping": { "type": "text", "analyzer": "german" }
+				}
+			},
+			{
+				"translations_en": {
+					"path_match": "translations.en*",
+					"match_mapping_type": "string",
+					"mapping": { "type": "text", "analyzer": "english" }
+				}
+			},
+			{
+				"translations_es": {
+					"path_match": "translations.es*",
+					"match_mapping_type": "string",
+					"mapping": { "type": "text", "analyzer": "spanish" }
+				}
+			},
+			{
+				"translations_fa": {
+					"path_match": "translations.fa*",
+					"match_mapping_type": "string",
+					"mapping": { "type": "text", "analyzer": "persian" }
+				}
+			},
+			{
+				"translations_fr": {
+					"path_match": "translations.fr*",
+					"match_mapping_type": "string",
+					"mapping": { "type": "text", "analyzer": "french" }
+				}
+			},
+			{
+				"translations_it": {
+					"path_match": "translations.it*",
+					"match_mapping_type": "string",
+					"mapping": { "type": "text", "analyzer": "italian" }
+				}
+			},
+			{
+				"translations_ja": {
+					"path_match": "translations.ja*",
+					"match_mapping_type": "string",
+					"mapping": { "type": "text", "analyzer": "cjk" }
+				}
+			},
+			{
+				"translations_ko": {
+					"path_match": "translations.ko*",
+					"match_mapping_type": "string",
+					"mapping": { "type": "text", "analyzer": "cjk" }
+				}
+			},
+			{
+				"translations_nl": {
+					"path_match": "translations.nl*",
+					"match_mapping_type": "string",
+					"mapping": { "type": "text", "analyzer": "dutch" }
+				}
+			},
+			{
+				"translations_pt": {
+					"path_match": "translations.pt*",
+					"match_mapping_type": "string",
+					"mapping": { "type": "text", "analyzer": "portuguese" }
+				}
+			},
+			{
+				"translations_ru": {
+					"path_match": "translations.ru*",
+					"match_mapping_type": "string",
+					"mapping": { "type": "text", "analyzer": "russian" }
+				}
+			},
+			{
+				"translations_tr": {
+					"path_match": "translations.tr*",
+					"match_mapping_type": "string",
+					"mapping": { "type": "text", "analyzer": "turkish" }
+				}
+			},
+			{
+				"translations_zh": {
+					"path_match": "translations.zh*",
+					"match_mapping_type": "string",
+					"mapping": { "type": "text", "analyzer": "cjk" }
+				}
+			},
+			{
+				"translations": {
+					"path_match": "translations.*",
+					"match_mapping_type": "string",
+					"mapping": { "type": "text" }
+				}
+			}
+		]`
+
 	seriesesIndexMappings = `{
 		"mappings": {
+			` + translationsDynamicTemplates + `,
 			"properties": {
 				"id": { "type": "keyword", "index": false },
 				"title": { "type": "text" },
@@ -18,13 +129,22 @@ const (
 				"date_ended": { "type": "date", "index": false },
 				"contributed_by": { "type": "keyword", "index": false },
 				"contributed_at": { "type": "date", "index": false },
-				"invalidation": { "type": "keyword", "index": false }
+				"invalidation": { "type": "keyword" },
+				"deleted_at": { "type": "date" },
+				"external_ids": {
+					"properties": {
+						"imdb": { "type": "keyword" },
+						"tmdb": { "type": "keyword" },
+						"wikidata": { "type": "keyword" }
+					}
+				}
 			}
 		}
 	}`
 
 	moviesIndexMappings = `{
 		"mappings": {
+			` + translationsDynamicTemplates + `,
 			"properties": {
 				"id": { "type": "keyword", "index": false },
 				"title": { "type": "text" },
@@ -33,7 +153,15 @@ const (
 				"duration": { "type": "short", "index": false },
 				"contributed_by": { "type": "keyword", "index": false },
 				"contributed_at": { "type": "date", "index": false },
-				"invalidation": { "type": "keyword", "index": false }
+				"invalidation": { "type": "keyword" },
+				"deleted_at": { "type": "date" },
+				"external_ids": {
+					"properties": {
+						"imdb": { "type": "keyword" },
+						"tmdb": { "type": "keyword" },
+						"wikidata": { "type": "keyword" }
+					}
+				}
 			}
 		}
 	}`
@@ -36,6 +36,34 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// DeleteMovie mocks base method.
+func (m *MockService) DeleteMovie(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMovie", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMovie indicates an expected call of DeleteMovie.
+func (mr *MockServiceMockRecorder) DeleteMovie(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMovie", reflect.TypeOf((*MockService)(nil).DeleteMovie), arg0, arg1)
+}
+
+// DeleteSeries mocks base method.
+func (m *MockService) DeleteSeries(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeries", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries.
+func (mr *MockServiceMockRecorder) DeleteSeries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*MockService)(nil).DeleteSeries), arg0, arg1)
+}
+
 // SearchMovies mocks base method.
 func (m *MockService) SearchMovies(arg0 context.Context, arg1 query.SearchOptions) ([]*models.Film, int, error) {
 	m.ctrl.T.Helper()
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
+	"strconv"
 	"strings"
 	"unsafe"
 
@@ -25,6 +27,8 @@ type Service interface {
 		ctx context.Context,
 		queryOptions query.SearchOptions,
 	) (hits []*models.Film, totalHits int, err error)
+	DeleteSeries(ctx context.Context, id int) error
+	DeleteMovie(ctx context.Context, id int) error
 }
 
 type ElasticSearch struct {
@@ -59,6 +63,19 @@ func NewElasticSearch(client *elasticsearch.Client) (*ElasticSearch, error) {
 	return &ElasticSearch{client: client}, nil
 }
 
+// invalidationFilter returns the bool query clause filtering the documents by
+// the invalidation filter. hidden documents are always filtered out
+func invalidationFilter(invalidation string) string {
+	switch invalidation {
+	case query.InvalidationExclude:
+		return `, "must_not": [{"exists": {"field": "invalidation"}}, {"exists": {"field": "deleted_at"}}]`
+	case query.InvalidationOnly:
+		return `, "filter": [{"exists": {"field": "invalidation"}}], "must_not": [{"exists": {"field": "deleted_at"}}]`
+	default:
+		return `, "must_not": [{"exists": {"field": "deleted_at"}}]`
+	}
+}
+
 // note: caller must close 'responseBody.Close' manually
 func (e *ElasticSearch) search(
 	ctx context.Context,
@@ -89,8 +106,9 @@ func (e *ElasticSearch) SearchSerieses(
 ) (hits []*models.Series, totalHits int, err error) {
 	// prepare search query
 	searchQuery := fmt.Sprintf(
-		`{"query": {"multi_match": {"query": "%s", "fields": ["title^2", "descriptions"], "fuzziness": "AUTO"}}}`,
+		`{"query": {"bool": {"should": [{"multi_match": {"query": "%[1]s", "fields": ["title^2", "descriptions", "translations.*.title^2", "translations.*.descriptions"], "fuzziness": "AUTO"}}, {"multi_match": {"query": "%[1]s", "fields": ["external_ids.*"]}}], "minimum_should_match": 1%[2]s}}}`,
 		queryOptions.Query,
+		invalidationFilter(queryOptions.Invalidation),
 	)
 
 	// search query
@@ -135,8 +153,9 @@ func (e *ElasticSearch) SearchMovies(
 ) (hits []*models.Film, totalHits int, err error) {
 	// prepare search query
 	searchQuery := fmt.Sprintf(
-		`{"query": {"multi_match": {"query": "%s", "fields": ["title^2", "descriptions"], "fuzziness": "AUTO"}}}`,
+		`{"query": {"bool": {"should": [{"multi_match": {"query": "%[1]s", "fields": ["title^2", "descriptions", "translations.*.title^2", "translations.*.descriptions"], "fuzziness": "AUTO"}}, {"multi_match": {"query": "%[1]s", "fields": ["external_ids.*"]}}], "minimum_should_match": 1%[2]s}}}`,
 		queryOptions.Query,
+		invalidationFilter(queryOptions.Invalidation),
 	)
 
 	// search query
@@ -174,3 +193,33 @@ func (e *ElasticSearch) SearchMovies(
 
 	return hits, totalHits, nil
 }
+
+// delete removes the document from the index. a missing document is not an
+// error as it might not be synced yet
+func (e *ElasticSearch) delete(
+	ctx context.Context,
+	index string,
+	id int,
+) error {
+	resp, err := e.client.Delete(
+		index,
+		strconv.Itoa(id),
+		e.client.Delete.WithContext(ctx),
+	)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.IsError() && resp.StatusCode != http.StatusNotFound {
+		return responseError(resp)
+	}
+	return nil
+}
+
+func (e *ElasticSearch) DeleteSeries(ctx context.Context, id int) error {
+	return e.delete(ctx, config.Config.Elasticsearch.Index.Serieses, id)
+}
+
+func (e *ElasticSearch) DeleteMovie(ctx context.Context, id int) error {
+	return e.delete(ctx, config.Config.Elasticsearch.Index.Movies, id)
+}
@@ -419,3 +419,333 @@ func TestSearchMovies(t *testing.T) {
 		require.Equal(queryMovies[i], gm)
 	}
 }
+
+func TestSearchMoviesByExternalID(t *testing.T) {
+	require := require.New(t)
+
+	t.Cleanup(teardown)
+
+	s, err := search.NewElasticSearch(esClient)
+	require.NoError(err)
+
+	ctx := context.Background()
+
+	// index movies with external ids
+	type document struct {
+		*models.Film
+		ExternalIDs map[string]string `json:"external_ids"`
+	}
+	documents := []document{
+		{
+			Film:        &models.Film{ID: 1, Title: "title"},
+			ExternalIDs: map[string]string{"imdb": "tt0111161"},
+		},
+		{
+			Film:        &models.Film{ID: 2, Title: "title"},
+			ExternalIDs: map[string]string{"imdb": "tt0111162"},
+		},
+		{
+			Film:        &models.Film{ID: 3, Title: "title"},
+			ExternalIDs: map[string]string{"wikidata": "Q172241"},
+		},
+	}
+	for _, d := range documents {
+		jsonBody, err := json.Marshal(d)
+		require.NoError(err)
+
+		err = searchtestutils.CreateDocument(
+			esClient,
+			config.Config.Elasticsearch.Index.Movies,
+			jsonBody,
+			strconv.Itoa(d.ID),
+		)
+		require.NoError(err)
+	}
+
+	// wait until all documents are indexed
+	err = testutils.WaitUntil(
+		func() (bool, error) {
+			c, err := searchtestutils.CountIndex(
+				esClient,
+				config.Config.Elasticsearch.Index.Movies,
+			)
+			if err != nil {
+				return false, err
+			}
+			return c == len(documents), nil
+		},
+		10*time.Second,
+		200*time.Millisecond,
+	)
+	require.NoError(err)
+
+	// external ids match exactly
+	for _, d := range documents {
+		for _, extID := range d.ExternalIDs {
+			gotMovies, total, err := s.SearchMovies(
+				ctx,
+				query.SearchOptions{
+					Query: extID,
+					From:  0,
+					Size:  config.Config.Validation.Pagination.PageSize.MaxValue,
+				},
+			)
+			require.NoError(err)
+			require.Equal(1, total)
+			require.Equal(d.Film, gotMovies[0])
+		}
+	}
+}
+
+func TestSearchSeriesesByTranslation(t *testing.T) {
+	require := require.New(t)
+
+	t.Cleanup(teardown)
+
+	s, err := search.NewElasticSearch(esClient)
+	require.NoError(err)
+
+	ctx := context.Background()
+
+	// index serieses with translations
+	type translation struct {
+		Title        string `json:"title"`
+		Descriptions string `json:"descriptions,omitempty"`
+	}
+	type document struct {
+		*models.Series
+		Translations map[string]translation `json:"translations,omitempty"`
+	}
+	documents := []document{
+		{
+			Series: &models.Series{ID: 1, Title: "the house"},
+			Translations: map[string]translation{
+				"de":    {Title: "das Haus"},
+				"pt-BR": {Title: "a casa", Descriptions: "uma família"},
+			},
+		},
+		{
+			Series: &models.Series{ID: 2, Title: "the garden"},
+			Translations: map[string]translation{
+				"fr": {Title: "le jardin"},
+			},
+		},
+		{
+			Series: &models.Series{ID: 3, Title: "the street"},
+		},
+	}
+	for _, d := range documents {
+		jsonBody, err := json.Marshal(d)
+		require.NoError(err)
+
+		err = searchtestutils.CreateDocument(
+			esClient,
+			config.Config.Elasticsearch.Index.Serieses,
+			jsonBody,
+			strconv.Itoa(d.ID),
+		)
+		require.NoError(err)
+	}
+
+	// wait until all documents are indexed
+	err = testutils.WaitUntil(
+		func() (bool, error) {
+			c, err := searchtestutils.CountIndex(
+				esClient,
+				config.Config.Elasticsearch.Index.Serieses,
+			)
+			if err != nil {
+				return false, err
+			}
+			return c == len(documents), nil
+		},
+		10*time.Second,
+		200*time.Millisecond,
+	)
+	require.NoError(err)
+
+	// translated titles and descriptions match with the language analyzer
+	for q, expSeries := range map[string]*models.Series{
+		"Häuser":   documents[0].Series,
+		"famílias": documents[0].Series,
+		"jardins":  documents[1].Series,
+	} {
+		gotSerieses, total, err := s.SearchSerieses(
+			ctx,
+			query.SearchOptions{
+				Query: q,
+				From:  0,
+				Size:  config.Config.Validation.Pagination.PageSize.MaxValue,
+			},
+		)
+		require.NoError(err, q)
+		require.Equal(1, total, q)
+		require.Equal(expSeries, gotSerieses[0], q)
+	}
+}
+
+func TestSearchMoviesByInvalidation(t *testing.T) {
+	require := require.New(t)
+
+	t.Cleanup(teardown)
+
+	s, err := search.NewElasticSearch(esClient)
+	require.NoError(err)
+
+	ctx := context.Background()
+
+	// index a valid and an invalidated movie
+	movies := []*models.Film{
+		{ID: 1, Title: "query"},
+		{ID: 2, Title: "query", Invalidation: null.StringFrom("invalidation")},
+	}
+	for _, m := range movies {
+		jsonBody, err := json.Marshal(m)
+		require.NoError(err)
+
+		err = searchtestutils.CreateDocument(
+			esClient,
+			config.Config.Elasticsearch.Index.Movies,
+			jsonBody,
+			strconv.Itoa(m.ID),
+		)
+		require.NoError(err)
+	}
+
+	// wait until all documents are indexed
+	err = testutils.WaitUntil(
+		func() (bool, error) {
+			c, err := searchtestutils.CountIndex(
+				esClient,
+				config.Config.Elasticsearch.Index.Movies,
+			)
+			if err != nil {
+				return false, err
+			}
+			return c == len(movies), nil
+		},
+		10*time.Second,
+		200*time.Millisecond,
+	)
+	require.NoError(err)
+
+	testCases := []struct {
+		invalidation string
+		expMovies    []*models.Film
+	}{
+		{
+			invalidation: query.InvalidationExclude,
+			expMovies:    movies[:1],
+		},
+		{
+			invalidation: query.InvalidationOnly,
+			expMovies:    movies[1:],
+		},
+		{
+			invalidation: query.InvalidationInclude,
+			expMovies:    movies,
+		},
+	}
+
+	for _, tc := range testCases {
+		gotMovies, total, err := s.SearchMovies(
+			ctx,
+			query.SearchOptions{
+				Query:        "query",
+				From:         0,
+				Size:         config.Config.Validation.Pagination.PageSize.MaxValue,
+				Invalidation: tc.invalidation,
+			},
+		)
+		require.NoError(err, tc.invalidation)
+		require.Equal(len(tc.expMovies), total, tc.invalidation)
+
+		sort.Slice(
+			gotMovies,
+			func(i, j int) bool { return gotMovies[i].ID < gotMovies[j].ID },
+		)
+		require.Equal(tc.expMovies, gotMovies, tc.invalidation)
+	}
+}
+
+func TestSearchMoviesHiddenAndDeleted(t *testing.T) {
+	require := require.New(t)
+
+	t.Cleanup(teardown)
+
+	s, err := search.NewElasticSearch(esClient)
+	require.NoError(err)
+
+	ctx := context.Background()
+
+	// index a visible, a hidden and a to be deleted movie
+	movies := []*models.Film{
+		{ID: 1, Title: "query"},
+		{
+			ID:        2,
+			Title:     "query",
+			DeletedAt: null.TimeFrom(time.Date(2023, 1, 20, 0, 0, 0, 0, time.UTC)),
+		},
+		{ID: 3, Title: "query"},
+	}
+	for _, m := range movies {
+		jsonBody, err := json.Marshal(m)
+		require.NoError(err)
+
+		err = searchtestutils.CreateDocument(
+			esClient,
+			config.Config.Elasticsearch.Index.Movies,
+			jsonBody,
+			strconv.Itoa(m.ID),
+		)
+		require.NoError(err)
+	}
+
+	// wait until all documents are indexed
+	waitCount := func(count int) {
+		err := testutils.WaitUntil(
+			func() (bool, error) {
+				c, err := searchtestutils.CountIndex(
+					esClient,
+					config.Config.Elasticsearch.Index.Movies,
+				)
+				if err != nil {
+					return false, err
+				}
+				return c == count, nil
+			},
+			10*time.Second,
+			200*time.Millisecond,
+		)
+		require.NoError(err)
+	}
+	waitCount(len(movies))
+
+	// delete movie 3
+	err = s.DeleteMovie(ctx, 3)
+	require.NoError(err)
+	waitCount(len(movies) - 1)
+
+	// deleting a missing document is fine
+	err = s.DeleteMovie(ctx, 4)
+	require.NoError(err)
+
+	// only the visible movie is found
+	for _, invalidation := range []string{
+		query.InvalidationExclude,
+		query.InvalidationInclude,
+	} {
+		gotMovies, total, err := s.SearchMovies(
+			ctx,
+			query.SearchOptions{
+				Query:        "query",
+				From:         0,
+				Size:         config.Config.Validation.Pagination.PageSize.MaxValue,
+				Invalidation: invalidation,
+			},
+		)
+		require.NoError(err, invalidation)
+		require.Equal(1, total, invalidation)
+		require.Equal(movies[:1], gotMovies, invalidation)
+	}
+}
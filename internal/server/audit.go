@@ -0,0 +1,31 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// GET /v1/authorized/audit/prune/latest
+func (s *Server) HandleAuditPruneGetLatest(c echo.Context) error {
+	// fetch the latest prune
+	prune, err := s.app.AuditPruneGetLatest(c.Request().Context())
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleAuditPruneGetLatest: audit prune not found",
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleAuditPruneGetLatest: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, prune)
+}
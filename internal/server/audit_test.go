@@ -0,0 +1,79 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleAuditPruneGetLatest(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultUser)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/audit/prune/latest"
+	method := http.MethodGet
+
+	// unauthorized
+	e.Request(method, path).
+		Expect().
+		Status(http.StatusUnauthorized)
+
+	// no prune
+	e.Request(method, path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			http.StatusText(http.StatusNotFound),
+		))
+
+	// a movie with an audit
+	movieID, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "title",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+	err = appInstance.MovieInvalidate(
+		ctx,
+		movieID,
+		defaults.user.id,
+		&dto.InvalidationRequest{Invalidation: "invalidation"},
+	)
+	require.NoError(err)
+
+	// a dry run prunes nothing
+	prune, err := appInstance.AuditPrune(ctx, true)
+	require.NoError(err)
+
+	e.Request(method, path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ContainsMap(map[string]any{
+			"id":            prune.ID,
+			"status":        app.AuditPruneStatusSucceeded,
+			"dry_run":       true,
+			"pruned_rows":   0,
+			"archived_rows": 0,
+		}).
+		ContainsKey("finished_at")
+}
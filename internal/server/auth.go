@@ -3,6 +3,7 @@ package server
 import (
 	"net/http"
 
+	"github.com/aria3ppp/watchlist-server/internal/app"
 	"github.com/aria3ppp/watchlist-server/internal/auth"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
@@ -23,3 +24,18 @@ func (s *Server) getUserPayload(
 	}
 	return payload, nil
 }
+
+// actorMiddleware sets the authorized user as the acting user of the request
+// recorded in the audits
+func (s *Server) actorMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		payload, httpError := s.getUserPayload(c)
+		if httpError != nil {
+			return httpError
+		}
+		c.SetRequest(c.Request().WithContext(
+			app.WithActor(c.Request().Context(), payload.UserID),
+		))
+		return next(c)
+	}
+}
@@ -0,0 +1,433 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/server/request"
+	"github.com/aria3ppp/watchlist-server/internal/server/response"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// GET /v1/authorized/collection/:id/
+func (s *Server) HandleCollectionGet(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// fetch collection
+	collection, err := s.app.CollectionGet(c.Request().Context(), param.ID)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleCollectionGet: collection not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleCollectionGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, collection)
+}
+
+// GET /v1/authorized/collection/?page=1&page_size=60&sort_field=id&sort_order=desc
+func (s *Server) HandleCollectionsGetAll(c echo.Context) error {
+	// bind & validate query
+	var pagQuery request.PaginationSortingQuery
+	if httpError := s.bindQuery(c, pagQuery.SetValidationModel(models.TableNames.Collections)); httpError != nil {
+		return httpError
+	}
+
+	queryOptions := pagQuery.SetQueryIfNotSet(request.PaginationSortingQuery{
+		SortingQuery: request.SortingQuery{
+			SortField: models.CollectionColumns.ID,
+			SortOrderQuery: request.SortOrderQuery{
+				SortOrder: request.SortOrderAsc,
+			},
+		},
+		PaginationQuery: request.PaginationQuery{
+			Page:     config.Config.Validation.Pagination.Page.MinValue,
+			PageSize: config.Config.Validation.Pagination.PageSize.DefaultValue,
+		},
+		InvalidationQuery: request.InvalidationQuery{
+			Invalidation: query.InvalidationExclude,
+		},
+	}).ToQueryOptions()
+
+	// fetch collections
+	collections, total, err := s.app.CollectionsGetAll(
+		c.Request().Context(),
+		queryOptions,
+	)
+	if err != nil {
+		s.logger.Error(
+			"server.HandleCollectionsGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(
+			pagQuery.Page,
+			pagQuery.PageSize,
+			collections,
+			total,
+		),
+	)
+}
+
+// POST /v1/authorized/collection/
+func (s *Server) HandleCollectionCreate(c echo.Context) error {
+	// bind & validate request
+	var req dto.CollectionCreateRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// create collection
+	collectionID, err := s.app.CollectionCreate(
+		c.Request().Context(),
+		payload.UserID,
+		&req,
+	)
+	if err != nil {
+		s.logger.Error(
+			"server.HandleCollectionCreate: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, response.ID(collectionID))
+}
+
+// PATCH /v1/authorized/collection/:id/
+func (s *Server) HandleCollectionUpdate(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate request
+	var req dto.CollectionUpdateRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// update collection
+	err := s.app.CollectionUpdate(
+		c.Request().Context(),
+		param.ID,
+		payload.UserID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleCollectionUpdate: collection not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrLowReputation {
+			s.logger.Info(
+				"server.HandleCollectionUpdate: low reputation",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleCollectionUpdate: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// POST /v1/authorized/collection/:id/invalidate
+func (s *Server) HandleCollectionInvalidate(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate request
+	var req dto.InvalidationRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// invalidate collection
+	err := s.app.CollectionInvalidate(
+		c.Request().Context(),
+		param.ID,
+		payload.UserID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleCollectionInvalidate: collection not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrLowReputation {
+			s.logger.Info(
+				"server.HandleCollectionInvalidate: low reputation",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleCollectionInvalidate: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// GET /v1/authorized/collection/:id/audits/?page=1&page_size=60&sort_order=desc
+func (s *Server) HandleCollectionAuditsGetAll(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate query
+	var pagQuery request.PaginationSortOrderQuery
+	if httpError := s.bindQuery(c, &pagQuery); httpError != nil {
+		return httpError
+	}
+
+	queryOptions := pagQuery.SetQueryIfNotSet(request.PaginationSortOrderQuery{
+		PaginationQuery: request.PaginationQuery{
+			Page:     config.Config.Validation.Pagination.Page.MinValue,
+			PageSize: config.Config.Validation.Pagination.PageSize.DefaultValue,
+		},
+		SortOrderQuery: request.SortOrderQuery{
+			SortOrder: request.SortOrderDesc,
+		},
+	}).ToQueryOptions()
+
+	// fetch audits
+	audits, total, err := s.app.CollectionAuditsGetAll(
+		c.Request().Context(),
+		param.ID,
+		queryOptions,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleCollectionAuditsGetAll: collection not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleCollectionAuditsGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(
+			pagQuery.Page,
+			pagQuery.PageSize,
+			audits,
+			total,
+		),
+	)
+}
+
+// GET /v1/authorized/collection/:id/items
+func (s *Server) HandleCollectionItemsGet(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	localeOptions, httpError := s.getLocaleOptions(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// fetch items
+	items, err := s.app.CollectionItemsGet(
+		c.Request().Context(),
+		param.ID,
+		localeOptions,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleCollectionItemsGet: collection not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleCollectionItemsGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, items)
+}
+
+// PUT /v1/authorized/collection/:id/items
+func (s *Server) HandleCollectionItemsPut(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate request
+	var req dto.CollectionItemsPutRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// put items
+	err := s.app.CollectionItemsPut(
+		c.Request().Context(),
+		param.ID,
+		payload.UserID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleCollectionItemsPut: collection or item not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrLowReputation {
+			s.logger.Info(
+				"server.HandleCollectionItemsPut: low reputation",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleCollectionItemsPut: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// GET /v1/authorized/collection/:id/items/audits/?page=1&page_size=60&sort_order=desc
+func (s *Server) HandleCollectionItemAuditsGetAll(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate query
+	var pagQuery request.PaginationSortOrderQuery
+	if httpError := s.bindQuery(c, &pagQuery); httpError != nil {
+		return httpError
+	}
+
+	queryOptions := pagQuery.SetQueryIfNotSet(request.PaginationSortOrderQuery{
+		PaginationQuery: request.PaginationQuery{
+			Page:     config.Config.Validation.Pagination.Page.MinValue,
+			PageSize: config.Config.Validation.Pagination.PageSize.DefaultValue,
+		},
+		SortOrderQuery: request.SortOrderQuery{
+			SortOrder: request.SortOrderDesc,
+		},
+	}).ToQueryOptions()
+
+	// fetch audits
+	audits, total, err := s.app.CollectionItemAuditsGetAll(
+		c.Request().Context(),
+		param.ID,
+		queryOptions,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleCollectionItemAuditsGetAll: collection not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleCollectionItemAuditsGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(
+			pagQuery.Page,
+			pagQuery.PageSize,
+			audits,
+			total,
+		),
+	)
+}
@@ -0,0 +1,222 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/gavv/httpexpect/v2"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestHandleCollectionItemsPut(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(
+		OptEnableDefaultUser | OptEnableDefaultSeries,
+	)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/collection/{id}/items"
+
+	// create a collection
+	collectionID := e.POST("/v1/authorized/collection").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(dto.CollectionCreateRequest{Title: "universe"}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("id").
+		Number().
+		Raw()
+
+	movieID, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+
+	// invalid request
+	e.PUT(path).
+		WithPath("id", collectionID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(dto.CollectionItemsPutRequest{
+			Items: []*dto.CollectionItem{
+				{FilmID: null.IntFrom(movieID)},
+				{FilmID: null.IntFrom(movieID)},
+			},
+		}).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			validation.Errors{
+				"items": dto.ErrDuplicateCollectionItem,
+			}.Error(),
+		))
+
+	// collection not found
+	e.PUT(path).
+		WithPath("id", collectionID+1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(dto.CollectionItemsPutRequest{}).
+		Expect().
+		Status(http.StatusNotFound)
+
+	// item not found
+	e.PUT(path).
+		WithPath("id", collectionID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(dto.CollectionItemsPutRequest{
+			Items: []*dto.CollectionItem{
+				{FilmID: null.IntFrom(movieID + 100)},
+			},
+		}).
+		Expect().
+		Status(http.StatusNotFound)
+
+	// put the movie then the series
+	e.PUT(path).
+		WithPath("id", collectionID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(dto.CollectionItemsPutRequest{
+			Items: []*dto.CollectionItem{
+				{FilmID: null.IntFrom(movieID)},
+				{SeriesID: null.IntFrom(defaults.series.id)},
+			},
+		}).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+
+	items := e.GET(path).
+		WithPath("id", collectionID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Array()
+	items.Length().Equal(2)
+	items.Element(0).Object().ContainsMap(map[string]any{
+		"position": 1,
+		"film_id":  movieID,
+	})
+	items.Element(0).Object().Value("film").Object().ValueEqual("title", "movie")
+	items.Element(1).Object().ContainsMap(map[string]any{
+		"position":  2,
+		"series_id": defaults.series.id,
+	})
+	items.Element(1).Object().NotContainsKey("film")
+}
+
+func TestHandleWatchlistAddCollection(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(
+		OptEnableDefaultUser | OptEnableDefaultSeries,
+	)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/watchlist/add/collection"
+
+	movieID, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+	err = appInstance.EpisodesPutAllBySeason(
+		ctx,
+		defaults.series.id,
+		1,
+		defaults.user.id,
+		&dto.EpisodesPutAllBySeasonRequest{
+			Episodes: []*dto.EpisodePutRequest{
+				{Title: "episode 1", DateReleased: testutils.Date(2000, 1, 2)},
+				{Title: "episode 2", DateReleased: testutils.Date(2000, 1, 3)},
+			},
+		},
+	)
+	require.NoError(err)
+	collectionID, err := appInstance.CollectionCreate(
+		ctx,
+		defaults.user.id,
+		&dto.CollectionCreateRequest{Title: "collection"},
+	)
+	require.NoError(err)
+	err = appInstance.CollectionItemsPut(
+		ctx,
+		collectionID,
+		defaults.user.id,
+		&dto.CollectionItemsPutRequest{
+			Items: []*dto.CollectionItem{
+				{SeriesID: null.IntFrom(defaults.series.id)},
+				{FilmID: null.IntFrom(movieID)},
+			},
+		},
+	)
+	require.NoError(err)
+
+	// collection not found
+	e.POST(path).
+		WithQuery("collection_id", collectionID+1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound)
+
+	// add the episodes then the movie
+	e.POST(path).
+		WithQuery("collection_id", collectionID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("ids").
+		Array().
+		Length().
+		Equal(3)
+
+	watchlist := e.GET("/v1/authorized/watchlist").
+		WithQuery("sort_order", "asc").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	watchlist.ValueEqual("total_items", 3)
+	items := watchlist.Value("items").Array()
+	items.Element(0).Object().Value("film").Object().ValueEqual("title", "episode 1")
+	items.Element(1).Object().Value("film").Object().ValueEqual("title", "episode 2")
+	items.Element(2).Object().Value("film").Object().ValueEqual("title", "movie")
+
+	// adding again skips the films already in watchlist
+	e.POST(path).
+		WithQuery("collection_id", collectionID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("ids").
+		Array().
+		Empty()
+}
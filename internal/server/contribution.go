@@ -0,0 +1,105 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/server/request"
+	"github.com/aria3ppp/watchlist-server/internal/server/response"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// GET /v1/authorized/user/:id/contributions
+func (s *Server) HandleUserContributionsGet(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate query
+	var pagQuery request.PaginationSortOrderQuery
+	if httpError := s.bindQuery(c, &pagQuery); httpError != nil {
+		return httpError
+	}
+
+	queryOptions := pagQuery.SetQueryIfNotSet(request.PaginationSortOrderQuery{
+		PaginationQuery: request.PaginationQuery{
+			Page:     config.Config.Validation.Pagination.Page.MinValue,
+			PageSize: config.Config.Validation.Pagination.PageSize.DefaultValue,
+		},
+		SortOrderQuery: request.SortOrderQuery{
+			SortOrder: request.SortOrderDesc,
+		},
+	}).ToQueryOptions()
+
+	// fetch contributions
+	stats, contributions, total, err := s.app.UserContributionsGet(
+		c.Request().Context(),
+		param.ID,
+		queryOptions,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleUserContributionsGet: user not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleUserContributionsGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Contributions(
+			stats,
+			pagQuery.Page,
+			pagQuery.PageSize,
+			contributions,
+			total,
+		),
+	)
+}
+
+// GET /v1/authorized/user/leaderboard
+func (s *Server) HandleContributorsLeaderboard(c echo.Context) error {
+	// bind & validate query
+	var pagQuery request.PaginationQuery
+	if httpError := s.bindQuery(c, &pagQuery); httpError != nil {
+		return httpError
+	}
+
+	if pagQuery.Page == 0 {
+		pagQuery.Page = config.Config.Validation.Pagination.Page.MinValue
+	}
+	if pagQuery.PageSize == 0 {
+		pagQuery.PageSize = config.Config.Validation.Pagination.PageSize.DefaultValue
+	}
+
+	// fetch leaderboard
+	stats, total, err := s.app.ContributorsLeaderboard(
+		c.Request().Context(),
+		pagQuery.Offset(),
+		pagQuery.Limit(),
+	)
+	if err != nil {
+		s.logger.Error(
+			"server.HandleContributorsLeaderboard: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(pagQuery.Page, pagQuery.PageSize, stats, total),
+	)
+}
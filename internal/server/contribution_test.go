@@ -0,0 +1,155 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/contribution"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/server/request"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestHandleUserContributionsGet(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultUser)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/user/{id}/contributions"
+	method := http.MethodGet
+
+	// unauthorized
+	e.Request(method, path).
+		WithPath("id", defaults.user.id).
+		Expect().
+		Status(http.StatusUnauthorized)
+
+	// bad request
+	e.Request(method, path).
+		WithPath("id", defaults.user.id).
+		WithQueryObject(request.PaginationQuery{Page: -1}).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusBadRequest)
+
+	// user not found
+	e.Request(method, path).
+		WithPath("id", defaults.user.id+1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			http.StatusText(http.StatusNotFound),
+		))
+
+	// a created and edited movie
+	movieID, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "title",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+	err = appInstance.MovieUpdate(
+		ctx,
+		movieID,
+		defaults.user.id,
+		&dto.MovieUpdateRequest{Title: null.StringFrom("updated title")},
+	)
+	require.NoError(err)
+
+	object := e.Request(method, path).
+		WithPath("id", defaults.user.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+
+	object.Value("stats").Object().Equal(contribution.Stats{
+		UserID:    defaults.user.id,
+		Creations: 1,
+		Edits:     1,
+		Score: config.Config.Reputation.CreationPoints +
+			config.Config.Reputation.EditPoints,
+	})
+	object.ValueEqual("total_items", 2)
+	items := object.Value("items").Array()
+	items.Length().Equal(2)
+	items.Element(0).Object().ContainsMap(map[string]any{
+		"kind":   contribution.KindMovie,
+		"id":     movieID,
+		"title":  "updated title",
+		"action": contribution.ActionEdit,
+	})
+	items.Element(1).Object().ContainsMap(map[string]any{
+		"kind":   contribution.KindMovie,
+		"id":     movieID,
+		"title":  "title",
+		"action": contribution.ActionCreate,
+	})
+}
+
+func TestHandleContributorsLeaderboard(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultUser)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/user/leaderboard"
+	method := http.MethodGet
+
+	// unauthorized
+	e.Request(method, path).
+		Expect().
+		Status(http.StatusUnauthorized)
+
+	// no contributors
+	e.Request(method, path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("total_items", 0)
+
+	_, err := appInstance.SeriesCreate(
+		ctx,
+		defaults.user.id,
+		&dto.SeriesCreateRequest{
+			Title:       "title",
+			DateStarted: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+
+	object := e.Request(method, path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	object.ValueEqual("total_items", 1)
+	object.Value("items").Array().Element(0).Object().ContainsMap(
+		map[string]any{
+			"user_id":   defaults.user.id,
+			"creations": 1,
+			"score":     config.Config.Reputation.CreationPoints,
+		},
+	)
+}
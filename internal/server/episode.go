@@ -20,12 +20,18 @@ func (s *Server) HandleEpisodeGet(c echo.Context) error {
 		return httpError
 	}
 
+	localeOptions, httpError := s.getLocaleOptions(c)
+	if httpError != nil {
+		return httpError
+	}
+
 	// fetch episode
 	episode, err := s.app.EpisodeGet(
 		c.Request().Context(),
 		params.SeriesID,
 		params.SeasonNumber,
 		params.EpisodeNumber,
+		localeOptions,
 	)
 	if err != nil {
 		if err == app.ErrNotFound {
@@ -71,11 +77,17 @@ func (s *Server) HandleEpisodesGetAllBySeries(c echo.Context) error {
 		},
 	}).ToQueryOptions()
 
+	localeOptions, httpError := s.getLocaleOptions(c)
+	if httpError != nil {
+		return httpError
+	}
+
 	// fetch episodes
 	episodes, total, err := s.app.EpisodesGetAllBySeries(
 		c.Request().Context(),
 		param.ID,
 		queryOptions,
+		localeOptions,
 	)
 	if err != nil {
 		if err == app.ErrNotFound {
@@ -128,12 +140,18 @@ func (s *Server) HandleEpisodesGetAllBySeason(c echo.Context) error {
 		},
 	}).ToQueryOptions()
 
+	localeOptions, httpError := s.getLocaleOptions(c)
+	if httpError != nil {
+		return httpError
+	}
+
 	// fetch episodes
 	episodes, total, err := s.app.EpisodesGetAllBySeason(
 		c.Request().Context(),
 		params.SeriesID,
 		params.SeasonNumber,
 		queryOptions,
+		localeOptions,
 	)
 	if err != nil {
 		if err == app.ErrNotFound {
@@ -199,6 +217,25 @@ func (s *Server) HandleEpisodePut(c echo.Context) error {
 			return echo.NewHTTPError(http.StatusNotFound)
 		}
 
+		if err == app.ErrUsedEpisodeNumber {
+			s.logger.Info(
+				"server.HandleEpisodePut: absolute number already used",
+				zap.Int("series id", params.SeriesID),
+				zap.Int("season number", params.SeasonNumber),
+				zap.Int("episode number", params.EpisodeNumber),
+				zap.Int("absolute number", req.AbsoluteNumber.Int),
+			)
+			return echo.NewHTTPError(http.StatusConflict)
+		}
+
+		if err == app.ErrLowReputation {
+			s.logger.Info(
+				"server.HandleEpisodePut: low reputation",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
 		s.logger.Error(
 			"server.HandleEpisodePut: internal server error",
 			zap.Error(err),
@@ -245,6 +282,23 @@ func (s *Server) HandleEpisodesPutAllBySeason(c echo.Context) error {
 			return echo.NewHTTPError(http.StatusNotFound)
 		}
 
+		if err == app.ErrUsedEpisodeNumber {
+			s.logger.Info(
+				"server.HandleEpisodesPutAllBySeason: absolute number already used",
+				zap.Int("series id", params.SeriesID),
+				zap.Int("season number", params.SeasonNumber),
+			)
+			return echo.NewHTTPError(http.StatusConflict)
+		}
+
+		if err == app.ErrLowReputation {
+			s.logger.Info(
+				"server.HandleEpisodesPutAllBySeason: low reputation",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
 		s.logger.Error(
 			"server.HandleEpisodesPutAllBySeason: internal server error",
 			zap.Error(err),
@@ -294,6 +348,25 @@ func (s *Server) HandleEpisodeUpdate(c echo.Context) error {
 			return echo.NewHTTPError(http.StatusNotFound)
 		}
 
+		if err == app.ErrLowReputation {
+			s.logger.Info(
+				"server.HandleEpisodeUpdate: low reputation",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		if err == app.ErrUsedEpisodeNumber {
+			s.logger.Info(
+				"server.HandleEpisodeUpdate: absolute number already used",
+				zap.Int("series id", params.SeriesID),
+				zap.Int("season number", params.SeasonNumber),
+				zap.Int("episode number", params.EpisodeNumber),
+				zap.Int("absolute number", req.AbsoluteNumber.Int),
+			)
+			return echo.NewHTTPError(http.StatusConflict)
+		}
+
 		s.logger.Error(
 			"server.HandleEpisodeUpdate: internal server error",
 			zap.Error(err),
@@ -304,6 +377,66 @@ func (s *Server) HandleEpisodeUpdate(c echo.Context) error {
 	return c.NoContent(http.StatusOK)
 }
 
+// POST /v1/authorized/series/:id/episode/renumber
+func (s *Server) HandleEpisodesRenumber(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate request
+	var req dto.EpisodesRenumberRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// renumber episodes
+	err := s.app.EpisodesRenumber(
+		c.Request().Context(),
+		param.ID,
+		payload.UserID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleEpisodesRenumber: series or episode not found",
+				zap.Int("series id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+		if err == app.ErrUsedEpisodeNumber {
+			s.logger.Info(
+				"server.HandleEpisodesRenumber: episode number already used",
+				zap.Int("series id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusConflict)
+		}
+
+		if err == app.ErrLowReputation {
+			s.logger.Info(
+				"server.HandleEpisodesRenumber: low reputation",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleEpisodesRenumber: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
 // POST /v1/authorized/series/:id/season/:season_number/episode/:episode_number/invalidate
 func (s *Server) HandleEpisodeInvalidate(c echo.Context) error {
 	// bind & validate params
@@ -343,6 +476,14 @@ func (s *Server) HandleEpisodeInvalidate(c echo.Context) error {
 			return echo.NewHTTPError(http.StatusNotFound)
 		}
 
+		if err == app.ErrLowReputation {
+			s.logger.Info(
+				"server.HandleEpisodeInvalidate: low reputation",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
 		s.logger.Error(
 			"server.HandleEpisodeInvalidate: internal server error",
 			zap.Error(err),
@@ -390,6 +531,14 @@ func (s *Server) HandleEpisodesInvalidateAllBySeason(c echo.Context) error {
 			return echo.NewHTTPError(http.StatusNotFound)
 		}
 
+		if err == app.ErrLowReputation {
+			s.logger.Info(
+				"server.HandleEpisodesInvalidateAllBySeason: low reputation",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
 		s.logger.Error(
 			"server.HandleEpisodesInvalidateAllBySeason: internal server error",
 			zap.Error(err),
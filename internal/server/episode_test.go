@@ -13,6 +13,7 @@ import (
 	"github.com/aria3ppp/watchlist-server/internal/dto"
 	"github.com/aria3ppp/watchlist-server/internal/models"
 	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
 	"github.com/aria3ppp/watchlist-server/internal/server/request"
 	"github.com/aria3ppp/watchlist-server/internal/server/response"
 	"github.com/aria3ppp/watchlist-server/internal/testutils"
@@ -98,6 +99,7 @@ func TestHandleEpisodeGet(t *testing.T) {
 		defaults.series.id,
 		seasonNumber,
 		episodeNumber,
+		query.LocaleOptions{},
 	)
 	require.NoError(err)
 
@@ -289,6 +291,7 @@ func TestHandleEpisodesGetAllBySeries(t *testing.T) {
 			Offset:    0,
 			Limit:     config.Config.Validation.Pagination.PageSize.MaxValue,
 		},
+		query.LocaleOptions{},
 	)
 	require.NoError(err)
 
@@ -471,6 +474,7 @@ func TestHandleEpisodesGetAllBySeason(t *testing.T) {
 			Offset:    0,
 			Limit:     config.Config.Validation.Pagination.PageSize.MaxValue,
 		},
+		query.LocaleOptions{},
 	)
 	require.NoError(err)
 
@@ -609,6 +613,7 @@ func TestHandleEpisodePut(t *testing.T) {
 		defaults.series.id,
 		seasonNumber,
 		episodeNumber,
+		query.LocaleOptions{},
 	)
 	require.NoError(err)
 
@@ -748,6 +753,7 @@ func TestHandleEpisodesPutAllBySeason(t *testing.T) {
 			Offset:    0,
 			Limit:     math.MaxInt,
 		},
+		query.LocaleOptions{},
 	)
 	require.NoError(err)
 	require.Equal(len(episodePutAllReq.Episodes), total)
@@ -929,6 +935,7 @@ func TestHandleEpisodeUpdate(t *testing.T) {
 				defaults.series.id,
 				seasonNumber,
 				episodeNumber,
+				query.LocaleOptions{},
 			)
 			require.NoError(err)
 
@@ -951,6 +958,7 @@ func TestHandleEpisodeUpdate(t *testing.T) {
 				defaults.series.id,
 				seasonNumber,
 				episodeNumber,
+				query.LocaleOptions{},
 			)
 			require.NoError(err)
 
@@ -1104,6 +1112,7 @@ func TestHandleEpisodeInvalidate(t *testing.T) {
 		defaults.series.id,
 		seasonNumber,
 		episodeNumber,
+		query.LocaleOptions{},
 	)
 	require.NoError(err)
 
@@ -1252,6 +1261,7 @@ func TestHandleEpisodesInvalidateAllBySeason(t *testing.T) {
 			Offset:    0,
 			Limit:     math.MaxInt,
 		},
+		query.LocaleOptions{},
 	)
 	require.NoError(err)
 	require.Equal(len(episodePutAllReq.Episodes), total)
@@ -1385,6 +1395,7 @@ func TestHandleEpisodeAuditsGetAll(t *testing.T) {
 		defaults.series.id,
 		seasonNumber,
 		episodeNumber,
+		query.LocaleOptions{},
 	)
 	require.NoError(err)
 
@@ -1402,6 +1413,8 @@ func TestHandleEpisodeAuditsGetAll(t *testing.T) {
 		Invalidation:  null.String{},
 		ContributedBy: defaults.user.id,
 		ContributedAt: gotEpisode.ContributedAt,
+		AuditAction:   repo.AuditActionUpdate,
+		AuditActor:    null.IntFrom(defaults.user.id),
 	}
 
 	// no audits
@@ -1445,6 +1458,7 @@ func TestHandleEpisodeAuditsGetAll(t *testing.T) {
 		defaults.series.id,
 		seasonNumber,
 		episodeNumber,
+		query.LocaleOptions{},
 	)
 	require.NoError(err)
 
@@ -1462,6 +1476,8 @@ func TestHandleEpisodeAuditsGetAll(t *testing.T) {
 		Invalidation:  null.String{},
 		ContributedBy: defaults.user.id,
 		ContributedAt: gotEpisode.ContributedAt,
+		AuditAction:   repo.AuditActionUpdate,
+		AuditActor:    null.IntFrom(defaults.user.id),
 	}
 
 	// get update audits
@@ -1510,3 +1526,163 @@ func TestHandleEpisodeAuditsGetAll(t *testing.T) {
 			2,
 		))
 }
+
+func TestHandleEpisodesRenumber(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultSeries)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/series/{id}/episode/renumber"
+	method := http.MethodPost
+
+	// put a season of a two-part episode and a recap
+	err := appInstance.EpisodesPutAllBySeason(
+		ctx,
+		defaults.series.id,
+		1,
+		defaults.user.id,
+		&dto.EpisodesPutAllBySeasonRequest{
+			Episodes: []*dto.EpisodePutRequest{
+				{
+					Title:          "part one",
+					DateReleased:   testutils.Date(2000, 1, 1),
+					AbsoluteNumber: null.IntFrom(1),
+					PartNumber:     null.IntFrom(1),
+				},
+				{
+					Title:          "recap",
+					DateReleased:   testutils.Date(2000, 1, 2),
+					AbsoluteNumber: null.IntFrom(2),
+				},
+				{
+					Title:          "part two",
+					DateReleased:   testutils.Date(2000, 1, 3),
+					AbsoluteNumber: null.IntFrom(3),
+					PartNumber:     null.IntFrom(2),
+				},
+			},
+		},
+	)
+	require.NoError(err)
+
+	// invalid request
+	e.Request(method, path).
+		WithPath("id", defaults.series.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(dto.EpisodesRenumberRequest{}).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			validation.Errors{
+				"moves": validation.ErrRequired,
+			}.Error(),
+		))
+
+	// moving to the numbers of an episode not moved conflicts
+	e.Request(method, path).
+		WithPath("id", defaults.series.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(dto.EpisodesRenumberRequest{
+			Moves: []*dto.EpisodeMove{
+				{
+					SeasonNumber:    1,
+					EpisodeNumber:   2,
+					ToSeasonNumber:  1,
+					ToEpisodeNumber: 3,
+				},
+			},
+		}).
+		Expect().
+		Status(http.StatusConflict)
+
+	// episode not found
+	e.Request(method, path).
+		WithPath("id", defaults.series.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(dto.EpisodesRenumberRequest{
+			Moves: []*dto.EpisodeMove{
+				{
+					SeasonNumber:    1,
+					EpisodeNumber:   4,
+					ToSeasonNumber:  1,
+					ToEpisodeNumber: 5,
+				},
+			},
+		}).
+		Expect().
+		Status(http.StatusNotFound)
+
+	// move the recap to specials and the second part next to the first
+	e.Request(method, path).
+		WithPath("id", defaults.series.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(dto.EpisodesRenumberRequest{
+			Moves: []*dto.EpisodeMove{
+				{
+					SeasonNumber:     1,
+					EpisodeNumber:    2,
+					ToSeasonNumber:   0,
+					ToEpisodeNumber:  1,
+					ToAbsoluteNumber: null.IntFrom(3),
+				},
+				{
+					SeasonNumber:     1,
+					EpisodeNumber:    3,
+					ToSeasonNumber:   1,
+					ToEpisodeNumber:  2,
+					ToAbsoluteNumber: null.IntFrom(2),
+				},
+			},
+		}).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+
+	special, err := appInstance.EpisodeGet(
+		ctx,
+		defaults.series.id, 0, 1,
+		query.LocaleOptions{},
+	)
+	require.NoError(err)
+	require.Equal("recap", special.Title)
+
+	// the second part follows the first
+	e.Request(
+		http.MethodGet,
+		"/v1/authorized/series/{id}/season/{season_number}/episode/{episode_number}",
+	).
+		WithPath("id", defaults.series.id).
+		WithPath("season_number", 1).
+		WithPath("episode_number", 2).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ContainsMap(map[string]any{
+			"title":           "part two",
+			"absolute_number": 2,
+			"part_number":     2,
+		})
+
+	// the history follows the moved episode
+	audits, total, err := appInstance.EpisodeAuditsGetAll(
+		ctx,
+		defaults.series.id, 0, 1,
+		query.SortOrderOptions{
+			Offset:    0,
+			Limit:     math.MaxInt,
+			SortOrder: "asc",
+		},
+	)
+	require.NoError(err)
+	require.Equal(1, total)
+	require.Equal(special.ID, audits[0].ID)
+	require.Equal(1, audits[0].SeasonNumber.Int)
+	require.Equal(2, audits[0].EpisodeNumber.Int)
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/catalog"
+	"github.com/aria3ppp/watchlist-server/internal/server/request"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// GET /v1/authorized/export/latest
+func (s *Server) HandleCatalogExportManifestGet(c echo.Context) error {
+	// open manifest of the latest export
+	file, err := s.app.CatalogExportFileGet(
+		c.Request().Context(),
+		catalog.ManifestFilename,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleCatalogExportManifestGet: export not found",
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleCatalogExportManifestGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+	defer file.Close()
+
+	return c.Stream(http.StatusOK, echo.MIMEApplicationJSON, file)
+}
+
+// GET /v1/authorized/export/latest/:filename
+func (s *Server) HandleCatalogExportFileGet(c echo.Context) error {
+	// bind & validate filename param
+	var param request.CatalogExportFilePathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// open file of the latest export
+	file, err := s.app.CatalogExportFileGet(
+		c.Request().Context(),
+		param.Filename,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleCatalogExportFileGet: file not found",
+				zap.String("filename", param.Filename),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleCatalogExportFileGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+	defer file.Close()
+
+	c.Response().Header().Set(
+		echo.HeaderContentDisposition,
+		"attachment; filename="+param.Filename,
+	)
+	return c.Stream(http.StatusOK, "application/gzip", file)
+}
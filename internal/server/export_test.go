@@ -0,0 +1,116 @@
+package server_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/catalog"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/gavv/httpexpect/v2"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleCatalogExportFileGet(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultUser)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	manifestPath := "/v1/authorized/export/latest"
+	path := "/v1/authorized/export/latest/{filename}"
+	method := http.MethodGet
+
+	filename := catalog.Filename(models.TableNames.Films, catalog.FormatCSV)
+
+	// unauthorized
+	e.Request(method, manifestPath).
+		Expect().
+		Status(http.StatusUnauthorized)
+
+	// invalid filename
+	e.Request(method, path).
+		WithPath("filename", "films.xml").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			validation.Errors{
+				"filename": validation.ErrMatchInvalid,
+			}.Error(),
+		))
+
+	// no export
+	e.Request(method, manifestPath).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			http.StatusText(http.StatusNotFound),
+		))
+
+	// add a movie and export the catalog
+	_, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+
+	export, err := appInstance.CatalogExport(ctx)
+	require.NoError(err)
+
+	// get manifest
+	manifest := e.Request(method, manifestPath).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	manifest.Value("export_id").Equal(export.ID)
+	manifest.Value("files").Array().NotEmpty()
+
+	// file not in export
+	e.Request(method, path).
+		WithPath("filename", "films_audit.csv.gz").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound)
+
+	// download file
+	data := e.Request(method, path).
+		WithPath("filename", filename).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		ContentType("application/gzip").
+		Body().
+		Raw()
+
+	gz, err := gzip.NewReader(bytes.NewReader([]byte(data)))
+	require.NoError(err)
+	content, err := io.ReadAll(gz)
+	require.NoError(err)
+
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Equal(2, len(lines))
+	require.True(strings.HasPrefix(lines[0], "id,title,"))
+	require.Contains(lines[1], ",movie,")
+}
@@ -0,0 +1,379 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/server/request"
+	"github.com/aria3ppp/watchlist-server/internal/server/response"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// GET /v1/authorized/movie/:id/external_ids
+func (s *Server) HandleMovieExternalIDsGet(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// fetch external ids
+	extIDs, err := s.app.MovieExternalIDsGet(c.Request().Context(), param.ID)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleMovieExternalIDsGet: movie not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleMovieExternalIDsGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, extIDs)
+}
+
+// PUT /v1/authorized/movie/:id/external_ids
+func (s *Server) HandleMovieExternalIDPut(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate request
+	var req dto.ExternalIDPutRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// put external id
+	err := s.app.MovieExternalIDPut(
+		c.Request().Context(),
+		param.ID,
+		payload.UserID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleMovieExternalIDPut: movie not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+		if err == app.ErrUsedExternalID {
+			s.logger.Info(
+				"server.HandleMovieExternalIDPut: external id already used",
+				zap.String("provider", req.Provider),
+				zap.String("external_id", req.ExternalID),
+			)
+			return echo.NewHTTPError(http.StatusConflict)
+		}
+
+		if err == app.ErrLowReputation {
+			s.logger.Info(
+				"server.HandleMovieExternalIDPut: low reputation",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleMovieExternalIDPut: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// GET /v1/authorized/movie/:id/external_ids/audits?page=1&page_size=100&sort_order=desc
+func (s *Server) HandleMovieExternalIDAuditsGetAll(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate query
+	var pagQuery request.PaginationSortOrderQuery
+	if httpError := s.bindQuery(c, &pagQuery); httpError != nil {
+		return httpError
+	}
+
+	queryOptions := pagQuery.SetQueryIfNotSet(request.PaginationSortOrderQuery{
+		PaginationQuery: request.PaginationQuery{
+			Page:     config.Config.Validation.Pagination.Page.MinValue,
+			PageSize: config.Config.Validation.Pagination.PageSize.DefaultValue,
+		},
+		SortOrderQuery: request.SortOrderQuery{
+			SortOrder: request.SortOrderDesc,
+		},
+	}).
+		ToQueryOptions()
+
+	// fetch audits
+	audits, total, err := s.app.MovieExternalIDAuditsGetAll(
+		c.Request().Context(),
+		param.ID,
+		queryOptions,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleMovieExternalIDAuditsGetAll: movie not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleMovieExternalIDAuditsGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(
+			pagQuery.Page,
+			pagQuery.PageSize,
+			audits,
+			total,
+		),
+	)
+}
+
+// GET /v1/authorized/movie/by-external/:provider/:external_id
+func (s *Server) HandleMovieGetByExternalID(c echo.Context) error {
+	// bind & validate external id param
+	var param request.ExternalIDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// fetch movie
+	movie, err := s.app.MovieGetByExternalID(
+		c.Request().Context(),
+		param.Provider,
+		param.ExternalID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleMovieGetByExternalID: movie not found",
+				zap.String("provider", param.Provider),
+				zap.String("external_id", param.ExternalID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleMovieGetByExternalID: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, movie)
+}
+
+//------------------------------------------------------------------------------
+
+// GET /v1/authorized/series/:id/external_ids
+func (s *Server) HandleSeriesExternalIDsGet(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// fetch external ids
+	extIDs, err := s.app.SeriesExternalIDsGet(c.Request().Context(), param.ID)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleSeriesExternalIDsGet: series not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleSeriesExternalIDsGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, extIDs)
+}
+
+// PUT /v1/authorized/series/:id/external_ids
+func (s *Server) HandleSeriesExternalIDPut(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate request
+	var req dto.ExternalIDPutRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// put external id
+	err := s.app.SeriesExternalIDPut(
+		c.Request().Context(),
+		param.ID,
+		payload.UserID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleSeriesExternalIDPut: series not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+		if err == app.ErrUsedExternalID {
+			s.logger.Info(
+				"server.HandleSeriesExternalIDPut: external id already used",
+				zap.String("provider", req.Provider),
+				zap.String("external_id", req.ExternalID),
+			)
+			return echo.NewHTTPError(http.StatusConflict)
+		}
+
+		if err == app.ErrLowReputation {
+			s.logger.Info(
+				"server.HandleSeriesExternalIDPut: low reputation",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleSeriesExternalIDPut: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// GET /v1/authorized/series/:id/external_ids/audits?page=1&page_size=100&sort_order=desc
+func (s *Server) HandleSeriesExternalIDAuditsGetAll(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate query
+	var pagQuery request.PaginationSortOrderQuery
+	if httpError := s.bindQuery(c, &pagQuery); httpError != nil {
+		return httpError
+	}
+
+	queryOptions := pagQuery.SetQueryIfNotSet(request.PaginationSortOrderQuery{
+		PaginationQuery: request.PaginationQuery{
+			Page:     config.Config.Validation.Pagination.Page.MinValue,
+			PageSize: config.Config.Validation.Pagination.PageSize.DefaultValue,
+		},
+		SortOrderQuery: request.SortOrderQuery{
+			SortOrder: request.SortOrderDesc,
+		},
+	}).
+		ToQueryOptions()
+
+	// fetch audits
+	audits, total, err := s.app.SeriesExternalIDAuditsGetAll(
+		c.Request().Context(),
+		param.ID,
+		queryOptions,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleSeriesExternalIDAuditsGetAll: series not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleSeriesExternalIDAuditsGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(
+			pagQuery.Page,
+			pagQuery.PageSize,
+			audits,
+			total,
+		),
+	)
+}
+
+// GET /v1/authorized/series/by-external/:provider/:external_id
+func (s *Server) HandleSeriesGetByExternalID(c echo.Context) error {
+	// bind & validate external id param
+	var param request.ExternalIDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// fetch series
+	series, err := s.app.SeriesGetByExternalID(
+		c.Request().Context(),
+		param.Provider,
+		param.ExternalID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleSeriesGetByExternalID: series not found",
+				zap.String("provider", param.Provider),
+				zap.String("external_id", param.ExternalID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleSeriesGetByExternalID: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, series)
+}
@@ -0,0 +1,296 @@
+package server_test
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/gavv/httpexpect/v2"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleMovieExternalIDPut(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultUser)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/movie/{id}/external_ids"
+	method := http.MethodPut
+
+	// invalid request
+	e.Request(method, path).
+		WithPath("id", 1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(dto.ExternalIDPutRequest{
+			Provider:   dto.ExternalIDProviderIMDb,
+			ExternalID: "278",
+		}).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			validation.Errors{
+				"external_id": validation.ErrMatchInvalid,
+			}.Error(),
+		))
+
+	req := dto.ExternalIDPutRequest{
+		Provider:   dto.ExternalIDProviderIMDb,
+		ExternalID: "tt0111161",
+	}
+
+	// movie not found
+	e.Request(method, path).
+		WithPath("id", 999).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(req).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			http.StatusText(http.StatusNotFound),
+		))
+
+	// add movies
+	movieIDs := make([]int, 2)
+	for i := range movieIDs {
+		var err error
+		movieIDs[i], err = appInstance.MovieCreate(
+			ctx,
+			defaults.user.id,
+			&dto.MovieCreateRequest{
+				Title:        "movie",
+				DateReleased: testutils.Date(2000, 1, 1),
+			},
+		)
+		require.NoError(err)
+	}
+
+	// put external id
+	e.Request(method, path).
+		WithPath("id", movieIDs[0]).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(req).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+
+	// put the same external id again is ok
+	e.Request(method, path).
+		WithPath("id", movieIDs[0]).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(req).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+
+	// external id already used by another movie
+	e.Request(method, path).
+		WithPath("id", movieIDs[1]).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(req).
+		Expect().
+		Status(http.StatusConflict).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			http.StatusText(http.StatusConflict),
+		))
+
+	// get external ids
+	extIDs, err := appInstance.MovieExternalIDsGet(ctx, movieIDs[0])
+	require.NoError(err)
+	require.Equal(1, len(extIDs))
+	require.Equal(req.Provider, extIDs[0].Provider)
+	require.Equal(req.ExternalID, extIDs[0].ExternalID)
+	require.Equal(defaults.user.id, extIDs[0].ContributedBy)
+
+	e.Request(http.MethodGet, path).
+		WithPath("id", movieIDs[0]).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Array().
+		Equal(extIDs)
+
+	// the second put is audited
+	audits, total, err := appInstance.MovieExternalIDAuditsGetAll(
+		ctx,
+		movieIDs[0],
+		query.SortOrderOptions{
+			Offset:    0,
+			Limit:     math.MaxInt,
+			SortOrder: "desc",
+		},
+	)
+	require.NoError(err)
+	require.Equal(1, total)
+	require.Equal(req.ExternalID, audits[0].ExternalID)
+}
+
+func TestHandleMovieGetByExternalID(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultUser)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/movie/by-external/{provider}/{external_id}"
+	method := http.MethodGet
+
+	// invalid provider
+	e.Request(method, path).
+		WithPath("provider", "unknown").
+		WithPath("external_id", "tt0111161").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			validation.Errors{
+				"provider": validation.ErrInInvalid,
+			}.Error(),
+		))
+
+	// movie not found
+	e.Request(method, path).
+		WithPath("provider", dto.ExternalIDProviderIMDb).
+		WithPath("external_id", "tt0111161").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			http.StatusText(http.StatusNotFound),
+		))
+
+	// add a movie with an external id
+	movieID, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+	err = appInstance.MovieExternalIDPut(
+		ctx,
+		movieID,
+		defaults.user.id,
+		&dto.ExternalIDPutRequest{
+			Provider:   dto.ExternalIDProviderIMDb,
+			ExternalID: "tt0111161",
+		},
+	)
+	require.NoError(err)
+
+	movie, err := appInstance.MovieGet(ctx, movieID, query.LocaleOptions{})
+	require.NoError(err)
+
+	// lookup movie
+	e.Request(method, path).
+		WithPath("provider", dto.ExternalIDProviderIMDb).
+		WithPath("external_id", "tt0111161").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(movie)
+}
+
+func TestHandleSeriesGetByExternalID(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultSeries)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/series/by-external/{provider}/{external_id}"
+	method := http.MethodGet
+
+	// series not found
+	e.Request(method, path).
+		WithPath("provider", dto.ExternalIDProviderWikidata).
+		WithPath("external_id", "Q1079").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			http.StatusText(http.StatusNotFound),
+		))
+
+	// put series external id
+	e.Request(http.MethodPut, "/v1/authorized/series/{id}/external_ids").
+		WithPath("id", defaults.series.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(dto.ExternalIDPutRequest{
+			Provider:   dto.ExternalIDProviderWikidata,
+			ExternalID: "Q1079",
+		}).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+
+	series, err := appInstance.SeriesGet(
+		ctx,
+		defaults.series.id,
+		query.LocaleOptions{},
+	)
+	require.NoError(err)
+
+	// lookup series
+	e.Request(method, path).
+		WithPath("provider", dto.ExternalIDProviderWikidata).
+		WithPath("external_id", "Q1079").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(series)
+
+	// a series external id does not identify a movie
+	e.Request(
+		method,
+		"/v1/authorized/movie/by-external/{provider}/{external_id}",
+	).
+		WithPath("provider", dto.ExternalIDProviderWikidata).
+		WithPath("external_id", "Q1079").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound)
+
+	// external ids are listed on series
+	extIDs, err := appInstance.SeriesExternalIDsGet(ctx, defaults.series.id)
+	require.NoError(err)
+	require.Equal(1, len(extIDs))
+
+	e.Request(http.MethodGet, "/v1/authorized/series/{id}/external_ids").
+		WithPath("id", defaults.series.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Array().
+		Equal(extIDs)
+}
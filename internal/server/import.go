@@ -0,0 +1,162 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/server/request"
+	"github.com/aria3ppp/watchlist-server/internal/server/response"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// POST /v1/authorized/import?format=csv&dry_run=true
+func (s *Server) HandleImportCreate(c echo.Context) error {
+	// bind & validate query
+	var query request.ImportCreateQuery
+	if httpError := s.bindQuery(c, &query); httpError != nil {
+		return httpError
+	}
+
+	// get form file
+	file, _, httpError := s.getFormFile(c, "file")
+	if httpError != nil {
+		return httpError
+	}
+	defer file.Close()
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// create import job
+	jobID, err := s.app.ImportCreate(
+		c.Request().Context(),
+		payload.UserID,
+		query.Format,
+		query.DryRun,
+		file,
+	)
+	if err != nil {
+		if errors.Is(err, app.ErrInvalidImportFile) {
+			s.logger.Info(
+				"server.HandleImportCreate: invalid import file",
+				zap.Error(err),
+			)
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+
+		s.logger.Error(
+			"server.HandleImportCreate: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusAccepted, response.ID(jobID))
+}
+
+// GET /v1/authorized/import/:id
+func (s *Server) HandleImportJobGet(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// fetch job
+	job, err := s.app.ImportJobGet(
+		c.Request().Context(),
+		param.ID,
+		payload.UserID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleImportJobGet: import job not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleImportJobGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, job)
+}
+
+// GET /v1/authorized/import/:id/errors?page=1&page_size=100&sort_order=asc
+func (s *Server) HandleImportErrorsGetAll(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate query
+	var pagQuery request.PaginationSortOrderQuery
+	if httpError := s.bindQuery(c, &pagQuery); httpError != nil {
+		return httpError
+	}
+
+	queryOptions := pagQuery.SetQueryIfNotSet(request.PaginationSortOrderQuery{
+		PaginationQuery: request.PaginationQuery{
+			Page:     config.Config.Validation.Pagination.Page.MinValue,
+			PageSize: config.Config.Validation.Pagination.PageSize.DefaultValue,
+		},
+		SortOrderQuery: request.SortOrderQuery{
+			SortOrder: request.SortOrderAsc,
+		},
+	}).
+		ToQueryOptions()
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// fetch errors
+	importErrors, total, err := s.app.ImportErrorsGetAll(
+		c.Request().Context(),
+		param.ID,
+		payload.UserID,
+		queryOptions,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleImportErrorsGetAll: import job not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleImportErrorsGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(
+			pagQuery.Page,
+			pagQuery.PageSize,
+			importErrors,
+			total,
+		),
+	)
+}
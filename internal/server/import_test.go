@@ -0,0 +1,252 @@
+package server_test
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/catalog"
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/gavv/httpexpect/v2"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+const importCSVFile = `kind,title,date_released,date_started,series_row,season_number,episode_number
+series,series,,2000-01-01,,,
+episode,episode one,2000-01-01,,1,1,1
+episode,episode two,2000-01-08,,1,1,2
+movie,movie,2000-01-01,,,,
+`
+
+func TestHandleImportCreate(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultUser)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/import"
+	method := http.MethodPost
+
+	// invalid format
+	e.Request(method, path).
+		WithQuery("format", "xml").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithMultipart().
+		WithFileBytes("file", "catalog.xml", []byte("<movie/>")).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			validation.Errors{
+				"format": validation.ErrInInvalid,
+			}.Error(),
+		))
+
+	// missing file
+	e.Request(method, path).
+		WithQuery("format", catalog.FormatCSV).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithMultipart().
+		WithFormField("field", "value").
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage("missing file"))
+
+	// malformed file
+	e.Request(method, path).
+		WithQuery("format", catalog.FormatCSV).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithMultipart().
+		WithFileBytes("file", "catalog.csv", []byte("kind,unknown\n")).
+		Expect().
+		Status(http.StatusBadRequest)
+
+	waitJob := func(jobID int) *models.ImportJob {
+		var job *models.ImportJob
+		err := testutils.WaitUntil(
+			func() (bool, error) {
+				var err error
+				job, err = appInstance.ImportJobGet(ctx, jobID, defaults.user.id)
+				if err != nil {
+					return false, err
+				}
+				return job.FinishedAt.Valid, nil
+			},
+			10*time.Second,
+			100*time.Millisecond,
+		)
+		require.NoError(err)
+		return job
+	}
+
+	// dry-run reports errors and applies nothing
+	jobID := e.Request(method, path).
+		WithQuery("format", catalog.FormatCSV).
+		WithQuery("dry_run", true).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithMultipart().
+		WithFileBytes(
+			"file",
+			"catalog.csv",
+			[]byte(importCSVFile+"episode,episode,2000-01-01,,4,1,1\n"),
+		).
+		Expect().
+		Status(http.StatusAccepted).
+		JSON().
+		Object().
+		Value("id").
+		Number().
+		Raw()
+
+	job := waitJob(int(jobID))
+	require.Equal(app.ImportJobStatusSucceeded, job.Status)
+	require.Equal(5, job.TotalRows)
+	require.Equal(5, job.ProcessedRows)
+	require.Equal(1, job.FailedRows)
+
+	importErrors, total, err := appInstance.ImportErrorsGetAll(
+		ctx,
+		job.ID,
+		defaults.user.id,
+		query.SortOrderOptions{
+			Offset:    0,
+			Limit:     math.MaxInt,
+			SortOrder: "asc",
+		},
+	)
+	require.NoError(err)
+	require.Equal(1, total)
+	require.Equal(5, importErrors[0].RowNumber)
+
+	e.Request(http.MethodGet, "/v1/authorized/import/{id}/errors").
+		WithPath("id", job.ID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("items").
+		Array().
+		Equal(importErrors)
+
+	_, moviesTotal, err := appInstance.MoviesGetAll(ctx, query.Options{
+		Offset:    0,
+		Limit:     math.MaxInt,
+		SortField: models.FilmColumns.ID,
+		SortOrder: "asc",
+	}, query.ReleaseOptions{}, query.LocaleOptions{})
+	require.NoError(err)
+	require.Equal(0, moviesTotal)
+
+	// import rows
+	jobID = e.Request(method, path).
+		WithQuery("format", catalog.FormatCSV).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithMultipart().
+		WithFileBytes("file", "catalog.csv", []byte(importCSVFile)).
+		Expect().
+		Status(http.StatusAccepted).
+		JSON().
+		Object().
+		Value("id").
+		Number().
+		Raw()
+
+	job = waitJob(int(jobID))
+	require.Equal(app.ImportJobStatusSucceeded, job.Status)
+	require.Equal(4, job.ProcessedRows)
+	require.Equal(0, job.FailedRows)
+
+	e.Request(http.MethodGet, "/v1/authorized/import/{id}").
+		WithPath("id", job.ID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Equal(job)
+
+	// imported rows are attributed to the importer
+	serieses, seriesesTotal, err := appInstance.SeriesesGetAll(
+		ctx,
+		query.Options{
+			Offset:    0,
+			Limit:     math.MaxInt,
+			SortField: models.SeriesColumns.ID,
+			SortOrder: "asc",
+		},
+		query.LocaleOptions{},
+	)
+	require.NoError(err)
+	require.Equal(1, seriesesTotal)
+	require.Equal(defaults.user.id, serieses[0].ContributedBy)
+
+	episodes, episodesTotal, err := appInstance.EpisodesGetAllBySeason(
+		ctx,
+		serieses[0].ID,
+		1,
+		query.SortOrderOptions{
+			Offset:    0,
+			Limit:     math.MaxInt,
+			SortOrder: "asc",
+		},
+		query.LocaleOptions{},
+	)
+	require.NoError(err)
+	require.Equal(2, episodesTotal)
+	require.Equal("episode one", episodes[0].Title)
+	require.Equal("episode two", episodes[1].Title)
+	require.Equal(defaults.user.id, episodes[0].ContributedBy)
+
+	movies, moviesTotal, err := appInstance.MoviesGetAll(ctx, query.Options{
+		Offset:    0,
+		Limit:     math.MaxInt,
+		SortField: models.FilmColumns.ID,
+		SortOrder: "asc",
+	}, query.ReleaseOptions{}, query.LocaleOptions{})
+	require.NoError(err)
+	require.Equal(1, moviesTotal)
+	require.Equal(defaults.user.id, movies[0].ContributedBy)
+}
+
+func TestHandleImportJobGet(t *testing.T) {
+	server, _, defaults, teardown := setup(OptEnableDefaultUser)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+
+	// import job not found
+	e.Request(http.MethodGet, "/v1/authorized/import/{id}").
+		WithPath("id", 999).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			http.StatusText(http.StatusNotFound),
+		))
+
+	e.Request(http.MethodGet, "/v1/authorized/import/{id}/errors").
+		WithPath("id", 999).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			http.StatusText(http.StatusNotFound),
+		))
+}
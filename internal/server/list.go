@@ -0,0 +1,983 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/server/request"
+	"github.com/aria3ppp/watchlist-server/internal/server/response"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// GET /v1/authorized/user/:id/lists
+func (s *Server) HandleUserListsGetAll(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate query
+	var pagQuery request.PaginationSortOrderQuery
+	if httpError := s.bindQuery(c, &pagQuery); httpError != nil {
+		return httpError
+	}
+
+	queryOptions := pagQuery.SetQueryIfNotSet(request.PaginationSortOrderQuery{
+		PaginationQuery: request.PaginationQuery{
+			Page:     config.Config.Validation.Pagination.Page.MinValue,
+			PageSize: config.Config.Validation.Pagination.PageSize.DefaultValue,
+		},
+		SortOrderQuery: request.SortOrderQuery{
+			SortOrder: request.SortOrderDesc,
+		},
+	}).ToQueryOptions()
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// fetch lists
+	lists, total, err := s.app.ListsGetAllByUser(
+		c.Request().Context(),
+		payload.UserID,
+		param.ID,
+		queryOptions,
+	)
+	if err != nil {
+		s.logger.Error(
+			"server.HandleUserListsGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(
+			pagQuery.Page,
+			pagQuery.PageSize,
+			lists,
+			total,
+		),
+	)
+}
+
+// POST /v1/authorized/list
+func (s *Server) HandleListCreate(c echo.Context) error {
+	// bind & validate request
+	var req dto.ListCreateRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// create list
+	listID, err := s.app.ListCreate(
+		c.Request().Context(),
+		payload.UserID,
+		&req,
+	)
+	if err != nil {
+		s.logger.Error(
+			"server.HandleListCreate: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, response.ID(listID))
+}
+
+// GET /v1/authorized/list/:id
+func (s *Server) HandleListGet(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// fetch list
+	l, err := s.app.ListGet(c.Request().Context(), payload.UserID, param.ID)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleListGet: list not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleListGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, l)
+}
+
+// PATCH /v1/authorized/list/:id
+func (s *Server) HandleListUpdate(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate request
+	var req dto.ListUpdateRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// update list
+	err := s.app.ListUpdate(
+		c.Request().Context(),
+		payload.UserID,
+		param.ID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleListUpdate: list not found",
+				zap.Int("user id", payload.UserID),
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrListPermission {
+			s.logger.Info(
+				"server.HandleListUpdate: list permission denied",
+				zap.Int("user id", payload.UserID),
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleListUpdate: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// DELETE /v1/authorized/list/:id
+func (s *Server) HandleListDelete(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// delete list
+	err := s.app.ListDelete(c.Request().Context(), payload.UserID, param.ID)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleListDelete: list not found",
+				zap.Int("user id", payload.UserID),
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrListPermission {
+			s.logger.Info(
+				"server.HandleListDelete: list permission denied",
+				zap.Int("user id", payload.UserID),
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleListDelete: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// GET /v1/authorized/list/:id/items
+func (s *Server) HandleListItemsGet(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	localeOptions, httpError := s.getLocaleOptions(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// fetch items
+	items, err := s.app.ListItemsGet(
+		c.Request().Context(),
+		payload.UserID,
+		param.ID,
+		localeOptions,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleListItemsGet: list not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleListItemsGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, items)
+}
+
+// POST /v1/authorized/list/:id/items
+func (s *Server) HandleListItemAdd(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate request
+	var req dto.ListItemAddRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// add item
+	itemID, err := s.app.ListItemAdd(
+		c.Request().Context(),
+		payload.UserID,
+		param.ID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleListItemAdd: list or item not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrListItemExists {
+			s.logger.Info(
+				"server.HandleListItemAdd: item already in list",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusConflict)
+		}
+
+		if err == app.ErrListPermission {
+			s.logger.Info(
+				"server.HandleListItemAdd: list permission denied",
+				zap.Int("user id", payload.UserID),
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleListItemAdd: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, response.ID(itemID))
+}
+
+// PUT /v1/authorized/list/:id/items/order
+func (s *Server) HandleListReorder(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate request
+	var req dto.ListReorderRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// reorder items
+	err := s.app.ListReorder(
+		c.Request().Context(),
+		payload.UserID,
+		param.ID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleListReorder: list not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrInvalidListOrder {
+			s.logger.Info(
+				"server.HandleListReorder: invalid list order",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusBadRequest,
+				"must order all the items once",
+			)
+		}
+
+		if err == app.ErrListPermission {
+			s.logger.Info(
+				"server.HandleListReorder: list permission denied",
+				zap.Int("user id", payload.UserID),
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleListReorder: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// DELETE /v1/authorized/list/:id/items/:item_id
+func (s *Server) HandleListItemRemove(c echo.Context) error {
+	// bind & validate params
+	var params request.ListItemIDPathParam
+	if httpError := s.bindPath(c, &params); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// remove item
+	err := s.app.ListItemRemove(
+		c.Request().Context(),
+		payload.UserID,
+		params.ID,
+		params.ItemID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleListItemRemove: list item not found",
+				zap.Int("id", params.ID),
+				zap.Int("item id", params.ItemID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrListPermission {
+			s.logger.Info(
+				"server.HandleListItemRemove: list permission denied",
+				zap.Int("user id", payload.UserID),
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleListItemRemove: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// POST /v1/authorized/watchlist/add/list?list_id=1
+func (s *Server) HandleWatchlistAddList(c echo.Context) error {
+	// bind & validate query
+	var query request.WatchlistAddListQuery
+	if httpError := s.bindQuery(c, &query); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// add list to watchlist
+	watchIDs, err := s.app.WatchlistAddList(
+		c.Request().Context(),
+		payload.UserID,
+		query.ListID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleWatchlistAddList: list not found",
+				zap.Int("list id", query.ListID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleWatchlistAddList: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, response.IDs(watchIDs))
+}
+
+// GET /v1/authorized/list/:id/members
+func (s *Server) HandleListMembersGet(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// fetch members
+	members, err := s.app.ListMembersGet(
+		c.Request().Context(),
+		payload.UserID,
+		param.ID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleListMembersGet: list not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleListMembersGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, members)
+}
+
+// PUT /v1/authorized/list/:id/members/:user_id
+func (s *Server) HandleListMemberUpdate(c echo.Context) error {
+	// bind & validate params
+	var params request.ListMemberIDPathParam
+	if httpError := s.bindPath(c, &params); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate request
+	var req dto.ListMemberUpdateRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// set member role
+	err := s.app.ListMemberUpdate(
+		c.Request().Context(),
+		payload.UserID,
+		params.ID,
+		params.UserID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleListMemberUpdate: list member not found",
+				zap.Int("id", params.ID),
+				zap.Int("member id", params.UserID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrListPermission {
+			s.logger.Info(
+				"server.HandleListMemberUpdate: list permission denied",
+				zap.Int("user id", payload.UserID),
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleListMemberUpdate: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// DELETE /v1/authorized/list/:id/members/:user_id
+func (s *Server) HandleListMemberRemove(c echo.Context) error {
+	// bind & validate params
+	var params request.ListMemberIDPathParam
+	if httpError := s.bindPath(c, &params); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// remove member
+	err := s.app.ListMemberRemove(
+		c.Request().Context(),
+		payload.UserID,
+		params.ID,
+		params.UserID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleListMemberRemove: list member not found",
+				zap.Int("id", params.ID),
+				zap.Int("member id", params.UserID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrListPermission {
+			s.logger.Info(
+				"server.HandleListMemberRemove: list permission denied",
+				zap.Int("user id", payload.UserID),
+				zap.Int("id", params.ID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleListMemberRemove: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// GET /v1/authorized/list/:id/invites
+func (s *Server) HandleListInvitesGet(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// fetch invites
+	invites, err := s.app.ListInvitesGet(
+		c.Request().Context(),
+		payload.UserID,
+		param.ID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleListInvitesGet: list not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrListPermission {
+			s.logger.Info(
+				"server.HandleListInvitesGet: list permission denied",
+				zap.Int("user id", payload.UserID),
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleListInvitesGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, invites)
+}
+
+// POST /v1/authorized/list/:id/invites
+func (s *Server) HandleListInviteCreate(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate request
+	var req dto.ListInviteCreateRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// invite user
+	inviteID, err := s.app.ListInviteCreate(
+		c.Request().Context(),
+		payload.UserID,
+		param.ID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleListInviteCreate: list or user not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrListPermission {
+			s.logger.Info(
+				"server.HandleListInviteCreate: list permission denied",
+				zap.Int("user id", payload.UserID),
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		if err == app.ErrListMemberExists || err == app.ErrListInviteExists {
+			s.logger.Info(
+				"server.HandleListInviteCreate: user already a member or invited",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusConflict)
+		}
+
+		s.logger.Error(
+			"server.HandleListInviteCreate: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, response.ID(inviteID))
+}
+
+// POST /v1/authorized/list/:id/invites/link
+func (s *Server) HandleListInviteLinkCreate(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate request
+	var req dto.ListInviteLinkCreateRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// create link invite
+	invite, err := s.app.ListInviteLinkCreate(
+		c.Request().Context(),
+		payload.UserID,
+		param.ID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleListInviteLinkCreate: list not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrListPermission {
+			s.logger.Info(
+				"server.HandleListInviteLinkCreate: list permission denied",
+				zap.Int("user id", payload.UserID),
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleListInviteLinkCreate: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, invite)
+}
+
+// GET /v1/authorized/list/invites
+func (s *Server) HandleUserListInvitesGet(c echo.Context) error {
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// fetch invites
+	invites, err := s.app.ListInvitesGetByUser(
+		c.Request().Context(),
+		payload.UserID,
+	)
+	if err != nil {
+		s.logger.Error(
+			"server.HandleUserListInvitesGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, invites)
+}
+
+// POST /v1/authorized/list/invite/:id/accept
+func (s *Server) HandleListInviteAccept(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate request
+	var req dto.ListInviteAcceptRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// accept invite
+	listID, err := s.app.ListInviteAccept(
+		c.Request().Context(),
+		payload.UserID,
+		param.ID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleListInviteAccept: invite not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrListMemberExists {
+			s.logger.Info(
+				"server.HandleListInviteAccept: already a member",
+				zap.Int("user id", payload.UserID),
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusConflict)
+		}
+
+		s.logger.Error(
+			"server.HandleListInviteAccept: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, response.ID(listID))
+}
+
+// DELETE /v1/authorized/list/invite/:id
+func (s *Server) HandleListInviteDelete(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// decline or revoke invite
+	err := s.app.ListInviteDelete(
+		c.Request().Context(),
+		payload.UserID,
+		param.ID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleListInviteDelete: invite not found",
+				zap.Int("user id", payload.UserID),
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleListInviteDelete: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// GET /v1/authorized/list/:id/activities
+func (s *Server) HandleListActivitiesGet(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate query
+	var pagQuery request.PaginationSortOrderQuery
+	if httpError := s.bindQuery(c, &pagQuery); httpError != nil {
+		return httpError
+	}
+
+	queryOptions := pagQuery.SetQueryIfNotSet(request.PaginationSortOrderQuery{
+		PaginationQuery: request.PaginationQuery{
+			Page:     config.Config.Validation.Pagination.Page.MinValue,
+			PageSize: config.Config.Validation.Pagination.PageSize.DefaultValue,
+		},
+		SortOrderQuery: request.SortOrderQuery{
+			SortOrder: request.SortOrderDesc,
+		},
+	}).ToQueryOptions()
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// fetch activities
+	activities, total, err := s.app.ListActivitiesGet(
+		c.Request().Context(),
+		payload.UserID,
+		param.ID,
+		queryOptions,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleListActivitiesGet: list not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrListPermission {
+			s.logger.Info(
+				"server.HandleListActivitiesGet: list permission denied",
+				zap.Int("user id", payload.UserID),
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleListActivitiesGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(
+			pagQuery.Page,
+			pagQuery.PageSize,
+			activities,
+			total,
+		),
+	)
+}
@@ -0,0 +1,472 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/list"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestHandleList(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultUser)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/list/{id}"
+
+	movieIDs := make([]int, 2)
+	for i := range movieIDs {
+		var err error
+		movieIDs[i], err = appInstance.MovieCreate(
+			ctx,
+			defaults.user.id,
+			&dto.MovieCreateRequest{
+				Title:        "movie",
+				DateReleased: testutils.Date(2000, 1, 1),
+			},
+		)
+		require.NoError(err)
+	}
+
+	// another user
+	otherReq := &dto.UserCreateRequest{
+		Email:    "other@prog.net",
+		Password: "pa$$W0RD1",
+	}
+	_, err := appInstance.UserCreate(ctx, otherReq)
+	require.NoError(err)
+	otherTokens, err := appInstance.UserLogin(
+		ctx,
+		&dto.UserLoginRequest{
+			Email:    otherReq.Email,
+			Password: otherReq.Password,
+		},
+	)
+	require.NoError(err)
+	otherAuth := "Bearer " + otherTokens.JwtToken
+
+	// invalid request
+	e.POST("/v1/authorized/list").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ListCreateRequest{Title: "list", Visibility: "friends"}).
+		Expect().
+		Status(http.StatusBadRequest)
+
+	// create a private list
+	listID := int(e.POST("/v1/authorized/list").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ListCreateRequest{Title: "Halloween marathon"}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("id").Number().Raw())
+
+	e.GET(path).
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("title", "Halloween marathon").
+		ValueEqual("visibility", list.VisibilityPrivate)
+
+	// the private list is not found by the other user
+	e.GET(path).
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, otherAuth).
+		Expect().
+		Status(http.StatusNotFound)
+	e.GET("/v1/authorized/user/{id}/lists").
+		WithPath("id", defaults.user.id).
+		WithHeader(echo.HeaderAuthorization, otherAuth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("total_items", 0)
+
+	// add the items
+	itemIDs := make([]int, len(movieIDs))
+	for i, movieID := range movieIDs {
+		itemIDs[i] = int(e.POST(path+"/items").
+			WithPath("id", listID).
+			WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+			WithJSON(&dto.ListItemAddRequest{FilmID: null.IntFrom(movieID)}).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			Value("id").Number().Raw())
+	}
+
+	e.POST(path+"/items").
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ListItemAddRequest{FilmID: null.IntFrom(movieIDs[0])}).
+		Expect().
+		Status(http.StatusConflict)
+	e.POST(path+"/items").
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, otherAuth).
+		WithJSON(&dto.ListItemAddRequest{FilmID: null.IntFrom(movieIDs[0])}).
+		Expect().
+		Status(http.StatusNotFound)
+
+	// reorder the items
+	e.PUT(path+"/items/order").
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ListReorderRequest{ItemIDs: itemIDs[:1]}).
+		Expect().
+		Status(http.StatusBadRequest)
+	e.PUT(path+"/items/order").
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ListReorderRequest{
+			ItemIDs: []int{itemIDs[1], itemIDs[0]},
+		}).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+
+	items := e.GET(path+"/items").
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Array()
+	items.Length().Equal(2)
+	items.Element(0).Object().
+		ValueEqual("id", itemIDs[1]).
+		ValueEqual("position", 1).
+		Value("film").Object().ValueEqual("id", movieIDs[1])
+	items.Element(1).Object().
+		ValueEqual("id", itemIDs[0]).
+		ValueEqual("position", 2)
+
+	// publish the list
+	e.PATCH(path).
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, otherAuth).
+		WithJSON(&dto.ListUpdateRequest{
+			Visibility: null.StringFrom(list.VisibilityPublic),
+		}).
+		Expect().
+		Status(http.StatusNotFound)
+	e.PATCH(path).
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ListUpdateRequest{
+			Visibility: null.StringFrom(list.VisibilityPublic),
+		}).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+
+	e.GET("/v1/authorized/user/{id}/lists").
+		WithPath("id", defaults.user.id).
+		WithHeader(echo.HeaderAuthorization, otherAuth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("total_items", 1)
+
+	// the other user copies the list into their watchlist
+	e.POST("/v1/authorized/watchlist/add/list").
+		WithQuery("list_id", listID).
+		WithHeader(echo.HeaderAuthorization, otherAuth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("ids").Array().Length().Equal(2)
+
+	// remove an item
+	e.DELETE(path+"/items/{item_id}").
+		WithPath("id", listID).
+		WithPath("item_id", itemIDs[1]).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+	e.DELETE(path+"/items/{item_id}").
+		WithPath("id", listID).
+		WithPath("item_id", itemIDs[1]).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound)
+
+	e.GET(path+"/items").
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, otherAuth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Array().
+		Element(0).Object().
+		ValueEqual("id", itemIDs[0]).
+		ValueEqual("position", 1)
+
+	// delete the list
+	e.DELETE(path).
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, otherAuth).
+		Expect().
+		Status(http.StatusNotFound)
+	e.DELETE(path).
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+	e.GET(path).
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound)
+}
+
+func TestHandleListMembers(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultUser)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/list/{id}"
+
+	movieID, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+
+	// the editor and the viewer
+	auths := make([]string, 2)
+	userIDs := make([]int, 2)
+	emails := []string{"editor@prog.net", "viewer@prog.net"}
+	for i, email := range emails {
+		req := &dto.UserCreateRequest{Email: email, Password: "pa$$W0RD1"}
+		userIDs[i], err = appInstance.UserCreate(ctx, req)
+		require.NoError(err)
+		tokens, err := appInstance.UserLogin(
+			ctx,
+			&dto.UserLoginRequest{Email: req.Email, Password: req.Password},
+		)
+		require.NoError(err)
+		auths[i] = "Bearer " + tokens.JwtToken
+	}
+	editorAuth, viewerAuth := auths[0], auths[1]
+
+	listID := int(e.POST("/v1/authorized/list").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ListCreateRequest{Title: "household"}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("id").Number().Raw())
+
+	// invite the editor by email
+	e.POST(path+"/invites").
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ListInviteCreateRequest{
+			Email: "unknown@prog.net",
+			Role:  list.RoleEditor,
+		}).
+		Expect().
+		Status(http.StatusNotFound)
+	inviteID := int(e.POST(path+"/invites").
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ListInviteCreateRequest{
+			Email: emails[0],
+			Role:  list.RoleEditor,
+		}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("id").Number().Raw())
+	e.POST(path+"/invites").
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ListInviteCreateRequest{
+			Email: emails[0],
+			Role:  list.RoleEditor,
+		}).
+		Expect().
+		Status(http.StatusConflict)
+
+	e.GET("/v1/authorized/list/invites").
+		WithHeader(echo.HeaderAuthorization, editorAuth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Array().
+		Element(0).Object().
+		ValueEqual("id", inviteID).
+		ValueEqual("list_id", listID)
+
+	// the viewer cannot accept the invite of the editor
+	e.POST("/v1/authorized/list/invite/{id}/accept").
+		WithPath("id", inviteID).
+		WithHeader(echo.HeaderAuthorization, viewerAuth).
+		WithJSON(&dto.ListInviteAcceptRequest{}).
+		Expect().
+		Status(http.StatusNotFound)
+	e.POST("/v1/authorized/list/invite/{id}/accept").
+		WithPath("id", inviteID).
+		WithHeader(echo.HeaderAuthorization, editorAuth).
+		WithJSON(&dto.ListInviteAcceptRequest{}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("id", listID)
+
+	// invite the viewer by link
+	e.POST(path+"/invites/link").
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, editorAuth).
+		WithJSON(&dto.ListInviteLinkCreateRequest{Role: list.RoleViewer}).
+		Expect().
+		Status(http.StatusForbidden)
+	link := e.POST(path+"/invites/link").
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ListInviteLinkCreateRequest{Role: list.RoleViewer}).
+		Expect().
+		Status(http.StatusOK)
+	linkObject := link.JSON().Object()
+	linkObject.NotContainsKey("token_hash")
+	linkID := int(linkObject.Value("id").Number().Raw())
+	token := linkObject.Value("token").String().Raw()
+
+	e.POST("/v1/authorized/list/invite/{id}/accept").
+		WithPath("id", linkID).
+		WithHeader(echo.HeaderAuthorization, viewerAuth).
+		WithJSON(&dto.ListInviteAcceptRequest{Token: null.StringFrom("wrong")}).
+		Expect().
+		Status(http.StatusNotFound)
+	e.POST("/v1/authorized/list/invite/{id}/accept").
+		WithPath("id", linkID).
+		WithHeader(echo.HeaderAuthorization, viewerAuth).
+		WithJSON(&dto.ListInviteAcceptRequest{Token: null.StringFrom(token)}).
+		Expect().
+		Status(http.StatusOK)
+
+	// the private list is visible to the members
+	e.GET(path).
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, viewerAuth).
+		Expect().
+		Status(http.StatusOK)
+	e.GET(path+"/members").
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, viewerAuth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Array().
+		Length().Equal(3)
+
+	// the editor adds an item the viewer cannot
+	e.POST(path+"/items").
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, viewerAuth).
+		WithJSON(&dto.ListItemAddRequest{FilmID: null.IntFrom(movieID)}).
+		Expect().
+		Status(http.StatusForbidden)
+	e.POST(path+"/items").
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, editorAuth).
+		WithJSON(&dto.ListItemAddRequest{FilmID: null.IntFrom(movieID)}).
+		Expect().
+		Status(http.StatusOK)
+	e.GET(path+"/items").
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, viewerAuth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Array().
+		Element(0).Object().
+		ValueEqual("added_by", userIDs[0])
+
+	// the editor cannot update the list
+	e.PATCH(path).
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, editorAuth).
+		WithJSON(&dto.ListUpdateRequest{Title: null.StringFrom("title")}).
+		Expect().
+		Status(http.StatusForbidden)
+
+	// the owner promotes the viewer and then the viewer leaves
+	e.PUT(path+"/members/{user_id}").
+		WithPath("id", listID).
+		WithPath("user_id", userIDs[1]).
+		WithHeader(echo.HeaderAuthorization, editorAuth).
+		WithJSON(&dto.ListMemberUpdateRequest{Role: list.RoleEditor}).
+		Expect().
+		Status(http.StatusForbidden)
+	e.PUT(path+"/members/{user_id}").
+		WithPath("id", listID).
+		WithPath("user_id", userIDs[1]).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ListMemberUpdateRequest{Role: list.RoleEditor}).
+		Expect().
+		Status(http.StatusOK)
+	e.DELETE(path+"/members/{user_id}").
+		WithPath("id", listID).
+		WithPath("user_id", defaults.user.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusForbidden)
+	e.DELETE(path+"/members/{user_id}").
+		WithPath("id", listID).
+		WithPath("user_id", userIDs[1]).
+		WithHeader(echo.HeaderAuthorization, viewerAuth).
+		Expect().
+		Status(http.StatusOK)
+	e.GET(path).
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, viewerAuth).
+		Expect().
+		Status(http.StatusNotFound)
+
+	// the activity log of the list: create, 2 joins, item add, role update
+	// and leave
+	activities := e.GET(path+"/activities").
+		WithPath("id", listID).
+		WithHeader(echo.HeaderAuthorization, editorAuth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	activities.ValueEqual("total_items", 6)
+	activities.Value("items").Array().
+		Element(0).Object().
+		ValueEqual("action", list.ActivityMemberRemove).
+		ValueEqual("member_id", userIDs[1])
+}
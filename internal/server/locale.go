@@ -0,0 +1,28 @@
+package server
+
+import (
+	"github.com/aria3ppp/watchlist-server/internal/locale"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/labstack/echo/v4"
+)
+
+const headerAcceptLanguage = "Accept-Language"
+
+// getLocaleOptions prefers the languages of the Accept-Language header and
+// falls back to the locale of the user
+func (s *Server) getLocaleOptions(
+	c echo.Context,
+) (query.LocaleOptions, *echo.HTTPError) {
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return query.LocaleOptions{}, httpError
+	}
+	// responses vary by the requested languages
+	c.Response().Header().Add(echo.HeaderVary, headerAcceptLanguage)
+	return query.LocaleOptions{
+		Languages: locale.ParseAcceptLanguage(
+			c.Request().Header.Get(headerAcceptLanguage),
+		),
+		UserID: payload.UserID,
+	}, nil
+}
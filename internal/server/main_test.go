@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"net/http"
 	"net/http/httptest"
 	"os"
 	"testing"
@@ -15,6 +16,8 @@ import (
 	"github.com/aria3ppp/watchlist-server/internal/config"
 	"github.com/aria3ppp/watchlist-server/internal/dto"
 	"github.com/aria3ppp/watchlist-server/internal/hasher"
+	"github.com/aria3ppp/watchlist-server/internal/metadata"
+	"github.com/aria3ppp/watchlist-server/internal/metadata/metadatatestutils"
 	"github.com/aria3ppp/watchlist-server/internal/repo"
 	"github.com/aria3ppp/watchlist-server/internal/search"
 	"github.com/aria3ppp/watchlist-server/internal/search/searchtestutils"
@@ -135,6 +138,12 @@ func setup(
 		searchService,
 		hasher,
 		storageService,
+		metadata.NewTMDb(
+			http.DefaultClient,
+			fakeTMDb.BaseURL(),
+			fakeTMDb.ImageBaseURL(),
+			fakeTMDb.APIKey,
+		),
 	)
 	router := echo.New()
 	server := appServer.NewServer(
@@ -217,6 +226,7 @@ func setup(
 			10*time.Second,
 			time.Second,
 			config.Config.MinIO.Bucket.Image.Name,
+			config.Config.MinIO.Bucket.Export.Name,
 		); err != nil {
 			log.Panicf("storage_test.teardown: error deleting buckets: %s", err)
 		}
@@ -239,6 +249,8 @@ func setup(
 		if err != nil {
 			log.Panicf("server_test.teardown: migrator.Drop error: %s", err)
 		}
+		// reset metadata provider
+		fakeTMDb.Reset()
 		// close server
 		testServer.Close()
 	}
@@ -250,6 +262,7 @@ var (
 	db          *sql.DB
 	esClient    *elasticsearch.Client
 	minioClient *minio.Client
+	fakeTMDb    *metadatatestutils.FakeTMDb
 )
 
 func TestMain(m *testing.M) {
@@ -311,9 +324,15 @@ func TestMain(m *testing.M) {
 		log.Panicf("server_test.TestMain: minio.New error: %s", err)
 	}
 
+	// setup metadata provider
+	fakeTMDb = metadatatestutils.NewFakeTMDb("api-key")
+
 	// Run tests
 	code := m.Run()
 
+	// close metadata provider
+	fakeTMDb.Close()
+
 	// close db
 	err = db.Close()
 	if err != nil {
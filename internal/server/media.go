@@ -0,0 +1,687 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/server/request"
+	"github.com/aria3ppp/watchlist-server/internal/server/response"
+	"github.com/aria3ppp/watchlist-server/internal/storage"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// GET /v1/authorized/movie/:id/media
+func (s *Server) HandleMovieMediaGet(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// fetch media
+	media, err := s.app.MovieMediaGet(c.Request().Context(), param.ID)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleMovieMediaGet: movie not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleMovieMediaGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, media)
+}
+
+// POST /v1/authorized/movie/:id/media?kind=backdrop&primary=true
+func (s *Server) HandleMovieMediaUpload(c echo.Context) error {
+	var (
+		filename = config.Config.MinIO.Filename.Media
+		bucket   = config.Config.MinIO.Bucket.Image.Name
+		category = config.Config.MinIO.Category.Movie
+	)
+
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate query
+	var query request.MediaUploadQuery
+	if httpError := s.bindQuery(c, &query); httpError != nil {
+		return httpError
+	}
+
+	// get form file
+	file, fileHeader, httpError := s.getFormFile(c, filename)
+	if httpError != nil {
+		return httpError
+	}
+	defer file.Close()
+
+	// detect content type
+	contentType, httpError := s.ensureSupportedFileType(
+		file,
+		config.Config.MinIO.Bucket.Image.SupportedTypes,
+	)
+	if httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// upload media: each media is stored by a unique filename
+	media, err := s.app.MovieMediaUpload(
+		c.Request().Context(),
+		param.ID,
+		payload.UserID,
+		query.Kind,
+		query.Primary,
+		file,
+		&storage.PutOptions{
+			Bucket:      bucket,
+			Category:    category,
+			CategoryID:  param.ID,
+			Filename:    filename + "-" + uuid.NewString(),
+			ContentType: contentType,
+			Size:        fileHeader.Size,
+		},
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleMovieMediaUpload: movie not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrLowReputation {
+			s.logger.Info(
+				"server.HandleMovieMediaUpload: low reputation",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleMovieMediaUpload: failed uploading media",
+			zap.String("bucket", bucket),
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, media)
+}
+
+// POST /v1/authorized/movie/:id/media/trailer
+func (s *Server) HandleMovieMediaTrailerAdd(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate request
+	var req dto.MediaTrailerAddRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// add trailer
+	media, err := s.app.MovieMediaTrailerAdd(
+		c.Request().Context(),
+		param.ID,
+		payload.UserID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleMovieMediaTrailerAdd: movie not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrLowReputation {
+			s.logger.Info(
+				"server.HandleMovieMediaTrailerAdd: low reputation",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleMovieMediaTrailerAdd: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, media)
+}
+
+// PUT /v1/authorized/movie/:id/media/order
+func (s *Server) HandleMovieMediaReorder(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate request
+	var req dto.MediaReorderRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// reorder media
+	err := s.app.MovieMediaReorder(
+		c.Request().Context(),
+		param.ID,
+		payload.UserID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleMovieMediaReorder: movie not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+		if err == app.ErrInvalidMediaOrder {
+			s.logger.Info(
+				"server.HandleMovieMediaReorder: invalid media order",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusBadRequest,
+				"must order all the media once with one primary media per kind",
+			)
+		}
+
+		if err == app.ErrLowReputation {
+			s.logger.Info(
+				"server.HandleMovieMediaReorder: low reputation",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleMovieMediaReorder: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// DELETE /v1/authorized/movie/:id/media/:media_id
+func (s *Server) HandleMovieMediaRemove(c echo.Context) error {
+	// bind & validate path params
+	var param request.MediaIDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// remove media
+	err := s.app.MovieMediaRemove(
+		c.Request().Context(),
+		param.ID,
+		param.MediaID,
+		payload.UserID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleMovieMediaRemove: movie or media not found",
+				zap.Int("id", param.ID),
+				zap.Int("media id", param.MediaID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrLowReputation {
+			s.logger.Info(
+				"server.HandleMovieMediaRemove: low reputation",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleMovieMediaRemove: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// GET /v1/authorized/movie/:id/media/audits/?page=1&page_size=60&sort_order=desc
+func (s *Server) HandleMovieMediaAuditsGetAll(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate query
+	var pagQuery request.PaginationSortOrderQuery
+	if httpError := s.bindQuery(c, &pagQuery); httpError != nil {
+		return httpError
+	}
+
+	queryOptions := pagQuery.SetQueryIfNotSet(request.PaginationSortOrderQuery{
+		PaginationQuery: request.PaginationQuery{
+			Page:     config.Config.Validation.Pagination.Page.MinValue,
+			PageSize: config.Config.Validation.Pagination.PageSize.DefaultValue,
+		},
+		SortOrderQuery: request.SortOrderQuery{
+			SortOrder: request.SortOrderDesc,
+		},
+	}).ToQueryOptions()
+
+	// fetch audits
+	audits, total, err := s.app.MovieMediaAuditsGetAll(
+		c.Request().Context(),
+		param.ID,
+		queryOptions,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleMovieMediaAuditsGetAll: movie not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleMovieMediaAuditsGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(
+			pagQuery.Page,
+			pagQuery.PageSize,
+			audits,
+			total,
+		),
+	)
+}
+
+// GET /v1/authorized/series/:id/media
+func (s *Server) HandleSeriesMediaGet(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// fetch media
+	media, err := s.app.SeriesMediaGet(c.Request().Context(), param.ID)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleSeriesMediaGet: series not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleSeriesMediaGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, media)
+}
+
+// POST /v1/authorized/series/:id/media?kind=backdrop&primary=true
+func (s *Server) HandleSeriesMediaUpload(c echo.Context) error {
+	var (
+		filename = config.Config.MinIO.Filename.Media
+		bucket   = config.Config.MinIO.Bucket.Image.Name
+		category = config.Config.MinIO.Category.Series
+	)
+
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate query
+	var query request.MediaUploadQuery
+	if httpError := s.bindQuery(c, &query); httpError != nil {
+		return httpError
+	}
+
+	// get form file
+	file, fileHeader, httpError := s.getFormFile(c, filename)
+	if httpError != nil {
+		return httpError
+	}
+	defer file.Close()
+
+	// detect content type
+	contentType, httpError := s.ensureSupportedFileType(
+		file,
+		config.Config.MinIO.Bucket.Image.SupportedTypes,
+	)
+	if httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// upload media: each media is stored by a unique filename
+	media, err := s.app.SeriesMediaUpload(
+		c.Request().Context(),
+		param.ID,
+		payload.UserID,
+		query.Kind,
+		query.Primary,
+		file,
+		&storage.PutOptions{
+			Bucket:      bucket,
+			Category:    category,
+			CategoryID:  param.ID,
+			Filename:    filename + "-" + uuid.NewString(),
+			ContentType: contentType,
+			Size:        fileHeader.Size,
+		},
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleSeriesMediaUpload: series not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrLowReputation {
+			s.logger.Info(
+				"server.HandleSeriesMediaUpload: low reputation",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleSeriesMediaUpload: failed uploading media",
+			zap.String("bucket", bucket),
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, media)
+}
+
+// POST /v1/authorized/series/:id/media/trailer
+func (s *Server) HandleSeriesMediaTrailerAdd(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate request
+	var req dto.MediaTrailerAddRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// add trailer
+	media, err := s.app.SeriesMediaTrailerAdd(
+		c.Request().Context(),
+		param.ID,
+		payload.UserID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleSeriesMediaTrailerAdd: series not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrLowReputation {
+			s.logger.Info(
+				"server.HandleSeriesMediaTrailerAdd: low reputation",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleSeriesMediaTrailerAdd: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, media)
+}
+
+// PUT /v1/authorized/series/:id/media/order
+func (s *Server) HandleSeriesMediaReorder(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate request
+	var req dto.MediaReorderRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// reorder media
+	err := s.app.SeriesMediaReorder(
+		c.Request().Context(),
+		param.ID,
+		payload.UserID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleSeriesMediaReorder: series not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+		if err == app.ErrInvalidMediaOrder {
+			s.logger.Info(
+				"server.HandleSeriesMediaReorder: invalid media order",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(
+				http.StatusBadRequest,
+				"must order all the media once with one primary media per kind",
+			)
+		}
+
+		if err == app.ErrLowReputation {
+			s.logger.Info(
+				"server.HandleSeriesMediaReorder: low reputation",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleSeriesMediaReorder: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// DELETE /v1/authorized/series/:id/media/:media_id
+func (s *Server) HandleSeriesMediaRemove(c echo.Context) error {
+	// bind & validate path params
+	var param request.MediaIDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// remove media
+	err := s.app.SeriesMediaRemove(
+		c.Request().Context(),
+		param.ID,
+		param.MediaID,
+		payload.UserID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleSeriesMediaRemove: series or media not found",
+				zap.Int("id", param.ID),
+				zap.Int("media id", param.MediaID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrLowReputation {
+			s.logger.Info(
+				"server.HandleSeriesMediaRemove: low reputation",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleSeriesMediaRemove: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// GET /v1/authorized/series/:id/media/audits/?page=1&page_size=60&sort_order=desc
+func (s *Server) HandleSeriesMediaAuditsGetAll(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate query
+	var pagQuery request.PaginationSortOrderQuery
+	if httpError := s.bindQuery(c, &pagQuery); httpError != nil {
+		return httpError
+	}
+
+	queryOptions := pagQuery.SetQueryIfNotSet(request.PaginationSortOrderQuery{
+		PaginationQuery: request.PaginationQuery{
+			Page:     config.Config.Validation.Pagination.Page.MinValue,
+			PageSize: config.Config.Validation.Pagination.PageSize.DefaultValue,
+		},
+		SortOrderQuery: request.SortOrderQuery{
+			SortOrder: request.SortOrderDesc,
+		},
+	}).ToQueryOptions()
+
+	// fetch audits
+	audits, total, err := s.app.SeriesMediaAuditsGetAll(
+		c.Request().Context(),
+		param.ID,
+		queryOptions,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleSeriesMediaAuditsGetAll: series not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleSeriesMediaAuditsGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(
+			pagQuery.Page,
+			pagQuery.PageSize,
+			audits,
+			total,
+		),
+	)
+}
@@ -0,0 +1,196 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/gavv/httpexpect/v2"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleMovieMedia(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultUser)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/movie/{id}/media"
+	filename := config.Config.MinIO.Filename.Media
+
+	movieID, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+
+	// trailer is not an upload kind
+	e.POST(path).
+		WithPath("id", movieID).
+		WithQuery("kind", dto.MediaKindTrailer).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithMultipart().
+		WithFile(filename, filepath.Join("testdata", "gopher-1.webp")).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			validation.Errors{
+				"kind": validation.ErrInInvalid,
+			}.Error(),
+		))
+
+	// movie not found
+	e.POST(path).
+		WithPath("id", movieID+1).
+		WithQuery("kind", dto.MediaKindPoster).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithMultipart().
+		WithFile(filename, filepath.Join("testdata", "gopher-1.webp")).
+		Expect().
+		Status(http.StatusNotFound)
+
+	// upload a primary poster then a backdrop
+	poster := e.POST(path).
+		WithPath("id", movieID).
+		WithQuery("kind", dto.MediaKindPoster).
+		WithQuery("primary", true).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithMultipart().
+		WithFile(filename, filepath.Join("testdata", "gopher-1.webp")).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	poster.ContainsMap(map[string]any{
+		"film_id":    movieID,
+		"kind":       dto.MediaKindPoster,
+		"position":   1,
+		"is_primary": true,
+	})
+	posterID := int(poster.Value("id").Number().Raw())
+
+	backdropID := int(e.POST(path).
+		WithPath("id", movieID).
+		WithQuery("kind", dto.MediaKindBackdrop).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithMultipart().
+		WithFile(filename, filepath.Join("testdata", "gopher-2.png")).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("id").
+		Number().
+		Raw())
+
+	// add a trailer link
+	trailerID := int(e.POST(path+"/trailer").
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(dto.MediaTrailerAddRequest{
+			URL: "https://www.youtube.com/watch?v=trailer",
+		}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ContainsMap(map[string]any{
+			"kind":     dto.MediaKindTrailer,
+			"uri":      "https://www.youtube.com/watch?v=trailer",
+			"position": 3,
+		}).
+		Value("id").
+		Number().
+		Raw())
+
+	// an order missing media is invalid
+	e.PUT(path+"/order").
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(dto.MediaReorderRequest{
+			Media: []*dto.MediaOrder{{ID: trailerID}},
+		}).
+		Expect().
+		Status(http.StatusBadRequest)
+
+	// put the trailer first
+	e.PUT(path+"/order").
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(dto.MediaReorderRequest{
+			Media: []*dto.MediaOrder{
+				{ID: trailerID, Primary: true},
+				{ID: posterID, Primary: true},
+				{ID: backdropID},
+			},
+		}).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+
+	media := e.GET(path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Array()
+	media.Length().Equal(3)
+	media.Element(0).Object().ContainsMap(map[string]any{
+		"id":         trailerID,
+		"is_primary": true,
+	})
+	media.Element(1).Object().ValueEqual("id", posterID)
+	media.Element(2).Object().ValueEqual("id", backdropID)
+
+	// remove the poster
+	e.DELETE(path+"/{media_id}").
+		WithPath("id", movieID).
+		WithPath("media_id", posterID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+
+	// a removed media is not found
+	e.DELETE(path+"/{media_id}").
+		WithPath("id", movieID).
+		WithPath("media_id", posterID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound)
+
+	e.GET(path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Array().
+		Length().
+		Equal(2)
+
+	// the history keeps the removed poster
+	e.GET(path+"/audits").
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("total_items", 4)
+}
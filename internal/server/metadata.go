@@ -0,0 +1,205 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/server/request"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// GET /v1/authorized/movie/:id/metadata
+func (s *Server) HandleMovieMetadataGet(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// fetch provider metadata
+	movie, err := s.app.MovieMetadataGet(c.Request().Context(), param.ID)
+	if err != nil {
+		return s.metadataHTTPError(
+			"server.HandleMovieMetadataGet",
+			err,
+			zap.Int("id", param.ID),
+		)
+	}
+
+	return c.JSON(http.StatusOK, movie)
+}
+
+// POST /v1/authorized/movie/:id/metadata
+func (s *Server) HandleMovieMetadataApply(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// apply provider metadata
+	err := s.app.MovieMetadataApply(
+		c.Request().Context(),
+		param.ID,
+		payload.UserID,
+	)
+	if err != nil {
+		return s.metadataHTTPError(
+			"server.HandleMovieMetadataApply",
+			err,
+			zap.Int("id", param.ID),
+		)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// GET /v1/authorized/series/:id/metadata
+func (s *Server) HandleSeriesMetadataGet(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// fetch provider metadata
+	series, err := s.app.SeriesMetadataGet(c.Request().Context(), param.ID)
+	if err != nil {
+		return s.metadataHTTPError(
+			"server.HandleSeriesMetadataGet",
+			err,
+			zap.Int("id", param.ID),
+		)
+	}
+
+	return c.JSON(http.StatusOK, series)
+}
+
+// POST /v1/authorized/series/:id/metadata
+func (s *Server) HandleSeriesMetadataApply(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// apply provider metadata
+	err := s.app.SeriesMetadataApply(
+		c.Request().Context(),
+		param.ID,
+		payload.UserID,
+	)
+	if err != nil {
+		return s.metadataHTTPError(
+			"server.HandleSeriesMetadataApply",
+			err,
+			zap.Int("id", param.ID),
+		)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// GET /v1/authorized/series/:id/season/:season_number/metadata
+func (s *Server) HandleSeasonMetadataGet(c echo.Context) error {
+	// bind & validate params
+	var params request.SeriesSeasonNumberPathParam
+	if httpError := s.bindPath(c, &params); httpError != nil {
+		return httpError
+	}
+
+	// fetch provider metadata
+	season, err := s.app.SeasonMetadataGet(
+		c.Request().Context(),
+		params.SeriesID,
+		params.SeasonNumber,
+	)
+	if err != nil {
+		return s.metadataHTTPError(
+			"server.HandleSeasonMetadataGet",
+			err,
+			zap.Int("series id", params.SeriesID),
+			zap.Int("season number", params.SeasonNumber),
+		)
+	}
+
+	return c.JSON(http.StatusOK, season)
+}
+
+// POST /v1/authorized/series/:id/season/:season_number/metadata
+func (s *Server) HandleSeasonMetadataApply(c echo.Context) error {
+	// bind & validate params
+	var params request.SeriesSeasonNumberPathParam
+	if httpError := s.bindPath(c, &params); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// apply provider metadata
+	err := s.app.SeasonMetadataApply(
+		c.Request().Context(),
+		params.SeriesID,
+		params.SeasonNumber,
+		payload.UserID,
+	)
+	if err != nil {
+		return s.metadataHTTPError(
+			"server.HandleSeasonMetadataApply",
+			err,
+			zap.Int("series id", params.SeriesID),
+			zap.Int("season number", params.SeasonNumber),
+		)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// metadataHTTPError logs and maps the errors of metadata handlers
+func (s *Server) metadataHTTPError(
+	handler string,
+	err error,
+	fields ...zap.Field,
+) *echo.HTTPError {
+	switch {
+	case err == app.ErrNotFound:
+		s.logger.Info(handler+": not found", fields...)
+		return echo.NewHTTPError(http.StatusNotFound)
+	case err == app.ErrMetadataNotFound:
+		s.logger.Info(handler+": metadata not found", fields...)
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case err == app.ErrLowReputation:
+		s.logger.Info(handler+": low reputation", fields...)
+		return echo.NewHTTPError(http.StatusForbidden)
+	case err == app.ErrUsedEpisodeNumber:
+		s.logger.Info(handler+": absolute number already used", fields...)
+		return echo.NewHTTPError(http.StatusConflict)
+	case err == app.ErrNoExternalID:
+		s.logger.Info(handler+": no external id", fields...)
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, err.Error())
+	case errors.Is(err, app.ErrMetadataProvider):
+		s.logger.Error(
+			handler+": metadata provider failed",
+			append(fields, zap.Error(err))...,
+		)
+		return echo.NewHTTPError(http.StatusBadGateway)
+	}
+
+	s.logger.Error(handler+": internal server error", zap.Error(err))
+	return echo.NewHTTPError(http.StatusInternalServerError)
+}
@@ -0,0 +1,232 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestHandleMovieMetadata(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultUser)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/movie/{id}/metadata"
+
+	// movie not found
+	e.GET(path).
+		WithPath("id", 999).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			http.StatusText(http.StatusNotFound),
+		))
+
+	// add movie
+	movieID, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+
+	// no external id
+	e.GET(path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusUnprocessableEntity).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(app.ErrNoExternalID.Error()))
+
+	err = appInstance.MovieExternalIDPut(
+		ctx,
+		movieID,
+		defaults.user.id,
+		&dto.ExternalIDPutRequest{
+			Provider:   dto.ExternalIDProviderTMDb,
+			ExternalID: "603",
+		},
+	)
+	require.NoError(err)
+
+	// provider metadata not found
+	e.GET(path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(app.ErrMetadataNotFound.Error()))
+
+	fakeTMDb.SetResponse("/movie/603", map[string]any{
+		"title":        "The Matrix",
+		"overview":     "A computer hacker learns about the true nature of reality.",
+		"release_date": "1999-03-30",
+		"runtime":      136,
+		"poster_path":  "/matrix.jpg",
+	})
+	fakeTMDb.SetImage("/matrix.jpg", []byte("\xff\xd8\xff\xe0 jpeg image"))
+
+	// preview
+	e.GET(path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("title", "The Matrix").
+		ValueEqual("duration", 136*60).
+		ValueEqual("poster", fakeTMDb.ImageBaseURL()+"/matrix.jpg")
+
+	// preview does not change the movie
+	movie, err := appInstance.MovieGet(ctx, movieID, query.LocaleOptions{})
+	require.NoError(err)
+	require.Equal("movie", movie.Title)
+
+	// apply
+	e.POST(path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+
+	movie, err = appInstance.MovieGet(ctx, movieID, query.LocaleOptions{})
+	require.NoError(err)
+	require.Equal("The Matrix", movie.Title)
+	require.Equal(
+		null.StringFrom(
+			"A computer hacker learns about the true nature of reality.",
+		),
+		movie.Descriptions,
+	)
+	require.Equal(testutils.Date(1999, 3, 30), movie.DateReleased.UTC())
+	require.Equal(null.IntFrom(136*60), movie.Duration)
+	require.True(movie.Poster.Valid)
+
+	// changes are audited
+	_, total, err := appInstance.MovieAuditsGetAll(
+		ctx,
+		movieID,
+		query.SortOrderOptions{Limit: 10, SortOrder: "desc"},
+	)
+	require.NoError(err)
+	require.Equal(2, total)
+}
+
+func TestHandleSeasonMetadata(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(
+		OptEnableDefaultUser | OptEnableDefaultSeries,
+	)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/series/{id}/season/{season_number}/metadata"
+
+	err := appInstance.SeriesExternalIDPut(
+		ctx,
+		defaults.series.id,
+		defaults.user.id,
+		&dto.ExternalIDPutRequest{
+			Provider:   dto.ExternalIDProviderTMDb,
+			ExternalID: "1396",
+		},
+	)
+	require.NoError(err)
+
+	fakeTMDb.SetResponse("/tv/1396/season/1", map[string]any{
+		"season_number": 1,
+		"episodes": []map[string]any{
+			{
+				"episode_number": 1,
+				"name":           "Pilot",
+				"air_date":       "2008-01-20",
+				"runtime":        58,
+			},
+			{
+				// missing release date is skipped
+				"episode_number": 2,
+				"name":           "Cat's in the Bag...",
+			},
+			{
+				"episode_number": 3,
+				"name":           "...And the Bag's in the River",
+				"air_date":       "2008-02-10",
+			},
+		},
+	})
+
+	// provider season not found
+	e.POST(path).
+		WithPath("id", defaults.series.id).
+		WithPath("season_number", 2).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(app.ErrMetadataNotFound.Error()))
+
+	// preview
+	e.GET(path).
+		WithPath("id", defaults.series.id).
+		WithPath("season_number", 1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("episodes").
+		Array().
+		Length().
+		Equal(3)
+
+	// apply
+	e.POST(path).
+		WithPath("id", defaults.series.id).
+		WithPath("season_number", 1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+
+	episodes, total, err := appInstance.EpisodesGetAllBySeason(
+		ctx,
+		defaults.series.id,
+		1,
+		query.SortOrderOptions{Limit: 10, SortOrder: "asc"},
+		query.LocaleOptions{},
+	)
+	require.NoError(err)
+	require.Equal(2, total)
+	require.Equal("Pilot", episodes[0].Title)
+	require.Equal(null.IntFrom(1), episodes[0].EpisodeNumber)
+	require.Equal(null.IntFrom(58*60), episodes[0].Duration)
+	require.Equal("...And the Bag's in the River", episodes[1].Title)
+	require.Equal(null.IntFrom(3), episodes[1].EpisodeNumber)
+}
@@ -0,0 +1,437 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/server/request"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// DELETE /v1/authorized/movie/:id/
+func (s *Server) HandleMovieHide(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// hide movie
+	err := s.app.MovieHide(c.Request().Context(), param.ID, payload.UserID)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleMovieHide: movie not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrNotModerator {
+			s.logger.Info(
+				"server.HandleMovieHide: not moderator",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleMovieHide: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// POST /v1/authorized/movie/:id/restore
+func (s *Server) HandleMovieRestore(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// restore movie
+	err := s.app.MovieRestore(c.Request().Context(), param.ID, payload.UserID)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleMovieRestore: movie not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrNotModerator {
+			s.logger.Info(
+				"server.HandleMovieRestore: not moderator",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleMovieRestore: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// DELETE /v1/authorized/movie/:id/purge
+func (s *Server) HandleMovieDelete(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// delete movie
+	err := s.app.MovieDelete(c.Request().Context(), param.ID, payload.UserID)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleMovieDelete: movie not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrNotModerator {
+			s.logger.Info(
+				"server.HandleMovieDelete: not moderator",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		// the movie is deleted even if its files or search document are left
+		if errors.Is(err, app.ErrPurgeCleanup) {
+			s.logger.Error(
+				"server.HandleMovieDelete: purge cleanup failed",
+				zap.Int("id", param.ID),
+				zap.Error(err),
+			)
+			return c.NoContent(http.StatusOK)
+		}
+
+		s.logger.Error(
+			"server.HandleMovieDelete: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// DELETE /v1/authorized/series/:id/
+func (s *Server) HandleSeriesHide(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// hide series
+	err := s.app.SeriesHide(c.Request().Context(), param.ID, payload.UserID)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleSeriesHide: series not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrNotModerator {
+			s.logger.Info(
+				"server.HandleSeriesHide: not moderator",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleSeriesHide: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// POST /v1/authorized/series/:id/restore
+func (s *Server) HandleSeriesRestore(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// restore series
+	err := s.app.SeriesRestore(c.Request().Context(), param.ID, payload.UserID)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleSeriesRestore: series not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrNotModerator {
+			s.logger.Info(
+				"server.HandleSeriesRestore: not moderator",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleSeriesRestore: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// DELETE /v1/authorized/series/:id/purge
+func (s *Server) HandleSeriesDelete(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// delete series
+	err := s.app.SeriesDelete(c.Request().Context(), param.ID, payload.UserID)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleSeriesDelete: series not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrNotModerator {
+			s.logger.Info(
+				"server.HandleSeriesDelete: not moderator",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		// the series is deleted even if its files or search document are left
+		if errors.Is(err, app.ErrPurgeCleanup) {
+			s.logger.Error(
+				"server.HandleSeriesDelete: purge cleanup failed",
+				zap.Int("id", param.ID),
+				zap.Error(err),
+			)
+			return c.NoContent(http.StatusOK)
+		}
+
+		s.logger.Error(
+			"server.HandleSeriesDelete: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// DELETE /v1/authorized/series/:id/season/:season_number/episode/:episode_number/
+func (s *Server) HandleEpisodeHide(c echo.Context) error {
+	// bind & validate params
+	var params request.SeriesSeasonEpisodeNumberPathParam
+	if httpError := s.bindPath(c, &params); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// hide episode
+	err := s.app.EpisodeHide(
+		c.Request().Context(),
+		params.SeriesID,
+		params.SeasonNumber,
+		params.EpisodeNumber,
+		payload.UserID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleEpisodeHide: episode not found",
+				zap.Int("series id", params.SeriesID),
+				zap.Int("season number", params.SeasonNumber),
+				zap.Int("episode number", params.EpisodeNumber),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrNotModerator {
+			s.logger.Info(
+				"server.HandleEpisodeHide: not moderator",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleEpisodeHide: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// POST /v1/authorized/series/:id/season/:season_number/episode/:episode_number/restore
+func (s *Server) HandleEpisodeRestore(c echo.Context) error {
+	// bind & validate params
+	var params request.SeriesSeasonEpisodeNumberPathParam
+	if httpError := s.bindPath(c, &params); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// restore episode
+	err := s.app.EpisodeRestore(
+		c.Request().Context(),
+		params.SeriesID,
+		params.SeasonNumber,
+		params.EpisodeNumber,
+		payload.UserID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleEpisodeRestore: episode not found",
+				zap.Int("series id", params.SeriesID),
+				zap.Int("season number", params.SeasonNumber),
+				zap.Int("episode number", params.EpisodeNumber),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrNotModerator {
+			s.logger.Info(
+				"server.HandleEpisodeRestore: not moderator",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleEpisodeRestore: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// DELETE /v1/authorized/series/:id/season/:season_number/episode/:episode_number/purge
+func (s *Server) HandleEpisodeDelete(c echo.Context) error {
+	// bind & validate params
+	var params request.SeriesSeasonEpisodeNumberPathParam
+	if httpError := s.bindPath(c, &params); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// delete episode
+	err := s.app.EpisodeDelete(
+		c.Request().Context(),
+		params.SeriesID,
+		params.SeasonNumber,
+		params.EpisodeNumber,
+		payload.UserID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleEpisodeDelete: episode not found",
+				zap.Int("series id", params.SeriesID),
+				zap.Int("season number", params.SeasonNumber),
+				zap.Int("episode number", params.EpisodeNumber),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		if err == app.ErrNotModerator {
+			s.logger.Info(
+				"server.HandleEpisodeDelete: not moderator",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		s.logger.Error(
+			"server.HandleEpisodeDelete: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
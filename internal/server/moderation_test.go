@@ -0,0 +1,239 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleMovieModeration(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultUser)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/movie/{id}"
+
+	movieID, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "title",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+	_, err = appInstance.WatchlistAdd(ctx, defaults.user.id, movieID)
+	require.NoError(err)
+
+	// unauthorized
+	e.DELETE(path).
+		WithPath("id", movieID).
+		Expect().
+		Status(http.StatusUnauthorized)
+
+	// not moderator
+	e.DELETE(path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusForbidden).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			http.StatusText(http.StatusForbidden),
+		))
+
+	err = appInstance.UserSetModerator(ctx, defaults.user.id, true)
+	require.NoError(err)
+
+	// movie not found
+	e.DELETE(path).
+		WithPath("id", movieID+1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound)
+
+	// hide
+	e.DELETE(path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK)
+
+	e.GET(path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound)
+
+	// the hidden movie is a tombstone in the watchlist
+	watchlist, _, err := appInstance.WatchlistGet(
+		ctx,
+		defaults.user.id,
+		query.WatchlistOptions{
+			Limit:     10,
+			SortOrder: "asc",
+		},
+		query.LocaleOptions{},
+	)
+	require.NoError(err)
+	require.Equal(1, len(watchlist))
+	require.True(watchlist[0].Tombstone)
+	require.Equal(movieID, watchlist[0].Film.ID)
+	require.Empty(watchlist[0].Film.Title)
+
+	// restore
+	e.POST(path+"/restore").
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK)
+
+	e.POST(path+"/restore").
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound)
+
+	e.GET(path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK)
+
+	// purge
+	e.DELETE(path+"/purge").
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK)
+
+	e.DELETE(path+"/purge").
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound)
+}
+
+func TestHandleSeriesModeration(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultSeries)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	seriesPath := "/v1/authorized/series/{id}"
+	episodePath := seriesPath +
+		"/season/{season_number}/episode/{episode_number}"
+
+	err := appInstance.EpisodePut(
+		ctx,
+		defaults.series.id, 1, 1,
+		defaults.user.id,
+		&dto.EpisodePutRequest{Title: "episode"},
+	)
+	require.NoError(err)
+
+	// not moderator
+	e.DELETE(episodePath).
+		WithPath("id", defaults.series.id).
+		WithPath("season_number", 1).
+		WithPath("episode_number", 1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusForbidden)
+
+	err = appInstance.UserSetModerator(ctx, defaults.user.id, true)
+	require.NoError(err)
+
+	// hide and restore the episode
+	e.DELETE(episodePath).
+		WithPath("id", defaults.series.id).
+		WithPath("season_number", 1).
+		WithPath("episode_number", 1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK)
+
+	_, err = appInstance.EpisodeGet(
+		ctx,
+		defaults.series.id, 1, 1,
+		query.LocaleOptions{},
+	)
+	require.Equal(app.ErrNotFound, err)
+
+	e.POST(episodePath+"/restore").
+		WithPath("id", defaults.series.id).
+		WithPath("season_number", 1).
+		WithPath("episode_number", 1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK)
+
+	// hide the series along with its episodes
+	e.DELETE(seriesPath).
+		WithPath("id", defaults.series.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK)
+
+	e.GET(seriesPath).
+		WithPath("id", defaults.series.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound)
+
+	_, err = appInstance.EpisodeGet(
+		ctx,
+		defaults.series.id, 1, 1,
+		query.LocaleOptions{},
+	)
+	require.Equal(app.ErrNotFound, err)
+
+	// restore the series along with its episodes
+	e.POST(seriesPath+"/restore").
+		WithPath("id", defaults.series.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK)
+
+	_, err = appInstance.EpisodeGet(
+		ctx,
+		defaults.series.id, 1, 1,
+		query.LocaleOptions{},
+	)
+	require.NoError(err)
+
+	// purge the episode and then the series
+	e.DELETE(episodePath+"/purge").
+		WithPath("id", defaults.series.id).
+		WithPath("season_number", 1).
+		WithPath("episode_number", 1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK)
+
+	e.DELETE(seriesPath+"/purge").
+		WithPath("id", defaults.series.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK)
+
+	e.DELETE(seriesPath+"/purge").
+		WithPath("id", defaults.series.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound)
+}
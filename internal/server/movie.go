@@ -7,6 +7,8 @@ import (
 	"github.com/aria3ppp/watchlist-server/internal/config"
 	"github.com/aria3ppp/watchlist-server/internal/dto"
 	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/modelsfield"
+	"github.com/aria3ppp/watchlist-server/internal/query"
 	"github.com/aria3ppp/watchlist-server/internal/server/request"
 	"github.com/aria3ppp/watchlist-server/internal/server/response"
 	"github.com/aria3ppp/watchlist-server/internal/storage"
@@ -22,8 +24,17 @@ func (s *Server) HandleMovieGet(c echo.Context) error {
 		return httpError
 	}
 
+	localeOptions, httpError := s.getLocaleOptions(c)
+	if httpError != nil {
+		return httpError
+	}
+
 	// fetch movie
-	movie, err := s.app.MovieGet(c.Request().Context(), param.ID)
+	movie, err := s.app.MovieGet(
+		c.Request().Context(),
+		param.ID,
+		localeOptions,
+	)
 	if err != nil {
 		if err == app.ErrNotFound {
 			s.logger.Info(
@@ -39,14 +50,14 @@ func (s *Server) HandleMovieGet(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError)
 	}
 
-	return c.JSON(http.StatusOK, movie)
+	return c.JSON(http.StatusOK, response.Movie(movie))
 }
 
-// GET /v1/authorized/movie/?page=1&page_size=60&sort_field=id&sort_order=asc
+// GET /v1/authorized/movie/?page=1&page_size=60&sort_field=id&sort_order=asc&region=DE&released=true&release_type=theatrical&max_age=12
 func (s *Server) HandleMoviesGetAll(c echo.Context) error {
 	// bind & validate query
 	var pagQuery request.PaginationSortingQuery
-	if httpError := s.bindQuery(c, pagQuery.SetValidationModel(models.TableNames.Films)); httpError != nil {
+	if httpError := s.bindQuery(c, pagQuery.SetValidationModel(modelsfield.MoviesWithScores)); httpError != nil {
 		return httpError
 	}
 
@@ -61,12 +72,28 @@ func (s *Server) HandleMoviesGetAll(c echo.Context) error {
 			Page:     config.Config.Validation.Pagination.Page.MinValue,
 			PageSize: config.Config.Validation.Pagination.PageSize.DefaultValue,
 		},
+		InvalidationQuery: request.InvalidationQuery{
+			Invalidation: query.InvalidationExclude,
+		},
 	}).ToQueryOptions()
 
+	// bind & validate release filters
+	var releaseQuery request.ReleaseQuery
+	if httpError := s.bindQuery(c, &releaseQuery); httpError != nil {
+		return httpError
+	}
+
+	localeOptions, httpError := s.getLocaleOptions(c)
+	if httpError != nil {
+		return httpError
+	}
+
 	// fetch movies
 	movies, total, err := s.app.MoviesGetAll(
 		c.Request().Context(),
 		queryOptions,
+		releaseQuery.ToReleaseOptions(),
+		localeOptions,
 	)
 	if err != nil {
 		s.logger.Error(
@@ -81,7 +108,7 @@ func (s *Server) HandleMoviesGetAll(c echo.Context) error {
 		response.Paginated(
 			pagQuery.Page,
 			pagQuery.PageSize,
-			movies,
+			response.Movies(movies),
 			total,
 		),
 	)
@@ -152,6 +179,14 @@ func (s *Server) HandleMovieUpdate(c echo.Context) error {
 			return echo.NewHTTPError(http.StatusNotFound)
 		}
 
+		if err == app.ErrLowReputation {
+			s.logger.Info(
+				"server.HandleMovieUpdate: low reputation",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
 		s.logger.Error(
 			"server.HandleMovieUpdate: internal server error",
 			zap.Error(err),
@@ -197,6 +232,14 @@ func (s *Server) HandleMovieInvalidate(c echo.Context) error {
 			return echo.NewHTTPError(http.StatusNotFound)
 		}
 
+		if err == app.ErrLowReputation {
+			s.logger.Info(
+				"server.HandleMovieInvalidate: low reputation",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
 		s.logger.Error(
 			"server.HandleMovieInvalidate: internal server error",
 			zap.Error(err),
@@ -273,9 +316,14 @@ func (s *Server) HandleMoviesSearch(c echo.Context) error {
 		return httpError
 	}
 
-	queryOptions := searchPagQuery.SetQueryIfNotSet(request.PaginationQuery{
-		Page:     config.Config.Validation.Pagination.Page.MinValue,
-		PageSize: config.Config.Validation.Pagination.PageSize.DefaultValue,
+	queryOptions := searchPagQuery.SetQueryIfNotSet(request.SearchPaginationQuery{
+		PaginationQuery: request.PaginationQuery{
+			Page:     config.Config.Validation.Pagination.Page.MinValue,
+			PageSize: config.Config.Validation.Pagination.PageSize.DefaultValue,
+		},
+		InvalidationQuery: request.InvalidationQuery{
+			Invalidation: query.InvalidationExclude,
+		},
 	}).ToQueryOptions()
 
 	// fetch movies
@@ -361,6 +409,14 @@ func (s *Server) HandleMoviePutPoster(c echo.Context) error {
 			return echo.NewHTTPError(http.StatusNotFound)
 		}
 
+		if err == app.ErrLowReputation {
+			s.logger.Info(
+				"server.HandleMoviePutPoster: low reputation",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
 		s.logger.Error(
 			"server.HandleMoviePutPoster: failed putting poster",
 			zap.String("bucket", bucket),
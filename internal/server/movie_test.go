@@ -14,6 +14,7 @@ import (
 	"github.com/aria3ppp/watchlist-server/internal/dto"
 	"github.com/aria3ppp/watchlist-server/internal/models"
 	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
 	"github.com/aria3ppp/watchlist-server/internal/search/searchtestutils"
 	"github.com/aria3ppp/watchlist-server/internal/server/request"
 	"github.com/aria3ppp/watchlist-server/internal/server/response"
@@ -79,7 +80,7 @@ func TestHandleMovieGet(t *testing.T) {
 	)
 	require.NoError(err)
 
-	gotMovie, err := appInstance.MovieGet(ctx, movieID)
+	gotMovie, err := appInstance.MovieGet(ctx, movieID, query.LocaleOptions{})
 	require.NoError(err)
 
 	require.GreaterOrEqual(gotMovie.ContributedAt, createTime)
@@ -92,7 +93,7 @@ func TestHandleMovieGet(t *testing.T) {
 		Status(http.StatusOK).
 		JSON().
 		Object().
-		Equal(&models.Film{
+		Equal(response.Movie(&models.Film{
 			ID:            movieID,
 			Title:         movieCreateReq.Title,
 			Descriptions:  movieCreateReq.Descriptions,
@@ -104,7 +105,7 @@ func TestHandleMovieGet(t *testing.T) {
 			Invalidation:  null.String{},
 			ContributedBy: defaults.user.id,
 			ContributedAt: gotMovie.ContributedAt,
-		})
+		}))
 }
 
 func TestHandleMoviesGetAll(t *testing.T) {
@@ -146,7 +147,7 @@ func TestHandleMoviesGetAll(t *testing.T) {
 		Equal(response.Paginated(
 			config.Config.Validation.Pagination.Page.MinValue,
 			config.Config.Validation.Pagination.PageSize.DefaultValue,
-			([]*models.Film)(nil),
+			([]response.MovieResponse)(nil),
 			0,
 		))
 
@@ -192,7 +193,7 @@ func TestHandleMoviesGetAll(t *testing.T) {
 		Limit:     config.Config.Validation.Pagination.PageSize.MaxValue,
 		SortField: models.FilmColumns.ID,
 		SortOrder: request.SortOrderAsc,
-	})
+	}, query.ReleaseOptions{}, query.LocaleOptions{})
 	require.NoError(err)
 
 	items := make([]*models.Film, len(gotMovies))
@@ -225,9 +226,62 @@ func TestHandleMoviesGetAll(t *testing.T) {
 		Equal(response.Paginated(
 			config.Config.Validation.Pagination.Page.MinValue,
 			config.Config.Validation.Pagination.PageSize.DefaultValue,
-			items,
+			response.Movies(items),
 			total,
 		))
+
+	// invalidated movies are excluded by default
+	err = appInstance.MovieInvalidate(
+		ctx,
+		movieIDs[0],
+		defaults.user.id,
+		&dto.InvalidationRequest{Invalidation: "invalidation"},
+	)
+	require.NoError(err)
+
+	e.Request(method, path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("total_items", total-1)
+
+	e.Request(method, path).
+		WithQuery("invalidation", query.InvalidationOnly).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ContainsMap(map[string]any{
+			"total_items": 1,
+			"items": []map[string]any{
+				{"id": movieIDs[0], "invalidation": "invalidation"},
+			},
+		})
+
+	e.Request(method, path).
+		WithQuery("invalidation", query.InvalidationInclude).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("total_items", total)
+
+	e.Request(method, path).
+		WithQuery("invalidation", "invalid").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			validation.Errors{
+				"invalidation": validation.ErrInInvalid,
+			}.Error(),
+		))
 }
 
 func TestHandleMovieCreate(t *testing.T) {
@@ -277,7 +331,7 @@ func TestHandleMovieCreate(t *testing.T) {
 
 	// check movie created
 
-	gotMovie, err := appInstance.MovieGet(ctx, movieID)
+	gotMovie, err := appInstance.MovieGet(ctx, movieID, query.LocaleOptions{})
 	require.NoError(err)
 
 	testutils.SetTimeLocation(
@@ -300,6 +354,7 @@ func TestHandleMovieCreate(t *testing.T) {
 			Invalidation:  null.String{},
 			ContributedBy: defaults.user.id,
 			ContributedAt: gotMovie.ContributedAt,
+			R:             gotMovie.R,
 		},
 		gotMovie,
 	)
@@ -425,7 +480,11 @@ func TestHandleMovieUpdate(t *testing.T) {
 			)
 			require.NoError(err)
 
-			gotMovieBeforeUpdate, err := appInstance.MovieGet(ctx, movieID)
+			gotMovieBeforeUpdate, err := appInstance.MovieGet(
+				ctx,
+				movieID,
+				query.LocaleOptions{},
+			)
 			require.NoError(err)
 
 			updateTime := time.Now()
@@ -440,7 +499,11 @@ func TestHandleMovieUpdate(t *testing.T) {
 				NoContent()
 
 			// check updated fields
-			gotMovieAfterUpdate, err := appInstance.MovieGet(ctx, movieID)
+			gotMovieAfterUpdate, err := appInstance.MovieGet(
+				ctx,
+				movieID,
+				query.LocaleOptions{},
+			)
 			require.NoError(err)
 
 			require.GreaterOrEqual(
@@ -473,6 +536,7 @@ func TestHandleMovieUpdate(t *testing.T) {
 			updatedMovie.Invalidation = null.String{}
 			updatedMovie.ContributedBy = defaults.user.id
 			updatedMovie.ContributedAt = gotMovieAfterUpdate.ContributedAt
+			updatedMovie.R = gotMovieAfterUpdate.R
 
 			testutils.SetTimeLocation(
 				&updatedMovie.DateReleased,
@@ -565,7 +629,11 @@ func TestHandleMovieInvalidate(t *testing.T) {
 		NoContent()
 
 	// check movie invalidated
-	gotInvalidatedMovie, err := appInstance.MovieGet(ctx, movieID)
+	gotInvalidatedMovie, err := appInstance.MovieGet(
+		ctx,
+		movieID,
+		query.LocaleOptions{},
+	)
 	require.NoError(err)
 
 	testutils.SetTimeLocation(
@@ -586,6 +654,7 @@ func TestHandleMovieInvalidate(t *testing.T) {
 			Invalidation:  null.StringFrom(invalidationRequest.Invalidation),
 			ContributedBy: defaults.user.id,
 			ContributedAt: gotInvalidatedMovie.ContributedAt,
+			R:             gotInvalidatedMovie.R,
 		},
 		gotInvalidatedMovie,
 	)
@@ -663,7 +732,7 @@ func TestHandleMovieAuditsGetAll(t *testing.T) {
 	)
 	require.NoError(err)
 
-	gotMovie, err := appInstance.MovieGet(ctx, movieID)
+	gotMovie, err := appInstance.MovieGet(ctx, movieID, query.LocaleOptions{})
 	require.NoError(err)
 
 	require.GreaterOrEqual(gotMovie.ContributedAt, createTime)
@@ -680,6 +749,8 @@ func TestHandleMovieAuditsGetAll(t *testing.T) {
 		Invalidation:  null.String{},
 		ContributedBy: defaults.user.id,
 		ContributedAt: gotMovie.ContributedAt,
+		AuditAction:   repo.AuditActionUpdate,
+		AuditActor:    null.IntFrom(defaults.user.id),
 	}
 
 	// no audits
@@ -716,7 +787,7 @@ func TestHandleMovieAuditsGetAll(t *testing.T) {
 	)
 	require.NoError(err)
 
-	gotMovie, err = appInstance.MovieGet(ctx, movieID)
+	gotMovie, err = appInstance.MovieGet(ctx, movieID, query.LocaleOptions{})
 	require.NoError(err)
 
 	require.GreaterOrEqual(gotMovie.ContributedAt, updateTime)
@@ -733,6 +804,8 @@ func TestHandleMovieAuditsGetAll(t *testing.T) {
 		Invalidation:  null.String{},
 		ContributedBy: defaults.user.id,
 		ContributedAt: gotMovie.ContributedAt,
+		AuditAction:   repo.AuditActionUpdate,
+		AuditActor:    null.IntFrom(defaults.user.id),
 	}
 
 	// get update audits
@@ -994,7 +1067,7 @@ func TestHandleMoviesSearch(t *testing.T) {
 		Limit:     config.Config.Validation.Pagination.PageSize.MaxValue,
 		SortField: models.FilmColumns.ID,
 		SortOrder: request.SortOrderAsc,
-	})
+	}, query.ReleaseOptions{}, query.LocaleOptions{})
 	require.NoError(err)
 
 	items := make([]*models.Film, len(queryMovies))
@@ -0,0 +1,145 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/server/request"
+	"github.com/aria3ppp/watchlist-server/internal/server/response"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// GET /v1/authorized/playback?page=1&page_size=10
+func (s *Server) HandleContinueWatchingGet(c echo.Context) error {
+	// bind & validate query
+	var pagQuery request.PaginationQuery
+	if httpError := s.bindQuery(c, &pagQuery); httpError != nil {
+		return httpError
+	}
+
+	if pagQuery.Page == 0 {
+		pagQuery.Page = config.Config.Validation.Pagination.Page.MinValue
+	}
+	if pagQuery.PageSize == 0 {
+		pagQuery.PageSize = config.Config.Validation.Pagination.PageSize.DefaultValue
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	localeOptions, httpError := s.getLocaleOptions(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// fetch continue watching
+	progress, total, err := s.app.ContinueWatchingGet(
+		c.Request().Context(),
+		payload.UserID,
+		pagQuery.Offset(),
+		pagQuery.Limit(),
+		localeOptions,
+	)
+	if err != nil {
+		s.logger.Error(
+			"server.HandleContinueWatchingGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(pagQuery.Page, pagQuery.PageSize, progress, total),
+	)
+}
+
+// GET /v1/authorized/playback/:id
+func (s *Server) HandlePlaybackProgressGet(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// fetch playback progress
+	progress, err := s.app.PlaybackProgressGet(
+		c.Request().Context(),
+		payload.UserID,
+		param.ID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandlePlaybackProgressGet: playback progress not found",
+				zap.Int("user id", payload.UserID),
+				zap.Int("film id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandlePlaybackProgressGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, progress)
+}
+
+// PUT /v1/authorized/playback/:id
+func (s *Server) HandlePlaybackProgressPut(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate request
+	var req dto.PlaybackProgressPutRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// put playback progress
+	progress, watched, err := s.app.PlaybackProgressPut(
+		c.Request().Context(),
+		payload.UserID,
+		param.ID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandlePlaybackProgressPut: film not found",
+				zap.Int("user id", payload.UserID),
+				zap.Int("film id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandlePlaybackProgressPut: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, response.PlaybackProgress(progress, watched))
+}
@@ -0,0 +1,140 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestHandlePlaybackProgress(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultUser)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/playback/{id}"
+
+	filmID, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "film",
+			DateReleased: testutils.Date(2000, 1, 2),
+			Duration:     null.IntFrom(58 * 60),
+		},
+	)
+	require.NoError(err)
+	watchID, err := appInstance.WatchlistAdd(ctx, defaults.user.id, filmID)
+	require.NoError(err)
+
+	// invalid request
+	e.PUT(path).
+		WithPath("id", filmID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.PlaybackProgressPutRequest{Position: -1}).
+		Expect().
+		Status(http.StatusBadRequest)
+
+	// not found
+	e.PUT(path).
+		WithPath("id", filmID+1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.PlaybackProgressPutRequest{Position: 60}).
+		Expect().
+		Status(http.StatusNotFound)
+	e.GET(path).
+		WithPath("id", filmID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound)
+
+	// watched 42 of 58 minutes on the tv
+	t2 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	put := e.PUT(path).
+		WithPath("id", filmID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.PlaybackProgressPutRequest{
+			Position:  42 * 60,
+			Device:    null.StringFrom("tv"),
+			UpdatedAt: null.TimeFrom(t2),
+		}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	put.ValueEqual("position", 42*60)
+	put.ValueEqual("watched", false)
+
+	// an earlier update from the phone loses
+	e.PUT(path).
+		WithPath("id", filmID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.PlaybackProgressPutRequest{
+			Position:  5 * 60,
+			Device:    null.StringFrom("phone"),
+			UpdatedAt: null.TimeFrom(t2.Add(-time.Hour)),
+		}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("device", "tv")
+
+	// continue watching
+	continued := e.GET("/v1/authorized/playback").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	continued.ValueEqual("total_items", 1)
+	continued.Value("items").Array().Element(0).Object().
+		Value("film").Object().ValueEqual("id", filmID)
+
+	// crossing the threshold marks the film watched
+	e.PUT(path).
+		WithPath("id", filmID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.PlaybackProgressPutRequest{Position: 56 * 60}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("watched", true)
+
+	e.GET("/v1/authorized/watchlist/{id}/history").
+		WithPath("id", watchID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("total_items", 1)
+
+	e.GET("/v1/authorized/playback").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("total_items", 0)
+
+	e.GET(path).
+		WithPath("id", filmID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("position", 56*60)
+}
@@ -0,0 +1,225 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/gavv/httpexpect/v2"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleMovieReleasePut(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultUser)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/movie/{id}/releases"
+	method := http.MethodPut
+
+	// invalid request
+	e.Request(method, path).
+		WithPath("id", 1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(dto.ReleasePutRequest{
+			Region:       "de",
+			ReleaseType:  dto.ReleaseTypeTheatrical,
+			DateReleased: testutils.Date(2000, 1, 1),
+		}).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			validation.Errors{
+				"region": validation.ErrMatchInvalid,
+			}.Error(),
+		))
+
+	req := dto.ReleasePutRequest{
+		Region:       "DE",
+		ReleaseType:  dto.ReleaseTypeTheatrical,
+		DateReleased: testutils.Date(2000, 1, 1),
+	}
+
+	// movie not found
+	e.Request(method, path).
+		WithPath("id", 999).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(req).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			http.StatusText(http.StatusNotFound),
+		))
+
+	// add a movie
+	movieID, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+
+	// put release
+	e.Request(method, path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(req).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+
+	releases, err := appInstance.MovieReleasesGet(ctx, movieID)
+	require.NoError(err)
+	require.Equal(1, len(releases))
+	require.Equal(req.Region, releases[0].Region)
+	require.Equal(req.ReleaseType, releases[0].ReleaseType)
+	require.Equal(defaults.user.id, releases[0].ContributedBy)
+
+	e.Request(http.MethodGet, path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Array().
+		Equal(releases)
+
+	// released movies of the region
+	e.Request(http.MethodGet, "/v1/authorized/movie").
+		WithQuery("region", "DE").
+		WithQuery("released", true).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("total_items").
+		Equal(1)
+
+	e.Request(http.MethodGet, "/v1/authorized/movie").
+		WithQuery("region", "US").
+		WithQuery("released", true).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("total_items").
+		Equal(0)
+
+	// region is required to filter releases
+	e.Request(http.MethodGet, "/v1/authorized/movie").
+		WithQuery("released", true).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusBadRequest)
+}
+
+func TestHandleMovieContentRatingPut(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultUser)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/movie/{id}/content_ratings"
+	method := http.MethodPut
+
+	// unknown rating of the region's system
+	e.Request(method, path).
+		WithPath("id", 1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(dto.ContentRatingPutRequest{Region: "US", Rating: "16"}).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			validation.Errors{
+				"rating": validation.ErrInInvalid,
+			}.Error(),
+		))
+
+	// add a movie and add it to the watchlist
+	movieID, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+	_, err = appInstance.WatchlistAdd(ctx, defaults.user.id, movieID)
+	require.NoError(err)
+
+	// put content rating
+	e.Request(method, path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(dto.ContentRatingPutRequest{Region: "DE", Rating: "16"}).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+
+	contentRatings, err := appInstance.MovieContentRatingsGet(ctx, movieID)
+	require.NoError(err)
+	require.Equal(1, len(contentRatings))
+	require.Equal("FSK", contentRatings[0].RatingSystem)
+	require.Equal(16, contentRatings[0].MinAge)
+
+	e.Request(http.MethodGet, path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Array().
+		Equal(contentRatings)
+
+	// family viewing filters
+	for _, tc := range []struct {
+		maxAge   int
+		expTotal int
+	}{
+		{maxAge: 12, expTotal: 0},
+		{maxAge: 16, expTotal: 1},
+	} {
+		e.Request(http.MethodGet, "/v1/authorized/movie").
+			WithQuery("region", "DE").
+			WithQuery("max_age", tc.maxAge).
+			WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			Value("total_items").
+			Equal(tc.expTotal)
+
+		e.Request(http.MethodGet, "/v1/authorized/watchlist").
+			WithQuery("region", "DE").
+			WithQuery("max_age", tc.maxAge).
+			WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			Value("total_items").
+			Equal(tc.expTotal)
+	}
+}
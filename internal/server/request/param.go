@@ -1,7 +1,11 @@
 package request
 
 import (
+	"regexp"
+	"time"
+
 	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
@@ -22,6 +26,75 @@ func (p IDPathParam) Validate() error {
 	)
 }
 
+type MediaIDPathParam struct {
+	ID      int `param:"id"       json:"id"`
+	MediaID int `param:"media_id" json:"media_id"`
+}
+
+var _ validation.Validatable = MediaIDPathParam{}
+
+func (p MediaIDPathParam) Validate() error {
+	return validation.ValidateStruct(
+		&p,
+		validation.Field(
+			&p.ID,
+			validation.Required,
+			validation.Min(1),
+		),
+		validation.Field(
+			&p.MediaID,
+			validation.Required,
+			validation.Min(1),
+		),
+	)
+}
+
+type ListItemIDPathParam struct {
+	ID     int `param:"id"      json:"id"`
+	ItemID int `param:"item_id" json:"item_id"`
+}
+
+var _ validation.Validatable = ListItemIDPathParam{}
+
+func (p ListItemIDPathParam) Validate() error {
+	return validation.ValidateStruct(
+		&p,
+		validation.Field(
+			&p.ID,
+			validation.Required,
+			validation.Min(1),
+		),
+		validation.Field(
+			&p.ItemID,
+			validation.Required,
+			validation.Min(1),
+		),
+	)
+}
+
+type ListMemberIDPathParam struct {
+	ID     int `param:"id"      json:"id"`
+	UserID int `param:"user_id" json:"user_id"`
+}
+
+var _ validation.Validatable = ListMemberIDPathParam{}
+
+func (p ListMemberIDPathParam) Validate() error {
+	return validation.ValidateStruct(
+		&p,
+		validation.Field(
+			&p.ID,
+			validation.Required,
+			validation.Min(1),
+		),
+		validation.Field(
+			&p.UserID,
+			validation.Required,
+			validation.Min(1),
+		),
+	)
+}
+
 type SeriesSeasonNumberPathParam struct {
 	SeriesID     int `param:"id"            json:"id"`
 	SeasonNumber int `param:"season_number" json:"season_number"`
@@ -39,8 +112,7 @@ func (p SeriesSeasonNumberPathParam) Validate() error {
 		),
 		validation.Field(
 			&p.SeasonNumber,
-			validation.Required,
-			validation.Min(1),
+			validation.Min(0),
 			validation.Max(config.Config.Validation.Film.SeasonNumber.MaxValue),
 		),
 	)
@@ -64,8 +136,7 @@ func (p SeriesSeasonEpisodeNumberPathParam) Validate() error {
 		),
 		validation.Field(
 			&p.SeasonNumber,
-			validation.Required,
-			validation.Min(1),
+			validation.Min(0),
 			validation.Max(config.Config.Validation.Film.SeasonNumber.MaxValue),
 		),
 		validation.Field(
@@ -78,3 +149,56 @@ func (p SeriesSeasonEpisodeNumberPathParam) Validate() error {
 		),
 	)
 }
+
+type ExternalIDPathParam struct {
+	Provider   string `param:"provider"    json:"provider"`
+	ExternalID string `param:"external_id" json:"external_id"`
+}
+
+var _ validation.Validatable = ExternalIDPathParam{}
+
+// validate against the same provider formats as dto.ExternalIDPutRequest
+func (p ExternalIDPathParam) Validate() error {
+	return dto.ExternalIDPutRequest(p).Validate()
+}
+
+type CatalogExportFilePathParam struct {
+	Filename string `param:"filename" json:"filename"`
+}
+
+var _ validation.Validatable = CatalogExportFilePathParam{}
+
+// catalog export dumps are named table.format.gz
+var catalogExportFilenameRegexp = regexp.MustCompile(`^[a-z_]+\.(ndjson|csv)\.gz$`)
+
+func (p CatalogExportFilePathParam) Validate() error {
+	return validation.ValidateStruct(
+		&p,
+		validation.Field(
+			&p.Filename,
+			validation.Required,
+			validation.Match(catalogExportFilenameRegexp),
+		),
+	)
+}
+
+type YearPathParam struct {
+	Year int `param:"year" json:"year"`
+}
+
+var _ validation.Validatable = YearPathParam{}
+
+func (p YearPathParam) Validate() error {
+	return validation.ValidateStruct(
+		&p,
+		validation.Field(
+			&p.Year,
+			validation.Required,
+			validation.Min(
+				config.Config.Validation.User.Birthdate.MinValue.Year,
+			),
+			// a year ahead for the time zones already in the next year
+			validation.Max(time.Now().Year()+1),
+		),
+	)
+}
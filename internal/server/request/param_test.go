@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
 	"github.com/aria3ppp/watchlist-server/internal/server/request"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/stretchr/testify/require"
@@ -20,7 +21,6 @@ func TestSeriesSeasonEpisodeNumberPathParam_Validate(t *testing.T) {
 			params: request.SeriesSeasonEpisodeNumberPathParam{},
 			expError: validation.Errors{
 				"id":             validation.ErrRequired,
-				"season_number":  validation.ErrRequired,
 				"episode_number": validation.ErrRequired,
 			},
 		},
@@ -36,7 +36,7 @@ func TestSeriesSeasonEpisodeNumberPathParam_Validate(t *testing.T) {
 					map[string]any{"threshold": 1},
 				),
 				"season_number": validation.ErrMinGreaterEqualThanRequired.SetParams(
-					map[string]any{"threshold": 1},
+					map[string]any{"threshold": 0},
 				),
 				"episode_number": validation.ErrMinGreaterEqualThanRequired.SetParams(
 					map[string]any{"threshold": 1},
@@ -72,6 +72,15 @@ func TestSeriesSeasonEpisodeNumberPathParam_Validate(t *testing.T) {
 			},
 			expError: nil,
 		},
+		{
+			name: "special",
+			params: request.SeriesSeasonEpisodeNumberPathParam{
+				SeriesID:      1,
+				SeasonNumber:  0,
+				EpisodeNumber: 1,
+			},
+			expError: nil,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -92,8 +101,7 @@ func TestSeriesSeasonNumberPathParam_Validate(t *testing.T) {
 			name:   "tc1",
 			params: request.SeriesSeasonNumberPathParam{},
 			expError: validation.Errors{
-				"id":            validation.ErrRequired,
-				"season_number": validation.ErrRequired,
+				"id": validation.ErrRequired,
 			},
 		},
 		{
@@ -107,7 +115,7 @@ func TestSeriesSeasonNumberPathParam_Validate(t *testing.T) {
 					map[string]any{"threshold": 1},
 				),
 				"season_number": validation.ErrMinGreaterEqualThanRequired.SetParams(
-					map[string]any{"threshold": 1},
+					map[string]any{"threshold": 0},
 				),
 			},
 		},
@@ -133,6 +141,14 @@ func TestSeriesSeasonNumberPathParam_Validate(t *testing.T) {
 			},
 			expError: nil,
 		},
+		{
+			name: "specials",
+			params: request.SeriesSeasonNumberPathParam{
+				SeriesID:     1,
+				SeasonNumber: 0,
+			},
+			expError: nil,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -183,3 +199,103 @@ func TestIDPathParam_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestExternalIDPathParam_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		params   request.ExternalIDPathParam
+		expError error
+	}{
+		{
+			name:   "tc1",
+			params: request.ExternalIDPathParam{},
+			expError: validation.Errors{
+				"provider":    validation.ErrRequired,
+				"external_id": validation.ErrRequired,
+			},
+		},
+		{
+			name: "tc2",
+			params: request.ExternalIDPathParam{
+				Provider:   "unknown",
+				ExternalID: "tt0111161",
+			},
+			expError: validation.Errors{
+				"provider": validation.ErrInInvalid,
+			},
+		},
+		{
+			name: "tc3",
+			params: request.ExternalIDPathParam{
+				Provider:   dto.ExternalIDProviderIMDb,
+				ExternalID: "0111161",
+			},
+			expError: validation.Errors{
+				"external_id": validation.ErrMatchInvalid,
+			},
+		},
+		{
+			name: "tc4",
+			params: request.ExternalIDPathParam{
+				Provider:   dto.ExternalIDProviderIMDb,
+				ExternalID: "tt0111161",
+			},
+			expError: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.params.Validate())
+		})
+	}
+}
+
+func TestCatalogExportFilePathParam_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		params   request.CatalogExportFilePathParam
+		expError error
+	}{
+		{
+			name:   "tc1",
+			params: request.CatalogExportFilePathParam{},
+			expError: validation.Errors{
+				"filename": validation.ErrRequired,
+			},
+		},
+		{
+			name: "tc2",
+			params: request.CatalogExportFilePathParam{
+				Filename: "../img/films.csv.gz",
+			},
+			expError: validation.Errors{
+				"filename": validation.ErrMatchInvalid,
+			},
+		},
+		{
+			name: "tc3",
+			params: request.CatalogExportFilePathParam{
+				Filename: "films.xml.gz",
+			},
+			expError: validation.Errors{
+				"filename": validation.ErrMatchInvalid,
+			},
+		},
+		{
+			name: "tc4",
+			params: request.CatalogExportFilePathParam{
+				Filename: "films_audit.ndjson.gz",
+			},
+			expError: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.params.Validate())
+		})
+	}
+}
@@ -1,16 +1,20 @@
 package request
 
 import (
+	"github.com/aria3ppp/watchlist-server/internal/catalog"
 	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
 	"github.com/aria3ppp/watchlist-server/internal/query"
 	"github.com/aria3ppp/watchlist-server/internal/repo"
 	"github.com/aria3ppp/watchlist-server/internal/validator"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/volatiletech/null/v8"
 )
 
 type PaginationSortingQuery struct {
 	PaginationQuery
 	SortingQuery
+	InvalidationQuery
 }
 
 func (q *PaginationSortingQuery) SetValidationModel(
@@ -29,6 +33,7 @@ func (r paginationSortingQueryValidate) Validate() error {
 		&r,
 		validation.Field(&r.PaginationQuery),
 		validation.Field(&r.SortingQuery),
+		validation.Field(&r.InvalidationQuery),
 	)
 }
 
@@ -47,6 +52,9 @@ func (q *PaginationSortingQuery) SetQueryIfNotSet(
 	if q.SortOrder == "" {
 		q.SortOrder = alt.SortOrder
 	}
+	if q.Invalidation == "" {
+		q.Invalidation = alt.Invalidation
+	}
 
 	return paginationSortingQueryToQueryOptions(*q)
 }
@@ -55,10 +63,11 @@ type paginationSortingQueryToQueryOptions PaginationSortingQuery
 
 func (q paginationSortingQueryToQueryOptions) ToQueryOptions() query.Options {
 	return query.Options{
-		Offset:    q.PaginationQuery.Offset(),
-		Limit:     q.PaginationQuery.Limit(),
-		SortField: q.SortField,
-		SortOrder: q.SortOrder,
+		Offset:       q.PaginationQuery.Offset(),
+		Limit:        q.PaginationQuery.Limit(),
+		SortField:    q.SortField,
+		SortOrder:    q.SortOrder,
+		Invalidation: q.Invalidation,
 	}
 }
 
@@ -110,6 +119,7 @@ func (q paginationSortOrderQueryToQueryOptions) ToQueryOptions() query.SortOrder
 type SearchPaginationQuery struct {
 	Query string `query:"query" url:"query" json:"query"`
 	PaginationQuery
+	InvalidationQuery
 }
 
 var _ validation.Validatable = SearchPaginationQuery{}
@@ -126,11 +136,12 @@ func (r SearchPaginationQuery) Validate() error {
 			),
 		),
 		validation.Field(&r.PaginationQuery),
+		validation.Field(&r.InvalidationQuery),
 	)
 }
 
 func (q *SearchPaginationQuery) SetQueryIfNotSet(
-	alt PaginationQuery,
+	alt SearchPaginationQuery,
 ) searchPaginationQueryToQueryOptions {
 	if q.Page == 0 {
 		q.Page = alt.Page
@@ -138,6 +149,9 @@ func (q *SearchPaginationQuery) SetQueryIfNotSet(
 	if q.PageSize == 0 {
 		q.PageSize = alt.PageSize
 	}
+	if q.Invalidation == "" {
+		q.Invalidation = alt.Invalidation
+	}
 	return searchPaginationQueryToQueryOptions(*q)
 }
 
@@ -145,9 +159,10 @@ type searchPaginationQueryToQueryOptions SearchPaginationQuery
 
 func (q searchPaginationQueryToQueryOptions) ToQueryOptions() query.SearchOptions {
 	return query.SearchOptions{
-		Query: q.Query,
-		From:  q.PaginationQuery.Offset(),
-		Size:  q.PaginationQuery.Limit(),
+		Query:        q.Query,
+		From:         q.PaginationQuery.Offset(),
+		Size:         q.PaginationQuery.Limit(),
+		Invalidation: q.Invalidation,
 	}
 }
 
@@ -232,6 +247,28 @@ func (r SortOrderQuery) Validate() error {
 
 ////////////////////////////////////////////////////////////////////////////////
 
+type InvalidationQuery struct {
+	Invalidation string `query:"invalidation" url:"invalidation" json:"invalidation"`
+}
+
+var _ validation.Validatable = InvalidationQuery{}
+
+func (r InvalidationQuery) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Invalidation,
+			validation.In(
+				query.InvalidationExclude,
+				query.InvalidationInclude,
+				query.InvalidationOnly,
+			),
+		),
+	)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
 const (
 	WatchlistFilterWatched    = "watched"
 	WatchlistFilterNotWatched = "not-watched"
@@ -241,7 +278,8 @@ const (
 type WatchlistGetQuery struct {
 	PaginationQuery
 	SortOrderQuery
-	Filter string `query:"filter" url:"filter" json:"filter"`
+	Filter    string `query:"filter"     url:"filter"     json:"filter"`
+	SortField string `query:"sort_field" url:"sort_field" json:"sort_field"`
 }
 
 var _ validation.Validatable = WatchlistGetQuery{}
@@ -259,6 +297,20 @@ func (r WatchlistGetQuery) Validate() error {
 				WatchlistFilterAll,
 			),
 		),
+		validation.Field(
+			&r.SortField,
+			validation.In(
+				query.WatchlistSortTimeAdded,
+				query.WatchlistSortTimeWatched,
+				query.WatchlistSortScoreMean,
+				query.WatchlistSortScoresCount,
+				query.WatchlistSortPosition,
+				query.WatchlistSortPriority,
+				query.WatchlistSortDateReleased,
+				query.WatchlistSortTitle,
+				query.WatchlistSortDuration,
+			),
+		),
 	)
 }
 
@@ -277,6 +329,9 @@ func (q *WatchlistGetQuery) SetQueryIfNotSet(
 	if q.Filter == "" {
 		q.Filter = alt.Filter
 	}
+	if q.SortField == "" {
+		q.SortField = alt.SortField
+	}
 
 	return watchlistGetQueryToQueryOptions(*q)
 }
@@ -295,6 +350,7 @@ func (q watchlistGetQueryToQueryOptions) ToQueryOptions() query.WatchlistOptions
 	return query.WatchlistOptions{
 		Offset:           q.PaginationQuery.Offset(),
 		Limit:            q.PaginationQuery.Limit(),
+		SortField:        q.SortField,
 		SortOrder:        q.SortOrder,
 		WhereTimeWatched: whereTimeWatched,
 	}
@@ -302,6 +358,234 @@ func (q watchlistGetQueryToQueryOptions) ToQueryOptions() query.WatchlistOptions
 
 ////////////////////////////////////////////////////////////////////////////////
 
+type ReviewsGetQuery struct {
+	PaginationSortOrderQuery
+	SortField string `query:"sort_field" url:"sort_field" json:"sort_field"`
+}
+
+var _ validation.Validatable = ReviewsGetQuery{}
+
+func (r ReviewsGetQuery) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(&r.PaginationSortOrderQuery),
+		validation.Field(
+			&r.SortField,
+			validation.In(query.ReviewSortHelpful, query.ReviewSortRecent),
+		),
+	)
+}
+
+func (q *ReviewsGetQuery) SetQueryIfNotSet(
+	alt ReviewsGetQuery,
+) reviewsGetQueryToQueryOptions {
+	q.PaginationSortOrderQuery.SetQueryIfNotSet(alt.PaginationSortOrderQuery)
+	if q.SortField == "" {
+		q.SortField = alt.SortField
+	}
+
+	return reviewsGetQueryToQueryOptions(*q)
+}
+
+type reviewsGetQueryToQueryOptions ReviewsGetQuery
+
+func (q reviewsGetQueryToQueryOptions) ToQueryOptions() query.ReviewOptions {
+	return query.ReviewOptions{
+		Offset:    q.PaginationQuery.Offset(),
+		Limit:     q.PaginationQuery.Limit(),
+		SortField: q.SortField,
+		SortOrder: q.SortOrder,
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// ReleaseQuery filters films by their releases and content ratings in region
+type ReleaseQuery struct {
+	Region      string   `query:"region"       url:"region"       json:"region"`
+	Released    bool     `query:"released"     url:"released"     json:"released"`
+	ReleaseType string   `query:"release_type" url:"release_type" json:"release_type"`
+	MaxAge      null.Int `query:"max_age"      url:"max_age"      json:"max_age"`
+}
+
+var _ validation.Validatable = ReleaseQuery{}
+
+func (r ReleaseQuery) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Region,
+			validation.When(
+				r.Released || r.ReleaseType != "" || r.MaxAge.Valid,
+				validation.Required,
+			),
+			validation.Match(dto.RegionPattern),
+		),
+		validation.Field(
+			&r.ReleaseType,
+			validation.In(
+				dto.ReleaseTypeTheatrical,
+				dto.ReleaseTypeStreaming,
+				dto.ReleaseTypePhysical,
+			),
+		),
+		validation.Field(
+			&r.MaxAge,
+			validation.When(
+				r.MaxAge.Valid,
+				validation.Min(0),
+				validation.Max(config.Config.Validation.Request.MaxAge.MaxValue),
+			),
+		),
+	)
+}
+
+func (q ReleaseQuery) ToReleaseOptions() query.ReleaseOptions {
+	return query.ReleaseOptions{
+		Region:      q.Region,
+		Released:    q.Released,
+		ReleaseType: q.ReleaseType,
+		MaxAge:      q.MaxAge,
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// WatchedQuery filters the watchlist items by their watch events: times
+// watched and the inclusive bounds of the watch time
+type WatchedQuery struct {
+	WatchedTimes null.Int  `query:"watched_times" url:"watched_times" json:"watched_times"`
+	WatchedFrom  null.Time `query:"watched_from"  url:"watched_from"  json:"watched_from"`
+	WatchedTo    null.Time `query:"watched_to"    url:"watched_to"    json:"watched_to"`
+}
+
+var _ validation.Validatable = WatchedQuery{}
+
+func (r WatchedQuery) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.WatchedTimes,
+			validation.When(r.WatchedTimes.Valid, validation.Min(0)),
+		),
+		validation.Field(
+			&r.WatchedTo,
+			validation.When(
+				r.WatchedFrom.Valid && r.WatchedTo.Valid,
+				validation.Min(r.WatchedFrom.Time),
+			),
+		),
+	)
+}
+
+func (q WatchedQuery) ToWatchedOptions() query.WatchedOptions {
+	return query.WatchedOptions{
+		Times: q.WatchedTimes,
+		From:  q.WatchedFrom,
+		To:    q.WatchedTo,
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// TimezoneQuery is the IANA time zone of the user: UTC if not set
+type TimezoneQuery struct {
+	Timezone string `query:"timezone" url:"timezone" json:"timezone"`
+}
+
+var _ validation.Validatable = TimezoneQuery{}
+
+func (r TimezoneQuery) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Timezone,
+			validation.Length(1, 64),
+			validator.IsTimezone(),
+		),
+	)
+}
+
+func (q TimezoneQuery) GetTimezone() string {
+	if q.Timezone == "" {
+		return "UTC"
+	}
+	return q.Timezone
+}
+
+// StatsQuery is the inclusive bounds of the watch time of the statistics
+type StatsQuery struct {
+	TimezoneQuery
+	From null.Time `query:"from" url:"from" json:"from"`
+	To   null.Time `query:"to"   url:"to"   json:"to"`
+}
+
+var _ validation.Validatable = StatsQuery{}
+
+func (r StatsQuery) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(&r.TimezoneQuery),
+		validation.Field(
+			&r.To,
+			validation.When(
+				r.From.Valid && r.To.Valid,
+				validation.Min(r.From.Time),
+			),
+		),
+	)
+}
+
+func (q StatsQuery) ToStatsOptions() query.StatsOptions {
+	return query.StatsOptions{
+		From:     q.From,
+		To:       q.To,
+		Timezone: q.GetTimezone(),
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// WatchlistItemQuery filters the watchlist items by their tag and priority
+type WatchlistItemQuery struct {
+	Tag      string   `query:"tag"      url:"tag"      json:"tag"`
+	Priority null.Int `query:"priority" url:"priority" json:"priority"`
+}
+
+var _ validation.Validatable = WatchlistItemQuery{}
+
+func (r WatchlistItemQuery) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Tag,
+			validation.Length(
+				1,
+				config.Config.Validation.WatchlistItem.Tag.MaxLength,
+			),
+		),
+		validation.Field(
+			&r.Priority,
+			validation.When(
+				r.Priority.Valid,
+				validation.Min(0),
+				validation.Max(
+					config.Config.Validation.WatchlistItem.Priority.MaxValue,
+				),
+			),
+		),
+	)
+}
+
+func (q WatchlistItemQuery) ToWatchlistItemOptions() query.WatchlistItemOptions {
+	return query.WatchlistItemOptions{
+		Tag:      q.Tag,
+		Priority: q.Priority,
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
 type WatchlistAddQuery struct {
 	FilmID int `query:"film_id" url:"film_id" json:"film_id"`
 }
@@ -318,3 +602,145 @@ func (r WatchlistAddQuery) Validate() error {
 		),
 	)
 }
+
+////////////////////////////////////////////////////////////////////////////////
+
+type WatchlistAddSeriesQuery struct {
+	SeriesID int  `query:"series_id" url:"series_id" json:"series_id"`
+	Follow   bool `query:"follow"    url:"follow"    json:"follow"`
+}
+
+var _ validation.Validatable = WatchlistAddSeriesQuery{}
+
+func (r WatchlistAddSeriesQuery) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.SeriesID,
+			validation.Required,
+			validation.Min(1),
+		),
+	)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type WatchlistAddSeasonQuery struct {
+	SeriesID     int `query:"series_id"     url:"series_id"     json:"series_id"`
+	SeasonNumber int `query:"season_number" url:"season_number" json:"season_number"`
+}
+
+var _ validation.Validatable = WatchlistAddSeasonQuery{}
+
+func (r WatchlistAddSeasonQuery) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.SeriesID,
+			validation.Required,
+			validation.Min(1),
+		),
+		validation.Field(
+			&r.SeasonNumber,
+			validation.Min(0),
+			validation.Max(config.Config.Validation.Film.SeasonNumber.MaxValue),
+		),
+	)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type SeriesFollowQuery struct {
+	SeriesID int `query:"series_id" url:"series_id" json:"series_id"`
+}
+
+var _ validation.Validatable = SeriesFollowQuery{}
+
+func (r SeriesFollowQuery) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.SeriesID,
+			validation.Required,
+			validation.Min(1),
+		),
+	)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type WatchlistAddCollectionQuery struct {
+	CollectionID int `query:"collection_id" url:"collection_id" json:"collection_id"`
+}
+
+var _ validation.Validatable = WatchlistAddCollectionQuery{}
+
+func (r WatchlistAddCollectionQuery) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.CollectionID,
+			validation.Required,
+			validation.Min(1),
+		),
+	)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type WatchlistAddListQuery struct {
+	ListID int `query:"list_id" url:"list_id" json:"list_id"`
+}
+
+var _ validation.Validatable = WatchlistAddListQuery{}
+
+func (r WatchlistAddListQuery) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.ListID,
+			validation.Required,
+			validation.Min(1),
+		),
+	)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type MediaUploadQuery struct {
+	Kind    string `query:"kind"    url:"kind"    json:"kind"`
+	Primary bool   `query:"primary" url:"primary" json:"primary"`
+}
+
+var _ validation.Validatable = MediaUploadQuery{}
+
+func (r MediaUploadQuery) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Kind,
+			validation.Required,
+			validation.In(dto.MediaImageKinds...),
+		),
+	)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type ImportCreateQuery struct {
+	Format string `query:"format"  url:"format"  json:"format"`
+	DryRun bool   `query:"dry_run" url:"dry_run" json:"dry_run"`
+}
+
+var _ validation.Validatable = ImportCreateQuery{}
+
+func (r ImportCreateQuery) Validate() error {
+	return validation.ValidateStruct(
+		&r,
+		validation.Field(
+			&r.Format,
+			validation.Required,
+			validation.In(catalog.FormatCSV, catalog.FormatNDJSON),
+		),
+	)
+}
@@ -2,14 +2,19 @@ package request_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/aria3ppp/watchlist-server/internal/catalog"
 	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
 	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/query"
 	"github.com/aria3ppp/watchlist-server/internal/server/request"
 	"github.com/aria3ppp/watchlist-server/internal/testutils"
 	"github.com/aria3ppp/watchlist-server/internal/validator"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
 )
 
 func TestPaginationSortingQuery_Validate(t *testing.T) {
@@ -309,6 +314,43 @@ func TestSearchPaginationQuery_Validate(t *testing.T) {
 	}
 }
 
+func TestInvalidationQuery_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		query    request.InvalidationQuery
+		expError error
+	}{
+		{
+			name:     "tc1",
+			query:    request.InvalidationQuery{},
+			expError: nil,
+		},
+		{
+			name: "tc2",
+			query: request.InvalidationQuery{
+				Invalidation: query.InvalidationOnly,
+			},
+			expError: nil,
+		},
+		{
+			name: "tc3",
+			query: request.InvalidationQuery{
+				Invalidation: "invalid",
+			},
+			expError: validation.Errors{
+				"invalidation": validation.ErrInInvalid,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.query.Validate())
+		})
+	}
+}
+
 func TestWatchlistGetQuery_Validate(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -415,6 +457,22 @@ func TestWatchlistGetQuery_Validate(t *testing.T) {
 				"filter": validation.ErrInInvalid,
 			},
 		},
+		{
+			name: "tc11",
+			query: request.WatchlistGetQuery{
+				SortField: query.WatchlistSortScoreMean,
+			},
+			expError: nil,
+		},
+		{
+			name: "tc12",
+			query: request.WatchlistGetQuery{
+				SortField: "invalid_sort_field",
+			},
+			expError: validation.Errors{
+				"sort_field": validation.ErrInInvalid,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -425,6 +483,77 @@ func TestWatchlistGetQuery_Validate(t *testing.T) {
 	}
 }
 
+func TestReleaseQuery_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		params   request.ReleaseQuery
+		expError error
+	}{
+		{
+			name:     "tc1",
+			params:   request.ReleaseQuery{},
+			expError: nil,
+		},
+		{
+			name: "tc2",
+			params: request.ReleaseQuery{
+				Released: true,
+				MaxAge:   null.IntFrom(12),
+			},
+			expError: validation.Errors{
+				"region": validation.ErrRequired,
+			},
+		},
+		{
+			name: "tc3",
+			params: request.ReleaseQuery{
+				Region:      "de",
+				ReleaseType: "tv",
+				MaxAge:      null.IntFrom(-1),
+			},
+			expError: validation.Errors{
+				"region":       validation.ErrMatchInvalid,
+				"release_type": validation.ErrInInvalid,
+				"max_age": validation.ErrMinGreaterEqualThanRequired.SetParams(
+					map[string]any{"threshold": 0},
+				),
+			},
+		},
+		{
+			name: "tc4",
+			params: request.ReleaseQuery{
+				Region: "DE",
+				MaxAge: null.IntFrom(
+					config.Config.Validation.Request.MaxAge.MaxValue + 1,
+				),
+			},
+			expError: validation.Errors{
+				"max_age": validation.ErrMaxLessEqualThanRequired.SetParams(
+					map[string]any{
+						"threshold": config.Config.Validation.Request.MaxAge.MaxValue,
+					},
+				),
+			},
+		},
+		{
+			name: "tc5",
+			params: request.ReleaseQuery{
+				Region:      "DE",
+				ReleaseType: dto.ReleaseTypeStreaming,
+				MaxAge:      null.IntFrom(0),
+			},
+			expError: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.params.Validate())
+		})
+	}
+}
+
 func TestWatchlistAddQuery_Validate(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -465,3 +594,148 @@ func TestWatchlistAddQuery_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestReviewsGetQuery_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		params   request.ReviewsGetQuery
+		expError error
+	}{
+		{
+			name: "tc1",
+			params: request.ReviewsGetQuery{
+				PaginationSortOrderQuery: request.PaginationSortOrderQuery{
+					PaginationQuery: request.PaginationQuery{
+						Page:     1,
+						PageSize: 1,
+					},
+					SortOrderQuery: request.SortOrderQuery{
+						SortOrder: "asc",
+					},
+				},
+				SortField: "invalid",
+			},
+			expError: validation.Errors{
+				"sort_field": validation.ErrInInvalid,
+			},
+		},
+		{
+			name: "tc2",
+			params: request.ReviewsGetQuery{
+				PaginationSortOrderQuery: request.PaginationSortOrderQuery{
+					PaginationQuery: request.PaginationQuery{
+						Page:     1,
+						PageSize: 1,
+					},
+					SortOrderQuery: request.SortOrderQuery{
+						SortOrder: "desc",
+					},
+				},
+				SortField: query.ReviewSortHelpful,
+			},
+			expError: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.params.Validate())
+		})
+	}
+}
+
+func TestImportCreateQuery_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		params   request.ImportCreateQuery
+		expError error
+	}{
+		{
+			name:   "tc1",
+			params: request.ImportCreateQuery{},
+			expError: validation.Errors{
+				"format": validation.ErrRequired,
+			},
+		},
+		{
+			name: "tc2",
+			params: request.ImportCreateQuery{
+				Format: "xml",
+			},
+			expError: validation.Errors{
+				"format": validation.ErrInInvalid,
+			},
+		},
+		{
+			name: "tc3",
+			params: request.ImportCreateQuery{
+				Format: catalog.FormatCSV,
+			},
+			expError: nil,
+		},
+		{
+			name: "tc4",
+			params: request.ImportCreateQuery{
+				Format: catalog.FormatNDJSON,
+				DryRun: true,
+			},
+			expError: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.params.Validate())
+		})
+	}
+}
+
+func TestStatsQuery_Validate(t *testing.T) {
+	from := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		params   request.StatsQuery
+		expError error
+	}{
+		{
+			name:     "tc1",
+			params:   request.StatsQuery{},
+			expError: nil,
+		},
+		{
+			name: "tc2",
+			params: request.StatsQuery{
+				TimezoneQuery: request.TimezoneQuery{Timezone: "Local"},
+				From:          null.TimeFrom(from),
+				To:            null.TimeFrom(from.Add(-time.Second)),
+			},
+			expError: validation.Errors{
+				"timezone": validator.ErrInvalidTimezone.SetParams(
+					map[string]any{"timezone": "Local"},
+				),
+				"to": validation.ErrMinGreaterEqualThanRequired.SetParams(
+					map[string]any{"threshold": from},
+				),
+			},
+		},
+		{
+			name: "tc3",
+			params: request.StatsQuery{
+				TimezoneQuery: request.TimezoneQuery{Timezone: "Europe/Berlin"},
+				From:          null.TimeFrom(from),
+				To:            null.TimeFrom(from),
+			},
+			expError: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tc.expError, tc.params.Validate())
+		})
+	}
+}
@@ -1,5 +1,10 @@
 package response
 
+import (
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
 type IDResponse struct {
 	ID int `json:"id"`
 }
@@ -8,6 +13,17 @@ func ID(id int) IDResponse {
 	return IDResponse{ID: id}
 }
 
+type IDsResponse struct {
+	IDs []int `json:"ids"`
+}
+
+func IDs(ids []int) IDsResponse {
+	if ids == nil {
+		ids = []int{}
+	}
+	return IDsResponse{IDs: ids}
+}
+
 type URIResponse struct {
 	URI string `json:"uri"`
 }
@@ -47,3 +63,115 @@ func Paginated[I any](
 		TotalItems: totalItems,
 	}
 }
+
+type ContributionsResponse[S any, I any] struct {
+	// Stats are the contribution statistics of the contributor
+	Stats S `json:"stats"`
+	PaginatedResponse[I]
+}
+
+func Contributions[S any, I any](
+	stats S,
+	page int,
+	pageSize int,
+	items []I,
+	totalItems int,
+) ContributionsResponse[S, I] {
+	return ContributionsResponse[S, I]{
+		Stats:             stats,
+		PaginatedResponse: Paginated(page, pageSize, items, totalItems),
+	}
+}
+
+type SeriesResponse struct {
+	*models.Series
+	// Aggregates are the seasons, episodes and runtime of the series
+	Aggregates *models.SeriesAggregate `json:"aggregates"`
+	// Scores are the mean, count and histogram of the scores of the series
+	Scores *models.SeriesScoreAggregate `json:"scores"`
+}
+
+// Series wraps the series along with its loaded aggregates and score
+// aggregates. a series missing its aggregates has no episodes yet and one
+// missing its score aggregates is not scored yet
+func Series(series *models.Series) SeriesResponse {
+	aggregates := &models.SeriesAggregate{
+		SeriesID:       series.ID,
+		SeasonEpisodes: types.JSON("{}"),
+	}
+	scores := &models.SeriesScoreAggregate{
+		SeriesID:       series.ID,
+		ScoreHistogram: emptyScoreHistogram(),
+	}
+	if series.R != nil && len(series.R.SeriesSeriesAggregates) > 0 {
+		aggregates = series.R.SeriesSeriesAggregates[0]
+	}
+	if series.R != nil && len(series.R.SeriesSeriesScoreAggregates) > 0 {
+		scores = series.R.SeriesSeriesScoreAggregates[0]
+	}
+	return SeriesResponse{Series: series, Aggregates: aggregates, Scores: scores}
+}
+
+func Serieses(serieses []*models.Series) []SeriesResponse {
+	if serieses == nil {
+		return nil
+	}
+	items := make([]SeriesResponse, len(serieses))
+	for i, series := range serieses {
+		items[i] = Series(series)
+	}
+	return items
+}
+
+type MovieResponse struct {
+	*models.Film
+	// Scores are the mean, count and histogram of the scores of the movie
+	Scores *models.FilmScoreAggregate `json:"scores"`
+}
+
+// Movie wraps the movie along with its loaded score aggregates. a movie
+// missing its score aggregates is not scored yet
+func Movie(movie *models.Film) MovieResponse {
+	scores := &models.FilmScoreAggregate{
+		FilmID:         movie.ID,
+		ScoreHistogram: emptyScoreHistogram(),
+	}
+	if movie.R != nil && len(movie.R.FilmScoreAggregates) > 0 {
+		scores = movie.R.FilmScoreAggregates[0]
+	}
+	return MovieResponse{Film: movie, Scores: scores}
+}
+
+func Movies(movies []*models.Film) []MovieResponse {
+	if movies == nil {
+		return nil
+	}
+	items := make([]MovieResponse, len(movies))
+	for i, movie := range movies {
+		items[i] = Movie(movie)
+	}
+	return items
+}
+
+// emptyScoreHistogram is the score histogram of the targets not scored yet
+func emptyScoreHistogram() types.JSON {
+	return types.JSON(
+		`{"0": 0, "10": 0, "20": 0, "30": 0, "40": 0, "50": 0, "60": 0, "70": 0, "80": 0, "90": 0}`,
+	)
+}
+
+type PlaybackProgressResponse struct {
+	*models.PlaybackProgress
+	// Watched reports whether the put progress marked the film watched
+	Watched bool `json:"watched"`
+}
+
+func PlaybackProgress(
+	progress *models.PlaybackProgress,
+	watched bool,
+) PlaybackProgressResponse {
+	return PlaybackProgressResponse{
+		PlaybackProgress: progress,
+		Watched:          watched,
+	}
+}
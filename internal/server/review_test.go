@@ -0,0 +1,292 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/review"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleMovieReview(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultUser)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/movie/{id}/review"
+	reviewsPath := "/v1/authorized/movie/{id}/reviews"
+
+	movieID, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+
+	// another reviewer
+	reviewerReq := &dto.UserCreateRequest{
+		Email:    "reviewer@prog.net",
+		Password: "pa$$W0RD1",
+	}
+	_, err = appInstance.UserCreate(ctx, reviewerReq)
+	require.NoError(err)
+	reviewerTokens, err := appInstance.UserLogin(
+		ctx,
+		&dto.UserLoginRequest{
+			Email:    reviewerReq.Email,
+			Password: reviewerReq.Password,
+		},
+	)
+	require.NoError(err)
+	reviewerAuth := "Bearer " + reviewerTokens.JwtToken
+
+	// invalid request
+	e.PUT(path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ReviewPutRequest{
+			Body: strings.Repeat(
+				"a",
+				config.Config.Validation.Review.Body.MaxLength+1,
+			),
+		}).
+		Expect().
+		Status(http.StatusBadRequest)
+
+	// not found
+	e.PUT(path).
+		WithPath("id", movieID+1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ReviewPutRequest{Body: "body"}).
+		Expect().
+		Status(http.StatusNotFound)
+	e.DELETE(path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound)
+
+	// write the reviews
+	ownReviewID := int(e.PUT(path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ReviewPutRequest{Body: "body"}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("body", "body").
+		ValueEqual("spoiler", false).
+		Value("id").Number().Raw())
+	reviewerReviewID := int(e.PUT(path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, reviewerAuth).
+		WithJSON(&dto.ReviewPutRequest{Body: "spoiler", Spoiler: true}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("spoiler", true).
+		Value("id").Number().Raw())
+
+	// edit the own review
+	e.PUT(path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ReviewPutRequest{Body: "edited body"}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("id", ownReviewID).
+		ValueEqual("body", "edited body")
+
+	e.GET("/v1/authorized/review/{id}/audits").
+		WithPath("id", ownReviewID).
+		WithHeader(echo.HeaderAuthorization, reviewerAuth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("total_items", 1).
+		Value("items").Array().
+		Element(0).Object().
+		ValueEqual("body", "body")
+
+	// vote the reviews
+	e.PUT("/v1/authorized/review/{id}/vote").
+		WithPath("id", ownReviewID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusForbidden)
+	e.PUT("/v1/authorized/review/{id}/vote").
+		WithPath("id", reviewerReviewID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+
+	// list the most helpful reviews first
+	items := e.GET(reviewsPath).
+		WithPath("id", movieID).
+		WithQuery("sort_field", query.ReviewSortHelpful).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("total_items", 2).
+		Value("items").Array()
+	items.Element(0).Object().
+		ValueEqual("id", reviewerReviewID).
+		ValueEqual("helpful_votes", 1)
+	items.Element(1).Object().
+		ValueEqual("id", ownReviewID).
+		ValueEqual("helpful_votes", 0)
+
+	// hide the review of the reviewer
+	statusPath := "/v1/authorized/review/{id}/status"
+	e.PUT(statusPath).
+		WithPath("id", reviewerReviewID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ReviewStatusRequest{Status: review.StatusHidden}).
+		Expect().
+		Status(http.StatusForbidden)
+
+	err = appInstance.UserSetModerator(ctx, defaults.user.id, true)
+	require.NoError(err)
+
+	e.PUT(statusPath).
+		WithPath("id", reviewerReviewID+ownReviewID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ReviewStatusRequest{Status: review.StatusHidden}).
+		Expect().
+		Status(http.StatusNotFound)
+	e.PUT(statusPath).
+		WithPath("id", reviewerReviewID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ReviewStatusRequest{Status: review.StatusHidden}).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+
+	// the hidden review is listed to its author only
+	e.GET(reviewsPath).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, reviewerAuth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("total_items", 2)
+
+	err = appInstance.UserSetModerator(ctx, defaults.user.id, false)
+	require.NoError(err)
+
+	e.GET(reviewsPath).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("total_items", 1)
+
+	// withdraw the vote
+	e.DELETE("/v1/authorized/review/{id}/vote").
+		WithPath("id", reviewerReviewID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+	e.DELETE("/v1/authorized/review/{id}/vote").
+		WithPath("id", reviewerReviewID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound)
+
+	// delete the own review
+	e.DELETE(path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+}
+
+func TestHandleSeriesReview(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultUser)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/series/{id}/review"
+
+	seriesID, err := appInstance.SeriesCreate(
+		ctx,
+		defaults.user.id,
+		&dto.SeriesCreateRequest{
+			Title:       "series",
+			DateStarted: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+
+	// not found
+	e.PUT(path).
+		WithPath("id", seriesID+1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ReviewPutRequest{Body: "body"}).
+		Expect().
+		Status(http.StatusNotFound)
+
+	reviewID := int(e.PUT(path).
+		WithPath("id", seriesID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ReviewPutRequest{Body: "body"}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("id").Number().Raw())
+
+	e.GET("/v1/authorized/series/{id}/reviews").
+		WithPath("id", seriesID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("total_items", 1).
+		Value("items").Array().
+		Element(0).Object().
+		ValueEqual("id", reviewID)
+
+	e.DELETE(path).
+		WithPath("id", seriesID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+	e.DELETE(path).
+		WithPath("id", seriesID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound)
+}
@@ -0,0 +1,271 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/server/request"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// PUT /v1/authorized/movie/:id/score
+func (s *Server) HandleMovieScorePut(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate request
+	var req dto.ScorePutRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// put score
+	err := s.app.MovieScorePut(
+		c.Request().Context(),
+		payload.UserID,
+		param.ID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleMovieScorePut: movie not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleMovieScorePut: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// DELETE /v1/authorized/movie/:id/score
+func (s *Server) HandleMovieScoreDelete(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// delete score
+	err := s.app.MovieScoreDelete(
+		c.Request().Context(),
+		payload.UserID,
+		param.ID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleMovieScoreDelete: movie score not found",
+				zap.Int("user id", payload.UserID),
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleMovieScoreDelete: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// PUT /v1/authorized/series/:id/season/:season_number/episode/:episode_number/score
+func (s *Server) HandleEpisodeScorePut(c echo.Context) error {
+	// bind & validate params
+	var params request.SeriesSeasonEpisodeNumberPathParam
+	if httpError := s.bindPath(c, &params); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate request
+	var req dto.ScorePutRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// put score
+	err := s.app.EpisodeScorePut(
+		c.Request().Context(),
+		payload.UserID,
+		params.SeriesID,
+		params.SeasonNumber,
+		params.EpisodeNumber,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleEpisodeScorePut: episode not found",
+				zap.Int("series id", params.SeriesID),
+				zap.Int("season number", params.SeasonNumber),
+				zap.Int("episode number", params.EpisodeNumber),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleEpisodeScorePut: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// DELETE /v1/authorized/series/:id/season/:season_number/episode/:episode_number/score
+func (s *Server) HandleEpisodeScoreDelete(c echo.Context) error {
+	// bind & validate params
+	var params request.SeriesSeasonEpisodeNumberPathParam
+	if httpError := s.bindPath(c, &params); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// delete score
+	err := s.app.EpisodeScoreDelete(
+		c.Request().Context(),
+		payload.UserID,
+		params.SeriesID,
+		params.SeasonNumber,
+		params.EpisodeNumber,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleEpisodeScoreDelete: episode score not found",
+				zap.Int("user id", payload.UserID),
+				zap.Int("series id", params.SeriesID),
+				zap.Int("season number", params.SeasonNumber),
+				zap.Int("episode number", params.EpisodeNumber),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleEpisodeScoreDelete: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// PUT /v1/authorized/series/:id/score
+func (s *Server) HandleSeriesScorePut(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate request
+	var req dto.ScorePutRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// put score
+	err := s.app.SeriesScorePut(
+		c.Request().Context(),
+		payload.UserID,
+		param.ID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleSeriesScorePut: series not found",
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleSeriesScorePut: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// DELETE /v1/authorized/series/:id/score
+func (s *Server) HandleSeriesScoreDelete(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// delete score
+	err := s.app.SeriesScoreDelete(
+		c.Request().Context(),
+		payload.UserID,
+		param.ID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleSeriesScoreDelete: series score not found",
+				zap.Int("user id", payload.UserID),
+				zap.Int("id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleSeriesScoreDelete: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
@@ -0,0 +1,215 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/server/request"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestHandleMovieScore(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultUser)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/movie/{id}/score"
+
+	movieIDs := make([]int, 2)
+	for i := range movieIDs {
+		var err error
+		movieIDs[i], err = appInstance.MovieCreate(
+			ctx,
+			defaults.user.id,
+			&dto.MovieCreateRequest{
+				Title:        "movie",
+				DateReleased: testutils.Date(2000, 1, 1),
+			},
+		)
+		require.NoError(err)
+	}
+
+	// invalid request
+	e.PUT(path).
+		WithPath("id", movieIDs[0]).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ScorePutRequest{Score: null.IntFrom(101)}).
+		Expect().
+		Status(http.StatusBadRequest)
+
+	// not found
+	e.PUT(path).
+		WithPath("id", movieIDs[1]+1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ScorePutRequest{Score: null.IntFrom(50)}).
+		Expect().
+		Status(http.StatusNotFound)
+	e.DELETE(path).
+		WithPath("id", movieIDs[0]).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound)
+
+	// an unscored movie has empty scores
+	e.GET("/v1/authorized/movie/{id}").
+		WithPath("id", movieIDs[0]).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("scores").Object().
+		ValueEqual("scores_count", 0).
+		ValueEqual("score_mean", nil)
+
+	// score the movies
+	for i, score := range []int{40, 95} {
+		e.PUT(path).
+			WithPath("id", movieIDs[i]).
+			WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+			WithJSON(&dto.ScorePutRequest{Score: null.IntFrom(score)}).
+			Expect().
+			Status(http.StatusOK).
+			NoContent()
+	}
+
+	scores := e.GET("/v1/authorized/movie/{id}").
+		WithPath("id", movieIDs[1]).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("scores").Object()
+	scores.ValueEqual("scores_count", 1)
+	scores.ValueEqual("score_mean", 95)
+	scores.Value("score_histogram").Object().ValueEqual("90", 1)
+
+	// sort the movies by their score mean
+	items := e.GET("/v1/authorized/movie").
+		WithQuery("sort_field", "score_mean").
+		WithQuery("sort_order", request.SortOrderDesc).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("items").Array()
+	items.Length().Equal(2)
+	items.Element(0).Object().ValueEqual("id", movieIDs[1])
+	items.Element(1).Object().ValueEqual("id", movieIDs[0])
+
+	// delete the score
+	e.DELETE(path).
+		WithPath("id", movieIDs[1]).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+
+	movie, err := appInstance.MovieGet(ctx, movieIDs[1], query.LocaleOptions{})
+	require.NoError(err)
+	require.Equal(0, movie.R.FilmScoreAggregates[0].ScoresCount)
+}
+
+func TestHandleSeriesScore(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultUser)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+
+	seriesID, err := appInstance.SeriesCreate(
+		ctx,
+		defaults.user.id,
+		&dto.SeriesCreateRequest{
+			Title:       "series",
+			DateStarted: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+	err = appInstance.EpisodePut(
+		ctx,
+		seriesID, 1, 1,
+		defaults.user.id,
+		&dto.EpisodePutRequest{
+			Title:        "episode",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+
+	// score the series
+	e.PUT("/v1/authorized/series/{id}/score").
+		WithPath("id", seriesID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ScorePutRequest{Score: null.IntFrom(60)}).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+
+	e.GET("/v1/authorized/series/{id}").
+		WithPath("id", seriesID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("scores").Object().
+		ValueEqual("scores_count", 1).
+		ValueEqual("score_mean", 60)
+
+	e.DELETE("/v1/authorized/series/{id}/score").
+		WithPath("id", seriesID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+
+	// score the episode
+	path := "/v1/authorized/series/{id}/season/{se}/episode/{ep}/score"
+	e.PUT(path).
+		WithPath("id", seriesID).
+		WithPath("se", 1).
+		WithPath("ep", 2).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ScorePutRequest{Score: null.IntFrom(60)}).
+		Expect().
+		Status(http.StatusNotFound)
+	e.PUT(path).
+		WithPath("id", seriesID).
+		WithPath("se", 1).
+		WithPath("ep", 1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.ScorePutRequest{Score: null.IntFrom(60)}).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+	e.DELETE(path).
+		WithPath("id", seriesID).
+		WithPath("se", 1).
+		WithPath("ep", 1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+	e.DELETE(path).
+		WithPath("id", seriesID).
+		WithPath("se", 1).
+		WithPath("ep", 1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound)
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/aria3ppp/watchlist-server/internal/config"
 	"github.com/aria3ppp/watchlist-server/internal/dto"
 	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/aria3ppp/watchlist-server/internal/modelsfield"
+	"github.com/aria3ppp/watchlist-server/internal/query"
 	"github.com/aria3ppp/watchlist-server/internal/server/request"
 	"github.com/aria3ppp/watchlist-server/internal/server/response"
 	"github.com/aria3ppp/watchlist-server/internal/storage"
@@ -22,8 +24,17 @@ func (s *Server) HandleSeriesGet(c echo.Context) error {
 		return httpError
 	}
 
+	localeOptions, httpError := s.getLocaleOptions(c)
+	if httpError != nil {
+		return httpError
+	}
+
 	// fetch series
-	series, err := s.app.SeriesGet(c.Request().Context(), param.ID)
+	series, err := s.app.SeriesGet(
+		c.Request().Context(),
+		param.ID,
+		localeOptions,
+	)
 	if err != nil {
 		if err == app.ErrNotFound {
 			s.logger.Info(
@@ -39,14 +50,14 @@ func (s *Server) HandleSeriesGet(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError)
 	}
 
-	return c.JSON(http.StatusOK, series)
+	return c.JSON(http.StatusOK, response.Series(series))
 }
 
 // GET /v1/authorized/series/?page=1&page_size=60&sort_field=id&sort_order=desc
 func (s *Server) HandleSeriesesGetAll(c echo.Context) error {
 	// bind & validate query
 	var pagQuery request.PaginationSortingQuery
-	if httpError := s.bindQuery(c, pagQuery.SetValidationModel(models.TableNames.Serieses)); httpError != nil {
+	if httpError := s.bindQuery(c, pagQuery.SetValidationModel(modelsfield.SeriesesWithAggregates)); httpError != nil {
 		return httpError
 	}
 
@@ -61,12 +72,21 @@ func (s *Server) HandleSeriesesGetAll(c echo.Context) error {
 			Page:     config.Config.Validation.Pagination.Page.MinValue,
 			PageSize: config.Config.Validation.Pagination.PageSize.DefaultValue,
 		},
+		InvalidationQuery: request.InvalidationQuery{
+			Invalidation: query.InvalidationExclude,
+		},
 	}).ToQueryOptions()
 
+	localeOptions, httpError := s.getLocaleOptions(c)
+	if httpError != nil {
+		return httpError
+	}
+
 	// fetch serieses
 	serieses, total, err := s.app.SeriesesGetAll(
 		c.Request().Context(),
 		queryOptions,
+		localeOptions,
 	)
 	if err != nil {
 		s.logger.Error(
@@ -81,7 +101,7 @@ func (s *Server) HandleSeriesesGetAll(c echo.Context) error {
 		response.Paginated(
 			pagQuery.Page,
 			pagQuery.PageSize,
-			serieses,
+			response.Serieses(serieses),
 			total,
 		),
 	)
@@ -152,6 +172,14 @@ func (s *Server) HandleSeriesUpdate(c echo.Context) error {
 			return echo.NewHTTPError(http.StatusNotFound)
 		}
 
+		if err == app.ErrLowReputation {
+			s.logger.Info(
+				"server.HandleSeriesUpdate: low reputation",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
 		s.logger.Error(
 			"server.HandleSeriesUpdate: internal server error",
 			zap.Error(err),
@@ -197,6 +225,14 @@ func (s *Server) HandleSeriesInvalidate(c echo.Context) error {
 			return echo.NewHTTPError(http.StatusNotFound)
 		}
 
+		if err == app.ErrLowReputation {
+			s.logger.Info(
+				"server.HandleSeriesInvalidate: low reputation",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
 		s.logger.Error(
 			"server.HandleSeriesInvalidate: internal server error",
 			zap.Error(err),
@@ -272,9 +308,14 @@ func (s *Server) HandleSeriesesSearch(c echo.Context) error {
 		return httpError
 	}
 
-	queryOptions := searchPagQuery.SetQueryIfNotSet(request.PaginationQuery{
-		Page:     config.Config.Validation.Pagination.Page.MinValue,
-		PageSize: config.Config.Validation.Pagination.PageSize.DefaultValue,
+	queryOptions := searchPagQuery.SetQueryIfNotSet(request.SearchPaginationQuery{
+		PaginationQuery: request.PaginationQuery{
+			Page:     config.Config.Validation.Pagination.Page.MinValue,
+			PageSize: config.Config.Validation.Pagination.PageSize.DefaultValue,
+		},
+		InvalidationQuery: request.InvalidationQuery{
+			Invalidation: query.InvalidationExclude,
+		},
 	}).ToQueryOptions()
 
 	// fetch serieses
@@ -360,6 +401,14 @@ func (s *Server) HandleSeriesPutPoster(c echo.Context) error {
 			return echo.NewHTTPError(http.StatusNotFound)
 		}
 
+		if err == app.ErrLowReputation {
+			s.logger.Info(
+				"server.HandleSeriesPutPoster: low reputation",
+				zap.Int("user id", payload.UserID),
+			)
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
 		s.logger.Error(
 			"server.HandleSeriesPutPoster: failed putting poster",
 			zap.String("bucket", bucket),
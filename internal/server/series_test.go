@@ -14,6 +14,7 @@ import (
 	"github.com/aria3ppp/watchlist-server/internal/dto"
 	"github.com/aria3ppp/watchlist-server/internal/models"
 	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/repo"
 	"github.com/aria3ppp/watchlist-server/internal/search/searchtestutils"
 	"github.com/aria3ppp/watchlist-server/internal/server/request"
 	"github.com/aria3ppp/watchlist-server/internal/server/response"
@@ -79,7 +80,11 @@ func TestHandleSeriesGet(t *testing.T) {
 	)
 	require.NoError(err)
 
-	gotSeries, err := appInstance.SeriesGet(ctx, seriesID)
+	gotSeries, err := appInstance.SeriesGet(
+		ctx,
+		seriesID,
+		query.LocaleOptions{},
+	)
 	require.NoError(err)
 
 	require.GreaterOrEqual(gotSeries.ContributedAt, createTime)
@@ -92,7 +97,7 @@ func TestHandleSeriesGet(t *testing.T) {
 		Status(http.StatusOK).
 		JSON().
 		Object().
-		Equal(&models.Series{
+		Equal(response.Series(&models.Series{
 			ID:            seriesID,
 			Title:         seriesCreateReq.Title,
 			Descriptions:  seriesCreateReq.Descriptions,
@@ -101,7 +106,7 @@ func TestHandleSeriesGet(t *testing.T) {
 			Invalidation:  null.String{},
 			ContributedBy: defaults.user.id,
 			ContributedAt: gotSeries.ContributedAt,
-		})
+		}))
 }
 
 func TestHandleSeriesesGetAll(t *testing.T) {
@@ -143,7 +148,7 @@ func TestHandleSeriesesGetAll(t *testing.T) {
 		Equal(response.Paginated(
 			config.Config.Validation.Pagination.Page.MinValue,
 			config.Config.Validation.Pagination.PageSize.DefaultValue,
-			([]*models.Series)(nil),
+			([]response.SeriesResponse)(nil),
 			0,
 		))
 
@@ -189,7 +194,7 @@ func TestHandleSeriesesGetAll(t *testing.T) {
 		Limit:     config.Config.Validation.Pagination.PageSize.MaxValue,
 		SortField: models.SeriesColumns.ID,
 		SortOrder: request.SortOrderAsc,
-	})
+	}, query.LocaleOptions{})
 	require.NoError(err)
 
 	items := make([]*models.Series, len(gotSerieses))
@@ -219,7 +224,7 @@ func TestHandleSeriesesGetAll(t *testing.T) {
 		Equal(response.Paginated(
 			config.Config.Validation.Pagination.Page.MinValue,
 			config.Config.Validation.Pagination.PageSize.DefaultValue,
-			items,
+			response.Serieses(items),
 			total,
 		))
 }
@@ -271,7 +276,11 @@ func TestHandleSeriesCreate(t *testing.T) {
 
 	// check series created
 
-	gotSeries, err := appInstance.SeriesGet(ctx, seriesID)
+	gotSeries, err := appInstance.SeriesGet(
+		ctx,
+		seriesID,
+		query.LocaleOptions{},
+	)
 	require.NoError(err)
 
 	testutils.SetTimeLocation(
@@ -295,6 +304,7 @@ func TestHandleSeriesCreate(t *testing.T) {
 			Invalidation:  null.String{},
 			ContributedBy: defaults.user.id,
 			ContributedAt: gotSeries.ContributedAt,
+			R:             gotSeries.R,
 		},
 		gotSeries,
 	)
@@ -420,7 +430,11 @@ func TestHandleSeriesUpdate(t *testing.T) {
 			)
 			require.NoError(err)
 
-			gotSeriesBeforeUpdate, err := appInstance.SeriesGet(ctx, seriesID)
+			gotSeriesBeforeUpdate, err := appInstance.SeriesGet(
+				ctx,
+				seriesID,
+				query.LocaleOptions{},
+			)
 			require.NoError(err)
 
 			updateTime := time.Now()
@@ -435,7 +449,11 @@ func TestHandleSeriesUpdate(t *testing.T) {
 				NoContent()
 
 			// check updated fields
-			gotSeriesAfterUpdate, err := appInstance.SeriesGet(ctx, seriesID)
+			gotSeriesAfterUpdate, err := appInstance.SeriesGet(
+				ctx,
+				seriesID,
+				query.LocaleOptions{},
+			)
 			require.NoError(err)
 
 			require.GreaterOrEqual(
@@ -468,6 +486,7 @@ func TestHandleSeriesUpdate(t *testing.T) {
 			updatedSeries.Invalidation = null.String{}
 			updatedSeries.ContributedBy = defaults.user.id
 			updatedSeries.ContributedAt = gotSeriesAfterUpdate.ContributedAt
+			updatedSeries.R = gotSeriesAfterUpdate.R
 
 			testutils.SetTimeLocation(
 				&updatedSeries.DateStarted,
@@ -564,7 +583,11 @@ func TestHandleSeriesInvalidate(t *testing.T) {
 		NoContent()
 
 	// check series invalidated
-	gotInvalidatedSeries, err := appInstance.SeriesGet(ctx, seriesID)
+	gotInvalidatedSeries, err := appInstance.SeriesGet(
+		ctx,
+		seriesID,
+		query.LocaleOptions{},
+	)
 	require.NoError(err)
 
 	testutils.SetTimeLocation(
@@ -586,6 +609,7 @@ func TestHandleSeriesInvalidate(t *testing.T) {
 			Invalidation:  null.StringFrom(invalidationRequest.Invalidation),
 			ContributedBy: defaults.user.id,
 			ContributedAt: gotInvalidatedSeries.ContributedAt,
+			R:             gotInvalidatedSeries.R,
 		},
 		gotInvalidatedSeries,
 	)
@@ -663,7 +687,11 @@ func TestHandleSeriesAuditsGetAll(t *testing.T) {
 	)
 	require.NoError(err)
 
-	gotSeries, err := appInstance.SeriesGet(ctx, seriesID)
+	gotSeries, err := appInstance.SeriesGet(
+		ctx,
+		seriesID,
+		query.LocaleOptions{},
+	)
 	require.NoError(err)
 
 	require.GreaterOrEqual(gotSeries.ContributedAt, createTime)
@@ -677,6 +705,8 @@ func TestHandleSeriesAuditsGetAll(t *testing.T) {
 		Invalidation:  null.String{},
 		ContributedBy: defaults.user.id,
 		ContributedAt: gotSeries.ContributedAt,
+		AuditAction:   repo.AuditActionUpdate,
+		AuditActor:    null.IntFrom(defaults.user.id),
 	}
 
 	// no audits
@@ -715,7 +745,7 @@ func TestHandleSeriesAuditsGetAll(t *testing.T) {
 	)
 	require.NoError(err)
 
-	gotSeries, err = appInstance.SeriesGet(ctx, seriesID)
+	gotSeries, err = appInstance.SeriesGet(ctx, seriesID, query.LocaleOptions{})
 	require.NoError(err)
 
 	require.GreaterOrEqual(gotSeries.ContributedAt, updateTime)
@@ -729,6 +759,8 @@ func TestHandleSeriesAuditsGetAll(t *testing.T) {
 		Invalidation:  null.String{},
 		ContributedBy: defaults.user.id,
 		ContributedAt: gotSeries.ContributedAt,
+		AuditAction:   repo.AuditActionUpdate,
+		AuditActor:    null.IntFrom(defaults.user.id),
 	}
 
 	// get update audits
@@ -991,7 +1023,7 @@ func TestHandleSeriesesSearch(t *testing.T) {
 			Limit:     config.Config.Validation.Pagination.PageSize.MaxValue,
 			SortField: models.SeriesColumns.ID,
 			SortOrder: request.SortOrderAsc,
-		})
+		}, query.LocaleOptions{})
 
 	require.NoError(err)
 
@@ -64,11 +64,14 @@ func (s *Server) setHandlers() {
 		}),
 	)
 
-	// set timeout middleware for all paths
+	// set timeout middleware for all paths but catalog export downloads
 	// if the request timeouts it sends "503 - Service Unavailable"
 	s.router.Use(
 		middleware.TimeoutWithConfig(
 			middleware.TimeoutConfig{
+				Skipper: func(c echo.Context) bool {
+					return c.Path() == "/v1/authorized/export/latest/:filename"
+				},
 				Timeout: time.Second * time.Duration(
 					config.Config.Server.HandlerTimeoutInSeconds,
 				),
@@ -112,12 +115,22 @@ func (s *Server) setHandlers() {
 					ContextKey:     contextKey,
 					ParseTokenFunc: s.parseTokenFunc,
 				}),
+				s.actorMiddleware,
 			)
 
 			// user
 			{
 				authorizedUser := authorized.Group("/user")
 				authorizedUser.GET("/:id", s.HandleUserGet)
+				authorizedUser.GET(
+					"/:id/contributions",
+					s.HandleUserContributionsGet,
+				)
+				authorizedUser.GET("/:id/lists", s.HandleUserListsGetAll)
+				authorizedUser.GET(
+					"/leaderboard",
+					s.HandleContributorsLeaderboard,
+				)
 				authorizedUser.PATCH("", s.HandleUserUpdate)
 				authorizedUser.PUT("/email", s.HandleUserEmailUpdate)
 				authorizedUser.PUT("/password", s.HandleUserPasswordUpdate)
@@ -131,14 +144,64 @@ func (s *Server) setHandlers() {
 				movies.GET("", s.HandleMoviesGetAll)
 				movies.POST("", s.HandleMovieCreate)
 				movies.GET("/search", s.HandleMoviesSearch)
+				movies.GET(
+					"/by-external/:provider/:external_id",
+					s.HandleMovieGetByExternalID,
+				)
 
 				{
 					movie := movies.Group("/:id")
 					movie.GET("", s.HandleMovieGet)
 					movie.PATCH("", s.HandleMovieUpdate)
+					movie.DELETE("", s.HandleMovieHide)
+					movie.POST("/restore", s.HandleMovieRestore)
+					movie.DELETE("/purge", s.HandleMovieDelete)
 					movie.POST("/invalidate", s.HandleMovieInvalidate)
 					movie.GET("/audits", s.HandleMovieAuditsGetAll)
 					movie.PUT("/poster", s.HandleMoviePutPoster)
+					movie.GET("/external_ids", s.HandleMovieExternalIDsGet)
+					movie.PUT("/external_ids", s.HandleMovieExternalIDPut)
+					movie.GET(
+						"/external_ids/audits",
+						s.HandleMovieExternalIDAuditsGetAll,
+					)
+					movie.GET("/releases", s.HandleMovieReleasesGet)
+					movie.PUT("/releases", s.HandleMovieReleasePut)
+					movie.GET(
+						"/releases/audits",
+						s.HandleMovieReleaseAuditsGetAll,
+					)
+					movie.GET(
+						"/content_ratings",
+						s.HandleMovieContentRatingsGet,
+					)
+					movie.PUT(
+						"/content_ratings",
+						s.HandleMovieContentRatingPut,
+					)
+					movie.GET(
+						"/content_ratings/audits",
+						s.HandleMovieContentRatingAuditsGetAll,
+					)
+					movie.GET("/translations", s.HandleMovieTranslationsGet)
+					movie.PUT("/translations", s.HandleMovieTranslationPut)
+					movie.GET(
+						"/translations/audits",
+						s.HandleMovieTranslationAuditsGetAll,
+					)
+					movie.GET("/metadata", s.HandleMovieMetadataGet)
+					movie.POST("/metadata", s.HandleMovieMetadataApply)
+					movie.GET("/media", s.HandleMovieMediaGet)
+					movie.POST("/media", s.HandleMovieMediaUpload)
+					movie.POST("/media/trailer", s.HandleMovieMediaTrailerAdd)
+					movie.PUT("/media/order", s.HandleMovieMediaReorder)
+					movie.GET("/media/audits", s.HandleMovieMediaAuditsGetAll)
+					movie.DELETE("/media/:media_id", s.HandleMovieMediaRemove)
+					movie.PUT("/score", s.HandleMovieScorePut)
+					movie.DELETE("/score", s.HandleMovieScoreDelete)
+					movie.GET("/reviews", s.HandleMovieReviewsGetAll)
+					movie.PUT("/review", s.HandleMovieReviewPut)
+					movie.DELETE("/review", s.HandleMovieReviewDelete)
 				}
 			}
 
@@ -148,17 +211,61 @@ func (s *Server) setHandlers() {
 				serieses.GET("", s.HandleSeriesesGetAll)
 				serieses.POST("", s.HandleSeriesCreate)
 				serieses.GET("/search", s.HandleSeriesesSearch)
+				serieses.GET(
+					"/by-external/:provider/:external_id",
+					s.HandleSeriesGetByExternalID,
+				)
 
 				{
 					series := serieses.Group("/:id")
 					series.GET("", s.HandleSeriesGet)
 					series.PATCH("", s.HandleSeriesUpdate)
+					series.DELETE("", s.HandleSeriesHide)
+					series.POST("/restore", s.HandleSeriesRestore)
+					series.DELETE("/purge", s.HandleSeriesDelete)
 					series.POST("/invalidate", s.HandleSeriesInvalidate)
 					series.GET("/audits", s.HandleSeriesAuditsGetAll)
 					series.PUT("/poster", s.HandleSeriesPutPoster)
+					series.GET("/external_ids", s.HandleSeriesExternalIDsGet)
+					series.PUT("/external_ids", s.HandleSeriesExternalIDPut)
+					series.GET(
+						"/external_ids/audits",
+						s.HandleSeriesExternalIDAuditsGetAll,
+					)
+					series.GET("/translations", s.HandleSeriesTranslationsGet)
+					series.PUT("/translations", s.HandleSeriesTranslationPut)
+					series.GET(
+						"/translations/audits",
+						s.HandleSeriesTranslationAuditsGetAll,
+					)
+					series.GET("/metadata", s.HandleSeriesMetadataGet)
+					series.POST("/metadata", s.HandleSeriesMetadataApply)
+					series.GET("/media", s.HandleSeriesMediaGet)
+					series.POST("/media", s.HandleSeriesMediaUpload)
+					series.POST("/media/trailer", s.HandleSeriesMediaTrailerAdd)
+					series.PUT("/media/order", s.HandleSeriesMediaReorder)
+					series.GET("/media/audits", s.HandleSeriesMediaAuditsGetAll)
+					series.DELETE("/media/:media_id", s.HandleSeriesMediaRemove)
+					series.PUT("/score", s.HandleSeriesScorePut)
+					series.DELETE("/score", s.HandleSeriesScoreDelete)
+					series.GET("/reviews", s.HandleSeriesReviewsGetAll)
+					series.PUT("/review", s.HandleSeriesReviewPut)
+					series.DELETE("/review", s.HandleSeriesReviewDelete)
+					series.GET(
+						"/season/:season_number/metadata",
+						s.HandleSeasonMetadataGet,
+					)
+					series.POST(
+						"/season/:season_number/metadata",
+						s.HandleSeasonMetadataApply,
+					)
 
 					// episode
 					series.GET("/episode", s.HandleEpisodesGetAllBySeries)
+					series.POST(
+						"/episode/renumber",
+						s.HandleEpisodesRenumber,
+					)
 					{
 						episodes := series.Group(
 							"/season/:season_number/episode",
@@ -175,23 +282,149 @@ func (s *Server) setHandlers() {
 							episode.GET("", s.HandleEpisodeGet)
 							episode.PUT("", s.HandleEpisodePut)
 							episode.PATCH("", s.HandleEpisodeUpdate)
+							episode.DELETE("", s.HandleEpisodeHide)
+							episode.POST("/restore", s.HandleEpisodeRestore)
+							episode.DELETE("/purge", s.HandleEpisodeDelete)
 							episode.POST(
 								"/invalidate",
 								s.HandleEpisodeInvalidate,
 							)
 							episode.GET("/audits", s.HandleEpisodeAuditsGetAll)
+							episode.GET(
+								"/translations",
+								s.HandleEpisodeTranslationsGet,
+							)
+							episode.PUT(
+								"/translations",
+								s.HandleEpisodeTranslationPut,
+							)
+							episode.GET(
+								"/translations/audits",
+								s.HandleEpisodeTranslationAuditsGetAll,
+							)
+							episode.PUT("/score", s.HandleEpisodeScorePut)
+							episode.DELETE("/score", s.HandleEpisodeScoreDelete)
 						}
 					}
 				}
 			}
 
+			// collection
+			{
+				collections := authorized.Group("/collection")
+				collections.GET("", s.HandleCollectionsGetAll)
+				collections.POST("", s.HandleCollectionCreate)
+
+				{
+					collection := collections.Group("/:id")
+					collection.GET("", s.HandleCollectionGet)
+					collection.PATCH("", s.HandleCollectionUpdate)
+					collection.POST("/invalidate", s.HandleCollectionInvalidate)
+					collection.GET("/audits", s.HandleCollectionAuditsGetAll)
+					collection.GET("/items", s.HandleCollectionItemsGet)
+					collection.PUT("/items", s.HandleCollectionItemsPut)
+					collection.GET(
+						"/items/audits",
+						s.HandleCollectionItemAuditsGetAll,
+					)
+				}
+			}
+
+			// list
+			{
+				lists := authorized.Group("/list")
+				lists.POST("", s.HandleListCreate)
+				lists.GET("/invites", s.HandleUserListInvitesGet)
+				{
+					invite := lists.Group("/invite/:id")
+					invite.POST("/accept", s.HandleListInviteAccept)
+					invite.DELETE("", s.HandleListInviteDelete)
+				}
+				{
+					list := lists.Group("/:id")
+					list.GET("", s.HandleListGet)
+					list.PATCH("", s.HandleListUpdate)
+					list.DELETE("", s.HandleListDelete)
+					list.GET("/items", s.HandleListItemsGet)
+					list.POST("/items", s.HandleListItemAdd)
+					list.PUT("/items/order", s.HandleListReorder)
+					list.DELETE("/items/:item_id", s.HandleListItemRemove)
+					list.GET("/members", s.HandleListMembersGet)
+					list.PUT("/members/:user_id", s.HandleListMemberUpdate)
+					list.DELETE("/members/:user_id", s.HandleListMemberRemove)
+					list.GET("/invites", s.HandleListInvitesGet)
+					list.POST("/invites", s.HandleListInviteCreate)
+					list.POST("/invites/link", s.HandleListInviteLinkCreate)
+					list.GET("/activities", s.HandleListActivitiesGet)
+				}
+			}
+
 			// watchlist
 			{
 				watchlist := authorized.Group("/watchlist")
 				watchlist.GET("", s.HandleWatchlistGet)
+				watchlist.GET("/next", s.HandleUpNextGet)
 				watchlist.POST("/add", s.HandleWatchlistAdd)
+				watchlist.POST(
+					"/add/collection",
+					s.HandleWatchlistAddCollection,
+				)
+				watchlist.POST("/add/list", s.HandleWatchlistAddList)
+				watchlist.POST("/add/series", s.HandleWatchlistAddSeries)
+				watchlist.POST("/add/season", s.HandleWatchlistAddSeason)
+				watchlist.DELETE("/follow", s.HandleSeriesUnfollow)
 				watchlist.DELETE("/:id", s.HandleWatchlistDelete)
 				watchlist.PATCH("/:id", s.HandleWatchlistSetWatched)
+				watchlist.PATCH("/:id/details", s.HandleWatchlistItemUpdate)
+				watchlist.PUT("/:id/position", s.HandleWatchlistMove)
+				watchlist.POST("/:id/watch", s.HandleWatchEventCreate)
+				watchlist.DELETE("/:id/watch", s.HandleWatchEventUndo)
+				watchlist.GET("/:id/history", s.HandleWatchEventsGetAll)
+			}
+
+			// playback
+			{
+				playback := authorized.Group("/playback")
+				playback.GET("", s.HandleContinueWatchingGet)
+				playback.GET("/:id", s.HandlePlaybackProgressGet)
+				playback.PUT("/:id", s.HandlePlaybackProgressPut)
+			}
+
+			// stats
+			{
+				stats := authorized.Group("/stats")
+				stats.GET("", s.HandleStatsGet)
+				stats.GET("/year/:year", s.HandleYearReviewGet)
+			}
+
+			// review
+			{
+				review := authorized.Group("/review/:id")
+				review.PUT("/vote", s.HandleReviewVote)
+				review.DELETE("/vote", s.HandleReviewUnvote)
+				review.GET("/audits", s.HandleReviewAuditsGetAll)
+				review.PUT("/status", s.HandleReviewSetStatus)
+			}
+
+			// import
+			{
+				imports := authorized.Group("/import")
+				imports.POST("", s.HandleImportCreate)
+				imports.GET("/:id", s.HandleImportJobGet)
+				imports.GET("/:id/errors", s.HandleImportErrorsGetAll)
+			}
+
+			// export
+			{
+				export := authorized.Group("/export")
+				export.GET("/latest", s.HandleCatalogExportManifestGet)
+				export.GET("/latest/:filename", s.HandleCatalogExportFileGet)
+			}
+
+			// audit
+			{
+				audit := authorized.Group("/audit")
+				audit.GET("/prune/latest", s.HandleAuditPruneGetLatest)
 			}
 		}
 	}
@@ -220,6 +453,11 @@ func (s *Server) Run(addr string) {
 		if err := s.router.Shutdown(ctx); err != nil {
 			s.logger.Error("server shutdown", zap.Error(err))
 		}
+		// wait for the import jobs: the jobs still running on timeout are
+		// canceled and marked failed
+		if err := s.app.ImportJobsWait(ctx); err != nil {
+			s.logger.Error("import jobs shutdown", zap.Error(err))
+		}
 		close(waitForShutdown)
 	}()
 	// start server
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/aria3ppp/watchlist-server/internal/server/request"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// GET /v1/authorized/stats?from=2022-01-01T00:00:00Z&to=2022-06-30T23:59:59Z&timezone=Europe/Berlin
+func (s *Server) HandleStatsGet(c echo.Context) error {
+	// bind & validate query
+	var query request.StatsQuery
+	if httpError := s.bindQuery(c, &query); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	localeOptions, httpError := s.getLocaleOptions(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// compute stats
+	stats, err := s.app.StatsGet(
+		c.Request().Context(),
+		payload.UserID,
+		query.ToStatsOptions(),
+		localeOptions,
+	)
+	if err != nil {
+		s.logger.Error(
+			"server.HandleStatsGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, stats)
+}
+
+// GET /v1/authorized/stats/year/2022?timezone=Europe/Berlin
+func (s *Server) HandleYearReviewGet(c echo.Context) error {
+	// bind & validate path
+	var param request.YearPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate query
+	var query request.TimezoneQuery
+	if httpError := s.bindQuery(c, &query); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	localeOptions, httpError := s.getLocaleOptions(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// fetch or generate year review
+	review, err := s.app.YearReviewGet(
+		c.Request().Context(),
+		payload.UserID,
+		param.Year,
+		query.GetTimezone(),
+		localeOptions,
+	)
+	if err != nil {
+		s.logger.Error(
+			"server.HandleYearReviewGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, review)
+}
@@ -0,0 +1,219 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/server/request"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/aria3ppp/watchlist-server/internal/validator"
+	"github.com/gavv/httpexpect/v2"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestHandleStatsGet(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(
+		OptEnableDefaultUser | OptEnableDefaultSeries,
+	)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/stats"
+
+	// invalid query
+	e.GET(path).
+		WithQuery("timezone", "Local").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			validation.Errors{
+				"timezone": validator.ErrInvalidTimezone.SetParams(
+					map[string]any{"timezone": "Local"},
+				),
+			}.Error(),
+		))
+
+	err := appInstance.EpisodesPutAllBySeason(
+		ctx,
+		defaults.series.id,
+		1,
+		defaults.user.id,
+		&dto.EpisodesPutAllBySeasonRequest{
+			Episodes: []*dto.EpisodePutRequest{
+				{
+					Title:        "episode 1",
+					DateReleased: testutils.Date(2000, 1, 1),
+					Duration:     null.IntFrom(60 * 60),
+				},
+				{
+					Title:        "episode 2",
+					DateReleased: testutils.Date(2000, 1, 2),
+					Duration:     null.IntFrom(60 * 60),
+				},
+			},
+		},
+	)
+	require.NoError(err)
+	watchIDs, err := appInstance.WatchlistAddSeries(
+		ctx,
+		defaults.user.id,
+		defaults.series.id,
+		false,
+	)
+	require.NoError(err)
+
+	// watch on monday and tuesday late at night in utc
+	for i, watchID := range watchIDs {
+		_, err = appInstance.WatchEventCreate(
+			ctx,
+			defaults.user.id,
+			watchID,
+			&dto.WatchEventCreateRequest{
+				TimeWatched: null.TimeFrom(
+					time.Date(2022, 3, 7+i, 22, 30, 0, 0, time.UTC),
+				),
+			},
+		)
+		require.NoError(err)
+	}
+
+	stats := e.GET(path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	stats.ValueEqual("minutes_watched", 120)
+	stats.ValueEqual("hours_watched", 2)
+	stats.ValueEqual("watches_count", 2)
+	stats.ValueEqual("movies_count", 0)
+	stats.ValueEqual("episodes_count", 2)
+	stats.ValueEqual("longest_streak", 2)
+	stats.Value("weekdays").Array().Length().Equal(2)
+	stats.Value("hours").Array().Element(0).Object().ValueEqual("value", 22)
+	topSeries := stats.Value("top_series").Array().Element(0).Object()
+	topSeries.Value("series").Object().ValueEqual("id", defaults.series.id)
+	topSeries.ValueEqual("minutes_watched", 120)
+
+	// the watches are on tuesday and wednesday morning in tokyo
+	stats = e.GET(path).
+		WithQuery("timezone", "Asia/Tokyo").
+		WithQuery("from", "2022-03-08T00:00:00Z").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	stats.ValueEqual("watches_count", 1)
+	stats.Value("weekdays").Array().Element(0).Object().ValueEqual("value", 3)
+	stats.Value("hours").Array().Element(0).Object().ValueEqual("value", 7)
+}
+
+func TestHandleYearReviewGet(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(
+		OptEnableDefaultUser | OptEnableDefaultSeries,
+	)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/stats/year/%d"
+
+	// invalid path
+	e.GET(path, 1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusBadRequest)
+
+	err := appInstance.EpisodesPutAllBySeason(
+		ctx,
+		defaults.series.id,
+		1,
+		defaults.user.id,
+		&dto.EpisodesPutAllBySeasonRequest{
+			Episodes: []*dto.EpisodePutRequest{
+				{Title: "episode 1", DateReleased: testutils.Date(2000, 1, 1)},
+			},
+		},
+	)
+	require.NoError(err)
+	watchIDs, err := appInstance.WatchlistAddSeries(
+		ctx,
+		defaults.user.id,
+		defaults.series.id,
+		false,
+	)
+	require.NoError(err)
+
+	// the last night of 2021 in utc is in 2022 in tokyo
+	_, err = appInstance.WatchEventCreate(
+		ctx,
+		defaults.user.id,
+		watchIDs[0],
+		&dto.WatchEventCreateRequest{
+			TimeWatched: null.TimeFrom(
+				time.Date(2021, 12, 31, 20, 0, 0, 0, time.UTC),
+			),
+		},
+	)
+	require.NoError(err)
+
+	review := e.GET(path, 2022).
+		WithQueryObject(request.TimezoneQuery{Timezone: "Asia/Tokyo"}).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	review.ValueEqual("year", 2022)
+	review.ValueEqual("timezone", "Asia/Tokyo")
+	review.ValueEqual("watches_count", 1)
+	month := review.Value("months").Array().Element(0).Object()
+	month.ValueEqual("value", 1)
+	review.Value("top_films").Array().Length().Equal(1)
+	generatedAt, err := time.Parse(
+		time.RFC3339Nano,
+		review.Value("generated_at").String().Raw(),
+	)
+	require.NoError(err)
+
+	// the stored review is returned until it expires
+	storedGeneratedAt, err := time.Parse(
+		time.RFC3339Nano,
+		e.GET(path, 2022).
+			WithQueryObject(request.TimezoneQuery{Timezone: "Asia/Tokyo"}).
+			WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			Value("generated_at").
+			String().
+			Raw(),
+	)
+	require.NoError(err)
+	require.True(generatedAt.Equal(storedGeneratedAt))
+
+	// not in 2022 in utc
+	e.GET(path, 2022).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("watches_count", 0)
+}
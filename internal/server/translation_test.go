@@ -0,0 +1,320 @@
+package server_test
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/dto"
+	"github.com/aria3ppp/watchlist-server/internal/query"
+	"github.com/aria3ppp/watchlist-server/internal/testutils"
+	"github.com/gavv/httpexpect/v2"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestHandleMovieTranslationPut(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultUser)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/movie/{id}/translations"
+	method := http.MethodPut
+
+	// invalid request
+	e.Request(method, path).
+		WithPath("id", 1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(dto.TranslationPutRequest{
+			Language: "pt_BR",
+			Title:    "filme",
+		}).
+		Expect().
+		Status(http.StatusBadRequest).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			validation.Errors{
+				"language": validation.ErrMatchInvalid,
+			}.Error(),
+		))
+
+	req := dto.TranslationPutRequest{
+		Language:     "pt-br",
+		Title:        "filme",
+		Descriptions: null.StringFrom("descrições"),
+	}
+
+	// movie not found
+	e.Request(method, path).
+		WithPath("id", 999).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(req).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			http.StatusText(http.StatusNotFound),
+		))
+
+	// add a movie
+	movieID, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "movie",
+			Descriptions: null.StringFrom("descriptions"),
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+
+	// put translation twice
+	for _, title := range []string{"filme antigo", req.Title} {
+		req := req
+		req.Title = title
+		e.Request(method, path).
+			WithPath("id", movieID).
+			WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+			WithJSON(req).
+			Expect().
+			Status(http.StatusOK).
+			NoContent()
+	}
+
+	// get translations
+	translations, err := appInstance.MovieTranslationsGet(ctx, movieID)
+	require.NoError(err)
+	require.Equal(1, len(translations))
+	require.Equal("pt-BR", translations[0].Language)
+	require.Equal(req.Title, translations[0].Title)
+	require.Equal(req.Descriptions, translations[0].Descriptions)
+	require.Equal(defaults.user.id, translations[0].ContributedBy)
+
+	e.Request(http.MethodGet, path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Array().
+		Equal(translations)
+
+	// the second put is audited
+	audits, total, err := appInstance.MovieTranslationAuditsGetAll(
+		ctx,
+		movieID,
+		query.SortOrderOptions{
+			Offset:    0,
+			Limit:     math.MaxInt,
+			SortOrder: "desc",
+		},
+	)
+	require.NoError(err)
+	require.Equal(1, total)
+	require.Equal("filme antigo", audits[0].Title)
+
+	e.Request(http.MethodGet, path+"/audits").
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("total_items").
+		Equal(total)
+}
+
+func TestHandleMovieGetLocalized(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultUser)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/movie/{id}"
+	method := http.MethodGet
+
+	// add a translated movie
+	movieID, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "movie",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+	for _, req := range []*dto.TranslationPutRequest{
+		{Language: "de", Title: "Film"},
+		{Language: "fr", Title: "film français"},
+	} {
+		err = appInstance.MovieTranslationPut(
+			ctx,
+			movieID,
+			defaults.user.id,
+			req,
+		)
+		require.NoError(err)
+	}
+
+	// original title without preferred languages
+	e.Request(method, path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("title").
+		Equal("movie")
+
+	// best matching translation of accepted languages
+	resp := e.Request(method, path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithHeader("Accept-Language", "it, fr-CH;q=0.9, de;q=0.8").
+		Expect().
+		Status(http.StatusOK)
+	resp.Header("Vary").Contains("Accept-Language")
+	resp.JSON().Object().Value("title").Equal("film français")
+
+	// no accepted language is translated
+	e.Request(method, path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithHeader("Accept-Language", "fa").
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("title").
+		Equal("movie")
+
+	// fallback to the user locale
+	e.Request(http.MethodPatch, "/v1/authorized/user").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(dto.UserUpdateRequest{Locale: null.StringFrom("de-at")}).
+		Expect().
+		Status(http.StatusOK)
+
+	e.Request(method, path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("title").
+		Equal("Film")
+
+	// accepted languages take precedence over the user locale
+	e.Request(method, path).
+		WithPath("id", movieID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithHeader("Accept-Language", "fr").
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("title").
+		Equal("film français")
+}
+
+func TestHandleEpisodeTranslationPut(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultSeries)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/series/{id}/season/{season_number}/episode/{episode_number}/translations"
+	method := http.MethodPut
+
+	req := dto.TranslationPutRequest{Language: "de", Title: "Pilotfolge"}
+
+	// episode not found
+	e.Request(method, path).
+		WithPath("id", defaults.series.id).
+		WithPath("season_number", 1).
+		WithPath("episode_number", 1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(req).
+		Expect().
+		Status(http.StatusNotFound).
+		JSON().
+		Object().
+		Equal(testutils.ErrorMessage(
+			http.StatusText(http.StatusNotFound),
+		))
+
+	// add an episode
+	err := appInstance.EpisodePut(
+		ctx,
+		defaults.series.id, 1, 1,
+		defaults.user.id,
+		&dto.EpisodePutRequest{
+			Title:        "pilot",
+			DateReleased: testutils.Date(2000, 1, 1),
+		},
+	)
+	require.NoError(err)
+
+	// put translation
+	e.Request(method, path).
+		WithPath("id", defaults.series.id).
+		WithPath("season_number", 1).
+		WithPath("episode_number", 1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(req).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+
+	translations, err := appInstance.EpisodeTranslationsGet(
+		ctx,
+		defaults.series.id, 1, 1,
+	)
+	require.NoError(err)
+	require.Equal(1, len(translations))
+
+	e.Request(http.MethodGet, path).
+		WithPath("id", defaults.series.id).
+		WithPath("season_number", 1).
+		WithPath("episode_number", 1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Array().
+		Equal(translations)
+
+	// episodes are localized
+	e.Request(
+		http.MethodGet,
+		"/v1/authorized/series/{id}/season/{season_number}/episode",
+	).
+		WithPath("id", defaults.series.id).
+		WithPath("season_number", 1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithHeader("Accept-Language", "de-DE").
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("items").
+		Array().
+		Element(0).
+		Object().
+		Value("title").
+		Equal(req.Title)
+}
@@ -5,13 +5,14 @@ import (
 
 	"github.com/aria3ppp/watchlist-server/internal/app"
 	"github.com/aria3ppp/watchlist-server/internal/config"
+	"github.com/aria3ppp/watchlist-server/internal/dto"
 	"github.com/aria3ppp/watchlist-server/internal/server/request"
 	"github.com/aria3ppp/watchlist-server/internal/server/response"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
 )
 
-// GET /v1/authorized/watchlist/?page=12&page_size=10&sort_order=desc&filter=all
+// GET /v1/authorized/watchlist/?page=12&page_size=10&sort_field=time_added&sort_order=desc&filter=all&region=DE&released=true&max_age=12&watched_times=2
 func (s *Server) HandleWatchlistGet(c echo.Context) error {
 	// bind & validate query
 	var query request.WatchlistGetQuery
@@ -35,11 +36,38 @@ func (s *Server) HandleWatchlistGet(c echo.Context) error {
 		},
 	}).ToQueryOptions()
 
+	// bind & validate release filters
+	var releaseQuery request.ReleaseQuery
+	if httpError := s.bindQuery(c, &releaseQuery); httpError != nil {
+		return httpError
+	}
+	queryOptions.Release = releaseQuery.ToReleaseOptions()
+
+	// bind & validate watched filters
+	var watchedQuery request.WatchedQuery
+	if httpError := s.bindQuery(c, &watchedQuery); httpError != nil {
+		return httpError
+	}
+	queryOptions.Watched = watchedQuery.ToWatchedOptions()
+
+	// bind & validate item filters
+	var itemQuery request.WatchlistItemQuery
+	if httpError := s.bindQuery(c, &itemQuery); httpError != nil {
+		return httpError
+	}
+	queryOptions.Item = itemQuery.ToWatchlistItemOptions()
+
+	localeOptions, httpError := s.getLocaleOptions(c)
+	if httpError != nil {
+		return httpError
+	}
+
 	// fetch watchlist
 	watchlist, total, err := s.app.WatchlistGet(
 		c.Request().Context(),
 		payload.UserID,
 		queryOptions,
+		localeOptions,
 	)
 	if err != nil {
 		s.logger.Error(
@@ -60,6 +88,53 @@ func (s *Server) HandleWatchlistGet(c echo.Context) error {
 	)
 }
 
+// GET /v1/authorized/watchlist/next?page=1&page_size=10
+func (s *Server) HandleUpNextGet(c echo.Context) error {
+	// bind & validate query
+	var pagQuery request.PaginationQuery
+	if httpError := s.bindQuery(c, &pagQuery); httpError != nil {
+		return httpError
+	}
+
+	if pagQuery.Page == 0 {
+		pagQuery.Page = config.Config.Validation.Pagination.Page.MinValue
+	}
+	if pagQuery.PageSize == 0 {
+		pagQuery.PageSize = config.Config.Validation.Pagination.PageSize.DefaultValue
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	localeOptions, httpError := s.getLocaleOptions(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// fetch up next
+	upNext, total, err := s.app.UpNextGet(
+		c.Request().Context(),
+		payload.UserID,
+		pagQuery.Offset(),
+		pagQuery.Limit(),
+		localeOptions,
+	)
+	if err != nil {
+		s.logger.Error(
+			"server.HandleUpNextGet: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(pagQuery.Page, pagQuery.PageSize, upNext, total),
+	)
+}
+
 // POST /v1/authorized/watchlist/add/?film_id=345
 func (s *Server) HandleWatchlistAdd(c echo.Context) error {
 	// bind & validate query
@@ -98,6 +173,162 @@ func (s *Server) HandleWatchlistAdd(c echo.Context) error {
 	return c.JSON(http.StatusOK, response.ID(watchID))
 }
 
+// POST /v1/authorized/watchlist/add/collection?collection_id=1
+func (s *Server) HandleWatchlistAddCollection(c echo.Context) error {
+	// bind & validate query
+	var query request.WatchlistAddCollectionQuery
+	if httpError := s.bindQuery(c, &query); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// add collection to watchlist
+	watchIDs, err := s.app.WatchlistAddCollection(
+		c.Request().Context(),
+		payload.UserID,
+		query.CollectionID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleWatchlistAddCollection: collection not found",
+				zap.Int("collection id", query.CollectionID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleWatchlistAddCollection: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, response.IDs(watchIDs))
+}
+
+// POST /v1/authorized/watchlist/add/series?series_id=1&follow=true
+func (s *Server) HandleWatchlistAddSeries(c echo.Context) error {
+	// bind & validate query
+	var query request.WatchlistAddSeriesQuery
+	if httpError := s.bindQuery(c, &query); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// add series episodes to watchlist
+	watchIDs, err := s.app.WatchlistAddSeries(
+		c.Request().Context(),
+		payload.UserID,
+		query.SeriesID,
+		query.Follow,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleWatchlistAddSeries: series not found",
+				zap.Int("series id", query.SeriesID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleWatchlistAddSeries: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, response.IDs(watchIDs))
+}
+
+// POST /v1/authorized/watchlist/add/season?series_id=1&season_number=2
+func (s *Server) HandleWatchlistAddSeason(c echo.Context) error {
+	// bind & validate query
+	var query request.WatchlistAddSeasonQuery
+	if httpError := s.bindQuery(c, &query); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// add season episodes to watchlist
+	watchIDs, err := s.app.WatchlistAddSeason(
+		c.Request().Context(),
+		payload.UserID,
+		query.SeriesID,
+		query.SeasonNumber,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleWatchlistAddSeason: season not found",
+				zap.Int("series id", query.SeriesID),
+				zap.Int("season number", query.SeasonNumber),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleWatchlistAddSeason: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, response.IDs(watchIDs))
+}
+
+// DELETE /v1/authorized/watchlist/follow?series_id=1
+func (s *Server) HandleSeriesUnfollow(c echo.Context) error {
+	// bind & validate query
+	var query request.SeriesFollowQuery
+	if httpError := s.bindQuery(c, &query); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// unfollow series
+	err := s.app.SeriesUnfollow(
+		c.Request().Context(),
+		payload.UserID,
+		query.SeriesID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleSeriesUnfollow: series not followed",
+				zap.Int("user id", payload.UserID),
+				zap.Int("series id", query.SeriesID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleSeriesUnfollow: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
 // DELETE /v1/authorized/watchlist/:id/
 func (s *Server) HandleWatchlistDelete(c echo.Context) error {
 	// bind & validate id param
@@ -175,3 +406,235 @@ func (s *Server) HandleWatchlistSetWatched(c echo.Context) error {
 
 	return c.NoContent(http.StatusOK)
 }
+
+// PATCH /v1/authorized/watchlist/:id/details
+func (s *Server) HandleWatchlistItemUpdate(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate request
+	var req dto.WatchlistItemUpdateRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// update watchlist item
+	err := s.app.WatchlistItemUpdate(
+		c.Request().Context(),
+		payload.UserID,
+		param.ID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleWatchlistItemUpdate: watchlist record not found",
+				zap.Int("user id", payload.UserID),
+				zap.Int("watch id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleWatchlistItemUpdate: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// PUT /v1/authorized/watchlist/:id/position
+func (s *Server) HandleWatchlistMove(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate request
+	var req dto.WatchlistMoveRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// move watchlist item
+	err := s.app.WatchlistMove(
+		c.Request().Context(),
+		payload.UserID,
+		param.ID,
+		req.AfterID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleWatchlistMove: watchlist record not found",
+				zap.Int("user id", payload.UserID),
+				zap.Int("watch id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleWatchlistMove: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// POST /v1/authorized/watchlist/:id/watch
+func (s *Server) HandleWatchEventCreate(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate request
+	var req dto.WatchEventCreateRequest
+	if httpError := s.bindBody(c, &req); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// log watch
+	eventID, err := s.app.WatchEventCreate(
+		c.Request().Context(),
+		payload.UserID,
+		param.ID,
+		&req,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleWatchEventCreate: watchlist record not found",
+				zap.Int("user id", payload.UserID),
+				zap.Int("watch id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleWatchEventCreate: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, response.ID(eventID))
+}
+
+// DELETE /v1/authorized/watchlist/:id/watch
+func (s *Server) HandleWatchEventUndo(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// undo latest watch
+	err := s.app.WatchEventUndo(
+		c.Request().Context(),
+		payload.UserID,
+		param.ID,
+	)
+	if err != nil {
+		if err == app.ErrNotFound {
+			s.logger.Info(
+				"server.HandleWatchEventUndo: watch event not found",
+				zap.Int("user id", payload.UserID),
+				zap.Int("watch id", param.ID),
+			)
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		s.logger.Error(
+			"server.HandleWatchEventUndo: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// GET /v1/authorized/watchlist/:id/history?page=1&page_size=10&sort_order=desc
+func (s *Server) HandleWatchEventsGetAll(c echo.Context) error {
+	// bind & validate id param
+	var param request.IDPathParam
+	if httpError := s.bindPath(c, &param); httpError != nil {
+		return httpError
+	}
+
+	// bind & validate query
+	var pagQuery request.PaginationSortOrderQuery
+	if httpError := s.bindQuery(c, &pagQuery); httpError != nil {
+		return httpError
+	}
+
+	queryOptions := pagQuery.SetQueryIfNotSet(request.PaginationSortOrderQuery{
+		PaginationQuery: request.PaginationQuery{
+			Page:     config.Config.Validation.Pagination.Page.MinValue,
+			PageSize: config.Config.Validation.Pagination.PageSize.DefaultValue,
+		},
+		SortOrderQuery: request.SortOrderQuery{
+			SortOrder: request.SortOrderDesc,
+		},
+	}).ToQueryOptions()
+
+	payload, httpError := s.getUserPayload(c)
+	if httpError != nil {
+		return httpError
+	}
+
+	// fetch watch history
+	events, total, err := s.app.WatchEventsGetAll(
+		c.Request().Context(),
+		payload.UserID,
+		param.ID,
+		queryOptions,
+	)
+	if err != nil {
+		s.logger.Error(
+			"server.HandleWatchEventsGetAll: internal server error",
+			zap.Error(err),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+
+	return c.JSON(
+		http.StatusOK,
+		response.Paginated(
+			pagQuery.Page,
+			pagQuery.PageSize,
+			events,
+			total,
+		),
+	)
+}
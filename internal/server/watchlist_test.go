@@ -19,6 +19,7 @@ import (
 	"github.com/gavv/httpexpect/v2"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 	"github.com/volatiletech/null/v8"
 )
@@ -123,7 +124,11 @@ func TestHandleWatchlistGet(t *testing.T) {
 	for i, req := range movieCreateReqs {
 		filmID, err := appInstance.MovieCreate(ctx, defaults.user.id, req)
 		require.NoError(err)
-		gotMovie, err := appInstance.MovieGet(ctx, filmID)
+		gotMovie, err := appInstance.MovieGet(
+			ctx,
+			filmID,
+			query.LocaleOptions{},
+		)
 		require.NoError(err)
 		require.LessOrEqual(createTime, gotMovie.ContributedAt)
 		watchID, err := appInstance.WatchlistAdd(ctx, defaults.user.id, filmID)
@@ -134,6 +139,7 @@ func TestHandleWatchlistGet(t *testing.T) {
 			query.WatchlistOptions{
 				Offset: i, Limit: 1, SortOrder: request.SortOrderAsc, WhereTimeWatched: repo.RawSqlWhereTimeWatchedEmptyClause,
 			},
+			query.LocaleOptions{},
 		)
 		require.NoError(err)
 		require.Equal(i+1, total)
@@ -146,7 +152,9 @@ func TestHandleWatchlistGet(t *testing.T) {
 				FilmID:      filmID,
 				TimeAdded:   gotWatchlist[0].TimeAdded,
 				TimeWatched: null.Time{},
+				Position:    gotWatchlist[0].Position,
 			},
+			Tags: pq.StringArray{},
 			Film: models.Film{
 				ID:            filmID,
 				Title:         req.Title,
@@ -181,6 +189,7 @@ func TestHandleWatchlistGet(t *testing.T) {
 			defaults.series.id,
 			seasonNumber,
 			episodeNumber,
+			query.LocaleOptions{},
 		)
 		require.NoError(err)
 		require.LessOrEqual(createTime, gotEpisode.ContributedAt)
@@ -196,6 +205,7 @@ func TestHandleWatchlistGet(t *testing.T) {
 			query.WatchlistOptions{
 				Offset: i, Limit: 1, SortOrder: request.SortOrderAsc, WhereTimeWatched: repo.RawSqlWhereTimeWatchedEmptyClause,
 			},
+			query.LocaleOptions{},
 		)
 		require.NoError(err)
 		require.Equal(i+1, total)
@@ -208,7 +218,9 @@ func TestHandleWatchlistGet(t *testing.T) {
 				FilmID:      gotEpisode.ID,
 				TimeAdded:   gotWatchlist[0].TimeAdded,
 				TimeWatched: null.Time{},
+				Position:    gotWatchlist[0].Position,
 			},
+			Tags: pq.StringArray{},
 			Film: models.Film{
 				ID:            gotEpisode.ID,
 				Title:         req.Title,
@@ -310,6 +322,7 @@ func TestHandleWatchlistAdd(t *testing.T) {
 		query.WatchlistOptions{
 			Offset: 0, Limit: 1, SortOrder: request.SortOrderAsc, WhereTimeWatched: repo.RawSqlWhereTimeWatchedEmptyClause,
 		},
+		query.LocaleOptions{},
 	)
 	require.NoError(err)
 	require.Equal(1, total)
@@ -328,7 +341,9 @@ func TestHandleWatchlistAdd(t *testing.T) {
 				FilmID:      filmID,
 				TimeAdded:   gotWatchlist[0].TimeAdded,
 				TimeWatched: null.Time{},
+				Position:    gotWatchlist[0].Position,
 			},
+			Tags: pq.StringArray{},
 			Film: models.Film{
 				ID:            filmID,
 				Title:         movieCreateReq.Title,
@@ -413,6 +428,7 @@ func TestHandleWatchlistDelete(t *testing.T) {
 		query.WatchlistOptions{
 			Offset: 0, Limit: math.MaxInt, SortOrder: request.SortOrderAsc, WhereTimeWatched: repo.RawSqlWhereTimeWatchedEmptyClause,
 		},
+		query.LocaleOptions{},
 	)
 	require.NoError(err)
 	require.Equal(0, total)
@@ -490,6 +506,7 @@ func TestHandleWatchlistSetWatched(t *testing.T) {
 		query.WatchlistOptions{
 			Offset: 0, Limit: math.MaxInt, SortOrder: request.SortOrderAsc, WhereTimeWatched: repo.RawSqlWhereTimeWatchedIsNotNull,
 		},
+		query.LocaleOptions{},
 	)
 	require.NoError(err)
 	require.Equal(1, total)
@@ -497,3 +514,438 @@ func TestHandleWatchlistSetWatched(t *testing.T) {
 	require.True(watchlist[0].TimeWatched.Valid)
 	require.LessOrEqual(watchTime, watchlist[0].TimeWatched.Time)
 }
+
+func TestHandleWatchlistAddSeries(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(
+		OptEnableDefaultUser | OptEnableDefaultSeries,
+	)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/watchlist/add/series"
+
+	for _, seasonNumber := range []int{2, 1} {
+		err := appInstance.EpisodesPutAllBySeason(
+			ctx,
+			defaults.series.id,
+			seasonNumber,
+			defaults.user.id,
+			&dto.EpisodesPutAllBySeasonRequest{
+				Episodes: []*dto.EpisodePutRequest{
+					{Title: "episode 1", DateReleased: testutils.Date(2000, 1, 1)},
+					{Title: "episode 2", DateReleased: testutils.Date(2000, 1, 2)},
+				},
+			},
+		)
+		require.NoError(err)
+	}
+
+	// invalid query
+	e.POST(path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusBadRequest)
+
+	// series not found
+	e.POST(path).
+		WithQuery("series_id", defaults.series.id+1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound)
+
+	// add the first season which is skipped later on
+	e.POST("/v1/authorized/watchlist/add/season").
+		WithQuery("series_id", defaults.series.id).
+		WithQuery("season_number", 1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("ids").
+		Array().
+		Length().
+		Equal(2)
+
+	e.POST("/v1/authorized/watchlist/add/season").
+		WithQuery("series_id", defaults.series.id).
+		WithQuery("season_number", 3).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound)
+
+	// add the series and follow it
+	e.POST(path).
+		WithQuery("series_id", defaults.series.id).
+		WithQuery("follow", true).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("ids").
+		Array().
+		Length().
+		Equal(2)
+
+	// the episodes are in episode order
+	watchlist, total, err := appInstance.WatchlistGet(
+		ctx,
+		defaults.user.id,
+		query.WatchlistOptions{
+			Limit:            math.MaxInt,
+			SortOrder:        "asc",
+			WhereTimeWatched: repo.RawSqlWhereTimeWatchedEmptyClause,
+		},
+		query.LocaleOptions{},
+	)
+	require.NoError(err)
+	require.Equal(4, total)
+	for i, item := range watchlist {
+		require.Equal(i/2+1, item.Film.SeasonNumber.Int)
+		require.Equal(i%2+1, item.Film.EpisodeNumber.Int)
+	}
+
+	// a new episode is appended to the follower watchlist
+	err = appInstance.EpisodePut(
+		ctx,
+		defaults.series.id, 2, 3,
+		defaults.user.id,
+		&dto.EpisodePutRequest{Title: "episode 3"},
+	)
+	require.NoError(err)
+	_, total, err = appInstance.WatchlistGet(
+		ctx,
+		defaults.user.id,
+		query.WatchlistOptions{
+			Limit:            math.MaxInt,
+			SortOrder:        "asc",
+			WhereTimeWatched: repo.RawSqlWhereTimeWatchedEmptyClause,
+		},
+		query.LocaleOptions{},
+	)
+	require.NoError(err)
+	require.Equal(5, total)
+
+	// unfollow
+	e.DELETE("/v1/authorized/watchlist/follow").
+		WithQuery("series_id", defaults.series.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK)
+
+	e.DELETE("/v1/authorized/watchlist/follow").
+		WithQuery("series_id", defaults.series.id).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound)
+}
+
+func TestHandleWatchEvents(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultUser)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/watchlist/{id}/watch"
+
+	filmID, err := appInstance.MovieCreate(
+		ctx,
+		defaults.user.id,
+		&dto.MovieCreateRequest{
+			Title:        "film",
+			DateReleased: testutils.Date(2000, 1, 2),
+		},
+	)
+	require.NoError(err)
+	watchID, err := appInstance.WatchlistAdd(ctx, defaults.user.id, filmID)
+	require.NoError(err)
+
+	// invalid request
+	e.POST(path).
+		WithPath("id", watchID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.WatchEventCreateRequest{Rating: null.IntFrom(0)}).
+		Expect().
+		Status(http.StatusBadRequest)
+
+	// not found
+	e.POST(path).
+		WithPath("id", watchID+1).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.WatchEventCreateRequest{}).
+		Expect().
+		Status(http.StatusNotFound)
+
+	// log two watches
+	firstWatch := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	e.POST(path).
+		WithPath("id", watchID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.WatchEventCreateRequest{
+			TimeWatched: null.TimeFrom(firstWatch),
+			Rating:      null.IntFrom(7),
+		}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("id").
+		Number().
+		Gt(0)
+	e.POST(path).
+		WithPath("id", watchID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.WatchEventCreateRequest{Device: null.StringFrom("tv")}).
+		Expect().
+		Status(http.StatusOK)
+
+	// history is latest first
+	history := e.GET("/v1/authorized/watchlist/{id}/history").
+		WithPath("id", watchID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	history.ValueEqual("total_items", 2)
+	history.Value("items").Array().Element(0).Object().ValueEqual("device", "tv")
+	history.Value("items").Array().Element(1).Object().ValueEqual("rating", 7)
+
+	// filter the items watched twice
+	e.GET("/v1/authorized/watchlist").
+		WithQuery("watched_times", 2).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("total_items", 1)
+
+	// filter the items watched before the first watch
+	e.GET("/v1/authorized/watchlist").
+		WithQuery("watched_to", firstWatch.Add(-time.Hour).Format(time.RFC3339)).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("total_items", 0)
+
+	// undo both watches
+	for i := 0; i < 2; i++ {
+		e.DELETE(path).
+			WithPath("id", watchID).
+			WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+			Expect().
+			Status(http.StatusOK)
+	}
+	e.DELETE(path).
+		WithPath("id", watchID).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusNotFound)
+
+	watchlist, _, err := appInstance.WatchlistGet(
+		ctx,
+		defaults.user.id,
+		query.WatchlistOptions{
+			Offset: 0, Limit: math.MaxInt, SortOrder: request.SortOrderAsc, WhereTimeWatched: repo.RawSqlWhereTimeWatchedEmptyClause,
+		},
+		query.LocaleOptions{},
+	)
+	require.NoError(err)
+	require.Equal(1, len(watchlist))
+	require.False(watchlist[0].TimeWatched.Valid)
+}
+
+func TestHandleWatchlistItemDetails(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(OptEnableDefaultUser)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	detailsPath := "/v1/authorized/watchlist/{id}/details"
+	positionPath := "/v1/authorized/watchlist/{id}/position"
+
+	// not found
+	e.PATCH(detailsPath).
+		WithPath("id", 999).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.WatchlistItemUpdateRequest{Priority: null.IntFrom(1)}).
+		Expect().
+		Status(http.StatusNotFound)
+	e.PUT(positionPath).
+		WithPath("id", 999).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.WatchlistMoveRequest{}).
+		Expect().
+		Status(http.StatusNotFound)
+
+	watchIDs := make([]int, 3)
+	for i := range watchIDs {
+		filmID, err := appInstance.MovieCreate(
+			ctx,
+			defaults.user.id,
+			&dto.MovieCreateRequest{
+				Title:        "film",
+				DateReleased: testutils.Date(2000, 1, 2),
+			},
+		)
+		require.NoError(err)
+		watchIDs[i], err = appInstance.WatchlistAdd(
+			ctx,
+			defaults.user.id,
+			filmID,
+		)
+		require.NoError(err)
+	}
+
+	// invalid priority
+	e.PATCH(detailsPath).
+		WithPath("id", watchIDs[0]).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.WatchlistItemUpdateRequest{Priority: null.IntFrom(-1)}).
+		Expect().
+		Status(http.StatusBadRequest)
+
+	// set details: duplicate tags are dropped
+	e.PATCH(detailsPath).
+		WithPath("id", watchIDs[1]).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.WatchlistItemUpdateRequest{
+			Priority: null.IntFrom(2),
+			Note:     null.StringFrom("on a rainy day"),
+			Tags:     []string{"cozy", "cozy"},
+		}).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+
+	watchlist := e.GET("/v1/authorized/watchlist").
+		WithQuery("tag", "cozy").
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	watchlist.ValueEqual("total_items", 1)
+	item := watchlist.Value("items").Array().Element(0).Object()
+	item.ValueEqual("id", watchIDs[1])
+	item.ValueEqual("priority", 2)
+	item.ValueEqual("note", "on a rainy day")
+	item.ValueEqual("tags", []string{"cozy"})
+
+	// move the last item to the top
+	e.PUT(positionPath).
+		WithPath("id", watchIDs[2]).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.WatchlistMoveRequest{}).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+
+	// move the first item after the second one
+	e.PUT(positionPath).
+		WithPath("id", watchIDs[0]).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		WithJSON(&dto.WatchlistMoveRequest{AfterID: null.IntFrom(watchIDs[1])}).
+		Expect().
+		Status(http.StatusOK).
+		NoContent()
+
+	items := e.GET("/v1/authorized/watchlist").
+		WithQuery("sort_field", query.WatchlistSortPosition).
+		WithQuery("sort_order", request.SortOrderAsc).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("items").
+		Array()
+	items.Length().Equal(3)
+	items.Element(0).Object().ValueEqual("id", watchIDs[2])
+	items.Element(1).Object().ValueEqual("id", watchIDs[1])
+	items.Element(2).Object().ValueEqual("id", watchIDs[0])
+}
+
+func TestHandleUpNextGet(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	server, appInstance, defaults, teardown := setup(
+		OptEnableDefaultUser | OptEnableDefaultSeries,
+	)
+	t.Cleanup(teardown)
+
+	e := httpexpect.New(t, server.URL)
+	path := "/v1/authorized/watchlist/next"
+
+	err := appInstance.EpisodesPutAllBySeason(
+		ctx,
+		defaults.series.id,
+		1,
+		defaults.user.id,
+		&dto.EpisodesPutAllBySeasonRequest{
+			Episodes: []*dto.EpisodePutRequest{
+				{Title: "episode 1", DateReleased: testutils.Date(2000, 1, 1)},
+				{Title: "episode 2", DateReleased: testutils.Date(2000, 1, 2)},
+			},
+		},
+	)
+	require.NoError(err)
+
+	// nothing up next without activity
+	e.GET(path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("total_items", 0)
+
+	// watch the first episode
+	watchIDs, err := appInstance.WatchlistAddSeries(
+		ctx,
+		defaults.user.id,
+		defaults.series.id,
+		false,
+	)
+	require.NoError(err)
+	err = appInstance.WatchlistSetWatched(ctx, defaults.user.id, watchIDs[0])
+	require.NoError(err)
+
+	upNext := e.GET(path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	upNext.ValueEqual("total_items", 1)
+	next := upNext.Value("items").Array().Element(0).Object()
+	next.Value("series").Object().ValueEqual("id", defaults.series.id)
+	next.Value("episode").Object().ValueEqual("title", "episode 2")
+	next.ValueEqual("episodes_count", 2)
+	next.ValueEqual("watched_count", 1)
+	next.ValueEqual("new_episodes_count", 0)
+	next.ValueEqual("watch_id", watchIDs[1])
+
+	// caught up
+	err = appInstance.WatchlistSetWatched(ctx, defaults.user.id, watchIDs[1])
+	require.NoError(err)
+	e.GET(path).
+		WithHeader(echo.HeaderAuthorization, defaults.user.auth).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("total_items", 0)
+}
@@ -0,0 +1,58 @@
+package stats
+
+import (
+	"time"
+
+	"github.com/aria3ppp/watchlist-server/internal/models"
+)
+
+// Stats are the watch statistics of a user: the watched minutes are the whole
+// minutes of the summed durations in seconds of the watched films (0 if
+// unknown) and the streaks count the consecutive days having a watch in the
+// time zone of the user
+type Stats struct {
+	MinutesWatched int           `json:"minutes_watched"`
+	HoursWatched   float64       `json:"hours_watched"`
+	WatchesCount   int           `json:"watches_count"`
+	MoviesCount    int           `json:"movies_count"`
+	EpisodesCount  int           `json:"episodes_count"`
+	CurrentStreak  int           `json:"current_streak"`
+	LongestStreak  int           `json:"longest_streak"`
+	Weekdays       []*Bucket     `json:"weekdays"`
+	Hours          []*Bucket     `json:"hours"`
+	TopSeries      []*SeriesTime `json:"top_series"`
+}
+
+// Bucket counts the watches of a weekday (0 is sunday), an hour of the day or
+// a month (1 is january) in the time zone of the user
+type Bucket struct {
+	Value          int `boil:"value"           json:"value"`
+	WatchesCount   int `boil:"watches_count"   json:"watches_count"`
+	MinutesWatched int `boil:"minutes_watched" json:"minutes_watched"`
+}
+
+// sync `boil` tag whenever there's a change in model name
+type SeriesTime struct {
+	Series         models.Series `boil:"serieses,bind"   json:"series"`
+	MinutesWatched int           `boil:"minutes_watched" json:"minutes_watched"`
+	EpisodesCount  int           `boil:"episodes_count"  json:"episodes_count"`
+}
+
+// sync `boil` tag whenever there's a change in model name
+type FilmTime struct {
+	Film           models.Film `boil:"films,bind"      json:"film"`
+	MinutesWatched int         `boil:"minutes_watched" json:"minutes_watched"`
+	WatchesCount   int         `boil:"watches_count"   json:"watches_count"`
+}
+
+// YearReview is the year in review report of a user: the statistics of the
+// year in the time zone of the user along with the busiest months and the most
+// watched films
+type YearReview struct {
+	Year     int    `json:"year"`
+	Timezone string `json:"timezone"`
+	Stats
+	Months      []*Bucket   `json:"months"`
+	TopFilms    []*FilmTime `json:"top_films"`
+	GeneratedAt time.Time   `json:"generated_at"`
+}
@@ -26,6 +26,7 @@ func teardown() {
 		10*time.Second,
 		time.Second,
 		config.Config.MinIO.Bucket.Image.Name,
+		config.Config.MinIO.Bucket.Export.Name,
 	); err != nil {
 		log.Panicf("storage_test.teardown: error deleting buckets: %s", err)
 	}
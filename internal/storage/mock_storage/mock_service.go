@@ -36,6 +36,35 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// DeleteFiles mocks base method.
+func (m *MockService) DeleteFiles(arg0 context.Context, arg1 *storage.DeleteOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFiles", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFiles indicates an expected call of DeleteFiles.
+func (mr *MockServiceMockRecorder) DeleteFiles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFiles", reflect.TypeOf((*MockService)(nil).DeleteFiles), arg0, arg1)
+}
+
+// GetFile mocks base method.
+func (m *MockService) GetFile(arg0 context.Context, arg1 *storage.GetOptions) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFile", arg0, arg1)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFile indicates an expected call of GetFile.
+func (mr *MockServiceMockRecorder) GetFile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFile", reflect.TypeOf((*MockService)(nil).GetFile), arg0, arg1)
+}
+
 // PutFile mocks base method.
 func (m *MockService) PutFile(arg0 context.Context, arg1 io.Reader, arg2 *storage.PutOptions) (string, error) {
 	m.ctrl.T.Helper()
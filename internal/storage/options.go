@@ -17,3 +17,33 @@ type PutOptions struct {
 func (o *PutOptions) BuildPath() string {
 	return path.Join(o.Category, strconv.Itoa(o.CategoryID), o.Filename)
 }
+
+type GetOptions struct {
+	Bucket     string
+	Category   string
+	CategoryID int
+	Filename   string
+}
+
+func (o *GetOptions) BuildPath() string {
+	return path.Join(o.Category, strconv.Itoa(o.CategoryID), o.Filename)
+}
+
+// DeleteOptions selects all the files of a category id or only the file named
+// filename if set
+type DeleteOptions struct {
+	Bucket     string
+	Category   string
+	CategoryID int
+	Filename   string
+}
+
+// BuildPath returns the prefix of the files of the category id or the path of
+// the file if filename is set. the trailing slash keeps the prefix from
+// matching other ids sharing its digits
+func (o *DeleteOptions) BuildPath() string {
+	if o.Filename != "" {
+		return path.Join(o.Category, strconv.Itoa(o.CategoryID), o.Filename)
+	}
+	return path.Join(o.Category, strconv.Itoa(o.CategoryID)) + "/"
+}
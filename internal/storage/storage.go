@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 
@@ -17,8 +18,15 @@ type Service interface {
 		file io.Reader,
 		options *PutOptions,
 	) (uri string, err error)
+	GetFile(
+		ctx context.Context,
+		options *GetOptions,
+	) (file io.ReadCloser, err error)
+	DeleteFiles(ctx context.Context, options *DeleteOptions) error
 }
 
+var ErrNoFile = errors.New("storage: no file")
+
 type MinIO struct {
 	client *minio.Client
 }
@@ -46,6 +54,17 @@ func NewMinIO(client *minio.Client) (*MinIO, error) {
 			return nil, err
 		}
 	}
+	// export bucket is private: files are only served through the api
+	bucket = config.Config.MinIO.Bucket.Export.Name
+	// check bucket exists
+	if exists, err := client.BucketExists(ctx, bucket); err != nil {
+		return nil, err
+	} else if !exists {
+		// create bucket
+		if err := client.MakeBucket(ctx, bucket, minio.MakeBucketOptions{}); err != nil {
+			return nil, err
+		}
+	}
 	return &MinIO{client: client}, nil
 }
 
@@ -79,3 +98,80 @@ func (m *MinIO) PutFile(
 	)
 	return uri, nil
 }
+
+func (m *MinIO) GetFile(
+	ctx context.Context,
+	options *GetOptions,
+) (file io.ReadCloser, err error) {
+	obj, err := m.client.GetObject(
+		ctx,
+		options.Bucket,
+		options.BuildPath(),
+		minio.GetObjectOptions{},
+	)
+	if err != nil {
+		return nil, err
+	}
+	// objects are fetched lazily: stat to check the file exists
+	if _, err := obj.Stat(); err != nil {
+		obj.Close()
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, ErrNoFile
+		}
+		return nil, err
+	}
+	return obj, nil
+}
+
+// DeleteFiles removes all the files of the category id, or only the file if
+// a filename is set, along with all their versions
+func (m *MinIO) DeleteFiles(
+	ctx context.Context,
+	options *DeleteOptions,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	// list objects versions and feed them to remove
+	var listErr error
+	prefix := options.BuildPath()
+	objects := make(chan minio.ObjectInfo)
+	go func() {
+		defer close(objects)
+		for obj := range m.client.ListObjects(
+			ctx,
+			options.Bucket,
+			minio.ListObjectsOptions{
+				Prefix:       prefix,
+				Recursive:    true,
+				WithVersions: true,
+			},
+		) {
+			if obj.Err != nil {
+				listErr = obj.Err
+				return
+			}
+			// the path of a file prefixes the paths of the files extending
+			// its name
+			if options.Filename != "" && obj.Key != prefix {
+				continue
+			}
+			objects <- obj
+		}
+	}()
+	// drain removal errors so the listing goroutine is never blocked
+	var removeErr error
+	for e := range m.client.RemoveObjects(
+		ctx,
+		options.Bucket,
+		objects,
+		minio.RemoveObjectsOptions{},
+	) {
+		if removeErr == nil {
+			removeErr = e.Err
+		}
+	}
+	if listErr != nil {
+		return listErr
+	}
+	return removeErr
+}
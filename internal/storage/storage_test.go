@@ -3,6 +3,7 @@ package storage_test
 import (
 	"context"
 	"fmt"
+	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
@@ -12,6 +13,7 @@ import (
 	"github.com/aria3ppp/watchlist-server/internal/config"
 	"github.com/aria3ppp/watchlist-server/internal/storage"
 	"github.com/aria3ppp/watchlist-server/internal/storage/storagetestutils"
+	"github.com/minio/minio-go/v7"
 	"github.com/stretchr/testify/require"
 )
 
@@ -280,3 +282,197 @@ func TestPutFile_overwrite(t *testing.T) {
 		require.Equal(files[i].contentType, fileContentType)
 	}
 }
+
+func TestGetFile(t *testing.T) {
+	require := require.New(t)
+
+	t.Cleanup(teardown)
+
+	m, err := storage.NewMinIO(client)
+	require.NoError(err)
+
+	ctx := context.Background()
+
+	getOptions := &storage.GetOptions{
+		Bucket:     config.Config.MinIO.Bucket.Export.Name,
+		Category:   config.Config.MinIO.Category.Export,
+		CategoryID: 1,
+		Filename:   "films.ndjson.gz",
+	}
+
+	// no file
+	file, err := m.GetFile(ctx, getOptions)
+	require.Equal(storage.ErrNoFile, err)
+	require.Nil(file)
+
+	// put file
+	content := `{"id":1,"title":"film"}` + "\n"
+	_, err = m.PutFile(
+		ctx,
+		strings.NewReader(content),
+		&storage.PutOptions{
+			Bucket:      getOptions.Bucket,
+			Category:    getOptions.Category,
+			CategoryID:  getOptions.CategoryID,
+			Filename:    getOptions.Filename,
+			ContentType: "application/gzip",
+			Size:        int64(len(content)),
+		},
+	)
+	require.NoError(err)
+
+	// get file
+	file, err = m.GetFile(ctx, getOptions)
+	require.NoError(err)
+	t.Cleanup(func() {
+		file.Close()
+	})
+
+	data, err := io.ReadAll(file)
+	require.NoError(err)
+	require.Equal(content, string(data))
+}
+
+func TestDeleteFiles(t *testing.T) {
+	require := require.New(t)
+
+	t.Cleanup(teardown)
+
+	m, err := storage.NewMinIO(client)
+	require.NoError(err)
+
+	ctx := context.Background()
+
+	// put two versions of a poster of movie 1 and a poster of movie 10
+	putFile := func(categoryID int, content string) {
+		_, err := m.PutFile(
+			ctx,
+			strings.NewReader(content),
+			&storage.PutOptions{
+				Bucket:      config.Config.MinIO.Bucket.Image.Name,
+				Category:    config.Config.MinIO.Category.Movie,
+				CategoryID:  categoryID,
+				Filename:    config.Config.MinIO.Filename.Movie,
+				ContentType: "image/png",
+				Size:        int64(len(content)),
+			},
+		)
+		require.NoError(err)
+	}
+	putFile(1, "poster")
+	putFile(1, "new poster")
+	putFile(10, "poster")
+
+	// delete files of movie 1
+	err = m.DeleteFiles(
+		ctx,
+		&storage.DeleteOptions{
+			Bucket:     config.Config.MinIO.Bucket.Image.Name,
+			Category:   config.Config.MinIO.Category.Movie,
+			CategoryID: 1,
+		},
+	)
+	require.NoError(err)
+
+	// no version of movie 1 files is left
+	for obj := range client.ListObjects(
+		ctx,
+		config.Config.MinIO.Bucket.Image.Name,
+		minio.ListObjectsOptions{
+			Prefix:       config.Config.MinIO.Category.Movie + "/1/",
+			Recursive:    true,
+			WithVersions: true,
+		},
+	) {
+		require.NoError(obj.Err)
+		require.Failf("file not deleted", "%s", obj.Key)
+	}
+
+	// movie 10 files are kept
+	getOptions := &storage.GetOptions{
+		Bucket:     config.Config.MinIO.Bucket.Image.Name,
+		Category:   config.Config.MinIO.Category.Movie,
+		CategoryID: 10,
+		Filename:   config.Config.MinIO.Filename.Movie,
+	}
+	file, err := m.GetFile(ctx, getOptions)
+	require.NoError(err)
+	t.Cleanup(func() {
+		file.Close()
+	})
+
+	// deleting no files is fine
+	err = m.DeleteFiles(
+		ctx,
+		&storage.DeleteOptions{
+			Bucket:     config.Config.MinIO.Bucket.Image.Name,
+			Category:   config.Config.MinIO.Category.Movie,
+			CategoryID: 1,
+		},
+	)
+	require.NoError(err)
+}
+
+func TestDeleteFilesFilename(t *testing.T) {
+	require := require.New(t)
+
+	t.Cleanup(teardown)
+
+	m, err := storage.NewMinIO(client)
+	require.NoError(err)
+
+	ctx := context.Background()
+
+	// put two versions of the poster of movie 1 and a media file named after it
+	poster := config.Config.MinIO.Filename.Movie
+	media := poster + "-1"
+	putFile := func(filename string, content string) {
+		_, err := m.PutFile(
+			ctx,
+			strings.NewReader(content),
+			&storage.PutOptions{
+				Bucket:      config.Config.MinIO.Bucket.Image.Name,
+				Category:    config.Config.MinIO.Category.Movie,
+				CategoryID:  1,
+				Filename:    filename,
+				ContentType: "image/png",
+				Size:        int64(len(content)),
+			},
+		)
+		require.NoError(err)
+	}
+	putFile(poster, "poster")
+	putFile(poster, "new poster")
+	putFile(media, "media")
+
+	// delete the poster of movie 1
+	err = m.DeleteFiles(
+		ctx,
+		&storage.DeleteOptions{
+			Bucket:     config.Config.MinIO.Bucket.Image.Name,
+			Category:   config.Config.MinIO.Category.Movie,
+			CategoryID: 1,
+			Filename:   poster,
+		},
+	)
+	require.NoError(err)
+
+	// only the media file is left
+	var keys []string
+	for obj := range client.ListObjects(
+		ctx,
+		config.Config.MinIO.Bucket.Image.Name,
+		minio.ListObjectsOptions{
+			Prefix:       config.Config.MinIO.Category.Movie + "/1/",
+			Recursive:    true,
+			WithVersions: true,
+		},
+	) {
+		require.NoError(obj.Err)
+		keys = append(keys, obj.Key)
+	}
+	require.Equal(
+		[]string{config.Config.MinIO.Category.Movie + "/1/" + media},
+		keys,
+	)
+}
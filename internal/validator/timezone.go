@@ -0,0 +1,55 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var ErrInvalidTimezone = validation.NewError(
+	"validation_timezone_invalid",
+	"{{.timezone}} is not an IANA time zone",
+)
+
+type IsTimezoneRule struct {
+	err validation.Error
+}
+
+func IsTimezone() IsTimezoneRule {
+	return IsTimezoneRule{err: ErrInvalidTimezone}
+}
+
+func (r IsTimezoneRule) Error(message string) IsTimezoneRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+func (r IsTimezoneRule) ErrorObject(err validation.Error) IsTimezoneRule {
+	r.err = err
+	return r
+}
+
+func (r IsTimezoneRule) Validate(value any) error {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return nil
+	}
+
+	timezone, isString := value.(string)
+	if !isString {
+		return fmt.Errorf(
+			"timezone must be string but it's not: %v",
+			reflect.ValueOf(value).Kind(),
+		)
+	}
+
+	// "Local" is the time zone of the server and unknown to postgres
+	_, err := time.LoadLocation(timezone)
+	if err != nil || timezone == "Local" {
+		return r.err.SetParams(map[string]any{"timezone": timezone})
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package validator_test
+
+import (
+	"testing"
+
+	"github.com/aria3ppp/watchlist-server/internal/validator"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsTimezone(t *testing.T) {
+	testCases := []struct {
+		name     string
+		timezone string
+		err      bool
+	}{
+		{"tc1", "", false},
+		{"tc2", "UTC", false},
+		{"tc3", "Asia/Tehran", false},
+		{"tc4", "Local", true},
+		{"tc5", "Mars/Olympus_Mons", true},
+		{"tc6", "../etc/passwd", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+
+			r := validator.IsTimezone()
+
+			err := r.Validate(tc.timezone)
+			if tc.err {
+				expValidationError := validator.ErrInvalidTimezone.SetParams(
+					map[string]any{"timezone": tc.timezone},
+				)
+				require.Equal(expValidationError, err)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+}
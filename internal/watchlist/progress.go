@@ -0,0 +1,9 @@
+package watchlist
+
+import "github.com/aria3ppp/watchlist-server/internal/models"
+
+// sync `boil` tag whenever there's a change in model name
+type Progress struct {
+	models.PlaybackProgress `boil:"playback_progress,bind"`
+	Film                    models.Film `boil:"films,bind" json:"film"`
+}
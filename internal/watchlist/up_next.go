@@ -0,0 +1,20 @@
+package watchlist
+
+import (
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/volatiletech/null/v8"
+)
+
+// UpNext is the next episode to watch of a series with the progress of the
+// user on the series: NewEpisodesCount is the count of the episodes released
+// after the user had caught up and WatchID refers to the episode in the
+// watchlist if added.
+// sync `boil` tag whenever there's a change in model name
+type UpNext struct {
+	Series           models.Series `boil:"serieses,bind"      json:"series"`
+	Episode          models.Film   `boil:"films,bind"         json:"episode"`
+	EpisodesCount    int           `boil:"episodes_count"     json:"episodes_count"`
+	WatchedCount     int           `boil:"watched_count"      json:"watched_count"`
+	NewEpisodesCount int           `boil:"new_episodes_count" json:"new_episodes_count"`
+	WatchID          null.Int      `boil:"watch_id"           json:"watch_id,omitempty"`
+}
@@ -1,9 +1,27 @@
 package watchlist
 
-import "github.com/aria3ppp/watchlist-server/internal/models"
+import (
+	"github.com/aria3ppp/watchlist-server/internal/models"
+	"github.com/lib/pq"
+)
 
 // sync `boil` tag whenever there's a change in model name
 type Item struct {
 	models.Watchfilm `boil:"watchfilms,bind"`
-	Film             models.Film `boil:"films,bind"      json:"film"`
+	Film             models.Film    `boil:"films,bind"      json:"film"`
+	Tags             pq.StringArray `boil:"tags"            json:"tags"`
+	Tombstone        bool           `boil:"-"               json:"tombstone"`
+}
+
+// Bury replaces the film of the item by a tombstone if the film is hidden:
+// only the film id and its deletion time are kept
+func (item *Item) Bury() {
+	if !item.Film.DeletedAt.Valid {
+		return
+	}
+	item.Film = models.Film{
+		ID:        item.Film.ID,
+		DeletedAt: item.Film.DeletedAt,
+	}
+	item.Tombstone = true
 }
@@ -1,20 +1,43 @@
-SELECT  id,
-        title,
-        descriptions,
-        date_released,
-        duration,
-        poster,
-        contributed_by,
-        contributed_at,
-        invalidation
-FROM films
+SELECT  f.id,
+        f.title,
+        f.descriptions,
+        f.date_released,
+        f.duration,
+        f.poster,
+        f.contributed_by,
+        f.contributed_at,
+        f.invalidation,
+        f.deleted_at,
+        x.imdb AS external_id_imdb,
+        x.tmdb AS external_id_tmdb,
+        x.wikidata AS external_id_wikidata,
+        t.translations AS translations_json,
+        GREATEST(f.contributed_at, x.contributed_at, t.contributed_at) AS synced_at
+FROM films f
+LEFT JOIN LATERAL (
+    SELECT  MAX(external_id) FILTER (WHERE provider = 'imdb') AS imdb,
+            MAX(external_id) FILTER (WHERE provider = 'tmdb') AS tmdb,
+            MAX(external_id) FILTER (WHERE provider = 'wikidata') AS wikidata,
+            MAX(contributed_at) AS contributed_at
+    FROM external_ids
+    WHERE film_id = f.id
+) x ON TRUE
+LEFT JOIN LATERAL (
+    SELECT  jsonb_object_agg(
+                language,
+                jsonb_build_object('title', title, 'descriptions', descriptions)
+            )::text AS translations,
+            MAX(contributed_at) AS contributed_at
+    FROM translations
+    WHERE film_id = f.id
+) t ON TRUE
 WHERE 
-        contributed_at > ?
+        GREATEST(f.contributed_at, x.contributed_at, t.contributed_at) > ?
     AND
-        series_id IS NULL
+        f.series_id IS NULL
     AND
-        season_number IS NULL
+        f.season_number IS NULL
     AND
-        episode_number IS NULL
+        f.episode_number IS NULL
 ORDER BY
-    contributed_at ASC;
\ No newline at end of file
+    synced_at ASC;
\ No newline at end of file
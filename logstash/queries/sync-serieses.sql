@@ -1,14 +1,37 @@
-SELECT  id,
-        title,
-        descriptions,
-        date_started,
-        date_ended,
-        poster,
-        contributed_by,
-        contributed_at,
-        invalidation
-FROM serieses
+SELECT  s.id,
+        s.title,
+        s.descriptions,
+        s.date_started,
+        s.date_ended,
+        s.poster,
+        s.contributed_by,
+        s.contributed_at,
+        s.invalidation,
+        s.deleted_at,
+        x.imdb AS external_id_imdb,
+        x.tmdb AS external_id_tmdb,
+        x.wikidata AS external_id_wikidata,
+        t.translations AS translations_json,
+        GREATEST(s.contributed_at, x.contributed_at, t.contributed_at) AS synced_at
+FROM serieses s
+LEFT JOIN LATERAL (
+    SELECT  MAX(external_id) FILTER (WHERE provider = 'imdb') AS imdb,
+            MAX(external_id) FILTER (WHERE provider = 'tmdb') AS tmdb,
+            MAX(external_id) FILTER (WHERE provider = 'wikidata') AS wikidata,
+            MAX(contributed_at) AS contributed_at
+    FROM external_ids
+    WHERE series_id = s.id
+) x ON TRUE
+LEFT JOIN LATERAL (
+    SELECT  jsonb_object_agg(
+                language,
+                jsonb_build_object('title', title, 'descriptions', descriptions)
+            )::text AS translations,
+            MAX(contributed_at) AS contributed_at
+    FROM translations
+    WHERE series_id = s.id
+) t ON TRUE
 WHERE 
-    contributed_at > ?
+    GREATEST(s.contributed_at, x.contributed_at, t.contributed_at) > ?
 ORDER BY
-    contributed_at ASC;
\ No newline at end of file
+    synced_at ASC;
\ No newline at end of file
@@ -1,15 +1,19 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
+	"time"
 
 	"github.com/aria3ppp/watchlist-server/internal/app"
 	"github.com/aria3ppp/watchlist-server/internal/auth"
 	"github.com/aria3ppp/watchlist-server/internal/config"
 	"github.com/aria3ppp/watchlist-server/internal/hasher"
+	"github.com/aria3ppp/watchlist-server/internal/metadata"
 	"github.com/aria3ppp/watchlist-server/internal/repo"
 	"github.com/aria3ppp/watchlist-server/internal/search"
 	"github.com/aria3ppp/watchlist-server/internal/server"
@@ -101,14 +105,73 @@ func main() {
 		logger.Panic("failed initializing storage service", zap.Error(err))
 	}
 
+	metadataProvider := metadata.NewTMDb(
+		&http.Client{
+			Timeout: time.Second * time.Duration(
+				config.Config.Metadata.TimeoutInSeconds,
+			),
+		},
+		config.Config.Metadata.TMDb.BaseUrl,
+		config.Config.Metadata.TMDb.ImageBaseUrl,
+		config.Config.Metadata.TMDb.ApiKey,
+	)
+
 	application := app.NewApplication(
 		repository,
 		auth,
 		searchService,
 		hasher,
 		storageService,
+		metadataProvider,
 	)
 
+	// export command: export the catalog once and exit
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := runCatalogExport(application, logger); err != nil {
+			logger.Sync()
+			os.Exit(1)
+		}
+		return
+	}
+
+	// prune-audits command: prune the audits once and exit
+	if len(os.Args) > 1 && os.Args[1] == "prune-audits" {
+		dryRun := config.Config.AuditRetention.DryRun ||
+			(len(os.Args) > 2 && os.Args[2] == "--dry-run")
+		if err := runAuditPrune(application, logger, dryRun); err != nil {
+			logger.Sync()
+			os.Exit(1)
+		}
+		return
+	}
+
+	// moderator command: grant or revoke the moderator role and exit
+	if len(os.Args) > 1 && os.Args[1] == "moderator" {
+		if err := runSetModerator(application, logger, os.Args[2:]); err != nil {
+			logger.Sync()
+			os.Exit(1)
+		}
+		return
+	}
+
+	// fail the import jobs interrupted by the last shutdown
+	failed, err := application.ImportJobsFailUnfinished(context.Background())
+	if err != nil {
+		logger.Error("failed marking unfinished import jobs as failed", zap.Error(err))
+	} else if failed > 0 {
+		logger.Info("unfinished import jobs failed", zap.Int("jobs", failed))
+	}
+
+	// schedule catalog export
+	if config.Config.Export.IntervalInHours > 0 {
+		go scheduleCatalogExport(application, logger)
+	}
+
+	// schedule audit prune
+	if config.Config.AuditRetention.IntervalInHours > 0 {
+		go scheduleAuditPrune(application, logger)
+	}
+
 	server := server.NewServer(
 		application,
 		echo.New(),
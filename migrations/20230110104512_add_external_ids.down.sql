@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP FUNCTION IF EXISTS external_ids_function_triggers_on_update;
+DROP TABLE IF EXISTS external_ids_audit;
+DROP TABLE IF EXISTS external_ids;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,93 @@
+BEGIN;
+
+-- create external_ids table
+CREATE TABLE IF NOT EXISTS external_ids (
+    id SERIAL PRIMARY KEY,
+
+    film_id INT,
+    series_id INT,
+
+    provider VARCHAR(20) NOT NULL,
+    external_id VARCHAR(50) NOT NULL,
+
+    -- an external id belongs either to a film or to a series
+    CONSTRAINT external_ids_film_or_series_cnst
+        CHECK ((film_id IS NULL) <> (series_id IS NULL))
+
+    -- contributed_by INT NOT NULL,
+    -- contributed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+	-- invalidation VARCHAR(100)
+);
+
+call add_contribution_and_invalidation_columns(
+	p_table => 'external_ids',
+	p_contributer_table => 'users',
+	p_contributer_table_pk => 'id',
+	
+	p_column_contributed_by_name => 'contributed_by',
+	p_column_contributed_by_type => 'INT', -- nullable to allow delete user
+	p_column_contributed_by_fk_constraint_name => 'external_ids_contributed_by_fk_users',
+	p_column_contributed_by_fk_index_name => 'external_ids_idx_contributed_by',
+	
+	p_column_contributed_at_name => 'contributed_at',
+	p_column_contributed_at_type => 'TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP',
+	p_column_contributed_at_column_index_name => 'external_ids_idx_contributed_at',
+	
+	p_column_invalidation_name => 'invalidation',
+	p_column_invalidation_type => 'VARCHAR(100)'
+);
+
+call create_audit_table(
+	p_table => 'external_ids',
+	p_audit_table_name => 'external_ids_audit',
+
+	p_audit_table_index_name => 'external_ids_audit_idx_contributed_at',
+	p_audit_table_index_column_name => 'contributed_at',
+
+	p_audit_table_pk_columns_list_sep_by_comma => 'id, contributed_by, contributed_at'
+);
+
+call build_trigger_audit_on_update(
+	p_table => 'external_ids',
+	p_table_contributed_at_column => 'contributed_at',
+	p_audit_table_name => 'external_ids_audit',
+	p_trigger_name => 'external_ids_trigger_audit_on_update',
+	p_trigger_function_name => 'external_ids_function_triggers_on_update'
+);
+
+-- an external id identifies only one film and one series per provider: the
+-- films and the serieses of a provider may be numbered apart (tmdb movie and
+-- tv ids overlap)
+CREATE UNIQUE INDEX IF NOT EXISTS external_ids_unique_film_provider_external_id_idx
+	ON external_ids (provider, external_id) WHERE film_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS external_ids_unique_series_provider_external_id_idx
+	ON external_ids (provider, external_id) WHERE series_id IS NOT NULL;
+
+-- a record has at most one external id per provider
+-- these also index the film_id and series_id foreign keys
+ALTER TABLE IF EXISTS external_ids
+	ADD CONSTRAINT external_ids_unique_film_provider_cnst
+	UNIQUE (film_id, provider);
+ALTER TABLE IF EXISTS external_ids
+	ADD CONSTRAINT external_ids_unique_series_provider_cnst
+	UNIQUE (series_id, provider);
+
+-- add film_id foreign key constraint
+ALTER TABLE IF EXISTS external_ids
+    ADD CONSTRAINT external_ids_fk_films
+    FOREIGN KEY (film_id)
+    REFERENCES films(id)
+    ON DELETE CASCADE;
+
+-- add series_id foreign key constraint
+ALTER TABLE IF EXISTS external_ids
+    ADD CONSTRAINT external_ids_fk_serieses
+    FOREIGN KEY (series_id)
+    REFERENCES serieses(id)
+    ON DELETE CASCADE;
+
+-- create index on film_id and series_id audits lookup
+CREATE INDEX IF NOT EXISTS external_ids_audit_idx_film_id ON external_ids_audit (film_id);
+CREATE INDEX IF NOT EXISTS external_ids_audit_idx_series_id ON external_ids_audit (series_id);
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS import_errors;
+DROP TABLE IF EXISTS import_jobs;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,51 @@
+BEGIN;
+
+-- create import_jobs table
+CREATE TABLE IF NOT EXISTS import_jobs (
+    id SERIAL PRIMARY KEY,
+
+    user_id INT NOT NULL,
+
+    format VARCHAR(10) NOT NULL,
+    dry_run BOOLEAN NOT NULL DEFAULT FALSE,
+    status VARCHAR(10) NOT NULL DEFAULT 'pending',
+
+    total_rows INT NOT NULL DEFAULT 0,
+    processed_rows INT NOT NULL DEFAULT 0,
+    failed_rows INT NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    finished_at TIMESTAMPTZ
+);
+
+-- add user_id foreign key constraint
+ALTER TABLE IF EXISTS import_jobs
+    ADD CONSTRAINT import_jobs_fk_users
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE;
+
+-- create index on user_id fk
+CREATE INDEX IF NOT EXISTS import_jobs_idx_user_id ON import_jobs (user_id);
+
+-- create import_errors table
+CREATE TABLE IF NOT EXISTS import_errors (
+    id SERIAL PRIMARY KEY,
+
+    job_id INT NOT NULL,
+
+    row_number INT NOT NULL,
+    message TEXT NOT NULL
+);
+
+-- add job_id foreign key constraint
+ALTER TABLE IF EXISTS import_errors
+    ADD CONSTRAINT import_errors_fk_import_jobs
+    FOREIGN KEY (job_id)
+    REFERENCES import_jobs(id)
+    ON DELETE CASCADE;
+
+-- create index on job_id fk and row_number
+CREATE INDEX IF NOT EXISTS import_errors_idx_job_id_row_number ON import_errors (job_id, row_number);
+
+COMMIT;
\ No newline at end of file
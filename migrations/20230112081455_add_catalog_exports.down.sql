@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS catalog_exports;
+
+COMMIT;
\ No newline at end of file
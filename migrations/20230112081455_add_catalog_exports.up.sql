@@ -0,0 +1,16 @@
+BEGIN;
+
+-- create catalog_exports table
+CREATE TABLE IF NOT EXISTS catalog_exports (
+    id SERIAL PRIMARY KEY,
+
+    status VARCHAR(10) NOT NULL DEFAULT 'running',
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    finished_at TIMESTAMPTZ
+);
+
+-- create index on status to lookup the latest succeeded export
+CREATE INDEX IF NOT EXISTS catalog_exports_idx_status_id ON catalog_exports (status, id);
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP FUNCTION IF EXISTS translations_function_triggers_on_update;
+DROP TABLE IF EXISTS translations_audit;
+DROP TABLE IF EXISTS translations;
+
+ALTER TABLE IF EXISTS users DROP COLUMN IF EXISTS locale;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,90 @@
+BEGIN;
+
+-- add users preferred language as a BCP-47 language tag
+ALTER TABLE IF EXISTS users
+	ADD COLUMN IF NOT EXISTS locale VARCHAR(35);
+
+-- create translations table
+CREATE TABLE IF NOT EXISTS translations (
+    id SERIAL PRIMARY KEY,
+
+    film_id INT,
+    series_id INT,
+
+    language VARCHAR(35) NOT NULL, -- BCP-47 language tag
+    title VARCHAR(100) NOT NULL,
+    descriptions VARCHAR(500),
+
+    -- a translation belongs either to a film or to a series
+    CONSTRAINT translations_film_or_series_cnst
+        CHECK ((film_id IS NULL) <> (series_id IS NULL))
+
+    -- contributed_by INT NOT NULL,
+    -- contributed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+	-- invalidation VARCHAR(100)
+);
+
+call add_contribution_and_invalidation_columns(
+	p_table => 'translations',
+	p_contributer_table => 'users',
+	p_contributer_table_pk => 'id',
+	
+	p_column_contributed_by_name => 'contributed_by',
+	p_column_contributed_by_type => 'INT', -- nullable to allow delete user
+	p_column_contributed_by_fk_constraint_name => 'translations_contributed_by_fk_users',
+	p_column_contributed_by_fk_index_name => 'translations_idx_contributed_by',
+	
+	p_column_contributed_at_name => 'contributed_at',
+	p_column_contributed_at_type => 'TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP',
+	p_column_contributed_at_column_index_name => 'translations_idx_contributed_at',
+	
+	p_column_invalidation_name => 'invalidation',
+	p_column_invalidation_type => 'VARCHAR(100)'
+);
+
+call create_audit_table(
+	p_table => 'translations',
+	p_audit_table_name => 'translations_audit',
+
+	p_audit_table_index_name => 'translations_audit_idx_contributed_at',
+	p_audit_table_index_column_name => 'contributed_at',
+
+	p_audit_table_pk_columns_list_sep_by_comma => 'id, contributed_by, contributed_at'
+);
+
+call build_trigger_audit_on_update(
+	p_table => 'translations',
+	p_table_contributed_at_column => 'contributed_at',
+	p_audit_table_name => 'translations_audit',
+	p_trigger_name => 'translations_trigger_audit_on_update',
+	p_trigger_function_name => 'translations_function_triggers_on_update'
+);
+
+-- a record has at most one translation per language
+-- these also index the film_id and series_id foreign keys
+ALTER TABLE IF EXISTS translations
+	ADD CONSTRAINT translations_unique_film_language_cnst
+	UNIQUE (film_id, language);
+ALTER TABLE IF EXISTS translations
+	ADD CONSTRAINT translations_unique_series_language_cnst
+	UNIQUE (series_id, language);
+
+-- add film_id foreign key constraint
+ALTER TABLE IF EXISTS translations
+    ADD CONSTRAINT translations_fk_films
+    FOREIGN KEY (film_id)
+    REFERENCES films(id)
+    ON DELETE CASCADE;
+
+-- add series_id foreign key constraint
+ALTER TABLE IF EXISTS translations
+    ADD CONSTRAINT translations_fk_serieses
+    FOREIGN KEY (series_id)
+    REFERENCES serieses(id)
+    ON DELETE CASCADE;
+
+-- create index on film_id and series_id audits lookup
+CREATE INDEX IF NOT EXISTS translations_audit_idx_film_id ON translations_audit (film_id);
+CREATE INDEX IF NOT EXISTS translations_audit_idx_series_id ON translations_audit (series_id);
+
+COMMIT;
\ No newline at end of file
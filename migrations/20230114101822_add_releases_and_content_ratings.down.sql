@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP FUNCTION IF EXISTS content_ratings_function_triggers_on_update;
+DROP TABLE IF EXISTS content_ratings_audit;
+DROP TABLE IF EXISTS content_ratings;
+
+DROP FUNCTION IF EXISTS releases_function_triggers_on_update;
+DROP TABLE IF EXISTS releases_audit;
+DROP TABLE IF EXISTS releases;
+
+COMMIT;
\ No newline at end of file
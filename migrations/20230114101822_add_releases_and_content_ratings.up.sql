@@ -0,0 +1,147 @@
+BEGIN;
+
+-- create releases table
+CREATE TABLE IF NOT EXISTS releases (
+    id SERIAL PRIMARY KEY,
+
+    film_id INT NOT NULL,
+
+    region VARCHAR(2) NOT NULL, -- ISO 3166-1 alpha-2 country code
+    release_type VARCHAR(20) NOT NULL,
+    date_released DATE NOT NULL,
+
+    CONSTRAINT releases_release_type_cnst
+        CHECK (release_type IN ('theatrical', 'streaming', 'physical'))
+
+    -- contributed_by INT NOT NULL,
+    -- contributed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+	-- invalidation VARCHAR(100)
+);
+
+call add_contribution_and_invalidation_columns(
+	p_table => 'releases',
+	p_contributer_table => 'users',
+	p_contributer_table_pk => 'id',
+	
+	p_column_contributed_by_name => 'contributed_by',
+	p_column_contributed_by_type => 'INT', -- nullable to allow delete user
+	p_column_contributed_by_fk_constraint_name => 'releases_contributed_by_fk_users',
+	p_column_contributed_by_fk_index_name => 'releases_idx_contributed_by',
+	
+	p_column_contributed_at_name => 'contributed_at',
+	p_column_contributed_at_type => 'TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP',
+	p_column_contributed_at_column_index_name => 'releases_idx_contributed_at',
+	
+	p_column_invalidation_name => 'invalidation',
+	p_column_invalidation_type => 'VARCHAR(100)'
+);
+
+call create_audit_table(
+	p_table => 'releases',
+	p_audit_table_name => 'releases_audit',
+
+	p_audit_table_index_name => 'releases_audit_idx_contributed_at',
+	p_audit_table_index_column_name => 'contributed_at',
+
+	p_audit_table_pk_columns_list_sep_by_comma => 'id, contributed_by, contributed_at'
+);
+
+call build_trigger_audit_on_update(
+	p_table => 'releases',
+	p_table_contributed_at_column => 'contributed_at',
+	p_audit_table_name => 'releases_audit',
+	p_trigger_name => 'releases_trigger_audit_on_update',
+	p_trigger_function_name => 'releases_function_triggers_on_update'
+);
+
+-- a film has at most one release per region and release type
+-- this also indexes the film_id foreign key
+ALTER TABLE IF EXISTS releases
+	ADD CONSTRAINT releases_unique_film_region_type_cnst
+	UNIQUE (film_id, region, release_type);
+
+-- add film_id foreign key constraint
+ALTER TABLE IF EXISTS releases
+    ADD CONSTRAINT releases_fk_films
+    FOREIGN KEY (film_id)
+    REFERENCES films(id)
+    ON DELETE CASCADE;
+
+-- create index on region release dates lookup
+CREATE INDEX IF NOT EXISTS releases_idx_region_date_released ON releases (region, date_released);
+
+-- create index on film_id audits lookup
+CREATE INDEX IF NOT EXISTS releases_audit_idx_film_id ON releases_audit (film_id);
+
+-- create content_ratings table
+CREATE TABLE IF NOT EXISTS content_ratings (
+    id SERIAL PRIMARY KEY,
+
+    film_id INT NOT NULL,
+
+    region VARCHAR(2) NOT NULL, -- ISO 3166-1 alpha-2 country code
+    rating_system VARCHAR(20) NOT NULL,
+    rating VARCHAR(10) NOT NULL,
+    min_age SMALLINT NOT NULL, -- minimum viewer age implied by the rating
+
+    CONSTRAINT content_ratings_min_age_cnst
+        CHECK (min_age >= 0)
+
+    -- contributed_by INT NOT NULL,
+    -- contributed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+	-- invalidation VARCHAR(100)
+);
+
+call add_contribution_and_invalidation_columns(
+	p_table => 'content_ratings',
+	p_contributer_table => 'users',
+	p_contributer_table_pk => 'id',
+	
+	p_column_contributed_by_name => 'contributed_by',
+	p_column_contributed_by_type => 'INT', -- nullable to allow delete user
+	p_column_contributed_by_fk_constraint_name => 'content_ratings_contributed_by_fk_users',
+	p_column_contributed_by_fk_index_name => 'content_ratings_idx_contributed_by',
+	
+	p_column_contributed_at_name => 'contributed_at',
+	p_column_contributed_at_type => 'TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP',
+	p_column_contributed_at_column_index_name => 'content_ratings_idx_contributed_at',
+	
+	p_column_invalidation_name => 'invalidation',
+	p_column_invalidation_type => 'VARCHAR(100)'
+);
+
+call create_audit_table(
+	p_table => 'content_ratings',
+	p_audit_table_name => 'content_ratings_audit',
+
+	p_audit_table_index_name => 'content_ratings_audit_idx_contributed_at',
+	p_audit_table_index_column_name => 'contributed_at',
+
+	p_audit_table_pk_columns_list_sep_by_comma => 'id, contributed_by, contributed_at'
+);
+
+call build_trigger_audit_on_update(
+	p_table => 'content_ratings',
+	p_table_contributed_at_column => 'contributed_at',
+	p_audit_table_name => 'content_ratings_audit',
+	p_trigger_name => 'content_ratings_trigger_audit_on_update',
+	p_trigger_function_name => 'content_ratings_function_triggers_on_update'
+);
+
+-- a film has at most one content rating per region
+-- this also indexes the film_id foreign key
+ALTER TABLE IF EXISTS content_ratings
+	ADD CONSTRAINT content_ratings_unique_film_region_cnst
+	UNIQUE (film_id, region);
+
+-- add film_id foreign key constraint
+ALTER TABLE IF EXISTS content_ratings
+    ADD CONSTRAINT content_ratings_fk_films
+    FOREIGN KEY (film_id)
+    REFERENCES films(id)
+    ON DELETE CASCADE;
+
+-- create index on film_id audits lookup
+CREATE INDEX IF NOT EXISTS content_ratings_audit_idx_film_id ON content_ratings_audit (film_id);
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,24 @@
+BEGIN;
+
+ALTER TABLE IF EXISTS films
+	DROP CONSTRAINT IF EXISTS films_unique_absolute_number_cnst;
+
+ALTER TABLE IF EXISTS films
+	DROP CONSTRAINT IF EXISTS films_unique_episode_cnst;
+
+ALTER TABLE IF EXISTS films
+	ADD CONSTRAINT films_unique_episode_cnst
+	UNIQUE (series_id, season_number, episode_number);
+
+ALTER TABLE IF EXISTS films
+	DROP CONSTRAINT IF EXISTS films_episode_numbers_cnst;
+
+ALTER TABLE IF EXISTS films_audit
+	DROP COLUMN IF EXISTS part_number,
+	DROP COLUMN IF EXISTS absolute_number;
+
+ALTER TABLE IF EXISTS films
+	DROP COLUMN IF EXISTS part_number,
+	DROP COLUMN IF EXISTS absolute_number;
+
+COMMIT;
\ No newline at end of file
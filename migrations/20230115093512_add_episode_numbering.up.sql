@@ -0,0 +1,40 @@
+BEGIN;
+
+-- absolute episode number across the series and part number of multi-part
+-- episodes. the audit table must have the same columns in the same order
+-- since the audit trigger inserts OLD.*
+ALTER TABLE IF EXISTS films
+	ADD COLUMN IF NOT EXISTS absolute_number INT,
+	ADD COLUMN IF NOT EXISTS part_number INT;
+
+ALTER TABLE IF EXISTS films_audit
+	ADD COLUMN IF NOT EXISTS absolute_number INT,
+	ADD COLUMN IF NOT EXISTS part_number INT;
+
+-- season 0 holds specials
+ALTER TABLE IF EXISTS films
+	ADD CONSTRAINT films_episode_numbers_cnst
+	CHECK (
+		(season_number IS NULL OR season_number >= 0)
+		AND (episode_number IS NULL OR episode_number >= 1)
+		AND (absolute_number IS NULL OR (series_id IS NOT NULL AND absolute_number >= 1))
+		AND (part_number IS NULL OR (series_id IS NOT NULL AND part_number >= 1))
+	);
+
+-- make the unique episode constraint deferrable so renumbering episodes could
+-- swap their numbers in a transaction
+ALTER TABLE IF EXISTS films
+	DROP CONSTRAINT IF EXISTS films_unique_episode_cnst;
+
+ALTER TABLE IF EXISTS films
+	ADD CONSTRAINT films_unique_episode_cnst
+	UNIQUE (series_id, season_number, episode_number)
+	DEFERRABLE INITIALLY IMMEDIATE;
+
+-- unique absolute episodes
+ALTER TABLE IF EXISTS films
+	ADD CONSTRAINT films_unique_absolute_number_cnst
+	UNIQUE (series_id, absolute_number)
+	DEFERRABLE INITIALLY IMMEDIATE;
+
+COMMIT;
\ No newline at end of file
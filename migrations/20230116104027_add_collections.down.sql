@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP FUNCTION IF EXISTS collection_items_function_triggers_on_update;
+DROP TABLE IF EXISTS collection_items_audit;
+DROP TABLE IF EXISTS collection_items;
+
+DROP FUNCTION IF EXISTS collections_function_triggers_on_update;
+DROP TABLE IF EXISTS collections_audit;
+DROP TABLE IF EXISTS collections;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,156 @@
+BEGIN;
+
+-- create collections table
+CREATE TABLE IF NOT EXISTS collections (
+    id SERIAL PRIMARY KEY,
+    title VARCHAR(100) NOT NULL,
+    descriptions VARCHAR(500)
+
+    -- contributed_by INT NOT NULL,
+    -- contributed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+	-- invalidation VARCHAR(100)
+);
+
+call add_contribution_and_invalidation_columns(
+	p_table => 'collections',
+	p_contributer_table => 'users',
+	p_contributer_table_pk => 'id',
+	
+	p_column_contributed_by_name => 'contributed_by',
+	p_column_contributed_by_type => 'INT', -- nullable to allow delete user
+	p_column_contributed_by_fk_constraint_name => 'collections_contributed_by_fk_users',
+	p_column_contributed_by_fk_index_name => 'collections_idx_contributed_by',
+	
+	p_column_contributed_at_name => 'contributed_at',
+	p_column_contributed_at_type => 'TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP',
+	p_column_contributed_at_column_index_name => 'collections_idx_contributed_at',
+	
+	p_column_invalidation_name => 'invalidation',
+	p_column_invalidation_type => 'VARCHAR(100)'
+);
+
+call create_audit_table(
+	p_table => 'collections',
+	p_audit_table_name => 'collections_audit',
+
+	p_audit_table_index_name => 'collections_audit_idx_contributed_at',
+	p_audit_table_index_column_name => 'contributed_at',
+
+	p_audit_table_pk_columns_list_sep_by_comma => 'id, contributed_by, contributed_at'
+);
+
+call build_trigger_audit_on_update(
+	p_table => 'collections',
+	p_table_contributed_at_column => 'contributed_at',
+	p_audit_table_name => 'collections_audit',
+	p_trigger_name => 'collections_trigger_audit_on_update',
+	p_trigger_function_name => 'collections_function_triggers_on_update'
+);
+
+-- create index on title
+CREATE INDEX IF NOT EXISTS collections_idx_title ON collections (title);
+
+-- create collection_items table
+-- an item is either a movie or a series at a position of the collection
+CREATE TABLE IF NOT EXISTS collection_items (
+    id SERIAL PRIMARY KEY,
+
+    collection_id INT NOT NULL,
+    position INT NOT NULL,
+
+    film_id INT,
+    series_id INT,
+
+    CONSTRAINT collection_items_position_cnst
+        CHECK (position >= 1),
+    CONSTRAINT collection_items_film_or_series_cnst
+        CHECK (num_nonnulls(film_id, series_id) = 1)
+
+    -- contributed_by INT NOT NULL,
+    -- contributed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+	-- invalidation VARCHAR(100)
+);
+
+call add_contribution_and_invalidation_columns(
+	p_table => 'collection_items',
+	p_contributer_table => 'users',
+	p_contributer_table_pk => 'id',
+	
+	p_column_contributed_by_name => 'contributed_by',
+	p_column_contributed_by_type => 'INT', -- nullable to allow delete user
+	p_column_contributed_by_fk_constraint_name => 'collection_items_contributed_by_fk_users',
+	p_column_contributed_by_fk_index_name => 'collection_items_idx_contributed_by',
+	
+	p_column_contributed_at_name => 'contributed_at',
+	p_column_contributed_at_type => 'TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP',
+	p_column_contributed_at_column_index_name => 'collection_items_idx_contributed_at',
+	
+	p_column_invalidation_name => 'invalidation',
+	p_column_invalidation_type => 'VARCHAR(100)'
+);
+
+call create_audit_table(
+	p_table => 'collection_items',
+	p_audit_table_name => 'collection_items_audit',
+
+	p_audit_table_index_name => 'collection_items_audit_idx_contributed_at',
+	p_audit_table_index_column_name => 'contributed_at',
+
+	p_audit_table_pk_columns_list_sep_by_comma => 'id, contributed_by, contributed_at'
+);
+
+call build_trigger_audit_on_update(
+	p_table => 'collection_items',
+	p_table_contributed_at_column => 'contributed_at',
+	p_audit_table_name => 'collection_items_audit',
+	p_trigger_name => 'collection_items_trigger_audit_on_update',
+	p_trigger_function_name => 'collection_items_function_triggers_on_update'
+);
+
+-- a position of a collection holds one item
+-- this also indexes the collection_id foreign key
+ALTER TABLE IF EXISTS collection_items
+	ADD CONSTRAINT collection_items_unique_position_cnst
+	UNIQUE (collection_id, position);
+
+-- a movie or series is a member of a collection once. deferrable as
+-- reordering items moves members between positions
+ALTER TABLE IF EXISTS collection_items
+	ADD CONSTRAINT collection_items_unique_film_cnst
+	UNIQUE (collection_id, film_id)
+	DEFERRABLE INITIALLY DEFERRED;
+
+ALTER TABLE IF EXISTS collection_items
+	ADD CONSTRAINT collection_items_unique_series_cnst
+	UNIQUE (collection_id, series_id)
+	DEFERRABLE INITIALLY DEFERRED;
+
+-- add collection_id foreign key constraint
+ALTER TABLE IF EXISTS collection_items
+    ADD CONSTRAINT collection_items_fk_collections
+    FOREIGN KEY (collection_id)
+    REFERENCES collections(id)
+    ON DELETE CASCADE;
+
+-- add film_id foreign key constraint
+ALTER TABLE IF EXISTS collection_items
+    ADD CONSTRAINT collection_items_fk_films
+    FOREIGN KEY (film_id)
+    REFERENCES films(id)
+    ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS collection_items_idx_film_id ON collection_items (film_id);
+
+-- add series_id foreign key constraint
+ALTER TABLE IF EXISTS collection_items
+    ADD CONSTRAINT collection_items_fk_serieses
+    FOREIGN KEY (series_id)
+    REFERENCES serieses(id)
+    ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS collection_items_idx_series_id ON collection_items (series_id);
+
+-- create index on collection_id audits lookup
+CREATE INDEX IF NOT EXISTS collection_items_audit_idx_collection_id ON collection_items_audit (collection_id);
+
+COMMIT;
\ No newline at end of file
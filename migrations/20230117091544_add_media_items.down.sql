@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP FUNCTION IF EXISTS media_items_function_triggers_on_update;
+DROP TABLE IF EXISTS media_items_audit;
+DROP TABLE IF EXISTS media_items;
+
+COMMIT;
\ No newline at end of file
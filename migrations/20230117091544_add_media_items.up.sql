@@ -0,0 +1,99 @@
+BEGIN;
+
+-- create media_items table
+-- a media item is an image uploaded to storage or a trailer link of a film or
+-- a series. removed items are kept to be referenced by audit history. there are
+-- no artists in the schema so artists own no media
+CREATE TABLE IF NOT EXISTS media_items (
+    id SERIAL PRIMARY KEY,
+
+    film_id INT,
+    series_id INT,
+
+    kind VARCHAR(20) NOT NULL,
+    uri VARCHAR(500) NOT NULL,
+    position INT NOT NULL,
+    is_primary BOOLEAN NOT NULL DEFAULT FALSE,
+    removed_at TIMESTAMPTZ,
+
+    -- a media item belongs either to a film or to a series
+    CONSTRAINT media_items_film_or_series_cnst
+        CHECK ((film_id IS NULL) <> (series_id IS NULL)),
+    CONSTRAINT media_items_kind_cnst
+        CHECK (kind IN ('poster', 'backdrop', 'still', 'trailer')),
+    CONSTRAINT media_items_position_cnst
+        CHECK (position >= 1),
+    -- a removed media item is not primary
+    CONSTRAINT media_items_removed_not_primary_cnst
+        CHECK (removed_at IS NULL OR NOT is_primary)
+
+    -- contributed_by INT NOT NULL,
+    -- contributed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+	-- invalidation VARCHAR(100)
+);
+
+call add_contribution_and_invalidation_columns(
+	p_table => 'media_items',
+	p_contributer_table => 'users',
+	p_contributer_table_pk => 'id',
+	
+	p_column_contributed_by_name => 'contributed_by',
+	p_column_contributed_by_type => 'INT', -- nullable to allow delete user
+	p_column_contributed_by_fk_constraint_name => 'media_items_contributed_by_fk_users',
+	p_column_contributed_by_fk_index_name => 'media_items_idx_contributed_by',
+	
+	p_column_contributed_at_name => 'contributed_at',
+	p_column_contributed_at_type => 'TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP',
+	p_column_contributed_at_column_index_name => 'media_items_idx_contributed_at',
+	
+	p_column_invalidation_name => 'invalidation',
+	p_column_invalidation_type => 'VARCHAR(100)'
+);
+
+call create_audit_table(
+	p_table => 'media_items',
+	p_audit_table_name => 'media_items_audit',
+
+	p_audit_table_index_name => 'media_items_audit_idx_contributed_at',
+	p_audit_table_index_column_name => 'contributed_at',
+
+	p_audit_table_pk_columns_list_sep_by_comma => 'id, contributed_by, contributed_at'
+);
+
+call build_trigger_audit_on_update(
+	p_table => 'media_items',
+	p_table_contributed_at_column => 'contributed_at',
+	p_audit_table_name => 'media_items_audit',
+	p_trigger_name => 'media_items_trigger_audit_on_update',
+	p_trigger_function_name => 'media_items_function_triggers_on_update'
+);
+
+-- a record has at most one primary media item per kind
+CREATE UNIQUE INDEX IF NOT EXISTS media_items_unique_film_primary_idx
+	ON media_items (film_id, kind) WHERE is_primary;
+CREATE UNIQUE INDEX IF NOT EXISTS media_items_unique_series_primary_idx
+	ON media_items (series_id, kind) WHERE is_primary;
+
+-- add film_id foreign key constraint
+ALTER TABLE IF EXISTS media_items
+    ADD CONSTRAINT media_items_fk_films
+    FOREIGN KEY (film_id)
+    REFERENCES films(id)
+    ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS media_items_idx_film_id ON media_items (film_id);
+
+-- add series_id foreign key constraint
+ALTER TABLE IF EXISTS media_items
+    ADD CONSTRAINT media_items_fk_serieses
+    FOREIGN KEY (series_id)
+    REFERENCES serieses(id)
+    ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS media_items_idx_series_id ON media_items (series_id);
+
+-- create index on film_id and series_id audits lookup
+CREATE INDEX IF NOT EXISTS media_items_audit_idx_film_id ON media_items_audit (film_id);
+CREATE INDEX IF NOT EXISTS media_items_audit_idx_series_id ON media_items_audit (series_id);
+
+COMMIT;
\ No newline at end of file
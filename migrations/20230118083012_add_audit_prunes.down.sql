@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS audit_prunes;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,18 @@
+BEGIN;
+
+-- create audit_prunes table
+CREATE TABLE IF NOT EXISTS audit_prunes (
+    id SERIAL PRIMARY KEY,
+
+    status VARCHAR(10) NOT NULL DEFAULT 'running',
+    dry_run BOOLEAN NOT NULL DEFAULT FALSE,
+
+    -- audits deleted (or would be deleted on a dry run) and audits archived
+    pruned_rows INT NOT NULL DEFAULT 0,
+    archived_rows INT NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    finished_at TIMESTAMPTZ
+);
+
+COMMIT;
\ No newline at end of file
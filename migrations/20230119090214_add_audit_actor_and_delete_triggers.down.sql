@@ -0,0 +1,154 @@
+BEGIN;
+
+-- drop users and watchfilms audits
+DROP TRIGGER IF EXISTS users_trigger_audit_on_update ON users;
+DROP TRIGGER IF EXISTS users_trigger_audit_on_delete ON users;
+DROP FUNCTION IF EXISTS users_function_triggers_on_update;
+DROP FUNCTION IF EXISTS users_function_triggers_on_delete;
+DROP TABLE IF EXISTS users_audit;
+
+DROP TRIGGER IF EXISTS watchfilms_trigger_audit_on_update ON watchfilms;
+DROP TRIGGER IF EXISTS watchfilms_trigger_audit_on_delete ON watchfilms;
+DROP FUNCTION IF EXISTS watchfilms_function_triggers_on_update;
+DROP FUNCTION IF EXISTS watchfilms_function_triggers_on_delete;
+DROP TABLE IF EXISTS watchfilms_audit;
+
+-- drop the new procedures
+DROP PROCEDURE IF EXISTS build_trigger_audit_on_delete;
+DROP PROCEDURE IF EXISTS build_trigger_audit_on_update;
+DROP PROCEDURE IF EXISTS create_audit_table;
+
+-- recreate the previous procedures
+create or replace procedure create_audit_table(
+	p_table text,
+	p_audit_table_name text,
+	p_audit_table_index_name text,
+	p_audit_table_index_column_name text,
+	p_audit_table_pk_columns_list_sep_by_comma text
+)
+language plpgsql
+as $$
+declare
+	v_row RECORD;
+    v_CREATE_AUDIT_TABLE_BODY TEXT;
+	v_CREATE_AUDIT_TABLE_CMD TEXT;
+begin
+	perform from information_schema.tables
+	where table_name = p_table and table_type = 'BASE TABLE';
+	
+	if not found then
+		raise exception 'table name "%" not found', p_table;
+	end if;
+
+    v_CREATE_AUDIT_TABLE_BODY = '';
+	
+	for v_row in
+		select column_name, data_type, is_nullable
+		from information_schema.columns
+		where table_name = p_table
+		order by ordinal_position
+	loop
+	
+		v_CREATE_AUDIT_TABLE_BODY = v_CREATE_AUDIT_TABLE_BODY || quote_ident(v_row.column_name) || ' ' || v_row.data_type;
+		
+		if v_row.is_nullable = 'NO' then
+			v_CREATE_AUDIT_TABLE_BODY = v_CREATE_AUDIT_TABLE_BODY || ' NOT NULL';
+		end if;
+
+		v_CREATE_AUDIT_TABLE_BODY = v_CREATE_AUDIT_TABLE_BODY || ', ';
+		
+	end loop;
+
+	-- set primary key
+	v_CREATE_AUDIT_TABLE_BODY = v_CREATE_AUDIT_TABLE_BODY || 'PRIMARY KEY (' || p_audit_table_pk_columns_list_sep_by_comma || ')';
+    
+	-- build create audit table command
+	v_CREATE_AUDIT_TABLE_CMD = 'CREATE TABLE IF NOT EXISTS ' || quote_ident(p_audit_table_name) || ' (' || v_CREATE_AUDIT_TABLE_BODY || ')';
+		
+	-- create the audit table
+	execute v_CREATE_AUDIT_TABLE_CMD;
+
+	-- create contributed_at index on audit table
+	execute 'CREATE INDEX ' || quote_ident(p_audit_table_index_name) || ' ON ' || quote_ident(p_audit_table_name) || ' (' || quote_ident(p_audit_table_index_column_name) || ')';
+	
+end;
+$$;
+
+create or replace procedure build_trigger_audit_on_update(
+	p_table text,
+	p_table_contributed_at_column text,
+	p_audit_table_name text,
+	p_trigger_name text,
+	p_trigger_function_name text
+)
+language plpgsql
+as $body$
+declare
+	v_trigger_func_body text;
+	v_trigger_func_cmd text;
+	v_create_trigger_on_table_cmd text;
+begin
+	-- build trigger function
+	v_trigger_func_body = 'BEGIN '
+			|| 'INSERT INTO ' || quote_ident(p_audit_table_name) || ' SELECT OLD.*; '
+			|| 'NEW.' || p_table_contributed_at_column || ' = CURRENT_TIMESTAMP; '
+			|| 'RETURN NEW; '
+			|| 'END;';
+	
+	v_trigger_func_cmd = 'CREATE OR REPLACE FUNCTION ' || p_trigger_function_name || '() RETURNS TRIGGER ' 
+						|| 'LANGUAGE plpgsql AS $$ ' || v_trigger_func_body || ' $$';
+	
+	-- create trigger function
+	execute v_trigger_func_cmd;
+	
+	-- build trigger on table
+	v_create_trigger_on_table_cmd = 'CREATE TRIGGER ' || p_trigger_name || ' '
+									|| 'BEFORE UPDATE ON ' || p_table || ' '
+									|| 'FOR EACH ROW EXECUTE FUNCTION ' || p_trigger_function_name || '()';
+	
+	-- create trigger on table
+	execute v_create_trigger_on_table_cmd;
+	
+end;
+$body$;
+
+-- drop the delete triggers and the audit action and actor columns and rebuild
+-- the previous update triggers
+DO $$
+declare
+	v_table text;
+begin
+	foreach v_table in array array[
+		'serieses',
+		'films',
+		'external_ids',
+		'translations',
+		'releases',
+		'content_ratings',
+		'collections',
+		'collection_items',
+		'media_items'
+	]
+	loop
+		execute 'DROP TRIGGER IF EXISTS ' || quote_ident(v_table || '_trigger_audit_on_delete') || ' ON ' || quote_ident(v_table);
+		execute 'DROP FUNCTION IF EXISTS ' || quote_ident(v_table || '_function_triggers_on_delete');
+
+		execute 'DROP INDEX IF EXISTS ' || quote_ident(v_table || '_audit_idx_audit_actor');
+		execute 'ALTER TABLE IF EXISTS ' || quote_ident(v_table || '_audit') || ' '
+			|| 'DROP COLUMN IF EXISTS audit_action, '
+			|| 'DROP COLUMN IF EXISTS audit_actor';
+
+		execute 'DROP TRIGGER IF EXISTS ' || quote_ident(v_table || '_trigger_audit_on_update') || ' ON ' || quote_ident(v_table);
+
+		call build_trigger_audit_on_update(
+			p_table => v_table,
+			p_table_contributed_at_column => 'contributed_at',
+			p_audit_table_name => v_table || '_audit',
+			p_trigger_name => v_table || '_trigger_audit_on_update',
+			p_trigger_function_name => v_table || '_function_triggers_on_update'
+		);
+	end loop;
+end;
+$$;
+
+COMMIT;
\ No newline at end of file
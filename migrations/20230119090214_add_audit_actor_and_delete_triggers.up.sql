@@ -0,0 +1,305 @@
+BEGIN;
+
+-- the acting user of a transaction is set by the application as the
+-- transaction local setting "watchlist.actor_id" and every audit records the
+-- action (update or delete) and its actor
+
+-- create an audit table with a primary key index on specified columns and
+-- optional extra columns
+DROP PROCEDURE IF EXISTS create_audit_table(text, text, text, text, text);
+
+create or replace procedure create_audit_table(
+	p_table text,
+	p_audit_table_name text,
+	p_audit_table_index_name text,
+	p_audit_table_index_column_name text,
+	p_audit_table_pk_columns_list_sep_by_comma text,
+	p_audit_table_extra_columns text default null
+)
+language plpgsql
+as $$
+declare
+	v_row RECORD;
+    v_CREATE_AUDIT_TABLE_BODY TEXT;
+	v_CREATE_AUDIT_TABLE_CMD TEXT;
+begin
+	perform from information_schema.tables
+	where table_name = p_table and table_type = 'BASE TABLE';
+	
+	if not found then
+		raise exception 'table name "%" not found', p_table;
+	end if;
+
+    v_CREATE_AUDIT_TABLE_BODY = '';
+	
+	for v_row in
+		select column_name, data_type, is_nullable
+		from information_schema.columns
+		where table_name = p_table
+		order by ordinal_position
+	loop
+	
+		v_CREATE_AUDIT_TABLE_BODY = v_CREATE_AUDIT_TABLE_BODY || quote_ident(v_row.column_name) || ' ' || v_row.data_type;
+		
+		if v_row.is_nullable = 'NO' then
+			v_CREATE_AUDIT_TABLE_BODY = v_CREATE_AUDIT_TABLE_BODY || ' NOT NULL';
+		end if;
+
+		v_CREATE_AUDIT_TABLE_BODY = v_CREATE_AUDIT_TABLE_BODY || ', ';
+		
+	end loop;
+
+	-- set audit action and actor columns
+	v_CREATE_AUDIT_TABLE_BODY = v_CREATE_AUDIT_TABLE_BODY || 'audit_action VARCHAR(6) NOT NULL DEFAULT ''update'', audit_actor INT, ';
+
+	-- set extra columns
+	if p_audit_table_extra_columns is not null then
+		v_CREATE_AUDIT_TABLE_BODY = v_CREATE_AUDIT_TABLE_BODY || p_audit_table_extra_columns || ', ';
+	end if;
+
+	-- set primary key
+	v_CREATE_AUDIT_TABLE_BODY = v_CREATE_AUDIT_TABLE_BODY || 'PRIMARY KEY (' || p_audit_table_pk_columns_list_sep_by_comma || ')';
+    
+	-- build create audit table command
+	v_CREATE_AUDIT_TABLE_CMD = 'CREATE TABLE IF NOT EXISTS ' || quote_ident(p_audit_table_name) || ' (' || v_CREATE_AUDIT_TABLE_BODY || ')';
+		
+	-- create the audit table
+	execute v_CREATE_AUDIT_TABLE_CMD;
+
+	-- create contributed_at index on audit table
+	execute 'CREATE INDEX ' || quote_ident(p_audit_table_index_name) || ' ON ' || quote_ident(p_audit_table_name) || ' (' || quote_ident(p_audit_table_index_column_name) || ')';
+
+	-- create audit_actor index on audit table
+	execute 'CREATE INDEX ' || quote_ident(p_audit_table_name || '_idx_audit_actor') || ' ON ' || quote_ident(p_audit_table_name) || ' (audit_actor)';
+	
+end;
+$$;
+
+-- build a trigger that audit old records on update: the actor is the acting
+-- user of the transaction or else the value of the actor column of the new
+-- record if given. the audit record is populated by column names from the old
+-- record.
+DROP PROCEDURE IF EXISTS build_trigger_audit_on_update(text, text, text, text, text);
+
+create or replace procedure build_trigger_audit_on_update(
+	p_table text,
+	p_table_actor_column text,
+	p_table_contributed_at_column text,
+	p_audit_table_name text,
+	p_trigger_name text,
+	p_trigger_function_name text
+)
+language plpgsql
+as $body$
+declare
+	v_actor text;
+	v_trigger_func_body text;
+	v_trigger_func_cmd text;
+	v_create_trigger_on_table_cmd text;
+begin
+	-- build actor
+	v_actor = 'nullif(current_setting(''watchlist.actor_id'', true), '''')::INT';
+	if p_table_actor_column is not null then
+		v_actor = 'coalesce(' || v_actor || ', NEW.' || quote_ident(p_table_actor_column) || ')';
+	end if;
+
+	-- build trigger function
+	v_trigger_func_body = 'BEGIN '
+			|| 'INSERT INTO ' || quote_ident(p_audit_table_name) || ' '
+			|| 'SELECT * FROM jsonb_populate_record(NULL::' || quote_ident(p_audit_table_name) || ', '
+			|| 'to_jsonb(OLD) || jsonb_build_object('
+			|| '''audit_action'', ''update'', '
+			|| '''audit_actor'', ' || v_actor || ', '
+			|| '''audited_at'', clock_timestamp())); ';
+	if p_table_contributed_at_column is not null then
+		v_trigger_func_body = v_trigger_func_body
+			|| 'NEW.' || quote_ident(p_table_contributed_at_column) || ' = CURRENT_TIMESTAMP; ';
+	end if;
+	v_trigger_func_body = v_trigger_func_body
+			|| 'RETURN NEW; '
+			|| 'END;';
+	
+	v_trigger_func_cmd = 'CREATE OR REPLACE FUNCTION ' || p_trigger_function_name || '() RETURNS TRIGGER ' 
+						|| 'LANGUAGE plpgsql AS $$ ' || v_trigger_func_body || ' $$';
+	
+	-- create trigger function
+	execute v_trigger_func_cmd;
+	
+	-- build trigger on table
+	v_create_trigger_on_table_cmd = 'CREATE TRIGGER ' || p_trigger_name || ' '
+									|| 'BEFORE UPDATE ON ' || p_table || ' '
+									|| 'FOR EACH ROW EXECUTE FUNCTION ' || p_trigger_function_name || '()';
+	
+	-- create trigger on table
+	execute 'DROP TRIGGER IF EXISTS ' || p_trigger_name || ' ON ' || p_table;
+	execute v_create_trigger_on_table_cmd;
+	
+end;
+$body$;
+
+-- build a trigger that audit the final records on delete: the actor is the
+-- acting user of the transaction or else the value of the actor column of the
+-- deleted record if given
+create or replace procedure build_trigger_audit_on_delete(
+	p_table text,
+	p_table_actor_column text,
+	p_audit_table_name text,
+	p_trigger_name text,
+	p_trigger_function_name text
+)
+language plpgsql
+as $body$
+declare
+	v_actor text;
+	v_trigger_func_body text;
+	v_trigger_func_cmd text;
+	v_create_trigger_on_table_cmd text;
+begin
+	-- build actor
+	v_actor = 'nullif(current_setting(''watchlist.actor_id'', true), '''')::INT';
+	if p_table_actor_column is not null then
+		v_actor = 'coalesce(' || v_actor || ', OLD.' || quote_ident(p_table_actor_column) || ')';
+	end if;
+
+	-- build trigger function
+	v_trigger_func_body = 'BEGIN '
+			|| 'INSERT INTO ' || quote_ident(p_audit_table_name) || ' '
+			|| 'SELECT * FROM jsonb_populate_record(NULL::' || quote_ident(p_audit_table_name) || ', '
+			|| 'to_jsonb(OLD) || jsonb_build_object('
+			|| '''audit_action'', ''delete'', '
+			|| '''audit_actor'', ' || v_actor || ', '
+			|| '''audited_at'', clock_timestamp())); '
+			|| 'RETURN OLD; '
+			|| 'END;';
+	
+	v_trigger_func_cmd = 'CREATE OR REPLACE FUNCTION ' || p_trigger_function_name || '() RETURNS TRIGGER ' 
+						|| 'LANGUAGE plpgsql AS $$ ' || v_trigger_func_body || ' $$';
+	
+	-- create trigger function
+	execute v_trigger_func_cmd;
+	
+	-- build trigger on table
+	v_create_trigger_on_table_cmd = 'CREATE TRIGGER ' || p_trigger_name || ' '
+									|| 'BEFORE DELETE ON ' || p_table || ' '
+									|| 'FOR EACH ROW EXECUTE FUNCTION ' || p_trigger_function_name || '()';
+	
+	-- create trigger on table
+	execute 'DROP TRIGGER IF EXISTS ' || p_trigger_name || ' ON ' || p_table;
+	execute v_create_trigger_on_table_cmd;
+	
+end;
+$body$;
+
+-- add the audit action and actor columns to the existing audit tables and
+-- rebuild their triggers
+DO $$
+declare
+	v_table text;
+begin
+	foreach v_table in array array[
+		'serieses',
+		'films',
+		'external_ids',
+		'translations',
+		'releases',
+		'content_ratings',
+		'collections',
+		'collection_items',
+		'media_items'
+	]
+	loop
+		execute 'ALTER TABLE IF EXISTS ' || quote_ident(v_table || '_audit') || ' '
+			|| 'ADD COLUMN IF NOT EXISTS audit_action VARCHAR(6) NOT NULL DEFAULT ''update'', '
+			|| 'ADD COLUMN IF NOT EXISTS audit_actor INT';
+
+		execute 'CREATE INDEX IF NOT EXISTS ' || quote_ident(v_table || '_audit_idx_audit_actor') || ' '
+			|| 'ON ' || quote_ident(v_table || '_audit') || ' (audit_actor)';
+
+		call build_trigger_audit_on_update(
+			p_table => v_table,
+			p_table_actor_column => 'contributed_by',
+			p_table_contributed_at_column => 'contributed_at',
+			p_audit_table_name => v_table || '_audit',
+			p_trigger_name => v_table || '_trigger_audit_on_update',
+			p_trigger_function_name => v_table || '_function_triggers_on_update'
+		);
+
+		call build_trigger_audit_on_delete(
+			p_table => v_table,
+			p_table_actor_column => null,
+			p_audit_table_name => v_table || '_audit',
+			p_trigger_name => v_table || '_trigger_audit_on_delete',
+			p_trigger_function_name => v_table || '_function_triggers_on_delete'
+		);
+	end loop;
+end;
+$$;
+
+-- audit users
+call create_audit_table(
+	p_table => 'users',
+	p_audit_table_name => 'users_audit',
+
+	p_audit_table_index_name => 'users_audit_idx_audited_at',
+	p_audit_table_index_column_name => 'audited_at',
+
+	p_audit_table_pk_columns_list_sep_by_comma => 'id, audited_at',
+	p_audit_table_extra_columns => 'audited_at TIMESTAMPTZ NOT NULL DEFAULT clock_timestamp()'
+);
+
+-- the audits never keep credentials: the triggers populate the audits by
+-- their columns so the password hash is left out
+ALTER TABLE users_audit DROP COLUMN password_hash;
+
+-- users are their own actors by default
+call build_trigger_audit_on_update(
+	p_table => 'users',
+	p_table_actor_column => 'id',
+	p_table_contributed_at_column => null,
+	p_audit_table_name => 'users_audit',
+	p_trigger_name => 'users_trigger_audit_on_update',
+	p_trigger_function_name => 'users_function_triggers_on_update'
+);
+
+call build_trigger_audit_on_delete(
+	p_table => 'users',
+	p_table_actor_column => 'id',
+	p_audit_table_name => 'users_audit',
+	p_trigger_name => 'users_trigger_audit_on_delete',
+	p_trigger_function_name => 'users_function_triggers_on_delete'
+);
+
+-- audit watchfilms
+call create_audit_table(
+	p_table => 'watchfilms',
+	p_audit_table_name => 'watchfilms_audit',
+
+	p_audit_table_index_name => 'watchfilms_audit_idx_audited_at',
+	p_audit_table_index_column_name => 'audited_at',
+
+	p_audit_table_pk_columns_list_sep_by_comma => 'id, audited_at',
+	p_audit_table_extra_columns => 'audited_at TIMESTAMPTZ NOT NULL DEFAULT clock_timestamp()'
+);
+
+-- watchlists owners are their actors by default
+call build_trigger_audit_on_update(
+	p_table => 'watchfilms',
+	p_table_actor_column => 'user_id',
+	p_table_contributed_at_column => null,
+	p_audit_table_name => 'watchfilms_audit',
+	p_trigger_name => 'watchfilms_trigger_audit_on_update',
+	p_trigger_function_name => 'watchfilms_function_triggers_on_update'
+);
+
+call build_trigger_audit_on_delete(
+	p_table => 'watchfilms',
+	p_table_actor_column => 'user_id',
+	p_audit_table_name => 'watchfilms_audit',
+	p_trigger_name => 'watchfilms_trigger_audit_on_delete',
+	p_trigger_function_name => 'watchfilms_function_triggers_on_delete'
+);
+
+-- create index on the audited watchfilms users
+CREATE INDEX IF NOT EXISTS watchfilms_audit_idx_user_id ON watchfilms_audit (user_id);
+
+COMMIT;
\ No newline at end of file
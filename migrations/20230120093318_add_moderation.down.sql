@@ -0,0 +1,21 @@
+BEGIN;
+
+ALTER TABLE IF EXISTS serieses_audit
+	DROP COLUMN IF EXISTS deleted_at;
+
+ALTER TABLE IF EXISTS serieses
+	DROP COLUMN IF EXISTS deleted_at;
+
+ALTER TABLE IF EXISTS films_audit
+	DROP COLUMN IF EXISTS deleted_at;
+
+ALTER TABLE IF EXISTS films
+	DROP COLUMN IF EXISTS deleted_at;
+
+ALTER TABLE IF EXISTS users_audit
+	DROP COLUMN IF EXISTS moderator;
+
+ALTER TABLE IF EXISTS users
+	DROP COLUMN IF EXISTS moderator;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,25 @@
+BEGIN;
+
+-- moderators could hide, restore and purge movies, serieses and episodes.
+-- the audit tables must have the same columns since the audit triggers
+-- populate the audit record from the old row
+ALTER TABLE IF EXISTS users
+	ADD COLUMN IF NOT EXISTS moderator BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE IF EXISTS users_audit
+	ADD COLUMN IF NOT EXISTS moderator BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- hidden (soft deleted) films and serieses have deleted_at set
+ALTER TABLE IF EXISTS films
+	ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+ALTER TABLE IF EXISTS films_audit
+	ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+ALTER TABLE IF EXISTS serieses
+	ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+ALTER TABLE IF EXISTS serieses_audit
+	ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS series_aggregates;
+
+COMMIT;
\ No newline at end of file
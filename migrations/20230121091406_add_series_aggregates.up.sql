@@ -0,0 +1,70 @@
+BEGIN;
+
+-- aggregates of the visible episodes of the serieses maintained by the
+-- repository on every episode write. seasons_count does not count the
+-- specials (season 0) and season_episodes maps the season numbers to their
+-- episodes count
+CREATE TABLE IF NOT EXISTS series_aggregates (
+    series_id INT PRIMARY KEY,
+    seasons_count INT NOT NULL DEFAULT 0,
+    episodes_count INT NOT NULL DEFAULT 0,
+    total_runtime INT NOT NULL DEFAULT 0,
+    first_episode_date DATE,
+    latest_episode_date DATE,
+    season_episodes JSONB NOT NULL DEFAULT '{}'
+);
+
+ALTER TABLE IF EXISTS series_aggregates
+    ADD CONSTRAINT series_aggregates_fk_serieses
+    FOREIGN KEY (series_id)
+    REFERENCES serieses(id)
+    ON DELETE CASCADE;
+
+-- indexes on the sortable aggregates
+CREATE INDEX IF NOT EXISTS series_aggregates_idx_seasons_count
+    ON series_aggregates (seasons_count);
+CREATE INDEX IF NOT EXISTS series_aggregates_idx_episodes_count
+    ON series_aggregates (episodes_count);
+CREATE INDEX IF NOT EXISTS series_aggregates_idx_total_runtime
+    ON series_aggregates (total_runtime);
+CREATE INDEX IF NOT EXISTS series_aggregates_idx_latest_episode_date
+    ON series_aggregates (latest_episode_date);
+
+-- aggregate the existing serieses
+INSERT INTO series_aggregates (
+    series_id,
+    seasons_count,
+    episodes_count,
+    total_runtime,
+    first_episode_date,
+    latest_episode_date,
+    season_episodes
+)
+SELECT s.id,
+    count(e.season_number) FILTER (WHERE e.season_number > 0),
+    coalesce(sum(e.episodes_count), 0),
+    coalesce(sum(e.total_runtime), 0),
+    min(e.first_episode_date),
+    max(e.latest_episode_date),
+    coalesce(
+        jsonb_object_agg(e.season_number, e.episodes_count)
+            FILTER (WHERE e.season_number IS NOT NULL),
+        '{}'
+    )
+FROM serieses s
+LEFT JOIN (
+    SELECT series_id, season_number,
+        count(*) AS episodes_count,
+        coalesce(sum(duration), 0) AS total_runtime,
+        min(date_released) AS first_episode_date,
+        max(date_released) AS latest_episode_date
+    FROM films
+    WHERE series_id IS NOT NULL
+        AND season_number IS NOT NULL
+        AND episode_number IS NOT NULL
+        AND deleted_at IS NULL
+    GROUP BY series_id, season_number
+) e ON e.series_id = s.id
+GROUP BY s.id;
+
+COMMIT;
\ No newline at end of file
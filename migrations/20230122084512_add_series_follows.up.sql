@@ -0,0 +1,29 @@
+BEGIN;
+
+-- followers of the serieses: new episodes are appended to their watchlists
+CREATE TABLE IF NOT EXISTS series_follows (
+    user_id INT NOT NULL,
+    series_id INT NOT NULL,
+
+    time_followed TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (user_id, series_id)
+);
+
+ALTER TABLE IF EXISTS series_follows
+    ADD CONSTRAINT series_follows_fk_users
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE;
+
+ALTER TABLE IF EXISTS series_follows
+    ADD CONSTRAINT series_follows_fk_serieses
+    FOREIGN KEY (series_id)
+    REFERENCES serieses(id)
+    ON DELETE CASCADE;
+
+-- create index on series_id fk
+CREATE INDEX IF NOT EXISTS series_follows_idx_series_id
+    ON series_follows (series_id);
+
+COMMIT;
\ No newline at end of file
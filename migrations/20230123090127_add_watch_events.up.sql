@@ -0,0 +1,31 @@
+BEGIN;
+
+-- watch events of the watchlist items: time_watched of a watchlist item is
+-- its latest watch event
+CREATE TABLE IF NOT EXISTS watch_events (
+    id SERIAL PRIMARY KEY,
+
+    watch_id INT NOT NULL,
+
+    time_watched TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    rating INT,
+    device VARCHAR(50)
+);
+
+ALTER TABLE IF EXISTS watch_events
+    ADD CONSTRAINT watch_events_fk_watchfilms
+    FOREIGN KEY (watch_id)
+    REFERENCES watchfilms(id)
+    ON DELETE CASCADE;
+
+-- create index on watch_id fk and the watch time
+CREATE INDEX IF NOT EXISTS watch_events_idx_watch_id_time_watched
+    ON watch_events (watch_id, time_watched);
+
+-- the watched items have been watched once
+INSERT INTO watch_events (watch_id, time_watched)
+SELECT id, time_watched
+FROM watchfilms
+WHERE time_watched IS NOT NULL;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS playback_progress;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,35 @@
+BEGIN;
+
+-- playback progress of the films by user: the latest update wins
+CREATE TABLE IF NOT EXISTS playback_progress (
+    user_id INT NOT NULL,
+    film_id INT NOT NULL,
+
+    position INT NOT NULL CHECK (position >= 0),
+    device VARCHAR(50),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (user_id, film_id)
+);
+
+ALTER TABLE IF EXISTS playback_progress
+    ADD CONSTRAINT playback_progress_fk_users
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE;
+
+ALTER TABLE IF EXISTS playback_progress
+    ADD CONSTRAINT playback_progress_fk_films
+    FOREIGN KEY (film_id)
+    REFERENCES films(id)
+    ON DELETE CASCADE;
+
+-- create index on film_id fk
+CREATE INDEX IF NOT EXISTS playback_progress_idx_film_id
+    ON playback_progress (film_id);
+
+-- create index on user_id and the update time for continue watching
+CREATE INDEX IF NOT EXISTS playback_progress_idx_user_id_updated_at
+    ON playback_progress (user_id, updated_at);
+
+COMMIT;
\ No newline at end of file
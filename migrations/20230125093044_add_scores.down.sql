@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS series_score_aggregates;
+DROP TABLE IF EXISTS film_score_aggregates;
+DROP TABLE IF EXISTS scores;
+
+COMMIT;
\ No newline at end of file
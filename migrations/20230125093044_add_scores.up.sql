@@ -0,0 +1,85 @@
+BEGIN;
+
+-- scores out of 100 of the users on the films (movies and episodes) and the
+-- serieses: one score per user and target
+CREATE TABLE IF NOT EXISTS scores (
+    id SERIAL PRIMARY KEY,
+
+    user_id INT NOT NULL,
+    film_id INT,
+    series_id INT,
+
+    score INT NOT NULL CHECK (score BETWEEN 0 AND 100),
+    time_scored TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CHECK ((film_id IS NULL) <> (series_id IS NULL)),
+    UNIQUE (user_id, film_id),
+    UNIQUE (user_id, series_id)
+);
+
+ALTER TABLE IF EXISTS scores
+    ADD CONSTRAINT scores_fk_users
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE;
+
+ALTER TABLE IF EXISTS scores
+    ADD CONSTRAINT scores_fk_films
+    FOREIGN KEY (film_id)
+    REFERENCES films(id)
+    ON DELETE CASCADE;
+
+ALTER TABLE IF EXISTS scores
+    ADD CONSTRAINT scores_fk_serieses
+    FOREIGN KEY (series_id)
+    REFERENCES serieses(id)
+    ON DELETE CASCADE;
+
+-- create index on film_id and series_id fks
+CREATE INDEX IF NOT EXISTS scores_idx_film_id ON scores (film_id);
+CREATE INDEX IF NOT EXISTS scores_idx_series_id ON scores (series_id);
+
+-- aggregates of the scores of the films and the serieses maintained
+-- incrementally by the repository on every score write. score_histogram maps
+-- the lower bounds of the tens to their scores count: 100 falls in 90
+CREATE TABLE IF NOT EXISTS film_score_aggregates (
+    film_id INT PRIMARY KEY,
+    scores_count INT NOT NULL DEFAULT 0,
+    scores_sum INT NOT NULL DEFAULT 0,
+    score_mean DOUBLE PRECISION,
+    score_histogram JSONB NOT NULL DEFAULT
+        '{"0": 0, "10": 0, "20": 0, "30": 0, "40": 0, "50": 0, "60": 0, "70": 0, "80": 0, "90": 0}'
+);
+
+ALTER TABLE IF EXISTS film_score_aggregates
+    ADD CONSTRAINT film_score_aggregates_fk_films
+    FOREIGN KEY (film_id)
+    REFERENCES films(id)
+    ON DELETE CASCADE;
+
+CREATE TABLE IF NOT EXISTS series_score_aggregates (
+    series_id INT PRIMARY KEY,
+    scores_count INT NOT NULL DEFAULT 0,
+    scores_sum INT NOT NULL DEFAULT 0,
+    score_mean DOUBLE PRECISION,
+    score_histogram JSONB NOT NULL DEFAULT
+        '{"0": 0, "10": 0, "20": 0, "30": 0, "40": 0, "50": 0, "60": 0, "70": 0, "80": 0, "90": 0}'
+);
+
+ALTER TABLE IF EXISTS series_score_aggregates
+    ADD CONSTRAINT series_score_aggregates_fk_serieses
+    FOREIGN KEY (series_id)
+    REFERENCES serieses(id)
+    ON DELETE CASCADE;
+
+-- indexes on the sortable aggregates
+CREATE INDEX IF NOT EXISTS film_score_aggregates_idx_scores_count
+    ON film_score_aggregates (scores_count);
+CREATE INDEX IF NOT EXISTS film_score_aggregates_idx_score_mean
+    ON film_score_aggregates (score_mean);
+CREATE INDEX IF NOT EXISTS series_score_aggregates_idx_scores_count
+    ON series_score_aggregates (scores_count);
+CREATE INDEX IF NOT EXISTS series_score_aggregates_idx_score_mean
+    ON series_score_aggregates (score_mean);
+
+COMMIT;
\ No newline at end of file
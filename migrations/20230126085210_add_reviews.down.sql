@@ -0,0 +1,12 @@
+BEGIN;
+
+DROP TABLE IF EXISTS review_votes;
+
+DROP TRIGGER IF EXISTS reviews_trigger_audit_on_update ON reviews;
+DROP TRIGGER IF EXISTS reviews_trigger_audit_on_delete ON reviews;
+DROP FUNCTION IF EXISTS reviews_function_triggers_on_update;
+DROP FUNCTION IF EXISTS reviews_function_triggers_on_delete;
+DROP TABLE IF EXISTS reviews_audit;
+DROP TABLE IF EXISTS reviews;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,103 @@
+BEGIN;
+
+-- reviews of the users on the films (movies and episodes) and the serieses:
+-- one review per user and target. the body is markdown and the edit history
+-- is kept by the audit triggers
+CREATE TABLE IF NOT EXISTS reviews (
+    id SERIAL PRIMARY KEY,
+
+    user_id INT NOT NULL,
+    film_id INT,
+    series_id INT,
+
+    body TEXT NOT NULL,
+    spoiler BOOLEAN NOT NULL DEFAULT FALSE,
+    -- moderation status: hidden reviews are listed to their authors only
+    status VARCHAR(10) NOT NULL DEFAULT 'published'
+        CHECK (status IN ('published', 'hidden')),
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    edited_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CHECK ((film_id IS NULL) <> (series_id IS NULL)),
+    UNIQUE (user_id, film_id),
+    UNIQUE (user_id, series_id)
+);
+
+ALTER TABLE IF EXISTS reviews
+    ADD CONSTRAINT reviews_fk_users
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE;
+
+ALTER TABLE IF EXISTS reviews
+    ADD CONSTRAINT reviews_fk_films
+    FOREIGN KEY (film_id)
+    REFERENCES films(id)
+    ON DELETE CASCADE;
+
+ALTER TABLE IF EXISTS reviews
+    ADD CONSTRAINT reviews_fk_serieses
+    FOREIGN KEY (series_id)
+    REFERENCES serieses(id)
+    ON DELETE CASCADE;
+
+-- create index on film_id and series_id fks
+CREATE INDEX IF NOT EXISTS reviews_idx_film_id ON reviews (film_id);
+CREATE INDEX IF NOT EXISTS reviews_idx_series_id ON reviews (series_id);
+
+-- audit reviews: the edits and moderations of a review
+call create_audit_table(
+	p_table => 'reviews',
+	p_audit_table_name => 'reviews_audit',
+
+	p_audit_table_index_name => 'reviews_audit_idx_audited_at',
+	p_audit_table_index_column_name => 'audited_at',
+
+	p_audit_table_pk_columns_list_sep_by_comma => 'id, audited_at',
+	p_audit_table_extra_columns => 'audited_at TIMESTAMPTZ NOT NULL DEFAULT clock_timestamp()'
+);
+
+-- reviews authors are their actors by default
+call build_trigger_audit_on_update(
+	p_table => 'reviews',
+	p_table_actor_column => 'user_id',
+	p_table_contributed_at_column => null,
+	p_audit_table_name => 'reviews_audit',
+	p_trigger_name => 'reviews_trigger_audit_on_update',
+	p_trigger_function_name => 'reviews_function_triggers_on_update'
+);
+
+call build_trigger_audit_on_delete(
+	p_table => 'reviews',
+	p_table_actor_column => 'user_id',
+	p_audit_table_name => 'reviews_audit',
+	p_trigger_name => 'reviews_trigger_audit_on_delete',
+	p_trigger_function_name => 'reviews_function_triggers_on_delete'
+);
+
+-- helpfulness votes of the users on the reviews: one vote per user and review
+CREATE TABLE IF NOT EXISTS review_votes (
+    review_id INT NOT NULL,
+    user_id INT NOT NULL,
+    time_voted TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (review_id, user_id)
+);
+
+ALTER TABLE IF EXISTS review_votes
+    ADD CONSTRAINT review_votes_fk_reviews
+    FOREIGN KEY (review_id)
+    REFERENCES reviews(id)
+    ON DELETE CASCADE;
+
+ALTER TABLE IF EXISTS review_votes
+    ADD CONSTRAINT review_votes_fk_users
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE;
+
+-- create index on user_id fk: review_id is indexed by the primary key
+CREATE INDEX IF NOT EXISTS review_votes_idx_user_id ON review_votes (user_id);
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS list_items;
+DROP TABLE IF EXISTS lists;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,90 @@
+BEGIN;
+
+-- custom named lists of the users beside their watchlist: private lists are
+-- visible to their owners only
+CREATE TABLE IF NOT EXISTS lists (
+    id SERIAL PRIMARY KEY,
+
+    user_id INT NOT NULL,
+
+    title VARCHAR(100) NOT NULL,
+    descriptions VARCHAR(500),
+    visibility VARCHAR(10) NOT NULL DEFAULT 'private'
+        CHECK (visibility IN ('private', 'public')),
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE IF EXISTS lists
+    ADD CONSTRAINT lists_fk_users
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE;
+
+-- create index on user_id fk
+CREATE INDEX IF NOT EXISTS lists_idx_user_id ON lists (user_id);
+
+-- create list_items table
+-- an item is either a film (movie or episode) or a series at a position of
+-- the list
+CREATE TABLE IF NOT EXISTS list_items (
+    id SERIAL PRIMARY KEY,
+
+    list_id INT NOT NULL,
+    position INT NOT NULL,
+
+    film_id INT,
+    series_id INT,
+
+    time_added TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT list_items_position_cnst
+        CHECK (position >= 1),
+    CONSTRAINT list_items_film_or_series_cnst
+        CHECK (num_nonnulls(film_id, series_id) = 1)
+);
+
+-- a position of a list holds one item. deferrable as reordering and removing
+-- items moves items between positions
+-- this also indexes the list_id foreign key
+ALTER TABLE IF EXISTS list_items
+	ADD CONSTRAINT list_items_unique_position_cnst
+	UNIQUE (list_id, position)
+	DEFERRABLE INITIALLY DEFERRED;
+
+-- a film or series is a member of a list once
+ALTER TABLE IF EXISTS list_items
+	ADD CONSTRAINT list_items_unique_film_cnst
+	UNIQUE (list_id, film_id);
+
+ALTER TABLE IF EXISTS list_items
+	ADD CONSTRAINT list_items_unique_series_cnst
+	UNIQUE (list_id, series_id);
+
+-- add list_id foreign key constraint
+ALTER TABLE IF EXISTS list_items
+    ADD CONSTRAINT list_items_fk_lists
+    FOREIGN KEY (list_id)
+    REFERENCES lists(id)
+    ON DELETE CASCADE;
+
+-- add film_id foreign key constraint
+ALTER TABLE IF EXISTS list_items
+    ADD CONSTRAINT list_items_fk_films
+    FOREIGN KEY (film_id)
+    REFERENCES films(id)
+    ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS list_items_idx_film_id ON list_items (film_id);
+
+-- add series_id foreign key constraint
+ALTER TABLE IF EXISTS list_items
+    ADD CONSTRAINT list_items_fk_serieses
+    FOREIGN KEY (series_id)
+    REFERENCES serieses(id)
+    ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS list_items_idx_series_id ON list_items (series_id);
+
+COMMIT;
\ No newline at end of file
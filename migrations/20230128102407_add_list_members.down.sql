@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP TABLE IF EXISTS list_activities;
+ALTER TABLE IF EXISTS list_items DROP COLUMN IF EXISTS added_by;
+DROP TABLE IF EXISTS list_invites;
+DROP TABLE IF EXISTS list_members;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,165 @@
+BEGIN;
+
+-- members of the shared lists: the owner creates the list and manages its
+-- members, the editors manage its items and the viewers see it even if
+-- private
+CREATE TABLE IF NOT EXISTS list_members (
+    list_id INT NOT NULL,
+    user_id INT NOT NULL,
+
+    role VARCHAR(10) NOT NULL
+        CHECK (role IN ('owner', 'editor', 'viewer')),
+
+    time_joined TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (list_id, user_id)
+);
+
+ALTER TABLE IF EXISTS list_members
+    ADD CONSTRAINT list_members_fk_lists
+    FOREIGN KEY (list_id)
+    REFERENCES lists(id)
+    ON DELETE CASCADE;
+
+ALTER TABLE IF EXISTS list_members
+    ADD CONSTRAINT list_members_fk_users
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE;
+
+-- create index on user_id fk
+CREATE INDEX IF NOT EXISTS list_members_idx_user_id ON list_members (user_id);
+
+-- the owners of the existing lists are their first members
+INSERT INTO list_members (list_id, user_id, role)
+    SELECT id, user_id, 'owner' FROM lists;
+
+-- invitations to join the lists: either a user invited by their email or a
+-- link invite anyone holding its token can accept until it expires
+CREATE TABLE IF NOT EXISTS list_invites (
+    id SERIAL PRIMARY KEY,
+
+    list_id INT NOT NULL,
+    role VARCHAR(10) NOT NULL
+        CHECK (role IN ('editor', 'viewer')),
+
+    invited_by INT,
+    user_id INT,
+    token_hash VARCHAR(60),
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMPTZ NOT NULL,
+
+    CONSTRAINT list_invites_user_or_token_cnst
+        CHECK (num_nonnulls(user_id, token_hash) = 1),
+    -- a user is invited to a list once
+    -- this also indexes the list_id foreign key
+    CONSTRAINT list_invites_unique_user_cnst
+        UNIQUE (list_id, user_id)
+);
+
+ALTER TABLE IF EXISTS list_invites
+    ADD CONSTRAINT list_invites_fk_lists
+    FOREIGN KEY (list_id)
+    REFERENCES lists(id)
+    ON DELETE CASCADE;
+
+ALTER TABLE IF EXISTS list_invites
+    ADD CONSTRAINT list_invites_fk_users_invited_by
+    FOREIGN KEY (invited_by)
+    REFERENCES users(id)
+    ON DELETE SET NULL;
+
+ALTER TABLE IF EXISTS list_invites
+    ADD CONSTRAINT list_invites_fk_users
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE;
+
+-- create index on user_id fk
+CREATE INDEX IF NOT EXISTS list_invites_idx_user_id ON list_invites (user_id);
+
+-- the member added the item: the owners added the existing items
+ALTER TABLE IF EXISTS list_items
+    ADD COLUMN added_by INT;
+
+UPDATE list_items
+    SET added_by = lists.user_id
+    FROM lists
+    WHERE lists.id = list_items.list_id;
+
+ALTER TABLE IF EXISTS list_items
+    ADD CONSTRAINT list_items_fk_users
+    FOREIGN KEY (added_by)
+    REFERENCES users(id)
+    ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS list_items_idx_added_by ON list_items (added_by);
+
+-- the activity log of the changes of the lists: user_id is the member made
+-- the change and member_id is the member the change was made to
+CREATE TABLE IF NOT EXISTS list_activities (
+    id SERIAL PRIMARY KEY,
+
+    list_id INT NOT NULL,
+    user_id INT,
+    action VARCHAR(20) NOT NULL
+        CHECK (action IN (
+            'create',
+            'update',
+            'item_add',
+            'item_remove',
+            'reorder',
+            'member_join',
+            'member_update',
+            'member_remove'
+        )),
+
+    film_id INT,
+    series_id INT,
+    member_id INT,
+
+    time_logged TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE IF EXISTS list_activities
+    ADD CONSTRAINT list_activities_fk_lists
+    FOREIGN KEY (list_id)
+    REFERENCES lists(id)
+    ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS list_activities_idx_list_id ON list_activities (list_id);
+
+ALTER TABLE IF EXISTS list_activities
+    ADD CONSTRAINT list_activities_fk_users
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS list_activities_idx_user_id ON list_activities (user_id);
+
+ALTER TABLE IF EXISTS list_activities
+    ADD CONSTRAINT list_activities_fk_films
+    FOREIGN KEY (film_id)
+    REFERENCES films(id)
+    ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS list_activities_idx_film_id ON list_activities (film_id);
+
+ALTER TABLE IF EXISTS list_activities
+    ADD CONSTRAINT list_activities_fk_serieses
+    FOREIGN KEY (series_id)
+    REFERENCES serieses(id)
+    ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS list_activities_idx_series_id ON list_activities (series_id);
+
+ALTER TABLE IF EXISTS list_activities
+    ADD CONSTRAINT list_activities_fk_users_member
+    FOREIGN KEY (member_id)
+    REFERENCES users(id)
+    ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS list_activities_idx_member_id ON list_activities (member_id);
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,20 @@
+BEGIN;
+
+DROP TRIGGER IF EXISTS watchfilms_trigger_audit_on_update ON watchfilms;
+CREATE TRIGGER watchfilms_trigger_audit_on_update
+    BEFORE UPDATE ON watchfilms
+    FOR EACH ROW
+    EXECUTE FUNCTION watchfilms_function_triggers_on_update();
+
+DROP TABLE IF EXISTS watchfilm_tags;
+DROP INDEX IF EXISTS watchfilms_idx_user_id_position;
+ALTER TABLE IF EXISTS watchfilms_audit
+    DROP COLUMN IF EXISTS position,
+    DROP COLUMN IF EXISTS priority,
+    DROP COLUMN IF EXISTS note;
+ALTER TABLE IF EXISTS watchfilms
+    DROP COLUMN IF EXISTS position,
+    DROP COLUMN IF EXISTS priority,
+    DROP COLUMN IF EXISTS note;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,59 @@
+BEGIN;
+
+-- the manual order, priority and private note of the watchlist items: the
+-- positions are spaced by 1024 so an item moves between two others by taking
+-- the middle position and the watchlist is renumbered once there's no gap
+ALTER TABLE IF EXISTS watchfilms
+    ADD COLUMN position INT,
+    ADD COLUMN priority INT NOT NULL DEFAULT 0,
+    ADD COLUMN note VARCHAR(500);
+
+UPDATE watchfilms
+    SET position = numbered.row_number * 1024
+    FROM (
+        SELECT id, row_number() OVER (PARTITION BY user_id ORDER BY time_added, id)
+        FROM watchfilms
+    ) numbered
+    WHERE numbered.id = watchfilms.id;
+
+ALTER TABLE IF EXISTS watchfilms
+    ALTER COLUMN position SET NOT NULL,
+    ADD CONSTRAINT watchfilms_position_cnst CHECK (position >= 1),
+    ADD CONSTRAINT watchfilms_priority_cnst CHECK (priority >= 0);
+
+CREATE INDEX IF NOT EXISTS watchfilms_idx_user_id_position ON watchfilms (user_id, position);
+
+-- the audit table must have the same columns since the audit triggers populate
+-- the audit record from the old row: the records audited before have no
+-- position
+ALTER TABLE IF EXISTS watchfilms_audit
+    ADD COLUMN IF NOT EXISTS position INT,
+    ADD COLUMN IF NOT EXISTS priority INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS note VARCHAR(500);
+
+-- moving an item only changes the positions, possibly of the whole watchlist
+-- once it is renumbered: the position only updates are not audited
+DROP TRIGGER IF EXISTS watchfilms_trigger_audit_on_update ON watchfilms;
+CREATE TRIGGER watchfilms_trigger_audit_on_update
+    BEFORE UPDATE ON watchfilms
+    FOR EACH ROW
+    WHEN ((to_jsonb(OLD) - 'position') IS DISTINCT FROM (to_jsonb(NEW) - 'position'))
+    EXECUTE FUNCTION watchfilms_function_triggers_on_update();
+
+-- the personal tags of the watchlist items
+CREATE TABLE IF NOT EXISTS watchfilm_tags (
+    watch_id INT NOT NULL,
+    tag VARCHAR(30) NOT NULL,
+
+    PRIMARY KEY (watch_id, tag)
+);
+
+ALTER TABLE IF EXISTS watchfilm_tags
+    ADD CONSTRAINT watchfilm_tags_fk_watchfilms
+    FOREIGN KEY (watch_id)
+    REFERENCES watchfilms(id)
+    ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS watchfilm_tags_idx_tag ON watchfilm_tags (tag);
+
+COMMIT;
\ No newline at end of file
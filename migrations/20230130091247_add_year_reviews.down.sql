@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS year_reviews;
+
+COMMIT;
\ No newline at end of file
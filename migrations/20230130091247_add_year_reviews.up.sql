@@ -0,0 +1,22 @@
+BEGIN;
+
+-- the generated year in review reports of the users per time zone: a report
+-- is regenerated once expired
+CREATE TABLE IF NOT EXISTS year_reviews (
+    user_id INT NOT NULL,
+    year INT NOT NULL,
+    timezone VARCHAR(64) NOT NULL,
+
+    report JSONB NOT NULL,
+    generated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (user_id, year, timezone)
+);
+
+ALTER TABLE IF EXISTS year_reviews
+    ADD CONSTRAINT year_reviews_fk_users
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE;
+
+COMMIT;
\ No newline at end of file
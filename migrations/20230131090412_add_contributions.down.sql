@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP TRIGGER IF EXISTS serieses_trigger_contribution_on_update ON serieses;
+DROP TRIGGER IF EXISTS serieses_trigger_contribution_on_insert ON serieses;
+DROP TRIGGER IF EXISTS films_trigger_contribution_on_update ON films;
+DROP TRIGGER IF EXISTS films_trigger_contribution_on_insert ON films;
+DROP FUNCTION IF EXISTS contributions_function_record();
+DROP TABLE IF EXISTS contributor_stats;
+DROP TABLE IF EXISTS contributions;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,232 @@
+BEGIN;
+
+-- the contributions to the movies, episodes and series recorded on write: the
+-- audits of the contributed records are pruned so the contributions could not
+-- be derived from them. the content hashes tell the edits reverting the
+-- previous version
+CREATE TABLE IF NOT EXISTS contributions (
+    id SERIAL PRIMARY KEY,
+    user_id INT,
+    kind VARCHAR(7) NOT NULL,
+    target_id INT NOT NULL,
+    title VARCHAR(100) NOT NULL,
+    action VARCHAR(10) NOT NULL,
+    content_hash TEXT NOT NULL,
+    previous_content_hash TEXT,
+    reverted BOOLEAN NOT NULL DEFAULT false,
+    invalidated BOOLEAN NOT NULL DEFAULT false,
+    contributed_at TIMESTAMPTZ NOT NULL
+);
+
+ALTER TABLE IF EXISTS contributions
+    ADD CONSTRAINT contributions_fk_users
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS contributions_idx_user_id_contributed_at ON contributions (user_id, contributed_at);
+CREATE INDEX IF NOT EXISTS contributions_idx_target_id ON contributions (target_id, id);
+
+-- the contribution counters of the contributors updated along with their
+-- contributions
+CREATE TABLE IF NOT EXISTS contributor_stats (
+    user_id INT PRIMARY KEY,
+    creations INT NOT NULL DEFAULT 0,
+    edits INT NOT NULL DEFAULT 0,
+    reverted_edits INT NOT NULL DEFAULT 0,
+    invalidations_received INT NOT NULL DEFAULT 0
+);
+
+ALTER TABLE IF EXISTS contributor_stats
+    ADD CONSTRAINT contributor_stats_fk_users
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE;
+
+-- record the contributions of the versions written so far: every version is
+-- numbered along with the previous and the next versions of its record
+WITH versions AS (
+    SELECT CASE WHEN series_id IS NULL THEN 'movie' ELSE 'episode' END AS kind,
+        id, title, contributed_by, contributed_at, invalidation,
+        to_jsonb(version)
+            - '{contributed_by,contributed_at,invalidation,audit_action,audit_actor,deleted_at}'::text[]
+            AS content
+    FROM films version
+    UNION ALL
+    SELECT CASE WHEN series_id IS NULL THEN 'movie' ELSE 'episode' END AS kind,
+        id, title, contributed_by, contributed_at, invalidation,
+        to_jsonb(version)
+            - '{contributed_by,contributed_at,invalidation,audit_action,audit_actor,deleted_at}'::text[]
+            AS content
+    FROM films_audit version
+    UNION ALL
+    SELECT 'series' AS kind,
+        id, title, contributed_by, contributed_at, invalidation,
+        to_jsonb(version)
+            - '{contributed_by,contributed_at,invalidation,audit_action,audit_actor,deleted_at}'::text[]
+            AS content
+    FROM serieses version
+    UNION ALL
+    SELECT 'series' AS kind,
+        id, title, contributed_by, contributed_at, invalidation,
+        to_jsonb(version)
+            - '{contributed_by,contributed_at,invalidation,audit_action,audit_actor,deleted_at}'::text[]
+            AS content
+    FROM serieses_audit version
+), numbered AS (
+    SELECT *,
+        row_number() OVER w AS number,
+        lag(invalidation) OVER w AS previous_invalidation,
+        lag(content) OVER w AS previous_content,
+        lead(content) OVER w AS next_content,
+        lead(contributed_by) OVER w AS next_contributed_by,
+        lead(invalidation) OVER w AS next_invalidation
+    FROM versions
+    WINDOW w AS (PARTITION BY kind = 'series', id ORDER BY contributed_at)
+)
+INSERT INTO contributions (
+    user_id, kind, target_id, title, action,
+    content_hash, previous_content_hash, reverted, invalidated, contributed_at
+)
+SELECT contributed_by, kind, id, title,
+    CASE
+        WHEN number = 1 THEN 'create'
+        WHEN invalidation IS NOT NULL AND previous_invalidation IS NULL THEN 'invalidate'
+        ELSE 'edit'
+    END,
+    md5(content::text),
+    md5(previous_content::text),
+    coalesce(content <> previous_content AND next_content = previous_content, false),
+    invalidation IS NULL
+        AND next_invalidation IS NOT NULL
+        AND next_contributed_by IS DISTINCT FROM contributed_by,
+    contributed_at
+FROM numbered
+ORDER BY contributed_at, kind = 'series', id, number;
+
+INSERT INTO contributor_stats (
+    user_id, creations, edits, reverted_edits, invalidations_received
+)
+SELECT user_id,
+    count(*) FILTER (WHERE action = 'create'),
+    count(*) FILTER (WHERE action = 'edit'),
+    count(*) FILTER (WHERE reverted),
+    count(*) FILTER (WHERE invalidated)
+FROM contributions
+WHERE user_id IS NOT NULL
+GROUP BY user_id;
+
+-- record the contribution of the new version of a movie, episode or series and
+-- credit its contributor. the last recorded contribution of the record is the
+-- version replaced: it is reverted if the new version restores the version
+-- before it and invalidated if another contributor invalidates it
+CREATE OR REPLACE FUNCTION contributions_function_record() RETURNS TRIGGER
+LANGUAGE plpgsql AS $$
+DECLARE
+    v_kind TEXT;
+    v_action TEXT;
+    v_content_hash TEXT;
+    v_previous_content_hash TEXT;
+    v_last contributions%ROWTYPE;
+BEGIN
+    IF TG_TABLE_NAME = 'serieses' THEN
+        v_kind = 'series';
+    ELSIF NEW.series_id IS NULL THEN
+        v_kind = 'movie';
+    ELSE
+        v_kind = 'episode';
+    END IF;
+
+    v_content_hash = md5((
+        to_jsonb(NEW)
+            - '{contributed_by,contributed_at,invalidation,audit_action,audit_actor,deleted_at}'::text[]
+    )::text);
+
+    IF TG_OP = 'INSERT' THEN
+        v_action = 'create';
+    ELSE
+        v_previous_content_hash = md5((
+            to_jsonb(OLD)
+                - '{contributed_by,contributed_at,invalidation,audit_action,audit_actor,deleted_at}'::text[]
+        )::text);
+        IF NEW.invalidation IS NOT NULL AND OLD.invalidation IS NULL THEN
+            v_action = 'invalidate';
+        ELSE
+            v_action = 'edit';
+        END IF;
+
+        SELECT * INTO v_last FROM contributions
+        WHERE target_id = NEW.id AND (kind = 'series') = (v_kind = 'series')
+        ORDER BY id DESC
+        LIMIT 1;
+
+        IF v_last.id IS NOT NULL
+            AND v_last.previous_content_hash IS NOT NULL
+            AND v_last.content_hash <> v_last.previous_content_hash
+            AND v_content_hash = v_last.previous_content_hash
+        THEN
+            UPDATE contributions SET reverted = true WHERE id = v_last.id;
+            UPDATE contributor_stats SET reverted_edits = reverted_edits + 1
+            WHERE user_id = v_last.user_id;
+        END IF;
+
+        IF v_last.id IS NOT NULL
+            AND OLD.invalidation IS NULL
+            AND NEW.invalidation IS NOT NULL
+            AND NEW.contributed_by IS DISTINCT FROM OLD.contributed_by
+        THEN
+            UPDATE contributions SET invalidated = true WHERE id = v_last.id;
+            UPDATE contributor_stats SET invalidations_received = invalidations_received + 1
+            WHERE user_id = v_last.user_id;
+        END IF;
+    END IF;
+
+    INSERT INTO contributions (
+        user_id, kind, target_id, title, action,
+        content_hash, previous_content_hash, contributed_at
+    ) VALUES (
+        NEW.contributed_by, v_kind, NEW.id, NEW.title, v_action,
+        v_content_hash, v_previous_content_hash, NEW.contributed_at
+    );
+
+    INSERT INTO contributor_stats (user_id, creations, edits)
+    VALUES (
+        NEW.contributed_by,
+        (v_action = 'create')::INT,
+        (v_action = 'edit')::INT
+    )
+    ON CONFLICT (user_id) DO UPDATE SET
+        creations = contributor_stats.creations + EXCLUDED.creations,
+        edits = contributor_stats.edits + EXCLUDED.edits;
+
+    RETURN NULL;
+END;
+$$;
+
+-- hiding or restoring a record and deleting its contributor, which leaves the
+-- record without contributor, are not contributions
+CREATE TRIGGER films_trigger_contribution_on_insert
+    AFTER INSERT ON films
+    FOR EACH ROW
+    WHEN (NEW.contributed_by IS NOT NULL)
+    EXECUTE FUNCTION contributions_function_record();
+
+CREATE TRIGGER films_trigger_contribution_on_update
+    AFTER UPDATE ON films
+    FOR EACH ROW
+    WHEN (NEW.contributed_by IS NOT NULL AND OLD.deleted_at IS NOT DISTINCT FROM NEW.deleted_at)
+    EXECUTE FUNCTION contributions_function_record();
+
+CREATE TRIGGER serieses_trigger_contribution_on_insert
+    AFTER INSERT ON serieses
+    FOR EACH ROW
+    WHEN (NEW.contributed_by IS NOT NULL)
+    EXECUTE FUNCTION contributions_function_record();
+
+CREATE TRIGGER serieses_trigger_contribution_on_update
+    AFTER UPDATE ON serieses
+    FOR EACH ROW
+    WHEN (NEW.contributed_by IS NOT NULL AND OLD.deleted_at IS NOT DISTINCT FROM NEW.deleted_at)
+    EXECUTE FUNCTION contributions_function_record();
+
+COMMIT;
\ No newline at end of file
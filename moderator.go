@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/aria3ppp/watchlist-server/internal/app"
+	"go.uber.org/zap"
+)
+
+// runSetModerator grants the moderator role to the user of args[0] or
+// revokes it if args[1] is --revoke
+func runSetModerator(
+	application *app.Application,
+	logger *zap.Logger,
+	args []string,
+) error {
+	if len(args) == 0 {
+		err := errors.New("usage: moderator <user id> [--revoke]")
+		logger.Error("set moderator failed", zap.Error(err))
+		return err
+	}
+	userID, err := strconv.Atoi(args[0])
+	if err != nil {
+		logger.Error("set moderator failed: invalid user id", zap.Error(err))
+		return err
+	}
+	moderator := !(len(args) > 1 && args[1] == "--revoke")
+	err = application.UserSetModerator(context.Background(), userID, moderator)
+	if err != nil {
+		logger.Error(
+			"set moderator failed",
+			zap.Int("user_id", userID),
+			zap.Error(err),
+		)
+		return err
+	}
+	logger.Info(
+		"moderator set",
+		zap.Int("user_id", userID),
+		zap.Bool("moderator", moderator),
+	)
+	return nil
+}